@@ -251,6 +251,40 @@ const docTemplate = `{
                 }
             }
         },
+        "/admin/library-audit": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyCookieAuth": []
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Returns the number of videos of each health status and the degraded and broken videos found by the last library audit.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "admin"
+                ],
+                "summary": "Get the library audit",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/vod.LibraryAudit"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
         "/admin/storage-distribution": {
             "get": {
                 "security": [
@@ -362,8 +396,8 @@ const docTemplate = `{
                 }
             }
         },
-        "/archive/channel": {
-            "post": {
+        "/admin/viewing-statistics": {
+            "get": {
                 "security": [
                     {
                         "ApiKeyCookieAuth": []
@@ -372,33 +406,39 @@ const docTemplate = `{
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "Archive a twitch channel (creates channel in database and download profile image)",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "Get the most watched channels and videos, completion rates and watch time per user of the viewing sessions started in the date range",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "archive"
+                    "admin"
                 ],
-                "summary": "Archive a twitch channel",
+                "summary": "Get viewing statistics",
                 "parameters": [
                     {
-                        "description": "Channel",
-                        "name": "channel",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/http.ArchiveChannelRequest"
-                        }
+                        "type": "string",
+                        "description": "Start of the date range (YYYY-MM-DD or RFC3339)",
+                        "name": "from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "End of the date range (YYYY-MM-DD or RFC3339)",
+                        "name": "to",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Only include sessions of the user",
+                        "name": "user_id",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/ent.Channel"
+                            "$ref": "#/definitions/admin.GetViewingStatisticsResponse"
                         }
                     },
                     "400": {
@@ -416,8 +456,8 @@ const docTemplate = `{
                 }
             }
         },
-        "/archive/video": {
-            "post": {
+        "/admin/viewing-statistics/videos/{id}/heatmap": {
+            "get": {
                 "security": [
                     {
                         "ApiKeyCookieAuth": []
@@ -426,33 +466,51 @@ const docTemplate = `{
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "Archive a twitch vod",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "Get how often each part of the video was watched in the viewing sessions started in the date range. Keys are the start of the buckets in seconds.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "archive"
+                    "admin"
                 ],
-                "summary": "Archive a twitch vod",
+                "summary": "Get video watch heatmap",
                 "parameters": [
                     {
-                        "description": "Vod",
-                        "name": "vod",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/http.ArchiveVideoRequest"
-                        }
+                        "type": "string",
+                        "description": "Video ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "minimum": 1,
+                        "type": "integer",
+                        "default": 60,
+                        "description": "Bucket size in seconds, increased for long videos so there are at most 10000 buckets",
+                        "name": "resolution",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Start of the date range (YYYY-MM-DD or RFC3339)",
+                        "name": "from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "End of the date range (YYYY-MM-DD or RFC3339)",
+                        "name": "to",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/archive.TwitchVodResponse"
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "integer"
+                            }
                         }
                     },
                     "400": {
@@ -461,6 +519,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/utils.ErrorResponse"
                         }
                     },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -470,14 +534,17 @@ const docTemplate = `{
                 }
             }
         },
-        "/auth/change-password": {
+        "/archive/channel": {
             "post": {
                 "security": [
                     {
                         "ApiKeyCookieAuth": []
+                    },
+                    {
+                        "ApiKeyAuth": []
                     }
                 ],
-                "description": "Change password",
+                "description": "Archive a twitch, youtube or kick channel (creates channel in database and download profile image)",
                 "consumes": [
                     "application/json"
                 ],
@@ -485,17 +552,17 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "auth"
+                    "archive"
                 ],
-                "summary": "Change password",
+                "summary": "Archive a channel",
                 "parameters": [
                     {
-                        "description": "Change password",
-                        "name": "change-password",
+                        "description": "Channel",
+                        "name": "channel",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/http.ChangePasswordRequest"
+                            "$ref": "#/definitions/http.ArchiveChannelRequest"
                         }
                     }
                 ],
@@ -503,7 +570,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "type": "string"
+                            "$ref": "#/definitions/ent.Channel"
                         }
                     },
                     "400": {
@@ -512,12 +579,6 @@ const docTemplate = `{
                             "$ref": "#/definitions/utils.ErrorResponse"
                         }
                     },
-                    "401": {
-                        "description": "Unauthorized",
-                        "schema": {
-                            "$ref": "#/definitions/utils.ErrorResponse"
-                        }
-                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -527,9 +588,17 @@ const docTemplate = `{
                 }
             }
         },
-        "/auth/login": {
+        "/archive/clip": {
             "post": {
-                "description": "Login a user (sets access-token and refresh-token cookies). Access token lasts for 1 hour. Refresh token lasts for 1 month.",
+                "security": [
+                    {
+                        "ApiKeyCookieAuth": []
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Create a clip of the part of an archived video between start and end. The video, rendered chat and chat are cut by a task, the streams are copied when the clip starts on a keyframe and re-encoded otherwise.",
                 "consumes": [
                     "application/json"
                 ],
@@ -537,17 +606,17 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "auth"
+                    "archive"
                 ],
-                "summary": "Login a user",
+                "summary": "Create a clip",
                 "parameters": [
                     {
-                        "description": "Login",
-                        "name": "login",
+                        "description": "Clip",
+                        "name": "clip",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/http.LoginRequest"
+                            "$ref": "#/definitions/http.CreateClipRequest"
                         }
                     }
                 ],
@@ -555,7 +624,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/ent.User"
+                            "$ref": "#/definitions/ent.Vod"
                         }
                     },
                     "400": {
@@ -564,8 +633,8 @@ const docTemplate = `{
                             "$ref": "#/definitions/utils.ErrorResponse"
                         }
                     },
-                    "401": {
-                        "description": "Unauthorized",
+                    "404": {
+                        "description": "Not Found",
                         "schema": {
                             "$ref": "#/definitions/utils.ErrorResponse"
                         }
@@ -579,14 +648,17 @@ const docTemplate = `{
                 }
             }
         },
-        "/auth/me": {
-            "get": {
+        "/archive/import/confirm": {
+            "post": {
                 "security": [
                     {
                         "ApiKeyCookieAuth": []
+                    },
+                    {
+                        "ApiKeyAuth": []
                     }
                 ],
-                "description": "Get current user",
+                "description": "Imports the reviewed items of an import scan. Missing channels are created and each video is probed and gets its thumbnails generated by a task. Set fetch_metadata to create channels and update videos with the metadata from their platform.",
                 "consumes": [
                     "application/json"
                 ],
@@ -594,14 +666,25 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "auth"
+                    "archive"
+                ],
+                "summary": "Import videos",
+                "parameters": [
+                    {
+                        "description": "Items to import",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/importer.ConfirmInput"
+                        }
+                    }
                 ],
-                "summary": "Get current user",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/ent.User"
+                            "$ref": "#/definitions/importer.ConfirmResult"
                         }
                     },
                     "400": {
@@ -610,12 +693,6 @@ const docTemplate = `{
                             "$ref": "#/definitions/utils.ErrorResponse"
                         }
                     },
-                    "401": {
-                        "description": "Unauthorized",
-                        "schema": {
-                            "$ref": "#/definitions/utils.ErrorResponse"
-                        }
-                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -625,9 +702,17 @@ const docTemplate = `{
                 }
             }
         },
-        "/auth/oauth/callback": {
-            "get": {
-                "description": "OAuth callback for OAuth provider",
+        "/archive/import/scan": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyCookieAuth": []
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Returns the videos found below the directory with the metadata read from their info files, NFO files or storage template without importing them. The directory must be inside the videos directory.",
                 "consumes": [
                     "application/json"
                 ],
@@ -635,14 +720,25 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "auth"
+                    "archive"
+                ],
+                "summary": "Scan a directory for videos to import",
+                "parameters": [
+                    {
+                        "description": "Directory",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/http.ScanImportRequest"
+                        }
+                    }
                 ],
-                "summary": "OAuth callback",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "type": "string"
+                            "$ref": "#/definitions/importer.Report"
                         }
                     },
                     "400": {
@@ -651,12 +747,6 @@ const docTemplate = `{
                             "$ref": "#/definitions/utils.ErrorResponse"
                         }
                     },
-                    "401": {
-                        "description": "Unauthorized",
-                        "schema": {
-                            "$ref": "#/definitions/utils.ErrorResponse"
-                        }
-                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -666,9 +756,17 @@ const docTemplate = `{
                 }
             }
         },
-        "/auth/oauth/login": {
-            "get": {
-                "description": "Login a user with OAuth (sets access-token and refresh-token cookies)",
+        "/archive/reencode": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyCookieAuth": []
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Re-encode the archived videos matching the filter with a transcoding profile. Each video is re-encoded in the temp directory and only replaces the archived video once its duration matches. HLS videos are skipped. Progress is shown in the queue.",
                 "consumes": [
                     "application/json"
                 ],
@@ -676,15 +774,23 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "auth"
+                    "archive"
                 ],
-                "summary": "Login a user with OAuth",
-                "responses": {
-                    "200": {
-                        "description": "OK",
+                "summary": "Re-encode archived videos",
+                "parameters": [
+                    {
+                        "description": "Re-encode request",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
                         "schema": {
-                            "$ref": "#/definitions/ent.User"
+                            "$ref": "#/definitions/http.ReencodeVideosRequest"
                         }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK"
                     },
                     "400": {
                         "description": "Bad Request",
@@ -692,12 +798,6 @@ const docTemplate = `{
                             "$ref": "#/definitions/utils.ErrorResponse"
                         }
                     },
-                    "401": {
-                        "description": "Unauthorized",
-                        "schema": {
-                            "$ref": "#/definitions/utils.ErrorResponse"
-                        }
-                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -707,9 +807,17 @@ const docTemplate = `{
                 }
             }
         },
-        "/auth/register": {
+        "/archive/video": {
             "post": {
-                "description": "Register a user (does not log in)",
+                "security": [
+                    {
+                        "ApiKeyCookieAuth": []
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Archive a twitch, youtube or kick vod, a twitch clip, or a live stream of a channel",
                 "consumes": [
                     "application/json"
                 ],
@@ -717,17 +825,17 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "auth"
+                    "archive"
                 ],
-                "summary": "Register a user",
+                "summary": "Archive a vod",
                 "parameters": [
                     {
-                        "description": "Register",
-                        "name": "register",
+                        "description": "Vod",
+                        "name": "vod",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/http.RegisterRequest"
+                            "$ref": "#/definitions/http.ArchiveVideoRequest"
                         }
                     }
                 ],
@@ -735,7 +843,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/ent.User"
+                            "$ref": "#/definitions/archive.TwitchVodResponse"
                         }
                     },
                     "400": {
@@ -744,12 +852,6 @@ const docTemplate = `{
                             "$ref": "#/definitions/utils.ErrorResponse"
                         }
                     },
-                    "403": {
-                        "description": "Forbidden",
-                        "schema": {
-                            "$ref": "#/definitions/utils.ErrorResponse"
-                        }
-                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -759,9 +861,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/channel": {
-            "get": {
-                "description": "Returns all channels",
+        "/auth/change-password": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyCookieAuth": []
+                    }
+                ],
+                "description": "Change password",
                 "consumes": [
                     "application/json"
                 ],
@@ -769,17 +876,25 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "channel"
+                    "auth"
+                ],
+                "summary": "Change password",
+                "parameters": [
+                    {
+                        "description": "Change password",
+                        "name": "change-password",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/http.ChangePasswordRequest"
+                        }
+                    }
                 ],
-                "summary": "Get all channels",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/ent.Channel"
-                            }
+                            "type": "string"
                         }
                     },
                     "400": {
@@ -788,6 +903,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/utils.ErrorResponse"
                         }
                     },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -795,17 +916,11 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
+            }
+        },
+        "/auth/login": {
             "post": {
-                "security": [
-                    {
-                        "ApiKeyCookieAuth": []
-                    },
-                    {
-                        "ApiKeyAuth": []
-                    }
-                ],
-                "description": "Create a channel",
+                "description": "Login a user (sets access-token and refresh-token cookies). Access token lasts for 1 hour. Refresh token lasts for 1 month.",
                 "consumes": [
                     "application/json"
                 ],
@@ -813,17 +928,17 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "channel"
+                    "auth"
                 ],
-                "summary": "Create a channel",
+                "summary": "Login a user",
                 "parameters": [
                     {
-                        "description": "Channel",
-                        "name": "channel",
+                        "description": "Login",
+                        "name": "login",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/http.CreateChannelRequest"
+                            "$ref": "#/definitions/http.LoginRequest"
                         }
                     }
                 ],
@@ -831,7 +946,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/ent.Channel"
+                            "$ref": "#/definitions/ent.User"
                         }
                     },
                     "400": {
@@ -840,6 +955,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/utils.ErrorResponse"
                         }
                     },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -849,9 +970,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/channel/name/{name}": {
+        "/auth/me": {
             "get": {
-                "description": "Returns a channel by name",
+                "security": [
+                    {
+                        "ApiKeyCookieAuth": []
+                    }
+                ],
+                "description": "Get current user",
                 "consumes": [
                     "application/json"
                 ],
@@ -859,23 +985,14 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "channel"
-                ],
-                "summary": "Get a channel by name",
-                "parameters": [
-                    {
-                        "type": "string",
-                        "description": "Channel name",
-                        "name": "name",
-                        "in": "path",
-                        "required": true
-                    }
+                    "auth"
                 ],
+                "summary": "Get current user",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/ent.Channel"
+                            "$ref": "#/definitions/ent.User"
                         }
                     },
                     "400": {
@@ -884,8 +1001,8 @@ const docTemplate = `{
                             "$ref": "#/definitions/utils.ErrorResponse"
                         }
                     },
-                    "404": {
-                        "description": "Not Found",
+                    "401": {
+                        "description": "Unauthorized",
                         "schema": {
                             "$ref": "#/definitions/utils.ErrorResponse"
                         }
@@ -899,9 +1016,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/channel/{id}": {
+        "/auth/oauth/callback": {
             "get": {
-                "description": "Returns a channel",
+                "description": "OAuth callback for OAuth provider",
                 "consumes": [
                     "application/json"
                 ],
@@ -909,23 +1026,14 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "channel"
-                ],
-                "summary": "Get a channel",
-                "parameters": [
-                    {
-                        "type": "string",
-                        "description": "Channel ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
-                    }
+                    "auth"
                 ],
+                "summary": "OAuth callback",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/ent.Channel"
+                            "type": "string"
                         }
                     },
                     "400": {
@@ -934,8 +1042,8 @@ const docTemplate = `{
                             "$ref": "#/definitions/utils.ErrorResponse"
                         }
                     },
-                    "404": {
-                        "description": "Not Found",
+                    "401": {
+                        "description": "Unauthorized",
                         "schema": {
                             "$ref": "#/definitions/utils.ErrorResponse"
                         }
@@ -947,17 +1055,11 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "put": {
-                "security": [
-                    {
-                        "ApiKeyCookieAuth": []
-                    },
-                    {
-                        "ApiKeyAuth": []
-                    }
-                ],
-                "description": "Update a channel",
+            }
+        },
+        "/auth/oauth/login": {
+            "get": {
+                "description": "Login a user with OAuth (sets access-token and refresh-token cookies)",
                 "consumes": [
                     "application/json"
                 ],
@@ -965,32 +1067,14 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "channel"
-                ],
-                "summary": "Update a channel",
-                "parameters": [
-                    {
-                        "type": "string",
-                        "description": "Channel ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "description": "Channel",
-                        "name": "channel",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/http.CreateChannelRequest"
-                        }
-                    }
+                    "auth"
                 ],
+                "summary": "Login a user with OAuth",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/ent.Channel"
+                            "$ref": "#/definitions/ent.User"
                         }
                     },
                     "400": {
@@ -999,8 +1083,8 @@ const docTemplate = `{
                             "$ref": "#/definitions/utils.ErrorResponse"
                         }
                     },
-                    "404": {
-                        "description": "Not Found",
+                    "401": {
+                        "description": "Unauthorized",
                         "schema": {
                             "$ref": "#/definitions/utils.ErrorResponse"
                         }
@@ -1012,17 +1096,11 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "delete": {
-                "security": [
-                    {
-                        "ApiKeyCookieAuth": []
-                    },
-                    {
-                        "ApiKeyAuth": []
-                    }
-                ],
-                "description": "Delete a channel",
+            }
+        },
+        "/auth/register": {
+            "post": {
+                "description": "Register a user (does not log in)",
                 "consumes": [
                     "application/json"
                 ],
@@ -1030,21 +1108,26 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "channel"
+                    "auth"
                 ],
-                "summary": "Delete a channel",
+                "summary": "Register a user",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "Channel ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
+                        "description": "Register",
+                        "name": "register",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/http.RegisterRequest"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "OK"
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/ent.User"
+                        }
                     },
                     "400": {
                         "description": "Bad Request",
@@ -1052,8 +1135,8 @@ const docTemplate = `{
                             "$ref": "#/definitions/utils.ErrorResponse"
                         }
                     },
-                    "404": {
-                        "description": "Not Found",
+                    "403": {
+                        "description": "Forbidden",
                         "schema": {
                             "$ref": "#/definitions/utils.ErrorResponse"
                         }
@@ -1067,17 +1150,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/config": {
+        "/channel": {
             "get": {
-                "security": [
-                    {
-                        "ApiKeyCookieAuth": []
-                    },
-                    {
-                        "ApiKeyAuth": []
-                    }
-                ],
-                "description": "Get config",
+                "description": "Returns all channels",
                 "consumes": [
                     "application/json"
                 ],
@@ -1085,14 +1160,23 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "config"
+                    "channel"
                 ],
-                "summary": "Get config",
+                "summary": "Get all channels",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/config.Config"
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/ent.Channel"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
                         }
                     },
                     "500": {
@@ -1103,7 +1187,7 @@ const docTemplate = `{
                     }
                 }
             },
-            "put": {
+            "post": {
                 "security": [
                     {
                         "ApiKeyCookieAuth": []
@@ -1112,7 +1196,7 @@ const docTemplate = `{
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "Update config",
+                "description": "Create a channel",
                 "consumes": [
                     "application/json"
                 ],
@@ -1120,17 +1204,17 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "config"
+                    "channel"
                 ],
-                "summary": "Update config",
+                "summary": "Create a channel",
                 "parameters": [
                     {
-                        "description": "Config",
-                        "name": "body",
+                        "description": "Channel",
+                        "name": "channel",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/config.Config"
+                            "$ref": "#/definitions/http.CreateChannelRequest"
                         }
                     }
                 ],
@@ -1138,7 +1222,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/config.Config"
+                            "$ref": "#/definitions/ent.Channel"
                         }
                     },
                     "400": {
@@ -1156,17 +1240,59 @@ const docTemplate = `{
                 }
             }
         },
-        "/live": {
+        "/channel/name/{name}": {
             "get": {
-                "security": [
-                    {
-                        "ApiKeyCookieAuth": []
-                    },
+                "description": "Returns a channel by name",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "channel"
+                ],
+                "summary": "Get a channel by name",
+                "parameters": [
                     {
-                        "ApiKeyAuth": []
+                        "type": "string",
+                        "description": "Channel name",
+                        "name": "name",
+                        "in": "path",
+                        "required": true
                     }
                 ],
-                "description": "Get all watched channels",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/ent.Channel"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/channel/{id}": {
+            "get": {
+                "description": "Returns a channel",
                 "consumes": [
                     "application/json"
                 ],
@@ -1174,17 +1300,35 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Live"
+                    "channel"
+                ],
+                "summary": "Get a channel",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Channel ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
                 ],
-                "summary": "Get all watched channels",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/ent.Live"
-                            }
+                            "$ref": "#/definitions/ent.Channel"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
                         }
                     },
                     "500": {
@@ -1195,7 +1339,7 @@ const docTemplate = `{
                     }
                 }
             },
-            "post": {
+            "put": {
                 "security": [
                     {
                         "ApiKeyCookieAuth": []
@@ -1204,7 +1348,7 @@ const docTemplate = `{
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "Add watched channel",
+                "description": "Update a channel",
                 "consumes": [
                     "application/json"
                 ],
@@ -1212,17 +1356,24 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Live"
+                    "channel"
                 ],
-                "summary": "Add watched channel",
+                "summary": "Update a channel",
                 "parameters": [
                     {
-                        "description": "Add watched channel",
-                        "name": "body",
+                        "type": "string",
+                        "description": "Channel ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Channel",
+                        "name": "channel",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/http.AddWatchedChannelRequest"
+                            "$ref": "#/definitions/http.CreateChannelRequest"
                         }
                     }
                 ],
@@ -1230,7 +1381,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/ent.Live"
+                            "$ref": "#/definitions/ent.Channel"
                         }
                     },
                     "400": {
@@ -1239,6 +1390,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/utils.ErrorResponse"
                         }
                     },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -1246,10 +1403,8 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/live/{id}": {
-            "put": {
+            },
+            "delete": {
                 "security": [
                     {
                         "ApiKeyCookieAuth": []
@@ -1258,7 +1413,7 @@ const docTemplate = `{
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "Update watched channel",
+                "description": "Delete a channel",
                 "consumes": [
                     "application/json"
                 ],
@@ -1266,9 +1421,9 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Live"
+                    "channel"
                 ],
-                "summary": "Update watched channel",
+                "summary": "Delete a channel",
                 "parameters": [
                     {
                         "type": "string",
@@ -1276,23 +1431,11 @@ const docTemplate = `{
                         "name": "id",
                         "in": "path",
                         "required": true
-                    },
-                    {
-                        "description": "Update watched channel",
-                        "name": "body",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/http.UpdateWatchedChannelRequest"
-                        }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "OK",
-                        "schema": {
-                            "$ref": "#/definitions/ent.Live"
-                        }
+                        "description": "OK"
                     },
                     "400": {
                         "description": "Bad Request",
@@ -1300,6 +1443,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/utils.ErrorResponse"
                         }
                     },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -1307,8 +1456,10 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "delete": {
+            }
+        },
+        "/channel/{id}/retention/dry-run": {
+            "get": {
                 "security": [
                     {
                         "ApiKeyCookieAuth": []
@@ -1317,17 +1468,14 @@ const docTemplate = `{
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "Delete watched channel",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "Returns the videos the retention policy of the channel would delete without deleting them. The policy is evaluated even if retention is not enabled for the channel.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Live"
+                    "channel"
                 ],
-                "summary": "Delete watched channel",
+                "summary": "Preview a channel retention policy",
                 "parameters": [
                     {
                         "type": "string",
@@ -1341,7 +1489,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/ent.Live"
+                            "$ref": "#/definitions/vod.RetentionDryRun"
                         }
                     },
                     "400": {
@@ -1350,6 +1498,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/utils.ErrorResponse"
                         }
                     },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -1359,7 +1513,7 @@ const docTemplate = `{
                 }
             }
         },
-        "/notification": {
+        "/config": {
             "get": {
                 "security": [
                     {
@@ -1369,7 +1523,7 @@ const docTemplate = `{
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "Get all notification configurations",
+                "description": "Get config",
                 "consumes": [
                     "application/json"
                 ],
@@ -1377,17 +1531,14 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "notification"
+                    "config"
                 ],
-                "summary": "Get all notification configurations",
+                "summary": "Get config",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/http.NotificationResponse"
-                            }
+                            "$ref": "#/definitions/config.Config"
                         }
                     },
                     "500": {
@@ -1398,7 +1549,7 @@ const docTemplate = `{
                     }
                 }
             },
-            "post": {
+            "put": {
                 "security": [
                     {
                         "ApiKeyCookieAuth": []
@@ -1407,7 +1558,7 @@ const docTemplate = `{
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "Create a new notification configuration",
+                "description": "Update config",
                 "consumes": [
                     "application/json"
                 ],
@@ -1415,17 +1566,17 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "notification"
+                    "config"
                 ],
-                "summary": "Create a notification configuration",
+                "summary": "Update config",
                 "parameters": [
                     {
-                        "description": "Notification",
+                        "description": "Config",
                         "name": "body",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/http.NotificationRequest"
+                            "$ref": "#/definitions/config.Config"
                         }
                     }
                 ],
@@ -1433,7 +1584,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/http.NotificationResponse"
+                            "$ref": "#/definitions/config.Config"
                         }
                     },
                     "400": {
@@ -1451,7 +1602,7 @@ const docTemplate = `{
                 }
             }
         },
-        "/notification/{id}": {
+        "/config/transcoding-profiles": {
             "get": {
                 "security": [
                     {
@@ -1461,7 +1612,7 @@ const docTemplate = `{
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "Get a single notification configuration by id",
+                "description": "Get the transcoding profiles that watched channels and archives can be post-processed with",
                 "consumes": [
                     "application/json"
                 ],
@@ -1469,35 +1620,17 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "notification"
-                ],
-                "summary": "Get a single notification configuration",
-                "parameters": [
-                    {
-                        "type": "string",
-                        "description": "Notification ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
-                    }
+                    "config"
                 ],
+                "summary": "Get transcoding profiles",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/http.NotificationResponse"
-                        }
-                    },
-                    "400": {
-                        "description": "Bad Request",
-                        "schema": {
-                            "$ref": "#/definitions/utils.ErrorResponse"
-                        }
-                    },
-                    "404": {
-                        "description": "Not Found",
-                        "schema": {
-                            "$ref": "#/definitions/utils.ErrorResponse"
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/ent.TranscodingProfile"
+                            }
                         }
                     },
                     "500": {
@@ -1508,7 +1641,7 @@ const docTemplate = `{
                     }
                 }
             },
-            "put": {
+            "post": {
                 "security": [
                     {
                         "ApiKeyCookieAuth": []
@@ -1517,7 +1650,7 @@ const docTemplate = `{
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "Update an existing notification configuration",
+                "description": "Create a transcoding profile. The FFmpeg arguments are validated by post-processing a short test video.",
                 "consumes": [
                     "application/json"
                 ],
@@ -1525,24 +1658,17 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "notification"
+                    "config"
                 ],
-                "summary": "Update a notification configuration",
+                "summary": "Create a transcoding profile",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "Notification ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "description": "Notification",
+                        "description": "Transcoding profile",
                         "name": "body",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/http.NotificationRequest"
+                            "$ref": "#/definitions/http.TranscodingProfileRequest"
                         }
                     }
                 ],
@@ -1550,7 +1676,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/http.NotificationResponse"
+                            "$ref": "#/definitions/ent.TranscodingProfile"
                         }
                     },
                     "400": {
@@ -1559,12 +1685,6 @@ const docTemplate = `{
                             "$ref": "#/definitions/utils.ErrorResponse"
                         }
                     },
-                    "404": {
-                        "description": "Not Found",
-                        "schema": {
-                            "$ref": "#/definitions/utils.ErrorResponse"
-                        }
-                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -1572,8 +1692,10 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "delete": {
+            }
+        },
+        "/config/transcoding-profiles/{id}": {
+            "put": {
                 "security": [
                     {
                         "ApiKeyCookieAuth": []
@@ -1582,7 +1704,7 @@ const docTemplate = `{
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "Delete a notification configuration by id",
+                "description": "Update a transcoding profile. The FFmpeg arguments are validated by post-processing a short test video.",
                 "consumes": [
                     "application/json"
                 ],
@@ -1590,21 +1712,33 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "notification"
+                    "config"
                 ],
-                "summary": "Delete a notification configuration",
+                "summary": "Update a transcoding profile",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Notification ID",
+                        "description": "Transcoding profile ID",
                         "name": "id",
                         "in": "path",
                         "required": true
-                    }
-                ],
-                "responses": {
-                    "200": {
-                        "description": "OK"
+                    },
+                    {
+                        "description": "Transcoding profile",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/http.TranscodingProfileRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/ent.TranscodingProfile"
+                        }
                     },
                     "400": {
                         "description": "Bad Request",
@@ -1625,10 +1759,8 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/notification/{id}/test": {
-            "post": {
+            },
+            "delete": {
                 "security": [
                     {
                         "ApiKeyCookieAuth": []
@@ -1637,7 +1769,7 @@ const docTemplate = `{
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "Send a test notification using dummy data for the given event type",
+                "description": "Delete a transcoding profile. Watched channels and videos using it fall back to the global video convert arguments.",
                 "consumes": [
                     "application/json"
                 ],
@@ -1645,25 +1777,16 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "notification"
+                    "config"
                 ],
-                "summary": "Test a notification configuration",
+                "summary": "Delete a transcoding profile",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Notification ID",
+                        "description": "Transcoding profile ID",
                         "name": "id",
                         "in": "path",
                         "required": true
-                    },
-                    {
-                        "description": "Test event",
-                        "name": "body",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/http.TestNotificationRequest"
-                        }
                     }
                 ],
                 "responses": {
@@ -1691,14 +1814,17 @@ const docTemplate = `{
                 }
             }
         },
-        "/playback": {
+        "/live": {
             "get": {
                 "security": [
                     {
                         "ApiKeyCookieAuth": []
+                    },
+                    {
+                        "ApiKeyAuth": []
                     }
                 ],
-                "description": "Get all playback progress",
+                "description": "Get all watched channels",
                 "consumes": [
                     "application/json"
                 ],
@@ -1706,16 +1832,16 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Playback"
+                    "Live"
                 ],
-                "summary": "Get all progress",
+                "summary": "Get all watched channels",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
                             "type": "array",
                             "items": {
-                                "$ref": "#/definitions/ent.Playback"
+                                "$ref": "#/definitions/ent.Live"
                             }
                         }
                     },
@@ -1726,16 +1852,17 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/playback/progress": {
+            },
             "post": {
                 "security": [
                     {
                         "ApiKeyCookieAuth": []
+                    },
+                    {
+                        "ApiKeyAuth": []
                     }
                 ],
-                "description": "Update playback progress",
+                "description": "Add watched channel",
                 "consumes": [
                     "application/json"
                 ],
@@ -1743,17 +1870,17 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Playback"
+                    "Live"
                 ],
-                "summary": "Update progress",
+                "summary": "Add watched channel",
                 "parameters": [
                     {
-                        "description": "progress",
-                        "name": "progress",
+                        "description": "Add watched channel",
+                        "name": "body",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/http.UpdateProgressRequest"
+                            "$ref": "#/definitions/http.AddWatchedChannelRequest"
                         }
                     }
                 ],
@@ -1761,7 +1888,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "type": "string"
+                            "$ref": "#/definitions/ent.Live"
                         }
                     },
                     "400": {
@@ -1779,58 +1906,17 @@ const docTemplate = `{
                 }
             }
         },
-        "/playback/progress/{id}": {
-            "get": {
+        "/live/{id}": {
+            "put": {
                 "security": [
                     {
                         "ApiKeyCookieAuth": []
-                    }
-                ],
-                "description": "Get playback progress",
-                "consumes": [
-                    "application/json"
-                ],
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "Playback"
-                ],
-                "summary": "Get progress",
-                "parameters": [
+                    },
                     {
-                        "type": "string",
-                        "description": "vod id",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
+                        "ApiKeyAuth": []
                     }
                 ],
-                "responses": {
-                    "200": {
-                        "description": "OK",
-                        "schema": {
-                            "$ref": "#/definitions/ent.Playback"
-                        }
-                    },
-                    "400": {
-                        "description": "Bad Request",
-                        "schema": {
-                            "$ref": "#/definitions/utils.ErrorResponse"
-                        }
-                    },
-                    "500": {
-                        "description": "Internal Server Error",
-                        "schema": {
-                            "$ref": "#/definitions/utils.ErrorResponse"
-                        }
-                    }
-                }
-            }
-        },
-        "/playback/start": {
-            "post": {
-                "description": "Adds a view to the video local view count",
+                "description": "Update watched channel",
                 "consumes": [
                     "application/json"
                 ],
@@ -1838,66 +1924,24 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Playback"
+                    "Live"
                 ],
-                "summary": "Start playback",
+                "summary": "Update watched channel",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "vod id",
+                        "description": "Channel ID",
                         "name": "id",
                         "in": "path",
                         "required": true
-                    }
-                ],
-                "responses": {
-                    "200": {
-                        "description": "OK",
-                        "schema": {
-                            "type": "string"
-                        }
-                    },
-                    "400": {
-                        "description": "Bad Request",
-                        "schema": {
-                            "$ref": "#/definitions/utils.ErrorResponse"
-                        }
                     },
-                    "500": {
-                        "description": "Internal Server Error",
-                        "schema": {
-                            "$ref": "#/definitions/utils.ErrorResponse"
-                        }
-                    }
-                }
-            }
-        },
-        "/playback/status": {
-            "post": {
-                "security": [
-                    {
-                        "ApiKeyCookieAuth": []
-                    }
-                ],
-                "description": "Update playback status",
-                "consumes": [
-                    "application/json"
-                ],
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "Playback"
-                ],
-                "summary": "Update status",
-                "parameters": [
                     {
-                        "description": "status",
-                        "name": "status",
+                        "description": "Update watched channel",
+                        "name": "body",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/http.UpdateStatusRequest"
+                            "$ref": "#/definitions/http.UpdateWatchedChannelRequest"
                         }
                     }
                 ],
@@ -1905,7 +1949,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "type": "string"
+                            "$ref": "#/definitions/ent.Live"
                         }
                     },
                     "400": {
@@ -1921,16 +1965,17 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/playback/{id}": {
+            },
             "delete": {
                 "security": [
                     {
                         "ApiKeyCookieAuth": []
+                    },
+                    {
+                        "ApiKeyAuth": []
                     }
                 ],
-                "description": "Delete playback progress",
+                "description": "Delete watched channel",
                 "consumes": [
                     "application/json"
                 ],
@@ -1938,13 +1983,13 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Playback"
+                    "Live"
                 ],
-                "summary": "Delete progress",
+                "summary": "Delete watched channel",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "vod id",
+                        "description": "Channel ID",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -1954,7 +1999,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "type": "string"
+                            "$ref": "#/definitions/ent.Live"
                         }
                     },
                     "400": {
@@ -1972,26 +2017,28 @@ const docTemplate = `{
                 }
             }
         },
-        "/playlist": {
+        "/me/lists": {
             "get": {
-                "description": "Get playlists",
-                "consumes": [
-                    "application/json"
+                "security": [
+                    {
+                        "ApiKeyCookieAuth": []
+                    }
                 ],
+                "description": "Get the personal video lists of the user with their items. Every user has a watch later and a favorites list.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Playlist"
+                    "Me"
                 ],
-                "summary": "Get playlists",
+                "summary": "Get my lists",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
                             "type": "array",
                             "items": {
-                                "$ref": "#/definitions/ent.Playlist"
+                                "$ref": "#/definitions/ent.UserList"
                             }
                         }
                     },
@@ -2007,12 +2054,9 @@ const docTemplate = `{
                 "security": [
                     {
                         "ApiKeyCookieAuth": []
-                    },
-                    {
-                        "ApiKeyAuth": []
                     }
                 ],
-                "description": "Create playlist",
+                "description": "Create a custom personal video list",
                 "consumes": [
                     "application/json"
                 ],
@@ -2020,17 +2064,17 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Playlist"
+                    "Me"
                 ],
-                "summary": "Create playlist",
+                "summary": "Create list",
                 "parameters": [
                     {
-                        "description": "playlist",
-                        "name": "playlist",
+                        "description": "list",
+                        "name": "body",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/http.CreatePlaylistRequest"
+                            "$ref": "#/definitions/http.UserListRequest"
                         }
                     }
                 ],
@@ -2038,7 +2082,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/ent.Playlist"
+                            "$ref": "#/definitions/ent.UserList"
                         }
                     },
                     "400": {
@@ -2047,6 +2091,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/utils.ErrorResponse"
                         }
                     },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -2056,39 +2106,35 @@ const docTemplate = `{
                 }
             }
         },
-        "/playlist/{id}": {
+        "/me/lists/{id}": {
             "get": {
-                "description": "Get playlist",
-                "consumes": [
-                    "application/json"
+                "security": [
+                    {
+                        "ApiKeyCookieAuth": []
+                    }
                 ],
+                "description": "Get a personal video list with its videos in order",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Playlist"
+                    "Me"
                 ],
-                "summary": "Get playlist",
+                "summary": "Get my list",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "playlist id",
+                        "description": "list id",
                         "name": "id",
                         "in": "path",
                         "required": true
-                    },
-                    {
-                        "type": "boolean",
-                        "description": "include multistream info",
-                        "name": "with_multistream_info",
-                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/ent.Playlist"
+                            "$ref": "#/definitions/ent.UserList"
                         }
                     },
                     "400": {
@@ -2097,6 +2143,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/utils.ErrorResponse"
                         }
                     },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -2109,12 +2161,9 @@ const docTemplate = `{
                 "security": [
                     {
                         "ApiKeyCookieAuth": []
-                    },
-                    {
-                        "ApiKeyAuth": []
                     }
                 ],
-                "description": "Update playlist",
+                "description": "Rename a custom personal video list",
                 "consumes": [
                     "application/json"
                 ],
@@ -2122,24 +2171,24 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Playlist"
+                    "Me"
                 ],
-                "summary": "Update playlist",
+                "summary": "Update list",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "playlist id",
+                        "description": "list id",
                         "name": "id",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "description": "playlist",
-                        "name": "playlist",
+                        "description": "list",
+                        "name": "body",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/http.CreatePlaylistRequest"
+                            "$ref": "#/definitions/http.UserListRequest"
                         }
                     }
                 ],
@@ -2147,7 +2196,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/ent.Playlist"
+                            "$ref": "#/definitions/ent.UserList"
                         }
                     },
                     "400": {
@@ -2156,61 +2205,14 @@ const docTemplate = `{
                             "$ref": "#/definitions/utils.ErrorResponse"
                         }
                     },
-                    "500": {
-                        "description": "Internal Server Error",
+                    "404": {
+                        "description": "Not Found",
                         "schema": {
                             "$ref": "#/definitions/utils.ErrorResponse"
                         }
-                    }
-                }
-            },
-            "post": {
-                "security": [
-                    {
-                        "ApiKeyCookieAuth": []
-                    },
-                    {
-                        "ApiKeyAuth": []
-                    }
-                ],
-                "description": "Add vod to playlist",
-                "consumes": [
-                    "application/json"
-                ],
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "Playlist"
-                ],
-                "summary": "Add vod to playlist",
-                "parameters": [
-                    {
-                        "type": "string",
-                        "description": "playlist id",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "description": "vod",
-                        "name": "vod",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/http.AddVodToPlaylistRequest"
-                        }
-                    }
-                ],
-                "responses": {
-                    "200": {
-                        "description": "OK",
-                        "schema": {
-                            "type": "string"
-                        }
                     },
-                    "400": {
-                        "description": "Bad Request",
+                    "409": {
+                        "description": "Conflict",
                         "schema": {
                             "$ref": "#/definitions/utils.ErrorResponse"
                         }
@@ -2227,26 +2229,20 @@ const docTemplate = `{
                 "security": [
                     {
                         "ApiKeyCookieAuth": []
-                    },
-                    {
-                        "ApiKeyAuth": []
                     }
                 ],
-                "description": "Delete playlist",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "Delete a custom personal video list",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Playlist"
+                    "Me"
                 ],
-                "summary": "Delete playlist",
+                "summary": "Delete list",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "playlist id",
+                        "description": "list id",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -2265,6 +2261,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/utils.ErrorResponse"
                         }
                     },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -2274,17 +2276,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/playlist/{id}/multistream/delay": {
+        "/me/lists/{id}/order": {
             "put": {
                 "security": [
                     {
                         "ApiKeyCookieAuth": []
-                    },
-                    {
-                        "ApiKeyAuth": []
                     }
                 ],
-                "description": "Set delay of vod in playlist for multistream",
+                "description": "Set the order of the videos of a personal video list. The order must contain every video of the list.",
                 "consumes": [
                     "application/json"
                 ],
@@ -2292,24 +2291,24 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Playlist"
+                    "Me"
                 ],
-                "summary": "Set delay of vod in playlist for multistream",
+                "summary": "Reorder list",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "playlist id",
+                        "description": "list id",
                         "name": "id",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "description": "delay",
-                        "name": "delay",
+                        "description": "order",
+                        "name": "body",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/http.SetVodDelayPlaylistRequest"
+                            "$ref": "#/definitions/http.ReorderUserListRequest"
                         }
                     }
                 ],
@@ -2326,6 +2325,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/utils.ErrorResponse"
                         }
                     },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -2335,31 +2340,25 @@ const docTemplate = `{
                 }
             }
         },
-        "/playlist/{id}/rules": {
-            "get": {
+        "/me/lists/{id}/share": {
+            "post": {
                 "security": [
                     {
                         "ApiKeyCookieAuth": []
-                    },
-                    {
-                        "ApiKeyAuth": []
                     }
                 ],
-                "description": "Get rules for a playlist",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "Create a read-only link for a personal video list. The share_token of the list is used in the link.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Playlist"
+                    "Me"
                 ],
-                "summary": "Get playlist rules",
+                "summary": "Share list",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "playlist id",
+                        "description": "list id",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -2369,10 +2368,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/ent.PlaylistRuleGroup"
-                            }
+                            "$ref": "#/definitions/ent.UserList"
                         }
                     },
                     "400": {
@@ -2381,6 +2377,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/utils.ErrorResponse"
                         }
                     },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -2389,47 +2391,34 @@ const docTemplate = `{
                     }
                 }
             },
-            "put": {
+            "delete": {
                 "security": [
                     {
                         "ApiKeyCookieAuth": []
-                    },
-                    {
-                        "ApiKeyAuth": []
                     }
                 ],
-                "description": "Test rules for a playlist against a video id",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "Remove the read-only link of a personal video list",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Playlist"
+                    "Me"
                 ],
-                "summary": "Test playlist rules",
+                "summary": "Unshare list",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "playlist id",
+                        "description": "list id",
                         "name": "id",
                         "in": "path",
                         "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "video id",
-                        "name": "video_id",
-                        "in": "query",
-                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "type": "boolean"
+                            "$ref": "#/definitions/ent.UserList"
                         }
                     },
                     "400": {
@@ -2438,6 +2427,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/utils.ErrorResponse"
                         }
                     },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -2445,14 +2440,16 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
+            }
+        },
+        "/me/lists/{id}/videos": {
             "post": {
                 "security": [
                     {
                         "ApiKeyCookieAuth": []
                     }
                 ],
-                "description": "Set rules for a playlist this will delete all existing rules and set new ones",
+                "description": "Add a video to the end of a personal video list",
                 "consumes": [
                     "application/json"
                 ],
@@ -2460,24 +2457,24 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Playlist"
+                    "Me"
                 ],
-                "summary": "Set playlist rules",
+                "summary": "Add video to list",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "playlist id",
+                        "description": "list id",
                         "name": "id",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "description": "rules",
-                        "name": "rules",
+                        "description": "video",
+                        "name": "body",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/http.SetPlaylistRulesRequest"
+                            "$ref": "#/definitions/http.AddVideoToUserListRequest"
                         }
                     }
                 ],
@@ -2494,6 +2491,18 @@ const docTemplate = `{
                             "$ref": "#/definitions/utils.ErrorResponse"
                         }
                     },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -2503,43 +2512,35 @@ const docTemplate = `{
                 }
             }
         },
-        "/playlist/{id}/vod": {
+        "/me/lists/{id}/videos/{video_id}": {
             "delete": {
                 "security": [
                     {
                         "ApiKeyCookieAuth": []
-                    },
-                    {
-                        "ApiKeyAuth": []
                     }
                 ],
-                "description": "Delete vod from playlist",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "Remove a video from a personal video list",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Playlist"
+                    "Me"
                 ],
-                "summary": "Delete vod from playlist",
+                "summary": "Remove video from list",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "playlist id",
+                        "description": "list id",
                         "name": "id",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "description": "vod",
-                        "name": "vod",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/http.AddVodToPlaylistRequest"
-                        }
+                        "type": "string",
+                        "description": "video id",
+                        "name": "video_id",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
@@ -2555,6 +2556,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/utils.ErrorResponse"
                         }
                     },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -2564,7 +2571,7 @@ const docTemplate = `{
                 }
             }
         },
-        "/queue": {
+        "/notification": {
             "get": {
                 "security": [
                     {
@@ -2574,7 +2581,7 @@ const docTemplate = `{
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "Get queue items",
+                "description": "Get all notification configurations",
                 "consumes": [
                     "application/json"
                 ],
@@ -2582,33 +2589,19 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "queue"
-                ],
-                "summary": "Get queue items",
-                "parameters": [
-                    {
-                        "type": "string",
-                        "description": "Get processing queue items",
-                        "name": "processing",
-                        "in": "query"
-                    }
+                    "notification"
                 ],
+                "summary": "Get all notification configurations",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
                             "type": "array",
                             "items": {
-                                "$ref": "#/definitions/ent.Queue"
+                                "$ref": "#/definitions/http.NotificationResponse"
                             }
                         }
                     },
-                    "400": {
-                        "description": "Bad Request",
-                        "schema": {
-                            "$ref": "#/definitions/utils.ErrorResponse"
-                        }
-                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -2626,7 +2619,7 @@ const docTemplate = `{
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "Create a queue item",
+                "description": "Create a new notification configuration",
                 "consumes": [
                     "application/json"
                 ],
@@ -2634,25 +2627,25 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "queue"
+                    "notification"
                 ],
-                "summary": "Create a queue item",
+                "summary": "Create a notification configuration",
                 "parameters": [
                     {
-                        "description": "Create queue item",
+                        "description": "Notification",
                         "name": "body",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/http.CreateQueueRequest"
+                            "$ref": "#/definitions/http.NotificationRequest"
                         }
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "Created",
+                    "200": {
+                        "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/ent.Queue"
+                            "$ref": "#/definitions/http.NotificationResponse"
                         }
                     },
                     "400": {
@@ -2670,8 +2663,8 @@ const docTemplate = `{
                 }
             }
         },
-        "/queue/task/start": {
-            "post": {
+        "/notification/{id}": {
+            "get": {
                 "security": [
                     {
                         "ApiKeyCookieAuth": []
@@ -2680,7 +2673,7 @@ const docTemplate = `{
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "Start a specific queue task",
+                "description": "Get a single notification configuration by id",
                 "consumes": [
                     "application/json"
                 ],
@@ -2688,14 +2681,23 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "queue"
+                    "notification"
+                ],
+                "summary": "Get a single notification configuration",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Notification ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
                 ],
-                "summary": "Start a queue task for a queue",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "type": "string"
+                            "$ref": "#/definitions/http.NotificationResponse"
                         }
                     },
                     "400": {
@@ -2704,17 +2706,21 @@ const docTemplate = `{
                             "$ref": "#/definitions/utils.ErrorResponse"
                         }
                     },
-                    "500": {
-                        "description": "Internal Server Error",
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
                         "schema": {
                             "$ref": "#/definitions/utils.ErrorResponse"
                         }
                     }
                 }
-            }
-        },
-        "/queue/{id}": {
-            "get": {
+            },
+            "put": {
                 "security": [
                     {
                         "ApiKeyCookieAuth": []
@@ -2723,7 +2729,7 @@ const docTemplate = `{
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "Get queue item",
+                "description": "Update an existing notification configuration",
                 "consumes": [
                     "application/json"
                 ],
@@ -2731,23 +2737,32 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "queue"
+                    "notification"
                 ],
-                "summary": "Get queue item",
+                "summary": "Update a notification configuration",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Queue item id",
+                        "description": "Notification ID",
                         "name": "id",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "description": "Notification",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/http.NotificationRequest"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/ent.Queue"
+                            "$ref": "#/definitions/http.NotificationResponse"
                         }
                     },
                     "400": {
@@ -2756,6 +2771,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/utils.ErrorResponse"
                         }
                     },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -2764,7 +2785,7 @@ const docTemplate = `{
                     }
                 }
             },
-            "put": {
+            "delete": {
                 "security": [
                     {
                         "ApiKeyCookieAuth": []
@@ -2773,7 +2794,7 @@ const docTemplate = `{
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "Update queue item",
+                "description": "Delete a notification configuration by id",
                 "consumes": [
                     "application/json"
                 ],
@@ -2781,33 +2802,21 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "queue"
+                    "notification"
                 ],
-                "summary": "Update queue item",
+                "summary": "Delete a notification configuration",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Queue item id",
+                        "description": "Notification ID",
                         "name": "id",
                         "in": "path",
                         "required": true
-                    },
-                    {
-                        "description": "Update queue item",
-                        "name": "body",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/http.UpdateQueueRequest"
-                        }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "OK",
-                        "schema": {
-                            "$ref": "#/definitions/ent.Queue"
-                        }
+                        "description": "OK"
                     },
                     "400": {
                         "description": "Bad Request",
@@ -2815,6 +2824,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/utils.ErrorResponse"
                         }
                     },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -2822,8 +2837,10 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "delete": {
+            }
+        },
+        "/notification/{id}/test": {
+            "post": {
                 "security": [
                     {
                         "ApiKeyCookieAuth": []
@@ -2832,7 +2849,7 @@ const docTemplate = `{
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "Delete queue item",
+                "description": "Send a test notification using dummy data for the given event type",
                 "consumes": [
                     "application/json"
                 ],
@@ -2840,21 +2857,30 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "queue"
+                    "notification"
                 ],
-                "summary": "Delete queue item",
+                "summary": "Test a notification configuration",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Queue item id",
+                        "description": "Notification ID",
                         "name": "id",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "description": "Test event",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/http.TestNotificationRequest"
+                        }
                     }
                 ],
                 "responses": {
-                    "204": {
-                        "description": "No Content"
+                    "200": {
+                        "description": "OK"
                     },
                     "400": {
                         "description": "Bad Request",
@@ -2862,6 +2888,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/utils.ErrorResponse"
                         }
                     },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -2871,17 +2903,51 @@ const docTemplate = `{
                 }
             }
         },
-        "/queue/{id}/stop": {
-            "post": {
+        "/playback": {
+            "get": {
                 "security": [
                     {
                         "ApiKeyCookieAuth": []
+                    }
+                ],
+                "description": "Get all playback progress",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Playback"
+                ],
+                "summary": "Get all progress",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/ent.Playback"
+                            }
+                        }
                     },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/playback/progress": {
+            "post": {
+                "security": [
                     {
-                        "ApiKeyAuth": []
+                        "ApiKeyCookieAuth": []
                     }
                 ],
-                "description": "Stop processing the video and chat downloads of an active queue item",
+                "description": "Update playback progress",
                 "consumes": [
                     "application/json"
                 ],
@@ -2889,16 +2955,18 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "queue"
+                    "Playback"
                 ],
-                "summary": "Stop a queue item",
+                "summary": "Update progress",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "Queue item id",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
+                        "description": "progress",
+                        "name": "progress",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/http.UpdateProgressRequest"
+                        }
                     }
                 ],
                 "responses": {
@@ -2923,17 +2991,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/queue/{id}/tail": {
+        "/playback/progress/{id}": {
             "get": {
                 "security": [
                     {
                         "ApiKeyCookieAuth": []
-                    },
-                    {
-                        "ApiKeyAuth": []
                     }
                 ],
-                "description": "Read queue log file",
+                "description": "Get playback progress",
                 "consumes": [
                     "application/json"
                 ],
@@ -2941,30 +3006,23 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "queue"
+                    "Playback"
                 ],
-                "summary": "Read queue log file",
+                "summary": "Get progress",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Queue item id",
+                        "description": "vod id",
                         "name": "id",
                         "in": "path",
                         "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "Log type: video, video-convert, chat, chat-render, or chat-convert",
-                        "name": "type",
-                        "in": "query",
-                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "type": "string"
+                            "$ref": "#/definitions/ent.Playback"
                         }
                     },
                     "400": {
@@ -2982,17 +3040,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/task/start": {
+        "/playback/sessions": {
             "post": {
                 "security": [
                     {
                         "ApiKeyCookieAuth": []
-                    },
-                    {
-                        "ApiKeyAuth": []
                     }
                 ],
-                "description": "Start a task",
+                "description": "Start logging a viewing session of the video. The ranges played are added to the session while watching.",
                 "consumes": [
                     "application/json"
                 ],
@@ -3000,23 +3055,38 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "task"
+                    "Playback"
                 ],
-                "summary": "Start a task",
+                "summary": "Start viewing session",
                 "parameters": [
                     {
-                        "description": "StartTaskRequest",
-                        "name": "body",
+                        "description": "viewing session",
+                        "name": "session",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/http.StartTaskRequest"
+                            "$ref": "#/definitions/http.StartViewingSessionRequest"
                         }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "OK"
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/ent.ViewingSession"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
                     },
                     "500": {
                         "description": "Internal Server Error",
@@ -3027,9 +3097,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/twitch/channel": {
-            "get": {
-                "description": "Get a twitch user/channel by name (uses twitch api)",
+        "/playback/sessions/{id}": {
+            "put": {
+                "security": [
+                    {
+                        "ApiKeyCookieAuth": []
+                    }
+                ],
+                "description": "Add the ranges of the video played since the last update to the viewing session",
                 "consumes": [
                     "application/json"
                 ],
@@ -3037,23 +3112,32 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "twitch"
+                    "Playback"
                 ],
-                "summary": "Get a twitch channel",
+                "summary": "Add viewing session ranges",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Twitch user login name",
-                        "name": "name",
-                        "in": "query",
+                        "description": "viewing session id",
+                        "name": "id",
+                        "in": "path",
                         "required": true
+                    },
+                    {
+                        "description": "ranges",
+                        "name": "ranges",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/http.AddViewingSessionRangesRequest"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/platform.ChannelInfo"
+                            "$ref": "#/definitions/ent.ViewingSession"
                         }
                     },
                     "400": {
@@ -3062,6 +3146,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/utils.ErrorResponse"
                         }
                     },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -3071,9 +3161,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/twitch/video": {
-            "get": {
-                "description": "Get a twitch vod by id (uses twitch api)",
+        "/playback/start": {
+            "post": {
+                "description": "Adds a view to the video local view count",
                 "consumes": [
                     "application/json"
                 ],
@@ -3081,15 +3171,15 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "twitch"
+                    "Playback"
                 ],
-                "summary": "Get a twitch vod",
+                "summary": "Start playback",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Twitch vod id",
+                        "description": "vod id",
                         "name": "id",
-                        "in": "query",
+                        "in": "path",
                         "required": true
                     }
                 ],
@@ -3097,7 +3187,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/platform.VideoInfo"
+                            "type": "string"
                         }
                     },
                     "400": {
@@ -3115,87 +3205,40 @@ const docTemplate = `{
                 }
             }
         },
-        "/user": {
+        "/playback/statistics": {
             "get": {
                 "security": [
                     {
                         "ApiKeyCookieAuth": []
-                    },
-                    {
-                        "ApiKeyAuth": []
                     }
                 ],
-                "description": "Get all users",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "Get the most watched channels and videos, completion rates and watch time of the viewing sessions of the user started in the date range",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "user"
+                    "Playback"
                 ],
-                "summary": "Get all users",
-                "responses": {
-                    "200": {
-                        "description": "OK",
-                        "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/ent.User"
-                            }
-                        }
-                    },
-                    "400": {
-                        "description": "Bad Request",
-                        "schema": {
-                            "$ref": "#/definitions/utils.ErrorResponse"
-                        }
-                    },
-                    "500": {
-                        "description": "Internal Server Error",
-                        "schema": {
-                            "$ref": "#/definitions/utils.ErrorResponse"
-                        }
-                    }
-                }
-            }
-        },
-        "/user/{id}": {
-            "get": {
-                "security": [
+                "summary": "Get my viewing statistics",
+                "parameters": [
                     {
-                        "ApiKeyCookieAuth": []
+                        "type": "string",
+                        "description": "Start of the date range (YYYY-MM-DD or RFC3339)",
+                        "name": "from",
+                        "in": "query"
                     },
-                    {
-                        "ApiKeyAuth": []
-                    }
-                ],
-                "description": "Get user by id",
-                "consumes": [
-                    "application/json"
-                ],
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "user"
-                ],
-                "summary": "Get user by id",
-                "parameters": [
                     {
                         "type": "string",
-                        "description": "User ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
+                        "description": "End of the date range (YYYY-MM-DD or RFC3339)",
+                        "name": "to",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/ent.User"
+                            "$ref": "#/definitions/admin.GetViewingStatisticsResponse"
                         }
                     },
                     "400": {
@@ -3211,17 +3254,16 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "put": {
+            }
+        },
+        "/playback/status": {
+            "post": {
                 "security": [
                     {
                         "ApiKeyCookieAuth": []
-                    },
-                    {
-                        "ApiKeyAuth": []
                     }
                 ],
-                "description": "Update user",
+                "description": "Update playback status",
                 "consumes": [
                     "application/json"
                 ],
@@ -3229,24 +3271,17 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "user"
+                    "Playback"
                 ],
-                "summary": "Update user",
+                "summary": "Update status",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "User ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "description": "User data",
-                        "name": "body",
+                        "description": "status",
+                        "name": "status",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/http.UpdateChannelRequest"
+                            "$ref": "#/definitions/http.UpdateStatusRequest"
                         }
                     }
                 ],
@@ -3254,7 +3289,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/ent.User"
+                            "type": "string"
                         }
                     },
                     "400": {
@@ -3270,17 +3305,16 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
+            }
+        },
+        "/playback/{id}": {
             "delete": {
                 "security": [
                     {
                         "ApiKeyCookieAuth": []
-                    },
-                    {
-                        "ApiKeyAuth": []
                     }
                 ],
-                "description": "Delete user",
+                "description": "Delete playback progress",
                 "consumes": [
                     "application/json"
                 ],
@@ -3288,13 +3322,13 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "user"
+                    "Playback"
                 ],
-                "summary": "Delete user",
+                "summary": "Delete progress",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "User ID",
+                        "description": "vod id",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -3302,7 +3336,10 @@ const docTemplate = `{
                 ],
                 "responses": {
                     "200": {
-                        "description": "OK"
+                        "description": "OK",
+                        "schema": {
+                            "type": "string"
+                        }
                     },
                     "400": {
                         "description": "Bad Request",
@@ -3319,9 +3356,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/vod": {
+        "/playlist": {
             "get": {
-                "description": "Get vods",
+                "description": "Get playlists",
                 "consumes": [
                     "application/json"
                 ],
@@ -3329,33 +3366,19 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "vods"
-                ],
-                "summary": "Get vods",
-                "parameters": [
-                    {
-                        "type": "string",
-                        "description": "Channel ID",
-                        "name": "channel_id",
-                        "in": "query"
-                    }
+                    "Playlist"
                 ],
+                "summary": "Get playlists",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
                             "type": "array",
                             "items": {
-                                "$ref": "#/definitions/ent.Vod"
+                                "$ref": "#/definitions/ent.Playlist"
                             }
                         }
                     },
-                    "400": {
-                        "description": "Bad Request",
-                        "schema": {
-                            "$ref": "#/definitions/utils.ErrorResponse"
-                        }
-                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -3373,7 +3396,7 @@ const docTemplate = `{
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "Create a vod",
+                "description": "Create playlist",
                 "consumes": [
                     "application/json"
                 ],
@@ -3381,25 +3404,25 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "vods"
+                    "Playlist"
                 ],
-                "summary": "Create a vod",
+                "summary": "Create playlist",
                 "parameters": [
                     {
-                        "description": "Create vod request",
-                        "name": "body",
+                        "description": "playlist",
+                        "name": "playlist",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/http.CreateVodRequest"
+                            "$ref": "#/definitions/http.CreatePlaylistRequest"
                         }
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "Created",
+                    "200": {
+                        "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/ent.Vod"
+                            "$ref": "#/definitions/ent.Playlist"
                         }
                     },
                     "400": {
@@ -3408,8 +3431,8 @@ const docTemplate = `{
                             "$ref": "#/definitions/utils.ErrorResponse"
                         }
                     },
-                    "409": {
-                        "description": "Conflict",
+                    "500": {
+                        "description": "Internal Server Error",
                         "schema": {
                             "$ref": "#/definitions/utils.ErrorResponse"
                         }
@@ -3417,9 +3440,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/vod/pagination": {
+        "/playlist/{id}": {
             "get": {
-                "description": "Get vods pagination",
+                "description": "Get playlist",
                 "consumes": [
                     "application/json"
                 ],
@@ -3427,46 +3450,21 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "vods"
+                    "Playlist"
                 ],
-                "summary": "Get vods pagination",
+                "summary": "Get playlist",
                 "parameters": [
-                    {
-                        "type": "integer",
-                        "default": 10,
-                        "description": "Limit",
-                        "name": "limit",
-                        "in": "query"
-                    },
-                    {
-                        "type": "integer",
-                        "default": 0,
-                        "description": "Offset",
-                        "name": "offset",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "Channel ID",
-                        "name": "channel_id",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "Types",
-                        "name": "types",
-                        "in": "query"
-                    },
                     {
                         "type": "string",
-                        "description": "Playlist ID",
-                        "name": "playlist_id",
-                        "in": "query"
+                        "description": "playlist id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
                     },
                     {
-                        "type": "string",
-                        "description": "Processing. Set to false to exclude videos that are still processing.",
-                        "name": "processing",
+                        "type": "boolean",
+                        "description": "include multistream info",
+                        "name": "with_multistream_info",
                         "in": "query"
                     }
                 ],
@@ -3474,7 +3472,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/vod.Pagination"
+                            "$ref": "#/definitions/ent.Playlist"
                         }
                     },
                     "400": {
@@ -3490,11 +3488,17 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/vod/search": {
-            "get": {
-                "description": "Search vods",
+            },
+            "put": {
+                "security": [
+                    {
+                        "ApiKeyCookieAuth": []
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Update playlist",
                 "consumes": [
                     "application/json"
                 ],
@@ -3502,40 +3506,32 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "vods"
+                    "Playlist"
                 ],
-                "summary": "Search vods",
+                "summary": "Update playlist",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Search query",
-                        "name": "q",
-                        "in": "query",
+                        "description": "playlist id",
+                        "name": "id",
+                        "in": "path",
                         "required": true
                     },
                     {
-                        "type": "integer",
-                        "default": 10,
-                        "description": "Limit",
-                        "name": "limit",
-                        "in": "query"
-                    },
-                    {
-                        "type": "integer",
-                        "default": 0,
-                        "description": "Offset",
-                        "name": "offset",
-                        "in": "query"
+                        "description": "playlist",
+                        "name": "playlist",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/http.CreatePlaylistRequest"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/ent.Vod"
-                            }
+                            "$ref": "#/definitions/ent.Playlist"
                         }
                     },
                     "400": {
@@ -3551,11 +3547,17 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/vod/{id}": {
-            "get": {
-                "description": "Get a vod",
+            },
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyCookieAuth": []
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Add vod to playlist",
                 "consumes": [
                     "application/json"
                 ],
@@ -3563,41 +3565,32 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "vods"
+                    "Playlist"
                 ],
-                "summary": "Get a vod",
+                "summary": "Add vod to playlist",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Vod ID",
+                        "description": "playlist id",
                         "name": "id",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "type": "string",
-                        "description": "With channel",
-                        "name": "with_channel",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "With chapters",
-                        "name": "with_chapters",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "With muted segments",
-                        "name": "with_muted_segments",
-                        "in": "query"
+                        "description": "vod",
+                        "name": "vod",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/http.AddVodToPlaylistRequest"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/ent.Vod"
+                            "type": "string"
                         }
                     },
                     "400": {
@@ -3606,12 +3599,6 @@ const docTemplate = `{
                             "$ref": "#/definitions/utils.ErrorResponse"
                         }
                     },
-                    "404": {
-                        "description": "Not Found",
-                        "schema": {
-                            "$ref": "#/definitions/utils.ErrorResponse"
-                        }
-                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -3620,7 +3607,7 @@ const docTemplate = `{
                     }
                 }
             },
-            "put": {
+            "delete": {
                 "security": [
                     {
                         "ApiKeyCookieAuth": []
@@ -3629,7 +3616,7 @@ const docTemplate = `{
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "Update a vod",
+                "description": "Delete playlist",
                 "consumes": [
                     "application/json"
                 ],
@@ -3637,32 +3624,23 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "vods"
+                    "Playlist"
                 ],
-                "summary": "Update a vod",
+                "summary": "Delete playlist",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Vod ID",
+                        "description": "playlist id",
                         "name": "id",
                         "in": "path",
                         "required": true
-                    },
-                    {
-                        "description": "Vod",
-                        "name": "body",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/http.CreateVodRequest"
-                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/ent.Vod"
+                            "type": "string"
                         }
                     },
                     "400": {
@@ -3671,12 +3649,6 @@ const docTemplate = `{
                             "$ref": "#/definitions/utils.ErrorResponse"
                         }
                     },
-                    "404": {
-                        "description": "Not Found",
-                        "schema": {
-                            "$ref": "#/definitions/utils.ErrorResponse"
-                        }
-                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -3684,8 +3656,10 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "delete": {
+            }
+        },
+        "/playlist/{id}/multistream/delay": {
+            "put": {
                 "security": [
                     {
                         "ApiKeyCookieAuth": []
@@ -3694,7 +3668,7 @@ const docTemplate = `{
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "Delete a vod",
+                "description": "Set delay of vod in playlist for multistream",
                 "consumes": [
                     "application/json"
                 ],
@@ -3702,36 +3676,36 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "vods"
+                    "Playlist"
                 ],
-                "summary": "Delete a vod",
+                "summary": "Set delay of vod in playlist for multistream",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Vod ID",
+                        "description": "playlist id",
                         "name": "id",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "type": "string",
-                        "description": "Delete files",
-                        "name": "delete_files",
-                        "in": "query"
+                        "description": "delay",
+                        "name": "delay",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/http.SetVodDelayPlaylistRequest"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "OK"
-                    },
-                    "400": {
-                        "description": "Bad Request",
+                        "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/utils.ErrorResponse"
+                            "type": "string"
                         }
                     },
-                    "404": {
-                        "description": "Not Found",
+                    "400": {
+                        "description": "Bad Request",
                         "schema": {
                             "$ref": "#/definitions/utils.ErrorResponse"
                         }
@@ -3745,9 +3719,17 @@ const docTemplate = `{
                 }
             }
         },
-        "/vod/{id}/chat": {
+        "/playlist/{id}/rules": {
             "get": {
-                "description": "Get vod chat comments",
+                "security": [
+                    {
+                        "ApiKeyCookieAuth": []
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Get rules for a playlist",
                 "consumes": [
                     "application/json"
                 ],
@@ -3755,28 +3737,16 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "vods"
+                    "Playlist"
                 ],
-                "summary": "Get vod chat comments",
+                "summary": "Get playlist rules",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Vod ID",
+                        "description": "playlist id",
                         "name": "id",
                         "in": "path",
                         "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "Start time",
-                        "name": "start",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "End time",
-                        "name": "end",
-                        "in": "query"
                     }
                 ],
                 "responses": {
@@ -3785,10 +3755,7 @@ const docTemplate = `{
                         "schema": {
                             "type": "array",
                             "items": {
-                                "type": "array",
-                                "items": {
-                                    "$ref": "#/definitions/chat.Comment"
-                                }
+                                "$ref": "#/definitions/ent.PlaylistRuleGroup"
                             }
                         }
                     },
@@ -3798,12 +3765,6 @@ const docTemplate = `{
                             "$ref": "#/definitions/utils.ErrorResponse"
                         }
                     },
-                    "404": {
-                        "description": "Not Found",
-                        "schema": {
-                            "$ref": "#/definitions/utils.ErrorResponse"
-                        }
-                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -3811,11 +3772,17 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/vod/{id}/chat/badges": {
-            "get": {
-                "description": "Get vod chat badges",
+            },
+            "put": {
+                "security": [
+                    {
+                        "ApiKeyCookieAuth": []
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Test rules for a playlist against a video id",
                 "consumes": [
                     "application/json"
                 ],
@@ -3823,26 +3790,30 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "vods"
+                    "Playlist"
                 ],
-                "summary": "Get vod chat badges",
+                "summary": "Test playlist rules",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Vod ID",
+                        "description": "playlist id",
                         "name": "id",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "video id",
+                        "name": "video_id",
+                        "in": "query",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/platform.Badge"
-                            }
+                            "type": "boolean"
                         }
                     },
                     "400": {
@@ -3851,12 +3822,6 @@ const docTemplate = `{
                             "$ref": "#/definitions/utils.ErrorResponse"
                         }
                     },
-                    "404": {
-                        "description": "Not Found",
-                        "schema": {
-                            "$ref": "#/definitions/utils.ErrorResponse"
-                        }
-                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -3864,11 +3829,14 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/vod/{id}/chat/chatter/{chatter_id}": {
-            "get": {
-                "description": "Get vod chat comments from a specific chatter",
+            },
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyCookieAuth": []
+                    }
+                ],
+                "description": "Set rules for a playlist this will delete all existing rules and set new ones",
                 "consumes": [
                     "application/json"
                 ],
@@ -3876,33 +3844,32 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "vods"
+                    "Playlist"
                 ],
-                "summary": "Get vod chat comments from a specific chatter",
+                "summary": "Set playlist rules",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Vod ID",
+                        "description": "playlist id",
                         "name": "id",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "type": "string",
-                        "description": "Chatter ID",
-                        "name": "chatter_id",
-                        "in": "path",
-                        "required": true
+                        "description": "rules",
+                        "name": "rules",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/http.SetPlaylistRulesRequest"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/chat.Comment"
-                            }
+                            "type": "string"
                         }
                     },
                     "400": {
@@ -3920,9 +3887,17 @@ const docTemplate = `{
                 }
             }
         },
-        "/vod/{id}/chat/emotes": {
-            "get": {
-                "description": "Get vod chat emotes",
+        "/playlist/{id}/vod": {
+            "delete": {
+                "security": [
+                    {
+                        "ApiKeyCookieAuth": []
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Delete vod from playlist",
                 "consumes": [
                     "application/json"
                 ],
@@ -3930,26 +3905,32 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "vods"
+                    "Playlist"
                 ],
-                "summary": "Get vod chat emotes",
+                "summary": "Delete vod from playlist",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Vod ID",
+                        "description": "playlist id",
                         "name": "id",
                         "in": "path",
                         "required": true
-                    }
-                ],
-                "responses": {
+                    },
+                    {
+                        "description": "vod",
+                        "name": "vod",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/http.AddVodToPlaylistRequest"
+                        }
+                    }
+                ],
+                "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/chat.GanymedeEmotes"
-                            }
+                            "type": "string"
                         }
                     },
                     "400": {
@@ -3958,12 +3939,6 @@ const docTemplate = `{
                             "$ref": "#/definitions/utils.ErrorResponse"
                         }
                     },
-                    "404": {
-                        "description": "Not Found",
-                        "schema": {
-                            "$ref": "#/definitions/utils.ErrorResponse"
-                        }
-                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -3973,9 +3948,17 @@ const docTemplate = `{
                 }
             }
         },
-        "/vod/{id}/chat/seek": {
+        "/queue": {
             "get": {
-                "description": "Get N number of vod chat comments before the start time (used for seeking)",
+                "security": [
+                    {
+                        "ApiKeyCookieAuth": []
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Get queue items",
                 "consumes": [
                     "application/json"
                 ],
@@ -3983,27 +3966,14 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "vods"
+                    "queue"
                 ],
-                "summary": "Get number of vod chat comments",
+                "summary": "Get queue items",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Vod ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "Start time",
-                        "name": "start",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "Count",
-                        "name": "count",
+                        "description": "Get processing queue items",
+                        "name": "processing",
                         "in": "query"
                     }
                 ],
@@ -4013,7 +3983,7 @@ const docTemplate = `{
                         "schema": {
                             "type": "array",
                             "items": {
-                                "$ref": "#/definitions/chat.Comment"
+                                "$ref": "#/definitions/ent.Queue"
                             }
                         }
                     },
@@ -4023,12 +3993,6 @@ const docTemplate = `{
                             "$ref": "#/definitions/utils.ErrorResponse"
                         }
                     },
-                    "404": {
-                        "description": "Not Found",
-                        "schema": {
-                            "$ref": "#/definitions/utils.ErrorResponse"
-                        }
-                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -4036,11 +4000,17 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/vod/{id}/chat/userid": {
-            "get": {
-                "description": "Get user id from chat json file",
+            },
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyCookieAuth": []
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Create a queue item",
                 "consumes": [
                     "application/json"
                 ],
@@ -4048,24 +4018,25 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "vods"
+                    "queue"
                 ],
-                "summary": "Get user id from chat",
+                "summary": "Create a queue item",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "Vod ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
+                        "description": "Create queue item",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/http.CreateQueueRequest"
+                        }
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "OK",
+                    "201": {
+                        "description": "Created",
                         "schema": {
-                            "type": "integer",
-                            "format": "int64"
+                            "$ref": "#/definitions/ent.Queue"
                         }
                     },
                     "400": {
@@ -4074,8 +4045,45 @@ const docTemplate = `{
                             "$ref": "#/definitions/utils.ErrorResponse"
                         }
                     },
-                    "404": {
-                        "description": "Not Found",
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/queue/task/start": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyCookieAuth": []
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Start a specific queue task",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "queue"
+                ],
+                "summary": "Start a queue task for a queue",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
                         "schema": {
                             "$ref": "#/definitions/utils.ErrorResponse"
                         }
@@ -4089,7 +4097,7 @@ const docTemplate = `{
                 }
             }
         },
-        "/vod/{id}/ffprobe": {
+        "/queue/{id}": {
             "get": {
                 "security": [
                     {
@@ -4099,7 +4107,7 @@ const docTemplate = `{
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "Get ffprobe data for video",
+                "description": "Get queue item",
                 "consumes": [
                     "application/json"
                 ],
@@ -4107,13 +4115,13 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "exec"
+                    "queue"
                 ],
-                "summary": "Get ffprobe data for video",
+                "summary": "Get queue item",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Vod ID",
+                        "description": "Queue item id",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -4123,7 +4131,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "type": "object"
+                            "$ref": "#/definitions/ent.Queue"
                         }
                     },
                     "400": {
@@ -4132,12 +4140,6 @@ const docTemplate = `{
                             "$ref": "#/definitions/utils.ErrorResponse"
                         }
                     },
-                    "404": {
-                        "description": "Not Found",
-                        "schema": {
-                            "$ref": "#/definitions/utils.ErrorResponse"
-                        }
-                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -4145,11 +4147,17 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/vod/{id}/playlist": {
-            "get": {
-                "description": "Get vod playlists",
+            },
+            "put": {
+                "security": [
+                    {
+                        "ApiKeyCookieAuth": []
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Update queue item",
                 "consumes": [
                     "application/json"
                 ],
@@ -4157,29 +4165,32 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "vods"
+                    "queue"
                 ],
-                "summary": "Get vod playlists",
+                "summary": "Update queue item",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Vod ID",
+                        "description": "Queue item id",
                         "name": "id",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "description": "Update queue item",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/http.UpdateQueueRequest"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "type": "array",
-                            "items": {
-                                "type": "array",
-                                "items": {
-                                    "$ref": "#/definitions/ent.Playlist"
-                                }
-                            }
+                            "$ref": "#/definitions/ent.Queue"
                         }
                     },
                     "400": {
@@ -4188,8 +4199,49 @@ const docTemplate = `{
                             "$ref": "#/definitions/utils.ErrorResponse"
                         }
                     },
-                    "404": {
-                        "description": "Not Found",
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "ApiKeyCookieAuth": []
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Delete queue item",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "queue"
+                ],
+                "summary": "Delete queue item",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Queue item id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
                         "schema": {
                             "$ref": "#/definitions/utils.ErrorResponse"
                         }
@@ -4202,16 +4254,2040 @@ const docTemplate = `{
                     }
                 }
             }
-        }
-    },
-    "definitions": {
-        "admin.GetStorageDistributionResponse": {
-            "type": "object",
-            "properties": {
-                "storage_distribution": {
-                    "description": "Map of channel names to total storage used",
-                    "type": "object",
-                    "additionalProperties": {
+        },
+        "/queue/{id}/stop": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyCookieAuth": []
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Stop processing the video and chat downloads of an active queue item",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "queue"
+                ],
+                "summary": "Stop a queue item",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Queue item id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/queue/{id}/tail": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyCookieAuth": []
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Read queue log file",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "queue"
+                ],
+                "summary": "Read queue log file",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Queue item id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Log type: video, video-convert, chat, chat-render, or chat-convert",
+                        "name": "type",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/shared-lists/{token}": {
+            "get": {
+                "description": "Get a personal video list shared by link",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Me"
+                ],
+                "summary": "Get shared list",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "share token",
+                        "name": "token",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/userlist.SharedList"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/task/start": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyCookieAuth": []
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Start a task",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "task"
+                ],
+                "summary": "Start a task",
+                "parameters": [
+                    {
+                        "description": "StartTaskRequest",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/http.StartTaskRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/twitch/channel": {
+            "get": {
+                "description": "Get a twitch user/channel by name (uses twitch api)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "twitch"
+                ],
+                "summary": "Get a twitch channel",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Twitch user login name",
+                        "name": "name",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/platform.ChannelInfo"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/twitch/video": {
+            "get": {
+                "description": "Get a twitch vod by id (uses twitch api)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "twitch"
+                ],
+                "summary": "Get a twitch vod",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Twitch vod id",
+                        "name": "id",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/platform.VideoInfo"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/user": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyCookieAuth": []
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Get all users",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "user"
+                ],
+                "summary": "Get all users",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/ent.User"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/user/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyCookieAuth": []
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Get user by id",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "user"
+                ],
+                "summary": "Get user by id",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "User ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/ent.User"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "ApiKeyCookieAuth": []
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Update user",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "user"
+                ],
+                "summary": "Update user",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "User ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "User data",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/http.UpdateChannelRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/ent.User"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "ApiKeyCookieAuth": []
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Delete user",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "user"
+                ],
+                "summary": "Delete user",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "User ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/vod": {
+            "get": {
+                "description": "Get vods",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "vods"
+                ],
+                "summary": "Get vods",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Channel ID",
+                        "name": "channel_id",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/ent.Vod"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyCookieAuth": []
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Create a vod",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "vods"
+                ],
+                "summary": "Create a vod",
+                "parameters": [
+                    {
+                        "description": "Create vod request",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/http.CreateVodRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/ent.Vod"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/vod/chat/search": {
+            "get": {
+                "description": "Search the chat of all archived videos. At least one of q, chatter or emote is required. Hits include the video ID and content offset of the message.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "vods"
+                ],
+                "summary": "Search chat",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Full-text query over the message text",
+                        "name": "q",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Chatter login or display name",
+                        "name": "chatter",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Emote name",
+                        "name": "emote",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Channel ID",
+                        "name": "channel_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Video ID",
+                        "name": "video_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Messages sent at or after this time (RFC3339)",
+                        "name": "from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Messages sent at or before this time (RFC3339)",
+                        "name": "to",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 20,
+                        "description": "Limit",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 0,
+                        "description": "Offset",
+                        "name": "offset",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/vod.ChatSearchPagination"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/vod/pagination": {
+            "get": {
+                "description": "Get vods pagination",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "vods"
+                ],
+                "summary": "Get vods pagination",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "default": 10,
+                        "description": "Limit",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 0,
+                        "description": "Offset",
+                        "name": "offset",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Channel ID",
+                        "name": "channel_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Types",
+                        "name": "types",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Playlist ID",
+                        "name": "playlist_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Processing. Set to false to exclude videos that are still processing.",
+                        "name": "processing",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/vod.Pagination"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/vod/search": {
+            "get": {
+                "description": "Full-text search over the title, category, channel name and chapter titles of videos. Results are ranked by relevance and include facet counts per channel, type and year.\nThe id, ext_id, channel_id and channel_ext_id fields look up videos by ID instead of using full-text search.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "vods"
+                ],
+                "summary": "Search vods",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Search query",
+                        "name": "q",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 10,
+                        "description": "Limit",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 0,
+                        "description": "Offset",
+                        "name": "offset",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Comma separated fields to look up the query in (id, ext_id, channel_id, channel_ext_id). title, chapter and channel_name use full-text search.",
+                        "name": "fields",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Comma separated video types",
+                        "name": "types",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Comma separated channel IDs",
+                        "name": "channel_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Category or chapter title",
+                        "name": "category",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Streamed at or after this date (YYYY-MM-DD or RFC3339)",
+                        "name": "from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Streamed at or before this date (YYYY-MM-DD or RFC3339)",
+                        "name": "to",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Minimum duration in seconds",
+                        "name": "min_duration",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Maximum duration in seconds",
+                        "name": "max_duration",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Comma separated resolutions",
+                        "name": "resolution",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Sort by (relevance, date, views, local_views, created). Defaults to relevance when a query is provided, otherwise date.",
+                        "name": "sort_by",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "default": "desc",
+                        "description": "Order (asc, desc)",
+                        "name": "order",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/vod.SearchPagination"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/vod/{id}": {
+            "get": {
+                "description": "Get a vod",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "vods"
+                ],
+                "summary": "Get a vod",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Vod ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "With channel",
+                        "name": "with_channel",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "With chapters",
+                        "name": "with_chapters",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "With muted segments",
+                        "name": "with_muted_segments",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/ent.Vod"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "ApiKeyCookieAuth": []
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Update a vod",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "vods"
+                ],
+                "summary": "Update a vod",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Vod ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Vod",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/http.CreateVodRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/ent.Vod"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "ApiKeyCookieAuth": []
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Delete a vod",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "vods"
+                ],
+                "summary": "Delete a vod",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Vod ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Delete files",
+                        "name": "delete_files",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/vod/{id}/chat": {
+            "get": {
+                "description": "Get vod chat comments",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "vods"
+                ],
+                "summary": "Get vod chat comments",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Vod ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Start time",
+                        "name": "start",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "End time",
+                        "name": "end",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "type": "array",
+                                "items": {
+                                    "$ref": "#/definitions/chat.Comment"
+                                }
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/vod/{id}/chat/badges": {
+            "get": {
+                "description": "Get vod chat badges",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "vods"
+                ],
+                "summary": "Get vod chat badges",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Vod ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/platform.Badge"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/vod/{id}/chat/chatter/{chatter_id}": {
+            "get": {
+                "description": "Get vod chat comments from a specific chatter",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "vods"
+                ],
+                "summary": "Get vod chat comments from a specific chatter",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Vod ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Chatter ID",
+                        "name": "chatter_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/chat.Comment"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/vod/{id}/chat/emotes": {
+            "get": {
+                "description": "Get vod chat emotes",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "vods"
+                ],
+                "summary": "Get vod chat emotes",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Vod ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/chat.GanymedeEmotes"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/vod/{id}/chat/seek": {
+            "get": {
+                "description": "Get N number of vod chat comments before the start time (used for seeking)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "vods"
+                ],
+                "summary": "Get number of vod chat comments",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Vod ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Start time",
+                        "name": "start",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Count",
+                        "name": "count",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/chat.Comment"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/vod/{id}/chat/userid": {
+            "get": {
+                "description": "Get user id from chat json file",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "vods"
+                ],
+                "summary": "Get user id from chat",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Vod ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "integer",
+                            "format": "int64"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/vod/{id}/ffprobe": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyCookieAuth": []
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Get ffprobe data for video",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "exec"
+                ],
+                "summary": "Get ffprobe data for video",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Vod ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "object"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/vod/{id}/gaps": {
+            "get": {
+                "description": "Returns the parts of the stream missed while recording the live archive of a video, such as while the recording reconnected after the stream went offline. The offset of a gap is its position in the archive in seconds. The archive and its chat don't include the gaps.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "vods"
+                ],
+                "summary": "Get the live gaps of a video",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Video ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/ent.LiveGap"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/vod/{id}/playlist": {
+            "get": {
+                "description": "Get vod playlists",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "vods"
+                ],
+                "summary": "Get vod playlists",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Vod ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "type": "array",
+                                "items": {
+                                    "$ref": "#/definitions/ent.Playlist"
+                                }
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/vod/{id}/recover-muted-audio": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyCookieAuth": []
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Queues the replacement of the audio of the muted segments of a VOD with the audio of the live archive of the same stream. HLS videos are not changed, their muted segments are marked in the player instead.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "vods"
+                ],
+                "summary": "Recover the muted audio of a video",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Video ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Recover muted audio request",
+                        "name": "body",
+                        "in": "body",
+                        "schema": {
+                            "$ref": "#/definitions/http.RecoverMutedAudioRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/vod/{id}/renditions": {
+            "get": {
+                "description": "Returns the adaptive bitrate renditions of an HLS video, such as source, 720p, 480p and audio-only, with their bandwidth, resolution and codecs. Videos with a single rendition return an empty list.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "vods"
+                ],
+                "summary": "Get the renditions of a video",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Video ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/hls.Variant"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/vod/{id}/repair": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyCookieAuth": []
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Queues the tasks that regenerate the thumbnails, sprite thumbnails and chat of a video that the last library audit found missing. The video is audited again an hour later.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "vods"
+                ],
+                "summary": "Repair a video",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Video ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/vod.RepairResult"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/vod/{id}/versions": {
+            "get": {
+                "description": "Returns the versions of the stream of a video, such as its live archive and its VOD, including the video. Each version has how many seconds of the stream it is missing and how many seconds are muted. The most complete version is marked as best.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "vods"
+                ],
+                "summary": "Get the versions of a video",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Video ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/streamversions.Version"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/vod/{id}/versions/reconcile": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyCookieAuth": []
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Queues the reconciliation of the versions of the stream of a video. Both versions are kept, only the most complete version is kept, or the live archive is kept with the chat of the VOD. Locked versions are never deleted.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "vods"
+                ],
+                "summary": "Reconcile the versions of a video",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Video ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Reconcile versions request",
+                        "name": "body",
+                        "in": "body",
+                        "schema": {
+                            "$ref": "#/definitions/http.ReconcileVersionsRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/watch-party": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyCookieAuth": []
+                    }
+                ],
+                "description": "Create a watch party room for a video hosted by the user. Members join the room over a WebSocket.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Watch Party"
+                ],
+                "summary": "Create watch party",
+                "parameters": [
+                    {
+                        "description": "watch party",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/http.CreateWatchPartyRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/watchparty.State"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/watch-party/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyCookieAuth": []
+                    }
+                ],
+                "description": "Get the playback state and members of a watch party room",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Watch Party"
+                ],
+                "summary": "Get watch party",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "watch party id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/watchparty.State"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/watch-party/{id}/ws": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyCookieAuth": []
+                    }
+                ],
+                "description": "Join a watch party room over a WebSocket. The server sends a \"state\" message with the playback state when joining and whenever it changes. The host sends \"play\", \"pause\" and \"seek\" messages with the position in seconds; any member can send \"sync\" to get the current state.",
+                "tags": [
+                    "Watch Party"
+                ],
+                "summary": "Join watch party",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "watch party id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "101": {
+                        "description": "Switching Protocols"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        }
+    },
+    "definitions": {
+        "admin.ChannelViewingStatistics": {
+            "type": "object",
+            "properties": {
+                "channel_id": {
+                    "type": "string"
+                },
+                "channel_name": {
+                    "type": "string"
+                },
+                "session_count": {
+                    "type": "integer"
+                },
+                "viewer_count": {
+                    "description": "Number of users that watched a video of the channel",
+                    "type": "integer"
+                },
+                "watched_seconds": {
+                    "type": "number"
+                }
+            }
+        },
+        "admin.GetStorageDistributionResponse": {
+            "type": "object",
+            "properties": {
+                "storage_distribution": {
+                    "description": "Map of channel names to total storage used",
+                    "type": "object",
+                    "additionalProperties": {
                         "type": "integer",
                         "format": "int64"
                     }
@@ -4225,6 +6301,14 @@ const docTemplate = `{
                     "description": "Number of CPU cores",
                     "type": "integer"
                 },
+                "disk_space": {
+                    "description": "Free space of the videos and temp directories, video downloads are paused while low",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/diskspace.Status"
+                        }
+                    ]
+                },
                 "memory_total": {
                     "description": "Total memory in bytes",
                     "type": "integer"
@@ -4262,6 +6346,42 @@ const docTemplate = `{
                 }
             }
         },
+        "admin.GetViewingStatisticsResponse": {
+            "type": "object",
+            "properties": {
+                "channels": {
+                    "description": "Sorted by watched seconds",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/admin.ChannelViewingStatistics"
+                    }
+                },
+                "completion_rate": {
+                    "description": "Share of the videos watched by a user that were completed by the user",
+                    "type": "number"
+                },
+                "session_count": {
+                    "type": "integer"
+                },
+                "users": {
+                    "description": "Sorted by watched seconds",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/admin.UserViewingStatistics"
+                    }
+                },
+                "videos": {
+                    "description": "Most watched videos, sorted by watched seconds",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/admin.VideoViewingStatistics"
+                    }
+                },
+                "watched_seconds": {
+                    "type": "number"
+                }
+            }
+        },
         "admin.InfoResp": {
             "type": "object",
             "properties": {
@@ -4296,6 +6416,70 @@ const docTemplate = `{
                 }
             }
         },
+        "admin.UserViewingStatistics": {
+            "type": "object",
+            "properties": {
+                "completed_count": {
+                    "description": "Number of videos the user completed",
+                    "type": "integer"
+                },
+                "completion_rate": {
+                    "description": "Share of the videos the user watched that were completed",
+                    "type": "number"
+                },
+                "session_count": {
+                    "type": "integer"
+                },
+                "user_id": {
+                    "type": "string"
+                },
+                "username": {
+                    "type": "string"
+                },
+                "video_count": {
+                    "description": "Number of videos the user watched",
+                    "type": "integer"
+                },
+                "watched_seconds": {
+                    "type": "number"
+                }
+            }
+        },
+        "admin.VideoViewingStatistics": {
+            "type": "object",
+            "properties": {
+                "channel_name": {
+                    "type": "string"
+                },
+                "completed_count": {
+                    "description": "Number of users that completed the video",
+                    "type": "integer"
+                },
+                "completion_rate": {
+                    "description": "Share of the users that watched the video that completed it",
+                    "type": "number"
+                },
+                "duration": {
+                    "type": "integer"
+                },
+                "session_count": {
+                    "type": "integer"
+                },
+                "title": {
+                    "type": "string"
+                },
+                "video_id": {
+                    "type": "string"
+                },
+                "viewer_count": {
+                    "description": "Number of users that watched the video",
+                    "type": "integer"
+                },
+                "watched_seconds": {
+                    "type": "number"
+                }
+            }
+        },
         "archive.TwitchVodResponse": {
             "type": "object",
             "properties": {
@@ -4576,9 +6760,68 @@ const docTemplate = `{
                             "description": "Generate sprite thumbnails for scrubbing.",
                             "type": "boolean"
                         },
+                        "hls_renditions": {
+                            "description": "Add 720p, 480p and audio-only renditions to HLS videos for adaptive bitrate playback.",
+                            "type": "boolean"
+                        },
+                        "min_free_space_temp_gb": {
+                            "description": "Pause new video downloads while the temp directory has less free space in GB, 0 disables.",
+                            "type": "integer"
+                        },
+                        "min_free_space_videos_gb": {
+                            "description": "Pause new video downloads while the videos directory has less free space in GB, 0 disables.",
+                            "type": "integer"
+                        },
+                        "recover_muted_audio": {
+                            "description": "Replace the audio of muted segments of VODs with the audio of the live archive of the same stream.",
+                            "type": "boolean"
+                        },
                         "save_as_hls": {
                             "description": "Save as HLS rather than MP4.",
                             "type": "boolean"
+                        },
+                        "stream_version_policy": {
+                            "description": "What is kept when a stream was archived both live and as a VOD.",
+                            "enum": [
+                                "keep_both",
+                                "keep_best",
+                                "keep_live_with_vod_chat"
+                            ],
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/utils.StreamVersionPolicy"
+                                }
+                            ]
+                        }
+                    }
+                },
+                "checksums": {
+                    "type": "object",
+                    "properties": {
+                        "enabled": {
+                            "description": "Record SHA-256 checksums of archived files and verify them nightly.",
+                            "type": "boolean"
+                        },
+                        "verify_days": {
+                            "description": "Verify every checksum once in this many days, a slice of the library is verified each night.",
+                            "type": "integer"
+                        }
+                    }
+                },
+                "cold_storage": {
+                    "type": "object",
+                    "properties": {
+                        "enabled": {
+                            "description": "Move videos to the COLD_VIDEOS_DIR directory.",
+                            "type": "boolean"
+                        },
+                        "min_age_days": {
+                            "description": "Only move videos archived at least this many days ago, 0 disables the rule.",
+                            "type": "integer"
+                        },
+                        "unwatched_days": {
+                            "description": "Only move videos nobody watched in this many days, 0 disables the rule.",
+                            "type": "integer"
                         }
                     }
                 },
@@ -4620,6 +6863,11 @@ const docTemplate = `{
                                 "type": "string"
                             }
                         },
+                        "reconnect_window_seconds": {
+                            "description": "Keep reconnecting to a live stream for this many seconds after the recording drops, recording the\nmissing part as a gap. Set to 0 to finish the archive when the recording drops.",
+                            "type": "integer",
+                            "minimum": 0
+                        },
                         "watch_while_archiving": {
                             "description": "Allow watching live streams while archiving them by downloading a temporary HLS stream.",
                             "type": "boolean"
@@ -4645,6 +6893,10 @@ const docTemplate = `{
                             "description": "TwitchDownloaderCLI arguments for chat rendering.",
                             "type": "string"
                         },
+                        "speech_to_text": {
+                            "description": "Speech-to-text command used to generate captions. Supports {{input}}, {{output}} and {{output_prefix}}.",
+                            "type": "string"
+                        },
                         "twitch_token": {
                             "description": "Twitch token for ad-free live streams or subscriber-only videos.",
                             "type": "string"
@@ -4755,8 +7007,45 @@ const docTemplate = `{
                 "file_template": {
                     "type": "string"
                 },
-                "folder_template": {
-                    "type": "string"
+                "folder_template": {
+                    "type": "string"
+                }
+            }
+        },
+        "diskspace.Directory": {
+            "type": "object",
+            "properties": {
+                "free_bytes": {
+                    "type": "integer"
+                },
+                "low": {
+                    "description": "free space is below the minimum",
+                    "type": "boolean"
+                },
+                "min_free_bytes": {
+                    "description": "0 if no minimum is configured",
+                    "type": "integer"
+                },
+                "name": {
+                    "description": "videos or temp",
+                    "type": "string"
+                },
+                "path": {
+                    "type": "string"
+                }
+            }
+        },
+        "diskspace.Status": {
+            "type": "object",
+            "properties": {
+                "directories": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/diskspace.Directory"
+                    }
+                },
+                "low": {
+                    "type": "boolean"
                 }
             }
         },
@@ -4795,6 +7084,14 @@ const docTemplate = `{
                     "description": "Name holds the value of the \"name\" field.",
                     "type": "string"
                 },
+                "platform": {
+                    "description": "The platform the channel is from, takes an enum.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/utils.VideoPlatform"
+                        }
+                    ]
+                },
                 "retention": {
                     "description": "Retention holds the value of the \"retention\" field.",
                     "type": "boolean"
@@ -4803,6 +7100,29 @@ const docTemplate = `{
                     "description": "RetentionDays holds the value of the \"retention_days\" field.",
                     "type": "integer"
                 },
+                "retention_exempt_min_views": {
+                    "description": "Never delete videos with at least this many local views, 0 disables the exemption.",
+                    "type": "integer"
+                },
+                "retention_exempt_playlists": {
+                    "description": "Never delete videos that are in a playlist.",
+                    "type": "boolean"
+                },
+                "retention_keep_newest": {
+                    "description": "Keep only the newest N videos, 0 disables the rule.",
+                    "type": "integer"
+                },
+                "retention_max_size_bytes": {
+                    "description": "Delete the oldest videos once the channel exceeds this size in bytes, 0 disables the rule.",
+                    "type": "integer"
+                },
+                "retention_video_types": {
+                    "description": "Video types the retention policy applies to, all types if empty.",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/utils.VodType"
+                    }
+                },
                 "storage_size_bytes": {
                     "description": "Total storage size in bytes for the channel's videos.",
                     "type": "integer"
@@ -4878,6 +7198,149 @@ const docTemplate = `{
                 }
             }
         },
+        "ent.ChatMessage": {
+            "type": "object",
+            "properties": {
+                "body": {
+                    "description": "The text of the message. Emotes are included by name.",
+                    "type": "string"
+                },
+                "commenter_display_name": {
+                    "description": "The display name of the chatter.",
+                    "type": "string"
+                },
+                "commenter_id": {
+                    "description": "The ID of the chatter on the external platform.",
+                    "type": "string"
+                },
+                "commenter_name": {
+                    "description": "The login name of the chatter.",
+                    "type": "string"
+                },
+                "content_offset_seconds": {
+                    "description": "The offset of the message from the start of the video in seconds.",
+                    "type": "number"
+                },
+                "created_at": {
+                    "description": "Time the message was sent.",
+                    "type": "string"
+                },
+                "edges": {
+                    "description": "Edges holds the relations/edges for other nodes in the graph.\nThe values are being populated by the ChatMessageQuery when eager-loading is set.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/ent.ChatMessageEdges"
+                        }
+                    ]
+                },
+                "emotes": {
+                    "description": "The names of the emotes used in the message.",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "ext_id": {
+                    "description": "The ID of the message on the external platform.",
+                    "type": "string"
+                },
+                "id": {
+                    "description": "ID of the ent.",
+                    "type": "string"
+                },
+                "vod_id": {
+                    "description": "The ID of the video the message belongs to.",
+                    "type": "string"
+                }
+            }
+        },
+        "ent.ChatMessageEdges": {
+            "type": "object",
+            "properties": {
+                "vod": {
+                    "description": "Vod holds the value of the vod edge.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/ent.Vod"
+                        }
+                    ]
+                }
+            }
+        },
+        "ent.Checksum": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "description": "CreatedAt holds the value of the \"created_at\" field.",
+                    "type": "string"
+                },
+                "edges": {
+                    "description": "Edges holds the relations/edges for other nodes in the graph.\nThe values are being populated by the ChecksumQuery when eager-loading is set.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/ent.ChecksumEdges"
+                        }
+                    ]
+                },
+                "file": {
+                    "description": "The kind of file.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/utils.ChecksumFile"
+                        }
+                    ]
+                },
+                "id": {
+                    "description": "ID of the ent.",
+                    "type": "string"
+                },
+                "name": {
+                    "description": "The path of the file in the HLS directory for HLS video files, empty for other files as their path is saved on the video.",
+                    "type": "string"
+                },
+                "sha256": {
+                    "description": "The hex encoded SHA-256 checksum of the file.",
+                    "type": "string"
+                },
+                "size_bytes": {
+                    "description": "The size of the file in bytes.",
+                    "type": "integer"
+                },
+                "status": {
+                    "description": "The result of the last verification.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/utils.ChecksumStatus"
+                        }
+                    ]
+                },
+                "updated_at": {
+                    "description": "UpdatedAt holds the value of the \"updated_at\" field.",
+                    "type": "string"
+                },
+                "verified_at": {
+                    "description": "The time the file was last hashed.",
+                    "type": "string"
+                },
+                "vod_id": {
+                    "description": "The ID of the video the file belongs to.",
+                    "type": "string"
+                }
+            }
+        },
+        "ent.ChecksumEdges": {
+            "type": "object",
+            "properties": {
+                "vod": {
+                    "description": "Vod holds the value of the vod edge.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/ent.Vod"
+                        }
+                    ]
+                }
+            }
+        },
         "ent.Live": {
             "type": "object",
             "properties": {
@@ -4937,6 +7400,10 @@ const docTemplate = `{
                         }
                     ]
                 },
+                "generate_captions": {
+                    "description": "Whether captions should be generated with speech-to-text after archiving.",
+                    "type": "boolean"
+                },
                 "id": {
                     "description": "ID of the ent.",
                     "type": "string"
@@ -4949,6 +7416,17 @@ const docTemplate = `{
                     "description": "The time the channel last went live.",
                     "type": "string"
                 },
+                "quality_fallbacks": {
+                    "description": "Qualities tried in order when archiving the live stream at the resolution keeps failing, e.g. 720p60 and best.",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "record_audio_fallback": {
+                    "description": "Whether the audio of live streams is recorded alongside the video as a fallback.",
+                    "type": "boolean"
+                },
                 "render_chat": {
                     "description": "Whether the chat should be rendered.",
                     "type": "boolean"
@@ -4957,10 +7435,22 @@ const docTemplate = `{
                     "description": "Live stream archive quality.",
                     "type": "string"
                 },
+                "split_hours": {
+                    "description": "Split live stream archives into parts every X hours. Set to 0 to disable.",
+                    "type": "integer"
+                },
+                "split_on_category_change": {
+                    "description": "Whether live stream archives are split into parts when the category changes.",
+                    "type": "boolean"
+                },
                 "strict_categories_live": {
                     "description": "Stop live stream archive if category changes to one not selected.",
                     "type": "boolean"
                 },
+                "transcoding_profile_id": {
+                    "description": "The transcoding profile used to post-process archives of the channel. The global video convert arguments are used if empty.",
+                    "type": "string"
+                },
                 "update_metadata_minutes": {
                     "description": "Queue metadata update X minutes after the stream is live. Set to 0 to disable.",
                     "type": "integer"
@@ -5039,16 +7529,151 @@ const docTemplate = `{
                     "description": "Channel holds the value of the channel edge.",
                     "allOf": [
                         {
-                            "$ref": "#/definitions/ent.Channel"
+                            "$ref": "#/definitions/ent.Channel"
+                        }
+                    ]
+                },
+                "schedule": {
+                    "description": "Schedule holds the value of the schedule edge.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/ent.LiveSchedule"
+                        }
+                    ]
+                },
+                "title_regex": {
+                    "description": "TitleRegex holds the value of the title_regex edge.",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/ent.LiveTitleRegex"
+                    }
+                },
+                "transcoding_profile": {
+                    "description": "TranscodingProfile holds the value of the transcoding_profile edge.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/ent.TranscodingProfile"
+                        }
+                    ]
+                }
+            }
+        },
+        "ent.LiveGap": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "description": "CreatedAt holds the value of the \"created_at\" field.",
+                    "type": "string"
+                },
+                "edges": {
+                    "description": "Edges holds the relations/edges for other nodes in the graph.\nThe values are being populated by the LiveGapQuery when eager-loading is set.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/ent.LiveGapEdges"
+                        }
+                    ]
+                },
+                "ended_at": {
+                    "description": "The wall-clock time the recording resumed.",
+                    "type": "string"
+                },
+                "id": {
+                    "description": "ID of the ent.",
+                    "type": "string"
+                },
+                "offset": {
+                    "description": "The position of the gap in the archive in seconds. The archive doesn't include the gap.",
+                    "type": "number"
+                },
+                "reason": {
+                    "description": "Why the recording dropped.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/utils.LiveGapReason"
+                        }
+                    ]
+                },
+                "started_at": {
+                    "description": "The wall-clock time the recording dropped.",
+                    "type": "string"
+                },
+                "vod_id": {
+                    "description": "The ID of the live stream archive.",
+                    "type": "string"
+                }
+            }
+        },
+        "ent.LiveGapEdges": {
+            "type": "object",
+            "properties": {
+                "vod": {
+                    "description": "Vod holds the value of the vod edge.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/ent.Vod"
+                        }
+                    ]
+                }
+            }
+        },
+        "ent.LiveSchedule": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "description": "CreatedAt holds the value of the \"created_at\" field.",
+                    "type": "string"
+                },
+                "edges": {
+                    "description": "Edges holds the relations/edges for other nodes in the graph.\nThe values are being populated by the LiveScheduleQuery when eager-loading is set.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/ent.LiveScheduleEdges"
+                        }
+                    ]
+                },
+                "first_stream_of_day": {
+                    "description": "Only archive the first live stream of a day.",
+                    "type": "boolean"
+                },
+                "id": {
+                    "description": "ID of the ent.",
+                    "type": "string"
+                },
+                "live_id": {
+                    "description": "The ID of the watched channel.",
+                    "type": "string"
+                },
+                "max_stream_hours": {
+                    "description": "Stop archiving a live stream after this many hours. Set to 0 to disable.",
+                    "type": "integer"
+                },
+                "timezone": {
+                    "description": "The IANA time zone the windows and days are in, e.g. Europe/Berlin.",
+                    "type": "string"
+                },
+                "updated_at": {
+                    "description": "UpdatedAt holds the value of the \"updated_at\" field.",
+                    "type": "string"
+                },
+                "windows": {
+                    "description": "Live streams are only archived inside these windows. Live streams are archived at any time if empty.",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/utils.LiveScheduleWindow"
+                    }
+                }
+            }
+        },
+        "ent.LiveScheduleEdges": {
+            "type": "object",
+            "properties": {
+                "live": {
+                    "description": "Live holds the value of the live edge.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/ent.Live"
                         }
                     ]
-                },
-                "title_regex": {
-                    "description": "TitleRegex holds the value of the title_regex edge.",
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/ent.LiveTitleRegex"
-                    }
                 }
             }
         },
@@ -5155,6 +7780,10 @@ const docTemplate = `{
                     "description": "ID of the ent.",
                     "type": "string"
                 },
+                "recovered": {
+                    "description": "Whether the audio of the muted segment was replaced with the audio of the live archive",
+                    "type": "boolean"
+                },
                 "start": {
                     "description": "The start time of the muted segment",
                     "type": "integer"
@@ -5230,6 +7859,10 @@ const docTemplate = `{
                         }
                     ]
                 },
+                "ext_stream_id": {
+                    "description": "The stream whose parts are grouped in the playlist, if it was created by splitting a live stream archive.",
+                    "type": "string"
+                },
                 "id": {
                     "description": "ID of the ent.",
                     "type": "string"
@@ -5543,6 +8176,35 @@ const docTemplate = `{
                 }
             }
         },
+        "ent.TranscodingProfile": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "description": "CreatedAt holds the value of the \"created_at\" field.",
+                    "type": "string"
+                },
+                "description": {
+                    "description": "What the profile is used for.",
+                    "type": "string"
+                },
+                "ffmpeg_args": {
+                    "description": "FFmpeg output arguments used when post-processing the video.",
+                    "type": "string"
+                },
+                "id": {
+                    "description": "ID of the ent.",
+                    "type": "string"
+                },
+                "name": {
+                    "description": "User-given name for this transcoding profile.",
+                    "type": "string"
+                },
+                "updated_at": {
+                    "description": "UpdatedAt holds the value of the \"updated_at\" field.",
+                    "type": "string"
+                }
+            }
+        },
         "ent.User": {
             "type": "object",
             "properties": {
@@ -5550,6 +8212,14 @@ const docTemplate = `{
                     "description": "CreatedAt holds the value of the \"created_at\" field.",
                     "type": "string"
                 },
+                "edges": {
+                    "description": "Edges holds the relations/edges for other nodes in the graph.\nThe values are being populated by the UserQuery when eager-loading is set.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/ent.UserEdges"
+                        }
+                    ]
+                },
                 "id": {
                     "description": "ID of the ent.",
                     "type": "string"
@@ -5584,13 +8254,248 @@ const docTemplate = `{
                 }
             }
         },
+        "ent.UserEdges": {
+            "type": "object",
+            "properties": {
+                "lists": {
+                    "description": "Lists holds the value of the lists edge.",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/ent.UserList"
+                    }
+                },
+                "viewing_sessions": {
+                    "description": "ViewingSessions holds the value of the viewing_sessions edge.",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/ent.ViewingSession"
+                    }
+                }
+            }
+        },
+        "ent.UserList": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "description": "CreatedAt holds the value of the \"created_at\" field.",
+                    "type": "string"
+                },
+                "edges": {
+                    "description": "Edges holds the relations/edges for other nodes in the graph.\nThe values are being populated by the UserListQuery when eager-loading is set.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/ent.UserListEdges"
+                        }
+                    ]
+                },
+                "id": {
+                    "description": "ID of the ent.",
+                    "type": "string"
+                },
+                "name": {
+                    "description": "Name holds the value of the \"name\" field.",
+                    "type": "string"
+                },
+                "share_token": {
+                    "description": "The token of the read-only link of the list, not shared if nil.",
+                    "type": "string"
+                },
+                "type": {
+                    "description": "Every user has one watch later and one favorites list, other lists are custom.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/utils.UserListType"
+                        }
+                    ]
+                },
+                "updated_at": {
+                    "description": "UpdatedAt holds the value of the \"updated_at\" field.",
+                    "type": "string"
+                },
+                "user_id": {
+                    "description": "The ID of the user the list belongs to.",
+                    "type": "string"
+                }
+            }
+        },
+        "ent.UserListEdges": {
+            "type": "object",
+            "properties": {
+                "items": {
+                    "description": "Items holds the value of the items edge.",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/ent.UserListItem"
+                    }
+                },
+                "user": {
+                    "description": "User holds the value of the user edge.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/ent.User"
+                        }
+                    ]
+                }
+            }
+        },
+        "ent.UserListItem": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "description": "CreatedAt holds the value of the \"created_at\" field.",
+                    "type": "string"
+                },
+                "edges": {
+                    "description": "Edges holds the relations/edges for other nodes in the graph.\nThe values are being populated by the UserListItemQuery when eager-loading is set.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/ent.UserListItemEdges"
+                        }
+                    ]
+                },
+                "id": {
+                    "description": "ID of the ent.",
+                    "type": "string"
+                },
+                "list_id": {
+                    "description": "The ID of the list.",
+                    "type": "string"
+                },
+                "position": {
+                    "description": "The position of the video in the list, items are sorted ascending.",
+                    "type": "integer"
+                },
+                "vod_id": {
+                    "description": "The ID of the video.",
+                    "type": "string"
+                }
+            }
+        },
+        "ent.UserListItemEdges": {
+            "type": "object",
+            "properties": {
+                "list": {
+                    "description": "List holds the value of the list edge.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/ent.UserList"
+                        }
+                    ]
+                },
+                "vod": {
+                    "description": "Vod holds the value of the vod edge.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/ent.Vod"
+                        }
+                    ]
+                }
+            }
+        },
+        "ent.ViewingSession": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "description": "CreatedAt holds the value of the \"created_at\" field.",
+                    "type": "string"
+                },
+                "device": {
+                    "description": "The device the video was watched on, e.g. the user agent of the browser.",
+                    "type": "string"
+                },
+                "edges": {
+                    "description": "Edges holds the relations/edges for other nodes in the graph.\nThe values are being populated by the ViewingSessionQuery when eager-loading is set.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/ent.ViewingSessionEdges"
+                        }
+                    ]
+                },
+                "ended_at": {
+                    "description": "The time the last range was played.",
+                    "type": "string"
+                },
+                "id": {
+                    "description": "ID of the ent.",
+                    "type": "string"
+                },
+                "ranges": {
+                    "description": "The ranges of the video that were played, in the order they were played.",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/utils.WatchedRange"
+                    }
+                },
+                "started_at": {
+                    "description": "The time the session started.",
+                    "type": "string"
+                },
+                "updated_at": {
+                    "description": "UpdatedAt holds the value of the \"updated_at\" field.",
+                    "type": "string"
+                },
+                "user_id": {
+                    "description": "The ID of the user that watched the video.",
+                    "type": "string"
+                },
+                "vod_id": {
+                    "description": "The ID of the video that was watched.",
+                    "type": "string"
+                },
+                "watched_seconds": {
+                    "description": "The seconds of the video that were played, counting parts played multiple times every time.",
+                    "type": "number"
+                }
+            }
+        },
+        "ent.ViewingSessionEdges": {
+            "type": "object",
+            "properties": {
+                "user": {
+                    "description": "User holds the value of the user edge.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/ent.User"
+                        }
+                    ]
+                },
+                "vod": {
+                    "description": "Vod holds the value of the vod edge.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/ent.Vod"
+                        }
+                    ]
+                }
+            }
+        },
         "ent.Vod": {
             "type": "object",
             "properties": {
+                "audio_path": {
+                    "description": "The audio of the live stream recorded alongside the video as a fallback.",
+                    "type": "string"
+                },
+                "audio_source_id": {
+                    "description": "The live archive of the same stream that has the original audio of the muted segments.",
+                    "type": "string"
+                },
+                "audio_source_offset": {
+                    "description": "The position in seconds in the VOD where the live archive starts.",
+                    "type": "number"
+                },
+                "authoritative": {
+                    "description": "Whether this is the version to keep when both the live archive and the VOD of a stream are archived.",
+                    "type": "boolean"
+                },
                 "caption_path": {
                     "description": "CaptionPath holds the value of the \"caption_path\" field.",
                     "type": "string"
                 },
+                "category": {
+                    "description": "The main category of the video, if known.",
+                    "type": "string"
+                },
                 "chat_path": {
                     "description": "ChatPath holds the value of the \"chat_path\" field.",
                     "type": "string"
@@ -5603,6 +8508,10 @@ const docTemplate = `{
                     "description": "The external VOD ID of a clip. This is only populated if the clip is linked to a video.",
                     "type": "string"
                 },
+                "clip_vod_id": {
+                    "description": "The video a clip was cut from. This is only populated if the clip was created in Ganymede.",
+                    "type": "string"
+                },
                 "clip_vod_offset": {
                     "description": "The offset in seconds to where the clip starts in the VOD. This is only populdated if the video is a clip.",
                     "type": "integer"
@@ -5639,6 +8548,25 @@ const docTemplate = `{
                     "description": "FolderName holds the value of the \"folder_name\" field.",
                     "type": "string"
                 },
+                "health_checked_at": {
+                    "description": "The time the VOD was last audited.",
+                    "type": "string"
+                },
+                "health_issues": {
+                    "description": "The problems found by the last library audit of the VOD.",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/utils.VideoHealthIssue"
+                    }
+                },
+                "health_status": {
+                    "description": "The result of the last library audit of the VOD.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/utils.VideoHealth"
+                        }
+                    ]
+                },
                 "id": {
                     "description": "ID of the ent.",
                     "type": "string"
@@ -5663,6 +8591,10 @@ const docTemplate = `{
                     "description": "Locked holds the value of the \"locked\" field.",
                     "type": "boolean"
                 },
+                "part": {
+                    "description": "The part number of a live stream archive split into parts, starting at 1. 0 if the archive isn't split.",
+                    "type": "integer"
+                },
                 "platform": {
                     "description": "The platform the VOD is from, takes an enum.",
                     "allOf": [
@@ -5675,6 +8607,20 @@ const docTemplate = `{
                     "description": "Whether the VOD is currently processing.",
                     "type": "boolean"
                 },
+                "quality_fallbacks": {
+                    "description": "Qualities tried in order when archiving the live stream at the resolution keeps failing.",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "quality_switches": {
+                    "description": "The qualities the live stream archive switched to after repeated failures.",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/utils.QualitySwitch"
+                    }
+                },
                 "resolution": {
                     "description": "Resolution holds the value of the \"resolution\" field.",
                     "type": "string"
@@ -5714,6 +8660,14 @@ const docTemplate = `{
                     "description": "The size of the VOD in bytes.",
                     "type": "integer"
                 },
+                "storage_tier": {
+                    "description": "Whether the VOD files are in the videos directory or the cold videos directory.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/utils.StorageTier"
+                        }
+                    ]
+                },
                 "streamed_at": {
                     "description": "The time the VOD was streamed.",
                     "type": "string"
@@ -5726,6 +8680,10 @@ const docTemplate = `{
                     "description": "Title holds the value of the \"title\" field.",
                     "type": "string"
                 },
+                "tmp_audio_path": {
+                    "description": "The path where the fallback audio is recorded to",
+                    "type": "string"
+                },
                 "tmp_chat_download_path": {
                     "description": "The path where the chat is downloaded to",
                     "type": "string"
@@ -5754,6 +8712,10 @@ const docTemplate = `{
                     "description": "The path where the temporary video hls files are",
                     "type": "string"
                 },
+                "transcoding_profile_id": {
+                    "description": "The transcoding profile the video is post-processed with. The global video convert arguments are used if empty.",
+                    "type": "string"
+                },
                 "type": {
                     "description": "The type of VOD, takes an enum.",
                     "allOf": [
@@ -5802,6 +8764,27 @@ const docTemplate = `{
                         "$ref": "#/definitions/ent.Chapter"
                     }
                 },
+                "chat_messages": {
+                    "description": "ChatMessages holds the value of the chat_messages edge.",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/ent.ChatMessage"
+                    }
+                },
+                "checksums": {
+                    "description": "Checksums holds the value of the checksums edge.",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/ent.Checksum"
+                    }
+                },
+                "live_gaps": {
+                    "description": "LiveGaps holds the value of the live_gaps edge.",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/ent.LiveGap"
+                    }
+                },
                 "multistream_info": {
                     "description": "MultistreamInfo holds the value of the multistream_info edge.",
                     "type": "array",
@@ -5830,6 +8813,111 @@ const docTemplate = `{
                             "$ref": "#/definitions/ent.Queue"
                         }
                     ]
+                },
+                "transcoding_profile": {
+                    "description": "TranscodingProfile holds the value of the transcoding_profile edge.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/ent.TranscodingProfile"
+                        }
+                    ]
+                },
+                "user_list_items": {
+                    "description": "UserListItems holds the value of the user_list_items edge.",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/ent.UserListItem"
+                    }
+                },
+                "viewing_sessions": {
+                    "description": "ViewingSessions holds the value of the viewing_sessions edge.",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/ent.ViewingSession"
+                    }
+                }
+            }
+        },
+        "hls.Variant": {
+            "type": "object",
+            "properties": {
+                "average_bandwidth": {
+                    "description": "average bits per second",
+                    "type": "integer"
+                },
+                "bandwidth": {
+                    "description": "peak bits per second of a segment",
+                    "type": "integer"
+                },
+                "codecs": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "frame_rate": {
+                    "type": "number"
+                },
+                "height": {
+                    "type": "integer"
+                },
+                "name": {
+                    "description": "e.g. source, 720p or audio",
+                    "type": "string"
+                },
+                "uri": {
+                    "description": "media playlist, relative to the multivariant playlist",
+                    "type": "string"
+                },
+                "width": {
+                    "type": "integer"
+                }
+            }
+        },
+        "http.AddLiveSchedule": {
+            "type": "object",
+            "properties": {
+                "first_stream_of_day": {
+                    "type": "boolean"
+                },
+                "max_stream_hours": {
+                    "description": "Stop archiving a live stream after this many hours. Set to 0 to disable.",
+                    "type": "integer",
+                    "minimum": 0
+                },
+                "timezone": {
+                    "description": "UTC if empty",
+                    "type": "string"
+                },
+                "windows": {
+                    "type": "array",
+                    "maxItems": 14,
+                    "items": {
+                        "$ref": "#/definitions/http.AddLiveScheduleWindow"
+                    }
+                }
+            }
+        },
+        "http.AddLiveScheduleWindow": {
+            "type": "object",
+            "required": [
+                "end",
+                "start"
+            ],
+            "properties": {
+                "days": {
+                    "description": "0 is Sunday; every day if empty",
+                    "type": "array",
+                    "maxItems": 7,
+                    "items": {
+                        "type": "integer"
+                    }
+                },
+                "end": {
+                    "type": "string"
+                },
+                "start": {
+                    "type": "string"
                 }
             }
         },
@@ -5850,6 +8938,33 @@ const docTemplate = `{
                 }
             }
         },
+        "http.AddVideoToUserListRequest": {
+            "type": "object",
+            "required": [
+                "video_id"
+            ],
+            "properties": {
+                "video_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "http.AddViewingSessionRangesRequest": {
+            "type": "object",
+            "required": [
+                "ranges"
+            ],
+            "properties": {
+                "ranges": {
+                    "type": "array",
+                    "maxItems": 100,
+                    "minItems": 1,
+                    "items": {
+                        "$ref": "#/definitions/utils.WatchedRange"
+                    }
+                }
+            }
+        },
         "http.AddVodToPlaylistRequest": {
             "type": "object",
             "required": [
@@ -5865,6 +8980,7 @@ const docTemplate = `{
             "type": "object",
             "required": [
                 "channel_id",
+                "quality_fallbacks",
                 "resolution"
             ],
             "properties": {
@@ -5909,6 +9025,20 @@ const docTemplate = `{
                 "download_uploads": {
                     "type": "boolean"
                 },
+                "generate_captions": {
+                    "type": "boolean"
+                },
+                "quality_fallbacks": {
+                    "description": "Qualities tried in order when archiving the live stream keeps failing, e.g. 720p60 or best.",
+                    "type": "array",
+                    "maxItems": 5,
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "record_audio_fallback": {
+                    "type": "boolean"
+                },
                 "regex": {
                     "type": "array",
                     "items": {
@@ -5931,9 +9061,24 @@ const docTemplate = `{
                         "audio"
                     ]
                 },
+                "schedule": {
+                    "$ref": "#/definitions/http.AddLiveSchedule"
+                },
+                "split_hours": {
+                    "description": "Split live stream archives into parts every X hours. Set to 0 to disable.",
+                    "type": "integer",
+                    "minimum": 0
+                },
+                "split_on_category_change": {
+                    "type": "boolean"
+                },
                 "strict_categories_live": {
                     "type": "boolean"
                 },
+                "transcoding_profile_id": {
+                    "description": "Post-process archives with the transcoding profile instead of the global video convert arguments.",
+                    "type": "string"
+                },
                 "update_metadata_minutes": {
                     "description": "Queue metadata update X minutes after the stream is live. Set to 0 to disable.",
                     "type": "integer",
@@ -5975,6 +9120,19 @@ const docTemplate = `{
             "properties": {
                 "channel_name": {
                     "type": "string"
+                },
+                "platform": {
+                    "description": "defaults to twitch",
+                    "enum": [
+                        "twitch",
+                        "youtube",
+                        "kick"
+                    ],
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/utils.VideoPlatform"
+                        }
+                    ]
                 }
             }
         },
@@ -5990,6 +9148,19 @@ const docTemplate = `{
                 "channel_id": {
                     "type": "string"
                 },
+                "platform": {
+                    "description": "platform of video_id, defaults to twitch",
+                    "enum": [
+                        "twitch",
+                        "youtube",
+                        "kick"
+                    ],
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/utils.VideoPlatform"
+                        }
+                    ]
+                },
                 "quality": {
                     "enum": [
                         "best",
@@ -6010,6 +9181,10 @@ const docTemplate = `{
                 "render_chat": {
                     "type": "boolean"
                 },
+                "transcoding_profile_id": {
+                    "description": "defaults to the global video convert arguments",
+                    "type": "string"
+                },
                 "video_id": {
                     "type": "string"
                 }
@@ -6089,7 +9264,52 @@ const docTemplate = `{
                     "type": "boolean"
                 },
                 "retention_days": {
+                    "type": "integer",
+                    "minimum": 0
+                },
+                "retention_exempt_min_views": {
+                    "type": "integer",
+                    "minimum": 0
+                },
+                "retention_exempt_playlists": {
+                    "type": "boolean"
+                },
+                "retention_keep_newest": {
+                    "type": "integer",
+                    "minimum": 0
+                },
+                "retention_max_size_bytes": {
+                    "type": "integer",
+                    "minimum": 0
+                },
+                "retention_video_types": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/utils.VodType"
+                    }
+                }
+            }
+        },
+        "http.CreateClipRequest": {
+            "type": "object",
+            "required": [
+                "video_id"
+            ],
+            "properties": {
+                "end": {
                     "type": "integer"
+                },
+                "start": {
+                    "description": "seconds into the video",
+                    "type": "integer",
+                    "minimum": 0
+                },
+                "title": {
+                    "description": "defaults to the title of the video",
+                    "type": "string"
+                },
+                "video_id": {
+                    "type": "string"
                 }
             }
         },
@@ -6135,6 +9355,9 @@ const docTemplate = `{
                 "caption_path": {
                     "type": "string"
                 },
+                "category": {
+                    "type": "string"
+                },
                 "channel_id": {
                     "type": "string"
                 },
@@ -6163,7 +9386,8 @@ const docTemplate = `{
                 "platform": {
                     "enum": [
                         "twitch",
-                        "youtube"
+                        "youtube",
+                        "kick"
                     ],
                     "allOf": [
                         {
@@ -6214,6 +9438,17 @@ const docTemplate = `{
                 }
             }
         },
+        "http.CreateWatchPartyRequest": {
+            "type": "object",
+            "required": [
+                "video_id"
+            ],
+            "properties": {
+                "video_id": {
+                    "type": "string"
+                }
+            }
+        },
         "http.LoginRequest": {
             "type": "object",
             "required": [
@@ -6263,6 +9498,9 @@ const docTemplate = `{
                 "apprise_urls": {
                     "type": "string"
                 },
+                "checksum_mismatch_template": {
+                    "type": "string"
+                },
                 "enabled": {
                     "type": "boolean"
                 },
@@ -6275,9 +9513,15 @@ const docTemplate = `{
                 "live_success_template": {
                     "type": "string"
                 },
+                "low_disk_space_template": {
+                    "type": "string"
+                },
                 "name": {
                     "type": "string"
                 },
+                "trigger_checksum_mismatch": {
+                    "type": "boolean"
+                },
                 "trigger_error": {
                     "type": "boolean"
                 },
@@ -6287,6 +9531,9 @@ const docTemplate = `{
                 "trigger_live_success": {
                     "type": "boolean"
                 },
+                "trigger_low_disk_space": {
+                    "type": "boolean"
+                },
                 "trigger_video_success": {
                     "type": "boolean"
                 },
@@ -6323,6 +9570,9 @@ const docTemplate = `{
                 "apprise_urls": {
                     "type": "string"
                 },
+                "checksum_mismatch_template": {
+                    "type": "string"
+                },
                 "created_at": {
                     "type": "string"
                 },
@@ -6341,9 +9591,15 @@ const docTemplate = `{
                 "live_success_template": {
                     "type": "string"
                 },
+                "low_disk_space_template": {
+                    "type": "string"
+                },
                 "name": {
                     "type": "string"
                 },
+                "trigger_checksum_mismatch": {
+                    "type": "boolean"
+                },
                 "trigger_error": {
                     "type": "boolean"
                 },
@@ -6353,6 +9609,9 @@ const docTemplate = `{
                 "trigger_live_success": {
                     "type": "boolean"
                 },
+                "trigger_low_disk_space": {
+                    "type": "boolean"
+                },
                 "trigger_video_success": {
                     "type": "boolean"
                 },
@@ -6370,6 +9629,64 @@ const docTemplate = `{
                 }
             }
         },
+        "http.ReconcileVersionsRequest": {
+            "type": "object",
+            "properties": {
+                "policy": {
+                    "description": "the configured policy is used if not set",
+                    "enum": [
+                        "keep_both",
+                        "keep_best",
+                        "keep_live_with_vod_chat"
+                    ],
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/utils.StreamVersionPolicy"
+                        }
+                    ]
+                }
+            }
+        },
+        "http.RecoverMutedAudioRequest": {
+            "type": "object",
+            "properties": {
+                "offset": {
+                    "description": "position in seconds in the VOD where the live archive starts, estimated if not set",
+                    "type": "number",
+                    "minimum": 0
+                }
+            }
+        },
+        "http.ReencodeVideosRequest": {
+            "type": "object",
+            "required": [
+                "transcoding_profile_id"
+            ],
+            "properties": {
+                "channel_id": {
+                    "description": "all channels if not set",
+                    "type": "string"
+                },
+                "codec": {
+                    "description": "only videos with this probed video codec, e.g. h264",
+                    "type": "string",
+                    "maxLength": 32
+                },
+                "older_than_days": {
+                    "description": "only videos streamed at least this many days ago",
+                    "type": "integer",
+                    "minimum": 0
+                },
+                "resolution": {
+                    "description": "only videos with this probed resolution, e.g. 1080p",
+                    "type": "string",
+                    "maxLength": 32
+                },
+                "transcoding_profile_id": {
+                    "type": "string"
+                }
+            }
+        },
         "http.RegisterRequest": {
             "type": "object",
             "required": [
@@ -6388,6 +9705,29 @@ const docTemplate = `{
                 }
             }
         },
+        "http.ReorderUserListRequest": {
+            "type": "object",
+            "required": [
+                "video_ids"
+            ],
+            "properties": {
+                "video_ids": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "http.ScanImportRequest": {
+            "type": "object",
+            "properties": {
+                "directory": {
+                    "description": "absolute or relative to the videos directory, defaults to the videos directory",
+                    "type": "string"
+                }
+            }
+        },
         "http.SetPlaylistRulesRequest": {
             "type": "object",
             "required": [
@@ -6504,11 +9844,32 @@ const docTemplate = `{
                         "update_video_storage_usage",
                         "process_playlist_video_rules",
                         "update_platform_channels",
-                        "generate_nfo_files"
+                        "generate_nfo_files",
+                        "index_chat",
+                        "audit_library",
+                        "record_checksums",
+                        "verify_checksums",
+                        "recover_muted_audio",
+                        "reconcile_stream_versions"
                     ]
                 }
             }
         },
+        "http.StartViewingSessionRequest": {
+            "type": "object",
+            "required": [
+                "vod_id"
+            ],
+            "properties": {
+                "device": {
+                    "description": "defaults to the user agent of the request",
+                    "type": "string"
+                },
+                "vod_id": {
+                    "type": "string"
+                }
+            }
+        },
         "http.TestNotificationRequest": {
             "type": "object",
             "required": [
@@ -6521,11 +9882,34 @@ const docTemplate = `{
                         "video_success",
                         "live_success",
                         "error",
-                        "is_live"
+                        "is_live",
+                        "low_disk_space",
+                        "checksum_mismatch"
                     ]
                 }
             }
         },
+        "http.TranscodingProfileRequest": {
+            "type": "object",
+            "required": [
+                "name"
+            ],
+            "properties": {
+                "description": {
+                    "type": "string",
+                    "maxLength": 1024
+                },
+                "ffmpeg_args": {
+                    "description": "FFmpeg output arguments, e.g. \"-c:v libx265 -crf 26 -c:a copy\"",
+                    "type": "string",
+                    "maxLength": 4096
+                },
+                "name": {
+                    "type": "string",
+                    "maxLength": 255
+                }
+            }
+        },
         "http.UpdateApiKeyRequest": {
             "type": "object",
             "required": [
@@ -6776,6 +10160,7 @@ const docTemplate = `{
         "http.UpdateWatchedChannelRequest": {
             "type": "object",
             "required": [
+                "quality_fallbacks",
                 "resolution"
             ],
             "properties": {
@@ -6817,6 +10202,20 @@ const docTemplate = `{
                 "download_uploads": {
                     "type": "boolean"
                 },
+                "generate_captions": {
+                    "type": "boolean"
+                },
+                "quality_fallbacks": {
+                    "description": "Qualities tried in order when archiving the live stream keeps failing, e.g. 720p60 or best.",
+                    "type": "array",
+                    "maxItems": 5,
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "record_audio_fallback": {
+                    "type": "boolean"
+                },
                 "regex": {
                     "type": "array",
                     "items": {
@@ -6839,9 +10238,24 @@ const docTemplate = `{
                         "audio"
                     ]
                 },
+                "schedule": {
+                    "$ref": "#/definitions/http.AddLiveSchedule"
+                },
+                "split_hours": {
+                    "description": "Split live stream archives into parts every X hours. Set to 0 to disable.",
+                    "type": "integer",
+                    "minimum": 0
+                },
+                "split_on_category_change": {
+                    "type": "boolean"
+                },
                 "strict_categories_live": {
                     "type": "boolean"
                 },
+                "transcoding_profile_id": {
+                    "description": "Post-process archives with the transcoding profile instead of the global video convert arguments.",
+                    "type": "string"
+                },
                 "update_metadata_minutes": {
                     "description": "Queue metadata update X minutes after the stream is live. Set to 0 to disable.",
                     "type": "integer",
@@ -6875,6 +10289,19 @@ const docTemplate = `{
                 }
             }
         },
+        "http.UserListRequest": {
+            "type": "object",
+            "required": [
+                "name"
+            ],
+            "properties": {
+                "name": {
+                    "type": "string",
+                    "maxLength": 100,
+                    "minLength": 1
+                }
+            }
+        },
         "http.apiKeyDTO": {
             "type": "object",
             "properties": {
@@ -6924,6 +10351,205 @@ const docTemplate = `{
                 }
             }
         },
+        "importer.ConfirmInput": {
+            "type": "object",
+            "required": [
+                "items"
+            ],
+            "properties": {
+                "fetch_metadata": {
+                    "description": "create channels and update videos with the metadata from their platform",
+                    "type": "boolean"
+                },
+                "items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/importer.Item"
+                    }
+                }
+            }
+        },
+        "importer.ConfirmResult": {
+            "type": "object",
+            "properties": {
+                "failed": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/importer.FailedItem"
+                    }
+                },
+                "imported": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/importer.ImportedItem"
+                    }
+                }
+            }
+        },
+        "importer.FailedItem": {
+            "type": "object",
+            "properties": {
+                "error": {
+                    "type": "string"
+                },
+                "video_path": {
+                    "type": "string"
+                }
+            }
+        },
+        "importer.ImportedItem": {
+            "type": "object",
+            "properties": {
+                "video_id": {
+                    "type": "string"
+                },
+                "video_path": {
+                    "type": "string"
+                }
+            }
+        },
+        "importer.Item": {
+            "type": "object",
+            "required": [
+                "video_path"
+            ],
+            "properties": {
+                "category": {
+                    "type": "string"
+                },
+                "channel_display_name": {
+                    "type": "string"
+                },
+                "channel_ext_id": {
+                    "type": "string"
+                },
+                "channel_id": {
+                    "description": "existing channel the video is imported to",
+                    "type": "string"
+                },
+                "channel_name": {
+                    "type": "string"
+                },
+                "chat_path": {
+                    "type": "string"
+                },
+                "chat_video_path": {
+                    "type": "string"
+                },
+                "ext_id": {
+                    "type": "string"
+                },
+                "platform": {
+                    "$ref": "#/definitions/utils.VideoPlatform"
+                },
+                "problems": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "source": {
+                    "$ref": "#/definitions/importer.Source"
+                },
+                "status": {
+                    "$ref": "#/definitions/importer.Status"
+                },
+                "streamed_at": {
+                    "type": "string"
+                },
+                "title": {
+                    "type": "string"
+                },
+                "type": {
+                    "$ref": "#/definitions/utils.VodType"
+                },
+                "video_hls_path": {
+                    "type": "string"
+                },
+                "video_id": {
+                    "description": "existing video with the same path",
+                    "type": "string"
+                },
+                "video_path": {
+                    "type": "string"
+                }
+            }
+        },
+        "importer.Report": {
+            "type": "object",
+            "properties": {
+                "directory": {
+                    "type": "string"
+                },
+                "exists": {
+                    "type": "integer"
+                },
+                "items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/importer.Item"
+                    }
+                },
+                "new": {
+                    "type": "integer"
+                },
+                "unmatched": {
+                    "type": "integer"
+                }
+            }
+        },
+        "importer.Source": {
+            "type": "string",
+            "enum": [
+                "info_json",
+                "ytdlp_info_json",
+                "nfo",
+                "template",
+                "none"
+            ],
+            "x-enum-comments": {
+                "SourceInfoJSON": "info file written by Ganymede",
+                "SourceTemplate": "storage template variables in the path",
+                "SourceYtdlpInfoJSON": "info file written by yt-dlp"
+            },
+            "x-enum-descriptions": [
+                "info file written by Ganymede",
+                "info file written by yt-dlp",
+                "",
+                "storage template variables in the path",
+                ""
+            ],
+            "x-enum-varnames": [
+                "SourceInfoJSON",
+                "SourceYtdlpInfoJSON",
+                "SourceNFO",
+                "SourceTemplate",
+                "SourceNone"
+            ]
+        },
+        "importer.Status": {
+            "type": "string",
+            "enum": [
+                "new",
+                "exists",
+                "unmatched"
+            ],
+            "x-enum-comments": {
+                "StatusExists": "the video is already in the library",
+                "StatusNew": "the video can be imported",
+                "StatusUnmatched": "the channel of the video is unknown and must be set before importing"
+            },
+            "x-enum-descriptions": [
+                "the video can be imported",
+                "the video is already in the library",
+                "the channel of the video is unknown and must be set before importing"
+            ],
+            "x-enum-varnames": [
+                "StatusNew",
+                "StatusExists",
+                "StatusUnmatched"
+            ]
+        },
         "platform.Badge": {
             "type": "object",
             "properties": {
@@ -7089,15 +10715,155 @@ const docTemplate = `{
                 "OR"
             ],
             "x-enum-varnames": [
-                "DefaultOperator",
-                "OperatorAND",
-                "OperatorOR"
+                "DefaultOperator",
+                "OperatorAND",
+                "OperatorOR"
+            ]
+        },
+        "streamversions.Version": {
+            "type": "object",
+            "properties": {
+                "best": {
+                    "type": "boolean"
+                },
+                "missing_seconds": {
+                    "description": "seconds of the stream the video didn't record",
+                    "type": "integer"
+                },
+                "muted_seconds": {
+                    "description": "seconds of the video that are muted and weren't recovered",
+                    "type": "integer"
+                },
+                "video": {
+                    "$ref": "#/definitions/ent.Vod"
+                }
+            }
+        },
+        "userlist.SharedList": {
+            "type": "object",
+            "properties": {
+                "id": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "updated_at": {
+                    "type": "string"
+                },
+                "username": {
+                    "description": "the user that shared the list",
+                    "type": "string"
+                },
+                "videos": {
+                    "description": "in the order of the list",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/ent.Vod"
+                    }
+                }
+            }
+        },
+        "utils.ChecksumFile": {
+            "type": "string",
+            "enum": [
+                "video",
+                "chat",
+                "chat_video",
+                "live_chat",
+                "live_chat_convert",
+                "info",
+                "thumbnail",
+                "web_thumbnail"
+            ],
+            "x-enum-comments": {
+                "ChecksumFileVideo": "the video or a file of its HLS playlist"
+            },
+            "x-enum-descriptions": [
+                "the video or a file of its HLS playlist",
+                "",
+                "",
+                "",
+                "",
+                "",
+                "",
+                ""
+            ],
+            "x-enum-varnames": [
+                "ChecksumFileVideo",
+                "ChecksumFileChat",
+                "ChecksumFileChatVideo",
+                "ChecksumFileLiveChat",
+                "ChecksumFileLiveChatConvert",
+                "ChecksumFileInfo",
+                "ChecksumFileThumbnail",
+                "ChecksumFileWebThumbnail"
+            ]
+        },
+        "utils.ChecksumStatus": {
+            "type": "string",
+            "enum": [
+                "ok",
+                "mismatch",
+                "missing"
+            ],
+            "x-enum-comments": {
+                "ChecksumStatusMismatch": "the file changed since the checksum was recorded"
+            },
+            "x-enum-descriptions": [
+                "",
+                "the file changed since the checksum was recorded",
+                ""
+            ],
+            "x-enum-varnames": [
+                "ChecksumStatusOk",
+                "ChecksumStatusMismatch",
+                "ChecksumStatusMissing"
+            ]
+        },
+        "utils.ErrorResponse": {
+            "type": "object",
+            "properties": {
+                "message": {
+                    "type": "string"
+                }
+            }
+        },
+        "utils.LiveGapReason": {
+            "type": "string",
+            "enum": [
+                "disconnected",
+                "offline"
+            ],
+            "x-enum-comments": {
+                "LiveGapReasonDisconnected": "the recording failed while the stream was live",
+                "LiveGapReasonOffline": "the stream went offline and came back within the reconnect window"
+            },
+            "x-enum-descriptions": [
+                "the recording failed while the stream was live",
+                "the stream went offline and came back within the reconnect window"
+            ],
+            "x-enum-varnames": [
+                "LiveGapReasonDisconnected",
+                "LiveGapReasonOffline"
             ]
         },
-        "utils.ErrorResponse": {
+        "utils.LiveScheduleWindow": {
             "type": "object",
             "properties": {
-                "message": {
+                "days": {
+                    "description": "0 is Sunday; every day if empty",
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                },
+                "end": {
+                    "description": "15:04, the window ends the next day if it isn't after the start",
+                    "type": "string"
+                },
+                "start": {
+                    "description": "15:04",
                     "type": "string"
                 }
             }
@@ -7154,6 +10920,26 @@ const docTemplate = `{
                 "ProxyTypeHTTP"
             ]
         },
+        "utils.QualitySwitch": {
+            "type": "object",
+            "properties": {
+                "failures": {
+                    "description": "the number of failures of the previous variant",
+                    "type": "integer"
+                },
+                "from": {
+                    "description": "the variant that kept failing",
+                    "type": "string"
+                },
+                "time": {
+                    "type": "string"
+                },
+                "to": {
+                    "description": "the variant archived from now on",
+                    "type": "string"
+                }
+            }
+        },
         "utils.Role": {
             "type": "string",
             "enum": [
@@ -7171,6 +10957,47 @@ const docTemplate = `{
                 "SystemRole"
             ]
         },
+        "utils.StorageTier": {
+            "type": "string",
+            "enum": [
+                "hot",
+                "cold"
+            ],
+            "x-enum-comments": {
+                "StorageTierCold": "stored in the cold videos directory",
+                "StorageTierHot": "stored in the videos directory"
+            },
+            "x-enum-descriptions": [
+                "stored in the videos directory",
+                "stored in the cold videos directory"
+            ],
+            "x-enum-varnames": [
+                "StorageTierHot",
+                "StorageTierCold"
+            ]
+        },
+        "utils.StreamVersionPolicy": {
+            "type": "string",
+            "enum": [
+                "keep_both",
+                "keep_best",
+                "keep_live_with_vod_chat"
+            ],
+            "x-enum-comments": {
+                "StreamVersionPolicyKeepBest": "keep the most complete version",
+                "StreamVersionPolicyKeepLiveWithVodChat": "keep the live archive with the chat of the VOD"
+            },
+            "x-enum-descriptions": [
+                "",
+                "keep the most complete version",
+                "keep the live archive with the chat of the VOD"
+            ],
+            "x-enum-varnames": [
+                "StreamVersionPolicyKeepBoth",
+                "StreamVersionPolicyKeepBest",
+                "StreamVersionPolicyKeepLiveWithVodChat"
+            ]
+        },
         "utils.TaskStatus": {
             "type": "string",
             "enum": [
@@ -7186,15 +11013,103 @@ const docTemplate = `{
                 "Failed"
             ]
         },
+        "utils.UserListType": {
+            "type": "string",
+            "enum": [
+                "watch_later",
+                "favorites",
+                "custom"
+            ],
+            "x-enum-comments": {
+                "UserListTypeCustom": "a list created by the user"
+            },
+            "x-enum-descriptions": [
+                "",
+                "",
+                "a list created by the user"
+            ],
+            "x-enum-varnames": [
+                "UserListTypeWatchLater",
+                "UserListTypeFavorites",
+                "UserListTypeCustom"
+            ]
+        },
+        "utils.VideoHealth": {
+            "type": "string",
+            "enum": [
+                "unknown",
+                "healthy",
+                "degraded",
+                "broken"
+            ],
+            "x-enum-comments": {
+                "VideoHealthBroken": "the video is missing or cannot be played",
+                "VideoHealthDegraded": "the video can be played but other files are missing",
+                "VideoHealthHealthy": "all files exist and the video can be played",
+                "VideoHealthUnknown": "not audited yet"
+            },
+            "x-enum-descriptions": [
+                "not audited yet",
+                "all files exist and the video can be played",
+                "the video can be played but other files are missing",
+                "the video is missing or cannot be played"
+            ],
+            "x-enum-varnames": [
+                "VideoHealthUnknown",
+                "VideoHealthHealthy",
+                "VideoHealthDegraded",
+                "VideoHealthBroken"
+            ]
+        },
+        "utils.VideoHealthIssue": {
+            "type": "string",
+            "enum": [
+                "video_missing",
+                "video_unplayable",
+                "hls_segments_missing",
+                "size_mismatch",
+                "chat_missing",
+                "chat_video_missing",
+                "thumbnail_missing",
+                "sprite_thumbnails_missing"
+            ],
+            "x-enum-comments": {
+                "VideoHealthIssueHLSSegmentsMissing": "files referenced by the HLS playlist are missing",
+                "VideoHealthIssueSizeMismatch": "the files are smaller than the recorded storage size",
+                "VideoHealthIssueVideoUnplayable": "ffprobe failed or found timestamp anomalies"
+            },
+            "x-enum-descriptions": [
+                "",
+                "ffprobe failed or found timestamp anomalies",
+                "files referenced by the HLS playlist are missing",
+                "the files are smaller than the recorded storage size",
+                "",
+                "",
+                "",
+                ""
+            ],
+            "x-enum-varnames": [
+                "VideoHealthIssueVideoMissing",
+                "VideoHealthIssueVideoUnplayable",
+                "VideoHealthIssueHLSSegmentsMissing",
+                "VideoHealthIssueSizeMismatch",
+                "VideoHealthIssueChatMissing",
+                "VideoHealthIssueChatVideoMissing",
+                "VideoHealthIssueThumbnailMissing",
+                "VideoHealthIssueSpriteThumbnailsMissing"
+            ]
+        },
         "utils.VideoPlatform": {
             "type": "string",
             "enum": [
                 "twitch",
-                "youtube"
+                "youtube",
+                "kick"
             ],
             "x-enum-varnames": [
                 "PlatformTwitch",
-                "PlatformYoutube"
+                "PlatformYoutube",
+                "PlatformKick"
             ]
         },
         "utils.VodQuality": {
@@ -7239,6 +11154,60 @@ const docTemplate = `{
                 "Clip"
             ]
         },
+        "utils.WatchedRange": {
+            "type": "object",
+            "properties": {
+                "end": {
+                    "description": "seconds on the video timeline, after the start",
+                    "type": "number"
+                },
+                "start": {
+                    "description": "seconds on the video timeline",
+                    "type": "number"
+                }
+            }
+        },
+        "vod.ChatSearchPagination": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/ent.ChatMessage"
+                    }
+                },
+                "limit": {
+                    "type": "integer"
+                },
+                "offset": {
+                    "type": "integer"
+                },
+                "pages": {
+                    "type": "integer"
+                },
+                "total_count": {
+                    "type": "integer"
+                }
+            }
+        },
+        "vod.LibraryAudit": {
+            "type": "object",
+            "properties": {
+                "counts": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "integer"
+                    }
+                },
+                "videos": {
+                    "description": "degraded and broken videos, broken first",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/ent.Vod"
+                    }
+                }
+            }
+        },
         "vod.Pagination": {
             "type": "object",
             "properties": {
@@ -7261,6 +11230,198 @@ const docTemplate = `{
                     "type": "integer"
                 }
             }
+        },
+        "vod.RepairResult": {
+            "type": "object",
+            "properties": {
+                "queued": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/utils.VideoHealthIssue"
+                    }
+                },
+                "skipped": {
+                    "description": "issues that can't be repaired automatically",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/utils.VideoHealthIssue"
+                    }
+                }
+            }
+        },
+        "vod.RetentionCandidate": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "reason": {
+                    "$ref": "#/definitions/vod.RetentionReason"
+                },
+                "storage_size_bytes": {
+                    "type": "integer"
+                },
+                "title": {
+                    "type": "string"
+                },
+                "type": {
+                    "$ref": "#/definitions/utils.VodType"
+                }
+            }
+        },
+        "vod.RetentionDryRun": {
+            "type": "object",
+            "properties": {
+                "channel_id": {
+                    "type": "string"
+                },
+                "enabled": {
+                    "description": "nothing is deleted until retention is enabled for the channel",
+                    "type": "boolean"
+                },
+                "total_bytes": {
+                    "type": "integer"
+                },
+                "total_count": {
+                    "type": "integer"
+                },
+                "videos": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/vod.RetentionCandidate"
+                    }
+                }
+            }
+        },
+        "vod.RetentionReason": {
+            "type": "string",
+            "enum": [
+                "age",
+                "keep_newest",
+                "max_size"
+            ],
+            "x-enum-comments": {
+                "RetentionReasonAge": "older than the retention days",
+                "RetentionReasonKeepNewest": "not one of the newest N videos",
+                "RetentionReasonMaxSize": "deleted to bring the channel below the size cap"
+            },
+            "x-enum-descriptions": [
+                "older than the retention days",
+                "not one of the newest N videos",
+                "deleted to bring the channel below the size cap"
+            ],
+            "x-enum-varnames": [
+                "RetentionReasonAge",
+                "RetentionReasonKeepNewest",
+                "RetentionReasonMaxSize"
+            ]
+        },
+        "vod.SearchFacetBucket": {
+            "type": "object",
+            "properties": {
+                "count": {
+                    "type": "integer"
+                },
+                "label": {
+                    "type": "string"
+                },
+                "value": {
+                    "type": "string"
+                }
+            }
+        },
+        "vod.SearchFacets": {
+            "type": "object",
+            "properties": {
+                "channels": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/vod.SearchFacetBucket"
+                    }
+                },
+                "types": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/vod.SearchFacetBucket"
+                    }
+                },
+                "years": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/vod.SearchFacetBucket"
+                    }
+                }
+            }
+        },
+        "vod.SearchPagination": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/ent.Vod"
+                    }
+                },
+                "facets": {
+                    "$ref": "#/definitions/vod.SearchFacets"
+                },
+                "limit": {
+                    "type": "integer"
+                },
+                "offset": {
+                    "type": "integer"
+                },
+                "pages": {
+                    "type": "integer"
+                },
+                "total_count": {
+                    "type": "integer"
+                }
+            }
+        },
+        "watchparty.Member": {
+            "type": "object",
+            "properties": {
+                "user_id": {
+                    "type": "string"
+                },
+                "username": {
+                    "type": "string"
+                }
+            }
+        },
+        "watchparty.State": {
+            "type": "object",
+            "properties": {
+                "host_id": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "members": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/watchparty.Member"
+                    }
+                },
+                "playing": {
+                    "type": "boolean"
+                },
+                "position": {
+                    "description": "seconds on the video timeline when the state was sent",
+                    "type": "number"
+                },
+                "updated_at": {
+                    "type": "string"
+                },
+                "video_id": {
+                    "type": "string"
+                }
+            }
         }
     },
     "securityDefinitions": {
@@ -3,6 +3,7 @@
 package ent
 
 import (
+	"encoding/json"
 	"fmt"
 	"strings"
 	"time"
@@ -11,6 +12,7 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"github.com/google/uuid"
 	"github.com/zibbp/ganymede/ent/channel"
+	"github.com/zibbp/ganymede/internal/utils"
 )
 
 // Channel is the model entity for the Channel schema.
@@ -26,10 +28,22 @@ type Channel struct {
 	DisplayName string `json:"display_name,omitempty"`
 	// ImagePath holds the value of the "image_path" field.
 	ImagePath string `json:"image_path,omitempty"`
+	// The platform the channel is from, takes an enum.
+	Platform utils.VideoPlatform `json:"platform,omitempty"`
 	// Retention holds the value of the "retention" field.
 	Retention bool `json:"retention,omitempty"`
 	// RetentionDays holds the value of the "retention_days" field.
 	RetentionDays int64 `json:"retention_days,omitempty"`
+	// Keep only the newest N videos, 0 disables the rule.
+	RetentionKeepNewest int `json:"retention_keep_newest,omitempty"`
+	// Delete the oldest videos once the channel exceeds this size in bytes, 0 disables the rule.
+	RetentionMaxSizeBytes int64 `json:"retention_max_size_bytes,omitempty"`
+	// Video types the retention policy applies to, all types if empty.
+	RetentionVideoTypes []utils.VodType `json:"retention_video_types,omitempty"`
+	// Never delete videos that are in a playlist.
+	RetentionExemptPlaylists bool `json:"retention_exempt_playlists,omitempty"`
+	// Never delete videos with at least this many local views, 0 disables the exemption.
+	RetentionExemptMinViews int `json:"retention_exempt_min_views,omitempty"`
 	// Total storage size in bytes for the channel's videos.
 	StorageSizeBytes int64 `json:"storage_size_bytes,omitempty"`
 	// UpdatedAt holds the value of the "updated_at" field.
@@ -76,11 +90,13 @@ func (*Channel) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case channel.FieldRetention:
+		case channel.FieldRetentionVideoTypes:
+			values[i] = new([]byte)
+		case channel.FieldRetention, channel.FieldRetentionExemptPlaylists:
 			values[i] = new(sql.NullBool)
-		case channel.FieldRetentionDays, channel.FieldStorageSizeBytes:
+		case channel.FieldRetentionDays, channel.FieldRetentionKeepNewest, channel.FieldRetentionMaxSizeBytes, channel.FieldRetentionExemptMinViews, channel.FieldStorageSizeBytes:
 			values[i] = new(sql.NullInt64)
-		case channel.FieldExtID, channel.FieldName, channel.FieldDisplayName, channel.FieldImagePath:
+		case channel.FieldExtID, channel.FieldName, channel.FieldDisplayName, channel.FieldImagePath, channel.FieldPlatform:
 			values[i] = new(sql.NullString)
 		case channel.FieldUpdatedAt, channel.FieldCreatedAt:
 			values[i] = new(sql.NullTime)
@@ -131,6 +147,12 @@ func (_m *Channel) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.ImagePath = value.String
 			}
+		case channel.FieldPlatform:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field platform", values[i])
+			} else if value.Valid {
+				_m.Platform = utils.VideoPlatform(value.String)
+			}
 		case channel.FieldRetention:
 			if value, ok := values[i].(*sql.NullBool); !ok {
 				return fmt.Errorf("unexpected type %T for field retention", values[i])
@@ -143,6 +165,38 @@ func (_m *Channel) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.RetentionDays = value.Int64
 			}
+		case channel.FieldRetentionKeepNewest:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field retention_keep_newest", values[i])
+			} else if value.Valid {
+				_m.RetentionKeepNewest = int(value.Int64)
+			}
+		case channel.FieldRetentionMaxSizeBytes:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field retention_max_size_bytes", values[i])
+			} else if value.Valid {
+				_m.RetentionMaxSizeBytes = value.Int64
+			}
+		case channel.FieldRetentionVideoTypes:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field retention_video_types", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.RetentionVideoTypes); err != nil {
+					return fmt.Errorf("unmarshal field retention_video_types: %w", err)
+				}
+			}
+		case channel.FieldRetentionExemptPlaylists:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field retention_exempt_playlists", values[i])
+			} else if value.Valid {
+				_m.RetentionExemptPlaylists = value.Bool
+			}
+		case channel.FieldRetentionExemptMinViews:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field retention_exempt_min_views", values[i])
+			} else if value.Valid {
+				_m.RetentionExemptMinViews = int(value.Int64)
+			}
 		case channel.FieldStorageSizeBytes:
 			if value, ok := values[i].(*sql.NullInt64); !ok {
 				return fmt.Errorf("unexpected type %T for field storage_size_bytes", values[i])
@@ -219,12 +273,30 @@ func (_m *Channel) String() string {
 	builder.WriteString("image_path=")
 	builder.WriteString(_m.ImagePath)
 	builder.WriteString(", ")
+	builder.WriteString("platform=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Platform))
+	builder.WriteString(", ")
 	builder.WriteString("retention=")
 	builder.WriteString(fmt.Sprintf("%v", _m.Retention))
 	builder.WriteString(", ")
 	builder.WriteString("retention_days=")
 	builder.WriteString(fmt.Sprintf("%v", _m.RetentionDays))
 	builder.WriteString(", ")
+	builder.WriteString("retention_keep_newest=")
+	builder.WriteString(fmt.Sprintf("%v", _m.RetentionKeepNewest))
+	builder.WriteString(", ")
+	builder.WriteString("retention_max_size_bytes=")
+	builder.WriteString(fmt.Sprintf("%v", _m.RetentionMaxSizeBytes))
+	builder.WriteString(", ")
+	builder.WriteString("retention_video_types=")
+	builder.WriteString(fmt.Sprintf("%v", _m.RetentionVideoTypes))
+	builder.WriteString(", ")
+	builder.WriteString("retention_exempt_playlists=")
+	builder.WriteString(fmt.Sprintf("%v", _m.RetentionExemptPlaylists))
+	builder.WriteString(", ")
+	builder.WriteString("retention_exempt_min_views=")
+	builder.WriteString(fmt.Sprintf("%v", _m.RetentionExemptMinViews))
+	builder.WriteString(", ")
 	builder.WriteString("storage_size_bytes=")
 	builder.WriteString(fmt.Sprintf("%v", _m.StorageSizeBytes))
 	builder.WriteString(", ")
@@ -3,11 +3,13 @@
 package channel
 
 import (
+	"fmt"
 	"time"
 
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"github.com/google/uuid"
+	"github.com/zibbp/ganymede/internal/utils"
 )
 
 const (
@@ -23,10 +25,22 @@ const (
 	FieldDisplayName = "display_name"
 	// FieldImagePath holds the string denoting the image_path field in the database.
 	FieldImagePath = "image_path"
+	// FieldPlatform holds the string denoting the platform field in the database.
+	FieldPlatform = "platform"
 	// FieldRetention holds the string denoting the retention field in the database.
 	FieldRetention = "retention"
 	// FieldRetentionDays holds the string denoting the retention_days field in the database.
 	FieldRetentionDays = "retention_days"
+	// FieldRetentionKeepNewest holds the string denoting the retention_keep_newest field in the database.
+	FieldRetentionKeepNewest = "retention_keep_newest"
+	// FieldRetentionMaxSizeBytes holds the string denoting the retention_max_size_bytes field in the database.
+	FieldRetentionMaxSizeBytes = "retention_max_size_bytes"
+	// FieldRetentionVideoTypes holds the string denoting the retention_video_types field in the database.
+	FieldRetentionVideoTypes = "retention_video_types"
+	// FieldRetentionExemptPlaylists holds the string denoting the retention_exempt_playlists field in the database.
+	FieldRetentionExemptPlaylists = "retention_exempt_playlists"
+	// FieldRetentionExemptMinViews holds the string denoting the retention_exempt_min_views field in the database.
+	FieldRetentionExemptMinViews = "retention_exempt_min_views"
 	// FieldStorageSizeBytes holds the string denoting the storage_size_bytes field in the database.
 	FieldStorageSizeBytes = "storage_size_bytes"
 	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
@@ -62,8 +76,14 @@ var Columns = []string{
 	FieldName,
 	FieldDisplayName,
 	FieldImagePath,
+	FieldPlatform,
 	FieldRetention,
 	FieldRetentionDays,
+	FieldRetentionKeepNewest,
+	FieldRetentionMaxSizeBytes,
+	FieldRetentionVideoTypes,
+	FieldRetentionExemptPlaylists,
+	FieldRetentionExemptMinViews,
 	FieldStorageSizeBytes,
 	FieldUpdatedAt,
 	FieldCreatedAt,
@@ -82,6 +102,20 @@ func ValidColumn(column string) bool {
 var (
 	// DefaultRetention holds the default value on creation for the "retention" field.
 	DefaultRetention bool
+	// DefaultRetentionKeepNewest holds the default value on creation for the "retention_keep_newest" field.
+	DefaultRetentionKeepNewest int
+	// RetentionKeepNewestValidator is a validator for the "retention_keep_newest" field. It is called by the builders before save.
+	RetentionKeepNewestValidator func(int) error
+	// DefaultRetentionMaxSizeBytes holds the default value on creation for the "retention_max_size_bytes" field.
+	DefaultRetentionMaxSizeBytes int64
+	// RetentionMaxSizeBytesValidator is a validator for the "retention_max_size_bytes" field. It is called by the builders before save.
+	RetentionMaxSizeBytesValidator func(int64) error
+	// DefaultRetentionExemptPlaylists holds the default value on creation for the "retention_exempt_playlists" field.
+	DefaultRetentionExemptPlaylists bool
+	// DefaultRetentionExemptMinViews holds the default value on creation for the "retention_exempt_min_views" field.
+	DefaultRetentionExemptMinViews int
+	// RetentionExemptMinViewsValidator is a validator for the "retention_exempt_min_views" field. It is called by the builders before save.
+	RetentionExemptMinViewsValidator func(int) error
 	// DefaultStorageSizeBytes holds the default value on creation for the "storage_size_bytes" field.
 	DefaultStorageSizeBytes int64
 	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
@@ -94,6 +128,18 @@ var (
 	DefaultID func() uuid.UUID
 )
 
+const DefaultPlatform utils.VideoPlatform = "twitch"
+
+// PlatformValidator is a validator for the "platform" field enum values. It is called by the builders before save.
+func PlatformValidator(pl utils.VideoPlatform) error {
+	switch pl {
+	case "twitch", "youtube", "kick":
+		return nil
+	default:
+		return fmt.Errorf("channel: invalid enum value for platform field: %q", pl)
+	}
+}
+
 // OrderOption defines the ordering options for the Channel queries.
 type OrderOption func(*sql.Selector)
 
@@ -122,6 +168,11 @@ func ByImagePath(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldImagePath, opts...).ToFunc()
 }
 
+// ByPlatform orders the results by the platform field.
+func ByPlatform(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPlatform, opts...).ToFunc()
+}
+
 // ByRetention orders the results by the retention field.
 func ByRetention(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldRetention, opts...).ToFunc()
@@ -132,6 +183,26 @@ func ByRetentionDays(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldRetentionDays, opts...).ToFunc()
 }
 
+// ByRetentionKeepNewest orders the results by the retention_keep_newest field.
+func ByRetentionKeepNewest(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRetentionKeepNewest, opts...).ToFunc()
+}
+
+// ByRetentionMaxSizeBytes orders the results by the retention_max_size_bytes field.
+func ByRetentionMaxSizeBytes(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRetentionMaxSizeBytes, opts...).ToFunc()
+}
+
+// ByRetentionExemptPlaylists orders the results by the retention_exempt_playlists field.
+func ByRetentionExemptPlaylists(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRetentionExemptPlaylists, opts...).ToFunc()
+}
+
+// ByRetentionExemptMinViews orders the results by the retention_exempt_min_views field.
+func ByRetentionExemptMinViews(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRetentionExemptMinViews, opts...).ToFunc()
+}
+
 // ByStorageSizeBytes orders the results by the storage_size_bytes field.
 func ByStorageSizeBytes(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldStorageSizeBytes, opts...).ToFunc()
@@ -9,6 +9,7 @@ import (
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"github.com/google/uuid"
 	"github.com/zibbp/ganymede/ent/predicate"
+	"github.com/zibbp/ganymede/internal/utils"
 )
 
 // ID filters vertices based on their ID field.
@@ -86,6 +87,26 @@ func RetentionDays(v int64) predicate.Channel {
 	return predicate.Channel(sql.FieldEQ(FieldRetentionDays, v))
 }
 
+// RetentionKeepNewest applies equality check predicate on the "retention_keep_newest" field. It's identical to RetentionKeepNewestEQ.
+func RetentionKeepNewest(v int) predicate.Channel {
+	return predicate.Channel(sql.FieldEQ(FieldRetentionKeepNewest, v))
+}
+
+// RetentionMaxSizeBytes applies equality check predicate on the "retention_max_size_bytes" field. It's identical to RetentionMaxSizeBytesEQ.
+func RetentionMaxSizeBytes(v int64) predicate.Channel {
+	return predicate.Channel(sql.FieldEQ(FieldRetentionMaxSizeBytes, v))
+}
+
+// RetentionExemptPlaylists applies equality check predicate on the "retention_exempt_playlists" field. It's identical to RetentionExemptPlaylistsEQ.
+func RetentionExemptPlaylists(v bool) predicate.Channel {
+	return predicate.Channel(sql.FieldEQ(FieldRetentionExemptPlaylists, v))
+}
+
+// RetentionExemptMinViews applies equality check predicate on the "retention_exempt_min_views" field. It's identical to RetentionExemptMinViewsEQ.
+func RetentionExemptMinViews(v int) predicate.Channel {
+	return predicate.Channel(sql.FieldEQ(FieldRetentionExemptMinViews, v))
+}
+
 // StorageSizeBytes applies equality check predicate on the "storage_size_bytes" field. It's identical to StorageSizeBytesEQ.
 func StorageSizeBytes(v int64) predicate.Channel {
 	return predicate.Channel(sql.FieldEQ(FieldStorageSizeBytes, v))
@@ -371,6 +392,36 @@ func ImagePathContainsFold(v string) predicate.Channel {
 	return predicate.Channel(sql.FieldContainsFold(FieldImagePath, v))
 }
 
+// PlatformEQ applies the EQ predicate on the "platform" field.
+func PlatformEQ(v utils.VideoPlatform) predicate.Channel {
+	vc := v
+	return predicate.Channel(sql.FieldEQ(FieldPlatform, vc))
+}
+
+// PlatformNEQ applies the NEQ predicate on the "platform" field.
+func PlatformNEQ(v utils.VideoPlatform) predicate.Channel {
+	vc := v
+	return predicate.Channel(sql.FieldNEQ(FieldPlatform, vc))
+}
+
+// PlatformIn applies the In predicate on the "platform" field.
+func PlatformIn(vs ...utils.VideoPlatform) predicate.Channel {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Channel(sql.FieldIn(FieldPlatform, v...))
+}
+
+// PlatformNotIn applies the NotIn predicate on the "platform" field.
+func PlatformNotIn(vs ...utils.VideoPlatform) predicate.Channel {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Channel(sql.FieldNotIn(FieldPlatform, v...))
+}
+
 // RetentionEQ applies the EQ predicate on the "retention" field.
 func RetentionEQ(v bool) predicate.Channel {
 	return predicate.Channel(sql.FieldEQ(FieldRetention, v))
@@ -431,6 +482,146 @@ func RetentionDaysNotNil() predicate.Channel {
 	return predicate.Channel(sql.FieldNotNull(FieldRetentionDays))
 }
 
+// RetentionKeepNewestEQ applies the EQ predicate on the "retention_keep_newest" field.
+func RetentionKeepNewestEQ(v int) predicate.Channel {
+	return predicate.Channel(sql.FieldEQ(FieldRetentionKeepNewest, v))
+}
+
+// RetentionKeepNewestNEQ applies the NEQ predicate on the "retention_keep_newest" field.
+func RetentionKeepNewestNEQ(v int) predicate.Channel {
+	return predicate.Channel(sql.FieldNEQ(FieldRetentionKeepNewest, v))
+}
+
+// RetentionKeepNewestIn applies the In predicate on the "retention_keep_newest" field.
+func RetentionKeepNewestIn(vs ...int) predicate.Channel {
+	return predicate.Channel(sql.FieldIn(FieldRetentionKeepNewest, vs...))
+}
+
+// RetentionKeepNewestNotIn applies the NotIn predicate on the "retention_keep_newest" field.
+func RetentionKeepNewestNotIn(vs ...int) predicate.Channel {
+	return predicate.Channel(sql.FieldNotIn(FieldRetentionKeepNewest, vs...))
+}
+
+// RetentionKeepNewestGT applies the GT predicate on the "retention_keep_newest" field.
+func RetentionKeepNewestGT(v int) predicate.Channel {
+	return predicate.Channel(sql.FieldGT(FieldRetentionKeepNewest, v))
+}
+
+// RetentionKeepNewestGTE applies the GTE predicate on the "retention_keep_newest" field.
+func RetentionKeepNewestGTE(v int) predicate.Channel {
+	return predicate.Channel(sql.FieldGTE(FieldRetentionKeepNewest, v))
+}
+
+// RetentionKeepNewestLT applies the LT predicate on the "retention_keep_newest" field.
+func RetentionKeepNewestLT(v int) predicate.Channel {
+	return predicate.Channel(sql.FieldLT(FieldRetentionKeepNewest, v))
+}
+
+// RetentionKeepNewestLTE applies the LTE predicate on the "retention_keep_newest" field.
+func RetentionKeepNewestLTE(v int) predicate.Channel {
+	return predicate.Channel(sql.FieldLTE(FieldRetentionKeepNewest, v))
+}
+
+// RetentionMaxSizeBytesEQ applies the EQ predicate on the "retention_max_size_bytes" field.
+func RetentionMaxSizeBytesEQ(v int64) predicate.Channel {
+	return predicate.Channel(sql.FieldEQ(FieldRetentionMaxSizeBytes, v))
+}
+
+// RetentionMaxSizeBytesNEQ applies the NEQ predicate on the "retention_max_size_bytes" field.
+func RetentionMaxSizeBytesNEQ(v int64) predicate.Channel {
+	return predicate.Channel(sql.FieldNEQ(FieldRetentionMaxSizeBytes, v))
+}
+
+// RetentionMaxSizeBytesIn applies the In predicate on the "retention_max_size_bytes" field.
+func RetentionMaxSizeBytesIn(vs ...int64) predicate.Channel {
+	return predicate.Channel(sql.FieldIn(FieldRetentionMaxSizeBytes, vs...))
+}
+
+// RetentionMaxSizeBytesNotIn applies the NotIn predicate on the "retention_max_size_bytes" field.
+func RetentionMaxSizeBytesNotIn(vs ...int64) predicate.Channel {
+	return predicate.Channel(sql.FieldNotIn(FieldRetentionMaxSizeBytes, vs...))
+}
+
+// RetentionMaxSizeBytesGT applies the GT predicate on the "retention_max_size_bytes" field.
+func RetentionMaxSizeBytesGT(v int64) predicate.Channel {
+	return predicate.Channel(sql.FieldGT(FieldRetentionMaxSizeBytes, v))
+}
+
+// RetentionMaxSizeBytesGTE applies the GTE predicate on the "retention_max_size_bytes" field.
+func RetentionMaxSizeBytesGTE(v int64) predicate.Channel {
+	return predicate.Channel(sql.FieldGTE(FieldRetentionMaxSizeBytes, v))
+}
+
+// RetentionMaxSizeBytesLT applies the LT predicate on the "retention_max_size_bytes" field.
+func RetentionMaxSizeBytesLT(v int64) predicate.Channel {
+	return predicate.Channel(sql.FieldLT(FieldRetentionMaxSizeBytes, v))
+}
+
+// RetentionMaxSizeBytesLTE applies the LTE predicate on the "retention_max_size_bytes" field.
+func RetentionMaxSizeBytesLTE(v int64) predicate.Channel {
+	return predicate.Channel(sql.FieldLTE(FieldRetentionMaxSizeBytes, v))
+}
+
+// RetentionVideoTypesIsNil applies the IsNil predicate on the "retention_video_types" field.
+func RetentionVideoTypesIsNil() predicate.Channel {
+	return predicate.Channel(sql.FieldIsNull(FieldRetentionVideoTypes))
+}
+
+// RetentionVideoTypesNotNil applies the NotNil predicate on the "retention_video_types" field.
+func RetentionVideoTypesNotNil() predicate.Channel {
+	return predicate.Channel(sql.FieldNotNull(FieldRetentionVideoTypes))
+}
+
+// RetentionExemptPlaylistsEQ applies the EQ predicate on the "retention_exempt_playlists" field.
+func RetentionExemptPlaylistsEQ(v bool) predicate.Channel {
+	return predicate.Channel(sql.FieldEQ(FieldRetentionExemptPlaylists, v))
+}
+
+// RetentionExemptPlaylistsNEQ applies the NEQ predicate on the "retention_exempt_playlists" field.
+func RetentionExemptPlaylistsNEQ(v bool) predicate.Channel {
+	return predicate.Channel(sql.FieldNEQ(FieldRetentionExemptPlaylists, v))
+}
+
+// RetentionExemptMinViewsEQ applies the EQ predicate on the "retention_exempt_min_views" field.
+func RetentionExemptMinViewsEQ(v int) predicate.Channel {
+	return predicate.Channel(sql.FieldEQ(FieldRetentionExemptMinViews, v))
+}
+
+// RetentionExemptMinViewsNEQ applies the NEQ predicate on the "retention_exempt_min_views" field.
+func RetentionExemptMinViewsNEQ(v int) predicate.Channel {
+	return predicate.Channel(sql.FieldNEQ(FieldRetentionExemptMinViews, v))
+}
+
+// RetentionExemptMinViewsIn applies the In predicate on the "retention_exempt_min_views" field.
+func RetentionExemptMinViewsIn(vs ...int) predicate.Channel {
+	return predicate.Channel(sql.FieldIn(FieldRetentionExemptMinViews, vs...))
+}
+
+// RetentionExemptMinViewsNotIn applies the NotIn predicate on the "retention_exempt_min_views" field.
+func RetentionExemptMinViewsNotIn(vs ...int) predicate.Channel {
+	return predicate.Channel(sql.FieldNotIn(FieldRetentionExemptMinViews, vs...))
+}
+
+// RetentionExemptMinViewsGT applies the GT predicate on the "retention_exempt_min_views" field.
+func RetentionExemptMinViewsGT(v int) predicate.Channel {
+	return predicate.Channel(sql.FieldGT(FieldRetentionExemptMinViews, v))
+}
+
+// RetentionExemptMinViewsGTE applies the GTE predicate on the "retention_exempt_min_views" field.
+func RetentionExemptMinViewsGTE(v int) predicate.Channel {
+	return predicate.Channel(sql.FieldGTE(FieldRetentionExemptMinViews, v))
+}
+
+// RetentionExemptMinViewsLT applies the LT predicate on the "retention_exempt_min_views" field.
+func RetentionExemptMinViewsLT(v int) predicate.Channel {
+	return predicate.Channel(sql.FieldLT(FieldRetentionExemptMinViews, v))
+}
+
+// RetentionExemptMinViewsLTE applies the LTE predicate on the "retention_exempt_min_views" field.
+func RetentionExemptMinViewsLTE(v int) predicate.Channel {
+	return predicate.Channel(sql.FieldLTE(FieldRetentionExemptMinViews, v))
+}
+
 // StorageSizeBytesEQ applies the EQ predicate on the "storage_size_bytes" field.
 func StorageSizeBytesEQ(v int64) predicate.Channel {
 	return predicate.Channel(sql.FieldEQ(FieldStorageSizeBytes, v))
@@ -16,6 +16,7 @@ import (
 	"github.com/zibbp/ganymede/ent/channel"
 	"github.com/zibbp/ganymede/ent/live"
 	"github.com/zibbp/ganymede/ent/vod"
+	"github.com/zibbp/ganymede/internal/utils"
 )
 
 // ChannelCreate is the builder for creating a Channel entity.
@@ -58,6 +59,20 @@ func (_c *ChannelCreate) SetImagePath(v string) *ChannelCreate {
 	return _c
 }
 
+// SetPlatform sets the "platform" field.
+func (_c *ChannelCreate) SetPlatform(v utils.VideoPlatform) *ChannelCreate {
+	_c.mutation.SetPlatform(v)
+	return _c
+}
+
+// SetNillablePlatform sets the "platform" field if the given value is not nil.
+func (_c *ChannelCreate) SetNillablePlatform(v *utils.VideoPlatform) *ChannelCreate {
+	if v != nil {
+		_c.SetPlatform(*v)
+	}
+	return _c
+}
+
 // SetRetention sets the "retention" field.
 func (_c *ChannelCreate) SetRetention(v bool) *ChannelCreate {
 	_c.mutation.SetRetention(v)
@@ -86,6 +101,68 @@ func (_c *ChannelCreate) SetNillableRetentionDays(v *int64) *ChannelCreate {
 	return _c
 }
 
+// SetRetentionKeepNewest sets the "retention_keep_newest" field.
+func (_c *ChannelCreate) SetRetentionKeepNewest(v int) *ChannelCreate {
+	_c.mutation.SetRetentionKeepNewest(v)
+	return _c
+}
+
+// SetNillableRetentionKeepNewest sets the "retention_keep_newest" field if the given value is not nil.
+func (_c *ChannelCreate) SetNillableRetentionKeepNewest(v *int) *ChannelCreate {
+	if v != nil {
+		_c.SetRetentionKeepNewest(*v)
+	}
+	return _c
+}
+
+// SetRetentionMaxSizeBytes sets the "retention_max_size_bytes" field.
+func (_c *ChannelCreate) SetRetentionMaxSizeBytes(v int64) *ChannelCreate {
+	_c.mutation.SetRetentionMaxSizeBytes(v)
+	return _c
+}
+
+// SetNillableRetentionMaxSizeBytes sets the "retention_max_size_bytes" field if the given value is not nil.
+func (_c *ChannelCreate) SetNillableRetentionMaxSizeBytes(v *int64) *ChannelCreate {
+	if v != nil {
+		_c.SetRetentionMaxSizeBytes(*v)
+	}
+	return _c
+}
+
+// SetRetentionVideoTypes sets the "retention_video_types" field.
+func (_c *ChannelCreate) SetRetentionVideoTypes(v []utils.VodType) *ChannelCreate {
+	_c.mutation.SetRetentionVideoTypes(v)
+	return _c
+}
+
+// SetRetentionExemptPlaylists sets the "retention_exempt_playlists" field.
+func (_c *ChannelCreate) SetRetentionExemptPlaylists(v bool) *ChannelCreate {
+	_c.mutation.SetRetentionExemptPlaylists(v)
+	return _c
+}
+
+// SetNillableRetentionExemptPlaylists sets the "retention_exempt_playlists" field if the given value is not nil.
+func (_c *ChannelCreate) SetNillableRetentionExemptPlaylists(v *bool) *ChannelCreate {
+	if v != nil {
+		_c.SetRetentionExemptPlaylists(*v)
+	}
+	return _c
+}
+
+// SetRetentionExemptMinViews sets the "retention_exempt_min_views" field.
+func (_c *ChannelCreate) SetRetentionExemptMinViews(v int) *ChannelCreate {
+	_c.mutation.SetRetentionExemptMinViews(v)
+	return _c
+}
+
+// SetNillableRetentionExemptMinViews sets the "retention_exempt_min_views" field if the given value is not nil.
+func (_c *ChannelCreate) SetNillableRetentionExemptMinViews(v *int) *ChannelCreate {
+	if v != nil {
+		_c.SetRetentionExemptMinViews(*v)
+	}
+	return _c
+}
+
 // SetStorageSizeBytes sets the "storage_size_bytes" field.
 func (_c *ChannelCreate) SetStorageSizeBytes(v int64) *ChannelCreate {
 	_c.mutation.SetStorageSizeBytes(v)
@@ -207,10 +284,30 @@ func (_c *ChannelCreate) ExecX(ctx context.Context) {
 
 // defaults sets the default values of the builder before save.
 func (_c *ChannelCreate) defaults() {
+	if _, ok := _c.mutation.Platform(); !ok {
+		v := channel.DefaultPlatform
+		_c.mutation.SetPlatform(v)
+	}
 	if _, ok := _c.mutation.Retention(); !ok {
 		v := channel.DefaultRetention
 		_c.mutation.SetRetention(v)
 	}
+	if _, ok := _c.mutation.RetentionKeepNewest(); !ok {
+		v := channel.DefaultRetentionKeepNewest
+		_c.mutation.SetRetentionKeepNewest(v)
+	}
+	if _, ok := _c.mutation.RetentionMaxSizeBytes(); !ok {
+		v := channel.DefaultRetentionMaxSizeBytes
+		_c.mutation.SetRetentionMaxSizeBytes(v)
+	}
+	if _, ok := _c.mutation.RetentionExemptPlaylists(); !ok {
+		v := channel.DefaultRetentionExemptPlaylists
+		_c.mutation.SetRetentionExemptPlaylists(v)
+	}
+	if _, ok := _c.mutation.RetentionExemptMinViews(); !ok {
+		v := channel.DefaultRetentionExemptMinViews
+		_c.mutation.SetRetentionExemptMinViews(v)
+	}
 	if _, ok := _c.mutation.StorageSizeBytes(); !ok {
 		v := channel.DefaultStorageSizeBytes
 		_c.mutation.SetStorageSizeBytes(v)
@@ -240,9 +337,44 @@ func (_c *ChannelCreate) check() error {
 	if _, ok := _c.mutation.ImagePath(); !ok {
 		return &ValidationError{Name: "image_path", err: errors.New(`ent: missing required field "Channel.image_path"`)}
 	}
+	if _, ok := _c.mutation.Platform(); !ok {
+		return &ValidationError{Name: "platform", err: errors.New(`ent: missing required field "Channel.platform"`)}
+	}
+	if v, ok := _c.mutation.Platform(); ok {
+		if err := channel.PlatformValidator(v); err != nil {
+			return &ValidationError{Name: "platform", err: fmt.Errorf(`ent: validator failed for field "Channel.platform": %w`, err)}
+		}
+	}
 	if _, ok := _c.mutation.Retention(); !ok {
 		return &ValidationError{Name: "retention", err: errors.New(`ent: missing required field "Channel.retention"`)}
 	}
+	if _, ok := _c.mutation.RetentionKeepNewest(); !ok {
+		return &ValidationError{Name: "retention_keep_newest", err: errors.New(`ent: missing required field "Channel.retention_keep_newest"`)}
+	}
+	if v, ok := _c.mutation.RetentionKeepNewest(); ok {
+		if err := channel.RetentionKeepNewestValidator(v); err != nil {
+			return &ValidationError{Name: "retention_keep_newest", err: fmt.Errorf(`ent: validator failed for field "Channel.retention_keep_newest": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.RetentionMaxSizeBytes(); !ok {
+		return &ValidationError{Name: "retention_max_size_bytes", err: errors.New(`ent: missing required field "Channel.retention_max_size_bytes"`)}
+	}
+	if v, ok := _c.mutation.RetentionMaxSizeBytes(); ok {
+		if err := channel.RetentionMaxSizeBytesValidator(v); err != nil {
+			return &ValidationError{Name: "retention_max_size_bytes", err: fmt.Errorf(`ent: validator failed for field "Channel.retention_max_size_bytes": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.RetentionExemptPlaylists(); !ok {
+		return &ValidationError{Name: "retention_exempt_playlists", err: errors.New(`ent: missing required field "Channel.retention_exempt_playlists"`)}
+	}
+	if _, ok := _c.mutation.RetentionExemptMinViews(); !ok {
+		return &ValidationError{Name: "retention_exempt_min_views", err: errors.New(`ent: missing required field "Channel.retention_exempt_min_views"`)}
+	}
+	if v, ok := _c.mutation.RetentionExemptMinViews(); ok {
+		if err := channel.RetentionExemptMinViewsValidator(v); err != nil {
+			return &ValidationError{Name: "retention_exempt_min_views", err: fmt.Errorf(`ent: validator failed for field "Channel.retention_exempt_min_views": %w`, err)}
+		}
+	}
 	if _, ok := _c.mutation.StorageSizeBytes(); !ok {
 		return &ValidationError{Name: "storage_size_bytes", err: errors.New(`ent: missing required field "Channel.storage_size_bytes"`)}
 	}
@@ -304,6 +436,10 @@ func (_c *ChannelCreate) createSpec() (*Channel, *sqlgraph.CreateSpec) {
 		_spec.SetField(channel.FieldImagePath, field.TypeString, value)
 		_node.ImagePath = value
 	}
+	if value, ok := _c.mutation.Platform(); ok {
+		_spec.SetField(channel.FieldPlatform, field.TypeEnum, value)
+		_node.Platform = value
+	}
 	if value, ok := _c.mutation.Retention(); ok {
 		_spec.SetField(channel.FieldRetention, field.TypeBool, value)
 		_node.Retention = value
@@ -312,6 +448,26 @@ func (_c *ChannelCreate) createSpec() (*Channel, *sqlgraph.CreateSpec) {
 		_spec.SetField(channel.FieldRetentionDays, field.TypeInt64, value)
 		_node.RetentionDays = value
 	}
+	if value, ok := _c.mutation.RetentionKeepNewest(); ok {
+		_spec.SetField(channel.FieldRetentionKeepNewest, field.TypeInt, value)
+		_node.RetentionKeepNewest = value
+	}
+	if value, ok := _c.mutation.RetentionMaxSizeBytes(); ok {
+		_spec.SetField(channel.FieldRetentionMaxSizeBytes, field.TypeInt64, value)
+		_node.RetentionMaxSizeBytes = value
+	}
+	if value, ok := _c.mutation.RetentionVideoTypes(); ok {
+		_spec.SetField(channel.FieldRetentionVideoTypes, field.TypeJSON, value)
+		_node.RetentionVideoTypes = value
+	}
+	if value, ok := _c.mutation.RetentionExemptPlaylists(); ok {
+		_spec.SetField(channel.FieldRetentionExemptPlaylists, field.TypeBool, value)
+		_node.RetentionExemptPlaylists = value
+	}
+	if value, ok := _c.mutation.RetentionExemptMinViews(); ok {
+		_spec.SetField(channel.FieldRetentionExemptMinViews, field.TypeInt, value)
+		_node.RetentionExemptMinViews = value
+	}
 	if value, ok := _c.mutation.StorageSizeBytes(); ok {
 		_spec.SetField(channel.FieldStorageSizeBytes, field.TypeInt64, value)
 		_node.StorageSizeBytes = value
@@ -462,6 +618,18 @@ func (u *ChannelUpsert) UpdateImagePath() *ChannelUpsert {
 	return u
 }
 
+// SetPlatform sets the "platform" field.
+func (u *ChannelUpsert) SetPlatform(v utils.VideoPlatform) *ChannelUpsert {
+	u.Set(channel.FieldPlatform, v)
+	return u
+}
+
+// UpdatePlatform sets the "platform" field to the value that was provided on create.
+func (u *ChannelUpsert) UpdatePlatform() *ChannelUpsert {
+	u.SetExcluded(channel.FieldPlatform)
+	return u
+}
+
 // SetRetention sets the "retention" field.
 func (u *ChannelUpsert) SetRetention(v bool) *ChannelUpsert {
 	u.Set(channel.FieldRetention, v)
@@ -498,6 +666,90 @@ func (u *ChannelUpsert) ClearRetentionDays() *ChannelUpsert {
 	return u
 }
 
+// SetRetentionKeepNewest sets the "retention_keep_newest" field.
+func (u *ChannelUpsert) SetRetentionKeepNewest(v int) *ChannelUpsert {
+	u.Set(channel.FieldRetentionKeepNewest, v)
+	return u
+}
+
+// UpdateRetentionKeepNewest sets the "retention_keep_newest" field to the value that was provided on create.
+func (u *ChannelUpsert) UpdateRetentionKeepNewest() *ChannelUpsert {
+	u.SetExcluded(channel.FieldRetentionKeepNewest)
+	return u
+}
+
+// AddRetentionKeepNewest adds v to the "retention_keep_newest" field.
+func (u *ChannelUpsert) AddRetentionKeepNewest(v int) *ChannelUpsert {
+	u.Add(channel.FieldRetentionKeepNewest, v)
+	return u
+}
+
+// SetRetentionMaxSizeBytes sets the "retention_max_size_bytes" field.
+func (u *ChannelUpsert) SetRetentionMaxSizeBytes(v int64) *ChannelUpsert {
+	u.Set(channel.FieldRetentionMaxSizeBytes, v)
+	return u
+}
+
+// UpdateRetentionMaxSizeBytes sets the "retention_max_size_bytes" field to the value that was provided on create.
+func (u *ChannelUpsert) UpdateRetentionMaxSizeBytes() *ChannelUpsert {
+	u.SetExcluded(channel.FieldRetentionMaxSizeBytes)
+	return u
+}
+
+// AddRetentionMaxSizeBytes adds v to the "retention_max_size_bytes" field.
+func (u *ChannelUpsert) AddRetentionMaxSizeBytes(v int64) *ChannelUpsert {
+	u.Add(channel.FieldRetentionMaxSizeBytes, v)
+	return u
+}
+
+// SetRetentionVideoTypes sets the "retention_video_types" field.
+func (u *ChannelUpsert) SetRetentionVideoTypes(v []utils.VodType) *ChannelUpsert {
+	u.Set(channel.FieldRetentionVideoTypes, v)
+	return u
+}
+
+// UpdateRetentionVideoTypes sets the "retention_video_types" field to the value that was provided on create.
+func (u *ChannelUpsert) UpdateRetentionVideoTypes() *ChannelUpsert {
+	u.SetExcluded(channel.FieldRetentionVideoTypes)
+	return u
+}
+
+// ClearRetentionVideoTypes clears the value of the "retention_video_types" field.
+func (u *ChannelUpsert) ClearRetentionVideoTypes() *ChannelUpsert {
+	u.SetNull(channel.FieldRetentionVideoTypes)
+	return u
+}
+
+// SetRetentionExemptPlaylists sets the "retention_exempt_playlists" field.
+func (u *ChannelUpsert) SetRetentionExemptPlaylists(v bool) *ChannelUpsert {
+	u.Set(channel.FieldRetentionExemptPlaylists, v)
+	return u
+}
+
+// UpdateRetentionExemptPlaylists sets the "retention_exempt_playlists" field to the value that was provided on create.
+func (u *ChannelUpsert) UpdateRetentionExemptPlaylists() *ChannelUpsert {
+	u.SetExcluded(channel.FieldRetentionExemptPlaylists)
+	return u
+}
+
+// SetRetentionExemptMinViews sets the "retention_exempt_min_views" field.
+func (u *ChannelUpsert) SetRetentionExemptMinViews(v int) *ChannelUpsert {
+	u.Set(channel.FieldRetentionExemptMinViews, v)
+	return u
+}
+
+// UpdateRetentionExemptMinViews sets the "retention_exempt_min_views" field to the value that was provided on create.
+func (u *ChannelUpsert) UpdateRetentionExemptMinViews() *ChannelUpsert {
+	u.SetExcluded(channel.FieldRetentionExemptMinViews)
+	return u
+}
+
+// AddRetentionExemptMinViews adds v to the "retention_exempt_min_views" field.
+func (u *ChannelUpsert) AddRetentionExemptMinViews(v int) *ChannelUpsert {
+	u.Add(channel.FieldRetentionExemptMinViews, v)
+	return u
+}
+
 // SetStorageSizeBytes sets the "storage_size_bytes" field.
 func (u *ChannelUpsert) SetStorageSizeBytes(v int64) *ChannelUpsert {
 	u.Set(channel.FieldStorageSizeBytes, v)
@@ -642,6 +894,20 @@ func (u *ChannelUpsertOne) UpdateImagePath() *ChannelUpsertOne {
 	})
 }
 
+// SetPlatform sets the "platform" field.
+func (u *ChannelUpsertOne) SetPlatform(v utils.VideoPlatform) *ChannelUpsertOne {
+	return u.Update(func(s *ChannelUpsert) {
+		s.SetPlatform(v)
+	})
+}
+
+// UpdatePlatform sets the "platform" field to the value that was provided on create.
+func (u *ChannelUpsertOne) UpdatePlatform() *ChannelUpsertOne {
+	return u.Update(func(s *ChannelUpsert) {
+		s.UpdatePlatform()
+	})
+}
+
 // SetRetention sets the "retention" field.
 func (u *ChannelUpsertOne) SetRetention(v bool) *ChannelUpsertOne {
 	return u.Update(func(s *ChannelUpsert) {
@@ -684,6 +950,104 @@ func (u *ChannelUpsertOne) ClearRetentionDays() *ChannelUpsertOne {
 	})
 }
 
+// SetRetentionKeepNewest sets the "retention_keep_newest" field.
+func (u *ChannelUpsertOne) SetRetentionKeepNewest(v int) *ChannelUpsertOne {
+	return u.Update(func(s *ChannelUpsert) {
+		s.SetRetentionKeepNewest(v)
+	})
+}
+
+// AddRetentionKeepNewest adds v to the "retention_keep_newest" field.
+func (u *ChannelUpsertOne) AddRetentionKeepNewest(v int) *ChannelUpsertOne {
+	return u.Update(func(s *ChannelUpsert) {
+		s.AddRetentionKeepNewest(v)
+	})
+}
+
+// UpdateRetentionKeepNewest sets the "retention_keep_newest" field to the value that was provided on create.
+func (u *ChannelUpsertOne) UpdateRetentionKeepNewest() *ChannelUpsertOne {
+	return u.Update(func(s *ChannelUpsert) {
+		s.UpdateRetentionKeepNewest()
+	})
+}
+
+// SetRetentionMaxSizeBytes sets the "retention_max_size_bytes" field.
+func (u *ChannelUpsertOne) SetRetentionMaxSizeBytes(v int64) *ChannelUpsertOne {
+	return u.Update(func(s *ChannelUpsert) {
+		s.SetRetentionMaxSizeBytes(v)
+	})
+}
+
+// AddRetentionMaxSizeBytes adds v to the "retention_max_size_bytes" field.
+func (u *ChannelUpsertOne) AddRetentionMaxSizeBytes(v int64) *ChannelUpsertOne {
+	return u.Update(func(s *ChannelUpsert) {
+		s.AddRetentionMaxSizeBytes(v)
+	})
+}
+
+// UpdateRetentionMaxSizeBytes sets the "retention_max_size_bytes" field to the value that was provided on create.
+func (u *ChannelUpsertOne) UpdateRetentionMaxSizeBytes() *ChannelUpsertOne {
+	return u.Update(func(s *ChannelUpsert) {
+		s.UpdateRetentionMaxSizeBytes()
+	})
+}
+
+// SetRetentionVideoTypes sets the "retention_video_types" field.
+func (u *ChannelUpsertOne) SetRetentionVideoTypes(v []utils.VodType) *ChannelUpsertOne {
+	return u.Update(func(s *ChannelUpsert) {
+		s.SetRetentionVideoTypes(v)
+	})
+}
+
+// UpdateRetentionVideoTypes sets the "retention_video_types" field to the value that was provided on create.
+func (u *ChannelUpsertOne) UpdateRetentionVideoTypes() *ChannelUpsertOne {
+	return u.Update(func(s *ChannelUpsert) {
+		s.UpdateRetentionVideoTypes()
+	})
+}
+
+// ClearRetentionVideoTypes clears the value of the "retention_video_types" field.
+func (u *ChannelUpsertOne) ClearRetentionVideoTypes() *ChannelUpsertOne {
+	return u.Update(func(s *ChannelUpsert) {
+		s.ClearRetentionVideoTypes()
+	})
+}
+
+// SetRetentionExemptPlaylists sets the "retention_exempt_playlists" field.
+func (u *ChannelUpsertOne) SetRetentionExemptPlaylists(v bool) *ChannelUpsertOne {
+	return u.Update(func(s *ChannelUpsert) {
+		s.SetRetentionExemptPlaylists(v)
+	})
+}
+
+// UpdateRetentionExemptPlaylists sets the "retention_exempt_playlists" field to the value that was provided on create.
+func (u *ChannelUpsertOne) UpdateRetentionExemptPlaylists() *ChannelUpsertOne {
+	return u.Update(func(s *ChannelUpsert) {
+		s.UpdateRetentionExemptPlaylists()
+	})
+}
+
+// SetRetentionExemptMinViews sets the "retention_exempt_min_views" field.
+func (u *ChannelUpsertOne) SetRetentionExemptMinViews(v int) *ChannelUpsertOne {
+	return u.Update(func(s *ChannelUpsert) {
+		s.SetRetentionExemptMinViews(v)
+	})
+}
+
+// AddRetentionExemptMinViews adds v to the "retention_exempt_min_views" field.
+func (u *ChannelUpsertOne) AddRetentionExemptMinViews(v int) *ChannelUpsertOne {
+	return u.Update(func(s *ChannelUpsert) {
+		s.AddRetentionExemptMinViews(v)
+	})
+}
+
+// UpdateRetentionExemptMinViews sets the "retention_exempt_min_views" field to the value that was provided on create.
+func (u *ChannelUpsertOne) UpdateRetentionExemptMinViews() *ChannelUpsertOne {
+	return u.Update(func(s *ChannelUpsert) {
+		s.UpdateRetentionExemptMinViews()
+	})
+}
+
 // SetStorageSizeBytes sets the "storage_size_bytes" field.
 func (u *ChannelUpsertOne) SetStorageSizeBytes(v int64) *ChannelUpsertOne {
 	return u.Update(func(s *ChannelUpsert) {
@@ -1000,6 +1364,20 @@ func (u *ChannelUpsertBulk) UpdateImagePath() *ChannelUpsertBulk {
 	})
 }
 
+// SetPlatform sets the "platform" field.
+func (u *ChannelUpsertBulk) SetPlatform(v utils.VideoPlatform) *ChannelUpsertBulk {
+	return u.Update(func(s *ChannelUpsert) {
+		s.SetPlatform(v)
+	})
+}
+
+// UpdatePlatform sets the "platform" field to the value that was provided on create.
+func (u *ChannelUpsertBulk) UpdatePlatform() *ChannelUpsertBulk {
+	return u.Update(func(s *ChannelUpsert) {
+		s.UpdatePlatform()
+	})
+}
+
 // SetRetention sets the "retention" field.
 func (u *ChannelUpsertBulk) SetRetention(v bool) *ChannelUpsertBulk {
 	return u.Update(func(s *ChannelUpsert) {
@@ -1042,6 +1420,104 @@ func (u *ChannelUpsertBulk) ClearRetentionDays() *ChannelUpsertBulk {
 	})
 }
 
+// SetRetentionKeepNewest sets the "retention_keep_newest" field.
+func (u *ChannelUpsertBulk) SetRetentionKeepNewest(v int) *ChannelUpsertBulk {
+	return u.Update(func(s *ChannelUpsert) {
+		s.SetRetentionKeepNewest(v)
+	})
+}
+
+// AddRetentionKeepNewest adds v to the "retention_keep_newest" field.
+func (u *ChannelUpsertBulk) AddRetentionKeepNewest(v int) *ChannelUpsertBulk {
+	return u.Update(func(s *ChannelUpsert) {
+		s.AddRetentionKeepNewest(v)
+	})
+}
+
+// UpdateRetentionKeepNewest sets the "retention_keep_newest" field to the value that was provided on create.
+func (u *ChannelUpsertBulk) UpdateRetentionKeepNewest() *ChannelUpsertBulk {
+	return u.Update(func(s *ChannelUpsert) {
+		s.UpdateRetentionKeepNewest()
+	})
+}
+
+// SetRetentionMaxSizeBytes sets the "retention_max_size_bytes" field.
+func (u *ChannelUpsertBulk) SetRetentionMaxSizeBytes(v int64) *ChannelUpsertBulk {
+	return u.Update(func(s *ChannelUpsert) {
+		s.SetRetentionMaxSizeBytes(v)
+	})
+}
+
+// AddRetentionMaxSizeBytes adds v to the "retention_max_size_bytes" field.
+func (u *ChannelUpsertBulk) AddRetentionMaxSizeBytes(v int64) *ChannelUpsertBulk {
+	return u.Update(func(s *ChannelUpsert) {
+		s.AddRetentionMaxSizeBytes(v)
+	})
+}
+
+// UpdateRetentionMaxSizeBytes sets the "retention_max_size_bytes" field to the value that was provided on create.
+func (u *ChannelUpsertBulk) UpdateRetentionMaxSizeBytes() *ChannelUpsertBulk {
+	return u.Update(func(s *ChannelUpsert) {
+		s.UpdateRetentionMaxSizeBytes()
+	})
+}
+
+// SetRetentionVideoTypes sets the "retention_video_types" field.
+func (u *ChannelUpsertBulk) SetRetentionVideoTypes(v []utils.VodType) *ChannelUpsertBulk {
+	return u.Update(func(s *ChannelUpsert) {
+		s.SetRetentionVideoTypes(v)
+	})
+}
+
+// UpdateRetentionVideoTypes sets the "retention_video_types" field to the value that was provided on create.
+func (u *ChannelUpsertBulk) UpdateRetentionVideoTypes() *ChannelUpsertBulk {
+	return u.Update(func(s *ChannelUpsert) {
+		s.UpdateRetentionVideoTypes()
+	})
+}
+
+// ClearRetentionVideoTypes clears the value of the "retention_video_types" field.
+func (u *ChannelUpsertBulk) ClearRetentionVideoTypes() *ChannelUpsertBulk {
+	return u.Update(func(s *ChannelUpsert) {
+		s.ClearRetentionVideoTypes()
+	})
+}
+
+// SetRetentionExemptPlaylists sets the "retention_exempt_playlists" field.
+func (u *ChannelUpsertBulk) SetRetentionExemptPlaylists(v bool) *ChannelUpsertBulk {
+	return u.Update(func(s *ChannelUpsert) {
+		s.SetRetentionExemptPlaylists(v)
+	})
+}
+
+// UpdateRetentionExemptPlaylists sets the "retention_exempt_playlists" field to the value that was provided on create.
+func (u *ChannelUpsertBulk) UpdateRetentionExemptPlaylists() *ChannelUpsertBulk {
+	return u.Update(func(s *ChannelUpsert) {
+		s.UpdateRetentionExemptPlaylists()
+	})
+}
+
+// SetRetentionExemptMinViews sets the "retention_exempt_min_views" field.
+func (u *ChannelUpsertBulk) SetRetentionExemptMinViews(v int) *ChannelUpsertBulk {
+	return u.Update(func(s *ChannelUpsert) {
+		s.SetRetentionExemptMinViews(v)
+	})
+}
+
+// AddRetentionExemptMinViews adds v to the "retention_exempt_min_views" field.
+func (u *ChannelUpsertBulk) AddRetentionExemptMinViews(v int) *ChannelUpsertBulk {
+	return u.Update(func(s *ChannelUpsert) {
+		s.AddRetentionExemptMinViews(v)
+	})
+}
+
+// UpdateRetentionExemptMinViews sets the "retention_exempt_min_views" field to the value that was provided on create.
+func (u *ChannelUpsertBulk) UpdateRetentionExemptMinViews() *ChannelUpsertBulk {
+	return u.Update(func(s *ChannelUpsert) {
+		s.UpdateRetentionExemptMinViews()
+	})
+}
+
 // SetStorageSizeBytes sets the "storage_size_bytes" field.
 func (u *ChannelUpsertBulk) SetStorageSizeBytes(v int64) *ChannelUpsertBulk {
 	return u.Update(func(s *ChannelUpsert) {
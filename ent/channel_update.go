@@ -10,12 +10,14 @@ import (
 
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
 	"entgo.io/ent/schema/field"
 	"github.com/google/uuid"
 	"github.com/zibbp/ganymede/ent/channel"
 	"github.com/zibbp/ganymede/ent/live"
 	"github.com/zibbp/ganymede/ent/predicate"
 	"github.com/zibbp/ganymede/ent/vod"
+	"github.com/zibbp/ganymede/internal/utils"
 )
 
 // ChannelUpdate is the builder for updating Channel entities.
@@ -93,6 +95,20 @@ func (_u *ChannelUpdate) SetNillableImagePath(v *string) *ChannelUpdate {
 	return _u
 }
 
+// SetPlatform sets the "platform" field.
+func (_u *ChannelUpdate) SetPlatform(v utils.VideoPlatform) *ChannelUpdate {
+	_u.mutation.SetPlatform(v)
+	return _u
+}
+
+// SetNillablePlatform sets the "platform" field if the given value is not nil.
+func (_u *ChannelUpdate) SetNillablePlatform(v *utils.VideoPlatform) *ChannelUpdate {
+	if v != nil {
+		_u.SetPlatform(*v)
+	}
+	return _u
+}
+
 // SetRetention sets the "retention" field.
 func (_u *ChannelUpdate) SetRetention(v bool) *ChannelUpdate {
 	_u.mutation.SetRetention(v)
@@ -134,6 +150,101 @@ func (_u *ChannelUpdate) ClearRetentionDays() *ChannelUpdate {
 	return _u
 }
 
+// SetRetentionKeepNewest sets the "retention_keep_newest" field.
+func (_u *ChannelUpdate) SetRetentionKeepNewest(v int) *ChannelUpdate {
+	_u.mutation.ResetRetentionKeepNewest()
+	_u.mutation.SetRetentionKeepNewest(v)
+	return _u
+}
+
+// SetNillableRetentionKeepNewest sets the "retention_keep_newest" field if the given value is not nil.
+func (_u *ChannelUpdate) SetNillableRetentionKeepNewest(v *int) *ChannelUpdate {
+	if v != nil {
+		_u.SetRetentionKeepNewest(*v)
+	}
+	return _u
+}
+
+// AddRetentionKeepNewest adds value to the "retention_keep_newest" field.
+func (_u *ChannelUpdate) AddRetentionKeepNewest(v int) *ChannelUpdate {
+	_u.mutation.AddRetentionKeepNewest(v)
+	return _u
+}
+
+// SetRetentionMaxSizeBytes sets the "retention_max_size_bytes" field.
+func (_u *ChannelUpdate) SetRetentionMaxSizeBytes(v int64) *ChannelUpdate {
+	_u.mutation.ResetRetentionMaxSizeBytes()
+	_u.mutation.SetRetentionMaxSizeBytes(v)
+	return _u
+}
+
+// SetNillableRetentionMaxSizeBytes sets the "retention_max_size_bytes" field if the given value is not nil.
+func (_u *ChannelUpdate) SetNillableRetentionMaxSizeBytes(v *int64) *ChannelUpdate {
+	if v != nil {
+		_u.SetRetentionMaxSizeBytes(*v)
+	}
+	return _u
+}
+
+// AddRetentionMaxSizeBytes adds value to the "retention_max_size_bytes" field.
+func (_u *ChannelUpdate) AddRetentionMaxSizeBytes(v int64) *ChannelUpdate {
+	_u.mutation.AddRetentionMaxSizeBytes(v)
+	return _u
+}
+
+// SetRetentionVideoTypes sets the "retention_video_types" field.
+func (_u *ChannelUpdate) SetRetentionVideoTypes(v []utils.VodType) *ChannelUpdate {
+	_u.mutation.SetRetentionVideoTypes(v)
+	return _u
+}
+
+// AppendRetentionVideoTypes appends value to the "retention_video_types" field.
+func (_u *ChannelUpdate) AppendRetentionVideoTypes(v []utils.VodType) *ChannelUpdate {
+	_u.mutation.AppendRetentionVideoTypes(v)
+	return _u
+}
+
+// ClearRetentionVideoTypes clears the value of the "retention_video_types" field.
+func (_u *ChannelUpdate) ClearRetentionVideoTypes() *ChannelUpdate {
+	_u.mutation.ClearRetentionVideoTypes()
+	return _u
+}
+
+// SetRetentionExemptPlaylists sets the "retention_exempt_playlists" field.
+func (_u *ChannelUpdate) SetRetentionExemptPlaylists(v bool) *ChannelUpdate {
+	_u.mutation.SetRetentionExemptPlaylists(v)
+	return _u
+}
+
+// SetNillableRetentionExemptPlaylists sets the "retention_exempt_playlists" field if the given value is not nil.
+func (_u *ChannelUpdate) SetNillableRetentionExemptPlaylists(v *bool) *ChannelUpdate {
+	if v != nil {
+		_u.SetRetentionExemptPlaylists(*v)
+	}
+	return _u
+}
+
+// SetRetentionExemptMinViews sets the "retention_exempt_min_views" field.
+func (_u *ChannelUpdate) SetRetentionExemptMinViews(v int) *ChannelUpdate {
+	_u.mutation.ResetRetentionExemptMinViews()
+	_u.mutation.SetRetentionExemptMinViews(v)
+	return _u
+}
+
+// SetNillableRetentionExemptMinViews sets the "retention_exempt_min_views" field if the given value is not nil.
+func (_u *ChannelUpdate) SetNillableRetentionExemptMinViews(v *int) *ChannelUpdate {
+	if v != nil {
+		_u.SetRetentionExemptMinViews(*v)
+	}
+	return _u
+}
+
+// AddRetentionExemptMinViews adds value to the "retention_exempt_min_views" field.
+func (_u *ChannelUpdate) AddRetentionExemptMinViews(v int) *ChannelUpdate {
+	_u.mutation.AddRetentionExemptMinViews(v)
+	return _u
+}
+
 // SetStorageSizeBytes sets the "storage_size_bytes" field.
 func (_u *ChannelUpdate) SetStorageSizeBytes(v int64) *ChannelUpdate {
 	_u.mutation.ResetStorageSizeBytes()
@@ -274,7 +385,35 @@ func (_u *ChannelUpdate) defaults() {
 	}
 }
 
+// check runs all checks and user-defined validators on the builder.
+func (_u *ChannelUpdate) check() error {
+	if v, ok := _u.mutation.Platform(); ok {
+		if err := channel.PlatformValidator(v); err != nil {
+			return &ValidationError{Name: "platform", err: fmt.Errorf(`ent: validator failed for field "Channel.platform": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.RetentionKeepNewest(); ok {
+		if err := channel.RetentionKeepNewestValidator(v); err != nil {
+			return &ValidationError{Name: "retention_keep_newest", err: fmt.Errorf(`ent: validator failed for field "Channel.retention_keep_newest": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.RetentionMaxSizeBytes(); ok {
+		if err := channel.RetentionMaxSizeBytesValidator(v); err != nil {
+			return &ValidationError{Name: "retention_max_size_bytes", err: fmt.Errorf(`ent: validator failed for field "Channel.retention_max_size_bytes": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.RetentionExemptMinViews(); ok {
+		if err := channel.RetentionExemptMinViewsValidator(v); err != nil {
+			return &ValidationError{Name: "retention_exempt_min_views", err: fmt.Errorf(`ent: validator failed for field "Channel.retention_exempt_min_views": %w`, err)}
+		}
+	}
+	return nil
+}
+
 func (_u *ChannelUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
 	_spec := sqlgraph.NewUpdateSpec(channel.Table, channel.Columns, sqlgraph.NewFieldSpec(channel.FieldID, field.TypeUUID))
 	if ps := _u.mutation.predicates; len(ps) > 0 {
 		_spec.Predicate = func(selector *sql.Selector) {
@@ -298,6 +437,9 @@ func (_u *ChannelUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if value, ok := _u.mutation.ImagePath(); ok {
 		_spec.SetField(channel.FieldImagePath, field.TypeString, value)
 	}
+	if value, ok := _u.mutation.Platform(); ok {
+		_spec.SetField(channel.FieldPlatform, field.TypeEnum, value)
+	}
 	if value, ok := _u.mutation.Retention(); ok {
 		_spec.SetField(channel.FieldRetention, field.TypeBool, value)
 	}
@@ -310,6 +452,38 @@ func (_u *ChannelUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if _u.mutation.RetentionDaysCleared() {
 		_spec.ClearField(channel.FieldRetentionDays, field.TypeInt64)
 	}
+	if value, ok := _u.mutation.RetentionKeepNewest(); ok {
+		_spec.SetField(channel.FieldRetentionKeepNewest, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedRetentionKeepNewest(); ok {
+		_spec.AddField(channel.FieldRetentionKeepNewest, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.RetentionMaxSizeBytes(); ok {
+		_spec.SetField(channel.FieldRetentionMaxSizeBytes, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedRetentionMaxSizeBytes(); ok {
+		_spec.AddField(channel.FieldRetentionMaxSizeBytes, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.RetentionVideoTypes(); ok {
+		_spec.SetField(channel.FieldRetentionVideoTypes, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedRetentionVideoTypes(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, channel.FieldRetentionVideoTypes, value)
+		})
+	}
+	if _u.mutation.RetentionVideoTypesCleared() {
+		_spec.ClearField(channel.FieldRetentionVideoTypes, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.RetentionExemptPlaylists(); ok {
+		_spec.SetField(channel.FieldRetentionExemptPlaylists, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.RetentionExemptMinViews(); ok {
+		_spec.SetField(channel.FieldRetentionExemptMinViews, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedRetentionExemptMinViews(); ok {
+		_spec.AddField(channel.FieldRetentionExemptMinViews, field.TypeInt, value)
+	}
 	if value, ok := _u.mutation.StorageSizeBytes(); ok {
 		_spec.SetField(channel.FieldStorageSizeBytes, field.TypeInt64, value)
 	}
@@ -491,6 +665,20 @@ func (_u *ChannelUpdateOne) SetNillableImagePath(v *string) *ChannelUpdateOne {
 	return _u
 }
 
+// SetPlatform sets the "platform" field.
+func (_u *ChannelUpdateOne) SetPlatform(v utils.VideoPlatform) *ChannelUpdateOne {
+	_u.mutation.SetPlatform(v)
+	return _u
+}
+
+// SetNillablePlatform sets the "platform" field if the given value is not nil.
+func (_u *ChannelUpdateOne) SetNillablePlatform(v *utils.VideoPlatform) *ChannelUpdateOne {
+	if v != nil {
+		_u.SetPlatform(*v)
+	}
+	return _u
+}
+
 // SetRetention sets the "retention" field.
 func (_u *ChannelUpdateOne) SetRetention(v bool) *ChannelUpdateOne {
 	_u.mutation.SetRetention(v)
@@ -532,6 +720,101 @@ func (_u *ChannelUpdateOne) ClearRetentionDays() *ChannelUpdateOne {
 	return _u
 }
 
+// SetRetentionKeepNewest sets the "retention_keep_newest" field.
+func (_u *ChannelUpdateOne) SetRetentionKeepNewest(v int) *ChannelUpdateOne {
+	_u.mutation.ResetRetentionKeepNewest()
+	_u.mutation.SetRetentionKeepNewest(v)
+	return _u
+}
+
+// SetNillableRetentionKeepNewest sets the "retention_keep_newest" field if the given value is not nil.
+func (_u *ChannelUpdateOne) SetNillableRetentionKeepNewest(v *int) *ChannelUpdateOne {
+	if v != nil {
+		_u.SetRetentionKeepNewest(*v)
+	}
+	return _u
+}
+
+// AddRetentionKeepNewest adds value to the "retention_keep_newest" field.
+func (_u *ChannelUpdateOne) AddRetentionKeepNewest(v int) *ChannelUpdateOne {
+	_u.mutation.AddRetentionKeepNewest(v)
+	return _u
+}
+
+// SetRetentionMaxSizeBytes sets the "retention_max_size_bytes" field.
+func (_u *ChannelUpdateOne) SetRetentionMaxSizeBytes(v int64) *ChannelUpdateOne {
+	_u.mutation.ResetRetentionMaxSizeBytes()
+	_u.mutation.SetRetentionMaxSizeBytes(v)
+	return _u
+}
+
+// SetNillableRetentionMaxSizeBytes sets the "retention_max_size_bytes" field if the given value is not nil.
+func (_u *ChannelUpdateOne) SetNillableRetentionMaxSizeBytes(v *int64) *ChannelUpdateOne {
+	if v != nil {
+		_u.SetRetentionMaxSizeBytes(*v)
+	}
+	return _u
+}
+
+// AddRetentionMaxSizeBytes adds value to the "retention_max_size_bytes" field.
+func (_u *ChannelUpdateOne) AddRetentionMaxSizeBytes(v int64) *ChannelUpdateOne {
+	_u.mutation.AddRetentionMaxSizeBytes(v)
+	return _u
+}
+
+// SetRetentionVideoTypes sets the "retention_video_types" field.
+func (_u *ChannelUpdateOne) SetRetentionVideoTypes(v []utils.VodType) *ChannelUpdateOne {
+	_u.mutation.SetRetentionVideoTypes(v)
+	return _u
+}
+
+// AppendRetentionVideoTypes appends value to the "retention_video_types" field.
+func (_u *ChannelUpdateOne) AppendRetentionVideoTypes(v []utils.VodType) *ChannelUpdateOne {
+	_u.mutation.AppendRetentionVideoTypes(v)
+	return _u
+}
+
+// ClearRetentionVideoTypes clears the value of the "retention_video_types" field.
+func (_u *ChannelUpdateOne) ClearRetentionVideoTypes() *ChannelUpdateOne {
+	_u.mutation.ClearRetentionVideoTypes()
+	return _u
+}
+
+// SetRetentionExemptPlaylists sets the "retention_exempt_playlists" field.
+func (_u *ChannelUpdateOne) SetRetentionExemptPlaylists(v bool) *ChannelUpdateOne {
+	_u.mutation.SetRetentionExemptPlaylists(v)
+	return _u
+}
+
+// SetNillableRetentionExemptPlaylists sets the "retention_exempt_playlists" field if the given value is not nil.
+func (_u *ChannelUpdateOne) SetNillableRetentionExemptPlaylists(v *bool) *ChannelUpdateOne {
+	if v != nil {
+		_u.SetRetentionExemptPlaylists(*v)
+	}
+	return _u
+}
+
+// SetRetentionExemptMinViews sets the "retention_exempt_min_views" field.
+func (_u *ChannelUpdateOne) SetRetentionExemptMinViews(v int) *ChannelUpdateOne {
+	_u.mutation.ResetRetentionExemptMinViews()
+	_u.mutation.SetRetentionExemptMinViews(v)
+	return _u
+}
+
+// SetNillableRetentionExemptMinViews sets the "retention_exempt_min_views" field if the given value is not nil.
+func (_u *ChannelUpdateOne) SetNillableRetentionExemptMinViews(v *int) *ChannelUpdateOne {
+	if v != nil {
+		_u.SetRetentionExemptMinViews(*v)
+	}
+	return _u
+}
+
+// AddRetentionExemptMinViews adds value to the "retention_exempt_min_views" field.
+func (_u *ChannelUpdateOne) AddRetentionExemptMinViews(v int) *ChannelUpdateOne {
+	_u.mutation.AddRetentionExemptMinViews(v)
+	return _u
+}
+
 // SetStorageSizeBytes sets the "storage_size_bytes" field.
 func (_u *ChannelUpdateOne) SetStorageSizeBytes(v int64) *ChannelUpdateOne {
 	_u.mutation.ResetStorageSizeBytes()
@@ -685,7 +968,35 @@ func (_u *ChannelUpdateOne) defaults() {
 	}
 }
 
+// check runs all checks and user-defined validators on the builder.
+func (_u *ChannelUpdateOne) check() error {
+	if v, ok := _u.mutation.Platform(); ok {
+		if err := channel.PlatformValidator(v); err != nil {
+			return &ValidationError{Name: "platform", err: fmt.Errorf(`ent: validator failed for field "Channel.platform": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.RetentionKeepNewest(); ok {
+		if err := channel.RetentionKeepNewestValidator(v); err != nil {
+			return &ValidationError{Name: "retention_keep_newest", err: fmt.Errorf(`ent: validator failed for field "Channel.retention_keep_newest": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.RetentionMaxSizeBytes(); ok {
+		if err := channel.RetentionMaxSizeBytesValidator(v); err != nil {
+			return &ValidationError{Name: "retention_max_size_bytes", err: fmt.Errorf(`ent: validator failed for field "Channel.retention_max_size_bytes": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.RetentionExemptMinViews(); ok {
+		if err := channel.RetentionExemptMinViewsValidator(v); err != nil {
+			return &ValidationError{Name: "retention_exempt_min_views", err: fmt.Errorf(`ent: validator failed for field "Channel.retention_exempt_min_views": %w`, err)}
+		}
+	}
+	return nil
+}
+
 func (_u *ChannelUpdateOne) sqlSave(ctx context.Context) (_node *Channel, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
 	_spec := sqlgraph.NewUpdateSpec(channel.Table, channel.Columns, sqlgraph.NewFieldSpec(channel.FieldID, field.TypeUUID))
 	id, ok := _u.mutation.ID()
 	if !ok {
@@ -726,6 +1037,9 @@ func (_u *ChannelUpdateOne) sqlSave(ctx context.Context) (_node *Channel, err er
 	if value, ok := _u.mutation.ImagePath(); ok {
 		_spec.SetField(channel.FieldImagePath, field.TypeString, value)
 	}
+	if value, ok := _u.mutation.Platform(); ok {
+		_spec.SetField(channel.FieldPlatform, field.TypeEnum, value)
+	}
 	if value, ok := _u.mutation.Retention(); ok {
 		_spec.SetField(channel.FieldRetention, field.TypeBool, value)
 	}
@@ -738,6 +1052,38 @@ func (_u *ChannelUpdateOne) sqlSave(ctx context.Context) (_node *Channel, err er
 	if _u.mutation.RetentionDaysCleared() {
 		_spec.ClearField(channel.FieldRetentionDays, field.TypeInt64)
 	}
+	if value, ok := _u.mutation.RetentionKeepNewest(); ok {
+		_spec.SetField(channel.FieldRetentionKeepNewest, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedRetentionKeepNewest(); ok {
+		_spec.AddField(channel.FieldRetentionKeepNewest, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.RetentionMaxSizeBytes(); ok {
+		_spec.SetField(channel.FieldRetentionMaxSizeBytes, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedRetentionMaxSizeBytes(); ok {
+		_spec.AddField(channel.FieldRetentionMaxSizeBytes, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.RetentionVideoTypes(); ok {
+		_spec.SetField(channel.FieldRetentionVideoTypes, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedRetentionVideoTypes(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, channel.FieldRetentionVideoTypes, value)
+		})
+	}
+	if _u.mutation.RetentionVideoTypesCleared() {
+		_spec.ClearField(channel.FieldRetentionVideoTypes, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.RetentionExemptPlaylists(); ok {
+		_spec.SetField(channel.FieldRetentionExemptPlaylists, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.RetentionExemptMinViews(); ok {
+		_spec.SetField(channel.FieldRetentionExemptMinViews, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedRetentionExemptMinViews(); ok {
+		_spec.AddField(channel.FieldRetentionExemptMinViews, field.TypeInt, value)
+	}
 	if value, ok := _u.mutation.StorageSizeBytes(); ok {
 		_spec.SetField(channel.FieldStorageSizeBytes, field.TypeInt64, value)
 	}
@@ -0,0 +1,231 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/zibbp/ganymede/ent/chatmessage"
+	"github.com/zibbp/ganymede/ent/vod"
+)
+
+// ChatMessage is the model entity for the ChatMessage schema.
+type ChatMessage struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// The ID of the video the message belongs to.
+	VodID uuid.UUID `json:"vod_id,omitempty"`
+	// The ID of the message on the external platform.
+	ExtID string `json:"ext_id,omitempty"`
+	// The offset of the message from the start of the video in seconds.
+	ContentOffsetSeconds float64 `json:"content_offset_seconds,omitempty"`
+	// The ID of the chatter on the external platform.
+	CommenterID string `json:"commenter_id,omitempty"`
+	// The login name of the chatter.
+	CommenterName string `json:"commenter_name,omitempty"`
+	// The display name of the chatter.
+	CommenterDisplayName string `json:"commenter_display_name,omitempty"`
+	// The text of the message. Emotes are included by name.
+	Body string `json:"body,omitempty"`
+	// The names of the emotes used in the message.
+	Emotes []string `json:"emotes,omitempty"`
+	// Time the message was sent.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the ChatMessageQuery when eager-loading is set.
+	Edges        ChatMessageEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// ChatMessageEdges holds the relations/edges for other nodes in the graph.
+type ChatMessageEdges struct {
+	// Vod holds the value of the vod edge.
+	Vod *Vod `json:"vod,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// VodOrErr returns the Vod value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ChatMessageEdges) VodOrErr() (*Vod, error) {
+	if e.Vod != nil {
+		return e.Vod, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: vod.Label}
+	}
+	return nil, &NotLoadedError{edge: "vod"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*ChatMessage) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case chatmessage.FieldEmotes:
+			values[i] = new([]byte)
+		case chatmessage.FieldContentOffsetSeconds:
+			values[i] = new(sql.NullFloat64)
+		case chatmessage.FieldExtID, chatmessage.FieldCommenterID, chatmessage.FieldCommenterName, chatmessage.FieldCommenterDisplayName, chatmessage.FieldBody:
+			values[i] = new(sql.NullString)
+		case chatmessage.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		case chatmessage.FieldID, chatmessage.FieldVodID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the ChatMessage fields.
+func (_m *ChatMessage) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case chatmessage.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case chatmessage.FieldVodID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field vod_id", values[i])
+			} else if value != nil {
+				_m.VodID = *value
+			}
+		case chatmessage.FieldExtID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field ext_id", values[i])
+			} else if value.Valid {
+				_m.ExtID = value.String
+			}
+		case chatmessage.FieldContentOffsetSeconds:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field content_offset_seconds", values[i])
+			} else if value.Valid {
+				_m.ContentOffsetSeconds = value.Float64
+			}
+		case chatmessage.FieldCommenterID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field commenter_id", values[i])
+			} else if value.Valid {
+				_m.CommenterID = value.String
+			}
+		case chatmessage.FieldCommenterName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field commenter_name", values[i])
+			} else if value.Valid {
+				_m.CommenterName = value.String
+			}
+		case chatmessage.FieldCommenterDisplayName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field commenter_display_name", values[i])
+			} else if value.Valid {
+				_m.CommenterDisplayName = value.String
+			}
+		case chatmessage.FieldBody:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field body", values[i])
+			} else if value.Valid {
+				_m.Body = value.String
+			}
+		case chatmessage.FieldEmotes:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field emotes", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.Emotes); err != nil {
+					return fmt.Errorf("unmarshal field emotes: %w", err)
+				}
+			}
+		case chatmessage.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the ChatMessage.
+// This includes values selected through modifiers, order, etc.
+func (_m *ChatMessage) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryVod queries the "vod" edge of the ChatMessage entity.
+func (_m *ChatMessage) QueryVod() *VodQuery {
+	return NewChatMessageClient(_m.config).QueryVod(_m)
+}
+
+// Update returns a builder for updating this ChatMessage.
+// Note that you need to call ChatMessage.Unwrap() before calling this method if this ChatMessage
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *ChatMessage) Update() *ChatMessageUpdateOne {
+	return NewChatMessageClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the ChatMessage entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *ChatMessage) Unwrap() *ChatMessage {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: ChatMessage is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *ChatMessage) String() string {
+	var builder strings.Builder
+	builder.WriteString("ChatMessage(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("vod_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.VodID))
+	builder.WriteString(", ")
+	builder.WriteString("ext_id=")
+	builder.WriteString(_m.ExtID)
+	builder.WriteString(", ")
+	builder.WriteString("content_offset_seconds=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ContentOffsetSeconds))
+	builder.WriteString(", ")
+	builder.WriteString("commenter_id=")
+	builder.WriteString(_m.CommenterID)
+	builder.WriteString(", ")
+	builder.WriteString("commenter_name=")
+	builder.WriteString(_m.CommenterName)
+	builder.WriteString(", ")
+	builder.WriteString("commenter_display_name=")
+	builder.WriteString(_m.CommenterDisplayName)
+	builder.WriteString(", ")
+	builder.WriteString("body=")
+	builder.WriteString(_m.Body)
+	builder.WriteString(", ")
+	builder.WriteString("emotes=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Emotes))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// ChatMessages is a parsable slice of ChatMessage.
+type ChatMessages []*ChatMessage
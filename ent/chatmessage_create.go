@@ -0,0 +1,1104 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/zibbp/ganymede/ent/chatmessage"
+	"github.com/zibbp/ganymede/ent/vod"
+)
+
+// ChatMessageCreate is the builder for creating a ChatMessage entity.
+type ChatMessageCreate struct {
+	config
+	mutation *ChatMessageMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetVodID sets the "vod_id" field.
+func (_c *ChatMessageCreate) SetVodID(v uuid.UUID) *ChatMessageCreate {
+	_c.mutation.SetVodID(v)
+	return _c
+}
+
+// SetExtID sets the "ext_id" field.
+func (_c *ChatMessageCreate) SetExtID(v string) *ChatMessageCreate {
+	_c.mutation.SetExtID(v)
+	return _c
+}
+
+// SetNillableExtID sets the "ext_id" field if the given value is not nil.
+func (_c *ChatMessageCreate) SetNillableExtID(v *string) *ChatMessageCreate {
+	if v != nil {
+		_c.SetExtID(*v)
+	}
+	return _c
+}
+
+// SetContentOffsetSeconds sets the "content_offset_seconds" field.
+func (_c *ChatMessageCreate) SetContentOffsetSeconds(v float64) *ChatMessageCreate {
+	_c.mutation.SetContentOffsetSeconds(v)
+	return _c
+}
+
+// SetCommenterID sets the "commenter_id" field.
+func (_c *ChatMessageCreate) SetCommenterID(v string) *ChatMessageCreate {
+	_c.mutation.SetCommenterID(v)
+	return _c
+}
+
+// SetNillableCommenterID sets the "commenter_id" field if the given value is not nil.
+func (_c *ChatMessageCreate) SetNillableCommenterID(v *string) *ChatMessageCreate {
+	if v != nil {
+		_c.SetCommenterID(*v)
+	}
+	return _c
+}
+
+// SetCommenterName sets the "commenter_name" field.
+func (_c *ChatMessageCreate) SetCommenterName(v string) *ChatMessageCreate {
+	_c.mutation.SetCommenterName(v)
+	return _c
+}
+
+// SetNillableCommenterName sets the "commenter_name" field if the given value is not nil.
+func (_c *ChatMessageCreate) SetNillableCommenterName(v *string) *ChatMessageCreate {
+	if v != nil {
+		_c.SetCommenterName(*v)
+	}
+	return _c
+}
+
+// SetCommenterDisplayName sets the "commenter_display_name" field.
+func (_c *ChatMessageCreate) SetCommenterDisplayName(v string) *ChatMessageCreate {
+	_c.mutation.SetCommenterDisplayName(v)
+	return _c
+}
+
+// SetNillableCommenterDisplayName sets the "commenter_display_name" field if the given value is not nil.
+func (_c *ChatMessageCreate) SetNillableCommenterDisplayName(v *string) *ChatMessageCreate {
+	if v != nil {
+		_c.SetCommenterDisplayName(*v)
+	}
+	return _c
+}
+
+// SetBody sets the "body" field.
+func (_c *ChatMessageCreate) SetBody(v string) *ChatMessageCreate {
+	_c.mutation.SetBody(v)
+	return _c
+}
+
+// SetEmotes sets the "emotes" field.
+func (_c *ChatMessageCreate) SetEmotes(v []string) *ChatMessageCreate {
+	_c.mutation.SetEmotes(v)
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *ChatMessageCreate) SetCreatedAt(v time.Time) *ChatMessageCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *ChatMessageCreate) SetNillableCreatedAt(v *time.Time) *ChatMessageCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *ChatMessageCreate) SetID(v uuid.UUID) *ChatMessageCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *ChatMessageCreate) SetNillableID(v *uuid.UUID) *ChatMessageCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// SetVod sets the "vod" edge to the Vod entity.
+func (_c *ChatMessageCreate) SetVod(v *Vod) *ChatMessageCreate {
+	return _c.SetVodID(v.ID)
+}
+
+// Mutation returns the ChatMessageMutation object of the builder.
+func (_c *ChatMessageCreate) Mutation() *ChatMessageMutation {
+	return _c.mutation
+}
+
+// Save creates the ChatMessage in the database.
+func (_c *ChatMessageCreate) Save(ctx context.Context) (*ChatMessage, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *ChatMessageCreate) SaveX(ctx context.Context) *ChatMessage {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ChatMessageCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ChatMessageCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *ChatMessageCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := chatmessage.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := chatmessage.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *ChatMessageCreate) check() error {
+	if _, ok := _c.mutation.VodID(); !ok {
+		return &ValidationError{Name: "vod_id", err: errors.New(`ent: missing required field "ChatMessage.vod_id"`)}
+	}
+	if _, ok := _c.mutation.ContentOffsetSeconds(); !ok {
+		return &ValidationError{Name: "content_offset_seconds", err: errors.New(`ent: missing required field "ChatMessage.content_offset_seconds"`)}
+	}
+	if _, ok := _c.mutation.Body(); !ok {
+		return &ValidationError{Name: "body", err: errors.New(`ent: missing required field "ChatMessage.body"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "ChatMessage.created_at"`)}
+	}
+	if len(_c.mutation.VodIDs()) == 0 {
+		return &ValidationError{Name: "vod", err: errors.New(`ent: missing required edge "ChatMessage.vod"`)}
+	}
+	return nil
+}
+
+func (_c *ChatMessageCreate) sqlSave(ctx context.Context) (*ChatMessage, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *ChatMessageCreate) createSpec() (*ChatMessage, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ChatMessage{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(chatmessage.Table, sqlgraph.NewFieldSpec(chatmessage.FieldID, field.TypeUUID))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.ExtID(); ok {
+		_spec.SetField(chatmessage.FieldExtID, field.TypeString, value)
+		_node.ExtID = value
+	}
+	if value, ok := _c.mutation.ContentOffsetSeconds(); ok {
+		_spec.SetField(chatmessage.FieldContentOffsetSeconds, field.TypeFloat64, value)
+		_node.ContentOffsetSeconds = value
+	}
+	if value, ok := _c.mutation.CommenterID(); ok {
+		_spec.SetField(chatmessage.FieldCommenterID, field.TypeString, value)
+		_node.CommenterID = value
+	}
+	if value, ok := _c.mutation.CommenterName(); ok {
+		_spec.SetField(chatmessage.FieldCommenterName, field.TypeString, value)
+		_node.CommenterName = value
+	}
+	if value, ok := _c.mutation.CommenterDisplayName(); ok {
+		_spec.SetField(chatmessage.FieldCommenterDisplayName, field.TypeString, value)
+		_node.CommenterDisplayName = value
+	}
+	if value, ok := _c.mutation.Body(); ok {
+		_spec.SetField(chatmessage.FieldBody, field.TypeString, value)
+		_node.Body = value
+	}
+	if value, ok := _c.mutation.Emotes(); ok {
+		_spec.SetField(chatmessage.FieldEmotes, field.TypeJSON, value)
+		_node.Emotes = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(chatmessage.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := _c.mutation.VodIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   chatmessage.VodTable,
+			Columns: []string{chatmessage.VodColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(vod.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.VodID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.ChatMessage.Create().
+//		SetVodID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ChatMessageUpsert) {
+//			SetVodID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *ChatMessageCreate) OnConflict(opts ...sql.ConflictOption) *ChatMessageUpsertOne {
+	_c.conflict = opts
+	return &ChatMessageUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.ChatMessage.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *ChatMessageCreate) OnConflictColumns(columns ...string) *ChatMessageUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &ChatMessageUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// ChatMessageUpsertOne is the builder for "upsert"-ing
+	//  one ChatMessage node.
+	ChatMessageUpsertOne struct {
+		create *ChatMessageCreate
+	}
+
+	// ChatMessageUpsert is the "OnConflict" setter.
+	ChatMessageUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetVodID sets the "vod_id" field.
+func (u *ChatMessageUpsert) SetVodID(v uuid.UUID) *ChatMessageUpsert {
+	u.Set(chatmessage.FieldVodID, v)
+	return u
+}
+
+// UpdateVodID sets the "vod_id" field to the value that was provided on create.
+func (u *ChatMessageUpsert) UpdateVodID() *ChatMessageUpsert {
+	u.SetExcluded(chatmessage.FieldVodID)
+	return u
+}
+
+// SetExtID sets the "ext_id" field.
+func (u *ChatMessageUpsert) SetExtID(v string) *ChatMessageUpsert {
+	u.Set(chatmessage.FieldExtID, v)
+	return u
+}
+
+// UpdateExtID sets the "ext_id" field to the value that was provided on create.
+func (u *ChatMessageUpsert) UpdateExtID() *ChatMessageUpsert {
+	u.SetExcluded(chatmessage.FieldExtID)
+	return u
+}
+
+// ClearExtID clears the value of the "ext_id" field.
+func (u *ChatMessageUpsert) ClearExtID() *ChatMessageUpsert {
+	u.SetNull(chatmessage.FieldExtID)
+	return u
+}
+
+// SetContentOffsetSeconds sets the "content_offset_seconds" field.
+func (u *ChatMessageUpsert) SetContentOffsetSeconds(v float64) *ChatMessageUpsert {
+	u.Set(chatmessage.FieldContentOffsetSeconds, v)
+	return u
+}
+
+// UpdateContentOffsetSeconds sets the "content_offset_seconds" field to the value that was provided on create.
+func (u *ChatMessageUpsert) UpdateContentOffsetSeconds() *ChatMessageUpsert {
+	u.SetExcluded(chatmessage.FieldContentOffsetSeconds)
+	return u
+}
+
+// AddContentOffsetSeconds adds v to the "content_offset_seconds" field.
+func (u *ChatMessageUpsert) AddContentOffsetSeconds(v float64) *ChatMessageUpsert {
+	u.Add(chatmessage.FieldContentOffsetSeconds, v)
+	return u
+}
+
+// SetCommenterID sets the "commenter_id" field.
+func (u *ChatMessageUpsert) SetCommenterID(v string) *ChatMessageUpsert {
+	u.Set(chatmessage.FieldCommenterID, v)
+	return u
+}
+
+// UpdateCommenterID sets the "commenter_id" field to the value that was provided on create.
+func (u *ChatMessageUpsert) UpdateCommenterID() *ChatMessageUpsert {
+	u.SetExcluded(chatmessage.FieldCommenterID)
+	return u
+}
+
+// ClearCommenterID clears the value of the "commenter_id" field.
+func (u *ChatMessageUpsert) ClearCommenterID() *ChatMessageUpsert {
+	u.SetNull(chatmessage.FieldCommenterID)
+	return u
+}
+
+// SetCommenterName sets the "commenter_name" field.
+func (u *ChatMessageUpsert) SetCommenterName(v string) *ChatMessageUpsert {
+	u.Set(chatmessage.FieldCommenterName, v)
+	return u
+}
+
+// UpdateCommenterName sets the "commenter_name" field to the value that was provided on create.
+func (u *ChatMessageUpsert) UpdateCommenterName() *ChatMessageUpsert {
+	u.SetExcluded(chatmessage.FieldCommenterName)
+	return u
+}
+
+// ClearCommenterName clears the value of the "commenter_name" field.
+func (u *ChatMessageUpsert) ClearCommenterName() *ChatMessageUpsert {
+	u.SetNull(chatmessage.FieldCommenterName)
+	return u
+}
+
+// SetCommenterDisplayName sets the "commenter_display_name" field.
+func (u *ChatMessageUpsert) SetCommenterDisplayName(v string) *ChatMessageUpsert {
+	u.Set(chatmessage.FieldCommenterDisplayName, v)
+	return u
+}
+
+// UpdateCommenterDisplayName sets the "commenter_display_name" field to the value that was provided on create.
+func (u *ChatMessageUpsert) UpdateCommenterDisplayName() *ChatMessageUpsert {
+	u.SetExcluded(chatmessage.FieldCommenterDisplayName)
+	return u
+}
+
+// ClearCommenterDisplayName clears the value of the "commenter_display_name" field.
+func (u *ChatMessageUpsert) ClearCommenterDisplayName() *ChatMessageUpsert {
+	u.SetNull(chatmessage.FieldCommenterDisplayName)
+	return u
+}
+
+// SetBody sets the "body" field.
+func (u *ChatMessageUpsert) SetBody(v string) *ChatMessageUpsert {
+	u.Set(chatmessage.FieldBody, v)
+	return u
+}
+
+// UpdateBody sets the "body" field to the value that was provided on create.
+func (u *ChatMessageUpsert) UpdateBody() *ChatMessageUpsert {
+	u.SetExcluded(chatmessage.FieldBody)
+	return u
+}
+
+// SetEmotes sets the "emotes" field.
+func (u *ChatMessageUpsert) SetEmotes(v []string) *ChatMessageUpsert {
+	u.Set(chatmessage.FieldEmotes, v)
+	return u
+}
+
+// UpdateEmotes sets the "emotes" field to the value that was provided on create.
+func (u *ChatMessageUpsert) UpdateEmotes() *ChatMessageUpsert {
+	u.SetExcluded(chatmessage.FieldEmotes)
+	return u
+}
+
+// ClearEmotes clears the value of the "emotes" field.
+func (u *ChatMessageUpsert) ClearEmotes() *ChatMessageUpsert {
+	u.SetNull(chatmessage.FieldEmotes)
+	return u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (u *ChatMessageUpsert) SetCreatedAt(v time.Time) *ChatMessageUpsert {
+	u.Set(chatmessage.FieldCreatedAt, v)
+	return u
+}
+
+// UpdateCreatedAt sets the "created_at" field to the value that was provided on create.
+func (u *ChatMessageUpsert) UpdateCreatedAt() *ChatMessageUpsert {
+	u.SetExcluded(chatmessage.FieldCreatedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.ChatMessage.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(chatmessage.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *ChatMessageUpsertOne) UpdateNewValues() *ChatMessageUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(chatmessage.FieldID)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.ChatMessage.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *ChatMessageUpsertOne) Ignore() *ChatMessageUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ChatMessageUpsertOne) DoNothing() *ChatMessageUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ChatMessageCreate.OnConflict
+// documentation for more info.
+func (u *ChatMessageUpsertOne) Update(set func(*ChatMessageUpsert)) *ChatMessageUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ChatMessageUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetVodID sets the "vod_id" field.
+func (u *ChatMessageUpsertOne) SetVodID(v uuid.UUID) *ChatMessageUpsertOne {
+	return u.Update(func(s *ChatMessageUpsert) {
+		s.SetVodID(v)
+	})
+}
+
+// UpdateVodID sets the "vod_id" field to the value that was provided on create.
+func (u *ChatMessageUpsertOne) UpdateVodID() *ChatMessageUpsertOne {
+	return u.Update(func(s *ChatMessageUpsert) {
+		s.UpdateVodID()
+	})
+}
+
+// SetExtID sets the "ext_id" field.
+func (u *ChatMessageUpsertOne) SetExtID(v string) *ChatMessageUpsertOne {
+	return u.Update(func(s *ChatMessageUpsert) {
+		s.SetExtID(v)
+	})
+}
+
+// UpdateExtID sets the "ext_id" field to the value that was provided on create.
+func (u *ChatMessageUpsertOne) UpdateExtID() *ChatMessageUpsertOne {
+	return u.Update(func(s *ChatMessageUpsert) {
+		s.UpdateExtID()
+	})
+}
+
+// ClearExtID clears the value of the "ext_id" field.
+func (u *ChatMessageUpsertOne) ClearExtID() *ChatMessageUpsertOne {
+	return u.Update(func(s *ChatMessageUpsert) {
+		s.ClearExtID()
+	})
+}
+
+// SetContentOffsetSeconds sets the "content_offset_seconds" field.
+func (u *ChatMessageUpsertOne) SetContentOffsetSeconds(v float64) *ChatMessageUpsertOne {
+	return u.Update(func(s *ChatMessageUpsert) {
+		s.SetContentOffsetSeconds(v)
+	})
+}
+
+// AddContentOffsetSeconds adds v to the "content_offset_seconds" field.
+func (u *ChatMessageUpsertOne) AddContentOffsetSeconds(v float64) *ChatMessageUpsertOne {
+	return u.Update(func(s *ChatMessageUpsert) {
+		s.AddContentOffsetSeconds(v)
+	})
+}
+
+// UpdateContentOffsetSeconds sets the "content_offset_seconds" field to the value that was provided on create.
+func (u *ChatMessageUpsertOne) UpdateContentOffsetSeconds() *ChatMessageUpsertOne {
+	return u.Update(func(s *ChatMessageUpsert) {
+		s.UpdateContentOffsetSeconds()
+	})
+}
+
+// SetCommenterID sets the "commenter_id" field.
+func (u *ChatMessageUpsertOne) SetCommenterID(v string) *ChatMessageUpsertOne {
+	return u.Update(func(s *ChatMessageUpsert) {
+		s.SetCommenterID(v)
+	})
+}
+
+// UpdateCommenterID sets the "commenter_id" field to the value that was provided on create.
+func (u *ChatMessageUpsertOne) UpdateCommenterID() *ChatMessageUpsertOne {
+	return u.Update(func(s *ChatMessageUpsert) {
+		s.UpdateCommenterID()
+	})
+}
+
+// ClearCommenterID clears the value of the "commenter_id" field.
+func (u *ChatMessageUpsertOne) ClearCommenterID() *ChatMessageUpsertOne {
+	return u.Update(func(s *ChatMessageUpsert) {
+		s.ClearCommenterID()
+	})
+}
+
+// SetCommenterName sets the "commenter_name" field.
+func (u *ChatMessageUpsertOne) SetCommenterName(v string) *ChatMessageUpsertOne {
+	return u.Update(func(s *ChatMessageUpsert) {
+		s.SetCommenterName(v)
+	})
+}
+
+// UpdateCommenterName sets the "commenter_name" field to the value that was provided on create.
+func (u *ChatMessageUpsertOne) UpdateCommenterName() *ChatMessageUpsertOne {
+	return u.Update(func(s *ChatMessageUpsert) {
+		s.UpdateCommenterName()
+	})
+}
+
+// ClearCommenterName clears the value of the "commenter_name" field.
+func (u *ChatMessageUpsertOne) ClearCommenterName() *ChatMessageUpsertOne {
+	return u.Update(func(s *ChatMessageUpsert) {
+		s.ClearCommenterName()
+	})
+}
+
+// SetCommenterDisplayName sets the "commenter_display_name" field.
+func (u *ChatMessageUpsertOne) SetCommenterDisplayName(v string) *ChatMessageUpsertOne {
+	return u.Update(func(s *ChatMessageUpsert) {
+		s.SetCommenterDisplayName(v)
+	})
+}
+
+// UpdateCommenterDisplayName sets the "commenter_display_name" field to the value that was provided on create.
+func (u *ChatMessageUpsertOne) UpdateCommenterDisplayName() *ChatMessageUpsertOne {
+	return u.Update(func(s *ChatMessageUpsert) {
+		s.UpdateCommenterDisplayName()
+	})
+}
+
+// ClearCommenterDisplayName clears the value of the "commenter_display_name" field.
+func (u *ChatMessageUpsertOne) ClearCommenterDisplayName() *ChatMessageUpsertOne {
+	return u.Update(func(s *ChatMessageUpsert) {
+		s.ClearCommenterDisplayName()
+	})
+}
+
+// SetBody sets the "body" field.
+func (u *ChatMessageUpsertOne) SetBody(v string) *ChatMessageUpsertOne {
+	return u.Update(func(s *ChatMessageUpsert) {
+		s.SetBody(v)
+	})
+}
+
+// UpdateBody sets the "body" field to the value that was provided on create.
+func (u *ChatMessageUpsertOne) UpdateBody() *ChatMessageUpsertOne {
+	return u.Update(func(s *ChatMessageUpsert) {
+		s.UpdateBody()
+	})
+}
+
+// SetEmotes sets the "emotes" field.
+func (u *ChatMessageUpsertOne) SetEmotes(v []string) *ChatMessageUpsertOne {
+	return u.Update(func(s *ChatMessageUpsert) {
+		s.SetEmotes(v)
+	})
+}
+
+// UpdateEmotes sets the "emotes" field to the value that was provided on create.
+func (u *ChatMessageUpsertOne) UpdateEmotes() *ChatMessageUpsertOne {
+	return u.Update(func(s *ChatMessageUpsert) {
+		s.UpdateEmotes()
+	})
+}
+
+// ClearEmotes clears the value of the "emotes" field.
+func (u *ChatMessageUpsertOne) ClearEmotes() *ChatMessageUpsertOne {
+	return u.Update(func(s *ChatMessageUpsert) {
+		s.ClearEmotes()
+	})
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (u *ChatMessageUpsertOne) SetCreatedAt(v time.Time) *ChatMessageUpsertOne {
+	return u.Update(func(s *ChatMessageUpsert) {
+		s.SetCreatedAt(v)
+	})
+}
+
+// UpdateCreatedAt sets the "created_at" field to the value that was provided on create.
+func (u *ChatMessageUpsertOne) UpdateCreatedAt() *ChatMessageUpsertOne {
+	return u.Update(func(s *ChatMessageUpsert) {
+		s.UpdateCreatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *ChatMessageUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ChatMessageCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ChatMessageUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *ChatMessageUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: ChatMessageUpsertOne.ID is not supported by MySQL driver. Use ChatMessageUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *ChatMessageUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// ChatMessageCreateBulk is the builder for creating many ChatMessage entities in bulk.
+type ChatMessageCreateBulk struct {
+	config
+	err      error
+	builders []*ChatMessageCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the ChatMessage entities in the database.
+func (_c *ChatMessageCreateBulk) Save(ctx context.Context) ([]*ChatMessage, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*ChatMessage, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ChatMessageMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *ChatMessageCreateBulk) SaveX(ctx context.Context) []*ChatMessage {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ChatMessageCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ChatMessageCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.ChatMessage.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ChatMessageUpsert) {
+//			SetVodID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *ChatMessageCreateBulk) OnConflict(opts ...sql.ConflictOption) *ChatMessageUpsertBulk {
+	_c.conflict = opts
+	return &ChatMessageUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.ChatMessage.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *ChatMessageCreateBulk) OnConflictColumns(columns ...string) *ChatMessageUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &ChatMessageUpsertBulk{
+		create: _c,
+	}
+}
+
+// ChatMessageUpsertBulk is the builder for "upsert"-ing
+// a bulk of ChatMessage nodes.
+type ChatMessageUpsertBulk struct {
+	create *ChatMessageCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.ChatMessage.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(chatmessage.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *ChatMessageUpsertBulk) UpdateNewValues() *ChatMessageUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(chatmessage.FieldID)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.ChatMessage.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *ChatMessageUpsertBulk) Ignore() *ChatMessageUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ChatMessageUpsertBulk) DoNothing() *ChatMessageUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ChatMessageCreateBulk.OnConflict
+// documentation for more info.
+func (u *ChatMessageUpsertBulk) Update(set func(*ChatMessageUpsert)) *ChatMessageUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ChatMessageUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetVodID sets the "vod_id" field.
+func (u *ChatMessageUpsertBulk) SetVodID(v uuid.UUID) *ChatMessageUpsertBulk {
+	return u.Update(func(s *ChatMessageUpsert) {
+		s.SetVodID(v)
+	})
+}
+
+// UpdateVodID sets the "vod_id" field to the value that was provided on create.
+func (u *ChatMessageUpsertBulk) UpdateVodID() *ChatMessageUpsertBulk {
+	return u.Update(func(s *ChatMessageUpsert) {
+		s.UpdateVodID()
+	})
+}
+
+// SetExtID sets the "ext_id" field.
+func (u *ChatMessageUpsertBulk) SetExtID(v string) *ChatMessageUpsertBulk {
+	return u.Update(func(s *ChatMessageUpsert) {
+		s.SetExtID(v)
+	})
+}
+
+// UpdateExtID sets the "ext_id" field to the value that was provided on create.
+func (u *ChatMessageUpsertBulk) UpdateExtID() *ChatMessageUpsertBulk {
+	return u.Update(func(s *ChatMessageUpsert) {
+		s.UpdateExtID()
+	})
+}
+
+// ClearExtID clears the value of the "ext_id" field.
+func (u *ChatMessageUpsertBulk) ClearExtID() *ChatMessageUpsertBulk {
+	return u.Update(func(s *ChatMessageUpsert) {
+		s.ClearExtID()
+	})
+}
+
+// SetContentOffsetSeconds sets the "content_offset_seconds" field.
+func (u *ChatMessageUpsertBulk) SetContentOffsetSeconds(v float64) *ChatMessageUpsertBulk {
+	return u.Update(func(s *ChatMessageUpsert) {
+		s.SetContentOffsetSeconds(v)
+	})
+}
+
+// AddContentOffsetSeconds adds v to the "content_offset_seconds" field.
+func (u *ChatMessageUpsertBulk) AddContentOffsetSeconds(v float64) *ChatMessageUpsertBulk {
+	return u.Update(func(s *ChatMessageUpsert) {
+		s.AddContentOffsetSeconds(v)
+	})
+}
+
+// UpdateContentOffsetSeconds sets the "content_offset_seconds" field to the value that was provided on create.
+func (u *ChatMessageUpsertBulk) UpdateContentOffsetSeconds() *ChatMessageUpsertBulk {
+	return u.Update(func(s *ChatMessageUpsert) {
+		s.UpdateContentOffsetSeconds()
+	})
+}
+
+// SetCommenterID sets the "commenter_id" field.
+func (u *ChatMessageUpsertBulk) SetCommenterID(v string) *ChatMessageUpsertBulk {
+	return u.Update(func(s *ChatMessageUpsert) {
+		s.SetCommenterID(v)
+	})
+}
+
+// UpdateCommenterID sets the "commenter_id" field to the value that was provided on create.
+func (u *ChatMessageUpsertBulk) UpdateCommenterID() *ChatMessageUpsertBulk {
+	return u.Update(func(s *ChatMessageUpsert) {
+		s.UpdateCommenterID()
+	})
+}
+
+// ClearCommenterID clears the value of the "commenter_id" field.
+func (u *ChatMessageUpsertBulk) ClearCommenterID() *ChatMessageUpsertBulk {
+	return u.Update(func(s *ChatMessageUpsert) {
+		s.ClearCommenterID()
+	})
+}
+
+// SetCommenterName sets the "commenter_name" field.
+func (u *ChatMessageUpsertBulk) SetCommenterName(v string) *ChatMessageUpsertBulk {
+	return u.Update(func(s *ChatMessageUpsert) {
+		s.SetCommenterName(v)
+	})
+}
+
+// UpdateCommenterName sets the "commenter_name" field to the value that was provided on create.
+func (u *ChatMessageUpsertBulk) UpdateCommenterName() *ChatMessageUpsertBulk {
+	return u.Update(func(s *ChatMessageUpsert) {
+		s.UpdateCommenterName()
+	})
+}
+
+// ClearCommenterName clears the value of the "commenter_name" field.
+func (u *ChatMessageUpsertBulk) ClearCommenterName() *ChatMessageUpsertBulk {
+	return u.Update(func(s *ChatMessageUpsert) {
+		s.ClearCommenterName()
+	})
+}
+
+// SetCommenterDisplayName sets the "commenter_display_name" field.
+func (u *ChatMessageUpsertBulk) SetCommenterDisplayName(v string) *ChatMessageUpsertBulk {
+	return u.Update(func(s *ChatMessageUpsert) {
+		s.SetCommenterDisplayName(v)
+	})
+}
+
+// UpdateCommenterDisplayName sets the "commenter_display_name" field to the value that was provided on create.
+func (u *ChatMessageUpsertBulk) UpdateCommenterDisplayName() *ChatMessageUpsertBulk {
+	return u.Update(func(s *ChatMessageUpsert) {
+		s.UpdateCommenterDisplayName()
+	})
+}
+
+// ClearCommenterDisplayName clears the value of the "commenter_display_name" field.
+func (u *ChatMessageUpsertBulk) ClearCommenterDisplayName() *ChatMessageUpsertBulk {
+	return u.Update(func(s *ChatMessageUpsert) {
+		s.ClearCommenterDisplayName()
+	})
+}
+
+// SetBody sets the "body" field.
+func (u *ChatMessageUpsertBulk) SetBody(v string) *ChatMessageUpsertBulk {
+	return u.Update(func(s *ChatMessageUpsert) {
+		s.SetBody(v)
+	})
+}
+
+// UpdateBody sets the "body" field to the value that was provided on create.
+func (u *ChatMessageUpsertBulk) UpdateBody() *ChatMessageUpsertBulk {
+	return u.Update(func(s *ChatMessageUpsert) {
+		s.UpdateBody()
+	})
+}
+
+// SetEmotes sets the "emotes" field.
+func (u *ChatMessageUpsertBulk) SetEmotes(v []string) *ChatMessageUpsertBulk {
+	return u.Update(func(s *ChatMessageUpsert) {
+		s.SetEmotes(v)
+	})
+}
+
+// UpdateEmotes sets the "emotes" field to the value that was provided on create.
+func (u *ChatMessageUpsertBulk) UpdateEmotes() *ChatMessageUpsertBulk {
+	return u.Update(func(s *ChatMessageUpsert) {
+		s.UpdateEmotes()
+	})
+}
+
+// ClearEmotes clears the value of the "emotes" field.
+func (u *ChatMessageUpsertBulk) ClearEmotes() *ChatMessageUpsertBulk {
+	return u.Update(func(s *ChatMessageUpsert) {
+		s.ClearEmotes()
+	})
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (u *ChatMessageUpsertBulk) SetCreatedAt(v time.Time) *ChatMessageUpsertBulk {
+	return u.Update(func(s *ChatMessageUpsert) {
+		s.SetCreatedAt(v)
+	})
+}
+
+// UpdateCreatedAt sets the "created_at" field to the value that was provided on create.
+func (u *ChatMessageUpsertBulk) UpdateCreatedAt() *ChatMessageUpsertBulk {
+	return u.Update(func(s *ChatMessageUpsert) {
+		s.UpdateCreatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *ChatMessageUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the ChatMessageCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ChatMessageCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ChatMessageUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,697 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/zibbp/ganymede/ent/chatmessage"
+	"github.com/zibbp/ganymede/ent/predicate"
+	"github.com/zibbp/ganymede/ent/vod"
+)
+
+// ChatMessageUpdate is the builder for updating ChatMessage entities.
+type ChatMessageUpdate struct {
+	config
+	hooks    []Hook
+	mutation *ChatMessageMutation
+}
+
+// Where appends a list predicates to the ChatMessageUpdate builder.
+func (_u *ChatMessageUpdate) Where(ps ...predicate.ChatMessage) *ChatMessageUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetVodID sets the "vod_id" field.
+func (_u *ChatMessageUpdate) SetVodID(v uuid.UUID) *ChatMessageUpdate {
+	_u.mutation.SetVodID(v)
+	return _u
+}
+
+// SetNillableVodID sets the "vod_id" field if the given value is not nil.
+func (_u *ChatMessageUpdate) SetNillableVodID(v *uuid.UUID) *ChatMessageUpdate {
+	if v != nil {
+		_u.SetVodID(*v)
+	}
+	return _u
+}
+
+// SetExtID sets the "ext_id" field.
+func (_u *ChatMessageUpdate) SetExtID(v string) *ChatMessageUpdate {
+	_u.mutation.SetExtID(v)
+	return _u
+}
+
+// SetNillableExtID sets the "ext_id" field if the given value is not nil.
+func (_u *ChatMessageUpdate) SetNillableExtID(v *string) *ChatMessageUpdate {
+	if v != nil {
+		_u.SetExtID(*v)
+	}
+	return _u
+}
+
+// ClearExtID clears the value of the "ext_id" field.
+func (_u *ChatMessageUpdate) ClearExtID() *ChatMessageUpdate {
+	_u.mutation.ClearExtID()
+	return _u
+}
+
+// SetContentOffsetSeconds sets the "content_offset_seconds" field.
+func (_u *ChatMessageUpdate) SetContentOffsetSeconds(v float64) *ChatMessageUpdate {
+	_u.mutation.ResetContentOffsetSeconds()
+	_u.mutation.SetContentOffsetSeconds(v)
+	return _u
+}
+
+// SetNillableContentOffsetSeconds sets the "content_offset_seconds" field if the given value is not nil.
+func (_u *ChatMessageUpdate) SetNillableContentOffsetSeconds(v *float64) *ChatMessageUpdate {
+	if v != nil {
+		_u.SetContentOffsetSeconds(*v)
+	}
+	return _u
+}
+
+// AddContentOffsetSeconds adds value to the "content_offset_seconds" field.
+func (_u *ChatMessageUpdate) AddContentOffsetSeconds(v float64) *ChatMessageUpdate {
+	_u.mutation.AddContentOffsetSeconds(v)
+	return _u
+}
+
+// SetCommenterID sets the "commenter_id" field.
+func (_u *ChatMessageUpdate) SetCommenterID(v string) *ChatMessageUpdate {
+	_u.mutation.SetCommenterID(v)
+	return _u
+}
+
+// SetNillableCommenterID sets the "commenter_id" field if the given value is not nil.
+func (_u *ChatMessageUpdate) SetNillableCommenterID(v *string) *ChatMessageUpdate {
+	if v != nil {
+		_u.SetCommenterID(*v)
+	}
+	return _u
+}
+
+// ClearCommenterID clears the value of the "commenter_id" field.
+func (_u *ChatMessageUpdate) ClearCommenterID() *ChatMessageUpdate {
+	_u.mutation.ClearCommenterID()
+	return _u
+}
+
+// SetCommenterName sets the "commenter_name" field.
+func (_u *ChatMessageUpdate) SetCommenterName(v string) *ChatMessageUpdate {
+	_u.mutation.SetCommenterName(v)
+	return _u
+}
+
+// SetNillableCommenterName sets the "commenter_name" field if the given value is not nil.
+func (_u *ChatMessageUpdate) SetNillableCommenterName(v *string) *ChatMessageUpdate {
+	if v != nil {
+		_u.SetCommenterName(*v)
+	}
+	return _u
+}
+
+// ClearCommenterName clears the value of the "commenter_name" field.
+func (_u *ChatMessageUpdate) ClearCommenterName() *ChatMessageUpdate {
+	_u.mutation.ClearCommenterName()
+	return _u
+}
+
+// SetCommenterDisplayName sets the "commenter_display_name" field.
+func (_u *ChatMessageUpdate) SetCommenterDisplayName(v string) *ChatMessageUpdate {
+	_u.mutation.SetCommenterDisplayName(v)
+	return _u
+}
+
+// SetNillableCommenterDisplayName sets the "commenter_display_name" field if the given value is not nil.
+func (_u *ChatMessageUpdate) SetNillableCommenterDisplayName(v *string) *ChatMessageUpdate {
+	if v != nil {
+		_u.SetCommenterDisplayName(*v)
+	}
+	return _u
+}
+
+// ClearCommenterDisplayName clears the value of the "commenter_display_name" field.
+func (_u *ChatMessageUpdate) ClearCommenterDisplayName() *ChatMessageUpdate {
+	_u.mutation.ClearCommenterDisplayName()
+	return _u
+}
+
+// SetBody sets the "body" field.
+func (_u *ChatMessageUpdate) SetBody(v string) *ChatMessageUpdate {
+	_u.mutation.SetBody(v)
+	return _u
+}
+
+// SetNillableBody sets the "body" field if the given value is not nil.
+func (_u *ChatMessageUpdate) SetNillableBody(v *string) *ChatMessageUpdate {
+	if v != nil {
+		_u.SetBody(*v)
+	}
+	return _u
+}
+
+// SetEmotes sets the "emotes" field.
+func (_u *ChatMessageUpdate) SetEmotes(v []string) *ChatMessageUpdate {
+	_u.mutation.SetEmotes(v)
+	return _u
+}
+
+// AppendEmotes appends value to the "emotes" field.
+func (_u *ChatMessageUpdate) AppendEmotes(v []string) *ChatMessageUpdate {
+	_u.mutation.AppendEmotes(v)
+	return _u
+}
+
+// ClearEmotes clears the value of the "emotes" field.
+func (_u *ChatMessageUpdate) ClearEmotes() *ChatMessageUpdate {
+	_u.mutation.ClearEmotes()
+	return _u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_u *ChatMessageUpdate) SetCreatedAt(v time.Time) *ChatMessageUpdate {
+	_u.mutation.SetCreatedAt(v)
+	return _u
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_u *ChatMessageUpdate) SetNillableCreatedAt(v *time.Time) *ChatMessageUpdate {
+	if v != nil {
+		_u.SetCreatedAt(*v)
+	}
+	return _u
+}
+
+// SetVod sets the "vod" edge to the Vod entity.
+func (_u *ChatMessageUpdate) SetVod(v *Vod) *ChatMessageUpdate {
+	return _u.SetVodID(v.ID)
+}
+
+// Mutation returns the ChatMessageMutation object of the builder.
+func (_u *ChatMessageUpdate) Mutation() *ChatMessageMutation {
+	return _u.mutation
+}
+
+// ClearVod clears the "vod" edge to the Vod entity.
+func (_u *ChatMessageUpdate) ClearVod() *ChatMessageUpdate {
+	_u.mutation.ClearVod()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *ChatMessageUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ChatMessageUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *ChatMessageUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ChatMessageUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *ChatMessageUpdate) check() error {
+	if _u.mutation.VodCleared() && len(_u.mutation.VodIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "ChatMessage.vod"`)
+	}
+	return nil
+}
+
+func (_u *ChatMessageUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(chatmessage.Table, chatmessage.Columns, sqlgraph.NewFieldSpec(chatmessage.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.ExtID(); ok {
+		_spec.SetField(chatmessage.FieldExtID, field.TypeString, value)
+	}
+	if _u.mutation.ExtIDCleared() {
+		_spec.ClearField(chatmessage.FieldExtID, field.TypeString)
+	}
+	if value, ok := _u.mutation.ContentOffsetSeconds(); ok {
+		_spec.SetField(chatmessage.FieldContentOffsetSeconds, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedContentOffsetSeconds(); ok {
+		_spec.AddField(chatmessage.FieldContentOffsetSeconds, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.CommenterID(); ok {
+		_spec.SetField(chatmessage.FieldCommenterID, field.TypeString, value)
+	}
+	if _u.mutation.CommenterIDCleared() {
+		_spec.ClearField(chatmessage.FieldCommenterID, field.TypeString)
+	}
+	if value, ok := _u.mutation.CommenterName(); ok {
+		_spec.SetField(chatmessage.FieldCommenterName, field.TypeString, value)
+	}
+	if _u.mutation.CommenterNameCleared() {
+		_spec.ClearField(chatmessage.FieldCommenterName, field.TypeString)
+	}
+	if value, ok := _u.mutation.CommenterDisplayName(); ok {
+		_spec.SetField(chatmessage.FieldCommenterDisplayName, field.TypeString, value)
+	}
+	if _u.mutation.CommenterDisplayNameCleared() {
+		_spec.ClearField(chatmessage.FieldCommenterDisplayName, field.TypeString)
+	}
+	if value, ok := _u.mutation.Body(); ok {
+		_spec.SetField(chatmessage.FieldBody, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Emotes(); ok {
+		_spec.SetField(chatmessage.FieldEmotes, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedEmotes(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, chatmessage.FieldEmotes, value)
+		})
+	}
+	if _u.mutation.EmotesCleared() {
+		_spec.ClearField(chatmessage.FieldEmotes, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.CreatedAt(); ok {
+		_spec.SetField(chatmessage.FieldCreatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.VodCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   chatmessage.VodTable,
+			Columns: []string{chatmessage.VodColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(vod.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.VodIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   chatmessage.VodTable,
+			Columns: []string{chatmessage.VodColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(vod.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{chatmessage.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// ChatMessageUpdateOne is the builder for updating a single ChatMessage entity.
+type ChatMessageUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *ChatMessageMutation
+}
+
+// SetVodID sets the "vod_id" field.
+func (_u *ChatMessageUpdateOne) SetVodID(v uuid.UUID) *ChatMessageUpdateOne {
+	_u.mutation.SetVodID(v)
+	return _u
+}
+
+// SetNillableVodID sets the "vod_id" field if the given value is not nil.
+func (_u *ChatMessageUpdateOne) SetNillableVodID(v *uuid.UUID) *ChatMessageUpdateOne {
+	if v != nil {
+		_u.SetVodID(*v)
+	}
+	return _u
+}
+
+// SetExtID sets the "ext_id" field.
+func (_u *ChatMessageUpdateOne) SetExtID(v string) *ChatMessageUpdateOne {
+	_u.mutation.SetExtID(v)
+	return _u
+}
+
+// SetNillableExtID sets the "ext_id" field if the given value is not nil.
+func (_u *ChatMessageUpdateOne) SetNillableExtID(v *string) *ChatMessageUpdateOne {
+	if v != nil {
+		_u.SetExtID(*v)
+	}
+	return _u
+}
+
+// ClearExtID clears the value of the "ext_id" field.
+func (_u *ChatMessageUpdateOne) ClearExtID() *ChatMessageUpdateOne {
+	_u.mutation.ClearExtID()
+	return _u
+}
+
+// SetContentOffsetSeconds sets the "content_offset_seconds" field.
+func (_u *ChatMessageUpdateOne) SetContentOffsetSeconds(v float64) *ChatMessageUpdateOne {
+	_u.mutation.ResetContentOffsetSeconds()
+	_u.mutation.SetContentOffsetSeconds(v)
+	return _u
+}
+
+// SetNillableContentOffsetSeconds sets the "content_offset_seconds" field if the given value is not nil.
+func (_u *ChatMessageUpdateOne) SetNillableContentOffsetSeconds(v *float64) *ChatMessageUpdateOne {
+	if v != nil {
+		_u.SetContentOffsetSeconds(*v)
+	}
+	return _u
+}
+
+// AddContentOffsetSeconds adds value to the "content_offset_seconds" field.
+func (_u *ChatMessageUpdateOne) AddContentOffsetSeconds(v float64) *ChatMessageUpdateOne {
+	_u.mutation.AddContentOffsetSeconds(v)
+	return _u
+}
+
+// SetCommenterID sets the "commenter_id" field.
+func (_u *ChatMessageUpdateOne) SetCommenterID(v string) *ChatMessageUpdateOne {
+	_u.mutation.SetCommenterID(v)
+	return _u
+}
+
+// SetNillableCommenterID sets the "commenter_id" field if the given value is not nil.
+func (_u *ChatMessageUpdateOne) SetNillableCommenterID(v *string) *ChatMessageUpdateOne {
+	if v != nil {
+		_u.SetCommenterID(*v)
+	}
+	return _u
+}
+
+// ClearCommenterID clears the value of the "commenter_id" field.
+func (_u *ChatMessageUpdateOne) ClearCommenterID() *ChatMessageUpdateOne {
+	_u.mutation.ClearCommenterID()
+	return _u
+}
+
+// SetCommenterName sets the "commenter_name" field.
+func (_u *ChatMessageUpdateOne) SetCommenterName(v string) *ChatMessageUpdateOne {
+	_u.mutation.SetCommenterName(v)
+	return _u
+}
+
+// SetNillableCommenterName sets the "commenter_name" field if the given value is not nil.
+func (_u *ChatMessageUpdateOne) SetNillableCommenterName(v *string) *ChatMessageUpdateOne {
+	if v != nil {
+		_u.SetCommenterName(*v)
+	}
+	return _u
+}
+
+// ClearCommenterName clears the value of the "commenter_name" field.
+func (_u *ChatMessageUpdateOne) ClearCommenterName() *ChatMessageUpdateOne {
+	_u.mutation.ClearCommenterName()
+	return _u
+}
+
+// SetCommenterDisplayName sets the "commenter_display_name" field.
+func (_u *ChatMessageUpdateOne) SetCommenterDisplayName(v string) *ChatMessageUpdateOne {
+	_u.mutation.SetCommenterDisplayName(v)
+	return _u
+}
+
+// SetNillableCommenterDisplayName sets the "commenter_display_name" field if the given value is not nil.
+func (_u *ChatMessageUpdateOne) SetNillableCommenterDisplayName(v *string) *ChatMessageUpdateOne {
+	if v != nil {
+		_u.SetCommenterDisplayName(*v)
+	}
+	return _u
+}
+
+// ClearCommenterDisplayName clears the value of the "commenter_display_name" field.
+func (_u *ChatMessageUpdateOne) ClearCommenterDisplayName() *ChatMessageUpdateOne {
+	_u.mutation.ClearCommenterDisplayName()
+	return _u
+}
+
+// SetBody sets the "body" field.
+func (_u *ChatMessageUpdateOne) SetBody(v string) *ChatMessageUpdateOne {
+	_u.mutation.SetBody(v)
+	return _u
+}
+
+// SetNillableBody sets the "body" field if the given value is not nil.
+func (_u *ChatMessageUpdateOne) SetNillableBody(v *string) *ChatMessageUpdateOne {
+	if v != nil {
+		_u.SetBody(*v)
+	}
+	return _u
+}
+
+// SetEmotes sets the "emotes" field.
+func (_u *ChatMessageUpdateOne) SetEmotes(v []string) *ChatMessageUpdateOne {
+	_u.mutation.SetEmotes(v)
+	return _u
+}
+
+// AppendEmotes appends value to the "emotes" field.
+func (_u *ChatMessageUpdateOne) AppendEmotes(v []string) *ChatMessageUpdateOne {
+	_u.mutation.AppendEmotes(v)
+	return _u
+}
+
+// ClearEmotes clears the value of the "emotes" field.
+func (_u *ChatMessageUpdateOne) ClearEmotes() *ChatMessageUpdateOne {
+	_u.mutation.ClearEmotes()
+	return _u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_u *ChatMessageUpdateOne) SetCreatedAt(v time.Time) *ChatMessageUpdateOne {
+	_u.mutation.SetCreatedAt(v)
+	return _u
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_u *ChatMessageUpdateOne) SetNillableCreatedAt(v *time.Time) *ChatMessageUpdateOne {
+	if v != nil {
+		_u.SetCreatedAt(*v)
+	}
+	return _u
+}
+
+// SetVod sets the "vod" edge to the Vod entity.
+func (_u *ChatMessageUpdateOne) SetVod(v *Vod) *ChatMessageUpdateOne {
+	return _u.SetVodID(v.ID)
+}
+
+// Mutation returns the ChatMessageMutation object of the builder.
+func (_u *ChatMessageUpdateOne) Mutation() *ChatMessageMutation {
+	return _u.mutation
+}
+
+// ClearVod clears the "vod" edge to the Vod entity.
+func (_u *ChatMessageUpdateOne) ClearVod() *ChatMessageUpdateOne {
+	_u.mutation.ClearVod()
+	return _u
+}
+
+// Where appends a list predicates to the ChatMessageUpdate builder.
+func (_u *ChatMessageUpdateOne) Where(ps ...predicate.ChatMessage) *ChatMessageUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *ChatMessageUpdateOne) Select(field string, fields ...string) *ChatMessageUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated ChatMessage entity.
+func (_u *ChatMessageUpdateOne) Save(ctx context.Context) (*ChatMessage, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ChatMessageUpdateOne) SaveX(ctx context.Context) *ChatMessage {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *ChatMessageUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ChatMessageUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *ChatMessageUpdateOne) check() error {
+	if _u.mutation.VodCleared() && len(_u.mutation.VodIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "ChatMessage.vod"`)
+	}
+	return nil
+}
+
+func (_u *ChatMessageUpdateOne) sqlSave(ctx context.Context) (_node *ChatMessage, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(chatmessage.Table, chatmessage.Columns, sqlgraph.NewFieldSpec(chatmessage.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "ChatMessage.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, chatmessage.FieldID)
+		for _, f := range fields {
+			if !chatmessage.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != chatmessage.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.ExtID(); ok {
+		_spec.SetField(chatmessage.FieldExtID, field.TypeString, value)
+	}
+	if _u.mutation.ExtIDCleared() {
+		_spec.ClearField(chatmessage.FieldExtID, field.TypeString)
+	}
+	if value, ok := _u.mutation.ContentOffsetSeconds(); ok {
+		_spec.SetField(chatmessage.FieldContentOffsetSeconds, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedContentOffsetSeconds(); ok {
+		_spec.AddField(chatmessage.FieldContentOffsetSeconds, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.CommenterID(); ok {
+		_spec.SetField(chatmessage.FieldCommenterID, field.TypeString, value)
+	}
+	if _u.mutation.CommenterIDCleared() {
+		_spec.ClearField(chatmessage.FieldCommenterID, field.TypeString)
+	}
+	if value, ok := _u.mutation.CommenterName(); ok {
+		_spec.SetField(chatmessage.FieldCommenterName, field.TypeString, value)
+	}
+	if _u.mutation.CommenterNameCleared() {
+		_spec.ClearField(chatmessage.FieldCommenterName, field.TypeString)
+	}
+	if value, ok := _u.mutation.CommenterDisplayName(); ok {
+		_spec.SetField(chatmessage.FieldCommenterDisplayName, field.TypeString, value)
+	}
+	if _u.mutation.CommenterDisplayNameCleared() {
+		_spec.ClearField(chatmessage.FieldCommenterDisplayName, field.TypeString)
+	}
+	if value, ok := _u.mutation.Body(); ok {
+		_spec.SetField(chatmessage.FieldBody, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Emotes(); ok {
+		_spec.SetField(chatmessage.FieldEmotes, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedEmotes(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, chatmessage.FieldEmotes, value)
+		})
+	}
+	if _u.mutation.EmotesCleared() {
+		_spec.ClearField(chatmessage.FieldEmotes, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.CreatedAt(); ok {
+		_spec.SetField(chatmessage.FieldCreatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.VodCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   chatmessage.VodTable,
+			Columns: []string{chatmessage.VodColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(vod.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.VodIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   chatmessage.VodTable,
+			Columns: []string{chatmessage.VodColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(vod.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &ChatMessage{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{chatmessage.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
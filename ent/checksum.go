@@ -0,0 +1,227 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/zibbp/ganymede/ent/checksum"
+	"github.com/zibbp/ganymede/ent/vod"
+	"github.com/zibbp/ganymede/internal/utils"
+)
+
+// Checksum is the model entity for the Checksum schema.
+type Checksum struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// The ID of the video the file belongs to.
+	VodID uuid.UUID `json:"vod_id,omitempty"`
+	// The kind of file.
+	File utils.ChecksumFile `json:"file,omitempty"`
+	// The path of the file in the HLS directory for HLS video files, empty for other files as their path is saved on the video.
+	Name string `json:"name,omitempty"`
+	// The hex encoded SHA-256 checksum of the file.
+	Sha256 string `json:"sha256,omitempty"`
+	// The size of the file in bytes.
+	SizeBytes int64 `json:"size_bytes,omitempty"`
+	// The result of the last verification.
+	Status utils.ChecksumStatus `json:"status,omitempty"`
+	// The time the file was last hashed.
+	VerifiedAt time.Time `json:"verified_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the ChecksumQuery when eager-loading is set.
+	Edges        ChecksumEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// ChecksumEdges holds the relations/edges for other nodes in the graph.
+type ChecksumEdges struct {
+	// Vod holds the value of the vod edge.
+	Vod *Vod `json:"vod,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// VodOrErr returns the Vod value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ChecksumEdges) VodOrErr() (*Vod, error) {
+	if e.Vod != nil {
+		return e.Vod, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: vod.Label}
+	}
+	return nil, &NotLoadedError{edge: "vod"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Checksum) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case checksum.FieldSizeBytes:
+			values[i] = new(sql.NullInt64)
+		case checksum.FieldFile, checksum.FieldName, checksum.FieldSha256, checksum.FieldStatus:
+			values[i] = new(sql.NullString)
+		case checksum.FieldVerifiedAt, checksum.FieldUpdatedAt, checksum.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		case checksum.FieldID, checksum.FieldVodID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Checksum fields.
+func (_m *Checksum) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case checksum.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case checksum.FieldVodID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field vod_id", values[i])
+			} else if value != nil {
+				_m.VodID = *value
+			}
+		case checksum.FieldFile:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field file", values[i])
+			} else if value.Valid {
+				_m.File = utils.ChecksumFile(value.String)
+			}
+		case checksum.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				_m.Name = value.String
+			}
+		case checksum.FieldSha256:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field sha256", values[i])
+			} else if value.Valid {
+				_m.Sha256 = value.String
+			}
+		case checksum.FieldSizeBytes:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field size_bytes", values[i])
+			} else if value.Valid {
+				_m.SizeBytes = value.Int64
+			}
+		case checksum.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				_m.Status = utils.ChecksumStatus(value.String)
+			}
+		case checksum.FieldVerifiedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field verified_at", values[i])
+			} else if value.Valid {
+				_m.VerifiedAt = value.Time
+			}
+		case checksum.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		case checksum.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Checksum.
+// This includes values selected through modifiers, order, etc.
+func (_m *Checksum) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryVod queries the "vod" edge of the Checksum entity.
+func (_m *Checksum) QueryVod() *VodQuery {
+	return NewChecksumClient(_m.config).QueryVod(_m)
+}
+
+// Update returns a builder for updating this Checksum.
+// Note that you need to call Checksum.Unwrap() before calling this method if this Checksum
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *Checksum) Update() *ChecksumUpdateOne {
+	return NewChecksumClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the Checksum entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *Checksum) Unwrap() *Checksum {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Checksum is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *Checksum) String() string {
+	var builder strings.Builder
+	builder.WriteString("Checksum(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("vod_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.VodID))
+	builder.WriteString(", ")
+	builder.WriteString("file=")
+	builder.WriteString(fmt.Sprintf("%v", _m.File))
+	builder.WriteString(", ")
+	builder.WriteString("name=")
+	builder.WriteString(_m.Name)
+	builder.WriteString(", ")
+	builder.WriteString("sha256=")
+	builder.WriteString(_m.Sha256)
+	builder.WriteString(", ")
+	builder.WriteString("size_bytes=")
+	builder.WriteString(fmt.Sprintf("%v", _m.SizeBytes))
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Status))
+	builder.WriteString(", ")
+	builder.WriteString("verified_at=")
+	builder.WriteString(_m.VerifiedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Checksums is a parsable slice of Checksum.
+type Checksums []*Checksum
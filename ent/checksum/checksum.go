@@ -0,0 +1,177 @@
+// Code generated by ent, DO NOT EDIT.
+
+package checksum
+
+import (
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+	"github.com/zibbp/ganymede/internal/utils"
+)
+
+const (
+	// Label holds the string label denoting the checksum type in the database.
+	Label = "checksum"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldVodID holds the string denoting the vod_id field in the database.
+	FieldVodID = "vod_id"
+	// FieldFile holds the string denoting the file field in the database.
+	FieldFile = "file"
+	// FieldName holds the string denoting the name field in the database.
+	FieldName = "name"
+	// FieldSha256 holds the string denoting the sha256 field in the database.
+	FieldSha256 = "sha256"
+	// FieldSizeBytes holds the string denoting the size_bytes field in the database.
+	FieldSizeBytes = "size_bytes"
+	// FieldStatus holds the string denoting the status field in the database.
+	FieldStatus = "status"
+	// FieldVerifiedAt holds the string denoting the verified_at field in the database.
+	FieldVerifiedAt = "verified_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// EdgeVod holds the string denoting the vod edge name in mutations.
+	EdgeVod = "vod"
+	// Table holds the table name of the checksum in the database.
+	Table = "checksums"
+	// VodTable is the table that holds the vod relation/edge.
+	VodTable = "checksums"
+	// VodInverseTable is the table name for the Vod entity.
+	// It exists in this package in order to avoid circular dependency with the "vod" package.
+	VodInverseTable = "vods"
+	// VodColumn is the table column denoting the vod relation/edge.
+	VodColumn = "vod_id"
+)
+
+// Columns holds all SQL columns for checksum fields.
+var Columns = []string{
+	FieldID,
+	FieldVodID,
+	FieldFile,
+	FieldName,
+	FieldSha256,
+	FieldSizeBytes,
+	FieldStatus,
+	FieldVerifiedAt,
+	FieldUpdatedAt,
+	FieldCreatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultName holds the default value on creation for the "name" field.
+	DefaultName string
+	// DefaultVerifiedAt holds the default value on creation for the "verified_at" field.
+	DefaultVerifiedAt func() time.Time
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() time.Time
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() uuid.UUID
+)
+
+// FileValidator is a validator for the "file" field enum values. It is called by the builders before save.
+func FileValidator(f utils.ChecksumFile) error {
+	switch f {
+	case "video", "chat", "chat_video", "live_chat", "live_chat_convert", "info", "thumbnail", "web_thumbnail":
+		return nil
+	default:
+		return fmt.Errorf("checksum: invalid enum value for file field: %q", f)
+	}
+}
+
+const DefaultStatus utils.ChecksumStatus = "ok"
+
+// StatusValidator is a validator for the "status" field enum values. It is called by the builders before save.
+func StatusValidator(s utils.ChecksumStatus) error {
+	switch s {
+	case "ok", "mismatch", "missing":
+		return nil
+	default:
+		return fmt.Errorf("checksum: invalid enum value for status field: %q", s)
+	}
+}
+
+// OrderOption defines the ordering options for the Checksum queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByVodID orders the results by the vod_id field.
+func ByVodID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldVodID, opts...).ToFunc()
+}
+
+// ByFile orders the results by the file field.
+func ByFile(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFile, opts...).ToFunc()
+}
+
+// ByName orders the results by the name field.
+func ByName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldName, opts...).ToFunc()
+}
+
+// BySha256 orders the results by the sha256 field.
+func BySha256(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSha256, opts...).ToFunc()
+}
+
+// BySizeBytes orders the results by the size_bytes field.
+func BySizeBytes(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSizeBytes, opts...).ToFunc()
+}
+
+// ByStatus orders the results by the status field.
+func ByStatus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStatus, opts...).ToFunc()
+}
+
+// ByVerifiedAt orders the results by the verified_at field.
+func ByVerifiedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldVerifiedAt, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByVodField orders the results by vod field.
+func ByVodField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newVodStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newVodStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(VodInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, VodTable, VodColumn),
+	)
+}
@@ -0,0 +1,1012 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/zibbp/ganymede/ent/checksum"
+	"github.com/zibbp/ganymede/ent/vod"
+	"github.com/zibbp/ganymede/internal/utils"
+)
+
+// ChecksumCreate is the builder for creating a Checksum entity.
+type ChecksumCreate struct {
+	config
+	mutation *ChecksumMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetVodID sets the "vod_id" field.
+func (_c *ChecksumCreate) SetVodID(v uuid.UUID) *ChecksumCreate {
+	_c.mutation.SetVodID(v)
+	return _c
+}
+
+// SetFile sets the "file" field.
+func (_c *ChecksumCreate) SetFile(v utils.ChecksumFile) *ChecksumCreate {
+	_c.mutation.SetFile(v)
+	return _c
+}
+
+// SetName sets the "name" field.
+func (_c *ChecksumCreate) SetName(v string) *ChecksumCreate {
+	_c.mutation.SetName(v)
+	return _c
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_c *ChecksumCreate) SetNillableName(v *string) *ChecksumCreate {
+	if v != nil {
+		_c.SetName(*v)
+	}
+	return _c
+}
+
+// SetSha256 sets the "sha256" field.
+func (_c *ChecksumCreate) SetSha256(v string) *ChecksumCreate {
+	_c.mutation.SetSha256(v)
+	return _c
+}
+
+// SetSizeBytes sets the "size_bytes" field.
+func (_c *ChecksumCreate) SetSizeBytes(v int64) *ChecksumCreate {
+	_c.mutation.SetSizeBytes(v)
+	return _c
+}
+
+// SetStatus sets the "status" field.
+func (_c *ChecksumCreate) SetStatus(v utils.ChecksumStatus) *ChecksumCreate {
+	_c.mutation.SetStatus(v)
+	return _c
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_c *ChecksumCreate) SetNillableStatus(v *utils.ChecksumStatus) *ChecksumCreate {
+	if v != nil {
+		_c.SetStatus(*v)
+	}
+	return _c
+}
+
+// SetVerifiedAt sets the "verified_at" field.
+func (_c *ChecksumCreate) SetVerifiedAt(v time.Time) *ChecksumCreate {
+	_c.mutation.SetVerifiedAt(v)
+	return _c
+}
+
+// SetNillableVerifiedAt sets the "verified_at" field if the given value is not nil.
+func (_c *ChecksumCreate) SetNillableVerifiedAt(v *time.Time) *ChecksumCreate {
+	if v != nil {
+		_c.SetVerifiedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *ChecksumCreate) SetUpdatedAt(v time.Time) *ChecksumCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *ChecksumCreate) SetNillableUpdatedAt(v *time.Time) *ChecksumCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *ChecksumCreate) SetCreatedAt(v time.Time) *ChecksumCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *ChecksumCreate) SetNillableCreatedAt(v *time.Time) *ChecksumCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *ChecksumCreate) SetID(v uuid.UUID) *ChecksumCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *ChecksumCreate) SetNillableID(v *uuid.UUID) *ChecksumCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// SetVod sets the "vod" edge to the Vod entity.
+func (_c *ChecksumCreate) SetVod(v *Vod) *ChecksumCreate {
+	return _c.SetVodID(v.ID)
+}
+
+// Mutation returns the ChecksumMutation object of the builder.
+func (_c *ChecksumCreate) Mutation() *ChecksumMutation {
+	return _c.mutation
+}
+
+// Save creates the Checksum in the database.
+func (_c *ChecksumCreate) Save(ctx context.Context) (*Checksum, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *ChecksumCreate) SaveX(ctx context.Context) *Checksum {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ChecksumCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ChecksumCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *ChecksumCreate) defaults() {
+	if _, ok := _c.mutation.Name(); !ok {
+		v := checksum.DefaultName
+		_c.mutation.SetName(v)
+	}
+	if _, ok := _c.mutation.Status(); !ok {
+		v := checksum.DefaultStatus
+		_c.mutation.SetStatus(v)
+	}
+	if _, ok := _c.mutation.VerifiedAt(); !ok {
+		v := checksum.DefaultVerifiedAt()
+		_c.mutation.SetVerifiedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := checksum.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := checksum.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := checksum.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *ChecksumCreate) check() error {
+	if _, ok := _c.mutation.VodID(); !ok {
+		return &ValidationError{Name: "vod_id", err: errors.New(`ent: missing required field "Checksum.vod_id"`)}
+	}
+	if _, ok := _c.mutation.File(); !ok {
+		return &ValidationError{Name: "file", err: errors.New(`ent: missing required field "Checksum.file"`)}
+	}
+	if v, ok := _c.mutation.File(); ok {
+		if err := checksum.FileValidator(v); err != nil {
+			return &ValidationError{Name: "file", err: fmt.Errorf(`ent: validator failed for field "Checksum.file": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "Checksum.name"`)}
+	}
+	if _, ok := _c.mutation.Sha256(); !ok {
+		return &ValidationError{Name: "sha256", err: errors.New(`ent: missing required field "Checksum.sha256"`)}
+	}
+	if _, ok := _c.mutation.SizeBytes(); !ok {
+		return &ValidationError{Name: "size_bytes", err: errors.New(`ent: missing required field "Checksum.size_bytes"`)}
+	}
+	if _, ok := _c.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "Checksum.status"`)}
+	}
+	if v, ok := _c.mutation.Status(); ok {
+		if err := checksum.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Checksum.status": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.VerifiedAt(); !ok {
+		return &ValidationError{Name: "verified_at", err: errors.New(`ent: missing required field "Checksum.verified_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "Checksum.updated_at"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Checksum.created_at"`)}
+	}
+	if len(_c.mutation.VodIDs()) == 0 {
+		return &ValidationError{Name: "vod", err: errors.New(`ent: missing required edge "Checksum.vod"`)}
+	}
+	return nil
+}
+
+func (_c *ChecksumCreate) sqlSave(ctx context.Context) (*Checksum, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *ChecksumCreate) createSpec() (*Checksum, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Checksum{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(checksum.Table, sqlgraph.NewFieldSpec(checksum.FieldID, field.TypeUUID))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.File(); ok {
+		_spec.SetField(checksum.FieldFile, field.TypeEnum, value)
+		_node.File = value
+	}
+	if value, ok := _c.mutation.Name(); ok {
+		_spec.SetField(checksum.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := _c.mutation.Sha256(); ok {
+		_spec.SetField(checksum.FieldSha256, field.TypeString, value)
+		_node.Sha256 = value
+	}
+	if value, ok := _c.mutation.SizeBytes(); ok {
+		_spec.SetField(checksum.FieldSizeBytes, field.TypeInt64, value)
+		_node.SizeBytes = value
+	}
+	if value, ok := _c.mutation.Status(); ok {
+		_spec.SetField(checksum.FieldStatus, field.TypeEnum, value)
+		_node.Status = value
+	}
+	if value, ok := _c.mutation.VerifiedAt(); ok {
+		_spec.SetField(checksum.FieldVerifiedAt, field.TypeTime, value)
+		_node.VerifiedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(checksum.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(checksum.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := _c.mutation.VodIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   checksum.VodTable,
+			Columns: []string{checksum.VodColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(vod.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.VodID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Checksum.Create().
+//		SetVodID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ChecksumUpsert) {
+//			SetVodID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *ChecksumCreate) OnConflict(opts ...sql.ConflictOption) *ChecksumUpsertOne {
+	_c.conflict = opts
+	return &ChecksumUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Checksum.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *ChecksumCreate) OnConflictColumns(columns ...string) *ChecksumUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &ChecksumUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// ChecksumUpsertOne is the builder for "upsert"-ing
+	//  one Checksum node.
+	ChecksumUpsertOne struct {
+		create *ChecksumCreate
+	}
+
+	// ChecksumUpsert is the "OnConflict" setter.
+	ChecksumUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetVodID sets the "vod_id" field.
+func (u *ChecksumUpsert) SetVodID(v uuid.UUID) *ChecksumUpsert {
+	u.Set(checksum.FieldVodID, v)
+	return u
+}
+
+// UpdateVodID sets the "vod_id" field to the value that was provided on create.
+func (u *ChecksumUpsert) UpdateVodID() *ChecksumUpsert {
+	u.SetExcluded(checksum.FieldVodID)
+	return u
+}
+
+// SetFile sets the "file" field.
+func (u *ChecksumUpsert) SetFile(v utils.ChecksumFile) *ChecksumUpsert {
+	u.Set(checksum.FieldFile, v)
+	return u
+}
+
+// UpdateFile sets the "file" field to the value that was provided on create.
+func (u *ChecksumUpsert) UpdateFile() *ChecksumUpsert {
+	u.SetExcluded(checksum.FieldFile)
+	return u
+}
+
+// SetName sets the "name" field.
+func (u *ChecksumUpsert) SetName(v string) *ChecksumUpsert {
+	u.Set(checksum.FieldName, v)
+	return u
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *ChecksumUpsert) UpdateName() *ChecksumUpsert {
+	u.SetExcluded(checksum.FieldName)
+	return u
+}
+
+// SetSha256 sets the "sha256" field.
+func (u *ChecksumUpsert) SetSha256(v string) *ChecksumUpsert {
+	u.Set(checksum.FieldSha256, v)
+	return u
+}
+
+// UpdateSha256 sets the "sha256" field to the value that was provided on create.
+func (u *ChecksumUpsert) UpdateSha256() *ChecksumUpsert {
+	u.SetExcluded(checksum.FieldSha256)
+	return u
+}
+
+// SetSizeBytes sets the "size_bytes" field.
+func (u *ChecksumUpsert) SetSizeBytes(v int64) *ChecksumUpsert {
+	u.Set(checksum.FieldSizeBytes, v)
+	return u
+}
+
+// UpdateSizeBytes sets the "size_bytes" field to the value that was provided on create.
+func (u *ChecksumUpsert) UpdateSizeBytes() *ChecksumUpsert {
+	u.SetExcluded(checksum.FieldSizeBytes)
+	return u
+}
+
+// AddSizeBytes adds v to the "size_bytes" field.
+func (u *ChecksumUpsert) AddSizeBytes(v int64) *ChecksumUpsert {
+	u.Add(checksum.FieldSizeBytes, v)
+	return u
+}
+
+// SetStatus sets the "status" field.
+func (u *ChecksumUpsert) SetStatus(v utils.ChecksumStatus) *ChecksumUpsert {
+	u.Set(checksum.FieldStatus, v)
+	return u
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *ChecksumUpsert) UpdateStatus() *ChecksumUpsert {
+	u.SetExcluded(checksum.FieldStatus)
+	return u
+}
+
+// SetVerifiedAt sets the "verified_at" field.
+func (u *ChecksumUpsert) SetVerifiedAt(v time.Time) *ChecksumUpsert {
+	u.Set(checksum.FieldVerifiedAt, v)
+	return u
+}
+
+// UpdateVerifiedAt sets the "verified_at" field to the value that was provided on create.
+func (u *ChecksumUpsert) UpdateVerifiedAt() *ChecksumUpsert {
+	u.SetExcluded(checksum.FieldVerifiedAt)
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *ChecksumUpsert) SetUpdatedAt(v time.Time) *ChecksumUpsert {
+	u.Set(checksum.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *ChecksumUpsert) UpdateUpdatedAt() *ChecksumUpsert {
+	u.SetExcluded(checksum.FieldUpdatedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.Checksum.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(checksum.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *ChecksumUpsertOne) UpdateNewValues() *ChecksumUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(checksum.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(checksum.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Checksum.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *ChecksumUpsertOne) Ignore() *ChecksumUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ChecksumUpsertOne) DoNothing() *ChecksumUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ChecksumCreate.OnConflict
+// documentation for more info.
+func (u *ChecksumUpsertOne) Update(set func(*ChecksumUpsert)) *ChecksumUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ChecksumUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetVodID sets the "vod_id" field.
+func (u *ChecksumUpsertOne) SetVodID(v uuid.UUID) *ChecksumUpsertOne {
+	return u.Update(func(s *ChecksumUpsert) {
+		s.SetVodID(v)
+	})
+}
+
+// UpdateVodID sets the "vod_id" field to the value that was provided on create.
+func (u *ChecksumUpsertOne) UpdateVodID() *ChecksumUpsertOne {
+	return u.Update(func(s *ChecksumUpsert) {
+		s.UpdateVodID()
+	})
+}
+
+// SetFile sets the "file" field.
+func (u *ChecksumUpsertOne) SetFile(v utils.ChecksumFile) *ChecksumUpsertOne {
+	return u.Update(func(s *ChecksumUpsert) {
+		s.SetFile(v)
+	})
+}
+
+// UpdateFile sets the "file" field to the value that was provided on create.
+func (u *ChecksumUpsertOne) UpdateFile() *ChecksumUpsertOne {
+	return u.Update(func(s *ChecksumUpsert) {
+		s.UpdateFile()
+	})
+}
+
+// SetName sets the "name" field.
+func (u *ChecksumUpsertOne) SetName(v string) *ChecksumUpsertOne {
+	return u.Update(func(s *ChecksumUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *ChecksumUpsertOne) UpdateName() *ChecksumUpsertOne {
+	return u.Update(func(s *ChecksumUpsert) {
+		s.UpdateName()
+	})
+}
+
+// SetSha256 sets the "sha256" field.
+func (u *ChecksumUpsertOne) SetSha256(v string) *ChecksumUpsertOne {
+	return u.Update(func(s *ChecksumUpsert) {
+		s.SetSha256(v)
+	})
+}
+
+// UpdateSha256 sets the "sha256" field to the value that was provided on create.
+func (u *ChecksumUpsertOne) UpdateSha256() *ChecksumUpsertOne {
+	return u.Update(func(s *ChecksumUpsert) {
+		s.UpdateSha256()
+	})
+}
+
+// SetSizeBytes sets the "size_bytes" field.
+func (u *ChecksumUpsertOne) SetSizeBytes(v int64) *ChecksumUpsertOne {
+	return u.Update(func(s *ChecksumUpsert) {
+		s.SetSizeBytes(v)
+	})
+}
+
+// AddSizeBytes adds v to the "size_bytes" field.
+func (u *ChecksumUpsertOne) AddSizeBytes(v int64) *ChecksumUpsertOne {
+	return u.Update(func(s *ChecksumUpsert) {
+		s.AddSizeBytes(v)
+	})
+}
+
+// UpdateSizeBytes sets the "size_bytes" field to the value that was provided on create.
+func (u *ChecksumUpsertOne) UpdateSizeBytes() *ChecksumUpsertOne {
+	return u.Update(func(s *ChecksumUpsert) {
+		s.UpdateSizeBytes()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *ChecksumUpsertOne) SetStatus(v utils.ChecksumStatus) *ChecksumUpsertOne {
+	return u.Update(func(s *ChecksumUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *ChecksumUpsertOne) UpdateStatus() *ChecksumUpsertOne {
+	return u.Update(func(s *ChecksumUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetVerifiedAt sets the "verified_at" field.
+func (u *ChecksumUpsertOne) SetVerifiedAt(v time.Time) *ChecksumUpsertOne {
+	return u.Update(func(s *ChecksumUpsert) {
+		s.SetVerifiedAt(v)
+	})
+}
+
+// UpdateVerifiedAt sets the "verified_at" field to the value that was provided on create.
+func (u *ChecksumUpsertOne) UpdateVerifiedAt() *ChecksumUpsertOne {
+	return u.Update(func(s *ChecksumUpsert) {
+		s.UpdateVerifiedAt()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *ChecksumUpsertOne) SetUpdatedAt(v time.Time) *ChecksumUpsertOne {
+	return u.Update(func(s *ChecksumUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *ChecksumUpsertOne) UpdateUpdatedAt() *ChecksumUpsertOne {
+	return u.Update(func(s *ChecksumUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *ChecksumUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ChecksumCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ChecksumUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *ChecksumUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: ChecksumUpsertOne.ID is not supported by MySQL driver. Use ChecksumUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *ChecksumUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// ChecksumCreateBulk is the builder for creating many Checksum entities in bulk.
+type ChecksumCreateBulk struct {
+	config
+	err      error
+	builders []*ChecksumCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the Checksum entities in the database.
+func (_c *ChecksumCreateBulk) Save(ctx context.Context) ([]*Checksum, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*Checksum, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ChecksumMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *ChecksumCreateBulk) SaveX(ctx context.Context) []*Checksum {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ChecksumCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ChecksumCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Checksum.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ChecksumUpsert) {
+//			SetVodID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *ChecksumCreateBulk) OnConflict(opts ...sql.ConflictOption) *ChecksumUpsertBulk {
+	_c.conflict = opts
+	return &ChecksumUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Checksum.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *ChecksumCreateBulk) OnConflictColumns(columns ...string) *ChecksumUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &ChecksumUpsertBulk{
+		create: _c,
+	}
+}
+
+// ChecksumUpsertBulk is the builder for "upsert"-ing
+// a bulk of Checksum nodes.
+type ChecksumUpsertBulk struct {
+	create *ChecksumCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.Checksum.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(checksum.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *ChecksumUpsertBulk) UpdateNewValues() *ChecksumUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(checksum.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(checksum.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Checksum.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *ChecksumUpsertBulk) Ignore() *ChecksumUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ChecksumUpsertBulk) DoNothing() *ChecksumUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ChecksumCreateBulk.OnConflict
+// documentation for more info.
+func (u *ChecksumUpsertBulk) Update(set func(*ChecksumUpsert)) *ChecksumUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ChecksumUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetVodID sets the "vod_id" field.
+func (u *ChecksumUpsertBulk) SetVodID(v uuid.UUID) *ChecksumUpsertBulk {
+	return u.Update(func(s *ChecksumUpsert) {
+		s.SetVodID(v)
+	})
+}
+
+// UpdateVodID sets the "vod_id" field to the value that was provided on create.
+func (u *ChecksumUpsertBulk) UpdateVodID() *ChecksumUpsertBulk {
+	return u.Update(func(s *ChecksumUpsert) {
+		s.UpdateVodID()
+	})
+}
+
+// SetFile sets the "file" field.
+func (u *ChecksumUpsertBulk) SetFile(v utils.ChecksumFile) *ChecksumUpsertBulk {
+	return u.Update(func(s *ChecksumUpsert) {
+		s.SetFile(v)
+	})
+}
+
+// UpdateFile sets the "file" field to the value that was provided on create.
+func (u *ChecksumUpsertBulk) UpdateFile() *ChecksumUpsertBulk {
+	return u.Update(func(s *ChecksumUpsert) {
+		s.UpdateFile()
+	})
+}
+
+// SetName sets the "name" field.
+func (u *ChecksumUpsertBulk) SetName(v string) *ChecksumUpsertBulk {
+	return u.Update(func(s *ChecksumUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *ChecksumUpsertBulk) UpdateName() *ChecksumUpsertBulk {
+	return u.Update(func(s *ChecksumUpsert) {
+		s.UpdateName()
+	})
+}
+
+// SetSha256 sets the "sha256" field.
+func (u *ChecksumUpsertBulk) SetSha256(v string) *ChecksumUpsertBulk {
+	return u.Update(func(s *ChecksumUpsert) {
+		s.SetSha256(v)
+	})
+}
+
+// UpdateSha256 sets the "sha256" field to the value that was provided on create.
+func (u *ChecksumUpsertBulk) UpdateSha256() *ChecksumUpsertBulk {
+	return u.Update(func(s *ChecksumUpsert) {
+		s.UpdateSha256()
+	})
+}
+
+// SetSizeBytes sets the "size_bytes" field.
+func (u *ChecksumUpsertBulk) SetSizeBytes(v int64) *ChecksumUpsertBulk {
+	return u.Update(func(s *ChecksumUpsert) {
+		s.SetSizeBytes(v)
+	})
+}
+
+// AddSizeBytes adds v to the "size_bytes" field.
+func (u *ChecksumUpsertBulk) AddSizeBytes(v int64) *ChecksumUpsertBulk {
+	return u.Update(func(s *ChecksumUpsert) {
+		s.AddSizeBytes(v)
+	})
+}
+
+// UpdateSizeBytes sets the "size_bytes" field to the value that was provided on create.
+func (u *ChecksumUpsertBulk) UpdateSizeBytes() *ChecksumUpsertBulk {
+	return u.Update(func(s *ChecksumUpsert) {
+		s.UpdateSizeBytes()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *ChecksumUpsertBulk) SetStatus(v utils.ChecksumStatus) *ChecksumUpsertBulk {
+	return u.Update(func(s *ChecksumUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *ChecksumUpsertBulk) UpdateStatus() *ChecksumUpsertBulk {
+	return u.Update(func(s *ChecksumUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetVerifiedAt sets the "verified_at" field.
+func (u *ChecksumUpsertBulk) SetVerifiedAt(v time.Time) *ChecksumUpsertBulk {
+	return u.Update(func(s *ChecksumUpsert) {
+		s.SetVerifiedAt(v)
+	})
+}
+
+// UpdateVerifiedAt sets the "verified_at" field to the value that was provided on create.
+func (u *ChecksumUpsertBulk) UpdateVerifiedAt() *ChecksumUpsertBulk {
+	return u.Update(func(s *ChecksumUpsert) {
+		s.UpdateVerifiedAt()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *ChecksumUpsertBulk) SetUpdatedAt(v time.Time) *ChecksumUpsertBulk {
+	return u.Update(func(s *ChecksumUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *ChecksumUpsertBulk) UpdateUpdatedAt() *ChecksumUpsertBulk {
+	return u.Update(func(s *ChecksumUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *ChecksumUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the ChecksumCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ChecksumCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ChecksumUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
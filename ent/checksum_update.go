@@ -0,0 +1,589 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/zibbp/ganymede/ent/checksum"
+	"github.com/zibbp/ganymede/ent/predicate"
+	"github.com/zibbp/ganymede/ent/vod"
+	"github.com/zibbp/ganymede/internal/utils"
+)
+
+// ChecksumUpdate is the builder for updating Checksum entities.
+type ChecksumUpdate struct {
+	config
+	hooks    []Hook
+	mutation *ChecksumMutation
+}
+
+// Where appends a list predicates to the ChecksumUpdate builder.
+func (_u *ChecksumUpdate) Where(ps ...predicate.Checksum) *ChecksumUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetVodID sets the "vod_id" field.
+func (_u *ChecksumUpdate) SetVodID(v uuid.UUID) *ChecksumUpdate {
+	_u.mutation.SetVodID(v)
+	return _u
+}
+
+// SetNillableVodID sets the "vod_id" field if the given value is not nil.
+func (_u *ChecksumUpdate) SetNillableVodID(v *uuid.UUID) *ChecksumUpdate {
+	if v != nil {
+		_u.SetVodID(*v)
+	}
+	return _u
+}
+
+// SetFile sets the "file" field.
+func (_u *ChecksumUpdate) SetFile(v utils.ChecksumFile) *ChecksumUpdate {
+	_u.mutation.SetFile(v)
+	return _u
+}
+
+// SetNillableFile sets the "file" field if the given value is not nil.
+func (_u *ChecksumUpdate) SetNillableFile(v *utils.ChecksumFile) *ChecksumUpdate {
+	if v != nil {
+		_u.SetFile(*v)
+	}
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *ChecksumUpdate) SetName(v string) *ChecksumUpdate {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *ChecksumUpdate) SetNillableName(v *string) *ChecksumUpdate {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetSha256 sets the "sha256" field.
+func (_u *ChecksumUpdate) SetSha256(v string) *ChecksumUpdate {
+	_u.mutation.SetSha256(v)
+	return _u
+}
+
+// SetNillableSha256 sets the "sha256" field if the given value is not nil.
+func (_u *ChecksumUpdate) SetNillableSha256(v *string) *ChecksumUpdate {
+	if v != nil {
+		_u.SetSha256(*v)
+	}
+	return _u
+}
+
+// SetSizeBytes sets the "size_bytes" field.
+func (_u *ChecksumUpdate) SetSizeBytes(v int64) *ChecksumUpdate {
+	_u.mutation.ResetSizeBytes()
+	_u.mutation.SetSizeBytes(v)
+	return _u
+}
+
+// SetNillableSizeBytes sets the "size_bytes" field if the given value is not nil.
+func (_u *ChecksumUpdate) SetNillableSizeBytes(v *int64) *ChecksumUpdate {
+	if v != nil {
+		_u.SetSizeBytes(*v)
+	}
+	return _u
+}
+
+// AddSizeBytes adds value to the "size_bytes" field.
+func (_u *ChecksumUpdate) AddSizeBytes(v int64) *ChecksumUpdate {
+	_u.mutation.AddSizeBytes(v)
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *ChecksumUpdate) SetStatus(v utils.ChecksumStatus) *ChecksumUpdate {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *ChecksumUpdate) SetNillableStatus(v *utils.ChecksumStatus) *ChecksumUpdate {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetVerifiedAt sets the "verified_at" field.
+func (_u *ChecksumUpdate) SetVerifiedAt(v time.Time) *ChecksumUpdate {
+	_u.mutation.SetVerifiedAt(v)
+	return _u
+}
+
+// SetNillableVerifiedAt sets the "verified_at" field if the given value is not nil.
+func (_u *ChecksumUpdate) SetNillableVerifiedAt(v *time.Time) *ChecksumUpdate {
+	if v != nil {
+		_u.SetVerifiedAt(*v)
+	}
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *ChecksumUpdate) SetUpdatedAt(v time.Time) *ChecksumUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetVod sets the "vod" edge to the Vod entity.
+func (_u *ChecksumUpdate) SetVod(v *Vod) *ChecksumUpdate {
+	return _u.SetVodID(v.ID)
+}
+
+// Mutation returns the ChecksumMutation object of the builder.
+func (_u *ChecksumUpdate) Mutation() *ChecksumMutation {
+	return _u.mutation
+}
+
+// ClearVod clears the "vod" edge to the Vod entity.
+func (_u *ChecksumUpdate) ClearVod() *ChecksumUpdate {
+	_u.mutation.ClearVod()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *ChecksumUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ChecksumUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *ChecksumUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ChecksumUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *ChecksumUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := checksum.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *ChecksumUpdate) check() error {
+	if v, ok := _u.mutation.File(); ok {
+		if err := checksum.FileValidator(v); err != nil {
+			return &ValidationError{Name: "file", err: fmt.Errorf(`ent: validator failed for field "Checksum.file": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Status(); ok {
+		if err := checksum.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Checksum.status": %w`, err)}
+		}
+	}
+	if _u.mutation.VodCleared() && len(_u.mutation.VodIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Checksum.vod"`)
+	}
+	return nil
+}
+
+func (_u *ChecksumUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(checksum.Table, checksum.Columns, sqlgraph.NewFieldSpec(checksum.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.File(); ok {
+		_spec.SetField(checksum.FieldFile, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(checksum.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Sha256(); ok {
+		_spec.SetField(checksum.FieldSha256, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.SizeBytes(); ok {
+		_spec.SetField(checksum.FieldSizeBytes, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedSizeBytes(); ok {
+		_spec.AddField(checksum.FieldSizeBytes, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(checksum.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.VerifiedAt(); ok {
+		_spec.SetField(checksum.FieldVerifiedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(checksum.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.VodCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   checksum.VodTable,
+			Columns: []string{checksum.VodColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(vod.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.VodIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   checksum.VodTable,
+			Columns: []string{checksum.VodColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(vod.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{checksum.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// ChecksumUpdateOne is the builder for updating a single Checksum entity.
+type ChecksumUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *ChecksumMutation
+}
+
+// SetVodID sets the "vod_id" field.
+func (_u *ChecksumUpdateOne) SetVodID(v uuid.UUID) *ChecksumUpdateOne {
+	_u.mutation.SetVodID(v)
+	return _u
+}
+
+// SetNillableVodID sets the "vod_id" field if the given value is not nil.
+func (_u *ChecksumUpdateOne) SetNillableVodID(v *uuid.UUID) *ChecksumUpdateOne {
+	if v != nil {
+		_u.SetVodID(*v)
+	}
+	return _u
+}
+
+// SetFile sets the "file" field.
+func (_u *ChecksumUpdateOne) SetFile(v utils.ChecksumFile) *ChecksumUpdateOne {
+	_u.mutation.SetFile(v)
+	return _u
+}
+
+// SetNillableFile sets the "file" field if the given value is not nil.
+func (_u *ChecksumUpdateOne) SetNillableFile(v *utils.ChecksumFile) *ChecksumUpdateOne {
+	if v != nil {
+		_u.SetFile(*v)
+	}
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *ChecksumUpdateOne) SetName(v string) *ChecksumUpdateOne {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *ChecksumUpdateOne) SetNillableName(v *string) *ChecksumUpdateOne {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetSha256 sets the "sha256" field.
+func (_u *ChecksumUpdateOne) SetSha256(v string) *ChecksumUpdateOne {
+	_u.mutation.SetSha256(v)
+	return _u
+}
+
+// SetNillableSha256 sets the "sha256" field if the given value is not nil.
+func (_u *ChecksumUpdateOne) SetNillableSha256(v *string) *ChecksumUpdateOne {
+	if v != nil {
+		_u.SetSha256(*v)
+	}
+	return _u
+}
+
+// SetSizeBytes sets the "size_bytes" field.
+func (_u *ChecksumUpdateOne) SetSizeBytes(v int64) *ChecksumUpdateOne {
+	_u.mutation.ResetSizeBytes()
+	_u.mutation.SetSizeBytes(v)
+	return _u
+}
+
+// SetNillableSizeBytes sets the "size_bytes" field if the given value is not nil.
+func (_u *ChecksumUpdateOne) SetNillableSizeBytes(v *int64) *ChecksumUpdateOne {
+	if v != nil {
+		_u.SetSizeBytes(*v)
+	}
+	return _u
+}
+
+// AddSizeBytes adds value to the "size_bytes" field.
+func (_u *ChecksumUpdateOne) AddSizeBytes(v int64) *ChecksumUpdateOne {
+	_u.mutation.AddSizeBytes(v)
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *ChecksumUpdateOne) SetStatus(v utils.ChecksumStatus) *ChecksumUpdateOne {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *ChecksumUpdateOne) SetNillableStatus(v *utils.ChecksumStatus) *ChecksumUpdateOne {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetVerifiedAt sets the "verified_at" field.
+func (_u *ChecksumUpdateOne) SetVerifiedAt(v time.Time) *ChecksumUpdateOne {
+	_u.mutation.SetVerifiedAt(v)
+	return _u
+}
+
+// SetNillableVerifiedAt sets the "verified_at" field if the given value is not nil.
+func (_u *ChecksumUpdateOne) SetNillableVerifiedAt(v *time.Time) *ChecksumUpdateOne {
+	if v != nil {
+		_u.SetVerifiedAt(*v)
+	}
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *ChecksumUpdateOne) SetUpdatedAt(v time.Time) *ChecksumUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetVod sets the "vod" edge to the Vod entity.
+func (_u *ChecksumUpdateOne) SetVod(v *Vod) *ChecksumUpdateOne {
+	return _u.SetVodID(v.ID)
+}
+
+// Mutation returns the ChecksumMutation object of the builder.
+func (_u *ChecksumUpdateOne) Mutation() *ChecksumMutation {
+	return _u.mutation
+}
+
+// ClearVod clears the "vod" edge to the Vod entity.
+func (_u *ChecksumUpdateOne) ClearVod() *ChecksumUpdateOne {
+	_u.mutation.ClearVod()
+	return _u
+}
+
+// Where appends a list predicates to the ChecksumUpdate builder.
+func (_u *ChecksumUpdateOne) Where(ps ...predicate.Checksum) *ChecksumUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *ChecksumUpdateOne) Select(field string, fields ...string) *ChecksumUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated Checksum entity.
+func (_u *ChecksumUpdateOne) Save(ctx context.Context) (*Checksum, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ChecksumUpdateOne) SaveX(ctx context.Context) *Checksum {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *ChecksumUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ChecksumUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *ChecksumUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := checksum.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *ChecksumUpdateOne) check() error {
+	if v, ok := _u.mutation.File(); ok {
+		if err := checksum.FileValidator(v); err != nil {
+			return &ValidationError{Name: "file", err: fmt.Errorf(`ent: validator failed for field "Checksum.file": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Status(); ok {
+		if err := checksum.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Checksum.status": %w`, err)}
+		}
+	}
+	if _u.mutation.VodCleared() && len(_u.mutation.VodIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Checksum.vod"`)
+	}
+	return nil
+}
+
+func (_u *ChecksumUpdateOne) sqlSave(ctx context.Context) (_node *Checksum, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(checksum.Table, checksum.Columns, sqlgraph.NewFieldSpec(checksum.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Checksum.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, checksum.FieldID)
+		for _, f := range fields {
+			if !checksum.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != checksum.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.File(); ok {
+		_spec.SetField(checksum.FieldFile, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(checksum.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Sha256(); ok {
+		_spec.SetField(checksum.FieldSha256, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.SizeBytes(); ok {
+		_spec.SetField(checksum.FieldSizeBytes, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedSizeBytes(); ok {
+		_spec.AddField(checksum.FieldSizeBytes, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(checksum.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.VerifiedAt(); ok {
+		_spec.SetField(checksum.FieldVerifiedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(checksum.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.VodCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   checksum.VodTable,
+			Columns: []string{checksum.VodColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(vod.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.VodIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   checksum.VodTable,
+			Columns: []string{checksum.VodColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(vod.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &Checksum{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{checksum.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -20,8 +20,12 @@ import (
 	"github.com/zibbp/ganymede/ent/blockedvideos"
 	"github.com/zibbp/ganymede/ent/channel"
 	"github.com/zibbp/ganymede/ent/chapter"
+	"github.com/zibbp/ganymede/ent/chatmessage"
+	"github.com/zibbp/ganymede/ent/checksum"
 	"github.com/zibbp/ganymede/ent/live"
 	"github.com/zibbp/ganymede/ent/livecategory"
+	"github.com/zibbp/ganymede/ent/livegap"
+	"github.com/zibbp/ganymede/ent/liveschedule"
 	"github.com/zibbp/ganymede/ent/livetitleregex"
 	"github.com/zibbp/ganymede/ent/multistreaminfo"
 	"github.com/zibbp/ganymede/ent/mutedsegment"
@@ -32,8 +36,12 @@ import (
 	"github.com/zibbp/ganymede/ent/playlistrulegroup"
 	"github.com/zibbp/ganymede/ent/queue"
 	"github.com/zibbp/ganymede/ent/sessions"
+	"github.com/zibbp/ganymede/ent/transcodingprofile"
 	"github.com/zibbp/ganymede/ent/twitchcategory"
 	"github.com/zibbp/ganymede/ent/user"
+	"github.com/zibbp/ganymede/ent/userlist"
+	"github.com/zibbp/ganymede/ent/userlistitem"
+	"github.com/zibbp/ganymede/ent/viewingsession"
 	"github.com/zibbp/ganymede/ent/vod"
 )
 
@@ -50,10 +58,18 @@ type Client struct {
 	Channel *ChannelClient
 	// Chapter is the client for interacting with the Chapter builders.
 	Chapter *ChapterClient
+	// ChatMessage is the client for interacting with the ChatMessage builders.
+	ChatMessage *ChatMessageClient
+	// Checksum is the client for interacting with the Checksum builders.
+	Checksum *ChecksumClient
 	// Live is the client for interacting with the Live builders.
 	Live *LiveClient
 	// LiveCategory is the client for interacting with the LiveCategory builders.
 	LiveCategory *LiveCategoryClient
+	// LiveGap is the client for interacting with the LiveGap builders.
+	LiveGap *LiveGapClient
+	// LiveSchedule is the client for interacting with the LiveSchedule builders.
+	LiveSchedule *LiveScheduleClient
 	// LiveTitleRegex is the client for interacting with the LiveTitleRegex builders.
 	LiveTitleRegex *LiveTitleRegexClient
 	// MultistreamInfo is the client for interacting with the MultistreamInfo builders.
@@ -74,10 +90,18 @@ type Client struct {
 	Queue *QueueClient
 	// Sessions is the client for interacting with the Sessions builders.
 	Sessions *SessionsClient
+	// TranscodingProfile is the client for interacting with the TranscodingProfile builders.
+	TranscodingProfile *TranscodingProfileClient
 	// TwitchCategory is the client for interacting with the TwitchCategory builders.
 	TwitchCategory *TwitchCategoryClient
 	// User is the client for interacting with the User builders.
 	User *UserClient
+	// UserList is the client for interacting with the UserList builders.
+	UserList *UserListClient
+	// UserListItem is the client for interacting with the UserListItem builders.
+	UserListItem *UserListItemClient
+	// ViewingSession is the client for interacting with the ViewingSession builders.
+	ViewingSession *ViewingSessionClient
 	// Vod is the client for interacting with the Vod builders.
 	Vod *VodClient
 }
@@ -95,8 +119,12 @@ func (c *Client) init() {
 	c.BlockedVideos = NewBlockedVideosClient(c.config)
 	c.Channel = NewChannelClient(c.config)
 	c.Chapter = NewChapterClient(c.config)
+	c.ChatMessage = NewChatMessageClient(c.config)
+	c.Checksum = NewChecksumClient(c.config)
 	c.Live = NewLiveClient(c.config)
 	c.LiveCategory = NewLiveCategoryClient(c.config)
+	c.LiveGap = NewLiveGapClient(c.config)
+	c.LiveSchedule = NewLiveScheduleClient(c.config)
 	c.LiveTitleRegex = NewLiveTitleRegexClient(c.config)
 	c.MultistreamInfo = NewMultistreamInfoClient(c.config)
 	c.MutedSegment = NewMutedSegmentClient(c.config)
@@ -107,8 +135,12 @@ func (c *Client) init() {
 	c.PlaylistRuleGroup = NewPlaylistRuleGroupClient(c.config)
 	c.Queue = NewQueueClient(c.config)
 	c.Sessions = NewSessionsClient(c.config)
+	c.TranscodingProfile = NewTranscodingProfileClient(c.config)
 	c.TwitchCategory = NewTwitchCategoryClient(c.config)
 	c.User = NewUserClient(c.config)
+	c.UserList = NewUserListClient(c.config)
+	c.UserListItem = NewUserListItemClient(c.config)
+	c.ViewingSession = NewViewingSessionClient(c.config)
 	c.Vod = NewVodClient(c.config)
 }
 
@@ -200,27 +232,35 @@ func (c *Client) Tx(ctx context.Context) (*Tx, error) {
 	cfg := c.config
 	cfg.driver = tx
 	return &Tx{
-		ctx:               ctx,
-		config:            cfg,
-		ApiKey:            NewApiKeyClient(cfg),
-		BlockedVideos:     NewBlockedVideosClient(cfg),
-		Channel:           NewChannelClient(cfg),
-		Chapter:           NewChapterClient(cfg),
-		Live:              NewLiveClient(cfg),
-		LiveCategory:      NewLiveCategoryClient(cfg),
-		LiveTitleRegex:    NewLiveTitleRegexClient(cfg),
-		MultistreamInfo:   NewMultistreamInfoClient(cfg),
-		MutedSegment:      NewMutedSegmentClient(cfg),
-		Notification:      NewNotificationClient(cfg),
-		Playback:          NewPlaybackClient(cfg),
-		Playlist:          NewPlaylistClient(cfg),
-		PlaylistRule:      NewPlaylistRuleClient(cfg),
-		PlaylistRuleGroup: NewPlaylistRuleGroupClient(cfg),
-		Queue:             NewQueueClient(cfg),
-		Sessions:          NewSessionsClient(cfg),
-		TwitchCategory:    NewTwitchCategoryClient(cfg),
-		User:              NewUserClient(cfg),
-		Vod:               NewVodClient(cfg),
+		ctx:                ctx,
+		config:             cfg,
+		ApiKey:             NewApiKeyClient(cfg),
+		BlockedVideos:      NewBlockedVideosClient(cfg),
+		Channel:            NewChannelClient(cfg),
+		Chapter:            NewChapterClient(cfg),
+		ChatMessage:        NewChatMessageClient(cfg),
+		Checksum:           NewChecksumClient(cfg),
+		Live:               NewLiveClient(cfg),
+		LiveCategory:       NewLiveCategoryClient(cfg),
+		LiveGap:            NewLiveGapClient(cfg),
+		LiveSchedule:       NewLiveScheduleClient(cfg),
+		LiveTitleRegex:     NewLiveTitleRegexClient(cfg),
+		MultistreamInfo:    NewMultistreamInfoClient(cfg),
+		MutedSegment:       NewMutedSegmentClient(cfg),
+		Notification:       NewNotificationClient(cfg),
+		Playback:           NewPlaybackClient(cfg),
+		Playlist:           NewPlaylistClient(cfg),
+		PlaylistRule:       NewPlaylistRuleClient(cfg),
+		PlaylistRuleGroup:  NewPlaylistRuleGroupClient(cfg),
+		Queue:              NewQueueClient(cfg),
+		Sessions:           NewSessionsClient(cfg),
+		TranscodingProfile: NewTranscodingProfileClient(cfg),
+		TwitchCategory:     NewTwitchCategoryClient(cfg),
+		User:               NewUserClient(cfg),
+		UserList:           NewUserListClient(cfg),
+		UserListItem:       NewUserListItemClient(cfg),
+		ViewingSession:     NewViewingSessionClient(cfg),
+		Vod:                NewVodClient(cfg),
 	}, nil
 }
 
@@ -238,27 +278,35 @@ func (c *Client) BeginTx(ctx context.Context, opts *sql.TxOptions) (*Tx, error)
 	cfg := c.config
 	cfg.driver = &txDriver{tx: tx, drv: c.driver}
 	return &Tx{
-		ctx:               ctx,
-		config:            cfg,
-		ApiKey:            NewApiKeyClient(cfg),
-		BlockedVideos:     NewBlockedVideosClient(cfg),
-		Channel:           NewChannelClient(cfg),
-		Chapter:           NewChapterClient(cfg),
-		Live:              NewLiveClient(cfg),
-		LiveCategory:      NewLiveCategoryClient(cfg),
-		LiveTitleRegex:    NewLiveTitleRegexClient(cfg),
-		MultistreamInfo:   NewMultistreamInfoClient(cfg),
-		MutedSegment:      NewMutedSegmentClient(cfg),
-		Notification:      NewNotificationClient(cfg),
-		Playback:          NewPlaybackClient(cfg),
-		Playlist:          NewPlaylistClient(cfg),
-		PlaylistRule:      NewPlaylistRuleClient(cfg),
-		PlaylistRuleGroup: NewPlaylistRuleGroupClient(cfg),
-		Queue:             NewQueueClient(cfg),
-		Sessions:          NewSessionsClient(cfg),
-		TwitchCategory:    NewTwitchCategoryClient(cfg),
-		User:              NewUserClient(cfg),
-		Vod:               NewVodClient(cfg),
+		ctx:                ctx,
+		config:             cfg,
+		ApiKey:             NewApiKeyClient(cfg),
+		BlockedVideos:      NewBlockedVideosClient(cfg),
+		Channel:            NewChannelClient(cfg),
+		Chapter:            NewChapterClient(cfg),
+		ChatMessage:        NewChatMessageClient(cfg),
+		Checksum:           NewChecksumClient(cfg),
+		Live:               NewLiveClient(cfg),
+		LiveCategory:       NewLiveCategoryClient(cfg),
+		LiveGap:            NewLiveGapClient(cfg),
+		LiveSchedule:       NewLiveScheduleClient(cfg),
+		LiveTitleRegex:     NewLiveTitleRegexClient(cfg),
+		MultistreamInfo:    NewMultistreamInfoClient(cfg),
+		MutedSegment:       NewMutedSegmentClient(cfg),
+		Notification:       NewNotificationClient(cfg),
+		Playback:           NewPlaybackClient(cfg),
+		Playlist:           NewPlaylistClient(cfg),
+		PlaylistRule:       NewPlaylistRuleClient(cfg),
+		PlaylistRuleGroup:  NewPlaylistRuleGroupClient(cfg),
+		Queue:              NewQueueClient(cfg),
+		Sessions:           NewSessionsClient(cfg),
+		TranscodingProfile: NewTranscodingProfileClient(cfg),
+		TwitchCategory:     NewTwitchCategoryClient(cfg),
+		User:               NewUserClient(cfg),
+		UserList:           NewUserListClient(cfg),
+		UserListItem:       NewUserListItemClient(cfg),
+		ViewingSession:     NewViewingSessionClient(cfg),
+		Vod:                NewVodClient(cfg),
 	}, nil
 }
 
@@ -288,10 +336,11 @@ func (c *Client) Close() error {
 // In order to add hooks to a specific client, call: `client.Node.Use(...)`.
 func (c *Client) Use(hooks ...Hook) {
 	for _, n := range []interface{ Use(...Hook) }{
-		c.ApiKey, c.BlockedVideos, c.Channel, c.Chapter, c.Live, c.LiveCategory,
-		c.LiveTitleRegex, c.MultistreamInfo, c.MutedSegment, c.Notification,
-		c.Playback, c.Playlist, c.PlaylistRule, c.PlaylistRuleGroup, c.Queue,
-		c.Sessions, c.TwitchCategory, c.User, c.Vod,
+		c.ApiKey, c.BlockedVideos, c.Channel, c.Chapter, c.ChatMessage, c.Checksum,
+		c.Live, c.LiveCategory, c.LiveGap, c.LiveSchedule, c.LiveTitleRegex,
+		c.MultistreamInfo, c.MutedSegment, c.Notification, c.Playback, c.Playlist,
+		c.PlaylistRule, c.PlaylistRuleGroup, c.Queue, c.Sessions, c.TranscodingProfile,
+		c.TwitchCategory, c.User, c.UserList, c.UserListItem, c.ViewingSession, c.Vod,
 	} {
 		n.Use(hooks...)
 	}
@@ -301,10 +350,11 @@ func (c *Client) Use(hooks ...Hook) {
 // In order to add interceptors to a specific client, call: `client.Node.Intercept(...)`.
 func (c *Client) Intercept(interceptors ...Interceptor) {
 	for _, n := range []interface{ Intercept(...Interceptor) }{
-		c.ApiKey, c.BlockedVideos, c.Channel, c.Chapter, c.Live, c.LiveCategory,
-		c.LiveTitleRegex, c.MultistreamInfo, c.MutedSegment, c.Notification,
-		c.Playback, c.Playlist, c.PlaylistRule, c.PlaylistRuleGroup, c.Queue,
-		c.Sessions, c.TwitchCategory, c.User, c.Vod,
+		c.ApiKey, c.BlockedVideos, c.Channel, c.Chapter, c.ChatMessage, c.Checksum,
+		c.Live, c.LiveCategory, c.LiveGap, c.LiveSchedule, c.LiveTitleRegex,
+		c.MultistreamInfo, c.MutedSegment, c.Notification, c.Playback, c.Playlist,
+		c.PlaylistRule, c.PlaylistRuleGroup, c.Queue, c.Sessions, c.TranscodingProfile,
+		c.TwitchCategory, c.User, c.UserList, c.UserListItem, c.ViewingSession, c.Vod,
 	} {
 		n.Intercept(interceptors...)
 	}
@@ -321,10 +371,18 @@ func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 		return c.Channel.mutate(ctx, m)
 	case *ChapterMutation:
 		return c.Chapter.mutate(ctx, m)
+	case *ChatMessageMutation:
+		return c.ChatMessage.mutate(ctx, m)
+	case *ChecksumMutation:
+		return c.Checksum.mutate(ctx, m)
 	case *LiveMutation:
 		return c.Live.mutate(ctx, m)
 	case *LiveCategoryMutation:
 		return c.LiveCategory.mutate(ctx, m)
+	case *LiveGapMutation:
+		return c.LiveGap.mutate(ctx, m)
+	case *LiveScheduleMutation:
+		return c.LiveSchedule.mutate(ctx, m)
 	case *LiveTitleRegexMutation:
 		return c.LiveTitleRegex.mutate(ctx, m)
 	case *MultistreamInfoMutation:
@@ -345,10 +403,18 @@ func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 		return c.Queue.mutate(ctx, m)
 	case *SessionsMutation:
 		return c.Sessions.mutate(ctx, m)
+	case *TranscodingProfileMutation:
+		return c.TranscodingProfile.mutate(ctx, m)
 	case *TwitchCategoryMutation:
 		return c.TwitchCategory.mutate(ctx, m)
 	case *UserMutation:
 		return c.User.mutate(ctx, m)
+	case *UserListMutation:
+		return c.UserList.mutate(ctx, m)
+	case *UserListItemMutation:
+		return c.UserListItem.mutate(ctx, m)
+	case *ViewingSessionMutation:
+		return c.ViewingSession.mutate(ctx, m)
 	case *VodMutation:
 		return c.Vod.mutate(ctx, m)
 	default:
@@ -952,6 +1018,304 @@ func (c *ChapterClient) mutate(ctx context.Context, m *ChapterMutation) (Value,
 	}
 }
 
+// ChatMessageClient is a client for the ChatMessage schema.
+type ChatMessageClient struct {
+	config
+}
+
+// NewChatMessageClient returns a client for the ChatMessage from the given config.
+func NewChatMessageClient(c config) *ChatMessageClient {
+	return &ChatMessageClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `chatmessage.Hooks(f(g(h())))`.
+func (c *ChatMessageClient) Use(hooks ...Hook) {
+	c.hooks.ChatMessage = append(c.hooks.ChatMessage, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `chatmessage.Intercept(f(g(h())))`.
+func (c *ChatMessageClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ChatMessage = append(c.inters.ChatMessage, interceptors...)
+}
+
+// Create returns a builder for creating a ChatMessage entity.
+func (c *ChatMessageClient) Create() *ChatMessageCreate {
+	mutation := newChatMessageMutation(c.config, OpCreate)
+	return &ChatMessageCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of ChatMessage entities.
+func (c *ChatMessageClient) CreateBulk(builders ...*ChatMessageCreate) *ChatMessageCreateBulk {
+	return &ChatMessageCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *ChatMessageClient) MapCreateBulk(slice any, setFunc func(*ChatMessageCreate, int)) *ChatMessageCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &ChatMessageCreateBulk{err: fmt.Errorf("calling to ChatMessageClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*ChatMessageCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &ChatMessageCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for ChatMessage.
+func (c *ChatMessageClient) Update() *ChatMessageUpdate {
+	mutation := newChatMessageMutation(c.config, OpUpdate)
+	return &ChatMessageUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ChatMessageClient) UpdateOne(_m *ChatMessage) *ChatMessageUpdateOne {
+	mutation := newChatMessageMutation(c.config, OpUpdateOne, withChatMessage(_m))
+	return &ChatMessageUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ChatMessageClient) UpdateOneID(id uuid.UUID) *ChatMessageUpdateOne {
+	mutation := newChatMessageMutation(c.config, OpUpdateOne, withChatMessageID(id))
+	return &ChatMessageUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for ChatMessage.
+func (c *ChatMessageClient) Delete() *ChatMessageDelete {
+	mutation := newChatMessageMutation(c.config, OpDelete)
+	return &ChatMessageDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *ChatMessageClient) DeleteOne(_m *ChatMessage) *ChatMessageDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *ChatMessageClient) DeleteOneID(id uuid.UUID) *ChatMessageDeleteOne {
+	builder := c.Delete().Where(chatmessage.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ChatMessageDeleteOne{builder}
+}
+
+// Query returns a query builder for ChatMessage.
+func (c *ChatMessageClient) Query() *ChatMessageQuery {
+	return &ChatMessageQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeChatMessage},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a ChatMessage entity by its id.
+func (c *ChatMessageClient) Get(ctx context.Context, id uuid.UUID) (*ChatMessage, error) {
+	return c.Query().Where(chatmessage.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ChatMessageClient) GetX(ctx context.Context, id uuid.UUID) *ChatMessage {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryVod queries the vod edge of a ChatMessage.
+func (c *ChatMessageClient) QueryVod(_m *ChatMessage) *VodQuery {
+	query := (&VodClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(chatmessage.Table, chatmessage.FieldID, id),
+			sqlgraph.To(vod.Table, vod.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, chatmessage.VodTable, chatmessage.VodColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *ChatMessageClient) Hooks() []Hook {
+	return c.hooks.ChatMessage
+}
+
+// Interceptors returns the client interceptors.
+func (c *ChatMessageClient) Interceptors() []Interceptor {
+	return c.inters.ChatMessage
+}
+
+func (c *ChatMessageClient) mutate(ctx context.Context, m *ChatMessageMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&ChatMessageCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&ChatMessageUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&ChatMessageUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&ChatMessageDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown ChatMessage mutation op: %q", m.Op())
+	}
+}
+
+// ChecksumClient is a client for the Checksum schema.
+type ChecksumClient struct {
+	config
+}
+
+// NewChecksumClient returns a client for the Checksum from the given config.
+func NewChecksumClient(c config) *ChecksumClient {
+	return &ChecksumClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `checksum.Hooks(f(g(h())))`.
+func (c *ChecksumClient) Use(hooks ...Hook) {
+	c.hooks.Checksum = append(c.hooks.Checksum, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `checksum.Intercept(f(g(h())))`.
+func (c *ChecksumClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Checksum = append(c.inters.Checksum, interceptors...)
+}
+
+// Create returns a builder for creating a Checksum entity.
+func (c *ChecksumClient) Create() *ChecksumCreate {
+	mutation := newChecksumMutation(c.config, OpCreate)
+	return &ChecksumCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Checksum entities.
+func (c *ChecksumClient) CreateBulk(builders ...*ChecksumCreate) *ChecksumCreateBulk {
+	return &ChecksumCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *ChecksumClient) MapCreateBulk(slice any, setFunc func(*ChecksumCreate, int)) *ChecksumCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &ChecksumCreateBulk{err: fmt.Errorf("calling to ChecksumClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*ChecksumCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &ChecksumCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Checksum.
+func (c *ChecksumClient) Update() *ChecksumUpdate {
+	mutation := newChecksumMutation(c.config, OpUpdate)
+	return &ChecksumUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ChecksumClient) UpdateOne(_m *Checksum) *ChecksumUpdateOne {
+	mutation := newChecksumMutation(c.config, OpUpdateOne, withChecksum(_m))
+	return &ChecksumUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ChecksumClient) UpdateOneID(id uuid.UUID) *ChecksumUpdateOne {
+	mutation := newChecksumMutation(c.config, OpUpdateOne, withChecksumID(id))
+	return &ChecksumUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Checksum.
+func (c *ChecksumClient) Delete() *ChecksumDelete {
+	mutation := newChecksumMutation(c.config, OpDelete)
+	return &ChecksumDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *ChecksumClient) DeleteOne(_m *Checksum) *ChecksumDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *ChecksumClient) DeleteOneID(id uuid.UUID) *ChecksumDeleteOne {
+	builder := c.Delete().Where(checksum.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ChecksumDeleteOne{builder}
+}
+
+// Query returns a query builder for Checksum.
+func (c *ChecksumClient) Query() *ChecksumQuery {
+	return &ChecksumQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeChecksum},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Checksum entity by its id.
+func (c *ChecksumClient) Get(ctx context.Context, id uuid.UUID) (*Checksum, error) {
+	return c.Query().Where(checksum.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ChecksumClient) GetX(ctx context.Context, id uuid.UUID) *Checksum {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryVod queries the vod edge of a Checksum.
+func (c *ChecksumClient) QueryVod(_m *Checksum) *VodQuery {
+	query := (&VodClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(checksum.Table, checksum.FieldID, id),
+			sqlgraph.To(vod.Table, vod.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, checksum.VodTable, checksum.VodColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *ChecksumClient) Hooks() []Hook {
+	return c.hooks.Checksum
+}
+
+// Interceptors returns the client interceptors.
+func (c *ChecksumClient) Interceptors() []Interceptor {
+	return c.inters.Checksum
+}
+
+func (c *ChecksumClient) mutate(ctx context.Context, m *ChecksumMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&ChecksumCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&ChecksumUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&ChecksumUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&ChecksumDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Checksum mutation op: %q", m.Op())
+	}
+}
+
 // LiveClient is a client for the Live schema.
 type LiveClient struct {
 	config
@@ -1108,6 +1472,38 @@ func (c *LiveClient) QueryTitleRegex(_m *Live) *LiveTitleRegexQuery {
 	return query
 }
 
+// QuerySchedule queries the schedule edge of a Live.
+func (c *LiveClient) QuerySchedule(_m *Live) *LiveScheduleQuery {
+	query := (&LiveScheduleClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(live.Table, live.FieldID, id),
+			sqlgraph.To(liveschedule.Table, liveschedule.FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, false, live.ScheduleTable, live.ScheduleColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryTranscodingProfile queries the transcoding_profile edge of a Live.
+func (c *LiveClient) QueryTranscodingProfile(_m *Live) *TranscodingProfileQuery {
+	query := (&TranscodingProfileClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(live.Table, live.FieldID, id),
+			sqlgraph.To(transcodingprofile.Table, transcodingprofile.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, live.TranscodingProfileTable, live.TranscodingProfileColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
 // Hooks returns the client hooks.
 func (c *LiveClient) Hooks() []Hook {
 	return c.hooks.Live
@@ -1282,107 +1678,107 @@ func (c *LiveCategoryClient) mutate(ctx context.Context, m *LiveCategoryMutation
 	}
 }
 
-// LiveTitleRegexClient is a client for the LiveTitleRegex schema.
-type LiveTitleRegexClient struct {
+// LiveGapClient is a client for the LiveGap schema.
+type LiveGapClient struct {
 	config
 }
 
-// NewLiveTitleRegexClient returns a client for the LiveTitleRegex from the given config.
-func NewLiveTitleRegexClient(c config) *LiveTitleRegexClient {
-	return &LiveTitleRegexClient{config: c}
+// NewLiveGapClient returns a client for the LiveGap from the given config.
+func NewLiveGapClient(c config) *LiveGapClient {
+	return &LiveGapClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `livetitleregex.Hooks(f(g(h())))`.
-func (c *LiveTitleRegexClient) Use(hooks ...Hook) {
-	c.hooks.LiveTitleRegex = append(c.hooks.LiveTitleRegex, hooks...)
+// A call to `Use(f, g, h)` equals to `livegap.Hooks(f(g(h())))`.
+func (c *LiveGapClient) Use(hooks ...Hook) {
+	c.hooks.LiveGap = append(c.hooks.LiveGap, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `livetitleregex.Intercept(f(g(h())))`.
-func (c *LiveTitleRegexClient) Intercept(interceptors ...Interceptor) {
-	c.inters.LiveTitleRegex = append(c.inters.LiveTitleRegex, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `livegap.Intercept(f(g(h())))`.
+func (c *LiveGapClient) Intercept(interceptors ...Interceptor) {
+	c.inters.LiveGap = append(c.inters.LiveGap, interceptors...)
 }
 
-// Create returns a builder for creating a LiveTitleRegex entity.
-func (c *LiveTitleRegexClient) Create() *LiveTitleRegexCreate {
-	mutation := newLiveTitleRegexMutation(c.config, OpCreate)
-	return &LiveTitleRegexCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a LiveGap entity.
+func (c *LiveGapClient) Create() *LiveGapCreate {
+	mutation := newLiveGapMutation(c.config, OpCreate)
+	return &LiveGapCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of LiveTitleRegex entities.
-func (c *LiveTitleRegexClient) CreateBulk(builders ...*LiveTitleRegexCreate) *LiveTitleRegexCreateBulk {
-	return &LiveTitleRegexCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of LiveGap entities.
+func (c *LiveGapClient) CreateBulk(builders ...*LiveGapCreate) *LiveGapCreateBulk {
+	return &LiveGapCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *LiveTitleRegexClient) MapCreateBulk(slice any, setFunc func(*LiveTitleRegexCreate, int)) *LiveTitleRegexCreateBulk {
+func (c *LiveGapClient) MapCreateBulk(slice any, setFunc func(*LiveGapCreate, int)) *LiveGapCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &LiveTitleRegexCreateBulk{err: fmt.Errorf("calling to LiveTitleRegexClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &LiveGapCreateBulk{err: fmt.Errorf("calling to LiveGapClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*LiveTitleRegexCreate, rv.Len())
+	builders := make([]*LiveGapCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &LiveTitleRegexCreateBulk{config: c.config, builders: builders}
+	return &LiveGapCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for LiveTitleRegex.
-func (c *LiveTitleRegexClient) Update() *LiveTitleRegexUpdate {
-	mutation := newLiveTitleRegexMutation(c.config, OpUpdate)
-	return &LiveTitleRegexUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for LiveGap.
+func (c *LiveGapClient) Update() *LiveGapUpdate {
+	mutation := newLiveGapMutation(c.config, OpUpdate)
+	return &LiveGapUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *LiveTitleRegexClient) UpdateOne(_m *LiveTitleRegex) *LiveTitleRegexUpdateOne {
-	mutation := newLiveTitleRegexMutation(c.config, OpUpdateOne, withLiveTitleRegex(_m))
-	return &LiveTitleRegexUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *LiveGapClient) UpdateOne(_m *LiveGap) *LiveGapUpdateOne {
+	mutation := newLiveGapMutation(c.config, OpUpdateOne, withLiveGap(_m))
+	return &LiveGapUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *LiveTitleRegexClient) UpdateOneID(id uuid.UUID) *LiveTitleRegexUpdateOne {
-	mutation := newLiveTitleRegexMutation(c.config, OpUpdateOne, withLiveTitleRegexID(id))
-	return &LiveTitleRegexUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *LiveGapClient) UpdateOneID(id uuid.UUID) *LiveGapUpdateOne {
+	mutation := newLiveGapMutation(c.config, OpUpdateOne, withLiveGapID(id))
+	return &LiveGapUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for LiveTitleRegex.
-func (c *LiveTitleRegexClient) Delete() *LiveTitleRegexDelete {
-	mutation := newLiveTitleRegexMutation(c.config, OpDelete)
-	return &LiveTitleRegexDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for LiveGap.
+func (c *LiveGapClient) Delete() *LiveGapDelete {
+	mutation := newLiveGapMutation(c.config, OpDelete)
+	return &LiveGapDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *LiveTitleRegexClient) DeleteOne(_m *LiveTitleRegex) *LiveTitleRegexDeleteOne {
+func (c *LiveGapClient) DeleteOne(_m *LiveGap) *LiveGapDeleteOne {
 	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *LiveTitleRegexClient) DeleteOneID(id uuid.UUID) *LiveTitleRegexDeleteOne {
-	builder := c.Delete().Where(livetitleregex.ID(id))
+func (c *LiveGapClient) DeleteOneID(id uuid.UUID) *LiveGapDeleteOne {
+	builder := c.Delete().Where(livegap.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &LiveTitleRegexDeleteOne{builder}
+	return &LiveGapDeleteOne{builder}
 }
 
-// Query returns a query builder for LiveTitleRegex.
-func (c *LiveTitleRegexClient) Query() *LiveTitleRegexQuery {
-	return &LiveTitleRegexQuery{
+// Query returns a query builder for LiveGap.
+func (c *LiveGapClient) Query() *LiveGapQuery {
+	return &LiveGapQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeLiveTitleRegex},
+		ctx:    &QueryContext{Type: TypeLiveGap},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a LiveTitleRegex entity by its id.
-func (c *LiveTitleRegexClient) Get(ctx context.Context, id uuid.UUID) (*LiveTitleRegex, error) {
-	return c.Query().Where(livetitleregex.ID(id)).Only(ctx)
+// Get returns a LiveGap entity by its id.
+func (c *LiveGapClient) Get(ctx context.Context, id uuid.UUID) (*LiveGap, error) {
+	return c.Query().Where(livegap.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *LiveTitleRegexClient) GetX(ctx context.Context, id uuid.UUID) *LiveTitleRegex {
+func (c *LiveGapClient) GetX(ctx context.Context, id uuid.UUID) *LiveGap {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -1390,15 +1786,15 @@ func (c *LiveTitleRegexClient) GetX(ctx context.Context, id uuid.UUID) *LiveTitl
 	return obj
 }
 
-// QueryLive queries the live edge of a LiveTitleRegex.
-func (c *LiveTitleRegexClient) QueryLive(_m *LiveTitleRegex) *LiveQuery {
-	query := (&LiveClient{config: c.config}).Query()
+// QueryVod queries the vod edge of a LiveGap.
+func (c *LiveGapClient) QueryVod(_m *LiveGap) *VodQuery {
+	query := (&VodClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := _m.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(livetitleregex.Table, livetitleregex.FieldID, id),
-			sqlgraph.To(live.Table, live.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, livetitleregex.LiveTable, livetitleregex.LiveColumn),
+			sqlgraph.From(livegap.Table, livegap.FieldID, id),
+			sqlgraph.To(vod.Table, vod.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, livegap.VodTable, livegap.VodColumn),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
@@ -1407,13 +1803,311 @@ func (c *LiveTitleRegexClient) QueryLive(_m *LiveTitleRegex) *LiveQuery {
 }
 
 // Hooks returns the client hooks.
-func (c *LiveTitleRegexClient) Hooks() []Hook {
-	return c.hooks.LiveTitleRegex
+func (c *LiveGapClient) Hooks() []Hook {
+	return c.hooks.LiveGap
 }
 
 // Interceptors returns the client interceptors.
-func (c *LiveTitleRegexClient) Interceptors() []Interceptor {
-	return c.inters.LiveTitleRegex
+func (c *LiveGapClient) Interceptors() []Interceptor {
+	return c.inters.LiveGap
+}
+
+func (c *LiveGapClient) mutate(ctx context.Context, m *LiveGapMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&LiveGapCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&LiveGapUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&LiveGapUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&LiveGapDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown LiveGap mutation op: %q", m.Op())
+	}
+}
+
+// LiveScheduleClient is a client for the LiveSchedule schema.
+type LiveScheduleClient struct {
+	config
+}
+
+// NewLiveScheduleClient returns a client for the LiveSchedule from the given config.
+func NewLiveScheduleClient(c config) *LiveScheduleClient {
+	return &LiveScheduleClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `liveschedule.Hooks(f(g(h())))`.
+func (c *LiveScheduleClient) Use(hooks ...Hook) {
+	c.hooks.LiveSchedule = append(c.hooks.LiveSchedule, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `liveschedule.Intercept(f(g(h())))`.
+func (c *LiveScheduleClient) Intercept(interceptors ...Interceptor) {
+	c.inters.LiveSchedule = append(c.inters.LiveSchedule, interceptors...)
+}
+
+// Create returns a builder for creating a LiveSchedule entity.
+func (c *LiveScheduleClient) Create() *LiveScheduleCreate {
+	mutation := newLiveScheduleMutation(c.config, OpCreate)
+	return &LiveScheduleCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of LiveSchedule entities.
+func (c *LiveScheduleClient) CreateBulk(builders ...*LiveScheduleCreate) *LiveScheduleCreateBulk {
+	return &LiveScheduleCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *LiveScheduleClient) MapCreateBulk(slice any, setFunc func(*LiveScheduleCreate, int)) *LiveScheduleCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &LiveScheduleCreateBulk{err: fmt.Errorf("calling to LiveScheduleClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*LiveScheduleCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &LiveScheduleCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for LiveSchedule.
+func (c *LiveScheduleClient) Update() *LiveScheduleUpdate {
+	mutation := newLiveScheduleMutation(c.config, OpUpdate)
+	return &LiveScheduleUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *LiveScheduleClient) UpdateOne(_m *LiveSchedule) *LiveScheduleUpdateOne {
+	mutation := newLiveScheduleMutation(c.config, OpUpdateOne, withLiveSchedule(_m))
+	return &LiveScheduleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *LiveScheduleClient) UpdateOneID(id uuid.UUID) *LiveScheduleUpdateOne {
+	mutation := newLiveScheduleMutation(c.config, OpUpdateOne, withLiveScheduleID(id))
+	return &LiveScheduleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for LiveSchedule.
+func (c *LiveScheduleClient) Delete() *LiveScheduleDelete {
+	mutation := newLiveScheduleMutation(c.config, OpDelete)
+	return &LiveScheduleDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *LiveScheduleClient) DeleteOne(_m *LiveSchedule) *LiveScheduleDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *LiveScheduleClient) DeleteOneID(id uuid.UUID) *LiveScheduleDeleteOne {
+	builder := c.Delete().Where(liveschedule.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &LiveScheduleDeleteOne{builder}
+}
+
+// Query returns a query builder for LiveSchedule.
+func (c *LiveScheduleClient) Query() *LiveScheduleQuery {
+	return &LiveScheduleQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeLiveSchedule},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a LiveSchedule entity by its id.
+func (c *LiveScheduleClient) Get(ctx context.Context, id uuid.UUID) (*LiveSchedule, error) {
+	return c.Query().Where(liveschedule.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *LiveScheduleClient) GetX(ctx context.Context, id uuid.UUID) *LiveSchedule {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryLive queries the live edge of a LiveSchedule.
+func (c *LiveScheduleClient) QueryLive(_m *LiveSchedule) *LiveQuery {
+	query := (&LiveClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(liveschedule.Table, liveschedule.FieldID, id),
+			sqlgraph.To(live.Table, live.FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, true, liveschedule.LiveTable, liveschedule.LiveColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *LiveScheduleClient) Hooks() []Hook {
+	return c.hooks.LiveSchedule
+}
+
+// Interceptors returns the client interceptors.
+func (c *LiveScheduleClient) Interceptors() []Interceptor {
+	return c.inters.LiveSchedule
+}
+
+func (c *LiveScheduleClient) mutate(ctx context.Context, m *LiveScheduleMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&LiveScheduleCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&LiveScheduleUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&LiveScheduleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&LiveScheduleDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown LiveSchedule mutation op: %q", m.Op())
+	}
+}
+
+// LiveTitleRegexClient is a client for the LiveTitleRegex schema.
+type LiveTitleRegexClient struct {
+	config
+}
+
+// NewLiveTitleRegexClient returns a client for the LiveTitleRegex from the given config.
+func NewLiveTitleRegexClient(c config) *LiveTitleRegexClient {
+	return &LiveTitleRegexClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `livetitleregex.Hooks(f(g(h())))`.
+func (c *LiveTitleRegexClient) Use(hooks ...Hook) {
+	c.hooks.LiveTitleRegex = append(c.hooks.LiveTitleRegex, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `livetitleregex.Intercept(f(g(h())))`.
+func (c *LiveTitleRegexClient) Intercept(interceptors ...Interceptor) {
+	c.inters.LiveTitleRegex = append(c.inters.LiveTitleRegex, interceptors...)
+}
+
+// Create returns a builder for creating a LiveTitleRegex entity.
+func (c *LiveTitleRegexClient) Create() *LiveTitleRegexCreate {
+	mutation := newLiveTitleRegexMutation(c.config, OpCreate)
+	return &LiveTitleRegexCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of LiveTitleRegex entities.
+func (c *LiveTitleRegexClient) CreateBulk(builders ...*LiveTitleRegexCreate) *LiveTitleRegexCreateBulk {
+	return &LiveTitleRegexCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *LiveTitleRegexClient) MapCreateBulk(slice any, setFunc func(*LiveTitleRegexCreate, int)) *LiveTitleRegexCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &LiveTitleRegexCreateBulk{err: fmt.Errorf("calling to LiveTitleRegexClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*LiveTitleRegexCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &LiveTitleRegexCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for LiveTitleRegex.
+func (c *LiveTitleRegexClient) Update() *LiveTitleRegexUpdate {
+	mutation := newLiveTitleRegexMutation(c.config, OpUpdate)
+	return &LiveTitleRegexUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *LiveTitleRegexClient) UpdateOne(_m *LiveTitleRegex) *LiveTitleRegexUpdateOne {
+	mutation := newLiveTitleRegexMutation(c.config, OpUpdateOne, withLiveTitleRegex(_m))
+	return &LiveTitleRegexUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *LiveTitleRegexClient) UpdateOneID(id uuid.UUID) *LiveTitleRegexUpdateOne {
+	mutation := newLiveTitleRegexMutation(c.config, OpUpdateOne, withLiveTitleRegexID(id))
+	return &LiveTitleRegexUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for LiveTitleRegex.
+func (c *LiveTitleRegexClient) Delete() *LiveTitleRegexDelete {
+	mutation := newLiveTitleRegexMutation(c.config, OpDelete)
+	return &LiveTitleRegexDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *LiveTitleRegexClient) DeleteOne(_m *LiveTitleRegex) *LiveTitleRegexDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *LiveTitleRegexClient) DeleteOneID(id uuid.UUID) *LiveTitleRegexDeleteOne {
+	builder := c.Delete().Where(livetitleregex.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &LiveTitleRegexDeleteOne{builder}
+}
+
+// Query returns a query builder for LiveTitleRegex.
+func (c *LiveTitleRegexClient) Query() *LiveTitleRegexQuery {
+	return &LiveTitleRegexQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeLiveTitleRegex},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a LiveTitleRegex entity by its id.
+func (c *LiveTitleRegexClient) Get(ctx context.Context, id uuid.UUID) (*LiveTitleRegex, error) {
+	return c.Query().Where(livetitleregex.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *LiveTitleRegexClient) GetX(ctx context.Context, id uuid.UUID) *LiveTitleRegex {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryLive queries the live edge of a LiveTitleRegex.
+func (c *LiveTitleRegexClient) QueryLive(_m *LiveTitleRegex) *LiveQuery {
+	query := (&LiveClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(livetitleregex.Table, livetitleregex.FieldID, id),
+			sqlgraph.To(live.Table, live.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, livetitleregex.LiveTable, livetitleregex.LiveColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *LiveTitleRegexClient) Hooks() []Hook {
+	return c.hooks.LiveTitleRegex
+}
+
+// Interceptors returns the client interceptors.
+func (c *LiveTitleRegexClient) Interceptors() []Interceptor {
+	return c.inters.LiveTitleRegex
 }
 
 func (c *LiveTitleRegexClient) mutate(ctx context.Context, m *LiveTitleRegexMutation) (Value, error) {
@@ -2788,58 +3482,191 @@ func (c *SessionsClient) mutate(ctx context.Context, m *SessionsMutation) (Value
 	}
 }
 
-// TwitchCategoryClient is a client for the TwitchCategory schema.
-type TwitchCategoryClient struct {
+// TranscodingProfileClient is a client for the TranscodingProfile schema.
+type TranscodingProfileClient struct {
 	config
 }
 
-// NewTwitchCategoryClient returns a client for the TwitchCategory from the given config.
-func NewTwitchCategoryClient(c config) *TwitchCategoryClient {
-	return &TwitchCategoryClient{config: c}
+// NewTranscodingProfileClient returns a client for the TranscodingProfile from the given config.
+func NewTranscodingProfileClient(c config) *TranscodingProfileClient {
+	return &TranscodingProfileClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `twitchcategory.Hooks(f(g(h())))`.
-func (c *TwitchCategoryClient) Use(hooks ...Hook) {
-	c.hooks.TwitchCategory = append(c.hooks.TwitchCategory, hooks...)
+// A call to `Use(f, g, h)` equals to `transcodingprofile.Hooks(f(g(h())))`.
+func (c *TranscodingProfileClient) Use(hooks ...Hook) {
+	c.hooks.TranscodingProfile = append(c.hooks.TranscodingProfile, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `twitchcategory.Intercept(f(g(h())))`.
-func (c *TwitchCategoryClient) Intercept(interceptors ...Interceptor) {
-	c.inters.TwitchCategory = append(c.inters.TwitchCategory, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `transcodingprofile.Intercept(f(g(h())))`.
+func (c *TranscodingProfileClient) Intercept(interceptors ...Interceptor) {
+	c.inters.TranscodingProfile = append(c.inters.TranscodingProfile, interceptors...)
 }
 
-// Create returns a builder for creating a TwitchCategory entity.
-func (c *TwitchCategoryClient) Create() *TwitchCategoryCreate {
-	mutation := newTwitchCategoryMutation(c.config, OpCreate)
-	return &TwitchCategoryCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a TranscodingProfile entity.
+func (c *TranscodingProfileClient) Create() *TranscodingProfileCreate {
+	mutation := newTranscodingProfileMutation(c.config, OpCreate)
+	return &TranscodingProfileCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of TwitchCategory entities.
-func (c *TwitchCategoryClient) CreateBulk(builders ...*TwitchCategoryCreate) *TwitchCategoryCreateBulk {
-	return &TwitchCategoryCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of TranscodingProfile entities.
+func (c *TranscodingProfileClient) CreateBulk(builders ...*TranscodingProfileCreate) *TranscodingProfileCreateBulk {
+	return &TranscodingProfileCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *TwitchCategoryClient) MapCreateBulk(slice any, setFunc func(*TwitchCategoryCreate, int)) *TwitchCategoryCreateBulk {
+func (c *TranscodingProfileClient) MapCreateBulk(slice any, setFunc func(*TranscodingProfileCreate, int)) *TranscodingProfileCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &TwitchCategoryCreateBulk{err: fmt.Errorf("calling to TwitchCategoryClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &TranscodingProfileCreateBulk{err: fmt.Errorf("calling to TranscodingProfileClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*TwitchCategoryCreate, rv.Len())
+	builders := make([]*TranscodingProfileCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &TwitchCategoryCreateBulk{config: c.config, builders: builders}
+	return &TranscodingProfileCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for TwitchCategory.
-func (c *TwitchCategoryClient) Update() *TwitchCategoryUpdate {
-	mutation := newTwitchCategoryMutation(c.config, OpUpdate)
-	return &TwitchCategoryUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for TranscodingProfile.
+func (c *TranscodingProfileClient) Update() *TranscodingProfileUpdate {
+	mutation := newTranscodingProfileMutation(c.config, OpUpdate)
+	return &TranscodingProfileUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *TranscodingProfileClient) UpdateOne(_m *TranscodingProfile) *TranscodingProfileUpdateOne {
+	mutation := newTranscodingProfileMutation(c.config, OpUpdateOne, withTranscodingProfile(_m))
+	return &TranscodingProfileUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *TranscodingProfileClient) UpdateOneID(id uuid.UUID) *TranscodingProfileUpdateOne {
+	mutation := newTranscodingProfileMutation(c.config, OpUpdateOne, withTranscodingProfileID(id))
+	return &TranscodingProfileUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for TranscodingProfile.
+func (c *TranscodingProfileClient) Delete() *TranscodingProfileDelete {
+	mutation := newTranscodingProfileMutation(c.config, OpDelete)
+	return &TranscodingProfileDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *TranscodingProfileClient) DeleteOne(_m *TranscodingProfile) *TranscodingProfileDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *TranscodingProfileClient) DeleteOneID(id uuid.UUID) *TranscodingProfileDeleteOne {
+	builder := c.Delete().Where(transcodingprofile.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &TranscodingProfileDeleteOne{builder}
+}
+
+// Query returns a query builder for TranscodingProfile.
+func (c *TranscodingProfileClient) Query() *TranscodingProfileQuery {
+	return &TranscodingProfileQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeTranscodingProfile},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a TranscodingProfile entity by its id.
+func (c *TranscodingProfileClient) Get(ctx context.Context, id uuid.UUID) (*TranscodingProfile, error) {
+	return c.Query().Where(transcodingprofile.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *TranscodingProfileClient) GetX(ctx context.Context, id uuid.UUID) *TranscodingProfile {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *TranscodingProfileClient) Hooks() []Hook {
+	return c.hooks.TranscodingProfile
+}
+
+// Interceptors returns the client interceptors.
+func (c *TranscodingProfileClient) Interceptors() []Interceptor {
+	return c.inters.TranscodingProfile
+}
+
+func (c *TranscodingProfileClient) mutate(ctx context.Context, m *TranscodingProfileMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&TranscodingProfileCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&TranscodingProfileUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&TranscodingProfileUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&TranscodingProfileDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown TranscodingProfile mutation op: %q", m.Op())
+	}
+}
+
+// TwitchCategoryClient is a client for the TwitchCategory schema.
+type TwitchCategoryClient struct {
+	config
+}
+
+// NewTwitchCategoryClient returns a client for the TwitchCategory from the given config.
+func NewTwitchCategoryClient(c config) *TwitchCategoryClient {
+	return &TwitchCategoryClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `twitchcategory.Hooks(f(g(h())))`.
+func (c *TwitchCategoryClient) Use(hooks ...Hook) {
+	c.hooks.TwitchCategory = append(c.hooks.TwitchCategory, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `twitchcategory.Intercept(f(g(h())))`.
+func (c *TwitchCategoryClient) Intercept(interceptors ...Interceptor) {
+	c.inters.TwitchCategory = append(c.inters.TwitchCategory, interceptors...)
+}
+
+// Create returns a builder for creating a TwitchCategory entity.
+func (c *TwitchCategoryClient) Create() *TwitchCategoryCreate {
+	mutation := newTwitchCategoryMutation(c.config, OpCreate)
+	return &TwitchCategoryCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of TwitchCategory entities.
+func (c *TwitchCategoryClient) CreateBulk(builders ...*TwitchCategoryCreate) *TwitchCategoryCreateBulk {
+	return &TwitchCategoryCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *TwitchCategoryClient) MapCreateBulk(slice any, setFunc func(*TwitchCategoryCreate, int)) *TwitchCategoryCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &TwitchCategoryCreateBulk{err: fmt.Errorf("calling to TwitchCategoryClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*TwitchCategoryCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &TwitchCategoryCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for TwitchCategory.
+func (c *TwitchCategoryClient) Update() *TwitchCategoryUpdate {
+	mutation := newTwitchCategoryMutation(c.config, OpUpdate)
+	return &TwitchCategoryUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
@@ -3029,6 +3856,38 @@ func (c *UserClient) GetX(ctx context.Context, id uuid.UUID) *User {
 	return obj
 }
 
+// QueryViewingSessions queries the viewing_sessions edge of a User.
+func (c *UserClient) QueryViewingSessions(_m *User) *ViewingSessionQuery {
+	query := (&ViewingSessionClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(viewingsession.Table, viewingsession.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.ViewingSessionsTable, user.ViewingSessionsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryLists queries the lists edge of a User.
+func (c *UserClient) QueryLists(_m *User) *UserListQuery {
+	query := (&UserListClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(userlist.Table, userlist.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.ListsTable, user.ListsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
 // Hooks returns the client hooks.
 func (c *UserClient) Hooks() []Hook {
 	return c.hooks.User
@@ -3054,6 +3913,501 @@ func (c *UserClient) mutate(ctx context.Context, m *UserMutation) (Value, error)
 	}
 }
 
+// UserListClient is a client for the UserList schema.
+type UserListClient struct {
+	config
+}
+
+// NewUserListClient returns a client for the UserList from the given config.
+func NewUserListClient(c config) *UserListClient {
+	return &UserListClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `userlist.Hooks(f(g(h())))`.
+func (c *UserListClient) Use(hooks ...Hook) {
+	c.hooks.UserList = append(c.hooks.UserList, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `userlist.Intercept(f(g(h())))`.
+func (c *UserListClient) Intercept(interceptors ...Interceptor) {
+	c.inters.UserList = append(c.inters.UserList, interceptors...)
+}
+
+// Create returns a builder for creating a UserList entity.
+func (c *UserListClient) Create() *UserListCreate {
+	mutation := newUserListMutation(c.config, OpCreate)
+	return &UserListCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of UserList entities.
+func (c *UserListClient) CreateBulk(builders ...*UserListCreate) *UserListCreateBulk {
+	return &UserListCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *UserListClient) MapCreateBulk(slice any, setFunc func(*UserListCreate, int)) *UserListCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &UserListCreateBulk{err: fmt.Errorf("calling to UserListClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*UserListCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &UserListCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for UserList.
+func (c *UserListClient) Update() *UserListUpdate {
+	mutation := newUserListMutation(c.config, OpUpdate)
+	return &UserListUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *UserListClient) UpdateOne(_m *UserList) *UserListUpdateOne {
+	mutation := newUserListMutation(c.config, OpUpdateOne, withUserList(_m))
+	return &UserListUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *UserListClient) UpdateOneID(id uuid.UUID) *UserListUpdateOne {
+	mutation := newUserListMutation(c.config, OpUpdateOne, withUserListID(id))
+	return &UserListUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for UserList.
+func (c *UserListClient) Delete() *UserListDelete {
+	mutation := newUserListMutation(c.config, OpDelete)
+	return &UserListDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *UserListClient) DeleteOne(_m *UserList) *UserListDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *UserListClient) DeleteOneID(id uuid.UUID) *UserListDeleteOne {
+	builder := c.Delete().Where(userlist.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &UserListDeleteOne{builder}
+}
+
+// Query returns a query builder for UserList.
+func (c *UserListClient) Query() *UserListQuery {
+	return &UserListQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeUserList},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a UserList entity by its id.
+func (c *UserListClient) Get(ctx context.Context, id uuid.UUID) (*UserList, error) {
+	return c.Query().Where(userlist.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *UserListClient) GetX(ctx context.Context, id uuid.UUID) *UserList {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryUser queries the user edge of a UserList.
+func (c *UserListClient) QueryUser(_m *UserList) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(userlist.Table, userlist.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, userlist.UserTable, userlist.UserColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryItems queries the items edge of a UserList.
+func (c *UserListClient) QueryItems(_m *UserList) *UserListItemQuery {
+	query := (&UserListItemClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(userlist.Table, userlist.FieldID, id),
+			sqlgraph.To(userlistitem.Table, userlistitem.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, userlist.ItemsTable, userlist.ItemsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *UserListClient) Hooks() []Hook {
+	return c.hooks.UserList
+}
+
+// Interceptors returns the client interceptors.
+func (c *UserListClient) Interceptors() []Interceptor {
+	return c.inters.UserList
+}
+
+func (c *UserListClient) mutate(ctx context.Context, m *UserListMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&UserListCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&UserListUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&UserListUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&UserListDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown UserList mutation op: %q", m.Op())
+	}
+}
+
+// UserListItemClient is a client for the UserListItem schema.
+type UserListItemClient struct {
+	config
+}
+
+// NewUserListItemClient returns a client for the UserListItem from the given config.
+func NewUserListItemClient(c config) *UserListItemClient {
+	return &UserListItemClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `userlistitem.Hooks(f(g(h())))`.
+func (c *UserListItemClient) Use(hooks ...Hook) {
+	c.hooks.UserListItem = append(c.hooks.UserListItem, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `userlistitem.Intercept(f(g(h())))`.
+func (c *UserListItemClient) Intercept(interceptors ...Interceptor) {
+	c.inters.UserListItem = append(c.inters.UserListItem, interceptors...)
+}
+
+// Create returns a builder for creating a UserListItem entity.
+func (c *UserListItemClient) Create() *UserListItemCreate {
+	mutation := newUserListItemMutation(c.config, OpCreate)
+	return &UserListItemCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of UserListItem entities.
+func (c *UserListItemClient) CreateBulk(builders ...*UserListItemCreate) *UserListItemCreateBulk {
+	return &UserListItemCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *UserListItemClient) MapCreateBulk(slice any, setFunc func(*UserListItemCreate, int)) *UserListItemCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &UserListItemCreateBulk{err: fmt.Errorf("calling to UserListItemClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*UserListItemCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &UserListItemCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for UserListItem.
+func (c *UserListItemClient) Update() *UserListItemUpdate {
+	mutation := newUserListItemMutation(c.config, OpUpdate)
+	return &UserListItemUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *UserListItemClient) UpdateOne(_m *UserListItem) *UserListItemUpdateOne {
+	mutation := newUserListItemMutation(c.config, OpUpdateOne, withUserListItem(_m))
+	return &UserListItemUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *UserListItemClient) UpdateOneID(id uuid.UUID) *UserListItemUpdateOne {
+	mutation := newUserListItemMutation(c.config, OpUpdateOne, withUserListItemID(id))
+	return &UserListItemUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for UserListItem.
+func (c *UserListItemClient) Delete() *UserListItemDelete {
+	mutation := newUserListItemMutation(c.config, OpDelete)
+	return &UserListItemDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *UserListItemClient) DeleteOne(_m *UserListItem) *UserListItemDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *UserListItemClient) DeleteOneID(id uuid.UUID) *UserListItemDeleteOne {
+	builder := c.Delete().Where(userlistitem.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &UserListItemDeleteOne{builder}
+}
+
+// Query returns a query builder for UserListItem.
+func (c *UserListItemClient) Query() *UserListItemQuery {
+	return &UserListItemQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeUserListItem},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a UserListItem entity by its id.
+func (c *UserListItemClient) Get(ctx context.Context, id uuid.UUID) (*UserListItem, error) {
+	return c.Query().Where(userlistitem.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *UserListItemClient) GetX(ctx context.Context, id uuid.UUID) *UserListItem {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryList queries the list edge of a UserListItem.
+func (c *UserListItemClient) QueryList(_m *UserListItem) *UserListQuery {
+	query := (&UserListClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(userlistitem.Table, userlistitem.FieldID, id),
+			sqlgraph.To(userlist.Table, userlist.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, userlistitem.ListTable, userlistitem.ListColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryVod queries the vod edge of a UserListItem.
+func (c *UserListItemClient) QueryVod(_m *UserListItem) *VodQuery {
+	query := (&VodClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(userlistitem.Table, userlistitem.FieldID, id),
+			sqlgraph.To(vod.Table, vod.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, userlistitem.VodTable, userlistitem.VodColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *UserListItemClient) Hooks() []Hook {
+	return c.hooks.UserListItem
+}
+
+// Interceptors returns the client interceptors.
+func (c *UserListItemClient) Interceptors() []Interceptor {
+	return c.inters.UserListItem
+}
+
+func (c *UserListItemClient) mutate(ctx context.Context, m *UserListItemMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&UserListItemCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&UserListItemUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&UserListItemUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&UserListItemDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown UserListItem mutation op: %q", m.Op())
+	}
+}
+
+// ViewingSessionClient is a client for the ViewingSession schema.
+type ViewingSessionClient struct {
+	config
+}
+
+// NewViewingSessionClient returns a client for the ViewingSession from the given config.
+func NewViewingSessionClient(c config) *ViewingSessionClient {
+	return &ViewingSessionClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `viewingsession.Hooks(f(g(h())))`.
+func (c *ViewingSessionClient) Use(hooks ...Hook) {
+	c.hooks.ViewingSession = append(c.hooks.ViewingSession, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `viewingsession.Intercept(f(g(h())))`.
+func (c *ViewingSessionClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ViewingSession = append(c.inters.ViewingSession, interceptors...)
+}
+
+// Create returns a builder for creating a ViewingSession entity.
+func (c *ViewingSessionClient) Create() *ViewingSessionCreate {
+	mutation := newViewingSessionMutation(c.config, OpCreate)
+	return &ViewingSessionCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of ViewingSession entities.
+func (c *ViewingSessionClient) CreateBulk(builders ...*ViewingSessionCreate) *ViewingSessionCreateBulk {
+	return &ViewingSessionCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *ViewingSessionClient) MapCreateBulk(slice any, setFunc func(*ViewingSessionCreate, int)) *ViewingSessionCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &ViewingSessionCreateBulk{err: fmt.Errorf("calling to ViewingSessionClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*ViewingSessionCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &ViewingSessionCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for ViewingSession.
+func (c *ViewingSessionClient) Update() *ViewingSessionUpdate {
+	mutation := newViewingSessionMutation(c.config, OpUpdate)
+	return &ViewingSessionUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ViewingSessionClient) UpdateOne(_m *ViewingSession) *ViewingSessionUpdateOne {
+	mutation := newViewingSessionMutation(c.config, OpUpdateOne, withViewingSession(_m))
+	return &ViewingSessionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ViewingSessionClient) UpdateOneID(id uuid.UUID) *ViewingSessionUpdateOne {
+	mutation := newViewingSessionMutation(c.config, OpUpdateOne, withViewingSessionID(id))
+	return &ViewingSessionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for ViewingSession.
+func (c *ViewingSessionClient) Delete() *ViewingSessionDelete {
+	mutation := newViewingSessionMutation(c.config, OpDelete)
+	return &ViewingSessionDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *ViewingSessionClient) DeleteOne(_m *ViewingSession) *ViewingSessionDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *ViewingSessionClient) DeleteOneID(id uuid.UUID) *ViewingSessionDeleteOne {
+	builder := c.Delete().Where(viewingsession.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ViewingSessionDeleteOne{builder}
+}
+
+// Query returns a query builder for ViewingSession.
+func (c *ViewingSessionClient) Query() *ViewingSessionQuery {
+	return &ViewingSessionQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeViewingSession},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a ViewingSession entity by its id.
+func (c *ViewingSessionClient) Get(ctx context.Context, id uuid.UUID) (*ViewingSession, error) {
+	return c.Query().Where(viewingsession.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ViewingSessionClient) GetX(ctx context.Context, id uuid.UUID) *ViewingSession {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryVod queries the vod edge of a ViewingSession.
+func (c *ViewingSessionClient) QueryVod(_m *ViewingSession) *VodQuery {
+	query := (&VodClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(viewingsession.Table, viewingsession.FieldID, id),
+			sqlgraph.To(vod.Table, vod.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, viewingsession.VodTable, viewingsession.VodColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryUser queries the user edge of a ViewingSession.
+func (c *ViewingSessionClient) QueryUser(_m *ViewingSession) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(viewingsession.Table, viewingsession.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, viewingsession.UserTable, viewingsession.UserColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *ViewingSessionClient) Hooks() []Hook {
+	return c.hooks.ViewingSession
+}
+
+// Interceptors returns the client interceptors.
+func (c *ViewingSessionClient) Interceptors() []Interceptor {
+	return c.inters.ViewingSession
+}
+
+func (c *ViewingSessionClient) mutate(ctx context.Context, m *ViewingSessionMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&ViewingSessionCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&ViewingSessionUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&ViewingSessionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&ViewingSessionDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown ViewingSession mutation op: %q", m.Op())
+	}
+}
+
 // VodClient is a client for the Vod schema.
 type VodClient struct {
 	config
@@ -3258,6 +4612,102 @@ func (c *VodClient) QueryMultistreamInfo(_m *Vod) *MultistreamInfoQuery {
 	return query
 }
 
+// QueryChatMessages queries the chat_messages edge of a Vod.
+func (c *VodClient) QueryChatMessages(_m *Vod) *ChatMessageQuery {
+	query := (&ChatMessageClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(vod.Table, vod.FieldID, id),
+			sqlgraph.To(chatmessage.Table, chatmessage.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, vod.ChatMessagesTable, vod.ChatMessagesColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryChecksums queries the checksums edge of a Vod.
+func (c *VodClient) QueryChecksums(_m *Vod) *ChecksumQuery {
+	query := (&ChecksumClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(vod.Table, vod.FieldID, id),
+			sqlgraph.To(checksum.Table, checksum.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, vod.ChecksumsTable, vod.ChecksumsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryLiveGaps queries the live_gaps edge of a Vod.
+func (c *VodClient) QueryLiveGaps(_m *Vod) *LiveGapQuery {
+	query := (&LiveGapClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(vod.Table, vod.FieldID, id),
+			sqlgraph.To(livegap.Table, livegap.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, vod.LiveGapsTable, vod.LiveGapsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryViewingSessions queries the viewing_sessions edge of a Vod.
+func (c *VodClient) QueryViewingSessions(_m *Vod) *ViewingSessionQuery {
+	query := (&ViewingSessionClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(vod.Table, vod.FieldID, id),
+			sqlgraph.To(viewingsession.Table, viewingsession.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, vod.ViewingSessionsTable, vod.ViewingSessionsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryUserListItems queries the user_list_items edge of a Vod.
+func (c *VodClient) QueryUserListItems(_m *Vod) *UserListItemQuery {
+	query := (&UserListItemClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(vod.Table, vod.FieldID, id),
+			sqlgraph.To(userlistitem.Table, userlistitem.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, vod.UserListItemsTable, vod.UserListItemsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryTranscodingProfile queries the transcoding_profile edge of a Vod.
+func (c *VodClient) QueryTranscodingProfile(_m *Vod) *TranscodingProfileQuery {
+	query := (&TranscodingProfileClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(vod.Table, vod.FieldID, id),
+			sqlgraph.To(transcodingprofile.Table, transcodingprofile.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, vod.TranscodingProfileTable, vod.TranscodingProfileColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
 // Hooks returns the client hooks.
 func (c *VodClient) Hooks() []Hook {
 	return c.hooks.Vod
@@ -3286,13 +4736,17 @@ func (c *VodClient) mutate(ctx context.Context, m *VodMutation) (Value, error) {
 // hooks and interceptors per client, for fast access.
 type (
 	hooks struct {
-		ApiKey, BlockedVideos, Channel, Chapter, Live, LiveCategory, LiveTitleRegex,
-		MultistreamInfo, MutedSegment, Notification, Playback, Playlist, PlaylistRule,
-		PlaylistRuleGroup, Queue, Sessions, TwitchCategory, User, Vod []ent.Hook
+		ApiKey, BlockedVideos, Channel, Chapter, ChatMessage, Checksum, Live,
+		LiveCategory, LiveGap, LiveSchedule, LiveTitleRegex, MultistreamInfo,
+		MutedSegment, Notification, Playback, Playlist, PlaylistRule,
+		PlaylistRuleGroup, Queue, Sessions, TranscodingProfile, TwitchCategory, User,
+		UserList, UserListItem, ViewingSession, Vod []ent.Hook
 	}
 	inters struct {
-		ApiKey, BlockedVideos, Channel, Chapter, Live, LiveCategory, LiveTitleRegex,
-		MultistreamInfo, MutedSegment, Notification, Playback, Playlist, PlaylistRule,
-		PlaylistRuleGroup, Queue, Sessions, TwitchCategory, User, Vod []ent.Interceptor
+		ApiKey, BlockedVideos, Channel, Chapter, ChatMessage, Checksum, Live,
+		LiveCategory, LiveGap, LiveSchedule, LiveTitleRegex, MultistreamInfo,
+		MutedSegment, Notification, Playback, Playlist, PlaylistRule,
+		PlaylistRuleGroup, Queue, Sessions, TranscodingProfile, TwitchCategory, User,
+		UserList, UserListItem, ViewingSession, Vod []ent.Interceptor
 	}
 )
@@ -16,8 +16,12 @@ import (
 	"github.com/zibbp/ganymede/ent/blockedvideos"
 	"github.com/zibbp/ganymede/ent/channel"
 	"github.com/zibbp/ganymede/ent/chapter"
+	"github.com/zibbp/ganymede/ent/chatmessage"
+	"github.com/zibbp/ganymede/ent/checksum"
 	"github.com/zibbp/ganymede/ent/live"
 	"github.com/zibbp/ganymede/ent/livecategory"
+	"github.com/zibbp/ganymede/ent/livegap"
+	"github.com/zibbp/ganymede/ent/liveschedule"
 	"github.com/zibbp/ganymede/ent/livetitleregex"
 	"github.com/zibbp/ganymede/ent/multistreaminfo"
 	"github.com/zibbp/ganymede/ent/mutedsegment"
@@ -28,8 +32,12 @@ import (
 	"github.com/zibbp/ganymede/ent/playlistrulegroup"
 	"github.com/zibbp/ganymede/ent/queue"
 	"github.com/zibbp/ganymede/ent/sessions"
+	"github.com/zibbp/ganymede/ent/transcodingprofile"
 	"github.com/zibbp/ganymede/ent/twitchcategory"
 	"github.com/zibbp/ganymede/ent/user"
+	"github.com/zibbp/ganymede/ent/userlist"
+	"github.com/zibbp/ganymede/ent/userlistitem"
+	"github.com/zibbp/ganymede/ent/viewingsession"
 	"github.com/zibbp/ganymede/ent/vod"
 )
 
@@ -91,25 +99,33 @@ var (
 func checkColumn(t, c string) error {
 	initCheck.Do(func() {
 		columnCheck = sql.NewColumnCheck(map[string]func(string) bool{
-			apikey.Table:            apikey.ValidColumn,
-			blockedvideos.Table:     blockedvideos.ValidColumn,
-			channel.Table:           channel.ValidColumn,
-			chapter.Table:           chapter.ValidColumn,
-			live.Table:              live.ValidColumn,
-			livecategory.Table:      livecategory.ValidColumn,
-			livetitleregex.Table:    livetitleregex.ValidColumn,
-			multistreaminfo.Table:   multistreaminfo.ValidColumn,
-			mutedsegment.Table:      mutedsegment.ValidColumn,
-			notification.Table:      notification.ValidColumn,
-			playback.Table:          playback.ValidColumn,
-			playlist.Table:          playlist.ValidColumn,
-			playlistrule.Table:      playlistrule.ValidColumn,
-			playlistrulegroup.Table: playlistrulegroup.ValidColumn,
-			queue.Table:             queue.ValidColumn,
-			sessions.Table:          sessions.ValidColumn,
-			twitchcategory.Table:    twitchcategory.ValidColumn,
-			user.Table:              user.ValidColumn,
-			vod.Table:               vod.ValidColumn,
+			apikey.Table:             apikey.ValidColumn,
+			blockedvideos.Table:      blockedvideos.ValidColumn,
+			channel.Table:            channel.ValidColumn,
+			chapter.Table:            chapter.ValidColumn,
+			chatmessage.Table:        chatmessage.ValidColumn,
+			checksum.Table:           checksum.ValidColumn,
+			live.Table:               live.ValidColumn,
+			livecategory.Table:       livecategory.ValidColumn,
+			livegap.Table:            livegap.ValidColumn,
+			liveschedule.Table:       liveschedule.ValidColumn,
+			livetitleregex.Table:     livetitleregex.ValidColumn,
+			multistreaminfo.Table:    multistreaminfo.ValidColumn,
+			mutedsegment.Table:       mutedsegment.ValidColumn,
+			notification.Table:       notification.ValidColumn,
+			playback.Table:           playback.ValidColumn,
+			playlist.Table:           playlist.ValidColumn,
+			playlistrule.Table:       playlistrule.ValidColumn,
+			playlistrulegroup.Table:  playlistrulegroup.ValidColumn,
+			queue.Table:              queue.ValidColumn,
+			sessions.Table:           sessions.ValidColumn,
+			transcodingprofile.Table: transcodingprofile.ValidColumn,
+			twitchcategory.Table:     twitchcategory.ValidColumn,
+			user.Table:               user.ValidColumn,
+			userlist.Table:           userlist.ValidColumn,
+			userlistitem.Table:       userlistitem.ValidColumn,
+			viewingsession.Table:     viewingsession.ValidColumn,
+			vod.Table:                vod.ValidColumn,
 		})
 	})
 	return columnCheck(t, c)
@@ -57,6 +57,30 @@ func (f ChapterFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, err
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.ChapterMutation", m)
 }
 
+// The ChatMessageFunc type is an adapter to allow the use of ordinary
+// function as ChatMessage mutator.
+type ChatMessageFunc func(context.Context, *ent.ChatMessageMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f ChatMessageFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.ChatMessageMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.ChatMessageMutation", m)
+}
+
+// The ChecksumFunc type is an adapter to allow the use of ordinary
+// function as Checksum mutator.
+type ChecksumFunc func(context.Context, *ent.ChecksumMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f ChecksumFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.ChecksumMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.ChecksumMutation", m)
+}
+
 // The LiveFunc type is an adapter to allow the use of ordinary
 // function as Live mutator.
 type LiveFunc func(context.Context, *ent.LiveMutation) (ent.Value, error)
@@ -81,6 +105,30 @@ func (f LiveCategoryFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.LiveCategoryMutation", m)
 }
 
+// The LiveGapFunc type is an adapter to allow the use of ordinary
+// function as LiveGap mutator.
+type LiveGapFunc func(context.Context, *ent.LiveGapMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f LiveGapFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.LiveGapMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.LiveGapMutation", m)
+}
+
+// The LiveScheduleFunc type is an adapter to allow the use of ordinary
+// function as LiveSchedule mutator.
+type LiveScheduleFunc func(context.Context, *ent.LiveScheduleMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f LiveScheduleFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.LiveScheduleMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.LiveScheduleMutation", m)
+}
+
 // The LiveTitleRegexFunc type is an adapter to allow the use of ordinary
 // function as LiveTitleRegex mutator.
 type LiveTitleRegexFunc func(context.Context, *ent.LiveTitleRegexMutation) (ent.Value, error)
@@ -201,6 +249,18 @@ func (f SessionsFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, er
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.SessionsMutation", m)
 }
 
+// The TranscodingProfileFunc type is an adapter to allow the use of ordinary
+// function as TranscodingProfile mutator.
+type TranscodingProfileFunc func(context.Context, *ent.TranscodingProfileMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f TranscodingProfileFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.TranscodingProfileMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.TranscodingProfileMutation", m)
+}
+
 // The TwitchCategoryFunc type is an adapter to allow the use of ordinary
 // function as TwitchCategory mutator.
 type TwitchCategoryFunc func(context.Context, *ent.TwitchCategoryMutation) (ent.Value, error)
@@ -225,6 +285,42 @@ func (f UserFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error)
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.UserMutation", m)
 }
 
+// The UserListFunc type is an adapter to allow the use of ordinary
+// function as UserList mutator.
+type UserListFunc func(context.Context, *ent.UserListMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f UserListFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.UserListMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.UserListMutation", m)
+}
+
+// The UserListItemFunc type is an adapter to allow the use of ordinary
+// function as UserListItem mutator.
+type UserListItemFunc func(context.Context, *ent.UserListItemMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f UserListItemFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.UserListItemMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.UserListItemMutation", m)
+}
+
+// The ViewingSessionFunc type is an adapter to allow the use of ordinary
+// function as ViewingSession mutator.
+type ViewingSessionFunc func(context.Context, *ent.ViewingSessionMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f ViewingSessionFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.ViewingSessionMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.ViewingSessionMutation", m)
+}
+
 // The VodFunc type is an adapter to allow the use of ordinary
 // function as Vod mutator.
 type VodFunc func(context.Context, *ent.VodMutation) (ent.Value, error)
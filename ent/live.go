@@ -3,6 +3,7 @@
 package ent
 
 import (
+	"encoding/json"
 	"fmt"
 	"strings"
 	"time"
@@ -12,6 +13,8 @@ import (
 	"github.com/google/uuid"
 	"github.com/zibbp/ganymede/ent/channel"
 	"github.com/zibbp/ganymede/ent/live"
+	"github.com/zibbp/ganymede/ent/liveschedule"
+	"github.com/zibbp/ganymede/ent/transcodingprofile"
 )
 
 // Live is the model entity for the Live schema.
@@ -37,12 +40,22 @@ type Live struct {
 	ArchiveChat bool `json:"archive_chat"`
 	// Live stream archive quality.
 	Resolution string `json:"resolution"`
+	// Qualities tried in order when archiving the live stream at the resolution keeps failing, e.g. 720p60 and best.
+	QualityFallbacks []string `json:"quality_fallbacks"`
+	// Whether the audio of live streams is recorded alongside the video as a fallback.
+	RecordAudioFallback bool `json:"record_audio_fallback"`
+	// Split live stream archives into parts every X hours. Set to 0 to disable.
+	SplitHours int `json:"split_hours"`
+	// Whether live stream archives are split into parts when the category changes.
+	SplitOnCategoryChange bool `json:"split_on_category_change"`
 	// Video and clip archive quality.
 	VodResolution string `json:"vod_resolution"`
 	// The time the channel last went live.
 	LastLive time.Time `json:"last_live"`
 	// Whether the chat should be rendered.
 	RenderChat bool `json:"render_chat"`
+	// Whether captions should be generated with speech-to-text after archiving.
+	GenerateCaptions bool `json:"generate_captions"`
 	// Restrict fetching videos to a certain age.
 	VideoAge int64 `json:"video_age"`
 	// Whether the categories should be applied to livestreams.
@@ -63,6 +76,8 @@ type Live struct {
 	ClipsIgnoreLastChecked bool `json:"clips_ignore_last_checked"`
 	// Queue metadata update X minutes after the stream is live. Set to 0 to disable.
 	UpdateMetadataMinutes int `json:"update_metadata_minutes"`
+	// The transcoding profile used to post-process archives of the channel. The global video convert arguments are used if empty.
+	TranscodingProfileID *uuid.UUID `json:"transcoding_profile_id"`
 	// UpdatedAt holds the value of the "updated_at" field.
 	UpdatedAt time.Time `json:"updated_at"`
 	// CreatedAt holds the value of the "created_at" field.
@@ -82,9 +97,13 @@ type LiveEdges struct {
 	Categories []*LiveCategory `json:"categories,omitempty"`
 	// TitleRegex holds the value of the title_regex edge.
 	TitleRegex []*LiveTitleRegex `json:"title_regex,omitempty"`
+	// Schedule holds the value of the schedule edge.
+	Schedule *LiveSchedule `json:"schedule,omitempty"`
+	// TranscodingProfile holds the value of the transcoding_profile edge.
+	TranscodingProfile *TranscodingProfile `json:"transcoding_profile,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [3]bool
+	loadedTypes [5]bool
 }
 
 // ChannelOrErr returns the Channel value or an error if the edge
@@ -116,14 +135,40 @@ func (e LiveEdges) TitleRegexOrErr() ([]*LiveTitleRegex, error) {
 	return nil, &NotLoadedError{edge: "title_regex"}
 }
 
+// ScheduleOrErr returns the Schedule value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e LiveEdges) ScheduleOrErr() (*LiveSchedule, error) {
+	if e.Schedule != nil {
+		return e.Schedule, nil
+	} else if e.loadedTypes[3] {
+		return nil, &NotFoundError{label: liveschedule.Label}
+	}
+	return nil, &NotLoadedError{edge: "schedule"}
+}
+
+// TranscodingProfileOrErr returns the TranscodingProfile value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e LiveEdges) TranscodingProfileOrErr() (*TranscodingProfile, error) {
+	if e.TranscodingProfile != nil {
+		return e.TranscodingProfile, nil
+	} else if e.loadedTypes[4] {
+		return nil, &NotFoundError{label: transcodingprofile.Label}
+	}
+	return nil, &NotLoadedError{edge: "transcoding_profile"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*Live) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case live.FieldWatchLive, live.FieldWatchVod, live.FieldDownloadArchives, live.FieldDownloadHighlights, live.FieldDownloadUploads, live.FieldDownloadSubOnly, live.FieldIsLive, live.FieldArchiveChat, live.FieldRenderChat, live.FieldApplyCategoriesToLive, live.FieldStrictCategoriesLive, live.FieldBlacklistCategories, live.FieldWatchClips, live.FieldClipsIgnoreLastChecked:
+		case live.FieldTranscodingProfileID:
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		case live.FieldQualityFallbacks:
+			values[i] = new([]byte)
+		case live.FieldWatchLive, live.FieldWatchVod, live.FieldDownloadArchives, live.FieldDownloadHighlights, live.FieldDownloadUploads, live.FieldDownloadSubOnly, live.FieldIsLive, live.FieldArchiveChat, live.FieldRecordAudioFallback, live.FieldSplitOnCategoryChange, live.FieldRenderChat, live.FieldGenerateCaptions, live.FieldApplyCategoriesToLive, live.FieldStrictCategoriesLive, live.FieldBlacklistCategories, live.FieldWatchClips, live.FieldClipsIgnoreLastChecked:
 			values[i] = new(sql.NullBool)
-		case live.FieldVideoAge, live.FieldClipsLimit, live.FieldClipsIntervalDays, live.FieldUpdateMetadataMinutes:
+		case live.FieldSplitHours, live.FieldVideoAge, live.FieldClipsLimit, live.FieldClipsIntervalDays, live.FieldUpdateMetadataMinutes:
 			values[i] = new(sql.NullInt64)
 		case live.FieldResolution, live.FieldVodResolution:
 			values[i] = new(sql.NullString)
@@ -208,6 +253,32 @@ func (_m *Live) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.Resolution = value.String
 			}
+		case live.FieldQualityFallbacks:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field quality_fallbacks", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.QualityFallbacks); err != nil {
+					return fmt.Errorf("unmarshal field quality_fallbacks: %w", err)
+				}
+			}
+		case live.FieldRecordAudioFallback:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field record_audio_fallback", values[i])
+			} else if value.Valid {
+				_m.RecordAudioFallback = value.Bool
+			}
+		case live.FieldSplitHours:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field split_hours", values[i])
+			} else if value.Valid {
+				_m.SplitHours = int(value.Int64)
+			}
+		case live.FieldSplitOnCategoryChange:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field split_on_category_change", values[i])
+			} else if value.Valid {
+				_m.SplitOnCategoryChange = value.Bool
+			}
 		case live.FieldVodResolution:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field vod_resolution", values[i])
@@ -226,6 +297,12 @@ func (_m *Live) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.RenderChat = value.Bool
 			}
+		case live.FieldGenerateCaptions:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field generate_captions", values[i])
+			} else if value.Valid {
+				_m.GenerateCaptions = value.Bool
+			}
 		case live.FieldVideoAge:
 			if value, ok := values[i].(*sql.NullInt64); !ok {
 				return fmt.Errorf("unexpected type %T for field video_age", values[i])
@@ -286,6 +363,13 @@ func (_m *Live) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.UpdateMetadataMinutes = int(value.Int64)
 			}
+		case live.FieldTranscodingProfileID:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field transcoding_profile_id", values[i])
+			} else if value.Valid {
+				_m.TranscodingProfileID = new(uuid.UUID)
+				*_m.TranscodingProfileID = *value.S.(*uuid.UUID)
+			}
 		case live.FieldUpdatedAt:
 			if value, ok := values[i].(*sql.NullTime); !ok {
 				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
@@ -333,6 +417,16 @@ func (_m *Live) QueryTitleRegex() *LiveTitleRegexQuery {
 	return NewLiveClient(_m.config).QueryTitleRegex(_m)
 }
 
+// QuerySchedule queries the "schedule" edge of the Live entity.
+func (_m *Live) QuerySchedule() *LiveScheduleQuery {
+	return NewLiveClient(_m.config).QuerySchedule(_m)
+}
+
+// QueryTranscodingProfile queries the "transcoding_profile" edge of the Live entity.
+func (_m *Live) QueryTranscodingProfile() *TranscodingProfileQuery {
+	return NewLiveClient(_m.config).QueryTranscodingProfile(_m)
+}
+
 // Update returns a builder for updating this Live.
 // Note that you need to call Live.Unwrap() before calling this method if this Live
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -383,6 +477,18 @@ func (_m *Live) String() string {
 	builder.WriteString("resolution=")
 	builder.WriteString(_m.Resolution)
 	builder.WriteString(", ")
+	builder.WriteString("quality_fallbacks=")
+	builder.WriteString(fmt.Sprintf("%v", _m.QualityFallbacks))
+	builder.WriteString(", ")
+	builder.WriteString("record_audio_fallback=")
+	builder.WriteString(fmt.Sprintf("%v", _m.RecordAudioFallback))
+	builder.WriteString(", ")
+	builder.WriteString("split_hours=")
+	builder.WriteString(fmt.Sprintf("%v", _m.SplitHours))
+	builder.WriteString(", ")
+	builder.WriteString("split_on_category_change=")
+	builder.WriteString(fmt.Sprintf("%v", _m.SplitOnCategoryChange))
+	builder.WriteString(", ")
 	builder.WriteString("vod_resolution=")
 	builder.WriteString(_m.VodResolution)
 	builder.WriteString(", ")
@@ -392,6 +498,9 @@ func (_m *Live) String() string {
 	builder.WriteString("render_chat=")
 	builder.WriteString(fmt.Sprintf("%v", _m.RenderChat))
 	builder.WriteString(", ")
+	builder.WriteString("generate_captions=")
+	builder.WriteString(fmt.Sprintf("%v", _m.GenerateCaptions))
+	builder.WriteString(", ")
 	builder.WriteString("video_age=")
 	builder.WriteString(fmt.Sprintf("%v", _m.VideoAge))
 	builder.WriteString(", ")
@@ -422,6 +531,11 @@ func (_m *Live) String() string {
 	builder.WriteString("update_metadata_minutes=")
 	builder.WriteString(fmt.Sprintf("%v", _m.UpdateMetadataMinutes))
 	builder.WriteString(", ")
+	if v := _m.TranscodingProfileID; v != nil {
+		builder.WriteString("transcoding_profile_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
 	builder.WriteString("updated_at=")
 	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
 	builder.WriteString(", ")
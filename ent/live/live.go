@@ -33,12 +33,22 @@ const (
 	FieldArchiveChat = "archive_chat"
 	// FieldResolution holds the string denoting the resolution field in the database.
 	FieldResolution = "resolution"
+	// FieldQualityFallbacks holds the string denoting the quality_fallbacks field in the database.
+	FieldQualityFallbacks = "quality_fallbacks"
+	// FieldRecordAudioFallback holds the string denoting the record_audio_fallback field in the database.
+	FieldRecordAudioFallback = "record_audio_fallback"
+	// FieldSplitHours holds the string denoting the split_hours field in the database.
+	FieldSplitHours = "split_hours"
+	// FieldSplitOnCategoryChange holds the string denoting the split_on_category_change field in the database.
+	FieldSplitOnCategoryChange = "split_on_category_change"
 	// FieldVodResolution holds the string denoting the vod_resolution field in the database.
 	FieldVodResolution = "vod_resolution"
 	// FieldLastLive holds the string denoting the last_live field in the database.
 	FieldLastLive = "last_live"
 	// FieldRenderChat holds the string denoting the render_chat field in the database.
 	FieldRenderChat = "render_chat"
+	// FieldGenerateCaptions holds the string denoting the generate_captions field in the database.
+	FieldGenerateCaptions = "generate_captions"
 	// FieldVideoAge holds the string denoting the video_age field in the database.
 	FieldVideoAge = "video_age"
 	// FieldApplyCategoriesToLive holds the string denoting the apply_categories_to_live field in the database.
@@ -59,6 +69,8 @@ const (
 	FieldClipsIgnoreLastChecked = "clips_ignore_last_checked"
 	// FieldUpdateMetadataMinutes holds the string denoting the update_metadata_minutes field in the database.
 	FieldUpdateMetadataMinutes = "update_metadata_minutes"
+	// FieldTranscodingProfileID holds the string denoting the transcoding_profile_id field in the database.
+	FieldTranscodingProfileID = "transcoding_profile_id"
 	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
 	FieldUpdatedAt = "updated_at"
 	// FieldCreatedAt holds the string denoting the created_at field in the database.
@@ -69,6 +81,10 @@ const (
 	EdgeCategories = "categories"
 	// EdgeTitleRegex holds the string denoting the title_regex edge name in mutations.
 	EdgeTitleRegex = "title_regex"
+	// EdgeSchedule holds the string denoting the schedule edge name in mutations.
+	EdgeSchedule = "schedule"
+	// EdgeTranscodingProfile holds the string denoting the transcoding_profile edge name in mutations.
+	EdgeTranscodingProfile = "transcoding_profile"
 	// Table holds the table name of the live in the database.
 	Table = "lives"
 	// ChannelTable is the table that holds the channel relation/edge.
@@ -92,6 +108,20 @@ const (
 	TitleRegexInverseTable = "live_title_regexes"
 	// TitleRegexColumn is the table column denoting the title_regex relation/edge.
 	TitleRegexColumn = "live_id"
+	// ScheduleTable is the table that holds the schedule relation/edge.
+	ScheduleTable = "live_schedules"
+	// ScheduleInverseTable is the table name for the LiveSchedule entity.
+	// It exists in this package in order to avoid circular dependency with the "liveschedule" package.
+	ScheduleInverseTable = "live_schedules"
+	// ScheduleColumn is the table column denoting the schedule relation/edge.
+	ScheduleColumn = "live_id"
+	// TranscodingProfileTable is the table that holds the transcoding_profile relation/edge.
+	TranscodingProfileTable = "lives"
+	// TranscodingProfileInverseTable is the table name for the TranscodingProfile entity.
+	// It exists in this package in order to avoid circular dependency with the "transcodingprofile" package.
+	TranscodingProfileInverseTable = "transcoding_profiles"
+	// TranscodingProfileColumn is the table column denoting the transcoding_profile relation/edge.
+	TranscodingProfileColumn = "transcoding_profile_id"
 )
 
 // Columns holds all SQL columns for live fields.
@@ -106,9 +136,14 @@ var Columns = []string{
 	FieldIsLive,
 	FieldArchiveChat,
 	FieldResolution,
+	FieldQualityFallbacks,
+	FieldRecordAudioFallback,
+	FieldSplitHours,
+	FieldSplitOnCategoryChange,
 	FieldVodResolution,
 	FieldLastLive,
 	FieldRenderChat,
+	FieldGenerateCaptions,
 	FieldVideoAge,
 	FieldApplyCategoriesToLive,
 	FieldStrictCategoriesLive,
@@ -119,6 +154,7 @@ var Columns = []string{
 	FieldClipsLastChecked,
 	FieldClipsIgnoreLastChecked,
 	FieldUpdateMetadataMinutes,
+	FieldTranscodingProfileID,
 	FieldUpdatedAt,
 	FieldCreatedAt,
 }
@@ -163,12 +199,22 @@ var (
 	DefaultArchiveChat bool
 	// DefaultResolution holds the default value on creation for the "resolution" field.
 	DefaultResolution string
+	// DefaultRecordAudioFallback holds the default value on creation for the "record_audio_fallback" field.
+	DefaultRecordAudioFallback bool
+	// DefaultSplitHours holds the default value on creation for the "split_hours" field.
+	DefaultSplitHours int
+	// SplitHoursValidator is a validator for the "split_hours" field. It is called by the builders before save.
+	SplitHoursValidator func(int) error
+	// DefaultSplitOnCategoryChange holds the default value on creation for the "split_on_category_change" field.
+	DefaultSplitOnCategoryChange bool
 	// DefaultVodResolution holds the default value on creation for the "vod_resolution" field.
 	DefaultVodResolution string
 	// DefaultLastLive holds the default value on creation for the "last_live" field.
 	DefaultLastLive func() time.Time
 	// DefaultRenderChat holds the default value on creation for the "render_chat" field.
 	DefaultRenderChat bool
+	// DefaultGenerateCaptions holds the default value on creation for the "generate_captions" field.
+	DefaultGenerateCaptions bool
 	// DefaultVideoAge holds the default value on creation for the "video_age" field.
 	DefaultVideoAge int64
 	// DefaultApplyCategoriesToLive holds the default value on creation for the "apply_categories_to_live" field.
@@ -252,6 +298,21 @@ func ByResolution(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldResolution, opts...).ToFunc()
 }
 
+// ByRecordAudioFallback orders the results by the record_audio_fallback field.
+func ByRecordAudioFallback(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRecordAudioFallback, opts...).ToFunc()
+}
+
+// BySplitHours orders the results by the split_hours field.
+func BySplitHours(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSplitHours, opts...).ToFunc()
+}
+
+// BySplitOnCategoryChange orders the results by the split_on_category_change field.
+func BySplitOnCategoryChange(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSplitOnCategoryChange, opts...).ToFunc()
+}
+
 // ByVodResolution orders the results by the vod_resolution field.
 func ByVodResolution(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldVodResolution, opts...).ToFunc()
@@ -267,6 +328,11 @@ func ByRenderChat(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldRenderChat, opts...).ToFunc()
 }
 
+// ByGenerateCaptions orders the results by the generate_captions field.
+func ByGenerateCaptions(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldGenerateCaptions, opts...).ToFunc()
+}
+
 // ByVideoAge orders the results by the video_age field.
 func ByVideoAge(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldVideoAge, opts...).ToFunc()
@@ -317,6 +383,11 @@ func ByUpdateMetadataMinutes(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldUpdateMetadataMinutes, opts...).ToFunc()
 }
 
+// ByTranscodingProfileID orders the results by the transcoding_profile_id field.
+func ByTranscodingProfileID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTranscodingProfileID, opts...).ToFunc()
+}
+
 // ByUpdatedAt orders the results by the updated_at field.
 func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
@@ -361,6 +432,20 @@ func ByTitleRegex(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
 		sqlgraph.OrderByNeighborTerms(s, newTitleRegexStep(), append([]sql.OrderTerm{term}, terms...)...)
 	}
 }
+
+// ByScheduleField orders the results by schedule field.
+func ByScheduleField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newScheduleStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByTranscodingProfileField orders the results by transcoding_profile field.
+func ByTranscodingProfileField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newTranscodingProfileStep(), sql.OrderByField(field, opts...))
+	}
+}
 func newChannelStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -382,3 +467,17 @@ func newTitleRegexStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.O2M, false, TitleRegexTable, TitleRegexColumn),
 	)
 }
+func newScheduleStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ScheduleInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2O, false, ScheduleTable, ScheduleColumn),
+	)
+}
+func newTranscodingProfileStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(TranscodingProfileInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, false, TranscodingProfileTable, TranscodingProfileColumn),
+	)
+}
@@ -101,6 +101,21 @@ func Resolution(v string) predicate.Live {
 	return predicate.Live(sql.FieldEQ(FieldResolution, v))
 }
 
+// RecordAudioFallback applies equality check predicate on the "record_audio_fallback" field. It's identical to RecordAudioFallbackEQ.
+func RecordAudioFallback(v bool) predicate.Live {
+	return predicate.Live(sql.FieldEQ(FieldRecordAudioFallback, v))
+}
+
+// SplitHours applies equality check predicate on the "split_hours" field. It's identical to SplitHoursEQ.
+func SplitHours(v int) predicate.Live {
+	return predicate.Live(sql.FieldEQ(FieldSplitHours, v))
+}
+
+// SplitOnCategoryChange applies equality check predicate on the "split_on_category_change" field. It's identical to SplitOnCategoryChangeEQ.
+func SplitOnCategoryChange(v bool) predicate.Live {
+	return predicate.Live(sql.FieldEQ(FieldSplitOnCategoryChange, v))
+}
+
 // VodResolution applies equality check predicate on the "vod_resolution" field. It's identical to VodResolutionEQ.
 func VodResolution(v string) predicate.Live {
 	return predicate.Live(sql.FieldEQ(FieldVodResolution, v))
@@ -116,6 +131,11 @@ func RenderChat(v bool) predicate.Live {
 	return predicate.Live(sql.FieldEQ(FieldRenderChat, v))
 }
 
+// GenerateCaptions applies equality check predicate on the "generate_captions" field. It's identical to GenerateCaptionsEQ.
+func GenerateCaptions(v bool) predicate.Live {
+	return predicate.Live(sql.FieldEQ(FieldGenerateCaptions, v))
+}
+
 // VideoAge applies equality check predicate on the "video_age" field. It's identical to VideoAgeEQ.
 func VideoAge(v int64) predicate.Live {
 	return predicate.Live(sql.FieldEQ(FieldVideoAge, v))
@@ -166,6 +186,11 @@ func UpdateMetadataMinutes(v int) predicate.Live {
 	return predicate.Live(sql.FieldEQ(FieldUpdateMetadataMinutes, v))
 }
 
+// TranscodingProfileID applies equality check predicate on the "transcoding_profile_id" field. It's identical to TranscodingProfileIDEQ.
+func TranscodingProfileID(v uuid.UUID) predicate.Live {
+	return predicate.Live(sql.FieldEQ(FieldTranscodingProfileID, v))
+}
+
 // UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
 func UpdatedAt(v time.Time) predicate.Live {
 	return predicate.Live(sql.FieldEQ(FieldUpdatedAt, v))
@@ -331,6 +356,76 @@ func ResolutionContainsFold(v string) predicate.Live {
 	return predicate.Live(sql.FieldContainsFold(FieldResolution, v))
 }
 
+// QualityFallbacksIsNil applies the IsNil predicate on the "quality_fallbacks" field.
+func QualityFallbacksIsNil() predicate.Live {
+	return predicate.Live(sql.FieldIsNull(FieldQualityFallbacks))
+}
+
+// QualityFallbacksNotNil applies the NotNil predicate on the "quality_fallbacks" field.
+func QualityFallbacksNotNil() predicate.Live {
+	return predicate.Live(sql.FieldNotNull(FieldQualityFallbacks))
+}
+
+// RecordAudioFallbackEQ applies the EQ predicate on the "record_audio_fallback" field.
+func RecordAudioFallbackEQ(v bool) predicate.Live {
+	return predicate.Live(sql.FieldEQ(FieldRecordAudioFallback, v))
+}
+
+// RecordAudioFallbackNEQ applies the NEQ predicate on the "record_audio_fallback" field.
+func RecordAudioFallbackNEQ(v bool) predicate.Live {
+	return predicate.Live(sql.FieldNEQ(FieldRecordAudioFallback, v))
+}
+
+// SplitHoursEQ applies the EQ predicate on the "split_hours" field.
+func SplitHoursEQ(v int) predicate.Live {
+	return predicate.Live(sql.FieldEQ(FieldSplitHours, v))
+}
+
+// SplitHoursNEQ applies the NEQ predicate on the "split_hours" field.
+func SplitHoursNEQ(v int) predicate.Live {
+	return predicate.Live(sql.FieldNEQ(FieldSplitHours, v))
+}
+
+// SplitHoursIn applies the In predicate on the "split_hours" field.
+func SplitHoursIn(vs ...int) predicate.Live {
+	return predicate.Live(sql.FieldIn(FieldSplitHours, vs...))
+}
+
+// SplitHoursNotIn applies the NotIn predicate on the "split_hours" field.
+func SplitHoursNotIn(vs ...int) predicate.Live {
+	return predicate.Live(sql.FieldNotIn(FieldSplitHours, vs...))
+}
+
+// SplitHoursGT applies the GT predicate on the "split_hours" field.
+func SplitHoursGT(v int) predicate.Live {
+	return predicate.Live(sql.FieldGT(FieldSplitHours, v))
+}
+
+// SplitHoursGTE applies the GTE predicate on the "split_hours" field.
+func SplitHoursGTE(v int) predicate.Live {
+	return predicate.Live(sql.FieldGTE(FieldSplitHours, v))
+}
+
+// SplitHoursLT applies the LT predicate on the "split_hours" field.
+func SplitHoursLT(v int) predicate.Live {
+	return predicate.Live(sql.FieldLT(FieldSplitHours, v))
+}
+
+// SplitHoursLTE applies the LTE predicate on the "split_hours" field.
+func SplitHoursLTE(v int) predicate.Live {
+	return predicate.Live(sql.FieldLTE(FieldSplitHours, v))
+}
+
+// SplitOnCategoryChangeEQ applies the EQ predicate on the "split_on_category_change" field.
+func SplitOnCategoryChangeEQ(v bool) predicate.Live {
+	return predicate.Live(sql.FieldEQ(FieldSplitOnCategoryChange, v))
+}
+
+// SplitOnCategoryChangeNEQ applies the NEQ predicate on the "split_on_category_change" field.
+func SplitOnCategoryChangeNEQ(v bool) predicate.Live {
+	return predicate.Live(sql.FieldNEQ(FieldSplitOnCategoryChange, v))
+}
+
 // VodResolutionEQ applies the EQ predicate on the "vod_resolution" field.
 func VodResolutionEQ(v string) predicate.Live {
 	return predicate.Live(sql.FieldEQ(FieldVodResolution, v))
@@ -456,6 +551,16 @@ func RenderChatNEQ(v bool) predicate.Live {
 	return predicate.Live(sql.FieldNEQ(FieldRenderChat, v))
 }
 
+// GenerateCaptionsEQ applies the EQ predicate on the "generate_captions" field.
+func GenerateCaptionsEQ(v bool) predicate.Live {
+	return predicate.Live(sql.FieldEQ(FieldGenerateCaptions, v))
+}
+
+// GenerateCaptionsNEQ applies the NEQ predicate on the "generate_captions" field.
+func GenerateCaptionsNEQ(v bool) predicate.Live {
+	return predicate.Live(sql.FieldNEQ(FieldGenerateCaptions, v))
+}
+
 // VideoAgeEQ applies the EQ predicate on the "video_age" field.
 func VideoAgeEQ(v int64) predicate.Live {
 	return predicate.Live(sql.FieldEQ(FieldVideoAge, v))
@@ -716,6 +821,36 @@ func UpdateMetadataMinutesLTE(v int) predicate.Live {
 	return predicate.Live(sql.FieldLTE(FieldUpdateMetadataMinutes, v))
 }
 
+// TranscodingProfileIDEQ applies the EQ predicate on the "transcoding_profile_id" field.
+func TranscodingProfileIDEQ(v uuid.UUID) predicate.Live {
+	return predicate.Live(sql.FieldEQ(FieldTranscodingProfileID, v))
+}
+
+// TranscodingProfileIDNEQ applies the NEQ predicate on the "transcoding_profile_id" field.
+func TranscodingProfileIDNEQ(v uuid.UUID) predicate.Live {
+	return predicate.Live(sql.FieldNEQ(FieldTranscodingProfileID, v))
+}
+
+// TranscodingProfileIDIn applies the In predicate on the "transcoding_profile_id" field.
+func TranscodingProfileIDIn(vs ...uuid.UUID) predicate.Live {
+	return predicate.Live(sql.FieldIn(FieldTranscodingProfileID, vs...))
+}
+
+// TranscodingProfileIDNotIn applies the NotIn predicate on the "transcoding_profile_id" field.
+func TranscodingProfileIDNotIn(vs ...uuid.UUID) predicate.Live {
+	return predicate.Live(sql.FieldNotIn(FieldTranscodingProfileID, vs...))
+}
+
+// TranscodingProfileIDIsNil applies the IsNil predicate on the "transcoding_profile_id" field.
+func TranscodingProfileIDIsNil() predicate.Live {
+	return predicate.Live(sql.FieldIsNull(FieldTranscodingProfileID))
+}
+
+// TranscodingProfileIDNotNil applies the NotNil predicate on the "transcoding_profile_id" field.
+func TranscodingProfileIDNotNil() predicate.Live {
+	return predicate.Live(sql.FieldNotNull(FieldTranscodingProfileID))
+}
+
 // UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
 func UpdatedAtEQ(v time.Time) predicate.Live {
 	return predicate.Live(sql.FieldEQ(FieldUpdatedAt, v))
@@ -865,6 +1000,52 @@ func HasTitleRegexWith(preds ...predicate.LiveTitleRegex) predicate.Live {
 	})
 }
 
+// HasSchedule applies the HasEdge predicate on the "schedule" edge.
+func HasSchedule() predicate.Live {
+	return predicate.Live(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, false, ScheduleTable, ScheduleColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasScheduleWith applies the HasEdge predicate on the "schedule" edge with a given conditions (other predicates).
+func HasScheduleWith(preds ...predicate.LiveSchedule) predicate.Live {
+	return predicate.Live(func(s *sql.Selector) {
+		step := newScheduleStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasTranscodingProfile applies the HasEdge predicate on the "transcoding_profile" edge.
+func HasTranscodingProfile() predicate.Live {
+	return predicate.Live(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, TranscodingProfileTable, TranscodingProfileColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasTranscodingProfileWith applies the HasEdge predicate on the "transcoding_profile" edge with a given conditions (other predicates).
+func HasTranscodingProfileWith(preds ...predicate.TranscodingProfile) predicate.Live {
+	return predicate.Live(func(s *sql.Selector) {
+		step := newTranscodingProfileStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.Live) predicate.Live {
 	return predicate.Live(sql.AndPredicates(predicates...))
@@ -16,7 +16,9 @@ import (
 	"github.com/zibbp/ganymede/ent/channel"
 	"github.com/zibbp/ganymede/ent/live"
 	"github.com/zibbp/ganymede/ent/livecategory"
+	"github.com/zibbp/ganymede/ent/liveschedule"
 	"github.com/zibbp/ganymede/ent/livetitleregex"
+	"github.com/zibbp/ganymede/ent/transcodingprofile"
 )
 
 // LiveCreate is the builder for creating a Live entity.
@@ -153,6 +155,54 @@ func (_c *LiveCreate) SetNillableResolution(v *string) *LiveCreate {
 	return _c
 }
 
+// SetQualityFallbacks sets the "quality_fallbacks" field.
+func (_c *LiveCreate) SetQualityFallbacks(v []string) *LiveCreate {
+	_c.mutation.SetQualityFallbacks(v)
+	return _c
+}
+
+// SetRecordAudioFallback sets the "record_audio_fallback" field.
+func (_c *LiveCreate) SetRecordAudioFallback(v bool) *LiveCreate {
+	_c.mutation.SetRecordAudioFallback(v)
+	return _c
+}
+
+// SetNillableRecordAudioFallback sets the "record_audio_fallback" field if the given value is not nil.
+func (_c *LiveCreate) SetNillableRecordAudioFallback(v *bool) *LiveCreate {
+	if v != nil {
+		_c.SetRecordAudioFallback(*v)
+	}
+	return _c
+}
+
+// SetSplitHours sets the "split_hours" field.
+func (_c *LiveCreate) SetSplitHours(v int) *LiveCreate {
+	_c.mutation.SetSplitHours(v)
+	return _c
+}
+
+// SetNillableSplitHours sets the "split_hours" field if the given value is not nil.
+func (_c *LiveCreate) SetNillableSplitHours(v *int) *LiveCreate {
+	if v != nil {
+		_c.SetSplitHours(*v)
+	}
+	return _c
+}
+
+// SetSplitOnCategoryChange sets the "split_on_category_change" field.
+func (_c *LiveCreate) SetSplitOnCategoryChange(v bool) *LiveCreate {
+	_c.mutation.SetSplitOnCategoryChange(v)
+	return _c
+}
+
+// SetNillableSplitOnCategoryChange sets the "split_on_category_change" field if the given value is not nil.
+func (_c *LiveCreate) SetNillableSplitOnCategoryChange(v *bool) *LiveCreate {
+	if v != nil {
+		_c.SetSplitOnCategoryChange(*v)
+	}
+	return _c
+}
+
 // SetVodResolution sets the "vod_resolution" field.
 func (_c *LiveCreate) SetVodResolution(v string) *LiveCreate {
 	_c.mutation.SetVodResolution(v)
@@ -195,6 +245,20 @@ func (_c *LiveCreate) SetNillableRenderChat(v *bool) *LiveCreate {
 	return _c
 }
 
+// SetGenerateCaptions sets the "generate_captions" field.
+func (_c *LiveCreate) SetGenerateCaptions(v bool) *LiveCreate {
+	_c.mutation.SetGenerateCaptions(v)
+	return _c
+}
+
+// SetNillableGenerateCaptions sets the "generate_captions" field if the given value is not nil.
+func (_c *LiveCreate) SetNillableGenerateCaptions(v *bool) *LiveCreate {
+	if v != nil {
+		_c.SetGenerateCaptions(*v)
+	}
+	return _c
+}
+
 // SetVideoAge sets the "video_age" field.
 func (_c *LiveCreate) SetVideoAge(v int64) *LiveCreate {
 	_c.mutation.SetVideoAge(v)
@@ -335,6 +399,20 @@ func (_c *LiveCreate) SetNillableUpdateMetadataMinutes(v *int) *LiveCreate {
 	return _c
 }
 
+// SetTranscodingProfileID sets the "transcoding_profile_id" field.
+func (_c *LiveCreate) SetTranscodingProfileID(v uuid.UUID) *LiveCreate {
+	_c.mutation.SetTranscodingProfileID(v)
+	return _c
+}
+
+// SetNillableTranscodingProfileID sets the "transcoding_profile_id" field if the given value is not nil.
+func (_c *LiveCreate) SetNillableTranscodingProfileID(v *uuid.UUID) *LiveCreate {
+	if v != nil {
+		_c.SetTranscodingProfileID(*v)
+	}
+	return _c
+}
+
 // SetUpdatedAt sets the "updated_at" field.
 func (_c *LiveCreate) SetUpdatedAt(v time.Time) *LiveCreate {
 	_c.mutation.SetUpdatedAt(v)
@@ -418,6 +496,30 @@ func (_c *LiveCreate) AddTitleRegex(v ...*LiveTitleRegex) *LiveCreate {
 	return _c.AddTitleRegexIDs(ids...)
 }
 
+// SetScheduleID sets the "schedule" edge to the LiveSchedule entity by ID.
+func (_c *LiveCreate) SetScheduleID(id uuid.UUID) *LiveCreate {
+	_c.mutation.SetScheduleID(id)
+	return _c
+}
+
+// SetNillableScheduleID sets the "schedule" edge to the LiveSchedule entity by ID if the given value is not nil.
+func (_c *LiveCreate) SetNillableScheduleID(id *uuid.UUID) *LiveCreate {
+	if id != nil {
+		_c = _c.SetScheduleID(*id)
+	}
+	return _c
+}
+
+// SetSchedule sets the "schedule" edge to the LiveSchedule entity.
+func (_c *LiveCreate) SetSchedule(v *LiveSchedule) *LiveCreate {
+	return _c.SetScheduleID(v.ID)
+}
+
+// SetTranscodingProfile sets the "transcoding_profile" edge to the TranscodingProfile entity.
+func (_c *LiveCreate) SetTranscodingProfile(v *TranscodingProfile) *LiveCreate {
+	return _c.SetTranscodingProfileID(v.ID)
+}
+
 // Mutation returns the LiveMutation object of the builder.
 func (_c *LiveCreate) Mutation() *LiveMutation {
 	return _c.mutation
@@ -489,6 +591,18 @@ func (_c *LiveCreate) defaults() {
 		v := live.DefaultResolution
 		_c.mutation.SetResolution(v)
 	}
+	if _, ok := _c.mutation.RecordAudioFallback(); !ok {
+		v := live.DefaultRecordAudioFallback
+		_c.mutation.SetRecordAudioFallback(v)
+	}
+	if _, ok := _c.mutation.SplitHours(); !ok {
+		v := live.DefaultSplitHours
+		_c.mutation.SetSplitHours(v)
+	}
+	if _, ok := _c.mutation.SplitOnCategoryChange(); !ok {
+		v := live.DefaultSplitOnCategoryChange
+		_c.mutation.SetSplitOnCategoryChange(v)
+	}
 	if _, ok := _c.mutation.VodResolution(); !ok {
 		v := live.DefaultVodResolution
 		_c.mutation.SetVodResolution(v)
@@ -501,6 +615,10 @@ func (_c *LiveCreate) defaults() {
 		v := live.DefaultRenderChat
 		_c.mutation.SetRenderChat(v)
 	}
+	if _, ok := _c.mutation.GenerateCaptions(); !ok {
+		v := live.DefaultGenerateCaptions
+		_c.mutation.SetGenerateCaptions(v)
+	}
 	if _, ok := _c.mutation.VideoAge(); !ok {
 		v := live.DefaultVideoAge
 		_c.mutation.SetVideoAge(v)
@@ -577,12 +695,29 @@ func (_c *LiveCreate) check() error {
 	if _, ok := _c.mutation.ArchiveChat(); !ok {
 		return &ValidationError{Name: "archive_chat", err: errors.New(`ent: missing required field "Live.archive_chat"`)}
 	}
+	if _, ok := _c.mutation.RecordAudioFallback(); !ok {
+		return &ValidationError{Name: "record_audio_fallback", err: errors.New(`ent: missing required field "Live.record_audio_fallback"`)}
+	}
+	if _, ok := _c.mutation.SplitHours(); !ok {
+		return &ValidationError{Name: "split_hours", err: errors.New(`ent: missing required field "Live.split_hours"`)}
+	}
+	if v, ok := _c.mutation.SplitHours(); ok {
+		if err := live.SplitHoursValidator(v); err != nil {
+			return &ValidationError{Name: "split_hours", err: fmt.Errorf(`ent: validator failed for field "Live.split_hours": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.SplitOnCategoryChange(); !ok {
+		return &ValidationError{Name: "split_on_category_change", err: errors.New(`ent: missing required field "Live.split_on_category_change"`)}
+	}
 	if _, ok := _c.mutation.LastLive(); !ok {
 		return &ValidationError{Name: "last_live", err: errors.New(`ent: missing required field "Live.last_live"`)}
 	}
 	if _, ok := _c.mutation.RenderChat(); !ok {
 		return &ValidationError{Name: "render_chat", err: errors.New(`ent: missing required field "Live.render_chat"`)}
 	}
+	if _, ok := _c.mutation.GenerateCaptions(); !ok {
+		return &ValidationError{Name: "generate_captions", err: errors.New(`ent: missing required field "Live.generate_captions"`)}
+	}
 	if _, ok := _c.mutation.VideoAge(); !ok {
 		return &ValidationError{Name: "video_age", err: errors.New(`ent: missing required field "Live.video_age"`)}
 	}
@@ -696,6 +831,22 @@ func (_c *LiveCreate) createSpec() (*Live, *sqlgraph.CreateSpec) {
 		_spec.SetField(live.FieldResolution, field.TypeString, value)
 		_node.Resolution = value
 	}
+	if value, ok := _c.mutation.QualityFallbacks(); ok {
+		_spec.SetField(live.FieldQualityFallbacks, field.TypeJSON, value)
+		_node.QualityFallbacks = value
+	}
+	if value, ok := _c.mutation.RecordAudioFallback(); ok {
+		_spec.SetField(live.FieldRecordAudioFallback, field.TypeBool, value)
+		_node.RecordAudioFallback = value
+	}
+	if value, ok := _c.mutation.SplitHours(); ok {
+		_spec.SetField(live.FieldSplitHours, field.TypeInt, value)
+		_node.SplitHours = value
+	}
+	if value, ok := _c.mutation.SplitOnCategoryChange(); ok {
+		_spec.SetField(live.FieldSplitOnCategoryChange, field.TypeBool, value)
+		_node.SplitOnCategoryChange = value
+	}
 	if value, ok := _c.mutation.VodResolution(); ok {
 		_spec.SetField(live.FieldVodResolution, field.TypeString, value)
 		_node.VodResolution = value
@@ -708,6 +859,10 @@ func (_c *LiveCreate) createSpec() (*Live, *sqlgraph.CreateSpec) {
 		_spec.SetField(live.FieldRenderChat, field.TypeBool, value)
 		_node.RenderChat = value
 	}
+	if value, ok := _c.mutation.GenerateCaptions(); ok {
+		_spec.SetField(live.FieldGenerateCaptions, field.TypeBool, value)
+		_node.GenerateCaptions = value
+	}
 	if value, ok := _c.mutation.VideoAge(); ok {
 		_spec.SetField(live.FieldVideoAge, field.TypeInt64, value)
 		_node.VideoAge = value
@@ -805,6 +960,39 @@ func (_c *LiveCreate) createSpec() (*Live, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := _c.mutation.ScheduleIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   live.ScheduleTable,
+			Columns: []string{live.ScheduleColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(liveschedule.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.TranscodingProfileIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   live.TranscodingProfileTable,
+			Columns: []string{live.TranscodingProfileColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(transcodingprofile.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.TranscodingProfileID = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
@@ -971,6 +1159,66 @@ func (u *LiveUpsert) ClearResolution() *LiveUpsert {
 	return u
 }
 
+// SetQualityFallbacks sets the "quality_fallbacks" field.
+func (u *LiveUpsert) SetQualityFallbacks(v []string) *LiveUpsert {
+	u.Set(live.FieldQualityFallbacks, v)
+	return u
+}
+
+// UpdateQualityFallbacks sets the "quality_fallbacks" field to the value that was provided on create.
+func (u *LiveUpsert) UpdateQualityFallbacks() *LiveUpsert {
+	u.SetExcluded(live.FieldQualityFallbacks)
+	return u
+}
+
+// ClearQualityFallbacks clears the value of the "quality_fallbacks" field.
+func (u *LiveUpsert) ClearQualityFallbacks() *LiveUpsert {
+	u.SetNull(live.FieldQualityFallbacks)
+	return u
+}
+
+// SetRecordAudioFallback sets the "record_audio_fallback" field.
+func (u *LiveUpsert) SetRecordAudioFallback(v bool) *LiveUpsert {
+	u.Set(live.FieldRecordAudioFallback, v)
+	return u
+}
+
+// UpdateRecordAudioFallback sets the "record_audio_fallback" field to the value that was provided on create.
+func (u *LiveUpsert) UpdateRecordAudioFallback() *LiveUpsert {
+	u.SetExcluded(live.FieldRecordAudioFallback)
+	return u
+}
+
+// SetSplitHours sets the "split_hours" field.
+func (u *LiveUpsert) SetSplitHours(v int) *LiveUpsert {
+	u.Set(live.FieldSplitHours, v)
+	return u
+}
+
+// UpdateSplitHours sets the "split_hours" field to the value that was provided on create.
+func (u *LiveUpsert) UpdateSplitHours() *LiveUpsert {
+	u.SetExcluded(live.FieldSplitHours)
+	return u
+}
+
+// AddSplitHours adds v to the "split_hours" field.
+func (u *LiveUpsert) AddSplitHours(v int) *LiveUpsert {
+	u.Add(live.FieldSplitHours, v)
+	return u
+}
+
+// SetSplitOnCategoryChange sets the "split_on_category_change" field.
+func (u *LiveUpsert) SetSplitOnCategoryChange(v bool) *LiveUpsert {
+	u.Set(live.FieldSplitOnCategoryChange, v)
+	return u
+}
+
+// UpdateSplitOnCategoryChange sets the "split_on_category_change" field to the value that was provided on create.
+func (u *LiveUpsert) UpdateSplitOnCategoryChange() *LiveUpsert {
+	u.SetExcluded(live.FieldSplitOnCategoryChange)
+	return u
+}
+
 // SetVodResolution sets the "vod_resolution" field.
 func (u *LiveUpsert) SetVodResolution(v string) *LiveUpsert {
 	u.Set(live.FieldVodResolution, v)
@@ -1013,6 +1261,18 @@ func (u *LiveUpsert) UpdateRenderChat() *LiveUpsert {
 	return u
 }
 
+// SetGenerateCaptions sets the "generate_captions" field.
+func (u *LiveUpsert) SetGenerateCaptions(v bool) *LiveUpsert {
+	u.Set(live.FieldGenerateCaptions, v)
+	return u
+}
+
+// UpdateGenerateCaptions sets the "generate_captions" field to the value that was provided on create.
+func (u *LiveUpsert) UpdateGenerateCaptions() *LiveUpsert {
+	u.SetExcluded(live.FieldGenerateCaptions)
+	return u
+}
+
 // SetVideoAge sets the "video_age" field.
 func (u *LiveUpsert) SetVideoAge(v int64) *LiveUpsert {
 	u.Set(live.FieldVideoAge, v)
@@ -1163,6 +1423,24 @@ func (u *LiveUpsert) AddUpdateMetadataMinutes(v int) *LiveUpsert {
 	return u
 }
 
+// SetTranscodingProfileID sets the "transcoding_profile_id" field.
+func (u *LiveUpsert) SetTranscodingProfileID(v uuid.UUID) *LiveUpsert {
+	u.Set(live.FieldTranscodingProfileID, v)
+	return u
+}
+
+// UpdateTranscodingProfileID sets the "transcoding_profile_id" field to the value that was provided on create.
+func (u *LiveUpsert) UpdateTranscodingProfileID() *LiveUpsert {
+	u.SetExcluded(live.FieldTranscodingProfileID)
+	return u
+}
+
+// ClearTranscodingProfileID clears the value of the "transcoding_profile_id" field.
+func (u *LiveUpsert) ClearTranscodingProfileID() *LiveUpsert {
+	u.SetNull(live.FieldTranscodingProfileID)
+	return u
+}
+
 // SetUpdatedAt sets the "updated_at" field.
 func (u *LiveUpsert) SetUpdatedAt(v time.Time) *LiveUpsert {
 	u.Set(live.FieldUpdatedAt, v)
@@ -1359,6 +1637,76 @@ func (u *LiveUpsertOne) ClearResolution() *LiveUpsertOne {
 	})
 }
 
+// SetQualityFallbacks sets the "quality_fallbacks" field.
+func (u *LiveUpsertOne) SetQualityFallbacks(v []string) *LiveUpsertOne {
+	return u.Update(func(s *LiveUpsert) {
+		s.SetQualityFallbacks(v)
+	})
+}
+
+// UpdateQualityFallbacks sets the "quality_fallbacks" field to the value that was provided on create.
+func (u *LiveUpsertOne) UpdateQualityFallbacks() *LiveUpsertOne {
+	return u.Update(func(s *LiveUpsert) {
+		s.UpdateQualityFallbacks()
+	})
+}
+
+// ClearQualityFallbacks clears the value of the "quality_fallbacks" field.
+func (u *LiveUpsertOne) ClearQualityFallbacks() *LiveUpsertOne {
+	return u.Update(func(s *LiveUpsert) {
+		s.ClearQualityFallbacks()
+	})
+}
+
+// SetRecordAudioFallback sets the "record_audio_fallback" field.
+func (u *LiveUpsertOne) SetRecordAudioFallback(v bool) *LiveUpsertOne {
+	return u.Update(func(s *LiveUpsert) {
+		s.SetRecordAudioFallback(v)
+	})
+}
+
+// UpdateRecordAudioFallback sets the "record_audio_fallback" field to the value that was provided on create.
+func (u *LiveUpsertOne) UpdateRecordAudioFallback() *LiveUpsertOne {
+	return u.Update(func(s *LiveUpsert) {
+		s.UpdateRecordAudioFallback()
+	})
+}
+
+// SetSplitHours sets the "split_hours" field.
+func (u *LiveUpsertOne) SetSplitHours(v int) *LiveUpsertOne {
+	return u.Update(func(s *LiveUpsert) {
+		s.SetSplitHours(v)
+	})
+}
+
+// AddSplitHours adds v to the "split_hours" field.
+func (u *LiveUpsertOne) AddSplitHours(v int) *LiveUpsertOne {
+	return u.Update(func(s *LiveUpsert) {
+		s.AddSplitHours(v)
+	})
+}
+
+// UpdateSplitHours sets the "split_hours" field to the value that was provided on create.
+func (u *LiveUpsertOne) UpdateSplitHours() *LiveUpsertOne {
+	return u.Update(func(s *LiveUpsert) {
+		s.UpdateSplitHours()
+	})
+}
+
+// SetSplitOnCategoryChange sets the "split_on_category_change" field.
+func (u *LiveUpsertOne) SetSplitOnCategoryChange(v bool) *LiveUpsertOne {
+	return u.Update(func(s *LiveUpsert) {
+		s.SetSplitOnCategoryChange(v)
+	})
+}
+
+// UpdateSplitOnCategoryChange sets the "split_on_category_change" field to the value that was provided on create.
+func (u *LiveUpsertOne) UpdateSplitOnCategoryChange() *LiveUpsertOne {
+	return u.Update(func(s *LiveUpsert) {
+		s.UpdateSplitOnCategoryChange()
+	})
+}
+
 // SetVodResolution sets the "vod_resolution" field.
 func (u *LiveUpsertOne) SetVodResolution(v string) *LiveUpsertOne {
 	return u.Update(func(s *LiveUpsert) {
@@ -1408,6 +1756,20 @@ func (u *LiveUpsertOne) UpdateRenderChat() *LiveUpsertOne {
 	})
 }
 
+// SetGenerateCaptions sets the "generate_captions" field.
+func (u *LiveUpsertOne) SetGenerateCaptions(v bool) *LiveUpsertOne {
+	return u.Update(func(s *LiveUpsert) {
+		s.SetGenerateCaptions(v)
+	})
+}
+
+// UpdateGenerateCaptions sets the "generate_captions" field to the value that was provided on create.
+func (u *LiveUpsertOne) UpdateGenerateCaptions() *LiveUpsertOne {
+	return u.Update(func(s *LiveUpsert) {
+		s.UpdateGenerateCaptions()
+	})
+}
+
 // SetVideoAge sets the "video_age" field.
 func (u *LiveUpsertOne) SetVideoAge(v int64) *LiveUpsertOne {
 	return u.Update(func(s *LiveUpsert) {
@@ -1583,6 +1945,27 @@ func (u *LiveUpsertOne) UpdateUpdateMetadataMinutes() *LiveUpsertOne {
 	})
 }
 
+// SetTranscodingProfileID sets the "transcoding_profile_id" field.
+func (u *LiveUpsertOne) SetTranscodingProfileID(v uuid.UUID) *LiveUpsertOne {
+	return u.Update(func(s *LiveUpsert) {
+		s.SetTranscodingProfileID(v)
+	})
+}
+
+// UpdateTranscodingProfileID sets the "transcoding_profile_id" field to the value that was provided on create.
+func (u *LiveUpsertOne) UpdateTranscodingProfileID() *LiveUpsertOne {
+	return u.Update(func(s *LiveUpsert) {
+		s.UpdateTranscodingProfileID()
+	})
+}
+
+// ClearTranscodingProfileID clears the value of the "transcoding_profile_id" field.
+func (u *LiveUpsertOne) ClearTranscodingProfileID() *LiveUpsertOne {
+	return u.Update(func(s *LiveUpsert) {
+		s.ClearTranscodingProfileID()
+	})
+}
+
 // SetUpdatedAt sets the "updated_at" field.
 func (u *LiveUpsertOne) SetUpdatedAt(v time.Time) *LiveUpsertOne {
 	return u.Update(func(s *LiveUpsert) {
@@ -1948,6 +2331,76 @@ func (u *LiveUpsertBulk) ClearResolution() *LiveUpsertBulk {
 	})
 }
 
+// SetQualityFallbacks sets the "quality_fallbacks" field.
+func (u *LiveUpsertBulk) SetQualityFallbacks(v []string) *LiveUpsertBulk {
+	return u.Update(func(s *LiveUpsert) {
+		s.SetQualityFallbacks(v)
+	})
+}
+
+// UpdateQualityFallbacks sets the "quality_fallbacks" field to the value that was provided on create.
+func (u *LiveUpsertBulk) UpdateQualityFallbacks() *LiveUpsertBulk {
+	return u.Update(func(s *LiveUpsert) {
+		s.UpdateQualityFallbacks()
+	})
+}
+
+// ClearQualityFallbacks clears the value of the "quality_fallbacks" field.
+func (u *LiveUpsertBulk) ClearQualityFallbacks() *LiveUpsertBulk {
+	return u.Update(func(s *LiveUpsert) {
+		s.ClearQualityFallbacks()
+	})
+}
+
+// SetRecordAudioFallback sets the "record_audio_fallback" field.
+func (u *LiveUpsertBulk) SetRecordAudioFallback(v bool) *LiveUpsertBulk {
+	return u.Update(func(s *LiveUpsert) {
+		s.SetRecordAudioFallback(v)
+	})
+}
+
+// UpdateRecordAudioFallback sets the "record_audio_fallback" field to the value that was provided on create.
+func (u *LiveUpsertBulk) UpdateRecordAudioFallback() *LiveUpsertBulk {
+	return u.Update(func(s *LiveUpsert) {
+		s.UpdateRecordAudioFallback()
+	})
+}
+
+// SetSplitHours sets the "split_hours" field.
+func (u *LiveUpsertBulk) SetSplitHours(v int) *LiveUpsertBulk {
+	return u.Update(func(s *LiveUpsert) {
+		s.SetSplitHours(v)
+	})
+}
+
+// AddSplitHours adds v to the "split_hours" field.
+func (u *LiveUpsertBulk) AddSplitHours(v int) *LiveUpsertBulk {
+	return u.Update(func(s *LiveUpsert) {
+		s.AddSplitHours(v)
+	})
+}
+
+// UpdateSplitHours sets the "split_hours" field to the value that was provided on create.
+func (u *LiveUpsertBulk) UpdateSplitHours() *LiveUpsertBulk {
+	return u.Update(func(s *LiveUpsert) {
+		s.UpdateSplitHours()
+	})
+}
+
+// SetSplitOnCategoryChange sets the "split_on_category_change" field.
+func (u *LiveUpsertBulk) SetSplitOnCategoryChange(v bool) *LiveUpsertBulk {
+	return u.Update(func(s *LiveUpsert) {
+		s.SetSplitOnCategoryChange(v)
+	})
+}
+
+// UpdateSplitOnCategoryChange sets the "split_on_category_change" field to the value that was provided on create.
+func (u *LiveUpsertBulk) UpdateSplitOnCategoryChange() *LiveUpsertBulk {
+	return u.Update(func(s *LiveUpsert) {
+		s.UpdateSplitOnCategoryChange()
+	})
+}
+
 // SetVodResolution sets the "vod_resolution" field.
 func (u *LiveUpsertBulk) SetVodResolution(v string) *LiveUpsertBulk {
 	return u.Update(func(s *LiveUpsert) {
@@ -1997,6 +2450,20 @@ func (u *LiveUpsertBulk) UpdateRenderChat() *LiveUpsertBulk {
 	})
 }
 
+// SetGenerateCaptions sets the "generate_captions" field.
+func (u *LiveUpsertBulk) SetGenerateCaptions(v bool) *LiveUpsertBulk {
+	return u.Update(func(s *LiveUpsert) {
+		s.SetGenerateCaptions(v)
+	})
+}
+
+// UpdateGenerateCaptions sets the "generate_captions" field to the value that was provided on create.
+func (u *LiveUpsertBulk) UpdateGenerateCaptions() *LiveUpsertBulk {
+	return u.Update(func(s *LiveUpsert) {
+		s.UpdateGenerateCaptions()
+	})
+}
+
 // SetVideoAge sets the "video_age" field.
 func (u *LiveUpsertBulk) SetVideoAge(v int64) *LiveUpsertBulk {
 	return u.Update(func(s *LiveUpsert) {
@@ -2172,6 +2639,27 @@ func (u *LiveUpsertBulk) UpdateUpdateMetadataMinutes() *LiveUpsertBulk {
 	})
 }
 
+// SetTranscodingProfileID sets the "transcoding_profile_id" field.
+func (u *LiveUpsertBulk) SetTranscodingProfileID(v uuid.UUID) *LiveUpsertBulk {
+	return u.Update(func(s *LiveUpsert) {
+		s.SetTranscodingProfileID(v)
+	})
+}
+
+// UpdateTranscodingProfileID sets the "transcoding_profile_id" field to the value that was provided on create.
+func (u *LiveUpsertBulk) UpdateTranscodingProfileID() *LiveUpsertBulk {
+	return u.Update(func(s *LiveUpsert) {
+		s.UpdateTranscodingProfileID()
+	})
+}
+
+// ClearTranscodingProfileID clears the value of the "transcoding_profile_id" field.
+func (u *LiveUpsertBulk) ClearTranscodingProfileID() *LiveUpsertBulk {
+	return u.Update(func(s *LiveUpsert) {
+		s.ClearTranscodingProfileID()
+	})
+}
+
 // SetUpdatedAt sets the "updated_at" field.
 func (u *LiveUpsertBulk) SetUpdatedAt(v time.Time) *LiveUpsertBulk {
 	return u.Update(func(s *LiveUpsert) {
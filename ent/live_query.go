@@ -16,21 +16,25 @@ import (
 	"github.com/zibbp/ganymede/ent/channel"
 	"github.com/zibbp/ganymede/ent/live"
 	"github.com/zibbp/ganymede/ent/livecategory"
+	"github.com/zibbp/ganymede/ent/liveschedule"
 	"github.com/zibbp/ganymede/ent/livetitleregex"
 	"github.com/zibbp/ganymede/ent/predicate"
+	"github.com/zibbp/ganymede/ent/transcodingprofile"
 )
 
 // LiveQuery is the builder for querying Live entities.
 type LiveQuery struct {
 	config
-	ctx            *QueryContext
-	order          []live.OrderOption
-	inters         []Interceptor
-	predicates     []predicate.Live
-	withChannel    *ChannelQuery
-	withCategories *LiveCategoryQuery
-	withTitleRegex *LiveTitleRegexQuery
-	withFKs        bool
+	ctx                    *QueryContext
+	order                  []live.OrderOption
+	inters                 []Interceptor
+	predicates             []predicate.Live
+	withChannel            *ChannelQuery
+	withCategories         *LiveCategoryQuery
+	withTitleRegex         *LiveTitleRegexQuery
+	withSchedule           *LiveScheduleQuery
+	withTranscodingProfile *TranscodingProfileQuery
+	withFKs                bool
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -133,6 +137,50 @@ func (_q *LiveQuery) QueryTitleRegex() *LiveTitleRegexQuery {
 	return query
 }
 
+// QuerySchedule chains the current query on the "schedule" edge.
+func (_q *LiveQuery) QuerySchedule() *LiveScheduleQuery {
+	query := (&LiveScheduleClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(live.Table, live.FieldID, selector),
+			sqlgraph.To(liveschedule.Table, liveschedule.FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, false, live.ScheduleTable, live.ScheduleColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryTranscodingProfile chains the current query on the "transcoding_profile" edge.
+func (_q *LiveQuery) QueryTranscodingProfile() *TranscodingProfileQuery {
+	query := (&TranscodingProfileClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(live.Table, live.FieldID, selector),
+			sqlgraph.To(transcodingprofile.Table, transcodingprofile.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, live.TranscodingProfileTable, live.TranscodingProfileColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first Live entity from the query.
 // Returns a *NotFoundError when no Live was found.
 func (_q *LiveQuery) First(ctx context.Context) (*Live, error) {
@@ -320,14 +368,16 @@ func (_q *LiveQuery) Clone() *LiveQuery {
 		return nil
 	}
 	return &LiveQuery{
-		config:         _q.config,
-		ctx:            _q.ctx.Clone(),
-		order:          append([]live.OrderOption{}, _q.order...),
-		inters:         append([]Interceptor{}, _q.inters...),
-		predicates:     append([]predicate.Live{}, _q.predicates...),
-		withChannel:    _q.withChannel.Clone(),
-		withCategories: _q.withCategories.Clone(),
-		withTitleRegex: _q.withTitleRegex.Clone(),
+		config:                 _q.config,
+		ctx:                    _q.ctx.Clone(),
+		order:                  append([]live.OrderOption{}, _q.order...),
+		inters:                 append([]Interceptor{}, _q.inters...),
+		predicates:             append([]predicate.Live{}, _q.predicates...),
+		withChannel:            _q.withChannel.Clone(),
+		withCategories:         _q.withCategories.Clone(),
+		withTitleRegex:         _q.withTitleRegex.Clone(),
+		withSchedule:           _q.withSchedule.Clone(),
+		withTranscodingProfile: _q.withTranscodingProfile.Clone(),
 		// clone intermediate query.
 		sql:  _q.sql.Clone(),
 		path: _q.path,
@@ -367,6 +417,28 @@ func (_q *LiveQuery) WithTitleRegex(opts ...func(*LiveTitleRegexQuery)) *LiveQue
 	return _q
 }
 
+// WithSchedule tells the query-builder to eager-load the nodes that are connected to
+// the "schedule" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *LiveQuery) WithSchedule(opts ...func(*LiveScheduleQuery)) *LiveQuery {
+	query := (&LiveScheduleClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withSchedule = query
+	return _q
+}
+
+// WithTranscodingProfile tells the query-builder to eager-load the nodes that are connected to
+// the "transcoding_profile" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *LiveQuery) WithTranscodingProfile(opts ...func(*TranscodingProfileQuery)) *LiveQuery {
+	query := (&TranscodingProfileClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withTranscodingProfile = query
+	return _q
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -446,10 +518,12 @@ func (_q *LiveQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Live, e
 		nodes       = []*Live{}
 		withFKs     = _q.withFKs
 		_spec       = _q.querySpec()
-		loadedTypes = [3]bool{
+		loadedTypes = [5]bool{
 			_q.withChannel != nil,
 			_q.withCategories != nil,
 			_q.withTitleRegex != nil,
+			_q.withSchedule != nil,
+			_q.withTranscodingProfile != nil,
 		}
 	)
 	if _q.withChannel != nil {
@@ -496,6 +570,18 @@ func (_q *LiveQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Live, e
 			return nil, err
 		}
 	}
+	if query := _q.withSchedule; query != nil {
+		if err := _q.loadSchedule(ctx, query, nodes, nil,
+			func(n *Live, e *LiveSchedule) { n.Edges.Schedule = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withTranscodingProfile; query != nil {
+		if err := _q.loadTranscodingProfile(ctx, query, nodes, nil,
+			func(n *Live, e *TranscodingProfile) { n.Edges.TranscodingProfile = e }); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
@@ -593,6 +679,65 @@ func (_q *LiveQuery) loadTitleRegex(ctx context.Context, query *LiveTitleRegexQu
 	}
 	return nil
 }
+func (_q *LiveQuery) loadSchedule(ctx context.Context, query *LiveScheduleQuery, nodes []*Live, init func(*Live), assign func(*Live, *LiveSchedule)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*Live)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(liveschedule.FieldLiveID)
+	}
+	query.Where(predicate.LiveSchedule(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(live.ScheduleColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.LiveID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "live_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *LiveQuery) loadTranscodingProfile(ctx context.Context, query *TranscodingProfileQuery, nodes []*Live, init func(*Live), assign func(*Live, *TranscodingProfile)) error {
+	ids := make([]uuid.UUID, 0, len(nodes))
+	nodeids := make(map[uuid.UUID][]*Live)
+	for i := range nodes {
+		if nodes[i].TranscodingProfileID == nil {
+			continue
+		}
+		fk := *nodes[i].TranscodingProfileID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(transcodingprofile.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "transcoding_profile_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
 
 func (_q *LiveQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := _q.querySpec()
@@ -619,6 +764,9 @@ func (_q *LiveQuery) querySpec() *sqlgraph.QuerySpec {
 				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
 			}
 		}
+		if _q.withTranscodingProfile != nil {
+			_spec.Node.AddColumnOnce(live.FieldTranscodingProfileID)
+		}
 	}
 	if ps := _q.predicates; len(ps) > 0 {
 		_spec.Predicate = func(selector *sql.Selector) {
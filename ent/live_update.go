@@ -10,13 +10,16 @@ import (
 
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
 	"entgo.io/ent/schema/field"
 	"github.com/google/uuid"
 	"github.com/zibbp/ganymede/ent/channel"
 	"github.com/zibbp/ganymede/ent/live"
 	"github.com/zibbp/ganymede/ent/livecategory"
+	"github.com/zibbp/ganymede/ent/liveschedule"
 	"github.com/zibbp/ganymede/ent/livetitleregex"
 	"github.com/zibbp/ganymede/ent/predicate"
+	"github.com/zibbp/ganymede/ent/transcodingprofile"
 )
 
 // LiveUpdate is the builder for updating Live entities.
@@ -164,6 +167,73 @@ func (_u *LiveUpdate) ClearResolution() *LiveUpdate {
 	return _u
 }
 
+// SetQualityFallbacks sets the "quality_fallbacks" field.
+func (_u *LiveUpdate) SetQualityFallbacks(v []string) *LiveUpdate {
+	_u.mutation.SetQualityFallbacks(v)
+	return _u
+}
+
+// AppendQualityFallbacks appends value to the "quality_fallbacks" field.
+func (_u *LiveUpdate) AppendQualityFallbacks(v []string) *LiveUpdate {
+	_u.mutation.AppendQualityFallbacks(v)
+	return _u
+}
+
+// ClearQualityFallbacks clears the value of the "quality_fallbacks" field.
+func (_u *LiveUpdate) ClearQualityFallbacks() *LiveUpdate {
+	_u.mutation.ClearQualityFallbacks()
+	return _u
+}
+
+// SetRecordAudioFallback sets the "record_audio_fallback" field.
+func (_u *LiveUpdate) SetRecordAudioFallback(v bool) *LiveUpdate {
+	_u.mutation.SetRecordAudioFallback(v)
+	return _u
+}
+
+// SetNillableRecordAudioFallback sets the "record_audio_fallback" field if the given value is not nil.
+func (_u *LiveUpdate) SetNillableRecordAudioFallback(v *bool) *LiveUpdate {
+	if v != nil {
+		_u.SetRecordAudioFallback(*v)
+	}
+	return _u
+}
+
+// SetSplitHours sets the "split_hours" field.
+func (_u *LiveUpdate) SetSplitHours(v int) *LiveUpdate {
+	_u.mutation.ResetSplitHours()
+	_u.mutation.SetSplitHours(v)
+	return _u
+}
+
+// SetNillableSplitHours sets the "split_hours" field if the given value is not nil.
+func (_u *LiveUpdate) SetNillableSplitHours(v *int) *LiveUpdate {
+	if v != nil {
+		_u.SetSplitHours(*v)
+	}
+	return _u
+}
+
+// AddSplitHours adds value to the "split_hours" field.
+func (_u *LiveUpdate) AddSplitHours(v int) *LiveUpdate {
+	_u.mutation.AddSplitHours(v)
+	return _u
+}
+
+// SetSplitOnCategoryChange sets the "split_on_category_change" field.
+func (_u *LiveUpdate) SetSplitOnCategoryChange(v bool) *LiveUpdate {
+	_u.mutation.SetSplitOnCategoryChange(v)
+	return _u
+}
+
+// SetNillableSplitOnCategoryChange sets the "split_on_category_change" field if the given value is not nil.
+func (_u *LiveUpdate) SetNillableSplitOnCategoryChange(v *bool) *LiveUpdate {
+	if v != nil {
+		_u.SetSplitOnCategoryChange(*v)
+	}
+	return _u
+}
+
 // SetVodResolution sets the "vod_resolution" field.
 func (_u *LiveUpdate) SetVodResolution(v string) *LiveUpdate {
 	_u.mutation.SetVodResolution(v)
@@ -212,6 +282,20 @@ func (_u *LiveUpdate) SetNillableRenderChat(v *bool) *LiveUpdate {
 	return _u
 }
 
+// SetGenerateCaptions sets the "generate_captions" field.
+func (_u *LiveUpdate) SetGenerateCaptions(v bool) *LiveUpdate {
+	_u.mutation.SetGenerateCaptions(v)
+	return _u
+}
+
+// SetNillableGenerateCaptions sets the "generate_captions" field if the given value is not nil.
+func (_u *LiveUpdate) SetNillableGenerateCaptions(v *bool) *LiveUpdate {
+	if v != nil {
+		_u.SetGenerateCaptions(*v)
+	}
+	return _u
+}
+
 // SetVideoAge sets the "video_age" field.
 func (_u *LiveUpdate) SetVideoAge(v int64) *LiveUpdate {
 	_u.mutation.ResetVideoAge()
@@ -386,6 +470,26 @@ func (_u *LiveUpdate) AddUpdateMetadataMinutes(v int) *LiveUpdate {
 	return _u
 }
 
+// SetTranscodingProfileID sets the "transcoding_profile_id" field.
+func (_u *LiveUpdate) SetTranscodingProfileID(v uuid.UUID) *LiveUpdate {
+	_u.mutation.SetTranscodingProfileID(v)
+	return _u
+}
+
+// SetNillableTranscodingProfileID sets the "transcoding_profile_id" field if the given value is not nil.
+func (_u *LiveUpdate) SetNillableTranscodingProfileID(v *uuid.UUID) *LiveUpdate {
+	if v != nil {
+		_u.SetTranscodingProfileID(*v)
+	}
+	return _u
+}
+
+// ClearTranscodingProfileID clears the value of the "transcoding_profile_id" field.
+func (_u *LiveUpdate) ClearTranscodingProfileID() *LiveUpdate {
+	_u.mutation.ClearTranscodingProfileID()
+	return _u
+}
+
 // SetUpdatedAt sets the "updated_at" field.
 func (_u *LiveUpdate) SetUpdatedAt(v time.Time) *LiveUpdate {
 	_u.mutation.SetUpdatedAt(v)
@@ -433,6 +537,30 @@ func (_u *LiveUpdate) AddTitleRegex(v ...*LiveTitleRegex) *LiveUpdate {
 	return _u.AddTitleRegexIDs(ids...)
 }
 
+// SetScheduleID sets the "schedule" edge to the LiveSchedule entity by ID.
+func (_u *LiveUpdate) SetScheduleID(id uuid.UUID) *LiveUpdate {
+	_u.mutation.SetScheduleID(id)
+	return _u
+}
+
+// SetNillableScheduleID sets the "schedule" edge to the LiveSchedule entity by ID if the given value is not nil.
+func (_u *LiveUpdate) SetNillableScheduleID(id *uuid.UUID) *LiveUpdate {
+	if id != nil {
+		_u = _u.SetScheduleID(*id)
+	}
+	return _u
+}
+
+// SetSchedule sets the "schedule" edge to the LiveSchedule entity.
+func (_u *LiveUpdate) SetSchedule(v *LiveSchedule) *LiveUpdate {
+	return _u.SetScheduleID(v.ID)
+}
+
+// SetTranscodingProfile sets the "transcoding_profile" edge to the TranscodingProfile entity.
+func (_u *LiveUpdate) SetTranscodingProfile(v *TranscodingProfile) *LiveUpdate {
+	return _u.SetTranscodingProfileID(v.ID)
+}
+
 // Mutation returns the LiveMutation object of the builder.
 func (_u *LiveUpdate) Mutation() *LiveMutation {
 	return _u.mutation
@@ -486,6 +614,18 @@ func (_u *LiveUpdate) RemoveTitleRegex(v ...*LiveTitleRegex) *LiveUpdate {
 	return _u.RemoveTitleRegexIDs(ids...)
 }
 
+// ClearSchedule clears the "schedule" edge to the LiveSchedule entity.
+func (_u *LiveUpdate) ClearSchedule() *LiveUpdate {
+	_u.mutation.ClearSchedule()
+	return _u
+}
+
+// ClearTranscodingProfile clears the "transcoding_profile" edge to the TranscodingProfile entity.
+func (_u *LiveUpdate) ClearTranscodingProfile() *LiveUpdate {
+	_u.mutation.ClearTranscodingProfile()
+	return _u
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (_u *LiveUpdate) Save(ctx context.Context) (int, error) {
 	_u.defaults()
@@ -524,6 +664,11 @@ func (_u *LiveUpdate) defaults() {
 
 // check runs all checks and user-defined validators on the builder.
 func (_u *LiveUpdate) check() error {
+	if v, ok := _u.mutation.SplitHours(); ok {
+		if err := live.SplitHoursValidator(v); err != nil {
+			return &ValidationError{Name: "split_hours", err: fmt.Errorf(`ent: validator failed for field "Live.split_hours": %w`, err)}
+		}
+	}
 	if v, ok := _u.mutation.UpdateMetadataMinutes(); ok {
 		if err := live.UpdateMetadataMinutesValidator(v); err != nil {
 			return &ValidationError{Name: "update_metadata_minutes", err: fmt.Errorf(`ent: validator failed for field "Live.update_metadata_minutes": %w`, err)}
@@ -577,6 +722,29 @@ func (_u *LiveUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if _u.mutation.ResolutionCleared() {
 		_spec.ClearField(live.FieldResolution, field.TypeString)
 	}
+	if value, ok := _u.mutation.QualityFallbacks(); ok {
+		_spec.SetField(live.FieldQualityFallbacks, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedQualityFallbacks(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, live.FieldQualityFallbacks, value)
+		})
+	}
+	if _u.mutation.QualityFallbacksCleared() {
+		_spec.ClearField(live.FieldQualityFallbacks, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.RecordAudioFallback(); ok {
+		_spec.SetField(live.FieldRecordAudioFallback, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.SplitHours(); ok {
+		_spec.SetField(live.FieldSplitHours, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedSplitHours(); ok {
+		_spec.AddField(live.FieldSplitHours, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.SplitOnCategoryChange(); ok {
+		_spec.SetField(live.FieldSplitOnCategoryChange, field.TypeBool, value)
+	}
 	if value, ok := _u.mutation.VodResolution(); ok {
 		_spec.SetField(live.FieldVodResolution, field.TypeString, value)
 	}
@@ -589,6 +757,9 @@ func (_u *LiveUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if value, ok := _u.mutation.RenderChat(); ok {
 		_spec.SetField(live.FieldRenderChat, field.TypeBool, value)
 	}
+	if value, ok := _u.mutation.GenerateCaptions(); ok {
+		_spec.SetField(live.FieldGenerateCaptions, field.TypeBool, value)
+	}
 	if value, ok := _u.mutation.VideoAge(); ok {
 		_spec.SetField(live.FieldVideoAge, field.TypeInt64, value)
 	}
@@ -756,6 +927,64 @@ func (_u *LiveUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.ScheduleCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   live.ScheduleTable,
+			Columns: []string{live.ScheduleColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(liveschedule.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ScheduleIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   live.ScheduleTable,
+			Columns: []string{live.ScheduleColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(liveschedule.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.TranscodingProfileCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   live.TranscodingProfileTable,
+			Columns: []string{live.TranscodingProfileColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(transcodingprofile.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.TranscodingProfileIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   live.TranscodingProfileTable,
+			Columns: []string{live.TranscodingProfileColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(transcodingprofile.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{live.Label}
@@ -908,6 +1137,73 @@ func (_u *LiveUpdateOne) ClearResolution() *LiveUpdateOne {
 	return _u
 }
 
+// SetQualityFallbacks sets the "quality_fallbacks" field.
+func (_u *LiveUpdateOne) SetQualityFallbacks(v []string) *LiveUpdateOne {
+	_u.mutation.SetQualityFallbacks(v)
+	return _u
+}
+
+// AppendQualityFallbacks appends value to the "quality_fallbacks" field.
+func (_u *LiveUpdateOne) AppendQualityFallbacks(v []string) *LiveUpdateOne {
+	_u.mutation.AppendQualityFallbacks(v)
+	return _u
+}
+
+// ClearQualityFallbacks clears the value of the "quality_fallbacks" field.
+func (_u *LiveUpdateOne) ClearQualityFallbacks() *LiveUpdateOne {
+	_u.mutation.ClearQualityFallbacks()
+	return _u
+}
+
+// SetRecordAudioFallback sets the "record_audio_fallback" field.
+func (_u *LiveUpdateOne) SetRecordAudioFallback(v bool) *LiveUpdateOne {
+	_u.mutation.SetRecordAudioFallback(v)
+	return _u
+}
+
+// SetNillableRecordAudioFallback sets the "record_audio_fallback" field if the given value is not nil.
+func (_u *LiveUpdateOne) SetNillableRecordAudioFallback(v *bool) *LiveUpdateOne {
+	if v != nil {
+		_u.SetRecordAudioFallback(*v)
+	}
+	return _u
+}
+
+// SetSplitHours sets the "split_hours" field.
+func (_u *LiveUpdateOne) SetSplitHours(v int) *LiveUpdateOne {
+	_u.mutation.ResetSplitHours()
+	_u.mutation.SetSplitHours(v)
+	return _u
+}
+
+// SetNillableSplitHours sets the "split_hours" field if the given value is not nil.
+func (_u *LiveUpdateOne) SetNillableSplitHours(v *int) *LiveUpdateOne {
+	if v != nil {
+		_u.SetSplitHours(*v)
+	}
+	return _u
+}
+
+// AddSplitHours adds value to the "split_hours" field.
+func (_u *LiveUpdateOne) AddSplitHours(v int) *LiveUpdateOne {
+	_u.mutation.AddSplitHours(v)
+	return _u
+}
+
+// SetSplitOnCategoryChange sets the "split_on_category_change" field.
+func (_u *LiveUpdateOne) SetSplitOnCategoryChange(v bool) *LiveUpdateOne {
+	_u.mutation.SetSplitOnCategoryChange(v)
+	return _u
+}
+
+// SetNillableSplitOnCategoryChange sets the "split_on_category_change" field if the given value is not nil.
+func (_u *LiveUpdateOne) SetNillableSplitOnCategoryChange(v *bool) *LiveUpdateOne {
+	if v != nil {
+		_u.SetSplitOnCategoryChange(*v)
+	}
+	return _u
+}
+
 // SetVodResolution sets the "vod_resolution" field.
 func (_u *LiveUpdateOne) SetVodResolution(v string) *LiveUpdateOne {
 	_u.mutation.SetVodResolution(v)
@@ -956,6 +1252,20 @@ func (_u *LiveUpdateOne) SetNillableRenderChat(v *bool) *LiveUpdateOne {
 	return _u
 }
 
+// SetGenerateCaptions sets the "generate_captions" field.
+func (_u *LiveUpdateOne) SetGenerateCaptions(v bool) *LiveUpdateOne {
+	_u.mutation.SetGenerateCaptions(v)
+	return _u
+}
+
+// SetNillableGenerateCaptions sets the "generate_captions" field if the given value is not nil.
+func (_u *LiveUpdateOne) SetNillableGenerateCaptions(v *bool) *LiveUpdateOne {
+	if v != nil {
+		_u.SetGenerateCaptions(*v)
+	}
+	return _u
+}
+
 // SetVideoAge sets the "video_age" field.
 func (_u *LiveUpdateOne) SetVideoAge(v int64) *LiveUpdateOne {
 	_u.mutation.ResetVideoAge()
@@ -1130,6 +1440,26 @@ func (_u *LiveUpdateOne) AddUpdateMetadataMinutes(v int) *LiveUpdateOne {
 	return _u
 }
 
+// SetTranscodingProfileID sets the "transcoding_profile_id" field.
+func (_u *LiveUpdateOne) SetTranscodingProfileID(v uuid.UUID) *LiveUpdateOne {
+	_u.mutation.SetTranscodingProfileID(v)
+	return _u
+}
+
+// SetNillableTranscodingProfileID sets the "transcoding_profile_id" field if the given value is not nil.
+func (_u *LiveUpdateOne) SetNillableTranscodingProfileID(v *uuid.UUID) *LiveUpdateOne {
+	if v != nil {
+		_u.SetTranscodingProfileID(*v)
+	}
+	return _u
+}
+
+// ClearTranscodingProfileID clears the value of the "transcoding_profile_id" field.
+func (_u *LiveUpdateOne) ClearTranscodingProfileID() *LiveUpdateOne {
+	_u.mutation.ClearTranscodingProfileID()
+	return _u
+}
+
 // SetUpdatedAt sets the "updated_at" field.
 func (_u *LiveUpdateOne) SetUpdatedAt(v time.Time) *LiveUpdateOne {
 	_u.mutation.SetUpdatedAt(v)
@@ -1177,6 +1507,30 @@ func (_u *LiveUpdateOne) AddTitleRegex(v ...*LiveTitleRegex) *LiveUpdateOne {
 	return _u.AddTitleRegexIDs(ids...)
 }
 
+// SetScheduleID sets the "schedule" edge to the LiveSchedule entity by ID.
+func (_u *LiveUpdateOne) SetScheduleID(id uuid.UUID) *LiveUpdateOne {
+	_u.mutation.SetScheduleID(id)
+	return _u
+}
+
+// SetNillableScheduleID sets the "schedule" edge to the LiveSchedule entity by ID if the given value is not nil.
+func (_u *LiveUpdateOne) SetNillableScheduleID(id *uuid.UUID) *LiveUpdateOne {
+	if id != nil {
+		_u = _u.SetScheduleID(*id)
+	}
+	return _u
+}
+
+// SetSchedule sets the "schedule" edge to the LiveSchedule entity.
+func (_u *LiveUpdateOne) SetSchedule(v *LiveSchedule) *LiveUpdateOne {
+	return _u.SetScheduleID(v.ID)
+}
+
+// SetTranscodingProfile sets the "transcoding_profile" edge to the TranscodingProfile entity.
+func (_u *LiveUpdateOne) SetTranscodingProfile(v *TranscodingProfile) *LiveUpdateOne {
+	return _u.SetTranscodingProfileID(v.ID)
+}
+
 // Mutation returns the LiveMutation object of the builder.
 func (_u *LiveUpdateOne) Mutation() *LiveMutation {
 	return _u.mutation
@@ -1230,6 +1584,18 @@ func (_u *LiveUpdateOne) RemoveTitleRegex(v ...*LiveTitleRegex) *LiveUpdateOne {
 	return _u.RemoveTitleRegexIDs(ids...)
 }
 
+// ClearSchedule clears the "schedule" edge to the LiveSchedule entity.
+func (_u *LiveUpdateOne) ClearSchedule() *LiveUpdateOne {
+	_u.mutation.ClearSchedule()
+	return _u
+}
+
+// ClearTranscodingProfile clears the "transcoding_profile" edge to the TranscodingProfile entity.
+func (_u *LiveUpdateOne) ClearTranscodingProfile() *LiveUpdateOne {
+	_u.mutation.ClearTranscodingProfile()
+	return _u
+}
+
 // Where appends a list predicates to the LiveUpdate builder.
 func (_u *LiveUpdateOne) Where(ps ...predicate.Live) *LiveUpdateOne {
 	_u.mutation.Where(ps...)
@@ -1281,6 +1647,11 @@ func (_u *LiveUpdateOne) defaults() {
 
 // check runs all checks and user-defined validators on the builder.
 func (_u *LiveUpdateOne) check() error {
+	if v, ok := _u.mutation.SplitHours(); ok {
+		if err := live.SplitHoursValidator(v); err != nil {
+			return &ValidationError{Name: "split_hours", err: fmt.Errorf(`ent: validator failed for field "Live.split_hours": %w`, err)}
+		}
+	}
 	if v, ok := _u.mutation.UpdateMetadataMinutes(); ok {
 		if err := live.UpdateMetadataMinutesValidator(v); err != nil {
 			return &ValidationError{Name: "update_metadata_minutes", err: fmt.Errorf(`ent: validator failed for field "Live.update_metadata_minutes": %w`, err)}
@@ -1351,6 +1722,29 @@ func (_u *LiveUpdateOne) sqlSave(ctx context.Context) (_node *Live, err error) {
 	if _u.mutation.ResolutionCleared() {
 		_spec.ClearField(live.FieldResolution, field.TypeString)
 	}
+	if value, ok := _u.mutation.QualityFallbacks(); ok {
+		_spec.SetField(live.FieldQualityFallbacks, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedQualityFallbacks(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, live.FieldQualityFallbacks, value)
+		})
+	}
+	if _u.mutation.QualityFallbacksCleared() {
+		_spec.ClearField(live.FieldQualityFallbacks, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.RecordAudioFallback(); ok {
+		_spec.SetField(live.FieldRecordAudioFallback, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.SplitHours(); ok {
+		_spec.SetField(live.FieldSplitHours, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedSplitHours(); ok {
+		_spec.AddField(live.FieldSplitHours, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.SplitOnCategoryChange(); ok {
+		_spec.SetField(live.FieldSplitOnCategoryChange, field.TypeBool, value)
+	}
 	if value, ok := _u.mutation.VodResolution(); ok {
 		_spec.SetField(live.FieldVodResolution, field.TypeString, value)
 	}
@@ -1363,6 +1757,9 @@ func (_u *LiveUpdateOne) sqlSave(ctx context.Context) (_node *Live, err error) {
 	if value, ok := _u.mutation.RenderChat(); ok {
 		_spec.SetField(live.FieldRenderChat, field.TypeBool, value)
 	}
+	if value, ok := _u.mutation.GenerateCaptions(); ok {
+		_spec.SetField(live.FieldGenerateCaptions, field.TypeBool, value)
+	}
 	if value, ok := _u.mutation.VideoAge(); ok {
 		_spec.SetField(live.FieldVideoAge, field.TypeInt64, value)
 	}
@@ -1530,6 +1927,64 @@ func (_u *LiveUpdateOne) sqlSave(ctx context.Context) (_node *Live, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.ScheduleCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   live.ScheduleTable,
+			Columns: []string{live.ScheduleColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(liveschedule.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ScheduleIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   live.ScheduleTable,
+			Columns: []string{live.ScheduleColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(liveschedule.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.TranscodingProfileCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   live.TranscodingProfileTable,
+			Columns: []string{live.TranscodingProfileColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(transcodingprofile.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.TranscodingProfileIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   live.TranscodingProfileTable,
+			Columns: []string{live.TranscodingProfileColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(transcodingprofile.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_node = &Live{config: _u.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
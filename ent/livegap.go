@@ -0,0 +1,194 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/zibbp/ganymede/ent/livegap"
+	"github.com/zibbp/ganymede/ent/vod"
+	"github.com/zibbp/ganymede/internal/utils"
+)
+
+// LiveGap is the model entity for the LiveGap schema.
+type LiveGap struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// The ID of the live stream archive.
+	VodID uuid.UUID `json:"vod_id,omitempty"`
+	// Why the recording dropped.
+	Reason utils.LiveGapReason `json:"reason,omitempty"`
+	// The wall-clock time the recording dropped.
+	StartedAt time.Time `json:"started_at,omitempty"`
+	// The wall-clock time the recording resumed.
+	EndedAt time.Time `json:"ended_at,omitempty"`
+	// The position of the gap in the archive in seconds. The archive doesn't include the gap.
+	Offset float64 `json:"offset,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the LiveGapQuery when eager-loading is set.
+	Edges        LiveGapEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// LiveGapEdges holds the relations/edges for other nodes in the graph.
+type LiveGapEdges struct {
+	// Vod holds the value of the vod edge.
+	Vod *Vod `json:"vod,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// VodOrErr returns the Vod value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e LiveGapEdges) VodOrErr() (*Vod, error) {
+	if e.Vod != nil {
+		return e.Vod, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: vod.Label}
+	}
+	return nil, &NotLoadedError{edge: "vod"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*LiveGap) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case livegap.FieldOffset:
+			values[i] = new(sql.NullFloat64)
+		case livegap.FieldReason:
+			values[i] = new(sql.NullString)
+		case livegap.FieldStartedAt, livegap.FieldEndedAt, livegap.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		case livegap.FieldID, livegap.FieldVodID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the LiveGap fields.
+func (_m *LiveGap) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case livegap.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case livegap.FieldVodID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field vod_id", values[i])
+			} else if value != nil {
+				_m.VodID = *value
+			}
+		case livegap.FieldReason:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field reason", values[i])
+			} else if value.Valid {
+				_m.Reason = utils.LiveGapReason(value.String)
+			}
+		case livegap.FieldStartedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field started_at", values[i])
+			} else if value.Valid {
+				_m.StartedAt = value.Time
+			}
+		case livegap.FieldEndedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field ended_at", values[i])
+			} else if value.Valid {
+				_m.EndedAt = value.Time
+			}
+		case livegap.FieldOffset:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field offset", values[i])
+			} else if value.Valid {
+				_m.Offset = value.Float64
+			}
+		case livegap.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the LiveGap.
+// This includes values selected through modifiers, order, etc.
+func (_m *LiveGap) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryVod queries the "vod" edge of the LiveGap entity.
+func (_m *LiveGap) QueryVod() *VodQuery {
+	return NewLiveGapClient(_m.config).QueryVod(_m)
+}
+
+// Update returns a builder for updating this LiveGap.
+// Note that you need to call LiveGap.Unwrap() before calling this method if this LiveGap
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *LiveGap) Update() *LiveGapUpdateOne {
+	return NewLiveGapClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the LiveGap entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *LiveGap) Unwrap() *LiveGap {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: LiveGap is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *LiveGap) String() string {
+	var builder strings.Builder
+	builder.WriteString("LiveGap(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("vod_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.VodID))
+	builder.WriteString(", ")
+	builder.WriteString("reason=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Reason))
+	builder.WriteString(", ")
+	builder.WriteString("started_at=")
+	builder.WriteString(_m.StartedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("ended_at=")
+	builder.WriteString(_m.EndedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("offset=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Offset))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// LiveGaps is a parsable slice of LiveGap.
+type LiveGaps []*LiveGap
@@ -0,0 +1,331 @@
+// Code generated by ent, DO NOT EDIT.
+
+package livegap
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+	"github.com/zibbp/ganymede/ent/predicate"
+	"github.com/zibbp/ganymede/internal/utils"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.LiveGap {
+	return predicate.LiveGap(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.LiveGap {
+	return predicate.LiveGap(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.LiveGap {
+	return predicate.LiveGap(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.LiveGap {
+	return predicate.LiveGap(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.LiveGap {
+	return predicate.LiveGap(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.LiveGap {
+	return predicate.LiveGap(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.LiveGap {
+	return predicate.LiveGap(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.LiveGap {
+	return predicate.LiveGap(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.LiveGap {
+	return predicate.LiveGap(sql.FieldLTE(FieldID, id))
+}
+
+// VodID applies equality check predicate on the "vod_id" field. It's identical to VodIDEQ.
+func VodID(v uuid.UUID) predicate.LiveGap {
+	return predicate.LiveGap(sql.FieldEQ(FieldVodID, v))
+}
+
+// StartedAt applies equality check predicate on the "started_at" field. It's identical to StartedAtEQ.
+func StartedAt(v time.Time) predicate.LiveGap {
+	return predicate.LiveGap(sql.FieldEQ(FieldStartedAt, v))
+}
+
+// EndedAt applies equality check predicate on the "ended_at" field. It's identical to EndedAtEQ.
+func EndedAt(v time.Time) predicate.LiveGap {
+	return predicate.LiveGap(sql.FieldEQ(FieldEndedAt, v))
+}
+
+// Offset applies equality check predicate on the "offset" field. It's identical to OffsetEQ.
+func Offset(v float64) predicate.LiveGap {
+	return predicate.LiveGap(sql.FieldEQ(FieldOffset, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.LiveGap {
+	return predicate.LiveGap(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// VodIDEQ applies the EQ predicate on the "vod_id" field.
+func VodIDEQ(v uuid.UUID) predicate.LiveGap {
+	return predicate.LiveGap(sql.FieldEQ(FieldVodID, v))
+}
+
+// VodIDNEQ applies the NEQ predicate on the "vod_id" field.
+func VodIDNEQ(v uuid.UUID) predicate.LiveGap {
+	return predicate.LiveGap(sql.FieldNEQ(FieldVodID, v))
+}
+
+// VodIDIn applies the In predicate on the "vod_id" field.
+func VodIDIn(vs ...uuid.UUID) predicate.LiveGap {
+	return predicate.LiveGap(sql.FieldIn(FieldVodID, vs...))
+}
+
+// VodIDNotIn applies the NotIn predicate on the "vod_id" field.
+func VodIDNotIn(vs ...uuid.UUID) predicate.LiveGap {
+	return predicate.LiveGap(sql.FieldNotIn(FieldVodID, vs...))
+}
+
+// ReasonEQ applies the EQ predicate on the "reason" field.
+func ReasonEQ(v utils.LiveGapReason) predicate.LiveGap {
+	vc := v
+	return predicate.LiveGap(sql.FieldEQ(FieldReason, vc))
+}
+
+// ReasonNEQ applies the NEQ predicate on the "reason" field.
+func ReasonNEQ(v utils.LiveGapReason) predicate.LiveGap {
+	vc := v
+	return predicate.LiveGap(sql.FieldNEQ(FieldReason, vc))
+}
+
+// ReasonIn applies the In predicate on the "reason" field.
+func ReasonIn(vs ...utils.LiveGapReason) predicate.LiveGap {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.LiveGap(sql.FieldIn(FieldReason, v...))
+}
+
+// ReasonNotIn applies the NotIn predicate on the "reason" field.
+func ReasonNotIn(vs ...utils.LiveGapReason) predicate.LiveGap {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.LiveGap(sql.FieldNotIn(FieldReason, v...))
+}
+
+// StartedAtEQ applies the EQ predicate on the "started_at" field.
+func StartedAtEQ(v time.Time) predicate.LiveGap {
+	return predicate.LiveGap(sql.FieldEQ(FieldStartedAt, v))
+}
+
+// StartedAtNEQ applies the NEQ predicate on the "started_at" field.
+func StartedAtNEQ(v time.Time) predicate.LiveGap {
+	return predicate.LiveGap(sql.FieldNEQ(FieldStartedAt, v))
+}
+
+// StartedAtIn applies the In predicate on the "started_at" field.
+func StartedAtIn(vs ...time.Time) predicate.LiveGap {
+	return predicate.LiveGap(sql.FieldIn(FieldStartedAt, vs...))
+}
+
+// StartedAtNotIn applies the NotIn predicate on the "started_at" field.
+func StartedAtNotIn(vs ...time.Time) predicate.LiveGap {
+	return predicate.LiveGap(sql.FieldNotIn(FieldStartedAt, vs...))
+}
+
+// StartedAtGT applies the GT predicate on the "started_at" field.
+func StartedAtGT(v time.Time) predicate.LiveGap {
+	return predicate.LiveGap(sql.FieldGT(FieldStartedAt, v))
+}
+
+// StartedAtGTE applies the GTE predicate on the "started_at" field.
+func StartedAtGTE(v time.Time) predicate.LiveGap {
+	return predicate.LiveGap(sql.FieldGTE(FieldStartedAt, v))
+}
+
+// StartedAtLT applies the LT predicate on the "started_at" field.
+func StartedAtLT(v time.Time) predicate.LiveGap {
+	return predicate.LiveGap(sql.FieldLT(FieldStartedAt, v))
+}
+
+// StartedAtLTE applies the LTE predicate on the "started_at" field.
+func StartedAtLTE(v time.Time) predicate.LiveGap {
+	return predicate.LiveGap(sql.FieldLTE(FieldStartedAt, v))
+}
+
+// EndedAtEQ applies the EQ predicate on the "ended_at" field.
+func EndedAtEQ(v time.Time) predicate.LiveGap {
+	return predicate.LiveGap(sql.FieldEQ(FieldEndedAt, v))
+}
+
+// EndedAtNEQ applies the NEQ predicate on the "ended_at" field.
+func EndedAtNEQ(v time.Time) predicate.LiveGap {
+	return predicate.LiveGap(sql.FieldNEQ(FieldEndedAt, v))
+}
+
+// EndedAtIn applies the In predicate on the "ended_at" field.
+func EndedAtIn(vs ...time.Time) predicate.LiveGap {
+	return predicate.LiveGap(sql.FieldIn(FieldEndedAt, vs...))
+}
+
+// EndedAtNotIn applies the NotIn predicate on the "ended_at" field.
+func EndedAtNotIn(vs ...time.Time) predicate.LiveGap {
+	return predicate.LiveGap(sql.FieldNotIn(FieldEndedAt, vs...))
+}
+
+// EndedAtGT applies the GT predicate on the "ended_at" field.
+func EndedAtGT(v time.Time) predicate.LiveGap {
+	return predicate.LiveGap(sql.FieldGT(FieldEndedAt, v))
+}
+
+// EndedAtGTE applies the GTE predicate on the "ended_at" field.
+func EndedAtGTE(v time.Time) predicate.LiveGap {
+	return predicate.LiveGap(sql.FieldGTE(FieldEndedAt, v))
+}
+
+// EndedAtLT applies the LT predicate on the "ended_at" field.
+func EndedAtLT(v time.Time) predicate.LiveGap {
+	return predicate.LiveGap(sql.FieldLT(FieldEndedAt, v))
+}
+
+// EndedAtLTE applies the LTE predicate on the "ended_at" field.
+func EndedAtLTE(v time.Time) predicate.LiveGap {
+	return predicate.LiveGap(sql.FieldLTE(FieldEndedAt, v))
+}
+
+// OffsetEQ applies the EQ predicate on the "offset" field.
+func OffsetEQ(v float64) predicate.LiveGap {
+	return predicate.LiveGap(sql.FieldEQ(FieldOffset, v))
+}
+
+// OffsetNEQ applies the NEQ predicate on the "offset" field.
+func OffsetNEQ(v float64) predicate.LiveGap {
+	return predicate.LiveGap(sql.FieldNEQ(FieldOffset, v))
+}
+
+// OffsetIn applies the In predicate on the "offset" field.
+func OffsetIn(vs ...float64) predicate.LiveGap {
+	return predicate.LiveGap(sql.FieldIn(FieldOffset, vs...))
+}
+
+// OffsetNotIn applies the NotIn predicate on the "offset" field.
+func OffsetNotIn(vs ...float64) predicate.LiveGap {
+	return predicate.LiveGap(sql.FieldNotIn(FieldOffset, vs...))
+}
+
+// OffsetGT applies the GT predicate on the "offset" field.
+func OffsetGT(v float64) predicate.LiveGap {
+	return predicate.LiveGap(sql.FieldGT(FieldOffset, v))
+}
+
+// OffsetGTE applies the GTE predicate on the "offset" field.
+func OffsetGTE(v float64) predicate.LiveGap {
+	return predicate.LiveGap(sql.FieldGTE(FieldOffset, v))
+}
+
+// OffsetLT applies the LT predicate on the "offset" field.
+func OffsetLT(v float64) predicate.LiveGap {
+	return predicate.LiveGap(sql.FieldLT(FieldOffset, v))
+}
+
+// OffsetLTE applies the LTE predicate on the "offset" field.
+func OffsetLTE(v float64) predicate.LiveGap {
+	return predicate.LiveGap(sql.FieldLTE(FieldOffset, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.LiveGap {
+	return predicate.LiveGap(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.LiveGap {
+	return predicate.LiveGap(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.LiveGap {
+	return predicate.LiveGap(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.LiveGap {
+	return predicate.LiveGap(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.LiveGap {
+	return predicate.LiveGap(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.LiveGap {
+	return predicate.LiveGap(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.LiveGap {
+	return predicate.LiveGap(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.LiveGap {
+	return predicate.LiveGap(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// HasVod applies the HasEdge predicate on the "vod" edge.
+func HasVod() predicate.LiveGap {
+	return predicate.LiveGap(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, VodTable, VodColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasVodWith applies the HasEdge predicate on the "vod" edge with a given conditions (other predicates).
+func HasVodWith(preds ...predicate.Vod) predicate.LiveGap {
+	return predicate.LiveGap(func(s *sql.Selector) {
+		step := newVodStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.LiveGap) predicate.LiveGap {
+	return predicate.LiveGap(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.LiveGap) predicate.LiveGap {
+	return predicate.LiveGap(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.LiveGap) predicate.LiveGap {
+	return predicate.LiveGap(sql.NotPredicates(p))
+}
@@ -0,0 +1,800 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/zibbp/ganymede/ent/livegap"
+	"github.com/zibbp/ganymede/ent/vod"
+	"github.com/zibbp/ganymede/internal/utils"
+)
+
+// LiveGapCreate is the builder for creating a LiveGap entity.
+type LiveGapCreate struct {
+	config
+	mutation *LiveGapMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetVodID sets the "vod_id" field.
+func (_c *LiveGapCreate) SetVodID(v uuid.UUID) *LiveGapCreate {
+	_c.mutation.SetVodID(v)
+	return _c
+}
+
+// SetReason sets the "reason" field.
+func (_c *LiveGapCreate) SetReason(v utils.LiveGapReason) *LiveGapCreate {
+	_c.mutation.SetReason(v)
+	return _c
+}
+
+// SetStartedAt sets the "started_at" field.
+func (_c *LiveGapCreate) SetStartedAt(v time.Time) *LiveGapCreate {
+	_c.mutation.SetStartedAt(v)
+	return _c
+}
+
+// SetEndedAt sets the "ended_at" field.
+func (_c *LiveGapCreate) SetEndedAt(v time.Time) *LiveGapCreate {
+	_c.mutation.SetEndedAt(v)
+	return _c
+}
+
+// SetOffset sets the "offset" field.
+func (_c *LiveGapCreate) SetOffset(v float64) *LiveGapCreate {
+	_c.mutation.SetOffset(v)
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *LiveGapCreate) SetCreatedAt(v time.Time) *LiveGapCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *LiveGapCreate) SetNillableCreatedAt(v *time.Time) *LiveGapCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *LiveGapCreate) SetID(v uuid.UUID) *LiveGapCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *LiveGapCreate) SetNillableID(v *uuid.UUID) *LiveGapCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// SetVod sets the "vod" edge to the Vod entity.
+func (_c *LiveGapCreate) SetVod(v *Vod) *LiveGapCreate {
+	return _c.SetVodID(v.ID)
+}
+
+// Mutation returns the LiveGapMutation object of the builder.
+func (_c *LiveGapCreate) Mutation() *LiveGapMutation {
+	return _c.mutation
+}
+
+// Save creates the LiveGap in the database.
+func (_c *LiveGapCreate) Save(ctx context.Context) (*LiveGap, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *LiveGapCreate) SaveX(ctx context.Context) *LiveGap {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *LiveGapCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *LiveGapCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *LiveGapCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := livegap.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := livegap.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *LiveGapCreate) check() error {
+	if _, ok := _c.mutation.VodID(); !ok {
+		return &ValidationError{Name: "vod_id", err: errors.New(`ent: missing required field "LiveGap.vod_id"`)}
+	}
+	if _, ok := _c.mutation.Reason(); !ok {
+		return &ValidationError{Name: "reason", err: errors.New(`ent: missing required field "LiveGap.reason"`)}
+	}
+	if v, ok := _c.mutation.Reason(); ok {
+		if err := livegap.ReasonValidator(v); err != nil {
+			return &ValidationError{Name: "reason", err: fmt.Errorf(`ent: validator failed for field "LiveGap.reason": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.StartedAt(); !ok {
+		return &ValidationError{Name: "started_at", err: errors.New(`ent: missing required field "LiveGap.started_at"`)}
+	}
+	if _, ok := _c.mutation.EndedAt(); !ok {
+		return &ValidationError{Name: "ended_at", err: errors.New(`ent: missing required field "LiveGap.ended_at"`)}
+	}
+	if _, ok := _c.mutation.Offset(); !ok {
+		return &ValidationError{Name: "offset", err: errors.New(`ent: missing required field "LiveGap.offset"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "LiveGap.created_at"`)}
+	}
+	if len(_c.mutation.VodIDs()) == 0 {
+		return &ValidationError{Name: "vod", err: errors.New(`ent: missing required edge "LiveGap.vod"`)}
+	}
+	return nil
+}
+
+func (_c *LiveGapCreate) sqlSave(ctx context.Context) (*LiveGap, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *LiveGapCreate) createSpec() (*LiveGap, *sqlgraph.CreateSpec) {
+	var (
+		_node = &LiveGap{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(livegap.Table, sqlgraph.NewFieldSpec(livegap.FieldID, field.TypeUUID))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.Reason(); ok {
+		_spec.SetField(livegap.FieldReason, field.TypeEnum, value)
+		_node.Reason = value
+	}
+	if value, ok := _c.mutation.StartedAt(); ok {
+		_spec.SetField(livegap.FieldStartedAt, field.TypeTime, value)
+		_node.StartedAt = value
+	}
+	if value, ok := _c.mutation.EndedAt(); ok {
+		_spec.SetField(livegap.FieldEndedAt, field.TypeTime, value)
+		_node.EndedAt = value
+	}
+	if value, ok := _c.mutation.Offset(); ok {
+		_spec.SetField(livegap.FieldOffset, field.TypeFloat64, value)
+		_node.Offset = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(livegap.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := _c.mutation.VodIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   livegap.VodTable,
+			Columns: []string{livegap.VodColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(vod.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.VodID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.LiveGap.Create().
+//		SetVodID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.LiveGapUpsert) {
+//			SetVodID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *LiveGapCreate) OnConflict(opts ...sql.ConflictOption) *LiveGapUpsertOne {
+	_c.conflict = opts
+	return &LiveGapUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.LiveGap.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *LiveGapCreate) OnConflictColumns(columns ...string) *LiveGapUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &LiveGapUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// LiveGapUpsertOne is the builder for "upsert"-ing
+	//  one LiveGap node.
+	LiveGapUpsertOne struct {
+		create *LiveGapCreate
+	}
+
+	// LiveGapUpsert is the "OnConflict" setter.
+	LiveGapUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetVodID sets the "vod_id" field.
+func (u *LiveGapUpsert) SetVodID(v uuid.UUID) *LiveGapUpsert {
+	u.Set(livegap.FieldVodID, v)
+	return u
+}
+
+// UpdateVodID sets the "vod_id" field to the value that was provided on create.
+func (u *LiveGapUpsert) UpdateVodID() *LiveGapUpsert {
+	u.SetExcluded(livegap.FieldVodID)
+	return u
+}
+
+// SetReason sets the "reason" field.
+func (u *LiveGapUpsert) SetReason(v utils.LiveGapReason) *LiveGapUpsert {
+	u.Set(livegap.FieldReason, v)
+	return u
+}
+
+// UpdateReason sets the "reason" field to the value that was provided on create.
+func (u *LiveGapUpsert) UpdateReason() *LiveGapUpsert {
+	u.SetExcluded(livegap.FieldReason)
+	return u
+}
+
+// SetStartedAt sets the "started_at" field.
+func (u *LiveGapUpsert) SetStartedAt(v time.Time) *LiveGapUpsert {
+	u.Set(livegap.FieldStartedAt, v)
+	return u
+}
+
+// UpdateStartedAt sets the "started_at" field to the value that was provided on create.
+func (u *LiveGapUpsert) UpdateStartedAt() *LiveGapUpsert {
+	u.SetExcluded(livegap.FieldStartedAt)
+	return u
+}
+
+// SetEndedAt sets the "ended_at" field.
+func (u *LiveGapUpsert) SetEndedAt(v time.Time) *LiveGapUpsert {
+	u.Set(livegap.FieldEndedAt, v)
+	return u
+}
+
+// UpdateEndedAt sets the "ended_at" field to the value that was provided on create.
+func (u *LiveGapUpsert) UpdateEndedAt() *LiveGapUpsert {
+	u.SetExcluded(livegap.FieldEndedAt)
+	return u
+}
+
+// SetOffset sets the "offset" field.
+func (u *LiveGapUpsert) SetOffset(v float64) *LiveGapUpsert {
+	u.Set(livegap.FieldOffset, v)
+	return u
+}
+
+// UpdateOffset sets the "offset" field to the value that was provided on create.
+func (u *LiveGapUpsert) UpdateOffset() *LiveGapUpsert {
+	u.SetExcluded(livegap.FieldOffset)
+	return u
+}
+
+// AddOffset adds v to the "offset" field.
+func (u *LiveGapUpsert) AddOffset(v float64) *LiveGapUpsert {
+	u.Add(livegap.FieldOffset, v)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.LiveGap.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(livegap.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *LiveGapUpsertOne) UpdateNewValues() *LiveGapUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(livegap.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(livegap.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.LiveGap.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *LiveGapUpsertOne) Ignore() *LiveGapUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *LiveGapUpsertOne) DoNothing() *LiveGapUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the LiveGapCreate.OnConflict
+// documentation for more info.
+func (u *LiveGapUpsertOne) Update(set func(*LiveGapUpsert)) *LiveGapUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&LiveGapUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetVodID sets the "vod_id" field.
+func (u *LiveGapUpsertOne) SetVodID(v uuid.UUID) *LiveGapUpsertOne {
+	return u.Update(func(s *LiveGapUpsert) {
+		s.SetVodID(v)
+	})
+}
+
+// UpdateVodID sets the "vod_id" field to the value that was provided on create.
+func (u *LiveGapUpsertOne) UpdateVodID() *LiveGapUpsertOne {
+	return u.Update(func(s *LiveGapUpsert) {
+		s.UpdateVodID()
+	})
+}
+
+// SetReason sets the "reason" field.
+func (u *LiveGapUpsertOne) SetReason(v utils.LiveGapReason) *LiveGapUpsertOne {
+	return u.Update(func(s *LiveGapUpsert) {
+		s.SetReason(v)
+	})
+}
+
+// UpdateReason sets the "reason" field to the value that was provided on create.
+func (u *LiveGapUpsertOne) UpdateReason() *LiveGapUpsertOne {
+	return u.Update(func(s *LiveGapUpsert) {
+		s.UpdateReason()
+	})
+}
+
+// SetStartedAt sets the "started_at" field.
+func (u *LiveGapUpsertOne) SetStartedAt(v time.Time) *LiveGapUpsertOne {
+	return u.Update(func(s *LiveGapUpsert) {
+		s.SetStartedAt(v)
+	})
+}
+
+// UpdateStartedAt sets the "started_at" field to the value that was provided on create.
+func (u *LiveGapUpsertOne) UpdateStartedAt() *LiveGapUpsertOne {
+	return u.Update(func(s *LiveGapUpsert) {
+		s.UpdateStartedAt()
+	})
+}
+
+// SetEndedAt sets the "ended_at" field.
+func (u *LiveGapUpsertOne) SetEndedAt(v time.Time) *LiveGapUpsertOne {
+	return u.Update(func(s *LiveGapUpsert) {
+		s.SetEndedAt(v)
+	})
+}
+
+// UpdateEndedAt sets the "ended_at" field to the value that was provided on create.
+func (u *LiveGapUpsertOne) UpdateEndedAt() *LiveGapUpsertOne {
+	return u.Update(func(s *LiveGapUpsert) {
+		s.UpdateEndedAt()
+	})
+}
+
+// SetOffset sets the "offset" field.
+func (u *LiveGapUpsertOne) SetOffset(v float64) *LiveGapUpsertOne {
+	return u.Update(func(s *LiveGapUpsert) {
+		s.SetOffset(v)
+	})
+}
+
+// AddOffset adds v to the "offset" field.
+func (u *LiveGapUpsertOne) AddOffset(v float64) *LiveGapUpsertOne {
+	return u.Update(func(s *LiveGapUpsert) {
+		s.AddOffset(v)
+	})
+}
+
+// UpdateOffset sets the "offset" field to the value that was provided on create.
+func (u *LiveGapUpsertOne) UpdateOffset() *LiveGapUpsertOne {
+	return u.Update(func(s *LiveGapUpsert) {
+		s.UpdateOffset()
+	})
+}
+
+// Exec executes the query.
+func (u *LiveGapUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for LiveGapCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *LiveGapUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *LiveGapUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: LiveGapUpsertOne.ID is not supported by MySQL driver. Use LiveGapUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *LiveGapUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// LiveGapCreateBulk is the builder for creating many LiveGap entities in bulk.
+type LiveGapCreateBulk struct {
+	config
+	err      error
+	builders []*LiveGapCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the LiveGap entities in the database.
+func (_c *LiveGapCreateBulk) Save(ctx context.Context) ([]*LiveGap, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*LiveGap, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*LiveGapMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *LiveGapCreateBulk) SaveX(ctx context.Context) []*LiveGap {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *LiveGapCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *LiveGapCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.LiveGap.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.LiveGapUpsert) {
+//			SetVodID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *LiveGapCreateBulk) OnConflict(opts ...sql.ConflictOption) *LiveGapUpsertBulk {
+	_c.conflict = opts
+	return &LiveGapUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.LiveGap.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *LiveGapCreateBulk) OnConflictColumns(columns ...string) *LiveGapUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &LiveGapUpsertBulk{
+		create: _c,
+	}
+}
+
+// LiveGapUpsertBulk is the builder for "upsert"-ing
+// a bulk of LiveGap nodes.
+type LiveGapUpsertBulk struct {
+	create *LiveGapCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.LiveGap.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(livegap.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *LiveGapUpsertBulk) UpdateNewValues() *LiveGapUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(livegap.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(livegap.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.LiveGap.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *LiveGapUpsertBulk) Ignore() *LiveGapUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *LiveGapUpsertBulk) DoNothing() *LiveGapUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the LiveGapCreateBulk.OnConflict
+// documentation for more info.
+func (u *LiveGapUpsertBulk) Update(set func(*LiveGapUpsert)) *LiveGapUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&LiveGapUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetVodID sets the "vod_id" field.
+func (u *LiveGapUpsertBulk) SetVodID(v uuid.UUID) *LiveGapUpsertBulk {
+	return u.Update(func(s *LiveGapUpsert) {
+		s.SetVodID(v)
+	})
+}
+
+// UpdateVodID sets the "vod_id" field to the value that was provided on create.
+func (u *LiveGapUpsertBulk) UpdateVodID() *LiveGapUpsertBulk {
+	return u.Update(func(s *LiveGapUpsert) {
+		s.UpdateVodID()
+	})
+}
+
+// SetReason sets the "reason" field.
+func (u *LiveGapUpsertBulk) SetReason(v utils.LiveGapReason) *LiveGapUpsertBulk {
+	return u.Update(func(s *LiveGapUpsert) {
+		s.SetReason(v)
+	})
+}
+
+// UpdateReason sets the "reason" field to the value that was provided on create.
+func (u *LiveGapUpsertBulk) UpdateReason() *LiveGapUpsertBulk {
+	return u.Update(func(s *LiveGapUpsert) {
+		s.UpdateReason()
+	})
+}
+
+// SetStartedAt sets the "started_at" field.
+func (u *LiveGapUpsertBulk) SetStartedAt(v time.Time) *LiveGapUpsertBulk {
+	return u.Update(func(s *LiveGapUpsert) {
+		s.SetStartedAt(v)
+	})
+}
+
+// UpdateStartedAt sets the "started_at" field to the value that was provided on create.
+func (u *LiveGapUpsertBulk) UpdateStartedAt() *LiveGapUpsertBulk {
+	return u.Update(func(s *LiveGapUpsert) {
+		s.UpdateStartedAt()
+	})
+}
+
+// SetEndedAt sets the "ended_at" field.
+func (u *LiveGapUpsertBulk) SetEndedAt(v time.Time) *LiveGapUpsertBulk {
+	return u.Update(func(s *LiveGapUpsert) {
+		s.SetEndedAt(v)
+	})
+}
+
+// UpdateEndedAt sets the "ended_at" field to the value that was provided on create.
+func (u *LiveGapUpsertBulk) UpdateEndedAt() *LiveGapUpsertBulk {
+	return u.Update(func(s *LiveGapUpsert) {
+		s.UpdateEndedAt()
+	})
+}
+
+// SetOffset sets the "offset" field.
+func (u *LiveGapUpsertBulk) SetOffset(v float64) *LiveGapUpsertBulk {
+	return u.Update(func(s *LiveGapUpsert) {
+		s.SetOffset(v)
+	})
+}
+
+// AddOffset adds v to the "offset" field.
+func (u *LiveGapUpsertBulk) AddOffset(v float64) *LiveGapUpsertBulk {
+	return u.Update(func(s *LiveGapUpsert) {
+		s.AddOffset(v)
+	})
+}
+
+// UpdateOffset sets the "offset" field to the value that was provided on create.
+func (u *LiveGapUpsertBulk) UpdateOffset() *LiveGapUpsertBulk {
+	return u.Update(func(s *LiveGapUpsert) {
+		s.UpdateOffset()
+	})
+}
+
+// Exec executes the query.
+func (u *LiveGapUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the LiveGapCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for LiveGapCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *LiveGapUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,475 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/zibbp/ganymede/ent/livegap"
+	"github.com/zibbp/ganymede/ent/predicate"
+	"github.com/zibbp/ganymede/ent/vod"
+	"github.com/zibbp/ganymede/internal/utils"
+)
+
+// LiveGapUpdate is the builder for updating LiveGap entities.
+type LiveGapUpdate struct {
+	config
+	hooks    []Hook
+	mutation *LiveGapMutation
+}
+
+// Where appends a list predicates to the LiveGapUpdate builder.
+func (_u *LiveGapUpdate) Where(ps ...predicate.LiveGap) *LiveGapUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetVodID sets the "vod_id" field.
+func (_u *LiveGapUpdate) SetVodID(v uuid.UUID) *LiveGapUpdate {
+	_u.mutation.SetVodID(v)
+	return _u
+}
+
+// SetNillableVodID sets the "vod_id" field if the given value is not nil.
+func (_u *LiveGapUpdate) SetNillableVodID(v *uuid.UUID) *LiveGapUpdate {
+	if v != nil {
+		_u.SetVodID(*v)
+	}
+	return _u
+}
+
+// SetReason sets the "reason" field.
+func (_u *LiveGapUpdate) SetReason(v utils.LiveGapReason) *LiveGapUpdate {
+	_u.mutation.SetReason(v)
+	return _u
+}
+
+// SetNillableReason sets the "reason" field if the given value is not nil.
+func (_u *LiveGapUpdate) SetNillableReason(v *utils.LiveGapReason) *LiveGapUpdate {
+	if v != nil {
+		_u.SetReason(*v)
+	}
+	return _u
+}
+
+// SetStartedAt sets the "started_at" field.
+func (_u *LiveGapUpdate) SetStartedAt(v time.Time) *LiveGapUpdate {
+	_u.mutation.SetStartedAt(v)
+	return _u
+}
+
+// SetNillableStartedAt sets the "started_at" field if the given value is not nil.
+func (_u *LiveGapUpdate) SetNillableStartedAt(v *time.Time) *LiveGapUpdate {
+	if v != nil {
+		_u.SetStartedAt(*v)
+	}
+	return _u
+}
+
+// SetEndedAt sets the "ended_at" field.
+func (_u *LiveGapUpdate) SetEndedAt(v time.Time) *LiveGapUpdate {
+	_u.mutation.SetEndedAt(v)
+	return _u
+}
+
+// SetNillableEndedAt sets the "ended_at" field if the given value is not nil.
+func (_u *LiveGapUpdate) SetNillableEndedAt(v *time.Time) *LiveGapUpdate {
+	if v != nil {
+		_u.SetEndedAt(*v)
+	}
+	return _u
+}
+
+// SetOffset sets the "offset" field.
+func (_u *LiveGapUpdate) SetOffset(v float64) *LiveGapUpdate {
+	_u.mutation.ResetOffset()
+	_u.mutation.SetOffset(v)
+	return _u
+}
+
+// SetNillableOffset sets the "offset" field if the given value is not nil.
+func (_u *LiveGapUpdate) SetNillableOffset(v *float64) *LiveGapUpdate {
+	if v != nil {
+		_u.SetOffset(*v)
+	}
+	return _u
+}
+
+// AddOffset adds value to the "offset" field.
+func (_u *LiveGapUpdate) AddOffset(v float64) *LiveGapUpdate {
+	_u.mutation.AddOffset(v)
+	return _u
+}
+
+// SetVod sets the "vod" edge to the Vod entity.
+func (_u *LiveGapUpdate) SetVod(v *Vod) *LiveGapUpdate {
+	return _u.SetVodID(v.ID)
+}
+
+// Mutation returns the LiveGapMutation object of the builder.
+func (_u *LiveGapUpdate) Mutation() *LiveGapMutation {
+	return _u.mutation
+}
+
+// ClearVod clears the "vod" edge to the Vod entity.
+func (_u *LiveGapUpdate) ClearVod() *LiveGapUpdate {
+	_u.mutation.ClearVod()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *LiveGapUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *LiveGapUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *LiveGapUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *LiveGapUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *LiveGapUpdate) check() error {
+	if v, ok := _u.mutation.Reason(); ok {
+		if err := livegap.ReasonValidator(v); err != nil {
+			return &ValidationError{Name: "reason", err: fmt.Errorf(`ent: validator failed for field "LiveGap.reason": %w`, err)}
+		}
+	}
+	if _u.mutation.VodCleared() && len(_u.mutation.VodIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "LiveGap.vod"`)
+	}
+	return nil
+}
+
+func (_u *LiveGapUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(livegap.Table, livegap.Columns, sqlgraph.NewFieldSpec(livegap.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Reason(); ok {
+		_spec.SetField(livegap.FieldReason, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.StartedAt(); ok {
+		_spec.SetField(livegap.FieldStartedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.EndedAt(); ok {
+		_spec.SetField(livegap.FieldEndedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Offset(); ok {
+		_spec.SetField(livegap.FieldOffset, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedOffset(); ok {
+		_spec.AddField(livegap.FieldOffset, field.TypeFloat64, value)
+	}
+	if _u.mutation.VodCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   livegap.VodTable,
+			Columns: []string{livegap.VodColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(vod.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.VodIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   livegap.VodTable,
+			Columns: []string{livegap.VodColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(vod.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{livegap.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// LiveGapUpdateOne is the builder for updating a single LiveGap entity.
+type LiveGapUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *LiveGapMutation
+}
+
+// SetVodID sets the "vod_id" field.
+func (_u *LiveGapUpdateOne) SetVodID(v uuid.UUID) *LiveGapUpdateOne {
+	_u.mutation.SetVodID(v)
+	return _u
+}
+
+// SetNillableVodID sets the "vod_id" field if the given value is not nil.
+func (_u *LiveGapUpdateOne) SetNillableVodID(v *uuid.UUID) *LiveGapUpdateOne {
+	if v != nil {
+		_u.SetVodID(*v)
+	}
+	return _u
+}
+
+// SetReason sets the "reason" field.
+func (_u *LiveGapUpdateOne) SetReason(v utils.LiveGapReason) *LiveGapUpdateOne {
+	_u.mutation.SetReason(v)
+	return _u
+}
+
+// SetNillableReason sets the "reason" field if the given value is not nil.
+func (_u *LiveGapUpdateOne) SetNillableReason(v *utils.LiveGapReason) *LiveGapUpdateOne {
+	if v != nil {
+		_u.SetReason(*v)
+	}
+	return _u
+}
+
+// SetStartedAt sets the "started_at" field.
+func (_u *LiveGapUpdateOne) SetStartedAt(v time.Time) *LiveGapUpdateOne {
+	_u.mutation.SetStartedAt(v)
+	return _u
+}
+
+// SetNillableStartedAt sets the "started_at" field if the given value is not nil.
+func (_u *LiveGapUpdateOne) SetNillableStartedAt(v *time.Time) *LiveGapUpdateOne {
+	if v != nil {
+		_u.SetStartedAt(*v)
+	}
+	return _u
+}
+
+// SetEndedAt sets the "ended_at" field.
+func (_u *LiveGapUpdateOne) SetEndedAt(v time.Time) *LiveGapUpdateOne {
+	_u.mutation.SetEndedAt(v)
+	return _u
+}
+
+// SetNillableEndedAt sets the "ended_at" field if the given value is not nil.
+func (_u *LiveGapUpdateOne) SetNillableEndedAt(v *time.Time) *LiveGapUpdateOne {
+	if v != nil {
+		_u.SetEndedAt(*v)
+	}
+	return _u
+}
+
+// SetOffset sets the "offset" field.
+func (_u *LiveGapUpdateOne) SetOffset(v float64) *LiveGapUpdateOne {
+	_u.mutation.ResetOffset()
+	_u.mutation.SetOffset(v)
+	return _u
+}
+
+// SetNillableOffset sets the "offset" field if the given value is not nil.
+func (_u *LiveGapUpdateOne) SetNillableOffset(v *float64) *LiveGapUpdateOne {
+	if v != nil {
+		_u.SetOffset(*v)
+	}
+	return _u
+}
+
+// AddOffset adds value to the "offset" field.
+func (_u *LiveGapUpdateOne) AddOffset(v float64) *LiveGapUpdateOne {
+	_u.mutation.AddOffset(v)
+	return _u
+}
+
+// SetVod sets the "vod" edge to the Vod entity.
+func (_u *LiveGapUpdateOne) SetVod(v *Vod) *LiveGapUpdateOne {
+	return _u.SetVodID(v.ID)
+}
+
+// Mutation returns the LiveGapMutation object of the builder.
+func (_u *LiveGapUpdateOne) Mutation() *LiveGapMutation {
+	return _u.mutation
+}
+
+// ClearVod clears the "vod" edge to the Vod entity.
+func (_u *LiveGapUpdateOne) ClearVod() *LiveGapUpdateOne {
+	_u.mutation.ClearVod()
+	return _u
+}
+
+// Where appends a list predicates to the LiveGapUpdate builder.
+func (_u *LiveGapUpdateOne) Where(ps ...predicate.LiveGap) *LiveGapUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *LiveGapUpdateOne) Select(field string, fields ...string) *LiveGapUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated LiveGap entity.
+func (_u *LiveGapUpdateOne) Save(ctx context.Context) (*LiveGap, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *LiveGapUpdateOne) SaveX(ctx context.Context) *LiveGap {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *LiveGapUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *LiveGapUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *LiveGapUpdateOne) check() error {
+	if v, ok := _u.mutation.Reason(); ok {
+		if err := livegap.ReasonValidator(v); err != nil {
+			return &ValidationError{Name: "reason", err: fmt.Errorf(`ent: validator failed for field "LiveGap.reason": %w`, err)}
+		}
+	}
+	if _u.mutation.VodCleared() && len(_u.mutation.VodIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "LiveGap.vod"`)
+	}
+	return nil
+}
+
+func (_u *LiveGapUpdateOne) sqlSave(ctx context.Context) (_node *LiveGap, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(livegap.Table, livegap.Columns, sqlgraph.NewFieldSpec(livegap.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "LiveGap.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, livegap.FieldID)
+		for _, f := range fields {
+			if !livegap.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != livegap.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Reason(); ok {
+		_spec.SetField(livegap.FieldReason, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.StartedAt(); ok {
+		_spec.SetField(livegap.FieldStartedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.EndedAt(); ok {
+		_spec.SetField(livegap.FieldEndedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Offset(); ok {
+		_spec.SetField(livegap.FieldOffset, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedOffset(); ok {
+		_spec.AddField(livegap.FieldOffset, field.TypeFloat64, value)
+	}
+	if _u.mutation.VodCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   livegap.VodTable,
+			Columns: []string{livegap.VodColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(vod.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.VodIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   livegap.VodTable,
+			Columns: []string{livegap.VodColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(vod.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &LiveGap{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{livegap.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,212 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/zibbp/ganymede/ent/live"
+	"github.com/zibbp/ganymede/ent/liveschedule"
+	"github.com/zibbp/ganymede/internal/utils"
+)
+
+// LiveSchedule is the model entity for the LiveSchedule schema.
+type LiveSchedule struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// The ID of the watched channel.
+	LiveID uuid.UUID `json:"live_id,omitempty"`
+	// The IANA time zone the windows and days are in, e.g. Europe/Berlin.
+	Timezone string `json:"timezone,omitempty"`
+	// Live streams are only archived inside these windows. Live streams are archived at any time if empty.
+	Windows []utils.LiveScheduleWindow `json:"windows,omitempty"`
+	// Stop archiving a live stream after this many hours. Set to 0 to disable.
+	MaxStreamHours int `json:"max_stream_hours,omitempty"`
+	// Only archive the first live stream of a day.
+	FirstStreamOfDay bool `json:"first_stream_of_day,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the LiveScheduleQuery when eager-loading is set.
+	Edges        LiveScheduleEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// LiveScheduleEdges holds the relations/edges for other nodes in the graph.
+type LiveScheduleEdges struct {
+	// Live holds the value of the live edge.
+	Live *Live `json:"live,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// LiveOrErr returns the Live value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e LiveScheduleEdges) LiveOrErr() (*Live, error) {
+	if e.Live != nil {
+		return e.Live, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: live.Label}
+	}
+	return nil, &NotLoadedError{edge: "live"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*LiveSchedule) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case liveschedule.FieldWindows:
+			values[i] = new([]byte)
+		case liveschedule.FieldFirstStreamOfDay:
+			values[i] = new(sql.NullBool)
+		case liveschedule.FieldMaxStreamHours:
+			values[i] = new(sql.NullInt64)
+		case liveschedule.FieldTimezone:
+			values[i] = new(sql.NullString)
+		case liveschedule.FieldUpdatedAt, liveschedule.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		case liveschedule.FieldID, liveschedule.FieldLiveID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the LiveSchedule fields.
+func (_m *LiveSchedule) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case liveschedule.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case liveschedule.FieldLiveID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field live_id", values[i])
+			} else if value != nil {
+				_m.LiveID = *value
+			}
+		case liveschedule.FieldTimezone:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field timezone", values[i])
+			} else if value.Valid {
+				_m.Timezone = value.String
+			}
+		case liveschedule.FieldWindows:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field windows", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.Windows); err != nil {
+					return fmt.Errorf("unmarshal field windows: %w", err)
+				}
+			}
+		case liveschedule.FieldMaxStreamHours:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field max_stream_hours", values[i])
+			} else if value.Valid {
+				_m.MaxStreamHours = int(value.Int64)
+			}
+		case liveschedule.FieldFirstStreamOfDay:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field first_stream_of_day", values[i])
+			} else if value.Valid {
+				_m.FirstStreamOfDay = value.Bool
+			}
+		case liveschedule.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		case liveschedule.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the LiveSchedule.
+// This includes values selected through modifiers, order, etc.
+func (_m *LiveSchedule) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryLive queries the "live" edge of the LiveSchedule entity.
+func (_m *LiveSchedule) QueryLive() *LiveQuery {
+	return NewLiveScheduleClient(_m.config).QueryLive(_m)
+}
+
+// Update returns a builder for updating this LiveSchedule.
+// Note that you need to call LiveSchedule.Unwrap() before calling this method if this LiveSchedule
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *LiveSchedule) Update() *LiveScheduleUpdateOne {
+	return NewLiveScheduleClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the LiveSchedule entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *LiveSchedule) Unwrap() *LiveSchedule {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: LiveSchedule is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *LiveSchedule) String() string {
+	var builder strings.Builder
+	builder.WriteString("LiveSchedule(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("live_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.LiveID))
+	builder.WriteString(", ")
+	builder.WriteString("timezone=")
+	builder.WriteString(_m.Timezone)
+	builder.WriteString(", ")
+	builder.WriteString("windows=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Windows))
+	builder.WriteString(", ")
+	builder.WriteString("max_stream_hours=")
+	builder.WriteString(fmt.Sprintf("%v", _m.MaxStreamHours))
+	builder.WriteString(", ")
+	builder.WriteString("first_stream_of_day=")
+	builder.WriteString(fmt.Sprintf("%v", _m.FirstStreamOfDay))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// LiveSchedules is a parsable slice of LiveSchedule.
+type LiveSchedules []*LiveSchedule
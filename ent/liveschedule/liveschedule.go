@@ -0,0 +1,136 @@
+// Code generated by ent, DO NOT EDIT.
+
+package liveschedule
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+)
+
+const (
+	// Label holds the string label denoting the liveschedule type in the database.
+	Label = "live_schedule"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldLiveID holds the string denoting the live_id field in the database.
+	FieldLiveID = "live_id"
+	// FieldTimezone holds the string denoting the timezone field in the database.
+	FieldTimezone = "timezone"
+	// FieldWindows holds the string denoting the windows field in the database.
+	FieldWindows = "windows"
+	// FieldMaxStreamHours holds the string denoting the max_stream_hours field in the database.
+	FieldMaxStreamHours = "max_stream_hours"
+	// FieldFirstStreamOfDay holds the string denoting the first_stream_of_day field in the database.
+	FieldFirstStreamOfDay = "first_stream_of_day"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// EdgeLive holds the string denoting the live edge name in mutations.
+	EdgeLive = "live"
+	// Table holds the table name of the liveschedule in the database.
+	Table = "live_schedules"
+	// LiveTable is the table that holds the live relation/edge.
+	LiveTable = "live_schedules"
+	// LiveInverseTable is the table name for the Live entity.
+	// It exists in this package in order to avoid circular dependency with the "live" package.
+	LiveInverseTable = "lives"
+	// LiveColumn is the table column denoting the live relation/edge.
+	LiveColumn = "live_id"
+)
+
+// Columns holds all SQL columns for liveschedule fields.
+var Columns = []string{
+	FieldID,
+	FieldLiveID,
+	FieldTimezone,
+	FieldWindows,
+	FieldMaxStreamHours,
+	FieldFirstStreamOfDay,
+	FieldUpdatedAt,
+	FieldCreatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultTimezone holds the default value on creation for the "timezone" field.
+	DefaultTimezone string
+	// DefaultMaxStreamHours holds the default value on creation for the "max_stream_hours" field.
+	DefaultMaxStreamHours int
+	// MaxStreamHoursValidator is a validator for the "max_stream_hours" field. It is called by the builders before save.
+	MaxStreamHoursValidator func(int) error
+	// DefaultFirstStreamOfDay holds the default value on creation for the "first_stream_of_day" field.
+	DefaultFirstStreamOfDay bool
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() time.Time
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() uuid.UUID
+)
+
+// OrderOption defines the ordering options for the LiveSchedule queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByLiveID orders the results by the live_id field.
+func ByLiveID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLiveID, opts...).ToFunc()
+}
+
+// ByTimezone orders the results by the timezone field.
+func ByTimezone(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTimezone, opts...).ToFunc()
+}
+
+// ByMaxStreamHours orders the results by the max_stream_hours field.
+func ByMaxStreamHours(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMaxStreamHours, opts...).ToFunc()
+}
+
+// ByFirstStreamOfDay orders the results by the first_stream_of_day field.
+func ByFirstStreamOfDay(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFirstStreamOfDay, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByLiveField orders the results by live field.
+func ByLiveField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newLiveStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newLiveStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(LiveInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2O, true, LiveTable, LiveColumn),
+	)
+}
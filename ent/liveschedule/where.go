@@ -0,0 +1,350 @@
+// Code generated by ent, DO NOT EDIT.
+
+package liveschedule
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+	"github.com/zibbp/ganymede/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.LiveSchedule {
+	return predicate.LiveSchedule(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.LiveSchedule {
+	return predicate.LiveSchedule(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.LiveSchedule {
+	return predicate.LiveSchedule(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.LiveSchedule {
+	return predicate.LiveSchedule(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.LiveSchedule {
+	return predicate.LiveSchedule(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.LiveSchedule {
+	return predicate.LiveSchedule(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.LiveSchedule {
+	return predicate.LiveSchedule(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.LiveSchedule {
+	return predicate.LiveSchedule(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.LiveSchedule {
+	return predicate.LiveSchedule(sql.FieldLTE(FieldID, id))
+}
+
+// LiveID applies equality check predicate on the "live_id" field. It's identical to LiveIDEQ.
+func LiveID(v uuid.UUID) predicate.LiveSchedule {
+	return predicate.LiveSchedule(sql.FieldEQ(FieldLiveID, v))
+}
+
+// Timezone applies equality check predicate on the "timezone" field. It's identical to TimezoneEQ.
+func Timezone(v string) predicate.LiveSchedule {
+	return predicate.LiveSchedule(sql.FieldEQ(FieldTimezone, v))
+}
+
+// MaxStreamHours applies equality check predicate on the "max_stream_hours" field. It's identical to MaxStreamHoursEQ.
+func MaxStreamHours(v int) predicate.LiveSchedule {
+	return predicate.LiveSchedule(sql.FieldEQ(FieldMaxStreamHours, v))
+}
+
+// FirstStreamOfDay applies equality check predicate on the "first_stream_of_day" field. It's identical to FirstStreamOfDayEQ.
+func FirstStreamOfDay(v bool) predicate.LiveSchedule {
+	return predicate.LiveSchedule(sql.FieldEQ(FieldFirstStreamOfDay, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.LiveSchedule {
+	return predicate.LiveSchedule(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.LiveSchedule {
+	return predicate.LiveSchedule(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// LiveIDEQ applies the EQ predicate on the "live_id" field.
+func LiveIDEQ(v uuid.UUID) predicate.LiveSchedule {
+	return predicate.LiveSchedule(sql.FieldEQ(FieldLiveID, v))
+}
+
+// LiveIDNEQ applies the NEQ predicate on the "live_id" field.
+func LiveIDNEQ(v uuid.UUID) predicate.LiveSchedule {
+	return predicate.LiveSchedule(sql.FieldNEQ(FieldLiveID, v))
+}
+
+// LiveIDIn applies the In predicate on the "live_id" field.
+func LiveIDIn(vs ...uuid.UUID) predicate.LiveSchedule {
+	return predicate.LiveSchedule(sql.FieldIn(FieldLiveID, vs...))
+}
+
+// LiveIDNotIn applies the NotIn predicate on the "live_id" field.
+func LiveIDNotIn(vs ...uuid.UUID) predicate.LiveSchedule {
+	return predicate.LiveSchedule(sql.FieldNotIn(FieldLiveID, vs...))
+}
+
+// TimezoneEQ applies the EQ predicate on the "timezone" field.
+func TimezoneEQ(v string) predicate.LiveSchedule {
+	return predicate.LiveSchedule(sql.FieldEQ(FieldTimezone, v))
+}
+
+// TimezoneNEQ applies the NEQ predicate on the "timezone" field.
+func TimezoneNEQ(v string) predicate.LiveSchedule {
+	return predicate.LiveSchedule(sql.FieldNEQ(FieldTimezone, v))
+}
+
+// TimezoneIn applies the In predicate on the "timezone" field.
+func TimezoneIn(vs ...string) predicate.LiveSchedule {
+	return predicate.LiveSchedule(sql.FieldIn(FieldTimezone, vs...))
+}
+
+// TimezoneNotIn applies the NotIn predicate on the "timezone" field.
+func TimezoneNotIn(vs ...string) predicate.LiveSchedule {
+	return predicate.LiveSchedule(sql.FieldNotIn(FieldTimezone, vs...))
+}
+
+// TimezoneGT applies the GT predicate on the "timezone" field.
+func TimezoneGT(v string) predicate.LiveSchedule {
+	return predicate.LiveSchedule(sql.FieldGT(FieldTimezone, v))
+}
+
+// TimezoneGTE applies the GTE predicate on the "timezone" field.
+func TimezoneGTE(v string) predicate.LiveSchedule {
+	return predicate.LiveSchedule(sql.FieldGTE(FieldTimezone, v))
+}
+
+// TimezoneLT applies the LT predicate on the "timezone" field.
+func TimezoneLT(v string) predicate.LiveSchedule {
+	return predicate.LiveSchedule(sql.FieldLT(FieldTimezone, v))
+}
+
+// TimezoneLTE applies the LTE predicate on the "timezone" field.
+func TimezoneLTE(v string) predicate.LiveSchedule {
+	return predicate.LiveSchedule(sql.FieldLTE(FieldTimezone, v))
+}
+
+// TimezoneContains applies the Contains predicate on the "timezone" field.
+func TimezoneContains(v string) predicate.LiveSchedule {
+	return predicate.LiveSchedule(sql.FieldContains(FieldTimezone, v))
+}
+
+// TimezoneHasPrefix applies the HasPrefix predicate on the "timezone" field.
+func TimezoneHasPrefix(v string) predicate.LiveSchedule {
+	return predicate.LiveSchedule(sql.FieldHasPrefix(FieldTimezone, v))
+}
+
+// TimezoneHasSuffix applies the HasSuffix predicate on the "timezone" field.
+func TimezoneHasSuffix(v string) predicate.LiveSchedule {
+	return predicate.LiveSchedule(sql.FieldHasSuffix(FieldTimezone, v))
+}
+
+// TimezoneEqualFold applies the EqualFold predicate on the "timezone" field.
+func TimezoneEqualFold(v string) predicate.LiveSchedule {
+	return predicate.LiveSchedule(sql.FieldEqualFold(FieldTimezone, v))
+}
+
+// TimezoneContainsFold applies the ContainsFold predicate on the "timezone" field.
+func TimezoneContainsFold(v string) predicate.LiveSchedule {
+	return predicate.LiveSchedule(sql.FieldContainsFold(FieldTimezone, v))
+}
+
+// WindowsIsNil applies the IsNil predicate on the "windows" field.
+func WindowsIsNil() predicate.LiveSchedule {
+	return predicate.LiveSchedule(sql.FieldIsNull(FieldWindows))
+}
+
+// WindowsNotNil applies the NotNil predicate on the "windows" field.
+func WindowsNotNil() predicate.LiveSchedule {
+	return predicate.LiveSchedule(sql.FieldNotNull(FieldWindows))
+}
+
+// MaxStreamHoursEQ applies the EQ predicate on the "max_stream_hours" field.
+func MaxStreamHoursEQ(v int) predicate.LiveSchedule {
+	return predicate.LiveSchedule(sql.FieldEQ(FieldMaxStreamHours, v))
+}
+
+// MaxStreamHoursNEQ applies the NEQ predicate on the "max_stream_hours" field.
+func MaxStreamHoursNEQ(v int) predicate.LiveSchedule {
+	return predicate.LiveSchedule(sql.FieldNEQ(FieldMaxStreamHours, v))
+}
+
+// MaxStreamHoursIn applies the In predicate on the "max_stream_hours" field.
+func MaxStreamHoursIn(vs ...int) predicate.LiveSchedule {
+	return predicate.LiveSchedule(sql.FieldIn(FieldMaxStreamHours, vs...))
+}
+
+// MaxStreamHoursNotIn applies the NotIn predicate on the "max_stream_hours" field.
+func MaxStreamHoursNotIn(vs ...int) predicate.LiveSchedule {
+	return predicate.LiveSchedule(sql.FieldNotIn(FieldMaxStreamHours, vs...))
+}
+
+// MaxStreamHoursGT applies the GT predicate on the "max_stream_hours" field.
+func MaxStreamHoursGT(v int) predicate.LiveSchedule {
+	return predicate.LiveSchedule(sql.FieldGT(FieldMaxStreamHours, v))
+}
+
+// MaxStreamHoursGTE applies the GTE predicate on the "max_stream_hours" field.
+func MaxStreamHoursGTE(v int) predicate.LiveSchedule {
+	return predicate.LiveSchedule(sql.FieldGTE(FieldMaxStreamHours, v))
+}
+
+// MaxStreamHoursLT applies the LT predicate on the "max_stream_hours" field.
+func MaxStreamHoursLT(v int) predicate.LiveSchedule {
+	return predicate.LiveSchedule(sql.FieldLT(FieldMaxStreamHours, v))
+}
+
+// MaxStreamHoursLTE applies the LTE predicate on the "max_stream_hours" field.
+func MaxStreamHoursLTE(v int) predicate.LiveSchedule {
+	return predicate.LiveSchedule(sql.FieldLTE(FieldMaxStreamHours, v))
+}
+
+// FirstStreamOfDayEQ applies the EQ predicate on the "first_stream_of_day" field.
+func FirstStreamOfDayEQ(v bool) predicate.LiveSchedule {
+	return predicate.LiveSchedule(sql.FieldEQ(FieldFirstStreamOfDay, v))
+}
+
+// FirstStreamOfDayNEQ applies the NEQ predicate on the "first_stream_of_day" field.
+func FirstStreamOfDayNEQ(v bool) predicate.LiveSchedule {
+	return predicate.LiveSchedule(sql.FieldNEQ(FieldFirstStreamOfDay, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.LiveSchedule {
+	return predicate.LiveSchedule(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.LiveSchedule {
+	return predicate.LiveSchedule(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.LiveSchedule {
+	return predicate.LiveSchedule(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.LiveSchedule {
+	return predicate.LiveSchedule(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.LiveSchedule {
+	return predicate.LiveSchedule(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.LiveSchedule {
+	return predicate.LiveSchedule(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.LiveSchedule {
+	return predicate.LiveSchedule(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.LiveSchedule {
+	return predicate.LiveSchedule(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.LiveSchedule {
+	return predicate.LiveSchedule(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.LiveSchedule {
+	return predicate.LiveSchedule(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.LiveSchedule {
+	return predicate.LiveSchedule(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.LiveSchedule {
+	return predicate.LiveSchedule(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.LiveSchedule {
+	return predicate.LiveSchedule(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.LiveSchedule {
+	return predicate.LiveSchedule(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.LiveSchedule {
+	return predicate.LiveSchedule(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.LiveSchedule {
+	return predicate.LiveSchedule(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// HasLive applies the HasEdge predicate on the "live" edge.
+func HasLive() predicate.LiveSchedule {
+	return predicate.LiveSchedule(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, true, LiveTable, LiveColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasLiveWith applies the HasEdge predicate on the "live" edge with a given conditions (other predicates).
+func HasLiveWith(preds ...predicate.Live) predicate.LiveSchedule {
+	return predicate.LiveSchedule(func(s *sql.Selector) {
+		step := newLiveStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.LiveSchedule) predicate.LiveSchedule {
+	return predicate.LiveSchedule(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.LiveSchedule) predicate.LiveSchedule {
+	return predicate.LiveSchedule(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.LiveSchedule) predicate.LiveSchedule {
+	return predicate.LiveSchedule(sql.NotPredicates(p))
+}
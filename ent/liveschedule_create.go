@@ -0,0 +1,918 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/zibbp/ganymede/ent/live"
+	"github.com/zibbp/ganymede/ent/liveschedule"
+	"github.com/zibbp/ganymede/internal/utils"
+)
+
+// LiveScheduleCreate is the builder for creating a LiveSchedule entity.
+type LiveScheduleCreate struct {
+	config
+	mutation *LiveScheduleMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetLiveID sets the "live_id" field.
+func (_c *LiveScheduleCreate) SetLiveID(v uuid.UUID) *LiveScheduleCreate {
+	_c.mutation.SetLiveID(v)
+	return _c
+}
+
+// SetTimezone sets the "timezone" field.
+func (_c *LiveScheduleCreate) SetTimezone(v string) *LiveScheduleCreate {
+	_c.mutation.SetTimezone(v)
+	return _c
+}
+
+// SetNillableTimezone sets the "timezone" field if the given value is not nil.
+func (_c *LiveScheduleCreate) SetNillableTimezone(v *string) *LiveScheduleCreate {
+	if v != nil {
+		_c.SetTimezone(*v)
+	}
+	return _c
+}
+
+// SetWindows sets the "windows" field.
+func (_c *LiveScheduleCreate) SetWindows(v []utils.LiveScheduleWindow) *LiveScheduleCreate {
+	_c.mutation.SetWindows(v)
+	return _c
+}
+
+// SetMaxStreamHours sets the "max_stream_hours" field.
+func (_c *LiveScheduleCreate) SetMaxStreamHours(v int) *LiveScheduleCreate {
+	_c.mutation.SetMaxStreamHours(v)
+	return _c
+}
+
+// SetNillableMaxStreamHours sets the "max_stream_hours" field if the given value is not nil.
+func (_c *LiveScheduleCreate) SetNillableMaxStreamHours(v *int) *LiveScheduleCreate {
+	if v != nil {
+		_c.SetMaxStreamHours(*v)
+	}
+	return _c
+}
+
+// SetFirstStreamOfDay sets the "first_stream_of_day" field.
+func (_c *LiveScheduleCreate) SetFirstStreamOfDay(v bool) *LiveScheduleCreate {
+	_c.mutation.SetFirstStreamOfDay(v)
+	return _c
+}
+
+// SetNillableFirstStreamOfDay sets the "first_stream_of_day" field if the given value is not nil.
+func (_c *LiveScheduleCreate) SetNillableFirstStreamOfDay(v *bool) *LiveScheduleCreate {
+	if v != nil {
+		_c.SetFirstStreamOfDay(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *LiveScheduleCreate) SetUpdatedAt(v time.Time) *LiveScheduleCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *LiveScheduleCreate) SetNillableUpdatedAt(v *time.Time) *LiveScheduleCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *LiveScheduleCreate) SetCreatedAt(v time.Time) *LiveScheduleCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *LiveScheduleCreate) SetNillableCreatedAt(v *time.Time) *LiveScheduleCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *LiveScheduleCreate) SetID(v uuid.UUID) *LiveScheduleCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *LiveScheduleCreate) SetNillableID(v *uuid.UUID) *LiveScheduleCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// SetLive sets the "live" edge to the Live entity.
+func (_c *LiveScheduleCreate) SetLive(v *Live) *LiveScheduleCreate {
+	return _c.SetLiveID(v.ID)
+}
+
+// Mutation returns the LiveScheduleMutation object of the builder.
+func (_c *LiveScheduleCreate) Mutation() *LiveScheduleMutation {
+	return _c.mutation
+}
+
+// Save creates the LiveSchedule in the database.
+func (_c *LiveScheduleCreate) Save(ctx context.Context) (*LiveSchedule, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *LiveScheduleCreate) SaveX(ctx context.Context) *LiveSchedule {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *LiveScheduleCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *LiveScheduleCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *LiveScheduleCreate) defaults() {
+	if _, ok := _c.mutation.Timezone(); !ok {
+		v := liveschedule.DefaultTimezone
+		_c.mutation.SetTimezone(v)
+	}
+	if _, ok := _c.mutation.MaxStreamHours(); !ok {
+		v := liveschedule.DefaultMaxStreamHours
+		_c.mutation.SetMaxStreamHours(v)
+	}
+	if _, ok := _c.mutation.FirstStreamOfDay(); !ok {
+		v := liveschedule.DefaultFirstStreamOfDay
+		_c.mutation.SetFirstStreamOfDay(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := liveschedule.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := liveschedule.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := liveschedule.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *LiveScheduleCreate) check() error {
+	if _, ok := _c.mutation.LiveID(); !ok {
+		return &ValidationError{Name: "live_id", err: errors.New(`ent: missing required field "LiveSchedule.live_id"`)}
+	}
+	if _, ok := _c.mutation.Timezone(); !ok {
+		return &ValidationError{Name: "timezone", err: errors.New(`ent: missing required field "LiveSchedule.timezone"`)}
+	}
+	if _, ok := _c.mutation.MaxStreamHours(); !ok {
+		return &ValidationError{Name: "max_stream_hours", err: errors.New(`ent: missing required field "LiveSchedule.max_stream_hours"`)}
+	}
+	if v, ok := _c.mutation.MaxStreamHours(); ok {
+		if err := liveschedule.MaxStreamHoursValidator(v); err != nil {
+			return &ValidationError{Name: "max_stream_hours", err: fmt.Errorf(`ent: validator failed for field "LiveSchedule.max_stream_hours": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.FirstStreamOfDay(); !ok {
+		return &ValidationError{Name: "first_stream_of_day", err: errors.New(`ent: missing required field "LiveSchedule.first_stream_of_day"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "LiveSchedule.updated_at"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "LiveSchedule.created_at"`)}
+	}
+	if len(_c.mutation.LiveIDs()) == 0 {
+		return &ValidationError{Name: "live", err: errors.New(`ent: missing required edge "LiveSchedule.live"`)}
+	}
+	return nil
+}
+
+func (_c *LiveScheduleCreate) sqlSave(ctx context.Context) (*LiveSchedule, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *LiveScheduleCreate) createSpec() (*LiveSchedule, *sqlgraph.CreateSpec) {
+	var (
+		_node = &LiveSchedule{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(liveschedule.Table, sqlgraph.NewFieldSpec(liveschedule.FieldID, field.TypeUUID))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.Timezone(); ok {
+		_spec.SetField(liveschedule.FieldTimezone, field.TypeString, value)
+		_node.Timezone = value
+	}
+	if value, ok := _c.mutation.Windows(); ok {
+		_spec.SetField(liveschedule.FieldWindows, field.TypeJSON, value)
+		_node.Windows = value
+	}
+	if value, ok := _c.mutation.MaxStreamHours(); ok {
+		_spec.SetField(liveschedule.FieldMaxStreamHours, field.TypeInt, value)
+		_node.MaxStreamHours = value
+	}
+	if value, ok := _c.mutation.FirstStreamOfDay(); ok {
+		_spec.SetField(liveschedule.FieldFirstStreamOfDay, field.TypeBool, value)
+		_node.FirstStreamOfDay = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(liveschedule.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(liveschedule.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := _c.mutation.LiveIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   liveschedule.LiveTable,
+			Columns: []string{liveschedule.LiveColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(live.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.LiveID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.LiveSchedule.Create().
+//		SetLiveID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.LiveScheduleUpsert) {
+//			SetLiveID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *LiveScheduleCreate) OnConflict(opts ...sql.ConflictOption) *LiveScheduleUpsertOne {
+	_c.conflict = opts
+	return &LiveScheduleUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.LiveSchedule.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *LiveScheduleCreate) OnConflictColumns(columns ...string) *LiveScheduleUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &LiveScheduleUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// LiveScheduleUpsertOne is the builder for "upsert"-ing
+	//  one LiveSchedule node.
+	LiveScheduleUpsertOne struct {
+		create *LiveScheduleCreate
+	}
+
+	// LiveScheduleUpsert is the "OnConflict" setter.
+	LiveScheduleUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetLiveID sets the "live_id" field.
+func (u *LiveScheduleUpsert) SetLiveID(v uuid.UUID) *LiveScheduleUpsert {
+	u.Set(liveschedule.FieldLiveID, v)
+	return u
+}
+
+// UpdateLiveID sets the "live_id" field to the value that was provided on create.
+func (u *LiveScheduleUpsert) UpdateLiveID() *LiveScheduleUpsert {
+	u.SetExcluded(liveschedule.FieldLiveID)
+	return u
+}
+
+// SetTimezone sets the "timezone" field.
+func (u *LiveScheduleUpsert) SetTimezone(v string) *LiveScheduleUpsert {
+	u.Set(liveschedule.FieldTimezone, v)
+	return u
+}
+
+// UpdateTimezone sets the "timezone" field to the value that was provided on create.
+func (u *LiveScheduleUpsert) UpdateTimezone() *LiveScheduleUpsert {
+	u.SetExcluded(liveschedule.FieldTimezone)
+	return u
+}
+
+// SetWindows sets the "windows" field.
+func (u *LiveScheduleUpsert) SetWindows(v []utils.LiveScheduleWindow) *LiveScheduleUpsert {
+	u.Set(liveschedule.FieldWindows, v)
+	return u
+}
+
+// UpdateWindows sets the "windows" field to the value that was provided on create.
+func (u *LiveScheduleUpsert) UpdateWindows() *LiveScheduleUpsert {
+	u.SetExcluded(liveschedule.FieldWindows)
+	return u
+}
+
+// ClearWindows clears the value of the "windows" field.
+func (u *LiveScheduleUpsert) ClearWindows() *LiveScheduleUpsert {
+	u.SetNull(liveschedule.FieldWindows)
+	return u
+}
+
+// SetMaxStreamHours sets the "max_stream_hours" field.
+func (u *LiveScheduleUpsert) SetMaxStreamHours(v int) *LiveScheduleUpsert {
+	u.Set(liveschedule.FieldMaxStreamHours, v)
+	return u
+}
+
+// UpdateMaxStreamHours sets the "max_stream_hours" field to the value that was provided on create.
+func (u *LiveScheduleUpsert) UpdateMaxStreamHours() *LiveScheduleUpsert {
+	u.SetExcluded(liveschedule.FieldMaxStreamHours)
+	return u
+}
+
+// AddMaxStreamHours adds v to the "max_stream_hours" field.
+func (u *LiveScheduleUpsert) AddMaxStreamHours(v int) *LiveScheduleUpsert {
+	u.Add(liveschedule.FieldMaxStreamHours, v)
+	return u
+}
+
+// SetFirstStreamOfDay sets the "first_stream_of_day" field.
+func (u *LiveScheduleUpsert) SetFirstStreamOfDay(v bool) *LiveScheduleUpsert {
+	u.Set(liveschedule.FieldFirstStreamOfDay, v)
+	return u
+}
+
+// UpdateFirstStreamOfDay sets the "first_stream_of_day" field to the value that was provided on create.
+func (u *LiveScheduleUpsert) UpdateFirstStreamOfDay() *LiveScheduleUpsert {
+	u.SetExcluded(liveschedule.FieldFirstStreamOfDay)
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *LiveScheduleUpsert) SetUpdatedAt(v time.Time) *LiveScheduleUpsert {
+	u.Set(liveschedule.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *LiveScheduleUpsert) UpdateUpdatedAt() *LiveScheduleUpsert {
+	u.SetExcluded(liveschedule.FieldUpdatedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.LiveSchedule.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(liveschedule.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *LiveScheduleUpsertOne) UpdateNewValues() *LiveScheduleUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(liveschedule.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(liveschedule.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.LiveSchedule.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *LiveScheduleUpsertOne) Ignore() *LiveScheduleUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *LiveScheduleUpsertOne) DoNothing() *LiveScheduleUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the LiveScheduleCreate.OnConflict
+// documentation for more info.
+func (u *LiveScheduleUpsertOne) Update(set func(*LiveScheduleUpsert)) *LiveScheduleUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&LiveScheduleUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetLiveID sets the "live_id" field.
+func (u *LiveScheduleUpsertOne) SetLiveID(v uuid.UUID) *LiveScheduleUpsertOne {
+	return u.Update(func(s *LiveScheduleUpsert) {
+		s.SetLiveID(v)
+	})
+}
+
+// UpdateLiveID sets the "live_id" field to the value that was provided on create.
+func (u *LiveScheduleUpsertOne) UpdateLiveID() *LiveScheduleUpsertOne {
+	return u.Update(func(s *LiveScheduleUpsert) {
+		s.UpdateLiveID()
+	})
+}
+
+// SetTimezone sets the "timezone" field.
+func (u *LiveScheduleUpsertOne) SetTimezone(v string) *LiveScheduleUpsertOne {
+	return u.Update(func(s *LiveScheduleUpsert) {
+		s.SetTimezone(v)
+	})
+}
+
+// UpdateTimezone sets the "timezone" field to the value that was provided on create.
+func (u *LiveScheduleUpsertOne) UpdateTimezone() *LiveScheduleUpsertOne {
+	return u.Update(func(s *LiveScheduleUpsert) {
+		s.UpdateTimezone()
+	})
+}
+
+// SetWindows sets the "windows" field.
+func (u *LiveScheduleUpsertOne) SetWindows(v []utils.LiveScheduleWindow) *LiveScheduleUpsertOne {
+	return u.Update(func(s *LiveScheduleUpsert) {
+		s.SetWindows(v)
+	})
+}
+
+// UpdateWindows sets the "windows" field to the value that was provided on create.
+func (u *LiveScheduleUpsertOne) UpdateWindows() *LiveScheduleUpsertOne {
+	return u.Update(func(s *LiveScheduleUpsert) {
+		s.UpdateWindows()
+	})
+}
+
+// ClearWindows clears the value of the "windows" field.
+func (u *LiveScheduleUpsertOne) ClearWindows() *LiveScheduleUpsertOne {
+	return u.Update(func(s *LiveScheduleUpsert) {
+		s.ClearWindows()
+	})
+}
+
+// SetMaxStreamHours sets the "max_stream_hours" field.
+func (u *LiveScheduleUpsertOne) SetMaxStreamHours(v int) *LiveScheduleUpsertOne {
+	return u.Update(func(s *LiveScheduleUpsert) {
+		s.SetMaxStreamHours(v)
+	})
+}
+
+// AddMaxStreamHours adds v to the "max_stream_hours" field.
+func (u *LiveScheduleUpsertOne) AddMaxStreamHours(v int) *LiveScheduleUpsertOne {
+	return u.Update(func(s *LiveScheduleUpsert) {
+		s.AddMaxStreamHours(v)
+	})
+}
+
+// UpdateMaxStreamHours sets the "max_stream_hours" field to the value that was provided on create.
+func (u *LiveScheduleUpsertOne) UpdateMaxStreamHours() *LiveScheduleUpsertOne {
+	return u.Update(func(s *LiveScheduleUpsert) {
+		s.UpdateMaxStreamHours()
+	})
+}
+
+// SetFirstStreamOfDay sets the "first_stream_of_day" field.
+func (u *LiveScheduleUpsertOne) SetFirstStreamOfDay(v bool) *LiveScheduleUpsertOne {
+	return u.Update(func(s *LiveScheduleUpsert) {
+		s.SetFirstStreamOfDay(v)
+	})
+}
+
+// UpdateFirstStreamOfDay sets the "first_stream_of_day" field to the value that was provided on create.
+func (u *LiveScheduleUpsertOne) UpdateFirstStreamOfDay() *LiveScheduleUpsertOne {
+	return u.Update(func(s *LiveScheduleUpsert) {
+		s.UpdateFirstStreamOfDay()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *LiveScheduleUpsertOne) SetUpdatedAt(v time.Time) *LiveScheduleUpsertOne {
+	return u.Update(func(s *LiveScheduleUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *LiveScheduleUpsertOne) UpdateUpdatedAt() *LiveScheduleUpsertOne {
+	return u.Update(func(s *LiveScheduleUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *LiveScheduleUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for LiveScheduleCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *LiveScheduleUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *LiveScheduleUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: LiveScheduleUpsertOne.ID is not supported by MySQL driver. Use LiveScheduleUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *LiveScheduleUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// LiveScheduleCreateBulk is the builder for creating many LiveSchedule entities in bulk.
+type LiveScheduleCreateBulk struct {
+	config
+	err      error
+	builders []*LiveScheduleCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the LiveSchedule entities in the database.
+func (_c *LiveScheduleCreateBulk) Save(ctx context.Context) ([]*LiveSchedule, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*LiveSchedule, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*LiveScheduleMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *LiveScheduleCreateBulk) SaveX(ctx context.Context) []*LiveSchedule {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *LiveScheduleCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *LiveScheduleCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.LiveSchedule.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.LiveScheduleUpsert) {
+//			SetLiveID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *LiveScheduleCreateBulk) OnConflict(opts ...sql.ConflictOption) *LiveScheduleUpsertBulk {
+	_c.conflict = opts
+	return &LiveScheduleUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.LiveSchedule.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *LiveScheduleCreateBulk) OnConflictColumns(columns ...string) *LiveScheduleUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &LiveScheduleUpsertBulk{
+		create: _c,
+	}
+}
+
+// LiveScheduleUpsertBulk is the builder for "upsert"-ing
+// a bulk of LiveSchedule nodes.
+type LiveScheduleUpsertBulk struct {
+	create *LiveScheduleCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.LiveSchedule.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(liveschedule.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *LiveScheduleUpsertBulk) UpdateNewValues() *LiveScheduleUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(liveschedule.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(liveschedule.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.LiveSchedule.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *LiveScheduleUpsertBulk) Ignore() *LiveScheduleUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *LiveScheduleUpsertBulk) DoNothing() *LiveScheduleUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the LiveScheduleCreateBulk.OnConflict
+// documentation for more info.
+func (u *LiveScheduleUpsertBulk) Update(set func(*LiveScheduleUpsert)) *LiveScheduleUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&LiveScheduleUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetLiveID sets the "live_id" field.
+func (u *LiveScheduleUpsertBulk) SetLiveID(v uuid.UUID) *LiveScheduleUpsertBulk {
+	return u.Update(func(s *LiveScheduleUpsert) {
+		s.SetLiveID(v)
+	})
+}
+
+// UpdateLiveID sets the "live_id" field to the value that was provided on create.
+func (u *LiveScheduleUpsertBulk) UpdateLiveID() *LiveScheduleUpsertBulk {
+	return u.Update(func(s *LiveScheduleUpsert) {
+		s.UpdateLiveID()
+	})
+}
+
+// SetTimezone sets the "timezone" field.
+func (u *LiveScheduleUpsertBulk) SetTimezone(v string) *LiveScheduleUpsertBulk {
+	return u.Update(func(s *LiveScheduleUpsert) {
+		s.SetTimezone(v)
+	})
+}
+
+// UpdateTimezone sets the "timezone" field to the value that was provided on create.
+func (u *LiveScheduleUpsertBulk) UpdateTimezone() *LiveScheduleUpsertBulk {
+	return u.Update(func(s *LiveScheduleUpsert) {
+		s.UpdateTimezone()
+	})
+}
+
+// SetWindows sets the "windows" field.
+func (u *LiveScheduleUpsertBulk) SetWindows(v []utils.LiveScheduleWindow) *LiveScheduleUpsertBulk {
+	return u.Update(func(s *LiveScheduleUpsert) {
+		s.SetWindows(v)
+	})
+}
+
+// UpdateWindows sets the "windows" field to the value that was provided on create.
+func (u *LiveScheduleUpsertBulk) UpdateWindows() *LiveScheduleUpsertBulk {
+	return u.Update(func(s *LiveScheduleUpsert) {
+		s.UpdateWindows()
+	})
+}
+
+// ClearWindows clears the value of the "windows" field.
+func (u *LiveScheduleUpsertBulk) ClearWindows() *LiveScheduleUpsertBulk {
+	return u.Update(func(s *LiveScheduleUpsert) {
+		s.ClearWindows()
+	})
+}
+
+// SetMaxStreamHours sets the "max_stream_hours" field.
+func (u *LiveScheduleUpsertBulk) SetMaxStreamHours(v int) *LiveScheduleUpsertBulk {
+	return u.Update(func(s *LiveScheduleUpsert) {
+		s.SetMaxStreamHours(v)
+	})
+}
+
+// AddMaxStreamHours adds v to the "max_stream_hours" field.
+func (u *LiveScheduleUpsertBulk) AddMaxStreamHours(v int) *LiveScheduleUpsertBulk {
+	return u.Update(func(s *LiveScheduleUpsert) {
+		s.AddMaxStreamHours(v)
+	})
+}
+
+// UpdateMaxStreamHours sets the "max_stream_hours" field to the value that was provided on create.
+func (u *LiveScheduleUpsertBulk) UpdateMaxStreamHours() *LiveScheduleUpsertBulk {
+	return u.Update(func(s *LiveScheduleUpsert) {
+		s.UpdateMaxStreamHours()
+	})
+}
+
+// SetFirstStreamOfDay sets the "first_stream_of_day" field.
+func (u *LiveScheduleUpsertBulk) SetFirstStreamOfDay(v bool) *LiveScheduleUpsertBulk {
+	return u.Update(func(s *LiveScheduleUpsert) {
+		s.SetFirstStreamOfDay(v)
+	})
+}
+
+// UpdateFirstStreamOfDay sets the "first_stream_of_day" field to the value that was provided on create.
+func (u *LiveScheduleUpsertBulk) UpdateFirstStreamOfDay() *LiveScheduleUpsertBulk {
+	return u.Update(func(s *LiveScheduleUpsert) {
+		s.UpdateFirstStreamOfDay()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *LiveScheduleUpsertBulk) SetUpdatedAt(v time.Time) *LiveScheduleUpsertBulk {
+	return u.Update(func(s *LiveScheduleUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *LiveScheduleUpsertBulk) UpdateUpdatedAt() *LiveScheduleUpsertBulk {
+	return u.Update(func(s *LiveScheduleUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *LiveScheduleUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the LiveScheduleCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for LiveScheduleCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *LiveScheduleUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
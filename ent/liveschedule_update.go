@@ -0,0 +1,536 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/zibbp/ganymede/ent/live"
+	"github.com/zibbp/ganymede/ent/liveschedule"
+	"github.com/zibbp/ganymede/ent/predicate"
+	"github.com/zibbp/ganymede/internal/utils"
+)
+
+// LiveScheduleUpdate is the builder for updating LiveSchedule entities.
+type LiveScheduleUpdate struct {
+	config
+	hooks    []Hook
+	mutation *LiveScheduleMutation
+}
+
+// Where appends a list predicates to the LiveScheduleUpdate builder.
+func (_u *LiveScheduleUpdate) Where(ps ...predicate.LiveSchedule) *LiveScheduleUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetLiveID sets the "live_id" field.
+func (_u *LiveScheduleUpdate) SetLiveID(v uuid.UUID) *LiveScheduleUpdate {
+	_u.mutation.SetLiveID(v)
+	return _u
+}
+
+// SetNillableLiveID sets the "live_id" field if the given value is not nil.
+func (_u *LiveScheduleUpdate) SetNillableLiveID(v *uuid.UUID) *LiveScheduleUpdate {
+	if v != nil {
+		_u.SetLiveID(*v)
+	}
+	return _u
+}
+
+// SetTimezone sets the "timezone" field.
+func (_u *LiveScheduleUpdate) SetTimezone(v string) *LiveScheduleUpdate {
+	_u.mutation.SetTimezone(v)
+	return _u
+}
+
+// SetNillableTimezone sets the "timezone" field if the given value is not nil.
+func (_u *LiveScheduleUpdate) SetNillableTimezone(v *string) *LiveScheduleUpdate {
+	if v != nil {
+		_u.SetTimezone(*v)
+	}
+	return _u
+}
+
+// SetWindows sets the "windows" field.
+func (_u *LiveScheduleUpdate) SetWindows(v []utils.LiveScheduleWindow) *LiveScheduleUpdate {
+	_u.mutation.SetWindows(v)
+	return _u
+}
+
+// AppendWindows appends value to the "windows" field.
+func (_u *LiveScheduleUpdate) AppendWindows(v []utils.LiveScheduleWindow) *LiveScheduleUpdate {
+	_u.mutation.AppendWindows(v)
+	return _u
+}
+
+// ClearWindows clears the value of the "windows" field.
+func (_u *LiveScheduleUpdate) ClearWindows() *LiveScheduleUpdate {
+	_u.mutation.ClearWindows()
+	return _u
+}
+
+// SetMaxStreamHours sets the "max_stream_hours" field.
+func (_u *LiveScheduleUpdate) SetMaxStreamHours(v int) *LiveScheduleUpdate {
+	_u.mutation.ResetMaxStreamHours()
+	_u.mutation.SetMaxStreamHours(v)
+	return _u
+}
+
+// SetNillableMaxStreamHours sets the "max_stream_hours" field if the given value is not nil.
+func (_u *LiveScheduleUpdate) SetNillableMaxStreamHours(v *int) *LiveScheduleUpdate {
+	if v != nil {
+		_u.SetMaxStreamHours(*v)
+	}
+	return _u
+}
+
+// AddMaxStreamHours adds value to the "max_stream_hours" field.
+func (_u *LiveScheduleUpdate) AddMaxStreamHours(v int) *LiveScheduleUpdate {
+	_u.mutation.AddMaxStreamHours(v)
+	return _u
+}
+
+// SetFirstStreamOfDay sets the "first_stream_of_day" field.
+func (_u *LiveScheduleUpdate) SetFirstStreamOfDay(v bool) *LiveScheduleUpdate {
+	_u.mutation.SetFirstStreamOfDay(v)
+	return _u
+}
+
+// SetNillableFirstStreamOfDay sets the "first_stream_of_day" field if the given value is not nil.
+func (_u *LiveScheduleUpdate) SetNillableFirstStreamOfDay(v *bool) *LiveScheduleUpdate {
+	if v != nil {
+		_u.SetFirstStreamOfDay(*v)
+	}
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *LiveScheduleUpdate) SetUpdatedAt(v time.Time) *LiveScheduleUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetLive sets the "live" edge to the Live entity.
+func (_u *LiveScheduleUpdate) SetLive(v *Live) *LiveScheduleUpdate {
+	return _u.SetLiveID(v.ID)
+}
+
+// Mutation returns the LiveScheduleMutation object of the builder.
+func (_u *LiveScheduleUpdate) Mutation() *LiveScheduleMutation {
+	return _u.mutation
+}
+
+// ClearLive clears the "live" edge to the Live entity.
+func (_u *LiveScheduleUpdate) ClearLive() *LiveScheduleUpdate {
+	_u.mutation.ClearLive()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *LiveScheduleUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *LiveScheduleUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *LiveScheduleUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *LiveScheduleUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *LiveScheduleUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := liveschedule.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *LiveScheduleUpdate) check() error {
+	if v, ok := _u.mutation.MaxStreamHours(); ok {
+		if err := liveschedule.MaxStreamHoursValidator(v); err != nil {
+			return &ValidationError{Name: "max_stream_hours", err: fmt.Errorf(`ent: validator failed for field "LiveSchedule.max_stream_hours": %w`, err)}
+		}
+	}
+	if _u.mutation.LiveCleared() && len(_u.mutation.LiveIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "LiveSchedule.live"`)
+	}
+	return nil
+}
+
+func (_u *LiveScheduleUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(liveschedule.Table, liveschedule.Columns, sqlgraph.NewFieldSpec(liveschedule.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Timezone(); ok {
+		_spec.SetField(liveschedule.FieldTimezone, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Windows(); ok {
+		_spec.SetField(liveschedule.FieldWindows, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedWindows(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, liveschedule.FieldWindows, value)
+		})
+	}
+	if _u.mutation.WindowsCleared() {
+		_spec.ClearField(liveschedule.FieldWindows, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.MaxStreamHours(); ok {
+		_spec.SetField(liveschedule.FieldMaxStreamHours, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedMaxStreamHours(); ok {
+		_spec.AddField(liveschedule.FieldMaxStreamHours, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.FirstStreamOfDay(); ok {
+		_spec.SetField(liveschedule.FieldFirstStreamOfDay, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(liveschedule.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.LiveCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   liveschedule.LiveTable,
+			Columns: []string{liveschedule.LiveColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(live.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.LiveIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   liveschedule.LiveTable,
+			Columns: []string{liveschedule.LiveColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(live.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{liveschedule.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// LiveScheduleUpdateOne is the builder for updating a single LiveSchedule entity.
+type LiveScheduleUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *LiveScheduleMutation
+}
+
+// SetLiveID sets the "live_id" field.
+func (_u *LiveScheduleUpdateOne) SetLiveID(v uuid.UUID) *LiveScheduleUpdateOne {
+	_u.mutation.SetLiveID(v)
+	return _u
+}
+
+// SetNillableLiveID sets the "live_id" field if the given value is not nil.
+func (_u *LiveScheduleUpdateOne) SetNillableLiveID(v *uuid.UUID) *LiveScheduleUpdateOne {
+	if v != nil {
+		_u.SetLiveID(*v)
+	}
+	return _u
+}
+
+// SetTimezone sets the "timezone" field.
+func (_u *LiveScheduleUpdateOne) SetTimezone(v string) *LiveScheduleUpdateOne {
+	_u.mutation.SetTimezone(v)
+	return _u
+}
+
+// SetNillableTimezone sets the "timezone" field if the given value is not nil.
+func (_u *LiveScheduleUpdateOne) SetNillableTimezone(v *string) *LiveScheduleUpdateOne {
+	if v != nil {
+		_u.SetTimezone(*v)
+	}
+	return _u
+}
+
+// SetWindows sets the "windows" field.
+func (_u *LiveScheduleUpdateOne) SetWindows(v []utils.LiveScheduleWindow) *LiveScheduleUpdateOne {
+	_u.mutation.SetWindows(v)
+	return _u
+}
+
+// AppendWindows appends value to the "windows" field.
+func (_u *LiveScheduleUpdateOne) AppendWindows(v []utils.LiveScheduleWindow) *LiveScheduleUpdateOne {
+	_u.mutation.AppendWindows(v)
+	return _u
+}
+
+// ClearWindows clears the value of the "windows" field.
+func (_u *LiveScheduleUpdateOne) ClearWindows() *LiveScheduleUpdateOne {
+	_u.mutation.ClearWindows()
+	return _u
+}
+
+// SetMaxStreamHours sets the "max_stream_hours" field.
+func (_u *LiveScheduleUpdateOne) SetMaxStreamHours(v int) *LiveScheduleUpdateOne {
+	_u.mutation.ResetMaxStreamHours()
+	_u.mutation.SetMaxStreamHours(v)
+	return _u
+}
+
+// SetNillableMaxStreamHours sets the "max_stream_hours" field if the given value is not nil.
+func (_u *LiveScheduleUpdateOne) SetNillableMaxStreamHours(v *int) *LiveScheduleUpdateOne {
+	if v != nil {
+		_u.SetMaxStreamHours(*v)
+	}
+	return _u
+}
+
+// AddMaxStreamHours adds value to the "max_stream_hours" field.
+func (_u *LiveScheduleUpdateOne) AddMaxStreamHours(v int) *LiveScheduleUpdateOne {
+	_u.mutation.AddMaxStreamHours(v)
+	return _u
+}
+
+// SetFirstStreamOfDay sets the "first_stream_of_day" field.
+func (_u *LiveScheduleUpdateOne) SetFirstStreamOfDay(v bool) *LiveScheduleUpdateOne {
+	_u.mutation.SetFirstStreamOfDay(v)
+	return _u
+}
+
+// SetNillableFirstStreamOfDay sets the "first_stream_of_day" field if the given value is not nil.
+func (_u *LiveScheduleUpdateOne) SetNillableFirstStreamOfDay(v *bool) *LiveScheduleUpdateOne {
+	if v != nil {
+		_u.SetFirstStreamOfDay(*v)
+	}
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *LiveScheduleUpdateOne) SetUpdatedAt(v time.Time) *LiveScheduleUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetLive sets the "live" edge to the Live entity.
+func (_u *LiveScheduleUpdateOne) SetLive(v *Live) *LiveScheduleUpdateOne {
+	return _u.SetLiveID(v.ID)
+}
+
+// Mutation returns the LiveScheduleMutation object of the builder.
+func (_u *LiveScheduleUpdateOne) Mutation() *LiveScheduleMutation {
+	return _u.mutation
+}
+
+// ClearLive clears the "live" edge to the Live entity.
+func (_u *LiveScheduleUpdateOne) ClearLive() *LiveScheduleUpdateOne {
+	_u.mutation.ClearLive()
+	return _u
+}
+
+// Where appends a list predicates to the LiveScheduleUpdate builder.
+func (_u *LiveScheduleUpdateOne) Where(ps ...predicate.LiveSchedule) *LiveScheduleUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *LiveScheduleUpdateOne) Select(field string, fields ...string) *LiveScheduleUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated LiveSchedule entity.
+func (_u *LiveScheduleUpdateOne) Save(ctx context.Context) (*LiveSchedule, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *LiveScheduleUpdateOne) SaveX(ctx context.Context) *LiveSchedule {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *LiveScheduleUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *LiveScheduleUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *LiveScheduleUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := liveschedule.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *LiveScheduleUpdateOne) check() error {
+	if v, ok := _u.mutation.MaxStreamHours(); ok {
+		if err := liveschedule.MaxStreamHoursValidator(v); err != nil {
+			return &ValidationError{Name: "max_stream_hours", err: fmt.Errorf(`ent: validator failed for field "LiveSchedule.max_stream_hours": %w`, err)}
+		}
+	}
+	if _u.mutation.LiveCleared() && len(_u.mutation.LiveIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "LiveSchedule.live"`)
+	}
+	return nil
+}
+
+func (_u *LiveScheduleUpdateOne) sqlSave(ctx context.Context) (_node *LiveSchedule, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(liveschedule.Table, liveschedule.Columns, sqlgraph.NewFieldSpec(liveschedule.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "LiveSchedule.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, liveschedule.FieldID)
+		for _, f := range fields {
+			if !liveschedule.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != liveschedule.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Timezone(); ok {
+		_spec.SetField(liveschedule.FieldTimezone, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Windows(); ok {
+		_spec.SetField(liveschedule.FieldWindows, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedWindows(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, liveschedule.FieldWindows, value)
+		})
+	}
+	if _u.mutation.WindowsCleared() {
+		_spec.ClearField(liveschedule.FieldWindows, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.MaxStreamHours(); ok {
+		_spec.SetField(liveschedule.FieldMaxStreamHours, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedMaxStreamHours(); ok {
+		_spec.AddField(liveschedule.FieldMaxStreamHours, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.FirstStreamOfDay(); ok {
+		_spec.SetField(liveschedule.FieldFirstStreamOfDay, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(liveschedule.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.LiveCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   liveschedule.LiveTable,
+			Columns: []string{liveschedule.LiveColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(live.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.LiveIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   liveschedule.LiveTable,
+			Columns: []string{liveschedule.LiveColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(live.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &LiveSchedule{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{liveschedule.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
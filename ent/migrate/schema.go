@@ -3,6 +3,7 @@
 package migrate
 
 import (
+	"entgo.io/ent/dialect/entsql"
 	"entgo.io/ent/dialect/sql/schema"
 	"entgo.io/ent/schema/field"
 )
@@ -54,8 +55,14 @@ var (
 		{Name: "name", Type: field.TypeString, Unique: true},
 		{Name: "display_name", Type: field.TypeString, Unique: true},
 		{Name: "image_path", Type: field.TypeString},
+		{Name: "platform", Type: field.TypeEnum, Enums: []string{"twitch", "youtube", "kick"}, Default: "twitch"},
 		{Name: "retention", Type: field.TypeBool, Default: false},
 		{Name: "retention_days", Type: field.TypeInt64, Nullable: true},
+		{Name: "retention_keep_newest", Type: field.TypeInt, Default: 0},
+		{Name: "retention_max_size_bytes", Type: field.TypeInt64, Default: 0},
+		{Name: "retention_video_types", Type: field.TypeJSON, Nullable: true},
+		{Name: "retention_exempt_playlists", Type: field.TypeBool, Default: false},
+		{Name: "retention_exempt_min_views", Type: field.TypeInt, Default: 0},
 		{Name: "storage_size_bytes", Type: field.TypeInt64, Default: 0},
 		{Name: "updated_at", Type: field.TypeTime},
 		{Name: "created_at", Type: field.TypeTime},
@@ -89,6 +96,89 @@ var (
 			},
 		},
 	}
+	// ChatMessagesColumns holds the columns for the "chat_messages" table.
+	ChatMessagesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "ext_id", Type: field.TypeString, Nullable: true},
+		{Name: "content_offset_seconds", Type: field.TypeFloat64},
+		{Name: "commenter_id", Type: field.TypeString, Nullable: true},
+		{Name: "commenter_name", Type: field.TypeString, Nullable: true},
+		{Name: "commenter_display_name", Type: field.TypeString, Nullable: true},
+		{Name: "body", Type: field.TypeString, Size: 2147483647},
+		{Name: "emotes", Type: field.TypeJSON, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "vod_id", Type: field.TypeUUID},
+	}
+	// ChatMessagesTable holds the schema information for the "chat_messages" table.
+	ChatMessagesTable = &schema.Table{
+		Name:       "chat_messages",
+		Columns:    ChatMessagesColumns,
+		PrimaryKey: []*schema.Column{ChatMessagesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "chat_messages_vods_chat_messages",
+				Columns:    []*schema.Column{ChatMessagesColumns[9]},
+				RefColumns: []*schema.Column{VodsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "chatmessage_vod_id_content_offset_seconds",
+				Unique:  false,
+				Columns: []*schema.Column{ChatMessagesColumns[9], ChatMessagesColumns[2]},
+			},
+			{
+				Name:    "chatmessage_commenter_name",
+				Unique:  false,
+				Columns: []*schema.Column{ChatMessagesColumns[4]},
+			},
+			{
+				Name:    "chatmessage_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{ChatMessagesColumns[8]},
+			},
+		},
+	}
+	// ChecksumsColumns holds the columns for the "checksums" table.
+	ChecksumsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "file", Type: field.TypeEnum, Enums: []string{"video", "chat", "chat_video", "live_chat", "live_chat_convert", "info", "thumbnail", "web_thumbnail"}},
+		{Name: "name", Type: field.TypeString, Default: ""},
+		{Name: "sha256", Type: field.TypeString},
+		{Name: "size_bytes", Type: field.TypeInt64},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"ok", "mismatch", "missing"}, Default: "ok"},
+		{Name: "verified_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "vod_id", Type: field.TypeUUID},
+	}
+	// ChecksumsTable holds the schema information for the "checksums" table.
+	ChecksumsTable = &schema.Table{
+		Name:       "checksums",
+		Columns:    ChecksumsColumns,
+		PrimaryKey: []*schema.Column{ChecksumsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "checksums_vods_checksums",
+				Columns:    []*schema.Column{ChecksumsColumns[9]},
+				RefColumns: []*schema.Column{VodsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "checksum_vod_id_file_name",
+				Unique:  true,
+				Columns: []*schema.Column{ChecksumsColumns[9], ChecksumsColumns[1], ChecksumsColumns[2]},
+			},
+			{
+				Name:    "checksum_verified_at",
+				Unique:  false,
+				Columns: []*schema.Column{ChecksumsColumns[6]},
+			},
+		},
+	}
 	// LivesColumns holds the columns for the "lives" table.
 	LivesColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUUID},
@@ -101,9 +191,14 @@ var (
 		{Name: "is_live", Type: field.TypeBool, Default: false},
 		{Name: "archive_chat", Type: field.TypeBool, Default: true},
 		{Name: "resolution", Type: field.TypeString, Nullable: true, Default: "best"},
+		{Name: "quality_fallbacks", Type: field.TypeJSON, Nullable: true},
+		{Name: "record_audio_fallback", Type: field.TypeBool, Default: false},
+		{Name: "split_hours", Type: field.TypeInt, Default: 0},
+		{Name: "split_on_category_change", Type: field.TypeBool, Default: false},
 		{Name: "vod_resolution", Type: field.TypeString, Nullable: true, Default: "best"},
 		{Name: "last_live", Type: field.TypeTime},
 		{Name: "render_chat", Type: field.TypeBool, Default: true},
+		{Name: "generate_captions", Type: field.TypeBool, Default: false},
 		{Name: "video_age", Type: field.TypeInt64, Default: 0},
 		{Name: "apply_categories_to_live", Type: field.TypeBool, Default: false},
 		{Name: "strict_categories_live", Type: field.TypeBool, Default: false},
@@ -117,6 +212,7 @@ var (
 		{Name: "updated_at", Type: field.TypeTime},
 		{Name: "created_at", Type: field.TypeTime},
 		{Name: "channel_live", Type: field.TypeUUID},
+		{Name: "transcoding_profile_id", Type: field.TypeUUID, Nullable: true},
 	}
 	// LivesTable holds the schema information for the "lives" table.
 	LivesTable = &schema.Table{
@@ -126,10 +222,16 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "lives_channels_live",
-				Columns:    []*schema.Column{LivesColumns[25]},
+				Columns:    []*schema.Column{LivesColumns[30]},
 				RefColumns: []*schema.Column{ChannelsColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
+			{
+				Symbol:     "lives_transcoding_profiles_transcoding_profile",
+				Columns:    []*schema.Column{LivesColumns[31]},
+				RefColumns: []*schema.Column{TranscodingProfilesColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
 		},
 	}
 	// LiveCategoriesColumns holds the columns for the "live_categories" table.
@@ -152,6 +254,62 @@ var (
 			},
 		},
 	}
+	// LiveGapsColumns holds the columns for the "live_gaps" table.
+	LiveGapsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "reason", Type: field.TypeEnum, Enums: []string{"disconnected", "offline"}},
+		{Name: "started_at", Type: field.TypeTime},
+		{Name: "ended_at", Type: field.TypeTime},
+		{Name: "offset", Type: field.TypeFloat64},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "vod_id", Type: field.TypeUUID},
+	}
+	// LiveGapsTable holds the schema information for the "live_gaps" table.
+	LiveGapsTable = &schema.Table{
+		Name:       "live_gaps",
+		Columns:    LiveGapsColumns,
+		PrimaryKey: []*schema.Column{LiveGapsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "live_gaps_vods_live_gaps",
+				Columns:    []*schema.Column{LiveGapsColumns[6]},
+				RefColumns: []*schema.Column{VodsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "livegap_vod_id_offset",
+				Unique:  false,
+				Columns: []*schema.Column{LiveGapsColumns[6], LiveGapsColumns[4]},
+			},
+		},
+	}
+	// LiveSchedulesColumns holds the columns for the "live_schedules" table.
+	LiveSchedulesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "timezone", Type: field.TypeString, Default: "UTC"},
+		{Name: "windows", Type: field.TypeJSON, Nullable: true},
+		{Name: "max_stream_hours", Type: field.TypeInt, Default: 0},
+		{Name: "first_stream_of_day", Type: field.TypeBool, Default: false},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "live_id", Type: field.TypeUUID, Unique: true},
+	}
+	// LiveSchedulesTable holds the schema information for the "live_schedules" table.
+	LiveSchedulesTable = &schema.Table{
+		Name:       "live_schedules",
+		Columns:    LiveSchedulesColumns,
+		PrimaryKey: []*schema.Column{LiveSchedulesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "live_schedules_lives_schedule",
+				Columns:    []*schema.Column{LiveSchedulesColumns[7]},
+				RefColumns: []*schema.Column{LivesColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+	}
 	// LiveTitleRegexesColumns holds the columns for the "live_title_regexes" table.
 	LiveTitleRegexesColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUUID},
@@ -206,6 +364,7 @@ var (
 		{Name: "id", Type: field.TypeUUID},
 		{Name: "start", Type: field.TypeInt},
 		{Name: "end", Type: field.TypeInt},
+		{Name: "recovered", Type: field.TypeBool, Default: false},
 		{Name: "vod_muted_segments", Type: field.TypeUUID},
 	}
 	// MutedSegmentsTable holds the schema information for the "muted_segments" table.
@@ -216,7 +375,7 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "muted_segments_vods_muted_segments",
-				Columns:    []*schema.Column{MutedSegmentsColumns[3]},
+				Columns:    []*schema.Column{MutedSegmentsColumns[4]},
 				RefColumns: []*schema.Column{VodsColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
@@ -233,10 +392,14 @@ var (
 		{Name: "trigger_live_success", Type: field.TypeBool, Default: false},
 		{Name: "trigger_error", Type: field.TypeBool, Default: false},
 		{Name: "trigger_is_live", Type: field.TypeBool, Default: false},
+		{Name: "trigger_low_disk_space", Type: field.TypeBool, Default: false},
+		{Name: "trigger_checksum_mismatch", Type: field.TypeBool, Default: false},
 		{Name: "video_success_template", Type: field.TypeString, Size: 4096, Default: "✅ Video Archived: {{vod_title}} by {{channel_display_name}}."},
 		{Name: "live_success_template", Type: field.TypeString, Size: 4096, Default: "✅ Live Stream Archived: {{vod_title}} by {{channel_display_name}}."},
 		{Name: "error_template", Type: field.TypeString, Size: 4096, Default: "⚠️ Error: Queue {{queue_id}} failed at task {{failed_task}}."},
 		{Name: "is_live_template", Type: field.TypeString, Size: 4096, Default: "🔴 {{channel_display_name}} is live!"},
+		{Name: "low_disk_space_template", Type: field.TypeString, Size: 4096, Default: "💾 Low Disk Space: {{directory_path}} has {{free_space}} free, below the minimum of {{min_free_space}}. New archives are paused."},
+		{Name: "checksum_mismatch_template", Type: field.TypeString, Size: 4096, Default: "🧬 Checksum Mismatch: {{checksum_files}} of {{vod_title}} by {{channel_display_name}} changed or went missing."},
 		{Name: "apprise_urls", Type: field.TypeString, Nullable: true, Size: 4096, Default: ""},
 		{Name: "apprise_title", Type: field.TypeString, Nullable: true, Size: 4096, Default: ""},
 		{Name: "apprise_type", Type: field.TypeEnum, Enums: []string{"info", "success", "warning", "failure"}, Default: "info"},
@@ -273,6 +436,7 @@ var (
 		{Name: "name", Type: field.TypeString, Unique: true},
 		{Name: "description", Type: field.TypeString, Nullable: true},
 		{Name: "thumbnail_path", Type: field.TypeString, Nullable: true},
+		{Name: "ext_stream_id", Type: field.TypeString, Nullable: true},
 		{Name: "updated_at", Type: field.TypeTime},
 		{Name: "created_at", Type: field.TypeTime},
 	}
@@ -389,6 +553,21 @@ var (
 			},
 		},
 	}
+	// TranscodingProfilesColumns holds the columns for the "transcoding_profiles" table.
+	TranscodingProfilesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "name", Type: field.TypeString, Unique: true, Size: 255},
+		{Name: "description", Type: field.TypeString, Nullable: true, Size: 1024, Default: ""},
+		{Name: "ffmpeg_args", Type: field.TypeString, Size: 4096, Default: ""},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "created_at", Type: field.TypeTime},
+	}
+	// TranscodingProfilesTable holds the schema information for the "transcoding_profiles" table.
+	TranscodingProfilesTable = &schema.Table{
+		Name:       "transcoding_profiles",
+		Columns:    TranscodingProfilesColumns,
+		PrimaryKey: []*schema.Column{TranscodingProfilesColumns[0]},
+	}
 	// TwitchCategoriesColumns holds the columns for the "twitch_categories" table.
 	TwitchCategoriesColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeString},
@@ -422,19 +601,153 @@ var (
 		Columns:    UsersColumns,
 		PrimaryKey: []*schema.Column{UsersColumns[0]},
 	}
+	// UserListsColumns holds the columns for the "user_lists" table.
+	UserListsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "type", Type: field.TypeEnum, Enums: []string{"watch_later", "favorites", "custom"}, Default: "custom"},
+		{Name: "name", Type: field.TypeString},
+		{Name: "share_token", Type: field.TypeString, Unique: true, Nullable: true},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "user_id", Type: field.TypeUUID},
+	}
+	// UserListsTable holds the schema information for the "user_lists" table.
+	UserListsTable = &schema.Table{
+		Name:       "user_lists",
+		Columns:    UserListsColumns,
+		PrimaryKey: []*schema.Column{UserListsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "user_lists_users_lists",
+				Columns:    []*schema.Column{UserListsColumns[6]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "userlist_user_id_name",
+				Unique:  true,
+				Columns: []*schema.Column{UserListsColumns[6], UserListsColumns[2]},
+			},
+			{
+				Name:    "userlist_user_id_type",
+				Unique:  true,
+				Columns: []*schema.Column{UserListsColumns[6], UserListsColumns[1]},
+				Annotation: &entsql.IndexAnnotation{
+					Where: "type <> 'custom'",
+				},
+			},
+		},
+	}
+	// UserListItemsColumns holds the columns for the "user_list_items" table.
+	UserListItemsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "position", Type: field.TypeInt, Default: 0},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "list_id", Type: field.TypeUUID},
+		{Name: "vod_id", Type: field.TypeUUID},
+	}
+	// UserListItemsTable holds the schema information for the "user_list_items" table.
+	UserListItemsTable = &schema.Table{
+		Name:       "user_list_items",
+		Columns:    UserListItemsColumns,
+		PrimaryKey: []*schema.Column{UserListItemsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "user_list_items_user_lists_items",
+				Columns:    []*schema.Column{UserListItemsColumns[3]},
+				RefColumns: []*schema.Column{UserListsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "user_list_items_vods_user_list_items",
+				Columns:    []*schema.Column{UserListItemsColumns[4]},
+				RefColumns: []*schema.Column{VodsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "userlistitem_list_id_vod_id",
+				Unique:  true,
+				Columns: []*schema.Column{UserListItemsColumns[3], UserListItemsColumns[4]},
+			},
+			{
+				Name:    "userlistitem_vod_id",
+				Unique:  false,
+				Columns: []*schema.Column{UserListItemsColumns[4]},
+			},
+		},
+	}
+	// ViewingSessionsColumns holds the columns for the "viewing_sessions" table.
+	ViewingSessionsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "device", Type: field.TypeString, Default: ""},
+		{Name: "ranges", Type: field.TypeJSON},
+		{Name: "watched_seconds", Type: field.TypeFloat64, Default: 0},
+		{Name: "started_at", Type: field.TypeTime},
+		{Name: "ended_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "user_id", Type: field.TypeUUID},
+		{Name: "vod_id", Type: field.TypeUUID},
+	}
+	// ViewingSessionsTable holds the schema information for the "viewing_sessions" table.
+	ViewingSessionsTable = &schema.Table{
+		Name:       "viewing_sessions",
+		Columns:    ViewingSessionsColumns,
+		PrimaryKey: []*schema.Column{ViewingSessionsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "viewing_sessions_users_viewing_sessions",
+				Columns:    []*schema.Column{ViewingSessionsColumns[8]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "viewing_sessions_vods_viewing_sessions",
+				Columns:    []*schema.Column{ViewingSessionsColumns[9]},
+				RefColumns: []*schema.Column{VodsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "viewingsession_user_id_started_at",
+				Unique:  false,
+				Columns: []*schema.Column{ViewingSessionsColumns[8], ViewingSessionsColumns[4]},
+			},
+			{
+				Name:    "viewingsession_vod_id",
+				Unique:  false,
+				Columns: []*schema.Column{ViewingSessionsColumns[9]},
+			},
+			{
+				Name:    "viewingsession_started_at",
+				Unique:  false,
+				Columns: []*schema.Column{ViewingSessionsColumns[4]},
+			},
+		},
+	}
 	// VodsColumns holds the columns for the "vods" table.
 	VodsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUUID},
 		{Name: "ext_id", Type: field.TypeString},
 		{Name: "clip_ext_vod_id", Type: field.TypeString, Nullable: true},
 		{Name: "ext_stream_id", Type: field.TypeString, Nullable: true},
-		{Name: "platform", Type: field.TypeEnum, Enums: []string{"twitch", "youtube"}, Default: "twitch"},
+		{Name: "part", Type: field.TypeInt, Default: 0},
+		{Name: "platform", Type: field.TypeEnum, Enums: []string{"twitch", "youtube", "kick"}, Default: "twitch"},
 		{Name: "type", Type: field.TypeEnum, Enums: []string{"archive", "live", "highlight", "upload", "clip"}, Default: "archive"},
 		{Name: "title", Type: field.TypeString},
+		{Name: "category", Type: field.TypeString, Nullable: true},
 		{Name: "duration", Type: field.TypeInt, Default: 1},
 		{Name: "clip_vod_offset", Type: field.TypeInt, Nullable: true},
+		{Name: "clip_vod_id", Type: field.TypeUUID, Nullable: true},
 		{Name: "views", Type: field.TypeInt, Default: 1},
 		{Name: "resolution", Type: field.TypeString, Nullable: true},
+		{Name: "quality_fallbacks", Type: field.TypeJSON, Nullable: true},
+		{Name: "quality_switches", Type: field.TypeJSON, Nullable: true},
 		{Name: "processing", Type: field.TypeBool, Default: false},
 		{Name: "thumbnail_path", Type: field.TypeString, Nullable: true},
 		{Name: "web_thumbnail_path", Type: field.TypeString},
@@ -446,6 +759,7 @@ var (
 		{Name: "chat_video_path", Type: field.TypeString, Nullable: true},
 		{Name: "info_path", Type: field.TypeString, Nullable: true},
 		{Name: "caption_path", Type: field.TypeString, Nullable: true},
+		{Name: "audio_path", Type: field.TypeString, Nullable: true},
 		{Name: "folder_name", Type: field.TypeString, Nullable: true},
 		{Name: "file_name", Type: field.TypeString, Nullable: true},
 		{Name: "tmp_video_download_path", Type: field.TypeString, Nullable: true},
@@ -455,6 +769,7 @@ var (
 		{Name: "tmp_live_chat_convert_path", Type: field.TypeString, Nullable: true},
 		{Name: "tmp_chat_render_path", Type: field.TypeString, Nullable: true},
 		{Name: "tmp_video_hls_path", Type: field.TypeString, Nullable: true},
+		{Name: "tmp_audio_path", Type: field.TypeString, Nullable: true},
 		{Name: "locked", Type: field.TypeBool, Default: false},
 		{Name: "local_views", Type: field.TypeInt, Default: 0},
 		{Name: "sprite_thumbnails_enabled", Type: field.TypeBool, Default: false},
@@ -465,10 +780,18 @@ var (
 		{Name: "sprite_thumbnails_rows", Type: field.TypeInt, Nullable: true},
 		{Name: "sprite_thumbnails_columns", Type: field.TypeInt, Nullable: true},
 		{Name: "storage_size_bytes", Type: field.TypeInt64, Default: 0},
+		{Name: "storage_tier", Type: field.TypeEnum, Enums: []string{"hot", "cold"}, Default: "hot"},
+		{Name: "health_status", Type: field.TypeEnum, Enums: []string{"unknown", "healthy", "degraded", "broken"}, Default: "unknown"},
+		{Name: "health_issues", Type: field.TypeJSON, Nullable: true},
+		{Name: "health_checked_at", Type: field.TypeTime, Nullable: true},
+		{Name: "audio_source_id", Type: field.TypeUUID, Nullable: true},
+		{Name: "audio_source_offset", Type: field.TypeFloat64, Nullable: true},
+		{Name: "authoritative", Type: field.TypeBool, Default: true},
 		{Name: "streamed_at", Type: field.TypeTime},
 		{Name: "updated_at", Type: field.TypeTime},
 		{Name: "created_at", Type: field.TypeTime},
 		{Name: "channel_vods", Type: field.TypeUUID},
+		{Name: "transcoding_profile_id", Type: field.TypeUUID, Nullable: true},
 	}
 	// VodsTable holds the schema information for the "vods" table.
 	VodsTable = &schema.Table{
@@ -478,10 +801,16 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "vods_channels_vods",
-				Columns:    []*schema.Column{VodsColumns[44]},
+				Columns:    []*schema.Column{VodsColumns[58]},
 				RefColumns: []*schema.Column{ChannelsColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
+			{
+				Symbol:     "vods_transcoding_profiles_transcoding_profile",
+				Columns:    []*schema.Column{VodsColumns[59]},
+				RefColumns: []*schema.Column{TranscodingProfilesColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
 		},
 	}
 	// PlaylistVodsColumns holds the columns for the "playlist_vods" table.
@@ -515,8 +844,12 @@ var (
 		BlockedVideosTable,
 		ChannelsTable,
 		ChaptersTable,
+		ChatMessagesTable,
+		ChecksumsTable,
 		LivesTable,
 		LiveCategoriesTable,
+		LiveGapsTable,
+		LiveSchedulesTable,
 		LiveTitleRegexesTable,
 		MultistreamInfosTable,
 		MutedSegmentsTable,
@@ -527,8 +860,12 @@ var (
 		PlaylistRuleGroupsTable,
 		QueuesTable,
 		SessionsTable,
+		TranscodingProfilesTable,
 		TwitchCategoriesTable,
 		UsersTable,
+		UserListsTable,
+		UserListItemsTable,
+		ViewingSessionsTable,
 		VodsTable,
 		PlaylistVodsTable,
 	}
@@ -537,8 +874,13 @@ var (
 func init() {
 	APIKeysTable.ForeignKeys[0].RefTable = UsersTable
 	ChaptersTable.ForeignKeys[0].RefTable = VodsTable
+	ChatMessagesTable.ForeignKeys[0].RefTable = VodsTable
+	ChecksumsTable.ForeignKeys[0].RefTable = VodsTable
 	LivesTable.ForeignKeys[0].RefTable = ChannelsTable
+	LivesTable.ForeignKeys[1].RefTable = TranscodingProfilesTable
 	LiveCategoriesTable.ForeignKeys[0].RefTable = LivesTable
+	LiveGapsTable.ForeignKeys[0].RefTable = VodsTable
+	LiveSchedulesTable.ForeignKeys[0].RefTable = LivesTable
 	LiveTitleRegexesTable.ForeignKeys[0].RefTable = LivesTable
 	MultistreamInfosTable.ForeignKeys[0].RefTable = VodsTable
 	MultistreamInfosTable.ForeignKeys[1].RefTable = PlaylistsTable
@@ -546,7 +888,13 @@ func init() {
 	PlaylistRulesTable.ForeignKeys[0].RefTable = PlaylistRuleGroupsTable
 	PlaylistRuleGroupsTable.ForeignKeys[0].RefTable = PlaylistsTable
 	QueuesTable.ForeignKeys[0].RefTable = VodsTable
+	UserListsTable.ForeignKeys[0].RefTable = UsersTable
+	UserListItemsTable.ForeignKeys[0].RefTable = UserListsTable
+	UserListItemsTable.ForeignKeys[1].RefTable = VodsTable
+	ViewingSessionsTable.ForeignKeys[0].RefTable = UsersTable
+	ViewingSessionsTable.ForeignKeys[1].RefTable = VodsTable
 	VodsTable.ForeignKeys[0].RefTable = ChannelsTable
+	VodsTable.ForeignKeys[1].RefTable = TranscodingProfilesTable
 	PlaylistVodsTable.ForeignKeys[0].RefTable = PlaylistsTable
 	PlaylistVodsTable.ForeignKeys[1].RefTable = VodsTable
 }
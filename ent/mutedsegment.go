@@ -22,6 +22,8 @@ type MutedSegment struct {
 	Start int `json:"start,omitempty"`
 	// The end time of the muted segment
 	End int `json:"end,omitempty"`
+	// Whether the audio of the muted segment was replaced with the audio of the live archive
+	Recovered bool `json:"recovered,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the MutedSegmentQuery when eager-loading is set.
 	Edges              MutedSegmentEdges `json:"edges"`
@@ -54,6 +56,8 @@ func (*MutedSegment) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
+		case mutedsegment.FieldRecovered:
+			values[i] = new(sql.NullBool)
 		case mutedsegment.FieldStart, mutedsegment.FieldEnd:
 			values[i] = new(sql.NullInt64)
 		case mutedsegment.FieldID:
@@ -93,6 +97,12 @@ func (_m *MutedSegment) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.End = int(value.Int64)
 			}
+		case mutedsegment.FieldRecovered:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field recovered", values[i])
+			} else if value.Valid {
+				_m.Recovered = value.Bool
+			}
 		case mutedsegment.ForeignKeys[0]:
 			if value, ok := values[i].(*sql.NullScanner); !ok {
 				return fmt.Errorf("unexpected type %T for field vod_muted_segments", values[i])
@@ -146,6 +156,9 @@ func (_m *MutedSegment) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("end=")
 	builder.WriteString(fmt.Sprintf("%v", _m.End))
+	builder.WriteString(", ")
+	builder.WriteString("recovered=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Recovered))
 	builder.WriteByte(')')
 	return builder.String()
 }
@@ -17,6 +17,8 @@ const (
 	FieldStart = "start"
 	// FieldEnd holds the string denoting the end field in the database.
 	FieldEnd = "end"
+	// FieldRecovered holds the string denoting the recovered field in the database.
+	FieldRecovered = "recovered"
 	// EdgeVod holds the string denoting the vod edge name in mutations.
 	EdgeVod = "vod"
 	// Table holds the table name of the mutedsegment in the database.
@@ -35,6 +37,7 @@ var Columns = []string{
 	FieldID,
 	FieldStart,
 	FieldEnd,
+	FieldRecovered,
 }
 
 // ForeignKeys holds the SQL foreign-keys that are owned by the "muted_segments"
@@ -59,6 +62,8 @@ func ValidColumn(column string) bool {
 }
 
 var (
+	// DefaultRecovered holds the default value on creation for the "recovered" field.
+	DefaultRecovered bool
 	// DefaultID holds the default value on creation for the "id" field.
 	DefaultID func() uuid.UUID
 )
@@ -81,6 +86,11 @@ func ByEnd(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldEnd, opts...).ToFunc()
 }
 
+// ByRecovered orders the results by the recovered field.
+func ByRecovered(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRecovered, opts...).ToFunc()
+}
+
 // ByVodField orders the results by vod field.
 func ByVodField(field string, opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
@@ -64,6 +64,11 @@ func End(v int) predicate.MutedSegment {
 	return predicate.MutedSegment(sql.FieldEQ(FieldEnd, v))
 }
 
+// Recovered applies equality check predicate on the "recovered" field. It's identical to RecoveredEQ.
+func Recovered(v bool) predicate.MutedSegment {
+	return predicate.MutedSegment(sql.FieldEQ(FieldRecovered, v))
+}
+
 // StartEQ applies the EQ predicate on the "start" field.
 func StartEQ(v int) predicate.MutedSegment {
 	return predicate.MutedSegment(sql.FieldEQ(FieldStart, v))
@@ -144,6 +149,16 @@ func EndLTE(v int) predicate.MutedSegment {
 	return predicate.MutedSegment(sql.FieldLTE(FieldEnd, v))
 }
 
+// RecoveredEQ applies the EQ predicate on the "recovered" field.
+func RecoveredEQ(v bool) predicate.MutedSegment {
+	return predicate.MutedSegment(sql.FieldEQ(FieldRecovered, v))
+}
+
+// RecoveredNEQ applies the NEQ predicate on the "recovered" field.
+func RecoveredNEQ(v bool) predicate.MutedSegment {
+	return predicate.MutedSegment(sql.FieldNEQ(FieldRecovered, v))
+}
+
 // HasVod applies the HasEdge predicate on the "vod" edge.
 func HasVod() predicate.MutedSegment {
 	return predicate.MutedSegment(func(s *sql.Selector) {
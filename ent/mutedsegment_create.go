@@ -36,6 +36,20 @@ func (_c *MutedSegmentCreate) SetEnd(v int) *MutedSegmentCreate {
 	return _c
 }
 
+// SetRecovered sets the "recovered" field.
+func (_c *MutedSegmentCreate) SetRecovered(v bool) *MutedSegmentCreate {
+	_c.mutation.SetRecovered(v)
+	return _c
+}
+
+// SetNillableRecovered sets the "recovered" field if the given value is not nil.
+func (_c *MutedSegmentCreate) SetNillableRecovered(v *bool) *MutedSegmentCreate {
+	if v != nil {
+		_c.SetRecovered(*v)
+	}
+	return _c
+}
+
 // SetID sets the "id" field.
 func (_c *MutedSegmentCreate) SetID(v uuid.UUID) *MutedSegmentCreate {
 	_c.mutation.SetID(v)
@@ -96,6 +110,10 @@ func (_c *MutedSegmentCreate) ExecX(ctx context.Context) {
 
 // defaults sets the default values of the builder before save.
 func (_c *MutedSegmentCreate) defaults() {
+	if _, ok := _c.mutation.Recovered(); !ok {
+		v := mutedsegment.DefaultRecovered
+		_c.mutation.SetRecovered(v)
+	}
 	if _, ok := _c.mutation.ID(); !ok {
 		v := mutedsegment.DefaultID()
 		_c.mutation.SetID(v)
@@ -110,6 +128,9 @@ func (_c *MutedSegmentCreate) check() error {
 	if _, ok := _c.mutation.End(); !ok {
 		return &ValidationError{Name: "end", err: errors.New(`ent: missing required field "MutedSegment.end"`)}
 	}
+	if _, ok := _c.mutation.Recovered(); !ok {
+		return &ValidationError{Name: "recovered", err: errors.New(`ent: missing required field "MutedSegment.recovered"`)}
+	}
 	if len(_c.mutation.VodIDs()) == 0 {
 		return &ValidationError{Name: "vod", err: errors.New(`ent: missing required edge "MutedSegment.vod"`)}
 	}
@@ -157,6 +178,10 @@ func (_c *MutedSegmentCreate) createSpec() (*MutedSegment, *sqlgraph.CreateSpec)
 		_spec.SetField(mutedsegment.FieldEnd, field.TypeInt, value)
 		_node.End = value
 	}
+	if value, ok := _c.mutation.Recovered(); ok {
+		_spec.SetField(mutedsegment.FieldRecovered, field.TypeBool, value)
+		_node.Recovered = value
+	}
 	if nodes := _c.mutation.VodIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -262,6 +287,18 @@ func (u *MutedSegmentUpsert) AddEnd(v int) *MutedSegmentUpsert {
 	return u
 }
 
+// SetRecovered sets the "recovered" field.
+func (u *MutedSegmentUpsert) SetRecovered(v bool) *MutedSegmentUpsert {
+	u.Set(mutedsegment.FieldRecovered, v)
+	return u
+}
+
+// UpdateRecovered sets the "recovered" field to the value that was provided on create.
+func (u *MutedSegmentUpsert) UpdateRecovered() *MutedSegmentUpsert {
+	u.SetExcluded(mutedsegment.FieldRecovered)
+	return u
+}
+
 // UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
 // Using this option is equivalent to using:
 //
@@ -352,6 +389,20 @@ func (u *MutedSegmentUpsertOne) UpdateEnd() *MutedSegmentUpsertOne {
 	})
 }
 
+// SetRecovered sets the "recovered" field.
+func (u *MutedSegmentUpsertOne) SetRecovered(v bool) *MutedSegmentUpsertOne {
+	return u.Update(func(s *MutedSegmentUpsert) {
+		s.SetRecovered(v)
+	})
+}
+
+// UpdateRecovered sets the "recovered" field to the value that was provided on create.
+func (u *MutedSegmentUpsertOne) UpdateRecovered() *MutedSegmentUpsertOne {
+	return u.Update(func(s *MutedSegmentUpsert) {
+		s.UpdateRecovered()
+	})
+}
+
 // Exec executes the query.
 func (u *MutedSegmentUpsertOne) Exec(ctx context.Context) error {
 	if len(u.create.conflict) == 0 {
@@ -609,6 +660,20 @@ func (u *MutedSegmentUpsertBulk) UpdateEnd() *MutedSegmentUpsertBulk {
 	})
 }
 
+// SetRecovered sets the "recovered" field.
+func (u *MutedSegmentUpsertBulk) SetRecovered(v bool) *MutedSegmentUpsertBulk {
+	return u.Update(func(s *MutedSegmentUpsert) {
+		s.SetRecovered(v)
+	})
+}
+
+// UpdateRecovered sets the "recovered" field to the value that was provided on create.
+func (u *MutedSegmentUpsertBulk) UpdateRecovered() *MutedSegmentUpsertBulk {
+	return u.Update(func(s *MutedSegmentUpsert) {
+		s.UpdateRecovered()
+	})
+}
+
 // Exec executes the query.
 func (u *MutedSegmentUpsertBulk) Exec(ctx context.Context) error {
 	if u.create.err != nil {
@@ -71,6 +71,20 @@ func (_u *MutedSegmentUpdate) AddEnd(v int) *MutedSegmentUpdate {
 	return _u
 }
 
+// SetRecovered sets the "recovered" field.
+func (_u *MutedSegmentUpdate) SetRecovered(v bool) *MutedSegmentUpdate {
+	_u.mutation.SetRecovered(v)
+	return _u
+}
+
+// SetNillableRecovered sets the "recovered" field if the given value is not nil.
+func (_u *MutedSegmentUpdate) SetNillableRecovered(v *bool) *MutedSegmentUpdate {
+	if v != nil {
+		_u.SetRecovered(*v)
+	}
+	return _u
+}
+
 // SetVodID sets the "vod" edge to the Vod entity by ID.
 func (_u *MutedSegmentUpdate) SetVodID(id uuid.UUID) *MutedSegmentUpdate {
 	_u.mutation.SetVodID(id)
@@ -152,6 +166,9 @@ func (_u *MutedSegmentUpdate) sqlSave(ctx context.Context) (_node int, err error
 	if value, ok := _u.mutation.AddedEnd(); ok {
 		_spec.AddField(mutedsegment.FieldEnd, field.TypeInt, value)
 	}
+	if value, ok := _u.mutation.Recovered(); ok {
+		_spec.SetField(mutedsegment.FieldRecovered, field.TypeBool, value)
+	}
 	if _u.mutation.VodCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -243,6 +260,20 @@ func (_u *MutedSegmentUpdateOne) AddEnd(v int) *MutedSegmentUpdateOne {
 	return _u
 }
 
+// SetRecovered sets the "recovered" field.
+func (_u *MutedSegmentUpdateOne) SetRecovered(v bool) *MutedSegmentUpdateOne {
+	_u.mutation.SetRecovered(v)
+	return _u
+}
+
+// SetNillableRecovered sets the "recovered" field if the given value is not nil.
+func (_u *MutedSegmentUpdateOne) SetNillableRecovered(v *bool) *MutedSegmentUpdateOne {
+	if v != nil {
+		_u.SetRecovered(*v)
+	}
+	return _u
+}
+
 // SetVodID sets the "vod" edge to the Vod entity by ID.
 func (_u *MutedSegmentUpdateOne) SetVodID(id uuid.UUID) *MutedSegmentUpdateOne {
 	_u.mutation.SetVodID(id)
@@ -354,6 +385,9 @@ func (_u *MutedSegmentUpdateOne) sqlSave(ctx context.Context) (_node *MutedSegme
 	if value, ok := _u.mutation.AddedEnd(); ok {
 		_spec.AddField(mutedsegment.FieldEnd, field.TypeInt, value)
 	}
+	if value, ok := _u.mutation.Recovered(); ok {
+		_spec.SetField(mutedsegment.FieldRecovered, field.TypeBool, value)
+	}
 	if _u.mutation.VodCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
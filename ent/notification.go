@@ -34,6 +34,10 @@ type Notification struct {
 	TriggerError bool `json:"trigger_error,omitempty"`
 	// Fire when a channel goes live.
 	TriggerIsLive bool `json:"trigger_is_live,omitempty"`
+	// Fire when archiving is paused because of low disk space.
+	TriggerLowDiskSpace bool `json:"trigger_low_disk_space,omitempty"`
+	// Fire when archived files no longer match their checksums.
+	TriggerChecksumMismatch bool `json:"trigger_checksum_mismatch,omitempty"`
 	// Template for video archive success body.
 	VideoSuccessTemplate string `json:"video_success_template,omitempty"`
 	// Template for live archive success body.
@@ -42,6 +46,10 @@ type Notification struct {
 	ErrorTemplate string `json:"error_template,omitempty"`
 	// Template for is-live body.
 	IsLiveTemplate string `json:"is_live_template,omitempty"`
+	// Template for low disk space body.
+	LowDiskSpaceTemplate string `json:"low_disk_space_template,omitempty"`
+	// Template for checksum mismatch body.
+	ChecksumMismatchTemplate string `json:"checksum_mismatch_template,omitempty"`
 	// Stateless Apprise URLs parameter.
 	AppriseUrls string `json:"apprise_urls,omitempty"`
 	// Apprise notification title template.
@@ -64,9 +72,9 @@ func (*Notification) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case notification.FieldEnabled, notification.FieldTriggerVideoSuccess, notification.FieldTriggerLiveSuccess, notification.FieldTriggerError, notification.FieldTriggerIsLive:
+		case notification.FieldEnabled, notification.FieldTriggerVideoSuccess, notification.FieldTriggerLiveSuccess, notification.FieldTriggerError, notification.FieldTriggerIsLive, notification.FieldTriggerLowDiskSpace, notification.FieldTriggerChecksumMismatch:
 			values[i] = new(sql.NullBool)
-		case notification.FieldName, notification.FieldType, notification.FieldURL, notification.FieldVideoSuccessTemplate, notification.FieldLiveSuccessTemplate, notification.FieldErrorTemplate, notification.FieldIsLiveTemplate, notification.FieldAppriseUrls, notification.FieldAppriseTitle, notification.FieldAppriseType, notification.FieldAppriseTag, notification.FieldAppriseFormat:
+		case notification.FieldName, notification.FieldType, notification.FieldURL, notification.FieldVideoSuccessTemplate, notification.FieldLiveSuccessTemplate, notification.FieldErrorTemplate, notification.FieldIsLiveTemplate, notification.FieldLowDiskSpaceTemplate, notification.FieldChecksumMismatchTemplate, notification.FieldAppriseUrls, notification.FieldAppriseTitle, notification.FieldAppriseType, notification.FieldAppriseTag, notification.FieldAppriseFormat:
 			values[i] = new(sql.NullString)
 		case notification.FieldUpdatedAt, notification.FieldCreatedAt:
 			values[i] = new(sql.NullTime)
@@ -141,6 +149,18 @@ func (_m *Notification) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.TriggerIsLive = value.Bool
 			}
+		case notification.FieldTriggerLowDiskSpace:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field trigger_low_disk_space", values[i])
+			} else if value.Valid {
+				_m.TriggerLowDiskSpace = value.Bool
+			}
+		case notification.FieldTriggerChecksumMismatch:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field trigger_checksum_mismatch", values[i])
+			} else if value.Valid {
+				_m.TriggerChecksumMismatch = value.Bool
+			}
 		case notification.FieldVideoSuccessTemplate:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field video_success_template", values[i])
@@ -165,6 +185,18 @@ func (_m *Notification) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.IsLiveTemplate = value.String
 			}
+		case notification.FieldLowDiskSpaceTemplate:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field low_disk_space_template", values[i])
+			} else if value.Valid {
+				_m.LowDiskSpaceTemplate = value.String
+			}
+		case notification.FieldChecksumMismatchTemplate:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field checksum_mismatch_template", values[i])
+			} else if value.Valid {
+				_m.ChecksumMismatchTemplate = value.String
+			}
 		case notification.FieldAppriseUrls:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field apprise_urls", values[i])
@@ -267,6 +299,12 @@ func (_m *Notification) String() string {
 	builder.WriteString("trigger_is_live=")
 	builder.WriteString(fmt.Sprintf("%v", _m.TriggerIsLive))
 	builder.WriteString(", ")
+	builder.WriteString("trigger_low_disk_space=")
+	builder.WriteString(fmt.Sprintf("%v", _m.TriggerLowDiskSpace))
+	builder.WriteString(", ")
+	builder.WriteString("trigger_checksum_mismatch=")
+	builder.WriteString(fmt.Sprintf("%v", _m.TriggerChecksumMismatch))
+	builder.WriteString(", ")
 	builder.WriteString("video_success_template=")
 	builder.WriteString(_m.VideoSuccessTemplate)
 	builder.WriteString(", ")
@@ -279,6 +317,12 @@ func (_m *Notification) String() string {
 	builder.WriteString("is_live_template=")
 	builder.WriteString(_m.IsLiveTemplate)
 	builder.WriteString(", ")
+	builder.WriteString("low_disk_space_template=")
+	builder.WriteString(_m.LowDiskSpaceTemplate)
+	builder.WriteString(", ")
+	builder.WriteString("checksum_mismatch_template=")
+	builder.WriteString(_m.ChecksumMismatchTemplate)
+	builder.WriteString(", ")
 	builder.WriteString("apprise_urls=")
 	builder.WriteString(_m.AppriseUrls)
 	builder.WriteString(", ")
@@ -31,6 +31,10 @@ const (
 	FieldTriggerError = "trigger_error"
 	// FieldTriggerIsLive holds the string denoting the trigger_is_live field in the database.
 	FieldTriggerIsLive = "trigger_is_live"
+	// FieldTriggerLowDiskSpace holds the string denoting the trigger_low_disk_space field in the database.
+	FieldTriggerLowDiskSpace = "trigger_low_disk_space"
+	// FieldTriggerChecksumMismatch holds the string denoting the trigger_checksum_mismatch field in the database.
+	FieldTriggerChecksumMismatch = "trigger_checksum_mismatch"
 	// FieldVideoSuccessTemplate holds the string denoting the video_success_template field in the database.
 	FieldVideoSuccessTemplate = "video_success_template"
 	// FieldLiveSuccessTemplate holds the string denoting the live_success_template field in the database.
@@ -39,6 +43,10 @@ const (
 	FieldErrorTemplate = "error_template"
 	// FieldIsLiveTemplate holds the string denoting the is_live_template field in the database.
 	FieldIsLiveTemplate = "is_live_template"
+	// FieldLowDiskSpaceTemplate holds the string denoting the low_disk_space_template field in the database.
+	FieldLowDiskSpaceTemplate = "low_disk_space_template"
+	// FieldChecksumMismatchTemplate holds the string denoting the checksum_mismatch_template field in the database.
+	FieldChecksumMismatchTemplate = "checksum_mismatch_template"
 	// FieldAppriseUrls holds the string denoting the apprise_urls field in the database.
 	FieldAppriseUrls = "apprise_urls"
 	// FieldAppriseTitle holds the string denoting the apprise_title field in the database.
@@ -68,10 +76,14 @@ var Columns = []string{
 	FieldTriggerLiveSuccess,
 	FieldTriggerError,
 	FieldTriggerIsLive,
+	FieldTriggerLowDiskSpace,
+	FieldTriggerChecksumMismatch,
 	FieldVideoSuccessTemplate,
 	FieldLiveSuccessTemplate,
 	FieldErrorTemplate,
 	FieldIsLiveTemplate,
+	FieldLowDiskSpaceTemplate,
+	FieldChecksumMismatchTemplate,
 	FieldAppriseUrls,
 	FieldAppriseTitle,
 	FieldAppriseType,
@@ -106,6 +118,10 @@ var (
 	DefaultTriggerError bool
 	// DefaultTriggerIsLive holds the default value on creation for the "trigger_is_live" field.
 	DefaultTriggerIsLive bool
+	// DefaultTriggerLowDiskSpace holds the default value on creation for the "trigger_low_disk_space" field.
+	DefaultTriggerLowDiskSpace bool
+	// DefaultTriggerChecksumMismatch holds the default value on creation for the "trigger_checksum_mismatch" field.
+	DefaultTriggerChecksumMismatch bool
 	// DefaultVideoSuccessTemplate holds the default value on creation for the "video_success_template" field.
 	DefaultVideoSuccessTemplate string
 	// VideoSuccessTemplateValidator is a validator for the "video_success_template" field. It is called by the builders before save.
@@ -122,6 +138,14 @@ var (
 	DefaultIsLiveTemplate string
 	// IsLiveTemplateValidator is a validator for the "is_live_template" field. It is called by the builders before save.
 	IsLiveTemplateValidator func(string) error
+	// DefaultLowDiskSpaceTemplate holds the default value on creation for the "low_disk_space_template" field.
+	DefaultLowDiskSpaceTemplate string
+	// LowDiskSpaceTemplateValidator is a validator for the "low_disk_space_template" field. It is called by the builders before save.
+	LowDiskSpaceTemplateValidator func(string) error
+	// DefaultChecksumMismatchTemplate holds the default value on creation for the "checksum_mismatch_template" field.
+	DefaultChecksumMismatchTemplate string
+	// ChecksumMismatchTemplateValidator is a validator for the "checksum_mismatch_template" field. It is called by the builders before save.
+	ChecksumMismatchTemplateValidator func(string) error
 	// DefaultAppriseUrls holds the default value on creation for the "apprise_urls" field.
 	DefaultAppriseUrls string
 	// AppriseUrlsValidator is a validator for the "apprise_urls" field. It is called by the builders before save.
@@ -270,6 +294,16 @@ func ByTriggerIsLive(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldTriggerIsLive, opts...).ToFunc()
 }
 
+// ByTriggerLowDiskSpace orders the results by the trigger_low_disk_space field.
+func ByTriggerLowDiskSpace(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTriggerLowDiskSpace, opts...).ToFunc()
+}
+
+// ByTriggerChecksumMismatch orders the results by the trigger_checksum_mismatch field.
+func ByTriggerChecksumMismatch(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTriggerChecksumMismatch, opts...).ToFunc()
+}
+
 // ByVideoSuccessTemplate orders the results by the video_success_template field.
 func ByVideoSuccessTemplate(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldVideoSuccessTemplate, opts...).ToFunc()
@@ -290,6 +324,16 @@ func ByIsLiveTemplate(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldIsLiveTemplate, opts...).ToFunc()
 }
 
+// ByLowDiskSpaceTemplate orders the results by the low_disk_space_template field.
+func ByLowDiskSpaceTemplate(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLowDiskSpaceTemplate, opts...).ToFunc()
+}
+
+// ByChecksumMismatchTemplate orders the results by the checksum_mismatch_template field.
+func ByChecksumMismatchTemplate(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldChecksumMismatchTemplate, opts...).ToFunc()
+}
+
 // ByAppriseUrls orders the results by the apprise_urls field.
 func ByAppriseUrls(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldAppriseUrls, opts...).ToFunc()
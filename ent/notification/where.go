@@ -90,6 +90,16 @@ func TriggerIsLive(v bool) predicate.Notification {
 	return predicate.Notification(sql.FieldEQ(FieldTriggerIsLive, v))
 }
 
+// TriggerLowDiskSpace applies equality check predicate on the "trigger_low_disk_space" field. It's identical to TriggerLowDiskSpaceEQ.
+func TriggerLowDiskSpace(v bool) predicate.Notification {
+	return predicate.Notification(sql.FieldEQ(FieldTriggerLowDiskSpace, v))
+}
+
+// TriggerChecksumMismatch applies equality check predicate on the "trigger_checksum_mismatch" field. It's identical to TriggerChecksumMismatchEQ.
+func TriggerChecksumMismatch(v bool) predicate.Notification {
+	return predicate.Notification(sql.FieldEQ(FieldTriggerChecksumMismatch, v))
+}
+
 // VideoSuccessTemplate applies equality check predicate on the "video_success_template" field. It's identical to VideoSuccessTemplateEQ.
 func VideoSuccessTemplate(v string) predicate.Notification {
 	return predicate.Notification(sql.FieldEQ(FieldVideoSuccessTemplate, v))
@@ -110,6 +120,16 @@ func IsLiveTemplate(v string) predicate.Notification {
 	return predicate.Notification(sql.FieldEQ(FieldIsLiveTemplate, v))
 }
 
+// LowDiskSpaceTemplate applies equality check predicate on the "low_disk_space_template" field. It's identical to LowDiskSpaceTemplateEQ.
+func LowDiskSpaceTemplate(v string) predicate.Notification {
+	return predicate.Notification(sql.FieldEQ(FieldLowDiskSpaceTemplate, v))
+}
+
+// ChecksumMismatchTemplate applies equality check predicate on the "checksum_mismatch_template" field. It's identical to ChecksumMismatchTemplateEQ.
+func ChecksumMismatchTemplate(v string) predicate.Notification {
+	return predicate.Notification(sql.FieldEQ(FieldChecksumMismatchTemplate, v))
+}
+
 // AppriseUrls applies equality check predicate on the "apprise_urls" field. It's identical to AppriseUrlsEQ.
 func AppriseUrls(v string) predicate.Notification {
 	return predicate.Notification(sql.FieldEQ(FieldAppriseUrls, v))
@@ -335,6 +355,26 @@ func TriggerIsLiveNEQ(v bool) predicate.Notification {
 	return predicate.Notification(sql.FieldNEQ(FieldTriggerIsLive, v))
 }
 
+// TriggerLowDiskSpaceEQ applies the EQ predicate on the "trigger_low_disk_space" field.
+func TriggerLowDiskSpaceEQ(v bool) predicate.Notification {
+	return predicate.Notification(sql.FieldEQ(FieldTriggerLowDiskSpace, v))
+}
+
+// TriggerLowDiskSpaceNEQ applies the NEQ predicate on the "trigger_low_disk_space" field.
+func TriggerLowDiskSpaceNEQ(v bool) predicate.Notification {
+	return predicate.Notification(sql.FieldNEQ(FieldTriggerLowDiskSpace, v))
+}
+
+// TriggerChecksumMismatchEQ applies the EQ predicate on the "trigger_checksum_mismatch" field.
+func TriggerChecksumMismatchEQ(v bool) predicate.Notification {
+	return predicate.Notification(sql.FieldEQ(FieldTriggerChecksumMismatch, v))
+}
+
+// TriggerChecksumMismatchNEQ applies the NEQ predicate on the "trigger_checksum_mismatch" field.
+func TriggerChecksumMismatchNEQ(v bool) predicate.Notification {
+	return predicate.Notification(sql.FieldNEQ(FieldTriggerChecksumMismatch, v))
+}
+
 // VideoSuccessTemplateEQ applies the EQ predicate on the "video_success_template" field.
 func VideoSuccessTemplateEQ(v string) predicate.Notification {
 	return predicate.Notification(sql.FieldEQ(FieldVideoSuccessTemplate, v))
@@ -595,6 +635,136 @@ func IsLiveTemplateContainsFold(v string) predicate.Notification {
 	return predicate.Notification(sql.FieldContainsFold(FieldIsLiveTemplate, v))
 }
 
+// LowDiskSpaceTemplateEQ applies the EQ predicate on the "low_disk_space_template" field.
+func LowDiskSpaceTemplateEQ(v string) predicate.Notification {
+	return predicate.Notification(sql.FieldEQ(FieldLowDiskSpaceTemplate, v))
+}
+
+// LowDiskSpaceTemplateNEQ applies the NEQ predicate on the "low_disk_space_template" field.
+func LowDiskSpaceTemplateNEQ(v string) predicate.Notification {
+	return predicate.Notification(sql.FieldNEQ(FieldLowDiskSpaceTemplate, v))
+}
+
+// LowDiskSpaceTemplateIn applies the In predicate on the "low_disk_space_template" field.
+func LowDiskSpaceTemplateIn(vs ...string) predicate.Notification {
+	return predicate.Notification(sql.FieldIn(FieldLowDiskSpaceTemplate, vs...))
+}
+
+// LowDiskSpaceTemplateNotIn applies the NotIn predicate on the "low_disk_space_template" field.
+func LowDiskSpaceTemplateNotIn(vs ...string) predicate.Notification {
+	return predicate.Notification(sql.FieldNotIn(FieldLowDiskSpaceTemplate, vs...))
+}
+
+// LowDiskSpaceTemplateGT applies the GT predicate on the "low_disk_space_template" field.
+func LowDiskSpaceTemplateGT(v string) predicate.Notification {
+	return predicate.Notification(sql.FieldGT(FieldLowDiskSpaceTemplate, v))
+}
+
+// LowDiskSpaceTemplateGTE applies the GTE predicate on the "low_disk_space_template" field.
+func LowDiskSpaceTemplateGTE(v string) predicate.Notification {
+	return predicate.Notification(sql.FieldGTE(FieldLowDiskSpaceTemplate, v))
+}
+
+// LowDiskSpaceTemplateLT applies the LT predicate on the "low_disk_space_template" field.
+func LowDiskSpaceTemplateLT(v string) predicate.Notification {
+	return predicate.Notification(sql.FieldLT(FieldLowDiskSpaceTemplate, v))
+}
+
+// LowDiskSpaceTemplateLTE applies the LTE predicate on the "low_disk_space_template" field.
+func LowDiskSpaceTemplateLTE(v string) predicate.Notification {
+	return predicate.Notification(sql.FieldLTE(FieldLowDiskSpaceTemplate, v))
+}
+
+// LowDiskSpaceTemplateContains applies the Contains predicate on the "low_disk_space_template" field.
+func LowDiskSpaceTemplateContains(v string) predicate.Notification {
+	return predicate.Notification(sql.FieldContains(FieldLowDiskSpaceTemplate, v))
+}
+
+// LowDiskSpaceTemplateHasPrefix applies the HasPrefix predicate on the "low_disk_space_template" field.
+func LowDiskSpaceTemplateHasPrefix(v string) predicate.Notification {
+	return predicate.Notification(sql.FieldHasPrefix(FieldLowDiskSpaceTemplate, v))
+}
+
+// LowDiskSpaceTemplateHasSuffix applies the HasSuffix predicate on the "low_disk_space_template" field.
+func LowDiskSpaceTemplateHasSuffix(v string) predicate.Notification {
+	return predicate.Notification(sql.FieldHasSuffix(FieldLowDiskSpaceTemplate, v))
+}
+
+// LowDiskSpaceTemplateEqualFold applies the EqualFold predicate on the "low_disk_space_template" field.
+func LowDiskSpaceTemplateEqualFold(v string) predicate.Notification {
+	return predicate.Notification(sql.FieldEqualFold(FieldLowDiskSpaceTemplate, v))
+}
+
+// LowDiskSpaceTemplateContainsFold applies the ContainsFold predicate on the "low_disk_space_template" field.
+func LowDiskSpaceTemplateContainsFold(v string) predicate.Notification {
+	return predicate.Notification(sql.FieldContainsFold(FieldLowDiskSpaceTemplate, v))
+}
+
+// ChecksumMismatchTemplateEQ applies the EQ predicate on the "checksum_mismatch_template" field.
+func ChecksumMismatchTemplateEQ(v string) predicate.Notification {
+	return predicate.Notification(sql.FieldEQ(FieldChecksumMismatchTemplate, v))
+}
+
+// ChecksumMismatchTemplateNEQ applies the NEQ predicate on the "checksum_mismatch_template" field.
+func ChecksumMismatchTemplateNEQ(v string) predicate.Notification {
+	return predicate.Notification(sql.FieldNEQ(FieldChecksumMismatchTemplate, v))
+}
+
+// ChecksumMismatchTemplateIn applies the In predicate on the "checksum_mismatch_template" field.
+func ChecksumMismatchTemplateIn(vs ...string) predicate.Notification {
+	return predicate.Notification(sql.FieldIn(FieldChecksumMismatchTemplate, vs...))
+}
+
+// ChecksumMismatchTemplateNotIn applies the NotIn predicate on the "checksum_mismatch_template" field.
+func ChecksumMismatchTemplateNotIn(vs ...string) predicate.Notification {
+	return predicate.Notification(sql.FieldNotIn(FieldChecksumMismatchTemplate, vs...))
+}
+
+// ChecksumMismatchTemplateGT applies the GT predicate on the "checksum_mismatch_template" field.
+func ChecksumMismatchTemplateGT(v string) predicate.Notification {
+	return predicate.Notification(sql.FieldGT(FieldChecksumMismatchTemplate, v))
+}
+
+// ChecksumMismatchTemplateGTE applies the GTE predicate on the "checksum_mismatch_template" field.
+func ChecksumMismatchTemplateGTE(v string) predicate.Notification {
+	return predicate.Notification(sql.FieldGTE(FieldChecksumMismatchTemplate, v))
+}
+
+// ChecksumMismatchTemplateLT applies the LT predicate on the "checksum_mismatch_template" field.
+func ChecksumMismatchTemplateLT(v string) predicate.Notification {
+	return predicate.Notification(sql.FieldLT(FieldChecksumMismatchTemplate, v))
+}
+
+// ChecksumMismatchTemplateLTE applies the LTE predicate on the "checksum_mismatch_template" field.
+func ChecksumMismatchTemplateLTE(v string) predicate.Notification {
+	return predicate.Notification(sql.FieldLTE(FieldChecksumMismatchTemplate, v))
+}
+
+// ChecksumMismatchTemplateContains applies the Contains predicate on the "checksum_mismatch_template" field.
+func ChecksumMismatchTemplateContains(v string) predicate.Notification {
+	return predicate.Notification(sql.FieldContains(FieldChecksumMismatchTemplate, v))
+}
+
+// ChecksumMismatchTemplateHasPrefix applies the HasPrefix predicate on the "checksum_mismatch_template" field.
+func ChecksumMismatchTemplateHasPrefix(v string) predicate.Notification {
+	return predicate.Notification(sql.FieldHasPrefix(FieldChecksumMismatchTemplate, v))
+}
+
+// ChecksumMismatchTemplateHasSuffix applies the HasSuffix predicate on the "checksum_mismatch_template" field.
+func ChecksumMismatchTemplateHasSuffix(v string) predicate.Notification {
+	return predicate.Notification(sql.FieldHasSuffix(FieldChecksumMismatchTemplate, v))
+}
+
+// ChecksumMismatchTemplateEqualFold applies the EqualFold predicate on the "checksum_mismatch_template" field.
+func ChecksumMismatchTemplateEqualFold(v string) predicate.Notification {
+	return predicate.Notification(sql.FieldEqualFold(FieldChecksumMismatchTemplate, v))
+}
+
+// ChecksumMismatchTemplateContainsFold applies the ContainsFold predicate on the "checksum_mismatch_template" field.
+func ChecksumMismatchTemplateContainsFold(v string) predicate.Notification {
+	return predicate.Notification(sql.FieldContainsFold(FieldChecksumMismatchTemplate, v))
+}
+
 // AppriseUrlsEQ applies the EQ predicate on the "apprise_urls" field.
 func AppriseUrlsEQ(v string) predicate.Notification {
 	return predicate.Notification(sql.FieldEQ(FieldAppriseUrls, v))
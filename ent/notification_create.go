@@ -112,6 +112,34 @@ func (_c *NotificationCreate) SetNillableTriggerIsLive(v *bool) *NotificationCre
 	return _c
 }
 
+// SetTriggerLowDiskSpace sets the "trigger_low_disk_space" field.
+func (_c *NotificationCreate) SetTriggerLowDiskSpace(v bool) *NotificationCreate {
+	_c.mutation.SetTriggerLowDiskSpace(v)
+	return _c
+}
+
+// SetNillableTriggerLowDiskSpace sets the "trigger_low_disk_space" field if the given value is not nil.
+func (_c *NotificationCreate) SetNillableTriggerLowDiskSpace(v *bool) *NotificationCreate {
+	if v != nil {
+		_c.SetTriggerLowDiskSpace(*v)
+	}
+	return _c
+}
+
+// SetTriggerChecksumMismatch sets the "trigger_checksum_mismatch" field.
+func (_c *NotificationCreate) SetTriggerChecksumMismatch(v bool) *NotificationCreate {
+	_c.mutation.SetTriggerChecksumMismatch(v)
+	return _c
+}
+
+// SetNillableTriggerChecksumMismatch sets the "trigger_checksum_mismatch" field if the given value is not nil.
+func (_c *NotificationCreate) SetNillableTriggerChecksumMismatch(v *bool) *NotificationCreate {
+	if v != nil {
+		_c.SetTriggerChecksumMismatch(*v)
+	}
+	return _c
+}
+
 // SetVideoSuccessTemplate sets the "video_success_template" field.
 func (_c *NotificationCreate) SetVideoSuccessTemplate(v string) *NotificationCreate {
 	_c.mutation.SetVideoSuccessTemplate(v)
@@ -168,6 +196,34 @@ func (_c *NotificationCreate) SetNillableIsLiveTemplate(v *string) *Notification
 	return _c
 }
 
+// SetLowDiskSpaceTemplate sets the "low_disk_space_template" field.
+func (_c *NotificationCreate) SetLowDiskSpaceTemplate(v string) *NotificationCreate {
+	_c.mutation.SetLowDiskSpaceTemplate(v)
+	return _c
+}
+
+// SetNillableLowDiskSpaceTemplate sets the "low_disk_space_template" field if the given value is not nil.
+func (_c *NotificationCreate) SetNillableLowDiskSpaceTemplate(v *string) *NotificationCreate {
+	if v != nil {
+		_c.SetLowDiskSpaceTemplate(*v)
+	}
+	return _c
+}
+
+// SetChecksumMismatchTemplate sets the "checksum_mismatch_template" field.
+func (_c *NotificationCreate) SetChecksumMismatchTemplate(v string) *NotificationCreate {
+	_c.mutation.SetChecksumMismatchTemplate(v)
+	return _c
+}
+
+// SetNillableChecksumMismatchTemplate sets the "checksum_mismatch_template" field if the given value is not nil.
+func (_c *NotificationCreate) SetNillableChecksumMismatchTemplate(v *string) *NotificationCreate {
+	if v != nil {
+		_c.SetChecksumMismatchTemplate(*v)
+	}
+	return _c
+}
+
 // SetAppriseUrls sets the "apprise_urls" field.
 func (_c *NotificationCreate) SetAppriseUrls(v string) *NotificationCreate {
 	_c.mutation.SetAppriseUrls(v)
@@ -335,6 +391,14 @@ func (_c *NotificationCreate) defaults() {
 		v := notification.DefaultTriggerIsLive
 		_c.mutation.SetTriggerIsLive(v)
 	}
+	if _, ok := _c.mutation.TriggerLowDiskSpace(); !ok {
+		v := notification.DefaultTriggerLowDiskSpace
+		_c.mutation.SetTriggerLowDiskSpace(v)
+	}
+	if _, ok := _c.mutation.TriggerChecksumMismatch(); !ok {
+		v := notification.DefaultTriggerChecksumMismatch
+		_c.mutation.SetTriggerChecksumMismatch(v)
+	}
 	if _, ok := _c.mutation.VideoSuccessTemplate(); !ok {
 		v := notification.DefaultVideoSuccessTemplate
 		_c.mutation.SetVideoSuccessTemplate(v)
@@ -351,6 +415,14 @@ func (_c *NotificationCreate) defaults() {
 		v := notification.DefaultIsLiveTemplate
 		_c.mutation.SetIsLiveTemplate(v)
 	}
+	if _, ok := _c.mutation.LowDiskSpaceTemplate(); !ok {
+		v := notification.DefaultLowDiskSpaceTemplate
+		_c.mutation.SetLowDiskSpaceTemplate(v)
+	}
+	if _, ok := _c.mutation.ChecksumMismatchTemplate(); !ok {
+		v := notification.DefaultChecksumMismatchTemplate
+		_c.mutation.SetChecksumMismatchTemplate(v)
+	}
 	if _, ok := _c.mutation.AppriseUrls(); !ok {
 		v := notification.DefaultAppriseUrls
 		_c.mutation.SetAppriseUrls(v)
@@ -426,6 +498,12 @@ func (_c *NotificationCreate) check() error {
 	if _, ok := _c.mutation.TriggerIsLive(); !ok {
 		return &ValidationError{Name: "trigger_is_live", err: errors.New(`ent: missing required field "Notification.trigger_is_live"`)}
 	}
+	if _, ok := _c.mutation.TriggerLowDiskSpace(); !ok {
+		return &ValidationError{Name: "trigger_low_disk_space", err: errors.New(`ent: missing required field "Notification.trigger_low_disk_space"`)}
+	}
+	if _, ok := _c.mutation.TriggerChecksumMismatch(); !ok {
+		return &ValidationError{Name: "trigger_checksum_mismatch", err: errors.New(`ent: missing required field "Notification.trigger_checksum_mismatch"`)}
+	}
 	if _, ok := _c.mutation.VideoSuccessTemplate(); !ok {
 		return &ValidationError{Name: "video_success_template", err: errors.New(`ent: missing required field "Notification.video_success_template"`)}
 	}
@@ -458,6 +536,22 @@ func (_c *NotificationCreate) check() error {
 			return &ValidationError{Name: "is_live_template", err: fmt.Errorf(`ent: validator failed for field "Notification.is_live_template": %w`, err)}
 		}
 	}
+	if _, ok := _c.mutation.LowDiskSpaceTemplate(); !ok {
+		return &ValidationError{Name: "low_disk_space_template", err: errors.New(`ent: missing required field "Notification.low_disk_space_template"`)}
+	}
+	if v, ok := _c.mutation.LowDiskSpaceTemplate(); ok {
+		if err := notification.LowDiskSpaceTemplateValidator(v); err != nil {
+			return &ValidationError{Name: "low_disk_space_template", err: fmt.Errorf(`ent: validator failed for field "Notification.low_disk_space_template": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.ChecksumMismatchTemplate(); !ok {
+		return &ValidationError{Name: "checksum_mismatch_template", err: errors.New(`ent: missing required field "Notification.checksum_mismatch_template"`)}
+	}
+	if v, ok := _c.mutation.ChecksumMismatchTemplate(); ok {
+		if err := notification.ChecksumMismatchTemplateValidator(v); err != nil {
+			return &ValidationError{Name: "checksum_mismatch_template", err: fmt.Errorf(`ent: validator failed for field "Notification.checksum_mismatch_template": %w`, err)}
+		}
+	}
 	if v, ok := _c.mutation.AppriseUrls(); ok {
 		if err := notification.AppriseUrlsValidator(v); err != nil {
 			return &ValidationError{Name: "apprise_urls", err: fmt.Errorf(`ent: validator failed for field "Notification.apprise_urls": %w`, err)}
@@ -563,6 +657,14 @@ func (_c *NotificationCreate) createSpec() (*Notification, *sqlgraph.CreateSpec)
 		_spec.SetField(notification.FieldTriggerIsLive, field.TypeBool, value)
 		_node.TriggerIsLive = value
 	}
+	if value, ok := _c.mutation.TriggerLowDiskSpace(); ok {
+		_spec.SetField(notification.FieldTriggerLowDiskSpace, field.TypeBool, value)
+		_node.TriggerLowDiskSpace = value
+	}
+	if value, ok := _c.mutation.TriggerChecksumMismatch(); ok {
+		_spec.SetField(notification.FieldTriggerChecksumMismatch, field.TypeBool, value)
+		_node.TriggerChecksumMismatch = value
+	}
 	if value, ok := _c.mutation.VideoSuccessTemplate(); ok {
 		_spec.SetField(notification.FieldVideoSuccessTemplate, field.TypeString, value)
 		_node.VideoSuccessTemplate = value
@@ -579,6 +681,14 @@ func (_c *NotificationCreate) createSpec() (*Notification, *sqlgraph.CreateSpec)
 		_spec.SetField(notification.FieldIsLiveTemplate, field.TypeString, value)
 		_node.IsLiveTemplate = value
 	}
+	if value, ok := _c.mutation.LowDiskSpaceTemplate(); ok {
+		_spec.SetField(notification.FieldLowDiskSpaceTemplate, field.TypeString, value)
+		_node.LowDiskSpaceTemplate = value
+	}
+	if value, ok := _c.mutation.ChecksumMismatchTemplate(); ok {
+		_spec.SetField(notification.FieldChecksumMismatchTemplate, field.TypeString, value)
+		_node.ChecksumMismatchTemplate = value
+	}
 	if value, ok := _c.mutation.AppriseUrls(); ok {
 		_spec.SetField(notification.FieldAppriseUrls, field.TypeString, value)
 		_node.AppriseUrls = value
@@ -755,6 +865,30 @@ func (u *NotificationUpsert) UpdateTriggerIsLive() *NotificationUpsert {
 	return u
 }
 
+// SetTriggerLowDiskSpace sets the "trigger_low_disk_space" field.
+func (u *NotificationUpsert) SetTriggerLowDiskSpace(v bool) *NotificationUpsert {
+	u.Set(notification.FieldTriggerLowDiskSpace, v)
+	return u
+}
+
+// UpdateTriggerLowDiskSpace sets the "trigger_low_disk_space" field to the value that was provided on create.
+func (u *NotificationUpsert) UpdateTriggerLowDiskSpace() *NotificationUpsert {
+	u.SetExcluded(notification.FieldTriggerLowDiskSpace)
+	return u
+}
+
+// SetTriggerChecksumMismatch sets the "trigger_checksum_mismatch" field.
+func (u *NotificationUpsert) SetTriggerChecksumMismatch(v bool) *NotificationUpsert {
+	u.Set(notification.FieldTriggerChecksumMismatch, v)
+	return u
+}
+
+// UpdateTriggerChecksumMismatch sets the "trigger_checksum_mismatch" field to the value that was provided on create.
+func (u *NotificationUpsert) UpdateTriggerChecksumMismatch() *NotificationUpsert {
+	u.SetExcluded(notification.FieldTriggerChecksumMismatch)
+	return u
+}
+
 // SetVideoSuccessTemplate sets the "video_success_template" field.
 func (u *NotificationUpsert) SetVideoSuccessTemplate(v string) *NotificationUpsert {
 	u.Set(notification.FieldVideoSuccessTemplate, v)
@@ -803,6 +937,30 @@ func (u *NotificationUpsert) UpdateIsLiveTemplate() *NotificationUpsert {
 	return u
 }
 
+// SetLowDiskSpaceTemplate sets the "low_disk_space_template" field.
+func (u *NotificationUpsert) SetLowDiskSpaceTemplate(v string) *NotificationUpsert {
+	u.Set(notification.FieldLowDiskSpaceTemplate, v)
+	return u
+}
+
+// UpdateLowDiskSpaceTemplate sets the "low_disk_space_template" field to the value that was provided on create.
+func (u *NotificationUpsert) UpdateLowDiskSpaceTemplate() *NotificationUpsert {
+	u.SetExcluded(notification.FieldLowDiskSpaceTemplate)
+	return u
+}
+
+// SetChecksumMismatchTemplate sets the "checksum_mismatch_template" field.
+func (u *NotificationUpsert) SetChecksumMismatchTemplate(v string) *NotificationUpsert {
+	u.Set(notification.FieldChecksumMismatchTemplate, v)
+	return u
+}
+
+// UpdateChecksumMismatchTemplate sets the "checksum_mismatch_template" field to the value that was provided on create.
+func (u *NotificationUpsert) UpdateChecksumMismatchTemplate() *NotificationUpsert {
+	u.SetExcluded(notification.FieldChecksumMismatchTemplate)
+	return u
+}
+
 // SetAppriseUrls sets the "apprise_urls" field.
 func (u *NotificationUpsert) SetAppriseUrls(v string) *NotificationUpsert {
 	u.Set(notification.FieldAppriseUrls, v)
@@ -1056,6 +1214,34 @@ func (u *NotificationUpsertOne) UpdateTriggerIsLive() *NotificationUpsertOne {
 	})
 }
 
+// SetTriggerLowDiskSpace sets the "trigger_low_disk_space" field.
+func (u *NotificationUpsertOne) SetTriggerLowDiskSpace(v bool) *NotificationUpsertOne {
+	return u.Update(func(s *NotificationUpsert) {
+		s.SetTriggerLowDiskSpace(v)
+	})
+}
+
+// UpdateTriggerLowDiskSpace sets the "trigger_low_disk_space" field to the value that was provided on create.
+func (u *NotificationUpsertOne) UpdateTriggerLowDiskSpace() *NotificationUpsertOne {
+	return u.Update(func(s *NotificationUpsert) {
+		s.UpdateTriggerLowDiskSpace()
+	})
+}
+
+// SetTriggerChecksumMismatch sets the "trigger_checksum_mismatch" field.
+func (u *NotificationUpsertOne) SetTriggerChecksumMismatch(v bool) *NotificationUpsertOne {
+	return u.Update(func(s *NotificationUpsert) {
+		s.SetTriggerChecksumMismatch(v)
+	})
+}
+
+// UpdateTriggerChecksumMismatch sets the "trigger_checksum_mismatch" field to the value that was provided on create.
+func (u *NotificationUpsertOne) UpdateTriggerChecksumMismatch() *NotificationUpsertOne {
+	return u.Update(func(s *NotificationUpsert) {
+		s.UpdateTriggerChecksumMismatch()
+	})
+}
+
 // SetVideoSuccessTemplate sets the "video_success_template" field.
 func (u *NotificationUpsertOne) SetVideoSuccessTemplate(v string) *NotificationUpsertOne {
 	return u.Update(func(s *NotificationUpsert) {
@@ -1112,6 +1298,34 @@ func (u *NotificationUpsertOne) UpdateIsLiveTemplate() *NotificationUpsertOne {
 	})
 }
 
+// SetLowDiskSpaceTemplate sets the "low_disk_space_template" field.
+func (u *NotificationUpsertOne) SetLowDiskSpaceTemplate(v string) *NotificationUpsertOne {
+	return u.Update(func(s *NotificationUpsert) {
+		s.SetLowDiskSpaceTemplate(v)
+	})
+}
+
+// UpdateLowDiskSpaceTemplate sets the "low_disk_space_template" field to the value that was provided on create.
+func (u *NotificationUpsertOne) UpdateLowDiskSpaceTemplate() *NotificationUpsertOne {
+	return u.Update(func(s *NotificationUpsert) {
+		s.UpdateLowDiskSpaceTemplate()
+	})
+}
+
+// SetChecksumMismatchTemplate sets the "checksum_mismatch_template" field.
+func (u *NotificationUpsertOne) SetChecksumMismatchTemplate(v string) *NotificationUpsertOne {
+	return u.Update(func(s *NotificationUpsert) {
+		s.SetChecksumMismatchTemplate(v)
+	})
+}
+
+// UpdateChecksumMismatchTemplate sets the "checksum_mismatch_template" field to the value that was provided on create.
+func (u *NotificationUpsertOne) UpdateChecksumMismatchTemplate() *NotificationUpsertOne {
+	return u.Update(func(s *NotificationUpsert) {
+		s.UpdateChecksumMismatchTemplate()
+	})
+}
+
 // SetAppriseUrls sets the "apprise_urls" field.
 func (u *NotificationUpsertOne) SetAppriseUrls(v string) *NotificationUpsertOne {
 	return u.Update(func(s *NotificationUpsert) {
@@ -1547,6 +1761,34 @@ func (u *NotificationUpsertBulk) UpdateTriggerIsLive() *NotificationUpsertBulk {
 	})
 }
 
+// SetTriggerLowDiskSpace sets the "trigger_low_disk_space" field.
+func (u *NotificationUpsertBulk) SetTriggerLowDiskSpace(v bool) *NotificationUpsertBulk {
+	return u.Update(func(s *NotificationUpsert) {
+		s.SetTriggerLowDiskSpace(v)
+	})
+}
+
+// UpdateTriggerLowDiskSpace sets the "trigger_low_disk_space" field to the value that was provided on create.
+func (u *NotificationUpsertBulk) UpdateTriggerLowDiskSpace() *NotificationUpsertBulk {
+	return u.Update(func(s *NotificationUpsert) {
+		s.UpdateTriggerLowDiskSpace()
+	})
+}
+
+// SetTriggerChecksumMismatch sets the "trigger_checksum_mismatch" field.
+func (u *NotificationUpsertBulk) SetTriggerChecksumMismatch(v bool) *NotificationUpsertBulk {
+	return u.Update(func(s *NotificationUpsert) {
+		s.SetTriggerChecksumMismatch(v)
+	})
+}
+
+// UpdateTriggerChecksumMismatch sets the "trigger_checksum_mismatch" field to the value that was provided on create.
+func (u *NotificationUpsertBulk) UpdateTriggerChecksumMismatch() *NotificationUpsertBulk {
+	return u.Update(func(s *NotificationUpsert) {
+		s.UpdateTriggerChecksumMismatch()
+	})
+}
+
 // SetVideoSuccessTemplate sets the "video_success_template" field.
 func (u *NotificationUpsertBulk) SetVideoSuccessTemplate(v string) *NotificationUpsertBulk {
 	return u.Update(func(s *NotificationUpsert) {
@@ -1603,6 +1845,34 @@ func (u *NotificationUpsertBulk) UpdateIsLiveTemplate() *NotificationUpsertBulk
 	})
 }
 
+// SetLowDiskSpaceTemplate sets the "low_disk_space_template" field.
+func (u *NotificationUpsertBulk) SetLowDiskSpaceTemplate(v string) *NotificationUpsertBulk {
+	return u.Update(func(s *NotificationUpsert) {
+		s.SetLowDiskSpaceTemplate(v)
+	})
+}
+
+// UpdateLowDiskSpaceTemplate sets the "low_disk_space_template" field to the value that was provided on create.
+func (u *NotificationUpsertBulk) UpdateLowDiskSpaceTemplate() *NotificationUpsertBulk {
+	return u.Update(func(s *NotificationUpsert) {
+		s.UpdateLowDiskSpaceTemplate()
+	})
+}
+
+// SetChecksumMismatchTemplate sets the "checksum_mismatch_template" field.
+func (u *NotificationUpsertBulk) SetChecksumMismatchTemplate(v string) *NotificationUpsertBulk {
+	return u.Update(func(s *NotificationUpsert) {
+		s.SetChecksumMismatchTemplate(v)
+	})
+}
+
+// UpdateChecksumMismatchTemplate sets the "checksum_mismatch_template" field to the value that was provided on create.
+func (u *NotificationUpsertBulk) UpdateChecksumMismatchTemplate() *NotificationUpsertBulk {
+	return u.Update(func(s *NotificationUpsert) {
+		s.UpdateChecksumMismatchTemplate()
+	})
+}
+
 // SetAppriseUrls sets the "apprise_urls" field.
 func (u *NotificationUpsertBulk) SetAppriseUrls(v string) *NotificationUpsertBulk {
 	return u.Update(func(s *NotificationUpsert) {
@@ -140,6 +140,34 @@ func (_u *NotificationUpdate) SetNillableTriggerIsLive(v *bool) *NotificationUpd
 	return _u
 }
 
+// SetTriggerLowDiskSpace sets the "trigger_low_disk_space" field.
+func (_u *NotificationUpdate) SetTriggerLowDiskSpace(v bool) *NotificationUpdate {
+	_u.mutation.SetTriggerLowDiskSpace(v)
+	return _u
+}
+
+// SetNillableTriggerLowDiskSpace sets the "trigger_low_disk_space" field if the given value is not nil.
+func (_u *NotificationUpdate) SetNillableTriggerLowDiskSpace(v *bool) *NotificationUpdate {
+	if v != nil {
+		_u.SetTriggerLowDiskSpace(*v)
+	}
+	return _u
+}
+
+// SetTriggerChecksumMismatch sets the "trigger_checksum_mismatch" field.
+func (_u *NotificationUpdate) SetTriggerChecksumMismatch(v bool) *NotificationUpdate {
+	_u.mutation.SetTriggerChecksumMismatch(v)
+	return _u
+}
+
+// SetNillableTriggerChecksumMismatch sets the "trigger_checksum_mismatch" field if the given value is not nil.
+func (_u *NotificationUpdate) SetNillableTriggerChecksumMismatch(v *bool) *NotificationUpdate {
+	if v != nil {
+		_u.SetTriggerChecksumMismatch(*v)
+	}
+	return _u
+}
+
 // SetVideoSuccessTemplate sets the "video_success_template" field.
 func (_u *NotificationUpdate) SetVideoSuccessTemplate(v string) *NotificationUpdate {
 	_u.mutation.SetVideoSuccessTemplate(v)
@@ -196,6 +224,34 @@ func (_u *NotificationUpdate) SetNillableIsLiveTemplate(v *string) *Notification
 	return _u
 }
 
+// SetLowDiskSpaceTemplate sets the "low_disk_space_template" field.
+func (_u *NotificationUpdate) SetLowDiskSpaceTemplate(v string) *NotificationUpdate {
+	_u.mutation.SetLowDiskSpaceTemplate(v)
+	return _u
+}
+
+// SetNillableLowDiskSpaceTemplate sets the "low_disk_space_template" field if the given value is not nil.
+func (_u *NotificationUpdate) SetNillableLowDiskSpaceTemplate(v *string) *NotificationUpdate {
+	if v != nil {
+		_u.SetLowDiskSpaceTemplate(*v)
+	}
+	return _u
+}
+
+// SetChecksumMismatchTemplate sets the "checksum_mismatch_template" field.
+func (_u *NotificationUpdate) SetChecksumMismatchTemplate(v string) *NotificationUpdate {
+	_u.mutation.SetChecksumMismatchTemplate(v)
+	return _u
+}
+
+// SetNillableChecksumMismatchTemplate sets the "checksum_mismatch_template" field if the given value is not nil.
+func (_u *NotificationUpdate) SetNillableChecksumMismatchTemplate(v *string) *NotificationUpdate {
+	if v != nil {
+		_u.SetChecksumMismatchTemplate(*v)
+	}
+	return _u
+}
+
 // SetAppriseUrls sets the "apprise_urls" field.
 func (_u *NotificationUpdate) SetAppriseUrls(v string) *NotificationUpdate {
 	_u.mutation.SetAppriseUrls(v)
@@ -368,6 +424,16 @@ func (_u *NotificationUpdate) check() error {
 			return &ValidationError{Name: "is_live_template", err: fmt.Errorf(`ent: validator failed for field "Notification.is_live_template": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.LowDiskSpaceTemplate(); ok {
+		if err := notification.LowDiskSpaceTemplateValidator(v); err != nil {
+			return &ValidationError{Name: "low_disk_space_template", err: fmt.Errorf(`ent: validator failed for field "Notification.low_disk_space_template": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ChecksumMismatchTemplate(); ok {
+		if err := notification.ChecksumMismatchTemplateValidator(v); err != nil {
+			return &ValidationError{Name: "checksum_mismatch_template", err: fmt.Errorf(`ent: validator failed for field "Notification.checksum_mismatch_template": %w`, err)}
+		}
+	}
 	if v, ok := _u.mutation.AppriseUrls(); ok {
 		if err := notification.AppriseUrlsValidator(v); err != nil {
 			return &ValidationError{Name: "apprise_urls", err: fmt.Errorf(`ent: validator failed for field "Notification.apprise_urls": %w`, err)}
@@ -432,6 +498,12 @@ func (_u *NotificationUpdate) sqlSave(ctx context.Context) (_node int, err error
 	if value, ok := _u.mutation.TriggerIsLive(); ok {
 		_spec.SetField(notification.FieldTriggerIsLive, field.TypeBool, value)
 	}
+	if value, ok := _u.mutation.TriggerLowDiskSpace(); ok {
+		_spec.SetField(notification.FieldTriggerLowDiskSpace, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.TriggerChecksumMismatch(); ok {
+		_spec.SetField(notification.FieldTriggerChecksumMismatch, field.TypeBool, value)
+	}
 	if value, ok := _u.mutation.VideoSuccessTemplate(); ok {
 		_spec.SetField(notification.FieldVideoSuccessTemplate, field.TypeString, value)
 	}
@@ -444,6 +516,12 @@ func (_u *NotificationUpdate) sqlSave(ctx context.Context) (_node int, err error
 	if value, ok := _u.mutation.IsLiveTemplate(); ok {
 		_spec.SetField(notification.FieldIsLiveTemplate, field.TypeString, value)
 	}
+	if value, ok := _u.mutation.LowDiskSpaceTemplate(); ok {
+		_spec.SetField(notification.FieldLowDiskSpaceTemplate, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ChecksumMismatchTemplate(); ok {
+		_spec.SetField(notification.FieldChecksumMismatchTemplate, field.TypeString, value)
+	}
 	if value, ok := _u.mutation.AppriseUrls(); ok {
 		_spec.SetField(notification.FieldAppriseUrls, field.TypeString, value)
 	}
@@ -603,6 +681,34 @@ func (_u *NotificationUpdateOne) SetNillableTriggerIsLive(v *bool) *Notification
 	return _u
 }
 
+// SetTriggerLowDiskSpace sets the "trigger_low_disk_space" field.
+func (_u *NotificationUpdateOne) SetTriggerLowDiskSpace(v bool) *NotificationUpdateOne {
+	_u.mutation.SetTriggerLowDiskSpace(v)
+	return _u
+}
+
+// SetNillableTriggerLowDiskSpace sets the "trigger_low_disk_space" field if the given value is not nil.
+func (_u *NotificationUpdateOne) SetNillableTriggerLowDiskSpace(v *bool) *NotificationUpdateOne {
+	if v != nil {
+		_u.SetTriggerLowDiskSpace(*v)
+	}
+	return _u
+}
+
+// SetTriggerChecksumMismatch sets the "trigger_checksum_mismatch" field.
+func (_u *NotificationUpdateOne) SetTriggerChecksumMismatch(v bool) *NotificationUpdateOne {
+	_u.mutation.SetTriggerChecksumMismatch(v)
+	return _u
+}
+
+// SetNillableTriggerChecksumMismatch sets the "trigger_checksum_mismatch" field if the given value is not nil.
+func (_u *NotificationUpdateOne) SetNillableTriggerChecksumMismatch(v *bool) *NotificationUpdateOne {
+	if v != nil {
+		_u.SetTriggerChecksumMismatch(*v)
+	}
+	return _u
+}
+
 // SetVideoSuccessTemplate sets the "video_success_template" field.
 func (_u *NotificationUpdateOne) SetVideoSuccessTemplate(v string) *NotificationUpdateOne {
 	_u.mutation.SetVideoSuccessTemplate(v)
@@ -659,6 +765,34 @@ func (_u *NotificationUpdateOne) SetNillableIsLiveTemplate(v *string) *Notificat
 	return _u
 }
 
+// SetLowDiskSpaceTemplate sets the "low_disk_space_template" field.
+func (_u *NotificationUpdateOne) SetLowDiskSpaceTemplate(v string) *NotificationUpdateOne {
+	_u.mutation.SetLowDiskSpaceTemplate(v)
+	return _u
+}
+
+// SetNillableLowDiskSpaceTemplate sets the "low_disk_space_template" field if the given value is not nil.
+func (_u *NotificationUpdateOne) SetNillableLowDiskSpaceTemplate(v *string) *NotificationUpdateOne {
+	if v != nil {
+		_u.SetLowDiskSpaceTemplate(*v)
+	}
+	return _u
+}
+
+// SetChecksumMismatchTemplate sets the "checksum_mismatch_template" field.
+func (_u *NotificationUpdateOne) SetChecksumMismatchTemplate(v string) *NotificationUpdateOne {
+	_u.mutation.SetChecksumMismatchTemplate(v)
+	return _u
+}
+
+// SetNillableChecksumMismatchTemplate sets the "checksum_mismatch_template" field if the given value is not nil.
+func (_u *NotificationUpdateOne) SetNillableChecksumMismatchTemplate(v *string) *NotificationUpdateOne {
+	if v != nil {
+		_u.SetChecksumMismatchTemplate(*v)
+	}
+	return _u
+}
+
 // SetAppriseUrls sets the "apprise_urls" field.
 func (_u *NotificationUpdateOne) SetAppriseUrls(v string) *NotificationUpdateOne {
 	_u.mutation.SetAppriseUrls(v)
@@ -844,6 +978,16 @@ func (_u *NotificationUpdateOne) check() error {
 			return &ValidationError{Name: "is_live_template", err: fmt.Errorf(`ent: validator failed for field "Notification.is_live_template": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.LowDiskSpaceTemplate(); ok {
+		if err := notification.LowDiskSpaceTemplateValidator(v); err != nil {
+			return &ValidationError{Name: "low_disk_space_template", err: fmt.Errorf(`ent: validator failed for field "Notification.low_disk_space_template": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ChecksumMismatchTemplate(); ok {
+		if err := notification.ChecksumMismatchTemplateValidator(v); err != nil {
+			return &ValidationError{Name: "checksum_mismatch_template", err: fmt.Errorf(`ent: validator failed for field "Notification.checksum_mismatch_template": %w`, err)}
+		}
+	}
 	if v, ok := _u.mutation.AppriseUrls(); ok {
 		if err := notification.AppriseUrlsValidator(v); err != nil {
 			return &ValidationError{Name: "apprise_urls", err: fmt.Errorf(`ent: validator failed for field "Notification.apprise_urls": %w`, err)}
@@ -925,6 +1069,12 @@ func (_u *NotificationUpdateOne) sqlSave(ctx context.Context) (_node *Notificati
 	if value, ok := _u.mutation.TriggerIsLive(); ok {
 		_spec.SetField(notification.FieldTriggerIsLive, field.TypeBool, value)
 	}
+	if value, ok := _u.mutation.TriggerLowDiskSpace(); ok {
+		_spec.SetField(notification.FieldTriggerLowDiskSpace, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.TriggerChecksumMismatch(); ok {
+		_spec.SetField(notification.FieldTriggerChecksumMismatch, field.TypeBool, value)
+	}
 	if value, ok := _u.mutation.VideoSuccessTemplate(); ok {
 		_spec.SetField(notification.FieldVideoSuccessTemplate, field.TypeString, value)
 	}
@@ -937,6 +1087,12 @@ func (_u *NotificationUpdateOne) sqlSave(ctx context.Context) (_node *Notificati
 	if value, ok := _u.mutation.IsLiveTemplate(); ok {
 		_spec.SetField(notification.FieldIsLiveTemplate, field.TypeString, value)
 	}
+	if value, ok := _u.mutation.LowDiskSpaceTemplate(); ok {
+		_spec.SetField(notification.FieldLowDiskSpaceTemplate, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ChecksumMismatchTemplate(); ok {
+		_spec.SetField(notification.FieldChecksumMismatchTemplate, field.TypeString, value)
+	}
 	if value, ok := _u.mutation.AppriseUrls(); ok {
 		_spec.SetField(notification.FieldAppriseUrls, field.TypeString, value)
 	}
@@ -24,6 +24,8 @@ type Playlist struct {
 	Description string `json:"description,omitempty"`
 	// ThumbnailPath holds the value of the "thumbnail_path" field.
 	ThumbnailPath string `json:"thumbnail_path,omitempty"`
+	// The stream whose parts are grouped in the playlist, if it was created by splitting a live stream archive.
+	ExtStreamID string `json:"ext_stream_id,omitempty"`
 	// UpdatedAt holds the value of the "updated_at" field.
 	UpdatedAt time.Time `json:"updated_at,omitempty"`
 	// CreatedAt holds the value of the "created_at" field.
@@ -79,7 +81,7 @@ func (*Playlist) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case playlist.FieldName, playlist.FieldDescription, playlist.FieldThumbnailPath:
+		case playlist.FieldName, playlist.FieldDescription, playlist.FieldThumbnailPath, playlist.FieldExtStreamID:
 			values[i] = new(sql.NullString)
 		case playlist.FieldUpdatedAt, playlist.FieldCreatedAt:
 			values[i] = new(sql.NullTime)
@@ -124,6 +126,12 @@ func (_m *Playlist) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.ThumbnailPath = value.String
 			}
+		case playlist.FieldExtStreamID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field ext_stream_id", values[i])
+			} else if value.Valid {
+				_m.ExtStreamID = value.String
+			}
 		case playlist.FieldUpdatedAt:
 			if value, ok := values[i].(*sql.NullTime); !ok {
 				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
@@ -196,6 +204,9 @@ func (_m *Playlist) String() string {
 	builder.WriteString("thumbnail_path=")
 	builder.WriteString(_m.ThumbnailPath)
 	builder.WriteString(", ")
+	builder.WriteString("ext_stream_id=")
+	builder.WriteString(_m.ExtStreamID)
+	builder.WriteString(", ")
 	builder.WriteString("updated_at=")
 	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
 	builder.WriteString(", ")
@@ -21,6 +21,8 @@ const (
 	FieldDescription = "description"
 	// FieldThumbnailPath holds the string denoting the thumbnail_path field in the database.
 	FieldThumbnailPath = "thumbnail_path"
+	// FieldExtStreamID holds the string denoting the ext_stream_id field in the database.
+	FieldExtStreamID = "ext_stream_id"
 	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
 	FieldUpdatedAt = "updated_at"
 	// FieldCreatedAt holds the string denoting the created_at field in the database.
@@ -60,6 +62,7 @@ var Columns = []string{
 	FieldName,
 	FieldDescription,
 	FieldThumbnailPath,
+	FieldExtStreamID,
 	FieldUpdatedAt,
 	FieldCreatedAt,
 }
@@ -114,6 +117,11 @@ func ByThumbnailPath(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldThumbnailPath, opts...).ToFunc()
 }
 
+// ByExtStreamID orders the results by the ext_stream_id field.
+func ByExtStreamID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldExtStreamID, opts...).ToFunc()
+}
+
 // ByUpdatedAt orders the results by the updated_at field.
 func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
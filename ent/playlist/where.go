@@ -71,6 +71,11 @@ func ThumbnailPath(v string) predicate.Playlist {
 	return predicate.Playlist(sql.FieldEQ(FieldThumbnailPath, v))
 }
 
+// ExtStreamID applies equality check predicate on the "ext_stream_id" field. It's identical to ExtStreamIDEQ.
+func ExtStreamID(v string) predicate.Playlist {
+	return predicate.Playlist(sql.FieldEQ(FieldExtStreamID, v))
+}
+
 // UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
 func UpdatedAt(v time.Time) predicate.Playlist {
 	return predicate.Playlist(sql.FieldEQ(FieldUpdatedAt, v))
@@ -296,6 +301,81 @@ func ThumbnailPathContainsFold(v string) predicate.Playlist {
 	return predicate.Playlist(sql.FieldContainsFold(FieldThumbnailPath, v))
 }
 
+// ExtStreamIDEQ applies the EQ predicate on the "ext_stream_id" field.
+func ExtStreamIDEQ(v string) predicate.Playlist {
+	return predicate.Playlist(sql.FieldEQ(FieldExtStreamID, v))
+}
+
+// ExtStreamIDNEQ applies the NEQ predicate on the "ext_stream_id" field.
+func ExtStreamIDNEQ(v string) predicate.Playlist {
+	return predicate.Playlist(sql.FieldNEQ(FieldExtStreamID, v))
+}
+
+// ExtStreamIDIn applies the In predicate on the "ext_stream_id" field.
+func ExtStreamIDIn(vs ...string) predicate.Playlist {
+	return predicate.Playlist(sql.FieldIn(FieldExtStreamID, vs...))
+}
+
+// ExtStreamIDNotIn applies the NotIn predicate on the "ext_stream_id" field.
+func ExtStreamIDNotIn(vs ...string) predicate.Playlist {
+	return predicate.Playlist(sql.FieldNotIn(FieldExtStreamID, vs...))
+}
+
+// ExtStreamIDGT applies the GT predicate on the "ext_stream_id" field.
+func ExtStreamIDGT(v string) predicate.Playlist {
+	return predicate.Playlist(sql.FieldGT(FieldExtStreamID, v))
+}
+
+// ExtStreamIDGTE applies the GTE predicate on the "ext_stream_id" field.
+func ExtStreamIDGTE(v string) predicate.Playlist {
+	return predicate.Playlist(sql.FieldGTE(FieldExtStreamID, v))
+}
+
+// ExtStreamIDLT applies the LT predicate on the "ext_stream_id" field.
+func ExtStreamIDLT(v string) predicate.Playlist {
+	return predicate.Playlist(sql.FieldLT(FieldExtStreamID, v))
+}
+
+// ExtStreamIDLTE applies the LTE predicate on the "ext_stream_id" field.
+func ExtStreamIDLTE(v string) predicate.Playlist {
+	return predicate.Playlist(sql.FieldLTE(FieldExtStreamID, v))
+}
+
+// ExtStreamIDContains applies the Contains predicate on the "ext_stream_id" field.
+func ExtStreamIDContains(v string) predicate.Playlist {
+	return predicate.Playlist(sql.FieldContains(FieldExtStreamID, v))
+}
+
+// ExtStreamIDHasPrefix applies the HasPrefix predicate on the "ext_stream_id" field.
+func ExtStreamIDHasPrefix(v string) predicate.Playlist {
+	return predicate.Playlist(sql.FieldHasPrefix(FieldExtStreamID, v))
+}
+
+// ExtStreamIDHasSuffix applies the HasSuffix predicate on the "ext_stream_id" field.
+func ExtStreamIDHasSuffix(v string) predicate.Playlist {
+	return predicate.Playlist(sql.FieldHasSuffix(FieldExtStreamID, v))
+}
+
+// ExtStreamIDIsNil applies the IsNil predicate on the "ext_stream_id" field.
+func ExtStreamIDIsNil() predicate.Playlist {
+	return predicate.Playlist(sql.FieldIsNull(FieldExtStreamID))
+}
+
+// ExtStreamIDNotNil applies the NotNil predicate on the "ext_stream_id" field.
+func ExtStreamIDNotNil() predicate.Playlist {
+	return predicate.Playlist(sql.FieldNotNull(FieldExtStreamID))
+}
+
+// ExtStreamIDEqualFold applies the EqualFold predicate on the "ext_stream_id" field.
+func ExtStreamIDEqualFold(v string) predicate.Playlist {
+	return predicate.Playlist(sql.FieldEqualFold(FieldExtStreamID, v))
+}
+
+// ExtStreamIDContainsFold applies the ContainsFold predicate on the "ext_stream_id" field.
+func ExtStreamIDContainsFold(v string) predicate.Playlist {
+	return predicate.Playlist(sql.FieldContainsFold(FieldExtStreamID, v))
+}
+
 // UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
 func UpdatedAtEQ(v time.Time) predicate.Playlist {
 	return predicate.Playlist(sql.FieldEQ(FieldUpdatedAt, v))
@@ -61,6 +61,20 @@ func (_c *PlaylistCreate) SetNillableThumbnailPath(v *string) *PlaylistCreate {
 	return _c
 }
 
+// SetExtStreamID sets the "ext_stream_id" field.
+func (_c *PlaylistCreate) SetExtStreamID(v string) *PlaylistCreate {
+	_c.mutation.SetExtStreamID(v)
+	return _c
+}
+
+// SetNillableExtStreamID sets the "ext_stream_id" field if the given value is not nil.
+func (_c *PlaylistCreate) SetNillableExtStreamID(v *string) *PlaylistCreate {
+	if v != nil {
+		_c.SetExtStreamID(*v)
+	}
+	return _c
+}
+
 // SetUpdatedAt sets the "updated_at" field.
 func (_c *PlaylistCreate) SetUpdatedAt(v time.Time) *PlaylistCreate {
 	_c.mutation.SetUpdatedAt(v)
@@ -256,6 +270,10 @@ func (_c *PlaylistCreate) createSpec() (*Playlist, *sqlgraph.CreateSpec) {
 		_spec.SetField(playlist.FieldThumbnailPath, field.TypeString, value)
 		_node.ThumbnailPath = value
 	}
+	if value, ok := _c.mutation.ExtStreamID(); ok {
+		_spec.SetField(playlist.FieldExtStreamID, field.TypeString, value)
+		_node.ExtStreamID = value
+	}
 	if value, ok := _c.mutation.UpdatedAt(); ok {
 		_spec.SetField(playlist.FieldUpdatedAt, field.TypeTime, value)
 		_node.UpdatedAt = value
@@ -412,6 +430,24 @@ func (u *PlaylistUpsert) ClearThumbnailPath() *PlaylistUpsert {
 	return u
 }
 
+// SetExtStreamID sets the "ext_stream_id" field.
+func (u *PlaylistUpsert) SetExtStreamID(v string) *PlaylistUpsert {
+	u.Set(playlist.FieldExtStreamID, v)
+	return u
+}
+
+// UpdateExtStreamID sets the "ext_stream_id" field to the value that was provided on create.
+func (u *PlaylistUpsert) UpdateExtStreamID() *PlaylistUpsert {
+	u.SetExcluded(playlist.FieldExtStreamID)
+	return u
+}
+
+// ClearExtStreamID clears the value of the "ext_stream_id" field.
+func (u *PlaylistUpsert) ClearExtStreamID() *PlaylistUpsert {
+	u.SetNull(playlist.FieldExtStreamID)
+	return u
+}
+
 // SetUpdatedAt sets the "updated_at" field.
 func (u *PlaylistUpsert) SetUpdatedAt(v time.Time) *PlaylistUpsert {
 	u.Set(playlist.FieldUpdatedAt, v)
@@ -531,6 +567,27 @@ func (u *PlaylistUpsertOne) ClearThumbnailPath() *PlaylistUpsertOne {
 	})
 }
 
+// SetExtStreamID sets the "ext_stream_id" field.
+func (u *PlaylistUpsertOne) SetExtStreamID(v string) *PlaylistUpsertOne {
+	return u.Update(func(s *PlaylistUpsert) {
+		s.SetExtStreamID(v)
+	})
+}
+
+// UpdateExtStreamID sets the "ext_stream_id" field to the value that was provided on create.
+func (u *PlaylistUpsertOne) UpdateExtStreamID() *PlaylistUpsertOne {
+	return u.Update(func(s *PlaylistUpsert) {
+		s.UpdateExtStreamID()
+	})
+}
+
+// ClearExtStreamID clears the value of the "ext_stream_id" field.
+func (u *PlaylistUpsertOne) ClearExtStreamID() *PlaylistUpsertOne {
+	return u.Update(func(s *PlaylistUpsert) {
+		s.ClearExtStreamID()
+	})
+}
+
 // SetUpdatedAt sets the "updated_at" field.
 func (u *PlaylistUpsertOne) SetUpdatedAt(v time.Time) *PlaylistUpsertOne {
 	return u.Update(func(s *PlaylistUpsert) {
@@ -819,6 +876,27 @@ func (u *PlaylistUpsertBulk) ClearThumbnailPath() *PlaylistUpsertBulk {
 	})
 }
 
+// SetExtStreamID sets the "ext_stream_id" field.
+func (u *PlaylistUpsertBulk) SetExtStreamID(v string) *PlaylistUpsertBulk {
+	return u.Update(func(s *PlaylistUpsert) {
+		s.SetExtStreamID(v)
+	})
+}
+
+// UpdateExtStreamID sets the "ext_stream_id" field to the value that was provided on create.
+func (u *PlaylistUpsertBulk) UpdateExtStreamID() *PlaylistUpsertBulk {
+	return u.Update(func(s *PlaylistUpsert) {
+		s.UpdateExtStreamID()
+	})
+}
+
+// ClearExtStreamID clears the value of the "ext_stream_id" field.
+func (u *PlaylistUpsertBulk) ClearExtStreamID() *PlaylistUpsertBulk {
+	return u.Update(func(s *PlaylistUpsert) {
+		s.ClearExtStreamID()
+	})
+}
+
 // SetUpdatedAt sets the "updated_at" field.
 func (u *PlaylistUpsertBulk) SetUpdatedAt(v time.Time) *PlaylistUpsertBulk {
 	return u.Update(func(s *PlaylistUpsert) {
@@ -86,6 +86,26 @@ func (_u *PlaylistUpdate) ClearThumbnailPath() *PlaylistUpdate {
 	return _u
 }
 
+// SetExtStreamID sets the "ext_stream_id" field.
+func (_u *PlaylistUpdate) SetExtStreamID(v string) *PlaylistUpdate {
+	_u.mutation.SetExtStreamID(v)
+	return _u
+}
+
+// SetNillableExtStreamID sets the "ext_stream_id" field if the given value is not nil.
+func (_u *PlaylistUpdate) SetNillableExtStreamID(v *string) *PlaylistUpdate {
+	if v != nil {
+		_u.SetExtStreamID(*v)
+	}
+	return _u
+}
+
+// ClearExtStreamID clears the value of the "ext_stream_id" field.
+func (_u *PlaylistUpdate) ClearExtStreamID() *PlaylistUpdate {
+	_u.mutation.ClearExtStreamID()
+	return _u
+}
+
 // SetUpdatedAt sets the "updated_at" field.
 func (_u *PlaylistUpdate) SetUpdatedAt(v time.Time) *PlaylistUpdate {
 	_u.mutation.SetUpdatedAt(v)
@@ -265,6 +285,12 @@ func (_u *PlaylistUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if _u.mutation.ThumbnailPathCleared() {
 		_spec.ClearField(playlist.FieldThumbnailPath, field.TypeString)
 	}
+	if value, ok := _u.mutation.ExtStreamID(); ok {
+		_spec.SetField(playlist.FieldExtStreamID, field.TypeString, value)
+	}
+	if _u.mutation.ExtStreamIDCleared() {
+		_spec.ClearField(playlist.FieldExtStreamID, field.TypeString)
+	}
 	if value, ok := _u.mutation.UpdatedAt(); ok {
 		_spec.SetField(playlist.FieldUpdatedAt, field.TypeTime, value)
 	}
@@ -477,6 +503,26 @@ func (_u *PlaylistUpdateOne) ClearThumbnailPath() *PlaylistUpdateOne {
 	return _u
 }
 
+// SetExtStreamID sets the "ext_stream_id" field.
+func (_u *PlaylistUpdateOne) SetExtStreamID(v string) *PlaylistUpdateOne {
+	_u.mutation.SetExtStreamID(v)
+	return _u
+}
+
+// SetNillableExtStreamID sets the "ext_stream_id" field if the given value is not nil.
+func (_u *PlaylistUpdateOne) SetNillableExtStreamID(v *string) *PlaylistUpdateOne {
+	if v != nil {
+		_u.SetExtStreamID(*v)
+	}
+	return _u
+}
+
+// ClearExtStreamID clears the value of the "ext_stream_id" field.
+func (_u *PlaylistUpdateOne) ClearExtStreamID() *PlaylistUpdateOne {
+	_u.mutation.ClearExtStreamID()
+	return _u
+}
+
 // SetUpdatedAt sets the "updated_at" field.
 func (_u *PlaylistUpdateOne) SetUpdatedAt(v time.Time) *PlaylistUpdateOne {
 	_u.mutation.SetUpdatedAt(v)
@@ -686,6 +732,12 @@ func (_u *PlaylistUpdateOne) sqlSave(ctx context.Context) (_node *Playlist, err
 	if _u.mutation.ThumbnailPathCleared() {
 		_spec.ClearField(playlist.FieldThumbnailPath, field.TypeString)
 	}
+	if value, ok := _u.mutation.ExtStreamID(); ok {
+		_spec.SetField(playlist.FieldExtStreamID, field.TypeString, value)
+	}
+	if _u.mutation.ExtStreamIDCleared() {
+		_spec.ClearField(playlist.FieldExtStreamID, field.TypeString)
+	}
 	if value, ok := _u.mutation.UpdatedAt(); ok {
 		_spec.SetField(playlist.FieldUpdatedAt, field.TypeTime, value)
 	}
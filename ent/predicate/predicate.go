@@ -18,12 +18,24 @@ type Channel func(*sql.Selector)
 // Chapter is the predicate function for chapter builders.
 type Chapter func(*sql.Selector)
 
+// ChatMessage is the predicate function for chatmessage builders.
+type ChatMessage func(*sql.Selector)
+
+// Checksum is the predicate function for checksum builders.
+type Checksum func(*sql.Selector)
+
 // Live is the predicate function for live builders.
 type Live func(*sql.Selector)
 
 // LiveCategory is the predicate function for livecategory builders.
 type LiveCategory func(*sql.Selector)
 
+// LiveGap is the predicate function for livegap builders.
+type LiveGap func(*sql.Selector)
+
+// LiveSchedule is the predicate function for liveschedule builders.
+type LiveSchedule func(*sql.Selector)
+
 // LiveTitleRegex is the predicate function for livetitleregex builders.
 type LiveTitleRegex func(*sql.Selector)
 
@@ -54,11 +66,23 @@ type Queue func(*sql.Selector)
 // Sessions is the predicate function for sessions builders.
 type Sessions func(*sql.Selector)
 
+// TranscodingProfile is the predicate function for transcodingprofile builders.
+type TranscodingProfile func(*sql.Selector)
+
 // TwitchCategory is the predicate function for twitchcategory builders.
 type TwitchCategory func(*sql.Selector)
 
 // User is the predicate function for user builders.
 type User func(*sql.Selector)
 
+// UserList is the predicate function for userlist builders.
+type UserList func(*sql.Selector)
+
+// UserListItem is the predicate function for userlistitem builders.
+type UserListItem func(*sql.Selector)
+
+// ViewingSession is the predicate function for viewingsession builders.
+type ViewingSession func(*sql.Selector)
+
 // Vod is the predicate function for vod builders.
 type Vod func(*sql.Selector)
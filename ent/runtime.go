@@ -10,8 +10,12 @@ import (
 	"github.com/zibbp/ganymede/ent/blockedvideos"
 	"github.com/zibbp/ganymede/ent/channel"
 	"github.com/zibbp/ganymede/ent/chapter"
+	"github.com/zibbp/ganymede/ent/chatmessage"
+	"github.com/zibbp/ganymede/ent/checksum"
 	"github.com/zibbp/ganymede/ent/live"
 	"github.com/zibbp/ganymede/ent/livecategory"
+	"github.com/zibbp/ganymede/ent/livegap"
+	"github.com/zibbp/ganymede/ent/liveschedule"
 	"github.com/zibbp/ganymede/ent/livetitleregex"
 	"github.com/zibbp/ganymede/ent/mutedsegment"
 	"github.com/zibbp/ganymede/ent/notification"
@@ -22,9 +26,14 @@ import (
 	"github.com/zibbp/ganymede/ent/queue"
 	"github.com/zibbp/ganymede/ent/schema"
 	"github.com/zibbp/ganymede/ent/sessions"
+	"github.com/zibbp/ganymede/ent/transcodingprofile"
 	"github.com/zibbp/ganymede/ent/twitchcategory"
 	"github.com/zibbp/ganymede/ent/user"
+	"github.com/zibbp/ganymede/ent/userlist"
+	"github.com/zibbp/ganymede/ent/userlistitem"
+	"github.com/zibbp/ganymede/ent/viewingsession"
 	"github.com/zibbp/ganymede/ent/vod"
+	"github.com/zibbp/ganymede/internal/utils"
 )
 
 // The init function reads all schema descriptors with runtime code
@@ -72,21 +81,43 @@ func init() {
 	channelFields := schema.Channel{}.Fields()
 	_ = channelFields
 	// channelDescRetention is the schema descriptor for retention field.
-	channelDescRetention := channelFields[5].Descriptor()
+	channelDescRetention := channelFields[6].Descriptor()
 	// channel.DefaultRetention holds the default value on creation for the retention field.
 	channel.DefaultRetention = channelDescRetention.Default.(bool)
+	// channelDescRetentionKeepNewest is the schema descriptor for retention_keep_newest field.
+	channelDescRetentionKeepNewest := channelFields[8].Descriptor()
+	// channel.DefaultRetentionKeepNewest holds the default value on creation for the retention_keep_newest field.
+	channel.DefaultRetentionKeepNewest = channelDescRetentionKeepNewest.Default.(int)
+	// channel.RetentionKeepNewestValidator is a validator for the "retention_keep_newest" field. It is called by the builders before save.
+	channel.RetentionKeepNewestValidator = channelDescRetentionKeepNewest.Validators[0].(func(int) error)
+	// channelDescRetentionMaxSizeBytes is the schema descriptor for retention_max_size_bytes field.
+	channelDescRetentionMaxSizeBytes := channelFields[9].Descriptor()
+	// channel.DefaultRetentionMaxSizeBytes holds the default value on creation for the retention_max_size_bytes field.
+	channel.DefaultRetentionMaxSizeBytes = channelDescRetentionMaxSizeBytes.Default.(int64)
+	// channel.RetentionMaxSizeBytesValidator is a validator for the "retention_max_size_bytes" field. It is called by the builders before save.
+	channel.RetentionMaxSizeBytesValidator = channelDescRetentionMaxSizeBytes.Validators[0].(func(int64) error)
+	// channelDescRetentionExemptPlaylists is the schema descriptor for retention_exempt_playlists field.
+	channelDescRetentionExemptPlaylists := channelFields[11].Descriptor()
+	// channel.DefaultRetentionExemptPlaylists holds the default value on creation for the retention_exempt_playlists field.
+	channel.DefaultRetentionExemptPlaylists = channelDescRetentionExemptPlaylists.Default.(bool)
+	// channelDescRetentionExemptMinViews is the schema descriptor for retention_exempt_min_views field.
+	channelDescRetentionExemptMinViews := channelFields[12].Descriptor()
+	// channel.DefaultRetentionExemptMinViews holds the default value on creation for the retention_exempt_min_views field.
+	channel.DefaultRetentionExemptMinViews = channelDescRetentionExemptMinViews.Default.(int)
+	// channel.RetentionExemptMinViewsValidator is a validator for the "retention_exempt_min_views" field. It is called by the builders before save.
+	channel.RetentionExemptMinViewsValidator = channelDescRetentionExemptMinViews.Validators[0].(func(int) error)
 	// channelDescStorageSizeBytes is the schema descriptor for storage_size_bytes field.
-	channelDescStorageSizeBytes := channelFields[7].Descriptor()
+	channelDescStorageSizeBytes := channelFields[13].Descriptor()
 	// channel.DefaultStorageSizeBytes holds the default value on creation for the storage_size_bytes field.
 	channel.DefaultStorageSizeBytes = channelDescStorageSizeBytes.Default.(int64)
 	// channelDescUpdatedAt is the schema descriptor for updated_at field.
-	channelDescUpdatedAt := channelFields[8].Descriptor()
+	channelDescUpdatedAt := channelFields[14].Descriptor()
 	// channel.DefaultUpdatedAt holds the default value on creation for the updated_at field.
 	channel.DefaultUpdatedAt = channelDescUpdatedAt.Default.(func() time.Time)
 	// channel.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
 	channel.UpdateDefaultUpdatedAt = channelDescUpdatedAt.UpdateDefault.(func() time.Time)
 	// channelDescCreatedAt is the schema descriptor for created_at field.
-	channelDescCreatedAt := channelFields[9].Descriptor()
+	channelDescCreatedAt := channelFields[15].Descriptor()
 	// channel.DefaultCreatedAt holds the default value on creation for the created_at field.
 	channel.DefaultCreatedAt = channelDescCreatedAt.Default.(func() time.Time)
 	// channelDescID is the schema descriptor for id field.
@@ -99,6 +130,40 @@ func init() {
 	chapterDescID := chapterFields[0].Descriptor()
 	// chapter.DefaultID holds the default value on creation for the id field.
 	chapter.DefaultID = chapterDescID.Default.(func() uuid.UUID)
+	chatmessageFields := schema.ChatMessage{}.Fields()
+	_ = chatmessageFields
+	// chatmessageDescCreatedAt is the schema descriptor for created_at field.
+	chatmessageDescCreatedAt := chatmessageFields[9].Descriptor()
+	// chatmessage.DefaultCreatedAt holds the default value on creation for the created_at field.
+	chatmessage.DefaultCreatedAt = chatmessageDescCreatedAt.Default.(func() time.Time)
+	// chatmessageDescID is the schema descriptor for id field.
+	chatmessageDescID := chatmessageFields[0].Descriptor()
+	// chatmessage.DefaultID holds the default value on creation for the id field.
+	chatmessage.DefaultID = chatmessageDescID.Default.(func() uuid.UUID)
+	checksumFields := schema.Checksum{}.Fields()
+	_ = checksumFields
+	// checksumDescName is the schema descriptor for name field.
+	checksumDescName := checksumFields[3].Descriptor()
+	// checksum.DefaultName holds the default value on creation for the name field.
+	checksum.DefaultName = checksumDescName.Default.(string)
+	// checksumDescVerifiedAt is the schema descriptor for verified_at field.
+	checksumDescVerifiedAt := checksumFields[7].Descriptor()
+	// checksum.DefaultVerifiedAt holds the default value on creation for the verified_at field.
+	checksum.DefaultVerifiedAt = checksumDescVerifiedAt.Default.(func() time.Time)
+	// checksumDescUpdatedAt is the schema descriptor for updated_at field.
+	checksumDescUpdatedAt := checksumFields[8].Descriptor()
+	// checksum.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	checksum.DefaultUpdatedAt = checksumDescUpdatedAt.Default.(func() time.Time)
+	// checksum.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	checksum.UpdateDefaultUpdatedAt = checksumDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// checksumDescCreatedAt is the schema descriptor for created_at field.
+	checksumDescCreatedAt := checksumFields[9].Descriptor()
+	// checksum.DefaultCreatedAt holds the default value on creation for the created_at field.
+	checksum.DefaultCreatedAt = checksumDescCreatedAt.Default.(func() time.Time)
+	// checksumDescID is the schema descriptor for id field.
+	checksumDescID := checksumFields[0].Descriptor()
+	// checksum.DefaultID holds the default value on creation for the id field.
+	checksum.DefaultID = checksumDescID.Default.(func() uuid.UUID)
 	liveFields := schema.Live{}.Fields()
 	_ = liveFields
 	// liveDescWatchLive is the schema descriptor for watch_live field.
@@ -137,64 +202,82 @@ func init() {
 	liveDescResolution := liveFields[9].Descriptor()
 	// live.DefaultResolution holds the default value on creation for the resolution field.
 	live.DefaultResolution = liveDescResolution.Default.(string)
+	// liveDescRecordAudioFallback is the schema descriptor for record_audio_fallback field.
+	liveDescRecordAudioFallback := liveFields[11].Descriptor()
+	// live.DefaultRecordAudioFallback holds the default value on creation for the record_audio_fallback field.
+	live.DefaultRecordAudioFallback = liveDescRecordAudioFallback.Default.(bool)
+	// liveDescSplitHours is the schema descriptor for split_hours field.
+	liveDescSplitHours := liveFields[12].Descriptor()
+	// live.DefaultSplitHours holds the default value on creation for the split_hours field.
+	live.DefaultSplitHours = liveDescSplitHours.Default.(int)
+	// live.SplitHoursValidator is a validator for the "split_hours" field. It is called by the builders before save.
+	live.SplitHoursValidator = liveDescSplitHours.Validators[0].(func(int) error)
+	// liveDescSplitOnCategoryChange is the schema descriptor for split_on_category_change field.
+	liveDescSplitOnCategoryChange := liveFields[13].Descriptor()
+	// live.DefaultSplitOnCategoryChange holds the default value on creation for the split_on_category_change field.
+	live.DefaultSplitOnCategoryChange = liveDescSplitOnCategoryChange.Default.(bool)
 	// liveDescVodResolution is the schema descriptor for vod_resolution field.
-	liveDescVodResolution := liveFields[10].Descriptor()
+	liveDescVodResolution := liveFields[14].Descriptor()
 	// live.DefaultVodResolution holds the default value on creation for the vod_resolution field.
 	live.DefaultVodResolution = liveDescVodResolution.Default.(string)
 	// liveDescLastLive is the schema descriptor for last_live field.
-	liveDescLastLive := liveFields[11].Descriptor()
+	liveDescLastLive := liveFields[15].Descriptor()
 	// live.DefaultLastLive holds the default value on creation for the last_live field.
 	live.DefaultLastLive = liveDescLastLive.Default.(func() time.Time)
 	// liveDescRenderChat is the schema descriptor for render_chat field.
-	liveDescRenderChat := liveFields[12].Descriptor()
+	liveDescRenderChat := liveFields[16].Descriptor()
 	// live.DefaultRenderChat holds the default value on creation for the render_chat field.
 	live.DefaultRenderChat = liveDescRenderChat.Default.(bool)
+	// liveDescGenerateCaptions is the schema descriptor for generate_captions field.
+	liveDescGenerateCaptions := liveFields[17].Descriptor()
+	// live.DefaultGenerateCaptions holds the default value on creation for the generate_captions field.
+	live.DefaultGenerateCaptions = liveDescGenerateCaptions.Default.(bool)
 	// liveDescVideoAge is the schema descriptor for video_age field.
-	liveDescVideoAge := liveFields[13].Descriptor()
+	liveDescVideoAge := liveFields[18].Descriptor()
 	// live.DefaultVideoAge holds the default value on creation for the video_age field.
 	live.DefaultVideoAge = liveDescVideoAge.Default.(int64)
 	// liveDescApplyCategoriesToLive is the schema descriptor for apply_categories_to_live field.
-	liveDescApplyCategoriesToLive := liveFields[14].Descriptor()
+	liveDescApplyCategoriesToLive := liveFields[19].Descriptor()
 	// live.DefaultApplyCategoriesToLive holds the default value on creation for the apply_categories_to_live field.
 	live.DefaultApplyCategoriesToLive = liveDescApplyCategoriesToLive.Default.(bool)
 	// liveDescStrictCategoriesLive is the schema descriptor for strict_categories_live field.
-	liveDescStrictCategoriesLive := liveFields[15].Descriptor()
+	liveDescStrictCategoriesLive := liveFields[20].Descriptor()
 	// live.DefaultStrictCategoriesLive holds the default value on creation for the strict_categories_live field.
 	live.DefaultStrictCategoriesLive = liveDescStrictCategoriesLive.Default.(bool)
 	// liveDescBlacklistCategories is the schema descriptor for blacklist_categories field.
-	liveDescBlacklistCategories := liveFields[16].Descriptor()
+	liveDescBlacklistCategories := liveFields[21].Descriptor()
 	// live.DefaultBlacklistCategories holds the default value on creation for the blacklist_categories field.
 	live.DefaultBlacklistCategories = liveDescBlacklistCategories.Default.(bool)
 	// liveDescWatchClips is the schema descriptor for watch_clips field.
-	liveDescWatchClips := liveFields[17].Descriptor()
+	liveDescWatchClips := liveFields[22].Descriptor()
 	// live.DefaultWatchClips holds the default value on creation for the watch_clips field.
 	live.DefaultWatchClips = liveDescWatchClips.Default.(bool)
 	// liveDescClipsLimit is the schema descriptor for clips_limit field.
-	liveDescClipsLimit := liveFields[18].Descriptor()
+	liveDescClipsLimit := liveFields[23].Descriptor()
 	// live.DefaultClipsLimit holds the default value on creation for the clips_limit field.
 	live.DefaultClipsLimit = liveDescClipsLimit.Default.(int)
 	// liveDescClipsIntervalDays is the schema descriptor for clips_interval_days field.
-	liveDescClipsIntervalDays := liveFields[19].Descriptor()
+	liveDescClipsIntervalDays := liveFields[24].Descriptor()
 	// live.DefaultClipsIntervalDays holds the default value on creation for the clips_interval_days field.
 	live.DefaultClipsIntervalDays = liveDescClipsIntervalDays.Default.(int)
 	// liveDescClipsIgnoreLastChecked is the schema descriptor for clips_ignore_last_checked field.
-	liveDescClipsIgnoreLastChecked := liveFields[21].Descriptor()
+	liveDescClipsIgnoreLastChecked := liveFields[26].Descriptor()
 	// live.DefaultClipsIgnoreLastChecked holds the default value on creation for the clips_ignore_last_checked field.
 	live.DefaultClipsIgnoreLastChecked = liveDescClipsIgnoreLastChecked.Default.(bool)
 	// liveDescUpdateMetadataMinutes is the schema descriptor for update_metadata_minutes field.
-	liveDescUpdateMetadataMinutes := liveFields[22].Descriptor()
+	liveDescUpdateMetadataMinutes := liveFields[27].Descriptor()
 	// live.DefaultUpdateMetadataMinutes holds the default value on creation for the update_metadata_minutes field.
 	live.DefaultUpdateMetadataMinutes = liveDescUpdateMetadataMinutes.Default.(int)
 	// live.UpdateMetadataMinutesValidator is a validator for the "update_metadata_minutes" field. It is called by the builders before save.
 	live.UpdateMetadataMinutesValidator = liveDescUpdateMetadataMinutes.Validators[0].(func(int) error)
 	// liveDescUpdatedAt is the schema descriptor for updated_at field.
-	liveDescUpdatedAt := liveFields[23].Descriptor()
+	liveDescUpdatedAt := liveFields[29].Descriptor()
 	// live.DefaultUpdatedAt holds the default value on creation for the updated_at field.
 	live.DefaultUpdatedAt = liveDescUpdatedAt.Default.(func() time.Time)
 	// live.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
 	live.UpdateDefaultUpdatedAt = liveDescUpdatedAt.UpdateDefault.(func() time.Time)
 	// liveDescCreatedAt is the schema descriptor for created_at field.
-	liveDescCreatedAt := liveFields[24].Descriptor()
+	liveDescCreatedAt := liveFields[30].Descriptor()
 	// live.DefaultCreatedAt holds the default value on creation for the created_at field.
 	live.DefaultCreatedAt = liveDescCreatedAt.Default.(func() time.Time)
 	// liveDescID is the schema descriptor for id field.
@@ -207,6 +290,46 @@ func init() {
 	livecategoryDescID := livecategoryFields[0].Descriptor()
 	// livecategory.DefaultID holds the default value on creation for the id field.
 	livecategory.DefaultID = livecategoryDescID.Default.(func() uuid.UUID)
+	livegapFields := schema.LiveGap{}.Fields()
+	_ = livegapFields
+	// livegapDescCreatedAt is the schema descriptor for created_at field.
+	livegapDescCreatedAt := livegapFields[6].Descriptor()
+	// livegap.DefaultCreatedAt holds the default value on creation for the created_at field.
+	livegap.DefaultCreatedAt = livegapDescCreatedAt.Default.(func() time.Time)
+	// livegapDescID is the schema descriptor for id field.
+	livegapDescID := livegapFields[0].Descriptor()
+	// livegap.DefaultID holds the default value on creation for the id field.
+	livegap.DefaultID = livegapDescID.Default.(func() uuid.UUID)
+	livescheduleFields := schema.LiveSchedule{}.Fields()
+	_ = livescheduleFields
+	// livescheduleDescTimezone is the schema descriptor for timezone field.
+	livescheduleDescTimezone := livescheduleFields[2].Descriptor()
+	// liveschedule.DefaultTimezone holds the default value on creation for the timezone field.
+	liveschedule.DefaultTimezone = livescheduleDescTimezone.Default.(string)
+	// livescheduleDescMaxStreamHours is the schema descriptor for max_stream_hours field.
+	livescheduleDescMaxStreamHours := livescheduleFields[4].Descriptor()
+	// liveschedule.DefaultMaxStreamHours holds the default value on creation for the max_stream_hours field.
+	liveschedule.DefaultMaxStreamHours = livescheduleDescMaxStreamHours.Default.(int)
+	// liveschedule.MaxStreamHoursValidator is a validator for the "max_stream_hours" field. It is called by the builders before save.
+	liveschedule.MaxStreamHoursValidator = livescheduleDescMaxStreamHours.Validators[0].(func(int) error)
+	// livescheduleDescFirstStreamOfDay is the schema descriptor for first_stream_of_day field.
+	livescheduleDescFirstStreamOfDay := livescheduleFields[5].Descriptor()
+	// liveschedule.DefaultFirstStreamOfDay holds the default value on creation for the first_stream_of_day field.
+	liveschedule.DefaultFirstStreamOfDay = livescheduleDescFirstStreamOfDay.Default.(bool)
+	// livescheduleDescUpdatedAt is the schema descriptor for updated_at field.
+	livescheduleDescUpdatedAt := livescheduleFields[6].Descriptor()
+	// liveschedule.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	liveschedule.DefaultUpdatedAt = livescheduleDescUpdatedAt.Default.(func() time.Time)
+	// liveschedule.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	liveschedule.UpdateDefaultUpdatedAt = livescheduleDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// livescheduleDescCreatedAt is the schema descriptor for created_at field.
+	livescheduleDescCreatedAt := livescheduleFields[7].Descriptor()
+	// liveschedule.DefaultCreatedAt holds the default value on creation for the created_at field.
+	liveschedule.DefaultCreatedAt = livescheduleDescCreatedAt.Default.(func() time.Time)
+	// livescheduleDescID is the schema descriptor for id field.
+	livescheduleDescID := livescheduleFields[0].Descriptor()
+	// liveschedule.DefaultID holds the default value on creation for the id field.
+	liveschedule.DefaultID = livescheduleDescID.Default.(func() uuid.UUID)
 	livetitleregexFields := schema.LiveTitleRegex{}.Fields()
 	_ = livetitleregexFields
 	// livetitleregexDescNegative is the schema descriptor for negative field.
@@ -223,6 +346,10 @@ func init() {
 	livetitleregex.DefaultID = livetitleregexDescID.Default.(func() uuid.UUID)
 	mutedsegmentFields := schema.MutedSegment{}.Fields()
 	_ = mutedsegmentFields
+	// mutedsegmentDescRecovered is the schema descriptor for recovered field.
+	mutedsegmentDescRecovered := mutedsegmentFields[3].Descriptor()
+	// mutedsegment.DefaultRecovered holds the default value on creation for the recovered field.
+	mutedsegment.DefaultRecovered = mutedsegmentDescRecovered.Default.(bool)
 	// mutedsegmentDescID is the schema descriptor for id field.
 	mutedsegmentDescID := mutedsegmentFields[0].Descriptor()
 	// mutedsegment.DefaultID holds the default value on creation for the id field.
@@ -285,56 +412,76 @@ func init() {
 	notificationDescTriggerIsLive := notificationFields[8].Descriptor()
 	// notification.DefaultTriggerIsLive holds the default value on creation for the trigger_is_live field.
 	notification.DefaultTriggerIsLive = notificationDescTriggerIsLive.Default.(bool)
+	// notificationDescTriggerLowDiskSpace is the schema descriptor for trigger_low_disk_space field.
+	notificationDescTriggerLowDiskSpace := notificationFields[9].Descriptor()
+	// notification.DefaultTriggerLowDiskSpace holds the default value on creation for the trigger_low_disk_space field.
+	notification.DefaultTriggerLowDiskSpace = notificationDescTriggerLowDiskSpace.Default.(bool)
+	// notificationDescTriggerChecksumMismatch is the schema descriptor for trigger_checksum_mismatch field.
+	notificationDescTriggerChecksumMismatch := notificationFields[10].Descriptor()
+	// notification.DefaultTriggerChecksumMismatch holds the default value on creation for the trigger_checksum_mismatch field.
+	notification.DefaultTriggerChecksumMismatch = notificationDescTriggerChecksumMismatch.Default.(bool)
 	// notificationDescVideoSuccessTemplate is the schema descriptor for video_success_template field.
-	notificationDescVideoSuccessTemplate := notificationFields[9].Descriptor()
+	notificationDescVideoSuccessTemplate := notificationFields[11].Descriptor()
 	// notification.DefaultVideoSuccessTemplate holds the default value on creation for the video_success_template field.
 	notification.DefaultVideoSuccessTemplate = notificationDescVideoSuccessTemplate.Default.(string)
 	// notification.VideoSuccessTemplateValidator is a validator for the "video_success_template" field. It is called by the builders before save.
 	notification.VideoSuccessTemplateValidator = notificationDescVideoSuccessTemplate.Validators[0].(func(string) error)
 	// notificationDescLiveSuccessTemplate is the schema descriptor for live_success_template field.
-	notificationDescLiveSuccessTemplate := notificationFields[10].Descriptor()
+	notificationDescLiveSuccessTemplate := notificationFields[12].Descriptor()
 	// notification.DefaultLiveSuccessTemplate holds the default value on creation for the live_success_template field.
 	notification.DefaultLiveSuccessTemplate = notificationDescLiveSuccessTemplate.Default.(string)
 	// notification.LiveSuccessTemplateValidator is a validator for the "live_success_template" field. It is called by the builders before save.
 	notification.LiveSuccessTemplateValidator = notificationDescLiveSuccessTemplate.Validators[0].(func(string) error)
 	// notificationDescErrorTemplate is the schema descriptor for error_template field.
-	notificationDescErrorTemplate := notificationFields[11].Descriptor()
+	notificationDescErrorTemplate := notificationFields[13].Descriptor()
 	// notification.DefaultErrorTemplate holds the default value on creation for the error_template field.
 	notification.DefaultErrorTemplate = notificationDescErrorTemplate.Default.(string)
 	// notification.ErrorTemplateValidator is a validator for the "error_template" field. It is called by the builders before save.
 	notification.ErrorTemplateValidator = notificationDescErrorTemplate.Validators[0].(func(string) error)
 	// notificationDescIsLiveTemplate is the schema descriptor for is_live_template field.
-	notificationDescIsLiveTemplate := notificationFields[12].Descriptor()
+	notificationDescIsLiveTemplate := notificationFields[14].Descriptor()
 	// notification.DefaultIsLiveTemplate holds the default value on creation for the is_live_template field.
 	notification.DefaultIsLiveTemplate = notificationDescIsLiveTemplate.Default.(string)
 	// notification.IsLiveTemplateValidator is a validator for the "is_live_template" field. It is called by the builders before save.
 	notification.IsLiveTemplateValidator = notificationDescIsLiveTemplate.Validators[0].(func(string) error)
+	// notificationDescLowDiskSpaceTemplate is the schema descriptor for low_disk_space_template field.
+	notificationDescLowDiskSpaceTemplate := notificationFields[15].Descriptor()
+	// notification.DefaultLowDiskSpaceTemplate holds the default value on creation for the low_disk_space_template field.
+	notification.DefaultLowDiskSpaceTemplate = notificationDescLowDiskSpaceTemplate.Default.(string)
+	// notification.LowDiskSpaceTemplateValidator is a validator for the "low_disk_space_template" field. It is called by the builders before save.
+	notification.LowDiskSpaceTemplateValidator = notificationDescLowDiskSpaceTemplate.Validators[0].(func(string) error)
+	// notificationDescChecksumMismatchTemplate is the schema descriptor for checksum_mismatch_template field.
+	notificationDescChecksumMismatchTemplate := notificationFields[16].Descriptor()
+	// notification.DefaultChecksumMismatchTemplate holds the default value on creation for the checksum_mismatch_template field.
+	notification.DefaultChecksumMismatchTemplate = notificationDescChecksumMismatchTemplate.Default.(string)
+	// notification.ChecksumMismatchTemplateValidator is a validator for the "checksum_mismatch_template" field. It is called by the builders before save.
+	notification.ChecksumMismatchTemplateValidator = notificationDescChecksumMismatchTemplate.Validators[0].(func(string) error)
 	// notificationDescAppriseUrls is the schema descriptor for apprise_urls field.
-	notificationDescAppriseUrls := notificationFields[13].Descriptor()
+	notificationDescAppriseUrls := notificationFields[17].Descriptor()
 	// notification.DefaultAppriseUrls holds the default value on creation for the apprise_urls field.
 	notification.DefaultAppriseUrls = notificationDescAppriseUrls.Default.(string)
 	// notification.AppriseUrlsValidator is a validator for the "apprise_urls" field. It is called by the builders before save.
 	notification.AppriseUrlsValidator = notificationDescAppriseUrls.Validators[0].(func(string) error)
 	// notificationDescAppriseTitle is the schema descriptor for apprise_title field.
-	notificationDescAppriseTitle := notificationFields[14].Descriptor()
+	notificationDescAppriseTitle := notificationFields[18].Descriptor()
 	// notification.DefaultAppriseTitle holds the default value on creation for the apprise_title field.
 	notification.DefaultAppriseTitle = notificationDescAppriseTitle.Default.(string)
 	// notification.AppriseTitleValidator is a validator for the "apprise_title" field. It is called by the builders before save.
 	notification.AppriseTitleValidator = notificationDescAppriseTitle.Validators[0].(func(string) error)
 	// notificationDescAppriseTag is the schema descriptor for apprise_tag field.
-	notificationDescAppriseTag := notificationFields[16].Descriptor()
+	notificationDescAppriseTag := notificationFields[20].Descriptor()
 	// notification.DefaultAppriseTag holds the default value on creation for the apprise_tag field.
 	notification.DefaultAppriseTag = notificationDescAppriseTag.Default.(string)
 	// notification.AppriseTagValidator is a validator for the "apprise_tag" field. It is called by the builders before save.
 	notification.AppriseTagValidator = notificationDescAppriseTag.Validators[0].(func(string) error)
 	// notificationDescUpdatedAt is the schema descriptor for updated_at field.
-	notificationDescUpdatedAt := notificationFields[18].Descriptor()
+	notificationDescUpdatedAt := notificationFields[22].Descriptor()
 	// notification.DefaultUpdatedAt holds the default value on creation for the updated_at field.
 	notification.DefaultUpdatedAt = notificationDescUpdatedAt.Default.(func() time.Time)
 	// notification.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
 	notification.UpdateDefaultUpdatedAt = notificationDescUpdatedAt.UpdateDefault.(func() time.Time)
 	// notificationDescCreatedAt is the schema descriptor for created_at field.
-	notificationDescCreatedAt := notificationFields[19].Descriptor()
+	notificationDescCreatedAt := notificationFields[23].Descriptor()
 	// notification.DefaultCreatedAt holds the default value on creation for the created_at field.
 	notification.DefaultCreatedAt = notificationDescCreatedAt.Default.(func() time.Time)
 	// notificationDescID is the schema descriptor for id field.
@@ -364,13 +511,13 @@ func init() {
 	playlistFields := schema.Playlist{}.Fields()
 	_ = playlistFields
 	// playlistDescUpdatedAt is the schema descriptor for updated_at field.
-	playlistDescUpdatedAt := playlistFields[4].Descriptor()
+	playlistDescUpdatedAt := playlistFields[5].Descriptor()
 	// playlist.DefaultUpdatedAt holds the default value on creation for the updated_at field.
 	playlist.DefaultUpdatedAt = playlistDescUpdatedAt.Default.(func() time.Time)
 	// playlist.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
 	playlist.UpdateDefaultUpdatedAt = playlistDescUpdatedAt.UpdateDefault.(func() time.Time)
 	// playlistDescCreatedAt is the schema descriptor for created_at field.
-	playlistDescCreatedAt := playlistFields[5].Descriptor()
+	playlistDescCreatedAt := playlistFields[6].Descriptor()
 	// playlist.DefaultCreatedAt holds the default value on creation for the created_at field.
 	playlist.DefaultCreatedAt = playlistDescCreatedAt.Default.(func() time.Time)
 	// playlistDescID is the schema descriptor for id field.
@@ -455,6 +602,52 @@ func init() {
 	sessionsDescData := sessionsFields[1].Descriptor()
 	// sessions.DataValidator is a validator for the "data" field. It is called by the builders before save.
 	sessions.DataValidator = sessionsDescData.Validators[0].(func([]byte) error)
+	transcodingprofileFields := schema.TranscodingProfile{}.Fields()
+	_ = transcodingprofileFields
+	// transcodingprofileDescName is the schema descriptor for name field.
+	transcodingprofileDescName := transcodingprofileFields[1].Descriptor()
+	// transcodingprofile.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	transcodingprofile.NameValidator = func() func(string) error {
+		validators := transcodingprofileDescName.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(name string) error {
+			for _, fn := range fns {
+				if err := fn(name); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// transcodingprofileDescDescription is the schema descriptor for description field.
+	transcodingprofileDescDescription := transcodingprofileFields[2].Descriptor()
+	// transcodingprofile.DefaultDescription holds the default value on creation for the description field.
+	transcodingprofile.DefaultDescription = transcodingprofileDescDescription.Default.(string)
+	// transcodingprofile.DescriptionValidator is a validator for the "description" field. It is called by the builders before save.
+	transcodingprofile.DescriptionValidator = transcodingprofileDescDescription.Validators[0].(func(string) error)
+	// transcodingprofileDescFfmpegArgs is the schema descriptor for ffmpeg_args field.
+	transcodingprofileDescFfmpegArgs := transcodingprofileFields[3].Descriptor()
+	// transcodingprofile.DefaultFfmpegArgs holds the default value on creation for the ffmpeg_args field.
+	transcodingprofile.DefaultFfmpegArgs = transcodingprofileDescFfmpegArgs.Default.(string)
+	// transcodingprofile.FfmpegArgsValidator is a validator for the "ffmpeg_args" field. It is called by the builders before save.
+	transcodingprofile.FfmpegArgsValidator = transcodingprofileDescFfmpegArgs.Validators[0].(func(string) error)
+	// transcodingprofileDescUpdatedAt is the schema descriptor for updated_at field.
+	transcodingprofileDescUpdatedAt := transcodingprofileFields[4].Descriptor()
+	// transcodingprofile.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	transcodingprofile.DefaultUpdatedAt = transcodingprofileDescUpdatedAt.Default.(func() time.Time)
+	// transcodingprofile.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	transcodingprofile.UpdateDefaultUpdatedAt = transcodingprofileDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// transcodingprofileDescCreatedAt is the schema descriptor for created_at field.
+	transcodingprofileDescCreatedAt := transcodingprofileFields[5].Descriptor()
+	// transcodingprofile.DefaultCreatedAt holds the default value on creation for the created_at field.
+	transcodingprofile.DefaultCreatedAt = transcodingprofileDescCreatedAt.Default.(func() time.Time)
+	// transcodingprofileDescID is the schema descriptor for id field.
+	transcodingprofileDescID := transcodingprofileFields[0].Descriptor()
+	// transcodingprofile.DefaultID holds the default value on creation for the id field.
+	transcodingprofile.DefaultID = transcodingprofileDescID.Default.(func() uuid.UUID)
 	twitchcategoryFields := schema.TwitchCategory{}.Fields()
 	_ = twitchcategoryFields
 	// twitchcategoryDescUpdatedAt is the schema descriptor for updated_at field.
@@ -487,48 +680,124 @@ func init() {
 	userDescID := userFields[0].Descriptor()
 	// user.DefaultID holds the default value on creation for the id field.
 	user.DefaultID = userDescID.Default.(func() uuid.UUID)
+	userlistFields := schema.UserList{}.Fields()
+	_ = userlistFields
+	// userlistDescUpdatedAt is the schema descriptor for updated_at field.
+	userlistDescUpdatedAt := userlistFields[5].Descriptor()
+	// userlist.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	userlist.DefaultUpdatedAt = userlistDescUpdatedAt.Default.(func() time.Time)
+	// userlist.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	userlist.UpdateDefaultUpdatedAt = userlistDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// userlistDescCreatedAt is the schema descriptor for created_at field.
+	userlistDescCreatedAt := userlistFields[6].Descriptor()
+	// userlist.DefaultCreatedAt holds the default value on creation for the created_at field.
+	userlist.DefaultCreatedAt = userlistDescCreatedAt.Default.(func() time.Time)
+	// userlistDescID is the schema descriptor for id field.
+	userlistDescID := userlistFields[0].Descriptor()
+	// userlist.DefaultID holds the default value on creation for the id field.
+	userlist.DefaultID = userlistDescID.Default.(func() uuid.UUID)
+	userlistitemFields := schema.UserListItem{}.Fields()
+	_ = userlistitemFields
+	// userlistitemDescPosition is the schema descriptor for position field.
+	userlistitemDescPosition := userlistitemFields[3].Descriptor()
+	// userlistitem.DefaultPosition holds the default value on creation for the position field.
+	userlistitem.DefaultPosition = userlistitemDescPosition.Default.(int)
+	// userlistitemDescCreatedAt is the schema descriptor for created_at field.
+	userlistitemDescCreatedAt := userlistitemFields[4].Descriptor()
+	// userlistitem.DefaultCreatedAt holds the default value on creation for the created_at field.
+	userlistitem.DefaultCreatedAt = userlistitemDescCreatedAt.Default.(func() time.Time)
+	// userlistitemDescID is the schema descriptor for id field.
+	userlistitemDescID := userlistitemFields[0].Descriptor()
+	// userlistitem.DefaultID holds the default value on creation for the id field.
+	userlistitem.DefaultID = userlistitemDescID.Default.(func() uuid.UUID)
+	viewingsessionFields := schema.ViewingSession{}.Fields()
+	_ = viewingsessionFields
+	// viewingsessionDescDevice is the schema descriptor for device field.
+	viewingsessionDescDevice := viewingsessionFields[3].Descriptor()
+	// viewingsession.DefaultDevice holds the default value on creation for the device field.
+	viewingsession.DefaultDevice = viewingsessionDescDevice.Default.(string)
+	// viewingsessionDescRanges is the schema descriptor for ranges field.
+	viewingsessionDescRanges := viewingsessionFields[4].Descriptor()
+	// viewingsession.DefaultRanges holds the default value on creation for the ranges field.
+	viewingsession.DefaultRanges = viewingsessionDescRanges.Default.([]utils.WatchedRange)
+	// viewingsessionDescWatchedSeconds is the schema descriptor for watched_seconds field.
+	viewingsessionDescWatchedSeconds := viewingsessionFields[5].Descriptor()
+	// viewingsession.DefaultWatchedSeconds holds the default value on creation for the watched_seconds field.
+	viewingsession.DefaultWatchedSeconds = viewingsessionDescWatchedSeconds.Default.(float64)
+	// viewingsessionDescStartedAt is the schema descriptor for started_at field.
+	viewingsessionDescStartedAt := viewingsessionFields[6].Descriptor()
+	// viewingsession.DefaultStartedAt holds the default value on creation for the started_at field.
+	viewingsession.DefaultStartedAt = viewingsessionDescStartedAt.Default.(func() time.Time)
+	// viewingsessionDescEndedAt is the schema descriptor for ended_at field.
+	viewingsessionDescEndedAt := viewingsessionFields[7].Descriptor()
+	// viewingsession.DefaultEndedAt holds the default value on creation for the ended_at field.
+	viewingsession.DefaultEndedAt = viewingsessionDescEndedAt.Default.(func() time.Time)
+	// viewingsessionDescUpdatedAt is the schema descriptor for updated_at field.
+	viewingsessionDescUpdatedAt := viewingsessionFields[8].Descriptor()
+	// viewingsession.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	viewingsession.DefaultUpdatedAt = viewingsessionDescUpdatedAt.Default.(func() time.Time)
+	// viewingsession.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	viewingsession.UpdateDefaultUpdatedAt = viewingsessionDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// viewingsessionDescCreatedAt is the schema descriptor for created_at field.
+	viewingsessionDescCreatedAt := viewingsessionFields[9].Descriptor()
+	// viewingsession.DefaultCreatedAt holds the default value on creation for the created_at field.
+	viewingsession.DefaultCreatedAt = viewingsessionDescCreatedAt.Default.(func() time.Time)
+	// viewingsessionDescID is the schema descriptor for id field.
+	viewingsessionDescID := viewingsessionFields[0].Descriptor()
+	// viewingsession.DefaultID holds the default value on creation for the id field.
+	viewingsession.DefaultID = viewingsessionDescID.Default.(func() uuid.UUID)
 	vodFields := schema.Vod{}.Fields()
 	_ = vodFields
+	// vodDescPart is the schema descriptor for part field.
+	vodDescPart := vodFields[4].Descriptor()
+	// vod.DefaultPart holds the default value on creation for the part field.
+	vod.DefaultPart = vodDescPart.Default.(int)
+	// vod.PartValidator is a validator for the "part" field. It is called by the builders before save.
+	vod.PartValidator = vodDescPart.Validators[0].(func(int) error)
 	// vodDescDuration is the schema descriptor for duration field.
-	vodDescDuration := vodFields[7].Descriptor()
+	vodDescDuration := vodFields[9].Descriptor()
 	// vod.DefaultDuration holds the default value on creation for the duration field.
 	vod.DefaultDuration = vodDescDuration.Default.(int)
 	// vodDescViews is the schema descriptor for views field.
-	vodDescViews := vodFields[9].Descriptor()
+	vodDescViews := vodFields[12].Descriptor()
 	// vod.DefaultViews holds the default value on creation for the views field.
 	vod.DefaultViews = vodDescViews.Default.(int)
 	// vodDescProcessing is the schema descriptor for processing field.
-	vodDescProcessing := vodFields[11].Descriptor()
+	vodDescProcessing := vodFields[16].Descriptor()
 	// vod.DefaultProcessing holds the default value on creation for the processing field.
 	vod.DefaultProcessing = vodDescProcessing.Default.(bool)
 	// vodDescLocked is the schema descriptor for locked field.
-	vodDescLocked := vodFields[31].Descriptor()
+	vodDescLocked := vodFields[38].Descriptor()
 	// vod.DefaultLocked holds the default value on creation for the locked field.
 	vod.DefaultLocked = vodDescLocked.Default.(bool)
 	// vodDescLocalViews is the schema descriptor for local_views field.
-	vodDescLocalViews := vodFields[32].Descriptor()
+	vodDescLocalViews := vodFields[39].Descriptor()
 	// vod.DefaultLocalViews holds the default value on creation for the local_views field.
 	vod.DefaultLocalViews = vodDescLocalViews.Default.(int)
 	// vodDescSpriteThumbnailsEnabled is the schema descriptor for sprite_thumbnails_enabled field.
-	vodDescSpriteThumbnailsEnabled := vodFields[33].Descriptor()
+	vodDescSpriteThumbnailsEnabled := vodFields[40].Descriptor()
 	// vod.DefaultSpriteThumbnailsEnabled holds the default value on creation for the sprite_thumbnails_enabled field.
 	vod.DefaultSpriteThumbnailsEnabled = vodDescSpriteThumbnailsEnabled.Default.(bool)
 	// vodDescStorageSizeBytes is the schema descriptor for storage_size_bytes field.
-	vodDescStorageSizeBytes := vodFields[40].Descriptor()
+	vodDescStorageSizeBytes := vodFields[47].Descriptor()
 	// vod.DefaultStorageSizeBytes holds the default value on creation for the storage_size_bytes field.
 	vod.DefaultStorageSizeBytes = vodDescStorageSizeBytes.Default.(int64)
+	// vodDescAuthoritative is the schema descriptor for authoritative field.
+	vodDescAuthoritative := vodFields[55].Descriptor()
+	// vod.DefaultAuthoritative holds the default value on creation for the authoritative field.
+	vod.DefaultAuthoritative = vodDescAuthoritative.Default.(bool)
 	// vodDescStreamedAt is the schema descriptor for streamed_at field.
-	vodDescStreamedAt := vodFields[41].Descriptor()
+	vodDescStreamedAt := vodFields[56].Descriptor()
 	// vod.DefaultStreamedAt holds the default value on creation for the streamed_at field.
 	vod.DefaultStreamedAt = vodDescStreamedAt.Default.(func() time.Time)
 	// vodDescUpdatedAt is the schema descriptor for updated_at field.
-	vodDescUpdatedAt := vodFields[42].Descriptor()
+	vodDescUpdatedAt := vodFields[57].Descriptor()
 	// vod.DefaultUpdatedAt holds the default value on creation for the updated_at field.
 	vod.DefaultUpdatedAt = vodDescUpdatedAt.Default.(func() time.Time)
 	// vod.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
 	vod.UpdateDefaultUpdatedAt = vodDescUpdatedAt.UpdateDefault.(func() time.Time)
 	// vodDescCreatedAt is the schema descriptor for created_at field.
-	vodDescCreatedAt := vodFields[43].Descriptor()
+	vodDescCreatedAt := vodFields[58].Descriptor()
 	// vod.DefaultCreatedAt holds the default value on creation for the created_at field.
 	vod.DefaultCreatedAt = vodDescCreatedAt.Default.(func() time.Time)
 	// vodDescID is the schema descriptor for id field.
@@ -7,6 +7,7 @@ import (
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
 	"github.com/google/uuid"
+	"github.com/zibbp/ganymede/internal/utils"
 )
 
 // Channel holds the schema definition for the Channel entity.
@@ -22,8 +23,14 @@ func (Channel) Fields() []ent.Field {
 		field.String("name").Unique(),
 		field.String("display_name").Unique(),
 		field.String("image_path"),
+		field.Enum("platform").GoType(utils.VideoPlatform("")).Default(string(utils.PlatformTwitch)).Comment("The platform the channel is from, takes an enum."),
 		field.Bool("retention").Default(false),
 		field.Int64("retention_days").Optional(),
+		field.Int("retention_keep_newest").Default(0).Min(0).Comment("Keep only the newest N videos, 0 disables the rule."),
+		field.Int64("retention_max_size_bytes").Default(0).Min(0).Comment("Delete the oldest videos once the channel exceeds this size in bytes, 0 disables the rule."),
+		field.JSON("retention_video_types", []utils.VodType{}).Optional().Comment("Video types the retention policy applies to, all types if empty."),
+		field.Bool("retention_exempt_playlists").Default(false).Comment("Never delete videos that are in a playlist."),
+		field.Int("retention_exempt_min_views").Default(0).Min(0).Comment("Never delete videos with at least this many local views, 0 disables the exemption."),
 		field.Int64("storage_size_bytes").Default(0).Comment("Total storage size in bytes for the channel's videos."),
 		field.Time("updated_at").Default(time.Now).UpdateDefault(time.Now),
 		field.Time("created_at").Default(time.Now).Immutable(),
@@ -0,0 +1,50 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// ChatMessage holds the schema definition for the ChatMessage entity.
+// Messages are indexed from the archived chat file so chat can be searched across all videos.
+type ChatMessage struct {
+	ent.Schema
+}
+
+// Fields of the ChatMessage.
+func (ChatMessage) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).Default(uuid.New),
+		field.UUID("vod_id", uuid.UUID{}).Comment("The ID of the video the message belongs to."),
+		field.String("ext_id").Optional().Comment("The ID of the message on the external platform."),
+		field.Float("content_offset_seconds").Comment("The offset of the message from the start of the video in seconds."),
+		field.String("commenter_id").Optional().Comment("The ID of the chatter on the external platform."),
+		field.String("commenter_name").Optional().Comment("The login name of the chatter."),
+		field.String("commenter_display_name").Optional().Comment("The display name of the chatter."),
+		field.Text("body").Comment("The text of the message. Emotes are included by name."),
+		field.Strings("emotes").Optional().Comment("The names of the emotes used in the message."),
+		field.Time("created_at").Default(time.Now).Comment("Time the message was sent."),
+	}
+}
+
+// Edges of the ChatMessage.
+func (ChatMessage) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("vod", Vod.Type).Ref("chat_messages").Field("vod_id").Unique().Required(),
+	}
+}
+
+// Indexes of the ChatMessage.
+// The full-text index on the body is created outside of ent as it is an expression index, see database.ensureChatMessageSearchIndex.
+func (ChatMessage) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("vod_id", "content_offset_seconds"),
+		index.Fields("commenter_name"),
+		index.Fields("created_at"),
+	}
+}
@@ -0,0 +1,50 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+	"github.com/zibbp/ganymede/internal/utils"
+)
+
+// Checksum holds the schema definition for the Checksum entity.
+// The SHA-256 checksum of an archived file is recorded when the file is moved to storage and verified periodically to detect corruption.
+// The path of the file is resolved from the paths of the video so checksums stay valid when videos are moved or renamed.
+type Checksum struct {
+	ent.Schema
+}
+
+// Fields of the Checksum.
+func (Checksum) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).Default(uuid.New),
+		field.UUID("vod_id", uuid.UUID{}).Comment("The ID of the video the file belongs to."),
+		field.Enum("file").GoType(utils.ChecksumFile("")).Comment("The kind of file."),
+		field.String("name").Default("").Comment("The path of the file in the HLS directory for HLS video files, empty for other files as their path is saved on the video."),
+		field.String("sha256").Comment("The hex encoded SHA-256 checksum of the file."),
+		field.Int64("size_bytes").Comment("The size of the file in bytes."),
+		field.Enum("status").GoType(utils.ChecksumStatus("")).Default(string(utils.ChecksumStatusOk)).Comment("The result of the last verification."),
+		field.Time("verified_at").Default(time.Now).Comment("The time the file was last hashed."),
+		field.Time("updated_at").Default(time.Now).UpdateDefault(time.Now),
+		field.Time("created_at").Default(time.Now).Immutable(),
+	}
+}
+
+// Edges of the Checksum.
+func (Checksum) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("vod", Vod.Type).Ref("checksums").Field("vod_id").Unique().Required(),
+	}
+}
+
+// Indexes of the Checksum.
+func (Checksum) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("vod_id", "file", "name").Unique(),
+		index.Fields("verified_at"),
+	}
+}
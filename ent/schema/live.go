@@ -31,9 +31,14 @@ func (Live) Fields() []ent.Field {
 		field.Bool("is_live").Default(false).Comment("Whether the channel is currently live."),
 		field.Bool("archive_chat").Default(true).Comment("Whether the chat archive is enabled."),
 		field.String("resolution").Default("best").Optional().Comment("Live stream archive quality."),
+		field.Strings("quality_fallbacks").Optional().Comment("Qualities tried in order when archiving the live stream at the resolution keeps failing, e.g. 720p60 and best."),
+		field.Bool("record_audio_fallback").Default(false).Comment("Whether the audio of live streams is recorded alongside the video as a fallback."),
+		field.Int("split_hours").Default(0).Min(0).Comment("Split live stream archives into parts every X hours. Set to 0 to disable."),
+		field.Bool("split_on_category_change").Default(false).Comment("Whether live stream archives are split into parts when the category changes."),
 		field.String("vod_resolution").Default("best").Optional().Comment("Video and clip archive quality."),
 		field.Time("last_live").Default(time.Now).Comment("The time the channel last went live."),
 		field.Bool("render_chat").Default(true).Comment("Whether the chat should be rendered."),
+		field.Bool("generate_captions").Default(false).Comment("Whether captions should be generated with speech-to-text after archiving."),
 		field.Int64("video_age").Default(0).Comment("Restrict fetching videos to a certain age."),
 		field.Bool("apply_categories_to_live").Default(false).Comment("Whether the categories should be applied to livestreams."),
 		field.Bool("strict_categories_live").Default(false).Comment("Stop live stream archive if category changes to one not selected."),
@@ -44,6 +49,7 @@ func (Live) Fields() []ent.Field {
 		field.Time("clips_last_checked").Comment("Time when clips were last checked.").Optional(),
 		field.Bool("clips_ignore_last_checked").Default(false).Comment("Ignore last checked time and check all clips."),
 		field.Int("update_metadata_minutes").Default(15).Min(0).Comment("Queue metadata update X minutes after the stream is live. Set to 0 to disable."),
+		field.UUID("transcoding_profile_id", uuid.UUID{}).Optional().Nillable().Comment("The transcoding profile used to post-process archives of the channel. The global video convert arguments are used if empty."),
 		field.Time("updated_at").Default(time.Now).UpdateDefault(time.Now),
 		field.Time("created_at").Default(time.Now).Immutable(),
 	}
@@ -61,6 +67,13 @@ func (Live) Edges() []ent.Edge {
 		edge.To("title_regex", LiveTitleRegex.Type).StorageKey(edge.Column("live_id")).Annotations(
 			entsql.OnDelete(entsql.Cascade),
 		),
+		edge.To("schedule", LiveSchedule.Type).Unique().Annotations(
+			entsql.OnDelete(entsql.Cascade),
+		),
+		// deleting a profile falls back to the global video convert arguments
+		edge.To("transcoding_profile", TranscodingProfile.Type).Unique().Field("transcoding_profile_id").Annotations(
+			entsql.OnDelete(entsql.SetNull),
+		),
 	}
 }
 
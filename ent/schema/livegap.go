@@ -0,0 +1,45 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+	"github.com/zibbp/ganymede/internal/utils"
+)
+
+// LiveGap holds the schema definition for the LiveGap entity.
+// A gap is a part of a live stream that is missing from its archive because the recording dropped and was resumed.
+type LiveGap struct {
+	ent.Schema
+}
+
+// Fields of the LiveGap.
+func (LiveGap) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).Default(uuid.New),
+		field.UUID("vod_id", uuid.UUID{}).Comment("The ID of the live stream archive."),
+		field.Enum("reason").GoType(utils.LiveGapReason("")).Comment("Why the recording dropped."),
+		field.Time("started_at").Comment("The wall-clock time the recording dropped."),
+		field.Time("ended_at").Comment("The wall-clock time the recording resumed."),
+		field.Float("offset").Comment("The position of the gap in the archive in seconds. The archive doesn't include the gap."),
+		field.Time("created_at").Default(time.Now).Immutable(),
+	}
+}
+
+// Edges of the LiveGap.
+func (LiveGap) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("vod", Vod.Type).Ref("live_gaps").Field("vod_id").Unique().Required(),
+	}
+}
+
+// Indexes of the LiveGap.
+func (LiveGap) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("vod_id", "offset"),
+	}
+}
@@ -0,0 +1,38 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/zibbp/ganymede/internal/utils"
+)
+
+// LiveSchedule holds the schema definition for the LiveSchedule entity.
+// A schedule restricts when the live streams of a watched channel are archived.
+type LiveSchedule struct {
+	ent.Schema
+}
+
+// Fields of the LiveSchedule.
+func (LiveSchedule) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).Default(uuid.New),
+		field.UUID("live_id", uuid.UUID{}).Comment("The ID of the watched channel."),
+		field.String("timezone").Default("UTC").Comment("The IANA time zone the windows and days are in, e.g. Europe/Berlin."),
+		field.JSON("windows", []utils.LiveScheduleWindow{}).Optional().Comment("Live streams are only archived inside these windows. Live streams are archived at any time if empty."),
+		field.Int("max_stream_hours").Default(0).Min(0).Comment("Stop archiving a live stream after this many hours. Set to 0 to disable."),
+		field.Bool("first_stream_of_day").Default(false).Comment("Only archive the first live stream of a day."),
+		field.Time("updated_at").Default(time.Now).UpdateDefault(time.Now),
+		field.Time("created_at").Default(time.Now).Immutable(),
+	}
+}
+
+// Edges of the LiveSchedule.
+func (LiveSchedule) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("live", Live.Type).Ref("schedule").Field("live_id").Unique().Required(),
+	}
+}
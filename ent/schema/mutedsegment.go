@@ -18,6 +18,7 @@ func (MutedSegment) Fields() []ent.Field {
 		field.UUID("id", uuid.UUID{}).Default(uuid.New),
 		field.Int("start").Comment("The start time of the muted segment"),
 		field.Int("end").Comment("The end time of the muted segment"),
+		field.Bool("recovered").Default(false).Comment("Whether the audio of the muted segment was replaced with the audio of the live archive"),
 	}
 }
 
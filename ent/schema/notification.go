@@ -27,12 +27,16 @@ func (Notification) Fields() []ent.Field {
 		field.Bool("trigger_live_success").Default(false).Comment("Fire on live archive success."),
 		field.Bool("trigger_error").Default(false).Comment("Fire on task error."),
 		field.Bool("trigger_is_live").Default(false).Comment("Fire when a channel goes live."),
+		field.Bool("trigger_low_disk_space").Default(false).Comment("Fire when archiving is paused because of low disk space."),
+		field.Bool("trigger_checksum_mismatch").Default(false).Comment("Fire when archived files no longer match their checksums."),
 
 		// Templates
 		field.String("video_success_template").MaxLen(4096).Default("✅ Video Archived: {{vod_title}} by {{channel_display_name}}.").Comment("Template for video archive success body."),
 		field.String("live_success_template").MaxLen(4096).Default("✅ Live Stream Archived: {{vod_title}} by {{channel_display_name}}.").Comment("Template for live archive success body."),
 		field.String("error_template").MaxLen(4096).Default("⚠️ Error: Queue {{queue_id}} failed at task {{failed_task}}.").Comment("Template for error body."),
 		field.String("is_live_template").MaxLen(4096).Default("🔴 {{channel_display_name}} is live!").Comment("Template for is-live body."),
+		field.String("low_disk_space_template").MaxLen(4096).Default("💾 Low Disk Space: {{directory_path}} has {{free_space}} free, below the minimum of {{min_free_space}}. New archives are paused.").Comment("Template for low disk space body."),
+		field.String("checksum_mismatch_template").MaxLen(4096).Default("🧬 Checksum Mismatch: {{checksum_files}} of {{vod_title}} by {{channel_display_name}} changed or went missing.").Comment("Template for checksum mismatch body."),
 
 		// Apprise-specific fields (optional, only used when type=apprise)
 		field.String("apprise_urls").Optional().Default("").MaxLen(4096).Comment("Stateless Apprise URLs parameter."),
@@ -22,6 +22,7 @@ func (Playlist) Fields() []ent.Field {
 		field.String("name").Unique(),
 		field.String("description").Optional(),
 		field.String("thumbnail_path").Optional(),
+		field.String("ext_stream_id").Optional().Comment("The stream whose parts are grouped in the playlist, if it was created by splitting a live stream archive."),
 		field.Time("updated_at").Default(time.Now).UpdateDefault(time.Now),
 		field.Time("created_at").Default(time.Now).Immutable(),
 	}
@@ -0,0 +1,32 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// TranscodingProfile holds the schema definition for the TranscodingProfile entity.
+// A transcoding profile is a named set of FFmpeg arguments used to post-process videos of a watched channel or manual archive instead of the global video convert arguments.
+type TranscodingProfile struct {
+	ent.Schema
+}
+
+// Fields of the TranscodingProfile.
+func (TranscodingProfile) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).Default(uuid.New),
+		field.String("name").NotEmpty().MaxLen(255).Unique().Comment("User-given name for this transcoding profile."),
+		field.String("description").Optional().Default("").MaxLen(1024).Comment("What the profile is used for."),
+		field.String("ffmpeg_args").Default("").MaxLen(4096).Comment("FFmpeg output arguments used when post-processing the video."),
+		field.Time("updated_at").Default(time.Now).UpdateDefault(time.Now),
+		field.Time("created_at").Default(time.Now).Immutable(),
+	}
+}
+
+// Edges of the TranscodingProfile.
+func (TranscodingProfile) Edges() []ent.Edge {
+	return nil
+}
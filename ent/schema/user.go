@@ -2,6 +2,8 @@ package schema
 
 import (
 	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
 	"github.com/google/uuid"
 	"github.com/zibbp/ganymede/internal/utils"
@@ -30,5 +32,8 @@ func (User) Fields() []ent.Field {
 
 // Edges of the User.
 func (User) Edges() []ent.Edge {
-	return nil
+	return []ent.Edge{
+		edge.To("viewing_sessions", ViewingSession.Type).Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("lists", UserList.Type).Annotations(entsql.OnDelete(entsql.Cascade)),
+	}
 }
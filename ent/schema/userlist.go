@@ -0,0 +1,49 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+	"github.com/zibbp/ganymede/internal/utils"
+)
+
+// UserList holds the schema definition for the UserList entity.
+// User lists are personal collections of videos such as watch later and favorites, only visible to their user unless shared by link.
+type UserList struct {
+	ent.Schema
+}
+
+// Fields of the UserList.
+func (UserList) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).Default(uuid.New),
+		field.UUID("user_id", uuid.UUID{}).Comment("The ID of the user the list belongs to."),
+		field.Enum("type").GoType(utils.UserListType("")).Default(string(utils.UserListTypeCustom)).Comment("Every user has one watch later and one favorites list, other lists are custom."),
+		field.String("name"),
+		field.String("share_token").Optional().Nillable().Unique().Comment("The token of the read-only link of the list, not shared if nil."),
+		field.Time("updated_at").Default(time.Now).UpdateDefault(time.Now),
+		field.Time("created_at").Default(time.Now).Immutable(),
+	}
+}
+
+// Edges of the UserList.
+func (UserList) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("user", User.Type).Ref("lists").Field("user_id").Unique().Required(),
+		edge.To("items", UserListItem.Type).Annotations(entsql.OnDelete(entsql.Cascade)),
+	}
+}
+
+// Indexes of the UserList.
+func (UserList) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("user_id", "name").Unique(),
+		// every user has one list of each default type
+		index.Fields("user_id", "type").Unique().Annotations(entsql.IndexWhere("type <> 'custom'")),
+	}
+}
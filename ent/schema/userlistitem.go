@@ -0,0 +1,44 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// UserListItem holds the schema definition for the UserListItem entity.
+// An item is a video in a user list; items are removed with their video.
+type UserListItem struct {
+	ent.Schema
+}
+
+// Fields of the UserListItem.
+func (UserListItem) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).Default(uuid.New),
+		field.UUID("list_id", uuid.UUID{}).Comment("The ID of the list."),
+		field.UUID("vod_id", uuid.UUID{}).Comment("The ID of the video."),
+		field.Int("position").Default(0).Comment("The position of the video in the list, items are sorted ascending."),
+		field.Time("created_at").Default(time.Now).Immutable(),
+	}
+}
+
+// Edges of the UserListItem.
+func (UserListItem) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("list", UserList.Type).Ref("items").Field("list_id").Unique().Required(),
+		edge.From("vod", Vod.Type).Ref("user_list_items").Field("vod_id").Unique().Required(),
+	}
+}
+
+// Indexes of the UserListItem.
+func (UserListItem) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("list_id", "vod_id").Unique(),
+		index.Fields("vod_id"),
+	}
+}
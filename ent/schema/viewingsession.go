@@ -0,0 +1,51 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+	"github.com/zibbp/ganymede/internal/utils"
+)
+
+// ViewingSession holds the schema definition for the ViewingSession entity.
+// A viewing session is logged every time a user plays a video, recording the ranges of the video that were played.
+type ViewingSession struct {
+	ent.Schema
+}
+
+// Fields of the ViewingSession.
+func (ViewingSession) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).Default(uuid.New),
+		field.UUID("vod_id", uuid.UUID{}).Comment("The ID of the video that was watched."),
+		field.UUID("user_id", uuid.UUID{}).Comment("The ID of the user that watched the video."),
+		field.String("device").Default("").Comment("The device the video was watched on, e.g. the user agent of the browser."),
+		field.JSON("ranges", []utils.WatchedRange{}).Default([]utils.WatchedRange{}).Comment("The ranges of the video that were played, in the order they were played."),
+		field.Float("watched_seconds").Default(0).Comment("The seconds of the video that were played, counting parts played multiple times every time."),
+		field.Time("started_at").Default(time.Now).Immutable().Comment("The time the session started."),
+		field.Time("ended_at").Default(time.Now).Comment("The time the last range was played."),
+		field.Time("updated_at").Default(time.Now).UpdateDefault(time.Now),
+		field.Time("created_at").Default(time.Now).Immutable(),
+	}
+}
+
+// Edges of the ViewingSession.
+func (ViewingSession) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("vod", Vod.Type).Ref("viewing_sessions").Field("vod_id").Unique().Required(),
+		edge.From("user", User.Type).Ref("viewing_sessions").Field("user_id").Unique().Required(),
+	}
+}
+
+// Indexes of the ViewingSession.
+func (ViewingSession) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("user_id", "started_at"),
+		index.Fields("vod_id"),
+		index.Fields("started_at"),
+	}
+}
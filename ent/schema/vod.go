@@ -4,6 +4,7 @@ import (
 	"time"
 
 	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
 	"github.com/google/uuid"
@@ -22,13 +23,18 @@ func (Vod) Fields() []ent.Field {
 		field.String("ext_id").Comment("The ID of the video on the external platform."),
 		field.String("clip_ext_vod_id").Optional().Comment("The external VOD ID of a clip. This is only populated if the clip is linked to a video."),
 		field.String("ext_stream_id").Optional().Comment("The ID of the stream on the external platform, if applicable."),
+		field.Int("part").Default(0).Min(0).Comment("The part number of a live stream archive split into parts, starting at 1. 0 if the archive isn't split."),
 		field.Enum("platform").GoType(utils.VideoPlatform("")).Default(string(utils.PlatformTwitch)).Comment("The platform the VOD is from, takes an enum."),
 		field.Enum("type").GoType(utils.VodType("")).Default(string(utils.Archive)).Comment("The type of VOD, takes an enum."),
 		field.String("title"),
+		field.String("category").Optional().Comment("The main category of the video, if known."),
 		field.Int("duration").Default(1),
 		field.Int("clip_vod_offset").Optional().Comment("The offset in seconds to where the clip starts in the VOD. This is only populdated if the video is a clip."),
+		field.UUID("clip_vod_id", uuid.UUID{}).Optional().Nillable().Comment("The video a clip was cut from. This is only populated if the clip was created in Ganymede."),
 		field.Int("views").Default(1),
 		field.String("resolution").Optional(),
+		field.Strings("quality_fallbacks").Optional().Comment("Qualities tried in order when archiving the live stream at the resolution keeps failing."),
+		field.JSON("quality_switches", []utils.QualitySwitch{}).Optional().Comment("The qualities the live stream archive switched to after repeated failures."),
 		field.Bool("processing").Default(false).Comment("Whether the VOD is currently processing."),
 		field.String("thumbnail_path").Optional(),
 		field.String("web_thumbnail_path"),
@@ -40,6 +46,7 @@ func (Vod) Fields() []ent.Field {
 		field.String("chat_video_path").Optional(),
 		field.String("info_path").Optional(),
 		field.String("caption_path").Optional(),
+		field.String("audio_path").Optional().Comment("The audio of the live stream recorded alongside the video as a fallback."),
 		field.String("folder_name").Optional(),
 		field.String("file_name").Optional(),
 		field.String("tmp_video_download_path").Optional().Comment("The path where the video is downloaded to"),
@@ -49,6 +56,7 @@ func (Vod) Fields() []ent.Field {
 		field.String("tmp_live_chat_convert_path").Optional().Comment("The path where the converted chat is"),
 		field.String("tmp_chat_render_path").Optional().Comment("The path where the rendered chat is"),
 		field.String("tmp_video_hls_path").Optional().Comment("The path where the temporary video hls files are"),
+		field.String("tmp_audio_path").Optional().Comment("The path where the fallback audio is recorded to"),
 		field.Bool("locked").Default(false),
 		field.Int("local_views").Default(0),
 		field.Bool("sprite_thumbnails_enabled").Default(false),
@@ -59,6 +67,14 @@ func (Vod) Fields() []ent.Field {
 		field.Int("sprite_thumbnails_rows").Optional(),
 		field.Int("sprite_thumbnails_columns").Optional(),
 		field.Int64("storage_size_bytes").Default(0).Comment("The size of the VOD in bytes."),
+		field.Enum("storage_tier").GoType(utils.StorageTier("")).Default(string(utils.StorageTierHot)).Comment("Whether the VOD files are in the videos directory or the cold videos directory."),
+		field.Enum("health_status").GoType(utils.VideoHealth("")).Default(string(utils.VideoHealthUnknown)).Comment("The result of the last library audit of the VOD."),
+		field.JSON("health_issues", []utils.VideoHealthIssue{}).Optional().Comment("The problems found by the last library audit of the VOD."),
+		field.Time("health_checked_at").Optional().Nillable().Comment("The time the VOD was last audited."),
+		field.UUID("audio_source_id", uuid.UUID{}).Optional().Nillable().Comment("The live archive of the same stream that has the original audio of the muted segments."),
+		field.Float("audio_source_offset").Optional().Comment("The position in seconds in the VOD where the live archive starts."),
+		field.UUID("transcoding_profile_id", uuid.UUID{}).Optional().Nillable().Comment("The transcoding profile the video is post-processed with. The global video convert arguments are used if empty."),
+		field.Bool("authoritative").Default(true).Comment("Whether this is the version to keep when both the live archive and the VOD of a stream are archived."),
 		field.Time("streamed_at").Default(time.Now).Comment("The time the VOD was streamed."),
 		field.Time("updated_at").Default(time.Now).UpdateDefault(time.Now),
 		field.Time("created_at").Default(time.Now).Immutable(),
@@ -74,5 +90,11 @@ func (Vod) Edges() []ent.Edge {
 		edge.To("chapters", Chapter.Type),
 		edge.To("muted_segments", MutedSegment.Type),
 		edge.From("multistream_info", MultistreamInfo.Type).Ref("vod"),
+		edge.To("chat_messages", ChatMessage.Type).Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("checksums", Checksum.Type).Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("live_gaps", LiveGap.Type).Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("viewing_sessions", ViewingSession.Type).Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("user_list_items", UserListItem.Type).Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("transcoding_profile", TranscodingProfile.Type).Unique().Field("transcoding_profile_id").Annotations(entsql.OnDelete(entsql.SetNull)),
 	}
 }
@@ -0,0 +1,381 @@
+// Code generated by ent, DO NOT EDIT.
+
+package transcodingprofile
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/zibbp/ganymede/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.TranscodingProfile {
+	return predicate.TranscodingProfile(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.TranscodingProfile {
+	return predicate.TranscodingProfile(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.TranscodingProfile {
+	return predicate.TranscodingProfile(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.TranscodingProfile {
+	return predicate.TranscodingProfile(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.TranscodingProfile {
+	return predicate.TranscodingProfile(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.TranscodingProfile {
+	return predicate.TranscodingProfile(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.TranscodingProfile {
+	return predicate.TranscodingProfile(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.TranscodingProfile {
+	return predicate.TranscodingProfile(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.TranscodingProfile {
+	return predicate.TranscodingProfile(sql.FieldLTE(FieldID, id))
+}
+
+// Name applies equality check predicate on the "name" field. It's identical to NameEQ.
+func Name(v string) predicate.TranscodingProfile {
+	return predicate.TranscodingProfile(sql.FieldEQ(FieldName, v))
+}
+
+// Description applies equality check predicate on the "description" field. It's identical to DescriptionEQ.
+func Description(v string) predicate.TranscodingProfile {
+	return predicate.TranscodingProfile(sql.FieldEQ(FieldDescription, v))
+}
+
+// FfmpegArgs applies equality check predicate on the "ffmpeg_args" field. It's identical to FfmpegArgsEQ.
+func FfmpegArgs(v string) predicate.TranscodingProfile {
+	return predicate.TranscodingProfile(sql.FieldEQ(FieldFfmpegArgs, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.TranscodingProfile {
+	return predicate.TranscodingProfile(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.TranscodingProfile {
+	return predicate.TranscodingProfile(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// NameEQ applies the EQ predicate on the "name" field.
+func NameEQ(v string) predicate.TranscodingProfile {
+	return predicate.TranscodingProfile(sql.FieldEQ(FieldName, v))
+}
+
+// NameNEQ applies the NEQ predicate on the "name" field.
+func NameNEQ(v string) predicate.TranscodingProfile {
+	return predicate.TranscodingProfile(sql.FieldNEQ(FieldName, v))
+}
+
+// NameIn applies the In predicate on the "name" field.
+func NameIn(vs ...string) predicate.TranscodingProfile {
+	return predicate.TranscodingProfile(sql.FieldIn(FieldName, vs...))
+}
+
+// NameNotIn applies the NotIn predicate on the "name" field.
+func NameNotIn(vs ...string) predicate.TranscodingProfile {
+	return predicate.TranscodingProfile(sql.FieldNotIn(FieldName, vs...))
+}
+
+// NameGT applies the GT predicate on the "name" field.
+func NameGT(v string) predicate.TranscodingProfile {
+	return predicate.TranscodingProfile(sql.FieldGT(FieldName, v))
+}
+
+// NameGTE applies the GTE predicate on the "name" field.
+func NameGTE(v string) predicate.TranscodingProfile {
+	return predicate.TranscodingProfile(sql.FieldGTE(FieldName, v))
+}
+
+// NameLT applies the LT predicate on the "name" field.
+func NameLT(v string) predicate.TranscodingProfile {
+	return predicate.TranscodingProfile(sql.FieldLT(FieldName, v))
+}
+
+// NameLTE applies the LTE predicate on the "name" field.
+func NameLTE(v string) predicate.TranscodingProfile {
+	return predicate.TranscodingProfile(sql.FieldLTE(FieldName, v))
+}
+
+// NameContains applies the Contains predicate on the "name" field.
+func NameContains(v string) predicate.TranscodingProfile {
+	return predicate.TranscodingProfile(sql.FieldContains(FieldName, v))
+}
+
+// NameHasPrefix applies the HasPrefix predicate on the "name" field.
+func NameHasPrefix(v string) predicate.TranscodingProfile {
+	return predicate.TranscodingProfile(sql.FieldHasPrefix(FieldName, v))
+}
+
+// NameHasSuffix applies the HasSuffix predicate on the "name" field.
+func NameHasSuffix(v string) predicate.TranscodingProfile {
+	return predicate.TranscodingProfile(sql.FieldHasSuffix(FieldName, v))
+}
+
+// NameEqualFold applies the EqualFold predicate on the "name" field.
+func NameEqualFold(v string) predicate.TranscodingProfile {
+	return predicate.TranscodingProfile(sql.FieldEqualFold(FieldName, v))
+}
+
+// NameContainsFold applies the ContainsFold predicate on the "name" field.
+func NameContainsFold(v string) predicate.TranscodingProfile {
+	return predicate.TranscodingProfile(sql.FieldContainsFold(FieldName, v))
+}
+
+// DescriptionEQ applies the EQ predicate on the "description" field.
+func DescriptionEQ(v string) predicate.TranscodingProfile {
+	return predicate.TranscodingProfile(sql.FieldEQ(FieldDescription, v))
+}
+
+// DescriptionNEQ applies the NEQ predicate on the "description" field.
+func DescriptionNEQ(v string) predicate.TranscodingProfile {
+	return predicate.TranscodingProfile(sql.FieldNEQ(FieldDescription, v))
+}
+
+// DescriptionIn applies the In predicate on the "description" field.
+func DescriptionIn(vs ...string) predicate.TranscodingProfile {
+	return predicate.TranscodingProfile(sql.FieldIn(FieldDescription, vs...))
+}
+
+// DescriptionNotIn applies the NotIn predicate on the "description" field.
+func DescriptionNotIn(vs ...string) predicate.TranscodingProfile {
+	return predicate.TranscodingProfile(sql.FieldNotIn(FieldDescription, vs...))
+}
+
+// DescriptionGT applies the GT predicate on the "description" field.
+func DescriptionGT(v string) predicate.TranscodingProfile {
+	return predicate.TranscodingProfile(sql.FieldGT(FieldDescription, v))
+}
+
+// DescriptionGTE applies the GTE predicate on the "description" field.
+func DescriptionGTE(v string) predicate.TranscodingProfile {
+	return predicate.TranscodingProfile(sql.FieldGTE(FieldDescription, v))
+}
+
+// DescriptionLT applies the LT predicate on the "description" field.
+func DescriptionLT(v string) predicate.TranscodingProfile {
+	return predicate.TranscodingProfile(sql.FieldLT(FieldDescription, v))
+}
+
+// DescriptionLTE applies the LTE predicate on the "description" field.
+func DescriptionLTE(v string) predicate.TranscodingProfile {
+	return predicate.TranscodingProfile(sql.FieldLTE(FieldDescription, v))
+}
+
+// DescriptionContains applies the Contains predicate on the "description" field.
+func DescriptionContains(v string) predicate.TranscodingProfile {
+	return predicate.TranscodingProfile(sql.FieldContains(FieldDescription, v))
+}
+
+// DescriptionHasPrefix applies the HasPrefix predicate on the "description" field.
+func DescriptionHasPrefix(v string) predicate.TranscodingProfile {
+	return predicate.TranscodingProfile(sql.FieldHasPrefix(FieldDescription, v))
+}
+
+// DescriptionHasSuffix applies the HasSuffix predicate on the "description" field.
+func DescriptionHasSuffix(v string) predicate.TranscodingProfile {
+	return predicate.TranscodingProfile(sql.FieldHasSuffix(FieldDescription, v))
+}
+
+// DescriptionIsNil applies the IsNil predicate on the "description" field.
+func DescriptionIsNil() predicate.TranscodingProfile {
+	return predicate.TranscodingProfile(sql.FieldIsNull(FieldDescription))
+}
+
+// DescriptionNotNil applies the NotNil predicate on the "description" field.
+func DescriptionNotNil() predicate.TranscodingProfile {
+	return predicate.TranscodingProfile(sql.FieldNotNull(FieldDescription))
+}
+
+// DescriptionEqualFold applies the EqualFold predicate on the "description" field.
+func DescriptionEqualFold(v string) predicate.TranscodingProfile {
+	return predicate.TranscodingProfile(sql.FieldEqualFold(FieldDescription, v))
+}
+
+// DescriptionContainsFold applies the ContainsFold predicate on the "description" field.
+func DescriptionContainsFold(v string) predicate.TranscodingProfile {
+	return predicate.TranscodingProfile(sql.FieldContainsFold(FieldDescription, v))
+}
+
+// FfmpegArgsEQ applies the EQ predicate on the "ffmpeg_args" field.
+func FfmpegArgsEQ(v string) predicate.TranscodingProfile {
+	return predicate.TranscodingProfile(sql.FieldEQ(FieldFfmpegArgs, v))
+}
+
+// FfmpegArgsNEQ applies the NEQ predicate on the "ffmpeg_args" field.
+func FfmpegArgsNEQ(v string) predicate.TranscodingProfile {
+	return predicate.TranscodingProfile(sql.FieldNEQ(FieldFfmpegArgs, v))
+}
+
+// FfmpegArgsIn applies the In predicate on the "ffmpeg_args" field.
+func FfmpegArgsIn(vs ...string) predicate.TranscodingProfile {
+	return predicate.TranscodingProfile(sql.FieldIn(FieldFfmpegArgs, vs...))
+}
+
+// FfmpegArgsNotIn applies the NotIn predicate on the "ffmpeg_args" field.
+func FfmpegArgsNotIn(vs ...string) predicate.TranscodingProfile {
+	return predicate.TranscodingProfile(sql.FieldNotIn(FieldFfmpegArgs, vs...))
+}
+
+// FfmpegArgsGT applies the GT predicate on the "ffmpeg_args" field.
+func FfmpegArgsGT(v string) predicate.TranscodingProfile {
+	return predicate.TranscodingProfile(sql.FieldGT(FieldFfmpegArgs, v))
+}
+
+// FfmpegArgsGTE applies the GTE predicate on the "ffmpeg_args" field.
+func FfmpegArgsGTE(v string) predicate.TranscodingProfile {
+	return predicate.TranscodingProfile(sql.FieldGTE(FieldFfmpegArgs, v))
+}
+
+// FfmpegArgsLT applies the LT predicate on the "ffmpeg_args" field.
+func FfmpegArgsLT(v string) predicate.TranscodingProfile {
+	return predicate.TranscodingProfile(sql.FieldLT(FieldFfmpegArgs, v))
+}
+
+// FfmpegArgsLTE applies the LTE predicate on the "ffmpeg_args" field.
+func FfmpegArgsLTE(v string) predicate.TranscodingProfile {
+	return predicate.TranscodingProfile(sql.FieldLTE(FieldFfmpegArgs, v))
+}
+
+// FfmpegArgsContains applies the Contains predicate on the "ffmpeg_args" field.
+func FfmpegArgsContains(v string) predicate.TranscodingProfile {
+	return predicate.TranscodingProfile(sql.FieldContains(FieldFfmpegArgs, v))
+}
+
+// FfmpegArgsHasPrefix applies the HasPrefix predicate on the "ffmpeg_args" field.
+func FfmpegArgsHasPrefix(v string) predicate.TranscodingProfile {
+	return predicate.TranscodingProfile(sql.FieldHasPrefix(FieldFfmpegArgs, v))
+}
+
+// FfmpegArgsHasSuffix applies the HasSuffix predicate on the "ffmpeg_args" field.
+func FfmpegArgsHasSuffix(v string) predicate.TranscodingProfile {
+	return predicate.TranscodingProfile(sql.FieldHasSuffix(FieldFfmpegArgs, v))
+}
+
+// FfmpegArgsEqualFold applies the EqualFold predicate on the "ffmpeg_args" field.
+func FfmpegArgsEqualFold(v string) predicate.TranscodingProfile {
+	return predicate.TranscodingProfile(sql.FieldEqualFold(FieldFfmpegArgs, v))
+}
+
+// FfmpegArgsContainsFold applies the ContainsFold predicate on the "ffmpeg_args" field.
+func FfmpegArgsContainsFold(v string) predicate.TranscodingProfile {
+	return predicate.TranscodingProfile(sql.FieldContainsFold(FieldFfmpegArgs, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.TranscodingProfile {
+	return predicate.TranscodingProfile(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.TranscodingProfile {
+	return predicate.TranscodingProfile(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.TranscodingProfile {
+	return predicate.TranscodingProfile(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.TranscodingProfile {
+	return predicate.TranscodingProfile(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.TranscodingProfile {
+	return predicate.TranscodingProfile(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.TranscodingProfile {
+	return predicate.TranscodingProfile(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.TranscodingProfile {
+	return predicate.TranscodingProfile(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.TranscodingProfile {
+	return predicate.TranscodingProfile(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.TranscodingProfile {
+	return predicate.TranscodingProfile(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.TranscodingProfile {
+	return predicate.TranscodingProfile(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.TranscodingProfile {
+	return predicate.TranscodingProfile(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.TranscodingProfile {
+	return predicate.TranscodingProfile(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.TranscodingProfile {
+	return predicate.TranscodingProfile(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.TranscodingProfile {
+	return predicate.TranscodingProfile(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.TranscodingProfile {
+	return predicate.TranscodingProfile(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.TranscodingProfile {
+	return predicate.TranscodingProfile(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.TranscodingProfile) predicate.TranscodingProfile {
+	return predicate.TranscodingProfile(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.TranscodingProfile) predicate.TranscodingProfile {
+	return predicate.TranscodingProfile(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.TranscodingProfile) predicate.TranscodingProfile {
+	return predicate.TranscodingProfile(sql.NotPredicates(p))
+}
@@ -0,0 +1,767 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/zibbp/ganymede/ent/transcodingprofile"
+)
+
+// TranscodingProfileCreate is the builder for creating a TranscodingProfile entity.
+type TranscodingProfileCreate struct {
+	config
+	mutation *TranscodingProfileMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetName sets the "name" field.
+func (_c *TranscodingProfileCreate) SetName(v string) *TranscodingProfileCreate {
+	_c.mutation.SetName(v)
+	return _c
+}
+
+// SetDescription sets the "description" field.
+func (_c *TranscodingProfileCreate) SetDescription(v string) *TranscodingProfileCreate {
+	_c.mutation.SetDescription(v)
+	return _c
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (_c *TranscodingProfileCreate) SetNillableDescription(v *string) *TranscodingProfileCreate {
+	if v != nil {
+		_c.SetDescription(*v)
+	}
+	return _c
+}
+
+// SetFfmpegArgs sets the "ffmpeg_args" field.
+func (_c *TranscodingProfileCreate) SetFfmpegArgs(v string) *TranscodingProfileCreate {
+	_c.mutation.SetFfmpegArgs(v)
+	return _c
+}
+
+// SetNillableFfmpegArgs sets the "ffmpeg_args" field if the given value is not nil.
+func (_c *TranscodingProfileCreate) SetNillableFfmpegArgs(v *string) *TranscodingProfileCreate {
+	if v != nil {
+		_c.SetFfmpegArgs(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *TranscodingProfileCreate) SetUpdatedAt(v time.Time) *TranscodingProfileCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *TranscodingProfileCreate) SetNillableUpdatedAt(v *time.Time) *TranscodingProfileCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *TranscodingProfileCreate) SetCreatedAt(v time.Time) *TranscodingProfileCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *TranscodingProfileCreate) SetNillableCreatedAt(v *time.Time) *TranscodingProfileCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *TranscodingProfileCreate) SetID(v uuid.UUID) *TranscodingProfileCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *TranscodingProfileCreate) SetNillableID(v *uuid.UUID) *TranscodingProfileCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// Mutation returns the TranscodingProfileMutation object of the builder.
+func (_c *TranscodingProfileCreate) Mutation() *TranscodingProfileMutation {
+	return _c.mutation
+}
+
+// Save creates the TranscodingProfile in the database.
+func (_c *TranscodingProfileCreate) Save(ctx context.Context) (*TranscodingProfile, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *TranscodingProfileCreate) SaveX(ctx context.Context) *TranscodingProfile {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *TranscodingProfileCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *TranscodingProfileCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *TranscodingProfileCreate) defaults() {
+	if _, ok := _c.mutation.Description(); !ok {
+		v := transcodingprofile.DefaultDescription
+		_c.mutation.SetDescription(v)
+	}
+	if _, ok := _c.mutation.FfmpegArgs(); !ok {
+		v := transcodingprofile.DefaultFfmpegArgs
+		_c.mutation.SetFfmpegArgs(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := transcodingprofile.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := transcodingprofile.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := transcodingprofile.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *TranscodingProfileCreate) check() error {
+	if _, ok := _c.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "TranscodingProfile.name"`)}
+	}
+	if v, ok := _c.mutation.Name(); ok {
+		if err := transcodingprofile.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "TranscodingProfile.name": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.Description(); ok {
+		if err := transcodingprofile.DescriptionValidator(v); err != nil {
+			return &ValidationError{Name: "description", err: fmt.Errorf(`ent: validator failed for field "TranscodingProfile.description": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.FfmpegArgs(); !ok {
+		return &ValidationError{Name: "ffmpeg_args", err: errors.New(`ent: missing required field "TranscodingProfile.ffmpeg_args"`)}
+	}
+	if v, ok := _c.mutation.FfmpegArgs(); ok {
+		if err := transcodingprofile.FfmpegArgsValidator(v); err != nil {
+			return &ValidationError{Name: "ffmpeg_args", err: fmt.Errorf(`ent: validator failed for field "TranscodingProfile.ffmpeg_args": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "TranscodingProfile.updated_at"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "TranscodingProfile.created_at"`)}
+	}
+	return nil
+}
+
+func (_c *TranscodingProfileCreate) sqlSave(ctx context.Context) (*TranscodingProfile, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *TranscodingProfileCreate) createSpec() (*TranscodingProfile, *sqlgraph.CreateSpec) {
+	var (
+		_node = &TranscodingProfile{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(transcodingprofile.Table, sqlgraph.NewFieldSpec(transcodingprofile.FieldID, field.TypeUUID))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.Name(); ok {
+		_spec.SetField(transcodingprofile.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := _c.mutation.Description(); ok {
+		_spec.SetField(transcodingprofile.FieldDescription, field.TypeString, value)
+		_node.Description = value
+	}
+	if value, ok := _c.mutation.FfmpegArgs(); ok {
+		_spec.SetField(transcodingprofile.FieldFfmpegArgs, field.TypeString, value)
+		_node.FfmpegArgs = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(transcodingprofile.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(transcodingprofile.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.TranscodingProfile.Create().
+//		SetName(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.TranscodingProfileUpsert) {
+//			SetName(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *TranscodingProfileCreate) OnConflict(opts ...sql.ConflictOption) *TranscodingProfileUpsertOne {
+	_c.conflict = opts
+	return &TranscodingProfileUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.TranscodingProfile.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *TranscodingProfileCreate) OnConflictColumns(columns ...string) *TranscodingProfileUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &TranscodingProfileUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// TranscodingProfileUpsertOne is the builder for "upsert"-ing
+	//  one TranscodingProfile node.
+	TranscodingProfileUpsertOne struct {
+		create *TranscodingProfileCreate
+	}
+
+	// TranscodingProfileUpsert is the "OnConflict" setter.
+	TranscodingProfileUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetName sets the "name" field.
+func (u *TranscodingProfileUpsert) SetName(v string) *TranscodingProfileUpsert {
+	u.Set(transcodingprofile.FieldName, v)
+	return u
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *TranscodingProfileUpsert) UpdateName() *TranscodingProfileUpsert {
+	u.SetExcluded(transcodingprofile.FieldName)
+	return u
+}
+
+// SetDescription sets the "description" field.
+func (u *TranscodingProfileUpsert) SetDescription(v string) *TranscodingProfileUpsert {
+	u.Set(transcodingprofile.FieldDescription, v)
+	return u
+}
+
+// UpdateDescription sets the "description" field to the value that was provided on create.
+func (u *TranscodingProfileUpsert) UpdateDescription() *TranscodingProfileUpsert {
+	u.SetExcluded(transcodingprofile.FieldDescription)
+	return u
+}
+
+// ClearDescription clears the value of the "description" field.
+func (u *TranscodingProfileUpsert) ClearDescription() *TranscodingProfileUpsert {
+	u.SetNull(transcodingprofile.FieldDescription)
+	return u
+}
+
+// SetFfmpegArgs sets the "ffmpeg_args" field.
+func (u *TranscodingProfileUpsert) SetFfmpegArgs(v string) *TranscodingProfileUpsert {
+	u.Set(transcodingprofile.FieldFfmpegArgs, v)
+	return u
+}
+
+// UpdateFfmpegArgs sets the "ffmpeg_args" field to the value that was provided on create.
+func (u *TranscodingProfileUpsert) UpdateFfmpegArgs() *TranscodingProfileUpsert {
+	u.SetExcluded(transcodingprofile.FieldFfmpegArgs)
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *TranscodingProfileUpsert) SetUpdatedAt(v time.Time) *TranscodingProfileUpsert {
+	u.Set(transcodingprofile.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *TranscodingProfileUpsert) UpdateUpdatedAt() *TranscodingProfileUpsert {
+	u.SetExcluded(transcodingprofile.FieldUpdatedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.TranscodingProfile.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(transcodingprofile.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *TranscodingProfileUpsertOne) UpdateNewValues() *TranscodingProfileUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(transcodingprofile.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(transcodingprofile.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.TranscodingProfile.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *TranscodingProfileUpsertOne) Ignore() *TranscodingProfileUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *TranscodingProfileUpsertOne) DoNothing() *TranscodingProfileUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the TranscodingProfileCreate.OnConflict
+// documentation for more info.
+func (u *TranscodingProfileUpsertOne) Update(set func(*TranscodingProfileUpsert)) *TranscodingProfileUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&TranscodingProfileUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetName sets the "name" field.
+func (u *TranscodingProfileUpsertOne) SetName(v string) *TranscodingProfileUpsertOne {
+	return u.Update(func(s *TranscodingProfileUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *TranscodingProfileUpsertOne) UpdateName() *TranscodingProfileUpsertOne {
+	return u.Update(func(s *TranscodingProfileUpsert) {
+		s.UpdateName()
+	})
+}
+
+// SetDescription sets the "description" field.
+func (u *TranscodingProfileUpsertOne) SetDescription(v string) *TranscodingProfileUpsertOne {
+	return u.Update(func(s *TranscodingProfileUpsert) {
+		s.SetDescription(v)
+	})
+}
+
+// UpdateDescription sets the "description" field to the value that was provided on create.
+func (u *TranscodingProfileUpsertOne) UpdateDescription() *TranscodingProfileUpsertOne {
+	return u.Update(func(s *TranscodingProfileUpsert) {
+		s.UpdateDescription()
+	})
+}
+
+// ClearDescription clears the value of the "description" field.
+func (u *TranscodingProfileUpsertOne) ClearDescription() *TranscodingProfileUpsertOne {
+	return u.Update(func(s *TranscodingProfileUpsert) {
+		s.ClearDescription()
+	})
+}
+
+// SetFfmpegArgs sets the "ffmpeg_args" field.
+func (u *TranscodingProfileUpsertOne) SetFfmpegArgs(v string) *TranscodingProfileUpsertOne {
+	return u.Update(func(s *TranscodingProfileUpsert) {
+		s.SetFfmpegArgs(v)
+	})
+}
+
+// UpdateFfmpegArgs sets the "ffmpeg_args" field to the value that was provided on create.
+func (u *TranscodingProfileUpsertOne) UpdateFfmpegArgs() *TranscodingProfileUpsertOne {
+	return u.Update(func(s *TranscodingProfileUpsert) {
+		s.UpdateFfmpegArgs()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *TranscodingProfileUpsertOne) SetUpdatedAt(v time.Time) *TranscodingProfileUpsertOne {
+	return u.Update(func(s *TranscodingProfileUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *TranscodingProfileUpsertOne) UpdateUpdatedAt() *TranscodingProfileUpsertOne {
+	return u.Update(func(s *TranscodingProfileUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *TranscodingProfileUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for TranscodingProfileCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *TranscodingProfileUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *TranscodingProfileUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: TranscodingProfileUpsertOne.ID is not supported by MySQL driver. Use TranscodingProfileUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *TranscodingProfileUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// TranscodingProfileCreateBulk is the builder for creating many TranscodingProfile entities in bulk.
+type TranscodingProfileCreateBulk struct {
+	config
+	err      error
+	builders []*TranscodingProfileCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the TranscodingProfile entities in the database.
+func (_c *TranscodingProfileCreateBulk) Save(ctx context.Context) ([]*TranscodingProfile, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*TranscodingProfile, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*TranscodingProfileMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *TranscodingProfileCreateBulk) SaveX(ctx context.Context) []*TranscodingProfile {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *TranscodingProfileCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *TranscodingProfileCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.TranscodingProfile.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.TranscodingProfileUpsert) {
+//			SetName(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *TranscodingProfileCreateBulk) OnConflict(opts ...sql.ConflictOption) *TranscodingProfileUpsertBulk {
+	_c.conflict = opts
+	return &TranscodingProfileUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.TranscodingProfile.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *TranscodingProfileCreateBulk) OnConflictColumns(columns ...string) *TranscodingProfileUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &TranscodingProfileUpsertBulk{
+		create: _c,
+	}
+}
+
+// TranscodingProfileUpsertBulk is the builder for "upsert"-ing
+// a bulk of TranscodingProfile nodes.
+type TranscodingProfileUpsertBulk struct {
+	create *TranscodingProfileCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.TranscodingProfile.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(transcodingprofile.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *TranscodingProfileUpsertBulk) UpdateNewValues() *TranscodingProfileUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(transcodingprofile.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(transcodingprofile.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.TranscodingProfile.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *TranscodingProfileUpsertBulk) Ignore() *TranscodingProfileUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *TranscodingProfileUpsertBulk) DoNothing() *TranscodingProfileUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the TranscodingProfileCreateBulk.OnConflict
+// documentation for more info.
+func (u *TranscodingProfileUpsertBulk) Update(set func(*TranscodingProfileUpsert)) *TranscodingProfileUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&TranscodingProfileUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetName sets the "name" field.
+func (u *TranscodingProfileUpsertBulk) SetName(v string) *TranscodingProfileUpsertBulk {
+	return u.Update(func(s *TranscodingProfileUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *TranscodingProfileUpsertBulk) UpdateName() *TranscodingProfileUpsertBulk {
+	return u.Update(func(s *TranscodingProfileUpsert) {
+		s.UpdateName()
+	})
+}
+
+// SetDescription sets the "description" field.
+func (u *TranscodingProfileUpsertBulk) SetDescription(v string) *TranscodingProfileUpsertBulk {
+	return u.Update(func(s *TranscodingProfileUpsert) {
+		s.SetDescription(v)
+	})
+}
+
+// UpdateDescription sets the "description" field to the value that was provided on create.
+func (u *TranscodingProfileUpsertBulk) UpdateDescription() *TranscodingProfileUpsertBulk {
+	return u.Update(func(s *TranscodingProfileUpsert) {
+		s.UpdateDescription()
+	})
+}
+
+// ClearDescription clears the value of the "description" field.
+func (u *TranscodingProfileUpsertBulk) ClearDescription() *TranscodingProfileUpsertBulk {
+	return u.Update(func(s *TranscodingProfileUpsert) {
+		s.ClearDescription()
+	})
+}
+
+// SetFfmpegArgs sets the "ffmpeg_args" field.
+func (u *TranscodingProfileUpsertBulk) SetFfmpegArgs(v string) *TranscodingProfileUpsertBulk {
+	return u.Update(func(s *TranscodingProfileUpsert) {
+		s.SetFfmpegArgs(v)
+	})
+}
+
+// UpdateFfmpegArgs sets the "ffmpeg_args" field to the value that was provided on create.
+func (u *TranscodingProfileUpsertBulk) UpdateFfmpegArgs() *TranscodingProfileUpsertBulk {
+	return u.Update(func(s *TranscodingProfileUpsert) {
+		s.UpdateFfmpegArgs()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *TranscodingProfileUpsertBulk) SetUpdatedAt(v time.Time) *TranscodingProfileUpsertBulk {
+	return u.Update(func(s *TranscodingProfileUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *TranscodingProfileUpsertBulk) UpdateUpdatedAt() *TranscodingProfileUpsertBulk {
+	return u.Update(func(s *TranscodingProfileUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *TranscodingProfileUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the TranscodingProfileCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for TranscodingProfileCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *TranscodingProfileUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,378 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/zibbp/ganymede/ent/predicate"
+	"github.com/zibbp/ganymede/ent/transcodingprofile"
+)
+
+// TranscodingProfileUpdate is the builder for updating TranscodingProfile entities.
+type TranscodingProfileUpdate struct {
+	config
+	hooks    []Hook
+	mutation *TranscodingProfileMutation
+}
+
+// Where appends a list predicates to the TranscodingProfileUpdate builder.
+func (_u *TranscodingProfileUpdate) Where(ps ...predicate.TranscodingProfile) *TranscodingProfileUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *TranscodingProfileUpdate) SetName(v string) *TranscodingProfileUpdate {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *TranscodingProfileUpdate) SetNillableName(v *string) *TranscodingProfileUpdate {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetDescription sets the "description" field.
+func (_u *TranscodingProfileUpdate) SetDescription(v string) *TranscodingProfileUpdate {
+	_u.mutation.SetDescription(v)
+	return _u
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (_u *TranscodingProfileUpdate) SetNillableDescription(v *string) *TranscodingProfileUpdate {
+	if v != nil {
+		_u.SetDescription(*v)
+	}
+	return _u
+}
+
+// ClearDescription clears the value of the "description" field.
+func (_u *TranscodingProfileUpdate) ClearDescription() *TranscodingProfileUpdate {
+	_u.mutation.ClearDescription()
+	return _u
+}
+
+// SetFfmpegArgs sets the "ffmpeg_args" field.
+func (_u *TranscodingProfileUpdate) SetFfmpegArgs(v string) *TranscodingProfileUpdate {
+	_u.mutation.SetFfmpegArgs(v)
+	return _u
+}
+
+// SetNillableFfmpegArgs sets the "ffmpeg_args" field if the given value is not nil.
+func (_u *TranscodingProfileUpdate) SetNillableFfmpegArgs(v *string) *TranscodingProfileUpdate {
+	if v != nil {
+		_u.SetFfmpegArgs(*v)
+	}
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *TranscodingProfileUpdate) SetUpdatedAt(v time.Time) *TranscodingProfileUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// Mutation returns the TranscodingProfileMutation object of the builder.
+func (_u *TranscodingProfileUpdate) Mutation() *TranscodingProfileMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *TranscodingProfileUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *TranscodingProfileUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *TranscodingProfileUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *TranscodingProfileUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *TranscodingProfileUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := transcodingprofile.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *TranscodingProfileUpdate) check() error {
+	if v, ok := _u.mutation.Name(); ok {
+		if err := transcodingprofile.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "TranscodingProfile.name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Description(); ok {
+		if err := transcodingprofile.DescriptionValidator(v); err != nil {
+			return &ValidationError{Name: "description", err: fmt.Errorf(`ent: validator failed for field "TranscodingProfile.description": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.FfmpegArgs(); ok {
+		if err := transcodingprofile.FfmpegArgsValidator(v); err != nil {
+			return &ValidationError{Name: "ffmpeg_args", err: fmt.Errorf(`ent: validator failed for field "TranscodingProfile.ffmpeg_args": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *TranscodingProfileUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(transcodingprofile.Table, transcodingprofile.Columns, sqlgraph.NewFieldSpec(transcodingprofile.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(transcodingprofile.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Description(); ok {
+		_spec.SetField(transcodingprofile.FieldDescription, field.TypeString, value)
+	}
+	if _u.mutation.DescriptionCleared() {
+		_spec.ClearField(transcodingprofile.FieldDescription, field.TypeString)
+	}
+	if value, ok := _u.mutation.FfmpegArgs(); ok {
+		_spec.SetField(transcodingprofile.FieldFfmpegArgs, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(transcodingprofile.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{transcodingprofile.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// TranscodingProfileUpdateOne is the builder for updating a single TranscodingProfile entity.
+type TranscodingProfileUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *TranscodingProfileMutation
+}
+
+// SetName sets the "name" field.
+func (_u *TranscodingProfileUpdateOne) SetName(v string) *TranscodingProfileUpdateOne {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *TranscodingProfileUpdateOne) SetNillableName(v *string) *TranscodingProfileUpdateOne {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetDescription sets the "description" field.
+func (_u *TranscodingProfileUpdateOne) SetDescription(v string) *TranscodingProfileUpdateOne {
+	_u.mutation.SetDescription(v)
+	return _u
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (_u *TranscodingProfileUpdateOne) SetNillableDescription(v *string) *TranscodingProfileUpdateOne {
+	if v != nil {
+		_u.SetDescription(*v)
+	}
+	return _u
+}
+
+// ClearDescription clears the value of the "description" field.
+func (_u *TranscodingProfileUpdateOne) ClearDescription() *TranscodingProfileUpdateOne {
+	_u.mutation.ClearDescription()
+	return _u
+}
+
+// SetFfmpegArgs sets the "ffmpeg_args" field.
+func (_u *TranscodingProfileUpdateOne) SetFfmpegArgs(v string) *TranscodingProfileUpdateOne {
+	_u.mutation.SetFfmpegArgs(v)
+	return _u
+}
+
+// SetNillableFfmpegArgs sets the "ffmpeg_args" field if the given value is not nil.
+func (_u *TranscodingProfileUpdateOne) SetNillableFfmpegArgs(v *string) *TranscodingProfileUpdateOne {
+	if v != nil {
+		_u.SetFfmpegArgs(*v)
+	}
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *TranscodingProfileUpdateOne) SetUpdatedAt(v time.Time) *TranscodingProfileUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// Mutation returns the TranscodingProfileMutation object of the builder.
+func (_u *TranscodingProfileUpdateOne) Mutation() *TranscodingProfileMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the TranscodingProfileUpdate builder.
+func (_u *TranscodingProfileUpdateOne) Where(ps ...predicate.TranscodingProfile) *TranscodingProfileUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *TranscodingProfileUpdateOne) Select(field string, fields ...string) *TranscodingProfileUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated TranscodingProfile entity.
+func (_u *TranscodingProfileUpdateOne) Save(ctx context.Context) (*TranscodingProfile, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *TranscodingProfileUpdateOne) SaveX(ctx context.Context) *TranscodingProfile {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *TranscodingProfileUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *TranscodingProfileUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *TranscodingProfileUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := transcodingprofile.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *TranscodingProfileUpdateOne) check() error {
+	if v, ok := _u.mutation.Name(); ok {
+		if err := transcodingprofile.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "TranscodingProfile.name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Description(); ok {
+		if err := transcodingprofile.DescriptionValidator(v); err != nil {
+			return &ValidationError{Name: "description", err: fmt.Errorf(`ent: validator failed for field "TranscodingProfile.description": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.FfmpegArgs(); ok {
+		if err := transcodingprofile.FfmpegArgsValidator(v); err != nil {
+			return &ValidationError{Name: "ffmpeg_args", err: fmt.Errorf(`ent: validator failed for field "TranscodingProfile.ffmpeg_args": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *TranscodingProfileUpdateOne) sqlSave(ctx context.Context) (_node *TranscodingProfile, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(transcodingprofile.Table, transcodingprofile.Columns, sqlgraph.NewFieldSpec(transcodingprofile.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "TranscodingProfile.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, transcodingprofile.FieldID)
+		for _, f := range fields {
+			if !transcodingprofile.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != transcodingprofile.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(transcodingprofile.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Description(); ok {
+		_spec.SetField(transcodingprofile.FieldDescription, field.TypeString, value)
+	}
+	if _u.mutation.DescriptionCleared() {
+		_spec.ClearField(transcodingprofile.FieldDescription, field.TypeString)
+	}
+	if value, ok := _u.mutation.FfmpegArgs(); ok {
+		_spec.SetField(transcodingprofile.FieldFfmpegArgs, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(transcodingprofile.FieldUpdatedAt, field.TypeTime, value)
+	}
+	_node = &TranscodingProfile{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{transcodingprofile.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
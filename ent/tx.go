@@ -20,10 +20,18 @@ type Tx struct {
 	Channel *ChannelClient
 	// Chapter is the client for interacting with the Chapter builders.
 	Chapter *ChapterClient
+	// ChatMessage is the client for interacting with the ChatMessage builders.
+	ChatMessage *ChatMessageClient
+	// Checksum is the client for interacting with the Checksum builders.
+	Checksum *ChecksumClient
 	// Live is the client for interacting with the Live builders.
 	Live *LiveClient
 	// LiveCategory is the client for interacting with the LiveCategory builders.
 	LiveCategory *LiveCategoryClient
+	// LiveGap is the client for interacting with the LiveGap builders.
+	LiveGap *LiveGapClient
+	// LiveSchedule is the client for interacting with the LiveSchedule builders.
+	LiveSchedule *LiveScheduleClient
 	// LiveTitleRegex is the client for interacting with the LiveTitleRegex builders.
 	LiveTitleRegex *LiveTitleRegexClient
 	// MultistreamInfo is the client for interacting with the MultistreamInfo builders.
@@ -44,10 +52,18 @@ type Tx struct {
 	Queue *QueueClient
 	// Sessions is the client for interacting with the Sessions builders.
 	Sessions *SessionsClient
+	// TranscodingProfile is the client for interacting with the TranscodingProfile builders.
+	TranscodingProfile *TranscodingProfileClient
 	// TwitchCategory is the client for interacting with the TwitchCategory builders.
 	TwitchCategory *TwitchCategoryClient
 	// User is the client for interacting with the User builders.
 	User *UserClient
+	// UserList is the client for interacting with the UserList builders.
+	UserList *UserListClient
+	// UserListItem is the client for interacting with the UserListItem builders.
+	UserListItem *UserListItemClient
+	// ViewingSession is the client for interacting with the ViewingSession builders.
+	ViewingSession *ViewingSessionClient
 	// Vod is the client for interacting with the Vod builders.
 	Vod *VodClient
 
@@ -185,8 +201,12 @@ func (tx *Tx) init() {
 	tx.BlockedVideos = NewBlockedVideosClient(tx.config)
 	tx.Channel = NewChannelClient(tx.config)
 	tx.Chapter = NewChapterClient(tx.config)
+	tx.ChatMessage = NewChatMessageClient(tx.config)
+	tx.Checksum = NewChecksumClient(tx.config)
 	tx.Live = NewLiveClient(tx.config)
 	tx.LiveCategory = NewLiveCategoryClient(tx.config)
+	tx.LiveGap = NewLiveGapClient(tx.config)
+	tx.LiveSchedule = NewLiveScheduleClient(tx.config)
 	tx.LiveTitleRegex = NewLiveTitleRegexClient(tx.config)
 	tx.MultistreamInfo = NewMultistreamInfoClient(tx.config)
 	tx.MutedSegment = NewMutedSegmentClient(tx.config)
@@ -197,8 +217,12 @@ func (tx *Tx) init() {
 	tx.PlaylistRuleGroup = NewPlaylistRuleGroupClient(tx.config)
 	tx.Queue = NewQueueClient(tx.config)
 	tx.Sessions = NewSessionsClient(tx.config)
+	tx.TranscodingProfile = NewTranscodingProfileClient(tx.config)
 	tx.TwitchCategory = NewTwitchCategoryClient(tx.config)
 	tx.User = NewUserClient(tx.config)
+	tx.UserList = NewUserListClient(tx.config)
+	tx.UserListItem = NewUserListItemClient(tx.config)
+	tx.ViewingSession = NewViewingSessionClient(tx.config)
 	tx.Vod = NewVodClient(tx.config)
 }
 
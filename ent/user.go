@@ -34,10 +34,42 @@ type User struct {
 	// UpdatedAt holds the value of the "updated_at" field.
 	UpdatedAt time.Time `json:"updated_at,omitempty"`
 	// CreatedAt holds the value of the "created_at" field.
-	CreatedAt    time.Time `json:"created_at,omitempty"`
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the UserQuery when eager-loading is set.
+	Edges        UserEdges `json:"edges"`
 	selectValues sql.SelectValues
 }
 
+// UserEdges holds the relations/edges for other nodes in the graph.
+type UserEdges struct {
+	// ViewingSessions holds the value of the viewing_sessions edge.
+	ViewingSessions []*ViewingSession `json:"viewing_sessions,omitempty"`
+	// Lists holds the value of the lists edge.
+	Lists []*UserList `json:"lists,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// ViewingSessionsOrErr returns the ViewingSessions value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) ViewingSessionsOrErr() ([]*ViewingSession, error) {
+	if e.loadedTypes[0] {
+		return e.ViewingSessions, nil
+	}
+	return nil, &NotLoadedError{edge: "viewing_sessions"}
+}
+
+// ListsOrErr returns the Lists value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) ListsOrErr() ([]*UserList, error) {
+	if e.loadedTypes[1] {
+		return e.Lists, nil
+	}
+	return nil, &NotLoadedError{edge: "lists"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*User) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
@@ -133,6 +165,16 @@ func (_m *User) Value(name string) (ent.Value, error) {
 	return _m.selectValues.Get(name)
 }
 
+// QueryViewingSessions queries the "viewing_sessions" edge of the User entity.
+func (_m *User) QueryViewingSessions() *ViewingSessionQuery {
+	return NewUserClient(_m.config).QueryViewingSessions(_m)
+}
+
+// QueryLists queries the "lists" edge of the User entity.
+func (_m *User) QueryLists() *UserListQuery {
+	return NewUserClient(_m.config).QueryLists(_m)
+}
+
 // Update returns a builder for updating this User.
 // Note that you need to call User.Unwrap() before calling this method if this User
 // was returned from a transaction, and the transaction was committed or rolled back.
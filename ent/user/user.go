@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
 	"github.com/google/uuid"
 	"github.com/zibbp/ganymede/internal/utils"
 )
@@ -32,8 +33,26 @@ const (
 	FieldUpdatedAt = "updated_at"
 	// FieldCreatedAt holds the string denoting the created_at field in the database.
 	FieldCreatedAt = "created_at"
+	// EdgeViewingSessions holds the string denoting the viewing_sessions edge name in mutations.
+	EdgeViewingSessions = "viewing_sessions"
+	// EdgeLists holds the string denoting the lists edge name in mutations.
+	EdgeLists = "lists"
 	// Table holds the table name of the user in the database.
 	Table = "users"
+	// ViewingSessionsTable is the table that holds the viewing_sessions relation/edge.
+	ViewingSessionsTable = "viewing_sessions"
+	// ViewingSessionsInverseTable is the table name for the ViewingSession entity.
+	// It exists in this package in order to avoid circular dependency with the "viewingsession" package.
+	ViewingSessionsInverseTable = "viewing_sessions"
+	// ViewingSessionsColumn is the table column denoting the viewing_sessions relation/edge.
+	ViewingSessionsColumn = "user_id"
+	// ListsTable is the table that holds the lists relation/edge.
+	ListsTable = "user_lists"
+	// ListsInverseTable is the table name for the UserList entity.
+	// It exists in this package in order to avoid circular dependency with the "userlist" package.
+	ListsInverseTable = "user_lists"
+	// ListsColumn is the table column denoting the lists relation/edge.
+	ListsColumn = "user_id"
 )
 
 // Columns holds all SQL columns for user fields.
@@ -131,3 +150,45 @@ func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
 func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
 }
+
+// ByViewingSessionsCount orders the results by viewing_sessions count.
+func ByViewingSessionsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newViewingSessionsStep(), opts...)
+	}
+}
+
+// ByViewingSessions orders the results by viewing_sessions terms.
+func ByViewingSessions(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newViewingSessionsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByListsCount orders the results by lists count.
+func ByListsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newListsStep(), opts...)
+	}
+}
+
+// ByLists orders the results by lists terms.
+func ByLists(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newListsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+func newViewingSessionsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ViewingSessionsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, ViewingSessionsTable, ViewingSessionsColumn),
+	)
+}
+func newListsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ListsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, ListsTable, ListsColumn),
+	)
+}
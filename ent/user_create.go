@@ -14,6 +14,8 @@ import (
 	"entgo.io/ent/schema/field"
 	"github.com/google/uuid"
 	"github.com/zibbp/ganymede/ent/user"
+	"github.com/zibbp/ganymede/ent/userlist"
+	"github.com/zibbp/ganymede/ent/viewingsession"
 	"github.com/zibbp/ganymede/internal/utils"
 )
 
@@ -143,6 +145,36 @@ func (_c *UserCreate) SetNillableID(v *uuid.UUID) *UserCreate {
 	return _c
 }
 
+// AddViewingSessionIDs adds the "viewing_sessions" edge to the ViewingSession entity by IDs.
+func (_c *UserCreate) AddViewingSessionIDs(ids ...uuid.UUID) *UserCreate {
+	_c.mutation.AddViewingSessionIDs(ids...)
+	return _c
+}
+
+// AddViewingSessions adds the "viewing_sessions" edges to the ViewingSession entity.
+func (_c *UserCreate) AddViewingSessions(v ...*ViewingSession) *UserCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddViewingSessionIDs(ids...)
+}
+
+// AddListIDs adds the "lists" edge to the UserList entity by IDs.
+func (_c *UserCreate) AddListIDs(ids ...uuid.UUID) *UserCreate {
+	_c.mutation.AddListIDs(ids...)
+	return _c
+}
+
+// AddLists adds the "lists" edges to the UserList entity.
+func (_c *UserCreate) AddLists(v ...*UserList) *UserCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddListIDs(ids...)
+}
+
 // Mutation returns the UserMutation object of the builder.
 func (_c *UserCreate) Mutation() *UserMutation {
 	return _c.mutation
@@ -290,6 +322,38 @@ func (_c *UserCreate) createSpec() (*User, *sqlgraph.CreateSpec) {
 		_spec.SetField(user.FieldCreatedAt, field.TypeTime, value)
 		_node.CreatedAt = value
 	}
+	if nodes := _c.mutation.ViewingSessionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.ViewingSessionsTable,
+			Columns: []string{user.ViewingSessionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(viewingsession.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.ListsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.ListsTable,
+			Columns: []string{user.ListsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(userlist.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
@@ -11,8 +11,11 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
 	"github.com/zibbp/ganymede/ent/predicate"
 	"github.com/zibbp/ganymede/ent/user"
+	"github.com/zibbp/ganymede/ent/userlist"
+	"github.com/zibbp/ganymede/ent/viewingsession"
 	"github.com/zibbp/ganymede/internal/utils"
 )
 
@@ -137,11 +140,83 @@ func (_u *UserUpdate) SetUpdatedAt(v time.Time) *UserUpdate {
 	return _u
 }
 
+// AddViewingSessionIDs adds the "viewing_sessions" edge to the ViewingSession entity by IDs.
+func (_u *UserUpdate) AddViewingSessionIDs(ids ...uuid.UUID) *UserUpdate {
+	_u.mutation.AddViewingSessionIDs(ids...)
+	return _u
+}
+
+// AddViewingSessions adds the "viewing_sessions" edges to the ViewingSession entity.
+func (_u *UserUpdate) AddViewingSessions(v ...*ViewingSession) *UserUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddViewingSessionIDs(ids...)
+}
+
+// AddListIDs adds the "lists" edge to the UserList entity by IDs.
+func (_u *UserUpdate) AddListIDs(ids ...uuid.UUID) *UserUpdate {
+	_u.mutation.AddListIDs(ids...)
+	return _u
+}
+
+// AddLists adds the "lists" edges to the UserList entity.
+func (_u *UserUpdate) AddLists(v ...*UserList) *UserUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddListIDs(ids...)
+}
+
 // Mutation returns the UserMutation object of the builder.
 func (_u *UserUpdate) Mutation() *UserMutation {
 	return _u.mutation
 }
 
+// ClearViewingSessions clears all "viewing_sessions" edges to the ViewingSession entity.
+func (_u *UserUpdate) ClearViewingSessions() *UserUpdate {
+	_u.mutation.ClearViewingSessions()
+	return _u
+}
+
+// RemoveViewingSessionIDs removes the "viewing_sessions" edge to ViewingSession entities by IDs.
+func (_u *UserUpdate) RemoveViewingSessionIDs(ids ...uuid.UUID) *UserUpdate {
+	_u.mutation.RemoveViewingSessionIDs(ids...)
+	return _u
+}
+
+// RemoveViewingSessions removes "viewing_sessions" edges to ViewingSession entities.
+func (_u *UserUpdate) RemoveViewingSessions(v ...*ViewingSession) *UserUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveViewingSessionIDs(ids...)
+}
+
+// ClearLists clears all "lists" edges to the UserList entity.
+func (_u *UserUpdate) ClearLists() *UserUpdate {
+	_u.mutation.ClearLists()
+	return _u
+}
+
+// RemoveListIDs removes the "lists" edge to UserList entities by IDs.
+func (_u *UserUpdate) RemoveListIDs(ids ...uuid.UUID) *UserUpdate {
+	_u.mutation.RemoveListIDs(ids...)
+	return _u
+}
+
+// RemoveLists removes "lists" edges to UserList entities.
+func (_u *UserUpdate) RemoveLists(v ...*UserList) *UserUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveListIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (_u *UserUpdate) Save(ctx context.Context) (int, error) {
 	_u.defaults()
@@ -230,6 +305,96 @@ func (_u *UserUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if value, ok := _u.mutation.UpdatedAt(); ok {
 		_spec.SetField(user.FieldUpdatedAt, field.TypeTime, value)
 	}
+	if _u.mutation.ViewingSessionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.ViewingSessionsTable,
+			Columns: []string{user.ViewingSessionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(viewingsession.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedViewingSessionsIDs(); len(nodes) > 0 && !_u.mutation.ViewingSessionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.ViewingSessionsTable,
+			Columns: []string{user.ViewingSessionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(viewingsession.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ViewingSessionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.ViewingSessionsTable,
+			Columns: []string{user.ViewingSessionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(viewingsession.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.ListsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.ListsTable,
+			Columns: []string{user.ListsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(userlist.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedListsIDs(); len(nodes) > 0 && !_u.mutation.ListsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.ListsTable,
+			Columns: []string{user.ListsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(userlist.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ListsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.ListsTable,
+			Columns: []string{user.ListsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(userlist.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{user.Label}
@@ -358,11 +523,83 @@ func (_u *UserUpdateOne) SetUpdatedAt(v time.Time) *UserUpdateOne {
 	return _u
 }
 
+// AddViewingSessionIDs adds the "viewing_sessions" edge to the ViewingSession entity by IDs.
+func (_u *UserUpdateOne) AddViewingSessionIDs(ids ...uuid.UUID) *UserUpdateOne {
+	_u.mutation.AddViewingSessionIDs(ids...)
+	return _u
+}
+
+// AddViewingSessions adds the "viewing_sessions" edges to the ViewingSession entity.
+func (_u *UserUpdateOne) AddViewingSessions(v ...*ViewingSession) *UserUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddViewingSessionIDs(ids...)
+}
+
+// AddListIDs adds the "lists" edge to the UserList entity by IDs.
+func (_u *UserUpdateOne) AddListIDs(ids ...uuid.UUID) *UserUpdateOne {
+	_u.mutation.AddListIDs(ids...)
+	return _u
+}
+
+// AddLists adds the "lists" edges to the UserList entity.
+func (_u *UserUpdateOne) AddLists(v ...*UserList) *UserUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddListIDs(ids...)
+}
+
 // Mutation returns the UserMutation object of the builder.
 func (_u *UserUpdateOne) Mutation() *UserMutation {
 	return _u.mutation
 }
 
+// ClearViewingSessions clears all "viewing_sessions" edges to the ViewingSession entity.
+func (_u *UserUpdateOne) ClearViewingSessions() *UserUpdateOne {
+	_u.mutation.ClearViewingSessions()
+	return _u
+}
+
+// RemoveViewingSessionIDs removes the "viewing_sessions" edge to ViewingSession entities by IDs.
+func (_u *UserUpdateOne) RemoveViewingSessionIDs(ids ...uuid.UUID) *UserUpdateOne {
+	_u.mutation.RemoveViewingSessionIDs(ids...)
+	return _u
+}
+
+// RemoveViewingSessions removes "viewing_sessions" edges to ViewingSession entities.
+func (_u *UserUpdateOne) RemoveViewingSessions(v ...*ViewingSession) *UserUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveViewingSessionIDs(ids...)
+}
+
+// ClearLists clears all "lists" edges to the UserList entity.
+func (_u *UserUpdateOne) ClearLists() *UserUpdateOne {
+	_u.mutation.ClearLists()
+	return _u
+}
+
+// RemoveListIDs removes the "lists" edge to UserList entities by IDs.
+func (_u *UserUpdateOne) RemoveListIDs(ids ...uuid.UUID) *UserUpdateOne {
+	_u.mutation.RemoveListIDs(ids...)
+	return _u
+}
+
+// RemoveLists removes "lists" edges to UserList entities.
+func (_u *UserUpdateOne) RemoveLists(v ...*UserList) *UserUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveListIDs(ids...)
+}
+
 // Where appends a list predicates to the UserUpdate builder.
 func (_u *UserUpdateOne) Where(ps ...predicate.User) *UserUpdateOne {
 	_u.mutation.Where(ps...)
@@ -481,6 +718,96 @@ func (_u *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error) {
 	if value, ok := _u.mutation.UpdatedAt(); ok {
 		_spec.SetField(user.FieldUpdatedAt, field.TypeTime, value)
 	}
+	if _u.mutation.ViewingSessionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.ViewingSessionsTable,
+			Columns: []string{user.ViewingSessionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(viewingsession.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedViewingSessionsIDs(); len(nodes) > 0 && !_u.mutation.ViewingSessionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.ViewingSessionsTable,
+			Columns: []string{user.ViewingSessionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(viewingsession.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ViewingSessionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.ViewingSessionsTable,
+			Columns: []string{user.ViewingSessionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(viewingsession.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.ListsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.ListsTable,
+			Columns: []string{user.ListsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(userlist.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedListsIDs(); len(nodes) > 0 && !_u.mutation.ListsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.ListsTable,
+			Columns: []string{user.ListsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(userlist.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ListsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.ListsTable,
+			Columns: []string{user.ListsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(userlist.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_node = &User{config: _u.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
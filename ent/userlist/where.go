@@ -0,0 +1,414 @@
+// Code generated by ent, DO NOT EDIT.
+
+package userlist
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+	"github.com/zibbp/ganymede/ent/predicate"
+	"github.com/zibbp/ganymede/internal/utils"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.UserList {
+	return predicate.UserList(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.UserList {
+	return predicate.UserList(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.UserList {
+	return predicate.UserList(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.UserList {
+	return predicate.UserList(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.UserList {
+	return predicate.UserList(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.UserList {
+	return predicate.UserList(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.UserList {
+	return predicate.UserList(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.UserList {
+	return predicate.UserList(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.UserList {
+	return predicate.UserList(sql.FieldLTE(FieldID, id))
+}
+
+// UserID applies equality check predicate on the "user_id" field. It's identical to UserIDEQ.
+func UserID(v uuid.UUID) predicate.UserList {
+	return predicate.UserList(sql.FieldEQ(FieldUserID, v))
+}
+
+// Name applies equality check predicate on the "name" field. It's identical to NameEQ.
+func Name(v string) predicate.UserList {
+	return predicate.UserList(sql.FieldEQ(FieldName, v))
+}
+
+// ShareToken applies equality check predicate on the "share_token" field. It's identical to ShareTokenEQ.
+func ShareToken(v string) predicate.UserList {
+	return predicate.UserList(sql.FieldEQ(FieldShareToken, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.UserList {
+	return predicate.UserList(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.UserList {
+	return predicate.UserList(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UserIDEQ applies the EQ predicate on the "user_id" field.
+func UserIDEQ(v uuid.UUID) predicate.UserList {
+	return predicate.UserList(sql.FieldEQ(FieldUserID, v))
+}
+
+// UserIDNEQ applies the NEQ predicate on the "user_id" field.
+func UserIDNEQ(v uuid.UUID) predicate.UserList {
+	return predicate.UserList(sql.FieldNEQ(FieldUserID, v))
+}
+
+// UserIDIn applies the In predicate on the "user_id" field.
+func UserIDIn(vs ...uuid.UUID) predicate.UserList {
+	return predicate.UserList(sql.FieldIn(FieldUserID, vs...))
+}
+
+// UserIDNotIn applies the NotIn predicate on the "user_id" field.
+func UserIDNotIn(vs ...uuid.UUID) predicate.UserList {
+	return predicate.UserList(sql.FieldNotIn(FieldUserID, vs...))
+}
+
+// TypeEQ applies the EQ predicate on the "type" field.
+func TypeEQ(v utils.UserListType) predicate.UserList {
+	vc := v
+	return predicate.UserList(sql.FieldEQ(FieldType, vc))
+}
+
+// TypeNEQ applies the NEQ predicate on the "type" field.
+func TypeNEQ(v utils.UserListType) predicate.UserList {
+	vc := v
+	return predicate.UserList(sql.FieldNEQ(FieldType, vc))
+}
+
+// TypeIn applies the In predicate on the "type" field.
+func TypeIn(vs ...utils.UserListType) predicate.UserList {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.UserList(sql.FieldIn(FieldType, v...))
+}
+
+// TypeNotIn applies the NotIn predicate on the "type" field.
+func TypeNotIn(vs ...utils.UserListType) predicate.UserList {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.UserList(sql.FieldNotIn(FieldType, v...))
+}
+
+// NameEQ applies the EQ predicate on the "name" field.
+func NameEQ(v string) predicate.UserList {
+	return predicate.UserList(sql.FieldEQ(FieldName, v))
+}
+
+// NameNEQ applies the NEQ predicate on the "name" field.
+func NameNEQ(v string) predicate.UserList {
+	return predicate.UserList(sql.FieldNEQ(FieldName, v))
+}
+
+// NameIn applies the In predicate on the "name" field.
+func NameIn(vs ...string) predicate.UserList {
+	return predicate.UserList(sql.FieldIn(FieldName, vs...))
+}
+
+// NameNotIn applies the NotIn predicate on the "name" field.
+func NameNotIn(vs ...string) predicate.UserList {
+	return predicate.UserList(sql.FieldNotIn(FieldName, vs...))
+}
+
+// NameGT applies the GT predicate on the "name" field.
+func NameGT(v string) predicate.UserList {
+	return predicate.UserList(sql.FieldGT(FieldName, v))
+}
+
+// NameGTE applies the GTE predicate on the "name" field.
+func NameGTE(v string) predicate.UserList {
+	return predicate.UserList(sql.FieldGTE(FieldName, v))
+}
+
+// NameLT applies the LT predicate on the "name" field.
+func NameLT(v string) predicate.UserList {
+	return predicate.UserList(sql.FieldLT(FieldName, v))
+}
+
+// NameLTE applies the LTE predicate on the "name" field.
+func NameLTE(v string) predicate.UserList {
+	return predicate.UserList(sql.FieldLTE(FieldName, v))
+}
+
+// NameContains applies the Contains predicate on the "name" field.
+func NameContains(v string) predicate.UserList {
+	return predicate.UserList(sql.FieldContains(FieldName, v))
+}
+
+// NameHasPrefix applies the HasPrefix predicate on the "name" field.
+func NameHasPrefix(v string) predicate.UserList {
+	return predicate.UserList(sql.FieldHasPrefix(FieldName, v))
+}
+
+// NameHasSuffix applies the HasSuffix predicate on the "name" field.
+func NameHasSuffix(v string) predicate.UserList {
+	return predicate.UserList(sql.FieldHasSuffix(FieldName, v))
+}
+
+// NameEqualFold applies the EqualFold predicate on the "name" field.
+func NameEqualFold(v string) predicate.UserList {
+	return predicate.UserList(sql.FieldEqualFold(FieldName, v))
+}
+
+// NameContainsFold applies the ContainsFold predicate on the "name" field.
+func NameContainsFold(v string) predicate.UserList {
+	return predicate.UserList(sql.FieldContainsFold(FieldName, v))
+}
+
+// ShareTokenEQ applies the EQ predicate on the "share_token" field.
+func ShareTokenEQ(v string) predicate.UserList {
+	return predicate.UserList(sql.FieldEQ(FieldShareToken, v))
+}
+
+// ShareTokenNEQ applies the NEQ predicate on the "share_token" field.
+func ShareTokenNEQ(v string) predicate.UserList {
+	return predicate.UserList(sql.FieldNEQ(FieldShareToken, v))
+}
+
+// ShareTokenIn applies the In predicate on the "share_token" field.
+func ShareTokenIn(vs ...string) predicate.UserList {
+	return predicate.UserList(sql.FieldIn(FieldShareToken, vs...))
+}
+
+// ShareTokenNotIn applies the NotIn predicate on the "share_token" field.
+func ShareTokenNotIn(vs ...string) predicate.UserList {
+	return predicate.UserList(sql.FieldNotIn(FieldShareToken, vs...))
+}
+
+// ShareTokenGT applies the GT predicate on the "share_token" field.
+func ShareTokenGT(v string) predicate.UserList {
+	return predicate.UserList(sql.FieldGT(FieldShareToken, v))
+}
+
+// ShareTokenGTE applies the GTE predicate on the "share_token" field.
+func ShareTokenGTE(v string) predicate.UserList {
+	return predicate.UserList(sql.FieldGTE(FieldShareToken, v))
+}
+
+// ShareTokenLT applies the LT predicate on the "share_token" field.
+func ShareTokenLT(v string) predicate.UserList {
+	return predicate.UserList(sql.FieldLT(FieldShareToken, v))
+}
+
+// ShareTokenLTE applies the LTE predicate on the "share_token" field.
+func ShareTokenLTE(v string) predicate.UserList {
+	return predicate.UserList(sql.FieldLTE(FieldShareToken, v))
+}
+
+// ShareTokenContains applies the Contains predicate on the "share_token" field.
+func ShareTokenContains(v string) predicate.UserList {
+	return predicate.UserList(sql.FieldContains(FieldShareToken, v))
+}
+
+// ShareTokenHasPrefix applies the HasPrefix predicate on the "share_token" field.
+func ShareTokenHasPrefix(v string) predicate.UserList {
+	return predicate.UserList(sql.FieldHasPrefix(FieldShareToken, v))
+}
+
+// ShareTokenHasSuffix applies the HasSuffix predicate on the "share_token" field.
+func ShareTokenHasSuffix(v string) predicate.UserList {
+	return predicate.UserList(sql.FieldHasSuffix(FieldShareToken, v))
+}
+
+// ShareTokenIsNil applies the IsNil predicate on the "share_token" field.
+func ShareTokenIsNil() predicate.UserList {
+	return predicate.UserList(sql.FieldIsNull(FieldShareToken))
+}
+
+// ShareTokenNotNil applies the NotNil predicate on the "share_token" field.
+func ShareTokenNotNil() predicate.UserList {
+	return predicate.UserList(sql.FieldNotNull(FieldShareToken))
+}
+
+// ShareTokenEqualFold applies the EqualFold predicate on the "share_token" field.
+func ShareTokenEqualFold(v string) predicate.UserList {
+	return predicate.UserList(sql.FieldEqualFold(FieldShareToken, v))
+}
+
+// ShareTokenContainsFold applies the ContainsFold predicate on the "share_token" field.
+func ShareTokenContainsFold(v string) predicate.UserList {
+	return predicate.UserList(sql.FieldContainsFold(FieldShareToken, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.UserList {
+	return predicate.UserList(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.UserList {
+	return predicate.UserList(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.UserList {
+	return predicate.UserList(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.UserList {
+	return predicate.UserList(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.UserList {
+	return predicate.UserList(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.UserList {
+	return predicate.UserList(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.UserList {
+	return predicate.UserList(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.UserList {
+	return predicate.UserList(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.UserList {
+	return predicate.UserList(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.UserList {
+	return predicate.UserList(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.UserList {
+	return predicate.UserList(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.UserList {
+	return predicate.UserList(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.UserList {
+	return predicate.UserList(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.UserList {
+	return predicate.UserList(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.UserList {
+	return predicate.UserList(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.UserList {
+	return predicate.UserList(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// HasUser applies the HasEdge predicate on the "user" edge.
+func HasUser() predicate.UserList {
+	return predicate.UserList(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, UserTable, UserColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasUserWith applies the HasEdge predicate on the "user" edge with a given conditions (other predicates).
+func HasUserWith(preds ...predicate.User) predicate.UserList {
+	return predicate.UserList(func(s *sql.Selector) {
+		step := newUserStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasItems applies the HasEdge predicate on the "items" edge.
+func HasItems() predicate.UserList {
+	return predicate.UserList(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, ItemsTable, ItemsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasItemsWith applies the HasEdge predicate on the "items" edge with a given conditions (other predicates).
+func HasItemsWith(preds ...predicate.UserListItem) predicate.UserList {
+	return predicate.UserList(func(s *sql.Selector) {
+		step := newItemsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.UserList) predicate.UserList {
+	return predicate.UserList(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.UserList) predicate.UserList {
+	return predicate.UserList(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.UserList) predicate.UserList {
+	return predicate.UserList(sql.NotPredicates(p))
+}
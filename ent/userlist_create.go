@@ -0,0 +1,861 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/zibbp/ganymede/ent/user"
+	"github.com/zibbp/ganymede/ent/userlist"
+	"github.com/zibbp/ganymede/ent/userlistitem"
+	"github.com/zibbp/ganymede/internal/utils"
+)
+
+// UserListCreate is the builder for creating a UserList entity.
+type UserListCreate struct {
+	config
+	mutation *UserListMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetUserID sets the "user_id" field.
+func (_c *UserListCreate) SetUserID(v uuid.UUID) *UserListCreate {
+	_c.mutation.SetUserID(v)
+	return _c
+}
+
+// SetType sets the "type" field.
+func (_c *UserListCreate) SetType(v utils.UserListType) *UserListCreate {
+	_c.mutation.SetType(v)
+	return _c
+}
+
+// SetNillableType sets the "type" field if the given value is not nil.
+func (_c *UserListCreate) SetNillableType(v *utils.UserListType) *UserListCreate {
+	if v != nil {
+		_c.SetType(*v)
+	}
+	return _c
+}
+
+// SetName sets the "name" field.
+func (_c *UserListCreate) SetName(v string) *UserListCreate {
+	_c.mutation.SetName(v)
+	return _c
+}
+
+// SetShareToken sets the "share_token" field.
+func (_c *UserListCreate) SetShareToken(v string) *UserListCreate {
+	_c.mutation.SetShareToken(v)
+	return _c
+}
+
+// SetNillableShareToken sets the "share_token" field if the given value is not nil.
+func (_c *UserListCreate) SetNillableShareToken(v *string) *UserListCreate {
+	if v != nil {
+		_c.SetShareToken(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *UserListCreate) SetUpdatedAt(v time.Time) *UserListCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *UserListCreate) SetNillableUpdatedAt(v *time.Time) *UserListCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *UserListCreate) SetCreatedAt(v time.Time) *UserListCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *UserListCreate) SetNillableCreatedAt(v *time.Time) *UserListCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *UserListCreate) SetID(v uuid.UUID) *UserListCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *UserListCreate) SetNillableID(v *uuid.UUID) *UserListCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (_c *UserListCreate) SetUser(v *User) *UserListCreate {
+	return _c.SetUserID(v.ID)
+}
+
+// AddItemIDs adds the "items" edge to the UserListItem entity by IDs.
+func (_c *UserListCreate) AddItemIDs(ids ...uuid.UUID) *UserListCreate {
+	_c.mutation.AddItemIDs(ids...)
+	return _c
+}
+
+// AddItems adds the "items" edges to the UserListItem entity.
+func (_c *UserListCreate) AddItems(v ...*UserListItem) *UserListCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddItemIDs(ids...)
+}
+
+// Mutation returns the UserListMutation object of the builder.
+func (_c *UserListCreate) Mutation() *UserListMutation {
+	return _c.mutation
+}
+
+// Save creates the UserList in the database.
+func (_c *UserListCreate) Save(ctx context.Context) (*UserList, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *UserListCreate) SaveX(ctx context.Context) *UserList {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *UserListCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *UserListCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *UserListCreate) defaults() {
+	if _, ok := _c.mutation.GetType(); !ok {
+		v := userlist.DefaultType
+		_c.mutation.SetType(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := userlist.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := userlist.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := userlist.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *UserListCreate) check() error {
+	if _, ok := _c.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "UserList.user_id"`)}
+	}
+	if _, ok := _c.mutation.GetType(); !ok {
+		return &ValidationError{Name: "type", err: errors.New(`ent: missing required field "UserList.type"`)}
+	}
+	if v, ok := _c.mutation.GetType(); ok {
+		if err := userlist.TypeValidator(v); err != nil {
+			return &ValidationError{Name: "type", err: fmt.Errorf(`ent: validator failed for field "UserList.type": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "UserList.name"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "UserList.updated_at"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "UserList.created_at"`)}
+	}
+	if len(_c.mutation.UserIDs()) == 0 {
+		return &ValidationError{Name: "user", err: errors.New(`ent: missing required edge "UserList.user"`)}
+	}
+	return nil
+}
+
+func (_c *UserListCreate) sqlSave(ctx context.Context) (*UserList, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *UserListCreate) createSpec() (*UserList, *sqlgraph.CreateSpec) {
+	var (
+		_node = &UserList{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(userlist.Table, sqlgraph.NewFieldSpec(userlist.FieldID, field.TypeUUID))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.GetType(); ok {
+		_spec.SetField(userlist.FieldType, field.TypeEnum, value)
+		_node.Type = value
+	}
+	if value, ok := _c.mutation.Name(); ok {
+		_spec.SetField(userlist.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := _c.mutation.ShareToken(); ok {
+		_spec.SetField(userlist.FieldShareToken, field.TypeString, value)
+		_node.ShareToken = &value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(userlist.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(userlist.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := _c.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   userlist.UserTable,
+			Columns: []string{userlist.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.UserID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.ItemsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   userlist.ItemsTable,
+			Columns: []string{userlist.ItemsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(userlistitem.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.UserList.Create().
+//		SetUserID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.UserListUpsert) {
+//			SetUserID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *UserListCreate) OnConflict(opts ...sql.ConflictOption) *UserListUpsertOne {
+	_c.conflict = opts
+	return &UserListUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.UserList.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *UserListCreate) OnConflictColumns(columns ...string) *UserListUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &UserListUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// UserListUpsertOne is the builder for "upsert"-ing
+	//  one UserList node.
+	UserListUpsertOne struct {
+		create *UserListCreate
+	}
+
+	// UserListUpsert is the "OnConflict" setter.
+	UserListUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUserID sets the "user_id" field.
+func (u *UserListUpsert) SetUserID(v uuid.UUID) *UserListUpsert {
+	u.Set(userlist.FieldUserID, v)
+	return u
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *UserListUpsert) UpdateUserID() *UserListUpsert {
+	u.SetExcluded(userlist.FieldUserID)
+	return u
+}
+
+// SetType sets the "type" field.
+func (u *UserListUpsert) SetType(v utils.UserListType) *UserListUpsert {
+	u.Set(userlist.FieldType, v)
+	return u
+}
+
+// UpdateType sets the "type" field to the value that was provided on create.
+func (u *UserListUpsert) UpdateType() *UserListUpsert {
+	u.SetExcluded(userlist.FieldType)
+	return u
+}
+
+// SetName sets the "name" field.
+func (u *UserListUpsert) SetName(v string) *UserListUpsert {
+	u.Set(userlist.FieldName, v)
+	return u
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *UserListUpsert) UpdateName() *UserListUpsert {
+	u.SetExcluded(userlist.FieldName)
+	return u
+}
+
+// SetShareToken sets the "share_token" field.
+func (u *UserListUpsert) SetShareToken(v string) *UserListUpsert {
+	u.Set(userlist.FieldShareToken, v)
+	return u
+}
+
+// UpdateShareToken sets the "share_token" field to the value that was provided on create.
+func (u *UserListUpsert) UpdateShareToken() *UserListUpsert {
+	u.SetExcluded(userlist.FieldShareToken)
+	return u
+}
+
+// ClearShareToken clears the value of the "share_token" field.
+func (u *UserListUpsert) ClearShareToken() *UserListUpsert {
+	u.SetNull(userlist.FieldShareToken)
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *UserListUpsert) SetUpdatedAt(v time.Time) *UserListUpsert {
+	u.Set(userlist.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *UserListUpsert) UpdateUpdatedAt() *UserListUpsert {
+	u.SetExcluded(userlist.FieldUpdatedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.UserList.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(userlist.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *UserListUpsertOne) UpdateNewValues() *UserListUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(userlist.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(userlist.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.UserList.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *UserListUpsertOne) Ignore() *UserListUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *UserListUpsertOne) DoNothing() *UserListUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the UserListCreate.OnConflict
+// documentation for more info.
+func (u *UserListUpsertOne) Update(set func(*UserListUpsert)) *UserListUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&UserListUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUserID sets the "user_id" field.
+func (u *UserListUpsertOne) SetUserID(v uuid.UUID) *UserListUpsertOne {
+	return u.Update(func(s *UserListUpsert) {
+		s.SetUserID(v)
+	})
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *UserListUpsertOne) UpdateUserID() *UserListUpsertOne {
+	return u.Update(func(s *UserListUpsert) {
+		s.UpdateUserID()
+	})
+}
+
+// SetType sets the "type" field.
+func (u *UserListUpsertOne) SetType(v utils.UserListType) *UserListUpsertOne {
+	return u.Update(func(s *UserListUpsert) {
+		s.SetType(v)
+	})
+}
+
+// UpdateType sets the "type" field to the value that was provided on create.
+func (u *UserListUpsertOne) UpdateType() *UserListUpsertOne {
+	return u.Update(func(s *UserListUpsert) {
+		s.UpdateType()
+	})
+}
+
+// SetName sets the "name" field.
+func (u *UserListUpsertOne) SetName(v string) *UserListUpsertOne {
+	return u.Update(func(s *UserListUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *UserListUpsertOne) UpdateName() *UserListUpsertOne {
+	return u.Update(func(s *UserListUpsert) {
+		s.UpdateName()
+	})
+}
+
+// SetShareToken sets the "share_token" field.
+func (u *UserListUpsertOne) SetShareToken(v string) *UserListUpsertOne {
+	return u.Update(func(s *UserListUpsert) {
+		s.SetShareToken(v)
+	})
+}
+
+// UpdateShareToken sets the "share_token" field to the value that was provided on create.
+func (u *UserListUpsertOne) UpdateShareToken() *UserListUpsertOne {
+	return u.Update(func(s *UserListUpsert) {
+		s.UpdateShareToken()
+	})
+}
+
+// ClearShareToken clears the value of the "share_token" field.
+func (u *UserListUpsertOne) ClearShareToken() *UserListUpsertOne {
+	return u.Update(func(s *UserListUpsert) {
+		s.ClearShareToken()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *UserListUpsertOne) SetUpdatedAt(v time.Time) *UserListUpsertOne {
+	return u.Update(func(s *UserListUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *UserListUpsertOne) UpdateUpdatedAt() *UserListUpsertOne {
+	return u.Update(func(s *UserListUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *UserListUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for UserListCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *UserListUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *UserListUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: UserListUpsertOne.ID is not supported by MySQL driver. Use UserListUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *UserListUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// UserListCreateBulk is the builder for creating many UserList entities in bulk.
+type UserListCreateBulk struct {
+	config
+	err      error
+	builders []*UserListCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the UserList entities in the database.
+func (_c *UserListCreateBulk) Save(ctx context.Context) ([]*UserList, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*UserList, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*UserListMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *UserListCreateBulk) SaveX(ctx context.Context) []*UserList {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *UserListCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *UserListCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.UserList.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.UserListUpsert) {
+//			SetUserID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *UserListCreateBulk) OnConflict(opts ...sql.ConflictOption) *UserListUpsertBulk {
+	_c.conflict = opts
+	return &UserListUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.UserList.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *UserListCreateBulk) OnConflictColumns(columns ...string) *UserListUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &UserListUpsertBulk{
+		create: _c,
+	}
+}
+
+// UserListUpsertBulk is the builder for "upsert"-ing
+// a bulk of UserList nodes.
+type UserListUpsertBulk struct {
+	create *UserListCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.UserList.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(userlist.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *UserListUpsertBulk) UpdateNewValues() *UserListUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(userlist.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(userlist.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.UserList.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *UserListUpsertBulk) Ignore() *UserListUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *UserListUpsertBulk) DoNothing() *UserListUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the UserListCreateBulk.OnConflict
+// documentation for more info.
+func (u *UserListUpsertBulk) Update(set func(*UserListUpsert)) *UserListUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&UserListUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUserID sets the "user_id" field.
+func (u *UserListUpsertBulk) SetUserID(v uuid.UUID) *UserListUpsertBulk {
+	return u.Update(func(s *UserListUpsert) {
+		s.SetUserID(v)
+	})
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *UserListUpsertBulk) UpdateUserID() *UserListUpsertBulk {
+	return u.Update(func(s *UserListUpsert) {
+		s.UpdateUserID()
+	})
+}
+
+// SetType sets the "type" field.
+func (u *UserListUpsertBulk) SetType(v utils.UserListType) *UserListUpsertBulk {
+	return u.Update(func(s *UserListUpsert) {
+		s.SetType(v)
+	})
+}
+
+// UpdateType sets the "type" field to the value that was provided on create.
+func (u *UserListUpsertBulk) UpdateType() *UserListUpsertBulk {
+	return u.Update(func(s *UserListUpsert) {
+		s.UpdateType()
+	})
+}
+
+// SetName sets the "name" field.
+func (u *UserListUpsertBulk) SetName(v string) *UserListUpsertBulk {
+	return u.Update(func(s *UserListUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *UserListUpsertBulk) UpdateName() *UserListUpsertBulk {
+	return u.Update(func(s *UserListUpsert) {
+		s.UpdateName()
+	})
+}
+
+// SetShareToken sets the "share_token" field.
+func (u *UserListUpsertBulk) SetShareToken(v string) *UserListUpsertBulk {
+	return u.Update(func(s *UserListUpsert) {
+		s.SetShareToken(v)
+	})
+}
+
+// UpdateShareToken sets the "share_token" field to the value that was provided on create.
+func (u *UserListUpsertBulk) UpdateShareToken() *UserListUpsertBulk {
+	return u.Update(func(s *UserListUpsert) {
+		s.UpdateShareToken()
+	})
+}
+
+// ClearShareToken clears the value of the "share_token" field.
+func (u *UserListUpsertBulk) ClearShareToken() *UserListUpsertBulk {
+	return u.Update(func(s *UserListUpsert) {
+		s.ClearShareToken()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *UserListUpsertBulk) SetUpdatedAt(v time.Time) *UserListUpsertBulk {
+	return u.Update(func(s *UserListUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *UserListUpsertBulk) UpdateUpdatedAt() *UserListUpsertBulk {
+	return u.Update(func(s *UserListUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *UserListUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the UserListCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for UserListCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *UserListUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
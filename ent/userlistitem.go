@@ -0,0 +1,188 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/zibbp/ganymede/ent/userlist"
+	"github.com/zibbp/ganymede/ent/userlistitem"
+	"github.com/zibbp/ganymede/ent/vod"
+)
+
+// UserListItem is the model entity for the UserListItem schema.
+type UserListItem struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// The ID of the list.
+	ListID uuid.UUID `json:"list_id,omitempty"`
+	// The ID of the video.
+	VodID uuid.UUID `json:"vod_id,omitempty"`
+	// The position of the video in the list, items are sorted ascending.
+	Position int `json:"position,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the UserListItemQuery when eager-loading is set.
+	Edges        UserListItemEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// UserListItemEdges holds the relations/edges for other nodes in the graph.
+type UserListItemEdges struct {
+	// List holds the value of the list edge.
+	List *UserList `json:"list,omitempty"`
+	// Vod holds the value of the vod edge.
+	Vod *Vod `json:"vod,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// ListOrErr returns the List value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e UserListItemEdges) ListOrErr() (*UserList, error) {
+	if e.List != nil {
+		return e.List, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: userlist.Label}
+	}
+	return nil, &NotLoadedError{edge: "list"}
+}
+
+// VodOrErr returns the Vod value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e UserListItemEdges) VodOrErr() (*Vod, error) {
+	if e.Vod != nil {
+		return e.Vod, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: vod.Label}
+	}
+	return nil, &NotLoadedError{edge: "vod"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*UserListItem) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case userlistitem.FieldPosition:
+			values[i] = new(sql.NullInt64)
+		case userlistitem.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		case userlistitem.FieldID, userlistitem.FieldListID, userlistitem.FieldVodID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the UserListItem fields.
+func (_m *UserListItem) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case userlistitem.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case userlistitem.FieldListID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field list_id", values[i])
+			} else if value != nil {
+				_m.ListID = *value
+			}
+		case userlistitem.FieldVodID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field vod_id", values[i])
+			} else if value != nil {
+				_m.VodID = *value
+			}
+		case userlistitem.FieldPosition:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field position", values[i])
+			} else if value.Valid {
+				_m.Position = int(value.Int64)
+			}
+		case userlistitem.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the UserListItem.
+// This includes values selected through modifiers, order, etc.
+func (_m *UserListItem) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryList queries the "list" edge of the UserListItem entity.
+func (_m *UserListItem) QueryList() *UserListQuery {
+	return NewUserListItemClient(_m.config).QueryList(_m)
+}
+
+// QueryVod queries the "vod" edge of the UserListItem entity.
+func (_m *UserListItem) QueryVod() *VodQuery {
+	return NewUserListItemClient(_m.config).QueryVod(_m)
+}
+
+// Update returns a builder for updating this UserListItem.
+// Note that you need to call UserListItem.Unwrap() before calling this method if this UserListItem
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *UserListItem) Update() *UserListItemUpdateOne {
+	return NewUserListItemClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the UserListItem entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *UserListItem) Unwrap() *UserListItem {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: UserListItem is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *UserListItem) String() string {
+	var builder strings.Builder
+	builder.WriteString("UserListItem(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("list_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ListID))
+	builder.WriteString(", ")
+	builder.WriteString("vod_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.VodID))
+	builder.WriteString(", ")
+	builder.WriteString("position=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Position))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// UserListItems is a parsable slice of UserListItem.
+type UserListItems []*UserListItem
@@ -0,0 +1,258 @@
+// Code generated by ent, DO NOT EDIT.
+
+package userlistitem
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+	"github.com/zibbp/ganymede/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.UserListItem {
+	return predicate.UserListItem(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.UserListItem {
+	return predicate.UserListItem(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.UserListItem {
+	return predicate.UserListItem(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.UserListItem {
+	return predicate.UserListItem(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.UserListItem {
+	return predicate.UserListItem(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.UserListItem {
+	return predicate.UserListItem(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.UserListItem {
+	return predicate.UserListItem(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.UserListItem {
+	return predicate.UserListItem(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.UserListItem {
+	return predicate.UserListItem(sql.FieldLTE(FieldID, id))
+}
+
+// ListID applies equality check predicate on the "list_id" field. It's identical to ListIDEQ.
+func ListID(v uuid.UUID) predicate.UserListItem {
+	return predicate.UserListItem(sql.FieldEQ(FieldListID, v))
+}
+
+// VodID applies equality check predicate on the "vod_id" field. It's identical to VodIDEQ.
+func VodID(v uuid.UUID) predicate.UserListItem {
+	return predicate.UserListItem(sql.FieldEQ(FieldVodID, v))
+}
+
+// Position applies equality check predicate on the "position" field. It's identical to PositionEQ.
+func Position(v int) predicate.UserListItem {
+	return predicate.UserListItem(sql.FieldEQ(FieldPosition, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.UserListItem {
+	return predicate.UserListItem(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// ListIDEQ applies the EQ predicate on the "list_id" field.
+func ListIDEQ(v uuid.UUID) predicate.UserListItem {
+	return predicate.UserListItem(sql.FieldEQ(FieldListID, v))
+}
+
+// ListIDNEQ applies the NEQ predicate on the "list_id" field.
+func ListIDNEQ(v uuid.UUID) predicate.UserListItem {
+	return predicate.UserListItem(sql.FieldNEQ(FieldListID, v))
+}
+
+// ListIDIn applies the In predicate on the "list_id" field.
+func ListIDIn(vs ...uuid.UUID) predicate.UserListItem {
+	return predicate.UserListItem(sql.FieldIn(FieldListID, vs...))
+}
+
+// ListIDNotIn applies the NotIn predicate on the "list_id" field.
+func ListIDNotIn(vs ...uuid.UUID) predicate.UserListItem {
+	return predicate.UserListItem(sql.FieldNotIn(FieldListID, vs...))
+}
+
+// VodIDEQ applies the EQ predicate on the "vod_id" field.
+func VodIDEQ(v uuid.UUID) predicate.UserListItem {
+	return predicate.UserListItem(sql.FieldEQ(FieldVodID, v))
+}
+
+// VodIDNEQ applies the NEQ predicate on the "vod_id" field.
+func VodIDNEQ(v uuid.UUID) predicate.UserListItem {
+	return predicate.UserListItem(sql.FieldNEQ(FieldVodID, v))
+}
+
+// VodIDIn applies the In predicate on the "vod_id" field.
+func VodIDIn(vs ...uuid.UUID) predicate.UserListItem {
+	return predicate.UserListItem(sql.FieldIn(FieldVodID, vs...))
+}
+
+// VodIDNotIn applies the NotIn predicate on the "vod_id" field.
+func VodIDNotIn(vs ...uuid.UUID) predicate.UserListItem {
+	return predicate.UserListItem(sql.FieldNotIn(FieldVodID, vs...))
+}
+
+// PositionEQ applies the EQ predicate on the "position" field.
+func PositionEQ(v int) predicate.UserListItem {
+	return predicate.UserListItem(sql.FieldEQ(FieldPosition, v))
+}
+
+// PositionNEQ applies the NEQ predicate on the "position" field.
+func PositionNEQ(v int) predicate.UserListItem {
+	return predicate.UserListItem(sql.FieldNEQ(FieldPosition, v))
+}
+
+// PositionIn applies the In predicate on the "position" field.
+func PositionIn(vs ...int) predicate.UserListItem {
+	return predicate.UserListItem(sql.FieldIn(FieldPosition, vs...))
+}
+
+// PositionNotIn applies the NotIn predicate on the "position" field.
+func PositionNotIn(vs ...int) predicate.UserListItem {
+	return predicate.UserListItem(sql.FieldNotIn(FieldPosition, vs...))
+}
+
+// PositionGT applies the GT predicate on the "position" field.
+func PositionGT(v int) predicate.UserListItem {
+	return predicate.UserListItem(sql.FieldGT(FieldPosition, v))
+}
+
+// PositionGTE applies the GTE predicate on the "position" field.
+func PositionGTE(v int) predicate.UserListItem {
+	return predicate.UserListItem(sql.FieldGTE(FieldPosition, v))
+}
+
+// PositionLT applies the LT predicate on the "position" field.
+func PositionLT(v int) predicate.UserListItem {
+	return predicate.UserListItem(sql.FieldLT(FieldPosition, v))
+}
+
+// PositionLTE applies the LTE predicate on the "position" field.
+func PositionLTE(v int) predicate.UserListItem {
+	return predicate.UserListItem(sql.FieldLTE(FieldPosition, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.UserListItem {
+	return predicate.UserListItem(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.UserListItem {
+	return predicate.UserListItem(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.UserListItem {
+	return predicate.UserListItem(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.UserListItem {
+	return predicate.UserListItem(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.UserListItem {
+	return predicate.UserListItem(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.UserListItem {
+	return predicate.UserListItem(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.UserListItem {
+	return predicate.UserListItem(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.UserListItem {
+	return predicate.UserListItem(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// HasList applies the HasEdge predicate on the "list" edge.
+func HasList() predicate.UserListItem {
+	return predicate.UserListItem(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, ListTable, ListColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasListWith applies the HasEdge predicate on the "list" edge with a given conditions (other predicates).
+func HasListWith(preds ...predicate.UserList) predicate.UserListItem {
+	return predicate.UserListItem(func(s *sql.Selector) {
+		step := newListStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasVod applies the HasEdge predicate on the "vod" edge.
+func HasVod() predicate.UserListItem {
+	return predicate.UserListItem(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, VodTable, VodColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasVodWith applies the HasEdge predicate on the "vod" edge with a given conditions (other predicates).
+func HasVodWith(preds ...predicate.Vod) predicate.UserListItem {
+	return predicate.UserListItem(func(s *sql.Selector) {
+		step := newVodStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.UserListItem) predicate.UserListItem {
+	return predicate.UserListItem(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.UserListItem) predicate.UserListItem {
+	return predicate.UserListItem(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.UserListItem) predicate.UserListItem {
+	return predicate.UserListItem(sql.NotPredicates(p))
+}
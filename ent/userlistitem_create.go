@@ -0,0 +1,722 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/zibbp/ganymede/ent/userlist"
+	"github.com/zibbp/ganymede/ent/userlistitem"
+	"github.com/zibbp/ganymede/ent/vod"
+)
+
+// UserListItemCreate is the builder for creating a UserListItem entity.
+type UserListItemCreate struct {
+	config
+	mutation *UserListItemMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetListID sets the "list_id" field.
+func (_c *UserListItemCreate) SetListID(v uuid.UUID) *UserListItemCreate {
+	_c.mutation.SetListID(v)
+	return _c
+}
+
+// SetVodID sets the "vod_id" field.
+func (_c *UserListItemCreate) SetVodID(v uuid.UUID) *UserListItemCreate {
+	_c.mutation.SetVodID(v)
+	return _c
+}
+
+// SetPosition sets the "position" field.
+func (_c *UserListItemCreate) SetPosition(v int) *UserListItemCreate {
+	_c.mutation.SetPosition(v)
+	return _c
+}
+
+// SetNillablePosition sets the "position" field if the given value is not nil.
+func (_c *UserListItemCreate) SetNillablePosition(v *int) *UserListItemCreate {
+	if v != nil {
+		_c.SetPosition(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *UserListItemCreate) SetCreatedAt(v time.Time) *UserListItemCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *UserListItemCreate) SetNillableCreatedAt(v *time.Time) *UserListItemCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *UserListItemCreate) SetID(v uuid.UUID) *UserListItemCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *UserListItemCreate) SetNillableID(v *uuid.UUID) *UserListItemCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// SetList sets the "list" edge to the UserList entity.
+func (_c *UserListItemCreate) SetList(v *UserList) *UserListItemCreate {
+	return _c.SetListID(v.ID)
+}
+
+// SetVod sets the "vod" edge to the Vod entity.
+func (_c *UserListItemCreate) SetVod(v *Vod) *UserListItemCreate {
+	return _c.SetVodID(v.ID)
+}
+
+// Mutation returns the UserListItemMutation object of the builder.
+func (_c *UserListItemCreate) Mutation() *UserListItemMutation {
+	return _c.mutation
+}
+
+// Save creates the UserListItem in the database.
+func (_c *UserListItemCreate) Save(ctx context.Context) (*UserListItem, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *UserListItemCreate) SaveX(ctx context.Context) *UserListItem {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *UserListItemCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *UserListItemCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *UserListItemCreate) defaults() {
+	if _, ok := _c.mutation.Position(); !ok {
+		v := userlistitem.DefaultPosition
+		_c.mutation.SetPosition(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := userlistitem.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := userlistitem.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *UserListItemCreate) check() error {
+	if _, ok := _c.mutation.ListID(); !ok {
+		return &ValidationError{Name: "list_id", err: errors.New(`ent: missing required field "UserListItem.list_id"`)}
+	}
+	if _, ok := _c.mutation.VodID(); !ok {
+		return &ValidationError{Name: "vod_id", err: errors.New(`ent: missing required field "UserListItem.vod_id"`)}
+	}
+	if _, ok := _c.mutation.Position(); !ok {
+		return &ValidationError{Name: "position", err: errors.New(`ent: missing required field "UserListItem.position"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "UserListItem.created_at"`)}
+	}
+	if len(_c.mutation.ListIDs()) == 0 {
+		return &ValidationError{Name: "list", err: errors.New(`ent: missing required edge "UserListItem.list"`)}
+	}
+	if len(_c.mutation.VodIDs()) == 0 {
+		return &ValidationError{Name: "vod", err: errors.New(`ent: missing required edge "UserListItem.vod"`)}
+	}
+	return nil
+}
+
+func (_c *UserListItemCreate) sqlSave(ctx context.Context) (*UserListItem, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *UserListItemCreate) createSpec() (*UserListItem, *sqlgraph.CreateSpec) {
+	var (
+		_node = &UserListItem{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(userlistitem.Table, sqlgraph.NewFieldSpec(userlistitem.FieldID, field.TypeUUID))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.Position(); ok {
+		_spec.SetField(userlistitem.FieldPosition, field.TypeInt, value)
+		_node.Position = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(userlistitem.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := _c.mutation.ListIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   userlistitem.ListTable,
+			Columns: []string{userlistitem.ListColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(userlist.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.ListID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.VodIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   userlistitem.VodTable,
+			Columns: []string{userlistitem.VodColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(vod.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.VodID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.UserListItem.Create().
+//		SetListID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.UserListItemUpsert) {
+//			SetListID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *UserListItemCreate) OnConflict(opts ...sql.ConflictOption) *UserListItemUpsertOne {
+	_c.conflict = opts
+	return &UserListItemUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.UserListItem.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *UserListItemCreate) OnConflictColumns(columns ...string) *UserListItemUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &UserListItemUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// UserListItemUpsertOne is the builder for "upsert"-ing
+	//  one UserListItem node.
+	UserListItemUpsertOne struct {
+		create *UserListItemCreate
+	}
+
+	// UserListItemUpsert is the "OnConflict" setter.
+	UserListItemUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetListID sets the "list_id" field.
+func (u *UserListItemUpsert) SetListID(v uuid.UUID) *UserListItemUpsert {
+	u.Set(userlistitem.FieldListID, v)
+	return u
+}
+
+// UpdateListID sets the "list_id" field to the value that was provided on create.
+func (u *UserListItemUpsert) UpdateListID() *UserListItemUpsert {
+	u.SetExcluded(userlistitem.FieldListID)
+	return u
+}
+
+// SetVodID sets the "vod_id" field.
+func (u *UserListItemUpsert) SetVodID(v uuid.UUID) *UserListItemUpsert {
+	u.Set(userlistitem.FieldVodID, v)
+	return u
+}
+
+// UpdateVodID sets the "vod_id" field to the value that was provided on create.
+func (u *UserListItemUpsert) UpdateVodID() *UserListItemUpsert {
+	u.SetExcluded(userlistitem.FieldVodID)
+	return u
+}
+
+// SetPosition sets the "position" field.
+func (u *UserListItemUpsert) SetPosition(v int) *UserListItemUpsert {
+	u.Set(userlistitem.FieldPosition, v)
+	return u
+}
+
+// UpdatePosition sets the "position" field to the value that was provided on create.
+func (u *UserListItemUpsert) UpdatePosition() *UserListItemUpsert {
+	u.SetExcluded(userlistitem.FieldPosition)
+	return u
+}
+
+// AddPosition adds v to the "position" field.
+func (u *UserListItemUpsert) AddPosition(v int) *UserListItemUpsert {
+	u.Add(userlistitem.FieldPosition, v)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.UserListItem.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(userlistitem.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *UserListItemUpsertOne) UpdateNewValues() *UserListItemUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(userlistitem.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(userlistitem.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.UserListItem.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *UserListItemUpsertOne) Ignore() *UserListItemUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *UserListItemUpsertOne) DoNothing() *UserListItemUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the UserListItemCreate.OnConflict
+// documentation for more info.
+func (u *UserListItemUpsertOne) Update(set func(*UserListItemUpsert)) *UserListItemUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&UserListItemUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetListID sets the "list_id" field.
+func (u *UserListItemUpsertOne) SetListID(v uuid.UUID) *UserListItemUpsertOne {
+	return u.Update(func(s *UserListItemUpsert) {
+		s.SetListID(v)
+	})
+}
+
+// UpdateListID sets the "list_id" field to the value that was provided on create.
+func (u *UserListItemUpsertOne) UpdateListID() *UserListItemUpsertOne {
+	return u.Update(func(s *UserListItemUpsert) {
+		s.UpdateListID()
+	})
+}
+
+// SetVodID sets the "vod_id" field.
+func (u *UserListItemUpsertOne) SetVodID(v uuid.UUID) *UserListItemUpsertOne {
+	return u.Update(func(s *UserListItemUpsert) {
+		s.SetVodID(v)
+	})
+}
+
+// UpdateVodID sets the "vod_id" field to the value that was provided on create.
+func (u *UserListItemUpsertOne) UpdateVodID() *UserListItemUpsertOne {
+	return u.Update(func(s *UserListItemUpsert) {
+		s.UpdateVodID()
+	})
+}
+
+// SetPosition sets the "position" field.
+func (u *UserListItemUpsertOne) SetPosition(v int) *UserListItemUpsertOne {
+	return u.Update(func(s *UserListItemUpsert) {
+		s.SetPosition(v)
+	})
+}
+
+// AddPosition adds v to the "position" field.
+func (u *UserListItemUpsertOne) AddPosition(v int) *UserListItemUpsertOne {
+	return u.Update(func(s *UserListItemUpsert) {
+		s.AddPosition(v)
+	})
+}
+
+// UpdatePosition sets the "position" field to the value that was provided on create.
+func (u *UserListItemUpsertOne) UpdatePosition() *UserListItemUpsertOne {
+	return u.Update(func(s *UserListItemUpsert) {
+		s.UpdatePosition()
+	})
+}
+
+// Exec executes the query.
+func (u *UserListItemUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for UserListItemCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *UserListItemUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *UserListItemUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: UserListItemUpsertOne.ID is not supported by MySQL driver. Use UserListItemUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *UserListItemUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// UserListItemCreateBulk is the builder for creating many UserListItem entities in bulk.
+type UserListItemCreateBulk struct {
+	config
+	err      error
+	builders []*UserListItemCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the UserListItem entities in the database.
+func (_c *UserListItemCreateBulk) Save(ctx context.Context) ([]*UserListItem, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*UserListItem, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*UserListItemMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *UserListItemCreateBulk) SaveX(ctx context.Context) []*UserListItem {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *UserListItemCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *UserListItemCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.UserListItem.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.UserListItemUpsert) {
+//			SetListID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *UserListItemCreateBulk) OnConflict(opts ...sql.ConflictOption) *UserListItemUpsertBulk {
+	_c.conflict = opts
+	return &UserListItemUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.UserListItem.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *UserListItemCreateBulk) OnConflictColumns(columns ...string) *UserListItemUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &UserListItemUpsertBulk{
+		create: _c,
+	}
+}
+
+// UserListItemUpsertBulk is the builder for "upsert"-ing
+// a bulk of UserListItem nodes.
+type UserListItemUpsertBulk struct {
+	create *UserListItemCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.UserListItem.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(userlistitem.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *UserListItemUpsertBulk) UpdateNewValues() *UserListItemUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(userlistitem.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(userlistitem.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.UserListItem.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *UserListItemUpsertBulk) Ignore() *UserListItemUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *UserListItemUpsertBulk) DoNothing() *UserListItemUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the UserListItemCreateBulk.OnConflict
+// documentation for more info.
+func (u *UserListItemUpsertBulk) Update(set func(*UserListItemUpsert)) *UserListItemUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&UserListItemUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetListID sets the "list_id" field.
+func (u *UserListItemUpsertBulk) SetListID(v uuid.UUID) *UserListItemUpsertBulk {
+	return u.Update(func(s *UserListItemUpsert) {
+		s.SetListID(v)
+	})
+}
+
+// UpdateListID sets the "list_id" field to the value that was provided on create.
+func (u *UserListItemUpsertBulk) UpdateListID() *UserListItemUpsertBulk {
+	return u.Update(func(s *UserListItemUpsert) {
+		s.UpdateListID()
+	})
+}
+
+// SetVodID sets the "vod_id" field.
+func (u *UserListItemUpsertBulk) SetVodID(v uuid.UUID) *UserListItemUpsertBulk {
+	return u.Update(func(s *UserListItemUpsert) {
+		s.SetVodID(v)
+	})
+}
+
+// UpdateVodID sets the "vod_id" field to the value that was provided on create.
+func (u *UserListItemUpsertBulk) UpdateVodID() *UserListItemUpsertBulk {
+	return u.Update(func(s *UserListItemUpsert) {
+		s.UpdateVodID()
+	})
+}
+
+// SetPosition sets the "position" field.
+func (u *UserListItemUpsertBulk) SetPosition(v int) *UserListItemUpsertBulk {
+	return u.Update(func(s *UserListItemUpsert) {
+		s.SetPosition(v)
+	})
+}
+
+// AddPosition adds v to the "position" field.
+func (u *UserListItemUpsertBulk) AddPosition(v int) *UserListItemUpsertBulk {
+	return u.Update(func(s *UserListItemUpsert) {
+		s.AddPosition(v)
+	})
+}
+
+// UpdatePosition sets the "position" field to the value that was provided on create.
+func (u *UserListItemUpsertBulk) UpdatePosition() *UserListItemUpsertBulk {
+	return u.Update(func(s *UserListItemUpsert) {
+		s.UpdatePosition()
+	})
+}
+
+// Exec executes the query.
+func (u *UserListItemUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the UserListItemCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for UserListItemCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *UserListItemUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,476 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/zibbp/ganymede/ent/predicate"
+	"github.com/zibbp/ganymede/ent/userlist"
+	"github.com/zibbp/ganymede/ent/userlistitem"
+	"github.com/zibbp/ganymede/ent/vod"
+)
+
+// UserListItemUpdate is the builder for updating UserListItem entities.
+type UserListItemUpdate struct {
+	config
+	hooks    []Hook
+	mutation *UserListItemMutation
+}
+
+// Where appends a list predicates to the UserListItemUpdate builder.
+func (_u *UserListItemUpdate) Where(ps ...predicate.UserListItem) *UserListItemUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetListID sets the "list_id" field.
+func (_u *UserListItemUpdate) SetListID(v uuid.UUID) *UserListItemUpdate {
+	_u.mutation.SetListID(v)
+	return _u
+}
+
+// SetNillableListID sets the "list_id" field if the given value is not nil.
+func (_u *UserListItemUpdate) SetNillableListID(v *uuid.UUID) *UserListItemUpdate {
+	if v != nil {
+		_u.SetListID(*v)
+	}
+	return _u
+}
+
+// SetVodID sets the "vod_id" field.
+func (_u *UserListItemUpdate) SetVodID(v uuid.UUID) *UserListItemUpdate {
+	_u.mutation.SetVodID(v)
+	return _u
+}
+
+// SetNillableVodID sets the "vod_id" field if the given value is not nil.
+func (_u *UserListItemUpdate) SetNillableVodID(v *uuid.UUID) *UserListItemUpdate {
+	if v != nil {
+		_u.SetVodID(*v)
+	}
+	return _u
+}
+
+// SetPosition sets the "position" field.
+func (_u *UserListItemUpdate) SetPosition(v int) *UserListItemUpdate {
+	_u.mutation.ResetPosition()
+	_u.mutation.SetPosition(v)
+	return _u
+}
+
+// SetNillablePosition sets the "position" field if the given value is not nil.
+func (_u *UserListItemUpdate) SetNillablePosition(v *int) *UserListItemUpdate {
+	if v != nil {
+		_u.SetPosition(*v)
+	}
+	return _u
+}
+
+// AddPosition adds value to the "position" field.
+func (_u *UserListItemUpdate) AddPosition(v int) *UserListItemUpdate {
+	_u.mutation.AddPosition(v)
+	return _u
+}
+
+// SetList sets the "list" edge to the UserList entity.
+func (_u *UserListItemUpdate) SetList(v *UserList) *UserListItemUpdate {
+	return _u.SetListID(v.ID)
+}
+
+// SetVod sets the "vod" edge to the Vod entity.
+func (_u *UserListItemUpdate) SetVod(v *Vod) *UserListItemUpdate {
+	return _u.SetVodID(v.ID)
+}
+
+// Mutation returns the UserListItemMutation object of the builder.
+func (_u *UserListItemUpdate) Mutation() *UserListItemMutation {
+	return _u.mutation
+}
+
+// ClearList clears the "list" edge to the UserList entity.
+func (_u *UserListItemUpdate) ClearList() *UserListItemUpdate {
+	_u.mutation.ClearList()
+	return _u
+}
+
+// ClearVod clears the "vod" edge to the Vod entity.
+func (_u *UserListItemUpdate) ClearVod() *UserListItemUpdate {
+	_u.mutation.ClearVod()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *UserListItemUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *UserListItemUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *UserListItemUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *UserListItemUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *UserListItemUpdate) check() error {
+	if _u.mutation.ListCleared() && len(_u.mutation.ListIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "UserListItem.list"`)
+	}
+	if _u.mutation.VodCleared() && len(_u.mutation.VodIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "UserListItem.vod"`)
+	}
+	return nil
+}
+
+func (_u *UserListItemUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(userlistitem.Table, userlistitem.Columns, sqlgraph.NewFieldSpec(userlistitem.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Position(); ok {
+		_spec.SetField(userlistitem.FieldPosition, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedPosition(); ok {
+		_spec.AddField(userlistitem.FieldPosition, field.TypeInt, value)
+	}
+	if _u.mutation.ListCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   userlistitem.ListTable,
+			Columns: []string{userlistitem.ListColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(userlist.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ListIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   userlistitem.ListTable,
+			Columns: []string{userlistitem.ListColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(userlist.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.VodCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   userlistitem.VodTable,
+			Columns: []string{userlistitem.VodColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(vod.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.VodIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   userlistitem.VodTable,
+			Columns: []string{userlistitem.VodColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(vod.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{userlistitem.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// UserListItemUpdateOne is the builder for updating a single UserListItem entity.
+type UserListItemUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *UserListItemMutation
+}
+
+// SetListID sets the "list_id" field.
+func (_u *UserListItemUpdateOne) SetListID(v uuid.UUID) *UserListItemUpdateOne {
+	_u.mutation.SetListID(v)
+	return _u
+}
+
+// SetNillableListID sets the "list_id" field if the given value is not nil.
+func (_u *UserListItemUpdateOne) SetNillableListID(v *uuid.UUID) *UserListItemUpdateOne {
+	if v != nil {
+		_u.SetListID(*v)
+	}
+	return _u
+}
+
+// SetVodID sets the "vod_id" field.
+func (_u *UserListItemUpdateOne) SetVodID(v uuid.UUID) *UserListItemUpdateOne {
+	_u.mutation.SetVodID(v)
+	return _u
+}
+
+// SetNillableVodID sets the "vod_id" field if the given value is not nil.
+func (_u *UserListItemUpdateOne) SetNillableVodID(v *uuid.UUID) *UserListItemUpdateOne {
+	if v != nil {
+		_u.SetVodID(*v)
+	}
+	return _u
+}
+
+// SetPosition sets the "position" field.
+func (_u *UserListItemUpdateOne) SetPosition(v int) *UserListItemUpdateOne {
+	_u.mutation.ResetPosition()
+	_u.mutation.SetPosition(v)
+	return _u
+}
+
+// SetNillablePosition sets the "position" field if the given value is not nil.
+func (_u *UserListItemUpdateOne) SetNillablePosition(v *int) *UserListItemUpdateOne {
+	if v != nil {
+		_u.SetPosition(*v)
+	}
+	return _u
+}
+
+// AddPosition adds value to the "position" field.
+func (_u *UserListItemUpdateOne) AddPosition(v int) *UserListItemUpdateOne {
+	_u.mutation.AddPosition(v)
+	return _u
+}
+
+// SetList sets the "list" edge to the UserList entity.
+func (_u *UserListItemUpdateOne) SetList(v *UserList) *UserListItemUpdateOne {
+	return _u.SetListID(v.ID)
+}
+
+// SetVod sets the "vod" edge to the Vod entity.
+func (_u *UserListItemUpdateOne) SetVod(v *Vod) *UserListItemUpdateOne {
+	return _u.SetVodID(v.ID)
+}
+
+// Mutation returns the UserListItemMutation object of the builder.
+func (_u *UserListItemUpdateOne) Mutation() *UserListItemMutation {
+	return _u.mutation
+}
+
+// ClearList clears the "list" edge to the UserList entity.
+func (_u *UserListItemUpdateOne) ClearList() *UserListItemUpdateOne {
+	_u.mutation.ClearList()
+	return _u
+}
+
+// ClearVod clears the "vod" edge to the Vod entity.
+func (_u *UserListItemUpdateOne) ClearVod() *UserListItemUpdateOne {
+	_u.mutation.ClearVod()
+	return _u
+}
+
+// Where appends a list predicates to the UserListItemUpdate builder.
+func (_u *UserListItemUpdateOne) Where(ps ...predicate.UserListItem) *UserListItemUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *UserListItemUpdateOne) Select(field string, fields ...string) *UserListItemUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated UserListItem entity.
+func (_u *UserListItemUpdateOne) Save(ctx context.Context) (*UserListItem, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *UserListItemUpdateOne) SaveX(ctx context.Context) *UserListItem {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *UserListItemUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *UserListItemUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *UserListItemUpdateOne) check() error {
+	if _u.mutation.ListCleared() && len(_u.mutation.ListIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "UserListItem.list"`)
+	}
+	if _u.mutation.VodCleared() && len(_u.mutation.VodIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "UserListItem.vod"`)
+	}
+	return nil
+}
+
+func (_u *UserListItemUpdateOne) sqlSave(ctx context.Context) (_node *UserListItem, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(userlistitem.Table, userlistitem.Columns, sqlgraph.NewFieldSpec(userlistitem.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "UserListItem.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, userlistitem.FieldID)
+		for _, f := range fields {
+			if !userlistitem.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != userlistitem.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Position(); ok {
+		_spec.SetField(userlistitem.FieldPosition, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedPosition(); ok {
+		_spec.AddField(userlistitem.FieldPosition, field.TypeInt, value)
+	}
+	if _u.mutation.ListCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   userlistitem.ListTable,
+			Columns: []string{userlistitem.ListColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(userlist.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ListIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   userlistitem.ListTable,
+			Columns: []string{userlistitem.ListColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(userlist.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.VodCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   userlistitem.VodTable,
+			Columns: []string{userlistitem.VodColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(vod.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.VodIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   userlistitem.VodTable,
+			Columns: []string{userlistitem.VodColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(vod.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &UserListItem{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{userlistitem.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
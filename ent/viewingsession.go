@@ -0,0 +1,251 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/zibbp/ganymede/ent/user"
+	"github.com/zibbp/ganymede/ent/viewingsession"
+	"github.com/zibbp/ganymede/ent/vod"
+	"github.com/zibbp/ganymede/internal/utils"
+)
+
+// ViewingSession is the model entity for the ViewingSession schema.
+type ViewingSession struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// The ID of the video that was watched.
+	VodID uuid.UUID `json:"vod_id,omitempty"`
+	// The ID of the user that watched the video.
+	UserID uuid.UUID `json:"user_id,omitempty"`
+	// The device the video was watched on, e.g. the user agent of the browser.
+	Device string `json:"device,omitempty"`
+	// The ranges of the video that were played, in the order they were played.
+	Ranges []utils.WatchedRange `json:"ranges,omitempty"`
+	// The seconds of the video that were played, counting parts played multiple times every time.
+	WatchedSeconds float64 `json:"watched_seconds,omitempty"`
+	// The time the session started.
+	StartedAt time.Time `json:"started_at,omitempty"`
+	// The time the last range was played.
+	EndedAt time.Time `json:"ended_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the ViewingSessionQuery when eager-loading is set.
+	Edges        ViewingSessionEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// ViewingSessionEdges holds the relations/edges for other nodes in the graph.
+type ViewingSessionEdges struct {
+	// Vod holds the value of the vod edge.
+	Vod *Vod `json:"vod,omitempty"`
+	// User holds the value of the user edge.
+	User *User `json:"user,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// VodOrErr returns the Vod value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ViewingSessionEdges) VodOrErr() (*Vod, error) {
+	if e.Vod != nil {
+		return e.Vod, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: vod.Label}
+	}
+	return nil, &NotLoadedError{edge: "vod"}
+}
+
+// UserOrErr returns the User value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ViewingSessionEdges) UserOrErr() (*User, error) {
+	if e.User != nil {
+		return e.User, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: user.Label}
+	}
+	return nil, &NotLoadedError{edge: "user"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*ViewingSession) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case viewingsession.FieldRanges:
+			values[i] = new([]byte)
+		case viewingsession.FieldWatchedSeconds:
+			values[i] = new(sql.NullFloat64)
+		case viewingsession.FieldDevice:
+			values[i] = new(sql.NullString)
+		case viewingsession.FieldStartedAt, viewingsession.FieldEndedAt, viewingsession.FieldUpdatedAt, viewingsession.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		case viewingsession.FieldID, viewingsession.FieldVodID, viewingsession.FieldUserID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the ViewingSession fields.
+func (_m *ViewingSession) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case viewingsession.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case viewingsession.FieldVodID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field vod_id", values[i])
+			} else if value != nil {
+				_m.VodID = *value
+			}
+		case viewingsession.FieldUserID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value != nil {
+				_m.UserID = *value
+			}
+		case viewingsession.FieldDevice:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field device", values[i])
+			} else if value.Valid {
+				_m.Device = value.String
+			}
+		case viewingsession.FieldRanges:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field ranges", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.Ranges); err != nil {
+					return fmt.Errorf("unmarshal field ranges: %w", err)
+				}
+			}
+		case viewingsession.FieldWatchedSeconds:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field watched_seconds", values[i])
+			} else if value.Valid {
+				_m.WatchedSeconds = value.Float64
+			}
+		case viewingsession.FieldStartedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field started_at", values[i])
+			} else if value.Valid {
+				_m.StartedAt = value.Time
+			}
+		case viewingsession.FieldEndedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field ended_at", values[i])
+			} else if value.Valid {
+				_m.EndedAt = value.Time
+			}
+		case viewingsession.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		case viewingsession.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the ViewingSession.
+// This includes values selected through modifiers, order, etc.
+func (_m *ViewingSession) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryVod queries the "vod" edge of the ViewingSession entity.
+func (_m *ViewingSession) QueryVod() *VodQuery {
+	return NewViewingSessionClient(_m.config).QueryVod(_m)
+}
+
+// QueryUser queries the "user" edge of the ViewingSession entity.
+func (_m *ViewingSession) QueryUser() *UserQuery {
+	return NewViewingSessionClient(_m.config).QueryUser(_m)
+}
+
+// Update returns a builder for updating this ViewingSession.
+// Note that you need to call ViewingSession.Unwrap() before calling this method if this ViewingSession
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *ViewingSession) Update() *ViewingSessionUpdateOne {
+	return NewViewingSessionClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the ViewingSession entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *ViewingSession) Unwrap() *ViewingSession {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: ViewingSession is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *ViewingSession) String() string {
+	var builder strings.Builder
+	builder.WriteString("ViewingSession(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("vod_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.VodID))
+	builder.WriteString(", ")
+	builder.WriteString("user_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.UserID))
+	builder.WriteString(", ")
+	builder.WriteString("device=")
+	builder.WriteString(_m.Device)
+	builder.WriteString(", ")
+	builder.WriteString("ranges=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Ranges))
+	builder.WriteString(", ")
+	builder.WriteString("watched_seconds=")
+	builder.WriteString(fmt.Sprintf("%v", _m.WatchedSeconds))
+	builder.WriteString(", ")
+	builder.WriteString("started_at=")
+	builder.WriteString(_m.StartedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("ended_at=")
+	builder.WriteString(_m.EndedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// ViewingSessions is a parsable slice of ViewingSession.
+type ViewingSessions []*ViewingSession
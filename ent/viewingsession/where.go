@@ -0,0 +1,463 @@
+// Code generated by ent, DO NOT EDIT.
+
+package viewingsession
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+	"github.com/zibbp/ganymede/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.ViewingSession {
+	return predicate.ViewingSession(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.ViewingSession {
+	return predicate.ViewingSession(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.ViewingSession {
+	return predicate.ViewingSession(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.ViewingSession {
+	return predicate.ViewingSession(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.ViewingSession {
+	return predicate.ViewingSession(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.ViewingSession {
+	return predicate.ViewingSession(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.ViewingSession {
+	return predicate.ViewingSession(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.ViewingSession {
+	return predicate.ViewingSession(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.ViewingSession {
+	return predicate.ViewingSession(sql.FieldLTE(FieldID, id))
+}
+
+// VodID applies equality check predicate on the "vod_id" field. It's identical to VodIDEQ.
+func VodID(v uuid.UUID) predicate.ViewingSession {
+	return predicate.ViewingSession(sql.FieldEQ(FieldVodID, v))
+}
+
+// UserID applies equality check predicate on the "user_id" field. It's identical to UserIDEQ.
+func UserID(v uuid.UUID) predicate.ViewingSession {
+	return predicate.ViewingSession(sql.FieldEQ(FieldUserID, v))
+}
+
+// Device applies equality check predicate on the "device" field. It's identical to DeviceEQ.
+func Device(v string) predicate.ViewingSession {
+	return predicate.ViewingSession(sql.FieldEQ(FieldDevice, v))
+}
+
+// WatchedSeconds applies equality check predicate on the "watched_seconds" field. It's identical to WatchedSecondsEQ.
+func WatchedSeconds(v float64) predicate.ViewingSession {
+	return predicate.ViewingSession(sql.FieldEQ(FieldWatchedSeconds, v))
+}
+
+// StartedAt applies equality check predicate on the "started_at" field. It's identical to StartedAtEQ.
+func StartedAt(v time.Time) predicate.ViewingSession {
+	return predicate.ViewingSession(sql.FieldEQ(FieldStartedAt, v))
+}
+
+// EndedAt applies equality check predicate on the "ended_at" field. It's identical to EndedAtEQ.
+func EndedAt(v time.Time) predicate.ViewingSession {
+	return predicate.ViewingSession(sql.FieldEQ(FieldEndedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.ViewingSession {
+	return predicate.ViewingSession(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.ViewingSession {
+	return predicate.ViewingSession(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// VodIDEQ applies the EQ predicate on the "vod_id" field.
+func VodIDEQ(v uuid.UUID) predicate.ViewingSession {
+	return predicate.ViewingSession(sql.FieldEQ(FieldVodID, v))
+}
+
+// VodIDNEQ applies the NEQ predicate on the "vod_id" field.
+func VodIDNEQ(v uuid.UUID) predicate.ViewingSession {
+	return predicate.ViewingSession(sql.FieldNEQ(FieldVodID, v))
+}
+
+// VodIDIn applies the In predicate on the "vod_id" field.
+func VodIDIn(vs ...uuid.UUID) predicate.ViewingSession {
+	return predicate.ViewingSession(sql.FieldIn(FieldVodID, vs...))
+}
+
+// VodIDNotIn applies the NotIn predicate on the "vod_id" field.
+func VodIDNotIn(vs ...uuid.UUID) predicate.ViewingSession {
+	return predicate.ViewingSession(sql.FieldNotIn(FieldVodID, vs...))
+}
+
+// UserIDEQ applies the EQ predicate on the "user_id" field.
+func UserIDEQ(v uuid.UUID) predicate.ViewingSession {
+	return predicate.ViewingSession(sql.FieldEQ(FieldUserID, v))
+}
+
+// UserIDNEQ applies the NEQ predicate on the "user_id" field.
+func UserIDNEQ(v uuid.UUID) predicate.ViewingSession {
+	return predicate.ViewingSession(sql.FieldNEQ(FieldUserID, v))
+}
+
+// UserIDIn applies the In predicate on the "user_id" field.
+func UserIDIn(vs ...uuid.UUID) predicate.ViewingSession {
+	return predicate.ViewingSession(sql.FieldIn(FieldUserID, vs...))
+}
+
+// UserIDNotIn applies the NotIn predicate on the "user_id" field.
+func UserIDNotIn(vs ...uuid.UUID) predicate.ViewingSession {
+	return predicate.ViewingSession(sql.FieldNotIn(FieldUserID, vs...))
+}
+
+// DeviceEQ applies the EQ predicate on the "device" field.
+func DeviceEQ(v string) predicate.ViewingSession {
+	return predicate.ViewingSession(sql.FieldEQ(FieldDevice, v))
+}
+
+// DeviceNEQ applies the NEQ predicate on the "device" field.
+func DeviceNEQ(v string) predicate.ViewingSession {
+	return predicate.ViewingSession(sql.FieldNEQ(FieldDevice, v))
+}
+
+// DeviceIn applies the In predicate on the "device" field.
+func DeviceIn(vs ...string) predicate.ViewingSession {
+	return predicate.ViewingSession(sql.FieldIn(FieldDevice, vs...))
+}
+
+// DeviceNotIn applies the NotIn predicate on the "device" field.
+func DeviceNotIn(vs ...string) predicate.ViewingSession {
+	return predicate.ViewingSession(sql.FieldNotIn(FieldDevice, vs...))
+}
+
+// DeviceGT applies the GT predicate on the "device" field.
+func DeviceGT(v string) predicate.ViewingSession {
+	return predicate.ViewingSession(sql.FieldGT(FieldDevice, v))
+}
+
+// DeviceGTE applies the GTE predicate on the "device" field.
+func DeviceGTE(v string) predicate.ViewingSession {
+	return predicate.ViewingSession(sql.FieldGTE(FieldDevice, v))
+}
+
+// DeviceLT applies the LT predicate on the "device" field.
+func DeviceLT(v string) predicate.ViewingSession {
+	return predicate.ViewingSession(sql.FieldLT(FieldDevice, v))
+}
+
+// DeviceLTE applies the LTE predicate on the "device" field.
+func DeviceLTE(v string) predicate.ViewingSession {
+	return predicate.ViewingSession(sql.FieldLTE(FieldDevice, v))
+}
+
+// DeviceContains applies the Contains predicate on the "device" field.
+func DeviceContains(v string) predicate.ViewingSession {
+	return predicate.ViewingSession(sql.FieldContains(FieldDevice, v))
+}
+
+// DeviceHasPrefix applies the HasPrefix predicate on the "device" field.
+func DeviceHasPrefix(v string) predicate.ViewingSession {
+	return predicate.ViewingSession(sql.FieldHasPrefix(FieldDevice, v))
+}
+
+// DeviceHasSuffix applies the HasSuffix predicate on the "device" field.
+func DeviceHasSuffix(v string) predicate.ViewingSession {
+	return predicate.ViewingSession(sql.FieldHasSuffix(FieldDevice, v))
+}
+
+// DeviceEqualFold applies the EqualFold predicate on the "device" field.
+func DeviceEqualFold(v string) predicate.ViewingSession {
+	return predicate.ViewingSession(sql.FieldEqualFold(FieldDevice, v))
+}
+
+// DeviceContainsFold applies the ContainsFold predicate on the "device" field.
+func DeviceContainsFold(v string) predicate.ViewingSession {
+	return predicate.ViewingSession(sql.FieldContainsFold(FieldDevice, v))
+}
+
+// WatchedSecondsEQ applies the EQ predicate on the "watched_seconds" field.
+func WatchedSecondsEQ(v float64) predicate.ViewingSession {
+	return predicate.ViewingSession(sql.FieldEQ(FieldWatchedSeconds, v))
+}
+
+// WatchedSecondsNEQ applies the NEQ predicate on the "watched_seconds" field.
+func WatchedSecondsNEQ(v float64) predicate.ViewingSession {
+	return predicate.ViewingSession(sql.FieldNEQ(FieldWatchedSeconds, v))
+}
+
+// WatchedSecondsIn applies the In predicate on the "watched_seconds" field.
+func WatchedSecondsIn(vs ...float64) predicate.ViewingSession {
+	return predicate.ViewingSession(sql.FieldIn(FieldWatchedSeconds, vs...))
+}
+
+// WatchedSecondsNotIn applies the NotIn predicate on the "watched_seconds" field.
+func WatchedSecondsNotIn(vs ...float64) predicate.ViewingSession {
+	return predicate.ViewingSession(sql.FieldNotIn(FieldWatchedSeconds, vs...))
+}
+
+// WatchedSecondsGT applies the GT predicate on the "watched_seconds" field.
+func WatchedSecondsGT(v float64) predicate.ViewingSession {
+	return predicate.ViewingSession(sql.FieldGT(FieldWatchedSeconds, v))
+}
+
+// WatchedSecondsGTE applies the GTE predicate on the "watched_seconds" field.
+func WatchedSecondsGTE(v float64) predicate.ViewingSession {
+	return predicate.ViewingSession(sql.FieldGTE(FieldWatchedSeconds, v))
+}
+
+// WatchedSecondsLT applies the LT predicate on the "watched_seconds" field.
+func WatchedSecondsLT(v float64) predicate.ViewingSession {
+	return predicate.ViewingSession(sql.FieldLT(FieldWatchedSeconds, v))
+}
+
+// WatchedSecondsLTE applies the LTE predicate on the "watched_seconds" field.
+func WatchedSecondsLTE(v float64) predicate.ViewingSession {
+	return predicate.ViewingSession(sql.FieldLTE(FieldWatchedSeconds, v))
+}
+
+// StartedAtEQ applies the EQ predicate on the "started_at" field.
+func StartedAtEQ(v time.Time) predicate.ViewingSession {
+	return predicate.ViewingSession(sql.FieldEQ(FieldStartedAt, v))
+}
+
+// StartedAtNEQ applies the NEQ predicate on the "started_at" field.
+func StartedAtNEQ(v time.Time) predicate.ViewingSession {
+	return predicate.ViewingSession(sql.FieldNEQ(FieldStartedAt, v))
+}
+
+// StartedAtIn applies the In predicate on the "started_at" field.
+func StartedAtIn(vs ...time.Time) predicate.ViewingSession {
+	return predicate.ViewingSession(sql.FieldIn(FieldStartedAt, vs...))
+}
+
+// StartedAtNotIn applies the NotIn predicate on the "started_at" field.
+func StartedAtNotIn(vs ...time.Time) predicate.ViewingSession {
+	return predicate.ViewingSession(sql.FieldNotIn(FieldStartedAt, vs...))
+}
+
+// StartedAtGT applies the GT predicate on the "started_at" field.
+func StartedAtGT(v time.Time) predicate.ViewingSession {
+	return predicate.ViewingSession(sql.FieldGT(FieldStartedAt, v))
+}
+
+// StartedAtGTE applies the GTE predicate on the "started_at" field.
+func StartedAtGTE(v time.Time) predicate.ViewingSession {
+	return predicate.ViewingSession(sql.FieldGTE(FieldStartedAt, v))
+}
+
+// StartedAtLT applies the LT predicate on the "started_at" field.
+func StartedAtLT(v time.Time) predicate.ViewingSession {
+	return predicate.ViewingSession(sql.FieldLT(FieldStartedAt, v))
+}
+
+// StartedAtLTE applies the LTE predicate on the "started_at" field.
+func StartedAtLTE(v time.Time) predicate.ViewingSession {
+	return predicate.ViewingSession(sql.FieldLTE(FieldStartedAt, v))
+}
+
+// EndedAtEQ applies the EQ predicate on the "ended_at" field.
+func EndedAtEQ(v time.Time) predicate.ViewingSession {
+	return predicate.ViewingSession(sql.FieldEQ(FieldEndedAt, v))
+}
+
+// EndedAtNEQ applies the NEQ predicate on the "ended_at" field.
+func EndedAtNEQ(v time.Time) predicate.ViewingSession {
+	return predicate.ViewingSession(sql.FieldNEQ(FieldEndedAt, v))
+}
+
+// EndedAtIn applies the In predicate on the "ended_at" field.
+func EndedAtIn(vs ...time.Time) predicate.ViewingSession {
+	return predicate.ViewingSession(sql.FieldIn(FieldEndedAt, vs...))
+}
+
+// EndedAtNotIn applies the NotIn predicate on the "ended_at" field.
+func EndedAtNotIn(vs ...time.Time) predicate.ViewingSession {
+	return predicate.ViewingSession(sql.FieldNotIn(FieldEndedAt, vs...))
+}
+
+// EndedAtGT applies the GT predicate on the "ended_at" field.
+func EndedAtGT(v time.Time) predicate.ViewingSession {
+	return predicate.ViewingSession(sql.FieldGT(FieldEndedAt, v))
+}
+
+// EndedAtGTE applies the GTE predicate on the "ended_at" field.
+func EndedAtGTE(v time.Time) predicate.ViewingSession {
+	return predicate.ViewingSession(sql.FieldGTE(FieldEndedAt, v))
+}
+
+// EndedAtLT applies the LT predicate on the "ended_at" field.
+func EndedAtLT(v time.Time) predicate.ViewingSession {
+	return predicate.ViewingSession(sql.FieldLT(FieldEndedAt, v))
+}
+
+// EndedAtLTE applies the LTE predicate on the "ended_at" field.
+func EndedAtLTE(v time.Time) predicate.ViewingSession {
+	return predicate.ViewingSession(sql.FieldLTE(FieldEndedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.ViewingSession {
+	return predicate.ViewingSession(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.ViewingSession {
+	return predicate.ViewingSession(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.ViewingSession {
+	return predicate.ViewingSession(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.ViewingSession {
+	return predicate.ViewingSession(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.ViewingSession {
+	return predicate.ViewingSession(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.ViewingSession {
+	return predicate.ViewingSession(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.ViewingSession {
+	return predicate.ViewingSession(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.ViewingSession {
+	return predicate.ViewingSession(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.ViewingSession {
+	return predicate.ViewingSession(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.ViewingSession {
+	return predicate.ViewingSession(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.ViewingSession {
+	return predicate.ViewingSession(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.ViewingSession {
+	return predicate.ViewingSession(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.ViewingSession {
+	return predicate.ViewingSession(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.ViewingSession {
+	return predicate.ViewingSession(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.ViewingSession {
+	return predicate.ViewingSession(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.ViewingSession {
+	return predicate.ViewingSession(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// HasVod applies the HasEdge predicate on the "vod" edge.
+func HasVod() predicate.ViewingSession {
+	return predicate.ViewingSession(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, VodTable, VodColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasVodWith applies the HasEdge predicate on the "vod" edge with a given conditions (other predicates).
+func HasVodWith(preds ...predicate.Vod) predicate.ViewingSession {
+	return predicate.ViewingSession(func(s *sql.Selector) {
+		step := newVodStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasUser applies the HasEdge predicate on the "user" edge.
+func HasUser() predicate.ViewingSession {
+	return predicate.ViewingSession(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, UserTable, UserColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasUserWith applies the HasEdge predicate on the "user" edge with a given conditions (other predicates).
+func HasUserWith(preds ...predicate.User) predicate.ViewingSession {
+	return predicate.ViewingSession(func(s *sql.Selector) {
+		step := newUserStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.ViewingSession) predicate.ViewingSession {
+	return predicate.ViewingSession(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.ViewingSession) predicate.ViewingSession {
+	return predicate.ViewingSession(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.ViewingSession) predicate.ViewingSession {
+	return predicate.ViewingSession(sql.NotPredicates(p))
+}
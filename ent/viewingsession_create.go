@@ -0,0 +1,1006 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/zibbp/ganymede/ent/user"
+	"github.com/zibbp/ganymede/ent/viewingsession"
+	"github.com/zibbp/ganymede/ent/vod"
+	"github.com/zibbp/ganymede/internal/utils"
+)
+
+// ViewingSessionCreate is the builder for creating a ViewingSession entity.
+type ViewingSessionCreate struct {
+	config
+	mutation *ViewingSessionMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetVodID sets the "vod_id" field.
+func (_c *ViewingSessionCreate) SetVodID(v uuid.UUID) *ViewingSessionCreate {
+	_c.mutation.SetVodID(v)
+	return _c
+}
+
+// SetUserID sets the "user_id" field.
+func (_c *ViewingSessionCreate) SetUserID(v uuid.UUID) *ViewingSessionCreate {
+	_c.mutation.SetUserID(v)
+	return _c
+}
+
+// SetDevice sets the "device" field.
+func (_c *ViewingSessionCreate) SetDevice(v string) *ViewingSessionCreate {
+	_c.mutation.SetDevice(v)
+	return _c
+}
+
+// SetNillableDevice sets the "device" field if the given value is not nil.
+func (_c *ViewingSessionCreate) SetNillableDevice(v *string) *ViewingSessionCreate {
+	if v != nil {
+		_c.SetDevice(*v)
+	}
+	return _c
+}
+
+// SetRanges sets the "ranges" field.
+func (_c *ViewingSessionCreate) SetRanges(v []utils.WatchedRange) *ViewingSessionCreate {
+	_c.mutation.SetRanges(v)
+	return _c
+}
+
+// SetWatchedSeconds sets the "watched_seconds" field.
+func (_c *ViewingSessionCreate) SetWatchedSeconds(v float64) *ViewingSessionCreate {
+	_c.mutation.SetWatchedSeconds(v)
+	return _c
+}
+
+// SetNillableWatchedSeconds sets the "watched_seconds" field if the given value is not nil.
+func (_c *ViewingSessionCreate) SetNillableWatchedSeconds(v *float64) *ViewingSessionCreate {
+	if v != nil {
+		_c.SetWatchedSeconds(*v)
+	}
+	return _c
+}
+
+// SetStartedAt sets the "started_at" field.
+func (_c *ViewingSessionCreate) SetStartedAt(v time.Time) *ViewingSessionCreate {
+	_c.mutation.SetStartedAt(v)
+	return _c
+}
+
+// SetNillableStartedAt sets the "started_at" field if the given value is not nil.
+func (_c *ViewingSessionCreate) SetNillableStartedAt(v *time.Time) *ViewingSessionCreate {
+	if v != nil {
+		_c.SetStartedAt(*v)
+	}
+	return _c
+}
+
+// SetEndedAt sets the "ended_at" field.
+func (_c *ViewingSessionCreate) SetEndedAt(v time.Time) *ViewingSessionCreate {
+	_c.mutation.SetEndedAt(v)
+	return _c
+}
+
+// SetNillableEndedAt sets the "ended_at" field if the given value is not nil.
+func (_c *ViewingSessionCreate) SetNillableEndedAt(v *time.Time) *ViewingSessionCreate {
+	if v != nil {
+		_c.SetEndedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *ViewingSessionCreate) SetUpdatedAt(v time.Time) *ViewingSessionCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *ViewingSessionCreate) SetNillableUpdatedAt(v *time.Time) *ViewingSessionCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *ViewingSessionCreate) SetCreatedAt(v time.Time) *ViewingSessionCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *ViewingSessionCreate) SetNillableCreatedAt(v *time.Time) *ViewingSessionCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *ViewingSessionCreate) SetID(v uuid.UUID) *ViewingSessionCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *ViewingSessionCreate) SetNillableID(v *uuid.UUID) *ViewingSessionCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// SetVod sets the "vod" edge to the Vod entity.
+func (_c *ViewingSessionCreate) SetVod(v *Vod) *ViewingSessionCreate {
+	return _c.SetVodID(v.ID)
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (_c *ViewingSessionCreate) SetUser(v *User) *ViewingSessionCreate {
+	return _c.SetUserID(v.ID)
+}
+
+// Mutation returns the ViewingSessionMutation object of the builder.
+func (_c *ViewingSessionCreate) Mutation() *ViewingSessionMutation {
+	return _c.mutation
+}
+
+// Save creates the ViewingSession in the database.
+func (_c *ViewingSessionCreate) Save(ctx context.Context) (*ViewingSession, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *ViewingSessionCreate) SaveX(ctx context.Context) *ViewingSession {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ViewingSessionCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ViewingSessionCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *ViewingSessionCreate) defaults() {
+	if _, ok := _c.mutation.Device(); !ok {
+		v := viewingsession.DefaultDevice
+		_c.mutation.SetDevice(v)
+	}
+	if _, ok := _c.mutation.Ranges(); !ok {
+		v := viewingsession.DefaultRanges
+		_c.mutation.SetRanges(v)
+	}
+	if _, ok := _c.mutation.WatchedSeconds(); !ok {
+		v := viewingsession.DefaultWatchedSeconds
+		_c.mutation.SetWatchedSeconds(v)
+	}
+	if _, ok := _c.mutation.StartedAt(); !ok {
+		v := viewingsession.DefaultStartedAt()
+		_c.mutation.SetStartedAt(v)
+	}
+	if _, ok := _c.mutation.EndedAt(); !ok {
+		v := viewingsession.DefaultEndedAt()
+		_c.mutation.SetEndedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := viewingsession.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := viewingsession.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := viewingsession.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *ViewingSessionCreate) check() error {
+	if _, ok := _c.mutation.VodID(); !ok {
+		return &ValidationError{Name: "vod_id", err: errors.New(`ent: missing required field "ViewingSession.vod_id"`)}
+	}
+	if _, ok := _c.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "ViewingSession.user_id"`)}
+	}
+	if _, ok := _c.mutation.Device(); !ok {
+		return &ValidationError{Name: "device", err: errors.New(`ent: missing required field "ViewingSession.device"`)}
+	}
+	if _, ok := _c.mutation.Ranges(); !ok {
+		return &ValidationError{Name: "ranges", err: errors.New(`ent: missing required field "ViewingSession.ranges"`)}
+	}
+	if _, ok := _c.mutation.WatchedSeconds(); !ok {
+		return &ValidationError{Name: "watched_seconds", err: errors.New(`ent: missing required field "ViewingSession.watched_seconds"`)}
+	}
+	if _, ok := _c.mutation.StartedAt(); !ok {
+		return &ValidationError{Name: "started_at", err: errors.New(`ent: missing required field "ViewingSession.started_at"`)}
+	}
+	if _, ok := _c.mutation.EndedAt(); !ok {
+		return &ValidationError{Name: "ended_at", err: errors.New(`ent: missing required field "ViewingSession.ended_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "ViewingSession.updated_at"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "ViewingSession.created_at"`)}
+	}
+	if len(_c.mutation.VodIDs()) == 0 {
+		return &ValidationError{Name: "vod", err: errors.New(`ent: missing required edge "ViewingSession.vod"`)}
+	}
+	if len(_c.mutation.UserIDs()) == 0 {
+		return &ValidationError{Name: "user", err: errors.New(`ent: missing required edge "ViewingSession.user"`)}
+	}
+	return nil
+}
+
+func (_c *ViewingSessionCreate) sqlSave(ctx context.Context) (*ViewingSession, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *ViewingSessionCreate) createSpec() (*ViewingSession, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ViewingSession{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(viewingsession.Table, sqlgraph.NewFieldSpec(viewingsession.FieldID, field.TypeUUID))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.Device(); ok {
+		_spec.SetField(viewingsession.FieldDevice, field.TypeString, value)
+		_node.Device = value
+	}
+	if value, ok := _c.mutation.Ranges(); ok {
+		_spec.SetField(viewingsession.FieldRanges, field.TypeJSON, value)
+		_node.Ranges = value
+	}
+	if value, ok := _c.mutation.WatchedSeconds(); ok {
+		_spec.SetField(viewingsession.FieldWatchedSeconds, field.TypeFloat64, value)
+		_node.WatchedSeconds = value
+	}
+	if value, ok := _c.mutation.StartedAt(); ok {
+		_spec.SetField(viewingsession.FieldStartedAt, field.TypeTime, value)
+		_node.StartedAt = value
+	}
+	if value, ok := _c.mutation.EndedAt(); ok {
+		_spec.SetField(viewingsession.FieldEndedAt, field.TypeTime, value)
+		_node.EndedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(viewingsession.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(viewingsession.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := _c.mutation.VodIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   viewingsession.VodTable,
+			Columns: []string{viewingsession.VodColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(vod.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.VodID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   viewingsession.UserTable,
+			Columns: []string{viewingsession.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.UserID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.ViewingSession.Create().
+//		SetVodID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ViewingSessionUpsert) {
+//			SetVodID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *ViewingSessionCreate) OnConflict(opts ...sql.ConflictOption) *ViewingSessionUpsertOne {
+	_c.conflict = opts
+	return &ViewingSessionUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.ViewingSession.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *ViewingSessionCreate) OnConflictColumns(columns ...string) *ViewingSessionUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &ViewingSessionUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// ViewingSessionUpsertOne is the builder for "upsert"-ing
+	//  one ViewingSession node.
+	ViewingSessionUpsertOne struct {
+		create *ViewingSessionCreate
+	}
+
+	// ViewingSessionUpsert is the "OnConflict" setter.
+	ViewingSessionUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetVodID sets the "vod_id" field.
+func (u *ViewingSessionUpsert) SetVodID(v uuid.UUID) *ViewingSessionUpsert {
+	u.Set(viewingsession.FieldVodID, v)
+	return u
+}
+
+// UpdateVodID sets the "vod_id" field to the value that was provided on create.
+func (u *ViewingSessionUpsert) UpdateVodID() *ViewingSessionUpsert {
+	u.SetExcluded(viewingsession.FieldVodID)
+	return u
+}
+
+// SetUserID sets the "user_id" field.
+func (u *ViewingSessionUpsert) SetUserID(v uuid.UUID) *ViewingSessionUpsert {
+	u.Set(viewingsession.FieldUserID, v)
+	return u
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *ViewingSessionUpsert) UpdateUserID() *ViewingSessionUpsert {
+	u.SetExcluded(viewingsession.FieldUserID)
+	return u
+}
+
+// SetDevice sets the "device" field.
+func (u *ViewingSessionUpsert) SetDevice(v string) *ViewingSessionUpsert {
+	u.Set(viewingsession.FieldDevice, v)
+	return u
+}
+
+// UpdateDevice sets the "device" field to the value that was provided on create.
+func (u *ViewingSessionUpsert) UpdateDevice() *ViewingSessionUpsert {
+	u.SetExcluded(viewingsession.FieldDevice)
+	return u
+}
+
+// SetRanges sets the "ranges" field.
+func (u *ViewingSessionUpsert) SetRanges(v []utils.WatchedRange) *ViewingSessionUpsert {
+	u.Set(viewingsession.FieldRanges, v)
+	return u
+}
+
+// UpdateRanges sets the "ranges" field to the value that was provided on create.
+func (u *ViewingSessionUpsert) UpdateRanges() *ViewingSessionUpsert {
+	u.SetExcluded(viewingsession.FieldRanges)
+	return u
+}
+
+// SetWatchedSeconds sets the "watched_seconds" field.
+func (u *ViewingSessionUpsert) SetWatchedSeconds(v float64) *ViewingSessionUpsert {
+	u.Set(viewingsession.FieldWatchedSeconds, v)
+	return u
+}
+
+// UpdateWatchedSeconds sets the "watched_seconds" field to the value that was provided on create.
+func (u *ViewingSessionUpsert) UpdateWatchedSeconds() *ViewingSessionUpsert {
+	u.SetExcluded(viewingsession.FieldWatchedSeconds)
+	return u
+}
+
+// AddWatchedSeconds adds v to the "watched_seconds" field.
+func (u *ViewingSessionUpsert) AddWatchedSeconds(v float64) *ViewingSessionUpsert {
+	u.Add(viewingsession.FieldWatchedSeconds, v)
+	return u
+}
+
+// SetEndedAt sets the "ended_at" field.
+func (u *ViewingSessionUpsert) SetEndedAt(v time.Time) *ViewingSessionUpsert {
+	u.Set(viewingsession.FieldEndedAt, v)
+	return u
+}
+
+// UpdateEndedAt sets the "ended_at" field to the value that was provided on create.
+func (u *ViewingSessionUpsert) UpdateEndedAt() *ViewingSessionUpsert {
+	u.SetExcluded(viewingsession.FieldEndedAt)
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *ViewingSessionUpsert) SetUpdatedAt(v time.Time) *ViewingSessionUpsert {
+	u.Set(viewingsession.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *ViewingSessionUpsert) UpdateUpdatedAt() *ViewingSessionUpsert {
+	u.SetExcluded(viewingsession.FieldUpdatedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.ViewingSession.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(viewingsession.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *ViewingSessionUpsertOne) UpdateNewValues() *ViewingSessionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(viewingsession.FieldID)
+		}
+		if _, exists := u.create.mutation.StartedAt(); exists {
+			s.SetIgnore(viewingsession.FieldStartedAt)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(viewingsession.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.ViewingSession.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *ViewingSessionUpsertOne) Ignore() *ViewingSessionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ViewingSessionUpsertOne) DoNothing() *ViewingSessionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ViewingSessionCreate.OnConflict
+// documentation for more info.
+func (u *ViewingSessionUpsertOne) Update(set func(*ViewingSessionUpsert)) *ViewingSessionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ViewingSessionUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetVodID sets the "vod_id" field.
+func (u *ViewingSessionUpsertOne) SetVodID(v uuid.UUID) *ViewingSessionUpsertOne {
+	return u.Update(func(s *ViewingSessionUpsert) {
+		s.SetVodID(v)
+	})
+}
+
+// UpdateVodID sets the "vod_id" field to the value that was provided on create.
+func (u *ViewingSessionUpsertOne) UpdateVodID() *ViewingSessionUpsertOne {
+	return u.Update(func(s *ViewingSessionUpsert) {
+		s.UpdateVodID()
+	})
+}
+
+// SetUserID sets the "user_id" field.
+func (u *ViewingSessionUpsertOne) SetUserID(v uuid.UUID) *ViewingSessionUpsertOne {
+	return u.Update(func(s *ViewingSessionUpsert) {
+		s.SetUserID(v)
+	})
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *ViewingSessionUpsertOne) UpdateUserID() *ViewingSessionUpsertOne {
+	return u.Update(func(s *ViewingSessionUpsert) {
+		s.UpdateUserID()
+	})
+}
+
+// SetDevice sets the "device" field.
+func (u *ViewingSessionUpsertOne) SetDevice(v string) *ViewingSessionUpsertOne {
+	return u.Update(func(s *ViewingSessionUpsert) {
+		s.SetDevice(v)
+	})
+}
+
+// UpdateDevice sets the "device" field to the value that was provided on create.
+func (u *ViewingSessionUpsertOne) UpdateDevice() *ViewingSessionUpsertOne {
+	return u.Update(func(s *ViewingSessionUpsert) {
+		s.UpdateDevice()
+	})
+}
+
+// SetRanges sets the "ranges" field.
+func (u *ViewingSessionUpsertOne) SetRanges(v []utils.WatchedRange) *ViewingSessionUpsertOne {
+	return u.Update(func(s *ViewingSessionUpsert) {
+		s.SetRanges(v)
+	})
+}
+
+// UpdateRanges sets the "ranges" field to the value that was provided on create.
+func (u *ViewingSessionUpsertOne) UpdateRanges() *ViewingSessionUpsertOne {
+	return u.Update(func(s *ViewingSessionUpsert) {
+		s.UpdateRanges()
+	})
+}
+
+// SetWatchedSeconds sets the "watched_seconds" field.
+func (u *ViewingSessionUpsertOne) SetWatchedSeconds(v float64) *ViewingSessionUpsertOne {
+	return u.Update(func(s *ViewingSessionUpsert) {
+		s.SetWatchedSeconds(v)
+	})
+}
+
+// AddWatchedSeconds adds v to the "watched_seconds" field.
+func (u *ViewingSessionUpsertOne) AddWatchedSeconds(v float64) *ViewingSessionUpsertOne {
+	return u.Update(func(s *ViewingSessionUpsert) {
+		s.AddWatchedSeconds(v)
+	})
+}
+
+// UpdateWatchedSeconds sets the "watched_seconds" field to the value that was provided on create.
+func (u *ViewingSessionUpsertOne) UpdateWatchedSeconds() *ViewingSessionUpsertOne {
+	return u.Update(func(s *ViewingSessionUpsert) {
+		s.UpdateWatchedSeconds()
+	})
+}
+
+// SetEndedAt sets the "ended_at" field.
+func (u *ViewingSessionUpsertOne) SetEndedAt(v time.Time) *ViewingSessionUpsertOne {
+	return u.Update(func(s *ViewingSessionUpsert) {
+		s.SetEndedAt(v)
+	})
+}
+
+// UpdateEndedAt sets the "ended_at" field to the value that was provided on create.
+func (u *ViewingSessionUpsertOne) UpdateEndedAt() *ViewingSessionUpsertOne {
+	return u.Update(func(s *ViewingSessionUpsert) {
+		s.UpdateEndedAt()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *ViewingSessionUpsertOne) SetUpdatedAt(v time.Time) *ViewingSessionUpsertOne {
+	return u.Update(func(s *ViewingSessionUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *ViewingSessionUpsertOne) UpdateUpdatedAt() *ViewingSessionUpsertOne {
+	return u.Update(func(s *ViewingSessionUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *ViewingSessionUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ViewingSessionCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ViewingSessionUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *ViewingSessionUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: ViewingSessionUpsertOne.ID is not supported by MySQL driver. Use ViewingSessionUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *ViewingSessionUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// ViewingSessionCreateBulk is the builder for creating many ViewingSession entities in bulk.
+type ViewingSessionCreateBulk struct {
+	config
+	err      error
+	builders []*ViewingSessionCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the ViewingSession entities in the database.
+func (_c *ViewingSessionCreateBulk) Save(ctx context.Context) ([]*ViewingSession, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*ViewingSession, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ViewingSessionMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *ViewingSessionCreateBulk) SaveX(ctx context.Context) []*ViewingSession {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ViewingSessionCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ViewingSessionCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.ViewingSession.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ViewingSessionUpsert) {
+//			SetVodID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *ViewingSessionCreateBulk) OnConflict(opts ...sql.ConflictOption) *ViewingSessionUpsertBulk {
+	_c.conflict = opts
+	return &ViewingSessionUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.ViewingSession.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *ViewingSessionCreateBulk) OnConflictColumns(columns ...string) *ViewingSessionUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &ViewingSessionUpsertBulk{
+		create: _c,
+	}
+}
+
+// ViewingSessionUpsertBulk is the builder for "upsert"-ing
+// a bulk of ViewingSession nodes.
+type ViewingSessionUpsertBulk struct {
+	create *ViewingSessionCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.ViewingSession.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(viewingsession.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *ViewingSessionUpsertBulk) UpdateNewValues() *ViewingSessionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(viewingsession.FieldID)
+			}
+			if _, exists := b.mutation.StartedAt(); exists {
+				s.SetIgnore(viewingsession.FieldStartedAt)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(viewingsession.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.ViewingSession.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *ViewingSessionUpsertBulk) Ignore() *ViewingSessionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ViewingSessionUpsertBulk) DoNothing() *ViewingSessionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ViewingSessionCreateBulk.OnConflict
+// documentation for more info.
+func (u *ViewingSessionUpsertBulk) Update(set func(*ViewingSessionUpsert)) *ViewingSessionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ViewingSessionUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetVodID sets the "vod_id" field.
+func (u *ViewingSessionUpsertBulk) SetVodID(v uuid.UUID) *ViewingSessionUpsertBulk {
+	return u.Update(func(s *ViewingSessionUpsert) {
+		s.SetVodID(v)
+	})
+}
+
+// UpdateVodID sets the "vod_id" field to the value that was provided on create.
+func (u *ViewingSessionUpsertBulk) UpdateVodID() *ViewingSessionUpsertBulk {
+	return u.Update(func(s *ViewingSessionUpsert) {
+		s.UpdateVodID()
+	})
+}
+
+// SetUserID sets the "user_id" field.
+func (u *ViewingSessionUpsertBulk) SetUserID(v uuid.UUID) *ViewingSessionUpsertBulk {
+	return u.Update(func(s *ViewingSessionUpsert) {
+		s.SetUserID(v)
+	})
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *ViewingSessionUpsertBulk) UpdateUserID() *ViewingSessionUpsertBulk {
+	return u.Update(func(s *ViewingSessionUpsert) {
+		s.UpdateUserID()
+	})
+}
+
+// SetDevice sets the "device" field.
+func (u *ViewingSessionUpsertBulk) SetDevice(v string) *ViewingSessionUpsertBulk {
+	return u.Update(func(s *ViewingSessionUpsert) {
+		s.SetDevice(v)
+	})
+}
+
+// UpdateDevice sets the "device" field to the value that was provided on create.
+func (u *ViewingSessionUpsertBulk) UpdateDevice() *ViewingSessionUpsertBulk {
+	return u.Update(func(s *ViewingSessionUpsert) {
+		s.UpdateDevice()
+	})
+}
+
+// SetRanges sets the "ranges" field.
+func (u *ViewingSessionUpsertBulk) SetRanges(v []utils.WatchedRange) *ViewingSessionUpsertBulk {
+	return u.Update(func(s *ViewingSessionUpsert) {
+		s.SetRanges(v)
+	})
+}
+
+// UpdateRanges sets the "ranges" field to the value that was provided on create.
+func (u *ViewingSessionUpsertBulk) UpdateRanges() *ViewingSessionUpsertBulk {
+	return u.Update(func(s *ViewingSessionUpsert) {
+		s.UpdateRanges()
+	})
+}
+
+// SetWatchedSeconds sets the "watched_seconds" field.
+func (u *ViewingSessionUpsertBulk) SetWatchedSeconds(v float64) *ViewingSessionUpsertBulk {
+	return u.Update(func(s *ViewingSessionUpsert) {
+		s.SetWatchedSeconds(v)
+	})
+}
+
+// AddWatchedSeconds adds v to the "watched_seconds" field.
+func (u *ViewingSessionUpsertBulk) AddWatchedSeconds(v float64) *ViewingSessionUpsertBulk {
+	return u.Update(func(s *ViewingSessionUpsert) {
+		s.AddWatchedSeconds(v)
+	})
+}
+
+// UpdateWatchedSeconds sets the "watched_seconds" field to the value that was provided on create.
+func (u *ViewingSessionUpsertBulk) UpdateWatchedSeconds() *ViewingSessionUpsertBulk {
+	return u.Update(func(s *ViewingSessionUpsert) {
+		s.UpdateWatchedSeconds()
+	})
+}
+
+// SetEndedAt sets the "ended_at" field.
+func (u *ViewingSessionUpsertBulk) SetEndedAt(v time.Time) *ViewingSessionUpsertBulk {
+	return u.Update(func(s *ViewingSessionUpsert) {
+		s.SetEndedAt(v)
+	})
+}
+
+// UpdateEndedAt sets the "ended_at" field to the value that was provided on create.
+func (u *ViewingSessionUpsertBulk) UpdateEndedAt() *ViewingSessionUpsertBulk {
+	return u.Update(func(s *ViewingSessionUpsert) {
+		s.UpdateEndedAt()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *ViewingSessionUpsertBulk) SetUpdatedAt(v time.Time) *ViewingSessionUpsertBulk {
+	return u.Update(func(s *ViewingSessionUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *ViewingSessionUpsertBulk) UpdateUpdatedAt() *ViewingSessionUpsertBulk {
+	return u.Update(func(s *ViewingSessionUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *ViewingSessionUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the ViewingSessionCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ViewingSessionCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ViewingSessionUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,623 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/zibbp/ganymede/ent/predicate"
+	"github.com/zibbp/ganymede/ent/user"
+	"github.com/zibbp/ganymede/ent/viewingsession"
+	"github.com/zibbp/ganymede/ent/vod"
+	"github.com/zibbp/ganymede/internal/utils"
+)
+
+// ViewingSessionUpdate is the builder for updating ViewingSession entities.
+type ViewingSessionUpdate struct {
+	config
+	hooks    []Hook
+	mutation *ViewingSessionMutation
+}
+
+// Where appends a list predicates to the ViewingSessionUpdate builder.
+func (_u *ViewingSessionUpdate) Where(ps ...predicate.ViewingSession) *ViewingSessionUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetVodID sets the "vod_id" field.
+func (_u *ViewingSessionUpdate) SetVodID(v uuid.UUID) *ViewingSessionUpdate {
+	_u.mutation.SetVodID(v)
+	return _u
+}
+
+// SetNillableVodID sets the "vod_id" field if the given value is not nil.
+func (_u *ViewingSessionUpdate) SetNillableVodID(v *uuid.UUID) *ViewingSessionUpdate {
+	if v != nil {
+		_u.SetVodID(*v)
+	}
+	return _u
+}
+
+// SetUserID sets the "user_id" field.
+func (_u *ViewingSessionUpdate) SetUserID(v uuid.UUID) *ViewingSessionUpdate {
+	_u.mutation.SetUserID(v)
+	return _u
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_u *ViewingSessionUpdate) SetNillableUserID(v *uuid.UUID) *ViewingSessionUpdate {
+	if v != nil {
+		_u.SetUserID(*v)
+	}
+	return _u
+}
+
+// SetDevice sets the "device" field.
+func (_u *ViewingSessionUpdate) SetDevice(v string) *ViewingSessionUpdate {
+	_u.mutation.SetDevice(v)
+	return _u
+}
+
+// SetNillableDevice sets the "device" field if the given value is not nil.
+func (_u *ViewingSessionUpdate) SetNillableDevice(v *string) *ViewingSessionUpdate {
+	if v != nil {
+		_u.SetDevice(*v)
+	}
+	return _u
+}
+
+// SetRanges sets the "ranges" field.
+func (_u *ViewingSessionUpdate) SetRanges(v []utils.WatchedRange) *ViewingSessionUpdate {
+	_u.mutation.SetRanges(v)
+	return _u
+}
+
+// AppendRanges appends value to the "ranges" field.
+func (_u *ViewingSessionUpdate) AppendRanges(v []utils.WatchedRange) *ViewingSessionUpdate {
+	_u.mutation.AppendRanges(v)
+	return _u
+}
+
+// SetWatchedSeconds sets the "watched_seconds" field.
+func (_u *ViewingSessionUpdate) SetWatchedSeconds(v float64) *ViewingSessionUpdate {
+	_u.mutation.ResetWatchedSeconds()
+	_u.mutation.SetWatchedSeconds(v)
+	return _u
+}
+
+// SetNillableWatchedSeconds sets the "watched_seconds" field if the given value is not nil.
+func (_u *ViewingSessionUpdate) SetNillableWatchedSeconds(v *float64) *ViewingSessionUpdate {
+	if v != nil {
+		_u.SetWatchedSeconds(*v)
+	}
+	return _u
+}
+
+// AddWatchedSeconds adds value to the "watched_seconds" field.
+func (_u *ViewingSessionUpdate) AddWatchedSeconds(v float64) *ViewingSessionUpdate {
+	_u.mutation.AddWatchedSeconds(v)
+	return _u
+}
+
+// SetEndedAt sets the "ended_at" field.
+func (_u *ViewingSessionUpdate) SetEndedAt(v time.Time) *ViewingSessionUpdate {
+	_u.mutation.SetEndedAt(v)
+	return _u
+}
+
+// SetNillableEndedAt sets the "ended_at" field if the given value is not nil.
+func (_u *ViewingSessionUpdate) SetNillableEndedAt(v *time.Time) *ViewingSessionUpdate {
+	if v != nil {
+		_u.SetEndedAt(*v)
+	}
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *ViewingSessionUpdate) SetUpdatedAt(v time.Time) *ViewingSessionUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetVod sets the "vod" edge to the Vod entity.
+func (_u *ViewingSessionUpdate) SetVod(v *Vod) *ViewingSessionUpdate {
+	return _u.SetVodID(v.ID)
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (_u *ViewingSessionUpdate) SetUser(v *User) *ViewingSessionUpdate {
+	return _u.SetUserID(v.ID)
+}
+
+// Mutation returns the ViewingSessionMutation object of the builder.
+func (_u *ViewingSessionUpdate) Mutation() *ViewingSessionMutation {
+	return _u.mutation
+}
+
+// ClearVod clears the "vod" edge to the Vod entity.
+func (_u *ViewingSessionUpdate) ClearVod() *ViewingSessionUpdate {
+	_u.mutation.ClearVod()
+	return _u
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (_u *ViewingSessionUpdate) ClearUser() *ViewingSessionUpdate {
+	_u.mutation.ClearUser()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *ViewingSessionUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ViewingSessionUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *ViewingSessionUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ViewingSessionUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *ViewingSessionUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := viewingsession.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *ViewingSessionUpdate) check() error {
+	if _u.mutation.VodCleared() && len(_u.mutation.VodIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "ViewingSession.vod"`)
+	}
+	if _u.mutation.UserCleared() && len(_u.mutation.UserIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "ViewingSession.user"`)
+	}
+	return nil
+}
+
+func (_u *ViewingSessionUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(viewingsession.Table, viewingsession.Columns, sqlgraph.NewFieldSpec(viewingsession.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Device(); ok {
+		_spec.SetField(viewingsession.FieldDevice, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Ranges(); ok {
+		_spec.SetField(viewingsession.FieldRanges, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedRanges(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, viewingsession.FieldRanges, value)
+		})
+	}
+	if value, ok := _u.mutation.WatchedSeconds(); ok {
+		_spec.SetField(viewingsession.FieldWatchedSeconds, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedWatchedSeconds(); ok {
+		_spec.AddField(viewingsession.FieldWatchedSeconds, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.EndedAt(); ok {
+		_spec.SetField(viewingsession.FieldEndedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(viewingsession.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.VodCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   viewingsession.VodTable,
+			Columns: []string{viewingsession.VodColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(vod.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.VodIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   viewingsession.VodTable,
+			Columns: []string{viewingsession.VodColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(vod.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   viewingsession.UserTable,
+			Columns: []string{viewingsession.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   viewingsession.UserTable,
+			Columns: []string{viewingsession.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{viewingsession.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// ViewingSessionUpdateOne is the builder for updating a single ViewingSession entity.
+type ViewingSessionUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *ViewingSessionMutation
+}
+
+// SetVodID sets the "vod_id" field.
+func (_u *ViewingSessionUpdateOne) SetVodID(v uuid.UUID) *ViewingSessionUpdateOne {
+	_u.mutation.SetVodID(v)
+	return _u
+}
+
+// SetNillableVodID sets the "vod_id" field if the given value is not nil.
+func (_u *ViewingSessionUpdateOne) SetNillableVodID(v *uuid.UUID) *ViewingSessionUpdateOne {
+	if v != nil {
+		_u.SetVodID(*v)
+	}
+	return _u
+}
+
+// SetUserID sets the "user_id" field.
+func (_u *ViewingSessionUpdateOne) SetUserID(v uuid.UUID) *ViewingSessionUpdateOne {
+	_u.mutation.SetUserID(v)
+	return _u
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_u *ViewingSessionUpdateOne) SetNillableUserID(v *uuid.UUID) *ViewingSessionUpdateOne {
+	if v != nil {
+		_u.SetUserID(*v)
+	}
+	return _u
+}
+
+// SetDevice sets the "device" field.
+func (_u *ViewingSessionUpdateOne) SetDevice(v string) *ViewingSessionUpdateOne {
+	_u.mutation.SetDevice(v)
+	return _u
+}
+
+// SetNillableDevice sets the "device" field if the given value is not nil.
+func (_u *ViewingSessionUpdateOne) SetNillableDevice(v *string) *ViewingSessionUpdateOne {
+	if v != nil {
+		_u.SetDevice(*v)
+	}
+	return _u
+}
+
+// SetRanges sets the "ranges" field.
+func (_u *ViewingSessionUpdateOne) SetRanges(v []utils.WatchedRange) *ViewingSessionUpdateOne {
+	_u.mutation.SetRanges(v)
+	return _u
+}
+
+// AppendRanges appends value to the "ranges" field.
+func (_u *ViewingSessionUpdateOne) AppendRanges(v []utils.WatchedRange) *ViewingSessionUpdateOne {
+	_u.mutation.AppendRanges(v)
+	return _u
+}
+
+// SetWatchedSeconds sets the "watched_seconds" field.
+func (_u *ViewingSessionUpdateOne) SetWatchedSeconds(v float64) *ViewingSessionUpdateOne {
+	_u.mutation.ResetWatchedSeconds()
+	_u.mutation.SetWatchedSeconds(v)
+	return _u
+}
+
+// SetNillableWatchedSeconds sets the "watched_seconds" field if the given value is not nil.
+func (_u *ViewingSessionUpdateOne) SetNillableWatchedSeconds(v *float64) *ViewingSessionUpdateOne {
+	if v != nil {
+		_u.SetWatchedSeconds(*v)
+	}
+	return _u
+}
+
+// AddWatchedSeconds adds value to the "watched_seconds" field.
+func (_u *ViewingSessionUpdateOne) AddWatchedSeconds(v float64) *ViewingSessionUpdateOne {
+	_u.mutation.AddWatchedSeconds(v)
+	return _u
+}
+
+// SetEndedAt sets the "ended_at" field.
+func (_u *ViewingSessionUpdateOne) SetEndedAt(v time.Time) *ViewingSessionUpdateOne {
+	_u.mutation.SetEndedAt(v)
+	return _u
+}
+
+// SetNillableEndedAt sets the "ended_at" field if the given value is not nil.
+func (_u *ViewingSessionUpdateOne) SetNillableEndedAt(v *time.Time) *ViewingSessionUpdateOne {
+	if v != nil {
+		_u.SetEndedAt(*v)
+	}
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *ViewingSessionUpdateOne) SetUpdatedAt(v time.Time) *ViewingSessionUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetVod sets the "vod" edge to the Vod entity.
+func (_u *ViewingSessionUpdateOne) SetVod(v *Vod) *ViewingSessionUpdateOne {
+	return _u.SetVodID(v.ID)
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (_u *ViewingSessionUpdateOne) SetUser(v *User) *ViewingSessionUpdateOne {
+	return _u.SetUserID(v.ID)
+}
+
+// Mutation returns the ViewingSessionMutation object of the builder.
+func (_u *ViewingSessionUpdateOne) Mutation() *ViewingSessionMutation {
+	return _u.mutation
+}
+
+// ClearVod clears the "vod" edge to the Vod entity.
+func (_u *ViewingSessionUpdateOne) ClearVod() *ViewingSessionUpdateOne {
+	_u.mutation.ClearVod()
+	return _u
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (_u *ViewingSessionUpdateOne) ClearUser() *ViewingSessionUpdateOne {
+	_u.mutation.ClearUser()
+	return _u
+}
+
+// Where appends a list predicates to the ViewingSessionUpdate builder.
+func (_u *ViewingSessionUpdateOne) Where(ps ...predicate.ViewingSession) *ViewingSessionUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *ViewingSessionUpdateOne) Select(field string, fields ...string) *ViewingSessionUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated ViewingSession entity.
+func (_u *ViewingSessionUpdateOne) Save(ctx context.Context) (*ViewingSession, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ViewingSessionUpdateOne) SaveX(ctx context.Context) *ViewingSession {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *ViewingSessionUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ViewingSessionUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *ViewingSessionUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := viewingsession.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *ViewingSessionUpdateOne) check() error {
+	if _u.mutation.VodCleared() && len(_u.mutation.VodIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "ViewingSession.vod"`)
+	}
+	if _u.mutation.UserCleared() && len(_u.mutation.UserIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "ViewingSession.user"`)
+	}
+	return nil
+}
+
+func (_u *ViewingSessionUpdateOne) sqlSave(ctx context.Context) (_node *ViewingSession, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(viewingsession.Table, viewingsession.Columns, sqlgraph.NewFieldSpec(viewingsession.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "ViewingSession.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, viewingsession.FieldID)
+		for _, f := range fields {
+			if !viewingsession.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != viewingsession.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Device(); ok {
+		_spec.SetField(viewingsession.FieldDevice, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Ranges(); ok {
+		_spec.SetField(viewingsession.FieldRanges, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedRanges(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, viewingsession.FieldRanges, value)
+		})
+	}
+	if value, ok := _u.mutation.WatchedSeconds(); ok {
+		_spec.SetField(viewingsession.FieldWatchedSeconds, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedWatchedSeconds(); ok {
+		_spec.AddField(viewingsession.FieldWatchedSeconds, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.EndedAt(); ok {
+		_spec.SetField(viewingsession.FieldEndedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(viewingsession.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.VodCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   viewingsession.VodTable,
+			Columns: []string{viewingsession.VodColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(vod.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.VodIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   viewingsession.VodTable,
+			Columns: []string{viewingsession.VodColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(vod.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   viewingsession.UserTable,
+			Columns: []string{viewingsession.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   viewingsession.UserTable,
+			Columns: []string{viewingsession.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &ViewingSession{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{viewingsession.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
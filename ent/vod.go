@@ -13,6 +13,7 @@ import (
 	"github.com/google/uuid"
 	"github.com/zibbp/ganymede/ent/channel"
 	"github.com/zibbp/ganymede/ent/queue"
+	"github.com/zibbp/ganymede/ent/transcodingprofile"
 	"github.com/zibbp/ganymede/ent/vod"
 	"github.com/zibbp/ganymede/internal/utils"
 )
@@ -28,20 +29,30 @@ type Vod struct {
 	ClipExtVodID string `json:"clip_ext_vod_id,omitempty"`
 	// The ID of the stream on the external platform, if applicable.
 	ExtStreamID string `json:"ext_stream_id,omitempty"`
+	// The part number of a live stream archive split into parts, starting at 1. 0 if the archive isn't split.
+	Part int `json:"part,omitempty"`
 	// The platform the VOD is from, takes an enum.
 	Platform utils.VideoPlatform `json:"platform,omitempty"`
 	// The type of VOD, takes an enum.
 	Type utils.VodType `json:"type,omitempty"`
 	// Title holds the value of the "title" field.
 	Title string `json:"title,omitempty"`
+	// The main category of the video, if known.
+	Category string `json:"category,omitempty"`
 	// Duration holds the value of the "duration" field.
 	Duration int `json:"duration,omitempty"`
 	// The offset in seconds to where the clip starts in the VOD. This is only populdated if the video is a clip.
 	ClipVodOffset int `json:"clip_vod_offset,omitempty"`
+	// The video a clip was cut from. This is only populated if the clip was created in Ganymede.
+	ClipVodID *uuid.UUID `json:"clip_vod_id,omitempty"`
 	// Views holds the value of the "views" field.
 	Views int `json:"views,omitempty"`
 	// Resolution holds the value of the "resolution" field.
 	Resolution string `json:"resolution,omitempty"`
+	// Qualities tried in order when archiving the live stream at the resolution keeps failing.
+	QualityFallbacks []string `json:"quality_fallbacks,omitempty"`
+	// The qualities the live stream archive switched to after repeated failures.
+	QualitySwitches []utils.QualitySwitch `json:"quality_switches,omitempty"`
 	// Whether the VOD is currently processing.
 	Processing bool `json:"processing,omitempty"`
 	// ThumbnailPath holds the value of the "thumbnail_path" field.
@@ -64,6 +75,8 @@ type Vod struct {
 	InfoPath string `json:"info_path,omitempty"`
 	// CaptionPath holds the value of the "caption_path" field.
 	CaptionPath string `json:"caption_path,omitempty"`
+	// The audio of the live stream recorded alongside the video as a fallback.
+	AudioPath string `json:"audio_path,omitempty"`
 	// FolderName holds the value of the "folder_name" field.
 	FolderName string `json:"folder_name,omitempty"`
 	// FileName holds the value of the "file_name" field.
@@ -82,6 +95,8 @@ type Vod struct {
 	TmpChatRenderPath string `json:"tmp_chat_render_path,omitempty"`
 	// The path where the temporary video hls files are
 	TmpVideoHlsPath string `json:"tmp_video_hls_path,omitempty"`
+	// The path where the fallback audio is recorded to
+	TmpAudioPath string `json:"tmp_audio_path,omitempty"`
 	// Locked holds the value of the "locked" field.
 	Locked bool `json:"locked,omitempty"`
 	// LocalViews holds the value of the "local_views" field.
@@ -102,6 +117,22 @@ type Vod struct {
 	SpriteThumbnailsColumns int `json:"sprite_thumbnails_columns,omitempty"`
 	// The size of the VOD in bytes.
 	StorageSizeBytes int64 `json:"storage_size_bytes,omitempty"`
+	// Whether the VOD files are in the videos directory or the cold videos directory.
+	StorageTier utils.StorageTier `json:"storage_tier,omitempty"`
+	// The result of the last library audit of the VOD.
+	HealthStatus utils.VideoHealth `json:"health_status,omitempty"`
+	// The problems found by the last library audit of the VOD.
+	HealthIssues []utils.VideoHealthIssue `json:"health_issues,omitempty"`
+	// The time the VOD was last audited.
+	HealthCheckedAt *time.Time `json:"health_checked_at,omitempty"`
+	// The live archive of the same stream that has the original audio of the muted segments.
+	AudioSourceID *uuid.UUID `json:"audio_source_id,omitempty"`
+	// The position in seconds in the VOD where the live archive starts.
+	AudioSourceOffset float64 `json:"audio_source_offset,omitempty"`
+	// The transcoding profile the video is post-processed with. The global video convert arguments are used if empty.
+	TranscodingProfileID *uuid.UUID `json:"transcoding_profile_id,omitempty"`
+	// Whether this is the version to keep when both the live archive and the VOD of a stream are archived.
+	Authoritative bool `json:"authoritative,omitempty"`
 	// The time the VOD was streamed.
 	StreamedAt time.Time `json:"streamed_at,omitempty"`
 	// UpdatedAt holds the value of the "updated_at" field.
@@ -129,9 +160,21 @@ type VodEdges struct {
 	MutedSegments []*MutedSegment `json:"muted_segments,omitempty"`
 	// MultistreamInfo holds the value of the multistream_info edge.
 	MultistreamInfo []*MultistreamInfo `json:"multistream_info,omitempty"`
+	// ChatMessages holds the value of the chat_messages edge.
+	ChatMessages []*ChatMessage `json:"chat_messages,omitempty"`
+	// Checksums holds the value of the checksums edge.
+	Checksums []*Checksum `json:"checksums,omitempty"`
+	// LiveGaps holds the value of the live_gaps edge.
+	LiveGaps []*LiveGap `json:"live_gaps,omitempty"`
+	// ViewingSessions holds the value of the viewing_sessions edge.
+	ViewingSessions []*ViewingSession `json:"viewing_sessions,omitempty"`
+	// UserListItems holds the value of the user_list_items edge.
+	UserListItems []*UserListItem `json:"user_list_items,omitempty"`
+	// TranscodingProfile holds the value of the transcoding_profile edge.
+	TranscodingProfile *TranscodingProfile `json:"transcoding_profile,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [6]bool
+	loadedTypes [12]bool
 }
 
 // ChannelOrErr returns the Channel value or an error if the edge
@@ -192,20 +235,80 @@ func (e VodEdges) MultistreamInfoOrErr() ([]*MultistreamInfo, error) {
 	return nil, &NotLoadedError{edge: "multistream_info"}
 }
 
+// ChatMessagesOrErr returns the ChatMessages value or an error if the edge
+// was not loaded in eager-loading.
+func (e VodEdges) ChatMessagesOrErr() ([]*ChatMessage, error) {
+	if e.loadedTypes[6] {
+		return e.ChatMessages, nil
+	}
+	return nil, &NotLoadedError{edge: "chat_messages"}
+}
+
+// ChecksumsOrErr returns the Checksums value or an error if the edge
+// was not loaded in eager-loading.
+func (e VodEdges) ChecksumsOrErr() ([]*Checksum, error) {
+	if e.loadedTypes[7] {
+		return e.Checksums, nil
+	}
+	return nil, &NotLoadedError{edge: "checksums"}
+}
+
+// LiveGapsOrErr returns the LiveGaps value or an error if the edge
+// was not loaded in eager-loading.
+func (e VodEdges) LiveGapsOrErr() ([]*LiveGap, error) {
+	if e.loadedTypes[8] {
+		return e.LiveGaps, nil
+	}
+	return nil, &NotLoadedError{edge: "live_gaps"}
+}
+
+// ViewingSessionsOrErr returns the ViewingSessions value or an error if the edge
+// was not loaded in eager-loading.
+func (e VodEdges) ViewingSessionsOrErr() ([]*ViewingSession, error) {
+	if e.loadedTypes[9] {
+		return e.ViewingSessions, nil
+	}
+	return nil, &NotLoadedError{edge: "viewing_sessions"}
+}
+
+// UserListItemsOrErr returns the UserListItems value or an error if the edge
+// was not loaded in eager-loading.
+func (e VodEdges) UserListItemsOrErr() ([]*UserListItem, error) {
+	if e.loadedTypes[10] {
+		return e.UserListItems, nil
+	}
+	return nil, &NotLoadedError{edge: "user_list_items"}
+}
+
+// TranscodingProfileOrErr returns the TranscodingProfile value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e VodEdges) TranscodingProfileOrErr() (*TranscodingProfile, error) {
+	if e.TranscodingProfile != nil {
+		return e.TranscodingProfile, nil
+	} else if e.loadedTypes[11] {
+		return nil, &NotFoundError{label: transcodingprofile.Label}
+	}
+	return nil, &NotLoadedError{edge: "transcoding_profile"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*Vod) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case vod.FieldSpriteThumbnailsImages:
+		case vod.FieldClipVodID, vod.FieldAudioSourceID, vod.FieldTranscodingProfileID:
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		case vod.FieldQualityFallbacks, vod.FieldQualitySwitches, vod.FieldSpriteThumbnailsImages, vod.FieldHealthIssues:
 			values[i] = new([]byte)
-		case vod.FieldProcessing, vod.FieldLocked, vod.FieldSpriteThumbnailsEnabled:
+		case vod.FieldProcessing, vod.FieldLocked, vod.FieldSpriteThumbnailsEnabled, vod.FieldAuthoritative:
 			values[i] = new(sql.NullBool)
-		case vod.FieldDuration, vod.FieldClipVodOffset, vod.FieldViews, vod.FieldLocalViews, vod.FieldSpriteThumbnailsInterval, vod.FieldSpriteThumbnailsWidth, vod.FieldSpriteThumbnailsHeight, vod.FieldSpriteThumbnailsRows, vod.FieldSpriteThumbnailsColumns, vod.FieldStorageSizeBytes:
+		case vod.FieldAudioSourceOffset:
+			values[i] = new(sql.NullFloat64)
+		case vod.FieldPart, vod.FieldDuration, vod.FieldClipVodOffset, vod.FieldViews, vod.FieldLocalViews, vod.FieldSpriteThumbnailsInterval, vod.FieldSpriteThumbnailsWidth, vod.FieldSpriteThumbnailsHeight, vod.FieldSpriteThumbnailsRows, vod.FieldSpriteThumbnailsColumns, vod.FieldStorageSizeBytes:
 			values[i] = new(sql.NullInt64)
-		case vod.FieldExtID, vod.FieldClipExtVodID, vod.FieldExtStreamID, vod.FieldPlatform, vod.FieldType, vod.FieldTitle, vod.FieldResolution, vod.FieldThumbnailPath, vod.FieldWebThumbnailPath, vod.FieldVideoPath, vod.FieldVideoHlsPath, vod.FieldChatPath, vod.FieldLiveChatPath, vod.FieldLiveChatConvertPath, vod.FieldChatVideoPath, vod.FieldInfoPath, vod.FieldCaptionPath, vod.FieldFolderName, vod.FieldFileName, vod.FieldTmpVideoDownloadPath, vod.FieldTmpVideoConvertPath, vod.FieldTmpChatDownloadPath, vod.FieldTmpLiveChatDownloadPath, vod.FieldTmpLiveChatConvertPath, vod.FieldTmpChatRenderPath, vod.FieldTmpVideoHlsPath:
+		case vod.FieldExtID, vod.FieldClipExtVodID, vod.FieldExtStreamID, vod.FieldPlatform, vod.FieldType, vod.FieldTitle, vod.FieldCategory, vod.FieldResolution, vod.FieldThumbnailPath, vod.FieldWebThumbnailPath, vod.FieldVideoPath, vod.FieldVideoHlsPath, vod.FieldChatPath, vod.FieldLiveChatPath, vod.FieldLiveChatConvertPath, vod.FieldChatVideoPath, vod.FieldInfoPath, vod.FieldCaptionPath, vod.FieldAudioPath, vod.FieldFolderName, vod.FieldFileName, vod.FieldTmpVideoDownloadPath, vod.FieldTmpVideoConvertPath, vod.FieldTmpChatDownloadPath, vod.FieldTmpLiveChatDownloadPath, vod.FieldTmpLiveChatConvertPath, vod.FieldTmpChatRenderPath, vod.FieldTmpVideoHlsPath, vod.FieldTmpAudioPath, vod.FieldStorageTier, vod.FieldHealthStatus:
 			values[i] = new(sql.NullString)
-		case vod.FieldStreamedAt, vod.FieldUpdatedAt, vod.FieldCreatedAt:
+		case vod.FieldHealthCheckedAt, vod.FieldStreamedAt, vod.FieldUpdatedAt, vod.FieldCreatedAt:
 			values[i] = new(sql.NullTime)
 		case vod.FieldID:
 			values[i] = new(uuid.UUID)
@@ -250,6 +353,12 @@ func (_m *Vod) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.ExtStreamID = value.String
 			}
+		case vod.FieldPart:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field part", values[i])
+			} else if value.Valid {
+				_m.Part = int(value.Int64)
+			}
 		case vod.FieldPlatform:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field platform", values[i])
@@ -268,6 +377,12 @@ func (_m *Vod) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.Title = value.String
 			}
+		case vod.FieldCategory:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field category", values[i])
+			} else if value.Valid {
+				_m.Category = value.String
+			}
 		case vod.FieldDuration:
 			if value, ok := values[i].(*sql.NullInt64); !ok {
 				return fmt.Errorf("unexpected type %T for field duration", values[i])
@@ -280,6 +395,13 @@ func (_m *Vod) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.ClipVodOffset = int(value.Int64)
 			}
+		case vod.FieldClipVodID:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field clip_vod_id", values[i])
+			} else if value.Valid {
+				_m.ClipVodID = new(uuid.UUID)
+				*_m.ClipVodID = *value.S.(*uuid.UUID)
+			}
 		case vod.FieldViews:
 			if value, ok := values[i].(*sql.NullInt64); !ok {
 				return fmt.Errorf("unexpected type %T for field views", values[i])
@@ -292,6 +414,22 @@ func (_m *Vod) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.Resolution = value.String
 			}
+		case vod.FieldQualityFallbacks:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field quality_fallbacks", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.QualityFallbacks); err != nil {
+					return fmt.Errorf("unmarshal field quality_fallbacks: %w", err)
+				}
+			}
+		case vod.FieldQualitySwitches:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field quality_switches", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.QualitySwitches); err != nil {
+					return fmt.Errorf("unmarshal field quality_switches: %w", err)
+				}
+			}
 		case vod.FieldProcessing:
 			if value, ok := values[i].(*sql.NullBool); !ok {
 				return fmt.Errorf("unexpected type %T for field processing", values[i])
@@ -358,6 +496,12 @@ func (_m *Vod) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.CaptionPath = value.String
 			}
+		case vod.FieldAudioPath:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field audio_path", values[i])
+			} else if value.Valid {
+				_m.AudioPath = value.String
+			}
 		case vod.FieldFolderName:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field folder_name", values[i])
@@ -412,6 +556,12 @@ func (_m *Vod) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.TmpVideoHlsPath = value.String
 			}
+		case vod.FieldTmpAudioPath:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field tmp_audio_path", values[i])
+			} else if value.Valid {
+				_m.TmpAudioPath = value.String
+			}
 		case vod.FieldLocked:
 			if value, ok := values[i].(*sql.NullBool); !ok {
 				return fmt.Errorf("unexpected type %T for field locked", values[i])
@@ -474,6 +624,59 @@ func (_m *Vod) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.StorageSizeBytes = value.Int64
 			}
+		case vod.FieldStorageTier:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field storage_tier", values[i])
+			} else if value.Valid {
+				_m.StorageTier = utils.StorageTier(value.String)
+			}
+		case vod.FieldHealthStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field health_status", values[i])
+			} else if value.Valid {
+				_m.HealthStatus = utils.VideoHealth(value.String)
+			}
+		case vod.FieldHealthIssues:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field health_issues", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.HealthIssues); err != nil {
+					return fmt.Errorf("unmarshal field health_issues: %w", err)
+				}
+			}
+		case vod.FieldHealthCheckedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field health_checked_at", values[i])
+			} else if value.Valid {
+				_m.HealthCheckedAt = new(time.Time)
+				*_m.HealthCheckedAt = value.Time
+			}
+		case vod.FieldAudioSourceID:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field audio_source_id", values[i])
+			} else if value.Valid {
+				_m.AudioSourceID = new(uuid.UUID)
+				*_m.AudioSourceID = *value.S.(*uuid.UUID)
+			}
+		case vod.FieldAudioSourceOffset:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field audio_source_offset", values[i])
+			} else if value.Valid {
+				_m.AudioSourceOffset = value.Float64
+			}
+		case vod.FieldTranscodingProfileID:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field transcoding_profile_id", values[i])
+			} else if value.Valid {
+				_m.TranscodingProfileID = new(uuid.UUID)
+				*_m.TranscodingProfileID = *value.S.(*uuid.UUID)
+			}
+		case vod.FieldAuthoritative:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field authoritative", values[i])
+			} else if value.Valid {
+				_m.Authoritative = value.Bool
+			}
 		case vod.FieldStreamedAt:
 			if value, ok := values[i].(*sql.NullTime); !ok {
 				return fmt.Errorf("unexpected type %T for field streamed_at", values[i])
@@ -542,6 +745,36 @@ func (_m *Vod) QueryMultistreamInfo() *MultistreamInfoQuery {
 	return NewVodClient(_m.config).QueryMultistreamInfo(_m)
 }
 
+// QueryChatMessages queries the "chat_messages" edge of the Vod entity.
+func (_m *Vod) QueryChatMessages() *ChatMessageQuery {
+	return NewVodClient(_m.config).QueryChatMessages(_m)
+}
+
+// QueryChecksums queries the "checksums" edge of the Vod entity.
+func (_m *Vod) QueryChecksums() *ChecksumQuery {
+	return NewVodClient(_m.config).QueryChecksums(_m)
+}
+
+// QueryLiveGaps queries the "live_gaps" edge of the Vod entity.
+func (_m *Vod) QueryLiveGaps() *LiveGapQuery {
+	return NewVodClient(_m.config).QueryLiveGaps(_m)
+}
+
+// QueryViewingSessions queries the "viewing_sessions" edge of the Vod entity.
+func (_m *Vod) QueryViewingSessions() *ViewingSessionQuery {
+	return NewVodClient(_m.config).QueryViewingSessions(_m)
+}
+
+// QueryUserListItems queries the "user_list_items" edge of the Vod entity.
+func (_m *Vod) QueryUserListItems() *UserListItemQuery {
+	return NewVodClient(_m.config).QueryUserListItems(_m)
+}
+
+// QueryTranscodingProfile queries the "transcoding_profile" edge of the Vod entity.
+func (_m *Vod) QueryTranscodingProfile() *TranscodingProfileQuery {
+	return NewVodClient(_m.config).QueryTranscodingProfile(_m)
+}
+
 // Update returns a builder for updating this Vod.
 // Note that you need to call Vod.Unwrap() before calling this method if this Vod
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -574,6 +807,9 @@ func (_m *Vod) String() string {
 	builder.WriteString("ext_stream_id=")
 	builder.WriteString(_m.ExtStreamID)
 	builder.WriteString(", ")
+	builder.WriteString("part=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Part))
+	builder.WriteString(", ")
 	builder.WriteString("platform=")
 	builder.WriteString(fmt.Sprintf("%v", _m.Platform))
 	builder.WriteString(", ")
@@ -583,18 +819,32 @@ func (_m *Vod) String() string {
 	builder.WriteString("title=")
 	builder.WriteString(_m.Title)
 	builder.WriteString(", ")
+	builder.WriteString("category=")
+	builder.WriteString(_m.Category)
+	builder.WriteString(", ")
 	builder.WriteString("duration=")
 	builder.WriteString(fmt.Sprintf("%v", _m.Duration))
 	builder.WriteString(", ")
 	builder.WriteString("clip_vod_offset=")
 	builder.WriteString(fmt.Sprintf("%v", _m.ClipVodOffset))
 	builder.WriteString(", ")
+	if v := _m.ClipVodID; v != nil {
+		builder.WriteString("clip_vod_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
 	builder.WriteString("views=")
 	builder.WriteString(fmt.Sprintf("%v", _m.Views))
 	builder.WriteString(", ")
 	builder.WriteString("resolution=")
 	builder.WriteString(_m.Resolution)
 	builder.WriteString(", ")
+	builder.WriteString("quality_fallbacks=")
+	builder.WriteString(fmt.Sprintf("%v", _m.QualityFallbacks))
+	builder.WriteString(", ")
+	builder.WriteString("quality_switches=")
+	builder.WriteString(fmt.Sprintf("%v", _m.QualitySwitches))
+	builder.WriteString(", ")
 	builder.WriteString("processing=")
 	builder.WriteString(fmt.Sprintf("%v", _m.Processing))
 	builder.WriteString(", ")
@@ -628,6 +878,9 @@ func (_m *Vod) String() string {
 	builder.WriteString("caption_path=")
 	builder.WriteString(_m.CaptionPath)
 	builder.WriteString(", ")
+	builder.WriteString("audio_path=")
+	builder.WriteString(_m.AudioPath)
+	builder.WriteString(", ")
 	builder.WriteString("folder_name=")
 	builder.WriteString(_m.FolderName)
 	builder.WriteString(", ")
@@ -655,6 +908,9 @@ func (_m *Vod) String() string {
 	builder.WriteString("tmp_video_hls_path=")
 	builder.WriteString(_m.TmpVideoHlsPath)
 	builder.WriteString(", ")
+	builder.WriteString("tmp_audio_path=")
+	builder.WriteString(_m.TmpAudioPath)
+	builder.WriteString(", ")
 	builder.WriteString("locked=")
 	builder.WriteString(fmt.Sprintf("%v", _m.Locked))
 	builder.WriteString(", ")
@@ -685,6 +941,36 @@ func (_m *Vod) String() string {
 	builder.WriteString("storage_size_bytes=")
 	builder.WriteString(fmt.Sprintf("%v", _m.StorageSizeBytes))
 	builder.WriteString(", ")
+	builder.WriteString("storage_tier=")
+	builder.WriteString(fmt.Sprintf("%v", _m.StorageTier))
+	builder.WriteString(", ")
+	builder.WriteString("health_status=")
+	builder.WriteString(fmt.Sprintf("%v", _m.HealthStatus))
+	builder.WriteString(", ")
+	builder.WriteString("health_issues=")
+	builder.WriteString(fmt.Sprintf("%v", _m.HealthIssues))
+	builder.WriteString(", ")
+	if v := _m.HealthCheckedAt; v != nil {
+		builder.WriteString("health_checked_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.AudioSourceID; v != nil {
+		builder.WriteString("audio_source_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("audio_source_offset=")
+	builder.WriteString(fmt.Sprintf("%v", _m.AudioSourceOffset))
+	builder.WriteString(", ")
+	if v := _m.TranscodingProfileID; v != nil {
+		builder.WriteString("transcoding_profile_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("authoritative=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Authoritative))
+	builder.WriteString(", ")
 	builder.WriteString("streamed_at=")
 	builder.WriteString(_m.StreamedAt.Format(time.ANSIC))
 	builder.WriteString(", ")
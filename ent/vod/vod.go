@@ -23,20 +23,30 @@ const (
 	FieldClipExtVodID = "clip_ext_vod_id"
 	// FieldExtStreamID holds the string denoting the ext_stream_id field in the database.
 	FieldExtStreamID = "ext_stream_id"
+	// FieldPart holds the string denoting the part field in the database.
+	FieldPart = "part"
 	// FieldPlatform holds the string denoting the platform field in the database.
 	FieldPlatform = "platform"
 	// FieldType holds the string denoting the type field in the database.
 	FieldType = "type"
 	// FieldTitle holds the string denoting the title field in the database.
 	FieldTitle = "title"
+	// FieldCategory holds the string denoting the category field in the database.
+	FieldCategory = "category"
 	// FieldDuration holds the string denoting the duration field in the database.
 	FieldDuration = "duration"
 	// FieldClipVodOffset holds the string denoting the clip_vod_offset field in the database.
 	FieldClipVodOffset = "clip_vod_offset"
+	// FieldClipVodID holds the string denoting the clip_vod_id field in the database.
+	FieldClipVodID = "clip_vod_id"
 	// FieldViews holds the string denoting the views field in the database.
 	FieldViews = "views"
 	// FieldResolution holds the string denoting the resolution field in the database.
 	FieldResolution = "resolution"
+	// FieldQualityFallbacks holds the string denoting the quality_fallbacks field in the database.
+	FieldQualityFallbacks = "quality_fallbacks"
+	// FieldQualitySwitches holds the string denoting the quality_switches field in the database.
+	FieldQualitySwitches = "quality_switches"
 	// FieldProcessing holds the string denoting the processing field in the database.
 	FieldProcessing = "processing"
 	// FieldThumbnailPath holds the string denoting the thumbnail_path field in the database.
@@ -59,6 +69,8 @@ const (
 	FieldInfoPath = "info_path"
 	// FieldCaptionPath holds the string denoting the caption_path field in the database.
 	FieldCaptionPath = "caption_path"
+	// FieldAudioPath holds the string denoting the audio_path field in the database.
+	FieldAudioPath = "audio_path"
 	// FieldFolderName holds the string denoting the folder_name field in the database.
 	FieldFolderName = "folder_name"
 	// FieldFileName holds the string denoting the file_name field in the database.
@@ -77,6 +89,8 @@ const (
 	FieldTmpChatRenderPath = "tmp_chat_render_path"
 	// FieldTmpVideoHlsPath holds the string denoting the tmp_video_hls_path field in the database.
 	FieldTmpVideoHlsPath = "tmp_video_hls_path"
+	// FieldTmpAudioPath holds the string denoting the tmp_audio_path field in the database.
+	FieldTmpAudioPath = "tmp_audio_path"
 	// FieldLocked holds the string denoting the locked field in the database.
 	FieldLocked = "locked"
 	// FieldLocalViews holds the string denoting the local_views field in the database.
@@ -97,6 +111,22 @@ const (
 	FieldSpriteThumbnailsColumns = "sprite_thumbnails_columns"
 	// FieldStorageSizeBytes holds the string denoting the storage_size_bytes field in the database.
 	FieldStorageSizeBytes = "storage_size_bytes"
+	// FieldStorageTier holds the string denoting the storage_tier field in the database.
+	FieldStorageTier = "storage_tier"
+	// FieldHealthStatus holds the string denoting the health_status field in the database.
+	FieldHealthStatus = "health_status"
+	// FieldHealthIssues holds the string denoting the health_issues field in the database.
+	FieldHealthIssues = "health_issues"
+	// FieldHealthCheckedAt holds the string denoting the health_checked_at field in the database.
+	FieldHealthCheckedAt = "health_checked_at"
+	// FieldAudioSourceID holds the string denoting the audio_source_id field in the database.
+	FieldAudioSourceID = "audio_source_id"
+	// FieldAudioSourceOffset holds the string denoting the audio_source_offset field in the database.
+	FieldAudioSourceOffset = "audio_source_offset"
+	// FieldTranscodingProfileID holds the string denoting the transcoding_profile_id field in the database.
+	FieldTranscodingProfileID = "transcoding_profile_id"
+	// FieldAuthoritative holds the string denoting the authoritative field in the database.
+	FieldAuthoritative = "authoritative"
 	// FieldStreamedAt holds the string denoting the streamed_at field in the database.
 	FieldStreamedAt = "streamed_at"
 	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
@@ -115,6 +145,18 @@ const (
 	EdgeMutedSegments = "muted_segments"
 	// EdgeMultistreamInfo holds the string denoting the multistream_info edge name in mutations.
 	EdgeMultistreamInfo = "multistream_info"
+	// EdgeChatMessages holds the string denoting the chat_messages edge name in mutations.
+	EdgeChatMessages = "chat_messages"
+	// EdgeChecksums holds the string denoting the checksums edge name in mutations.
+	EdgeChecksums = "checksums"
+	// EdgeLiveGaps holds the string denoting the live_gaps edge name in mutations.
+	EdgeLiveGaps = "live_gaps"
+	// EdgeViewingSessions holds the string denoting the viewing_sessions edge name in mutations.
+	EdgeViewingSessions = "viewing_sessions"
+	// EdgeUserListItems holds the string denoting the user_list_items edge name in mutations.
+	EdgeUserListItems = "user_list_items"
+	// EdgeTranscodingProfile holds the string denoting the transcoding_profile edge name in mutations.
+	EdgeTranscodingProfile = "transcoding_profile"
 	// Table holds the table name of the vod in the database.
 	Table = "vods"
 	// ChannelTable is the table that holds the channel relation/edge.
@@ -157,6 +199,48 @@ const (
 	MultistreamInfoInverseTable = "multistream_infos"
 	// MultistreamInfoColumn is the table column denoting the multistream_info relation/edge.
 	MultistreamInfoColumn = "multistream_info_vod"
+	// ChatMessagesTable is the table that holds the chat_messages relation/edge.
+	ChatMessagesTable = "chat_messages"
+	// ChatMessagesInverseTable is the table name for the ChatMessage entity.
+	// It exists in this package in order to avoid circular dependency with the "chatmessage" package.
+	ChatMessagesInverseTable = "chat_messages"
+	// ChatMessagesColumn is the table column denoting the chat_messages relation/edge.
+	ChatMessagesColumn = "vod_id"
+	// ChecksumsTable is the table that holds the checksums relation/edge.
+	ChecksumsTable = "checksums"
+	// ChecksumsInverseTable is the table name for the Checksum entity.
+	// It exists in this package in order to avoid circular dependency with the "checksum" package.
+	ChecksumsInverseTable = "checksums"
+	// ChecksumsColumn is the table column denoting the checksums relation/edge.
+	ChecksumsColumn = "vod_id"
+	// LiveGapsTable is the table that holds the live_gaps relation/edge.
+	LiveGapsTable = "live_gaps"
+	// LiveGapsInverseTable is the table name for the LiveGap entity.
+	// It exists in this package in order to avoid circular dependency with the "livegap" package.
+	LiveGapsInverseTable = "live_gaps"
+	// LiveGapsColumn is the table column denoting the live_gaps relation/edge.
+	LiveGapsColumn = "vod_id"
+	// ViewingSessionsTable is the table that holds the viewing_sessions relation/edge.
+	ViewingSessionsTable = "viewing_sessions"
+	// ViewingSessionsInverseTable is the table name for the ViewingSession entity.
+	// It exists in this package in order to avoid circular dependency with the "viewingsession" package.
+	ViewingSessionsInverseTable = "viewing_sessions"
+	// ViewingSessionsColumn is the table column denoting the viewing_sessions relation/edge.
+	ViewingSessionsColumn = "vod_id"
+	// UserListItemsTable is the table that holds the user_list_items relation/edge.
+	UserListItemsTable = "user_list_items"
+	// UserListItemsInverseTable is the table name for the UserListItem entity.
+	// It exists in this package in order to avoid circular dependency with the "userlistitem" package.
+	UserListItemsInverseTable = "user_list_items"
+	// UserListItemsColumn is the table column denoting the user_list_items relation/edge.
+	UserListItemsColumn = "vod_id"
+	// TranscodingProfileTable is the table that holds the transcoding_profile relation/edge.
+	TranscodingProfileTable = "vods"
+	// TranscodingProfileInverseTable is the table name for the TranscodingProfile entity.
+	// It exists in this package in order to avoid circular dependency with the "transcodingprofile" package.
+	TranscodingProfileInverseTable = "transcoding_profiles"
+	// TranscodingProfileColumn is the table column denoting the transcoding_profile relation/edge.
+	TranscodingProfileColumn = "transcoding_profile_id"
 )
 
 // Columns holds all SQL columns for vod fields.
@@ -165,13 +249,18 @@ var Columns = []string{
 	FieldExtID,
 	FieldClipExtVodID,
 	FieldExtStreamID,
+	FieldPart,
 	FieldPlatform,
 	FieldType,
 	FieldTitle,
+	FieldCategory,
 	FieldDuration,
 	FieldClipVodOffset,
+	FieldClipVodID,
 	FieldViews,
 	FieldResolution,
+	FieldQualityFallbacks,
+	FieldQualitySwitches,
 	FieldProcessing,
 	FieldThumbnailPath,
 	FieldWebThumbnailPath,
@@ -183,6 +272,7 @@ var Columns = []string{
 	FieldChatVideoPath,
 	FieldInfoPath,
 	FieldCaptionPath,
+	FieldAudioPath,
 	FieldFolderName,
 	FieldFileName,
 	FieldTmpVideoDownloadPath,
@@ -192,6 +282,7 @@ var Columns = []string{
 	FieldTmpLiveChatConvertPath,
 	FieldTmpChatRenderPath,
 	FieldTmpVideoHlsPath,
+	FieldTmpAudioPath,
 	FieldLocked,
 	FieldLocalViews,
 	FieldSpriteThumbnailsEnabled,
@@ -202,6 +293,14 @@ var Columns = []string{
 	FieldSpriteThumbnailsRows,
 	FieldSpriteThumbnailsColumns,
 	FieldStorageSizeBytes,
+	FieldStorageTier,
+	FieldHealthStatus,
+	FieldHealthIssues,
+	FieldHealthCheckedAt,
+	FieldAudioSourceID,
+	FieldAudioSourceOffset,
+	FieldTranscodingProfileID,
+	FieldAuthoritative,
 	FieldStreamedAt,
 	FieldUpdatedAt,
 	FieldCreatedAt,
@@ -235,6 +334,10 @@ func ValidColumn(column string) bool {
 }
 
 var (
+	// DefaultPart holds the default value on creation for the "part" field.
+	DefaultPart int
+	// PartValidator is a validator for the "part" field. It is called by the builders before save.
+	PartValidator func(int) error
 	// DefaultDuration holds the default value on creation for the "duration" field.
 	DefaultDuration int
 	// DefaultViews holds the default value on creation for the "views" field.
@@ -249,6 +352,8 @@ var (
 	DefaultSpriteThumbnailsEnabled bool
 	// DefaultStorageSizeBytes holds the default value on creation for the "storage_size_bytes" field.
 	DefaultStorageSizeBytes int64
+	// DefaultAuthoritative holds the default value on creation for the "authoritative" field.
+	DefaultAuthoritative bool
 	// DefaultStreamedAt holds the default value on creation for the "streamed_at" field.
 	DefaultStreamedAt func() time.Time
 	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
@@ -266,7 +371,7 @@ const DefaultPlatform utils.VideoPlatform = "twitch"
 // PlatformValidator is a validator for the "platform" field enum values. It is called by the builders before save.
 func PlatformValidator(pl utils.VideoPlatform) error {
 	switch pl {
-	case "twitch", "youtube":
+	case "twitch", "youtube", "kick":
 		return nil
 	default:
 		return fmt.Errorf("vod: invalid enum value for platform field: %q", pl)
@@ -285,6 +390,30 @@ func TypeValidator(_type utils.VodType) error {
 	}
 }
 
+const DefaultStorageTier utils.StorageTier = "hot"
+
+// StorageTierValidator is a validator for the "storage_tier" field enum values. It is called by the builders before save.
+func StorageTierValidator(st utils.StorageTier) error {
+	switch st {
+	case "hot", "cold":
+		return nil
+	default:
+		return fmt.Errorf("vod: invalid enum value for storage_tier field: %q", st)
+	}
+}
+
+const DefaultHealthStatus utils.VideoHealth = "unknown"
+
+// HealthStatusValidator is a validator for the "health_status" field enum values. It is called by the builders before save.
+func HealthStatusValidator(hs utils.VideoHealth) error {
+	switch hs {
+	case "unknown", "healthy", "degraded", "broken":
+		return nil
+	default:
+		return fmt.Errorf("vod: invalid enum value for health_status field: %q", hs)
+	}
+}
+
 // OrderOption defines the ordering options for the Vod queries.
 type OrderOption func(*sql.Selector)
 
@@ -308,6 +437,11 @@ func ByExtStreamID(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldExtStreamID, opts...).ToFunc()
 }
 
+// ByPart orders the results by the part field.
+func ByPart(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPart, opts...).ToFunc()
+}
+
 // ByPlatform orders the results by the platform field.
 func ByPlatform(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldPlatform, opts...).ToFunc()
@@ -323,6 +457,11 @@ func ByTitle(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldTitle, opts...).ToFunc()
 }
 
+// ByCategory orders the results by the category field.
+func ByCategory(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCategory, opts...).ToFunc()
+}
+
 // ByDuration orders the results by the duration field.
 func ByDuration(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldDuration, opts...).ToFunc()
@@ -333,6 +472,11 @@ func ByClipVodOffset(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldClipVodOffset, opts...).ToFunc()
 }
 
+// ByClipVodID orders the results by the clip_vod_id field.
+func ByClipVodID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldClipVodID, opts...).ToFunc()
+}
+
 // ByViews orders the results by the views field.
 func ByViews(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldViews, opts...).ToFunc()
@@ -398,6 +542,11 @@ func ByCaptionPath(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldCaptionPath, opts...).ToFunc()
 }
 
+// ByAudioPath orders the results by the audio_path field.
+func ByAudioPath(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAudioPath, opts...).ToFunc()
+}
+
 // ByFolderName orders the results by the folder_name field.
 func ByFolderName(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldFolderName, opts...).ToFunc()
@@ -443,6 +592,11 @@ func ByTmpVideoHlsPath(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldTmpVideoHlsPath, opts...).ToFunc()
 }
 
+// ByTmpAudioPath orders the results by the tmp_audio_path field.
+func ByTmpAudioPath(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTmpAudioPath, opts...).ToFunc()
+}
+
 // ByLocked orders the results by the locked field.
 func ByLocked(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldLocked, opts...).ToFunc()
@@ -488,6 +642,41 @@ func ByStorageSizeBytes(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldStorageSizeBytes, opts...).ToFunc()
 }
 
+// ByStorageTier orders the results by the storage_tier field.
+func ByStorageTier(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStorageTier, opts...).ToFunc()
+}
+
+// ByHealthStatus orders the results by the health_status field.
+func ByHealthStatus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldHealthStatus, opts...).ToFunc()
+}
+
+// ByHealthCheckedAt orders the results by the health_checked_at field.
+func ByHealthCheckedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldHealthCheckedAt, opts...).ToFunc()
+}
+
+// ByAudioSourceID orders the results by the audio_source_id field.
+func ByAudioSourceID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAudioSourceID, opts...).ToFunc()
+}
+
+// ByAudioSourceOffset orders the results by the audio_source_offset field.
+func ByAudioSourceOffset(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAudioSourceOffset, opts...).ToFunc()
+}
+
+// ByTranscodingProfileID orders the results by the transcoding_profile_id field.
+func ByTranscodingProfileID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTranscodingProfileID, opts...).ToFunc()
+}
+
+// ByAuthoritative orders the results by the authoritative field.
+func ByAuthoritative(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAuthoritative, opts...).ToFunc()
+}
+
 // ByStreamedAt orders the results by the streamed_at field.
 func ByStreamedAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldStreamedAt, opts...).ToFunc()
@@ -572,6 +761,83 @@ func ByMultistreamInfo(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
 		sqlgraph.OrderByNeighborTerms(s, newMultistreamInfoStep(), append([]sql.OrderTerm{term}, terms...)...)
 	}
 }
+
+// ByChatMessagesCount orders the results by chat_messages count.
+func ByChatMessagesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newChatMessagesStep(), opts...)
+	}
+}
+
+// ByChatMessages orders the results by chat_messages terms.
+func ByChatMessages(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newChatMessagesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByChecksumsCount orders the results by checksums count.
+func ByChecksumsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newChecksumsStep(), opts...)
+	}
+}
+
+// ByChecksums orders the results by checksums terms.
+func ByChecksums(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newChecksumsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByLiveGapsCount orders the results by live_gaps count.
+func ByLiveGapsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newLiveGapsStep(), opts...)
+	}
+}
+
+// ByLiveGaps orders the results by live_gaps terms.
+func ByLiveGaps(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newLiveGapsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByViewingSessionsCount orders the results by viewing_sessions count.
+func ByViewingSessionsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newViewingSessionsStep(), opts...)
+	}
+}
+
+// ByViewingSessions orders the results by viewing_sessions terms.
+func ByViewingSessions(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newViewingSessionsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByUserListItemsCount orders the results by user_list_items count.
+func ByUserListItemsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newUserListItemsStep(), opts...)
+	}
+}
+
+// ByUserListItems orders the results by user_list_items terms.
+func ByUserListItems(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newUserListItemsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByTranscodingProfileField orders the results by transcoding_profile field.
+func ByTranscodingProfileField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newTranscodingProfileStep(), sql.OrderByField(field, opts...))
+	}
+}
 func newChannelStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -614,3 +880,45 @@ func newMultistreamInfoStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.O2M, true, MultistreamInfoTable, MultistreamInfoColumn),
 	)
 }
+func newChatMessagesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ChatMessagesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, ChatMessagesTable, ChatMessagesColumn),
+	)
+}
+func newChecksumsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ChecksumsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, ChecksumsTable, ChecksumsColumn),
+	)
+}
+func newLiveGapsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(LiveGapsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, LiveGapsTable, LiveGapsColumn),
+	)
+}
+func newViewingSessionsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ViewingSessionsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, ViewingSessionsTable, ViewingSessionsColumn),
+	)
+}
+func newUserListItemsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(UserListItemsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, UserListItemsTable, UserListItemsColumn),
+	)
+}
+func newTranscodingProfileStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(TranscodingProfileInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, false, TranscodingProfileTable, TranscodingProfileColumn),
+	)
+}
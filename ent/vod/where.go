@@ -72,11 +72,21 @@ func ExtStreamID(v string) predicate.Vod {
 	return predicate.Vod(sql.FieldEQ(FieldExtStreamID, v))
 }
 
+// Part applies equality check predicate on the "part" field. It's identical to PartEQ.
+func Part(v int) predicate.Vod {
+	return predicate.Vod(sql.FieldEQ(FieldPart, v))
+}
+
 // Title applies equality check predicate on the "title" field. It's identical to TitleEQ.
 func Title(v string) predicate.Vod {
 	return predicate.Vod(sql.FieldEQ(FieldTitle, v))
 }
 
+// Category applies equality check predicate on the "category" field. It's identical to CategoryEQ.
+func Category(v string) predicate.Vod {
+	return predicate.Vod(sql.FieldEQ(FieldCategory, v))
+}
+
 // Duration applies equality check predicate on the "duration" field. It's identical to DurationEQ.
 func Duration(v int) predicate.Vod {
 	return predicate.Vod(sql.FieldEQ(FieldDuration, v))
@@ -87,6 +97,11 @@ func ClipVodOffset(v int) predicate.Vod {
 	return predicate.Vod(sql.FieldEQ(FieldClipVodOffset, v))
 }
 
+// ClipVodID applies equality check predicate on the "clip_vod_id" field. It's identical to ClipVodIDEQ.
+func ClipVodID(v uuid.UUID) predicate.Vod {
+	return predicate.Vod(sql.FieldEQ(FieldClipVodID, v))
+}
+
 // Views applies equality check predicate on the "views" field. It's identical to ViewsEQ.
 func Views(v int) predicate.Vod {
 	return predicate.Vod(sql.FieldEQ(FieldViews, v))
@@ -152,6 +167,11 @@ func CaptionPath(v string) predicate.Vod {
 	return predicate.Vod(sql.FieldEQ(FieldCaptionPath, v))
 }
 
+// AudioPath applies equality check predicate on the "audio_path" field. It's identical to AudioPathEQ.
+func AudioPath(v string) predicate.Vod {
+	return predicate.Vod(sql.FieldEQ(FieldAudioPath, v))
+}
+
 // FolderName applies equality check predicate on the "folder_name" field. It's identical to FolderNameEQ.
 func FolderName(v string) predicate.Vod {
 	return predicate.Vod(sql.FieldEQ(FieldFolderName, v))
@@ -197,6 +217,11 @@ func TmpVideoHlsPath(v string) predicate.Vod {
 	return predicate.Vod(sql.FieldEQ(FieldTmpVideoHlsPath, v))
 }
 
+// TmpAudioPath applies equality check predicate on the "tmp_audio_path" field. It's identical to TmpAudioPathEQ.
+func TmpAudioPath(v string) predicate.Vod {
+	return predicate.Vod(sql.FieldEQ(FieldTmpAudioPath, v))
+}
+
 // Locked applies equality check predicate on the "locked" field. It's identical to LockedEQ.
 func Locked(v bool) predicate.Vod {
 	return predicate.Vod(sql.FieldEQ(FieldLocked, v))
@@ -242,6 +267,31 @@ func StorageSizeBytes(v int64) predicate.Vod {
 	return predicate.Vod(sql.FieldEQ(FieldStorageSizeBytes, v))
 }
 
+// HealthCheckedAt applies equality check predicate on the "health_checked_at" field. It's identical to HealthCheckedAtEQ.
+func HealthCheckedAt(v time.Time) predicate.Vod {
+	return predicate.Vod(sql.FieldEQ(FieldHealthCheckedAt, v))
+}
+
+// AudioSourceID applies equality check predicate on the "audio_source_id" field. It's identical to AudioSourceIDEQ.
+func AudioSourceID(v uuid.UUID) predicate.Vod {
+	return predicate.Vod(sql.FieldEQ(FieldAudioSourceID, v))
+}
+
+// AudioSourceOffset applies equality check predicate on the "audio_source_offset" field. It's identical to AudioSourceOffsetEQ.
+func AudioSourceOffset(v float64) predicate.Vod {
+	return predicate.Vod(sql.FieldEQ(FieldAudioSourceOffset, v))
+}
+
+// TranscodingProfileID applies equality check predicate on the "transcoding_profile_id" field. It's identical to TranscodingProfileIDEQ.
+func TranscodingProfileID(v uuid.UUID) predicate.Vod {
+	return predicate.Vod(sql.FieldEQ(FieldTranscodingProfileID, v))
+}
+
+// Authoritative applies equality check predicate on the "authoritative" field. It's identical to AuthoritativeEQ.
+func Authoritative(v bool) predicate.Vod {
+	return predicate.Vod(sql.FieldEQ(FieldAuthoritative, v))
+}
+
 // StreamedAt applies equality check predicate on the "streamed_at" field. It's identical to StreamedAtEQ.
 func StreamedAt(v time.Time) predicate.Vod {
 	return predicate.Vod(sql.FieldEQ(FieldStreamedAt, v))
@@ -472,6 +522,46 @@ func ExtStreamIDContainsFold(v string) predicate.Vod {
 	return predicate.Vod(sql.FieldContainsFold(FieldExtStreamID, v))
 }
 
+// PartEQ applies the EQ predicate on the "part" field.
+func PartEQ(v int) predicate.Vod {
+	return predicate.Vod(sql.FieldEQ(FieldPart, v))
+}
+
+// PartNEQ applies the NEQ predicate on the "part" field.
+func PartNEQ(v int) predicate.Vod {
+	return predicate.Vod(sql.FieldNEQ(FieldPart, v))
+}
+
+// PartIn applies the In predicate on the "part" field.
+func PartIn(vs ...int) predicate.Vod {
+	return predicate.Vod(sql.FieldIn(FieldPart, vs...))
+}
+
+// PartNotIn applies the NotIn predicate on the "part" field.
+func PartNotIn(vs ...int) predicate.Vod {
+	return predicate.Vod(sql.FieldNotIn(FieldPart, vs...))
+}
+
+// PartGT applies the GT predicate on the "part" field.
+func PartGT(v int) predicate.Vod {
+	return predicate.Vod(sql.FieldGT(FieldPart, v))
+}
+
+// PartGTE applies the GTE predicate on the "part" field.
+func PartGTE(v int) predicate.Vod {
+	return predicate.Vod(sql.FieldGTE(FieldPart, v))
+}
+
+// PartLT applies the LT predicate on the "part" field.
+func PartLT(v int) predicate.Vod {
+	return predicate.Vod(sql.FieldLT(FieldPart, v))
+}
+
+// PartLTE applies the LTE predicate on the "part" field.
+func PartLTE(v int) predicate.Vod {
+	return predicate.Vod(sql.FieldLTE(FieldPart, v))
+}
+
 // PlatformEQ applies the EQ predicate on the "platform" field.
 func PlatformEQ(v utils.VideoPlatform) predicate.Vod {
 	vc := v
@@ -597,6 +687,81 @@ func TitleContainsFold(v string) predicate.Vod {
 	return predicate.Vod(sql.FieldContainsFold(FieldTitle, v))
 }
 
+// CategoryEQ applies the EQ predicate on the "category" field.
+func CategoryEQ(v string) predicate.Vod {
+	return predicate.Vod(sql.FieldEQ(FieldCategory, v))
+}
+
+// CategoryNEQ applies the NEQ predicate on the "category" field.
+func CategoryNEQ(v string) predicate.Vod {
+	return predicate.Vod(sql.FieldNEQ(FieldCategory, v))
+}
+
+// CategoryIn applies the In predicate on the "category" field.
+func CategoryIn(vs ...string) predicate.Vod {
+	return predicate.Vod(sql.FieldIn(FieldCategory, vs...))
+}
+
+// CategoryNotIn applies the NotIn predicate on the "category" field.
+func CategoryNotIn(vs ...string) predicate.Vod {
+	return predicate.Vod(sql.FieldNotIn(FieldCategory, vs...))
+}
+
+// CategoryGT applies the GT predicate on the "category" field.
+func CategoryGT(v string) predicate.Vod {
+	return predicate.Vod(sql.FieldGT(FieldCategory, v))
+}
+
+// CategoryGTE applies the GTE predicate on the "category" field.
+func CategoryGTE(v string) predicate.Vod {
+	return predicate.Vod(sql.FieldGTE(FieldCategory, v))
+}
+
+// CategoryLT applies the LT predicate on the "category" field.
+func CategoryLT(v string) predicate.Vod {
+	return predicate.Vod(sql.FieldLT(FieldCategory, v))
+}
+
+// CategoryLTE applies the LTE predicate on the "category" field.
+func CategoryLTE(v string) predicate.Vod {
+	return predicate.Vod(sql.FieldLTE(FieldCategory, v))
+}
+
+// CategoryContains applies the Contains predicate on the "category" field.
+func CategoryContains(v string) predicate.Vod {
+	return predicate.Vod(sql.FieldContains(FieldCategory, v))
+}
+
+// CategoryHasPrefix applies the HasPrefix predicate on the "category" field.
+func CategoryHasPrefix(v string) predicate.Vod {
+	return predicate.Vod(sql.FieldHasPrefix(FieldCategory, v))
+}
+
+// CategoryHasSuffix applies the HasSuffix predicate on the "category" field.
+func CategoryHasSuffix(v string) predicate.Vod {
+	return predicate.Vod(sql.FieldHasSuffix(FieldCategory, v))
+}
+
+// CategoryIsNil applies the IsNil predicate on the "category" field.
+func CategoryIsNil() predicate.Vod {
+	return predicate.Vod(sql.FieldIsNull(FieldCategory))
+}
+
+// CategoryNotNil applies the NotNil predicate on the "category" field.
+func CategoryNotNil() predicate.Vod {
+	return predicate.Vod(sql.FieldNotNull(FieldCategory))
+}
+
+// CategoryEqualFold applies the EqualFold predicate on the "category" field.
+func CategoryEqualFold(v string) predicate.Vod {
+	return predicate.Vod(sql.FieldEqualFold(FieldCategory, v))
+}
+
+// CategoryContainsFold applies the ContainsFold predicate on the "category" field.
+func CategoryContainsFold(v string) predicate.Vod {
+	return predicate.Vod(sql.FieldContainsFold(FieldCategory, v))
+}
+
 // DurationEQ applies the EQ predicate on the "duration" field.
 func DurationEQ(v int) predicate.Vod {
 	return predicate.Vod(sql.FieldEQ(FieldDuration, v))
@@ -687,6 +852,56 @@ func ClipVodOffsetNotNil() predicate.Vod {
 	return predicate.Vod(sql.FieldNotNull(FieldClipVodOffset))
 }
 
+// ClipVodIDEQ applies the EQ predicate on the "clip_vod_id" field.
+func ClipVodIDEQ(v uuid.UUID) predicate.Vod {
+	return predicate.Vod(sql.FieldEQ(FieldClipVodID, v))
+}
+
+// ClipVodIDNEQ applies the NEQ predicate on the "clip_vod_id" field.
+func ClipVodIDNEQ(v uuid.UUID) predicate.Vod {
+	return predicate.Vod(sql.FieldNEQ(FieldClipVodID, v))
+}
+
+// ClipVodIDIn applies the In predicate on the "clip_vod_id" field.
+func ClipVodIDIn(vs ...uuid.UUID) predicate.Vod {
+	return predicate.Vod(sql.FieldIn(FieldClipVodID, vs...))
+}
+
+// ClipVodIDNotIn applies the NotIn predicate on the "clip_vod_id" field.
+func ClipVodIDNotIn(vs ...uuid.UUID) predicate.Vod {
+	return predicate.Vod(sql.FieldNotIn(FieldClipVodID, vs...))
+}
+
+// ClipVodIDGT applies the GT predicate on the "clip_vod_id" field.
+func ClipVodIDGT(v uuid.UUID) predicate.Vod {
+	return predicate.Vod(sql.FieldGT(FieldClipVodID, v))
+}
+
+// ClipVodIDGTE applies the GTE predicate on the "clip_vod_id" field.
+func ClipVodIDGTE(v uuid.UUID) predicate.Vod {
+	return predicate.Vod(sql.FieldGTE(FieldClipVodID, v))
+}
+
+// ClipVodIDLT applies the LT predicate on the "clip_vod_id" field.
+func ClipVodIDLT(v uuid.UUID) predicate.Vod {
+	return predicate.Vod(sql.FieldLT(FieldClipVodID, v))
+}
+
+// ClipVodIDLTE applies the LTE predicate on the "clip_vod_id" field.
+func ClipVodIDLTE(v uuid.UUID) predicate.Vod {
+	return predicate.Vod(sql.FieldLTE(FieldClipVodID, v))
+}
+
+// ClipVodIDIsNil applies the IsNil predicate on the "clip_vod_id" field.
+func ClipVodIDIsNil() predicate.Vod {
+	return predicate.Vod(sql.FieldIsNull(FieldClipVodID))
+}
+
+// ClipVodIDNotNil applies the NotNil predicate on the "clip_vod_id" field.
+func ClipVodIDNotNil() predicate.Vod {
+	return predicate.Vod(sql.FieldNotNull(FieldClipVodID))
+}
+
 // ViewsEQ applies the EQ predicate on the "views" field.
 func ViewsEQ(v int) predicate.Vod {
 	return predicate.Vod(sql.FieldEQ(FieldViews, v))
@@ -802,6 +1017,26 @@ func ResolutionContainsFold(v string) predicate.Vod {
 	return predicate.Vod(sql.FieldContainsFold(FieldResolution, v))
 }
 
+// QualityFallbacksIsNil applies the IsNil predicate on the "quality_fallbacks" field.
+func QualityFallbacksIsNil() predicate.Vod {
+	return predicate.Vod(sql.FieldIsNull(FieldQualityFallbacks))
+}
+
+// QualityFallbacksNotNil applies the NotNil predicate on the "quality_fallbacks" field.
+func QualityFallbacksNotNil() predicate.Vod {
+	return predicate.Vod(sql.FieldNotNull(FieldQualityFallbacks))
+}
+
+// QualitySwitchesIsNil applies the IsNil predicate on the "quality_switches" field.
+func QualitySwitchesIsNil() predicate.Vod {
+	return predicate.Vod(sql.FieldIsNull(FieldQualitySwitches))
+}
+
+// QualitySwitchesNotNil applies the NotNil predicate on the "quality_switches" field.
+func QualitySwitchesNotNil() predicate.Vod {
+	return predicate.Vod(sql.FieldNotNull(FieldQualitySwitches))
+}
+
 // ProcessingEQ applies the EQ predicate on the "processing" field.
 func ProcessingEQ(v bool) predicate.Vod {
 	return predicate.Vod(sql.FieldEQ(FieldProcessing, v))
@@ -1542,6 +1777,81 @@ func CaptionPathContainsFold(v string) predicate.Vod {
 	return predicate.Vod(sql.FieldContainsFold(FieldCaptionPath, v))
 }
 
+// AudioPathEQ applies the EQ predicate on the "audio_path" field.
+func AudioPathEQ(v string) predicate.Vod {
+	return predicate.Vod(sql.FieldEQ(FieldAudioPath, v))
+}
+
+// AudioPathNEQ applies the NEQ predicate on the "audio_path" field.
+func AudioPathNEQ(v string) predicate.Vod {
+	return predicate.Vod(sql.FieldNEQ(FieldAudioPath, v))
+}
+
+// AudioPathIn applies the In predicate on the "audio_path" field.
+func AudioPathIn(vs ...string) predicate.Vod {
+	return predicate.Vod(sql.FieldIn(FieldAudioPath, vs...))
+}
+
+// AudioPathNotIn applies the NotIn predicate on the "audio_path" field.
+func AudioPathNotIn(vs ...string) predicate.Vod {
+	return predicate.Vod(sql.FieldNotIn(FieldAudioPath, vs...))
+}
+
+// AudioPathGT applies the GT predicate on the "audio_path" field.
+func AudioPathGT(v string) predicate.Vod {
+	return predicate.Vod(sql.FieldGT(FieldAudioPath, v))
+}
+
+// AudioPathGTE applies the GTE predicate on the "audio_path" field.
+func AudioPathGTE(v string) predicate.Vod {
+	return predicate.Vod(sql.FieldGTE(FieldAudioPath, v))
+}
+
+// AudioPathLT applies the LT predicate on the "audio_path" field.
+func AudioPathLT(v string) predicate.Vod {
+	return predicate.Vod(sql.FieldLT(FieldAudioPath, v))
+}
+
+// AudioPathLTE applies the LTE predicate on the "audio_path" field.
+func AudioPathLTE(v string) predicate.Vod {
+	return predicate.Vod(sql.FieldLTE(FieldAudioPath, v))
+}
+
+// AudioPathContains applies the Contains predicate on the "audio_path" field.
+func AudioPathContains(v string) predicate.Vod {
+	return predicate.Vod(sql.FieldContains(FieldAudioPath, v))
+}
+
+// AudioPathHasPrefix applies the HasPrefix predicate on the "audio_path" field.
+func AudioPathHasPrefix(v string) predicate.Vod {
+	return predicate.Vod(sql.FieldHasPrefix(FieldAudioPath, v))
+}
+
+// AudioPathHasSuffix applies the HasSuffix predicate on the "audio_path" field.
+func AudioPathHasSuffix(v string) predicate.Vod {
+	return predicate.Vod(sql.FieldHasSuffix(FieldAudioPath, v))
+}
+
+// AudioPathIsNil applies the IsNil predicate on the "audio_path" field.
+func AudioPathIsNil() predicate.Vod {
+	return predicate.Vod(sql.FieldIsNull(FieldAudioPath))
+}
+
+// AudioPathNotNil applies the NotNil predicate on the "audio_path" field.
+func AudioPathNotNil() predicate.Vod {
+	return predicate.Vod(sql.FieldNotNull(FieldAudioPath))
+}
+
+// AudioPathEqualFold applies the EqualFold predicate on the "audio_path" field.
+func AudioPathEqualFold(v string) predicate.Vod {
+	return predicate.Vod(sql.FieldEqualFold(FieldAudioPath, v))
+}
+
+// AudioPathContainsFold applies the ContainsFold predicate on the "audio_path" field.
+func AudioPathContainsFold(v string) predicate.Vod {
+	return predicate.Vod(sql.FieldContainsFold(FieldAudioPath, v))
+}
+
 // FolderNameEQ applies the EQ predicate on the "folder_name" field.
 func FolderNameEQ(v string) predicate.Vod {
 	return predicate.Vod(sql.FieldEQ(FieldFolderName, v))
@@ -2217,6 +2527,81 @@ func TmpVideoHlsPathContainsFold(v string) predicate.Vod {
 	return predicate.Vod(sql.FieldContainsFold(FieldTmpVideoHlsPath, v))
 }
 
+// TmpAudioPathEQ applies the EQ predicate on the "tmp_audio_path" field.
+func TmpAudioPathEQ(v string) predicate.Vod {
+	return predicate.Vod(sql.FieldEQ(FieldTmpAudioPath, v))
+}
+
+// TmpAudioPathNEQ applies the NEQ predicate on the "tmp_audio_path" field.
+func TmpAudioPathNEQ(v string) predicate.Vod {
+	return predicate.Vod(sql.FieldNEQ(FieldTmpAudioPath, v))
+}
+
+// TmpAudioPathIn applies the In predicate on the "tmp_audio_path" field.
+func TmpAudioPathIn(vs ...string) predicate.Vod {
+	return predicate.Vod(sql.FieldIn(FieldTmpAudioPath, vs...))
+}
+
+// TmpAudioPathNotIn applies the NotIn predicate on the "tmp_audio_path" field.
+func TmpAudioPathNotIn(vs ...string) predicate.Vod {
+	return predicate.Vod(sql.FieldNotIn(FieldTmpAudioPath, vs...))
+}
+
+// TmpAudioPathGT applies the GT predicate on the "tmp_audio_path" field.
+func TmpAudioPathGT(v string) predicate.Vod {
+	return predicate.Vod(sql.FieldGT(FieldTmpAudioPath, v))
+}
+
+// TmpAudioPathGTE applies the GTE predicate on the "tmp_audio_path" field.
+func TmpAudioPathGTE(v string) predicate.Vod {
+	return predicate.Vod(sql.FieldGTE(FieldTmpAudioPath, v))
+}
+
+// TmpAudioPathLT applies the LT predicate on the "tmp_audio_path" field.
+func TmpAudioPathLT(v string) predicate.Vod {
+	return predicate.Vod(sql.FieldLT(FieldTmpAudioPath, v))
+}
+
+// TmpAudioPathLTE applies the LTE predicate on the "tmp_audio_path" field.
+func TmpAudioPathLTE(v string) predicate.Vod {
+	return predicate.Vod(sql.FieldLTE(FieldTmpAudioPath, v))
+}
+
+// TmpAudioPathContains applies the Contains predicate on the "tmp_audio_path" field.
+func TmpAudioPathContains(v string) predicate.Vod {
+	return predicate.Vod(sql.FieldContains(FieldTmpAudioPath, v))
+}
+
+// TmpAudioPathHasPrefix applies the HasPrefix predicate on the "tmp_audio_path" field.
+func TmpAudioPathHasPrefix(v string) predicate.Vod {
+	return predicate.Vod(sql.FieldHasPrefix(FieldTmpAudioPath, v))
+}
+
+// TmpAudioPathHasSuffix applies the HasSuffix predicate on the "tmp_audio_path" field.
+func TmpAudioPathHasSuffix(v string) predicate.Vod {
+	return predicate.Vod(sql.FieldHasSuffix(FieldTmpAudioPath, v))
+}
+
+// TmpAudioPathIsNil applies the IsNil predicate on the "tmp_audio_path" field.
+func TmpAudioPathIsNil() predicate.Vod {
+	return predicate.Vod(sql.FieldIsNull(FieldTmpAudioPath))
+}
+
+// TmpAudioPathNotNil applies the NotNil predicate on the "tmp_audio_path" field.
+func TmpAudioPathNotNil() predicate.Vod {
+	return predicate.Vod(sql.FieldNotNull(FieldTmpAudioPath))
+}
+
+// TmpAudioPathEqualFold applies the EqualFold predicate on the "tmp_audio_path" field.
+func TmpAudioPathEqualFold(v string) predicate.Vod {
+	return predicate.Vod(sql.FieldEqualFold(FieldTmpAudioPath, v))
+}
+
+// TmpAudioPathContainsFold applies the ContainsFold predicate on the "tmp_audio_path" field.
+func TmpAudioPathContainsFold(v string) predicate.Vod {
+	return predicate.Vod(sql.FieldContainsFold(FieldTmpAudioPath, v))
+}
+
 // LockedEQ applies the EQ predicate on the "locked" field.
 func LockedEQ(v bool) predicate.Vod {
 	return predicate.Vod(sql.FieldEQ(FieldLocked, v))
@@ -2577,6 +2962,266 @@ func StorageSizeBytesLTE(v int64) predicate.Vod {
 	return predicate.Vod(sql.FieldLTE(FieldStorageSizeBytes, v))
 }
 
+// StorageTierEQ applies the EQ predicate on the "storage_tier" field.
+func StorageTierEQ(v utils.StorageTier) predicate.Vod {
+	vc := v
+	return predicate.Vod(sql.FieldEQ(FieldStorageTier, vc))
+}
+
+// StorageTierNEQ applies the NEQ predicate on the "storage_tier" field.
+func StorageTierNEQ(v utils.StorageTier) predicate.Vod {
+	vc := v
+	return predicate.Vod(sql.FieldNEQ(FieldStorageTier, vc))
+}
+
+// StorageTierIn applies the In predicate on the "storage_tier" field.
+func StorageTierIn(vs ...utils.StorageTier) predicate.Vod {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Vod(sql.FieldIn(FieldStorageTier, v...))
+}
+
+// StorageTierNotIn applies the NotIn predicate on the "storage_tier" field.
+func StorageTierNotIn(vs ...utils.StorageTier) predicate.Vod {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Vod(sql.FieldNotIn(FieldStorageTier, v...))
+}
+
+// HealthStatusEQ applies the EQ predicate on the "health_status" field.
+func HealthStatusEQ(v utils.VideoHealth) predicate.Vod {
+	vc := v
+	return predicate.Vod(sql.FieldEQ(FieldHealthStatus, vc))
+}
+
+// HealthStatusNEQ applies the NEQ predicate on the "health_status" field.
+func HealthStatusNEQ(v utils.VideoHealth) predicate.Vod {
+	vc := v
+	return predicate.Vod(sql.FieldNEQ(FieldHealthStatus, vc))
+}
+
+// HealthStatusIn applies the In predicate on the "health_status" field.
+func HealthStatusIn(vs ...utils.VideoHealth) predicate.Vod {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Vod(sql.FieldIn(FieldHealthStatus, v...))
+}
+
+// HealthStatusNotIn applies the NotIn predicate on the "health_status" field.
+func HealthStatusNotIn(vs ...utils.VideoHealth) predicate.Vod {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Vod(sql.FieldNotIn(FieldHealthStatus, v...))
+}
+
+// HealthIssuesIsNil applies the IsNil predicate on the "health_issues" field.
+func HealthIssuesIsNil() predicate.Vod {
+	return predicate.Vod(sql.FieldIsNull(FieldHealthIssues))
+}
+
+// HealthIssuesNotNil applies the NotNil predicate on the "health_issues" field.
+func HealthIssuesNotNil() predicate.Vod {
+	return predicate.Vod(sql.FieldNotNull(FieldHealthIssues))
+}
+
+// HealthCheckedAtEQ applies the EQ predicate on the "health_checked_at" field.
+func HealthCheckedAtEQ(v time.Time) predicate.Vod {
+	return predicate.Vod(sql.FieldEQ(FieldHealthCheckedAt, v))
+}
+
+// HealthCheckedAtNEQ applies the NEQ predicate on the "health_checked_at" field.
+func HealthCheckedAtNEQ(v time.Time) predicate.Vod {
+	return predicate.Vod(sql.FieldNEQ(FieldHealthCheckedAt, v))
+}
+
+// HealthCheckedAtIn applies the In predicate on the "health_checked_at" field.
+func HealthCheckedAtIn(vs ...time.Time) predicate.Vod {
+	return predicate.Vod(sql.FieldIn(FieldHealthCheckedAt, vs...))
+}
+
+// HealthCheckedAtNotIn applies the NotIn predicate on the "health_checked_at" field.
+func HealthCheckedAtNotIn(vs ...time.Time) predicate.Vod {
+	return predicate.Vod(sql.FieldNotIn(FieldHealthCheckedAt, vs...))
+}
+
+// HealthCheckedAtGT applies the GT predicate on the "health_checked_at" field.
+func HealthCheckedAtGT(v time.Time) predicate.Vod {
+	return predicate.Vod(sql.FieldGT(FieldHealthCheckedAt, v))
+}
+
+// HealthCheckedAtGTE applies the GTE predicate on the "health_checked_at" field.
+func HealthCheckedAtGTE(v time.Time) predicate.Vod {
+	return predicate.Vod(sql.FieldGTE(FieldHealthCheckedAt, v))
+}
+
+// HealthCheckedAtLT applies the LT predicate on the "health_checked_at" field.
+func HealthCheckedAtLT(v time.Time) predicate.Vod {
+	return predicate.Vod(sql.FieldLT(FieldHealthCheckedAt, v))
+}
+
+// HealthCheckedAtLTE applies the LTE predicate on the "health_checked_at" field.
+func HealthCheckedAtLTE(v time.Time) predicate.Vod {
+	return predicate.Vod(sql.FieldLTE(FieldHealthCheckedAt, v))
+}
+
+// HealthCheckedAtIsNil applies the IsNil predicate on the "health_checked_at" field.
+func HealthCheckedAtIsNil() predicate.Vod {
+	return predicate.Vod(sql.FieldIsNull(FieldHealthCheckedAt))
+}
+
+// HealthCheckedAtNotNil applies the NotNil predicate on the "health_checked_at" field.
+func HealthCheckedAtNotNil() predicate.Vod {
+	return predicate.Vod(sql.FieldNotNull(FieldHealthCheckedAt))
+}
+
+// AudioSourceIDEQ applies the EQ predicate on the "audio_source_id" field.
+func AudioSourceIDEQ(v uuid.UUID) predicate.Vod {
+	return predicate.Vod(sql.FieldEQ(FieldAudioSourceID, v))
+}
+
+// AudioSourceIDNEQ applies the NEQ predicate on the "audio_source_id" field.
+func AudioSourceIDNEQ(v uuid.UUID) predicate.Vod {
+	return predicate.Vod(sql.FieldNEQ(FieldAudioSourceID, v))
+}
+
+// AudioSourceIDIn applies the In predicate on the "audio_source_id" field.
+func AudioSourceIDIn(vs ...uuid.UUID) predicate.Vod {
+	return predicate.Vod(sql.FieldIn(FieldAudioSourceID, vs...))
+}
+
+// AudioSourceIDNotIn applies the NotIn predicate on the "audio_source_id" field.
+func AudioSourceIDNotIn(vs ...uuid.UUID) predicate.Vod {
+	return predicate.Vod(sql.FieldNotIn(FieldAudioSourceID, vs...))
+}
+
+// AudioSourceIDGT applies the GT predicate on the "audio_source_id" field.
+func AudioSourceIDGT(v uuid.UUID) predicate.Vod {
+	return predicate.Vod(sql.FieldGT(FieldAudioSourceID, v))
+}
+
+// AudioSourceIDGTE applies the GTE predicate on the "audio_source_id" field.
+func AudioSourceIDGTE(v uuid.UUID) predicate.Vod {
+	return predicate.Vod(sql.FieldGTE(FieldAudioSourceID, v))
+}
+
+// AudioSourceIDLT applies the LT predicate on the "audio_source_id" field.
+func AudioSourceIDLT(v uuid.UUID) predicate.Vod {
+	return predicate.Vod(sql.FieldLT(FieldAudioSourceID, v))
+}
+
+// AudioSourceIDLTE applies the LTE predicate on the "audio_source_id" field.
+func AudioSourceIDLTE(v uuid.UUID) predicate.Vod {
+	return predicate.Vod(sql.FieldLTE(FieldAudioSourceID, v))
+}
+
+// AudioSourceIDIsNil applies the IsNil predicate on the "audio_source_id" field.
+func AudioSourceIDIsNil() predicate.Vod {
+	return predicate.Vod(sql.FieldIsNull(FieldAudioSourceID))
+}
+
+// AudioSourceIDNotNil applies the NotNil predicate on the "audio_source_id" field.
+func AudioSourceIDNotNil() predicate.Vod {
+	return predicate.Vod(sql.FieldNotNull(FieldAudioSourceID))
+}
+
+// AudioSourceOffsetEQ applies the EQ predicate on the "audio_source_offset" field.
+func AudioSourceOffsetEQ(v float64) predicate.Vod {
+	return predicate.Vod(sql.FieldEQ(FieldAudioSourceOffset, v))
+}
+
+// AudioSourceOffsetNEQ applies the NEQ predicate on the "audio_source_offset" field.
+func AudioSourceOffsetNEQ(v float64) predicate.Vod {
+	return predicate.Vod(sql.FieldNEQ(FieldAudioSourceOffset, v))
+}
+
+// AudioSourceOffsetIn applies the In predicate on the "audio_source_offset" field.
+func AudioSourceOffsetIn(vs ...float64) predicate.Vod {
+	return predicate.Vod(sql.FieldIn(FieldAudioSourceOffset, vs...))
+}
+
+// AudioSourceOffsetNotIn applies the NotIn predicate on the "audio_source_offset" field.
+func AudioSourceOffsetNotIn(vs ...float64) predicate.Vod {
+	return predicate.Vod(sql.FieldNotIn(FieldAudioSourceOffset, vs...))
+}
+
+// AudioSourceOffsetGT applies the GT predicate on the "audio_source_offset" field.
+func AudioSourceOffsetGT(v float64) predicate.Vod {
+	return predicate.Vod(sql.FieldGT(FieldAudioSourceOffset, v))
+}
+
+// AudioSourceOffsetGTE applies the GTE predicate on the "audio_source_offset" field.
+func AudioSourceOffsetGTE(v float64) predicate.Vod {
+	return predicate.Vod(sql.FieldGTE(FieldAudioSourceOffset, v))
+}
+
+// AudioSourceOffsetLT applies the LT predicate on the "audio_source_offset" field.
+func AudioSourceOffsetLT(v float64) predicate.Vod {
+	return predicate.Vod(sql.FieldLT(FieldAudioSourceOffset, v))
+}
+
+// AudioSourceOffsetLTE applies the LTE predicate on the "audio_source_offset" field.
+func AudioSourceOffsetLTE(v float64) predicate.Vod {
+	return predicate.Vod(sql.FieldLTE(FieldAudioSourceOffset, v))
+}
+
+// AudioSourceOffsetIsNil applies the IsNil predicate on the "audio_source_offset" field.
+func AudioSourceOffsetIsNil() predicate.Vod {
+	return predicate.Vod(sql.FieldIsNull(FieldAudioSourceOffset))
+}
+
+// AudioSourceOffsetNotNil applies the NotNil predicate on the "audio_source_offset" field.
+func AudioSourceOffsetNotNil() predicate.Vod {
+	return predicate.Vod(sql.FieldNotNull(FieldAudioSourceOffset))
+}
+
+// TranscodingProfileIDEQ applies the EQ predicate on the "transcoding_profile_id" field.
+func TranscodingProfileIDEQ(v uuid.UUID) predicate.Vod {
+	return predicate.Vod(sql.FieldEQ(FieldTranscodingProfileID, v))
+}
+
+// TranscodingProfileIDNEQ applies the NEQ predicate on the "transcoding_profile_id" field.
+func TranscodingProfileIDNEQ(v uuid.UUID) predicate.Vod {
+	return predicate.Vod(sql.FieldNEQ(FieldTranscodingProfileID, v))
+}
+
+// TranscodingProfileIDIn applies the In predicate on the "transcoding_profile_id" field.
+func TranscodingProfileIDIn(vs ...uuid.UUID) predicate.Vod {
+	return predicate.Vod(sql.FieldIn(FieldTranscodingProfileID, vs...))
+}
+
+// TranscodingProfileIDNotIn applies the NotIn predicate on the "transcoding_profile_id" field.
+func TranscodingProfileIDNotIn(vs ...uuid.UUID) predicate.Vod {
+	return predicate.Vod(sql.FieldNotIn(FieldTranscodingProfileID, vs...))
+}
+
+// TranscodingProfileIDIsNil applies the IsNil predicate on the "transcoding_profile_id" field.
+func TranscodingProfileIDIsNil() predicate.Vod {
+	return predicate.Vod(sql.FieldIsNull(FieldTranscodingProfileID))
+}
+
+// TranscodingProfileIDNotNil applies the NotNil predicate on the "transcoding_profile_id" field.
+func TranscodingProfileIDNotNil() predicate.Vod {
+	return predicate.Vod(sql.FieldNotNull(FieldTranscodingProfileID))
+}
+
+// AuthoritativeEQ applies the EQ predicate on the "authoritative" field.
+func AuthoritativeEQ(v bool) predicate.Vod {
+	return predicate.Vod(sql.FieldEQ(FieldAuthoritative, v))
+}
+
+// AuthoritativeNEQ applies the NEQ predicate on the "authoritative" field.
+func AuthoritativeNEQ(v bool) predicate.Vod {
+	return predicate.Vod(sql.FieldNEQ(FieldAuthoritative, v))
+}
+
 // StreamedAtEQ applies the EQ predicate on the "streamed_at" field.
 func StreamedAtEQ(v time.Time) predicate.Vod {
 	return predicate.Vod(sql.FieldEQ(FieldStreamedAt, v))
@@ -2835,6 +3480,144 @@ func HasMultistreamInfoWith(preds ...predicate.MultistreamInfo) predicate.Vod {
 	})
 }
 
+// HasChatMessages applies the HasEdge predicate on the "chat_messages" edge.
+func HasChatMessages() predicate.Vod {
+	return predicate.Vod(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, ChatMessagesTable, ChatMessagesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasChatMessagesWith applies the HasEdge predicate on the "chat_messages" edge with a given conditions (other predicates).
+func HasChatMessagesWith(preds ...predicate.ChatMessage) predicate.Vod {
+	return predicate.Vod(func(s *sql.Selector) {
+		step := newChatMessagesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasChecksums applies the HasEdge predicate on the "checksums" edge.
+func HasChecksums() predicate.Vod {
+	return predicate.Vod(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, ChecksumsTable, ChecksumsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasChecksumsWith applies the HasEdge predicate on the "checksums" edge with a given conditions (other predicates).
+func HasChecksumsWith(preds ...predicate.Checksum) predicate.Vod {
+	return predicate.Vod(func(s *sql.Selector) {
+		step := newChecksumsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasLiveGaps applies the HasEdge predicate on the "live_gaps" edge.
+func HasLiveGaps() predicate.Vod {
+	return predicate.Vod(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, LiveGapsTable, LiveGapsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasLiveGapsWith applies the HasEdge predicate on the "live_gaps" edge with a given conditions (other predicates).
+func HasLiveGapsWith(preds ...predicate.LiveGap) predicate.Vod {
+	return predicate.Vod(func(s *sql.Selector) {
+		step := newLiveGapsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasViewingSessions applies the HasEdge predicate on the "viewing_sessions" edge.
+func HasViewingSessions() predicate.Vod {
+	return predicate.Vod(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, ViewingSessionsTable, ViewingSessionsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasViewingSessionsWith applies the HasEdge predicate on the "viewing_sessions" edge with a given conditions (other predicates).
+func HasViewingSessionsWith(preds ...predicate.ViewingSession) predicate.Vod {
+	return predicate.Vod(func(s *sql.Selector) {
+		step := newViewingSessionsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasUserListItems applies the HasEdge predicate on the "user_list_items" edge.
+func HasUserListItems() predicate.Vod {
+	return predicate.Vod(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, UserListItemsTable, UserListItemsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasUserListItemsWith applies the HasEdge predicate on the "user_list_items" edge with a given conditions (other predicates).
+func HasUserListItemsWith(preds ...predicate.UserListItem) predicate.Vod {
+	return predicate.Vod(func(s *sql.Selector) {
+		step := newUserListItemsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasTranscodingProfile applies the HasEdge predicate on the "transcoding_profile" edge.
+func HasTranscodingProfile() predicate.Vod {
+	return predicate.Vod(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, TranscodingProfileTable, TranscodingProfileColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasTranscodingProfileWith applies the HasEdge predicate on the "transcoding_profile" edge with a given conditions (other predicates).
+func HasTranscodingProfileWith(preds ...predicate.TranscodingProfile) predicate.Vod {
+	return predicate.Vod(func(s *sql.Selector) {
+		step := newTranscodingProfileStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.Vod) predicate.Vod {
 	return predicate.Vod(sql.AndPredicates(predicates...))
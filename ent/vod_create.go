@@ -15,10 +15,16 @@ import (
 	"github.com/google/uuid"
 	"github.com/zibbp/ganymede/ent/channel"
 	"github.com/zibbp/ganymede/ent/chapter"
+	"github.com/zibbp/ganymede/ent/chatmessage"
+	"github.com/zibbp/ganymede/ent/checksum"
+	"github.com/zibbp/ganymede/ent/livegap"
 	"github.com/zibbp/ganymede/ent/multistreaminfo"
 	"github.com/zibbp/ganymede/ent/mutedsegment"
 	"github.com/zibbp/ganymede/ent/playlist"
 	"github.com/zibbp/ganymede/ent/queue"
+	"github.com/zibbp/ganymede/ent/transcodingprofile"
+	"github.com/zibbp/ganymede/ent/userlistitem"
+	"github.com/zibbp/ganymede/ent/viewingsession"
 	"github.com/zibbp/ganymede/ent/vod"
 	"github.com/zibbp/ganymede/internal/utils"
 )
@@ -65,6 +71,20 @@ func (_c *VodCreate) SetNillableExtStreamID(v *string) *VodCreate {
 	return _c
 }
 
+// SetPart sets the "part" field.
+func (_c *VodCreate) SetPart(v int) *VodCreate {
+	_c.mutation.SetPart(v)
+	return _c
+}
+
+// SetNillablePart sets the "part" field if the given value is not nil.
+func (_c *VodCreate) SetNillablePart(v *int) *VodCreate {
+	if v != nil {
+		_c.SetPart(*v)
+	}
+	return _c
+}
+
 // SetPlatform sets the "platform" field.
 func (_c *VodCreate) SetPlatform(v utils.VideoPlatform) *VodCreate {
 	_c.mutation.SetPlatform(v)
@@ -99,6 +119,20 @@ func (_c *VodCreate) SetTitle(v string) *VodCreate {
 	return _c
 }
 
+// SetCategory sets the "category" field.
+func (_c *VodCreate) SetCategory(v string) *VodCreate {
+	_c.mutation.SetCategory(v)
+	return _c
+}
+
+// SetNillableCategory sets the "category" field if the given value is not nil.
+func (_c *VodCreate) SetNillableCategory(v *string) *VodCreate {
+	if v != nil {
+		_c.SetCategory(*v)
+	}
+	return _c
+}
+
 // SetDuration sets the "duration" field.
 func (_c *VodCreate) SetDuration(v int) *VodCreate {
 	_c.mutation.SetDuration(v)
@@ -127,6 +161,20 @@ func (_c *VodCreate) SetNillableClipVodOffset(v *int) *VodCreate {
 	return _c
 }
 
+// SetClipVodID sets the "clip_vod_id" field.
+func (_c *VodCreate) SetClipVodID(v uuid.UUID) *VodCreate {
+	_c.mutation.SetClipVodID(v)
+	return _c
+}
+
+// SetNillableClipVodID sets the "clip_vod_id" field if the given value is not nil.
+func (_c *VodCreate) SetNillableClipVodID(v *uuid.UUID) *VodCreate {
+	if v != nil {
+		_c.SetClipVodID(*v)
+	}
+	return _c
+}
+
 // SetViews sets the "views" field.
 func (_c *VodCreate) SetViews(v int) *VodCreate {
 	_c.mutation.SetViews(v)
@@ -155,6 +203,18 @@ func (_c *VodCreate) SetNillableResolution(v *string) *VodCreate {
 	return _c
 }
 
+// SetQualityFallbacks sets the "quality_fallbacks" field.
+func (_c *VodCreate) SetQualityFallbacks(v []string) *VodCreate {
+	_c.mutation.SetQualityFallbacks(v)
+	return _c
+}
+
+// SetQualitySwitches sets the "quality_switches" field.
+func (_c *VodCreate) SetQualitySwitches(v []utils.QualitySwitch) *VodCreate {
+	_c.mutation.SetQualitySwitches(v)
+	return _c
+}
+
 // SetProcessing sets the "processing" field.
 func (_c *VodCreate) SetProcessing(v bool) *VodCreate {
 	_c.mutation.SetProcessing(v)
@@ -293,6 +353,20 @@ func (_c *VodCreate) SetNillableCaptionPath(v *string) *VodCreate {
 	return _c
 }
 
+// SetAudioPath sets the "audio_path" field.
+func (_c *VodCreate) SetAudioPath(v string) *VodCreate {
+	_c.mutation.SetAudioPath(v)
+	return _c
+}
+
+// SetNillableAudioPath sets the "audio_path" field if the given value is not nil.
+func (_c *VodCreate) SetNillableAudioPath(v *string) *VodCreate {
+	if v != nil {
+		_c.SetAudioPath(*v)
+	}
+	return _c
+}
+
 // SetFolderName sets the "folder_name" field.
 func (_c *VodCreate) SetFolderName(v string) *VodCreate {
 	_c.mutation.SetFolderName(v)
@@ -419,6 +493,20 @@ func (_c *VodCreate) SetNillableTmpVideoHlsPath(v *string) *VodCreate {
 	return _c
 }
 
+// SetTmpAudioPath sets the "tmp_audio_path" field.
+func (_c *VodCreate) SetTmpAudioPath(v string) *VodCreate {
+	_c.mutation.SetTmpAudioPath(v)
+	return _c
+}
+
+// SetNillableTmpAudioPath sets the "tmp_audio_path" field if the given value is not nil.
+func (_c *VodCreate) SetNillableTmpAudioPath(v *string) *VodCreate {
+	if v != nil {
+		_c.SetTmpAudioPath(*v)
+	}
+	return _c
+}
+
 // SetLocked sets the "locked" field.
 func (_c *VodCreate) SetLocked(v bool) *VodCreate {
 	_c.mutation.SetLocked(v)
@@ -551,6 +639,110 @@ func (_c *VodCreate) SetNillableStorageSizeBytes(v *int64) *VodCreate {
 	return _c
 }
 
+// SetStorageTier sets the "storage_tier" field.
+func (_c *VodCreate) SetStorageTier(v utils.StorageTier) *VodCreate {
+	_c.mutation.SetStorageTier(v)
+	return _c
+}
+
+// SetNillableStorageTier sets the "storage_tier" field if the given value is not nil.
+func (_c *VodCreate) SetNillableStorageTier(v *utils.StorageTier) *VodCreate {
+	if v != nil {
+		_c.SetStorageTier(*v)
+	}
+	return _c
+}
+
+// SetHealthStatus sets the "health_status" field.
+func (_c *VodCreate) SetHealthStatus(v utils.VideoHealth) *VodCreate {
+	_c.mutation.SetHealthStatus(v)
+	return _c
+}
+
+// SetNillableHealthStatus sets the "health_status" field if the given value is not nil.
+func (_c *VodCreate) SetNillableHealthStatus(v *utils.VideoHealth) *VodCreate {
+	if v != nil {
+		_c.SetHealthStatus(*v)
+	}
+	return _c
+}
+
+// SetHealthIssues sets the "health_issues" field.
+func (_c *VodCreate) SetHealthIssues(v []utils.VideoHealthIssue) *VodCreate {
+	_c.mutation.SetHealthIssues(v)
+	return _c
+}
+
+// SetHealthCheckedAt sets the "health_checked_at" field.
+func (_c *VodCreate) SetHealthCheckedAt(v time.Time) *VodCreate {
+	_c.mutation.SetHealthCheckedAt(v)
+	return _c
+}
+
+// SetNillableHealthCheckedAt sets the "health_checked_at" field if the given value is not nil.
+func (_c *VodCreate) SetNillableHealthCheckedAt(v *time.Time) *VodCreate {
+	if v != nil {
+		_c.SetHealthCheckedAt(*v)
+	}
+	return _c
+}
+
+// SetAudioSourceID sets the "audio_source_id" field.
+func (_c *VodCreate) SetAudioSourceID(v uuid.UUID) *VodCreate {
+	_c.mutation.SetAudioSourceID(v)
+	return _c
+}
+
+// SetNillableAudioSourceID sets the "audio_source_id" field if the given value is not nil.
+func (_c *VodCreate) SetNillableAudioSourceID(v *uuid.UUID) *VodCreate {
+	if v != nil {
+		_c.SetAudioSourceID(*v)
+	}
+	return _c
+}
+
+// SetAudioSourceOffset sets the "audio_source_offset" field.
+func (_c *VodCreate) SetAudioSourceOffset(v float64) *VodCreate {
+	_c.mutation.SetAudioSourceOffset(v)
+	return _c
+}
+
+// SetNillableAudioSourceOffset sets the "audio_source_offset" field if the given value is not nil.
+func (_c *VodCreate) SetNillableAudioSourceOffset(v *float64) *VodCreate {
+	if v != nil {
+		_c.SetAudioSourceOffset(*v)
+	}
+	return _c
+}
+
+// SetTranscodingProfileID sets the "transcoding_profile_id" field.
+func (_c *VodCreate) SetTranscodingProfileID(v uuid.UUID) *VodCreate {
+	_c.mutation.SetTranscodingProfileID(v)
+	return _c
+}
+
+// SetNillableTranscodingProfileID sets the "transcoding_profile_id" field if the given value is not nil.
+func (_c *VodCreate) SetNillableTranscodingProfileID(v *uuid.UUID) *VodCreate {
+	if v != nil {
+		_c.SetTranscodingProfileID(*v)
+	}
+	return _c
+}
+
+// SetAuthoritative sets the "authoritative" field.
+func (_c *VodCreate) SetAuthoritative(v bool) *VodCreate {
+	_c.mutation.SetAuthoritative(v)
+	return _c
+}
+
+// SetNillableAuthoritative sets the "authoritative" field if the given value is not nil.
+func (_c *VodCreate) SetNillableAuthoritative(v *bool) *VodCreate {
+	if v != nil {
+		_c.SetAuthoritative(*v)
+	}
+	return _c
+}
+
 // SetStreamedAt sets the "streamed_at" field.
 func (_c *VodCreate) SetStreamedAt(v time.Time) *VodCreate {
 	_c.mutation.SetStreamedAt(v)
@@ -697,6 +889,86 @@ func (_c *VodCreate) AddMultistreamInfo(v ...*MultistreamInfo) *VodCreate {
 	return _c.AddMultistreamInfoIDs(ids...)
 }
 
+// AddChatMessageIDs adds the "chat_messages" edge to the ChatMessage entity by IDs.
+func (_c *VodCreate) AddChatMessageIDs(ids ...uuid.UUID) *VodCreate {
+	_c.mutation.AddChatMessageIDs(ids...)
+	return _c
+}
+
+// AddChatMessages adds the "chat_messages" edges to the ChatMessage entity.
+func (_c *VodCreate) AddChatMessages(v ...*ChatMessage) *VodCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddChatMessageIDs(ids...)
+}
+
+// AddChecksumIDs adds the "checksums" edge to the Checksum entity by IDs.
+func (_c *VodCreate) AddChecksumIDs(ids ...uuid.UUID) *VodCreate {
+	_c.mutation.AddChecksumIDs(ids...)
+	return _c
+}
+
+// AddChecksums adds the "checksums" edges to the Checksum entity.
+func (_c *VodCreate) AddChecksums(v ...*Checksum) *VodCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddChecksumIDs(ids...)
+}
+
+// AddLiveGapIDs adds the "live_gaps" edge to the LiveGap entity by IDs.
+func (_c *VodCreate) AddLiveGapIDs(ids ...uuid.UUID) *VodCreate {
+	_c.mutation.AddLiveGapIDs(ids...)
+	return _c
+}
+
+// AddLiveGaps adds the "live_gaps" edges to the LiveGap entity.
+func (_c *VodCreate) AddLiveGaps(v ...*LiveGap) *VodCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddLiveGapIDs(ids...)
+}
+
+// AddViewingSessionIDs adds the "viewing_sessions" edge to the ViewingSession entity by IDs.
+func (_c *VodCreate) AddViewingSessionIDs(ids ...uuid.UUID) *VodCreate {
+	_c.mutation.AddViewingSessionIDs(ids...)
+	return _c
+}
+
+// AddViewingSessions adds the "viewing_sessions" edges to the ViewingSession entity.
+func (_c *VodCreate) AddViewingSessions(v ...*ViewingSession) *VodCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddViewingSessionIDs(ids...)
+}
+
+// AddUserListItemIDs adds the "user_list_items" edge to the UserListItem entity by IDs.
+func (_c *VodCreate) AddUserListItemIDs(ids ...uuid.UUID) *VodCreate {
+	_c.mutation.AddUserListItemIDs(ids...)
+	return _c
+}
+
+// AddUserListItems adds the "user_list_items" edges to the UserListItem entity.
+func (_c *VodCreate) AddUserListItems(v ...*UserListItem) *VodCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddUserListItemIDs(ids...)
+}
+
+// SetTranscodingProfile sets the "transcoding_profile" edge to the TranscodingProfile entity.
+func (_c *VodCreate) SetTranscodingProfile(v *TranscodingProfile) *VodCreate {
+	return _c.SetTranscodingProfileID(v.ID)
+}
+
 // Mutation returns the VodMutation object of the builder.
 func (_c *VodCreate) Mutation() *VodMutation {
 	return _c.mutation
@@ -732,6 +1004,10 @@ func (_c *VodCreate) ExecX(ctx context.Context) {
 
 // defaults sets the default values of the builder before save.
 func (_c *VodCreate) defaults() {
+	if _, ok := _c.mutation.Part(); !ok {
+		v := vod.DefaultPart
+		_c.mutation.SetPart(v)
+	}
 	if _, ok := _c.mutation.Platform(); !ok {
 		v := vod.DefaultPlatform
 		_c.mutation.SetPlatform(v)
@@ -768,6 +1044,18 @@ func (_c *VodCreate) defaults() {
 		v := vod.DefaultStorageSizeBytes
 		_c.mutation.SetStorageSizeBytes(v)
 	}
+	if _, ok := _c.mutation.StorageTier(); !ok {
+		v := vod.DefaultStorageTier
+		_c.mutation.SetStorageTier(v)
+	}
+	if _, ok := _c.mutation.HealthStatus(); !ok {
+		v := vod.DefaultHealthStatus
+		_c.mutation.SetHealthStatus(v)
+	}
+	if _, ok := _c.mutation.Authoritative(); !ok {
+		v := vod.DefaultAuthoritative
+		_c.mutation.SetAuthoritative(v)
+	}
 	if _, ok := _c.mutation.StreamedAt(); !ok {
 		v := vod.DefaultStreamedAt()
 		_c.mutation.SetStreamedAt(v)
@@ -791,6 +1079,14 @@ func (_c *VodCreate) check() error {
 	if _, ok := _c.mutation.ExtID(); !ok {
 		return &ValidationError{Name: "ext_id", err: errors.New(`ent: missing required field "Vod.ext_id"`)}
 	}
+	if _, ok := _c.mutation.Part(); !ok {
+		return &ValidationError{Name: "part", err: errors.New(`ent: missing required field "Vod.part"`)}
+	}
+	if v, ok := _c.mutation.Part(); ok {
+		if err := vod.PartValidator(v); err != nil {
+			return &ValidationError{Name: "part", err: fmt.Errorf(`ent: validator failed for field "Vod.part": %w`, err)}
+		}
+	}
 	if _, ok := _c.mutation.Platform(); !ok {
 		return &ValidationError{Name: "platform", err: errors.New(`ent: missing required field "Vod.platform"`)}
 	}
@@ -837,6 +1133,25 @@ func (_c *VodCreate) check() error {
 	if _, ok := _c.mutation.StorageSizeBytes(); !ok {
 		return &ValidationError{Name: "storage_size_bytes", err: errors.New(`ent: missing required field "Vod.storage_size_bytes"`)}
 	}
+	if _, ok := _c.mutation.StorageTier(); !ok {
+		return &ValidationError{Name: "storage_tier", err: errors.New(`ent: missing required field "Vod.storage_tier"`)}
+	}
+	if v, ok := _c.mutation.StorageTier(); ok {
+		if err := vod.StorageTierValidator(v); err != nil {
+			return &ValidationError{Name: "storage_tier", err: fmt.Errorf(`ent: validator failed for field "Vod.storage_tier": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.HealthStatus(); !ok {
+		return &ValidationError{Name: "health_status", err: errors.New(`ent: missing required field "Vod.health_status"`)}
+	}
+	if v, ok := _c.mutation.HealthStatus(); ok {
+		if err := vod.HealthStatusValidator(v); err != nil {
+			return &ValidationError{Name: "health_status", err: fmt.Errorf(`ent: validator failed for field "Vod.health_status": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Authoritative(); !ok {
+		return &ValidationError{Name: "authoritative", err: errors.New(`ent: missing required field "Vod.authoritative"`)}
+	}
 	if _, ok := _c.mutation.StreamedAt(); !ok {
 		return &ValidationError{Name: "streamed_at", err: errors.New(`ent: missing required field "Vod.streamed_at"`)}
 	}
@@ -897,6 +1212,10 @@ func (_c *VodCreate) createSpec() (*Vod, *sqlgraph.CreateSpec) {
 		_spec.SetField(vod.FieldExtStreamID, field.TypeString, value)
 		_node.ExtStreamID = value
 	}
+	if value, ok := _c.mutation.Part(); ok {
+		_spec.SetField(vod.FieldPart, field.TypeInt, value)
+		_node.Part = value
+	}
 	if value, ok := _c.mutation.Platform(); ok {
 		_spec.SetField(vod.FieldPlatform, field.TypeEnum, value)
 		_node.Platform = value
@@ -909,6 +1228,10 @@ func (_c *VodCreate) createSpec() (*Vod, *sqlgraph.CreateSpec) {
 		_spec.SetField(vod.FieldTitle, field.TypeString, value)
 		_node.Title = value
 	}
+	if value, ok := _c.mutation.Category(); ok {
+		_spec.SetField(vod.FieldCategory, field.TypeString, value)
+		_node.Category = value
+	}
 	if value, ok := _c.mutation.Duration(); ok {
 		_spec.SetField(vod.FieldDuration, field.TypeInt, value)
 		_node.Duration = value
@@ -917,6 +1240,10 @@ func (_c *VodCreate) createSpec() (*Vod, *sqlgraph.CreateSpec) {
 		_spec.SetField(vod.FieldClipVodOffset, field.TypeInt, value)
 		_node.ClipVodOffset = value
 	}
+	if value, ok := _c.mutation.ClipVodID(); ok {
+		_spec.SetField(vod.FieldClipVodID, field.TypeUUID, value)
+		_node.ClipVodID = &value
+	}
 	if value, ok := _c.mutation.Views(); ok {
 		_spec.SetField(vod.FieldViews, field.TypeInt, value)
 		_node.Views = value
@@ -925,6 +1252,14 @@ func (_c *VodCreate) createSpec() (*Vod, *sqlgraph.CreateSpec) {
 		_spec.SetField(vod.FieldResolution, field.TypeString, value)
 		_node.Resolution = value
 	}
+	if value, ok := _c.mutation.QualityFallbacks(); ok {
+		_spec.SetField(vod.FieldQualityFallbacks, field.TypeJSON, value)
+		_node.QualityFallbacks = value
+	}
+	if value, ok := _c.mutation.QualitySwitches(); ok {
+		_spec.SetField(vod.FieldQualitySwitches, field.TypeJSON, value)
+		_node.QualitySwitches = value
+	}
 	if value, ok := _c.mutation.Processing(); ok {
 		_spec.SetField(vod.FieldProcessing, field.TypeBool, value)
 		_node.Processing = value
@@ -969,6 +1304,10 @@ func (_c *VodCreate) createSpec() (*Vod, *sqlgraph.CreateSpec) {
 		_spec.SetField(vod.FieldCaptionPath, field.TypeString, value)
 		_node.CaptionPath = value
 	}
+	if value, ok := _c.mutation.AudioPath(); ok {
+		_spec.SetField(vod.FieldAudioPath, field.TypeString, value)
+		_node.AudioPath = value
+	}
 	if value, ok := _c.mutation.FolderName(); ok {
 		_spec.SetField(vod.FieldFolderName, field.TypeString, value)
 		_node.FolderName = value
@@ -1005,6 +1344,10 @@ func (_c *VodCreate) createSpec() (*Vod, *sqlgraph.CreateSpec) {
 		_spec.SetField(vod.FieldTmpVideoHlsPath, field.TypeString, value)
 		_node.TmpVideoHlsPath = value
 	}
+	if value, ok := _c.mutation.TmpAudioPath(); ok {
+		_spec.SetField(vod.FieldTmpAudioPath, field.TypeString, value)
+		_node.TmpAudioPath = value
+	}
 	if value, ok := _c.mutation.Locked(); ok {
 		_spec.SetField(vod.FieldLocked, field.TypeBool, value)
 		_node.Locked = value
@@ -1045,6 +1388,34 @@ func (_c *VodCreate) createSpec() (*Vod, *sqlgraph.CreateSpec) {
 		_spec.SetField(vod.FieldStorageSizeBytes, field.TypeInt64, value)
 		_node.StorageSizeBytes = value
 	}
+	if value, ok := _c.mutation.StorageTier(); ok {
+		_spec.SetField(vod.FieldStorageTier, field.TypeEnum, value)
+		_node.StorageTier = value
+	}
+	if value, ok := _c.mutation.HealthStatus(); ok {
+		_spec.SetField(vod.FieldHealthStatus, field.TypeEnum, value)
+		_node.HealthStatus = value
+	}
+	if value, ok := _c.mutation.HealthIssues(); ok {
+		_spec.SetField(vod.FieldHealthIssues, field.TypeJSON, value)
+		_node.HealthIssues = value
+	}
+	if value, ok := _c.mutation.HealthCheckedAt(); ok {
+		_spec.SetField(vod.FieldHealthCheckedAt, field.TypeTime, value)
+		_node.HealthCheckedAt = &value
+	}
+	if value, ok := _c.mutation.AudioSourceID(); ok {
+		_spec.SetField(vod.FieldAudioSourceID, field.TypeUUID, value)
+		_node.AudioSourceID = &value
+	}
+	if value, ok := _c.mutation.AudioSourceOffset(); ok {
+		_spec.SetField(vod.FieldAudioSourceOffset, field.TypeFloat64, value)
+		_node.AudioSourceOffset = value
+	}
+	if value, ok := _c.mutation.Authoritative(); ok {
+		_spec.SetField(vod.FieldAuthoritative, field.TypeBool, value)
+		_node.Authoritative = value
+	}
 	if value, ok := _c.mutation.StreamedAt(); ok {
 		_spec.SetField(vod.FieldStreamedAt, field.TypeTime, value)
 		_node.StreamedAt = value
@@ -1154,6 +1525,103 @@ func (_c *VodCreate) createSpec() (*Vod, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := _c.mutation.ChatMessagesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   vod.ChatMessagesTable,
+			Columns: []string{vod.ChatMessagesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(chatmessage.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.ChecksumsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   vod.ChecksumsTable,
+			Columns: []string{vod.ChecksumsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(checksum.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.LiveGapsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   vod.LiveGapsTable,
+			Columns: []string{vod.LiveGapsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(livegap.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.ViewingSessionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   vod.ViewingSessionsTable,
+			Columns: []string{vod.ViewingSessionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(viewingsession.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.UserListItemsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   vod.UserListItemsTable,
+			Columns: []string{vod.UserListItemsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(userlistitem.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.TranscodingProfileIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   vod.TranscodingProfileTable,
+			Columns: []string{vod.TranscodingProfileColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(transcodingprofile.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.TranscodingProfileID = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
@@ -1254,13 +1722,31 @@ func (u *VodUpsert) ClearExtStreamID() *VodUpsert {
 	return u
 }
 
-// SetPlatform sets the "platform" field.
-func (u *VodUpsert) SetPlatform(v utils.VideoPlatform) *VodUpsert {
-	u.Set(vod.FieldPlatform, v)
+// SetPart sets the "part" field.
+func (u *VodUpsert) SetPart(v int) *VodUpsert {
+	u.Set(vod.FieldPart, v)
 	return u
 }
 
-// UpdatePlatform sets the "platform" field to the value that was provided on create.
+// UpdatePart sets the "part" field to the value that was provided on create.
+func (u *VodUpsert) UpdatePart() *VodUpsert {
+	u.SetExcluded(vod.FieldPart)
+	return u
+}
+
+// AddPart adds v to the "part" field.
+func (u *VodUpsert) AddPart(v int) *VodUpsert {
+	u.Add(vod.FieldPart, v)
+	return u
+}
+
+// SetPlatform sets the "platform" field.
+func (u *VodUpsert) SetPlatform(v utils.VideoPlatform) *VodUpsert {
+	u.Set(vod.FieldPlatform, v)
+	return u
+}
+
+// UpdatePlatform sets the "platform" field to the value that was provided on create.
 func (u *VodUpsert) UpdatePlatform() *VodUpsert {
 	u.SetExcluded(vod.FieldPlatform)
 	return u
@@ -1290,6 +1776,24 @@ func (u *VodUpsert) UpdateTitle() *VodUpsert {
 	return u
 }
 
+// SetCategory sets the "category" field.
+func (u *VodUpsert) SetCategory(v string) *VodUpsert {
+	u.Set(vod.FieldCategory, v)
+	return u
+}
+
+// UpdateCategory sets the "category" field to the value that was provided on create.
+func (u *VodUpsert) UpdateCategory() *VodUpsert {
+	u.SetExcluded(vod.FieldCategory)
+	return u
+}
+
+// ClearCategory clears the value of the "category" field.
+func (u *VodUpsert) ClearCategory() *VodUpsert {
+	u.SetNull(vod.FieldCategory)
+	return u
+}
+
 // SetDuration sets the "duration" field.
 func (u *VodUpsert) SetDuration(v int) *VodUpsert {
 	u.Set(vod.FieldDuration, v)
@@ -1332,6 +1836,24 @@ func (u *VodUpsert) ClearClipVodOffset() *VodUpsert {
 	return u
 }
 
+// SetClipVodID sets the "clip_vod_id" field.
+func (u *VodUpsert) SetClipVodID(v uuid.UUID) *VodUpsert {
+	u.Set(vod.FieldClipVodID, v)
+	return u
+}
+
+// UpdateClipVodID sets the "clip_vod_id" field to the value that was provided on create.
+func (u *VodUpsert) UpdateClipVodID() *VodUpsert {
+	u.SetExcluded(vod.FieldClipVodID)
+	return u
+}
+
+// ClearClipVodID clears the value of the "clip_vod_id" field.
+func (u *VodUpsert) ClearClipVodID() *VodUpsert {
+	u.SetNull(vod.FieldClipVodID)
+	return u
+}
+
 // SetViews sets the "views" field.
 func (u *VodUpsert) SetViews(v int) *VodUpsert {
 	u.Set(vod.FieldViews, v)
@@ -1368,6 +1890,42 @@ func (u *VodUpsert) ClearResolution() *VodUpsert {
 	return u
 }
 
+// SetQualityFallbacks sets the "quality_fallbacks" field.
+func (u *VodUpsert) SetQualityFallbacks(v []string) *VodUpsert {
+	u.Set(vod.FieldQualityFallbacks, v)
+	return u
+}
+
+// UpdateQualityFallbacks sets the "quality_fallbacks" field to the value that was provided on create.
+func (u *VodUpsert) UpdateQualityFallbacks() *VodUpsert {
+	u.SetExcluded(vod.FieldQualityFallbacks)
+	return u
+}
+
+// ClearQualityFallbacks clears the value of the "quality_fallbacks" field.
+func (u *VodUpsert) ClearQualityFallbacks() *VodUpsert {
+	u.SetNull(vod.FieldQualityFallbacks)
+	return u
+}
+
+// SetQualitySwitches sets the "quality_switches" field.
+func (u *VodUpsert) SetQualitySwitches(v []utils.QualitySwitch) *VodUpsert {
+	u.Set(vod.FieldQualitySwitches, v)
+	return u
+}
+
+// UpdateQualitySwitches sets the "quality_switches" field to the value that was provided on create.
+func (u *VodUpsert) UpdateQualitySwitches() *VodUpsert {
+	u.SetExcluded(vod.FieldQualitySwitches)
+	return u
+}
+
+// ClearQualitySwitches clears the value of the "quality_switches" field.
+func (u *VodUpsert) ClearQualitySwitches() *VodUpsert {
+	u.SetNull(vod.FieldQualitySwitches)
+	return u
+}
+
 // SetProcessing sets the "processing" field.
 func (u *VodUpsert) SetProcessing(v bool) *VodUpsert {
 	u.Set(vod.FieldProcessing, v)
@@ -1548,6 +2106,24 @@ func (u *VodUpsert) ClearCaptionPath() *VodUpsert {
 	return u
 }
 
+// SetAudioPath sets the "audio_path" field.
+func (u *VodUpsert) SetAudioPath(v string) *VodUpsert {
+	u.Set(vod.FieldAudioPath, v)
+	return u
+}
+
+// UpdateAudioPath sets the "audio_path" field to the value that was provided on create.
+func (u *VodUpsert) UpdateAudioPath() *VodUpsert {
+	u.SetExcluded(vod.FieldAudioPath)
+	return u
+}
+
+// ClearAudioPath clears the value of the "audio_path" field.
+func (u *VodUpsert) ClearAudioPath() *VodUpsert {
+	u.SetNull(vod.FieldAudioPath)
+	return u
+}
+
 // SetFolderName sets the "folder_name" field.
 func (u *VodUpsert) SetFolderName(v string) *VodUpsert {
 	u.Set(vod.FieldFolderName, v)
@@ -1710,6 +2286,24 @@ func (u *VodUpsert) ClearTmpVideoHlsPath() *VodUpsert {
 	return u
 }
 
+// SetTmpAudioPath sets the "tmp_audio_path" field.
+func (u *VodUpsert) SetTmpAudioPath(v string) *VodUpsert {
+	u.Set(vod.FieldTmpAudioPath, v)
+	return u
+}
+
+// UpdateTmpAudioPath sets the "tmp_audio_path" field to the value that was provided on create.
+func (u *VodUpsert) UpdateTmpAudioPath() *VodUpsert {
+	u.SetExcluded(vod.FieldTmpAudioPath)
+	return u
+}
+
+// ClearTmpAudioPath clears the value of the "tmp_audio_path" field.
+func (u *VodUpsert) ClearTmpAudioPath() *VodUpsert {
+	u.SetNull(vod.FieldTmpAudioPath)
+	return u
+}
+
 // SetLocked sets the "locked" field.
 func (u *VodUpsert) SetLocked(v bool) *VodUpsert {
 	u.Set(vod.FieldLocked, v)
@@ -1908,6 +2502,138 @@ func (u *VodUpsert) AddStorageSizeBytes(v int64) *VodUpsert {
 	return u
 }
 
+// SetStorageTier sets the "storage_tier" field.
+func (u *VodUpsert) SetStorageTier(v utils.StorageTier) *VodUpsert {
+	u.Set(vod.FieldStorageTier, v)
+	return u
+}
+
+// UpdateStorageTier sets the "storage_tier" field to the value that was provided on create.
+func (u *VodUpsert) UpdateStorageTier() *VodUpsert {
+	u.SetExcluded(vod.FieldStorageTier)
+	return u
+}
+
+// SetHealthStatus sets the "health_status" field.
+func (u *VodUpsert) SetHealthStatus(v utils.VideoHealth) *VodUpsert {
+	u.Set(vod.FieldHealthStatus, v)
+	return u
+}
+
+// UpdateHealthStatus sets the "health_status" field to the value that was provided on create.
+func (u *VodUpsert) UpdateHealthStatus() *VodUpsert {
+	u.SetExcluded(vod.FieldHealthStatus)
+	return u
+}
+
+// SetHealthIssues sets the "health_issues" field.
+func (u *VodUpsert) SetHealthIssues(v []utils.VideoHealthIssue) *VodUpsert {
+	u.Set(vod.FieldHealthIssues, v)
+	return u
+}
+
+// UpdateHealthIssues sets the "health_issues" field to the value that was provided on create.
+func (u *VodUpsert) UpdateHealthIssues() *VodUpsert {
+	u.SetExcluded(vod.FieldHealthIssues)
+	return u
+}
+
+// ClearHealthIssues clears the value of the "health_issues" field.
+func (u *VodUpsert) ClearHealthIssues() *VodUpsert {
+	u.SetNull(vod.FieldHealthIssues)
+	return u
+}
+
+// SetHealthCheckedAt sets the "health_checked_at" field.
+func (u *VodUpsert) SetHealthCheckedAt(v time.Time) *VodUpsert {
+	u.Set(vod.FieldHealthCheckedAt, v)
+	return u
+}
+
+// UpdateHealthCheckedAt sets the "health_checked_at" field to the value that was provided on create.
+func (u *VodUpsert) UpdateHealthCheckedAt() *VodUpsert {
+	u.SetExcluded(vod.FieldHealthCheckedAt)
+	return u
+}
+
+// ClearHealthCheckedAt clears the value of the "health_checked_at" field.
+func (u *VodUpsert) ClearHealthCheckedAt() *VodUpsert {
+	u.SetNull(vod.FieldHealthCheckedAt)
+	return u
+}
+
+// SetAudioSourceID sets the "audio_source_id" field.
+func (u *VodUpsert) SetAudioSourceID(v uuid.UUID) *VodUpsert {
+	u.Set(vod.FieldAudioSourceID, v)
+	return u
+}
+
+// UpdateAudioSourceID sets the "audio_source_id" field to the value that was provided on create.
+func (u *VodUpsert) UpdateAudioSourceID() *VodUpsert {
+	u.SetExcluded(vod.FieldAudioSourceID)
+	return u
+}
+
+// ClearAudioSourceID clears the value of the "audio_source_id" field.
+func (u *VodUpsert) ClearAudioSourceID() *VodUpsert {
+	u.SetNull(vod.FieldAudioSourceID)
+	return u
+}
+
+// SetAudioSourceOffset sets the "audio_source_offset" field.
+func (u *VodUpsert) SetAudioSourceOffset(v float64) *VodUpsert {
+	u.Set(vod.FieldAudioSourceOffset, v)
+	return u
+}
+
+// UpdateAudioSourceOffset sets the "audio_source_offset" field to the value that was provided on create.
+func (u *VodUpsert) UpdateAudioSourceOffset() *VodUpsert {
+	u.SetExcluded(vod.FieldAudioSourceOffset)
+	return u
+}
+
+// AddAudioSourceOffset adds v to the "audio_source_offset" field.
+func (u *VodUpsert) AddAudioSourceOffset(v float64) *VodUpsert {
+	u.Add(vod.FieldAudioSourceOffset, v)
+	return u
+}
+
+// ClearAudioSourceOffset clears the value of the "audio_source_offset" field.
+func (u *VodUpsert) ClearAudioSourceOffset() *VodUpsert {
+	u.SetNull(vod.FieldAudioSourceOffset)
+	return u
+}
+
+// SetTranscodingProfileID sets the "transcoding_profile_id" field.
+func (u *VodUpsert) SetTranscodingProfileID(v uuid.UUID) *VodUpsert {
+	u.Set(vod.FieldTranscodingProfileID, v)
+	return u
+}
+
+// UpdateTranscodingProfileID sets the "transcoding_profile_id" field to the value that was provided on create.
+func (u *VodUpsert) UpdateTranscodingProfileID() *VodUpsert {
+	u.SetExcluded(vod.FieldTranscodingProfileID)
+	return u
+}
+
+// ClearTranscodingProfileID clears the value of the "transcoding_profile_id" field.
+func (u *VodUpsert) ClearTranscodingProfileID() *VodUpsert {
+	u.SetNull(vod.FieldTranscodingProfileID)
+	return u
+}
+
+// SetAuthoritative sets the "authoritative" field.
+func (u *VodUpsert) SetAuthoritative(v bool) *VodUpsert {
+	u.Set(vod.FieldAuthoritative, v)
+	return u
+}
+
+// UpdateAuthoritative sets the "authoritative" field to the value that was provided on create.
+func (u *VodUpsert) UpdateAuthoritative() *VodUpsert {
+	u.SetExcluded(vod.FieldAuthoritative)
+	return u
+}
+
 // SetStreamedAt sets the "streamed_at" field.
 func (u *VodUpsert) SetStreamedAt(v time.Time) *VodUpsert {
 	u.Set(vod.FieldStreamedAt, v)
@@ -2039,6 +2765,27 @@ func (u *VodUpsertOne) ClearExtStreamID() *VodUpsertOne {
 	})
 }
 
+// SetPart sets the "part" field.
+func (u *VodUpsertOne) SetPart(v int) *VodUpsertOne {
+	return u.Update(func(s *VodUpsert) {
+		s.SetPart(v)
+	})
+}
+
+// AddPart adds v to the "part" field.
+func (u *VodUpsertOne) AddPart(v int) *VodUpsertOne {
+	return u.Update(func(s *VodUpsert) {
+		s.AddPart(v)
+	})
+}
+
+// UpdatePart sets the "part" field to the value that was provided on create.
+func (u *VodUpsertOne) UpdatePart() *VodUpsertOne {
+	return u.Update(func(s *VodUpsert) {
+		s.UpdatePart()
+	})
+}
+
 // SetPlatform sets the "platform" field.
 func (u *VodUpsertOne) SetPlatform(v utils.VideoPlatform) *VodUpsertOne {
 	return u.Update(func(s *VodUpsert) {
@@ -2081,6 +2828,27 @@ func (u *VodUpsertOne) UpdateTitle() *VodUpsertOne {
 	})
 }
 
+// SetCategory sets the "category" field.
+func (u *VodUpsertOne) SetCategory(v string) *VodUpsertOne {
+	return u.Update(func(s *VodUpsert) {
+		s.SetCategory(v)
+	})
+}
+
+// UpdateCategory sets the "category" field to the value that was provided on create.
+func (u *VodUpsertOne) UpdateCategory() *VodUpsertOne {
+	return u.Update(func(s *VodUpsert) {
+		s.UpdateCategory()
+	})
+}
+
+// ClearCategory clears the value of the "category" field.
+func (u *VodUpsertOne) ClearCategory() *VodUpsertOne {
+	return u.Update(func(s *VodUpsert) {
+		s.ClearCategory()
+	})
+}
+
 // SetDuration sets the "duration" field.
 func (u *VodUpsertOne) SetDuration(v int) *VodUpsertOne {
 	return u.Update(func(s *VodUpsert) {
@@ -2130,6 +2898,27 @@ func (u *VodUpsertOne) ClearClipVodOffset() *VodUpsertOne {
 	})
 }
 
+// SetClipVodID sets the "clip_vod_id" field.
+func (u *VodUpsertOne) SetClipVodID(v uuid.UUID) *VodUpsertOne {
+	return u.Update(func(s *VodUpsert) {
+		s.SetClipVodID(v)
+	})
+}
+
+// UpdateClipVodID sets the "clip_vod_id" field to the value that was provided on create.
+func (u *VodUpsertOne) UpdateClipVodID() *VodUpsertOne {
+	return u.Update(func(s *VodUpsert) {
+		s.UpdateClipVodID()
+	})
+}
+
+// ClearClipVodID clears the value of the "clip_vod_id" field.
+func (u *VodUpsertOne) ClearClipVodID() *VodUpsertOne {
+	return u.Update(func(s *VodUpsert) {
+		s.ClearClipVodID()
+	})
+}
+
 // SetViews sets the "views" field.
 func (u *VodUpsertOne) SetViews(v int) *VodUpsertOne {
 	return u.Update(func(s *VodUpsert) {
@@ -2172,6 +2961,48 @@ func (u *VodUpsertOne) ClearResolution() *VodUpsertOne {
 	})
 }
 
+// SetQualityFallbacks sets the "quality_fallbacks" field.
+func (u *VodUpsertOne) SetQualityFallbacks(v []string) *VodUpsertOne {
+	return u.Update(func(s *VodUpsert) {
+		s.SetQualityFallbacks(v)
+	})
+}
+
+// UpdateQualityFallbacks sets the "quality_fallbacks" field to the value that was provided on create.
+func (u *VodUpsertOne) UpdateQualityFallbacks() *VodUpsertOne {
+	return u.Update(func(s *VodUpsert) {
+		s.UpdateQualityFallbacks()
+	})
+}
+
+// ClearQualityFallbacks clears the value of the "quality_fallbacks" field.
+func (u *VodUpsertOne) ClearQualityFallbacks() *VodUpsertOne {
+	return u.Update(func(s *VodUpsert) {
+		s.ClearQualityFallbacks()
+	})
+}
+
+// SetQualitySwitches sets the "quality_switches" field.
+func (u *VodUpsertOne) SetQualitySwitches(v []utils.QualitySwitch) *VodUpsertOne {
+	return u.Update(func(s *VodUpsert) {
+		s.SetQualitySwitches(v)
+	})
+}
+
+// UpdateQualitySwitches sets the "quality_switches" field to the value that was provided on create.
+func (u *VodUpsertOne) UpdateQualitySwitches() *VodUpsertOne {
+	return u.Update(func(s *VodUpsert) {
+		s.UpdateQualitySwitches()
+	})
+}
+
+// ClearQualitySwitches clears the value of the "quality_switches" field.
+func (u *VodUpsertOne) ClearQualitySwitches() *VodUpsertOne {
+	return u.Update(func(s *VodUpsert) {
+		s.ClearQualitySwitches()
+	})
+}
+
 // SetProcessing sets the "processing" field.
 func (u *VodUpsertOne) SetProcessing(v bool) *VodUpsertOne {
 	return u.Update(func(s *VodUpsert) {
@@ -2382,6 +3213,27 @@ func (u *VodUpsertOne) ClearCaptionPath() *VodUpsertOne {
 	})
 }
 
+// SetAudioPath sets the "audio_path" field.
+func (u *VodUpsertOne) SetAudioPath(v string) *VodUpsertOne {
+	return u.Update(func(s *VodUpsert) {
+		s.SetAudioPath(v)
+	})
+}
+
+// UpdateAudioPath sets the "audio_path" field to the value that was provided on create.
+func (u *VodUpsertOne) UpdateAudioPath() *VodUpsertOne {
+	return u.Update(func(s *VodUpsert) {
+		s.UpdateAudioPath()
+	})
+}
+
+// ClearAudioPath clears the value of the "audio_path" field.
+func (u *VodUpsertOne) ClearAudioPath() *VodUpsertOne {
+	return u.Update(func(s *VodUpsert) {
+		s.ClearAudioPath()
+	})
+}
+
 // SetFolderName sets the "folder_name" field.
 func (u *VodUpsertOne) SetFolderName(v string) *VodUpsertOne {
 	return u.Update(func(s *VodUpsert) {
@@ -2571,6 +3423,27 @@ func (u *VodUpsertOne) ClearTmpVideoHlsPath() *VodUpsertOne {
 	})
 }
 
+// SetTmpAudioPath sets the "tmp_audio_path" field.
+func (u *VodUpsertOne) SetTmpAudioPath(v string) *VodUpsertOne {
+	return u.Update(func(s *VodUpsert) {
+		s.SetTmpAudioPath(v)
+	})
+}
+
+// UpdateTmpAudioPath sets the "tmp_audio_path" field to the value that was provided on create.
+func (u *VodUpsertOne) UpdateTmpAudioPath() *VodUpsertOne {
+	return u.Update(func(s *VodUpsert) {
+		s.UpdateTmpAudioPath()
+	})
+}
+
+// ClearTmpAudioPath clears the value of the "tmp_audio_path" field.
+func (u *VodUpsertOne) ClearTmpAudioPath() *VodUpsertOne {
+	return u.Update(func(s *VodUpsert) {
+		s.ClearTmpAudioPath()
+	})
+}
+
 // SetLocked sets the "locked" field.
 func (u *VodUpsertOne) SetLocked(v bool) *VodUpsertOne {
 	return u.Update(func(s *VodUpsert) {
@@ -2802,6 +3675,160 @@ func (u *VodUpsertOne) UpdateStorageSizeBytes() *VodUpsertOne {
 	})
 }
 
+// SetStorageTier sets the "storage_tier" field.
+func (u *VodUpsertOne) SetStorageTier(v utils.StorageTier) *VodUpsertOne {
+	return u.Update(func(s *VodUpsert) {
+		s.SetStorageTier(v)
+	})
+}
+
+// UpdateStorageTier sets the "storage_tier" field to the value that was provided on create.
+func (u *VodUpsertOne) UpdateStorageTier() *VodUpsertOne {
+	return u.Update(func(s *VodUpsert) {
+		s.UpdateStorageTier()
+	})
+}
+
+// SetHealthStatus sets the "health_status" field.
+func (u *VodUpsertOne) SetHealthStatus(v utils.VideoHealth) *VodUpsertOne {
+	return u.Update(func(s *VodUpsert) {
+		s.SetHealthStatus(v)
+	})
+}
+
+// UpdateHealthStatus sets the "health_status" field to the value that was provided on create.
+func (u *VodUpsertOne) UpdateHealthStatus() *VodUpsertOne {
+	return u.Update(func(s *VodUpsert) {
+		s.UpdateHealthStatus()
+	})
+}
+
+// SetHealthIssues sets the "health_issues" field.
+func (u *VodUpsertOne) SetHealthIssues(v []utils.VideoHealthIssue) *VodUpsertOne {
+	return u.Update(func(s *VodUpsert) {
+		s.SetHealthIssues(v)
+	})
+}
+
+// UpdateHealthIssues sets the "health_issues" field to the value that was provided on create.
+func (u *VodUpsertOne) UpdateHealthIssues() *VodUpsertOne {
+	return u.Update(func(s *VodUpsert) {
+		s.UpdateHealthIssues()
+	})
+}
+
+// ClearHealthIssues clears the value of the "health_issues" field.
+func (u *VodUpsertOne) ClearHealthIssues() *VodUpsertOne {
+	return u.Update(func(s *VodUpsert) {
+		s.ClearHealthIssues()
+	})
+}
+
+// SetHealthCheckedAt sets the "health_checked_at" field.
+func (u *VodUpsertOne) SetHealthCheckedAt(v time.Time) *VodUpsertOne {
+	return u.Update(func(s *VodUpsert) {
+		s.SetHealthCheckedAt(v)
+	})
+}
+
+// UpdateHealthCheckedAt sets the "health_checked_at" field to the value that was provided on create.
+func (u *VodUpsertOne) UpdateHealthCheckedAt() *VodUpsertOne {
+	return u.Update(func(s *VodUpsert) {
+		s.UpdateHealthCheckedAt()
+	})
+}
+
+// ClearHealthCheckedAt clears the value of the "health_checked_at" field.
+func (u *VodUpsertOne) ClearHealthCheckedAt() *VodUpsertOne {
+	return u.Update(func(s *VodUpsert) {
+		s.ClearHealthCheckedAt()
+	})
+}
+
+// SetAudioSourceID sets the "audio_source_id" field.
+func (u *VodUpsertOne) SetAudioSourceID(v uuid.UUID) *VodUpsertOne {
+	return u.Update(func(s *VodUpsert) {
+		s.SetAudioSourceID(v)
+	})
+}
+
+// UpdateAudioSourceID sets the "audio_source_id" field to the value that was provided on create.
+func (u *VodUpsertOne) UpdateAudioSourceID() *VodUpsertOne {
+	return u.Update(func(s *VodUpsert) {
+		s.UpdateAudioSourceID()
+	})
+}
+
+// ClearAudioSourceID clears the value of the "audio_source_id" field.
+func (u *VodUpsertOne) ClearAudioSourceID() *VodUpsertOne {
+	return u.Update(func(s *VodUpsert) {
+		s.ClearAudioSourceID()
+	})
+}
+
+// SetAudioSourceOffset sets the "audio_source_offset" field.
+func (u *VodUpsertOne) SetAudioSourceOffset(v float64) *VodUpsertOne {
+	return u.Update(func(s *VodUpsert) {
+		s.SetAudioSourceOffset(v)
+	})
+}
+
+// AddAudioSourceOffset adds v to the "audio_source_offset" field.
+func (u *VodUpsertOne) AddAudioSourceOffset(v float64) *VodUpsertOne {
+	return u.Update(func(s *VodUpsert) {
+		s.AddAudioSourceOffset(v)
+	})
+}
+
+// UpdateAudioSourceOffset sets the "audio_source_offset" field to the value that was provided on create.
+func (u *VodUpsertOne) UpdateAudioSourceOffset() *VodUpsertOne {
+	return u.Update(func(s *VodUpsert) {
+		s.UpdateAudioSourceOffset()
+	})
+}
+
+// ClearAudioSourceOffset clears the value of the "audio_source_offset" field.
+func (u *VodUpsertOne) ClearAudioSourceOffset() *VodUpsertOne {
+	return u.Update(func(s *VodUpsert) {
+		s.ClearAudioSourceOffset()
+	})
+}
+
+// SetTranscodingProfileID sets the "transcoding_profile_id" field.
+func (u *VodUpsertOne) SetTranscodingProfileID(v uuid.UUID) *VodUpsertOne {
+	return u.Update(func(s *VodUpsert) {
+		s.SetTranscodingProfileID(v)
+	})
+}
+
+// UpdateTranscodingProfileID sets the "transcoding_profile_id" field to the value that was provided on create.
+func (u *VodUpsertOne) UpdateTranscodingProfileID() *VodUpsertOne {
+	return u.Update(func(s *VodUpsert) {
+		s.UpdateTranscodingProfileID()
+	})
+}
+
+// ClearTranscodingProfileID clears the value of the "transcoding_profile_id" field.
+func (u *VodUpsertOne) ClearTranscodingProfileID() *VodUpsertOne {
+	return u.Update(func(s *VodUpsert) {
+		s.ClearTranscodingProfileID()
+	})
+}
+
+// SetAuthoritative sets the "authoritative" field.
+func (u *VodUpsertOne) SetAuthoritative(v bool) *VodUpsertOne {
+	return u.Update(func(s *VodUpsert) {
+		s.SetAuthoritative(v)
+	})
+}
+
+// UpdateAuthoritative sets the "authoritative" field to the value that was provided on create.
+func (u *VodUpsertOne) UpdateAuthoritative() *VodUpsertOne {
+	return u.Update(func(s *VodUpsert) {
+		s.UpdateAuthoritative()
+	})
+}
+
 // SetStreamedAt sets the "streamed_at" field.
 func (u *VodUpsertOne) SetStreamedAt(v time.Time) *VodUpsertOne {
 	return u.Update(func(s *VodUpsert) {
@@ -3104,6 +4131,27 @@ func (u *VodUpsertBulk) ClearExtStreamID() *VodUpsertBulk {
 	})
 }
 
+// SetPart sets the "part" field.
+func (u *VodUpsertBulk) SetPart(v int) *VodUpsertBulk {
+	return u.Update(func(s *VodUpsert) {
+		s.SetPart(v)
+	})
+}
+
+// AddPart adds v to the "part" field.
+func (u *VodUpsertBulk) AddPart(v int) *VodUpsertBulk {
+	return u.Update(func(s *VodUpsert) {
+		s.AddPart(v)
+	})
+}
+
+// UpdatePart sets the "part" field to the value that was provided on create.
+func (u *VodUpsertBulk) UpdatePart() *VodUpsertBulk {
+	return u.Update(func(s *VodUpsert) {
+		s.UpdatePart()
+	})
+}
+
 // SetPlatform sets the "platform" field.
 func (u *VodUpsertBulk) SetPlatform(v utils.VideoPlatform) *VodUpsertBulk {
 	return u.Update(func(s *VodUpsert) {
@@ -3146,6 +4194,27 @@ func (u *VodUpsertBulk) UpdateTitle() *VodUpsertBulk {
 	})
 }
 
+// SetCategory sets the "category" field.
+func (u *VodUpsertBulk) SetCategory(v string) *VodUpsertBulk {
+	return u.Update(func(s *VodUpsert) {
+		s.SetCategory(v)
+	})
+}
+
+// UpdateCategory sets the "category" field to the value that was provided on create.
+func (u *VodUpsertBulk) UpdateCategory() *VodUpsertBulk {
+	return u.Update(func(s *VodUpsert) {
+		s.UpdateCategory()
+	})
+}
+
+// ClearCategory clears the value of the "category" field.
+func (u *VodUpsertBulk) ClearCategory() *VodUpsertBulk {
+	return u.Update(func(s *VodUpsert) {
+		s.ClearCategory()
+	})
+}
+
 // SetDuration sets the "duration" field.
 func (u *VodUpsertBulk) SetDuration(v int) *VodUpsertBulk {
 	return u.Update(func(s *VodUpsert) {
@@ -3195,6 +4264,27 @@ func (u *VodUpsertBulk) ClearClipVodOffset() *VodUpsertBulk {
 	})
 }
 
+// SetClipVodID sets the "clip_vod_id" field.
+func (u *VodUpsertBulk) SetClipVodID(v uuid.UUID) *VodUpsertBulk {
+	return u.Update(func(s *VodUpsert) {
+		s.SetClipVodID(v)
+	})
+}
+
+// UpdateClipVodID sets the "clip_vod_id" field to the value that was provided on create.
+func (u *VodUpsertBulk) UpdateClipVodID() *VodUpsertBulk {
+	return u.Update(func(s *VodUpsert) {
+		s.UpdateClipVodID()
+	})
+}
+
+// ClearClipVodID clears the value of the "clip_vod_id" field.
+func (u *VodUpsertBulk) ClearClipVodID() *VodUpsertBulk {
+	return u.Update(func(s *VodUpsert) {
+		s.ClearClipVodID()
+	})
+}
+
 // SetViews sets the "views" field.
 func (u *VodUpsertBulk) SetViews(v int) *VodUpsertBulk {
 	return u.Update(func(s *VodUpsert) {
@@ -3237,6 +4327,48 @@ func (u *VodUpsertBulk) ClearResolution() *VodUpsertBulk {
 	})
 }
 
+// SetQualityFallbacks sets the "quality_fallbacks" field.
+func (u *VodUpsertBulk) SetQualityFallbacks(v []string) *VodUpsertBulk {
+	return u.Update(func(s *VodUpsert) {
+		s.SetQualityFallbacks(v)
+	})
+}
+
+// UpdateQualityFallbacks sets the "quality_fallbacks" field to the value that was provided on create.
+func (u *VodUpsertBulk) UpdateQualityFallbacks() *VodUpsertBulk {
+	return u.Update(func(s *VodUpsert) {
+		s.UpdateQualityFallbacks()
+	})
+}
+
+// ClearQualityFallbacks clears the value of the "quality_fallbacks" field.
+func (u *VodUpsertBulk) ClearQualityFallbacks() *VodUpsertBulk {
+	return u.Update(func(s *VodUpsert) {
+		s.ClearQualityFallbacks()
+	})
+}
+
+// SetQualitySwitches sets the "quality_switches" field.
+func (u *VodUpsertBulk) SetQualitySwitches(v []utils.QualitySwitch) *VodUpsertBulk {
+	return u.Update(func(s *VodUpsert) {
+		s.SetQualitySwitches(v)
+	})
+}
+
+// UpdateQualitySwitches sets the "quality_switches" field to the value that was provided on create.
+func (u *VodUpsertBulk) UpdateQualitySwitches() *VodUpsertBulk {
+	return u.Update(func(s *VodUpsert) {
+		s.UpdateQualitySwitches()
+	})
+}
+
+// ClearQualitySwitches clears the value of the "quality_switches" field.
+func (u *VodUpsertBulk) ClearQualitySwitches() *VodUpsertBulk {
+	return u.Update(func(s *VodUpsert) {
+		s.ClearQualitySwitches()
+	})
+}
+
 // SetProcessing sets the "processing" field.
 func (u *VodUpsertBulk) SetProcessing(v bool) *VodUpsertBulk {
 	return u.Update(func(s *VodUpsert) {
@@ -3447,6 +4579,27 @@ func (u *VodUpsertBulk) ClearCaptionPath() *VodUpsertBulk {
 	})
 }
 
+// SetAudioPath sets the "audio_path" field.
+func (u *VodUpsertBulk) SetAudioPath(v string) *VodUpsertBulk {
+	return u.Update(func(s *VodUpsert) {
+		s.SetAudioPath(v)
+	})
+}
+
+// UpdateAudioPath sets the "audio_path" field to the value that was provided on create.
+func (u *VodUpsertBulk) UpdateAudioPath() *VodUpsertBulk {
+	return u.Update(func(s *VodUpsert) {
+		s.UpdateAudioPath()
+	})
+}
+
+// ClearAudioPath clears the value of the "audio_path" field.
+func (u *VodUpsertBulk) ClearAudioPath() *VodUpsertBulk {
+	return u.Update(func(s *VodUpsert) {
+		s.ClearAudioPath()
+	})
+}
+
 // SetFolderName sets the "folder_name" field.
 func (u *VodUpsertBulk) SetFolderName(v string) *VodUpsertBulk {
 	return u.Update(func(s *VodUpsert) {
@@ -3636,6 +4789,27 @@ func (u *VodUpsertBulk) ClearTmpVideoHlsPath() *VodUpsertBulk {
 	})
 }
 
+// SetTmpAudioPath sets the "tmp_audio_path" field.
+func (u *VodUpsertBulk) SetTmpAudioPath(v string) *VodUpsertBulk {
+	return u.Update(func(s *VodUpsert) {
+		s.SetTmpAudioPath(v)
+	})
+}
+
+// UpdateTmpAudioPath sets the "tmp_audio_path" field to the value that was provided on create.
+func (u *VodUpsertBulk) UpdateTmpAudioPath() *VodUpsertBulk {
+	return u.Update(func(s *VodUpsert) {
+		s.UpdateTmpAudioPath()
+	})
+}
+
+// ClearTmpAudioPath clears the value of the "tmp_audio_path" field.
+func (u *VodUpsertBulk) ClearTmpAudioPath() *VodUpsertBulk {
+	return u.Update(func(s *VodUpsert) {
+		s.ClearTmpAudioPath()
+	})
+}
+
 // SetLocked sets the "locked" field.
 func (u *VodUpsertBulk) SetLocked(v bool) *VodUpsertBulk {
 	return u.Update(func(s *VodUpsert) {
@@ -3867,6 +5041,160 @@ func (u *VodUpsertBulk) UpdateStorageSizeBytes() *VodUpsertBulk {
 	})
 }
 
+// SetStorageTier sets the "storage_tier" field.
+func (u *VodUpsertBulk) SetStorageTier(v utils.StorageTier) *VodUpsertBulk {
+	return u.Update(func(s *VodUpsert) {
+		s.SetStorageTier(v)
+	})
+}
+
+// UpdateStorageTier sets the "storage_tier" field to the value that was provided on create.
+func (u *VodUpsertBulk) UpdateStorageTier() *VodUpsertBulk {
+	return u.Update(func(s *VodUpsert) {
+		s.UpdateStorageTier()
+	})
+}
+
+// SetHealthStatus sets the "health_status" field.
+func (u *VodUpsertBulk) SetHealthStatus(v utils.VideoHealth) *VodUpsertBulk {
+	return u.Update(func(s *VodUpsert) {
+		s.SetHealthStatus(v)
+	})
+}
+
+// UpdateHealthStatus sets the "health_status" field to the value that was provided on create.
+func (u *VodUpsertBulk) UpdateHealthStatus() *VodUpsertBulk {
+	return u.Update(func(s *VodUpsert) {
+		s.UpdateHealthStatus()
+	})
+}
+
+// SetHealthIssues sets the "health_issues" field.
+func (u *VodUpsertBulk) SetHealthIssues(v []utils.VideoHealthIssue) *VodUpsertBulk {
+	return u.Update(func(s *VodUpsert) {
+		s.SetHealthIssues(v)
+	})
+}
+
+// UpdateHealthIssues sets the "health_issues" field to the value that was provided on create.
+func (u *VodUpsertBulk) UpdateHealthIssues() *VodUpsertBulk {
+	return u.Update(func(s *VodUpsert) {
+		s.UpdateHealthIssues()
+	})
+}
+
+// ClearHealthIssues clears the value of the "health_issues" field.
+func (u *VodUpsertBulk) ClearHealthIssues() *VodUpsertBulk {
+	return u.Update(func(s *VodUpsert) {
+		s.ClearHealthIssues()
+	})
+}
+
+// SetHealthCheckedAt sets the "health_checked_at" field.
+func (u *VodUpsertBulk) SetHealthCheckedAt(v time.Time) *VodUpsertBulk {
+	return u.Update(func(s *VodUpsert) {
+		s.SetHealthCheckedAt(v)
+	})
+}
+
+// UpdateHealthCheckedAt sets the "health_checked_at" field to the value that was provided on create.
+func (u *VodUpsertBulk) UpdateHealthCheckedAt() *VodUpsertBulk {
+	return u.Update(func(s *VodUpsert) {
+		s.UpdateHealthCheckedAt()
+	})
+}
+
+// ClearHealthCheckedAt clears the value of the "health_checked_at" field.
+func (u *VodUpsertBulk) ClearHealthCheckedAt() *VodUpsertBulk {
+	return u.Update(func(s *VodUpsert) {
+		s.ClearHealthCheckedAt()
+	})
+}
+
+// SetAudioSourceID sets the "audio_source_id" field.
+func (u *VodUpsertBulk) SetAudioSourceID(v uuid.UUID) *VodUpsertBulk {
+	return u.Update(func(s *VodUpsert) {
+		s.SetAudioSourceID(v)
+	})
+}
+
+// UpdateAudioSourceID sets the "audio_source_id" field to the value that was provided on create.
+func (u *VodUpsertBulk) UpdateAudioSourceID() *VodUpsertBulk {
+	return u.Update(func(s *VodUpsert) {
+		s.UpdateAudioSourceID()
+	})
+}
+
+// ClearAudioSourceID clears the value of the "audio_source_id" field.
+func (u *VodUpsertBulk) ClearAudioSourceID() *VodUpsertBulk {
+	return u.Update(func(s *VodUpsert) {
+		s.ClearAudioSourceID()
+	})
+}
+
+// SetAudioSourceOffset sets the "audio_source_offset" field.
+func (u *VodUpsertBulk) SetAudioSourceOffset(v float64) *VodUpsertBulk {
+	return u.Update(func(s *VodUpsert) {
+		s.SetAudioSourceOffset(v)
+	})
+}
+
+// AddAudioSourceOffset adds v to the "audio_source_offset" field.
+func (u *VodUpsertBulk) AddAudioSourceOffset(v float64) *VodUpsertBulk {
+	return u.Update(func(s *VodUpsert) {
+		s.AddAudioSourceOffset(v)
+	})
+}
+
+// UpdateAudioSourceOffset sets the "audio_source_offset" field to the value that was provided on create.
+func (u *VodUpsertBulk) UpdateAudioSourceOffset() *VodUpsertBulk {
+	return u.Update(func(s *VodUpsert) {
+		s.UpdateAudioSourceOffset()
+	})
+}
+
+// ClearAudioSourceOffset clears the value of the "audio_source_offset" field.
+func (u *VodUpsertBulk) ClearAudioSourceOffset() *VodUpsertBulk {
+	return u.Update(func(s *VodUpsert) {
+		s.ClearAudioSourceOffset()
+	})
+}
+
+// SetTranscodingProfileID sets the "transcoding_profile_id" field.
+func (u *VodUpsertBulk) SetTranscodingProfileID(v uuid.UUID) *VodUpsertBulk {
+	return u.Update(func(s *VodUpsert) {
+		s.SetTranscodingProfileID(v)
+	})
+}
+
+// UpdateTranscodingProfileID sets the "transcoding_profile_id" field to the value that was provided on create.
+func (u *VodUpsertBulk) UpdateTranscodingProfileID() *VodUpsertBulk {
+	return u.Update(func(s *VodUpsert) {
+		s.UpdateTranscodingProfileID()
+	})
+}
+
+// ClearTranscodingProfileID clears the value of the "transcoding_profile_id" field.
+func (u *VodUpsertBulk) ClearTranscodingProfileID() *VodUpsertBulk {
+	return u.Update(func(s *VodUpsert) {
+		s.ClearTranscodingProfileID()
+	})
+}
+
+// SetAuthoritative sets the "authoritative" field.
+func (u *VodUpsertBulk) SetAuthoritative(v bool) *VodUpsertBulk {
+	return u.Update(func(s *VodUpsert) {
+		s.SetAuthoritative(v)
+	})
+}
+
+// UpdateAuthoritative sets the "authoritative" field to the value that was provided on create.
+func (u *VodUpsertBulk) UpdateAuthoritative() *VodUpsertBulk {
+	return u.Update(func(s *VodUpsert) {
+		s.UpdateAuthoritative()
+	})
+}
+
 // SetStreamedAt sets the "streamed_at" field.
 func (u *VodUpsertBulk) SetStreamedAt(v time.Time) *VodUpsertBulk {
 	return u.Update(func(s *VodUpsert) {
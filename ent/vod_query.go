@@ -15,28 +15,40 @@ import (
 	"github.com/google/uuid"
 	"github.com/zibbp/ganymede/ent/channel"
 	"github.com/zibbp/ganymede/ent/chapter"
+	"github.com/zibbp/ganymede/ent/chatmessage"
+	"github.com/zibbp/ganymede/ent/checksum"
+	"github.com/zibbp/ganymede/ent/livegap"
 	"github.com/zibbp/ganymede/ent/multistreaminfo"
 	"github.com/zibbp/ganymede/ent/mutedsegment"
 	"github.com/zibbp/ganymede/ent/playlist"
 	"github.com/zibbp/ganymede/ent/predicate"
 	"github.com/zibbp/ganymede/ent/queue"
+	"github.com/zibbp/ganymede/ent/transcodingprofile"
+	"github.com/zibbp/ganymede/ent/userlistitem"
+	"github.com/zibbp/ganymede/ent/viewingsession"
 	"github.com/zibbp/ganymede/ent/vod"
 )
 
 // VodQuery is the builder for querying Vod entities.
 type VodQuery struct {
 	config
-	ctx                 *QueryContext
-	order               []vod.OrderOption
-	inters              []Interceptor
-	predicates          []predicate.Vod
-	withChannel         *ChannelQuery
-	withQueue           *QueueQuery
-	withPlaylists       *PlaylistQuery
-	withChapters        *ChapterQuery
-	withMutedSegments   *MutedSegmentQuery
-	withMultistreamInfo *MultistreamInfoQuery
-	withFKs             bool
+	ctx                    *QueryContext
+	order                  []vod.OrderOption
+	inters                 []Interceptor
+	predicates             []predicate.Vod
+	withChannel            *ChannelQuery
+	withQueue              *QueueQuery
+	withPlaylists          *PlaylistQuery
+	withChapters           *ChapterQuery
+	withMutedSegments      *MutedSegmentQuery
+	withMultistreamInfo    *MultistreamInfoQuery
+	withChatMessages       *ChatMessageQuery
+	withChecksums          *ChecksumQuery
+	withLiveGaps           *LiveGapQuery
+	withViewingSessions    *ViewingSessionQuery
+	withUserListItems      *UserListItemQuery
+	withTranscodingProfile *TranscodingProfileQuery
+	withFKs                bool
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -205,6 +217,138 @@ func (_q *VodQuery) QueryMultistreamInfo() *MultistreamInfoQuery {
 	return query
 }
 
+// QueryChatMessages chains the current query on the "chat_messages" edge.
+func (_q *VodQuery) QueryChatMessages() *ChatMessageQuery {
+	query := (&ChatMessageClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(vod.Table, vod.FieldID, selector),
+			sqlgraph.To(chatmessage.Table, chatmessage.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, vod.ChatMessagesTable, vod.ChatMessagesColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryChecksums chains the current query on the "checksums" edge.
+func (_q *VodQuery) QueryChecksums() *ChecksumQuery {
+	query := (&ChecksumClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(vod.Table, vod.FieldID, selector),
+			sqlgraph.To(checksum.Table, checksum.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, vod.ChecksumsTable, vod.ChecksumsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryLiveGaps chains the current query on the "live_gaps" edge.
+func (_q *VodQuery) QueryLiveGaps() *LiveGapQuery {
+	query := (&LiveGapClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(vod.Table, vod.FieldID, selector),
+			sqlgraph.To(livegap.Table, livegap.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, vod.LiveGapsTable, vod.LiveGapsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryViewingSessions chains the current query on the "viewing_sessions" edge.
+func (_q *VodQuery) QueryViewingSessions() *ViewingSessionQuery {
+	query := (&ViewingSessionClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(vod.Table, vod.FieldID, selector),
+			sqlgraph.To(viewingsession.Table, viewingsession.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, vod.ViewingSessionsTable, vod.ViewingSessionsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryUserListItems chains the current query on the "user_list_items" edge.
+func (_q *VodQuery) QueryUserListItems() *UserListItemQuery {
+	query := (&UserListItemClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(vod.Table, vod.FieldID, selector),
+			sqlgraph.To(userlistitem.Table, userlistitem.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, vod.UserListItemsTable, vod.UserListItemsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryTranscodingProfile chains the current query on the "transcoding_profile" edge.
+func (_q *VodQuery) QueryTranscodingProfile() *TranscodingProfileQuery {
+	query := (&TranscodingProfileClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(vod.Table, vod.FieldID, selector),
+			sqlgraph.To(transcodingprofile.Table, transcodingprofile.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, vod.TranscodingProfileTable, vod.TranscodingProfileColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first Vod entity from the query.
 // Returns a *NotFoundError when no Vod was found.
 func (_q *VodQuery) First(ctx context.Context) (*Vod, error) {
@@ -392,17 +536,23 @@ func (_q *VodQuery) Clone() *VodQuery {
 		return nil
 	}
 	return &VodQuery{
-		config:              _q.config,
-		ctx:                 _q.ctx.Clone(),
-		order:               append([]vod.OrderOption{}, _q.order...),
-		inters:              append([]Interceptor{}, _q.inters...),
-		predicates:          append([]predicate.Vod{}, _q.predicates...),
-		withChannel:         _q.withChannel.Clone(),
-		withQueue:           _q.withQueue.Clone(),
-		withPlaylists:       _q.withPlaylists.Clone(),
-		withChapters:        _q.withChapters.Clone(),
-		withMutedSegments:   _q.withMutedSegments.Clone(),
-		withMultistreamInfo: _q.withMultistreamInfo.Clone(),
+		config:                 _q.config,
+		ctx:                    _q.ctx.Clone(),
+		order:                  append([]vod.OrderOption{}, _q.order...),
+		inters:                 append([]Interceptor{}, _q.inters...),
+		predicates:             append([]predicate.Vod{}, _q.predicates...),
+		withChannel:            _q.withChannel.Clone(),
+		withQueue:              _q.withQueue.Clone(),
+		withPlaylists:          _q.withPlaylists.Clone(),
+		withChapters:           _q.withChapters.Clone(),
+		withMutedSegments:      _q.withMutedSegments.Clone(),
+		withMultistreamInfo:    _q.withMultistreamInfo.Clone(),
+		withChatMessages:       _q.withChatMessages.Clone(),
+		withChecksums:          _q.withChecksums.Clone(),
+		withLiveGaps:           _q.withLiveGaps.Clone(),
+		withViewingSessions:    _q.withViewingSessions.Clone(),
+		withUserListItems:      _q.withUserListItems.Clone(),
+		withTranscodingProfile: _q.withTranscodingProfile.Clone(),
 		// clone intermediate query.
 		sql:  _q.sql.Clone(),
 		path: _q.path,
@@ -475,6 +625,72 @@ func (_q *VodQuery) WithMultistreamInfo(opts ...func(*MultistreamInfoQuery)) *Vo
 	return _q
 }
 
+// WithChatMessages tells the query-builder to eager-load the nodes that are connected to
+// the "chat_messages" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *VodQuery) WithChatMessages(opts ...func(*ChatMessageQuery)) *VodQuery {
+	query := (&ChatMessageClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withChatMessages = query
+	return _q
+}
+
+// WithChecksums tells the query-builder to eager-load the nodes that are connected to
+// the "checksums" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *VodQuery) WithChecksums(opts ...func(*ChecksumQuery)) *VodQuery {
+	query := (&ChecksumClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withChecksums = query
+	return _q
+}
+
+// WithLiveGaps tells the query-builder to eager-load the nodes that are connected to
+// the "live_gaps" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *VodQuery) WithLiveGaps(opts ...func(*LiveGapQuery)) *VodQuery {
+	query := (&LiveGapClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withLiveGaps = query
+	return _q
+}
+
+// WithViewingSessions tells the query-builder to eager-load the nodes that are connected to
+// the "viewing_sessions" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *VodQuery) WithViewingSessions(opts ...func(*ViewingSessionQuery)) *VodQuery {
+	query := (&ViewingSessionClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withViewingSessions = query
+	return _q
+}
+
+// WithUserListItems tells the query-builder to eager-load the nodes that are connected to
+// the "user_list_items" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *VodQuery) WithUserListItems(opts ...func(*UserListItemQuery)) *VodQuery {
+	query := (&UserListItemClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withUserListItems = query
+	return _q
+}
+
+// WithTranscodingProfile tells the query-builder to eager-load the nodes that are connected to
+// the "transcoding_profile" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *VodQuery) WithTranscodingProfile(opts ...func(*TranscodingProfileQuery)) *VodQuery {
+	query := (&TranscodingProfileClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withTranscodingProfile = query
+	return _q
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -554,13 +770,19 @@ func (_q *VodQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Vod, err
 		nodes       = []*Vod{}
 		withFKs     = _q.withFKs
 		_spec       = _q.querySpec()
-		loadedTypes = [6]bool{
+		loadedTypes = [12]bool{
 			_q.withChannel != nil,
 			_q.withQueue != nil,
 			_q.withPlaylists != nil,
 			_q.withChapters != nil,
 			_q.withMutedSegments != nil,
 			_q.withMultistreamInfo != nil,
+			_q.withChatMessages != nil,
+			_q.withChecksums != nil,
+			_q.withLiveGaps != nil,
+			_q.withViewingSessions != nil,
+			_q.withUserListItems != nil,
+			_q.withTranscodingProfile != nil,
 		}
 	)
 	if _q.withChannel != nil {
@@ -627,6 +849,47 @@ func (_q *VodQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Vod, err
 			return nil, err
 		}
 	}
+	if query := _q.withChatMessages; query != nil {
+		if err := _q.loadChatMessages(ctx, query, nodes,
+			func(n *Vod) { n.Edges.ChatMessages = []*ChatMessage{} },
+			func(n *Vod, e *ChatMessage) { n.Edges.ChatMessages = append(n.Edges.ChatMessages, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withChecksums; query != nil {
+		if err := _q.loadChecksums(ctx, query, nodes,
+			func(n *Vod) { n.Edges.Checksums = []*Checksum{} },
+			func(n *Vod, e *Checksum) { n.Edges.Checksums = append(n.Edges.Checksums, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withLiveGaps; query != nil {
+		if err := _q.loadLiveGaps(ctx, query, nodes,
+			func(n *Vod) { n.Edges.LiveGaps = []*LiveGap{} },
+			func(n *Vod, e *LiveGap) { n.Edges.LiveGaps = append(n.Edges.LiveGaps, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withViewingSessions; query != nil {
+		if err := _q.loadViewingSessions(ctx, query, nodes,
+			func(n *Vod) { n.Edges.ViewingSessions = []*ViewingSession{} },
+			func(n *Vod, e *ViewingSession) { n.Edges.ViewingSessions = append(n.Edges.ViewingSessions, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withUserListItems; query != nil {
+		if err := _q.loadUserListItems(ctx, query, nodes,
+			func(n *Vod) { n.Edges.UserListItems = []*UserListItem{} },
+			func(n *Vod, e *UserListItem) { n.Edges.UserListItems = append(n.Edges.UserListItems, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withTranscodingProfile; query != nil {
+		if err := _q.loadTranscodingProfile(ctx, query, nodes, nil,
+			func(n *Vod, e *TranscodingProfile) { n.Edges.TranscodingProfile = e }); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
@@ -844,6 +1107,188 @@ func (_q *VodQuery) loadMultistreamInfo(ctx context.Context, query *MultistreamI
 	}
 	return nil
 }
+func (_q *VodQuery) loadChatMessages(ctx context.Context, query *ChatMessageQuery, nodes []*Vod, init func(*Vod), assign func(*Vod, *ChatMessage)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*Vod)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(chatmessage.FieldVodID)
+	}
+	query.Where(predicate.ChatMessage(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(vod.ChatMessagesColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.VodID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "vod_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *VodQuery) loadChecksums(ctx context.Context, query *ChecksumQuery, nodes []*Vod, init func(*Vod), assign func(*Vod, *Checksum)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*Vod)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(checksum.FieldVodID)
+	}
+	query.Where(predicate.Checksum(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(vod.ChecksumsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.VodID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "vod_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *VodQuery) loadLiveGaps(ctx context.Context, query *LiveGapQuery, nodes []*Vod, init func(*Vod), assign func(*Vod, *LiveGap)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*Vod)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(livegap.FieldVodID)
+	}
+	query.Where(predicate.LiveGap(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(vod.LiveGapsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.VodID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "vod_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *VodQuery) loadViewingSessions(ctx context.Context, query *ViewingSessionQuery, nodes []*Vod, init func(*Vod), assign func(*Vod, *ViewingSession)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*Vod)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(viewingsession.FieldVodID)
+	}
+	query.Where(predicate.ViewingSession(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(vod.ViewingSessionsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.VodID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "vod_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *VodQuery) loadUserListItems(ctx context.Context, query *UserListItemQuery, nodes []*Vod, init func(*Vod), assign func(*Vod, *UserListItem)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*Vod)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(userlistitem.FieldVodID)
+	}
+	query.Where(predicate.UserListItem(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(vod.UserListItemsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.VodID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "vod_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *VodQuery) loadTranscodingProfile(ctx context.Context, query *TranscodingProfileQuery, nodes []*Vod, init func(*Vod), assign func(*Vod, *TranscodingProfile)) error {
+	ids := make([]uuid.UUID, 0, len(nodes))
+	nodeids := make(map[uuid.UUID][]*Vod)
+	for i := range nodes {
+		if nodes[i].TranscodingProfileID == nil {
+			continue
+		}
+		fk := *nodes[i].TranscodingProfileID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(transcodingprofile.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "transcoding_profile_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
 
 func (_q *VodQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := _q.querySpec()
@@ -870,6 +1315,9 @@ func (_q *VodQuery) querySpec() *sqlgraph.QuerySpec {
 				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
 			}
 		}
+		if _q.withTranscodingProfile != nil {
+			_spec.Node.AddColumnOnce(vod.FieldTranscodingProfileID)
+		}
 	}
 	if ps := _q.predicates; len(ps) > 0 {
 		_spec.Predicate = func(selector *sql.Selector) {
@@ -15,11 +15,17 @@ import (
 	"github.com/google/uuid"
 	"github.com/zibbp/ganymede/ent/channel"
 	"github.com/zibbp/ganymede/ent/chapter"
+	"github.com/zibbp/ganymede/ent/chatmessage"
+	"github.com/zibbp/ganymede/ent/checksum"
+	"github.com/zibbp/ganymede/ent/livegap"
 	"github.com/zibbp/ganymede/ent/multistreaminfo"
 	"github.com/zibbp/ganymede/ent/mutedsegment"
 	"github.com/zibbp/ganymede/ent/playlist"
 	"github.com/zibbp/ganymede/ent/predicate"
 	"github.com/zibbp/ganymede/ent/queue"
+	"github.com/zibbp/ganymede/ent/transcodingprofile"
+	"github.com/zibbp/ganymede/ent/userlistitem"
+	"github.com/zibbp/ganymede/ent/viewingsession"
 	"github.com/zibbp/ganymede/ent/vod"
 	"github.com/zibbp/ganymede/internal/utils"
 )
@@ -91,6 +97,27 @@ func (_u *VodUpdate) ClearExtStreamID() *VodUpdate {
 	return _u
 }
 
+// SetPart sets the "part" field.
+func (_u *VodUpdate) SetPart(v int) *VodUpdate {
+	_u.mutation.ResetPart()
+	_u.mutation.SetPart(v)
+	return _u
+}
+
+// SetNillablePart sets the "part" field if the given value is not nil.
+func (_u *VodUpdate) SetNillablePart(v *int) *VodUpdate {
+	if v != nil {
+		_u.SetPart(*v)
+	}
+	return _u
+}
+
+// AddPart adds value to the "part" field.
+func (_u *VodUpdate) AddPart(v int) *VodUpdate {
+	_u.mutation.AddPart(v)
+	return _u
+}
+
 // SetPlatform sets the "platform" field.
 func (_u *VodUpdate) SetPlatform(v utils.VideoPlatform) *VodUpdate {
 	_u.mutation.SetPlatform(v)
@@ -133,6 +160,26 @@ func (_u *VodUpdate) SetNillableTitle(v *string) *VodUpdate {
 	return _u
 }
 
+// SetCategory sets the "category" field.
+func (_u *VodUpdate) SetCategory(v string) *VodUpdate {
+	_u.mutation.SetCategory(v)
+	return _u
+}
+
+// SetNillableCategory sets the "category" field if the given value is not nil.
+func (_u *VodUpdate) SetNillableCategory(v *string) *VodUpdate {
+	if v != nil {
+		_u.SetCategory(*v)
+	}
+	return _u
+}
+
+// ClearCategory clears the value of the "category" field.
+func (_u *VodUpdate) ClearCategory() *VodUpdate {
+	_u.mutation.ClearCategory()
+	return _u
+}
+
 // SetDuration sets the "duration" field.
 func (_u *VodUpdate) SetDuration(v int) *VodUpdate {
 	_u.mutation.ResetDuration()
@@ -181,6 +228,26 @@ func (_u *VodUpdate) ClearClipVodOffset() *VodUpdate {
 	return _u
 }
 
+// SetClipVodID sets the "clip_vod_id" field.
+func (_u *VodUpdate) SetClipVodID(v uuid.UUID) *VodUpdate {
+	_u.mutation.SetClipVodID(v)
+	return _u
+}
+
+// SetNillableClipVodID sets the "clip_vod_id" field if the given value is not nil.
+func (_u *VodUpdate) SetNillableClipVodID(v *uuid.UUID) *VodUpdate {
+	if v != nil {
+		_u.SetClipVodID(*v)
+	}
+	return _u
+}
+
+// ClearClipVodID clears the value of the "clip_vod_id" field.
+func (_u *VodUpdate) ClearClipVodID() *VodUpdate {
+	_u.mutation.ClearClipVodID()
+	return _u
+}
+
 // SetViews sets the "views" field.
 func (_u *VodUpdate) SetViews(v int) *VodUpdate {
 	_u.mutation.ResetViews()
@@ -222,6 +289,42 @@ func (_u *VodUpdate) ClearResolution() *VodUpdate {
 	return _u
 }
 
+// SetQualityFallbacks sets the "quality_fallbacks" field.
+func (_u *VodUpdate) SetQualityFallbacks(v []string) *VodUpdate {
+	_u.mutation.SetQualityFallbacks(v)
+	return _u
+}
+
+// AppendQualityFallbacks appends value to the "quality_fallbacks" field.
+func (_u *VodUpdate) AppendQualityFallbacks(v []string) *VodUpdate {
+	_u.mutation.AppendQualityFallbacks(v)
+	return _u
+}
+
+// ClearQualityFallbacks clears the value of the "quality_fallbacks" field.
+func (_u *VodUpdate) ClearQualityFallbacks() *VodUpdate {
+	_u.mutation.ClearQualityFallbacks()
+	return _u
+}
+
+// SetQualitySwitches sets the "quality_switches" field.
+func (_u *VodUpdate) SetQualitySwitches(v []utils.QualitySwitch) *VodUpdate {
+	_u.mutation.SetQualitySwitches(v)
+	return _u
+}
+
+// AppendQualitySwitches appends value to the "quality_switches" field.
+func (_u *VodUpdate) AppendQualitySwitches(v []utils.QualitySwitch) *VodUpdate {
+	_u.mutation.AppendQualitySwitches(v)
+	return _u
+}
+
+// ClearQualitySwitches clears the value of the "quality_switches" field.
+func (_u *VodUpdate) ClearQualitySwitches() *VodUpdate {
+	_u.mutation.ClearQualitySwitches()
+	return _u
+}
+
 // SetProcessing sets the "processing" field.
 func (_u *VodUpdate) SetProcessing(v bool) *VodUpdate {
 	_u.mutation.SetProcessing(v)
@@ -424,6 +527,26 @@ func (_u *VodUpdate) ClearCaptionPath() *VodUpdate {
 	return _u
 }
 
+// SetAudioPath sets the "audio_path" field.
+func (_u *VodUpdate) SetAudioPath(v string) *VodUpdate {
+	_u.mutation.SetAudioPath(v)
+	return _u
+}
+
+// SetNillableAudioPath sets the "audio_path" field if the given value is not nil.
+func (_u *VodUpdate) SetNillableAudioPath(v *string) *VodUpdate {
+	if v != nil {
+		_u.SetAudioPath(*v)
+	}
+	return _u
+}
+
+// ClearAudioPath clears the value of the "audio_path" field.
+func (_u *VodUpdate) ClearAudioPath() *VodUpdate {
+	_u.mutation.ClearAudioPath()
+	return _u
+}
+
 // SetFolderName sets the "folder_name" field.
 func (_u *VodUpdate) SetFolderName(v string) *VodUpdate {
 	_u.mutation.SetFolderName(v)
@@ -604,6 +727,26 @@ func (_u *VodUpdate) ClearTmpVideoHlsPath() *VodUpdate {
 	return _u
 }
 
+// SetTmpAudioPath sets the "tmp_audio_path" field.
+func (_u *VodUpdate) SetTmpAudioPath(v string) *VodUpdate {
+	_u.mutation.SetTmpAudioPath(v)
+	return _u
+}
+
+// SetNillableTmpAudioPath sets the "tmp_audio_path" field if the given value is not nil.
+func (_u *VodUpdate) SetNillableTmpAudioPath(v *string) *VodUpdate {
+	if v != nil {
+		_u.SetTmpAudioPath(*v)
+	}
+	return _u
+}
+
+// ClearTmpAudioPath clears the value of the "tmp_audio_path" field.
+func (_u *VodUpdate) ClearTmpAudioPath() *VodUpdate {
+	_u.mutation.ClearTmpAudioPath()
+	return _u
+}
+
 // SetLocked sets the "locked" field.
 func (_u *VodUpdate) SetLocked(v bool) *VodUpdate {
 	_u.mutation.SetLocked(v)
@@ -827,6 +970,153 @@ func (_u *VodUpdate) AddStorageSizeBytes(v int64) *VodUpdate {
 	return _u
 }
 
+// SetStorageTier sets the "storage_tier" field.
+func (_u *VodUpdate) SetStorageTier(v utils.StorageTier) *VodUpdate {
+	_u.mutation.SetStorageTier(v)
+	return _u
+}
+
+// SetNillableStorageTier sets the "storage_tier" field if the given value is not nil.
+func (_u *VodUpdate) SetNillableStorageTier(v *utils.StorageTier) *VodUpdate {
+	if v != nil {
+		_u.SetStorageTier(*v)
+	}
+	return _u
+}
+
+// SetHealthStatus sets the "health_status" field.
+func (_u *VodUpdate) SetHealthStatus(v utils.VideoHealth) *VodUpdate {
+	_u.mutation.SetHealthStatus(v)
+	return _u
+}
+
+// SetNillableHealthStatus sets the "health_status" field if the given value is not nil.
+func (_u *VodUpdate) SetNillableHealthStatus(v *utils.VideoHealth) *VodUpdate {
+	if v != nil {
+		_u.SetHealthStatus(*v)
+	}
+	return _u
+}
+
+// SetHealthIssues sets the "health_issues" field.
+func (_u *VodUpdate) SetHealthIssues(v []utils.VideoHealthIssue) *VodUpdate {
+	_u.mutation.SetHealthIssues(v)
+	return _u
+}
+
+// AppendHealthIssues appends value to the "health_issues" field.
+func (_u *VodUpdate) AppendHealthIssues(v []utils.VideoHealthIssue) *VodUpdate {
+	_u.mutation.AppendHealthIssues(v)
+	return _u
+}
+
+// ClearHealthIssues clears the value of the "health_issues" field.
+func (_u *VodUpdate) ClearHealthIssues() *VodUpdate {
+	_u.mutation.ClearHealthIssues()
+	return _u
+}
+
+// SetHealthCheckedAt sets the "health_checked_at" field.
+func (_u *VodUpdate) SetHealthCheckedAt(v time.Time) *VodUpdate {
+	_u.mutation.SetHealthCheckedAt(v)
+	return _u
+}
+
+// SetNillableHealthCheckedAt sets the "health_checked_at" field if the given value is not nil.
+func (_u *VodUpdate) SetNillableHealthCheckedAt(v *time.Time) *VodUpdate {
+	if v != nil {
+		_u.SetHealthCheckedAt(*v)
+	}
+	return _u
+}
+
+// ClearHealthCheckedAt clears the value of the "health_checked_at" field.
+func (_u *VodUpdate) ClearHealthCheckedAt() *VodUpdate {
+	_u.mutation.ClearHealthCheckedAt()
+	return _u
+}
+
+// SetAudioSourceID sets the "audio_source_id" field.
+func (_u *VodUpdate) SetAudioSourceID(v uuid.UUID) *VodUpdate {
+	_u.mutation.SetAudioSourceID(v)
+	return _u
+}
+
+// SetNillableAudioSourceID sets the "audio_source_id" field if the given value is not nil.
+func (_u *VodUpdate) SetNillableAudioSourceID(v *uuid.UUID) *VodUpdate {
+	if v != nil {
+		_u.SetAudioSourceID(*v)
+	}
+	return _u
+}
+
+// ClearAudioSourceID clears the value of the "audio_source_id" field.
+func (_u *VodUpdate) ClearAudioSourceID() *VodUpdate {
+	_u.mutation.ClearAudioSourceID()
+	return _u
+}
+
+// SetAudioSourceOffset sets the "audio_source_offset" field.
+func (_u *VodUpdate) SetAudioSourceOffset(v float64) *VodUpdate {
+	_u.mutation.ResetAudioSourceOffset()
+	_u.mutation.SetAudioSourceOffset(v)
+	return _u
+}
+
+// SetNillableAudioSourceOffset sets the "audio_source_offset" field if the given value is not nil.
+func (_u *VodUpdate) SetNillableAudioSourceOffset(v *float64) *VodUpdate {
+	if v != nil {
+		_u.SetAudioSourceOffset(*v)
+	}
+	return _u
+}
+
+// AddAudioSourceOffset adds value to the "audio_source_offset" field.
+func (_u *VodUpdate) AddAudioSourceOffset(v float64) *VodUpdate {
+	_u.mutation.AddAudioSourceOffset(v)
+	return _u
+}
+
+// ClearAudioSourceOffset clears the value of the "audio_source_offset" field.
+func (_u *VodUpdate) ClearAudioSourceOffset() *VodUpdate {
+	_u.mutation.ClearAudioSourceOffset()
+	return _u
+}
+
+// SetTranscodingProfileID sets the "transcoding_profile_id" field.
+func (_u *VodUpdate) SetTranscodingProfileID(v uuid.UUID) *VodUpdate {
+	_u.mutation.SetTranscodingProfileID(v)
+	return _u
+}
+
+// SetNillableTranscodingProfileID sets the "transcoding_profile_id" field if the given value is not nil.
+func (_u *VodUpdate) SetNillableTranscodingProfileID(v *uuid.UUID) *VodUpdate {
+	if v != nil {
+		_u.SetTranscodingProfileID(*v)
+	}
+	return _u
+}
+
+// ClearTranscodingProfileID clears the value of the "transcoding_profile_id" field.
+func (_u *VodUpdate) ClearTranscodingProfileID() *VodUpdate {
+	_u.mutation.ClearTranscodingProfileID()
+	return _u
+}
+
+// SetAuthoritative sets the "authoritative" field.
+func (_u *VodUpdate) SetAuthoritative(v bool) *VodUpdate {
+	_u.mutation.SetAuthoritative(v)
+	return _u
+}
+
+// SetNillableAuthoritative sets the "authoritative" field if the given value is not nil.
+func (_u *VodUpdate) SetNillableAuthoritative(v *bool) *VodUpdate {
+	if v != nil {
+		_u.SetAuthoritative(*v)
+	}
+	return _u
+}
+
 // SetStreamedAt sets the "streamed_at" field.
 func (_u *VodUpdate) SetStreamedAt(v time.Time) *VodUpdate {
 	_u.mutation.SetStreamedAt(v)
@@ -937,6 +1227,86 @@ func (_u *VodUpdate) AddMultistreamInfo(v ...*MultistreamInfo) *VodUpdate {
 	return _u.AddMultistreamInfoIDs(ids...)
 }
 
+// AddChatMessageIDs adds the "chat_messages" edge to the ChatMessage entity by IDs.
+func (_u *VodUpdate) AddChatMessageIDs(ids ...uuid.UUID) *VodUpdate {
+	_u.mutation.AddChatMessageIDs(ids...)
+	return _u
+}
+
+// AddChatMessages adds the "chat_messages" edges to the ChatMessage entity.
+func (_u *VodUpdate) AddChatMessages(v ...*ChatMessage) *VodUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddChatMessageIDs(ids...)
+}
+
+// AddChecksumIDs adds the "checksums" edge to the Checksum entity by IDs.
+func (_u *VodUpdate) AddChecksumIDs(ids ...uuid.UUID) *VodUpdate {
+	_u.mutation.AddChecksumIDs(ids...)
+	return _u
+}
+
+// AddChecksums adds the "checksums" edges to the Checksum entity.
+func (_u *VodUpdate) AddChecksums(v ...*Checksum) *VodUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddChecksumIDs(ids...)
+}
+
+// AddLiveGapIDs adds the "live_gaps" edge to the LiveGap entity by IDs.
+func (_u *VodUpdate) AddLiveGapIDs(ids ...uuid.UUID) *VodUpdate {
+	_u.mutation.AddLiveGapIDs(ids...)
+	return _u
+}
+
+// AddLiveGaps adds the "live_gaps" edges to the LiveGap entity.
+func (_u *VodUpdate) AddLiveGaps(v ...*LiveGap) *VodUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddLiveGapIDs(ids...)
+}
+
+// AddViewingSessionIDs adds the "viewing_sessions" edge to the ViewingSession entity by IDs.
+func (_u *VodUpdate) AddViewingSessionIDs(ids ...uuid.UUID) *VodUpdate {
+	_u.mutation.AddViewingSessionIDs(ids...)
+	return _u
+}
+
+// AddViewingSessions adds the "viewing_sessions" edges to the ViewingSession entity.
+func (_u *VodUpdate) AddViewingSessions(v ...*ViewingSession) *VodUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddViewingSessionIDs(ids...)
+}
+
+// AddUserListItemIDs adds the "user_list_items" edge to the UserListItem entity by IDs.
+func (_u *VodUpdate) AddUserListItemIDs(ids ...uuid.UUID) *VodUpdate {
+	_u.mutation.AddUserListItemIDs(ids...)
+	return _u
+}
+
+// AddUserListItems adds the "user_list_items" edges to the UserListItem entity.
+func (_u *VodUpdate) AddUserListItems(v ...*UserListItem) *VodUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddUserListItemIDs(ids...)
+}
+
+// SetTranscodingProfile sets the "transcoding_profile" edge to the TranscodingProfile entity.
+func (_u *VodUpdate) SetTranscodingProfile(v *TranscodingProfile) *VodUpdate {
+	return _u.SetTranscodingProfileID(v.ID)
+}
+
 // Mutation returns the VodMutation object of the builder.
 func (_u *VodUpdate) Mutation() *VodMutation {
 	return _u.mutation
@@ -1038,63 +1408,189 @@ func (_u *VodUpdate) RemoveMultistreamInfo(v ...*MultistreamInfo) *VodUpdate {
 	return _u.RemoveMultistreamInfoIDs(ids...)
 }
 
-// Save executes the query and returns the number of nodes affected by the update operation.
-func (_u *VodUpdate) Save(ctx context.Context) (int, error) {
-	_u.defaults()
-	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+// ClearChatMessages clears all "chat_messages" edges to the ChatMessage entity.
+func (_u *VodUpdate) ClearChatMessages() *VodUpdate {
+	_u.mutation.ClearChatMessages()
+	return _u
 }
 
-// SaveX is like Save, but panics if an error occurs.
-func (_u *VodUpdate) SaveX(ctx context.Context) int {
-	affected, err := _u.Save(ctx)
-	if err != nil {
-		panic(err)
+// RemoveChatMessageIDs removes the "chat_messages" edge to ChatMessage entities by IDs.
+func (_u *VodUpdate) RemoveChatMessageIDs(ids ...uuid.UUID) *VodUpdate {
+	_u.mutation.RemoveChatMessageIDs(ids...)
+	return _u
+}
+
+// RemoveChatMessages removes "chat_messages" edges to ChatMessage entities.
+func (_u *VodUpdate) RemoveChatMessages(v ...*ChatMessage) *VodUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
 	}
-	return affected
+	return _u.RemoveChatMessageIDs(ids...)
 }
 
-// Exec executes the query.
-func (_u *VodUpdate) Exec(ctx context.Context) error {
-	_, err := _u.Save(ctx)
-	return err
+// ClearChecksums clears all "checksums" edges to the Checksum entity.
+func (_u *VodUpdate) ClearChecksums() *VodUpdate {
+	_u.mutation.ClearChecksums()
+	return _u
 }
 
-// ExecX is like Exec, but panics if an error occurs.
-func (_u *VodUpdate) ExecX(ctx context.Context) {
-	if err := _u.Exec(ctx); err != nil {
-		panic(err)
-	}
+// RemoveChecksumIDs removes the "checksums" edge to Checksum entities by IDs.
+func (_u *VodUpdate) RemoveChecksumIDs(ids ...uuid.UUID) *VodUpdate {
+	_u.mutation.RemoveChecksumIDs(ids...)
+	return _u
 }
 
-// defaults sets the default values of the builder before save.
-func (_u *VodUpdate) defaults() {
-	if _, ok := _u.mutation.UpdatedAt(); !ok {
-		v := vod.UpdateDefaultUpdatedAt()
-		_u.mutation.SetUpdatedAt(v)
+// RemoveChecksums removes "checksums" edges to Checksum entities.
+func (_u *VodUpdate) RemoveChecksums(v ...*Checksum) *VodUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
 	}
+	return _u.RemoveChecksumIDs(ids...)
 }
 
-// check runs all checks and user-defined validators on the builder.
-func (_u *VodUpdate) check() error {
-	if v, ok := _u.mutation.Platform(); ok {
-		if err := vod.PlatformValidator(v); err != nil {
-			return &ValidationError{Name: "platform", err: fmt.Errorf(`ent: validator failed for field "Vod.platform": %w`, err)}
-		}
-	}
-	if v, ok := _u.mutation.GetType(); ok {
-		if err := vod.TypeValidator(v); err != nil {
-			return &ValidationError{Name: "type", err: fmt.Errorf(`ent: validator failed for field "Vod.type": %w`, err)}
-		}
-	}
-	if _u.mutation.ChannelCleared() && len(_u.mutation.ChannelIDs()) > 0 {
-		return errors.New(`ent: clearing a required unique edge "Vod.channel"`)
-	}
-	return nil
+// ClearLiveGaps clears all "live_gaps" edges to the LiveGap entity.
+func (_u *VodUpdate) ClearLiveGaps() *VodUpdate {
+	_u.mutation.ClearLiveGaps()
+	return _u
 }
 
-func (_u *VodUpdate) sqlSave(ctx context.Context) (_node int, err error) {
-	if err := _u.check(); err != nil {
-		return _node, err
+// RemoveLiveGapIDs removes the "live_gaps" edge to LiveGap entities by IDs.
+func (_u *VodUpdate) RemoveLiveGapIDs(ids ...uuid.UUID) *VodUpdate {
+	_u.mutation.RemoveLiveGapIDs(ids...)
+	return _u
+}
+
+// RemoveLiveGaps removes "live_gaps" edges to LiveGap entities.
+func (_u *VodUpdate) RemoveLiveGaps(v ...*LiveGap) *VodUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveLiveGapIDs(ids...)
+}
+
+// ClearViewingSessions clears all "viewing_sessions" edges to the ViewingSession entity.
+func (_u *VodUpdate) ClearViewingSessions() *VodUpdate {
+	_u.mutation.ClearViewingSessions()
+	return _u
+}
+
+// RemoveViewingSessionIDs removes the "viewing_sessions" edge to ViewingSession entities by IDs.
+func (_u *VodUpdate) RemoveViewingSessionIDs(ids ...uuid.UUID) *VodUpdate {
+	_u.mutation.RemoveViewingSessionIDs(ids...)
+	return _u
+}
+
+// RemoveViewingSessions removes "viewing_sessions" edges to ViewingSession entities.
+func (_u *VodUpdate) RemoveViewingSessions(v ...*ViewingSession) *VodUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveViewingSessionIDs(ids...)
+}
+
+// ClearUserListItems clears all "user_list_items" edges to the UserListItem entity.
+func (_u *VodUpdate) ClearUserListItems() *VodUpdate {
+	_u.mutation.ClearUserListItems()
+	return _u
+}
+
+// RemoveUserListItemIDs removes the "user_list_items" edge to UserListItem entities by IDs.
+func (_u *VodUpdate) RemoveUserListItemIDs(ids ...uuid.UUID) *VodUpdate {
+	_u.mutation.RemoveUserListItemIDs(ids...)
+	return _u
+}
+
+// RemoveUserListItems removes "user_list_items" edges to UserListItem entities.
+func (_u *VodUpdate) RemoveUserListItems(v ...*UserListItem) *VodUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveUserListItemIDs(ids...)
+}
+
+// ClearTranscodingProfile clears the "transcoding_profile" edge to the TranscodingProfile entity.
+func (_u *VodUpdate) ClearTranscodingProfile() *VodUpdate {
+	_u.mutation.ClearTranscodingProfile()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *VodUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *VodUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *VodUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *VodUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *VodUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := vod.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *VodUpdate) check() error {
+	if v, ok := _u.mutation.Part(); ok {
+		if err := vod.PartValidator(v); err != nil {
+			return &ValidationError{Name: "part", err: fmt.Errorf(`ent: validator failed for field "Vod.part": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Platform(); ok {
+		if err := vod.PlatformValidator(v); err != nil {
+			return &ValidationError{Name: "platform", err: fmt.Errorf(`ent: validator failed for field "Vod.platform": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.GetType(); ok {
+		if err := vod.TypeValidator(v); err != nil {
+			return &ValidationError{Name: "type", err: fmt.Errorf(`ent: validator failed for field "Vod.type": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.StorageTier(); ok {
+		if err := vod.StorageTierValidator(v); err != nil {
+			return &ValidationError{Name: "storage_tier", err: fmt.Errorf(`ent: validator failed for field "Vod.storage_tier": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.HealthStatus(); ok {
+		if err := vod.HealthStatusValidator(v); err != nil {
+			return &ValidationError{Name: "health_status", err: fmt.Errorf(`ent: validator failed for field "Vod.health_status": %w`, err)}
+		}
+	}
+	if _u.mutation.ChannelCleared() && len(_u.mutation.ChannelIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Vod.channel"`)
+	}
+	return nil
+}
+
+func (_u *VodUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
 	}
 	_spec := sqlgraph.NewUpdateSpec(vod.Table, vod.Columns, sqlgraph.NewFieldSpec(vod.FieldID, field.TypeUUID))
 	if ps := _u.mutation.predicates; len(ps) > 0 {
@@ -1119,6 +1615,12 @@ func (_u *VodUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if _u.mutation.ExtStreamIDCleared() {
 		_spec.ClearField(vod.FieldExtStreamID, field.TypeString)
 	}
+	if value, ok := _u.mutation.Part(); ok {
+		_spec.SetField(vod.FieldPart, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedPart(); ok {
+		_spec.AddField(vod.FieldPart, field.TypeInt, value)
+	}
 	if value, ok := _u.mutation.Platform(); ok {
 		_spec.SetField(vod.FieldPlatform, field.TypeEnum, value)
 	}
@@ -1128,6 +1630,12 @@ func (_u *VodUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if value, ok := _u.mutation.Title(); ok {
 		_spec.SetField(vod.FieldTitle, field.TypeString, value)
 	}
+	if value, ok := _u.mutation.Category(); ok {
+		_spec.SetField(vod.FieldCategory, field.TypeString, value)
+	}
+	if _u.mutation.CategoryCleared() {
+		_spec.ClearField(vod.FieldCategory, field.TypeString)
+	}
 	if value, ok := _u.mutation.Duration(); ok {
 		_spec.SetField(vod.FieldDuration, field.TypeInt, value)
 	}
@@ -1143,6 +1651,12 @@ func (_u *VodUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if _u.mutation.ClipVodOffsetCleared() {
 		_spec.ClearField(vod.FieldClipVodOffset, field.TypeInt)
 	}
+	if value, ok := _u.mutation.ClipVodID(); ok {
+		_spec.SetField(vod.FieldClipVodID, field.TypeUUID, value)
+	}
+	if _u.mutation.ClipVodIDCleared() {
+		_spec.ClearField(vod.FieldClipVodID, field.TypeUUID)
+	}
 	if value, ok := _u.mutation.Views(); ok {
 		_spec.SetField(vod.FieldViews, field.TypeInt, value)
 	}
@@ -1155,6 +1669,28 @@ func (_u *VodUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if _u.mutation.ResolutionCleared() {
 		_spec.ClearField(vod.FieldResolution, field.TypeString)
 	}
+	if value, ok := _u.mutation.QualityFallbacks(); ok {
+		_spec.SetField(vod.FieldQualityFallbacks, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedQualityFallbacks(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, vod.FieldQualityFallbacks, value)
+		})
+	}
+	if _u.mutation.QualityFallbacksCleared() {
+		_spec.ClearField(vod.FieldQualityFallbacks, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.QualitySwitches(); ok {
+		_spec.SetField(vod.FieldQualitySwitches, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedQualitySwitches(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, vod.FieldQualitySwitches, value)
+		})
+	}
+	if _u.mutation.QualitySwitchesCleared() {
+		_spec.ClearField(vod.FieldQualitySwitches, field.TypeJSON)
+	}
 	if value, ok := _u.mutation.Processing(); ok {
 		_spec.SetField(vod.FieldProcessing, field.TypeBool, value)
 	}
@@ -1212,6 +1748,12 @@ func (_u *VodUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if _u.mutation.CaptionPathCleared() {
 		_spec.ClearField(vod.FieldCaptionPath, field.TypeString)
 	}
+	if value, ok := _u.mutation.AudioPath(); ok {
+		_spec.SetField(vod.FieldAudioPath, field.TypeString, value)
+	}
+	if _u.mutation.AudioPathCleared() {
+		_spec.ClearField(vod.FieldAudioPath, field.TypeString)
+	}
 	if value, ok := _u.mutation.FolderName(); ok {
 		_spec.SetField(vod.FieldFolderName, field.TypeString, value)
 	}
@@ -1266,6 +1808,12 @@ func (_u *VodUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if _u.mutation.TmpVideoHlsPathCleared() {
 		_spec.ClearField(vod.FieldTmpVideoHlsPath, field.TypeString)
 	}
+	if value, ok := _u.mutation.TmpAudioPath(); ok {
+		_spec.SetField(vod.FieldTmpAudioPath, field.TypeString, value)
+	}
+	if _u.mutation.TmpAudioPathCleared() {
+		_spec.ClearField(vod.FieldTmpAudioPath, field.TypeString)
+	}
 	if value, ok := _u.mutation.Locked(); ok {
 		_spec.SetField(vod.FieldLocked, field.TypeBool, value)
 	}
@@ -1340,6 +1888,47 @@ func (_u *VodUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if value, ok := _u.mutation.AddedStorageSizeBytes(); ok {
 		_spec.AddField(vod.FieldStorageSizeBytes, field.TypeInt64, value)
 	}
+	if value, ok := _u.mutation.StorageTier(); ok {
+		_spec.SetField(vod.FieldStorageTier, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.HealthStatus(); ok {
+		_spec.SetField(vod.FieldHealthStatus, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.HealthIssues(); ok {
+		_spec.SetField(vod.FieldHealthIssues, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedHealthIssues(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, vod.FieldHealthIssues, value)
+		})
+	}
+	if _u.mutation.HealthIssuesCleared() {
+		_spec.ClearField(vod.FieldHealthIssues, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.HealthCheckedAt(); ok {
+		_spec.SetField(vod.FieldHealthCheckedAt, field.TypeTime, value)
+	}
+	if _u.mutation.HealthCheckedAtCleared() {
+		_spec.ClearField(vod.FieldHealthCheckedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.AudioSourceID(); ok {
+		_spec.SetField(vod.FieldAudioSourceID, field.TypeUUID, value)
+	}
+	if _u.mutation.AudioSourceIDCleared() {
+		_spec.ClearField(vod.FieldAudioSourceID, field.TypeUUID)
+	}
+	if value, ok := _u.mutation.AudioSourceOffset(); ok {
+		_spec.SetField(vod.FieldAudioSourceOffset, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedAudioSourceOffset(); ok {
+		_spec.AddField(vod.FieldAudioSourceOffset, field.TypeFloat64, value)
+	}
+	if _u.mutation.AudioSourceOffsetCleared() {
+		_spec.ClearField(vod.FieldAudioSourceOffset, field.TypeFloat64)
+	}
+	if value, ok := _u.mutation.Authoritative(); ok {
+		_spec.SetField(vod.FieldAuthoritative, field.TypeBool, value)
+	}
 	if value, ok := _u.mutation.StreamedAt(); ok {
 		_spec.SetField(vod.FieldStreamedAt, field.TypeTime, value)
 	}
@@ -1584,6 +2173,260 @@ func (_u *VodUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.ChatMessagesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   vod.ChatMessagesTable,
+			Columns: []string{vod.ChatMessagesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(chatmessage.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedChatMessagesIDs(); len(nodes) > 0 && !_u.mutation.ChatMessagesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   vod.ChatMessagesTable,
+			Columns: []string{vod.ChatMessagesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(chatmessage.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ChatMessagesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   vod.ChatMessagesTable,
+			Columns: []string{vod.ChatMessagesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(chatmessage.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.ChecksumsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   vod.ChecksumsTable,
+			Columns: []string{vod.ChecksumsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(checksum.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedChecksumsIDs(); len(nodes) > 0 && !_u.mutation.ChecksumsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   vod.ChecksumsTable,
+			Columns: []string{vod.ChecksumsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(checksum.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ChecksumsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   vod.ChecksumsTable,
+			Columns: []string{vod.ChecksumsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(checksum.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.LiveGapsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   vod.LiveGapsTable,
+			Columns: []string{vod.LiveGapsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(livegap.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedLiveGapsIDs(); len(nodes) > 0 && !_u.mutation.LiveGapsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   vod.LiveGapsTable,
+			Columns: []string{vod.LiveGapsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(livegap.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.LiveGapsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   vod.LiveGapsTable,
+			Columns: []string{vod.LiveGapsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(livegap.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.ViewingSessionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   vod.ViewingSessionsTable,
+			Columns: []string{vod.ViewingSessionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(viewingsession.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedViewingSessionsIDs(); len(nodes) > 0 && !_u.mutation.ViewingSessionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   vod.ViewingSessionsTable,
+			Columns: []string{vod.ViewingSessionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(viewingsession.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ViewingSessionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   vod.ViewingSessionsTable,
+			Columns: []string{vod.ViewingSessionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(viewingsession.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.UserListItemsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   vod.UserListItemsTable,
+			Columns: []string{vod.UserListItemsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(userlistitem.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedUserListItemsIDs(); len(nodes) > 0 && !_u.mutation.UserListItemsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   vod.UserListItemsTable,
+			Columns: []string{vod.UserListItemsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(userlistitem.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.UserListItemsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   vod.UserListItemsTable,
+			Columns: []string{vod.UserListItemsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(userlistitem.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.TranscodingProfileCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   vod.TranscodingProfileTable,
+			Columns: []string{vod.TranscodingProfileColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(transcodingprofile.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.TranscodingProfileIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   vod.TranscodingProfileTable,
+			Columns: []string{vod.TranscodingProfileColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(transcodingprofile.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{vod.Label}
@@ -1658,6 +2501,27 @@ func (_u *VodUpdateOne) ClearExtStreamID() *VodUpdateOne {
 	return _u
 }
 
+// SetPart sets the "part" field.
+func (_u *VodUpdateOne) SetPart(v int) *VodUpdateOne {
+	_u.mutation.ResetPart()
+	_u.mutation.SetPart(v)
+	return _u
+}
+
+// SetNillablePart sets the "part" field if the given value is not nil.
+func (_u *VodUpdateOne) SetNillablePart(v *int) *VodUpdateOne {
+	if v != nil {
+		_u.SetPart(*v)
+	}
+	return _u
+}
+
+// AddPart adds value to the "part" field.
+func (_u *VodUpdateOne) AddPart(v int) *VodUpdateOne {
+	_u.mutation.AddPart(v)
+	return _u
+}
+
 // SetPlatform sets the "platform" field.
 func (_u *VodUpdateOne) SetPlatform(v utils.VideoPlatform) *VodUpdateOne {
 	_u.mutation.SetPlatform(v)
@@ -1700,6 +2564,26 @@ func (_u *VodUpdateOne) SetNillableTitle(v *string) *VodUpdateOne {
 	return _u
 }
 
+// SetCategory sets the "category" field.
+func (_u *VodUpdateOne) SetCategory(v string) *VodUpdateOne {
+	_u.mutation.SetCategory(v)
+	return _u
+}
+
+// SetNillableCategory sets the "category" field if the given value is not nil.
+func (_u *VodUpdateOne) SetNillableCategory(v *string) *VodUpdateOne {
+	if v != nil {
+		_u.SetCategory(*v)
+	}
+	return _u
+}
+
+// ClearCategory clears the value of the "category" field.
+func (_u *VodUpdateOne) ClearCategory() *VodUpdateOne {
+	_u.mutation.ClearCategory()
+	return _u
+}
+
 // SetDuration sets the "duration" field.
 func (_u *VodUpdateOne) SetDuration(v int) *VodUpdateOne {
 	_u.mutation.ResetDuration()
@@ -1742,9 +2626,29 @@ func (_u *VodUpdateOne) AddClipVodOffset(v int) *VodUpdateOne {
 	return _u
 }
 
-// ClearClipVodOffset clears the value of the "clip_vod_offset" field.
-func (_u *VodUpdateOne) ClearClipVodOffset() *VodUpdateOne {
-	_u.mutation.ClearClipVodOffset()
+// ClearClipVodOffset clears the value of the "clip_vod_offset" field.
+func (_u *VodUpdateOne) ClearClipVodOffset() *VodUpdateOne {
+	_u.mutation.ClearClipVodOffset()
+	return _u
+}
+
+// SetClipVodID sets the "clip_vod_id" field.
+func (_u *VodUpdateOne) SetClipVodID(v uuid.UUID) *VodUpdateOne {
+	_u.mutation.SetClipVodID(v)
+	return _u
+}
+
+// SetNillableClipVodID sets the "clip_vod_id" field if the given value is not nil.
+func (_u *VodUpdateOne) SetNillableClipVodID(v *uuid.UUID) *VodUpdateOne {
+	if v != nil {
+		_u.SetClipVodID(*v)
+	}
+	return _u
+}
+
+// ClearClipVodID clears the value of the "clip_vod_id" field.
+func (_u *VodUpdateOne) ClearClipVodID() *VodUpdateOne {
+	_u.mutation.ClearClipVodID()
 	return _u
 }
 
@@ -1789,6 +2693,42 @@ func (_u *VodUpdateOne) ClearResolution() *VodUpdateOne {
 	return _u
 }
 
+// SetQualityFallbacks sets the "quality_fallbacks" field.
+func (_u *VodUpdateOne) SetQualityFallbacks(v []string) *VodUpdateOne {
+	_u.mutation.SetQualityFallbacks(v)
+	return _u
+}
+
+// AppendQualityFallbacks appends value to the "quality_fallbacks" field.
+func (_u *VodUpdateOne) AppendQualityFallbacks(v []string) *VodUpdateOne {
+	_u.mutation.AppendQualityFallbacks(v)
+	return _u
+}
+
+// ClearQualityFallbacks clears the value of the "quality_fallbacks" field.
+func (_u *VodUpdateOne) ClearQualityFallbacks() *VodUpdateOne {
+	_u.mutation.ClearQualityFallbacks()
+	return _u
+}
+
+// SetQualitySwitches sets the "quality_switches" field.
+func (_u *VodUpdateOne) SetQualitySwitches(v []utils.QualitySwitch) *VodUpdateOne {
+	_u.mutation.SetQualitySwitches(v)
+	return _u
+}
+
+// AppendQualitySwitches appends value to the "quality_switches" field.
+func (_u *VodUpdateOne) AppendQualitySwitches(v []utils.QualitySwitch) *VodUpdateOne {
+	_u.mutation.AppendQualitySwitches(v)
+	return _u
+}
+
+// ClearQualitySwitches clears the value of the "quality_switches" field.
+func (_u *VodUpdateOne) ClearQualitySwitches() *VodUpdateOne {
+	_u.mutation.ClearQualitySwitches()
+	return _u
+}
+
 // SetProcessing sets the "processing" field.
 func (_u *VodUpdateOne) SetProcessing(v bool) *VodUpdateOne {
 	_u.mutation.SetProcessing(v)
@@ -1991,6 +2931,26 @@ func (_u *VodUpdateOne) ClearCaptionPath() *VodUpdateOne {
 	return _u
 }
 
+// SetAudioPath sets the "audio_path" field.
+func (_u *VodUpdateOne) SetAudioPath(v string) *VodUpdateOne {
+	_u.mutation.SetAudioPath(v)
+	return _u
+}
+
+// SetNillableAudioPath sets the "audio_path" field if the given value is not nil.
+func (_u *VodUpdateOne) SetNillableAudioPath(v *string) *VodUpdateOne {
+	if v != nil {
+		_u.SetAudioPath(*v)
+	}
+	return _u
+}
+
+// ClearAudioPath clears the value of the "audio_path" field.
+func (_u *VodUpdateOne) ClearAudioPath() *VodUpdateOne {
+	_u.mutation.ClearAudioPath()
+	return _u
+}
+
 // SetFolderName sets the "folder_name" field.
 func (_u *VodUpdateOne) SetFolderName(v string) *VodUpdateOne {
 	_u.mutation.SetFolderName(v)
@@ -2171,6 +3131,26 @@ func (_u *VodUpdateOne) ClearTmpVideoHlsPath() *VodUpdateOne {
 	return _u
 }
 
+// SetTmpAudioPath sets the "tmp_audio_path" field.
+func (_u *VodUpdateOne) SetTmpAudioPath(v string) *VodUpdateOne {
+	_u.mutation.SetTmpAudioPath(v)
+	return _u
+}
+
+// SetNillableTmpAudioPath sets the "tmp_audio_path" field if the given value is not nil.
+func (_u *VodUpdateOne) SetNillableTmpAudioPath(v *string) *VodUpdateOne {
+	if v != nil {
+		_u.SetTmpAudioPath(*v)
+	}
+	return _u
+}
+
+// ClearTmpAudioPath clears the value of the "tmp_audio_path" field.
+func (_u *VodUpdateOne) ClearTmpAudioPath() *VodUpdateOne {
+	_u.mutation.ClearTmpAudioPath()
+	return _u
+}
+
 // SetLocked sets the "locked" field.
 func (_u *VodUpdateOne) SetLocked(v bool) *VodUpdateOne {
 	_u.mutation.SetLocked(v)
@@ -2394,6 +3374,153 @@ func (_u *VodUpdateOne) AddStorageSizeBytes(v int64) *VodUpdateOne {
 	return _u
 }
 
+// SetStorageTier sets the "storage_tier" field.
+func (_u *VodUpdateOne) SetStorageTier(v utils.StorageTier) *VodUpdateOne {
+	_u.mutation.SetStorageTier(v)
+	return _u
+}
+
+// SetNillableStorageTier sets the "storage_tier" field if the given value is not nil.
+func (_u *VodUpdateOne) SetNillableStorageTier(v *utils.StorageTier) *VodUpdateOne {
+	if v != nil {
+		_u.SetStorageTier(*v)
+	}
+	return _u
+}
+
+// SetHealthStatus sets the "health_status" field.
+func (_u *VodUpdateOne) SetHealthStatus(v utils.VideoHealth) *VodUpdateOne {
+	_u.mutation.SetHealthStatus(v)
+	return _u
+}
+
+// SetNillableHealthStatus sets the "health_status" field if the given value is not nil.
+func (_u *VodUpdateOne) SetNillableHealthStatus(v *utils.VideoHealth) *VodUpdateOne {
+	if v != nil {
+		_u.SetHealthStatus(*v)
+	}
+	return _u
+}
+
+// SetHealthIssues sets the "health_issues" field.
+func (_u *VodUpdateOne) SetHealthIssues(v []utils.VideoHealthIssue) *VodUpdateOne {
+	_u.mutation.SetHealthIssues(v)
+	return _u
+}
+
+// AppendHealthIssues appends value to the "health_issues" field.
+func (_u *VodUpdateOne) AppendHealthIssues(v []utils.VideoHealthIssue) *VodUpdateOne {
+	_u.mutation.AppendHealthIssues(v)
+	return _u
+}
+
+// ClearHealthIssues clears the value of the "health_issues" field.
+func (_u *VodUpdateOne) ClearHealthIssues() *VodUpdateOne {
+	_u.mutation.ClearHealthIssues()
+	return _u
+}
+
+// SetHealthCheckedAt sets the "health_checked_at" field.
+func (_u *VodUpdateOne) SetHealthCheckedAt(v time.Time) *VodUpdateOne {
+	_u.mutation.SetHealthCheckedAt(v)
+	return _u
+}
+
+// SetNillableHealthCheckedAt sets the "health_checked_at" field if the given value is not nil.
+func (_u *VodUpdateOne) SetNillableHealthCheckedAt(v *time.Time) *VodUpdateOne {
+	if v != nil {
+		_u.SetHealthCheckedAt(*v)
+	}
+	return _u
+}
+
+// ClearHealthCheckedAt clears the value of the "health_checked_at" field.
+func (_u *VodUpdateOne) ClearHealthCheckedAt() *VodUpdateOne {
+	_u.mutation.ClearHealthCheckedAt()
+	return _u
+}
+
+// SetAudioSourceID sets the "audio_source_id" field.
+func (_u *VodUpdateOne) SetAudioSourceID(v uuid.UUID) *VodUpdateOne {
+	_u.mutation.SetAudioSourceID(v)
+	return _u
+}
+
+// SetNillableAudioSourceID sets the "audio_source_id" field if the given value is not nil.
+func (_u *VodUpdateOne) SetNillableAudioSourceID(v *uuid.UUID) *VodUpdateOne {
+	if v != nil {
+		_u.SetAudioSourceID(*v)
+	}
+	return _u
+}
+
+// ClearAudioSourceID clears the value of the "audio_source_id" field.
+func (_u *VodUpdateOne) ClearAudioSourceID() *VodUpdateOne {
+	_u.mutation.ClearAudioSourceID()
+	return _u
+}
+
+// SetAudioSourceOffset sets the "audio_source_offset" field.
+func (_u *VodUpdateOne) SetAudioSourceOffset(v float64) *VodUpdateOne {
+	_u.mutation.ResetAudioSourceOffset()
+	_u.mutation.SetAudioSourceOffset(v)
+	return _u
+}
+
+// SetNillableAudioSourceOffset sets the "audio_source_offset" field if the given value is not nil.
+func (_u *VodUpdateOne) SetNillableAudioSourceOffset(v *float64) *VodUpdateOne {
+	if v != nil {
+		_u.SetAudioSourceOffset(*v)
+	}
+	return _u
+}
+
+// AddAudioSourceOffset adds value to the "audio_source_offset" field.
+func (_u *VodUpdateOne) AddAudioSourceOffset(v float64) *VodUpdateOne {
+	_u.mutation.AddAudioSourceOffset(v)
+	return _u
+}
+
+// ClearAudioSourceOffset clears the value of the "audio_source_offset" field.
+func (_u *VodUpdateOne) ClearAudioSourceOffset() *VodUpdateOne {
+	_u.mutation.ClearAudioSourceOffset()
+	return _u
+}
+
+// SetTranscodingProfileID sets the "transcoding_profile_id" field.
+func (_u *VodUpdateOne) SetTranscodingProfileID(v uuid.UUID) *VodUpdateOne {
+	_u.mutation.SetTranscodingProfileID(v)
+	return _u
+}
+
+// SetNillableTranscodingProfileID sets the "transcoding_profile_id" field if the given value is not nil.
+func (_u *VodUpdateOne) SetNillableTranscodingProfileID(v *uuid.UUID) *VodUpdateOne {
+	if v != nil {
+		_u.SetTranscodingProfileID(*v)
+	}
+	return _u
+}
+
+// ClearTranscodingProfileID clears the value of the "transcoding_profile_id" field.
+func (_u *VodUpdateOne) ClearTranscodingProfileID() *VodUpdateOne {
+	_u.mutation.ClearTranscodingProfileID()
+	return _u
+}
+
+// SetAuthoritative sets the "authoritative" field.
+func (_u *VodUpdateOne) SetAuthoritative(v bool) *VodUpdateOne {
+	_u.mutation.SetAuthoritative(v)
+	return _u
+}
+
+// SetNillableAuthoritative sets the "authoritative" field if the given value is not nil.
+func (_u *VodUpdateOne) SetNillableAuthoritative(v *bool) *VodUpdateOne {
+	if v != nil {
+		_u.SetAuthoritative(*v)
+	}
+	return _u
+}
+
 // SetStreamedAt sets the "streamed_at" field.
 func (_u *VodUpdateOne) SetStreamedAt(v time.Time) *VodUpdateOne {
 	_u.mutation.SetStreamedAt(v)
@@ -2504,6 +3631,86 @@ func (_u *VodUpdateOne) AddMultistreamInfo(v ...*MultistreamInfo) *VodUpdateOne
 	return _u.AddMultistreamInfoIDs(ids...)
 }
 
+// AddChatMessageIDs adds the "chat_messages" edge to the ChatMessage entity by IDs.
+func (_u *VodUpdateOne) AddChatMessageIDs(ids ...uuid.UUID) *VodUpdateOne {
+	_u.mutation.AddChatMessageIDs(ids...)
+	return _u
+}
+
+// AddChatMessages adds the "chat_messages" edges to the ChatMessage entity.
+func (_u *VodUpdateOne) AddChatMessages(v ...*ChatMessage) *VodUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddChatMessageIDs(ids...)
+}
+
+// AddChecksumIDs adds the "checksums" edge to the Checksum entity by IDs.
+func (_u *VodUpdateOne) AddChecksumIDs(ids ...uuid.UUID) *VodUpdateOne {
+	_u.mutation.AddChecksumIDs(ids...)
+	return _u
+}
+
+// AddChecksums adds the "checksums" edges to the Checksum entity.
+func (_u *VodUpdateOne) AddChecksums(v ...*Checksum) *VodUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddChecksumIDs(ids...)
+}
+
+// AddLiveGapIDs adds the "live_gaps" edge to the LiveGap entity by IDs.
+func (_u *VodUpdateOne) AddLiveGapIDs(ids ...uuid.UUID) *VodUpdateOne {
+	_u.mutation.AddLiveGapIDs(ids...)
+	return _u
+}
+
+// AddLiveGaps adds the "live_gaps" edges to the LiveGap entity.
+func (_u *VodUpdateOne) AddLiveGaps(v ...*LiveGap) *VodUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddLiveGapIDs(ids...)
+}
+
+// AddViewingSessionIDs adds the "viewing_sessions" edge to the ViewingSession entity by IDs.
+func (_u *VodUpdateOne) AddViewingSessionIDs(ids ...uuid.UUID) *VodUpdateOne {
+	_u.mutation.AddViewingSessionIDs(ids...)
+	return _u
+}
+
+// AddViewingSessions adds the "viewing_sessions" edges to the ViewingSession entity.
+func (_u *VodUpdateOne) AddViewingSessions(v ...*ViewingSession) *VodUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddViewingSessionIDs(ids...)
+}
+
+// AddUserListItemIDs adds the "user_list_items" edge to the UserListItem entity by IDs.
+func (_u *VodUpdateOne) AddUserListItemIDs(ids ...uuid.UUID) *VodUpdateOne {
+	_u.mutation.AddUserListItemIDs(ids...)
+	return _u
+}
+
+// AddUserListItems adds the "user_list_items" edges to the UserListItem entity.
+func (_u *VodUpdateOne) AddUserListItems(v ...*UserListItem) *VodUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddUserListItemIDs(ids...)
+}
+
+// SetTranscodingProfile sets the "transcoding_profile" edge to the TranscodingProfile entity.
+func (_u *VodUpdateOne) SetTranscodingProfile(v *TranscodingProfile) *VodUpdateOne {
+	return _u.SetTranscodingProfileID(v.ID)
+}
+
 // Mutation returns the VodMutation object of the builder.
 func (_u *VodUpdateOne) Mutation() *VodMutation {
 	return _u.mutation
@@ -2548,61 +3755,172 @@ func (_u *VodUpdateOne) ClearChapters() *VodUpdateOne {
 	return _u
 }
 
-// RemoveChapterIDs removes the "chapters" edge to Chapter entities by IDs.
-func (_u *VodUpdateOne) RemoveChapterIDs(ids ...uuid.UUID) *VodUpdateOne {
-	_u.mutation.RemoveChapterIDs(ids...)
+// RemoveChapterIDs removes the "chapters" edge to Chapter entities by IDs.
+func (_u *VodUpdateOne) RemoveChapterIDs(ids ...uuid.UUID) *VodUpdateOne {
+	_u.mutation.RemoveChapterIDs(ids...)
+	return _u
+}
+
+// RemoveChapters removes "chapters" edges to Chapter entities.
+func (_u *VodUpdateOne) RemoveChapters(v ...*Chapter) *VodUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveChapterIDs(ids...)
+}
+
+// ClearMutedSegments clears all "muted_segments" edges to the MutedSegment entity.
+func (_u *VodUpdateOne) ClearMutedSegments() *VodUpdateOne {
+	_u.mutation.ClearMutedSegments()
+	return _u
+}
+
+// RemoveMutedSegmentIDs removes the "muted_segments" edge to MutedSegment entities by IDs.
+func (_u *VodUpdateOne) RemoveMutedSegmentIDs(ids ...uuid.UUID) *VodUpdateOne {
+	_u.mutation.RemoveMutedSegmentIDs(ids...)
+	return _u
+}
+
+// RemoveMutedSegments removes "muted_segments" edges to MutedSegment entities.
+func (_u *VodUpdateOne) RemoveMutedSegments(v ...*MutedSegment) *VodUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveMutedSegmentIDs(ids...)
+}
+
+// ClearMultistreamInfo clears all "multistream_info" edges to the MultistreamInfo entity.
+func (_u *VodUpdateOne) ClearMultistreamInfo() *VodUpdateOne {
+	_u.mutation.ClearMultistreamInfo()
+	return _u
+}
+
+// RemoveMultistreamInfoIDs removes the "multistream_info" edge to MultistreamInfo entities by IDs.
+func (_u *VodUpdateOne) RemoveMultistreamInfoIDs(ids ...int) *VodUpdateOne {
+	_u.mutation.RemoveMultistreamInfoIDs(ids...)
+	return _u
+}
+
+// RemoveMultistreamInfo removes "multistream_info" edges to MultistreamInfo entities.
+func (_u *VodUpdateOne) RemoveMultistreamInfo(v ...*MultistreamInfo) *VodUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveMultistreamInfoIDs(ids...)
+}
+
+// ClearChatMessages clears all "chat_messages" edges to the ChatMessage entity.
+func (_u *VodUpdateOne) ClearChatMessages() *VodUpdateOne {
+	_u.mutation.ClearChatMessages()
+	return _u
+}
+
+// RemoveChatMessageIDs removes the "chat_messages" edge to ChatMessage entities by IDs.
+func (_u *VodUpdateOne) RemoveChatMessageIDs(ids ...uuid.UUID) *VodUpdateOne {
+	_u.mutation.RemoveChatMessageIDs(ids...)
+	return _u
+}
+
+// RemoveChatMessages removes "chat_messages" edges to ChatMessage entities.
+func (_u *VodUpdateOne) RemoveChatMessages(v ...*ChatMessage) *VodUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveChatMessageIDs(ids...)
+}
+
+// ClearChecksums clears all "checksums" edges to the Checksum entity.
+func (_u *VodUpdateOne) ClearChecksums() *VodUpdateOne {
+	_u.mutation.ClearChecksums()
+	return _u
+}
+
+// RemoveChecksumIDs removes the "checksums" edge to Checksum entities by IDs.
+func (_u *VodUpdateOne) RemoveChecksumIDs(ids ...uuid.UUID) *VodUpdateOne {
+	_u.mutation.RemoveChecksumIDs(ids...)
+	return _u
+}
+
+// RemoveChecksums removes "checksums" edges to Checksum entities.
+func (_u *VodUpdateOne) RemoveChecksums(v ...*Checksum) *VodUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveChecksumIDs(ids...)
+}
+
+// ClearLiveGaps clears all "live_gaps" edges to the LiveGap entity.
+func (_u *VodUpdateOne) ClearLiveGaps() *VodUpdateOne {
+	_u.mutation.ClearLiveGaps()
+	return _u
+}
+
+// RemoveLiveGapIDs removes the "live_gaps" edge to LiveGap entities by IDs.
+func (_u *VodUpdateOne) RemoveLiveGapIDs(ids ...uuid.UUID) *VodUpdateOne {
+	_u.mutation.RemoveLiveGapIDs(ids...)
 	return _u
 }
 
-// RemoveChapters removes "chapters" edges to Chapter entities.
-func (_u *VodUpdateOne) RemoveChapters(v ...*Chapter) *VodUpdateOne {
+// RemoveLiveGaps removes "live_gaps" edges to LiveGap entities.
+func (_u *VodUpdateOne) RemoveLiveGaps(v ...*LiveGap) *VodUpdateOne {
 	ids := make([]uuid.UUID, len(v))
 	for i := range v {
 		ids[i] = v[i].ID
 	}
-	return _u.RemoveChapterIDs(ids...)
+	return _u.RemoveLiveGapIDs(ids...)
 }
 
-// ClearMutedSegments clears all "muted_segments" edges to the MutedSegment entity.
-func (_u *VodUpdateOne) ClearMutedSegments() *VodUpdateOne {
-	_u.mutation.ClearMutedSegments()
+// ClearViewingSessions clears all "viewing_sessions" edges to the ViewingSession entity.
+func (_u *VodUpdateOne) ClearViewingSessions() *VodUpdateOne {
+	_u.mutation.ClearViewingSessions()
 	return _u
 }
 
-// RemoveMutedSegmentIDs removes the "muted_segments" edge to MutedSegment entities by IDs.
-func (_u *VodUpdateOne) RemoveMutedSegmentIDs(ids ...uuid.UUID) *VodUpdateOne {
-	_u.mutation.RemoveMutedSegmentIDs(ids...)
+// RemoveViewingSessionIDs removes the "viewing_sessions" edge to ViewingSession entities by IDs.
+func (_u *VodUpdateOne) RemoveViewingSessionIDs(ids ...uuid.UUID) *VodUpdateOne {
+	_u.mutation.RemoveViewingSessionIDs(ids...)
 	return _u
 }
 
-// RemoveMutedSegments removes "muted_segments" edges to MutedSegment entities.
-func (_u *VodUpdateOne) RemoveMutedSegments(v ...*MutedSegment) *VodUpdateOne {
+// RemoveViewingSessions removes "viewing_sessions" edges to ViewingSession entities.
+func (_u *VodUpdateOne) RemoveViewingSessions(v ...*ViewingSession) *VodUpdateOne {
 	ids := make([]uuid.UUID, len(v))
 	for i := range v {
 		ids[i] = v[i].ID
 	}
-	return _u.RemoveMutedSegmentIDs(ids...)
+	return _u.RemoveViewingSessionIDs(ids...)
 }
 
-// ClearMultistreamInfo clears all "multistream_info" edges to the MultistreamInfo entity.
-func (_u *VodUpdateOne) ClearMultistreamInfo() *VodUpdateOne {
-	_u.mutation.ClearMultistreamInfo()
+// ClearUserListItems clears all "user_list_items" edges to the UserListItem entity.
+func (_u *VodUpdateOne) ClearUserListItems() *VodUpdateOne {
+	_u.mutation.ClearUserListItems()
 	return _u
 }
 
-// RemoveMultistreamInfoIDs removes the "multistream_info" edge to MultistreamInfo entities by IDs.
-func (_u *VodUpdateOne) RemoveMultistreamInfoIDs(ids ...int) *VodUpdateOne {
-	_u.mutation.RemoveMultistreamInfoIDs(ids...)
+// RemoveUserListItemIDs removes the "user_list_items" edge to UserListItem entities by IDs.
+func (_u *VodUpdateOne) RemoveUserListItemIDs(ids ...uuid.UUID) *VodUpdateOne {
+	_u.mutation.RemoveUserListItemIDs(ids...)
 	return _u
 }
 
-// RemoveMultistreamInfo removes "multistream_info" edges to MultistreamInfo entities.
-func (_u *VodUpdateOne) RemoveMultistreamInfo(v ...*MultistreamInfo) *VodUpdateOne {
-	ids := make([]int, len(v))
+// RemoveUserListItems removes "user_list_items" edges to UserListItem entities.
+func (_u *VodUpdateOne) RemoveUserListItems(v ...*UserListItem) *VodUpdateOne {
+	ids := make([]uuid.UUID, len(v))
 	for i := range v {
 		ids[i] = v[i].ID
 	}
-	return _u.RemoveMultistreamInfoIDs(ids...)
+	return _u.RemoveUserListItemIDs(ids...)
+}
+
+// ClearTranscodingProfile clears the "transcoding_profile" edge to the TranscodingProfile entity.
+func (_u *VodUpdateOne) ClearTranscodingProfile() *VodUpdateOne {
+	_u.mutation.ClearTranscodingProfile()
+	return _u
 }
 
 // Where appends a list predicates to the VodUpdate builder.
@@ -2656,6 +3974,11 @@ func (_u *VodUpdateOne) defaults() {
 
 // check runs all checks and user-defined validators on the builder.
 func (_u *VodUpdateOne) check() error {
+	if v, ok := _u.mutation.Part(); ok {
+		if err := vod.PartValidator(v); err != nil {
+			return &ValidationError{Name: "part", err: fmt.Errorf(`ent: validator failed for field "Vod.part": %w`, err)}
+		}
+	}
 	if v, ok := _u.mutation.Platform(); ok {
 		if err := vod.PlatformValidator(v); err != nil {
 			return &ValidationError{Name: "platform", err: fmt.Errorf(`ent: validator failed for field "Vod.platform": %w`, err)}
@@ -2666,6 +3989,16 @@ func (_u *VodUpdateOne) check() error {
 			return &ValidationError{Name: "type", err: fmt.Errorf(`ent: validator failed for field "Vod.type": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.StorageTier(); ok {
+		if err := vod.StorageTierValidator(v); err != nil {
+			return &ValidationError{Name: "storage_tier", err: fmt.Errorf(`ent: validator failed for field "Vod.storage_tier": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.HealthStatus(); ok {
+		if err := vod.HealthStatusValidator(v); err != nil {
+			return &ValidationError{Name: "health_status", err: fmt.Errorf(`ent: validator failed for field "Vod.health_status": %w`, err)}
+		}
+	}
 	if _u.mutation.ChannelCleared() && len(_u.mutation.ChannelIDs()) > 0 {
 		return errors.New(`ent: clearing a required unique edge "Vod.channel"`)
 	}
@@ -2716,6 +4049,12 @@ func (_u *VodUpdateOne) sqlSave(ctx context.Context) (_node *Vod, err error) {
 	if _u.mutation.ExtStreamIDCleared() {
 		_spec.ClearField(vod.FieldExtStreamID, field.TypeString)
 	}
+	if value, ok := _u.mutation.Part(); ok {
+		_spec.SetField(vod.FieldPart, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedPart(); ok {
+		_spec.AddField(vod.FieldPart, field.TypeInt, value)
+	}
 	if value, ok := _u.mutation.Platform(); ok {
 		_spec.SetField(vod.FieldPlatform, field.TypeEnum, value)
 	}
@@ -2725,6 +4064,12 @@ func (_u *VodUpdateOne) sqlSave(ctx context.Context) (_node *Vod, err error) {
 	if value, ok := _u.mutation.Title(); ok {
 		_spec.SetField(vod.FieldTitle, field.TypeString, value)
 	}
+	if value, ok := _u.mutation.Category(); ok {
+		_spec.SetField(vod.FieldCategory, field.TypeString, value)
+	}
+	if _u.mutation.CategoryCleared() {
+		_spec.ClearField(vod.FieldCategory, field.TypeString)
+	}
 	if value, ok := _u.mutation.Duration(); ok {
 		_spec.SetField(vod.FieldDuration, field.TypeInt, value)
 	}
@@ -2740,6 +4085,12 @@ func (_u *VodUpdateOne) sqlSave(ctx context.Context) (_node *Vod, err error) {
 	if _u.mutation.ClipVodOffsetCleared() {
 		_spec.ClearField(vod.FieldClipVodOffset, field.TypeInt)
 	}
+	if value, ok := _u.mutation.ClipVodID(); ok {
+		_spec.SetField(vod.FieldClipVodID, field.TypeUUID, value)
+	}
+	if _u.mutation.ClipVodIDCleared() {
+		_spec.ClearField(vod.FieldClipVodID, field.TypeUUID)
+	}
 	if value, ok := _u.mutation.Views(); ok {
 		_spec.SetField(vod.FieldViews, field.TypeInt, value)
 	}
@@ -2752,6 +4103,28 @@ func (_u *VodUpdateOne) sqlSave(ctx context.Context) (_node *Vod, err error) {
 	if _u.mutation.ResolutionCleared() {
 		_spec.ClearField(vod.FieldResolution, field.TypeString)
 	}
+	if value, ok := _u.mutation.QualityFallbacks(); ok {
+		_spec.SetField(vod.FieldQualityFallbacks, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedQualityFallbacks(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, vod.FieldQualityFallbacks, value)
+		})
+	}
+	if _u.mutation.QualityFallbacksCleared() {
+		_spec.ClearField(vod.FieldQualityFallbacks, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.QualitySwitches(); ok {
+		_spec.SetField(vod.FieldQualitySwitches, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedQualitySwitches(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, vod.FieldQualitySwitches, value)
+		})
+	}
+	if _u.mutation.QualitySwitchesCleared() {
+		_spec.ClearField(vod.FieldQualitySwitches, field.TypeJSON)
+	}
 	if value, ok := _u.mutation.Processing(); ok {
 		_spec.SetField(vod.FieldProcessing, field.TypeBool, value)
 	}
@@ -2809,6 +4182,12 @@ func (_u *VodUpdateOne) sqlSave(ctx context.Context) (_node *Vod, err error) {
 	if _u.mutation.CaptionPathCleared() {
 		_spec.ClearField(vod.FieldCaptionPath, field.TypeString)
 	}
+	if value, ok := _u.mutation.AudioPath(); ok {
+		_spec.SetField(vod.FieldAudioPath, field.TypeString, value)
+	}
+	if _u.mutation.AudioPathCleared() {
+		_spec.ClearField(vod.FieldAudioPath, field.TypeString)
+	}
 	if value, ok := _u.mutation.FolderName(); ok {
 		_spec.SetField(vod.FieldFolderName, field.TypeString, value)
 	}
@@ -2863,6 +4242,12 @@ func (_u *VodUpdateOne) sqlSave(ctx context.Context) (_node *Vod, err error) {
 	if _u.mutation.TmpVideoHlsPathCleared() {
 		_spec.ClearField(vod.FieldTmpVideoHlsPath, field.TypeString)
 	}
+	if value, ok := _u.mutation.TmpAudioPath(); ok {
+		_spec.SetField(vod.FieldTmpAudioPath, field.TypeString, value)
+	}
+	if _u.mutation.TmpAudioPathCleared() {
+		_spec.ClearField(vod.FieldTmpAudioPath, field.TypeString)
+	}
 	if value, ok := _u.mutation.Locked(); ok {
 		_spec.SetField(vod.FieldLocked, field.TypeBool, value)
 	}
@@ -2937,6 +4322,47 @@ func (_u *VodUpdateOne) sqlSave(ctx context.Context) (_node *Vod, err error) {
 	if value, ok := _u.mutation.AddedStorageSizeBytes(); ok {
 		_spec.AddField(vod.FieldStorageSizeBytes, field.TypeInt64, value)
 	}
+	if value, ok := _u.mutation.StorageTier(); ok {
+		_spec.SetField(vod.FieldStorageTier, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.HealthStatus(); ok {
+		_spec.SetField(vod.FieldHealthStatus, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.HealthIssues(); ok {
+		_spec.SetField(vod.FieldHealthIssues, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedHealthIssues(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, vod.FieldHealthIssues, value)
+		})
+	}
+	if _u.mutation.HealthIssuesCleared() {
+		_spec.ClearField(vod.FieldHealthIssues, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.HealthCheckedAt(); ok {
+		_spec.SetField(vod.FieldHealthCheckedAt, field.TypeTime, value)
+	}
+	if _u.mutation.HealthCheckedAtCleared() {
+		_spec.ClearField(vod.FieldHealthCheckedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.AudioSourceID(); ok {
+		_spec.SetField(vod.FieldAudioSourceID, field.TypeUUID, value)
+	}
+	if _u.mutation.AudioSourceIDCleared() {
+		_spec.ClearField(vod.FieldAudioSourceID, field.TypeUUID)
+	}
+	if value, ok := _u.mutation.AudioSourceOffset(); ok {
+		_spec.SetField(vod.FieldAudioSourceOffset, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedAudioSourceOffset(); ok {
+		_spec.AddField(vod.FieldAudioSourceOffset, field.TypeFloat64, value)
+	}
+	if _u.mutation.AudioSourceOffsetCleared() {
+		_spec.ClearField(vod.FieldAudioSourceOffset, field.TypeFloat64)
+	}
+	if value, ok := _u.mutation.Authoritative(); ok {
+		_spec.SetField(vod.FieldAuthoritative, field.TypeBool, value)
+	}
 	if value, ok := _u.mutation.StreamedAt(); ok {
 		_spec.SetField(vod.FieldStreamedAt, field.TypeTime, value)
 	}
@@ -3181,6 +4607,260 @@ func (_u *VodUpdateOne) sqlSave(ctx context.Context) (_node *Vod, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.ChatMessagesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   vod.ChatMessagesTable,
+			Columns: []string{vod.ChatMessagesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(chatmessage.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedChatMessagesIDs(); len(nodes) > 0 && !_u.mutation.ChatMessagesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   vod.ChatMessagesTable,
+			Columns: []string{vod.ChatMessagesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(chatmessage.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ChatMessagesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   vod.ChatMessagesTable,
+			Columns: []string{vod.ChatMessagesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(chatmessage.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.ChecksumsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   vod.ChecksumsTable,
+			Columns: []string{vod.ChecksumsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(checksum.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedChecksumsIDs(); len(nodes) > 0 && !_u.mutation.ChecksumsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   vod.ChecksumsTable,
+			Columns: []string{vod.ChecksumsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(checksum.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ChecksumsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   vod.ChecksumsTable,
+			Columns: []string{vod.ChecksumsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(checksum.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.LiveGapsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   vod.LiveGapsTable,
+			Columns: []string{vod.LiveGapsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(livegap.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedLiveGapsIDs(); len(nodes) > 0 && !_u.mutation.LiveGapsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   vod.LiveGapsTable,
+			Columns: []string{vod.LiveGapsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(livegap.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.LiveGapsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   vod.LiveGapsTable,
+			Columns: []string{vod.LiveGapsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(livegap.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.ViewingSessionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   vod.ViewingSessionsTable,
+			Columns: []string{vod.ViewingSessionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(viewingsession.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedViewingSessionsIDs(); len(nodes) > 0 && !_u.mutation.ViewingSessionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   vod.ViewingSessionsTable,
+			Columns: []string{vod.ViewingSessionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(viewingsession.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ViewingSessionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   vod.ViewingSessionsTable,
+			Columns: []string{vod.ViewingSessionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(viewingsession.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.UserListItemsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   vod.UserListItemsTable,
+			Columns: []string{vod.UserListItemsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(userlistitem.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedUserListItemsIDs(); len(nodes) > 0 && !_u.mutation.UserListItemsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   vod.UserListItemsTable,
+			Columns: []string{vod.UserListItemsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(userlistitem.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.UserListItemsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   vod.UserListItemsTable,
+			Columns: []string{vod.UserListItemsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(userlistitem.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.TranscodingProfileCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   vod.TranscodingProfileTable,
+			Columns: []string{vod.TranscodingProfileColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(transcodingprofile.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.TranscodingProfileIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   vod.TranscodingProfileTable,
+			Columns: []string{vod.TranscodingProfileColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(transcodingprofile.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_node = &Vod{config: _u.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
@@ -27,6 +27,9 @@ if [ "${SKIP_CHOWN}" != "true" ]; then
   chown -R abc:abc "${CONFIG_DIR}"
   chown -R abc:abc "${TEMP_DIR}"
   chown abc:abc "${VIDEOS_DIR}"
+  if [ -n "${COLD_VIDEOS_DIR}" ]; then
+    chown abc:abc "${COLD_VIDEOS_DIR}"
+  fi
 else
   echo "Skipping chown because SKIP_CHOWN=true"
 fi
@@ -80,6 +80,14 @@ const TRIGGER_VARIABLES: Record<string, { label: string; vars: string[] }[]> = {
     { label: "Channel", vars: CHANNEL_VARS },
     { label: "Live", vars: ["category"] },
   ],
+  low_disk_space: [
+    { label: "Disk", vars: ["directory_path", "free_space", "min_free_space"] },
+  ],
+  checksum_mismatch: [
+    { label: "Channel", vars: CHANNEL_VARS },
+    { label: "Video", vars: VIDEO_VARS },
+    { label: "Checksum", vars: ["checksum_files"] },
+  ],
 };
 
 const TemplateVariableHints = ({ triggerKey, variablesLabel }: { triggerKey: string; variablesLabel: string }) => {
@@ -135,10 +143,14 @@ const AdminNotificationsPage = () => {
       trigger_live_success: false,
       trigger_error: false,
       trigger_is_live: false,
+      trigger_low_disk_space: false,
+      trigger_checksum_mismatch: false,
       video_success_template: "✅ Video Archived: {{vod_title}} by {{channel_display_name}}.",
       live_success_template: "✅ Live Stream Archived: {{vod_title}} by {{channel_display_name}}.",
       error_template: "⚠️ Error: Queue {{queue_id}} failed at task {{failed_task}}.",
       is_live_template: "🔴 {{channel_display_name}} is live!",
+      low_disk_space_template: "💾 Low Disk Space: {{directory_path}} has {{free_space}} free, below the minimum of {{min_free_space}}. New archives are paused.",
+      checksum_mismatch_template: "🧬 Checksum Mismatch: {{checksum_files}} of {{vod_title}} by {{channel_display_name}} changed or went missing.",
       apprise_urls: "",
       apprise_title: "",
       apprise_type: AppriseType.Info,
@@ -165,7 +177,7 @@ const AdminNotificationsPage = () => {
         }
       }
 
-      if (!values.trigger_video_success && !values.trigger_live_success && !values.trigger_error && !values.trigger_is_live) {
+      if (!values.trigger_video_success && !values.trigger_live_success && !values.trigger_error && !values.trigger_is_live && !values.trigger_low_disk_space && !values.trigger_checksum_mismatch) {
         errors.trigger_video_success = t("validation.triggerRequired");
       }
 
@@ -181,6 +193,12 @@ const AdminNotificationsPage = () => {
       if (values.trigger_is_live && !values.is_live_template.trim()) {
         errors.is_live_template = t("validation.templateRequired");
       }
+      if (values.trigger_low_disk_space && !values.low_disk_space_template.trim()) {
+        errors.low_disk_space_template = t("validation.templateRequired");
+      }
+      if (values.trigger_checksum_mismatch && !values.checksum_mismatch_template.trim()) {
+        errors.checksum_mismatch_template = t("validation.templateRequired");
+      }
 
       if (values.type === NotificationType.Apprise && !values.apprise_urls.trim() && !values.apprise_tag.trim()) {
         errors.apprise_urls = t("validation.appriseUrlsOrTagRequired");
@@ -208,10 +226,14 @@ const AdminNotificationsPage = () => {
       trigger_live_success: n.trigger_live_success,
       trigger_error: n.trigger_error,
       trigger_is_live: n.trigger_is_live,
+      trigger_low_disk_space: n.trigger_low_disk_space,
+      trigger_checksum_mismatch: n.trigger_checksum_mismatch,
       video_success_template: n.video_success_template,
       live_success_template: n.live_success_template,
       error_template: n.error_template,
       is_live_template: n.is_live_template,
+      low_disk_space_template: n.low_disk_space_template,
+      checksum_mismatch_template: n.checksum_mismatch_template,
       apprise_urls: n.apprise_urls,
       apprise_title: n.apprise_title,
       apprise_type: n.apprise_type || AppriseType.Info,
@@ -358,6 +380,8 @@ const AdminNotificationsPage = () => {
                   if (n.trigger_live_success) triggers.push(t("triggerLiveSuccess"));
                   if (n.trigger_error) triggers.push(t("triggerError"));
                   if (n.trigger_is_live) triggers.push(t("triggerIsLive"));
+                  if (n.trigger_low_disk_space) triggers.push(t("triggerLowDiskSpace"));
+                  if (n.trigger_checksum_mismatch) triggers.push(t("triggerChecksumMismatch"));
                   return (
                     <Group gap={4}>
                       {triggers.map((tr) => (
@@ -545,6 +569,42 @@ const AdminNotificationsPage = () => {
             </>
           )}
 
+          <Checkbox
+            mt={10}
+            label={t("drawer.triggerLowDiskSpace")}
+            {...form.getInputProps("trigger_low_disk_space", { type: "checkbox" })}
+          />
+          {form.values.trigger_low_disk_space && (
+            <>
+              <Textarea
+                mt={5}
+                ml={28}
+                label={t("drawer.messageLabel")}
+                required
+                {...form.getInputProps("low_disk_space_template")}
+              />
+              <TemplateVariableHints triggerKey="low_disk_space" variablesLabel={t("drawer.variablesLabel")} />
+            </>
+          )}
+
+          <Checkbox
+            mt={10}
+            label={t("drawer.triggerChecksumMismatch")}
+            {...form.getInputProps("trigger_checksum_mismatch", { type: "checkbox" })}
+          />
+          {form.values.trigger_checksum_mismatch && (
+            <>
+              <Textarea
+                mt={5}
+                ml={28}
+                label={t("drawer.messageLabel")}
+                required
+                {...form.getInputProps("checksum_mismatch_template")}
+              />
+              <TemplateVariableHints triggerKey="checksum_mismatch" variablesLabel={t("drawer.variablesLabel")} />
+            </>
+          )}
+
           {/* Apprise-specific fields */}
           {form.values.type === NotificationType.Apprise && (
             <>
@@ -638,6 +698,8 @@ const AdminNotificationsPage = () => {
                 { value: NotificationEventType.LiveSuccess, label: t("testModal.eventLiveSuccess") },
                 { value: NotificationEventType.Error, label: t("testModal.eventError") },
                 { value: NotificationEventType.IsLive, label: t("testModal.eventIsLive") },
+                { value: NotificationEventType.LowDiskSpace, label: t("testModal.eventLowDiskSpace") },
+                { value: NotificationEventType.ChecksumMismatch, label: t("testModal.eventChecksumMismatch") },
               ]}
               value={testEventType}
               onChange={(e) => setTestEventType(e.currentTarget.value as NotificationEventType)}
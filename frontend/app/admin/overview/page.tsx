@@ -2,12 +2,12 @@
 import GanymedeLoadingText from "@/app/components/utils/GanymedeLoadingText";
 import { useGetGanymedeStorageDistribution, useGetGanymedeSystemOverview, useGetGanymedeVideoStatistics } from "@/app/hooks/useAdmin";
 import { useAxiosPrivate } from "@/app/hooks/useAxios";
-import { Container, Title, Text, Paper, SimpleGrid, Group, RingProgress, Stack, Box, useMantineTheme } from "@mantine/core";
+import { Alert, Container, Title, Text, Paper, SimpleGrid, Group, RingProgress, Stack, Box, useMantineTheme } from "@mantine/core";
 import classes from "./AdminOverviewPage.module.css"
 import { useEffect, useState } from "react";
 import { useTranslations } from "next-intl";
 import { formatBytes, usePageTitle } from "@/app/util/util";
-import { IconCpu, IconDatabase, IconDeviceDesktop, IconUser, IconVideo } from "@tabler/icons-react";
+import { IconAlertTriangle, IconCpu, IconDatabase, IconDeviceDesktop, IconUser, IconVideo } from "@tabler/icons-react";
 import { PieChart } from "@mantine/charts";
 const colors = [
   'indigo.6', 'yellow.6', 'teal.6', 'gray.6',
@@ -89,6 +89,16 @@ const AdminOverviewPage = () => {
 
   return (
     <Container mt={10} size={"7xl"}>
+      {/* Low disk space */}
+      {systemOverview.disk_space?.low && (
+        <Alert color="red" icon={<IconAlertTriangle size={18} />} title={t('system.lowDiskSpaceTitle')} mb={10}>
+          {systemOverview.disk_space.directories.filter((directory) => directory.low).map((directory) => (
+            <Text key={directory.name} size="sm">
+              {t('system.lowDiskSpaceDirectory', { path: directory.path, free: formatBytes(directory.free_bytes, 2), min: formatBytes(directory.min_free_bytes, 2) })}
+            </Text>
+          ))}
+        </Alert>
+      )}
       {/* System Overview */}
       <Paper shadow="xs" withBorder p="xl">
         <Title order={4} >
@@ -1,7 +1,7 @@
 "use client"
 import GanymedeLoadingText from "@/app/components/utils/GanymedeLoadingText"
 import { useAxiosPrivate } from "@/app/hooks/useAxios"
-import { Config, ProxyListItem, ProxyType, useEditConfig, useGetConfig } from "@/app/hooks/useConfig"
+import { Config, ProxyListItem, ProxyType, StreamVersionPolicy, useEditConfig, useGetConfig } from "@/app/hooks/useConfig"
 import { ActionIcon, Button, Card, Checkbox, Code, Collapse, Container, MultiSelect, NumberInput, Select, Text, Textarea, TextInput, Title } from "@mantine/core"
 import { useForm } from "@mantine/form"
 import { useDisclosure } from "@mantine/hooks"
@@ -12,6 +12,7 @@ import { Channel, useFetchChannels } from "@/app/hooks/useChannels"
 import { showNotification } from "@mantine/notifications"
 import { useTranslations } from "next-intl"
 import { usePageTitle } from "@/app/util/util"
+import TranscodingProfiles from "@/app/components/admin/settings/TranscodingProfiles"
 
 interface SelectOption {
   label: string;
@@ -46,11 +47,26 @@ const AdminSettingsPage = () => {
         video_convert: data?.parameters.video_convert || "",
         chat_render: data?.parameters.chat_render || "",
         yt_dlp_video: data?.parameters.yt_dlp_video || "",
+        speech_to_text: data?.parameters.speech_to_text || "",
       },
       archive: {
         save_as_hls: data?.archive.save_as_hls ?? false,
+        hls_renditions: data?.archive.hls_renditions ?? false,
         generate_sprite_thumbnails: data?.archive.generate_sprite_thumbnails ?? true,
-        generate_nfo_files: data?.archive.generate_nfo_files ?? true
+        generate_nfo_files: data?.archive.generate_nfo_files ?? true,
+        min_free_space_videos_gb: data?.archive.min_free_space_videos_gb ?? 0,
+        min_free_space_temp_gb: data?.archive.min_free_space_temp_gb ?? 0,
+        recover_muted_audio: data?.archive.recover_muted_audio ?? true,
+        stream_version_policy: data?.archive.stream_version_policy || StreamVersionPolicy.KeepBoth,
+      },
+      cold_storage: {
+        enabled: data?.cold_storage.enabled ?? false,
+        min_age_days: data?.cold_storage.min_age_days ?? 90,
+        unwatched_days: data?.cold_storage.unwatched_days ?? 30,
+      },
+      checksums: {
+        enabled: data?.checksums.enabled ?? true,
+        verify_days: data?.checksums.verify_days ?? 30,
       },
       storage_templates: {
         folder_template: data?.storage_templates.folder_template || "",
@@ -62,6 +78,7 @@ const AdminSettingsPage = () => {
         proxy_enabled: data?.livestream.proxy_enabled ?? true,
         proxy_whitelist: data?.livestream.proxy_whitelist || [],
         watch_while_archiving: data?.livestream.watch_while_archiving ?? false,
+        reconnect_window_seconds: data?.livestream.reconnect_window_seconds ?? 0,
       }
     }
   })
@@ -174,6 +191,15 @@ const AdminSettingsPage = () => {
               mr={15}
             />
 
+            <Checkbox
+              mt={15}
+              label={t('archiveSettings.hlsRenditionsLabel')}
+              description={t('archiveSettings.hlsRenditionsDescription')}
+              key={form.key('archive.hls_renditions')}
+              {...form.getInputProps('archive.hls_renditions', { type: "checkbox" })}
+              mr={15}
+            />
+
             <Checkbox
               mt={15}
               label={t('archiveSettings.generateSpriteThumbnailsLabel')}
@@ -192,6 +218,96 @@ const AdminSettingsPage = () => {
               mr={15}
             />
 
+            <Checkbox
+              mt={15}
+              label={t('archiveSettings.recoverMutedAudioLabel')}
+              description={t('archiveSettings.recoverMutedAudioDescription')}
+              key={form.key('archive.recover_muted_audio')}
+              {...form.getInputProps('archive.recover_muted_audio', { type: "checkbox" })}
+              mr={15}
+            />
+
+            <Select
+              mt={10}
+              label={t('archiveSettings.streamVersionPolicyLabel')}
+              description={t('archiveSettings.streamVersionPolicyDescription')}
+              key={form.key('archive.stream_version_policy')}
+              data={Object.values(StreamVersionPolicy).map((value) => ({
+                label: t(`archiveSettings.streamVersionPolicies.${value}`),
+                value: value,
+              }))}
+              allowDeselect={false}
+              {...form.getInputProps('archive.stream_version_policy')}
+            />
+
+            <NumberInput
+              mt={10}
+              label={t('archiveSettings.minFreeSpaceVideosLabel')}
+              description={t('archiveSettings.minFreeSpaceDescription')}
+              placeholder="0"
+              key={form.key('archive.min_free_space_videos_gb')}
+              {...form.getInputProps('archive.min_free_space_videos_gb')}
+              min={0}
+            />
+
+            <NumberInput
+              mt={10}
+              label={t('archiveSettings.minFreeSpaceTempLabel')}
+              description={t('archiveSettings.minFreeSpaceDescription')}
+              placeholder="0"
+              key={form.key('archive.min_free_space_temp_gb')}
+              {...form.getInputProps('archive.min_free_space_temp_gb')}
+              min={0}
+            />
+
+            <Checkbox
+              mt={15}
+              label={t('archiveSettings.coldStorageEnabledLabel')}
+              description={t('archiveSettings.coldStorageEnabledDescription')}
+              key={form.key('cold_storage.enabled')}
+              {...form.getInputProps('cold_storage.enabled', { type: "checkbox" })}
+              mr={15}
+            />
+
+            <NumberInput
+              mt={10}
+              label={t('archiveSettings.coldStorageMinAgeDaysLabel')}
+              description={t('archiveSettings.coldStorageMinAgeDaysDescription')}
+              placeholder="90"
+              key={form.key('cold_storage.min_age_days')}
+              {...form.getInputProps('cold_storage.min_age_days')}
+              min={0}
+            />
+
+            <NumberInput
+              mt={10}
+              label={t('archiveSettings.coldStorageUnwatchedDaysLabel')}
+              description={t('archiveSettings.coldStorageUnwatchedDaysDescription')}
+              placeholder="30"
+              key={form.key('cold_storage.unwatched_days')}
+              {...form.getInputProps('cold_storage.unwatched_days')}
+              min={0}
+            />
+
+            <Checkbox
+              mt={15}
+              label={t('archiveSettings.checksumsEnabledLabel')}
+              description={t('archiveSettings.checksumsEnabledDescription')}
+              key={form.key('checksums.enabled')}
+              {...form.getInputProps('checksums.enabled', { type: "checkbox" })}
+              mr={15}
+            />
+
+            <NumberInput
+              mt={10}
+              label={t('archiveSettings.checksumsVerifyDaysLabel')}
+              description={t('archiveSettings.checksumsVerifyDaysDescription')}
+              placeholder="30"
+              key={form.key('checksums.verify_days')}
+              {...form.getInputProps('checksums.verify_days')}
+              min={1}
+            />
+
             <Button
               mt={15}
               onClick={toggleStorageTemplate}
@@ -313,6 +429,8 @@ const AdminSettingsPage = () => {
               {...form.getInputProps('parameters.video_convert')}
             />
 
+            <TranscodingProfiles />
+
             <TextInput
               label={t('videoSettings.ytdlpVideoArgsLabel')}
               description={t('videoSettings.ytdlpVideoArgsDescription')}
@@ -320,6 +438,14 @@ const AdminSettingsPage = () => {
               {...form.getInputProps('parameters.yt_dlp_video')}
             />
 
+            <TextInput
+              label={t('videoSettings.speechToTextLabel')}
+              description={t('videoSettings.speechToTextDescription')}
+              placeholder="whisper-cli -m /models/ggml-base.bin -f {{input}} -ovtt -of {{output_prefix}}"
+              key={form.key('parameters.speech_to_text')}
+              {...form.getInputProps('parameters.speech_to_text')}
+            />
+
             <Title mt={10} order={3}>{t('videoSettings.liveStreamTitle')}</Title>
 
             <Checkbox
@@ -331,6 +457,16 @@ const AdminSettingsPage = () => {
               mr={15}
             />
 
+            <NumberInput
+              mt={10}
+              label={t('videoSettings.reconnectWindowLabel')}
+              description={t('videoSettings.reconnectWindowDescription')}
+              placeholder="0"
+              key={form.key('livestream.reconnect_window_seconds')}
+              {...form.getInputProps('livestream.reconnect_window_seconds')}
+              min={0}
+            />
+
             <Title mt={5} order={5}>{t('videoSettings.proxySettings')}</Title>
             <Text>{t('videoSettings.proxySettingsDescription')}</Text>
 
@@ -223,6 +223,114 @@ const AdminTasksPage = () => {
             </Tooltip>
           </Group>
 
+          <Group justify="space-between" py={5} wrap="nowrap">
+            <Box>
+              <Text fw={"bold"}>{t('indexChat')}</Text>
+              <Text size="xs">{t('indexChatDescription')}</Text>
+            </Box>
+            <Tooltip label={t('startTaskButton')}>
+              <ActionIcon
+                onClick={() => startTask(Task.IndexChat)}
+                loading={loading}
+                color="green"
+                variant="filled"
+                size="lg"
+              >
+                <IconPlayerPlay size={24} />
+              </ActionIcon>
+            </Tooltip>
+          </Group>
+
+          <Group justify="space-between" py={5} wrap="nowrap">
+            <Box>
+              <Text fw={"bold"}>{t('auditLibrary')}</Text>
+              <Text size="xs">{t('auditLibraryDescription')}</Text>
+            </Box>
+            <Tooltip label={t('startTaskButton')}>
+              <ActionIcon
+                onClick={() => startTask(Task.AuditLibrary)}
+                loading={loading}
+                color="green"
+                variant="filled"
+                size="lg"
+              >
+                <IconPlayerPlay size={24} />
+              </ActionIcon>
+            </Tooltip>
+          </Group>
+
+          <Group justify="space-between" py={5} wrap="nowrap">
+            <Box>
+              <Text fw={"bold"}>{t('recordChecksums')}</Text>
+              <Text size="xs">{t('recordChecksumsDescription')}</Text>
+            </Box>
+            <Tooltip label={t('startTaskButton')}>
+              <ActionIcon
+                onClick={() => startTask(Task.RecordChecksums)}
+                loading={loading}
+                color="green"
+                variant="filled"
+                size="lg"
+              >
+                <IconPlayerPlay size={24} />
+              </ActionIcon>
+            </Tooltip>
+          </Group>
+
+          <Group justify="space-between" py={5} wrap="nowrap">
+            <Box>
+              <Text fw={"bold"}>{t('verifyChecksums')}</Text>
+              <Text size="xs">{t('verifyChecksumsDescription')}</Text>
+            </Box>
+            <Tooltip label={t('startTaskButton')}>
+              <ActionIcon
+                onClick={() => startTask(Task.VerifyChecksums)}
+                loading={loading}
+                color="green"
+                variant="filled"
+                size="lg"
+              >
+                <IconPlayerPlay size={24} />
+              </ActionIcon>
+            </Tooltip>
+          </Group>
+
+          <Group justify="space-between" py={5} wrap="nowrap">
+            <Box>
+              <Text fw={"bold"}>{t('recoverMutedAudio')}</Text>
+              <Text size="xs">{t('recoverMutedAudioDescription')}</Text>
+            </Box>
+            <Tooltip label={t('startTaskButton')}>
+              <ActionIcon
+                onClick={() => startTask(Task.RecoverMutedAudio)}
+                loading={loading}
+                color="green"
+                variant="filled"
+                size="lg"
+              >
+                <IconPlayerPlay size={24} />
+              </ActionIcon>
+            </Tooltip>
+          </Group>
+
+          <Group justify="space-between" py={5} wrap="nowrap">
+            <Box>
+              <Text fw={"bold"}>{t('reconcileStreamVersions')}</Text>
+              <Text size="xs">{t('reconcileStreamVersionsDescription')}</Text>
+            </Box>
+            <Tooltip label={t('startTaskButton')}>
+              <ActionIcon
+                onClick={() => startTask(Task.ReconcileStreamVersions)}
+                loading={loading}
+                color="green"
+                variant="filled"
+                size="lg"
+              >
+                <IconPlayerPlay size={24} />
+              </ActionIcon>
+            </Tooltip>
+          </Group>
+
           <Group justify="space-between" py={5} wrap="nowrap">
             <Box>
               <Text fw={"bold"}>{t('updateVideoStorageUsage')}</Text>
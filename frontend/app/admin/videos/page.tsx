@@ -13,6 +13,7 @@ import { useGetVideosNoPaginate, useLockVideo, Video } from "@/app/hooks/useVide
 import AdminVideoDrawerContent, { VideoEditMode } from "@/app/components/admin/video/DrawerContent";
 import DeleteVideoModalContent from "@/app/components/admin/video/DeleteModalContent";
 import MultiDeleteVideoModalContent from "@/app/components/admin/video/MultiDeleteModalContent";
+import ReencodeVideosModalContent from "@/app/components/admin/video/ReencodeModalContent";
 import { useTranslations } from "next-intl";
 import { formatBytes, usePageTitle } from "@/app/util/util";
 import { useDeletePlayback, useMarkVideoAsWatched } from "@/app/hooks/usePlayback";
@@ -47,6 +48,7 @@ const AdminVideosPage = () => {
   const [deleteModalOpened, { open: openDeleteModal, close: closeDeleteModal }] = useDisclosure(false);
   const [multiDeleteModalOpened, { open: openMultiDeleteModal, close: closeMultiDeleteModal }] = useDisclosure(false);
   const [multiVideoPlaylistModal, { open: openMultiVideoPlaylistModal, close: closeMultiVideoPlaylistModal }] = useDisclosure(false);
+  const [reencodeModalOpened, { open: openReencodeModal, close: closeReencodeModal }] = useDisclosure(false);
   const [activeVideos, setActiveVideos] = useState<Video[] | null>([]);
   const [bulkActionLoading, setBulkActionLoading] = useState<boolean>(false);
 
@@ -271,6 +273,14 @@ const AdminVideosPage = () => {
               </>
             )}
 
+            <Button
+              onClick={openReencodeModal}
+              mr={5}
+              variant="default"
+            >
+              {t('reencodeButton')}
+            </Button>
+
             <Button
               onClick={() => {
                 setDrawerEditMode(VideoEditMode.Create)
@@ -428,6 +438,10 @@ const AdminVideosPage = () => {
         )}
       </Modal>
 
+      <Modal opened={reencodeModalOpened} onClose={closeReencodeModal} title={t('reencodeModal')}>
+        <ReencodeVideosModalContent handleClose={closeReencodeModal} />
+      </Modal>
+
     </div >
   );
 }
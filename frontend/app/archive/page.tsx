@@ -6,6 +6,7 @@ import { Channel, useFetchChannels } from "../hooks/useChannels";
 import { showNotification } from "@mantine/notifications";
 import { useArchiveVideo, VideoQuality } from "../hooks/useArchive";
 import { useAxiosPrivate } from "../hooks/useAxios";
+import { useGetTranscodingProfiles } from "../hooks/useConfig";
 import { useTranslations } from "next-intl";
 import { usePageTitle } from "../util/util";
 
@@ -60,10 +61,12 @@ const ArchivePage = () => {
   const [archiveQuality, setArchiveQuality] = useInputState<VideoQuality>(VideoQuality.Best);
   const [channelData, setChannelData] = useState<SelectOption[]>([]);
   const [channelId, setChannelId] = useState("");
+  const [transcodingProfileId, setTranscodingProfileId] = useState<string | null>(null);
 
   const axiosPrivate = useAxiosPrivate();
   const useArchiveVideoMutate = useArchiveVideo();
   const { data: channels, isPending: channelsIsPending } = useFetchChannels();
+  const { data: transcodingProfiles } = useGetTranscodingProfiles(axiosPrivate);
 
   // Quality options using the enum
   const qualityOptions: SelectOption[] = Object.entries(VideoQuality).map(([key, value]) => ({
@@ -113,6 +116,7 @@ const ArchivePage = () => {
         quality: archiveQuality,
         archive_chat: archiveChat,
         render_chat: renderChat,
+        transcoding_profile_id: transcodingProfileId,
       });
 
       setArchiveInput("")
@@ -176,6 +180,14 @@ const ArchivePage = () => {
                   data={qualityOptions}
                   w={rem(200)}
                 />
+                <Select
+                  placeholder={t('transcodingProfilePlaceholder')}
+                  value={transcodingProfileId}
+                  onChange={setTranscodingProfileId}
+                  data={(transcodingProfiles ?? []).map((profile) => ({ label: profile.name, value: profile.id }))}
+                  clearable
+                  w={rem(200)}
+                />
                 <Switch
                   checked={archiveChat}
                   onChange={setArchiveChat}
@@ -1,10 +1,13 @@
 import { useAxiosPrivate } from "@/app/hooks/useAxios";
-import { Channel, useCreateChannel, useEditChannel, useUpdateChannelImage } from "@/app/hooks/useChannels";
-import { ActionIcon, Button, NumberInput, TextInput, Tooltip, Text, Divider, Checkbox } from "@mantine/core";
+import { Channel, useCreateChannel, useEditChannel, useFetchChannelRetentionDryRun, useUpdateChannelImage } from "@/app/hooks/useChannels";
+import { VideoType } from "@/app/hooks/useVideos";
+import { formatBytes } from "@/app/util/util";
+import { ActionIcon, Button, NumberInput, TextInput, Tooltip, Text, Divider, Checkbox, MultiSelect, List } from "@mantine/core";
 import { useForm, schemaResolver } from "@mantine/form";
 import { showNotification } from "@mantine/notifications";
 import { IconHelpCircle } from "@tabler/icons-react";
 import { useTranslations } from "next-intl";
+import { useState } from "react";
 import { z } from "zod";
 
 type Props = {
@@ -24,13 +27,18 @@ const AdminChannelDrawerContent = ({ channel, mode, handleClose }: Props) => {
   const useCreateChannelMutate = useCreateChannel()
   const useEditChannelMutate = useEditChannel()
   const useUpdateChannelImageMutate = useUpdateChannelImage()
+  const [showRetentionDryRun, setShowRetentionDryRun] = useState(false)
+  const { data: retentionDryRun, isFetching: isFetchingRetentionDryRun, refetch: refetchRetentionDryRun } = useFetchChannelRetentionDryRun(axiosPrivate, channel?.id || "", showRetentionDryRun && !!channel)
 
   const schema = z.object({
     display_name: z.string().min(2, { message: t('validation.displayName') }),
     name: z.string().min(2, { message: t('validation.name') }),
     image_path: z.string().min(3, { message: t('validation.imagePath') }),
     retention: z.boolean(),
-    retention_days: z.number().min(1)
+    retention_days: z.number().min(0),
+    retention_keep_newest: z.number().min(0),
+    retention_max_size_gb: z.number().min(0),
+    retention_exempt_min_views: z.number().min(0),
   })
 
   const form = useForm({
@@ -42,7 +50,12 @@ const AdminChannelDrawerContent = ({ channel, mode, handleClose }: Props) => {
       display_name: channel?.display_name || "",
       image_path: channel?.image_path || "",
       retention: channel?.retention || false,
-      retention_days: channel?.retention_days || 7,
+      retention_days: channel?.retention_days ?? 7,
+      retention_keep_newest: channel?.retention_keep_newest || 0,
+      retention_max_size_gb: (channel?.retention_max_size_bytes || 0) / 1024 ** 3,
+      retention_video_types: channel?.retention_video_types || [],
+      retention_exempt_playlists: channel?.retention_exempt_playlists ?? false,
+      retention_exempt_min_views: channel?.retention_exempt_min_views || 0,
     },
 
     validate: schemaResolver(schema),
@@ -60,6 +73,11 @@ const AdminChannelDrawerContent = ({ channel, mode, handleClose }: Props) => {
       image_path: formValues.image_path,
       retention: formValues.retention,
       retention_days: formValues.retention_days,
+      retention_keep_newest: formValues.retention_keep_newest,
+      retention_max_size_bytes: Math.round(formValues.retention_max_size_gb * 1024 ** 3),
+      retention_video_types: formValues.retention_video_types as VideoType[],
+      retention_exempt_playlists: formValues.retention_exempt_playlists,
+      retention_exempt_min_views: formValues.retention_exempt_min_views,
     }
 
     // create channel
@@ -176,7 +194,7 @@ const AdminChannelDrawerContent = ({ channel, mode, handleClose }: Props) => {
           {...form.getInputProps('retention', { type: "checkbox" })}
         />
 
-        {form.values.retention && (
+        {form.values.retention && form.values.retention_days > 0 && (
           <Text c="red" mt={5}>
             {t('videoRetentionWarning', { number: form.values.retention_days })}
           </Text>
@@ -185,17 +203,86 @@ const AdminChannelDrawerContent = ({ channel, mode, handleClose }: Props) => {
         <NumberInput
           disabled={!form.values.retention}
           label={t('videoRetentionDaysLabel')}
+          description={t('videoRetentionDisabledDescription')}
           placeholder="7"
           key={form.key('retention_days')}
           {...form.getInputProps('retention_days')}
         />
 
+        <NumberInput
+          disabled={!form.values.retention}
+          label={t('videoRetentionKeepNewestLabel')}
+          description={t('videoRetentionDisabledDescription')}
+          min={0}
+          key={form.key('retention_keep_newest')}
+          {...form.getInputProps('retention_keep_newest')}
+        />
+
+        <NumberInput
+          disabled={!form.values.retention}
+          label={t('videoRetentionMaxSizeLabel')}
+          description={t('videoRetentionDisabledDescription')}
+          min={0}
+          decimalScale={2}
+          key={form.key('retention_max_size_gb')}
+          {...form.getInputProps('retention_max_size_gb')}
+        />
+
+        <MultiSelect
+          disabled={!form.values.retention}
+          label={t('videoRetentionVideoTypesLabel')}
+          description={t('videoRetentionVideoTypesDescription')}
+          data={Object.values(VideoType)}
+          key={form.key('retention_video_types')}
+          {...form.getInputProps('retention_video_types')}
+        />
+
+        <Checkbox
+          mt={10}
+          disabled={!form.values.retention}
+          label={t('videoRetentionExemptPlaylistsLabel')}
+          key={form.key('retention_exempt_playlists')}
+          {...form.getInputProps('retention_exempt_playlists', { type: "checkbox" })}
+        />
+
+        <NumberInput
+          disabled={!form.values.retention}
+          label={t('videoRetentionExemptMinViewsLabel')}
+          description={t('videoRetentionDisabledDescription')}
+          min={0}
+          key={form.key('retention_exempt_min_views')}
+          {...form.getInputProps('retention_exempt_min_views')}
+        />
+
         <Button mt={10} type="submit" fullWidth>{mode == ChannelEditMode.Create ? t('submitButton') : t('editButton')}</Button>
       </form>
       {channel && (
         <div>
           <Divider mt={10} />
           <Button mt={10} fullWidth variant="default" onClick={handleUpdateChannelImage}>{t('imageUpdateButton')}</Button>
+          <Button
+            mt={10}
+            fullWidth
+            variant="default"
+            loading={isFetchingRetentionDryRun}
+            onClick={() => showRetentionDryRun ? refetchRetentionDryRun() : setShowRetentionDryRun(true)}
+          >
+            {t('videoRetentionDryRunButton')}
+          </Button>
+          {showRetentionDryRun && retentionDryRun && (
+            <div>
+              <Text mt={10} size="sm">
+                {t('videoRetentionDryRunSummary', { count: retentionDryRun.total_count, size: formatBytes(retentionDryRun.total_bytes) })}
+              </Text>
+              <List size="sm" mt={5}>
+                {retentionDryRun.videos.map((video) => (
+                  <List.Item key={video.id}>
+                    {video.title} ({video.type}, {formatBytes(video.storage_size_bytes)}) - {t(`videoRetentionReason.${video.reason}`)}
+                  </List.Item>
+                ))}
+              </List>
+            </div>
+          )}
         </div>
       )}
     </div>
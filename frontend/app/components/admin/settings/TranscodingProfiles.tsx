@@ -0,0 +1,188 @@
+import { useAxiosPrivate } from "@/app/hooks/useAxios";
+import {
+  TranscodingProfile,
+  TranscodingProfileInput,
+  useCreateTranscodingProfile,
+  useDeleteTranscodingProfile,
+  useGetTranscodingProfiles,
+  useUpdateTranscodingProfile,
+} from "@/app/hooks/useConfig";
+import { ActionIcon, Button, Code, Group, Modal, Text, TextInput } from "@mantine/core";
+import { useForm } from "@mantine/form";
+import { useDisclosure } from "@mantine/hooks";
+import { showNotification } from "@mantine/notifications";
+import { IconEdit, IconTrash } from "@tabler/icons-react";
+import { AxiosError } from "axios";
+import { DataTable } from "mantine-datatable";
+import { useTranslations } from "next-intl";
+import { useState } from "react";
+
+// Extract error message from axios error, the FFmpeg validation error is returned by the API
+const getErrorMessage = (error: unknown, fallback: string): string => {
+  if (error instanceof AxiosError && error.response?.data?.message) {
+    return error.response.data.message;
+  }
+  return fallback;
+};
+
+const TranscodingProfiles = () => {
+  const t = useTranslations("AdminSettingsPage.transcodingProfiles");
+  const axiosPrivate = useAxiosPrivate();
+
+  const { data: profiles, isPending, isError } = useGetTranscodingProfiles(axiosPrivate);
+  const createMutation = useCreateTranscodingProfile();
+  const updateMutation = useUpdateTranscodingProfile();
+  const deleteMutation = useDeleteTranscodingProfile();
+
+  const [modalOpened, { open: openModal, close: closeModal }] = useDisclosure(false);
+  const [editingProfile, setEditingProfile] = useState<TranscodingProfile | null>(null);
+
+  const form = useForm<TranscodingProfileInput>({
+    initialValues: {
+      name: "",
+      description: "",
+      ffmpeg_args: "",
+    },
+    validate: {
+      name: (value) => (value.trim() ? null : t("nameRequired")),
+    },
+  });
+
+  const handleOpenCreate = () => {
+    setEditingProfile(null);
+    form.reset();
+    openModal();
+  };
+
+  const handleOpenEdit = (profile: TranscodingProfile) => {
+    setEditingProfile(profile);
+    form.clearErrors();
+    form.setValues({
+      name: profile.name,
+      description: profile.description ?? "",
+      ffmpeg_args: profile.ffmpeg_args ?? "",
+    });
+    openModal();
+  };
+
+  const handleSubmit = async (values: TranscodingProfileInput) => {
+    try {
+      if (editingProfile) {
+        await updateMutation.mutateAsync({ axiosPrivate, id: editingProfile.id, input: values });
+      } else {
+        await createMutation.mutateAsync({ axiosPrivate, input: values });
+      }
+      showNotification({
+        message: t("savedNotification"),
+      });
+      closeModal();
+    } catch (error) {
+      showNotification({
+        title: t("errorTitle"),
+        message: getErrorMessage(error, t("saveFailed")),
+        color: "red",
+      });
+    }
+  };
+
+  const handleDelete = async (profile: TranscodingProfile) => {
+    try {
+      await deleteMutation.mutateAsync({ axiosPrivate, id: profile.id });
+      showNotification({
+        message: t("deletedNotification"),
+      });
+    } catch (error) {
+      showNotification({
+        title: t("errorTitle"),
+        message: getErrorMessage(error, t("deleteFailed")),
+        color: "red",
+      });
+    }
+  };
+
+  if (isError) return <Text>{t("error")}</Text>;
+
+  return (
+    <div>
+      <Group justify="space-between" mt={10}>
+        <div>
+          <Text size="sm">{t("label")}</Text>
+          <Text size="xs" c="dimmed">{t("description")}</Text>
+        </div>
+        <Button variant="default" size="xs" onClick={handleOpenCreate}>
+          {t("createButton")}
+        </Button>
+      </Group>
+
+      <DataTable<TranscodingProfile>
+        mt={5}
+        withTableBorder
+        borderRadius="sm"
+        withColumnBorders
+        striped
+        fetching={isPending}
+        minHeight={profiles?.length ? undefined : 100}
+        records={profiles ?? []}
+        columns={[
+          {
+            accessor: "name",
+            title: t("columns.name"),
+            render: (profile) => (
+              <div>
+                <Text size="sm">{profile.name}</Text>
+                {profile.description && <Text size="xs" c="dimmed">{profile.description}</Text>}
+              </div>
+            ),
+          },
+          {
+            accessor: "ffmpeg_args",
+            title: t("columns.ffmpegArgs"),
+            render: (profile) => <Code>{profile.ffmpeg_args}</Code>,
+          },
+          {
+            accessor: "actions",
+            title: t("columns.actions"),
+            render: (profile) => (
+              <Group gap={4} wrap="nowrap">
+                <ActionIcon variant="light" color="blue" aria-label={t("edit")} title={t("edit")} onClick={() => handleOpenEdit(profile)}>
+                  <IconEdit size={18} />
+                </ActionIcon>
+                <ActionIcon variant="light" color="red" aria-label={t("delete")} title={t("delete")} loading={deleteMutation.isPending} onClick={() => handleDelete(profile)}>
+                  <IconTrash size={18} />
+                </ActionIcon>
+              </Group>
+            ),
+          },
+        ]}
+      />
+
+      <Modal opened={modalOpened} onClose={closeModal} title={editingProfile ? t("editTitle") : t("createTitle")}>
+        <form onSubmit={form.onSubmit(handleSubmit)}>
+          <TextInput
+            label={t("nameLabel")}
+            placeholder="H.265 archival"
+            withAsterisk
+            {...form.getInputProps("name")}
+          />
+          <TextInput
+            mt={10}
+            label={t("descriptionLabel")}
+            {...form.getInputProps("description")}
+          />
+          <TextInput
+            mt={10}
+            label={t("ffmpegArgsLabel")}
+            description={t("ffmpegArgsDescription")}
+            placeholder="-c:v libx265 -preset medium -crf 26 -tag:v hvc1 -c:a copy"
+            {...form.getInputProps("ffmpeg_args")}
+          />
+          <Button mt={15} type="submit" fullWidth loading={createMutation.isPending || updateMutation.isPending}>
+            {t("saveButton")}
+          </Button>
+        </form>
+      </Modal>
+    </div>
+  );
+};
+
+export default TranscodingProfiles;
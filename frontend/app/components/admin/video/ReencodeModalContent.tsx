@@ -0,0 +1,112 @@
+import { useReencodeVideos } from "@/app/hooks/useArchive";
+import { useAxiosPrivate } from "@/app/hooks/useAxios";
+import { Channel, useFetchChannels } from "@/app/hooks/useChannels";
+import { useGetTranscodingProfiles } from "@/app/hooks/useConfig";
+import { Button, Group, NumberInput, Select, Text, TextInput } from "@mantine/core";
+import { useForm } from "@mantine/form";
+import { showNotification } from "@mantine/notifications";
+import { useTranslations } from "next-intl";
+
+type Props = {
+  handleClose: () => void;
+}
+
+const ReencodeVideosModalContent = ({ handleClose }: Props) => {
+  const t = useTranslations('AdminVideoComponents')
+  const axiosPrivate = useAxiosPrivate()
+  const reencodeVideosMutate = useReencodeVideos()
+
+  const { data: channels } = useFetchChannels()
+  const { data: transcodingProfiles } = useGetTranscodingProfiles(axiosPrivate)
+
+  const form = useForm({
+    mode: "controlled",
+    initialValues: {
+      channel_id: null as string | null,
+      older_than_days: 0,
+      resolution: "",
+      codec: "",
+      transcoding_profile_id: "",
+    },
+    validate: {
+      transcoding_profile_id: (value) => (value ? null : t('reencodeProfileRequired')),
+    },
+  })
+
+  const handleSubmitForm = async (values: typeof form.values) => {
+    try {
+      await reencodeVideosMutate.mutateAsync({
+        axiosPrivate,
+        channel_id: values.channel_id,
+        older_than_days: values.older_than_days,
+        resolution: values.resolution.trim(),
+        codec: values.codec.trim(),
+        transcoding_profile_id: values.transcoding_profile_id,
+      })
+
+      showNotification({
+        message: t('reencodeNotification'),
+      })
+
+      handleClose()
+    } catch (error) {
+      console.error(error)
+    }
+  }
+
+  return (
+    <div>
+      <Text size="sm" mb={10}>{t('reencodeDescription')}</Text>
+      <form onSubmit={form.onSubmit((values) => handleSubmitForm(values))}>
+        <Select
+          label={t('reencodeProfileLabel')}
+          data={(transcodingProfiles ?? []).map((profile) => ({ label: profile.name, value: profile.id }))}
+          withAsterisk
+          key={form.key('transcoding_profile_id')}
+          {...form.getInputProps('transcoding_profile_id')}
+        />
+
+        <Select
+          mt={10}
+          label={t('reencodeChannelLabel')}
+          placeholder={t('reencodeChannelPlaceholder')}
+          data={(channels ?? []).map((channel: Channel) => ({ label: channel.name, value: channel.id }))}
+          key={form.key('channel_id')}
+          {...form.getInputProps('channel_id')}
+          searchable
+          clearable
+        />
+
+        <NumberInput
+          mt={10}
+          label={t('reencodeOlderThanDaysLabel')}
+          description={t('reencodeOlderThanDaysDescription')}
+          min={0}
+          key={form.key('older_than_days')}
+          {...form.getInputProps('older_than_days')}
+        />
+
+        <Group grow mt={10}>
+          <TextInput
+            label={t('reencodeResolutionLabel')}
+            placeholder="1080p"
+            key={form.key('resolution')}
+            {...form.getInputProps('resolution')}
+          />
+          <TextInput
+            label={t('reencodeCodecLabel')}
+            placeholder="h264"
+            key={form.key('codec')}
+            {...form.getInputProps('codec')}
+          />
+        </Group>
+
+        <Button mt={15} type="submit" fullWidth loading={reencodeVideosMutate.isPending}>
+          {t('reencodeButton')}
+        </Button>
+      </form>
+    </div>
+  );
+}
+
+export default ReencodeVideosModalContent;
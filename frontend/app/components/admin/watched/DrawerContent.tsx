@@ -1,7 +1,7 @@
 import { useAxiosPrivate } from "@/app/hooks/useAxios";
 import { Channel, useFetchChannels } from "@/app/hooks/useChannels";
-import { WatchedChannel, WatchedChannelTitleRegex, useCreateWatchedChannel, useEditWatchedChannel } from "@/app/hooks/useWatchedChannels";
-import { ActionIcon, Button, NumberInput, TextInput, Tooltip, Text, Divider, Checkbox, Select, Title, Box, Group, Grid, MultiSelect, Collapse } from "@mantine/core";
+import { WatchedChannel, WatchedChannelSchedule, WatchedChannelScheduleWindow, WatchedChannelTitleRegex, useCreateWatchedChannel, useEditWatchedChannel } from "@/app/hooks/useWatchedChannels";
+import { ActionIcon, Button, NumberInput, TextInput, Tooltip, Text, Divider, Checkbox, Select, Title, Box, Group, Grid, MultiSelect, Collapse, TagsInput } from "@mantine/core";
 import { useForm } from "@mantine/form";
 import { showNotification } from "@mantine/notifications";
 import { IconPlus, IconTrash } from "@tabler/icons-react";
@@ -13,6 +13,7 @@ import Link from "next/link";
 import { useTranslations } from "next-intl";
 import { metadata } from "@/app/layout";
 import { VideoQuality } from "@/app/hooks/useArchive";
+import { useGetTranscodingProfiles } from "@/app/hooks/useConfig";
 
 type Props = {
   watchedChannel: WatchedChannel | null
@@ -43,8 +44,25 @@ const AdminWatchedChannelDrawerContent = ({ watchedChannel, mode, handleClose }:
     watchedChannel?.edges.title_regex || []
   );
 
+  const [schedule, setSchedule] = useState<WatchedChannelSchedule | null>(
+    watchedChannel?.edges.schedule || null
+  );
+
   const [channelSelect, setChannelSelect] = useState<SelectOption[]>([]);
 
+  // days of the week starting with Sunday, as used by the schedule windows
+  const weekdayOptions: SelectOption[] = [
+    t('scheduleDaySunday'), t('scheduleDayMonday'), t('scheduleDayTuesday'), t('scheduleDayWednesday'),
+    t('scheduleDayThursday'), t('scheduleDayFriday'), t('scheduleDaySaturday'),
+  ].map((label, index) => ({ label, value: index.toString() }));
+
+  const updateScheduleWindow = (index: number, window: Partial<WatchedChannelScheduleWindow>) => {
+    if (!schedule) return;
+    const windows = [...(schedule.windows ?? [])];
+    windows[index] = { ...windows[index], ...window };
+    setSchedule({ ...schedule, windows });
+  }
+
   const [clipMoreInfoOpened, { toggle: clipMoreInfoToggle }] = useDisclosure(false);
 
   // Initialize edit watched channel mutation
@@ -64,6 +82,7 @@ const AdminWatchedChannelDrawerContent = ({ watchedChannel, mode, handleClose }:
       archive_chat: watchedChannel?.archive_chat ?? true,
       channel_id: watchedChannel?.edges.channel.id || "",
       render_chat: watchedChannel?.render_chat ?? true,
+      generate_captions: watchedChannel?.generate_captions ?? false,
       download_sub_only: watchedChannel?.download_sub_only ?? false,
       video_age: watchedChannel?.video_age || 0,
       apply_categories_to_live: watchedChannel?.apply_categories_to_live ?? false,
@@ -74,6 +93,11 @@ const AdminWatchedChannelDrawerContent = ({ watchedChannel, mode, handleClose }:
       clips_interval_days: watchedChannel?.clips_interval_days || 7,
       clips_ignore_last_checked: watchedChannel?.clips_ignore_last_checked ?? false,
       update_metadata_minutes: watchedChannel?.update_metadata_minutes || 15,
+      transcoding_profile_id: watchedChannel?.transcoding_profile_id || null,
+      quality_fallbacks: watchedChannel?.quality_fallbacks || [] as string[],
+      record_audio_fallback: watchedChannel?.record_audio_fallback ?? false,
+      split_hours: watchedChannel?.split_hours || 0,
+      split_on_category_change: watchedChannel?.split_on_category_change ?? false,
       live_title_regexes: [],
       categories: [] as string[],
     },
@@ -136,6 +160,7 @@ const AdminWatchedChannelDrawerContent = ({ watchedChannel, mode, handleClose }:
           vod_resolution: formValues.vod_resolution,
           archive_chat: formValues.archive_chat,
           render_chat: formValues.render_chat,
+          generate_captions: formValues.generate_captions,
           download_sub_only: formValues.download_sub_only,
           video_age: formValues.video_age,
           apply_categories_to_live: formValues.apply_categories_to_live,
@@ -146,11 +171,17 @@ const AdminWatchedChannelDrawerContent = ({ watchedChannel, mode, handleClose }:
           clips_interval_days: formValues.clips_interval_days,
           clips_ignore_last_checked: formValues.clips_ignore_last_checked,
           update_metadata_minutes: formValues.update_metadata_minutes,
+          transcoding_profile_id: formValues.transcoding_profile_id,
+          quality_fallbacks: formValues.quality_fallbacks,
+          record_audio_fallback: formValues.record_audio_fallback,
+          split_hours: formValues.split_hours,
+          split_on_category_change: formValues.split_on_category_change,
           is_live: false, // Default value
           edges: {
             channel: { id: formValues.channel_id } as Channel,
             categories: [],
-            title_regex: liveTitleRegexes
+            title_regex: liveTitleRegexes,
+            schedule: schedule
           },
           last_live: "",
           updated_at: "",
@@ -182,6 +213,7 @@ const AdminWatchedChannelDrawerContent = ({ watchedChannel, mode, handleClose }:
           vod_resolution: formValues.vod_resolution,
           archive_chat: formValues.archive_chat,
           render_chat: formValues.render_chat,
+          generate_captions: formValues.generate_captions,
           download_sub_only: formValues.download_sub_only,
           video_age: formValues.video_age,
           apply_categories_to_live: formValues.apply_categories_to_live,
@@ -192,9 +224,15 @@ const AdminWatchedChannelDrawerContent = ({ watchedChannel, mode, handleClose }:
           clips_interval_days: formValues.clips_interval_days,
           clips_ignore_last_checked: formValues.clips_ignore_last_checked,
           update_metadata_minutes: formValues.update_metadata_minutes,
+          transcoding_profile_id: formValues.transcoding_profile_id,
+          quality_fallbacks: formValues.quality_fallbacks,
+          record_audio_fallback: formValues.record_audio_fallback,
+          split_hours: formValues.split_hours,
+          split_on_category_change: formValues.split_on_category_change,
           edges: {
             ...watchedChannel.edges,
-            title_regex: liveTitleRegexes
+            title_regex: liveTitleRegexes,
+            schedule: schedule
           }
         };
 
@@ -225,6 +263,8 @@ const AdminWatchedChannelDrawerContent = ({ watchedChannel, mode, handleClose }:
 
   const { data: channels } = useFetchChannels();
 
+  const { data: transcodingProfiles } = useGetTranscodingProfiles(axiosPrivate);
+
   useEffect(() => {
     if (!channels) return;
 
@@ -276,6 +316,25 @@ const AdminWatchedChannelDrawerContent = ({ watchedChannel, mode, handleClose }:
           {...form.getInputProps('render_chat', { type: "checkbox" })}
         />
 
+        <Checkbox
+          mt={5}
+          label={t('generateCaptionsLabel')}
+          description={t('generateCaptionsDescription')}
+          key={form.key('generate_captions')}
+          {...form.getInputProps('generate_captions', { type: "checkbox" })}
+        />
+
+        <Select
+          mt={10}
+          label={t('transcodingProfileLabel')}
+          description={t('transcodingProfileDescription')}
+          placeholder={t('transcodingProfilePlaceholder')}
+          data={(transcodingProfiles ?? []).map((profile) => ({ label: profile.name, value: profile.id }))}
+          key={form.key('transcoding_profile_id')}
+          {...form.getInputProps('transcoding_profile_id')}
+          clearable
+        />
+
         <Divider my="sm" size="md" />
 
         <div>
@@ -298,6 +357,42 @@ const AdminWatchedChannelDrawerContent = ({ watchedChannel, mode, handleClose }:
             searchable
           />
 
+          <TagsInput
+            mt={5}
+            label={t('qualityFallbacksLabel')}
+            description={t('qualityFallbacksDescription')}
+            data={['1080p60', '720p60', '480p30', 'best', 'audio']}
+            maxTags={5}
+            key={form.key('quality_fallbacks')}
+            {...form.getInputProps('quality_fallbacks')}
+            clearable
+          />
+
+          <Checkbox
+            mt={10}
+            label={t('recordAudioFallbackLabel')}
+            description={t('recordAudioFallbackDescription')}
+            key={form.key('record_audio_fallback')}
+            {...form.getInputProps('record_audio_fallback', { type: "checkbox" })}
+          />
+
+          <NumberInput
+            mt={5}
+            label={t('splitHoursLabel')}
+            description={t('splitHoursDescription')}
+            key={form.key('split_hours')}
+            {...form.getInputProps('split_hours')}
+            min={0}
+          />
+
+          <Checkbox
+            mt={10}
+            label={t('splitOnCategoryChangeLabel')}
+            description={t('splitOnCategoryChangeDescription')}
+            key={form.key('split_on_category_change')}
+            {...form.getInputProps('split_on_category_change', { type: "checkbox" })}
+          />
+
           {form.values.watch_live && (
             <NumberInput
               mt={5}
@@ -309,6 +404,100 @@ const AdminWatchedChannelDrawerContent = ({ watchedChannel, mode, handleClose }:
             />
           )}
 
+          <Checkbox
+            mt={10}
+            label={t('scheduleLabel')}
+            description={t('scheduleDescription')}
+            checked={schedule !== null}
+            onChange={(e) => {
+              setSchedule(e.currentTarget.checked ? {
+                timezone: Intl.DateTimeFormat().resolvedOptions().timeZone || "UTC",
+                windows: [],
+                max_stream_hours: 0,
+                first_stream_of_day: false,
+              } : null)
+            }}
+          />
+
+          {schedule && (
+            <Box ml={30}>
+              <TextInput
+                mt={5}
+                label={t('scheduleTimezoneLabel')}
+                placeholder="UTC"
+                value={schedule.timezone}
+                onChange={(e) => setSchedule({ ...schedule, timezone: e.currentTarget.value })}
+              />
+
+              <NumberInput
+                mt={5}
+                label={t('scheduleMaxStreamHoursLabel')}
+                description={t('scheduleMaxStreamHoursDescription')}
+                value={schedule.max_stream_hours}
+                onChange={(value) => setSchedule({ ...schedule, max_stream_hours: Number(value) || 0 })}
+                min={0}
+              />
+
+              <Checkbox
+                mt={10}
+                label={t('scheduleFirstStreamOfDayLabel')}
+                description={t('scheduleFirstStreamOfDayDescription')}
+                checked={schedule.first_stream_of_day}
+                onChange={(e) => setSchedule({ ...schedule, first_stream_of_day: e.currentTarget.checked })}
+              />
+
+              <Group mt={10}>
+                <Title order={5}>{t('scheduleWindowsText')}</Title>
+                <Tooltip label={t('scheduleWindowAddTooltip')}>
+                  <ActionIcon size="sm" variant="filled" color="green" aria-label="Add Schedule Window" onClick={() => {
+                    setSchedule({ ...schedule, windows: [...(schedule.windows ?? []), { days: [], start: "18:00", end: "23:00" }] })
+                  }}>
+                    <IconPlus style={{ width: '70%', height: '70%' }} stroke={1.5} />
+                  </ActionIcon>
+                </Tooltip>
+              </Group>
+              <Text size="sm">{t('scheduleWindowsDescription')}</Text>
+
+              {(schedule.windows ?? []).map((window: WatchedChannelScheduleWindow, index) => (
+                <Grid grow key={index}>
+                  <Grid.Col span={10}>
+                    <MultiSelect
+                      label={t('scheduleDaysLabel')}
+                      placeholder={t('scheduleDaysPlaceholder')}
+                      data={weekdayOptions}
+                      value={window.days.map((day) => day.toString())}
+                      onChange={(days) => updateScheduleWindow(index, { days: days.map(Number).sort((a, b) => a - b) })}
+                      clearable
+                    />
+                    <Group mt={5} grow>
+                      <TextInput
+                        type="time"
+                        label={t('scheduleStartLabel')}
+                        value={window.start}
+                        onChange={(e) => updateScheduleWindow(index, { start: e.currentTarget.value })}
+                      />
+                      <TextInput
+                        type="time"
+                        label={t('scheduleEndLabel')}
+                        value={window.end}
+                        onChange={(e) => updateScheduleWindow(index, { end: e.currentTarget.value })}
+                      />
+                    </Group>
+                  </Grid.Col>
+                  <Grid.Col span={1} mt={25}>
+                    <ActionIcon size="lg" variant="filled" color="red" aria-label="Delete Schedule Window" h={80} onClick={() => {
+                      const windows = [...(schedule.windows ?? [])]
+                      windows.splice(index, 1)
+                      setSchedule({ ...schedule, windows })
+                    }}>
+                      <IconTrash style={{ width: '70%', height: '70%' }} stroke={1.5} />
+                    </ActionIcon>
+                  </Grid.Col>
+                </Grid>
+              ))}
+            </Box>
+          )}
+
         </div>
 
         <Divider my="sm" size="md" />
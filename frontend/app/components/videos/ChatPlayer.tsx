@@ -1,4 +1,4 @@
-import { Video, VideoType } from "@/app/hooks/useVideos";
+import { useGetVideoLiveGaps, Video, VideoType } from "@/app/hooks/useVideos";
 import classes from "./ChatPlayer.module.css";
 import {
   Emote,
@@ -13,7 +13,7 @@ import {
 import { RefObject, useEffect, useRef, useState, useCallback, useMemo, MouseEvent } from "react";
 import { Box, Center, Loader, Text } from "@mantine/core";
 import ChatMessage from "./ChatMessage";
-import { uuidv4 } from "@/app/util/util";
+import { durationToTime, uuidv4 } from "@/app/util/util";
 import VideoEventBus from "@/app/util/VideoEventBus";
 import useSettingsStore from "@/app/store/useSettingsStore";
 import { useTranslations } from "next-intl";
@@ -103,6 +103,8 @@ const ChatPlayer = ({ video, playerRef }: Params) => {
   // Custom hooks with error handling
   const { data: chatEmotes, error: emotesError } = useGetEmotesForVideo(video.id);
   const { data: chatBadges, error: badgesError } = useGetBadgesForVideo(video.id);
+  const { data: liveGaps } = useGetVideoLiveGaps(video.id);
+  const gapTimeRef = useRef<number | null>(null);
 
   const scrollToBottom = useCallback((smooth = chatPlaybackSmoothScroll) => {
     if (chatContainerRef.current) {
@@ -317,6 +319,7 @@ const ChatPlayer = ({ video, playerRef }: Params) => {
     queuedIdsRef.current.clear();
     processedIdsRef.current.clear();
     processedIdsOrderRef.current = [];
+    gapTimeRef.current = null;
     setMessagesWithScroll([]);
     addCustomComment(t('chatTimeSkipDetected'));
     return requestGenerationRef.current;
@@ -370,6 +373,15 @@ const ChatPlayer = ({ video, playerRef }: Params) => {
         addProcessedId(comment._id);
       }
 
+      // Note the parts of the stream missed while the live archive reconnected when playback passes them
+      const previousTime = gapTimeRef.current ?? time;
+      gapTimeRef.current = time;
+      liveGaps?.forEach((gap) => {
+        if (gap.offset <= previousTime || gap.offset > time) return;
+        const missedSeconds = (new Date(gap.ended_at).getTime() - new Date(gap.started_at).getTime()) / 1000;
+        newMessagesToAdd.push(createSystemMessage(t('chatLiveGapNotice', { duration: durationToTime(Math.round(missedSeconds)) })));
+      });
+
       // Update state once with all new messages
       if (newMessagesToAdd.length > 0) {
         setMessagesWithScroll((prev) => {
@@ -381,7 +393,7 @@ const ChatPlayer = ({ video, playerRef }: Params) => {
     } catch (error) {
       handleError(error as Error, "Chat processing");
     }
-  }, [addProcessedId, handleCommentProcessingError, handleError, setMessagesWithScroll]);
+  }, [addProcessedId, createSystemMessage, handleCommentProcessingError, handleError, liveGaps, setMessagesWithScroll, t]);
 
   // Initialize chat data
   useEffect(() => {
@@ -12,9 +12,12 @@ import {
   IconShare,
   IconLock,
   IconMovie,
+  IconBadgeCc,
+  IconScissors,
 } from '@tabler/icons-react';
 import VideoInfoModalContent from './modals/InfoModalContent';
-import { useGenerateSpriteThumbnails, useGenerateStaticThumbnail, useLockVideo, Video } from '@/app/hooks/useVideos';
+import CreateClipModalContent from './modals/CreateClipModalContent';
+import { useGenerateCaptions, useGenerateSpriteThumbnails, useGenerateStaticThumbnail, useLockVideo, Video, VideoType } from '@/app/hooks/useVideos';
 import PlaylistManageDrawerContent from '../playlist/ManageDrawerContent';
 import { useAxiosPrivate } from '@/app/hooks/useAxios';
 import { useDeletePlayback, useMarkVideoAsWatched } from '@/app/hooks/usePlayback';
@@ -49,7 +52,9 @@ const VideoMenu = ({ video }: Props) => {
   const lockVideoMutate = useLockVideo()
   const generateStaticThumbnailMutate = useGenerateStaticThumbnail()
   const generateSpriteThumbnailsMutate = useGenerateSpriteThumbnails()
+  const generateCaptionsMutate = useGenerateCaptions()
   const [deleteModalOpened, { open: openDeleteModal, close: closeDeleteModal }] = useDisclosure(false);
+  const [createClipModalOpened, { open: openCreateClipModal, close: closeCreateClipModal }] = useDisclosure(false);
 
   const handleMarkAsWatched = async () => {
     try {
@@ -122,6 +127,19 @@ const VideoMenu = ({ video }: Props) => {
       console.error(error)
     }
   }
+  const handleGenerateCaptions = async () => {
+    try {
+      await generateCaptionsMutate.mutateAsync({
+        axiosPrivate,
+        videoId: video.id
+      })
+      showNotification({
+        message: t('generateCaptionsNotification')
+      })
+    } catch (error) {
+      console.error(error)
+    }
+  }
 
   const handleShareVideo = () => {
     let shareUrl: string = "";
@@ -196,9 +214,17 @@ const VideoMenu = ({ video }: Props) => {
           <Menu.Item leftSection={<IconMovie style={{ width: rem(14), height: rem(14) }} />} onClick={handleGenerateSpriteThumbnails}>
             {t('menu.generateSpriteThumbnails')}
           </Menu.Item>
+          <Menu.Item leftSection={<IconBadgeCc style={{ width: rem(14), height: rem(14) }} />} onClick={handleGenerateCaptions}>
+            {t('menu.generateCaptions')}
+          </Menu.Item>
           <Menu.Item leftSection={<IconShare style={{ width: rem(14), height: rem(14) }} />} onClick={handleShareVideo}>
             {t('menu.share')}
           </Menu.Item>
+          {hasPermission(UserRole.Editor) && video.type !== VideoType.Clip && !video.processing && (
+            <Menu.Item leftSection={<IconScissors style={{ width: rem(14), height: rem(14) }} />} onClick={openCreateClipModal}>
+              {t('menu.createClip')}
+            </Menu.Item>
+          )}
 
           {hasPermission(UserRole.Admin) && (
             <>
@@ -229,6 +255,10 @@ const VideoMenu = ({ video }: Props) => {
         <PlaylistManageDrawerContent videoId={video.id} />
       </Drawer>
 
+      <Modal opened={createClipModalOpened} onClose={closeCreateClipModal} title={t('createClipModalTitle')}>
+        <CreateClipModalContent video={video} handleClose={closeCreateClipModal} />
+      </Modal>
+
       <Modal opened={deleteModalOpened} onClose={closeDeleteModal} title={t('deleteVideoModalTitle')}>
         <DeleteVideoModalContent video={video} handleClose={closeDeleteModal} />
       </Modal>
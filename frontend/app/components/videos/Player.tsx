@@ -2,13 +2,13 @@ import '@vidstack/react/player/styles/default/theme.css';
 import '@vidstack/react/player/styles/default/layouts/video.css';
 import { MediaPlayer, MediaPlayerInstance, MediaProvider, MediaSrc, Poster, Track, VideoMimeType, useMediaState } from '@vidstack/react';
 import { defaultLayoutIcons, DefaultVideoLayout } from '@vidstack/react/player/layouts/default';
-import { Video, VideoType } from '@/app/hooks/useVideos';
+import { MutedSegment, Video, VideoType } from '@/app/hooks/useVideos';
 import classes from "./Player.module.css"
 import { RefObject, useEffect, useMemo, useRef, useState } from 'react';
 import { env } from 'next-runtime-env';
 import dayjs from 'dayjs';
 import { escapeURL } from '@/app/util/util';
-import { PlaybackStatus, useFetchPlaybackForVideo, useSetPlaybackProgressForVideo, useStartPlaybackForVideo, useUpdatePlaybackProgressForVideo } from '@/app/hooks/usePlayback';
+import { PlaybackStatus, useFetchPlaybackForVideo, useSetPlaybackProgressForVideo, useStartPlaybackForVideo, useUpdatePlaybackProgressForVideo, useViewingSession } from '@/app/hooks/usePlayback';
 import { useAxiosPrivate } from '@/app/hooks/useAxios';
 import useAuthStore from '@/app/store/useAuthStore';
 import { useSearchParams } from 'next/navigation';
@@ -17,6 +17,8 @@ import VideoPlayerTheaterModeIcon from './PlayerTheaterModeIcon';
 import useSettingsStore from '@/app/store/useSettingsStore';
 import VideoPlayerHideChatIcon from './PlayerHideChatIcon';
 import VideoPlayerAbsoluteTimeIcon from './PlayerAbsoluteTimeIcon';
+import Link from 'next/link';
+import { useTranslations } from 'next-intl';
 
 interface Params {
   video: Video;
@@ -38,6 +40,29 @@ const AbsoluteTimeDisplay = ({ streamedAt }: { streamedAt: string | Date }) => {
   );
 };
 
+// Marks the muted segments whose audio wasn't recovered and links to the same time in the live archive
+// of the stream, which has the original audio.
+const MutedSegmentOverlay = ({ segments, audioSourceId, audioSourceOffset }: { segments: MutedSegment[], audioSourceId?: string, audioSourceOffset: number }) => {
+  const t = useTranslations("VideoComponents");
+  const currentTime = useMediaState('currentTime');
+  const segment = segments.find((s) => currentTime >= s.start && currentTime < s.end);
+  if (!segment) {
+    return null;
+  }
+
+  const liveTime = Math.floor(currentTime - audioSourceOffset);
+  return (
+    <div className={classes.mutedSegmentOverlay}>
+      <span className={classes.mutedSegmentText}>{t('mutedSegmentOverlay')}</span>
+      {audioSourceId && liveTime >= 0 && (
+        <Link className={classes.mutedSegmentLink} href={`/videos/${audioSourceId}?t=${liveTime}`}>
+          {t('mutedSegmentOriginalAudio')}
+        </Link>
+      )}
+    </div>
+  );
+};
+
 const VideoPlayer = ({ video, ref }: Params) => {
   const searchParams = useSearchParams()
 
@@ -79,6 +104,9 @@ const VideoPlayer = ({ video, ref }: Params) => {
     // eslint-disable-next-line react-hooks/exhaustive-deps
   }, [])
 
+  // log the ranges played in a viewing session
+  useViewingSession(axiosPrivate, video.id, player, isLoggedIn)
+
   useEffect(() => {
     if (!player) return
 
@@ -105,8 +133,6 @@ const VideoPlayer = ({ video, ref }: Params) => {
       setVideoPoster(`${(env('NEXT_PUBLIC_CDN_URL') ?? '')}${escapeURL(video.thumbnail_path)}`)
     }
 
-    // todo: captions?
-
     const localVolume = localStorage.getItem("ganymede-volume")
     if (localVolume) {
       setPlayerVolume(parseFloat(localVolume))
@@ -193,6 +219,11 @@ const VideoPlayer = ({ video, ref }: Params) => {
     };
   }, [player, video.clip_vod_offset, video.type]);
 
+  const mutedSegments = useMemo(
+    () => (video.edges?.muted_segments ?? []).filter((segment) => !segment.recovered),
+    [video.edges?.muted_segments],
+  );
+
   // thumbnails URL only when not processing
   const thumbnails = !video.processing
     ? `${(env('NEXT_PUBLIC_API_URL') ?? '')}/api/v1/vod/${video.id}/thumbnails/vtt`
@@ -215,6 +246,9 @@ const VideoPlayer = ({ video, ref }: Params) => {
       autoPlay={autoplayVideo}
     >
       {showAbsoluteTime && <AbsoluteTimeDisplay streamedAt={video.streamed_at} />}
+      {mutedSegments.length > 0 && (
+        <MutedSegmentOverlay segments={mutedSegments} audioSourceId={video.audio_source_id} audioSourceOffset={video.audio_source_offset ?? 0} />
+      )}
       <MediaProvider>
         <Poster className={`${classes.mediaPlayerPoster} vds-poster`} src={videoPoster} alt={video.title} />
         {!video.processing && (
@@ -224,6 +258,13 @@ const VideoPlayer = ({ video, ref }: Params) => {
             default={true}
           />
         )}
+        {!video.processing && video.caption_path && (
+          <Track
+            src={`${(env('NEXT_PUBLIC_CDN_URL') ?? '')}${escapeURL(video.caption_path)}`}
+            kind="subtitles"
+            label="Captions"
+          />
+        )}
       </MediaProvider>
       <DefaultVideoLayout icons={defaultLayoutIcons} noScrubGesture={false}
         slots={{
@@ -20,7 +20,9 @@ const VideoTitleBar = ({ video }: Params) => {
   const t = useTranslations("VideoComponents");
   const hasPermission = useAuthStore(state => state.hasPermission);
 
-  const { data: clipFullVideo } = useGetVideoByExternalId(video.clip_ext_vod_id)
+  // clips created in Ganymede link to the video they were cut from
+  const { data: clipExtFullVideo } = useGetVideoByExternalId(video.clip_vod_id ? undefined : video.clip_ext_vod_id)
+  const clipFullVideoId = video.clip_vod_id ?? clipExtFullVideo?.id
 
   return (
     <div className={classes.titleBarContainer}>
@@ -46,9 +48,9 @@ const VideoTitleBar = ({ video }: Params) => {
 
           <div className={classes.titleBarBadge}>
 
-            {clipFullVideo && (
+            {clipFullVideoId && (
               <Group mr={15}>
-                <Button variant="default" size="xs" component={Link} href={`/videos/${clipFullVideo.id}?t=${video.clip_vod_offset}`}>Go To Full Video</Button>
+                <Button variant="default" size="xs" component={Link} href={`/videos/${clipFullVideoId}?t=${video.clip_vod_offset}`}>Go To Full Video</Button>
               </Group>
             )}
 
@@ -122,6 +124,18 @@ const VideoTitleBar = ({ video }: Params) => {
               </Group>
             )}
 
+            {video.part > 0 && (
+              <Group mr={5}>
+                <Tooltip label={t('partTooltip')} openDelay={250}>
+                  <div className={classes.titleBarBadge}>
+                    <Badge variant="default">
+                      {t('part', { part: video.part })}
+                    </Badge>
+                  </div>
+                </Tooltip>
+              </Group>
+            )}
+
             <Group>
               <Tooltip label={t('videoTypeTooltip')} openDelay={250}>
                 {video.processing ? (
@@ -0,0 +1,70 @@
+import { Button, Checkbox, Menu } from "@mantine/core";
+import { showNotification } from "@mantine/notifications";
+import { IconBookmark } from "@tabler/icons-react";
+import { useTranslations } from "next-intl";
+import Link from "next/link";
+import { useAxiosPrivate } from "@/app/hooks/useAxios";
+import { UserList, useAddVideoToUserList, useGetUserLists, useRemoveVideoFromUserList } from "@/app/hooks/useUserLists";
+import { Video } from "@/app/hooks/useVideos";
+import useAuthStore from "@/app/store/useAuthStore";
+
+interface Params {
+  video: Video;
+}
+
+// VideoUserListsMenu adds the video to or removes it from the personal lists of the user.
+const VideoUserListsMenu = ({ video }: Params) => {
+  const t = useTranslations("VideoComponents");
+  const isLoggedIn = useAuthStore((state) => state.isLoggedIn);
+  const axiosPrivate = useAxiosPrivate();
+
+  const { data: lists } = useGetUserLists(axiosPrivate, isLoggedIn);
+  const addVideoMutation = useAddVideoToUserList();
+  const removeVideoMutation = useRemoveVideoFromUserList();
+
+  if (!isLoggedIn) {
+    return null;
+  }
+
+  const toggleList = async (list: UserList, inList: boolean) => {
+    try {
+      if (inList) {
+        await removeVideoMutation.mutateAsync({ axiosPrivate, id: list.id, videoId: video.id });
+      } else {
+        await addVideoMutation.mutateAsync({ axiosPrivate, id: list.id, videoId: video.id });
+      }
+    } catch (error) {
+      console.error(error);
+      showNotification({
+        color: "red",
+        message: t('userListsError'),
+      });
+    }
+  };
+
+  return (
+    <Menu shadow="md" closeOnItemClick={false}>
+      <Menu.Target>
+        <Button variant="default" size="xs" leftSection={<IconBookmark size={14} />}>
+          {t('userListsSave')}
+        </Button>
+      </Menu.Target>
+      <Menu.Dropdown>
+        {lists?.map((list) => {
+          const inList = list.edges.items?.some((item) => item.vod_id == video.id) ?? false;
+          return (
+            <Menu.Item key={list.id} onClick={() => toggleList(list, inList)}>
+              <Checkbox label={list.name} checked={inList} readOnly />
+            </Menu.Item>
+          );
+        })}
+        <Menu.Divider />
+        <Menu.Item component={Link} href="/lists">
+          {t('userListsManage')}
+        </Menu.Item>
+      </Menu.Dropdown>
+    </Menu>
+  );
+};
+
+export default VideoUserListsMenu;
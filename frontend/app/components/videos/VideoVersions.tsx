@@ -0,0 +1,49 @@
+import { VideoVersion } from "@/app/hooks/useVideos";
+import { Badge, Group, SimpleGrid, Title } from "@mantine/core";
+import VideoCard from "./Card";
+import { useTranslations } from "next-intl";
+import { durationToTime } from "@/app/util/util";
+
+interface Params {
+  versions: VideoVersion[];
+}
+
+// Other archives of the same stream, such as the live archive of a VOD, with how complete each of them is.
+const VideoPageVersions = ({ versions }: Params) => {
+  const t = useTranslations("VideoComponents");
+
+  return (
+    <div>
+      <Title my={5}>{t('videoVersionsTitle')}</Title>
+      <SimpleGrid cols={{ base: 1, sm: 2, md: 3, lg: 4, xl: 6 }} spacing="md">
+        {versions.map((version) => (
+          <div key={version.video.id}>
+            <VideoCard
+              video={version.video}
+              showChannel={false}
+              showMenu={true}
+              showProgress={true}
+            />
+            <Group gap={5} mt={5}>
+              {version.best && (
+                <Badge variant="light" color="green">{t('videoVersionBest')}</Badge>
+              )}
+              {version.missing_seconds > 0 && (
+                <Badge variant="light" color="orange">
+                  {t('videoVersionMissing', { duration: durationToTime(version.missing_seconds) })}
+                </Badge>
+              )}
+              {version.muted_seconds > 0 && (
+                <Badge variant="light" color="red">
+                  {t('videoVersionMuted', { duration: durationToTime(version.muted_seconds) })}
+                </Badge>
+              )}
+            </Group>
+          </div>
+        ))}
+      </SimpleGrid>
+    </div>
+  );
+}
+
+export default VideoPageVersions;
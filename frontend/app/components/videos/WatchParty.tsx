@@ -0,0 +1,82 @@
+import { Badge, Button, Group, Text } from "@mantine/core";
+import { useClipboard } from "@mantine/hooks";
+import { showNotification } from "@mantine/notifications";
+import { MediaPlayerInstance } from "@vidstack/react";
+import { useTranslations } from "next-intl";
+import { usePathname, useRouter, useSearchParams } from "next/navigation";
+import { RefObject } from "react";
+import { useAxiosPrivate } from "@/app/hooks/useAxios";
+import { Video } from "@/app/hooks/useVideos";
+import { useCreateWatchParty, useWatchParty } from "@/app/hooks/useWatchParty";
+import useAuthStore from "@/app/store/useAuthStore";
+
+interface Params {
+  video: Video;
+  playerRef: RefObject<MediaPlayerInstance | null>;
+}
+
+// VideoWatchParty starts a watch party for the video or shows the watch party of the ?party= parameter.
+const VideoWatchParty = ({ video, playerRef }: Params) => {
+  const t = useTranslations("VideoComponents");
+  const router = useRouter();
+  const pathname = usePathname();
+  const searchParams = useSearchParams();
+  const partyId = searchParams.get("party");
+  const user = useAuthStore((state) => state.user);
+  const axiosPrivate = useAxiosPrivate();
+  const clipboard = useClipboard();
+
+  const createWatchPartyMutation = useCreateWatchParty();
+  const { state, error, connected } = useWatchParty(partyId, playerRef, user?.id);
+
+  if (!user) {
+    return null;
+  }
+
+  const startWatchParty = async () => {
+    try {
+      const party = await createWatchPartyMutation.mutateAsync({ axiosPrivate, videoId: video.id });
+      router.replace(`${pathname}?party=${party.id}`);
+    } catch (error) {
+      console.error(error);
+      showNotification({
+        color: "red",
+        message: t('watchPartyStartError'),
+      });
+    }
+  };
+
+  if (!partyId) {
+    return (
+      <Button variant="default" size="xs" onClick={startWatchParty} loading={createWatchPartyMutation.isPending}>
+        {t('watchPartyStart')}
+      </Button>
+    );
+  }
+
+  return (
+    <Group gap="xs">
+      <Badge color={connected ? "green" : "gray"}>
+        {connected ? t('watchPartyConnected') : t('watchPartyDisconnected')}
+      </Badge>
+      {state && (
+        <Text size="sm">
+          {t('watchPartyMembers', {
+            members: state.members.map((member) => member.user_id == state.host_id ? `${member.username} (${t('watchPartyHost')})` : member.username).join(", "),
+          })}
+        </Text>
+      )}
+      {error && (
+        <Text size="sm" c="red">{error}</Text>
+      )}
+      <Button variant="default" size="xs" onClick={() => clipboard.copy(window.location.href)}>
+        {clipboard.copied ? t('watchPartyLinkCopied') : t('watchPartyCopyLink')}
+      </Button>
+      <Button variant="default" size="xs" onClick={() => router.replace(pathname)}>
+        {t('watchPartyLeave')}
+      </Button>
+    </Group>
+  );
+};
+
+export default VideoWatchParty;
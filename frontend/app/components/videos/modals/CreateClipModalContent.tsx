@@ -0,0 +1,108 @@
+import { useCreateClip } from "@/app/hooks/useArchive";
+import { useAxiosPrivate } from "@/app/hooks/useAxios";
+import { Video } from "@/app/hooks/useVideos";
+import { durationToTime, timeToSeconds } from "@/app/util/util";
+import videoEventBusInstance from "@/app/util/VideoEventBus";
+import { Button, Group, Text, TextInput } from "@mantine/core";
+import { useForm } from "@mantine/form";
+import { showNotification } from "@mantine/notifications";
+import { useTranslations } from "next-intl";
+
+type Props = {
+  video: Video
+  handleClose: () => void;
+}
+
+// default length of a clip starting at the current time of the player
+const defaultClipLength = 30
+
+const CreateClipModalContent = ({ video, handleClose }: Props) => {
+  const t = useTranslations('VideoComponents')
+  const axiosPrivate = useAxiosPrivate()
+  const createClipMutate = useCreateClip()
+
+  // start the clip at the current time of the player if the video is playing
+  let start = 0
+  if (window.location.pathname.includes(video.id)) {
+    start = Math.min(Math.floor(videoEventBusInstance.getData().time), Math.max(video.duration - 1, 0))
+  }
+  const end = Math.min(start + defaultClipLength, video.duration)
+
+  const form = useForm({
+    mode: "controlled",
+    initialValues: {
+      start: durationToTime(start),
+      end: durationToTime(end),
+      title: video.title,
+    },
+    validate: {
+      start: (value) => (isNaN(timeToSeconds(value)) ? t('createClipInvalidTime') : null),
+      end: (value, values) => {
+        const seconds = timeToSeconds(value)
+        if (isNaN(seconds)) {
+          return t('createClipInvalidTime')
+        }
+        if (seconds <= timeToSeconds(values.start) || seconds > video.duration) {
+          return t('createClipInvalidRange')
+        }
+        return null
+      },
+    },
+  })
+
+  const handleSubmitForm = async (values: typeof form.values) => {
+    try {
+      await createClipMutate.mutateAsync({
+        axiosPrivate,
+        video_id: video.id,
+        start: timeToSeconds(values.start),
+        end: timeToSeconds(values.end),
+        title: values.title,
+      })
+
+      showNotification({
+        title: t('createClipNotificationTitle'),
+        message: t('createClipNotificationMessage'),
+      })
+
+      handleClose()
+    } catch (error) {
+      console.error(error)
+    }
+  }
+
+  return (
+    <div>
+      <Text size="sm" mb={10}>{t('createClipDescription')}</Text>
+      <form onSubmit={form.onSubmit((values) => handleSubmitForm(values))}>
+        <Group grow>
+          <TextInput
+            label={t('createClipStartLabel')}
+            placeholder="00:00:00"
+            key={form.key('start')}
+            {...form.getInputProps('start')}
+          />
+          <TextInput
+            label={t('createClipEndLabel')}
+            placeholder="00:00:30"
+            key={form.key('end')}
+            {...form.getInputProps('end')}
+          />
+        </Group>
+
+        <TextInput
+          mt={10}
+          label={t('createClipTitleLabel')}
+          key={form.key('title')}
+          {...form.getInputProps('title')}
+        />
+
+        <Button mt={15} type="submit" fullWidth loading={createClipMutate.isPending}>
+          {t('createClipButton')}
+        </Button>
+      </form>
+    </div>
+  );
+}
+
+export default CreateClipModalContent;
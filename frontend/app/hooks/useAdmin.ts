@@ -29,6 +29,20 @@ export interface GanymedeSystemOverview {
   videos_directory_used_space: number; // Used space in bytes
   cpu_cores: number; // Number of CPU cores
   memory_total: number; // Total memory in bytes
+  disk_space: GanymedeDiskSpace; // Free space of the videos and temp directories
+}
+
+export interface GanymedeDiskSpace {
+  low: boolean; // Video downloads are paused while any directory is below its minimum free space
+  directories: GanymedeDiskSpaceDirectory[];
+}
+
+export interface GanymedeDiskSpaceDirectory {
+  name: string;
+  path: string;
+  free_bytes: number;
+  min_free_bytes: number;
+  low: boolean;
 }
 
 export interface GanymedeStorageDistribution {
@@ -2,6 +2,7 @@ import { AxiosInstance } from "axios";
 import { ApiResponse } from "./useAxios";
 import { NullResponse } from "./usePlayback";
 import { useMutation, useQueryClient } from "@tanstack/react-query";
+import { Video } from "./useVideos";
 
 export interface ArchiveVideoInput {
   axiosPrivate: AxiosInstance;
@@ -10,6 +11,7 @@ export interface ArchiveVideoInput {
   quality: VideoQuality;
   archive_chat: boolean;
   render_chat: boolean;
+  transcoding_profile_id?: string | null;
 }
 
 export enum VideoQuality {
@@ -29,7 +31,8 @@ const archiveVideo = async (
   channel_id: string,
   quality: VideoQuality,
   archive_chat: boolean,
-  render_chat: boolean
+  render_chat: boolean,
+  transcoding_profile_id?: string | null
 ): Promise<ApiResponse<NullResponse>> => {
   const response = await axiosPrivate.post(`/api/v1/archive/video`, {
     video_id,
@@ -37,6 +40,7 @@ const archiveVideo = async (
     quality,
     archive_chat,
     render_chat,
+    transcoding_profile_id: transcoding_profile_id || null,
   });
   return response.data.data;
 };
@@ -51,6 +55,7 @@ const useArchiveVideo = () => {
       quality,
       archive_chat,
       render_chat,
+      transcoding_profile_id,
     }) =>
       archiveVideo(
         axiosPrivate,
@@ -58,7 +63,8 @@ const useArchiveVideo = () => {
         channel_id,
         quality,
         archive_chat,
-        render_chat
+        render_chat,
+        transcoding_profile_id
       ),
     onSuccess: () => {
       queryClient.invalidateQueries({ queryKey: ["queue"] });
@@ -92,4 +98,70 @@ const useArchiveChannel = () => {
   });
 };
 
-export { useArchiveVideo, useArchiveChannel };
+export interface CreateClipInput {
+  axiosPrivate: AxiosInstance;
+  video_id: string;
+  start: number;
+  end: number;
+  title: string;
+}
+
+const createClip = async (
+  axiosPrivate: AxiosInstance,
+  video_id: string,
+  start: number,
+  end: number,
+  title: string
+): Promise<Video> => {
+  const response = await axiosPrivate.post(`/api/v1/archive/clip`, {
+    video_id,
+    start,
+    end,
+    title,
+  });
+  return response.data.data;
+};
+
+const useCreateClip = () => {
+  const queryClient = useQueryClient();
+  return useMutation<Video, Error, CreateClipInput>({
+    mutationFn: ({ axiosPrivate, video_id, start, end, title }) =>
+      createClip(axiosPrivate, video_id, start, end, title),
+    onSuccess: (_, { video_id }) => {
+      queryClient.invalidateQueries({ queryKey: ["video_clips", video_id] });
+    },
+  });
+};
+
+export interface ReencodeVideosInput {
+  axiosPrivate: AxiosInstance;
+  channel_id: string | null;
+  older_than_days: number;
+  resolution: string;
+  codec: string;
+  transcoding_profile_id: string;
+}
+
+const reencodeVideos = async (
+  axiosPrivate: AxiosInstance,
+  input: Omit<ReencodeVideosInput, "axiosPrivate">
+): Promise<ApiResponse<NullResponse>> => {
+  const response = await axiosPrivate.post(`/api/v1/archive/reencode`, {
+    ...input,
+    channel_id: input.channel_id || null,
+  });
+  return response.data.data;
+};
+
+const useReencodeVideos = () => {
+  const queryClient = useQueryClient();
+  return useMutation<ApiResponse<NullResponse>, Error, ReencodeVideosInput>({
+    mutationFn: ({ axiosPrivate, ...input }) =>
+      reencodeVideos(axiosPrivate, input),
+    onSuccess: () => {
+      queryClient.invalidateQueries({ queryKey: ["queue"] });
+    },
+  });
+};
+
+export { useArchiveVideo, useArchiveChannel, useCreateClip, useReencodeVideos };
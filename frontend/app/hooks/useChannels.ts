@@ -1,6 +1,7 @@
 import { useMutation, useQuery, useQueryClient } from "@tanstack/react-query";
 import useAxios, { ApiResponse } from "./useAxios";
 import { AxiosInstance } from "axios";
+import { VideoType } from "./useVideos";
 
 export interface Channel {
   id: string;
@@ -10,6 +11,11 @@ export interface Channel {
   image_path: string;
   retention: boolean;
   retention_days: number;
+  retention_keep_newest: number;
+  retention_max_size_bytes: number;
+  retention_video_types: VideoType[];
+  retention_exempt_playlists: boolean;
+  retention_exempt_min_views: number;
   storage_size_bytes: number;
   updated_at: Date;
   created_at: Date;
@@ -85,6 +91,11 @@ const editChannel = async (
     image_path: channel.image_path,
     retention: channel.retention,
     retention_days: channel.retention_days,
+    retention_keep_newest: channel.retention_keep_newest,
+    retention_max_size_bytes: channel.retention_max_size_bytes,
+    retention_video_types: channel.retention_video_types,
+    retention_exempt_playlists: channel.retention_exempt_playlists,
+    retention_exempt_min_views: channel.retention_exempt_min_views,
   });
   return response.data.data;
 };
@@ -152,6 +163,46 @@ const useDeleteChannel = () => {
   });
 };
 
+export interface RetentionCandidate {
+  id: string;
+  title: string;
+  type: VideoType;
+  created_at: string;
+  storage_size_bytes: number;
+  reason: "age" | "keep_newest" | "max_size";
+}
+
+export interface RetentionDryRun {
+  channel_id: string;
+  enabled: boolean;
+  total_count: number;
+  total_bytes: number;
+  videos: RetentionCandidate[];
+}
+
+const fetchChannelRetentionDryRun = async (
+  axiosPrivate: AxiosInstance,
+  channelId: string
+): Promise<RetentionDryRun> => {
+  const response = await axiosPrivate.get<ApiResponse<RetentionDryRun>>(
+    `/api/v1/channel/${channelId}/retention/dry-run`
+  );
+  return response.data.data;
+};
+
+const useFetchChannelRetentionDryRun = (
+  axiosPrivate: AxiosInstance,
+  channelId: string,
+  enabled: boolean
+) => {
+  return useQuery({
+    queryKey: ["channel-retention-dry-run", channelId],
+    queryFn: () => fetchChannelRetentionDryRun(axiosPrivate, channelId),
+    enabled: enabled,
+    refetchOnWindowFocus: false,
+  });
+};
+
 export {
   useFetchChannels,
   fetchChannels,
@@ -161,4 +212,5 @@ export {
   useEditChannel,
   useUpdateChannelImage,
   useDeleteChannel,
+  useFetchChannelRetentionDryRun,
 };
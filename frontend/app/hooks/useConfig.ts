@@ -12,11 +12,26 @@ export interface Config {
     video_convert: string;
     chat_render: string;
     yt_dlp_video: string;
+    speech_to_text: string;
   };
   archive: {
     save_as_hls: boolean;
+    hls_renditions: boolean;
     generate_sprite_thumbnails: boolean;
     generate_nfo_files: boolean;
+    min_free_space_videos_gb: number;
+    min_free_space_temp_gb: number;
+    recover_muted_audio: boolean;
+    stream_version_policy: StreamVersionPolicy;
+  };
+  cold_storage: {
+    enabled: boolean;
+    min_age_days: number;
+    unwatched_days: number;
+  };
+  checksums: {
+    enabled: boolean;
+    verify_days: number;
   };
   storage_templates: StorageTemplate;
   livestream: {
@@ -24,6 +39,7 @@ export interface Config {
     proxy_enabled: boolean;
     proxy_whitelist: string[];
     watch_while_archiving: boolean;
+    reconnect_window_seconds: number;
   };
 }
 
@@ -38,6 +54,12 @@ export enum ProxyType {
   HTTP = "http",
 }
 
+export enum StreamVersionPolicy {
+  KeepBoth = "keep_both",
+  KeepBest = "keep_best",
+  KeepLiveWithVodChat = "keep_live_with_vod_chat",
+}
+
 export interface ProxyListItem {
   url: string;
   header: string;
@@ -83,4 +105,117 @@ const useEditConfig = () => {
   });
 };
 
-export { useEditConfig, useGetConfig };
+export interface TranscodingProfile {
+  id: string;
+  name: string;
+  description?: string;
+  ffmpeg_args?: string;
+  updated_at: string;
+  created_at: string;
+}
+
+export type TranscodingProfileInput = Pick<TranscodingProfile, "name" | "description" | "ffmpeg_args">;
+
+const getTranscodingProfiles = async (
+  axiosPrivate: AxiosInstance
+): Promise<TranscodingProfile[]> => {
+  const response = await axiosPrivate.get<ApiResponse<TranscodingProfile[]>>(
+    `/api/v1/config/transcoding-profiles`
+  );
+  return response.data.data;
+};
+
+const useGetTranscodingProfiles = (axiosPrivate: AxiosInstance) => {
+  return useQuery({
+    queryKey: ["transcoding_profiles"],
+    queryFn: () => getTranscodingProfiles(axiosPrivate),
+  });
+};
+
+const createTranscodingProfile = async (
+  axiosPrivate: AxiosInstance,
+  input: TranscodingProfileInput
+): Promise<TranscodingProfile> => {
+  const response = await axiosPrivate.post<ApiResponse<TranscodingProfile>>(
+    `/api/v1/config/transcoding-profiles`,
+    input
+  );
+  return response.data.data;
+};
+
+interface CreateTranscodingProfileVariables {
+  axiosPrivate: AxiosInstance;
+  input: TranscodingProfileInput;
+}
+
+const useCreateTranscodingProfile = () => {
+  const queryClient = useQueryClient();
+  return useMutation<TranscodingProfile, Error, CreateTranscodingProfileVariables>({
+    mutationFn: ({ axiosPrivate, input }) =>
+      createTranscodingProfile(axiosPrivate, input),
+    onSuccess: () => {
+      queryClient.invalidateQueries({ queryKey: ["transcoding_profiles"] });
+    },
+  });
+};
+
+const updateTranscodingProfile = async (
+  axiosPrivate: AxiosInstance,
+  id: string,
+  input: TranscodingProfileInput
+): Promise<TranscodingProfile> => {
+  const response = await axiosPrivate.put<ApiResponse<TranscodingProfile>>(
+    `/api/v1/config/transcoding-profiles/${id}`,
+    input
+  );
+  return response.data.data;
+};
+
+interface UpdateTranscodingProfileVariables {
+  axiosPrivate: AxiosInstance;
+  id: string;
+  input: TranscodingProfileInput;
+}
+
+const useUpdateTranscodingProfile = () => {
+  const queryClient = useQueryClient();
+  return useMutation<TranscodingProfile, Error, UpdateTranscodingProfileVariables>({
+    mutationFn: ({ axiosPrivate, id, input }) =>
+      updateTranscodingProfile(axiosPrivate, id, input),
+    onSuccess: () => {
+      queryClient.invalidateQueries({ queryKey: ["transcoding_profiles"] });
+    },
+  });
+};
+
+const deleteTranscodingProfile = async (
+  axiosPrivate: AxiosInstance,
+  id: string
+): Promise<void> => {
+  await axiosPrivate.delete(`/api/v1/config/transcoding-profiles/${id}`);
+};
+
+interface DeleteTranscodingProfileVariables {
+  axiosPrivate: AxiosInstance;
+  id: string;
+}
+
+const useDeleteTranscodingProfile = () => {
+  const queryClient = useQueryClient();
+  return useMutation<void, Error, DeleteTranscodingProfileVariables>({
+    mutationFn: ({ axiosPrivate, id }) =>
+      deleteTranscodingProfile(axiosPrivate, id),
+    onSuccess: () => {
+      queryClient.invalidateQueries({ queryKey: ["transcoding_profiles"] });
+    },
+  });
+};
+
+export {
+  useEditConfig,
+  useGetConfig,
+  useGetTranscodingProfiles,
+  useCreateTranscodingProfile,
+  useUpdateTranscodingProfile,
+  useDeleteTranscodingProfile,
+};
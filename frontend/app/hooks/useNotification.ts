@@ -25,6 +25,8 @@ export enum NotificationEventType {
   LiveSuccess = "live_success",
   Error = "error",
   IsLive = "is_live",
+  LowDiskSpace = "low_disk_space",
+  ChecksumMismatch = "checksum_mismatch",
 }
 
 export interface Notification {
@@ -37,10 +39,14 @@ export interface Notification {
   trigger_live_success: boolean;
   trigger_error: boolean;
   trigger_is_live: boolean;
+  trigger_low_disk_space: boolean;
+  trigger_checksum_mismatch: boolean;
   video_success_template: string;
   live_success_template: string;
   error_template: string;
   is_live_template: string;
+  low_disk_space_template: string;
+  checksum_mismatch_template: string;
   apprise_urls: string;
   apprise_title: string;
   apprise_type: AppriseType;
@@ -8,6 +8,8 @@ import {
 import { ApiResponse } from "@/app/hooks/useAxios";
 import { AxiosInstance } from "axios";
 import { Video } from "./useVideos";
+import { MediaPlayerInstance } from "@vidstack/react";
+import { RefObject, useEffect } from "react";
 
 export interface Playback {
   id: string;
@@ -256,6 +258,111 @@ const useDeletePlayback = () => {
   });
 };
 
+export interface WatchedRange {
+  start: number;
+  end: number;
+}
+
+export interface ViewingSession {
+  id: string;
+  vod_id: string;
+  user_id: string;
+  device: string;
+  ranges: WatchedRange[];
+  watched_seconds: number;
+  started_at: string;
+  ended_at: string;
+}
+
+const startViewingSession = async (
+  axiosPrivate: AxiosInstance,
+  videoId: string
+): Promise<ViewingSession> => {
+  const response = await axiosPrivate.post<ApiResponse<ViewingSession>>(
+    `/api/v1/playback/sessions`,
+    { vod_id: videoId }
+  );
+  return response.data.data;
+};
+
+const addViewingSessionRanges = async (
+  axiosPrivate: AxiosInstance,
+  sessionId: string,
+  ranges: WatchedRange[]
+): Promise<ViewingSession> => {
+  const response = await axiosPrivate.put<ApiResponse<ViewingSession>>(
+    `/api/v1/playback/sessions/${sessionId}`,
+    { ranges }
+  );
+  return response.data.data;
+};
+
+// seconds the player may advance between ticks for playback to count as continuous
+const maxViewingSessionTickDelta = 3;
+// milliseconds between sending the ranges played to the viewing session
+const viewingSessionFlushInterval = 30000;
+
+// useViewingSession logs a viewing session of the video with the ranges played in the player.
+const useViewingSession = (
+  axiosPrivate: AxiosInstance,
+  videoId: string,
+  playerRef: RefObject<MediaPlayerInstance | null>,
+  enabled: boolean
+) => {
+  useEffect(() => {
+    if (!enabled) return;
+
+    let sessionId: string | null = null;
+    let ranges: WatchedRange[] = [];
+    let current: WatchedRange | null = null;
+    let lastTime: number | null = null;
+
+    const flush = () => {
+      if (current && current.end > current.start) ranges.push(current);
+      current = null;
+      lastTime = null;
+      if (!sessionId || ranges.length === 0) return;
+      const pending = ranges;
+      ranges = [];
+      addViewingSessionRanges(axiosPrivate, sessionId, pending).catch((err) =>
+        console.error("error updating viewing session", err)
+      );
+    };
+
+    startViewingSession(axiosPrivate, videoId)
+      .then((session) => (sessionId = session.id))
+      .catch((err) => console.error("error starting viewing session", err));
+
+    const tick = setInterval(() => {
+      const player = playerRef.current;
+      if (!player || player.paused) {
+        lastTime = null;
+        return;
+      }
+      const time = player.currentTime;
+      const delta = lastTime === null ? -1 : time - lastTime;
+      if (current && delta >= 0 && delta <= maxViewingSessionTickDelta) {
+        current.end = time;
+      } else {
+        // playback started or the player seeked
+        if (current && current.end > current.start) ranges.push(current);
+        current = { start: time, end: time };
+      }
+      lastTime = time;
+    }, 1000);
+
+    const interval = setInterval(flush, viewingSessionFlushInterval);
+
+    return () => {
+      clearInterval(tick);
+      clearInterval(interval);
+      flush();
+    };
+    // axiosPrivate is created on every render, a new session is only started for a new video
+    // eslint-disable-next-line react-hooks/exhaustive-deps
+  }, [videoId, playerRef, enabled]);
+};
+
 export {
   useFetchPlaybackForVideo,
   fetchPlaybackForVideo,
@@ -265,4 +372,5 @@ export {
   useGetLastPlaybackVideos,
   useMarkVideoAsWatched,
   useDeletePlayback,
+  useViewingSession,
 };
@@ -15,6 +15,12 @@ export enum Task {
   ProcessPlaylistVideoRules = "process_playlist_video_rules",
   UpdatePlatformChannels = "update_platform_channels",
   GenerateNFOFiles = "generate_nfo_files",
+  IndexChat = "index_chat",
+  AuditLibrary = "audit_library",
+  RecordChecksums = "record_checksums",
+  VerifyChecksums = "verify_checksums",
+  RecoverMutedAudio = "recover_muted_audio",
+  ReconcileStreamVersions = "reconcile_stream_versions",
 }
 
 const startTask = async (
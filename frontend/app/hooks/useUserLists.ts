@@ -0,0 +1,150 @@
+import { useMutation, useQuery, useQueryClient } from "@tanstack/react-query";
+import useAxios, { ApiResponse } from "./useAxios";
+import { AxiosInstance } from "axios";
+import { NullResponse } from "./usePlayback";
+import { Video } from "./useVideos";
+
+export enum UserListType {
+  WatchLater = "watch_later",
+  Favorites = "favorites",
+  Custom = "custom",
+}
+
+export interface UserListItem {
+  id: string;
+  list_id: string;
+  vod_id: string;
+  position: number;
+  created_at: string;
+  edges: {
+    vod?: Video;
+  };
+}
+
+export interface UserList {
+  id: string;
+  user_id: string;
+  type: UserListType;
+  name: string;
+  share_token?: string;
+  updated_at: string;
+  created_at: string;
+  edges: {
+    items?: UserListItem[];
+  };
+}
+
+export interface SharedUserList {
+  id: string;
+  name: string;
+  username: string;
+  videos: Video[];
+  updated_at: string;
+}
+
+const getUserLists = async (axiosPrivate: AxiosInstance): Promise<UserList[]> => {
+  const response = await axiosPrivate.get<ApiResponse<UserList[]>>(`/api/v1/me/lists`);
+  return response.data.data;
+};
+
+const useGetUserLists = (axiosPrivate: AxiosInstance, enabled: boolean = true) => {
+  return useQuery({
+    queryKey: ["user-lists"],
+    queryFn: () => getUserLists(axiosPrivate),
+    enabled,
+  });
+};
+
+const getUserList = async (axiosPrivate: AxiosInstance, id: string): Promise<UserList> => {
+  const response = await axiosPrivate.get<ApiResponse<UserList>>(`/api/v1/me/lists/${id}`);
+  return response.data.data;
+};
+
+const useGetUserList = (axiosPrivate: AxiosInstance, id: string | null) => {
+  return useQuery({
+    queryKey: ["user-list", id],
+    queryFn: () => getUserList(axiosPrivate, id!),
+    enabled: !!id,
+  });
+};
+
+const getSharedUserList = async (token: string): Promise<SharedUserList> => {
+  const response = await useAxios.get<ApiResponse<SharedUserList>>(`/api/v1/shared-lists/${token}`);
+  return response.data.data;
+};
+
+const useGetSharedUserList = (token: string) => {
+  return useQuery({
+    queryKey: ["shared-user-list", token],
+    queryFn: () => getSharedUserList(token),
+  });
+};
+
+// useUserListMutation invalidates the lists of the user after the mutation succeeded.
+const useUserListMutation = <TData, TVariables>(mutationFn: (variables: TVariables) => Promise<TData>) => {
+  const queryClient = useQueryClient();
+  return useMutation<TData, Error, TVariables>({
+    mutationFn,
+    onSuccess: () => {
+      queryClient.invalidateQueries({ queryKey: ["user-lists"] });
+      queryClient.invalidateQueries({ queryKey: ["user-list"] });
+    },
+  });
+};
+
+const useCreateUserList = () =>
+  useUserListMutation(async ({ axiosPrivate, name }: { axiosPrivate: AxiosInstance; name: string }) => {
+    const response = await axiosPrivate.post<ApiResponse<UserList>>(`/api/v1/me/lists`, { name });
+    return response.data.data;
+  });
+
+const useUpdateUserList = () =>
+  useUserListMutation(async ({ axiosPrivate, id, name }: { axiosPrivate: AxiosInstance; id: string; name: string }) => {
+    const response = await axiosPrivate.put<ApiResponse<UserList>>(`/api/v1/me/lists/${id}`, { name });
+    return response.data.data;
+  });
+
+const useDeleteUserList = () =>
+  useUserListMutation(async ({ axiosPrivate, id }: { axiosPrivate: AxiosInstance; id: string }) => {
+    const response = await axiosPrivate.delete<ApiResponse<NullResponse>>(`/api/v1/me/lists/${id}`);
+    return response.data;
+  });
+
+const useAddVideoToUserList = () =>
+  useUserListMutation(async ({ axiosPrivate, id, videoId }: { axiosPrivate: AxiosInstance; id: string; videoId: string }) => {
+    const response = await axiosPrivate.post<ApiResponse<NullResponse>>(`/api/v1/me/lists/${id}/videos`, { video_id: videoId });
+    return response.data;
+  });
+
+const useRemoveVideoFromUserList = () =>
+  useUserListMutation(async ({ axiosPrivate, id, videoId }: { axiosPrivate: AxiosInstance; id: string; videoId: string }) => {
+    const response = await axiosPrivate.delete<ApiResponse<NullResponse>>(`/api/v1/me/lists/${id}/videos/${videoId}`);
+    return response.data;
+  });
+
+const useReorderUserList = () =>
+  useUserListMutation(async ({ axiosPrivate, id, videoIds }: { axiosPrivate: AxiosInstance; id: string; videoIds: string[] }) => {
+    const response = await axiosPrivate.put<ApiResponse<NullResponse>>(`/api/v1/me/lists/${id}/order`, { video_ids: videoIds });
+    return response.data;
+  });
+
+const useShareUserList = () =>
+  useUserListMutation(async ({ axiosPrivate, id, share }: { axiosPrivate: AxiosInstance; id: string; share: boolean }) => {
+    const response = share
+      ? await axiosPrivate.post<ApiResponse<UserList>>(`/api/v1/me/lists/${id}/share`)
+      : await axiosPrivate.delete<ApiResponse<UserList>>(`/api/v1/me/lists/${id}/share`);
+    return response.data.data;
+  });
+
+export {
+  useGetUserLists,
+  useGetUserList,
+  useGetSharedUserList,
+  useCreateUserList,
+  useUpdateUserList,
+  useDeleteUserList,
+  useAddVideoToUserList,
+  useRemoveVideoFromUserList,
+  useReorderUserList,
+  useShareUserList,
+};
@@ -18,6 +18,22 @@ export interface PaginationResponse<T> {
   data: T;
 }
 
+export interface SearchFacetBucket {
+  value: string;
+  label?: string;
+  count: number;
+}
+
+export interface SearchFacets {
+  channels: Array<SearchFacetBucket>;
+  types: Array<SearchFacetBucket>;
+  years: Array<SearchFacetBucket>;
+}
+
+export interface SearchVideosResponse extends PaginationResponse<Array<Video>> {
+  facets: SearchFacets;
+}
+
 export interface Video {
   id: string;
   ext_id: string;
@@ -25,10 +41,15 @@ export interface Video {
   platform: Platform;
   type: VideoType;
   title: string;
+  category?: string;
   duration: number;
   clip_vod_offset?: number;
+  clip_vod_id?: string;
   views: number;
   resolution: string;
+  quality_fallbacks?: string[];
+  quality_switches?: QualitySwitch[];
+  part: number;
   sprite_thumbnails_columns: number;
   sprite_thumbnails_enabled: boolean;
   sprite_thumbnails_height: number;
@@ -61,7 +82,20 @@ export interface Video {
   local_views?: number;
   locked: boolean;
   caption_path: string;
+  audio_path?: string;
   storage_size_bytes?: number;
+  storage_tier?: StorageTier;
+  audio_source_id?: string;
+  audio_source_offset?: number;
+  authoritative?: boolean;
+}
+
+// A switch of a live stream archive to another quality after repeated failures
+export interface QualitySwitch {
+  time: string;
+  from: string;
+  to: string;
+  failures: number;
 }
 
 export interface VideoEdges {
@@ -75,6 +109,11 @@ export enum Platform {
   Youtube = "youtube",
 }
 
+export enum StorageTier {
+  Hot = "hot",
+  Cold = "cold",
+}
+
 export enum VideoType {
   Archive = "archive",
   Clip = "clip",
@@ -109,6 +148,23 @@ export interface MutedSegment {
   id: string;
   start: number;
   end: number;
+  recovered?: boolean;
+}
+
+export interface VideoVersion {
+  video: Video;
+  missing_seconds: number;
+  muted_seconds: number;
+  best: boolean;
+}
+
+export interface LiveGap {
+  id: string;
+  vod_id: string;
+  reason: string;
+  started_at: string;
+  ended_at: string;
+  offset: number;
 }
 
 export interface Chapter {
@@ -303,7 +359,7 @@ const searchVideos = async (
   fields?: Array<SearchField>,
   sort_by?: VideoSortBy,
   order?: VideoOrder
-): Promise<PaginationResponse<Array<Video>>> => {
+): Promise<SearchVideosResponse> => {
   const queryParams: { [key: string]: unknown } = {};
   if (types && types.length > 0) {
     queryParams.types = types.join(",");
@@ -318,7 +374,7 @@ const searchVideos = async (
     queryParams.order = order;
   }
   const response = await useAxios.get<
-    ApiResponse<PaginationResponse<Array<Video>>>
+    ApiResponse<SearchVideosResponse>
   >("/api/v1/vod/search", {
     params: {
       limit,
@@ -346,7 +402,7 @@ const useSearchVideos = (
   enabled: boolean = true
 ) => {
   const { limit, offset, types, query, fields, sort_by, order } = params;
-  return useQuery<PaginationResponse<Array<Video>>, Error>({
+  return useQuery<SearchVideosResponse, Error>({
     queryKey: ["search", limit, offset, types, query, fields, sort_by, order],
     queryFn: () =>
       searchVideos(limit, offset, query, types, fields, sort_by, order),
@@ -562,6 +618,30 @@ const useGenerateSpriteThumbnails = () => {
   });
 };
 
+const generateCaptions = async (
+  axiosPrivate: AxiosInstance,
+  videoId: string
+) => {
+  const response = await axiosPrivate.post(
+    `/api/v1/vod/${videoId}/generate-captions`
+  );
+  return response.data;
+};
+
+const useGenerateCaptions = () => {
+  return useMutation<
+    ApiResponse<NullResponse>,
+    Error,
+    {
+      axiosPrivate: AxiosInstance;
+      videoId: string;
+    }
+  >({
+    mutationFn: ({ axiosPrivate, videoId }) =>
+      generateCaptions(axiosPrivate, videoId),
+  });
+};
+
 const getVideoByExternalId = async (extId: string): Promise<Video> => {
   const response = await useAxios.get<ApiResponse<Video>>(
     `/api/v1/vod/external_id/${extId}`
@@ -602,6 +682,44 @@ const useGetVideoClips = (id: string) => {
   });
 };
 
+const getVideoVersions = async (id: string): Promise<VideoVersion[]> => {
+  const response = await useAxios.get<ApiResponse<Array<VideoVersion>>>(
+    `/api/v1/vod/${id}/versions`
+  );
+  return response.data.data;
+};
+
+const useGetVideoVersions = (id: string) => {
+  return useQuery({
+    queryKey: ["video_versions", id],
+    queryFn: () => getVideoVersions(id),
+    refetchInterval: false,
+    refetchOnMount: false,
+    refetchOnWindowFocus: false,
+    refetchOnReconnect: false,
+    refetchIntervalInBackground: false,
+  });
+};
+
+const getVideoLiveGaps = async (id: string): Promise<LiveGap[]> => {
+  const response = await useAxios.get<ApiResponse<Array<LiveGap>>>(
+    `/api/v1/vod/${id}/gaps`
+  );
+  return response.data.data;
+};
+
+const useGetVideoLiveGaps = (id: string) => {
+  return useQuery({
+    queryKey: ["video_live_gaps", id],
+    queryFn: () => getVideoLiveGaps(id),
+    refetchInterval: false,
+    refetchOnMount: false,
+    refetchOnWindowFocus: false,
+    refetchOnReconnect: false,
+    refetchIntervalInBackground: false,
+  });
+};
+
 const getVideoChatHistogram = async (
   id: string
 ): Promise<ChatHistogramData> => {
@@ -657,7 +775,10 @@ export {
   useSearchVideos,
   useGetVideoByExternalId,
   useGetVideoClips,
+  useGetVideoVersions,
+  useGetVideoLiveGaps,
   useGenerateSpriteThumbnails,
+  useGenerateCaptions,
   useGetVideoChatHistogram,
   useGetVideoFFprobe,
 };
@@ -0,0 +1,149 @@
+import { useMutation } from "@tanstack/react-query";
+import { ApiResponse } from "@/app/hooks/useAxios";
+import { AxiosInstance } from "axios";
+import { env } from "next-runtime-env";
+import { RefObject, useEffect, useState } from "react";
+import { MediaPlayerInstance } from "@vidstack/react";
+
+export interface WatchPartyMember {
+  user_id: string;
+  username: string;
+}
+
+export interface WatchPartyState {
+  id: string;
+  video_id: string;
+  host_id: string;
+  playing: boolean;
+  position: number;
+  updated_at: string;
+  members: WatchPartyMember[];
+}
+
+interface WatchPartyMessage {
+  type: "play" | "pause" | "seek" | "sync" | "state" | "error";
+  position?: number;
+  state?: WatchPartyState;
+  error?: string;
+}
+
+// seconds a member's player may be off from the room before it is moved to the position of the room
+const maxWatchPartyDrift = 2;
+// milliseconds between members requesting the state of the room to correct drift
+const watchPartySyncInterval = 15000;
+
+const createWatchParty = async (
+  axiosPrivate: AxiosInstance,
+  videoId: string
+): Promise<WatchPartyState> => {
+  const response = await axiosPrivate.post<ApiResponse<WatchPartyState>>(
+    `/api/v1/watch-party`,
+    { video_id: videoId }
+  );
+  return response.data.data;
+};
+
+const useCreateWatchParty = () => {
+  return useMutation<
+    WatchPartyState,
+    Error,
+    { axiosPrivate: AxiosInstance; videoId: string }
+  >({
+    mutationFn: ({ axiosPrivate, videoId }) =>
+      createWatchParty(axiosPrivate, videoId),
+  });
+};
+
+const watchPartySocketURL = (partyId: string): string => {
+  const url = new URL(
+    `/api/v1/watch-party/${partyId}/ws`,
+    env("NEXT_PUBLIC_API_URL") || window.location.origin
+  );
+  url.protocol = url.protocol === "https:" ? "wss:" : "ws:";
+  return url.toString();
+};
+
+// useWatchParty joins the watch party and keeps the player in sync with it. The chat replay follows the
+// player so it stays in sync too. Playback of the host is sent to the other members.
+const useWatchParty = (
+  partyId: string | null,
+  playerRef: RefObject<MediaPlayerInstance | null>,
+  userId?: string
+) => {
+  const [state, setState] = useState<WatchPartyState | null>(null);
+  const [error, setError] = useState<string | null>(null);
+  const [connected, setConnected] = useState(false);
+
+  useEffect(() => {
+    if (!partyId || !userId) return;
+
+    const socket = new WebSocket(watchPartySocketURL(partyId));
+    let isHost = false;
+
+    const send = (message: WatchPartyMessage) => {
+      if (socket.readyState === WebSocket.OPEN) {
+        socket.send(JSON.stringify(message));
+      }
+    };
+
+    const applyState = async (roomState: WatchPartyState) => {
+      const player = playerRef.current;
+      if (!player) return;
+      try {
+        if (Math.abs(player.currentTime - roomState.position) > maxWatchPartyDrift) {
+          player.currentTime = roomState.position;
+        }
+        if (roomState.playing && player.paused) {
+          await player.play();
+        } else if (!roomState.playing && !player.paused) {
+          await player.pause();
+        }
+      } catch (err) {
+        console.error("error applying watch party state", err);
+      }
+    };
+
+    socket.onopen = () => {
+      setConnected(true);
+      setError(null);
+    };
+    socket.onclose = () => setConnected(false);
+    socket.onmessage = (event) => {
+      const message: WatchPartyMessage = JSON.parse(event.data);
+      if (message.type === "state" && message.state) {
+        isHost = message.state.host_id === userId;
+        setState(message.state);
+        applyState(message.state);
+      } else if (message.type === "error" && message.error) {
+        setError(message.error);
+      }
+    };
+
+    // the state of the room echoed back to the host is within the drift so it doesn't loop
+    const player = playerRef.current;
+    const onPlay = () => isHost && send({ type: "play", position: player?.currentTime ?? 0 });
+    const onPause = () => isHost && send({ type: "pause", position: player?.currentTime ?? 0 });
+    const onSeeked = () => isHost && send({ type: "seek", position: player?.currentTime ?? 0 });
+    player?.addEventListener("play", onPlay);
+    player?.addEventListener("pause", onPause);
+    player?.addEventListener("seeked", onSeeked);
+
+    const interval = setInterval(() => {
+      if (!isHost) send({ type: "sync" });
+    }, watchPartySyncInterval);
+
+    return () => {
+      clearInterval(interval);
+      player?.removeEventListener("play", onPlay);
+      player?.removeEventListener("pause", onPause);
+      player?.removeEventListener("seeked", onSeeked);
+      socket.close();
+      setState(null);
+      setConnected(false);
+    };
+  }, [partyId, playerRef, userId]);
+
+  return { state, error, connected };
+};
+
+export { useCreateWatchParty, useWatchParty };
@@ -20,6 +20,7 @@ export interface WatchedChannel {
   vod_resolution: string;
   last_live: string;
   render_chat: boolean;
+  generate_captions: boolean;
   video_age: number;
   apply_categories_to_live: boolean;
   strict_categories_live: boolean;
@@ -29,6 +30,11 @@ export interface WatchedChannel {
   clips_interval_days: number;
   clips_ignore_last_checked: boolean;
   update_metadata_minutes: number;
+  transcoding_profile_id?: string | null;
+  quality_fallbacks?: string[];
+  record_audio_fallback: boolean;
+  split_hours: number;
+  split_on_category_change: boolean;
   updated_at: string;
   created_at: string;
   edges: WatchedChannelEdges;
@@ -38,6 +44,7 @@ export interface WatchedChannelEdges {
   channel: Channel;
   categories: Category[];
   title_regex: WatchedChannelTitleRegex[];
+  schedule?: WatchedChannelSchedule | null;
 }
 
 export interface WatchedChannelTitleRegex {
@@ -47,6 +54,20 @@ export interface WatchedChannelTitleRegex {
   apply_to_videos: boolean;
 }
 
+export interface WatchedChannelSchedule {
+  id?: string;
+  timezone: string;
+  windows?: WatchedChannelScheduleWindow[] | null;
+  max_stream_hours: number;
+  first_stream_of_day: boolean;
+}
+
+export interface WatchedChannelScheduleWindow {
+  days: number[]; // 0 is Sunday, every day if empty
+  start: string; // HH:MM
+  end: string; // HH:MM, ends the next day if it isn't after the start
+}
+
 const getWatchedChannels = async (
   axiosPrivate: AxiosInstance
 ): Promise<Array<WatchedChannel>> => {
@@ -76,6 +97,7 @@ const editWatchedChannel = async (
     download_highlights: watchedChannel.download_highlights,
     download_uploads: watchedChannel.download_uploads,
     render_chat: watchedChannel.render_chat,
+    generate_captions: watchedChannel.generate_captions,
     download_sub_only: watchedChannel.download_sub_only,
     categories: categories,
     video_age: watchedChannel.video_age,
@@ -88,6 +110,12 @@ const editWatchedChannel = async (
     clips_interval_days: watchedChannel.clips_interval_days,
     clips_ignore_last_checked: watchedChannel.clips_ignore_last_checked,
     update_metadata_minutes: watchedChannel.update_metadata_minutes,
+    transcoding_profile_id: watchedChannel.transcoding_profile_id || null,
+    quality_fallbacks: watchedChannel.quality_fallbacks || [],
+    record_audio_fallback: watchedChannel.record_audio_fallback,
+    split_hours: watchedChannel.split_hours,
+    split_on_category_change: watchedChannel.split_on_category_change,
+    schedule: watchedChannel.edges.schedule || null,
   });
   return response.data.data;
 };
@@ -109,6 +137,7 @@ const createWatchedChannel = async (
     download_highlights: watchedChannel.download_highlights,
     download_uploads: watchedChannel.download_uploads,
     render_chat: watchedChannel.render_chat,
+    generate_captions: watchedChannel.generate_captions,
     download_sub_only: watchedChannel.download_sub_only,
     categories: categories,
     video_age: watchedChannel.video_age,
@@ -121,6 +150,12 @@ const createWatchedChannel = async (
     clips_interval_days: watchedChannel.clips_interval_days,
     clips_ignore_last_checked: watchedChannel.clips_ignore_last_checked,
     update_metadata_minutes: watchedChannel.update_metadata_minutes,
+    transcoding_profile_id: watchedChannel.transcoding_profile_id || null,
+    quality_fallbacks: watchedChannel.quality_fallbacks || [],
+    record_audio_fallback: watchedChannel.record_audio_fallback,
+    split_hours: watchedChannel.split_hours,
+    split_on_category_change: watchedChannel.split_on_category_change,
+    schedule: watchedChannel.edges.schedule || null,
   });
   return response.data.data;
 };
@@ -53,6 +53,7 @@ export function Navbar() {
       role: UserRole.Editor
     },
     { link: '/playlists', label: t('links.playlists') },
+    { link: '/lists', label: t('links.lists'), auth: true },
     { link: '/queue', label: t('links.queue'), auth: true, role: UserRole.Editor },
     { link: '/riverui//', label: t('links.tasks'), auth: true, role: UserRole.Editor },
     {
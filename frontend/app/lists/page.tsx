@@ -0,0 +1,188 @@
+"use client"
+import { ActionIcon, Button, Container, Grid, Group, NavLink, Stack, Text, TextInput, Title, Tooltip } from "@mantine/core";
+import { useClipboard } from "@mantine/hooks";
+import { showNotification } from "@mantine/notifications";
+import { IconArrowDown, IconArrowUp, IconShare, IconShareOff, IconTrash } from "@tabler/icons-react";
+import { useTranslations } from "next-intl";
+import Link from "next/link";
+import { useEffect, useState } from "react";
+import GanymedeLoadingText from "../components/utils/GanymedeLoadingText";
+import { useAxiosPrivate } from "../hooks/useAxios";
+import {
+  UserListType,
+  useCreateUserList,
+  useDeleteUserList,
+  useGetUserList,
+  useGetUserLists,
+  useRemoveVideoFromUserList,
+  useReorderUserList,
+  useShareUserList,
+  useUpdateUserList,
+} from "../hooks/useUserLists";
+import useAuthStore from "../store/useAuthStore";
+import { usePageTitle } from "../util/util";
+
+const UserListsPage = () => {
+  const t = useTranslations("UserListsPage");
+  usePageTitle(t('title'));
+  const isLoggedIn = useAuthStore((state) => state.isLoggedIn);
+  const axiosPrivate = useAxiosPrivate();
+  const clipboard = useClipboard();
+
+  const [selectedListId, setSelectedListId] = useState<string | null>(null);
+  const [newListName, setNewListName] = useState("");
+  const [listName, setListName] = useState("");
+
+  const { data: lists, isPending, isError } = useGetUserLists(axiosPrivate, isLoggedIn);
+  const listId = selectedListId ?? lists?.[0]?.id ?? null;
+  const { data: list } = useGetUserList(axiosPrivate, listId);
+
+  useEffect(() => {
+    setListName(list?.name ?? "");
+  }, [list?.id, list?.name]);
+
+  const createListMutation = useCreateUserList();
+  const updateListMutation = useUpdateUserList();
+  const deleteListMutation = useDeleteUserList();
+  const removeVideoMutation = useRemoveVideoFromUserList();
+  const reorderListMutation = useReorderUserList();
+  const shareListMutation = useShareUserList();
+
+  const run = async (action: () => Promise<unknown>) => {
+    try {
+      await action();
+    } catch (error) {
+      console.error(error);
+      showNotification({ color: "red", message: t('error') });
+    }
+  };
+
+  if (!isLoggedIn) return <div>{t('loginRequired')}</div>;
+  if (isPending) return <GanymedeLoadingText message={t('loading')} />;
+  if (isError) return <div>{t('error')}</div>;
+
+  const items = list?.edges.items ?? [];
+  const videoIds = items.map((item) => item.vod_id);
+  const moveVideo = (index: number, offset: number) => {
+    const order = [...videoIds];
+    [order[index], order[index + offset]] = [order[index + offset], order[index]];
+    return run(() => reorderListMutation.mutateAsync({ axiosPrivate, id: list!.id, videoIds: order }));
+  };
+  const shareLink = list?.share_token ? `${window.location.origin}/lists/shared/${list.share_token}` : null;
+
+  return (
+    <Container size="7xl" mt={10}>
+      <Title mb={10}>{t('title')}</Title>
+      <Grid>
+        <Grid.Col span={{ base: 12, md: 3 }}>
+          <Stack gap={0}>
+            {lists.map((l) => (
+              <NavLink
+                key={l.id}
+                label={l.name}
+                description={t('videoCount', { count: l.edges.items?.length ?? 0 })}
+                active={l.id == listId}
+                onClick={() => setSelectedListId(l.id)}
+              />
+            ))}
+          </Stack>
+          <Group mt={10} gap="xs" wrap="nowrap">
+            <TextInput placeholder={t('newListPlaceholder')} value={newListName} onChange={(e) => setNewListName(e.currentTarget.value)} />
+            <Button
+              variant="default"
+              disabled={newListName.trim() == ""}
+              loading={createListMutation.isPending}
+              onClick={() => run(async () => {
+                const created = await createListMutation.mutateAsync({ axiosPrivate, name: newListName.trim() });
+                setNewListName("");
+                setSelectedListId(created.id);
+              })}
+            >
+              {t('create')}
+            </Button>
+          </Group>
+        </Grid.Col>
+
+        <Grid.Col span={{ base: 12, md: 9 }}>
+          {list && (
+            <>
+              <Group justify="space-between" mb={10}>
+                {list.type == UserListType.Custom ? (
+                  <Group gap="xs">
+                    <TextInput value={listName} onChange={(e) => setListName(e.currentTarget.value)} />
+                    <Button
+                      variant="default"
+                      disabled={listName.trim() == "" || listName == list.name}
+                      onClick={() => run(() => updateListMutation.mutateAsync({ axiosPrivate, id: list.id, name: listName.trim() }))}
+                    >
+                      {t('rename')}
+                    </Button>
+                    <Button
+                      color="red"
+                      variant="light"
+                      onClick={() => run(async () => {
+                        await deleteListMutation.mutateAsync({ axiosPrivate, id: list.id });
+                        setSelectedListId(null);
+                      })}
+                    >
+                      {t('delete')}
+                    </Button>
+                  </Group>
+                ) : (
+                  <Title order={3}>{list.name}</Title>
+                )}
+                <Group gap="xs">
+                  {shareLink && (
+                    <Button variant="default" onClick={() => clipboard.copy(shareLink)}>
+                      {clipboard.copied ? t('linkCopied') : t('copyLink')}
+                    </Button>
+                  )}
+                  <Button
+                    variant="default"
+                    leftSection={shareLink ? <IconShareOff size={14} /> : <IconShare size={14} />}
+                    onClick={() => run(() => shareListMutation.mutateAsync({ axiosPrivate, id: list.id, share: !shareLink }))}
+                  >
+                    {shareLink ? t('unshare') : t('share')}
+                  </Button>
+                </Group>
+              </Group>
+
+              {items.length == 0 && <Text>{t('empty')}</Text>}
+              <Stack gap="xs">
+                {items.map((item, index) => item.edges.vod && (
+                  <Group key={item.id} justify="space-between" wrap="nowrap">
+                    <div>
+                      <Text component={Link} href={`/videos/${item.vod_id}`} lineClamp={1}>
+                        {item.edges.vod.title}
+                      </Text>
+                      <Text size="sm" c="dimmed">{item.edges.vod.edges.channel?.display_name}</Text>
+                    </div>
+                    <Group gap={5} wrap="nowrap">
+                      <Tooltip label={t('moveUp')}>
+                        <ActionIcon variant="default" disabled={index == 0} onClick={() => moveVideo(index, -1)}>
+                          <IconArrowUp size={16} />
+                        </ActionIcon>
+                      </Tooltip>
+                      <Tooltip label={t('moveDown')}>
+                        <ActionIcon variant="default" disabled={index == items.length - 1} onClick={() => moveVideo(index, 1)}>
+                          <IconArrowDown size={16} />
+                        </ActionIcon>
+                      </Tooltip>
+                      <Tooltip label={t('remove')}>
+                        <ActionIcon color="red" variant="light" onClick={() => run(() => removeVideoMutation.mutateAsync({ axiosPrivate, id: list.id, videoId: item.vod_id }))}>
+                          <IconTrash size={16} />
+                        </ActionIcon>
+                      </Tooltip>
+                    </Group>
+                  </Group>
+                ))}
+              </Stack>
+            </>
+          )}
+        </Grid.Col>
+      </Grid>
+    </Container>
+  );
+};
+
+export default UserListsPage;
@@ -0,0 +1,47 @@
+"use client"
+import { Center, Container, SimpleGrid, Text, Title } from "@mantine/core";
+import { useTranslations } from "next-intl";
+import React, { useEffect } from "react";
+import VideoCard from "@/app/components/videos/Card";
+import GanymedeLoadingText from "@/app/components/utils/GanymedeLoadingText";
+import { useGetSharedUserList } from "@/app/hooks/useUserLists";
+
+interface Params {
+  token: string;
+}
+
+const SharedUserListPage = ({ params }: { params: Promise<Params> }) => {
+  const { token } = React.use(params);
+  const t = useTranslations("UserListsPage");
+  const { data: list, isPending, isError } = useGetSharedUserList(token);
+
+  useEffect(() => {
+    document.title = `${list?.name ?? t('title')}`;
+  }, [list?.name, t]);
+
+  if (isPending) return <GanymedeLoadingText message={t('loading')} />;
+  if (isError) return <div>{t('sharedNotFound')}</div>;
+
+  return (
+    <Container size="xl" px="xl" fluid={true}>
+      <Center>
+        <Title>{list.name}</Title>
+      </Center>
+      <Center>
+        <Text>{t('sharedBy', { username: list.username })}</Text>
+      </Center>
+      {list.videos.length == 0 && (
+        <Center mt={10}>
+          <Text>{t('empty')}</Text>
+        </Center>
+      )}
+      <SimpleGrid cols={{ base: 1, sm: 2, md: 3, lg: 4, xl: 5 }} mt={10}>
+        {list.videos.map((video) => (
+          <VideoCard key={video.id} video={video} showProgress={true} showMenu={false} showChannel={true} />
+        ))}
+      </SimpleGrid>
+    </Container>
+  );
+};
+
+export default SharedUserListPage;
@@ -39,9 +39,10 @@ const SearchPage = () => {
   const parseQuery = (q: SearchField) => {
     const m = q.match(/^(\w+):(.+)$/);
     console.log("parsedQuery", q, m);
+    // without a field prefix the query uses the full-text search
     return m
       ? { field: m[1] as SearchField, query: m[2] }
-      : { field: "title" as SearchField, query: q };
+      : { field: undefined, query: q };
   };
   const { field, query } = parseQuery(searchTerm as SearchField);
 
@@ -50,7 +51,7 @@ const SearchPage = () => {
     offset: (activePage - 1) * videoLimit,
     query,
     types: videoTypes,
-    fields: [field],
+    fields: field ? [field] : undefined,
     sort_by: sortBy,
     order: order,
   });
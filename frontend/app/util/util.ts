@@ -79,4 +79,14 @@ export function durationToTime(seconds: number) {
   const formattedSeconds = String(secs).padStart(2, '0');
 
   return `${formattedHours}:${formattedMinutes}:${formattedSeconds}`;
-}
\ No newline at end of file
+}
+
+// timeToSeconds converts 'HH:mm:ss', 'mm:ss' or seconds to seconds, returning NaN if the time is invalid
+export function timeToSeconds(time: string) {
+  const parts = time.trim().split(':');
+  if (parts.length > 3 || parts.some((part) => !/^\d+$/.test(part))) {
+    return NaN;
+  }
+
+  return parts.reduce((seconds, part) => seconds * 60 + Number(part), 0);
+}
@@ -1,8 +1,8 @@
 "use client"
-import { useFetchVideo, useGetVideoClips, VideoType } from "@/app/hooks/useVideos";
+import { useFetchVideo, useGetVideoClips, useGetVideoVersions, VideoType } from "@/app/hooks/useVideos";
 import React, { useEffect, useRef } from "react";
 import classes from "./VideoPage.module.css"
-import { Box, Container, useMantineTheme } from "@mantine/core";
+import { Box, Container, Group, useMantineTheme } from "@mantine/core";
 import VideoPlayer from "@/app/components/videos/Player";
 import VideoTitleBar from "@/app/components/videos/TitleBar";
 import ChatPlayer from "@/app/components/videos/ChatPlayer";
@@ -13,7 +13,10 @@ import { env } from "next-runtime-env";
 import VideoLoginRequired from "@/app/components/videos/LoginRequired";
 import useAuthStore from "@/app/store/useAuthStore";
 import VideoPageClips from "@/app/components/videos/VideoClips";
+import VideoPageVersions from "@/app/components/videos/VideoVersions";
 import VideoChatHistogram from "@/app/components/videos/ChatHistogram";
+import VideoWatchParty from "@/app/components/videos/WatchParty";
+import VideoUserListsMenu from "@/app/components/videos/UserListsMenu";
 import { MediaPlayerInstance } from "@vidstack/react";
 import { useTranslations } from "next-intl";
 
@@ -41,6 +44,10 @@ const VideoPage = ({ params }: { params: Promise<Params> }) => {
   // need to fetch clips here to dynamically render the clips section
   const { data: videoClips, isPending: videoClipsPending, isError: videoClipsError } = useGetVideoClips(id)
 
+  // other versions of the stream, such as the live archive of a VOD
+  const { data: videoVersions } = useGetVideoVersions(id)
+  const otherVersions = videoVersions?.filter((version) => version.video.id != id) ?? []
+
   useEffect(() => {
     document.title = `${data?.title}`;
   }, [data?.title]);
@@ -115,6 +122,14 @@ const VideoPage = ({ params }: { params: Promise<Params> }) => {
       {/* Title bar */}
       {!videoTheaterMode && <VideoTitleBar video={data} />}
 
+      {/* Personal lists and watch party, kept mounted in theater mode so the party stays connected */}
+      <Container size="7xl" fluid={true} mt={5}>
+        <Group gap="xs">
+          <VideoUserListsMenu video={data} />
+          {!data.processing && <VideoWatchParty video={data} playerRef={player} />}
+        </Group>
+      </Container>
+
       {/* Desktop-only sections render after the player/chat block so toggling them doesn't shift player position */}
       {!isMobile && !data.processing && (
         <Container size="7xl" fluid={true} >
@@ -124,6 +139,9 @@ const VideoPage = ({ params }: { params: Promise<Params> }) => {
           {((!videoClipsPending) && (videoClips && videoClips.length > 0)) && (
             <VideoPageClips clips={videoClips} />
           )}
+          {otherVersions.length > 0 && (
+            <VideoPageVersions versions={otherVersions} />
+          )}
         </Container>
       )}
 
@@ -5,9 +5,11 @@ import (
 	"database/sql"
 	"fmt"
 
+	"github.com/rs/zerolog/log"
 	"github.com/zibbp/ganymede/ent"
 	entVod "github.com/zibbp/ganymede/ent/vod"
 	"github.com/zibbp/ganymede/internal/config"
+	"github.com/zibbp/ganymede/internal/diskspace"
 	"github.com/zibbp/ganymede/internal/utils"
 )
 
@@ -19,10 +21,11 @@ type GetVideoStatisticsResponse struct {
 }
 
 type GetSystemOverviewResponse struct {
-	VideosDirectoryFreeSpace int64 `json:"videos_directory_free_space"` // Free space in bytes
-	VideosDirectoryUsedSpace int64 `json:"videos_directory_used_space"` // Used space in bytes
-	CPUCores                 int   `json:"cpu_cores"`                   // Number of CPU cores
-	MemoryTotal              int64 `json:"memory_total"`                // Total memory in bytes
+	VideosDirectoryFreeSpace int64            `json:"videos_directory_free_space"` // Free space in bytes
+	VideosDirectoryUsedSpace int64            `json:"videos_directory_used_space"` // Used space in bytes
+	CPUCores                 int              `json:"cpu_cores"`                   // Number of CPU cores
+	MemoryTotal              int64            `json:"memory_total"`                // Total memory in bytes
+	DiskSpace                diskspace.Status `json:"disk_space"`                  // Free space of the videos and temp directories, video downloads are paused while low
 }
 
 type GetStorageDistributionResponse struct {
@@ -98,6 +101,15 @@ func (s *Service) GetSystemOverview(ctx context.Context) (GetSystemOverviewRespo
 	}
 	resp.VideosDirectoryFreeSpace = freeSpace
 
+	// Get free space of the videos and temp directories compared to the configured minimums
+	// The rest of the overview is still useful if a directory can't be checked so the disk space is left empty
+	diskSpace, err := diskspace.Check()
+	if err != nil {
+		log.Error().Err(err).Msg("error checking disk space")
+	} else {
+		resp.DiskSpace = diskSpace
+	}
+
 	// Get data directory used space by querying all vods and summing their storage sizes
 	// Could check the directory size directly, but this information is already stored in the database
 	type UsedSpaceResult struct {
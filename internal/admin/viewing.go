@@ -0,0 +1,281 @@
+package admin
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/zibbp/ganymede/ent"
+	"github.com/zibbp/ganymede/ent/predicate"
+	"github.com/zibbp/ganymede/ent/viewingsession"
+	entVod "github.com/zibbp/ganymede/ent/vod"
+	"github.com/zibbp/ganymede/internal/utils"
+)
+
+// completedThreshold is the share of a video a user has to watch for the video to count as completed.
+const completedThreshold = 0.9
+
+// maxViewingStatisticsVideos is the number of most watched videos returned in the viewing statistics.
+const maxViewingStatisticsVideos = 25
+
+// maxWatchHeatmapBuckets is the most buckets a watch heatmap has. The resolution is increased for longer videos.
+const maxWatchHeatmapBuckets = 10000
+
+// ViewingStatisticsFilter filters the viewing sessions statistics are calculated from.
+type ViewingStatisticsFilter struct {
+	From   time.Time  // sessions started at or after, unbounded if zero
+	To     time.Time  // sessions started at or before, unbounded if zero
+	UserID *uuid.UUID // sessions of the user, all users if nil
+}
+
+type ChannelViewingStatistics struct {
+	ChannelID      uuid.UUID `json:"channel_id"`
+	ChannelName    string    `json:"channel_name"`
+	WatchedSeconds float64   `json:"watched_seconds"`
+	SessionCount   int       `json:"session_count"`
+	ViewerCount    int       `json:"viewer_count"` // Number of users that watched a video of the channel
+}
+
+type UserViewingStatistics struct {
+	UserID         uuid.UUID `json:"user_id"`
+	Username       string    `json:"username"`
+	WatchedSeconds float64   `json:"watched_seconds"`
+	SessionCount   int       `json:"session_count"`
+	VideoCount     int       `json:"video_count"`     // Number of videos the user watched
+	CompletedCount int       `json:"completed_count"` // Number of videos the user completed
+	CompletionRate float64   `json:"completion_rate"` // Share of the videos the user watched that were completed
+}
+
+type VideoViewingStatistics struct {
+	VideoID        uuid.UUID `json:"video_id"`
+	Title          string    `json:"title"`
+	ChannelName    string    `json:"channel_name"`
+	Duration       int       `json:"duration"`
+	WatchedSeconds float64   `json:"watched_seconds"`
+	SessionCount   int       `json:"session_count"`
+	ViewerCount    int       `json:"viewer_count"`    // Number of users that watched the video
+	CompletedCount int       `json:"completed_count"` // Number of users that completed the video
+	CompletionRate float64   `json:"completion_rate"` // Share of the users that watched the video that completed it
+}
+
+type GetViewingStatisticsResponse struct {
+	WatchedSeconds float64                    `json:"watched_seconds"`
+	SessionCount   int                        `json:"session_count"`
+	CompletionRate float64                    `json:"completion_rate"` // Share of the videos watched by a user that were completed by the user
+	Channels       []ChannelViewingStatistics `json:"channels"`        // Sorted by watched seconds
+	Users          []UserViewingStatistics    `json:"users"`           // Sorted by watched seconds
+	Videos         []VideoViewingStatistics   `json:"videos"`          // Most watched videos, sorted by watched seconds
+}
+
+// GetViewingStatistics retrieves statistics about the viewing sessions matching the filter.
+// A video is completed by a user when the ranges the user watched in all of their sessions cover most of the video.
+func (s *Service) GetViewingStatistics(ctx context.Context, filter ViewingStatisticsFilter) (GetViewingStatisticsResponse, error) {
+	sessions, err := s.Store.Client.ViewingSession.Query().
+		Where(viewingSessionFilterPredicates(filter)...).
+		WithUser().
+		WithVod(func(q *ent.VodQuery) {
+			q.WithChannel()
+		}).
+		All(ctx)
+	if err != nil {
+		return GetViewingStatisticsResponse{}, fmt.Errorf("error getting viewing sessions: %w", err)
+	}
+
+	return calculateViewingStatistics(sessions), nil
+}
+
+// GetVideoWatchHeatmap returns how often each part of the video was watched in the viewing sessions matching the filter.
+// The keys of the heatmap are the start of the buckets in seconds; a range watched multiple times is counted every time.
+func (s *Service) GetVideoWatchHeatmap(ctx context.Context, videoId uuid.UUID, resolutionSeconds int, filter ViewingStatisticsFilter) (map[int]int, error) {
+	if resolutionSeconds < 1 {
+		return nil, fmt.Errorf("resolutionSeconds must be at least 1")
+	}
+
+	video, err := s.Store.Client.Vod.Query().Where(entVod.ID(videoId)).Only(ctx)
+	if err != nil {
+		if _, ok := err.(*ent.NotFoundError); ok {
+			return nil, fmt.Errorf("vod not found")
+		}
+		return nil, fmt.Errorf("error getting video: %w", err)
+	}
+
+	sessions, err := s.Store.Client.ViewingSession.Query().
+		Where(viewingsession.VodID(videoId)).
+		Where(viewingSessionFilterPredicates(filter)...).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("error getting viewing sessions: %w", err)
+	}
+
+	return calculateWatchHeatmap(sessions, float64(video.Duration), resolutionSeconds), nil
+}
+
+func viewingSessionFilterPredicates(filter ViewingStatisticsFilter) []predicate.ViewingSession {
+	var predicates []predicate.ViewingSession
+	if !filter.From.IsZero() {
+		predicates = append(predicates, viewingsession.StartedAtGTE(filter.From))
+	}
+	if !filter.To.IsZero() {
+		predicates = append(predicates, viewingsession.StartedAtLTE(filter.To))
+	}
+	if filter.UserID != nil {
+		predicates = append(predicates, viewingsession.UserID(*filter.UserID))
+	}
+	return predicates
+}
+
+// calculateViewingStatistics aggregates the sessions. The user and video with channel edges of the sessions must be loaded.
+func calculateViewingStatistics(sessions []*ent.ViewingSession) GetViewingStatisticsResponse {
+	type userVideo struct {
+		userID  uuid.UUID
+		videoID uuid.UUID
+	}
+
+	resp := GetViewingStatisticsResponse{
+		Channels: []ChannelViewingStatistics{},
+		Users:    []UserViewingStatistics{},
+		Videos:   []VideoViewingStatistics{},
+	}
+	channels := make(map[uuid.UUID]*ChannelViewingStatistics)
+	channelViewers := make(map[uuid.UUID]map[uuid.UUID]bool)
+	users := make(map[uuid.UUID]*UserViewingStatistics)
+	videos := make(map[uuid.UUID]*VideoViewingStatistics)
+	videoDurations := make(map[uuid.UUID]float64)
+	userVideoRanges := make(map[userVideo][]utils.WatchedRange)
+
+	for _, session := range sessions {
+		video := session.Edges.Vod
+		user := session.Edges.User
+		if video == nil || user == nil {
+			continue
+		}
+		resp.WatchedSeconds += session.WatchedSeconds
+		resp.SessionCount++
+
+		if channel := video.Edges.Channel; channel != nil {
+			c, ok := channels[channel.ID]
+			if !ok {
+				c = &ChannelViewingStatistics{ChannelID: channel.ID, ChannelName: channel.Name}
+				channels[channel.ID] = c
+				channelViewers[channel.ID] = make(map[uuid.UUID]bool)
+			}
+			c.WatchedSeconds += session.WatchedSeconds
+			c.SessionCount++
+			channelViewers[channel.ID][user.ID] = true
+		}
+
+		u, ok := users[user.ID]
+		if !ok {
+			u = &UserViewingStatistics{UserID: user.ID, Username: user.Username}
+			users[user.ID] = u
+		}
+		u.WatchedSeconds += session.WatchedSeconds
+		u.SessionCount++
+
+		v, ok := videos[video.ID]
+		if !ok {
+			v = &VideoViewingStatistics{VideoID: video.ID, Title: video.Title, Duration: video.Duration}
+			if video.Edges.Channel != nil {
+				v.ChannelName = video.Edges.Channel.Name
+			}
+			videos[video.ID] = v
+			videoDurations[video.ID] = float64(video.Duration)
+		}
+		v.WatchedSeconds += session.WatchedSeconds
+		v.SessionCount++
+
+		key := userVideo{userID: user.ID, videoID: video.ID}
+		userVideoRanges[key] = append(userVideoRanges[key], session.Ranges...)
+	}
+
+	var watchedVideos, completedVideos int
+	for key, ranges := range userVideoRanges {
+		u := users[key.userID]
+		v := videos[key.videoID]
+		u.VideoCount++
+		v.ViewerCount++
+		watchedVideos++
+
+		duration := videoDurations[key.videoID]
+		if duration <= 0 {
+			continue
+		}
+		if utils.WatchedSeconds(utils.MergeWatchedRanges(ranges))/duration >= completedThreshold {
+			u.CompletedCount++
+			v.CompletedCount++
+			completedVideos++
+		}
+	}
+	resp.CompletionRate = completionRate(completedVideos, watchedVideos)
+
+	for id, c := range channels {
+		c.ViewerCount = len(channelViewers[id])
+		resp.Channels = append(resp.Channels, *c)
+	}
+	for _, u := range users {
+		u.CompletionRate = completionRate(u.CompletedCount, u.VideoCount)
+		resp.Users = append(resp.Users, *u)
+	}
+	for _, v := range videos {
+		v.CompletionRate = completionRate(v.CompletedCount, v.ViewerCount)
+		resp.Videos = append(resp.Videos, *v)
+	}
+
+	sort.Slice(resp.Channels, func(i, j int) bool {
+		return resp.Channels[i].WatchedSeconds > resp.Channels[j].WatchedSeconds
+	})
+	sort.Slice(resp.Users, func(i, j int) bool {
+		return resp.Users[i].WatchedSeconds > resp.Users[j].WatchedSeconds
+	})
+	sort.Slice(resp.Videos, func(i, j int) bool {
+		return resp.Videos[i].WatchedSeconds > resp.Videos[j].WatchedSeconds
+	})
+	if len(resp.Videos) > maxViewingStatisticsVideos {
+		resp.Videos = resp.Videos[:maxViewingStatisticsVideos]
+	}
+
+	return resp
+}
+
+// calculateWatchHeatmap counts the ranges of the sessions covering each bucket of the video.
+// The resolution is increased if the video would have more than maxWatchHeatmapBuckets buckets.
+func calculateWatchHeatmap(sessions []*ent.ViewingSession, duration float64, resolutionSeconds int) map[int]int {
+	// ranges of videos without a duration are only bounded by the ranges themselves
+	length := duration
+	if length <= 0 {
+		for _, session := range sessions {
+			for _, r := range session.Ranges {
+				length = math.Max(length, r.End)
+			}
+		}
+	}
+	resolution := max(resolutionSeconds, 1, int(math.Ceil(length/maxWatchHeatmapBuckets)))
+
+	heatmap := make(map[int]int)
+	for _, session := range sessions {
+		for _, r := range session.Ranges {
+			start := math.Max(r.Start, 0)
+			end := r.End
+			if duration > 0 {
+				end = math.Min(end, duration)
+			}
+			if end <= start {
+				continue
+			}
+			for bucket := int(start) / resolution * resolution; float64(bucket) < end; bucket += resolution {
+				heatmap[bucket]++
+			}
+		}
+	}
+	return heatmap
+}
+
+func completionRate(completed int, total int) float64 {
+	if total == 0 {
+		return 0
+	}
+	return float64(completed) / float64(total)
+}
@@ -0,0 +1,88 @@
+package admin
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/zibbp/ganymede/ent"
+	"github.com/zibbp/ganymede/internal/utils"
+)
+
+func newTestViewingSession(user *ent.User, video *ent.Vod, ranges ...utils.WatchedRange) *ent.ViewingSession {
+	return &ent.ViewingSession{
+		ID:             uuid.New(),
+		UserID:         user.ID,
+		VodID:          video.ID,
+		Ranges:         ranges,
+		WatchedSeconds: utils.WatchedSeconds(ranges),
+		Edges:          ent.ViewingSessionEdges{User: user, Vod: video},
+	}
+}
+
+func TestCalculateViewingStatistics(t *testing.T) {
+	alice := &ent.User{ID: uuid.New(), Username: "alice"}
+	bob := &ent.User{ID: uuid.New(), Username: "bob"}
+	channelA := &ent.Channel{ID: uuid.New(), Name: "channel_a"}
+	channelB := &ent.Channel{ID: uuid.New(), Name: "channel_b"}
+	videoA := &ent.Vod{ID: uuid.New(), Title: "video a", Duration: 100, Edges: ent.VodEdges{Channel: channelA}}
+	videoB := &ent.Vod{ID: uuid.New(), Title: "video b", Duration: 1000, Edges: ent.VodEdges{Channel: channelB}}
+
+	stats := calculateViewingStatistics([]*ent.ViewingSession{
+		// alice completes video a over two sessions
+		newTestViewingSession(alice, videoA, utils.WatchedRange{Start: 0, End: 60}),
+		newTestViewingSession(alice, videoA, utils.WatchedRange{Start: 50, End: 100}),
+		// bob rewatches the start of video a without completing it
+		newTestViewingSession(bob, videoA, utils.WatchedRange{Start: 0, End: 30}, utils.WatchedRange{Start: 0, End: 30}),
+		newTestViewingSession(bob, videoB, utils.WatchedRange{Start: 0, End: 500}),
+	})
+
+	assert.Equal(t, 4, stats.SessionCount)
+	assert.Equal(t, 670.0, stats.WatchedSeconds)
+	assert.InDelta(t, 1.0/3.0, stats.CompletionRate, 0.0001)
+
+	assert.Len(t, stats.Channels, 2)
+	assert.Equal(t, ChannelViewingStatistics{ChannelID: channelB.ID, ChannelName: "channel_b", WatchedSeconds: 500, SessionCount: 1, ViewerCount: 1}, stats.Channels[0])
+	assert.Equal(t, ChannelViewingStatistics{ChannelID: channelA.ID, ChannelName: "channel_a", WatchedSeconds: 170, SessionCount: 3, ViewerCount: 2}, stats.Channels[1])
+
+	assert.Len(t, stats.Users, 2)
+	assert.Equal(t, UserViewingStatistics{UserID: bob.ID, Username: "bob", WatchedSeconds: 560, SessionCount: 2, VideoCount: 2, CompletedCount: 0, CompletionRate: 0}, stats.Users[0])
+	assert.Equal(t, UserViewingStatistics{UserID: alice.ID, Username: "alice", WatchedSeconds: 110, SessionCount: 2, VideoCount: 1, CompletedCount: 1, CompletionRate: 1}, stats.Users[1])
+
+	assert.Len(t, stats.Videos, 2)
+	assert.Equal(t, videoB.ID, stats.Videos[0].VideoID)
+	assert.Equal(t, VideoViewingStatistics{VideoID: videoA.ID, Title: "video a", ChannelName: "channel_a", Duration: 100, WatchedSeconds: 170, SessionCount: 3, ViewerCount: 2, CompletedCount: 1, CompletionRate: 0.5}, stats.Videos[1])
+}
+
+func TestCalculateWatchHeatmap(t *testing.T) {
+	user := &ent.User{ID: uuid.New()}
+	video := &ent.Vod{ID: uuid.New(), Duration: 100}
+
+	heatmap := calculateWatchHeatmap([]*ent.ViewingSession{
+		newTestViewingSession(user, video, utils.WatchedRange{Start: 0, End: 25}, utils.WatchedRange{Start: 10, End: 20}),
+		// ranges past the end of the video are clipped
+		newTestViewingSession(user, video, utils.WatchedRange{Start: 95, End: 120}),
+	}, float64(video.Duration), 10)
+
+	assert.Equal(t, map[int]int{0: 1, 10: 2, 20: 1, 90: 1}, heatmap)
+}
+
+func TestCalculateWatchHeatmapResolution(t *testing.T) {
+	user := &ent.User{ID: uuid.New()}
+	video := &ent.Vod{ID: uuid.New(), Duration: 100000}
+	sessions := []*ent.ViewingSession{
+		newTestViewingSession(user, video, utils.WatchedRange{Start: 0.5, End: 2.5}, utils.WatchedRange{Start: 99990, End: 100000}),
+	}
+
+	// buckets are whole seconds, a bucket is counted once per range
+	heatmap := calculateWatchHeatmap(sessions[:1], 3, 1)
+	assert.Equal(t, map[int]int{0: 1, 1: 1, 2: 1}, heatmap)
+
+	// long videos are limited to maxWatchHeatmapBuckets buckets
+	heatmap = calculateWatchHeatmap(sessions, float64(video.Duration), 1)
+	assert.Equal(t, map[int]int{0: 1, 99990: 1}, heatmap)
+
+	// ranges of videos without a duration bound the buckets
+	heatmap = calculateWatchHeatmap(sessions, 0, 1)
+	assert.Equal(t, map[int]int{0: 1, 99990: 1}, heatmap)
+}
@@ -31,6 +31,8 @@ type Service struct {
 	BlockedVodsService *blocked.Service
 	RiverClient        *tasks_client.RiverClient
 	PlatformTwitch     platform.Platform
+	PlatformYoutube    platform.Platform
+	PlatformKick       platform.Platform
 }
 
 type TwitchVodResponse struct {
@@ -43,8 +45,8 @@ type ArchiveResponse struct {
 	Video *ent.Vod   `json:"video"`
 }
 
-func NewService(store *database.Database, channelService *channel.Service, vodService *vod.Service, queueService *queue.Service, blockedVodService *blocked.Service, riverClient *tasks_client.RiverClient, platformTwitch platform.Platform) *Service {
-	return &Service{Store: store, ChannelService: channelService, VodService: vodService, QueueService: queueService, BlockedVodsService: blockedVodService, RiverClient: riverClient, PlatformTwitch: platformTwitch}
+func NewService(store *database.Database, channelService *channel.Service, vodService *vod.Service, queueService *queue.Service, blockedVodService *blocked.Service, riverClient *tasks_client.RiverClient, platformTwitch platform.Platform, platformYoutube platform.Platform, platformKick platform.Platform) *Service {
+	return &Service{Store: store, ChannelService: channelService, VodService: vodService, QueueService: queueService, BlockedVodsService: blockedVodService, RiverClient: riverClient, PlatformTwitch: platformTwitch, PlatformYoutube: platformYoutube, PlatformKick: platformKick}
 }
 
 // createArchiveRecordsAndEnqueue atomically creates the VOD and queue state,
@@ -111,15 +113,21 @@ func (s *Service) createArchiveRecordsAndEnqueue(ctx context.Context, vodDTO vod
 
 // ArchiveChannel - Create channel entry in database along with folder, profile image, etc.
 func (s *Service) ArchiveChannel(ctx context.Context, channelName string) (*ent.Channel, error) {
-	if s.PlatformTwitch == nil {
-		return nil, fmt.Errorf("twitch platform is not configured; set TWITCH_CLIENT_ID/SECRET")
+	return s.ArchivePlatformChannel(ctx, utils.PlatformTwitch, channelName)
+}
+
+// ArchivePlatformChannel creates a channel from the given platform. For YouTube the channel name can be either the channel handle or ID.
+func (s *Service) ArchivePlatformChannel(ctx context.Context, videoPlatform utils.VideoPlatform, channelName string) (*ent.Channel, error) {
+	channelPlatform, err := platform.Resolve(videoPlatform, s.PlatformTwitch, s.PlatformYoutube, s.PlatformKick)
+	if err != nil {
+		return nil, err
 	}
 
 	env := config.GetEnvConfig()
 	// get channel from platform
-	platformChannel, err := s.PlatformTwitch.GetChannel(ctx, &channelName, nil)
+	platformChannel, err := channelPlatform.GetChannel(ctx, &channelName, nil)
 	if err != nil {
-		return nil, fmt.Errorf("error fetching twitch channel: %v", err)
+		return nil, fmt.Errorf("error fetching %s channel: %v", videoPlatform, err)
 	}
 
 	// Check if channel exists in DB
@@ -157,6 +165,7 @@ func (s *Service) ArchiveChannel(ctx context.Context, channelName string) (*ent.
 		Name:        platformChannel.Login,
 		DisplayName: platformChannel.DisplayName,
 		ImagePath:   filepath.Join(env.VideosDir, channelFolderName, "profile.png"),
+		Platform:    videoPlatform,
 	}
 
 	dbC, err := s.ChannelService.CreateChannel(channelDTO)
@@ -169,11 +178,16 @@ func (s *Service) ArchiveChannel(ctx context.Context, channelName string) (*ent.
 }
 
 type ArchiveVideoInput struct {
-	VideoId     string
-	ChannelId   uuid.UUID
-	Quality     utils.VodQuality
-	ArchiveChat bool
-	RenderChat  bool
+	VideoId              string
+	ChannelId            uuid.UUID
+	Platform             utils.VideoPlatform // defaults to Twitch if empty
+	Quality              utils.VodQuality
+	ArchiveChat          bool
+	RenderChat           bool
+	TranscodingProfileID *uuid.UUID // uses the global video convert arguments if nil
+	QualityFallbacks     []string   // live streams only, qualities tried in order when the quality keeps failing
+	RecordAudioFallback  bool       // live streams only, record the audio alongside the video
+	Part                 int        // live streams only, the part number if the archive is split into parts
 }
 
 func (s *Service) ArchiveVideo(ctx context.Context, input ArchiveVideoInput) (*ArchiveResponse, error) {
@@ -190,8 +204,24 @@ func (s *Service) ArchiveVideo(ctx context.Context, input ArchiveVideoInput) (*A
 		return nil, fmt.Errorf("video id is blocked")
 	}
 
+	if input.Platform == "" {
+		input.Platform = utils.PlatformTwitch
+	}
+	videoPlatform, err := platform.Resolve(input.Platform, s.PlatformTwitch, s.PlatformYoutube, s.PlatformKick)
+	if err != nil {
+		return nil, err
+	}
+
+	// chat archiving is only supported for Twitch and Kick, chat rendering is only supported for Twitch
+	if input.Platform != utils.PlatformTwitch && input.Platform != utils.PlatformKick {
+		input.ArchiveChat = false
+	}
+	if input.Platform != utils.PlatformTwitch {
+		input.RenderChat = false
+	}
+
 	// get video
-	video, err := s.PlatformTwitch.GetVideo(ctx, input.VideoId, false, false)
+	video, err := videoPlatform.GetVideo(ctx, input.VideoId, false, false)
 	if err != nil {
 		return nil, err
 	}
@@ -210,20 +240,9 @@ func (s *Service) ArchiveVideo(ctx context.Context, input ArchiveVideoInput) (*A
 		return nil, fmt.Errorf("vod already exists")
 	}
 
-	// Check if channel exists
-	cCheck := s.ChannelService.CheckChannelExists(video.UserLogin)
-	if !cCheck {
-		log.Debug().Msgf("channel does not exist: %s while archiving vod. creating now.", video.UserLogin)
-		_, err := s.ArchiveChannel(ctx, video.UserLogin)
-		if err != nil {
-			return nil, fmt.Errorf("error creating channel: %v", err)
-		}
-	}
-
-	// Fetch channel
-	channel, err := s.ChannelService.GetChannelByName(video.UserLogin)
+	channel, err := s.getOrArchiveVideoChannel(ctx, input.Platform, video)
 	if err != nil {
-		return nil, fmt.Errorf("error fetching channel: %v", err)
+		return nil, err
 	}
 
 	// Generate Ganymede video ID for directory and file naming
@@ -285,30 +304,38 @@ func (s *Service) ArchiveVideo(ctx context.Context, input ArchiveVideoInput) (*A
 		}
 	}
 
+	category := ""
+	if video.Category != nil {
+		category = *video.Category
+	}
+
 	videoExtension := "mp4"
 
 	// Create VOD in DB
 	vodDTO := vod.Vod{
-		ID:                  vUUID,
-		ExtID:               video.ID,
-		Platform:            "twitch",
-		Type:                utils.VodType(video.Type),
-		Title:               video.Title,
-		Duration:            int(video.Duration.Seconds()),
-		Views:               int(video.ViewCount),
-		Resolution:          input.Quality.String(),
-		Processing:          true,
-		ThumbnailPath:       fmt.Sprintf("%s/%s-thumbnail.jpg", rootVideoPath, fileName),
-		WebThumbnailPath:    fmt.Sprintf("%s/%s-web_thumbnail.jpg", rootVideoPath, fileName),
-		VideoPath:           fmt.Sprintf("%s/%s-video.%s", rootVideoPath, fileName, videoExtension),
-		ChatPath:            chatPath,
-		LiveChatPath:        liveChatPath,
-		ChatVideoPath:       chatVideoPath,
-		LiveChatConvertPath: liveChatConvertPath,
-		InfoPath:            fmt.Sprintf("%s/%s-info.json", rootVideoPath, fileName),
-		StreamedAt:          video.CreatedAt,
-		FolderName:          folderName,
-		FileName:            fileName,
+		ID:                   vUUID,
+		ExtID:                video.ID,
+		ExtStreamID:          video.StreamID,
+		Platform:             input.Platform,
+		Type:                 utils.VodType(video.Type),
+		Title:                video.Title,
+		Category:             category,
+		Duration:             int(video.Duration.Seconds()),
+		Views:                int(video.ViewCount),
+		Resolution:           input.Quality.String(),
+		Processing:           true,
+		ThumbnailPath:        fmt.Sprintf("%s/%s-thumbnail.jpg", rootVideoPath, fileName),
+		WebThumbnailPath:     fmt.Sprintf("%s/%s-web_thumbnail.jpg", rootVideoPath, fileName),
+		VideoPath:            fmt.Sprintf("%s/%s-video.%s", rootVideoPath, fileName, videoExtension),
+		ChatPath:             chatPath,
+		LiveChatPath:         liveChatPath,
+		ChatVideoPath:        chatVideoPath,
+		LiveChatConvertPath:  liveChatConvertPath,
+		InfoPath:             fmt.Sprintf("%s/%s-info.json", rootVideoPath, fileName),
+		StreamedAt:           video.CreatedAt,
+		FolderName:           folderName,
+		FileName:             fileName,
+		TranscodingProfileID: input.TranscodingProfileID,
 		// create temporary paths
 		TmpVideoDownloadPath:    fmt.Sprintf("%s/%s_%s-video.%s", envConfig.TempDir, video.ID, vUUID, videoExtension),
 		TmpVideoConvertPath:     fmt.Sprintf("%s/%s_%s-video-convert.%s", envConfig.TempDir, video.ID, vUUID, videoExtension),
@@ -331,12 +358,47 @@ func (s *Service) ArchiveVideo(ctx context.Context, input ArchiveVideoInput) (*A
 	})
 }
 
+// getOrArchiveVideoChannel returns the channel of the video, creating it if it does not exist yet.
+// Twitch channels are matched by login name for compatibility with channels created before external IDs were stored. Other platforms are matched by the channel's external ID.
+func (s *Service) getOrArchiveVideoChannel(ctx context.Context, videoPlatform utils.VideoPlatform, video *platform.VideoInfo) (*ent.Channel, error) {
+	if videoPlatform == utils.PlatformTwitch {
+		if !s.ChannelService.CheckChannelExists(video.UserLogin) {
+			log.Debug().Msgf("channel does not exist: %s while archiving vod. creating now.", video.UserLogin)
+			_, err := s.ArchiveChannel(ctx, video.UserLogin)
+			if err != nil {
+				return nil, fmt.Errorf("error creating channel: %v", err)
+			}
+		}
+
+		channel, err := s.ChannelService.GetChannelByName(video.UserLogin)
+		if err != nil {
+			return nil, fmt.Errorf("error fetching channel: %v", err)
+		}
+		return channel, nil
+	}
+
+	if !s.ChannelService.CheckChannelExistsByExtId(video.UserID) {
+		log.Debug().Msgf("channel does not exist: %s while archiving vod. creating now.", video.UserID)
+		_, err := s.ArchivePlatformChannel(ctx, videoPlatform, video.UserID)
+		if err != nil {
+			return nil, fmt.Errorf("error creating channel: %v", err)
+		}
+	}
+
+	channel, err := s.ChannelService.GetChannelByExtId(video.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("error fetching channel: %v", err)
+	}
+	return channel, nil
+}
+
 type ArchiveClipInput struct {
-	ID          string
-	ChannelId   uuid.UUID
-	Quality     utils.VodQuality
-	ArchiveChat bool
-	RenderChat  bool
+	ID                   string
+	ChannelId            uuid.UUID
+	Quality              utils.VodQuality
+	ArchiveChat          bool
+	RenderChat           bool
+	TranscodingProfileID *uuid.UUID // uses the global video convert arguments if nil
 }
 
 // ArchiveClip archives a clip from a platform
@@ -448,28 +510,29 @@ func (s *Service) ArchiveClip(ctx context.Context, input ArchiveClipInput) (*Arc
 
 	// Create VOD in DB
 	vodDTO := vod.Vod{
-		ID:                  vUUID,
-		ExtID:               clip.ID,
-		ClipExtVodID:        clip.VideoID,
-		Platform:            "twitch",
-		Type:                utils.Clip,
-		Title:               clip.Title,
-		Duration:            clip.Duration,
-		ClipVodOffset:       *clip.VodOffset,
-		Views:               int(clip.ViewCount),
-		Resolution:          input.Quality.String(),
-		Processing:          true,
-		ThumbnailPath:       fmt.Sprintf("%s/%s-thumbnail.jpg", rootVideoPath, fileName),
-		WebThumbnailPath:    fmt.Sprintf("%s/%s-web_thumbnail.jpg", rootVideoPath, fileName),
-		VideoPath:           fmt.Sprintf("%s/%s-video.%s", rootVideoPath, fileName, videoExtension),
-		ChatPath:            chatPath,
-		LiveChatPath:        liveChatPath,
-		ChatVideoPath:       chatVideoPath,
-		LiveChatConvertPath: liveChatConvertPath,
-		InfoPath:            fmt.Sprintf("%s/%s-info.json", rootVideoPath, fileName),
-		StreamedAt:          clip.CreatedAt,
-		FolderName:          folderName,
-		FileName:            fileName,
+		ID:                   vUUID,
+		ExtID:                clip.ID,
+		ClipExtVodID:         clip.VideoID,
+		Platform:             "twitch",
+		Type:                 utils.Clip,
+		Title:                clip.Title,
+		Duration:             clip.Duration,
+		ClipVodOffset:        *clip.VodOffset,
+		Views:                int(clip.ViewCount),
+		Resolution:           input.Quality.String(),
+		Processing:           true,
+		ThumbnailPath:        fmt.Sprintf("%s/%s-thumbnail.jpg", rootVideoPath, fileName),
+		WebThumbnailPath:     fmt.Sprintf("%s/%s-web_thumbnail.jpg", rootVideoPath, fileName),
+		VideoPath:            fmt.Sprintf("%s/%s-video.%s", rootVideoPath, fileName, videoExtension),
+		ChatPath:             chatPath,
+		LiveChatPath:         liveChatPath,
+		ChatVideoPath:        chatVideoPath,
+		LiveChatConvertPath:  liveChatConvertPath,
+		InfoPath:             fmt.Sprintf("%s/%s-info.json", rootVideoPath, fileName),
+		StreamedAt:           clip.CreatedAt,
+		FolderName:           folderName,
+		FileName:             fileName,
+		TranscodingProfileID: input.TranscodingProfileID,
 		// create temporary paths
 		TmpVideoDownloadPath:    fmt.Sprintf("%s/%s_%s-video.%s", envConfig.TempDir, clip.ID, vUUID, videoExtension),
 		TmpVideoConvertPath:     fmt.Sprintf("%s/%s_%s-video-convert.%s", envConfig.TempDir, clip.ID, vUUID, videoExtension),
@@ -500,8 +563,19 @@ func (s *Service) ArchiveLivestream(ctx context.Context, input ArchiveVideoInput
 		return nil, fmt.Errorf("error fetching channel: %v", err)
 	}
 
+	livePlatform, err := platform.Resolve(channel.Platform, s.PlatformTwitch, s.PlatformYoutube, s.PlatformKick)
+	if err != nil {
+		return nil, err
+	}
+
+	// live chat archiving is only supported for Twitch
+	if channel.Platform != utils.PlatformTwitch {
+		input.ArchiveChat = false
+		input.RenderChat = false
+	}
+
 	// get video
-	video, err := s.PlatformTwitch.GetLiveStream(ctx, channel.Name)
+	video, err := livePlatform.GetLiveStream(ctx, channel.Name)
 	if err != nil {
 		return nil, err
 	}
@@ -548,6 +622,11 @@ func (s *Service) ArchiveLivestream(ctx context.Context, input ArchiveVideoInput
 		log.Error().Err(err).Msg("error using template to create file name, falling back to default")
 		fileName = video.ID
 	}
+	// parts after the first share the stream ID and title with the first part
+	if input.Part > 1 {
+		folderName = fmt.Sprintf("%s-part%d", folderName, input.Part)
+		fileName = fmt.Sprintf("%s-part%d", fileName, input.Part)
+	}
 
 	// set facts
 	rootVideoPath := fmt.Sprintf("%s/%s/%s", envConfig.VideosDir, channelFolderName, folderName)
@@ -568,27 +647,31 @@ func (s *Service) ArchiveLivestream(ctx context.Context, input ArchiveVideoInput
 
 	// Create VOD in DB
 	vodDTO := vod.Vod{
-		ID:                  vUUID,
-		ExtID:               video.ID,
-		ExtStreamID:         video.ID,
-		Platform:            "twitch",
-		Type:                utils.VodType(video.Type),
-		Title:               video.Title,
-		Duration:            1,
-		Views:               1,
-		Resolution:          input.Quality.String(),
-		Processing:          true,
-		ThumbnailPath:       fmt.Sprintf("%s/%s-thumbnail.jpg", rootVideoPath, fileName),
-		WebThumbnailPath:    fmt.Sprintf("%s/%s-web_thumbnail.jpg", rootVideoPath, fileName),
-		VideoPath:           fmt.Sprintf("%s/%s-video.%s", rootVideoPath, fileName, videoExtension),
-		ChatPath:            chatPath,
-		LiveChatPath:        liveChatPath,
-		ChatVideoPath:       chatVideoPath,
-		LiveChatConvertPath: liveChatConvertPath,
-		InfoPath:            fmt.Sprintf("%s/%s-info.json", rootVideoPath, fileName),
-		StreamedAt:          video.StartedAt,
-		FolderName:          folderName,
-		FileName:            fileName,
+		ID:                   vUUID,
+		ExtID:                video.ID,
+		ExtStreamID:          video.ID,
+		Platform:             channel.Platform,
+		Type:                 utils.VodType(video.Type),
+		Title:                video.Title,
+		Category:             video.GameName,
+		Duration:             1,
+		Views:                1,
+		Resolution:           input.Quality.String(),
+		Processing:           true,
+		ThumbnailPath:        fmt.Sprintf("%s/%s-thumbnail.jpg", rootVideoPath, fileName),
+		WebThumbnailPath:     fmt.Sprintf("%s/%s-web_thumbnail.jpg", rootVideoPath, fileName),
+		VideoPath:            fmt.Sprintf("%s/%s-video.%s", rootVideoPath, fileName, videoExtension),
+		ChatPath:             chatPath,
+		LiveChatPath:         liveChatPath,
+		ChatVideoPath:        chatVideoPath,
+		LiveChatConvertPath:  liveChatConvertPath,
+		InfoPath:             fmt.Sprintf("%s/%s-info.json", rootVideoPath, fileName),
+		StreamedAt:           video.StartedAt,
+		FolderName:           folderName,
+		FileName:             fileName,
+		TranscodingProfileID: input.TranscodingProfileID,
+		QualityFallbacks:     utils.QualityChain("", input.QualityFallbacks),
+		Part:                 input.Part,
 		// create temporary paths
 		TmpVideoDownloadPath:    fmt.Sprintf("%s/%s_%s-video.%s", envConfig.TempDir, video.ID, vUUID, tmpLiveExtension),
 		TmpVideoConvertPath:     fmt.Sprintf("%s/%s_%s-video-convert.%s", envConfig.TempDir, video.ID, vUUID, videoExtension),
@@ -598,6 +681,12 @@ func (s *Service) ArchiveLivestream(ctx context.Context, input ArchiveVideoInput
 		TmpChatRenderPath:       fmt.Sprintf("%s/%s_%s-chat.mp4", envConfig.TempDir, video.ID, vUUID),
 	}
 
+	if input.RecordAudioFallback {
+		// ADTS can be appended to when the recording restarts
+		vodDTO.AudioPath = fmt.Sprintf("%s/%s-audio.aac", rootVideoPath, fileName)
+		vodDTO.TmpAudioPath = fmt.Sprintf("%s/%s_%s-audio.aac", envConfig.TempDir, video.ID, vUUID)
+	}
+
 	vodDTO.TmpVideoHLSPath = fmt.Sprintf("%s/%s_%s-video_hls0", envConfig.TempDir, video.ID, vUUID)
 	if config.Get().Archive.SaveAsHls {
 		vodDTO.TmpVideoDownloadPath = fmt.Sprintf("%s/%s-video.m3u8", vodDTO.TmpVideoHLSPath, video.ID)
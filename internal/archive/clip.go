@@ -0,0 +1,139 @@
+package archive
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rs/zerolog/log"
+	"github.com/zibbp/ganymede/ent"
+	entVod "github.com/zibbp/ganymede/ent/vod"
+	"github.com/zibbp/ganymede/internal/config"
+	"github.com/zibbp/ganymede/internal/tasks"
+	"github.com/zibbp/ganymede/internal/utils"
+	"github.com/zibbp/ganymede/internal/vod"
+)
+
+// CreateClipInput is the part of a video to cut into a clip.
+type CreateClipInput struct {
+	VideoID uuid.UUID
+	Start   int // seconds
+	End     int // seconds
+	Title   string
+}
+
+// CreateClip creates a clip of the part of an archived video between start and end. The clip is a new
+// video linked to the video it was cut from and is created by a task that cuts the video, rendered chat
+// and chat of the video.
+func (s *Service) CreateClip(ctx context.Context, input CreateClipInput) (*ent.Vod, error) {
+	envConfig := config.GetEnvConfig()
+
+	video, err := s.Store.Client.Vod.Query().Where(entVod.ID(input.VideoID)).WithChannel().Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, fmt.Errorf("video not found")
+		}
+		return nil, fmt.Errorf("error fetching video: %v", err)
+	}
+	if video.Processing {
+		return nil, fmt.Errorf("video is processing")
+	}
+	if video.Type == utils.Clip {
+		return nil, fmt.Errorf("video is a clip")
+	}
+	if input.Start < 0 || input.End <= input.Start || input.End > video.Duration {
+		return nil, fmt.Errorf("invalid clip range")
+	}
+	if input.Title == "" {
+		input.Title = video.Title
+	}
+	channel := video.Edges.Channel
+
+	vUUID := uuid.New()
+
+	channelFolderName, err := GetChannelFolderName(ChannelTemplateInput{
+		ChannelName:        channel.Name,
+		ChannelID:          channel.ExtID,
+		ChannelDisplayName: channel.DisplayName,
+	})
+	if err != nil {
+		log.Warn().Err(err).Msg("error resolving channel folder template, falling back to channel login name")
+		channelFolderName = channel.Name
+	}
+
+	streamedAt := video.StreamedAt.Add(time.Duration(input.Start) * time.Second)
+	storageTemplateInput := StorageTemplateInput{
+		UUID:               vUUID,
+		ID:                 vUUID.String(),
+		Channel:            channel.Name,
+		ChannelID:          channel.ExtID,
+		ChannelDisplayName: channel.DisplayName,
+		Title:              input.Title,
+		Type:               string(utils.Clip),
+		Date:               streamedAt.Format("2006-01-02"),
+		YYYY:               streamedAt.Format("2006"),
+		MM:                 streamedAt.Format("01"),
+		DD:                 streamedAt.Format("02"),
+		HH:                 streamedAt.Format("15"),
+	}
+	folderName, err := GetFolderName(vUUID, storageTemplateInput)
+	if err != nil {
+		log.Error().Err(err).Msg("error using template to create folder name, falling back to default")
+		folderName = vUUID.String()
+	}
+	fileName, err := GetFileName(vUUID, storageTemplateInput)
+	if err != nil {
+		log.Error().Err(err).Msg("error using template to create file name, falling back to default")
+		fileName = vUUID.String()
+	}
+
+	rootVideoPath := fmt.Sprintf("%s/%s/%s", envConfig.VideosDir, channelFolderName, folderName)
+	chatPath := ""
+	if video.ChatPath != "" {
+		chatPath = fmt.Sprintf("%s/%s-chat.json", rootVideoPath, fileName)
+	}
+	chatVideoPath := ""
+	if video.ChatVideoPath != "" {
+		chatVideoPath = fmt.Sprintf("%s/%s-chat.mp4", rootVideoPath, fileName)
+	}
+
+	// clips are always saved as MP4, HLS is only worth it for long videos
+	vodDTO := vod.Vod{
+		ID:               vUUID,
+		Platform:         video.Platform,
+		Type:             utils.Clip,
+		Title:            input.Title,
+		Category:         video.Category,
+		Duration:         input.End - input.Start,
+		ClipVodOffset:    input.Start,
+		ClipVodID:        &video.ID,
+		Resolution:       video.Resolution,
+		Processing:       true,
+		ThumbnailPath:    fmt.Sprintf("%s/%s-thumbnail.jpg", rootVideoPath, fileName),
+		WebThumbnailPath: fmt.Sprintf("%s/%s-web_thumbnail.jpg", rootVideoPath, fileName),
+		VideoPath:        fmt.Sprintf("%s/%s-video.mp4", rootVideoPath, fileName),
+		ChatPath:         chatPath,
+		ChatVideoPath:    chatVideoPath,
+		StreamedAt:       streamedAt,
+		FolderName:       folderName,
+		FileName:         fileName,
+	}
+
+	var clip *ent.Vod
+	err = s.Store.WithTx(ctx, func(txClient *ent.Client, tx *sql.Tx) error {
+		clip, err = s.VodService.CreateVodWithClient(ctx, txClient, vodDTO, channel.ID)
+		if err != nil {
+			return err
+		}
+		_, err = s.RiverClient.InsertTx(ctx, tx, tasks.CreateClipArgs{VideoID: vUUID}, nil)
+		return err
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error creating clip: %v", err)
+	}
+
+	log.Info().Str("video_id", video.ID.String()).Str("clip_id", clip.ID.String()).Int("start", input.Start).Int("end", input.End).Msg("creating clip")
+	return clip, nil
+}
@@ -0,0 +1,33 @@
+package archive
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/zibbp/ganymede/ent"
+	"github.com/zibbp/ganymede/internal/reencode"
+	"github.com/zibbp/ganymede/internal/tasks"
+)
+
+// ReencodeVideosInput selects the archived videos to re-encode and the transcoding profile to re-encode them with.
+type ReencodeVideosInput struct {
+	Filter               reencode.Filter
+	TranscodingProfileID uuid.UUID
+}
+
+// ReencodeVideos queues the re-encoding of the archived videos matching the filter. Each video is re-encoded by
+// its own task which shows in the queue.
+func (s *Service) ReencodeVideos(ctx context.Context, input ReencodeVideosInput) error {
+	if _, err := s.Store.Client.TranscodingProfile.Get(ctx, input.TranscodingProfileID); err != nil {
+		if ent.IsNotFound(err) {
+			return fmt.Errorf("transcoding profile not found")
+		}
+		return fmt.Errorf("error fetching transcoding profile: %v", err)
+	}
+
+	if _, err := s.RiverClient.Client.Insert(ctx, tasks.ReencodeVideosArgs{Filter: input.Filter, TranscodingProfileID: input.TranscodingProfileID}, nil); err != nil {
+		return fmt.Errorf("error queueing re-encode: %v", err)
+	}
+	return nil
+}
@@ -0,0 +1,222 @@
+// Package audit verifies that the files of archived videos exist and can be played.
+//
+// The library audit task checks every video and saves the result on the video so broken
+// videos can be listed and repaired without checking the whole library again.
+package audit
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/zibbp/ganymede/ent"
+	"github.com/zibbp/ganymede/internal/exec"
+	"github.com/zibbp/ganymede/internal/hls"
+	"github.com/zibbp/ganymede/internal/storage"
+	"github.com/zibbp/ganymede/internal/utils"
+)
+
+// sizeMismatchTolerance is how much smaller than the recorded storage size the files of a video may be
+// before they are reported, the size changes a little when thumbnails or NFO files are regenerated.
+const sizeMismatchTolerance = 0.05
+
+// Result is the health of a video.
+type Result struct {
+	Status utils.VideoHealth        `json:"status"`
+	Issues []utils.VideoHealthIssue `json:"issues"`
+}
+
+// Checker checks the files of videos.
+type Checker struct {
+	Storage storage.Storage
+	// Probe reads the duration of a media file, ffprobe is used if it is nil.
+	Probe func(ctx context.Context, path string) (exec.MediaDuration, error)
+}
+
+func NewChecker() *Checker {
+	return &Checker{
+		Storage: storage.Get(),
+		Probe:   exec.ProbeMediaDuration,
+	}
+}
+
+// Check returns the health of the video.
+func (c *Checker) Check(ctx context.Context, video *ent.Vod) (Result, error) {
+	issues := []utils.VideoHealthIssue{}
+
+	videoExists, err := c.exists(ctx, video.VideoPath)
+	if err != nil {
+		return Result{}, err
+	}
+	if !videoExists {
+		issues = append(issues, utils.VideoHealthIssueVideoMissing)
+	} else {
+		if video.VideoHlsPath != "" {
+			complete, err := c.hlsSegmentsExist(ctx, video.VideoPath)
+			if err != nil {
+				return Result{}, err
+			}
+			if !complete {
+				issues = append(issues, utils.VideoHealthIssueHLSSegmentsMissing)
+			}
+		}
+		if c.playable(ctx, video) {
+			if c.Storage.Driver() == storage.DriverLocal && video.StorageSizeBytes > 0 {
+				size, err := utils.GetSizeOfDirectory(videoDirectory(video))
+				if err != nil {
+					return Result{}, fmt.Errorf("error getting size of video directory: %w", err)
+				}
+				if float64(size) < float64(video.StorageSizeBytes)*(1-sizeMismatchTolerance) {
+					issues = append(issues, utils.VideoHealthIssueSizeMismatch)
+				}
+			}
+		} else {
+			issues = append(issues, utils.VideoHealthIssueVideoUnplayable)
+		}
+	}
+
+	optional := []struct {
+		path  string
+		issue utils.VideoHealthIssue
+	}{
+		{video.ChatPath, utils.VideoHealthIssueChatMissing},
+		{video.ChatVideoPath, utils.VideoHealthIssueChatVideoMissing},
+		{video.WebThumbnailPath, utils.VideoHealthIssueThumbnailMissing},
+	}
+	for _, file := range optional {
+		if file.path == "" {
+			continue
+		}
+		exists, err := c.exists(ctx, file.path)
+		if err != nil {
+			return Result{}, err
+		}
+		if !exists {
+			issues = append(issues, file.issue)
+		}
+	}
+
+	if video.SpriteThumbnailsEnabled {
+		for _, image := range video.SpriteThumbnailsImages {
+			exists, err := c.exists(ctx, image)
+			if err != nil {
+				return Result{}, err
+			}
+			if !exists {
+				issues = append(issues, utils.VideoHealthIssueSpriteThumbnailsMissing)
+				break
+			}
+		}
+	}
+
+	return Result{Status: Classify(issues), Issues: issues}, nil
+}
+
+// Classify returns the health of a video with the issues. A video that cannot be played is broken,
+// a video that can be played but is missing other files is degraded.
+func Classify(issues []utils.VideoHealthIssue) utils.VideoHealth {
+	status := utils.VideoHealthHealthy
+	for _, issue := range issues {
+		switch issue {
+		case utils.VideoHealthIssueVideoMissing, utils.VideoHealthIssueVideoUnplayable, utils.VideoHealthIssueHLSSegmentsMissing:
+			return utils.VideoHealthBroken
+		default:
+			status = utils.VideoHealthDegraded
+		}
+	}
+	return status
+}
+
+// Save saves the result on the video.
+func Save(ctx context.Context, client *ent.Client, videoID uuid.UUID, result Result, checkedAt time.Time) error {
+	_, err := client.Vod.UpdateOneID(videoID).
+		SetHealthStatus(result.Status).
+		SetHealthIssues(result.Issues).
+		SetHealthCheckedAt(checkedAt).
+		Save(ctx)
+	if err != nil {
+		return fmt.Errorf("error saving health of video %s: %w", videoID, err)
+	}
+	return nil
+}
+
+func (c *Checker) exists(ctx context.Context, path string) (bool, error) {
+	if path == "" {
+		return false, nil
+	}
+	exists, err := c.Storage.Exists(ctx, path)
+	if err != nil {
+		return false, fmt.Errorf("error checking file %s: %w", path, err)
+	}
+	return exists, nil
+}
+
+// hlsSegmentsExist reports whether every file referenced by the playlist exists. The segments of the media
+// playlists of a multivariant playlist are checked as well.
+func (c *Checker) hlsSegmentsExist(ctx context.Context, playlistPath string) (bool, error) {
+	reader, err := c.Storage.Open(ctx, playlistPath)
+	if err != nil {
+		return false, fmt.Errorf("error opening playlist %s: %w", playlistPath, err)
+	}
+	defer reader.Close()
+
+	files, err := hls.MediaPlaylistFiles(reader)
+	if err != nil {
+		return false, fmt.Errorf("error reading playlist %s: %w", playlistPath, err)
+	}
+	for _, file := range files {
+		// remote segments can't be checked
+		if strings.Contains(file, "://") {
+			continue
+		}
+		filePath := filepath.Join(filepath.Dir(playlistPath), filepath.FromSlash(path.Clean(file)))
+		exists, err := c.exists(ctx, filePath)
+		if err != nil {
+			return false, err
+		}
+		if !exists {
+			return false, nil
+		}
+		if path.Ext(file) == ".m3u8" {
+			exists, err = c.hlsSegmentsExist(ctx, filePath)
+			if err != nil || !exists {
+				return false, err
+			}
+		}
+	}
+	return true, nil
+}
+
+// playable reports whether ffprobe can read the video and its timeline is not broken.
+func (c *Checker) playable(ctx context.Context, video *ent.Vod) bool {
+	// presigned URLs can't be used for the relative segment URIs of a playlist
+	if video.VideoHlsPath != "" && c.Storage.Driver() != storage.DriverLocal {
+		return true
+	}
+	location, err := c.Storage.URL(ctx, video.VideoPath)
+	if err != nil {
+		return false
+	}
+	probe := c.Probe
+	if probe == nil {
+		probe = exec.ProbeMediaDuration
+	}
+	duration, err := probe(ctx, location)
+	if err != nil || duration.Duration <= 0 {
+		return false
+	}
+	return !duration.HasTimestampAnomaly()
+}
+
+// videoDirectory returns the directory holding all files of the video.
+func videoDirectory(video *ent.Vod) string {
+	directory := filepath.Dir(video.VideoPath)
+	if video.VideoHlsPath != "" {
+		directory = filepath.Dir(directory)
+	}
+	return directory
+}
@@ -0,0 +1,163 @@
+package audit
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"github.com/zibbp/ganymede/ent"
+	"github.com/zibbp/ganymede/internal/exec"
+	"github.com/zibbp/ganymede/internal/storage"
+	"github.com/zibbp/ganymede/internal/utils"
+	tests_files "github.com/zibbp/ganymede/tests/files"
+)
+
+func newTestChecker(duration exec.MediaDuration, err error) *Checker {
+	return &Checker{
+		Storage: storage.NewLocal(),
+		Probe: func(ctx context.Context, path string) (exec.MediaDuration, error) {
+			return duration, err
+		},
+	}
+}
+
+func newTestVideo(t *testing.T) *ent.Vod {
+	t.Helper()
+	dir := t.TempDir()
+	video := &ent.Vod{
+		ID:               uuid.New(),
+		VideoPath:        filepath.Join(dir, "123-video.mp4"),
+		ChatPath:         filepath.Join(dir, "123-chat.json"),
+		WebThumbnailPath: filepath.Join(dir, "123-web_thumbnail.jpg"),
+	}
+	tests_files.Write(t, video.VideoPath, "video")
+	tests_files.Write(t, video.ChatPath, "{}")
+	tests_files.Write(t, video.WebThumbnailPath, "thumbnail")
+	return video
+}
+
+var playableDuration = exec.MediaDuration{Duration: 60, FormatDuration: 60, LongestStreamDuration: 60}
+
+func TestCheckHealthyVideo(t *testing.T) {
+	t.Parallel()
+
+	video := newTestVideo(t)
+	video.StorageSizeBytes = int64(len("video") + len("{}") + len("thumbnail"))
+
+	result, err := newTestChecker(playableDuration, nil).Check(t.Context(), video)
+	require.NoError(t, err)
+	require.Equal(t, utils.VideoHealthHealthy, result.Status)
+	require.Empty(t, result.Issues)
+}
+
+func TestCheckMissingFiles(t *testing.T) {
+	t.Parallel()
+
+	video := newTestVideo(t)
+	require.NoError(t, os.Remove(video.ChatPath))
+	require.NoError(t, os.Remove(video.WebThumbnailPath))
+	video.SpriteThumbnailsEnabled = true
+	video.SpriteThumbnailsImages = []string{filepath.Join(filepath.Dir(video.VideoPath), "sprites", "123_0.jpg")}
+
+	result, err := newTestChecker(playableDuration, nil).Check(t.Context(), video)
+	require.NoError(t, err)
+	require.Equal(t, utils.VideoHealthDegraded, result.Status)
+	require.ElementsMatch(t, []utils.VideoHealthIssue{
+		utils.VideoHealthIssueChatMissing,
+		utils.VideoHealthIssueThumbnailMissing,
+		utils.VideoHealthIssueSpriteThumbnailsMissing,
+	}, result.Issues)
+
+	require.NoError(t, os.Remove(video.VideoPath))
+	result, err = newTestChecker(playableDuration, nil).Check(t.Context(), video)
+	require.NoError(t, err)
+	require.Equal(t, utils.VideoHealthBroken, result.Status)
+	require.Contains(t, result.Issues, utils.VideoHealthIssueVideoMissing)
+}
+
+func TestCheckUnplayableVideo(t *testing.T) {
+	t.Parallel()
+
+	video := newTestVideo(t)
+
+	result, err := newTestChecker(exec.MediaDuration{}, errors.New("invalid data found when processing input")).Check(t.Context(), video)
+	require.NoError(t, err)
+	require.Equal(t, utils.VideoHealthBroken, result.Status)
+	require.Equal(t, []utils.VideoHealthIssue{utils.VideoHealthIssueVideoUnplayable}, result.Issues)
+
+	anomaly := exec.MediaDuration{Duration: 60, FormatDuration: 36000, LongestStreamDuration: 60}
+	result, err = newTestChecker(anomaly, nil).Check(t.Context(), video)
+	require.NoError(t, err)
+	require.Equal(t, []utils.VideoHealthIssue{utils.VideoHealthIssueVideoUnplayable}, result.Issues)
+}
+
+func TestCheckSizeMismatch(t *testing.T) {
+	t.Parallel()
+
+	video := newTestVideo(t)
+	video.StorageSizeBytes = 1 << 20
+
+	result, err := newTestChecker(playableDuration, nil).Check(t.Context(), video)
+	require.NoError(t, err)
+	require.Equal(t, utils.VideoHealthDegraded, result.Status)
+	require.Equal(t, []utils.VideoHealthIssue{utils.VideoHealthIssueSizeMismatch}, result.Issues)
+}
+
+func TestCheckHLSSegments(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	hlsDir := filepath.Join(dir, "123-video_hls")
+	video := &ent.Vod{
+		ID:           uuid.New(),
+		VideoPath:    filepath.Join(hlsDir, "123-video.m3u8"),
+		VideoHlsPath: hlsDir,
+	}
+	tests_files.Write(t, video.VideoPath, "#EXTM3U\n#EXT-X-MAP:URI=\"init.mp4\"\n#EXTINF:10.0,\n123-video0.mp4\n#EXTINF:10.0,\n123-video1.mp4\n#EXT-X-ENDLIST\n")
+	tests_files.Write(t, filepath.Join(hlsDir, "init.mp4"), "init")
+	tests_files.Write(t, filepath.Join(hlsDir, "123-video0.mp4"), "segment")
+
+	result, err := newTestChecker(playableDuration, nil).Check(t.Context(), video)
+	require.NoError(t, err)
+	require.Equal(t, utils.VideoHealthBroken, result.Status)
+	require.Equal(t, []utils.VideoHealthIssue{utils.VideoHealthIssueHLSSegmentsMissing}, result.Issues)
+
+	tests_files.Write(t, filepath.Join(hlsDir, "123-video1.mp4"), "segment")
+	result, err = newTestChecker(playableDuration, nil).Check(t.Context(), video)
+	require.NoError(t, err)
+	require.Equal(t, utils.VideoHealthHealthy, result.Status)
+}
+
+func TestCheckHLSMultivariantSegments(t *testing.T) {
+	t.Parallel()
+
+	hlsDir := filepath.Join(t.TempDir(), "123-video_hls")
+	video := &ent.Vod{
+		ID:           uuid.New(),
+		VideoPath:    filepath.Join(hlsDir, "123-video.m3u8"),
+		VideoHlsPath: hlsDir,
+	}
+	tests_files.Write(t, video.VideoPath, "#EXTM3U\n#EXT-X-STREAM-INF:BANDWIDTH=6000000\n123-source.m3u8\n")
+	tests_files.Write(t, filepath.Join(hlsDir, "123-source.m3u8"), "#EXTM3U\n#EXTINF:10.0,\n123-source_segment0.ts\n#EXT-X-ENDLIST\n")
+
+	result, err := newTestChecker(playableDuration, nil).Check(t.Context(), video)
+	require.NoError(t, err)
+	require.Equal(t, []utils.VideoHealthIssue{utils.VideoHealthIssueHLSSegmentsMissing}, result.Issues)
+
+	tests_files.Write(t, filepath.Join(hlsDir, "123-source_segment0.ts"), "segment")
+	result, err = newTestChecker(playableDuration, nil).Check(t.Context(), video)
+	require.NoError(t, err)
+	require.Equal(t, utils.VideoHealthHealthy, result.Status)
+}
+
+func TestClassify(t *testing.T) {
+	t.Parallel()
+
+	require.Equal(t, utils.VideoHealthHealthy, Classify(nil))
+	require.Equal(t, utils.VideoHealthDegraded, Classify([]utils.VideoHealthIssue{utils.VideoHealthIssueChatVideoMissing}))
+	require.Equal(t, utils.VideoHealthBroken, Classify([]utils.VideoHealthIssue{utils.VideoHealthIssueThumbnailMissing, utils.VideoHealthIssueVideoMissing}))
+}
@@ -18,29 +18,42 @@ import (
 )
 
 type Service struct {
-	Store          *database.Database
-	PlatformTwitch platform.Platform
+	Store           *database.Database
+	PlatformTwitch  platform.Platform
+	PlatformYoutube platform.Platform
+	PlatformKick    platform.Platform
 }
 
-func NewService(store *database.Database, platformTwitch platform.Platform) *Service {
-	return &Service{Store: store, PlatformTwitch: platformTwitch}
+func NewService(store *database.Database, platformTwitch platform.Platform, platformYoutube platform.Platform, platformKick platform.Platform) *Service {
+	return &Service{Store: store, PlatformTwitch: platformTwitch, PlatformYoutube: platformYoutube, PlatformKick: platformKick}
 }
 
 type Channel struct {
-	ID            uuid.UUID `json:"id"`
-	ExtID         string    `json:"ext_id"`
-	Name          string    `json:"name"`
-	DisplayName   string    `json:"display_name"`
-	ImagePath     string    `json:"image_path"`
-	Retention     bool      `json:"retention"`
-	RetentionDays int64     `json:"retention_days"`
-	UpdatedAt     time.Time `json:"updated_at"`
-	CreatedAt     time.Time `json:"created_at"`
+	ID                       uuid.UUID           `json:"id"`
+	ExtID                    string              `json:"ext_id"`
+	Name                     string              `json:"name"`
+	DisplayName              string              `json:"display_name"`
+	ImagePath                string              `json:"image_path"`
+	Platform                 utils.VideoPlatform `json:"platform"`
+	Retention                bool                `json:"retention"`
+	RetentionDays            int64               `json:"retention_days"`
+	RetentionKeepNewest      int                 `json:"retention_keep_newest"`
+	RetentionMaxSizeBytes    int64               `json:"retention_max_size_bytes"`
+	RetentionVideoTypes      []utils.VodType     `json:"retention_video_types"`
+	RetentionExemptPlaylists bool                `json:"retention_exempt_playlists"`
+	RetentionExemptMinViews  int                 `json:"retention_exempt_min_views"`
+	UpdatedAt                time.Time           `json:"updated_at"`
+	CreatedAt                time.Time           `json:"created_at"`
 }
 
 func (s *Service) CreateChannel(channelDto Channel) (*ent.Channel, error) {
 
-	cha, err := s.Store.Client.Channel.Create().SetExtID(channelDto.ExtID).SetName(channelDto.Name).SetDisplayName(channelDto.DisplayName).SetImagePath(channelDto.ImagePath).Save(context.Background())
+	create := s.Store.Client.Channel.Create().SetExtID(channelDto.ExtID).SetName(channelDto.Name).SetDisplayName(channelDto.DisplayName).SetImagePath(channelDto.ImagePath)
+	if channelDto.Platform != "" {
+		create.SetPlatform(channelDto.Platform)
+	}
+
+	cha, err := create.Save(context.Background())
 	if err != nil {
 		if _, ok := err.(*ent.ConstraintError); ok {
 			return nil, fmt.Errorf("channel already exists: %v", err)
@@ -120,7 +133,18 @@ func (s *Service) DeleteChannel(channelID uuid.UUID) error {
 }
 
 func (s *Service) UpdateChannel(cId uuid.UUID, channelDto Channel) (*ent.Channel, error) {
-	cha, err := s.Store.Client.Channel.UpdateOneID(cId).SetName(channelDto.Name).SetDisplayName(channelDto.DisplayName).SetImagePath(channelDto.ImagePath).SetRetention(channelDto.Retention).SetRetentionDays(channelDto.RetentionDays).Save(context.Background())
+	cha, err := s.Store.Client.Channel.UpdateOneID(cId).
+		SetName(channelDto.Name).
+		SetDisplayName(channelDto.DisplayName).
+		SetImagePath(channelDto.ImagePath).
+		SetRetention(channelDto.Retention).
+		SetRetentionDays(channelDto.RetentionDays).
+		SetRetentionKeepNewest(channelDto.RetentionKeepNewest).
+		SetRetentionMaxSizeBytes(channelDto.RetentionMaxSizeBytes).
+		SetRetentionVideoTypes(channelDto.RetentionVideoTypes).
+		SetRetentionExemptPlaylists(channelDto.RetentionExemptPlaylists).
+		SetRetentionExemptMinViews(channelDto.RetentionExemptMinViews).
+		Save(context.Background())
 	if err != nil {
 		// if channel not found
 		if _, ok := err.(*ent.NotFoundError); ok {
@@ -169,7 +193,7 @@ func (s *Service) PopulateExternalChannelID(ctx context.Context) {
 	}
 
 	for _, c := range channels {
-		if c.ExtID != "" {
+		if c.ExtID != "" || c.Platform != utils.PlatformTwitch {
 			continue
 		}
 		twitcChannel, err := s.PlatformTwitch.GetChannel(ctx, &c.Name, nil)
@@ -192,41 +216,46 @@ func (s *Service) UpdateChannelImage(ctx context.Context, channelID uuid.UUID, c
 		return fmt.Errorf("error getting channel: %v", err)
 	}
 
-	// Fetch channel from Twitch API
-	twitchChannel, err := s.PlatformTwitch.GetChannel(ctx, &channel.Name, nil)
+	channelPlatform, err := platform.Resolve(channel.Platform, s.PlatformTwitch, s.PlatformYoutube, s.PlatformKick)
+	if err != nil {
+		return err
+	}
+
+	// Fetch channel from the platform API
+	platformChannel, err := channelPlatform.GetChannel(ctx, &channel.Name, nil)
 	if err != nil {
-		return fmt.Errorf("error fetching twitch channel: %v", err)
+		return fmt.Errorf("error fetching %s channel: %v", channel.Platform, err)
 	}
 
 	env := config.GetEnvConfig()
 
 	// Resolve channel folder name from template
 	channelFolderName, chErr := storagetemplate.GetChannelFolderName(storagetemplate.ChannelTemplateInput{
-		ChannelName:        twitchChannel.Login,
-		ChannelID:          twitchChannel.ID,
-		ChannelDisplayName: twitchChannel.DisplayName,
+		ChannelName:        platformChannel.Login,
+		ChannelID:          platformChannel.ID,
+		ChannelDisplayName: platformChannel.DisplayName,
 	})
 	if chErr != nil {
 		log.Warn().Err(chErr).Msg("error resolving channel folder template, falling back to channel login name")
-		channelFolderName = twitchChannel.Login
+		channelFolderName = platformChannel.Login
 	}
 
 	// Download channel profile image
 	imagePath := filepath.Join(env.VideosDir, channelFolderName, "profile.png")
 	if checkIfExists {
-		changed, err := utils.DownloadFileIfChanged(ctx, twitchChannel.ProfileImageURL, imagePath)
+		changed, err := utils.DownloadFileIfChanged(ctx, platformChannel.ProfileImageURL, imagePath)
 		if err != nil {
 			return fmt.Errorf("error downloading channel profile image: %v", err)
 		}
 		if !changed {
-			log.Debug().Msgf("channel profile image unchanged for channel: %s", twitchChannel.Login)
+			log.Debug().Msgf("channel profile image unchanged for channel: %s", platformChannel.Login)
 		} else {
-			log.Debug().Msgf("channel profile image updated for channel: %s", twitchChannel.Login)
+			log.Debug().Msgf("channel profile image updated for channel: %s", platformChannel.Login)
 		}
 		return nil
 	}
 
-	err = utils.DownloadFile(ctx, twitchChannel.ProfileImageURL, imagePath)
+	err = utils.DownloadFile(ctx, platformChannel.ProfileImageURL, imagePath)
 	if err != nil {
 		return fmt.Errorf("error downloading channel profile image: %v", err)
 	}
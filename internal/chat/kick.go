@@ -0,0 +1,217 @@
+package chat
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/rs/zerolog/log"
+	"github.com/zibbp/ganymede/internal/platform"
+)
+
+// matches emotes in Kick chat messages such as "[emote:37226:KEKW]"
+var kickEmoteRegex = regexp.MustCompile(`\[emote:(\d+):([^\]]*)\]`)
+
+// ConvertKickChat converts a Kick chat replay to the chat format used by Ganymede. Emotes are referenced by their ID and can be embedded using EmbedKickEmotes.
+func ConvertKickChat(videoChat *platform.KickVideoChat, videoID string) Chat {
+	channelID := strconv.FormatInt(videoChat.ChannelID, 10)
+
+	comments := make([]Comment, 0, len(videoChat.Messages))
+	for _, message := range videoChat.Messages {
+		createdAt, err := time.Parse(time.RFC3339Nano, message.CreatedAt)
+		if err != nil {
+			log.Debug().Err(err).Str("message_id", message.ID).Msg("error parsing kick chat message time, skipping message")
+			continue
+		}
+
+		offset := createdAt.Sub(videoChat.StartedAt).Seconds()
+		if offset < 0 {
+			offset = 0
+		}
+
+		body, fragments, emoticons := convertKickMessageContent(message.Content)
+
+		badges := make([]UserBadge, 0, len(message.Sender.Identity.Badges))
+		for _, badge := range message.Sender.Identity.Badges {
+			version := "1"
+			if badge.Count > 0 {
+				version = strconv.Itoa(badge.Count)
+			}
+			badges = append(badges, UserBadge{
+				ID:      ID(badge.Type),
+				Version: version,
+			})
+		}
+
+		comment := Comment{
+			ID:                   message.ID,
+			CreatedAt:            message.CreatedAt,
+			UpdatedAt:            message.CreatedAt,
+			ChannelID:            channelID,
+			ContentType:          "video",
+			ContentID:            videoID,
+			ContentOffsetSeconds: offset,
+			Commenter: Commenter{
+				DisplayName: message.Sender.Username,
+				ID:          strconv.FormatInt(message.Sender.ID, 10),
+				Name:        message.Sender.Slug,
+				CreatedAt:   message.CreatedAt,
+				UpdatedAt:   message.CreatedAt,
+			},
+			Source: "chat",
+			State:  "published",
+			Message: Message{
+				Body:       body,
+				Fragments:  fragments,
+				UserBadges: badges,
+				Emoticons:  emoticons,
+			},
+		}
+
+		if message.Sender.Identity.Color != "" {
+			color := message.Sender.Identity.Color
+			comment.Message.UserColor = &color
+		}
+
+		if message.Type == "reply" && message.Metadata != "" {
+			var metadata platform.KickChatReplyMetadata
+			if err := json.Unmarshal([]byte(message.Metadata), &metadata); err == nil {
+				parentBody, _, _ := convertKickMessageContent(metadata.OriginalMessage.Content)
+				comment.Message.Reply = &Reply{
+					ParentMsgID:       metadata.OriginalMessage.ID,
+					ParentUserID:      fmt.Sprint(metadata.OriginalSender.ID),
+					ParentUserLogin:   strings.ToLower(metadata.OriginalSender.Username),
+					ParentDisplayName: metadata.OriginalSender.Username,
+					ParentMsgBody:     parentBody,
+				}
+			}
+		}
+
+		comments = append(comments, comment)
+	}
+
+	return Chat{
+		Streamer: Streamer{
+			Name: videoChat.ChannelSlug,
+			ID:   videoChat.ChannelSlug,
+		},
+		Comments: comments,
+		Video: VideoClass{
+			Start: 0,
+			End:   videoChat.Duration.Seconds(),
+		},
+	}
+}
+
+// convertKickMessageContent replaces the emote tags in the message content with the emote names. The message is split into text and emote fragments.
+func convertKickMessageContent(content string) (string, []Fragment, []EmoticonElement) {
+	var body strings.Builder
+	fragments := []Fragment{}
+	emoticons := []EmoticonElement{}
+
+	last := 0
+	for _, match := range kickEmoteRegex.FindAllStringSubmatchIndex(content, -1) {
+		if match[0] > last {
+			text := content[last:match[0]]
+			body.WriteString(text)
+			fragments = append(fragments, Fragment{Text: text})
+		}
+
+		emoteID := content[match[2]:match[3]]
+		emoteName := content[match[4]:match[5]]
+		begin := int64(len([]rune(body.String())))
+		body.WriteString(emoteName)
+		fragments = append(fragments, Fragment{
+			Text: emoteName,
+			Emoticon: &FragmentEmoticon{
+				EmoticonID: emoteID,
+			},
+		})
+		emoticons = append(emoticons, EmoticonElement{
+			ID:    emoteID,
+			Begin: begin,
+			End:   begin + int64(len([]rune(emoteName))) - 1,
+		})
+
+		last = match[1]
+	}
+	if last < len(content) {
+		text := content[last:]
+		body.WriteString(text)
+		fragments = append(fragments, Fragment{Text: text})
+	}
+
+	return body.String(), fragments, emoticons
+}
+
+// EmbedKickEmotes downloads the emotes used in the chat and embeds them as first party emotes. Emotes that fail to download are skipped.
+func EmbedKickEmotes(ctx context.Context, chat *Chat) error {
+	embedded := make(map[string]struct{})
+	for _, comment := range chat.Comments {
+		for _, fragment := range comment.Message.Fragments {
+			if fragment.Emoticon == nil {
+				continue
+			}
+			id := fragment.Emoticon.EmoticonID
+			if _, ok := embedded[id]; ok {
+				continue
+			}
+			embedded[id] = struct{}{}
+
+			data, err := downloadKickEmote(ctx, id)
+			if err != nil {
+				if ctx.Err() != nil {
+					return ctx.Err()
+				}
+				log.Warn().Err(err).Str("emote_id", id).Msg("error downloading kick emote")
+				continue
+			}
+
+			chat.Emotes.FirstParty = append(chat.Emotes.FirstParty, Party{
+				ID:         id,
+				ImageScale: 1,
+				Data:       base64.StdEncoding.EncodeToString(data),
+				Name:       fragment.Text,
+			})
+		}
+	}
+
+	return nil
+}
+
+func downloadKickEmote(ctx context.Context, id string) ([]byte, error) {
+	client := &http.Client{}
+	req, err := http.NewRequestWithContext(ctx, "GET", platform.KickEmoteURL(id), nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %v", err)
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get emote: %v", err)
+	}
+
+	defer func() {
+		if err := resp.Body.Close(); err != nil {
+			log.Debug().Err(err).Msg("failed to close response body")
+		}
+	}()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status code %d", resp.StatusCode)
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response body: %v", err)
+	}
+
+	return body, nil
+}
@@ -0,0 +1,66 @@
+package chat
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/zibbp/ganymede/internal/platform"
+)
+
+func TestConvertKickChat(t *testing.T) {
+	var messages []platform.KickChatMessage
+	err := json.Unmarshal([]byte(`[
+		{"id":"m1","content":"hello [emote:37226:KEKW] world","type":"message","created_at":"2024-05-02T09:00:05.500000Z",
+		 "sender":{"id":7,"slug":"chatter","username":"Chatter","identity":{"color":"#FF0000","badges":[{"type":"subscriber","text":"Subscriber","count":3},{"type":"moderator","text":"Moderator"}]}}},
+		{"id":"m2","content":"@Chatter agreed","type":"reply","created_at":"2024-05-02T09:00:10Z",
+		 "metadata":"{\"original_sender\":{\"id\":7,\"username\":\"Chatter\"},\"original_message\":{\"id\":\"m1\",\"content\":\"hello [emote:37226:KEKW] world\"}}",
+		 "sender":{"id":8,"slug":"replier","username":"Replier"}}
+	]`), &messages)
+	if err != nil {
+		t.Fatalf("failed to unmarshal messages: %v", err)
+	}
+
+	chat := ConvertKickChat(&platform.KickVideoChat{
+		ChannelID:   42,
+		ChannelSlug: "testkick",
+		StartedAt:   time.Date(2024, 5, 2, 9, 0, 0, 0, time.UTC),
+		Duration:    time.Minute,
+		Messages:    messages,
+	}, "video-uuid")
+
+	if chat.Streamer.Name != "testkick" || chat.Video.End != 60 {
+		t.Fatalf("unexpected chat metadata: %+v %+v", chat.Streamer, chat.Video)
+	}
+	if len(chat.Comments) != 2 {
+		t.Fatalf("expected 2 comments, got %d", len(chat.Comments))
+	}
+
+	comment := chat.Comments[0]
+	if comment.ContentOffsetSeconds != 5.5 || comment.ContentID != "video-uuid" || comment.ChannelID != "42" {
+		t.Fatalf("unexpected comment: %+v", comment)
+	}
+	if comment.Commenter.DisplayName != "Chatter" || comment.Commenter.Name != "chatter" || comment.Commenter.ID != "7" {
+		t.Fatalf("unexpected commenter: %+v", comment.Commenter)
+	}
+	if comment.Message.Body != "hello KEKW world" {
+		t.Fatalf("expected emote tag to be replaced, got %q", comment.Message.Body)
+	}
+	if len(comment.Message.Fragments) != 3 || comment.Message.Fragments[1].Emoticon == nil || comment.Message.Fragments[1].Emoticon.EmoticonID != "37226" {
+		t.Fatalf("unexpected fragments: %+v", comment.Message.Fragments)
+	}
+	if len(comment.Message.Emoticons) != 1 || comment.Message.Emoticons[0].Begin != 6 || comment.Message.Emoticons[0].End != 9 {
+		t.Fatalf("unexpected emoticons: %+v", comment.Message.Emoticons)
+	}
+	if comment.Message.UserColor == nil || *comment.Message.UserColor != "#FF0000" {
+		t.Fatalf("unexpected user color: %v", comment.Message.UserColor)
+	}
+	if len(comment.Message.UserBadges) != 2 || comment.Message.UserBadges[0].ID != "subscriber" || comment.Message.UserBadges[0].Version != "3" || comment.Message.UserBadges[1].Version != "1" {
+		t.Fatalf("unexpected badges: %+v", comment.Message.UserBadges)
+	}
+
+	reply := chat.Comments[1].Message.Reply
+	if reply == nil || reply.ParentMsgID != "m1" || reply.ParentUserID != "7" || reply.ParentMsgBody != "hello KEKW world" {
+		t.Fatalf("unexpected reply: %+v", reply)
+	}
+}
@@ -0,0 +1,120 @@
+package chat
+
+import (
+	"bytes"
+	"cmp"
+	"encoding/json"
+	"fmt"
+	"math"
+	"slices"
+)
+
+// Shift moves the comments of a chat offset seconds earlier, for a chat downloaded for a video that
+// starts offset seconds later. Comments before the start of the video are dropped.
+func Shift(data []byte, offset float64) ([]byte, error) {
+	return rewrite(data,
+		func(seconds float64) (float64, bool) { return seconds - offset, seconds >= offset },
+		func(seconds float64) float64 { return math.Max(0, seconds-offset) },
+	)
+}
+
+// Slice keeps the comments of a chat between start and end. Comments keep their offset in the video
+// the chat was downloaded for, like the chats of clips downloaded from their VOD.
+func Slice(data []byte, start float64, end float64) ([]byte, error) {
+	return rewrite(data,
+		func(seconds float64) (float64, bool) { return seconds, seconds >= start && seconds <= end },
+		func(seconds float64) float64 { return math.Min(math.Max(seconds, start), end) },
+	)
+}
+
+// Gap is a part of a live stream missing from its archive.
+type Gap struct {
+	Offset   float64 // the position of the gap in the archive in seconds
+	Duration float64 // the seconds of the stream missing from the archive
+}
+
+// CloseGaps moves the comments of a chat recorded alongside a live stream archive earlier by the duration of
+// the gaps of the archive before them, so the chat stays aligned with the archive which doesn't include the
+// gaps. Comments sent during a gap are moved to the position of the gap.
+func CloseGaps(data []byte, gaps []Gap) ([]byte, error) {
+	gaps = slices.Clone(gaps)
+	slices.SortFunc(gaps, func(a, b Gap) int { return cmp.Compare(a.Offset, b.Offset) })
+	toArchive := func(seconds float64) float64 {
+		removed := 0.0
+		for _, gap := range gaps {
+			start := gap.Offset + removed
+			if seconds < start {
+				break
+			}
+			if seconds < start+gap.Duration {
+				return gap.Offset
+			}
+			removed += gap.Duration
+		}
+		return seconds - removed
+	}
+	return rewrite(data,
+		func(seconds float64) (float64, bool) { return toArchive(seconds), true },
+		toArchive,
+	)
+}
+
+// rewrite changes the offsets of the comments and the video range of a chat in the TwitchDownloader format.
+// comment returns the new offset of a comment and whether it is kept. Fields that aren't changed are kept
+// as they are.
+func rewrite(data []byte, comment func(seconds float64) (float64, bool), video func(seconds float64) float64) ([]byte, error) {
+	var chat map[string]json.RawMessage
+	if err := json.Unmarshal(data, &chat); err != nil {
+		return nil, fmt.Errorf("error unmarshalling chat: %w", err)
+	}
+
+	var comments []map[string]json.RawMessage
+	if raw, ok := chat["comments"]; ok {
+		if err := json.Unmarshal(raw, &comments); err != nil {
+			return nil, fmt.Errorf("error unmarshalling comments: %w", err)
+		}
+	}
+	kept := make([]map[string]json.RawMessage, 0, len(comments))
+	for _, c := range comments {
+		var seconds float64
+		if err := json.Unmarshal(c["content_offset_seconds"], &seconds); err != nil {
+			return nil, fmt.Errorf("error unmarshalling comment offset: %w", err)
+		}
+		seconds, ok := comment(seconds)
+		if !ok {
+			continue
+		}
+		c["content_offset_seconds"] = json.RawMessage(formatSeconds(seconds))
+		kept = append(kept, c)
+	}
+	raw, err := json.Marshal(kept)
+	if err != nil {
+		return nil, fmt.Errorf("error marshalling comments: %w", err)
+	}
+	chat["comments"] = raw
+
+	if raw, ok := chat["video"]; ok && !bytes.Equal(raw, []byte("null")) {
+		var v map[string]json.RawMessage
+		if err := json.Unmarshal(raw, &v); err != nil {
+			return nil, fmt.Errorf("error unmarshalling chat video: %w", err)
+		}
+		for _, key := range []string{"start", "end"} {
+			var seconds float64
+			if err := json.Unmarshal(v[key], &seconds); err != nil {
+				continue
+			}
+			v[key] = json.RawMessage(formatSeconds(video(seconds)))
+		}
+		if chat["video"], err = json.Marshal(v); err != nil {
+			return nil, fmt.Errorf("error marshalling chat video: %w", err)
+		}
+	}
+
+	return json.Marshal(chat)
+}
+
+// formatSeconds formats seconds as a JSON number rounded to milliseconds.
+func formatSeconds(seconds float64) string {
+	out, _ := json.Marshal(math.Round(seconds*1000) / 1000)
+	return string(out)
+}
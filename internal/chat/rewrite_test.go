@@ -0,0 +1,39 @@
+package chat
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+const rewriteTestChat = `{"streamer":{"name":"streamer","id":1},"video":{"start":0,"end":3600},"comments":[` +
+	`{"_id":"a","content_offset_seconds":12.5,"message":{"body":"first"}},` +
+	`{"_id":"b","content_offset_seconds":700.25,"message":{"body":"second"}},` +
+	`{"_id":"c","content_offset_seconds":1500,"message":{"body":"third"}}]}`
+
+func TestShift(t *testing.T) {
+	shifted, err := Shift([]byte(rewriteTestChat), 600)
+	require.NoError(t, err)
+	require.JSONEq(t, `{"streamer":{"name":"streamer","id":1},"video":{"start":0,"end":3000},"comments":[`+
+		`{"_id":"b","content_offset_seconds":100.25,"message":{"body":"second"}},`+
+		`{"_id":"c","content_offset_seconds":900,"message":{"body":"third"}}]}`, string(shifted))
+
+	_, err = Shift([]byte("not json"), 600)
+	require.Error(t, err)
+}
+
+func TestSlice(t *testing.T) {
+	sliced, err := Slice([]byte(rewriteTestChat), 600, 1200)
+	require.NoError(t, err)
+	require.JSONEq(t, `{"streamer":{"name":"streamer","id":1},"video":{"start":600,"end":1200},"comments":[`+
+		`{"_id":"b","content_offset_seconds":700.25,"message":{"body":"second"}}]}`, string(sliced))
+}
+
+func TestCloseGaps(t *testing.T) {
+	closed, err := CloseGaps([]byte(rewriteTestChat), []Gap{{Offset: 1000, Duration: 300}, {Offset: 690, Duration: 20}})
+	require.NoError(t, err)
+	require.JSONEq(t, `{"streamer":{"name":"streamer","id":1},"video":{"start":0,"end":3280},"comments":[`+
+		`{"_id":"a","content_offset_seconds":12.5,"message":{"body":"first"}},`+
+		`{"_id":"b","content_offset_seconds":690,"message":{"body":"second"}},`+
+		`{"_id":"c","content_offset_seconds":1180,"message":{"body":"third"}}]}`, string(closed))
+}
@@ -0,0 +1,332 @@
+// Package checksum records and verifies SHA-256 checksums of archived files to detect silent corruption.
+//
+// Checksums are recorded when the files of a video are moved to storage. A slice of the library is
+// hashed again each night and files that changed or disappeared are reported. A manifest in the
+// sha256sum format is written to the folder of each video so backups can be verified without Ganymede.
+package checksum
+
+import (
+	"context"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/zibbp/ganymede/ent"
+	entChecksum "github.com/zibbp/ganymede/ent/checksum"
+	"github.com/zibbp/ganymede/ent/predicate"
+	"github.com/zibbp/ganymede/internal/database"
+	"github.com/zibbp/ganymede/internal/hls"
+	"github.com/zibbp/ganymede/internal/storage"
+	"github.com/zibbp/ganymede/internal/utils"
+)
+
+// VideoFiles are the files recorded when the video is moved to storage.
+var VideoFiles = []utils.ChecksumFile{utils.ChecksumFileVideo, utils.ChecksumFileInfo, utils.ChecksumFileThumbnail, utils.ChecksumFileWebThumbnail}
+
+// ChatFiles are the files recorded when the chat is moved to storage.
+var ChatFiles = []utils.ChecksumFile{utils.ChecksumFileChat, utils.ChecksumFileChatVideo, utils.ChecksumFileLiveChat, utils.ChecksumFileLiveChatConvert}
+
+// ThumbnailFiles are the files recorded when the thumbnails are generated again.
+var ThumbnailFiles = []utils.ChecksumFile{utils.ChecksumFileThumbnail, utils.ChecksumFileWebThumbnail}
+
+// Artifact is an archived file of a video.
+type Artifact struct {
+	File utils.ChecksumFile
+	Name string // path in the HLS directory for HLS video files
+	Path string
+}
+
+// Path returns the current path of the file the checksum was recorded for.
+func Path(video *ent.Vod, file utils.ChecksumFile, name string) string {
+	switch file {
+	case utils.ChecksumFileVideo:
+		if name != "" {
+			return filepath.Join(video.VideoHlsPath, filepath.FromSlash(name))
+		}
+		return video.VideoPath
+	case utils.ChecksumFileChat:
+		return video.ChatPath
+	case utils.ChecksumFileChatVideo:
+		return video.ChatVideoPath
+	case utils.ChecksumFileLiveChat:
+		return video.LiveChatPath
+	case utils.ChecksumFileLiveChatConvert:
+		return video.LiveChatConvertPath
+	case utils.ChecksumFileInfo:
+		return video.InfoPath
+	case utils.ChecksumFileThumbnail:
+		return video.ThumbnailPath
+	case utils.ChecksumFileWebThumbnail:
+		return video.WebThumbnailPath
+	}
+	return ""
+}
+
+// Artifacts returns the files of the kinds that exist for the video. The playlist and every file it
+// references are returned for HLS videos.
+func Artifacts(ctx context.Context, s storage.Storage, video *ent.Vod, files []utils.ChecksumFile) ([]Artifact, error) {
+	candidates := []Artifact{}
+	for _, file := range files {
+		if file == utils.ChecksumFileVideo && video.VideoHlsPath != "" {
+			hlsFiles, err := playlistArtifacts(ctx, s, video)
+			if err != nil {
+				return nil, err
+			}
+			candidates = append(candidates, hlsFiles...)
+			continue
+		}
+		candidates = append(candidates, Artifact{File: file, Path: Path(video, file, "")})
+	}
+
+	artifacts := []Artifact{}
+	seen := map[string]bool{}
+	for _, artifact := range candidates {
+		if artifact.Path == "" || seen[artifact.Path] {
+			continue
+		}
+		seen[artifact.Path] = true
+		exists, err := s.Exists(ctx, artifact.Path)
+		if err != nil {
+			return nil, fmt.Errorf("error checking file %s: %w", artifact.Path, err)
+		}
+		if exists {
+			artifacts = append(artifacts, artifact)
+		}
+	}
+	return artifacts, nil
+}
+
+// playlistArtifacts returns the playlist of the HLS video and the local files it references. The media
+// playlists of a multivariant playlist and their segments are included.
+func playlistArtifacts(ctx context.Context, s storage.Storage, video *ent.Vod) ([]Artifact, error) {
+	playlist := filepath.Base(video.VideoPath)
+	files, err := playlistFiles(ctx, s, video, playlist)
+	if err != nil {
+		return nil, err
+	}
+
+	names := []string{playlist}
+	for _, name := range files {
+		names = append(names, name)
+		if path.Ext(name) != ".m3u8" {
+			continue
+		}
+		mediaFiles, err := playlistFiles(ctx, s, video, name)
+		if err != nil {
+			return nil, err
+		}
+		names = append(names, mediaFiles...)
+	}
+
+	artifacts := make([]Artifact, 0, len(names))
+	for _, name := range names {
+		artifacts = append(artifacts, Artifact{File: utils.ChecksumFileVideo, Name: name, Path: Path(video, utils.ChecksumFileVideo, name)})
+	}
+	return artifacts, nil
+}
+
+// playlistFiles returns the local files referenced by the playlist with the name in the HLS directory,
+// relative to the HLS directory.
+func playlistFiles(ctx context.Context, s storage.Storage, video *ent.Vod, name string) ([]string, error) {
+	playlistPath := Path(video, utils.ChecksumFileVideo, name)
+	reader, err := s.Open(ctx, playlistPath)
+	if err != nil {
+		return nil, fmt.Errorf("error opening playlist %s: %w", playlistPath, err)
+	}
+	defer reader.Close()
+
+	files, err := hls.MediaPlaylistFiles(reader)
+	if err != nil {
+		return nil, fmt.Errorf("error reading playlist %s: %w", playlistPath, err)
+	}
+
+	names := []string{}
+	for _, file := range files {
+		// the files are relative to the playlist
+		fileName := path.Join(path.Dir(name), file)
+		// remote segments and segments outside of the HLS directory are not archived files
+		if strings.Contains(file, "://") || path.IsAbs(file) || fileName == ".." || strings.HasPrefix(fileName, "../") {
+			continue
+		}
+		names = append(names, fileName)
+	}
+	return names, nil
+}
+
+// Sum returns the hex encoded SHA-256 checksum and the size of the file.
+func Sum(ctx context.Context, s storage.Storage, path string) (string, int64, error) {
+	reader, err := s.Open(ctx, path)
+	if err != nil {
+		return "", 0, err
+	}
+	defer reader.Close()
+
+	hash := sha256.New()
+	size, err := io.Copy(hash, reader)
+	if err != nil {
+		return "", 0, fmt.Errorf("error reading %s: %w", path, err)
+	}
+	return hex.EncodeToString(hash.Sum(nil)), size, nil
+}
+
+// Record hashes the files of the kinds and saves their checksums. Checksums of files of the kinds
+// that no longer exist are removed.
+func Record(ctx context.Context, store *database.Database, s storage.Storage, video *ent.Vod, files []utils.ChecksumFile) error {
+	artifacts, err := Artifacts(ctx, s, video, files)
+	if err != nil {
+		return err
+	}
+
+	type recorded struct {
+		Artifact
+		sum  string
+		size int64
+	}
+	sums := make([]recorded, 0, len(artifacts))
+	for _, artifact := range artifacts {
+		sum, size, err := Sum(ctx, s, artifact.Path)
+		if err != nil {
+			return fmt.Errorf("error hashing %s: %w", artifact.Path, err)
+		}
+		sums = append(sums, recorded{artifact, sum, size})
+	}
+
+	now := time.Now()
+	return store.WithTx(ctx, func(txClient *ent.Client, _ *sql.Tx) error {
+		ids := make([]uuid.UUID, 0, len(sums))
+		for _, r := range sums {
+			id, err := txClient.Checksum.Create().
+				SetVodID(video.ID).
+				SetFile(r.File).
+				SetName(r.Name).
+				SetSha256(r.sum).
+				SetSizeBytes(r.size).
+				SetStatus(utils.ChecksumStatusOk).
+				SetVerifiedAt(now).
+				OnConflictColumns(entChecksum.FieldVodID, entChecksum.FieldFile, entChecksum.FieldName).
+				UpdateNewValues().
+				ID(ctx)
+			if err != nil {
+				return fmt.Errorf("error saving checksum of %s: %w", r.Path, err)
+			}
+			ids = append(ids, id)
+		}
+		stale := []predicate.Checksum{entChecksum.VodID(video.ID), entChecksum.FileIn(files...)}
+		if len(ids) > 0 {
+			stale = append(stale, entChecksum.IDNotIn(ids...))
+		}
+		_, err := txClient.Checksum.Delete().Where(stale...).Exec(ctx)
+		if err != nil {
+			return fmt.Errorf("error deleting stale checksums: %w", err)
+		}
+		return nil
+	})
+}
+
+// Verify hashes the file again and returns whether it still matches the checksum.
+func Verify(ctx context.Context, s storage.Storage, video *ent.Vod, checksum *ent.Checksum) (utils.ChecksumStatus, error) {
+	filePath := Path(video, checksum.File, checksum.Name)
+	if filePath == "" {
+		return utils.ChecksumStatusMissing, nil
+	}
+	exists, err := s.Exists(ctx, filePath)
+	if err != nil {
+		return "", fmt.Errorf("error checking file %s: %w", filePath, err)
+	}
+	if !exists {
+		return utils.ChecksumStatusMissing, nil
+	}
+	sum, size, err := Sum(ctx, s, filePath)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return utils.ChecksumStatusMissing, nil
+		}
+		return "", fmt.Errorf("error hashing %s: %w", filePath, err)
+	}
+	if sum != checksum.Sha256 || size != checksum.SizeBytes {
+		return utils.ChecksumStatusMismatch, nil
+	}
+	return utils.ChecksumStatusOk, nil
+}
+
+// VerifySliceSize returns how many checksums are verified each night to verify all of them in verifyDays.
+func VerifySliceSize(total int, verifyDays int) int {
+	if total <= 0 {
+		return 0
+	}
+	if verifyDays <= 1 {
+		return total
+	}
+	return (total + verifyDays - 1) / verifyDays
+}
+
+// ManifestPath returns the path of the checksum manifest of the video.
+func ManifestPath(video *ent.Vod) string {
+	return filepath.Join(videoDirectory(video), fmt.Sprintf("%s-checksums.sha256", video.FileName))
+}
+
+// Manifest returns the checksums of the video in the sha256sum format with paths relative to the
+// folder of the video, so `sha256sum -c` can be run in the folder.
+func Manifest(video *ent.Vod, checksums []*ent.Checksum) []byte {
+	directory := videoDirectory(video)
+	lines := []string{}
+	for _, checksum := range checksums {
+		rel, err := filepath.Rel(directory, Path(video, checksum.File, checksum.Name))
+		if err != nil || rel == ".." || strings.HasPrefix(rel, "../") {
+			continue
+		}
+		lines = append(lines, fmt.Sprintf("%s  %s\n", checksum.Sha256, filepath.ToSlash(rel)))
+	}
+	// sort by path
+	slices.SortFunc(lines, func(a, b string) int {
+		return strings.Compare(a[sha256.Size*2:], b[sha256.Size*2:])
+	})
+	return []byte(strings.Join(lines, ""))
+}
+
+// WriteManifest writes the manifest of the video to its folder.
+func WriteManifest(ctx context.Context, store *database.Database, s storage.Storage, video *ent.Vod, tempDir string) error {
+	checksums, err := store.Client.Checksum.Query().Where(entChecksum.VodID(video.ID)).All(ctx)
+	if err != nil {
+		return fmt.Errorf("error fetching checksums: %w", err)
+	}
+	if len(checksums) == 0 {
+		return nil
+	}
+
+	tmp, err := os.CreateTemp(tempDir, fmt.Sprintf("%s-checksums-*.sha256", video.ID))
+	if err != nil {
+		return fmt.Errorf("error creating manifest: %w", err)
+	}
+	defer os.Remove(tmp.Name())
+	if _, err := tmp.Write(Manifest(video, checksums)); err != nil {
+		tmp.Close()
+		return fmt.Errorf("error writing manifest: %w", err)
+	}
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("error writing manifest: %w", err)
+	}
+	if err := s.Save(ctx, tmp.Name(), ManifestPath(video)); err != nil {
+		return fmt.Errorf("error saving manifest: %w", err)
+	}
+	return nil
+}
+
+// videoDirectory returns the directory holding all files of the video.
+func videoDirectory(video *ent.Vod) string {
+	directory := filepath.Dir(video.VideoPath)
+	if video.VideoHlsPath != "" {
+		directory = filepath.Dir(directory)
+	}
+	return directory
+}
@@ -0,0 +1,149 @@
+package checksum
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"github.com/zibbp/ganymede/ent"
+	"github.com/zibbp/ganymede/internal/storage"
+	"github.com/zibbp/ganymede/internal/utils"
+	tests_files "github.com/zibbp/ganymede/tests/files"
+)
+
+func TestSum(t *testing.T) {
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), "file.txt")
+	tests_files.Write(t, path, "hello")
+
+	sum, size, err := Sum(t.Context(), storage.NewLocal(), path)
+	require.NoError(t, err)
+	require.Equal(t, "2cf24dba5fb0a30e26e83b2ac5b9e29e1b161e5c1fa7425e73043362938b9824", sum)
+	require.Equal(t, int64(5), size)
+}
+
+func TestArtifactsHLS(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	hlsDir := filepath.Join(dir, "123-video_hls")
+	video := &ent.Vod{
+		ID:               uuid.New(),
+		VideoPath:        filepath.Join(hlsDir, "123-video.m3u8"),
+		VideoHlsPath:     hlsDir,
+		ChatPath:         filepath.Join(dir, "123-chat.json"),
+		WebThumbnailPath: filepath.Join(dir, "123-web_thumbnail.jpg"),
+	}
+	tests_files.Write(t, video.VideoPath, "#EXTM3U\n#EXT-X-MAP:URI=\"init.mp4\"\n#EXTINF:10.0,\n123-video0.mp4\n#EXTINF:10.0,\nhttps://example.com/remote.ts\n#EXTINF:10.0,\n../outside.mp4\n#EXT-X-ENDLIST\n")
+	tests_files.Write(t, filepath.Join(hlsDir, "init.mp4"), "init")
+	tests_files.Write(t, filepath.Join(hlsDir, "123-video0.mp4"), "segment")
+	tests_files.Write(t, video.ChatPath, "{}")
+
+	artifacts, err := Artifacts(t.Context(), storage.NewLocal(), video, append(VideoFiles, ChatFiles...))
+	require.NoError(t, err)
+	require.Equal(t, []Artifact{
+		{File: utils.ChecksumFileVideo, Name: "123-video.m3u8", Path: video.VideoPath},
+		{File: utils.ChecksumFileVideo, Name: "init.mp4", Path: filepath.Join(hlsDir, "init.mp4")},
+		{File: utils.ChecksumFileVideo, Name: "123-video0.mp4", Path: filepath.Join(hlsDir, "123-video0.mp4")},
+		{File: utils.ChecksumFileChat, Path: video.ChatPath},
+	}, artifacts)
+}
+
+func TestArtifactsHLSMultivariant(t *testing.T) {
+	t.Parallel()
+
+	hlsDir := filepath.Join(t.TempDir(), "123-video_hls")
+	video := &ent.Vod{
+		ID:           uuid.New(),
+		VideoPath:    filepath.Join(hlsDir, "123-video.m3u8"),
+		VideoHlsPath: hlsDir,
+	}
+	tests_files.Write(t, video.VideoPath, "#EXTM3U\n#EXT-X-STREAM-INF:BANDWIDTH=6000000\n123-source.m3u8\n#EXT-X-STREAM-INF:BANDWIDTH=96000\naudio/123-audio.m3u8\n")
+	tests_files.Write(t, filepath.Join(hlsDir, "123-source.m3u8"), "#EXTM3U\n#EXTINF:10.0,\n123-source_segment0.ts\n#EXT-X-ENDLIST\n")
+	tests_files.Write(t, filepath.Join(hlsDir, "123-source_segment0.ts"), "source")
+	tests_files.Write(t, filepath.Join(hlsDir, "audio", "123-audio.m3u8"), "#EXTM3U\n#EXTINF:10.0,\n123-audio_segment0.ts\n#EXT-X-ENDLIST\n")
+	tests_files.Write(t, filepath.Join(hlsDir, "audio", "123-audio_segment0.ts"), "audio")
+
+	artifacts, err := Artifacts(t.Context(), storage.NewLocal(), video, []utils.ChecksumFile{utils.ChecksumFileVideo})
+	require.NoError(t, err)
+	require.Equal(t, []Artifact{
+		{File: utils.ChecksumFileVideo, Name: "123-video.m3u8", Path: video.VideoPath},
+		{File: utils.ChecksumFileVideo, Name: "123-source.m3u8", Path: filepath.Join(hlsDir, "123-source.m3u8")},
+		{File: utils.ChecksumFileVideo, Name: "123-source_segment0.ts", Path: filepath.Join(hlsDir, "123-source_segment0.ts")},
+		{File: utils.ChecksumFileVideo, Name: "audio/123-audio.m3u8", Path: filepath.Join(hlsDir, "audio", "123-audio.m3u8")},
+		{File: utils.ChecksumFileVideo, Name: "audio/123-audio_segment0.ts", Path: filepath.Join(hlsDir, "audio", "123-audio_segment0.ts")},
+	}, artifacts)
+}
+
+func TestPathFollowsVideo(t *testing.T) {
+	t.Parallel()
+
+	video := &ent.Vod{
+		VideoPath:    "/cold/channel/folder/456-video_hls/123-video.m3u8",
+		VideoHlsPath: "/cold/channel/folder/456-video_hls",
+		InfoPath:     "/cold/channel/folder/456-info.json",
+	}
+	require.Equal(t, "/cold/channel/folder/456-video_hls/123-video0.mp4", Path(video, utils.ChecksumFileVideo, "123-video0.mp4"))
+	require.Equal(t, "/cold/channel/folder/456-info.json", Path(video, utils.ChecksumFileInfo, ""))
+	require.Empty(t, Path(video, utils.ChecksumFileChat, ""))
+}
+
+func TestVerify(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	video := &ent.Vod{ID: uuid.New(), VideoPath: filepath.Join(dir, "123-video.mp4")}
+	tests_files.Write(t, video.VideoPath, "hello")
+	s := storage.NewLocal()
+
+	sum, size, err := Sum(t.Context(), s, video.VideoPath)
+	require.NoError(t, err)
+	checksum := &ent.Checksum{File: utils.ChecksumFileVideo, Sha256: sum, SizeBytes: size}
+
+	status, err := Verify(t.Context(), s, video, checksum)
+	require.NoError(t, err)
+	require.Equal(t, utils.ChecksumStatusOk, status)
+
+	tests_files.Write(t, video.VideoPath, "hellp")
+	status, err = Verify(t.Context(), s, video, checksum)
+	require.NoError(t, err)
+	require.Equal(t, utils.ChecksumStatusMismatch, status)
+
+	require.NoError(t, os.Remove(video.VideoPath))
+	status, err = Verify(t.Context(), s, video, checksum)
+	require.NoError(t, err)
+	require.Equal(t, utils.ChecksumStatusMissing, status)
+}
+
+func TestManifest(t *testing.T) {
+	t.Parallel()
+
+	video := &ent.Vod{
+		VideoPath:    "/data/videos/channel/folder/123-video_hls/123-video.m3u8",
+		VideoHlsPath: "/data/videos/channel/folder/123-video_hls",
+		ChatPath:     "/data/videos/channel/folder/123-chat.json",
+		FileName:     "123",
+	}
+	checksums := []*ent.Checksum{
+		{File: utils.ChecksumFileVideo, Name: "123-video0.mp4", Sha256: "3d0a3b4d5ee2a3d6e3e6bc3b3a1b8d6fa1c1f8e4b6a8d4a0e2a4f7f3d2f4b6c8"},
+		{File: utils.ChecksumFileChat, Sha256: "44136fa355b3678a1146ad16f7e8649e94fb4fc21fe77e8310c060f61caaff8a"},
+		{File: utils.ChecksumFileVideo, Name: "123-video.m3u8", Sha256: "e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855"},
+	}
+
+	require.Equal(t, "/data/videos/channel/folder/123-checksums.sha256", ManifestPath(video))
+	require.Equal(t, "44136fa355b3678a1146ad16f7e8649e94fb4fc21fe77e8310c060f61caaff8a  123-chat.json\n"+
+		"e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855  123-video_hls/123-video.m3u8\n"+
+		"3d0a3b4d5ee2a3d6e3e6bc3b3a1b8d6fa1c1f8e4b6a8d4a0e2a4f7f3d2f4b6c8  123-video_hls/123-video0.mp4\n", string(Manifest(video, checksums)))
+}
+
+func TestVerifySliceSize(t *testing.T) {
+	t.Parallel()
+
+	require.Equal(t, 0, VerifySliceSize(0, 30))
+	require.Equal(t, 1, VerifySliceSize(1, 30))
+	require.Equal(t, 4, VerifySliceSize(100, 30))
+	require.Equal(t, 100, VerifySliceSize(100, 0))
+}
@@ -0,0 +1,314 @@
+// Package coldstorage moves videos between the videos directory and the cold videos directory.
+//
+// Videos that match the cold storage policy are moved to the cold directory and moved back when
+// they are played. A video keeps its path relative to the tier root, so moving it only changes
+// the root of its paths.
+package coldstorage
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rs/zerolog/log"
+	"github.com/zibbp/ganymede/ent"
+	entPlayback "github.com/zibbp/ganymede/ent/playback"
+	entViewingSession "github.com/zibbp/ganymede/ent/viewingsession"
+	entVod "github.com/zibbp/ganymede/ent/vod"
+	"github.com/zibbp/ganymede/internal/config"
+	"github.com/zibbp/ganymede/internal/database"
+	"github.com/zibbp/ganymede/internal/storage"
+	"github.com/zibbp/ganymede/internal/utils"
+)
+
+// Policy selects the hot videos that are moved to the cold directory.
+//
+// Rules are combined, a video is moved only if every enabled rule selects it.
+type Policy struct {
+	MinAgeDays    int // archived at least this many days ago, 0 disables the rule
+	UnwatchedDays int // not watched by anyone for this many days, videos that were never watched count from when they were archived, 0 disables the rule
+}
+
+func PolicyFromConfig() Policy {
+	coldStorage := config.Get().ColdStorage
+	return Policy{
+		MinAgeDays:    coldStorage.MinAgeDays,
+		UnwatchedDays: coldStorage.UnwatchedDays,
+	}
+}
+
+// Evaluate returns the videos the policy moves to the cold directory.
+// lastWatched holds the time of the most recent playback update or viewing session of each video.
+func (p Policy) Evaluate(videos []*ent.Vod, lastWatched map[uuid.UUID]time.Time, now time.Time) []*ent.Vod {
+	if p.MinAgeDays <= 0 && p.UnwatchedDays <= 0 {
+		return nil
+	}
+
+	candidates := []*ent.Vod{}
+	for _, video := range videos {
+		if video.StorageTier == utils.StorageTierCold || video.Processing {
+			continue
+		}
+		if p.MinAgeDays > 0 && video.CreatedAt.Add(days(p.MinAgeDays)).After(now) {
+			continue
+		}
+		if p.UnwatchedDays > 0 {
+			lastActivity := video.CreatedAt
+			if watched, ok := lastWatched[video.ID]; ok && watched.After(lastActivity) {
+				lastActivity = watched
+			}
+			if lastActivity.Add(days(p.UnwatchedDays)).After(now) {
+				continue
+			}
+		}
+		candidates = append(candidates, video)
+	}
+	return candidates
+}
+
+func days(n int) time.Duration {
+	return time.Duration(n) * 24 * time.Hour
+}
+
+// Enabled reports whether videos are moved to the cold directory. Cold storage requires
+// COLD_VIDEOS_DIR and the local storage driver.
+func Enabled() bool {
+	env := config.GetEnvConfig()
+	return config.Get().ColdStorage.Enabled && env.ColdVideosDir != "" && storage.Driver(env.StorageDriver) != storage.DriverS3
+}
+
+// Candidates returns the hot videos that are moved to the cold directory by the configured policy.
+func Candidates(ctx context.Context, store *database.Database, now time.Time) ([]*ent.Vod, error) {
+	videos, err := store.Client.Vod.Query().Where(entVod.StorageTierEQ(utils.StorageTierHot), entVod.Processing(false)).All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("error fetching videos: %w", err)
+	}
+
+	watched, err := lastWatched(ctx, store)
+	if err != nil {
+		return nil, err
+	}
+
+	return PolicyFromConfig().Evaluate(videos, watched, now), nil
+}
+
+// RootDirectory returns the directory videos of the tier are stored in.
+func RootDirectory(tier utils.StorageTier) string {
+	env := config.GetEnvConfig()
+	if tier == utils.StorageTierCold {
+		return env.ColdVideosDir
+	}
+	return env.VideosDir
+}
+
+// videoDirectory returns the directory holding all files of the video.
+func videoDirectory(video *ent.Vod) string {
+	videoPath := video.VideoPath
+	if video.VideoHlsPath != "" {
+		videoPath = video.VideoHlsPath
+	}
+	return filepath.Dir(filepath.Clean(videoPath))
+}
+
+// LeftoverRetention is how long the files of a moved video are kept in the previous tier after it was
+// last watched. Viewers that started playing before the move were given the previous paths.
+const LeftoverRetention = 6 * time.Hour
+
+// Move moves the files of the video to the tier and updates its paths.
+//
+// The files are copied to the tier and the paths are updated in one transaction. The files in the
+// previous tier are kept so viewers that were given the previous paths keep playing, they are
+// removed later by RemoveLeftover. An interrupted move is finished by running it again.
+func Move(ctx context.Context, store *database.Database, videoID uuid.UUID, tier utils.StorageTier) error {
+	video, err := store.Client.Vod.Get(ctx, videoID)
+	if err != nil {
+		return fmt.Errorf("error fetching video: %w", err)
+	}
+	if video.StorageTier == tier {
+		return nil
+	}
+	if video.Processing {
+		return fmt.Errorf("video %s is processing", video.ID)
+	}
+
+	fromRoot := filepath.Clean(RootDirectory(video.StorageTier))
+	toRoot := filepath.Clean(RootDirectory(tier))
+	if fromRoot == "." || toRoot == "." {
+		return fmt.Errorf("cold videos directory is not configured")
+	}
+
+	source := videoDirectory(video)
+	rel, err := filepath.Rel(fromRoot, source)
+	if err != nil || rel == "." || rel == ".." || strings.HasPrefix(rel, "../") {
+		return fmt.Errorf("video directory %s is not inside %s", source, fromRoot)
+	}
+	// make sure the folder name is in the path to not move unrelated directories, same as deleting a video
+	if video.FolderName != "" && !strings.Contains(source, video.FolderName) {
+		return fmt.Errorf("video folder_name not found in path, refusing to move: %s", source)
+	}
+	dest := filepath.Join(toRoot, rel)
+
+	if _, err := os.Stat(source); err == nil {
+		log.Info().Str("video_id", video.ID.String()).Str("source", source).Str("destination", dest).Msgf("moving video to %s storage", tier)
+		if err := utils.CopyDirectory(ctx, source, dest); err != nil {
+			return fmt.Errorf("error copying video directory: %w", err)
+		}
+	} else if !errors.Is(err, os.ErrNotExist) {
+		return fmt.Errorf("error checking video directory: %w", err)
+	} else if _, err := os.Stat(dest); err != nil {
+		return fmt.Errorf("video directory not found in %s or %s: %w", source, dest, err)
+	}
+
+	err = store.WithTx(ctx, func(txClient *ent.Client, _ *sql.Tx) error {
+		update := txClient.Vod.UpdateOneID(video.ID).
+			SetStorageTier(tier).
+			SetVideoPath(rebasePath(video.VideoPath, fromRoot, toRoot)).
+			SetWebThumbnailPath(rebasePath(video.WebThumbnailPath, fromRoot, toRoot))
+		if video.VideoHlsPath != "" {
+			update.SetVideoHlsPath(rebasePath(video.VideoHlsPath, fromRoot, toRoot))
+		}
+		if video.ThumbnailPath != "" {
+			update.SetThumbnailPath(rebasePath(video.ThumbnailPath, fromRoot, toRoot))
+		}
+		if video.ChatPath != "" {
+			update.SetChatPath(rebasePath(video.ChatPath, fromRoot, toRoot))
+		}
+		if video.ChatVideoPath != "" {
+			update.SetChatVideoPath(rebasePath(video.ChatVideoPath, fromRoot, toRoot))
+		}
+		if video.LiveChatPath != "" {
+			update.SetLiveChatPath(rebasePath(video.LiveChatPath, fromRoot, toRoot))
+		}
+		if video.LiveChatConvertPath != "" {
+			update.SetLiveChatConvertPath(rebasePath(video.LiveChatConvertPath, fromRoot, toRoot))
+		}
+		if video.InfoPath != "" {
+			update.SetInfoPath(rebasePath(video.InfoPath, fromRoot, toRoot))
+		}
+		if video.CaptionPath != "" {
+			update.SetCaptionPath(rebasePath(video.CaptionPath, fromRoot, toRoot))
+		}
+		if video.AudioPath != "" {
+			update.SetAudioPath(rebasePath(video.AudioPath, fromRoot, toRoot))
+		}
+		if len(video.SpriteThumbnailsImages) > 0 {
+			spriteThumbnails := make([]string, len(video.SpriteThumbnailsImages))
+			for i, image := range video.SpriteThumbnailsImages {
+				spriteThumbnails[i] = rebasePath(image, fromRoot, toRoot)
+			}
+			update.SetSpriteThumbnailsImages(spriteThumbnails)
+		}
+		return update.Exec(ctx)
+	})
+	if err != nil {
+		return fmt.Errorf("error updating video paths: %w", err)
+	}
+
+	log.Info().Str("video_id", video.ID.String()).Msgf("moved video to %s storage", tier)
+	return nil
+}
+
+// RemoveLeftover removes the files a move of the video to the tier left in the previous tier.
+//
+// Nothing is removed while the video was watched within LeftoverRetention, the returned time is when
+// to try again. Nothing is removed if the video was moved to another tier since.
+func RemoveLeftover(ctx context.Context, store *database.Database, videoID uuid.UUID, tier utils.StorageTier, now time.Time) (time.Time, error) {
+	video, err := store.Client.Vod.Get(ctx, videoID)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("error fetching video: %w", err)
+	}
+	if video.StorageTier != tier {
+		return time.Time{}, nil
+	}
+
+	watched, err := lastWatched(ctx, store, video.ID)
+	if err != nil {
+		return time.Time{}, err
+	}
+	if retryAt := watched[video.ID].Add(LeftoverRetention); retryAt.After(now) {
+		return retryAt, nil
+	}
+
+	return time.Time{}, removeLeftover(video, tier)
+}
+
+// lastWatched returns the time of the most recent playback update or viewing session of the videos, or of
+// every video if none are given. Videos that were never watched are left out.
+func lastWatched(ctx context.Context, store *database.Database, videoIDs ...uuid.UUID) (map[uuid.UUID]time.Time, error) {
+	playbackQuery := store.Client.Playback.Query()
+	sessionQuery := store.Client.ViewingSession.Query()
+	if len(videoIDs) > 0 {
+		playbackQuery.Where(entPlayback.VodIDIn(videoIDs...))
+		sessionQuery.Where(entViewingSession.VodIDIn(videoIDs...))
+	}
+
+	playbacks, err := playbackQuery.Select(entPlayback.FieldVodID, entPlayback.FieldUpdatedAt).All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("error fetching playback: %w", err)
+	}
+	sessions, err := sessionQuery.Select(entViewingSession.FieldVodID, entViewingSession.FieldUpdatedAt).All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("error fetching viewing sessions: %w", err)
+	}
+
+	watched := make(map[uuid.UUID]time.Time, len(playbacks))
+	for _, playback := range playbacks {
+		if playback.UpdatedAt.After(watched[playback.VodID]) {
+			watched[playback.VodID] = playback.UpdatedAt
+		}
+	}
+	for _, session := range sessions {
+		if session.UpdatedAt.After(watched[session.VodID]) {
+			watched[session.VodID] = session.UpdatedAt
+		}
+	}
+	return watched, nil
+}
+
+// removeLeftover removes the files a move of the video to the tier left in the previous tier.
+func removeLeftover(video *ent.Vod, tier utils.StorageTier) error {
+	previousTier := utils.StorageTierCold
+	if tier == utils.StorageTierCold {
+		previousTier = utils.StorageTierHot
+	}
+	root := filepath.Clean(RootDirectory(tier))
+	previousRoot := filepath.Clean(RootDirectory(previousTier))
+	if root == "." || previousRoot == "." || root == previousRoot {
+		return nil
+	}
+
+	rel, err := filepath.Rel(root, videoDirectory(video))
+	if err != nil || rel == "." || rel == ".." || strings.HasPrefix(rel, "../") {
+		return nil
+	}
+	leftover := filepath.Join(previousRoot, rel)
+	if video.FolderName != "" && !strings.Contains(leftover, video.FolderName) {
+		return nil
+	}
+	if _, err := os.Stat(leftover); err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil
+		}
+		return fmt.Errorf("error checking video directory: %w", err)
+	}
+
+	log.Info().Str("video_id", video.ID.String()).Str("directory", leftover).Msgf("removing video files left in %s storage", previousTier)
+	if err := os.RemoveAll(leftover); err != nil {
+		return fmt.Errorf("error removing video directory: %w", err)
+	}
+	return nil
+}
+
+// rebasePath replaces the root of path. Paths outside of the root are returned unchanged.
+func rebasePath(path string, fromRoot string, toRoot string) string {
+	if !strings.HasPrefix(path, fromRoot+"/") {
+		return path
+	}
+	return toRoot + strings.TrimPrefix(path, fromRoot)
+}
@@ -0,0 +1,63 @@
+package coldstorage
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"github.com/zibbp/ganymede/ent"
+	"github.com/zibbp/ganymede/internal/utils"
+)
+
+func coldStorageTestVideo(daysOld int, now time.Time) *ent.Vod {
+	return &ent.Vod{
+		ID:          uuid.New(),
+		StorageTier: utils.StorageTierHot,
+		CreatedAt:   now.Add(-days(daysOld)),
+	}
+}
+
+func TestPolicyRequiresEveryEnabledRule(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)
+	oldUnwatched := coldStorageTestVideo(100, now)
+	oldWatched := coldStorageTestVideo(100, now)
+	oldWatchedLongAgo := coldStorageTestVideo(100, now)
+	newUnwatched := coldStorageTestVideo(10, now)
+	cold := coldStorageTestVideo(100, now)
+	cold.StorageTier = utils.StorageTierCold
+	processing := coldStorageTestVideo(100, now)
+	processing.Processing = true
+
+	lastWatched := map[uuid.UUID]time.Time{
+		oldWatched.ID:        now.Add(-days(5)),
+		oldWatchedLongAgo.ID: now.Add(-days(60)),
+	}
+	videos := []*ent.Vod{oldUnwatched, oldWatched, oldWatchedLongAgo, newUnwatched, cold, processing}
+
+	policy := Policy{MinAgeDays: 90, UnwatchedDays: 30}
+	require.Equal(t, []*ent.Vod{oldUnwatched, oldWatchedLongAgo}, policy.Evaluate(videos, lastWatched, now))
+
+	policy = Policy{UnwatchedDays: 30}
+	require.Equal(t, []*ent.Vod{oldUnwatched, oldWatchedLongAgo}, policy.Evaluate(videos, lastWatched, now))
+
+	policy = Policy{MinAgeDays: 90}
+	require.Equal(t, []*ent.Vod{oldUnwatched, oldWatched, oldWatchedLongAgo}, policy.Evaluate(videos, lastWatched, now))
+}
+
+func TestPolicyWithoutRulesMovesNothing(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)
+	require.Empty(t, Policy{}.Evaluate([]*ent.Vod{coldStorageTestVideo(1000, now)}, nil, now))
+}
+
+func TestRebasePath(t *testing.T) {
+	t.Parallel()
+
+	require.Equal(t, "/data/cold/channel/folder/video.mp4", rebasePath("/data/videos/channel/folder/video.mp4", "/data/videos", "/data/cold"))
+	require.Equal(t, "/data/videos/channel/folder/video.mp4", rebasePath("/data/cold/channel/folder/video.mp4", "/data/cold", "/data/videos"))
+	require.Equal(t, "/data/videos-old/video.mp4", rebasePath("/data/videos-old/video.mp4", "/data/videos", "/data/cold"))
+}
@@ -0,0 +1,113 @@
+package coldstorage_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"github.com/zibbp/ganymede/internal/coldstorage"
+	"github.com/zibbp/ganymede/internal/config"
+	"github.com/zibbp/ganymede/internal/utils"
+	"github.com/zibbp/ganymede/tests"
+)
+
+// TestMoveKeepsPreviousTierUntilNotWatched tests that a video played from the cold directory keeps its
+// cold files after it is moved back, until it was not watched for the leftover retention.
+func TestMoveKeepsPreviousTierUntilNotWatched(t *testing.T) {
+	app, err := tests.SetupWithoutWorker(t)
+	require.NoError(t, err)
+	ctx := t.Context()
+
+	coldDir := t.TempDir()
+	t.Setenv("COLD_VIDEOS_DIR", coldDir)
+	videosDir := config.GetEnvConfig().VideosDir
+
+	coldVideoDir := filepath.Join(coldDir, "channel", "folder")
+	require.NoError(t, os.MkdirAll(coldVideoDir, 0755))
+	require.NoError(t, os.WriteFile(filepath.Join(coldVideoDir, "video.mp4"), []byte("video"), 0644))
+
+	channel, err := app.Database.Client.Channel.Create().SetName("channel").SetDisplayName("channel").SetImagePath("").Save(ctx)
+	require.NoError(t, err)
+	user, err := app.Database.Client.User.Create().SetUsername("viewer").Save(ctx)
+	require.NoError(t, err)
+	video, err := app.Database.Client.Vod.Create().
+		SetChannel(channel).
+		SetExtID("1").
+		SetTitle("cold video").
+		SetFolderName("folder").
+		SetStorageTier(utils.StorageTierCold).
+		SetVideoPath(filepath.Join(coldVideoDir, "video.mp4")).
+		SetWebThumbnailPath(filepath.Join(coldVideoDir, "thumbnail.jpg")).
+		Save(ctx)
+	require.NoError(t, err)
+
+	// the viewer pressing play was given the cold path
+	_, err = app.Database.Client.Playback.Create().SetVodID(video.ID).SetUserID(user.ID).Save(ctx)
+	require.NoError(t, err)
+
+	require.NoError(t, coldstorage.Move(ctx, app.Database, video.ID, utils.StorageTierHot))
+
+	moved, err := app.Database.Client.Vod.Get(ctx, video.ID)
+	require.NoError(t, err)
+	require.Equal(t, utils.StorageTierHot, moved.StorageTier)
+	require.Equal(t, filepath.Join(videosDir, "channel", "folder", "video.mp4"), moved.VideoPath)
+	require.FileExists(t, moved.VideoPath)
+	require.FileExists(t, filepath.Join(coldVideoDir, "video.mp4"), "cold copy is removed while the video is being watched")
+
+	// the video is still being watched
+	now := time.Now()
+	retryAt, err := coldstorage.RemoveLeftover(ctx, app.Database, video.ID, utils.StorageTierHot, now)
+	require.NoError(t, err)
+	require.True(t, retryAt.After(now))
+	require.FileExists(t, filepath.Join(coldVideoDir, "video.mp4"))
+
+	// the video was not watched for the leftover retention
+	retryAt, err = coldstorage.RemoveLeftover(ctx, app.Database, video.ID, utils.StorageTierHot, now.Add(coldstorage.LeftoverRetention+time.Minute))
+	require.NoError(t, err)
+	require.True(t, retryAt.IsZero())
+	require.NoDirExists(t, coldVideoDir)
+	require.FileExists(t, moved.VideoPath)
+}
+
+// TestCandidatesCountViewingSessions tests that a video watched only in a viewing session is not moved
+// to the cold directory.
+func TestCandidatesCountViewingSessions(t *testing.T) {
+	app, err := tests.SetupWithoutWorker(t)
+	require.NoError(t, err)
+	ctx := t.Context()
+
+	cfg := config.Get()
+	cfg.ColdStorage.MinAgeDays = 0
+	cfg.ColdStorage.UnwatchedDays = 30
+	require.NoError(t, config.UpdateConfig(cfg))
+
+	channel, err := app.Database.Client.Channel.Create().SetName("channel").SetDisplayName("channel").SetImagePath("").Save(ctx)
+	require.NoError(t, err)
+	user, err := app.Database.Client.User.Create().SetUsername("viewer").Save(ctx)
+	require.NoError(t, err)
+	createVideo := func(extID string) uuid.UUID {
+		video, err := app.Database.Client.Vod.Create().
+			SetChannel(channel).
+			SetExtID(extID).
+			SetTitle(extID).
+			SetVideoPath("").
+			SetWebThumbnailPath("").
+			SetCreatedAt(time.Now().Add(-100 * 24 * time.Hour)).
+			Save(ctx)
+		require.NoError(t, err)
+		return video.ID
+	}
+	watched := createVideo("watched")
+	unwatched := createVideo("unwatched")
+
+	_, err = app.Database.Client.ViewingSession.Create().SetVodID(watched).SetUserID(user.ID).Save(ctx)
+	require.NoError(t, err)
+
+	candidates, err := coldstorage.Candidates(ctx, app.Database, time.Now())
+	require.NoError(t, err)
+	require.Len(t, candidates, 1)
+	require.Equal(t, unwatched, candidates[0].ID)
+}
@@ -15,16 +15,31 @@ type Config struct {
 	VideoCheckInterval  int  `json:"video_check_interval_minutes"` // How often in minutes watched channels are checked for new videos.
 	RegistrationEnabled bool `json:"registration_enabled"`         // Enable registration.
 	Parameters          struct {
-		TwitchToken  string `json:"twitch_token"`  // Twitch token for ad-free live streams or subscriber-only videos.
-		VideoConvert string `json:"video_convert"` // FFmpeg arguments for video conversion.
-		ChatRender   string `json:"chat_render"`   // TwitchDownloaderCLI arguments for chat rendering.
-		YtDlpVideo   string `json:"yt_dlp_video"`  // yt-dlp arguments for video downloads.
+		TwitchToken  string `json:"twitch_token"`   // Twitch token for ad-free live streams or subscriber-only videos.
+		VideoConvert string `json:"video_convert"`  // FFmpeg arguments for video conversion.
+		ChatRender   string `json:"chat_render"`    // TwitchDownloaderCLI arguments for chat rendering.
+		YtDlpVideo   string `json:"yt_dlp_video"`   // yt-dlp arguments for video downloads.
+		SpeechToText string `json:"speech_to_text"` // Speech-to-text command used to generate captions. Supports {{input}}, {{output}} and {{output_prefix}}.
 	} `json:"parameters"`
 	Archive struct {
-		SaveAsHls                bool `json:"save_as_hls"`                // Save as HLS rather than MP4.
-		GenerateSpriteThumbnails bool `json:"generate_sprite_thumbnails"` // Generate sprite thumbnails for scrubbing.
-		GenerateNFOFiles         bool `json:"generate_nfo_files"`         // Generate Kodi-compatible NFO sidecars for archived videos.
+		SaveAsHls                bool                      `json:"save_as_hls"`                                                                                  // Save as HLS rather than MP4.
+		HlsRenditions            bool                      `json:"hls_renditions"`                                                                               // Add 720p, 480p and audio-only renditions to HLS videos for adaptive bitrate playback.
+		GenerateSpriteThumbnails bool                      `json:"generate_sprite_thumbnails"`                                                                   // Generate sprite thumbnails for scrubbing.
+		GenerateNFOFiles         bool                      `json:"generate_nfo_files"`                                                                           // Generate Kodi-compatible NFO sidecars for archived videos.
+		MinFreeSpaceVideosGB     int                       `json:"min_free_space_videos_gb"`                                                                     // Pause new video downloads while the videos directory has less free space in GB, 0 disables.
+		MinFreeSpaceTempGB       int                       `json:"min_free_space_temp_gb"`                                                                       // Pause new video downloads while the temp directory has less free space in GB, 0 disables.
+		RecoverMutedAudio        bool                      `json:"recover_muted_audio"`                                                                          // Replace the audio of muted segments of VODs with the audio of the live archive of the same stream.
+		StreamVersionPolicy      utils.StreamVersionPolicy `json:"stream_version_policy" validate:"omitempty,oneof=keep_both keep_best keep_live_with_vod_chat"` // What is kept when a stream was archived both live and as a VOD.
 	} `json:"archive"`
+	ColdStorage struct {
+		Enabled       bool `json:"enabled"`        // Move videos to the COLD_VIDEOS_DIR directory.
+		MinAgeDays    int  `json:"min_age_days"`   // Only move videos archived at least this many days ago, 0 disables the rule.
+		UnwatchedDays int  `json:"unwatched_days"` // Only move videos nobody watched in this many days, 0 disables the rule.
+	} `json:"cold_storage"`
+	Checksums struct {
+		Enabled    bool `json:"enabled"`     // Record SHA-256 checksums of archived files and verify them nightly.
+		VerifyDays int  `json:"verify_days"` // Verify every checksum once in this many days, a slice of the library is verified each night.
+	} `json:"checksums"`
 	StorageTemplates StorageTemplate `json:"storage_templates"` // Storage folder/file templates.
 	Livestream       struct {
 		Proxies             []ProxyListItem `json:"proxies" validate:"dive"` // List of proxies for live stream download.
@@ -32,6 +47,9 @@ type Config struct {
 		ProxyParameters     string          `json:"proxy_parameters"`        // Query parameters for proxy URL.
 		ProxyWhitelist      []string        `json:"proxy_whitelist"`         // Channels exempt from proxy.
 		WatchWhileArchiving bool            `json:"watch_while_archiving"`   // Allow watching live streams while archiving them by downloading a temporary HLS stream.
+		// Keep reconnecting to a live stream for this many seconds after the recording drops, recording the
+		// missing part as a gap. Set to 0 to finish the archive when the recording drops.
+		ReconnectWindowSeconds int `json:"reconnect_window_seconds" validate:"gte=0"`
 	} `json:"livestream"`
 	Experimental struct {
 		BetterLiveStreamDetectionAndCleanup bool `json:"better_live_stream_detection_and_cleanup"` // [EXPERIMENTAL] Enable enhanced detection and cleanup.
@@ -200,10 +218,25 @@ func (c *Config) SetDefaults() {
 	c.Parameters.VideoConvert = "-c:v copy -c:a copy"
 	c.Parameters.ChatRender = "-h 1440 -w 340 --framerate 30 --font Inter --font-size 13"
 	c.Parameters.YtDlpVideo = ""
+	c.Parameters.SpeechToText = ""
 
 	c.Archive.SaveAsHls = false
+	c.Archive.HlsRenditions = false
 	c.Archive.GenerateSpriteThumbnails = true
 	c.Archive.GenerateNFOFiles = true
+	c.Archive.MinFreeSpaceVideosGB = 0
+	c.Archive.MinFreeSpaceTempGB = 0
+	c.Archive.RecoverMutedAudio = true
+	c.Archive.StreamVersionPolicy = utils.StreamVersionPolicyKeepBoth
+
+	// cold storage
+	c.ColdStorage.Enabled = false
+	c.ColdStorage.MinAgeDays = 90
+	c.ColdStorage.UnwatchedDays = 30
+
+	// checksums
+	c.Checksums.Enabled = true
+	c.Checksums.VerifyDays = 30
 
 	// storage templates
 	c.StorageTemplates.FolderTemplate = "{{date}}-{{id}}-{{type}}-{{uuid}}"
@@ -218,6 +251,7 @@ func (c *Config) SetDefaults() {
 	c.Livestream.ProxyParameters = "%3Fplayer%3Dtwitchweb%26type%3Dany%26allow_source%3Dtrue%26allow_audio_only%3Dtrue%26allow_spectre%3Dfalse%26fast_bread%3Dtrue"
 	c.Livestream.ProxyWhitelist = []string{}
 	c.Livestream.WatchWhileArchiving = false
+	c.Livestream.ReconnectWindowSeconds = 0
 
 	c.LogRetentionDays = 30
 
@@ -31,9 +31,11 @@ type EnvConfig struct {
 	ConfigDir            string `env:"CONFIG_DIR, default=/data/config"`
 	LogsDir              string `env:"LOGS_DIR, default=/data/logs"`
 	PathMigrationEnabled bool   `env:"PATH_MIGRATION_ENABLED, default=true"`
+	ColdVideosDir        string `env:"COLD_VIDEOS_DIR, default="` // Optional, videos are moved here by the cold storage settings
 	// platform variables
 	TwitchClientId     string `env:"TWITCH_CLIENT_ID, required"`
 	TwitchClientSecret string `env:"TWITCH_CLIENT_SECRET, required"`
+	YoutubeApiKey      string `env:"YOUTUBE_API_KEY, default="` // Optional, enables the YouTube platform
 
 	// worker config
 	MaxChatDownloadExecutions         int `env:"MAX_CHAT_DOWNLOAD_EXECUTIONS, default=3"`
@@ -49,8 +51,19 @@ type EnvConfig struct {
 	OAuthClientSecret string `env:"OAUTH_CLIENT_SECRET, default="`
 	OAuthRedirectURL  string `env:"OAUTH_REDIRECT_URL, default="`
 
+	// archive storage
+	StorageDriver          string `env:"STORAGE_DRIVER, default=local"`          // Where finished archives are stored, local or s3. Temporary files are always stored in TEMP_DIR.
+	S3Endpoint             string `env:"S3_ENDPOINT, default="`                  // S3-compatible endpoint such as http://minio:9000, AWS if empty.
+	S3Region               string `env:"S3_REGION, default=us-east-1"`           // S3 signing region.
+	S3Bucket               string `env:"S3_BUCKET, default="`                    // S3 bucket archives are stored in, files are stored relative to VIDEOS_DIR.
+	S3AccessKeyID          string `env:"S3_ACCESS_KEY_ID, default="`             // S3 access key id.
+	S3SecretAccessKey      string `env:"S3_SECRET_ACCESS_KEY, default="`         // S3 secret access key.
+	S3UsePathStyle         bool   `env:"S3_USE_PATH_STYLE, default=true"`        // Address the bucket with path-style URLs, required by most self-hosted servers.
+	S3PresignExpiryMinutes int    `env:"S3_PRESIGN_EXPIRY_MINUTES, default=360"` // How long presigned playback URLs are valid.
+
 	// frontend
-	CDN_URL string `env:"CDN_URL, default="` // Populate if using an external host for the static files (Nginx, S3, etc). By default Ganymede will serve the VIDEOS_DIR directory.
+	CDN_URL      string `env:"CDN_URL, default="`       // Populate if using an external host for the static files (Nginx, S3, etc). By default Ganymede will serve the VIDEOS_DIR directory.
+	FrontendHost string `env:"FRONTEND_HOST, default="` // Optional, comma separated origins the frontend is served from if not the host of the API, e.g. https://ganymede.example.com
 }
 
 const fileSuffix = "_FILE"
@@ -14,7 +14,9 @@ import (
 	"github.com/riverqueue/river/rivermigrate"
 	"github.com/rs/zerolog/log"
 	"github.com/zibbp/ganymede/ent"
+	entChatMessage "github.com/zibbp/ganymede/ent/chatmessage"
 	entLive "github.com/zibbp/ganymede/ent/live"
+	entVod "github.com/zibbp/ganymede/ent/vod"
 	"github.com/zibbp/ganymede/internal/utils"
 	"golang.org/x/crypto/bcrypt"
 )
@@ -147,6 +149,7 @@ func migrate(ctx context.Context, sqlDB *sql.DB, client *ent.Client) error {
 	}
 
 	hasLiveVodResolution := columnExists(ctx, sqlDB, entLive.Table, entLive.FieldVodResolution)
+	hasVodSearchDocument := columnExists(ctx, sqlDB, entVod.Table, VodSearchDocumentColumn)
 	if err := client.Schema.Create(ctx); err != nil {
 		return fmt.Errorf("run Ent migrations: %w", err)
 	}
@@ -154,6 +157,8 @@ func migrate(ctx context.Context, sqlDB *sql.DB, client *ent.Client) error {
 		backfillLiveVodResolution(ctx, sqlDB)
 	}
 	dropOrphanedColumns(ctx, sqlDB)
+	ensureChatMessageSearchIndex(ctx, sqlDB)
+	ensureVodSearchDocument(ctx, sqlDB, !hasVodSearchDocument)
 
 	riverMigrator, err := rivermigrate.New(riverdatabasesql.New(sqlDB), nil)
 	if err != nil {
@@ -299,6 +304,27 @@ func backfillLiveVodResolution(ctx context.Context, conn sqlExecutor) {
 	}
 }
 
+// ensureChatMessageSearchIndex creates the indexes used by chat search: the
+// full-text index of the body, the case-insensitive indexes of the chatter
+// names and the GIN index of the emotes. ent can't describe expression
+// indexes so they are created here. The 'simple' text search configuration
+// is used as chat is multilingual and emote names must not be stemmed.
+// Search still works without the indexes, only slower, so a failure is
+// logged instead of blocking startup.
+func ensureChatMessageSearchIndex(ctx context.Context, conn sqlExecutor) {
+	stmts := []string{
+		fmt.Sprintf(`CREATE INDEX IF NOT EXISTS %s_body_search ON %s USING GIN (to_tsvector('simple', %s))`, entChatMessage.Table, entChatMessage.Table, entChatMessage.FieldBody),
+		fmt.Sprintf(`CREATE INDEX IF NOT EXISTS %s_%s_lower ON %s (lower(%s))`, entChatMessage.Table, entChatMessage.FieldCommenterName, entChatMessage.Table, entChatMessage.FieldCommenterName),
+		fmt.Sprintf(`CREATE INDEX IF NOT EXISTS %s_%s_lower ON %s (lower(%s))`, entChatMessage.Table, entChatMessage.FieldCommenterDisplayName, entChatMessage.Table, entChatMessage.FieldCommenterDisplayName),
+		fmt.Sprintf(`CREATE INDEX IF NOT EXISTS %s_%s ON %s USING GIN (%s jsonb_path_ops)`, entChatMessage.Table, entChatMessage.FieldEmotes, entChatMessage.Table, entChatMessage.FieldEmotes),
+	}
+	for _, stmt := range stmts {
+		if _, err := conn.ExecContext(ctx, stmt); err != nil {
+			log.Warn().Err(err).Str("statement", stmt).Msg("create chat message search index failed")
+		}
+	}
+}
+
 func seedDatabase(ctx context.Context, client *ent.Client) error {
 
 	// Create initial user
@@ -82,6 +82,7 @@ func (db *Database) VideosDirMigrate(ctx context.Context, videosDir string) erro
 			update.SetChatVideoPath(strings.Replace(v.ChatVideoPath, oldVideoPath, videosDir, 1))
 			update.SetInfoPath(strings.Replace(v.InfoPath, oldVideoPath, videosDir, 1))
 			update.SetCaptionPath(strings.Replace(v.CaptionPath, oldVideoPath, videosDir, 1))
+			update.SetAudioPath(strings.Replace(v.AudioPath, oldVideoPath, videosDir, 1))
 
 			if v.SpriteThumbnailsEnabled && len(v.SpriteThumbnailsImages) > 0 {
 				var newSpriteThumbs []string
@@ -143,6 +144,7 @@ func (db *Database) TempDirMigrate(ctx context.Context, tempDir string) error {
 			update.SetTmpLiveChatConvertPath(strings.Replace(v.TmpLiveChatConvertPath, oldTmpVideoDownloadPath, tempDir, 1))
 			update.SetTmpChatRenderPath(strings.Replace(v.TmpChatRenderPath, oldTmpVideoDownloadPath, tempDir, 1))
 			update.SetTmpVideoHlsPath(strings.Replace(v.TmpVideoHlsPath, oldTmpVideoDownloadPath, tempDir, 1))
+			update.SetTmpAudioPath(strings.Replace(v.TmpAudioPath, oldTmpVideoDownloadPath, tempDir, 1))
 
 			if _, err := update.Save(ctx); err != nil {
 				return err
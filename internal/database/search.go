@@ -0,0 +1,117 @@
+package database
+
+import (
+	"context"
+
+	"github.com/rs/zerolog/log"
+)
+
+// VodSearchDocumentColumn is the column of the vods table holding the full-text search document of the video.
+// ent can't describe tsvector columns so the column, its index and the triggers keeping it up to date are
+// created here.
+const VodSearchDocumentColumn = "search_document"
+
+// vodSearchDocumentStatements create the search document of videos. The title ranks highest, followed by the
+// category and channel names, then chapter titles. The document includes the channel and chapters of the
+// video so it is updated by triggers on all three tables. Statements marked onCreate only run when the search
+// document column is created.
+var vodSearchDocumentStatements = []struct {
+	name, sql string
+	onCreate  bool
+}{
+	{
+		name: "vods.search_document",
+		sql:  `ALTER TABLE vods ADD COLUMN IF NOT EXISTS search_document tsvector`,
+	},
+	{
+		name: "vod_search_document",
+		sql: `CREATE OR REPLACE FUNCTION vod_search_document(vod_id uuid, vod_title text, vod_category text, vod_channel uuid) RETURNS tsvector AS $$
+	SELECT setweight(to_tsvector('simple', coalesce(vod_title, '')), 'A') ||
+		setweight(to_tsvector('simple', coalesce(vod_category, '')), 'B') ||
+		setweight(to_tsvector('simple', coalesce((SELECT channels.name || ' ' || channels.display_name FROM channels WHERE channels.id = vod_channel), '')), 'B') ||
+		setweight(to_tsvector('simple', coalesce((SELECT string_agg(chapters.title, ' ') FROM chapters WHERE chapters.vod_chapters = vod_id), '')), 'C')
+$$ LANGUAGE SQL STABLE`,
+	},
+	{
+		name: "vods_search_document_trigger",
+		sql: `CREATE OR REPLACE FUNCTION vods_search_document_trigger() RETURNS trigger AS $$
+BEGIN
+	NEW.search_document := vod_search_document(NEW.id, NEW.title, NEW.category, NEW.channel_vods);
+	RETURN NEW;
+END
+$$ LANGUAGE plpgsql`,
+	},
+	{
+		name: "chapters_search_document_trigger",
+		sql: `CREATE OR REPLACE FUNCTION chapters_search_document_trigger() RETURNS trigger AS $$
+BEGIN
+	IF TG_OP IN ('UPDATE', 'DELETE') THEN
+		UPDATE vods SET search_document = vod_search_document(vods.id, vods.title, vods.category, vods.channel_vods) WHERE vods.id = OLD.vod_chapters;
+	END IF;
+	IF TG_OP IN ('INSERT', 'UPDATE') THEN
+		UPDATE vods SET search_document = vod_search_document(vods.id, vods.title, vods.category, vods.channel_vods) WHERE vods.id = NEW.vod_chapters;
+	END IF;
+	RETURN NULL;
+END
+$$ LANGUAGE plpgsql`,
+	},
+	{
+		name: "channels_search_document_trigger",
+		sql: `CREATE OR REPLACE FUNCTION channels_search_document_trigger() RETURNS trigger AS $$
+BEGIN
+	UPDATE vods SET search_document = vod_search_document(vods.id, vods.title, vods.category, vods.channel_vods) WHERE vods.channel_vods = NEW.id;
+	RETURN NULL;
+END
+$$ LANGUAGE plpgsql`,
+	},
+	{
+		name: "vods_search_document trigger",
+		sql:  `CREATE OR REPLACE TRIGGER vods_search_document BEFORE INSERT OR UPDATE OF title, category, channel_vods ON vods FOR EACH ROW EXECUTE FUNCTION vods_search_document_trigger()`,
+	},
+	{
+		name: "chapters_search_document trigger",
+		sql:  `CREATE OR REPLACE TRIGGER chapters_search_document AFTER INSERT OR UPDATE OR DELETE ON chapters FOR EACH ROW EXECUTE FUNCTION chapters_search_document_trigger()`,
+	},
+	{
+		name: "channels_search_document trigger",
+		sql:  `CREATE OR REPLACE TRIGGER channels_search_document AFTER UPDATE OF name, display_name ON channels FOR EACH ROW WHEN (OLD.name IS DISTINCT FROM NEW.name OR OLD.display_name IS DISTINCT FROM NEW.display_name) EXECUTE FUNCTION channels_search_document_trigger()`,
+	},
+	{
+		// videos archived before the category was recorded get the category of their longest game chapter
+		name: "vods.category backfill",
+		sql: `UPDATE vods SET category = chapter.title
+FROM (
+	SELECT DISTINCT ON (chapters.vod_chapters) chapters.vod_chapters, chapters.title
+	FROM chapters
+	WHERE chapters.type IN ('GAME_CHANGE', 'FALLBACK') AND coalesce(chapters.title, '') <> ''
+	ORDER BY chapters.vod_chapters, coalesce(chapters."end", 0) - coalesce(chapters.start, 0) DESC
+) AS chapter
+WHERE vods.id = chapter.vod_chapters AND coalesce(vods.category, '') = ''`,
+		// later videos record their category, an empty category was cleared by an editor
+		onCreate: true,
+	},
+	{
+		name: "vods.search_document backfill",
+		sql:  `UPDATE vods SET search_document = vod_search_document(id, title, category, channel_vods) WHERE search_document IS NULL`,
+	},
+	{
+		name: "vods_search_document index",
+		sql:  `CREATE INDEX IF NOT EXISTS vods_search_document ON vods USING GIN (search_document)`,
+	},
+}
+
+// ensureVodSearchDocument creates the search document of videos and backfills it. When created is set the
+// search document column doesn't exist yet and the category of videos archived before it was recorded is
+// backfilled once. The other statements are idempotent and run on every boot. Search doesn't work without
+// the search document, but a failure shouldn't block startup so it is logged.
+func ensureVodSearchDocument(ctx context.Context, conn sqlExecutor, created bool) {
+	for _, s := range vodSearchDocumentStatements {
+		if s.onCreate && !created {
+			continue
+		}
+		if _, err := conn.ExecContext(ctx, s.sql); err != nil {
+			log.Warn().Err(err).Str("statement", s.name).Msg("create vod search document failed")
+			return
+		}
+	}
+}
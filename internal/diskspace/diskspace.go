@@ -0,0 +1,64 @@
+// Package diskspace checks the free space of the archive directories against the configured minimums.
+package diskspace
+
+import (
+	"fmt"
+
+	"github.com/zibbp/ganymede/internal/config"
+	"github.com/zibbp/ganymede/internal/utils"
+)
+
+const bytesPerGB = 1024 * 1024 * 1024
+
+// Directory is the free space of a single archive directory.
+type Directory struct {
+	Name         string `json:"name"` // videos or temp
+	Path         string `json:"path"`
+	FreeBytes    int64  `json:"free_bytes"`
+	MinFreeBytes int64  `json:"min_free_bytes"` // 0 if no minimum is configured
+	Low          bool   `json:"low"`            // free space is below the minimum
+}
+
+// Status is the free space of all archive directories. New video downloads are paused while Low is true.
+type Status struct {
+	Low         bool        `json:"low"`
+	Directories []Directory `json:"directories"`
+}
+
+// LowDirectories returns the directories that are below their minimum free space.
+func (s Status) LowDirectories() []Directory {
+	var low []Directory
+	for _, directory := range s.Directories {
+		if directory.Low {
+			low = append(low, directory)
+		}
+	}
+	return low
+}
+
+// Check returns the free space of the videos and temp directories compared to the configured minimums.
+func Check() (Status, error) {
+	env := config.GetEnvConfig()
+	archive := config.Get().Archive
+	return check([]Directory{
+		{Name: "videos", Path: env.VideosDir, MinFreeBytes: int64(archive.MinFreeSpaceVideosGB) * bytesPerGB},
+		{Name: "temp", Path: env.TempDir, MinFreeBytes: int64(archive.MinFreeSpaceTempGB) * bytesPerGB},
+	}, utils.GetFreeSpaceOfDirectory)
+}
+
+func check(directories []Directory, freeSpace func(path string) (int64, error)) (Status, error) {
+	var status Status
+	for _, directory := range directories {
+		free, err := freeSpace(directory.Path)
+		if err != nil {
+			return status, fmt.Errorf("error getting free space of %s directory: %w", directory.Name, err)
+		}
+		directory.FreeBytes = free
+		directory.Low = directory.MinFreeBytes > 0 && free < directory.MinFreeBytes
+		if directory.Low {
+			status.Low = true
+		}
+		status.Directories = append(status.Directories, directory)
+	}
+	return status, nil
+}
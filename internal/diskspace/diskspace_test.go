@@ -0,0 +1,42 @@
+package diskspace
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCheckMarksDirectoriesBelowMinimum(t *testing.T) {
+	t.Parallel()
+
+	free := map[string]int64{"/data/videos": 5 * bytesPerGB, "/data/temp": 50 * bytesPerGB}
+	status, err := check([]Directory{
+		{Name: "videos", Path: "/data/videos", MinFreeBytes: 10 * bytesPerGB},
+		{Name: "temp", Path: "/data/temp", MinFreeBytes: 10 * bytesPerGB},
+	}, func(path string) (int64, error) { return free[path], nil })
+	require.NoError(t, err)
+
+	require.True(t, status.Low)
+	require.Len(t, status.Directories, 2)
+	require.True(t, status.Directories[0].Low)
+	require.Equal(t, int64(5*bytesPerGB), status.Directories[0].FreeBytes)
+	require.False(t, status.Directories[1].Low)
+	require.Equal(t, []Directory{status.Directories[0]}, status.LowDirectories())
+}
+
+func TestCheckWithoutMinimumIsNeverLow(t *testing.T) {
+	t.Parallel()
+
+	status, err := check([]Directory{{Name: "videos", Path: "/data/videos"}}, func(string) (int64, error) { return 0, nil })
+	require.NoError(t, err)
+	require.False(t, status.Low)
+	require.Empty(t, status.LowDirectories())
+}
+
+func TestCheckReturnsFreeSpaceError(t *testing.T) {
+	t.Parallel()
+
+	_, err := check([]Directory{{Name: "temp", Path: "/missing"}}, func(string) (int64, error) { return 0, errors.New("no such directory") })
+	require.ErrorContains(t, err, "temp directory")
+}
@@ -0,0 +1,96 @@
+package exec
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"os/exec"
+	"strconv"
+	"strings"
+
+	"github.com/rs/zerolog/log"
+)
+
+// TimeRange is a range of a media file in seconds.
+type TimeRange struct {
+	Start float64 `json:"start"`
+	End   float64 `json:"end"`
+}
+
+// SourceRange is a range of a video whose audio is taken from a source that starts Offset seconds into the video.
+type SourceRange struct {
+	TimeRange
+	Offset float64 `json:"offset"`
+}
+
+// ReplaceAudio replaces the audio of the video in the ranges with the audio of the source and saves the result
+// to outputPath. Each range takes the source from its own offset, a source with gaps starts later in the video
+// after every gap. The audio outside of the ranges is kept and the video is copied.
+func ReplaceAudio(ctx context.Context, videoPath string, sourcePath string, ranges []SourceRange, outputPath string) error {
+	if len(ranges) == 0 {
+		return fmt.Errorf("no ranges to replace")
+	}
+	log.Info().Str("video_path", videoPath).Str("source_path", sourcePath).Int("ranges", len(ranges)).Msg("replacing audio of video")
+
+	cmd := exec.CommandContext(ctx, "ffmpeg", replaceAudioArgs(videoPath, sourcePath, ranges, outputPath)...)
+	out, err := cmd.CombinedOutput()
+	if err != nil {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		log.Error().Err(err).Str("ffmpeg_output", string(out)).Msg("error replacing audio")
+		return fmt.Errorf("error running ffmpeg: %w", err)
+	}
+	return nil
+}
+
+// replaceAudioArgs silences the ranges in the audio of the video. The audio of the source is delayed once per
+// offset and everything but the ranges of that offset is silenced, then all of them are mixed.
+func replaceAudioArgs(videoPath string, sourcePath string, ranges []SourceRange, outputPath string) []string {
+	inRanges := make([]string, 0, len(ranges))
+	offsets := []float64{}
+	inOffsetRanges := map[float64][]string{}
+	for _, r := range ranges {
+		between := fmt.Sprintf("between(t,%s,%s)", formatSeconds(r.Start), formatSeconds(r.End))
+		inRanges = append(inRanges, between)
+		if _, ok := inOffsetRanges[r.Offset]; !ok {
+			offsets = append(offsets, r.Offset)
+		}
+		inOffsetRanges[r.Offset] = append(inOffsetRanges[r.Offset], between)
+	}
+
+	filter := fmt.Sprintf("[0:a]volume=0:enable='%s'[video];", strings.Join(inRanges, "+"))
+	sources := []string{"[1:a]"}
+	if len(offsets) > 1 {
+		sources = make([]string, len(offsets))
+		filter += fmt.Sprintf("[1:a]asplit=%d", len(offsets))
+		for i := range offsets {
+			sources[i] = fmt.Sprintf("[split%d]", i)
+			filter += sources[i]
+		}
+		filter += ";"
+	}
+	mix := "[video]"
+	for i, offset := range offsets {
+		filter += sources[i]
+		if delay := int64(math.Round(offset * 1000)); delay > 0 {
+			filter += fmt.Sprintf("adelay=delays=%d:all=1,", delay)
+		}
+		filter += fmt.Sprintf("volume=0:enable='not(%s)'[source%d];", strings.Join(inOffsetRanges[offset], "+"), i)
+		mix += fmt.Sprintf("[source%d]", i)
+	}
+	filter += fmt.Sprintf("%samix=inputs=%d:duration=first:dropout_transition=0:normalize=0[audio]", mix, len(offsets)+1)
+
+	return []string{"-y", "-hide_banner", "-loglevel", "error",
+		"-i", videoPath, "-i", sourcePath,
+		"-filter_complex", filter,
+		"-map", "0:v", "-map", "[audio]",
+		"-c:v", "copy", "-c:a", "aac", "-b:a", "160k",
+		"-movflags", "+faststart",
+		outputPath,
+	}
+}
+
+func formatSeconds(seconds float64) string {
+	return strconv.FormatFloat(seconds, 'f', -1, 64)
+}
@@ -0,0 +1,111 @@
+package exec
+
+import (
+	"os/exec"
+	"path/filepath"
+	"reflect"
+	"regexp"
+	"strconv"
+	"testing"
+)
+
+func TestReplaceAudioArgs(t *testing.T) {
+	args := replaceAudioArgs("vod.mp4", "live.mp4", []SourceRange{{TimeRange: TimeRange{Start: 2, End: 4}, Offset: 1.5}, {TimeRange: TimeRange{Start: 10, End: 12.5}, Offset: 1.5}}, "out.mp4")
+	expected := []string{"-y", "-hide_banner", "-loglevel", "error",
+		"-i", "vod.mp4", "-i", "live.mp4",
+		"-filter_complex", "[0:a]volume=0:enable='between(t,2,4)+between(t,10,12.5)'[video];[1:a]adelay=delays=1500:all=1,volume=0:enable='not(between(t,2,4)+between(t,10,12.5))'[source0];[video][source0]amix=inputs=2:duration=first:dropout_transition=0:normalize=0[audio]",
+		"-map", "0:v", "-map", "[audio]",
+		"-c:v", "copy", "-c:a", "aac", "-b:a", "160k",
+		"-movflags", "+faststart",
+		"out.mp4",
+	}
+	if !reflect.DeepEqual(args, expected) {
+		t.Fatalf("expected %v, got %v", expected, args)
+	}
+
+	args = replaceAudioArgs("vod.mp4", "live.mp4", []SourceRange{{TimeRange: TimeRange{Start: 2, End: 4}}}, "out.mp4")
+	if filter := args[9]; filter != "[0:a]volume=0:enable='between(t,2,4)'[video];[1:a]volume=0:enable='not(between(t,2,4))'[source0];[video][source0]amix=inputs=2:duration=first:dropout_transition=0:normalize=0[audio]" {
+		t.Fatalf("unexpected filter without offset: %s", filter)
+	}
+
+	// the source starts later after a gap
+	args = replaceAudioArgs("vod.mp4", "live.mp4", []SourceRange{{TimeRange: TimeRange{Start: 2, End: 4}, Offset: 1}, {TimeRange: TimeRange{Start: 10, End: 12}, Offset: 3}}, "out.mp4")
+	if filter := args[9]; filter != "[0:a]volume=0:enable='between(t,2,4)+between(t,10,12)'[video];[1:a]asplit=2[split0][split1];[split0]adelay=delays=1000:all=1,volume=0:enable='not(between(t,2,4))'[source0];[split1]adelay=delays=3000:all=1,volume=0:enable='not(between(t,10,12))'[source1];[video][source0][source1]amix=inputs=3:duration=first:dropout_transition=0:normalize=0[audio]" {
+		t.Fatalf("unexpected filter with gaps: %s", filter)
+	}
+}
+
+// createMutedVideo creates a video with a silent audio track.
+func createMutedVideo(t *testing.T, dir string) string {
+	t.Helper()
+	path := filepath.Join(dir, "vod.mp4")
+	cmd := exec.Command("ffmpeg", "-y", "-f", "lavfi", "-i", "testsrc=duration=6:size=128x128:rate=10", "-f", "lavfi", "-i", "anullsrc=r=48000:cl=stereo", "-t", "6", "-c:v", "libx264", "-pix_fmt", "yuv420p", "-c:a", "aac", "-shortest", path)
+	if out, err := cmd.CombinedOutput(); err != nil {
+		t.Fatalf("failed to create muted video: %v, output: %s", err, string(out))
+	}
+	return path
+}
+
+// createToneVideo creates a video with a sine tone as audio.
+func createToneVideo(t *testing.T, dir string, duration int) string {
+	t.Helper()
+	path := filepath.Join(dir, "live.mp4")
+	cmd := exec.Command("ffmpeg", "-y", "-f", "lavfi", "-i", "testsrc=duration="+strconv.Itoa(duration)+":size=128x128:rate=10", "-f", "lavfi", "-i", "sine=frequency=440:sample_rate=48000:duration="+strconv.Itoa(duration), "-c:v", "libx264", "-pix_fmt", "yuv420p", "-c:a", "aac", "-shortest", path)
+	if out, err := cmd.CombinedOutput(); err != nil {
+		t.Fatalf("failed to create tone video: %v, output: %s", err, string(out))
+	}
+	return path
+}
+
+var maxVolumeRegexp = regexp.MustCompile(`max_volume: (-?[0-9.]+|-inf) dB`)
+
+// maxVolume returns the peak volume of the audio between start and start+duration in dB.
+func maxVolume(t *testing.T, path string, start string, duration string) float64 {
+	t.Helper()
+	out, err := exec.Command("ffmpeg", "-hide_banner", "-ss", start, "-t", duration, "-i", path, "-vn", "-af", "volumedetect", "-f", "null", "-").CombinedOutput()
+	if err != nil {
+		t.Fatalf("failed to detect volume: %v, output: %s", err, string(out))
+	}
+	match := maxVolumeRegexp.FindStringSubmatch(string(out))
+	if match == nil {
+		t.Fatalf("no max volume in output: %s", string(out))
+	}
+	if match[1] == "-inf" {
+		return -200
+	}
+	volume, err := strconv.ParseFloat(match[1], 64)
+	if err != nil {
+		t.Fatalf("parse max volume %q: %v", match[1], err)
+	}
+	return volume
+}
+
+func TestReplaceAudio(t *testing.T) {
+	dir := t.TempDir()
+	video := createMutedVideo(t, dir)
+	// the live archive started one second into the video and covers 1s-5s
+	source := createToneVideo(t, dir, 4)
+	output := filepath.Join(dir, "out.mp4")
+
+	if err := ReplaceAudio(t.Context(), video, source, []SourceRange{{TimeRange: TimeRange{Start: 2, End: 4}, Offset: 1}}, output); err != nil {
+		t.Fatalf("ReplaceAudio failed: %v", err)
+	}
+
+	duration, err := GetVideoDuration(t.Context(), output)
+	if err != nil {
+		t.Fatalf("GetVideoDuration failed: %v", err)
+	}
+	if duration < 5 || duration > 7 {
+		t.Errorf("unexpected duration: got %d, want ~6", duration)
+	}
+	if volume := maxVolume(t, output, "2.3", "1.4"); volume < -30 {
+		t.Errorf("expected the tone in the replaced range, got max volume %f dB", volume)
+	}
+	// the source covers 1s-2s as well but only the range is replaced
+	if volume := maxVolume(t, output, "1.1", "0.7"); volume > -60 {
+		t.Errorf("expected silence before the range, got max volume %f dB", volume)
+	}
+	if volume := maxVolume(t, output, "4.4", "1.2"); volume > -60 {
+		t.Errorf("expected silence after the range, got max volume %f dB", volume)
+	}
+}
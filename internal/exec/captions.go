@@ -0,0 +1,96 @@
+package exec
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+	"os/exec"
+	"strings"
+
+	"github.com/rs/zerolog/log"
+)
+
+// ExtractAudio extracts the audio of a video as 16 kHz mono WAV, the input format expected by most speech-to-text engines.
+func ExtractAudio(ctx context.Context, videoPath string, audioPath string) error {
+	log.Info().Str("video_path", videoPath).Str("audio_path", audioPath).Msg("extracting audio for speech-to-text")
+
+	args := []string{"-y", "-hide_banner", "-i", videoPath, "-vn", "-ac", "1", "-ar", "16000", "-c:a", "pcm_s16le", audioPath}
+	cmd := exec.CommandContext(ctx, "ffmpeg", args...)
+	out, err := cmd.CombinedOutput()
+	if err != nil {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		log.Error().Err(err).Str("ffmpeg_output", string(out)).Msg("error extracting audio")
+		return fmt.Errorf("error running ffmpeg: %w", err)
+	}
+	return nil
+}
+
+// GenerateCaptions runs the speech-to-text command on the audio file and saves the WebVTT captions to outputPath.
+//
+// The command is split on whitespace and the {{input}}, {{output}} and {{output_prefix}} (output path without the .vtt extension)
+// variables are replaced in each argument. Commands which print the captions to stdout instead of writing a file are supported as well.
+func GenerateCaptions(ctx context.Context, command string, audioPath string, outputPath string) error {
+	args, err := speechToTextArgs(command, audioPath, outputPath)
+	if err != nil {
+		return err
+	}
+
+	log.Info().Str("command", args[0]).Str("audio_path", audioPath).Str("output_path", outputPath).Msg("generating captions")
+
+	cmd := exec.CommandContext(ctx, args[0], args[1:]...)
+	var stdout, stderr bytes.Buffer
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		log.Error().Err(err).Str("stderr", stderr.String()).Msg("error running speech-to-text command")
+		return fmt.Errorf("error running speech-to-text command: %w", err)
+	}
+
+	if _, err := os.Stat(outputPath); os.IsNotExist(err) {
+		if !isWebVTT(stdout.Bytes()) {
+			return fmt.Errorf("speech-to-text command did not write captions to %s", outputPath)
+		}
+		if err := os.WriteFile(outputPath, stdout.Bytes(), 0644); err != nil {
+			return fmt.Errorf("error writing captions: %w", err)
+		}
+	}
+
+	data, err := os.ReadFile(outputPath)
+	if err != nil {
+		return fmt.Errorf("error reading captions: %w", err)
+	}
+	if !isWebVTT(data) {
+		return fmt.Errorf("speech-to-text output %s is not a WebVTT file", outputPath)
+	}
+
+	return nil
+}
+
+func speechToTextArgs(command string, audioPath string, outputPath string) ([]string, error) {
+	fields := strings.Fields(command)
+	if len(fields) == 0 {
+		return nil, fmt.Errorf("speech-to-text command is not configured")
+	}
+
+	replacer := strings.NewReplacer(
+		"{{input}}", audioPath,
+		"{{output}}", outputPath,
+		"{{output_prefix}}", strings.TrimSuffix(outputPath, ".vtt"),
+	)
+	args := make([]string, len(fields))
+	for i, field := range fields {
+		args[i] = replacer.Replace(field)
+	}
+	return args, nil
+}
+
+func isWebVTT(data []byte) bool {
+	data = bytes.TrimPrefix(data, []byte("\ufeff"))
+	return bytes.HasPrefix(data, []byte("WEBVTT"))
+}
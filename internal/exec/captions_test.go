@@ -0,0 +1,52 @@
+package exec
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+func TestSpeechToTextArgs(t *testing.T) {
+	args, err := speechToTextArgs("whisper-cli -m /models/base.bin -f {{input}} -ovtt -of {{output_prefix}}", "/tmp/my audio.wav", "/videos/a/a-caption.vtt")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	expected := []string{"whisper-cli", "-m", "/models/base.bin", "-f", "/tmp/my audio.wav", "-ovtt", "-of", "/videos/a/a-caption"}
+	if !reflect.DeepEqual(args, expected) {
+		t.Fatalf("expected %v, got %v", expected, args)
+	}
+
+	if _, err := speechToTextArgs("  ", "in.wav", "out.vtt"); err == nil {
+		t.Fatal("expected error for empty command")
+	}
+}
+
+func TestGenerateCaptionsFromStdout(t *testing.T) {
+	dir := t.TempDir()
+	script := filepath.Join(dir, "stt.sh")
+	writeExecutable(t, script, "#!/bin/sh\nprintf 'WEBVTT\\n\\n00:00:00.000 --> 00:00:01.000\\nhello\\n'\n")
+	output := filepath.Join(dir, "captions.vtt")
+
+	if err := GenerateCaptions(context.Background(), script+" {{input}}", filepath.Join(dir, "audio.wav"), output); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	data, err := os.ReadFile(output)
+	if err != nil {
+		t.Fatalf("read captions: %v", err)
+	}
+	if string(data) != "WEBVTT\n\n00:00:00.000 --> 00:00:01.000\nhello\n" {
+		t.Fatalf("unexpected captions: %q", data)
+	}
+}
+
+func TestGenerateCaptionsRejectsNonWebVTTOutput(t *testing.T) {
+	dir := t.TempDir()
+	script := filepath.Join(dir, "stt.sh")
+	writeExecutable(t, script, "#!/bin/sh\nprintf '1\\n00:00:00,000 --> 00:00:01,000\\nhello\\n' > \"$1\"\n")
+
+	if err := GenerateCaptions(context.Background(), script+" {{output}}", filepath.Join(dir, "audio.wav"), filepath.Join(dir, "captions.vtt")); err == nil {
+		t.Fatal("expected error for non WebVTT output")
+	}
+}
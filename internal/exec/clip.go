@@ -0,0 +1,94 @@
+package exec
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"os/exec"
+	"strconv"
+	"strings"
+
+	"github.com/rs/zerolog/log"
+)
+
+// keyframeTolerance is how close in seconds a keyframe must be to the start of a clip to cut it without re-encoding.
+const keyframeTolerance = 0.05
+
+// Keyframes returns the timestamps in seconds of the video keyframes between from and to.
+func Keyframes(ctx context.Context, videoPath string, from float64, to float64) ([]float64, error) {
+	cmd := exec.CommandContext(ctx, "ffprobe",
+		"-v", "error",
+		"-select_streams", "v:0",
+		"-skip_frame", "nokey",
+		"-show_entries", "frame=pts_time",
+		"-read_intervals", fmt.Sprintf("%s%%%s", formatSeconds(math.Max(0, from)), formatSeconds(to)),
+		"-of", "csv=p=0",
+		videoPath,
+	)
+	out, err := cmd.Output()
+	if err != nil {
+		return nil, fmt.Errorf("error running ffprobe: %w", err)
+	}
+	return parseKeyframes(string(out)), nil
+}
+
+// parseKeyframes parses the frame timestamps printed by ffprobe, one per line.
+func parseKeyframes(out string) []float64 {
+	keyframes := []float64{}
+	for _, line := range strings.Split(out, "\n") {
+		line = strings.TrimSuffix(strings.TrimSpace(line), ",")
+		timestamp, err := strconv.ParseFloat(line, 64)
+		if err != nil {
+			continue
+		}
+		keyframes = append(keyframes, timestamp)
+	}
+	return keyframes
+}
+
+// KeyframeAligned returns whether a clip starting at start can be cut from a video with the keyframes
+// without re-encoding.
+func KeyframeAligned(keyframes []float64, start float64) bool {
+	for _, keyframe := range keyframes {
+		if math.Abs(keyframe-start) <= keyframeTolerance {
+			return true
+		}
+	}
+	return false
+}
+
+// CutVideo saves the part of the video between start and end to outputPath. The streams are copied if
+// copyStreams is set, which is only accurate if start is on a keyframe, and re-encoded otherwise.
+func CutVideo(ctx context.Context, videoPath string, start float64, end float64, copyStreams bool, outputPath string) error {
+	if end <= start {
+		return fmt.Errorf("end must be after start")
+	}
+	log.Info().Str("video_path", videoPath).Float64("start", start).Float64("end", end).Bool("copy", copyStreams).Msg("cutting video")
+
+	cmd := exec.CommandContext(ctx, "ffmpeg", cutVideoArgs(videoPath, start, end, copyStreams, outputPath)...)
+	out, err := cmd.CombinedOutput()
+	if err != nil {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		log.Error().Err(err).Str("ffmpeg_output", string(out)).Msg("error cutting video")
+		return fmt.Errorf("error running ffmpeg: %w", err)
+	}
+	return nil
+}
+
+// cutVideoArgs seeks the input to start, which is frame accurate when re-encoding, and keeps the
+// duration of the clip.
+func cutVideoArgs(videoPath string, start float64, end float64, copyStreams bool, outputPath string) []string {
+	args := []string{"-y", "-hide_banner", "-loglevel", "error",
+		"-ss", formatSeconds(start), "-i", videoPath,
+		"-t", formatSeconds(end - start),
+		"-map", "0:v?", "-map", "0:a?",
+	}
+	if copyStreams {
+		args = append(args, "-c", "copy", "-avoid_negative_ts", "make_zero")
+	} else {
+		args = append(args, "-c:v", "libx264", "-preset", "veryfast", "-crf", "20", "-c:a", "aac", "-b:a", "160k")
+	}
+	return append(args, "-movflags", "+faststart", outputPath)
+}
@@ -0,0 +1,50 @@
+package exec
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestCutVideoArgs(t *testing.T) {
+	args := cutVideoArgs("vod.mp4", 90, 120.5, true, "clip.mp4")
+	expected := []string{"-y", "-hide_banner", "-loglevel", "error",
+		"-ss", "90", "-i", "vod.mp4",
+		"-t", "30.5",
+		"-map", "0:v?", "-map", "0:a?",
+		"-c", "copy", "-avoid_negative_ts", "make_zero",
+		"-movflags", "+faststart",
+		"clip.mp4",
+	}
+	if !reflect.DeepEqual(args, expected) {
+		t.Fatalf("expected %v, got %v", expected, args)
+	}
+
+	args = cutVideoArgs("vod.mp4", 90, 120, false, "clip.mp4")
+	expected = []string{"-y", "-hide_banner", "-loglevel", "error",
+		"-ss", "90", "-i", "vod.mp4",
+		"-t", "30",
+		"-map", "0:v?", "-map", "0:a?",
+		"-c:v", "libx264", "-preset", "veryfast", "-crf", "20", "-c:a", "aac", "-b:a", "160k",
+		"-movflags", "+faststart",
+		"clip.mp4",
+	}
+	if !reflect.DeepEqual(args, expected) {
+		t.Fatalf("expected %v, got %v", expected, args)
+	}
+}
+
+func TestKeyframeAligned(t *testing.T) {
+	keyframes := parseKeyframes("86.000000\n88.000000,\n\n90.033333\nN/A\n")
+	if !reflect.DeepEqual(keyframes, []float64{86, 88, 90.033333}) {
+		t.Fatalf("unexpected keyframes %v", keyframes)
+	}
+	if !KeyframeAligned(keyframes, 90) {
+		t.Fatal("expected 90 to be aligned")
+	}
+	if KeyframeAligned(keyframes, 89) {
+		t.Fatal("expected 89 not to be aligned")
+	}
+	if KeyframeAligned(nil, 0) {
+		t.Fatal("expected no keyframes not to be aligned")
+	}
+}
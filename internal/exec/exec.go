@@ -151,6 +151,11 @@ func DownloadTwitchVideo(ctx context.Context, video ent.Vod) error {
 	// Create yt-dlp quality string
 	qualityString := ytdlpSvc.CreateQualityOption(closestQuality)
 
+	return downloadVideoWithYtDlp(ctx, video, ytdlpSvc, url, qualityString, file)
+}
+
+// downloadVideoWithYtDlp runs yt-dlp to download the video to its temporary download path, writing output to the log file.
+func downloadVideoWithYtDlp(ctx context.Context, video ent.Vod, ytdlpSvc *ytdlp.YtDlpService, url string, qualityString string, file *os.File) error {
 	// Build output path
 	// yt-dlp will sometimes download two separate files for audio and video
 	// so we need to remove the extension and let yt-dlp add the extension
@@ -215,7 +220,7 @@ func DownloadTwitchVideo(ctx context.Context, video ent.Vod) error {
 	return nil
 }
 
-func DownloadTwitchLiveVideo(ctx context.Context, video ent.Vod, channel ent.Channel, startChat chan bool) error {
+func DownloadTwitchLiveVideo(ctx context.Context, video ent.Vod, channel ent.Channel, startChat chan bool, events LiveArchiveEvents) error {
 	video.Edges.Channel = &channel
 	env := config.GetEnvConfig()
 
@@ -233,9 +238,19 @@ func DownloadTwitchLiveVideo(ctx context.Context, video ent.Vod, channel ent.Cha
 
 	log.Debug().Str("video_id", video.ID.String()).Msgf("logging ffmpeg output to %s", logFilePath)
 
-	proxyFound := false // Whether a proxy was found
-	var masterPlaylist *hls.Multivariant
+	resolve := func(ctx context.Context, quality string) (liveVariant, error) {
+		masterPlaylist, err := getTwitchLiveStream(ctx, video, channel)
+		if err != nil {
+			return liveVariant{}, err
+		}
+		return selectLiveStreamVariant(quality, masterPlaylist), nil
+	}
 
+	return archiveLiveStream(ctx, video, channel, resolve, file, startChat, events)
+}
+
+// getTwitchLiveStream returns the multivariant playlist of the Twitch live stream, using the first working proxy if proxies are enabled.
+func getTwitchLiveStream(ctx context.Context, video ent.Vod, channel ent.Channel) (*hls.Multivariant, error) {
 	twitchURL := utils.CreateTwitchURL(video.ExtID, video.Type, channel.Name)
 
 	// Handle proxy setting
@@ -259,25 +274,25 @@ func DownloadTwitchLiveVideo(ctx context.Context, video ent.Vod, channel ent.Cha
 					proxyUrl = fmt.Sprintf("%s/playlist/%s.m3u8%s", proxy.URL, channel.Name, proxyParams)
 				}
 				// Try the proxy server
-				var ok bool
-				masterPlaylist, ok = tryProxyServer(proxy.URL, proxyUrl, proxy.Header, proxy.ProxyType)
+				masterPlaylist, ok := tryProxyServer(proxy.URL, proxyUrl, proxy.Header, proxy.ProxyType)
 				if ok {
 					log.Debug().Str("channel_name", channel.Name).Str("proxy_url", proxy.URL).Msg("proxy found")
-					proxyFound = true
-					break
+					return masterPlaylist, nil
 				}
 			}
 		}
 	}
 
-	if !proxyFound {
-		tc := &platform.TwitchConnection{}
-		masterPlaylist, err = tc.GetStream(ctx, channel.Name)
-		if err != nil {
-			return fmt.Errorf("failed to get stream: %v", err)
-		}
+	tc := &platform.TwitchConnection{}
+	masterPlaylist, err := tc.GetStream(ctx, channel.Name)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get stream: %v", err)
 	}
+	return masterPlaylist, nil
+}
 
+// selectLiveStreamVariant returns the playlist variant closest to the requested quality.
+func selectLiveStreamVariant(quality string, masterPlaylist *hls.Multivariant) liveVariant {
 	qualities := make([]string, 0, len(masterPlaylist.Variants))
 	qualitiesURI := make(map[string]string, len(masterPlaylist.Variants))
 	for _, variant := range masterPlaylist.Variants {
@@ -289,15 +304,130 @@ func DownloadTwitchLiveVideo(ctx context.Context, video ent.Vod, channel ent.Cha
 		log.Debug().Str("quality", b).Str("quality_uri", a).Msg("quality uri")
 	}
 
-	closestQuality := utils.SelectClosestQuality(video.Resolution, qualities)
-	log.Info().Str("requested_quality", video.Resolution).Msgf("selected closest quality %s", closestQuality)
+	closestQuality := utils.SelectClosestQuality(quality, qualities)
+	log.Info().Str("requested_quality", quality).Msgf("selected closest quality %s", closestQuality)
 
 	if closestQuality == "audio" {
 		closestQuality = "audio_only"
 	}
 
-	audioOnly := closestQuality == "audio_only"
+	return liveVariant{
+		name:      closestQuality,
+		url:       qualitiesURI[closestQuality],
+		audioOnly: closestQuality == "audio_only",
+	}
+}
+
+// archiveLiveStream archives the live stream with ffmpeg until the stream ends or the context is cancelled.
+// The stream is archived as MPEG-TS or HLS depending on the video paths. startChat is signalled once ffmpeg is about to start.
+//
+// When ffmpeg keeps failing while the stream is still live the archive resumes in the next quality of the
+// quality fallbacks of the video. MPEG-TS archives don't switch between audio-only and video qualities. When the recording drops it is resumed if the stream is live again within
+// the reconnect window, the missing part of the stream is reported as a gap. Resumed MPEG-TS archives are
+// written to part files which are stitched in post-process. If the video has a temporary audio path the
+// audio of the stream is recorded alongside the video.
+func archiveLiveStream(ctx context.Context, video ent.Vod, channel ent.Channel, resolve liveVariantResolver, file *os.File, startChat chan bool, events LiveArchiveEvents) error {
+	chain := utils.QualityChain(video.Resolution, video.QualityFallbacks)
+	if len(chain) == 0 {
+		chain = []string{video.Resolution}
+	}
+
+	variant, err := resolve(ctx, chain[0])
+	if err != nil {
+		return err
+	}
+
+	if video.VideoHlsPath != "" || (config.Get().Livestream.WatchWhileArchiving && video.TmpVideoHlsPath != "") {
+		if err := utils.CreateDirectory(video.TmpVideoHlsPath); err != nil {
+			return fmt.Errorf("error creating hls directory: %w", err)
+		}
+	}
+
+	if video.TmpAudioPath != "" {
+		audioCtx, cancelAudio := context.WithCancel(ctx)
+		audioDone := make(chan struct{})
+		go func() {
+			defer close(audioDone)
+			recordLiveAudio(audioCtx, video, channel, resolve, file)
+		}()
+		defer func() {
+			cancelAudio()
+			<-audioDone
+		}()
+	}
+
+	reconnectWindow := time.Duration(config.Get().Livestream.ReconnectWindowSeconds) * time.Second
+	archived := 0.0 // seconds of the stream in the archive
+	index, failures := 0, 0
+	for attempt := 0; ; attempt++ {
+		output := liveArchiveAttemptPath(video, attempt)
+		ffmpegArgs := liveArchiveArgs(video, variant, output, attempt > 0)
+
+		// start chat download
+		if attempt == 0 {
+			startChat <- true
+		}
+
+		startedAt := time.Now()
+		err := runLiveArchiveCommand(ctx, ffmpegArgs, file, channel)
+		if ctx.Err() != nil {
+			return err
+		}
+		stoppedAt := time.Now()
+		if reconnectWindow == 0 && (err == nil || len(chain) == 1) {
+			return err
+		}
+		archived = liveArchivedSeconds(ctx, video, output, archived, stoppedAt.Sub(startedAt))
 
+		if err != nil {
+			failures++
+		} else {
+			failures = 0
+		}
+		if err != nil && failures >= liveArchiveFailureThreshold {
+			if len(chain) == 1 {
+				log.Info().Err(err).Str("video_id", video.ID.String()).Int("failures", failures).Msg("giving up on live archive after repeated failures")
+				return err
+			}
+			// MPEG-TS parts are stitched, which requires the same streams in every part
+			nextIndex, next, resolveErr := nextLiveVariant(ctx, resolve, chain, index, variant, video.VideoHlsPath == "")
+			if resolveErr == nil {
+				qualitySwitch := utils.QualitySwitch{Time: time.Now(), From: variant.name, To: next.name, Failures: failures}
+				log.Warn().Str("video_id", video.ID.String()).Str("from", qualitySwitch.From).Str("to", qualitySwitch.To).Int("failures", failures).Msg("switching live archive quality")
+				writeLiveArchiveNote(file, qualitySwitch.Time, fmt.Sprintf("switching quality from %s to %s after %d failures", qualitySwitch.From, qualitySwitch.To, failures))
+				if events.QualitySwitch != nil {
+					events.QualitySwitch(qualitySwitch)
+				}
+				index, variant, failures = nextIndex, next, 0
+				reportLiveGap(file, events, video, utils.LiveGapReasonDisconnected, stoppedAt, archived)
+				continue
+			}
+			if stdErrors.Is(resolveErr, errLiveQualitiesExhausted) || reconnectWindow == 0 {
+				log.Info().Err(resolveErr).Str("video_id", video.ID.String()).Msg("no quality to fall back to")
+				return err
+			}
+		}
+
+		next, offline, reconnectErr := reconnectLiveStream(ctx, resolve, chain[index], reconnectWindow)
+		if reconnectErr != nil {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			log.Info().Err(reconnectErr).Str("video_id", video.ID.String()).Msg("live stream did not come back")
+			return err
+		}
+		reason := utils.LiveGapReasonDisconnected
+		if offline || err == nil {
+			reason = utils.LiveGapReasonOffline
+		}
+		variant = next
+		reportLiveGap(file, events, video, reason, stoppedAt, archived)
+	}
+}
+
+// liveArchiveArgs returns the ffmpeg arguments archiving the live stream variant to output, or to the HLS
+// playlist of the video. resumed marks the archive as resumed after an earlier attempt.
+func liveArchiveArgs(video ent.Vod, variant liveVariant, output string, resumed bool) []string {
 	// Base ffmpeg args (shared between transport-stream and hls live archiving)
 	ffmpegArgs := []string{
 		"-y",
@@ -305,9 +435,9 @@ func DownloadTwitchLiveVideo(ctx context.Context, video ent.Vod, channel ent.Cha
 		"-fflags", "+genpts+discardcorrupt",
 		"-rw_timeout", "30000000", // 30 second timeout for ffmpeg to connect/read before it gives up and retries
 		"-timeout", "30000000", // 30 second timeout for ffmpeg to connect/read before it gives up and retries
-		"-i", qualitiesURI[closestQuality],
+		"-i", variant.url,
 	}
-	ffmpegArgs = appendFFmpegLiveOutputStreamArgs(ffmpegArgs, audioOnly)
+	ffmpegArgs = appendFFmpegLiveOutputStreamArgs(ffmpegArgs, variant.audioOnly)
 
 	// Decide archive format.
 	archivingAsMP4 := (video.VideoHlsPath == "")
@@ -317,32 +447,34 @@ func DownloadTwitchLiveVideo(ctx context.Context, video ent.Vod, channel ent.Cha
 	videoConvertArgs := strings.Fields(videoConvertString)
 	ffmpegArgs = append(ffmpegArgs, videoConvertArgs...)
 
+	// segments of a resumed archive may have a different quality
+	hlsFlags := "append_list+independent_segments"
+	if resumed {
+		hlsFlags += "+discont_start"
+	}
+
 	// Archive output
 	if archivingAsMP4 {
 		// Archive to crash-tolerant MPEG-TS while live; finalize to MP4 in post-process.
 		ffmpegArgs = append(ffmpegArgs,
 			"-f", "mpegts",
-			video.TmpVideoDownloadPath,
+			output,
 		)
 
 		// Also archive HLS for watch-while-archiving
 		if config.Get().Livestream.WatchWhileArchiving && video.TmpVideoHlsPath != "" {
-			if err := utils.CreateDirectory(video.TmpVideoHlsPath); err != nil {
-				return fmt.Errorf("error creating hls directory: %w", err)
-			}
-
 			playlistPath := fmt.Sprintf("%s/%s-video.m3u8", video.TmpVideoHlsPath, video.ExtID)
 			segmentPattern := fmt.Sprintf("%s/%s_segment%%06d.ts", video.TmpVideoHlsPath, video.ExtID)
 
 			ffmpegArgs = append(ffmpegArgs,
-				appendFFmpegLiveOutputStreamArgs(nil, audioOnly)...,
+				appendFFmpegLiveOutputStreamArgs(nil, variant.audioOnly)...,
 			)
 			ffmpegArgs = append(ffmpegArgs,
 				"-start_number", "0",
 				"-hls_time", "2",
 				"-hls_list_size", "0",
 				"-hls_playlist_type", "event",
-				"-hls_flags", "append_list+independent_segments",
+				"-hls_flags", hlsFlags,
 				"-hls_segment_filename", segmentPattern,
 				"-f", "hls",
 				playlistPath,
@@ -350,37 +482,34 @@ func DownloadTwitchLiveVideo(ctx context.Context, video ent.Vod, channel ent.Cha
 		}
 	} else {
 		// Archive as HLS
-		if err := utils.CreateDirectory(video.TmpVideoHlsPath); err != nil {
-			return fmt.Errorf("error creating hls directory: %w", err)
-		}
-
 		playlistPath := fmt.Sprintf("%s/%s-video.m3u8", video.TmpVideoHlsPath, video.ExtID)
 		segmentPattern := fmt.Sprintf("%s/%s_segment%%06d.ts", video.TmpVideoHlsPath, video.ExtID)
 
 		ffmpegArgs = append(ffmpegArgs,
-			appendFFmpegLiveOutputStreamArgs(nil, audioOnly)...,
+			appendFFmpegLiveOutputStreamArgs(nil, variant.audioOnly)...,
 		)
 		ffmpegArgs = append(ffmpegArgs,
 			"-start_number", "0",
 			"-hls_time", "10",
 			"-hls_list_size", "0",
 			"-hls_playlist_type", "event",
-			"-hls_flags", "append_list+independent_segments",
+			"-hls_flags", hlsFlags,
 			"-hls_segment_filename", segmentPattern,
 			"-f", "hls",
 			playlistPath,
 		)
 	}
 
-	// Run ffmpeg
+	return ffmpegArgs
+}
+
+// runLiveArchiveCommand runs ffmpeg until it exits or the context is cancelled.
+func runLiveArchiveCommand(ctx context.Context, ffmpegArgs []string, file *os.File, channel ent.Channel) error {
 	cmd := osExec.Command("ffmpeg", ffmpegArgs...)
 	cmd.SysProcAttr = liveArchiveProcessAttributes()
 
 	log.Debug().Str("channel", channel.Name).Str("cmd", strings.Join(cmd.Args, " ")).Msgf("running ffmpeg")
 
-	// start chat download
-	startChat <- true
-
 	cmd.Stderr = file
 	cmd.Stdout = file
 
@@ -482,10 +611,24 @@ func vodArchiveProcessAttributes() *syscall.SysProcAttr {
 	}
 }
 
+// ConvertVideoToHLS converts the post-processed video to HLS. With adaptive bitrate renditions enabled the
+// playlist of the video is a multivariant playlist of the source, 720p, 480p and audio-only renditions.
 func ConvertVideoToHLS(ctx context.Context, video ent.Vod) error {
 	env := config.GetEnvConfig()
 	ffmpegArgs := []string{"-y", "-hide_banner", "-i", video.TmpVideoConvertPath, "-c", "copy", "-start_number", "0", "-hls_time", "10", "-hls_list_size", "0", "-hls_segment_filename", fmt.Sprintf("%s/%s_segment%s.ts", video.TmpVideoHlsPath, video.ExtID, "%d"), "-f", "hls", fmt.Sprintf("%s/%s-video.m3u8", video.TmpVideoHlsPath, video.ExtID)}
 
+	var renditions []hlsRendition
+	if config.Get().Archive.HlsRenditions {
+		probe, err := GetFfprobeVideoData(ctx, video.TmpVideoConvertPath)
+		if err != nil {
+			return err
+		}
+		renditions = hlsRenditions(probe)
+		if len(renditions) > 0 {
+			ffmpegArgs = hlsRenditionArgs(video, renditions, probe.hasAudio())
+		}
+	}
+
 	// open log file
 	logFilePath := fmt.Sprintf("%s/%s-video-convert.log", env.LogsDir, video.ID.String())
 	file, err := os.Create(logFilePath)
@@ -533,12 +676,16 @@ func ConvertVideoToHLS(ctx context.Context, video ent.Vod) error {
 		}
 	}
 
+	if len(renditions) > 0 {
+		return writeHLSMultivariant(ctx, video, renditions)
+	}
+
 	return nil
 }
 
 func PostProcessVideo(ctx context.Context, video ent.Vod) error {
 	env := config.GetEnvConfig()
-	configFfmpegArgs := config.Get().Parameters.VideoConvert
+	configFfmpegArgs := VideoConvertArgs(video)
 
 	// open log file
 	logFilePath := fmt.Sprintf("%s/%s-video-convert.log", env.LogsDir, video.ID.String())
@@ -218,3 +218,22 @@ func GetFfprobeVideoData(ctx context.Context, path string) (*FFprobeJsonData, er
 
 	return &data, nil
 }
+
+// VideoStream returns the first video stream, or nil if there is none.
+func (d *FFprobeJsonData) VideoStream() *FFprobestream {
+	for i := range d.Streams {
+		if d.Streams[i].CodecType == "video" {
+			return &d.Streams[i]
+		}
+	}
+	return nil
+}
+
+// Resolution returns the height of the video stream like "1080p", or "audio" if there is no video stream.
+func (d *FFprobeJsonData) Resolution() string {
+	stream := d.VideoStream()
+	if stream == nil || stream.Height == nil {
+		return "audio"
+	}
+	return fmt.Sprintf("%dp", *stream.Height)
+}
@@ -258,3 +258,24 @@ func TestGetFfprobeVideoData_NoFilename(t *testing.T) {
 		t.Errorf("expected no filename error, got: %v", err)
 	}
 }
+
+func TestFFprobeJsonDataResolution(t *testing.T) {
+	height := int64(720)
+	tests := []struct {
+		name    string
+		streams []FFprobestream
+		want    string
+	}{
+		{"video", []FFprobestream{{CodecType: "audio"}, {CodecType: "video", CodecName: "h264", Height: &height}}, "720p"},
+		{"audio only", []FFprobestream{{CodecType: "audio"}}, "audio"},
+		{"no height", []FFprobestream{{CodecType: "video"}}, "audio"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data := FFprobeJsonData{Streams: tt.streams}
+			if got := data.Resolution(); got != tt.want {
+				t.Errorf("Resolution() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
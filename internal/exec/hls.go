@@ -0,0 +1,221 @@
+package exec
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/zibbp/ganymede/ent"
+	"github.com/zibbp/ganymede/internal/hls"
+)
+
+// hlsRendition is a rendition of an HLS video with adaptive bitrate renditions.
+type hlsRendition struct {
+	name         string
+	height       int    // 0 keeps the source resolution
+	videoBitrate string // empty copies the source video
+	maxrate      string
+	bufsize      string
+	audioBitrate string // empty copies the source audio
+	audioOnly    bool
+}
+
+// hlsSourceRendition copies the source video and audio.
+var hlsSourceRendition = hlsRendition{name: "source"}
+
+// hlsRenditionLadder are the renditions encoded below the source resolution.
+var hlsRenditionLadder = []hlsRendition{
+	{name: "720p", height: 720, videoBitrate: "2500k", maxrate: "2675k", bufsize: "3750k", audioBitrate: "128k"},
+	{name: "480p", height: 480, videoBitrate: "1000k", maxrate: "1070k", bufsize: "1500k", audioBitrate: "96k"},
+}
+
+// hlsAudioRendition is the audio-only rendition for viewers on bad connections.
+var hlsAudioRendition = hlsRendition{name: "audio", audioBitrate: "96k", audioOnly: true}
+
+// hlsRenditions returns the renditions of the video: the source, the renditions of the ladder below the
+// source resolution and audio-only if the video has audio. Videos without a video stream have no renditions.
+func hlsRenditions(probe *FFprobeJsonData) []hlsRendition {
+	stream := probe.VideoStream()
+	if stream == nil || stream.Height == nil {
+		return nil
+	}
+
+	renditions := []hlsRendition{hlsSourceRendition}
+	for _, rendition := range hlsRenditionLadder {
+		if int(*stream.Height) > rendition.height {
+			renditions = append(renditions, rendition)
+		}
+	}
+	if probe.hasAudio() {
+		renditions = append(renditions, hlsAudioRendition)
+	}
+	return renditions
+}
+
+// hlsRenditionArgs returns the FFmpeg arguments that convert the video to a media playlist per rendition.
+func hlsRenditionArgs(video ent.Vod, renditions []hlsRendition, hasAudio bool) []string {
+	args := []string{"-y", "-hide_banner", "-i", video.TmpVideoConvertPath}
+	streamMap := []string{}
+	videoIndex, audioIndex := 0, 0
+	for _, rendition := range renditions {
+		entry := []string{}
+		if !rendition.audioOnly {
+			v := strconv.Itoa(videoIndex)
+			args = append(args, "-map", "0:v:0")
+			if rendition.videoBitrate == "" {
+				args = append(args, "-c:v:"+v, "copy")
+			} else {
+				args = append(args,
+					"-filter:v:"+v, fmt.Sprintf("scale=-2:%d", rendition.height),
+					"-c:v:"+v, "libx264", "-preset:v:"+v, "veryfast", "-pix_fmt:v:"+v, "yuv420p",
+					"-b:v:"+v, rendition.videoBitrate, "-maxrate:v:"+v, rendition.maxrate, "-bufsize:v:"+v, rendition.bufsize,
+					// keyframes every two seconds keep the segments of the renditions aligned
+					"-force_key_frames:v:"+v, "expr:gte(t,n_forced*2)",
+				)
+			}
+			entry = append(entry, "v:"+v)
+			videoIndex++
+		}
+		if hasAudio {
+			a := strconv.Itoa(audioIndex)
+			args = append(args, "-map", "0:a:0")
+			if rendition.audioBitrate == "" {
+				args = append(args, "-c:a:"+a, "copy")
+			} else {
+				args = append(args, "-c:a:"+a, "aac", "-b:a:"+a, rendition.audioBitrate)
+			}
+			entry = append(entry, "a:"+a)
+			audioIndex++
+		}
+		entry = append(entry, "name:"+rendition.name)
+		streamMap = append(streamMap, strings.Join(entry, ","))
+	}
+
+	return append(args,
+		"-var_stream_map", strings.Join(streamMap, " "),
+		"-start_number", "0", "-hls_time", "10", "-hls_list_size", "0", "-hls_playlist_type", "vod",
+		"-hls_segment_filename", fmt.Sprintf("%s/%s-%%v_segment%%d.ts", video.TmpVideoHlsPath, video.ExtID),
+		"-f", "hls", fmt.Sprintf("%s/%s-%%v.m3u8", video.TmpVideoHlsPath, video.ExtID),
+	)
+}
+
+// writeHLSMultivariant writes the multivariant playlist of the converted renditions of the video.
+func writeHLSMultivariant(ctx context.Context, video ent.Vod, renditions []hlsRendition) error {
+	variants := make([]hls.Variant, 0, len(renditions))
+	for _, rendition := range renditions {
+		uri := fmt.Sprintf("%s-%s.m3u8", video.ExtID, rendition.name)
+		playlistPath := fmt.Sprintf("%s/%s", video.TmpVideoHlsPath, uri)
+
+		peak, average, err := hls.MediaPlaylistBandwidth(playlistPath)
+		if err != nil {
+			return fmt.Errorf("error reading bandwidth of rendition %s: %w", rendition.name, err)
+		}
+		probe, err := GetFfprobeVideoData(ctx, playlistPath)
+		if err != nil {
+			return fmt.Errorf("error probing rendition %s: %w", rendition.name, err)
+		}
+
+		variant := hls.Variant{
+			Name:             rendition.name,
+			URI:              uri,
+			Bandwidth:        peak,
+			AverageBandwidth: average,
+			Codecs:           hlsCodecs(probe),
+		}
+		if stream := probe.VideoStream(); stream != nil && stream.Width != nil && stream.Height != nil {
+			variant.Width = int(*stream.Width)
+			variant.Height = int(*stream.Height)
+			variant.FrameRate = parseFrameRate(stream.AvgFrameRate)
+		}
+		variants = append(variants, variant)
+	}
+
+	return hls.WriteMultivariant(fmt.Sprintf("%s/%s-video.m3u8", video.TmpVideoHlsPath, video.ExtID), variants)
+}
+
+// hasAudio returns whether the probed file has an audio stream.
+func (d *FFprobeJsonData) hasAudio() bool {
+	for _, stream := range d.Streams {
+		if stream.CodecType == "audio" {
+			return true
+		}
+	}
+	return false
+}
+
+// hlsCodecs returns the CODECS of the audio and video streams of the probed file. Nothing is returned if
+// a codec is unknown so players don't mistake a variant for audio-only.
+func hlsCodecs(probe *FFprobeJsonData) []string {
+	codecs := []string{}
+	for _, stream := range probe.Streams {
+		if stream.CodecType != "video" && stream.CodecType != "audio" {
+			continue
+		}
+		codec := hlsCodec(stream)
+		if codec == "" {
+			return nil
+		}
+		codecs = append(codecs, codec)
+	}
+	return codecs
+}
+
+// hlsCodec returns the RFC 6381 codec string of the stream, or an empty string if it is unknown.
+func hlsCodec(stream FFprobestream) string {
+	level := int64(0)
+	if stream.Level != nil {
+		level = *stream.Level
+	}
+	switch stream.CodecName {
+	case "h264":
+		profiles := map[string]string{
+			"Constrained Baseline": "4240",
+			"Baseline":             "4200",
+			"Main":                 "4d00",
+			"High":                 "6400",
+			"High 10":              "6e00",
+		}
+		profile, ok := profiles[stream.Profile]
+		if !ok || level <= 0 {
+			return ""
+		}
+		return fmt.Sprintf("avc1.%s%02x", profile, level)
+	case "hevc":
+		profiles := map[string]string{
+			"Main":    "1.6",
+			"Main 10": "2.4",
+		}
+		profile, ok := profiles[stream.Profile]
+		if !ok || level <= 0 {
+			return ""
+		}
+		return fmt.Sprintf("hvc1.%s.L%d.B0", profile, level)
+	case "aac":
+		if stream.Profile == "HE-AAC" {
+			return "mp4a.40.5"
+		}
+		return "mp4a.40.2"
+	case "mp3":
+		return "mp4a.40.34"
+	}
+	return ""
+}
+
+// parseFrameRate parses a frame rate like "30000/1001", returning 0 if it is invalid.
+func parseFrameRate(frameRate string) float64 {
+	numerator, denominator, ok := strings.Cut(frameRate, "/")
+	if !ok {
+		fps, _ := strconv.ParseFloat(frameRate, 64)
+		return fps
+	}
+	n, err := strconv.ParseFloat(numerator, 64)
+	if err != nil {
+		return 0
+	}
+	d, err := strconv.ParseFloat(denominator, 64)
+	if err != nil || d == 0 {
+		return 0
+	}
+	return n / d
+}
@@ -0,0 +1,122 @@
+package exec
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/zibbp/ganymede/ent"
+)
+
+func hlsTestProbe(height int64, audio bool) *FFprobeJsonData {
+	width := height * 16 / 9
+	probe := &FFprobeJsonData{Streams: []FFprobestream{{CodecType: "video", CodecName: "h264", Width: &width, Height: &height}}}
+	if audio {
+		probe.Streams = append(probe.Streams, FFprobestream{CodecType: "audio", CodecName: "aac"})
+	}
+	return probe
+}
+
+func hlsRenditionNames(renditions []hlsRendition) string {
+	names := []string{}
+	for _, rendition := range renditions {
+		names = append(names, rendition.name)
+	}
+	return strings.Join(names, ",")
+}
+
+func TestHLSRenditions(t *testing.T) {
+	tests := []struct {
+		name   string
+		probe  *FFprobeJsonData
+		expect string
+	}{
+		{name: "1080p", probe: hlsTestProbe(1080, true), expect: "source,720p,480p,audio"},
+		{name: "720p", probe: hlsTestProbe(720, true), expect: "source,480p,audio"},
+		{name: "360p", probe: hlsTestProbe(360, true), expect: "source,audio"},
+		{name: "no audio", probe: hlsTestProbe(1080, false), expect: "source,720p,480p"},
+		{name: "audio only", probe: &FFprobeJsonData{Streams: []FFprobestream{{CodecType: "audio"}}}, expect: ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := hlsRenditionNames(hlsRenditions(tt.probe)); got != tt.expect {
+				t.Errorf("expected renditions %q, got %q", tt.expect, got)
+			}
+		})
+	}
+}
+
+func TestHLSRenditionArgs(t *testing.T) {
+	video := ent.Vod{ExtID: "123", TmpVideoConvertPath: "/tmp/123.mp4", TmpVideoHlsPath: "/tmp/123_hls"}
+	args := strings.Join(hlsRenditionArgs(video, []hlsRendition{hlsSourceRendition, hlsRenditionLadder[0], hlsAudioRendition}, true), " ")
+
+	for _, expected := range []string{
+		"-i /tmp/123.mp4",
+		"-c:v:0 copy -map 0:a:0 -c:a:0 copy",
+		"-filter:v:1 scale=-2:720 -c:v:1 libx264",
+		"-c:a:1 aac -b:a:1 128k",
+		"-c:a:2 aac -b:a:2 96k",
+		"-var_stream_map v:0,a:0,name:source v:1,a:1,name:720p a:2,name:audio",
+		"-hls_segment_filename /tmp/123_hls/123-%v_segment%d.ts",
+		"-f hls /tmp/123_hls/123-%v.m3u8",
+	} {
+		if !strings.Contains(args, expected) {
+			t.Errorf("expected args to contain %q, got %s", expected, args)
+		}
+	}
+	if strings.Count(args, "-map 0:v:0") != 2 {
+		t.Errorf("expected the audio rendition to have no video, got %s", args)
+	}
+}
+
+func TestHLSCodecs(t *testing.T) {
+	level := int64(42)
+	hevcLevel := int64(150)
+	tests := []struct {
+		name   string
+		probe  *FFprobeJsonData
+		expect string
+	}{
+		{
+			name: "h264 and aac",
+			probe: &FFprobeJsonData{Streams: []FFprobestream{
+				{CodecType: "video", CodecName: "h264", Profile: "High", Level: &level},
+				{CodecType: "audio", CodecName: "aac", Profile: "LC"},
+			}},
+			expect: "avc1.64002a,mp4a.40.2",
+		},
+		{
+			name:   "hevc",
+			probe:  &FFprobeJsonData{Streams: []FFprobestream{{CodecType: "video", CodecName: "hevc", Profile: "Main", Level: &hevcLevel}}},
+			expect: "hvc1.1.6.L150.B0",
+		},
+		{
+			name:   "audio only",
+			probe:  &FFprobeJsonData{Streams: []FFprobestream{{CodecType: "audio", CodecName: "aac", Profile: "LC"}, {CodecType: "data"}}},
+			expect: "mp4a.40.2",
+		},
+		{
+			name: "unknown codec",
+			probe: &FFprobeJsonData{Streams: []FFprobestream{
+				{CodecType: "video", CodecName: "vp9"},
+				{CodecType: "audio", CodecName: "aac"},
+			}},
+			expect: "",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := strings.Join(hlsCodecs(tt.probe), ","); got != tt.expect {
+				t.Errorf("expected codecs %q, got %q", tt.expect, got)
+			}
+		})
+	}
+}
+
+func TestParseFrameRate(t *testing.T) {
+	tests := map[string]float64{"60/1": 60, "30000/1001": 30000.0 / 1001, "25": 25, "0/0": 0, "": 0}
+	for input, expect := range tests {
+		if got := parseFrameRate(input); got != expect {
+			t.Errorf("parseFrameRate(%q) = %v, want %v", input, got, expect)
+		}
+	}
+}
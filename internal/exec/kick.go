@@ -0,0 +1,104 @@
+package exec
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/rs/zerolog/log"
+	"github.com/zibbp/ganymede/ent"
+	"github.com/zibbp/ganymede/internal/chat"
+	"github.com/zibbp/ganymede/internal/config"
+	"github.com/zibbp/ganymede/internal/exec/ytdlp"
+	"github.com/zibbp/ganymede/internal/platform"
+)
+
+// DownloadKickVideo downloads a Kick video. The m3u8 playlist of the video is resolved through the Kick API and downloaded with yt-dlp.
+func DownloadKickVideo(ctx context.Context, kc *platform.KickConnection, video ent.Vod) error {
+	env := config.GetEnvConfig()
+
+	// Open download log file
+	logFilePath := fmt.Sprintf("%s/%s-video.log", env.LogsDir, video.ID.String())
+	file, err := os.Create(logFilePath)
+	if err != nil {
+		return fmt.Errorf("failed to open log file: %w", err)
+	}
+	defer func() {
+		if err := file.Close(); err != nil {
+			log.Debug().Err(err).Msg("failed to close log file")
+		}
+	}()
+	log.Debug().Str("video_id", video.ID.String()).Msgf("logging output to %s", logFilePath)
+
+	playlistURL, err := kc.GetVideoPlaylistURL(ctx, video.ExtID)
+	if err != nil {
+		return fmt.Errorf("failed to get video playlist: %w", err)
+	}
+
+	ytdlpSvc := ytdlp.NewYtDlpService(ytdlp.YtDlpOptions{})
+
+	// Kick playlists contain combined audio and video streams like Twitch
+	qualityString := ytdlpSvc.CreateQualityOption(video.Resolution)
+	log.Info().Str("requested_quality", video.Resolution).Msgf("using quality option %s", qualityString)
+
+	return downloadVideoWithYtDlp(ctx, video, ytdlpSvc, playlistURL, qualityString, file)
+}
+
+// DownloadKickLiveVideo archives a Kick live stream with ffmpeg.
+func DownloadKickLiveVideo(ctx context.Context, kc *platform.KickConnection, video ent.Vod, channel ent.Channel, startChat chan bool, events LiveArchiveEvents) error {
+	video.Edges.Channel = &channel
+	env := config.GetEnvConfig()
+
+	// open video log file
+	logFilePath := fmt.Sprintf("%s/%s-video.log", env.LogsDir, video.ID.String())
+	file, err := os.Create(logFilePath)
+	if err != nil {
+		return fmt.Errorf("failed to open log file: %w", err)
+	}
+	defer func() {
+		if err := file.Close(); err != nil {
+			log.Debug().Err(err).Msg("failed to close log file")
+		}
+	}()
+
+	log.Debug().Str("video_id", video.ID.String()).Msgf("logging ffmpeg output to %s", logFilePath)
+
+	resolve := func(ctx context.Context, quality string) (liveVariant, error) {
+		masterPlaylist, err := kc.GetStream(ctx, channel.Name)
+		if err != nil {
+			return liveVariant{}, fmt.Errorf("failed to get stream: %v", err)
+		}
+		return selectLiveStreamVariant(quality, masterPlaylist), nil
+	}
+
+	return archiveLiveStream(ctx, video, channel, resolve, file, startChat, events)
+}
+
+// DownloadKickChat imports the chat replay of a Kick video. The chat is converted to the TwitchDownloader chat format with the emotes embedded.
+func DownloadKickChat(ctx context.Context, kc *platform.KickConnection, video ent.Vod) error {
+	videoChat, err := kc.GetVideoChat(ctx, video.ExtID)
+	if err != nil {
+		return fmt.Errorf("failed to get chat replay: %w", err)
+	}
+
+	kickChat := chat.ConvertKickChat(videoChat, video.ExtID)
+
+	err = chat.EmbedKickEmotes(ctx, &kickChat)
+	if err != nil {
+		return fmt.Errorf("failed to embed emotes: %w", err)
+	}
+
+	data, err := kickChat.Marshal()
+	if err != nil {
+		return fmt.Errorf("failed to marshal chat: %w", err)
+	}
+
+	err = os.WriteFile(video.TmpChatDownloadPath, data, 0644)
+	if err != nil {
+		return fmt.Errorf("failed to write chat file: %w", err)
+	}
+
+	log.Debug().Str("video_id", video.ID.String()).Int("comments", len(kickChat.Comments)).Msg("imported kick chat")
+
+	return nil
+}
@@ -0,0 +1,450 @@
+package exec
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	osExec "os/exec"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/rs/zerolog/log"
+	"github.com/zibbp/ganymede/ent"
+	"github.com/zibbp/ganymede/internal/hls"
+	"github.com/zibbp/ganymede/internal/utils"
+)
+
+const (
+	// liveArchiveFailureThreshold is the number of consecutive ffmpeg failures of a live stream variant
+	// before the archive switches to the next quality of the quality fallbacks.
+	liveArchiveFailureThreshold = 3
+	// liveArchiveRetryDelay is the delay before ffmpeg is restarted after a failure.
+	liveArchiveRetryDelay = 5 * time.Second
+)
+
+// errLiveQualitiesExhausted is returned when no other quality of the quality fallbacks is available.
+var errLiveQualitiesExhausted = errors.New("no other quality is available")
+
+// LiveArchiveEvents receives the events of a live stream archive. Nil events are ignored.
+type LiveArchiveEvents struct {
+	QualitySwitch func(utils.QualitySwitch) // the archive switched to another quality of the quality fallbacks
+	Gap           func(ent.LiveGap)         // the recording resumed after it dropped
+}
+
+// liveVariant is a playlist of a live stream.
+type liveVariant struct {
+	name      string // the quality of the variant, e.g. 1080p60 or audio_only
+	url       string
+	audioOnly bool
+}
+
+// liveVariantResolver returns the variant of the live stream closest to the quality. An error is returned
+// if the stream is not live.
+type liveVariantResolver func(ctx context.Context, quality string) (liveVariant, error)
+
+// nextLiveVariant resolves the qualities of the chain after index, returning the first which resolves to
+// a variant other than current. With sameLayout variants that are audio-only when current isn't, or the
+// other way around, are skipped.
+func nextLiveVariant(ctx context.Context, resolve liveVariantResolver, chain []string, index int, current liveVariant, sameLayout bool) (int, liveVariant, error) {
+	for i := index + 1; i < len(chain); i++ {
+		variant, err := resolve(ctx, chain[i])
+		if err != nil {
+			return 0, liveVariant{}, err
+		}
+		if variant.url == "" || variant.name == current.name {
+			continue
+		}
+		if sameLayout && variant.audioOnly != current.audioOnly {
+			continue
+		}
+		return i, variant, nil
+	}
+	return 0, liveVariant{}, errLiveQualitiesExhausted
+}
+
+// reconnectLiveStream resolves the quality until the live stream is available again or the reconnect window
+// has passed, returning whether the stream was unavailable in the meantime. The quality is resolved at least
+// once.
+func reconnectLiveStream(ctx context.Context, resolve liveVariantResolver, quality string, window time.Duration) (liveVariant, bool, error) {
+	deadline := time.Now().Add(window)
+	offline := false
+	for {
+		select {
+		case <-ctx.Done():
+			return liveVariant{}, offline, ctx.Err()
+		case <-time.After(liveArchiveRetryDelay):
+		}
+
+		variant, err := resolve(ctx, quality)
+		if err == nil && variant.url != "" {
+			return variant, offline, nil
+		}
+		offline = true
+		if !time.Now().Before(deadline) {
+			if err == nil {
+				err = fmt.Errorf("quality %s is not available", quality)
+			}
+			return liveVariant{}, offline, err
+		}
+	}
+}
+
+// liveArchivedSeconds returns the seconds of the stream in the archive after an attempt archived to output,
+// given the seconds archived before it. The wall-clock time of the attempt is used if the archive can't be
+// probed.
+func liveArchivedSeconds(ctx context.Context, video ent.Vod, output string, archived float64, elapsed time.Duration) float64 {
+	if video.VideoHlsPath != "" {
+		playlist, err := os.Open(fmt.Sprintf("%s/%s-video.m3u8", video.TmpVideoHlsPath, video.ExtID))
+		if err == nil {
+			defer playlist.Close()
+			if seconds, err := hls.MediaPlaylistDuration(playlist); err == nil {
+				return seconds
+			}
+		}
+	} else if duration, err := ProbeMediaDuration(ctx, output); err == nil && duration.Duration > 0 {
+		return archived + duration.Duration
+	}
+	return archived + elapsed.Seconds()
+}
+
+// reportLiveGap notes the gap from stoppedAt until now in the video log and reports it.
+func reportLiveGap(file *os.File, events LiveArchiveEvents, video ent.Vod, reason utils.LiveGapReason, stoppedAt time.Time, offset float64) {
+	gap := ent.LiveGap{VodID: video.ID, Reason: reason, StartedAt: stoppedAt, EndedAt: time.Now(), Offset: offset}
+	log.Info().Str("video_id", video.ID.String()).Str("reason", string(reason)).Float64("offset", offset).Dur("duration", gap.EndedAt.Sub(gap.StartedAt)).Msg("resuming live archive after gap")
+	writeLiveArchiveNote(file, gap.EndedAt, fmt.Sprintf("resuming after a %s gap of %s at %s of the archive", reason, gap.EndedAt.Sub(gap.StartedAt).Round(time.Second), time.Duration(offset*float64(time.Second)).Round(time.Second)))
+	if events.Gap != nil {
+		events.Gap(gap)
+	}
+}
+
+// writeLiveArchiveNote writes a note between the ffmpeg output in the video log.
+func writeLiveArchiveNote(file *os.File, t time.Time, note string) {
+	if _, err := fmt.Fprintf(file, "\n[ganymede] %s: %s\n\n", t.Format(time.RFC3339), note); err != nil {
+		log.Debug().Err(err).Msg("failed to write note to log file")
+	}
+}
+
+// liveArchiveAttemptPath returns the path ffmpeg archives the live stream to. Attempts after the first are
+// archived to a part file which is stitched to the archive in post-process, HLS archives are appended to by
+// ffmpeg.
+func liveArchiveAttemptPath(video ent.Vod, attempt int) string {
+	if attempt == 0 || video.VideoHlsPath != "" {
+		return video.TmpVideoDownloadPath
+	}
+	return fmt.Sprintf("%s.part%d", video.TmpVideoDownloadPath, attempt)
+}
+
+// liveArchiveParts returns the part files of the archive in the order they were archived.
+func liveArchiveParts(path string) ([]string, error) {
+	matches, err := filepath.Glob(globEscape(path) + ".part*")
+	if err != nil {
+		return nil, err
+	}
+	parts := []string{}
+	numbers := map[string]int{}
+	for _, match := range matches {
+		number, err := strconv.Atoi(strings.TrimPrefix(match, path+".part"))
+		if err != nil {
+			continue
+		}
+		numbers[match] = number
+		parts = append(parts, match)
+	}
+	sort.Slice(parts, func(i, j int) bool { return numbers[parts[i]] < numbers[parts[j]] })
+	return parts, nil
+}
+
+// globEscape escapes the special characters of filepath.Match in the path.
+func globEscape(path string) string {
+	var b strings.Builder
+	for _, r := range path {
+		if strings.ContainsRune(`*?[\`, r) {
+			b.WriteRune('\\')
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
+
+// liveArchiveLayout is the streams of a live archive file. Files are only stitched if their layouts match,
+// the concat demuxer requires every file to have the streams of the first.
+type liveArchiveLayout struct {
+	videoCodec string // empty if the file has no video
+	width      int64
+	height     int64
+	pixFmt     string
+	frameRate  string
+	audioCodec string // empty if the file has no audio
+	sampleRate string
+	channels   int64
+}
+
+// matches reports whether files with the layouts can be stitched without re-encoding.
+func (l liveArchiveLayout) matches(other liveArchiveLayout) bool {
+	return l.videoCodec == other.videoCodec && l.width == other.width && l.height == other.height && l.pixFmt == other.pixFmt &&
+		l.audioCodec == other.audioCodec && l.sampleRate == other.sampleRate && l.channels == other.channels
+}
+
+// probeLiveArchiveLayout returns the layout of the first video and audio stream of the file.
+func probeLiveArchiveLayout(ctx context.Context, path string) (liveArchiveLayout, error) {
+	data, err := GetFfprobeVideoData(ctx, path)
+	if err != nil {
+		return liveArchiveLayout{}, err
+	}
+	layout := liveArchiveLayout{}
+	for _, stream := range data.Streams {
+		switch {
+		case stream.CodecType == "video" && layout.videoCodec == "":
+			layout.videoCodec = stream.CodecName
+			layout.width = derefOr(stream.Width, 0)
+			layout.height = derefOr(stream.Height, 0)
+			layout.pixFmt = derefOr(stream.PixFmt, "")
+			layout.frameRate = stream.RFrameRate
+		case stream.CodecType == "audio" && layout.audioCodec == "":
+			layout.audioCodec = stream.CodecName
+			layout.sampleRate = derefOr(stream.SampleRate, "")
+			layout.channels = derefOr(stream.Channels, 0)
+		}
+	}
+	if layout.videoCodec == "" && layout.audioCodec == "" {
+		return liveArchiveLayout{}, fmt.Errorf("no audio or video streams found in %s", path)
+	}
+	return layout, nil
+}
+
+func derefOr[T any](value *T, fallback T) T {
+	if value == nil {
+		return fallback
+	}
+	return *value
+}
+
+// conformLiveArchivePartArgs returns the ffmpeg arguments re-encoding the part with the layout to output
+// with the layout want. Missing video is filled with black frames and missing audio with silence.
+func conformLiveArchivePartArgs(part string, layout liveArchiveLayout, want liveArchiveLayout, output string) []string {
+	args := []string{"-y", "-hide_banner", "-i", part}
+	inputs, generated := 1, false
+	videoInput, audioInput := "0:v:0", "0:a:0"
+	if want.videoCodec != "" && layout.videoCodec == "" {
+		frameRate := want.frameRate
+		if frameRate == "" || frameRate == "0/0" {
+			frameRate = "30"
+		}
+		args = append(args, "-f", "lavfi", "-i", fmt.Sprintf("color=c=black:s=%dx%d:r=%s", want.width, want.height, frameRate))
+		videoInput = fmt.Sprintf("%d:v:0", inputs)
+		inputs++
+		generated = true
+	}
+	if want.audioCodec != "" && layout.audioCodec == "" {
+		source := "anullsrc"
+		if want.sampleRate != "" {
+			source += "=sample_rate=" + want.sampleRate
+		}
+		args = append(args, "-f", "lavfi", "-i", source)
+		audioInput = fmt.Sprintf("%d:a:0", inputs)
+		generated = true
+	}
+
+	if want.videoCodec != "" {
+		args = append(args, "-map", videoInput, "-c:v", want.videoCodec, "-s", fmt.Sprintf("%dx%d", want.width, want.height))
+		if want.pixFmt != "" {
+			args = append(args, "-pix_fmt", want.pixFmt)
+		}
+	}
+	if want.audioCodec != "" {
+		args = append(args, "-map", audioInput, "-c:a", want.audioCodec)
+		if want.sampleRate != "" {
+			args = append(args, "-ar", want.sampleRate)
+		}
+		if want.channels > 0 {
+			args = append(args, "-ac", strconv.FormatInt(want.channels, 10))
+		}
+	}
+	// the generated inputs never end
+	if generated {
+		args = append(args, "-shortest")
+	}
+	return append(args, "-f", "mpegts", output)
+}
+
+// conformedLiveArchivePartPath returns the path of the part re-encoded to the layout of the archive.
+func conformedLiveArchivePartPath(part string) string {
+	return part + ".conformed"
+}
+
+// conformLiveArchivePart re-encodes the part to the layout want, returning the path of the re-encoded part.
+// A part re-encoded before stitching was interrupted is reused.
+func conformLiveArchivePart(ctx context.Context, part string, layout liveArchiveLayout, want liveArchiveLayout) (string, error) {
+	conformedPath := conformedLiveArchivePartPath(part)
+	if utils.FileExists(conformedPath) {
+		return conformedPath, nil
+	}
+	log.Info().Str("part", part).Msg("re-encoding live archive part with different streams")
+
+	tmpPath := conformedPath + ".tmp"
+	cmd := osExec.CommandContext(ctx, "ffmpeg", conformLiveArchivePartArgs(part, layout, want, tmpPath)...)
+	if out, err := cmd.CombinedOutput(); err != nil {
+		log.Error().Err(err).Str("ffmpeg_output", string(out)).Msg("error re-encoding live archive part")
+		return "", fmt.Errorf("error re-encoding live archive part: %w", err)
+	}
+	if err := os.Rename(tmpPath, conformedPath); err != nil {
+		return "", err
+	}
+	return conformedPath, nil
+}
+
+// StitchLiveArchive stitches the part files of a live stream archive resumed after gaps into the archive
+// with continuous timestamps. Archives without part files are left unchanged. It is safe to call again if
+// stitching was interrupted.
+//
+// Parts with other streams than the first file, e.g. after the archive switched to another quality, are
+// re-encoded to its streams first. Files without streams are left out.
+func StitchLiveArchive(ctx context.Context, path string) error {
+	stitchedPath := path + ".stitched"
+	parts, err := liveArchiveParts(path)
+	if err != nil {
+		return err
+	}
+
+	if !utils.FileExists(stitchedPath) {
+		if len(parts) == 0 {
+			return nil
+		}
+		log.Info().Str("path", path).Int("parts", len(parts)).Msg("stitching live archive parts")
+
+		files := []string{}
+		var want *liveArchiveLayout
+		for _, file := range append([]string{path}, parts...) {
+			layout, err := probeLiveArchiveLayout(ctx, file)
+			if err != nil {
+				log.Warn().Err(err).Str("file", file).Msg("leaving live archive file without streams out of the stitched archive")
+				continue
+			}
+			if want == nil {
+				want = &layout
+			} else if !layout.matches(*want) {
+				if file, err = conformLiveArchivePart(ctx, file, layout, *want); err != nil {
+					return err
+				}
+			}
+			files = append(files, file)
+		}
+		if len(files) == 0 {
+			return fmt.Errorf("no live archive file of %s has streams", path)
+		}
+
+		listPath := path + ".concat.txt"
+		var list strings.Builder
+		for _, file := range files {
+			list.WriteString("file '" + strings.ReplaceAll(file, "'", `'\''`) + "'\n")
+		}
+		if err := os.WriteFile(listPath, []byte(list.String()), 0644); err != nil {
+			return err
+		}
+		defer os.Remove(listPath)
+
+		tmpPath := stitchedPath + ".tmp"
+		cmd := osExec.CommandContext(ctx, "ffmpeg", "-y", "-hide_banner", "-f", "concat", "-safe", "0", "-i", listPath, "-map", "0", "-c", "copy", "-f", "mpegts", tmpPath)
+		if out, err := cmd.CombinedOutput(); err != nil {
+			log.Error().Err(err).Str("ffmpeg_output", string(out)).Msg("error stitching live archive parts")
+			return fmt.Errorf("error stitching live archive parts: %w", err)
+		}
+		// the stitched archive is complete once renamed, the parts are only removed after that
+		if err := os.Rename(tmpPath, stitchedPath); err != nil {
+			return err
+		}
+	}
+
+	for _, part := range parts {
+		for _, file := range []string{part, conformedLiveArchivePartPath(part)} {
+			if err := os.Remove(file); err != nil && !os.IsNotExist(err) {
+				return err
+			}
+		}
+	}
+	return os.Rename(stitchedPath, path)
+}
+
+// appendLiveArchivePart appends the part file to the archive and removes it.
+func appendLiveArchivePart(path string, partPath string) error {
+	part, err := os.Open(partPath)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+		return err
+	}
+	defer part.Close()
+
+	archive, err := os.OpenFile(path, os.O_WRONLY|os.O_APPEND|os.O_CREATE, 0644)
+	if err != nil {
+		return err
+	}
+	if _, err := io.Copy(archive, part); err != nil {
+		_ = archive.Close()
+		return err
+	}
+	if err := archive.Close(); err != nil {
+		return err
+	}
+	return os.Remove(partPath)
+}
+
+// liveAudioArgs returns the ffmpeg arguments recording the audio of the live stream variant to output as ADTS.
+func liveAudioArgs(variant liveVariant, output string) []string {
+	return []string{
+		"-y",
+		"-hide_banner",
+		"-fflags", "+genpts+discardcorrupt",
+		"-rw_timeout", "30000000",
+		"-timeout", "30000000",
+		"-i", variant.url,
+		"-map", "0:a:0",
+		"-vn",
+		"-c:a", "copy",
+		"-f", "adts",
+		output,
+	}
+}
+
+// recordLiveAudio records the audio of the live stream to the temporary audio path of the video until the
+// stream ends or the context is cancelled. The audio-only variant is preferred, ffmpeg is restarted while
+// the stream is live.
+func recordLiveAudio(ctx context.Context, video ent.Vod, channel ent.Channel, resolve liveVariantResolver, file *os.File) {
+	for attempt := 0; ; attempt++ {
+		variant, err := resolve(ctx, "audio")
+		if err == nil && variant.url == "" {
+			// streams without an audio-only variant are recorded from the audio of the best variant
+			variant, err = resolve(ctx, "best")
+		}
+		if err != nil {
+			log.Info().Err(err).Str("video_id", video.ID.String()).Msg("stopped recording live audio")
+			return
+		}
+
+		output := video.TmpAudioPath
+		if attempt > 0 {
+			output = fmt.Sprintf("%s.part%d", video.TmpAudioPath, attempt)
+		}
+		err = runLiveArchiveCommand(ctx, liveAudioArgs(variant, output), file, channel)
+		if output != video.TmpAudioPath {
+			if appendErr := appendLiveArchivePart(video.TmpAudioPath, output); appendErr != nil {
+				log.Error().Err(appendErr).Str("video_id", video.ID.String()).Msg("error appending live audio part")
+			}
+		}
+		if err == nil || ctx.Err() != nil {
+			return
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(liveArchiveRetryDelay):
+		}
+	}
+}
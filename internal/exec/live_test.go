@@ -0,0 +1,182 @@
+package exec
+
+import (
+	"context"
+	"errors"
+	"os"
+	osExec "os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/zibbp/ganymede/ent"
+)
+
+func TestNextLiveVariant(t *testing.T) {
+	variants := map[string]liveVariant{
+		"1080p60": {name: "1080p60", url: "https://example.com/1080p60.m3u8"},
+		"720p60":  {name: "1080p60", url: "https://example.com/1080p60.m3u8"}, // no 720p60 variant, closest is 1080p60
+		"audio":   {name: "audio_only"},
+		"best":    {name: "chunked", url: "https://example.com/chunked.m3u8"},
+	}
+	resolve := func(ctx context.Context, quality string) (liveVariant, error) {
+		return variants[quality], nil
+	}
+	chain := []string{"1080p60", "720p60", "audio", "best"}
+
+	index, variant, err := nextLiveVariant(context.Background(), resolve, chain, 0, variants["1080p60"], false)
+	if err != nil {
+		t.Fatalf("next live variant: %v", err)
+	}
+	if index != 3 || variant.name != "chunked" {
+		t.Errorf("expected to switch to chunked at index 3, got %s at index %d", variant.name, index)
+	}
+
+	if _, _, err := nextLiveVariant(context.Background(), resolve, chain, 3, variants["best"], false); err == nil {
+		t.Error("expected an error when the chain is exhausted")
+	}
+
+	offline := func(ctx context.Context, quality string) (liveVariant, error) {
+		return liveVariant{}, errors.New("stream is offline")
+	}
+	if _, _, err := nextLiveVariant(context.Background(), offline, chain, 0, variants["1080p60"], false); err == nil || err.Error() != "stream is offline" {
+		t.Errorf("expected the offline error, got %v", err)
+	}
+
+	// MPEG-TS archives keep the streams of the first variant
+	variants["audio"] = liveVariant{name: "audio_only", url: "https://example.com/audio_only.m3u8", audioOnly: true}
+	if _, _, err := nextLiveVariant(context.Background(), resolve, []string{"1080p60", "audio"}, 0, variants["1080p60"], true); !errors.Is(err, errLiveQualitiesExhausted) {
+		t.Errorf("expected the audio-only variant to be skipped, got %v", err)
+	}
+	index, variant, err = nextLiveVariant(context.Background(), resolve, []string{"1080p60", "audio"}, 0, variants["1080p60"], false)
+	if err != nil || index != 1 || !variant.audioOnly {
+		t.Errorf("expected to switch to the audio-only variant of HLS archives, got %s at index %d: %v", variant.name, index, err)
+	}
+}
+
+func TestLiveArchiveAttemptPath(t *testing.T) {
+	video := ent.Vod{TmpVideoDownloadPath: "/tmp/123-video.ts"}
+	if got := liveArchiveAttemptPath(video, 0); got != "/tmp/123-video.ts" {
+		t.Errorf("expected the first attempt to archive to the download path, got %s", got)
+	}
+	if got := liveArchiveAttemptPath(video, 2); got != "/tmp/123-video.ts.part2" {
+		t.Errorf("expected a part file, got %s", got)
+	}
+
+	video.VideoHlsPath = "/videos/123-video_hls"
+	video.TmpVideoDownloadPath = "/tmp/123_hls0/123-video.m3u8"
+	if got := liveArchiveAttemptPath(video, 2); got != video.TmpVideoDownloadPath {
+		t.Errorf("expected HLS archives to be appended to by ffmpeg, got %s", got)
+	}
+}
+
+func TestAppendLiveArchivePart(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "123-video.ts")
+	partPath := path + ".part1"
+	if err := os.WriteFile(path, []byte("first"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(partPath, []byte("second"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := appendLiveArchivePart(path, partPath); err != nil {
+		t.Fatalf("append live archive part: %v", err)
+	}
+	contents, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(contents) != "firstsecond" {
+		t.Errorf("expected the part to be appended, got %q", contents)
+	}
+	if _, err := os.Stat(partPath); !os.IsNotExist(err) {
+		t.Errorf("expected the part file to be removed, got %v", err)
+	}
+
+	// ffmpeg may fail before creating the part file
+	if err := appendLiveArchivePart(path, partPath); err != nil {
+		t.Errorf("expected a missing part file to be ignored, got %v", err)
+	}
+}
+
+func TestLiveArchiveParts(t *testing.T) {
+	// brackets would be a character class if the path wasn't escaped
+	path := filepath.Join(t.TempDir(), "[123]-video.mp4")
+	for _, name := range []string{path, path + ".part10", path + ".part2", path + ".part1", path + ".part3.tmp"} {
+		if err := os.WriteFile(name, nil, 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	parts, err := liveArchiveParts(path)
+	if err != nil {
+		t.Fatalf("live archive parts: %v", err)
+	}
+	expect := []string{path + ".part1", path + ".part2", path + ".part10"}
+	if strings.Join(parts, ",") != strings.Join(expect, ",") {
+		t.Errorf("expected parts %v, got %v", expect, parts)
+	}
+}
+
+func TestLiveAudioArgs(t *testing.T) {
+	args := strings.Join(liveAudioArgs(liveVariant{url: "https://example.com/audio.m3u8"}, "/tmp/123-audio.aac"), " ")
+	if !strings.Contains(args, "-i https://example.com/audio.m3u8 -map 0:a:0 -vn -c:a copy -f adts /tmp/123-audio.aac") {
+		t.Errorf("unexpected audio args %s", args)
+	}
+}
+
+func TestStitchLiveArchiveReencodesPartsWithOtherStreams(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "123-video.ts")
+	createLiveArchiveFile(t, path, true)
+	// the archive switched to the audio-only quality
+	createLiveArchiveFile(t, path+".part1", false)
+
+	if err := StitchLiveArchive(t.Context(), path); err != nil {
+		t.Fatalf("stitch live archive: %v", err)
+	}
+
+	layout, err := probeLiveArchiveLayout(t.Context(), path)
+	if err != nil {
+		t.Fatalf("probe stitched archive: %v", err)
+	}
+	if layout.videoCodec != "h264" || layout.width != 320 || layout.height != 180 || layout.audioCodec != "aac" {
+		t.Errorf("expected the streams of the first file, got %+v", layout)
+	}
+	probe, err := ProbeMediaDuration(t.Context(), path)
+	if err != nil {
+		t.Fatalf("probe stitched archive duration: %v", err)
+	}
+	if probe.FormatDuration < 3.5 || probe.FormatDuration > 5 {
+		t.Errorf("stitched duration = %f, want about 4 seconds", probe.FormatDuration)
+	}
+	decode := osExec.Command("ffmpeg", "-v", "error", "-i", path, "-f", "null", "-")
+	if out, err := decode.CombinedOutput(); err != nil {
+		t.Fatalf("decode stitched archive: %v, output: %s", err, out)
+	}
+	for _, leftover := range []string{path + ".part1", conformedLiveArchivePartPath(path + ".part1"), path + ".stitched"} {
+		if _, err := os.Stat(leftover); !os.IsNotExist(err) {
+			t.Errorf("expected %s to be removed, got %v", leftover, err)
+		}
+	}
+}
+
+// createLiveArchiveFile creates two seconds of MPEG-TS with audio, and video if withVideo is set.
+func createLiveArchiveFile(t *testing.T, path string, withVideo bool) {
+	t.Helper()
+
+	args := []string{"-v", "error", "-y"}
+	if withVideo {
+		args = append(args, "-f", "lavfi", "-i", "testsrc=size=320x180:rate=30:duration=2")
+	}
+	args = append(args, "-f", "lavfi", "-i", "sine=frequency=1000:sample_rate=48000:duration=2")
+	if withVideo {
+		args = append(args, "-c:v", "libx264", "-preset", "ultrafast", "-pix_fmt", "yuv420p")
+	}
+	args = append(args, "-c:a", "aac", "-f", "mpegts", path)
+	if out, err := osExec.Command("ffmpeg", args...).CombinedOutput(); err != nil {
+		t.Fatalf("create live archive file: %v, output: %s", err, out)
+	}
+}
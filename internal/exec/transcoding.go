@@ -0,0 +1,122 @@
+package exec
+
+import (
+	"context"
+	"fmt"
+	"os"
+	osExec "os/exec"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/rs/zerolog/log"
+	"github.com/zibbp/ganymede/ent"
+	"github.com/zibbp/ganymede/internal/config"
+)
+
+// validateVideoConvertArgsTimeout is how long the test conversion of ValidateVideoConvertArgs may take.
+const validateVideoConvertArgsTimeout = 60 * time.Second
+
+// reservedVideoConvertArgs are set by Ganymede when post-processing a video and can't be overridden.
+var reservedVideoConvertArgs = []string{"-i", "-f", "-y", "-n"}
+
+// VideoConvertArgs returns the FFmpeg arguments the video is post-processed with. These are the arguments of the
+// transcoding profile of the video if it is loaded, and the global video convert arguments otherwise.
+func VideoConvertArgs(video ent.Vod) string {
+	if video.Edges.TranscodingProfile != nil {
+		return video.Edges.TranscodingProfile.FfmpegArgs
+	}
+	return config.Get().Parameters.VideoConvert
+}
+
+// ReencodeVideo converts the archived video at input to output with the FFmpeg arguments the same way an
+// archive is post-processed. The FFmpeg output is written to the video convert log of the video.
+func ReencodeVideo(ctx context.Context, video ent.Vod, input string, output string, args string) error {
+	logFilePath := fmt.Sprintf("%s/%s-video-convert.log", config.GetEnvConfig().LogsDir, video.ID.String())
+	file, err := os.Create(logFilePath)
+	if err != nil {
+		return fmt.Errorf("failed to open log file: %w", err)
+	}
+	defer func() {
+		if err := file.Close(); err != nil {
+			log.Debug().Err(err).Msg("failed to close log file")
+		}
+	}()
+
+	video.TmpVideoDownloadPath = input
+	video.TmpVideoConvertPath = output
+	return postProcessVideo(ctx, video, args, file)
+}
+
+// checkVideoConvertArgs returns an error if the arguments set the input or output of FFmpeg.
+func checkVideoConvertArgs(args string) error {
+	for _, arg := range strings.Fields(args) {
+		for _, reserved := range reservedVideoConvertArgs {
+			if arg == reserved {
+				return fmt.Errorf("argument %s is not allowed", arg)
+			}
+		}
+	}
+	return nil
+}
+
+// ValidateVideoConvertArgs post-processes a short test video with the FFmpeg arguments and returns an error if
+// FFmpeg fails or the arguments write another file.
+func ValidateVideoConvertArgs(ctx context.Context, args string) error {
+	if err := checkVideoConvertArgs(args); err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, validateVideoConvertArgsTimeout)
+	defer cancel()
+
+	tmpDir, err := os.MkdirTemp(config.GetEnvConfig().TempDir, "transcoding-profile-")
+	if err != nil {
+		return fmt.Errorf("error creating temporary directory: %w", err)
+	}
+	defer os.RemoveAll(tmpDir)
+
+	video := ent.Vod{
+		Title:                "transcoding profile test",
+		TmpVideoDownloadPath: filepath.Join(tmpDir, "input.ts"),
+		TmpVideoConvertPath:  filepath.Join(tmpDir, "output.mp4"),
+	}
+	// the test video is encoded like a Twitch stream
+	input := osExec.CommandContext(ctx, "ffmpeg", "-v", "error", "-y",
+		"-f", "lavfi", "-i", "testsrc=size=640x360:rate=30:duration=2",
+		"-f", "lavfi", "-i", "sine=frequency=1000:sample_rate=48000:duration=2",
+		"-c:v", "libx264", "-preset", "ultrafast", "-pix_fmt", "yuv420p", "-c:a", "aac",
+		"-f", "mpegts", video.TmpVideoDownloadPath,
+	)
+	if out, err := input.CombinedOutput(); err != nil {
+		return fmt.Errorf("error creating test video: %w: %s", err, strings.TrimSpace(string(out)))
+	}
+
+	var out strings.Builder
+	cmd := osExec.CommandContext(ctx, "ffmpeg", postProcessVideoFFmpegArgs(video, args)...)
+	// relative output paths in the arguments are written to the temporary directory
+	cmd.Dir = tmpDir
+	cmd.Stdout = &out
+	cmd.Stderr = &out
+	if err := cmd.Run(); err != nil {
+		if ctx.Err() != nil {
+			return fmt.Errorf("test conversion did not finish in %s", validateVideoConvertArgsTimeout)
+		}
+		return fmt.Errorf("invalid ffmpeg arguments: %s", lastLine(out.String()))
+	}
+
+	entries, err := os.ReadDir(tmpDir)
+	if err != nil {
+		return fmt.Errorf("error reading temporary directory: %w", err)
+	}
+	if len(entries) != 2 {
+		return fmt.Errorf("arguments must not contain an output file")
+	}
+	return nil
+}
+
+// lastLine returns the last non-empty line of the output, which is the error for FFmpeg.
+func lastLine(output string) string {
+	lines := strings.Split(strings.TrimSpace(output), "\n")
+	return strings.TrimSpace(lines[len(lines)-1])
+}
@@ -0,0 +1,29 @@
+package exec
+
+import "testing"
+
+func TestCheckVideoConvertArgs(t *testing.T) {
+	t.Parallel()
+
+	for _, args := range []string{
+		"",
+		"-c:v libx265 -crf 26 -tag:v hvc1 -c:a copy",
+		"-vf scale=-2:720 -c:v libx264 -preset veryfast",
+	} {
+		if err := checkVideoConvertArgs(args); err != nil {
+			t.Fatalf("checkVideoConvertArgs(%q) = %v, want nil", args, err)
+		}
+	}
+
+	// the input and output are set when post-processing
+	for args, want := range map[string]string{
+		"-i /tmp/other.mp4 -c copy": "argument -i is not allowed",
+		"-c copy -f matroska":       "argument -f is not allowed",
+		"-n -c copy":                "argument -n is not allowed",
+	} {
+		err := checkVideoConvertArgs(args)
+		if err == nil || err.Error() != want {
+			t.Fatalf("checkVideoConvertArgs(%q) = %v, want %q", args, err, want)
+		}
+	}
+}
@@ -0,0 +1,77 @@
+package exec
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/rs/zerolog/log"
+	"github.com/zibbp/ganymede/ent"
+	"github.com/zibbp/ganymede/internal/config"
+	"github.com/zibbp/ganymede/internal/exec/ytdlp"
+	"github.com/zibbp/ganymede/internal/utils"
+)
+
+// DownloadYoutubeVideo downloads a YouTube video. YouTube serves separate video and audio streams which yt-dlp merges into a single file.
+func DownloadYoutubeVideo(ctx context.Context, video ent.Vod) error {
+	env := config.GetEnvConfig()
+
+	// Open download log file
+	logFilePath := fmt.Sprintf("%s/%s-video.log", env.LogsDir, video.ID.String())
+	file, err := os.Create(logFilePath)
+	if err != nil {
+		return fmt.Errorf("failed to open log file: %w", err)
+	}
+	defer func() {
+		if err := file.Close(); err != nil {
+			log.Debug().Err(err).Msg("failed to close log file")
+		}
+	}()
+	log.Debug().Str("video_id", video.ID.String()).Msgf("logging output to %s", logFilePath)
+
+	ytdlpSvc := ytdlp.NewYtDlpService(ytdlp.YtDlpOptions{})
+
+	qualityString := ytdlpSvc.CreateSplitQualityOption(video.Resolution)
+	log.Info().Str("requested_quality", video.Resolution).Msgf("using quality option %s", qualityString)
+
+	return downloadVideoWithYtDlp(ctx, video, ytdlpSvc, utils.CreateYoutubeURL(video.ExtID), qualityString, file)
+}
+
+// DownloadYoutubeLiveVideo archives a YouTube live stream. yt-dlp is used to resolve the HLS playlist of the requested quality which is then archived with ffmpeg.
+func DownloadYoutubeLiveVideo(ctx context.Context, video ent.Vod, channel ent.Channel, startChat chan bool, events LiveArchiveEvents) error {
+	video.Edges.Channel = &channel
+	env := config.GetEnvConfig()
+
+	// open video log file
+	logFilePath := fmt.Sprintf("%s/%s-video.log", env.LogsDir, video.ID.String())
+	file, err := os.Create(logFilePath)
+	if err != nil {
+		return fmt.Errorf("failed to open log file: %w", err)
+	}
+	defer func() {
+		if err := file.Close(); err != nil {
+			log.Debug().Err(err).Msg("failed to close log file")
+		}
+	}()
+
+	log.Debug().Str("video_id", video.ID.String()).Msgf("logging ffmpeg output to %s", logFilePath)
+
+	ytdlpSvc := ytdlp.NewYtDlpService(ytdlp.YtDlpOptions{})
+	resolve := func(ctx context.Context, quality string) (liveVariant, error) {
+		qualityString := ytdlpSvc.CreateSplitQualityOption(quality)
+		log.Info().Str("requested_quality", quality).Msgf("using quality option %s", qualityString)
+
+		streamURL, err := ytdlpSvc.GetStreamURL(ctx, utils.CreateYoutubeURL(video.ExtID), qualityString)
+		if err != nil {
+			return liveVariant{}, fmt.Errorf("failed to get stream: %v", err)
+		}
+		return liveVariant{
+			name: qualityString,
+			url:  streamURL,
+			// live stream playlists contain both video and audio so audio only archives only map the audio stream
+			audioOnly: quality == "audio" || quality == "audio_only",
+		}, nil
+	}
+
+	return archiveLiveStream(ctx, video, channel, resolve, file, startChat, events)
+}
@@ -175,7 +175,15 @@ func NewYtDlpService(options YtDlpOptions) *YtDlpService {
 
 // YtDlpGetVideoInfo retrieves video information using yt-dlp for a given video entity.
 func (s *YtDlpService) GetVideoInfo(ctx context.Context, video ent.Vod) (*YTDLPVideoInfo, error) {
-	url := utils.CreateTwitchURL(video.ExtID, video.Type, video.Edges.Channel.Name)
+	var url string
+	switch video.Platform {
+	case utils.PlatformYoutube:
+		url = utils.CreateYoutubeURL(video.ExtID)
+	case utils.PlatformKick:
+		url = utils.CreateKickURL(video.ExtID, video.Type, video.Edges.Channel.Name)
+	default:
+		url = utils.CreateTwitchURL(video.ExtID, video.Type, video.Edges.Channel.Name)
+	}
 
 	args := []string{"-q", "-j", url}
 	log.Info().Msgf("running yt-dlp with args: %s", strings.Join(args, " "))
@@ -339,3 +347,61 @@ func (s *YtDlpService) CreateQualityOption(quality string) string {
 	// Fallback: match up to resolution
 	return fmt.Sprintf("best[height<=?%s]/best", quality)
 }
+
+// CreateSplitQualityOption creates a yt-dlp format string for platforms such as YouTube
+// which serve separate video and audio streams for videos. The best video stream up to the
+// requested resolution is merged with the best audio stream, falling back to a combined stream.
+func (s *YtDlpService) CreateSplitQualityOption(quality string) string {
+	switch quality {
+	case "", "best":
+		return "bestvideo+bestaudio/best"
+	case "audio", "audio_only":
+		return "bestaudio/best"
+	}
+
+	// Match resolution from formats like "1080p60", "1080p" or "1080"
+	re := regexp.MustCompile(`^(\d+)(?:[pP]\d*)?$`)
+	if matches := re.FindStringSubmatch(quality); len(matches) > 1 {
+		res := matches[1]
+		return fmt.Sprintf("bestvideo[height<=?%s]+bestaudio/best[height<=?%s]/best", res, res)
+	}
+
+	return "bestvideo+bestaudio/best"
+}
+
+// GetStreamURL returns the direct media URL of the format selected by the quality option.
+// For live streams this is the HLS playlist of the selected variant.
+func (s *YtDlpService) GetStreamURL(ctx context.Context, url string, quality string) (string, error) {
+	args := []string{"-q", "--no-warnings", "-f", quality, "-g", url}
+
+	cmd, cookieFile, err := s.CreateCommand(ctx, args, true)
+	defer func() {
+		if cookieFile != nil {
+			if err := cookieFile.Close(); err != nil {
+				log.Debug().Err(err).Msg("failed to close cookies file")
+			}
+			if err := os.Remove(cookieFile.Name()); err != nil {
+				log.Debug().Err(err).Msg("failed to remove cookies file")
+			}
+		}
+	}()
+	if err != nil {
+		return "", fmt.Errorf("error creating yt-dlp command: %w", err)
+	}
+
+	var stdout, stderr bytes.Buffer
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		log.Error().Err(err).Str("stderr", stderr.String()).Msg("error running yt-dlp")
+		return "", fmt.Errorf("error running yt-dlp: %w", err)
+	}
+
+	// a URL is printed per selected stream, only the first is used
+	streamURL := strings.TrimSpace(strings.SplitN(stdout.String(), "\n", 2)[0])
+	if streamURL == "" {
+		return "", fmt.Errorf("yt-dlp did not return a stream url")
+	}
+
+	return streamURL, nil
+}
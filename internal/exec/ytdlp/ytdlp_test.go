@@ -120,3 +120,29 @@ func TestYTDLPVideoInfo_CreateQualityOption(t *testing.T) {
 		})
 	}
 }
+
+// TestYtDlpService_CreateSplitQualityOption tests the CreateSplitQualityOption method.
+func TestYtDlpService_CreateSplitQualityOption(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{"best", "bestvideo+bestaudio/best"},
+		{"", "bestvideo+bestaudio/best"},
+		{"audio", "bestaudio/best"},
+		{"audio_only", "bestaudio/best"},
+		{"1080p60", "bestvideo[height<=?1080]+bestaudio/best[height<=?1080]/best"},
+		{"720p", "bestvideo[height<=?720]+bestaudio/best[height<=?720]/best"},
+		{"480", "bestvideo[height<=?480]+bestaudio/best[height<=?480]/best"},
+		{"foo", "bestvideo+bestaudio/best"},
+	}
+
+	svc := NewYtDlpService(YtDlpOptions{})
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			result := svc.CreateSplitQualityOption(tt.input)
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+}
@@ -0,0 +1,159 @@
+package hls
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"math"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+// Variant is a rendition of a video in a multivariant playlist.
+type Variant struct {
+	Name             string   `json:"name"`                        // e.g. source, 720p or audio
+	URI              string   `json:"uri"`                         // media playlist, relative to the multivariant playlist
+	Bandwidth        int      `json:"bandwidth"`                   // peak bits per second of a segment
+	AverageBandwidth int      `json:"average_bandwidth,omitempty"` // average bits per second
+	Codecs           []string `json:"codecs,omitempty"`
+	Width            int      `json:"width,omitempty"`
+	Height           int      `json:"height,omitempty"`
+	FrameRate        float64  `json:"frame_rate,omitempty"`
+}
+
+// AudioOnly returns whether the variant has no video.
+func (v Variant) AudioOnly() bool {
+	return v.Height == 0
+}
+
+// MarshalMultivariant encodes a multivariant playlist of the variants. The name of each variant is
+// written as its STABLE-VARIANT-ID so it can be read back by ReadVariants.
+func MarshalMultivariant(variants []Variant) ([]byte, error) {
+	if len(variants) == 0 {
+		return nil, fmt.Errorf("multivariant playlist needs at least one variant")
+	}
+
+	var b strings.Builder
+	b.WriteString("#EXTM3U\n#EXT-X-VERSION:3\n#EXT-X-INDEPENDENT-SEGMENTS\n\n")
+	for _, v := range variants {
+		if v.URI == "" || strings.ContainsAny(v.URI, "\r\n") {
+			return nil, fmt.Errorf("invalid URI for variant %q", v.Name)
+		}
+		b.WriteString("#EXT-X-STREAM-INF:BANDWIDTH=" + strconv.Itoa(v.Bandwidth))
+		if v.AverageBandwidth > 0 {
+			b.WriteString(",AVERAGE-BANDWIDTH=" + strconv.Itoa(v.AverageBandwidth))
+		}
+		if len(v.Codecs) > 0 {
+			b.WriteString(`,CODECS="` + sanitizeHLSQuotedString(strings.Join(v.Codecs, ",")) + `"`)
+		}
+		if v.Width > 0 && v.Height > 0 {
+			b.WriteString(fmt.Sprintf(",RESOLUTION=%dx%d", v.Width, v.Height))
+		}
+		if v.FrameRate > 0 {
+			b.WriteString(",FRAME-RATE=" + strconv.FormatFloat(v.FrameRate, 'f', 3, 64))
+		}
+		if v.Name != "" {
+			b.WriteString(`,STABLE-VARIANT-ID="` + sanitizeHLSQuotedString(v.Name) + `"`)
+		}
+		b.WriteString("\n" + v.URI + "\n")
+	}
+	return []byte(b.String()), nil
+}
+
+// WriteMultivariant writes a multivariant playlist of the variants to the path.
+func WriteMultivariant(path string, variants []Variant) error {
+	byts, err := MarshalMultivariant(variants)
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, byts, 0644)
+}
+
+// ReadVariants returns the variants of a multivariant playlist. Media playlists have a single rendition
+// and return no variants.
+func ReadVariants(r io.Reader) ([]Variant, error) {
+	byts, err := io.ReadAll(io.LimitReader(r, maxPlaylistSize+1))
+	if err != nil {
+		return nil, err
+	}
+	if len(byts) > maxPlaylistSize {
+		return nil, fmt.Errorf("playlist exceeds maximum size of %d bytes", maxPlaylistSize)
+	}
+	if !bytes.Contains(byts, []byte("#EXT-X-STREAM-INF:")) {
+		return nil, nil
+	}
+
+	pl, err := DecodeMultivariant(bytes.NewReader(byts))
+	if err != nil {
+		return nil, err
+	}
+
+	variants := make([]Variant, 0, len(pl.Variants))
+	for _, v := range pl.Variants {
+		variant := Variant{
+			// the VIDEO attribute is derived from STABLE-VARIANT-ID or RESOLUTION when decoding
+			Name:      v.Video,
+			URI:       v.URI,
+			Bandwidth: v.Bandwidth,
+			Codecs:    v.Codecs,
+		}
+		if v.AverageBandwidth != nil {
+			variant.AverageBandwidth = *v.AverageBandwidth
+		}
+		if v.FrameRate != nil {
+			variant.FrameRate = *v.FrameRate
+		}
+		if width, height, ok := strings.Cut(v.Resolution, "x"); ok {
+			variant.Width, _ = strconv.Atoi(width)
+			variant.Height, _ = strconv.Atoi(height)
+		}
+		if variant.Name == "" && variant.AudioOnly() {
+			variant.Name = "audio"
+		}
+		variants = append(variants, variant)
+	}
+	return variants, nil
+}
+
+// MediaPlaylistBandwidth returns the peak and average bits per second of the segments of a local media
+// playlist, as required by the BANDWIDTH and AVERAGE-BANDWIDTH attributes of a multivariant playlist.
+func MediaPlaylistBandwidth(path string) (peak int, average int, err error) {
+	byts, err := os.ReadFile(path)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	dir := filepath.Dir(path)
+	var totalBits, totalDuration, duration float64
+	for _, line := range strings.Split(string(byts), "\n") {
+		line = strings.TrimSpace(line)
+		switch {
+		case line == "":
+		case strings.HasPrefix(line, "#EXTINF:"):
+			value, _, _ := strings.Cut(strings.TrimPrefix(line, "#EXTINF:"), ",")
+			duration, err = strconv.ParseFloat(strings.TrimSpace(value), 64)
+			if err != nil {
+				return 0, 0, fmt.Errorf("invalid segment duration %q: %w", value, err)
+			}
+		case strings.HasPrefix(line, "#"):
+		default:
+			info, err := os.Stat(filepath.Join(dir, filepath.FromSlash(line)))
+			if err != nil {
+				return 0, 0, err
+			}
+			bits := float64(info.Size() * 8)
+			if duration > 0 {
+				peak = max(peak, int(math.Ceil(bits/duration)))
+			}
+			totalBits += bits
+			totalDuration += duration
+			duration = 0
+		}
+	}
+	if totalDuration == 0 {
+		return 0, 0, fmt.Errorf("media playlist %s has no segments", path)
+	}
+	return peak, int(math.Ceil(totalBits / totalDuration)), nil
+}
@@ -51,7 +51,8 @@ func DecodeMultivariant(r io.Reader) (*Multivariant, error) {
 }
 
 // FinalizeMediaPlaylist makes an interrupted live/event media playlist look
-// like a completed VOD playlist. It is safe to call repeatedly.
+// like a completed VOD playlist. It is safe to call repeatedly. Multivariant
+// playlists are left unchanged.
 func FinalizeMediaPlaylist(path string) error {
 	byts, err := os.ReadFile(path)
 	if err != nil {
@@ -59,6 +60,9 @@ func FinalizeMediaPlaylist(path string) error {
 	}
 
 	playlistText := string(byts)
+	if strings.Contains(playlistText, "#EXT-X-STREAM-INF:") {
+		return nil
+	}
 	playlistText = strings.ReplaceAll(playlistText, "#EXT-X-PLAYLIST-TYPE:EVENT", "#EXT-X-PLAYLIST-TYPE:VOD")
 
 	trimmed := strings.TrimRight(playlistText, "\r\n")
@@ -71,6 +75,71 @@ func FinalizeMediaPlaylist(path string) error {
 	return writeFileAtomic(path, []byte(playlistText))
 }
 
+// MediaPlaylistFiles returns the URIs of the segments and initialization
+// sections referenced by a media playlist, in playlist order.
+func MediaPlaylistFiles(r io.Reader) ([]string, error) {
+	byts, err := io.ReadAll(io.LimitReader(r, maxPlaylistSize+1))
+	if err != nil {
+		return nil, err
+	}
+	if len(byts) > maxPlaylistSize {
+		return nil, fmt.Errorf("playlist exceeds maximum size of %d bytes", maxPlaylistSize)
+	}
+
+	files := []string{}
+	seen := map[string]struct{}{}
+	add := func(uri string) {
+		if _, ok := seen[uri]; ok {
+			return
+		}
+		seen[uri] = struct{}{}
+		files = append(files, uri)
+	}
+	for _, line := range strings.Split(string(byts), "\n") {
+		line = strings.TrimSpace(line)
+		switch {
+		case line == "":
+		case strings.HasPrefix(line, "#EXT-X-MAP:"):
+			_, uri, ok := strings.Cut(line, `URI="`)
+			if !ok {
+				return nil, fmt.Errorf("EXT-X-MAP without URI")
+			}
+			uri, _, _ = strings.Cut(uri, `"`)
+			add(uri)
+		case strings.HasPrefix(line, "#"):
+		default:
+			add(line)
+		}
+	}
+	return files, nil
+}
+
+// MediaPlaylistDuration returns the total duration in seconds of the segments of a media playlist.
+func MediaPlaylistDuration(r io.Reader) (float64, error) {
+	byts, err := io.ReadAll(io.LimitReader(r, maxPlaylistSize+1))
+	if err != nil {
+		return 0, err
+	}
+	if len(byts) > maxPlaylistSize {
+		return 0, fmt.Errorf("playlist exceeds maximum size of %d bytes", maxPlaylistSize)
+	}
+
+	var duration float64
+	for _, line := range strings.Split(string(byts), "\n") {
+		line = strings.TrimSpace(line)
+		if !strings.HasPrefix(line, "#EXTINF:") {
+			continue
+		}
+		value, _, _ := strings.Cut(strings.TrimPrefix(line, "#EXTINF:"), ",")
+		seconds, err := strconv.ParseFloat(strings.TrimSpace(value), 64)
+		if err != nil {
+			return 0, fmt.Errorf("invalid segment duration %q: %w", value, err)
+		}
+		duration += seconds
+	}
+	return duration, nil
+}
+
 func writeFileAtomic(path string, data []byte) error {
 	info, err := os.Stat(path)
 	if err != nil {
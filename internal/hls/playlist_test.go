@@ -163,3 +163,136 @@ segment0.ts
 		t.Fatalf("expected one VOD playlist type, got:\n%s", output)
 	}
 }
+
+func TestMediaPlaylistFiles(t *testing.T) {
+	input := `#EXTM3U
+#EXT-X-VERSION:7
+#EXT-X-MAP:URI="init.mp4"
+#EXTINF:10.0,
+segment0.m4s
+#EXTINF:10.0,
+segment1.m4s
+
+#EXT-X-DISCONTINUITY
+#EXT-X-MAP:URI="init.mp4"
+#EXTINF:4.5,
+segment2.m4s
+#EXT-X-ENDLIST
+`
+	files, err := MediaPlaylistFiles(strings.NewReader(input))
+	if err != nil {
+		t.Fatalf("MediaPlaylistFiles returned error: %v", err)
+	}
+	expected := []string{"init.mp4", "segment0.m4s", "segment1.m4s", "segment2.m4s"}
+	if strings.Join(files, ",") != strings.Join(expected, ",") {
+		t.Fatalf("expected files %v, got %v", expected, files)
+	}
+}
+
+func TestMediaPlaylistDuration(t *testing.T) {
+	input := `#EXTM3U
+#EXT-X-PLAYLIST-TYPE:EVENT
+#EXTINF:10.0,
+segment0.ts
+#EXTINF:10.0,
+segment1.ts
+#EXT-X-DISCONTINUITY
+#EXTINF:4.5,
+segment2.ts
+`
+	duration, err := MediaPlaylistDuration(strings.NewReader(input))
+	if err != nil {
+		t.Fatalf("MediaPlaylistDuration returned error: %v", err)
+	}
+	if duration != 24.5 {
+		t.Fatalf("expected duration 24.5, got %v", duration)
+	}
+}
+
+func TestMultivariantRoundTrip(t *testing.T) {
+	variants := []Variant{
+		{Name: "source", URI: "123-source.m3u8", Bandwidth: 6500000, AverageBandwidth: 6000000, Codecs: []string{"avc1.64002a", "mp4a.40.2"}, Width: 1920, Height: 1080, FrameRate: 60},
+		{Name: "480p", URI: "123-480p.m3u8", Bandwidth: 1200000, Codecs: []string{"avc1.64001e", "mp4a.40.2"}, Width: 854, Height: 480, FrameRate: 30},
+		{Name: "audio", URI: "123-audio.m3u8", Bandwidth: 100000, AverageBandwidth: 96000, Codecs: []string{"mp4a.40.2"}},
+	}
+
+	byts, err := MarshalMultivariant(variants)
+	if err != nil {
+		t.Fatalf("MarshalMultivariant returned error: %v", err)
+	}
+	if !strings.Contains(string(byts), `#EXT-X-STREAM-INF:BANDWIDTH=6500000,AVERAGE-BANDWIDTH=6000000,CODECS="avc1.64002a,mp4a.40.2",RESOLUTION=1920x1080,FRAME-RATE=60.000,STABLE-VARIANT-ID="source"`+"\n123-source.m3u8\n") {
+		t.Fatalf("unexpected multivariant playlist:\n%s", byts)
+	}
+
+	decoded, err := ReadVariants(strings.NewReader(string(byts)))
+	if err != nil {
+		t.Fatalf("ReadVariants returned error: %v", err)
+	}
+	if len(decoded) != len(variants) {
+		t.Fatalf("expected %d variants, got %d", len(variants), len(decoded))
+	}
+	for i := range variants {
+		got, want := decoded[i], variants[i]
+		if got.Name != want.Name || got.URI != want.URI || got.Bandwidth != want.Bandwidth || got.AverageBandwidth != want.AverageBandwidth ||
+			got.Width != want.Width || got.Height != want.Height || got.FrameRate != want.FrameRate || strings.Join(got.Codecs, ",") != strings.Join(want.Codecs, ",") {
+			t.Fatalf("expected variant %+v, got %+v", want, got)
+		}
+	}
+	if !decoded[2].AudioOnly() || decoded[0].AudioOnly() {
+		t.Fatalf("expected only the last variant to be audio-only")
+	}
+}
+
+func TestReadVariantsMediaPlaylist(t *testing.T) {
+	variants, err := ReadVariants(strings.NewReader("#EXTM3U\n#EXTINF:10.0,\nsegment0.ts\n#EXT-X-ENDLIST\n"))
+	if err != nil {
+		t.Fatalf("ReadVariants returned error: %v", err)
+	}
+	if len(variants) != 0 {
+		t.Fatalf("expected no variants for a media playlist, got %v", variants)
+	}
+}
+
+func TestMediaPlaylistBandwidth(t *testing.T) {
+	dir := t.TempDir()
+	playlistPath := filepath.Join(dir, "123-720p.m3u8")
+	if err := os.WriteFile(playlistPath, []byte("#EXTM3U\n#EXTINF:10.000000,\n123-720p_segment0.ts\n#EXTINF:5.000000,\n123-720p_segment1.ts\n#EXT-X-ENDLIST\n"), 0644); err != nil {
+		t.Fatalf("write playlist: %v", err)
+	}
+	// 10 seconds at 1000 bytes per second and 5 seconds at 2000 bytes per second
+	if err := os.WriteFile(filepath.Join(dir, "123-720p_segment0.ts"), make([]byte, 10000), 0644); err != nil {
+		t.Fatalf("write segment: %v", err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, "123-720p_segment1.ts"), make([]byte, 10000), 0644); err != nil {
+		t.Fatalf("write segment: %v", err)
+	}
+
+	peak, average, err := MediaPlaylistBandwidth(playlistPath)
+	if err != nil {
+		t.Fatalf("MediaPlaylistBandwidth returned error: %v", err)
+	}
+	if peak != 16000 {
+		t.Fatalf("expected peak bandwidth 16000, got %d", peak)
+	}
+	if average != 10667 {
+		t.Fatalf("expected average bandwidth 10667, got %d", average)
+	}
+}
+
+func TestFinalizeMediaPlaylistSkipsMultivariant(t *testing.T) {
+	playlistPath := filepath.Join(t.TempDir(), "123-video.m3u8")
+	input := "#EXTM3U\n#EXT-X-STREAM-INF:BANDWIDTH=100000\n123-audio.m3u8\n"
+	if err := os.WriteFile(playlistPath, []byte(input), 0644); err != nil {
+		t.Fatalf("write playlist: %v", err)
+	}
+	if err := FinalizeMediaPlaylist(playlistPath); err != nil {
+		t.Fatalf("FinalizeMediaPlaylist returned error: %v", err)
+	}
+	output, err := os.ReadFile(playlistPath)
+	if err != nil {
+		t.Fatalf("read playlist: %v", err)
+	}
+	if string(output) != input {
+		t.Fatalf("expected multivariant playlist to be unchanged, got:\n%s", output)
+	}
+}
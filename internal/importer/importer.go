@@ -0,0 +1,389 @@
+// Package importer imports videos that are already on disk, e.g. from a previous installation
+// or downloaded with other tools.
+//
+// Importing is done in two steps. Scan reads the videos below a directory and returns a report
+// of the metadata found for each video without changing anything. The reviewed, and possibly
+// corrected, items of the report are passed to Confirm which creates the channels and videos
+// and enqueues a task to probe each video and generate its thumbnails.
+package importer
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"path/filepath"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rs/zerolog/log"
+	"github.com/zibbp/ganymede/ent"
+	entChannel "github.com/zibbp/ganymede/ent/channel"
+	entVod "github.com/zibbp/ganymede/ent/vod"
+	"github.com/zibbp/ganymede/internal/archive"
+	"github.com/zibbp/ganymede/internal/config"
+	"github.com/zibbp/ganymede/internal/database"
+	"github.com/zibbp/ganymede/internal/storage"
+	"github.com/zibbp/ganymede/internal/storagetemplate"
+	"github.com/zibbp/ganymede/internal/tasks"
+	tasks_client "github.com/zibbp/ganymede/internal/tasks/client"
+	"github.com/zibbp/ganymede/internal/utils"
+	"github.com/zibbp/ganymede/internal/vod"
+)
+
+var (
+	ErrInvalidDirectory  = errors.New("directory must be inside the videos directory")
+	ErrUnsupportedDriver = errors.New("importing requires the local storage driver")
+)
+
+type Service struct {
+	Store          *database.Database
+	ArchiveService *archive.Service
+	VodService     *vod.Service
+	RiverClient    *tasks_client.RiverClient
+}
+
+func NewService(store *database.Database, archiveService *archive.Service, vodService *vod.Service, riverClient *tasks_client.RiverClient) *Service {
+	return &Service{Store: store, ArchiveService: archiveService, VodService: vodService, RiverClient: riverClient}
+}
+
+// Source is where the metadata of an item was found.
+type Source string
+
+const (
+	SourceInfoJSON      Source = "info_json"       // info file written by Ganymede
+	SourceYtdlpInfoJSON Source = "ytdlp_info_json" // info file written by yt-dlp
+	SourceNFO           Source = "nfo"
+	SourceTemplate      Source = "template" // storage template variables in the path
+	SourceNone          Source = "none"
+)
+
+type Status string
+
+const (
+	StatusNew       Status = "new"       // the video can be imported
+	StatusExists    Status = "exists"    // the video is already in the library
+	StatusUnmatched Status = "unmatched" // the channel of the video is unknown and must be set before importing
+)
+
+// Item is a video found on disk. The metadata fields can be changed before passing the item to Confirm.
+type Item struct {
+	VideoPath          string              `json:"video_path" validate:"required"`
+	VideoHLSPath       string              `json:"video_hls_path,omitempty"`
+	ChatPath           string              `json:"chat_path,omitempty"`
+	ChatVideoPath      string              `json:"chat_video_path,omitempty"`
+	Source             Source              `json:"source"`
+	Status             Status              `json:"status"`
+	Problems           []string            `json:"problems"`
+	Platform           utils.VideoPlatform `json:"platform"`
+	Type               utils.VodType       `json:"type"`
+	ExtID              string              `json:"ext_id"`
+	Title              string              `json:"title"`
+	Category           string              `json:"category"`
+	StreamedAt         time.Time           `json:"streamed_at"`
+	ChannelName        string              `json:"channel_name"`
+	ChannelExtID       string              `json:"channel_ext_id"`
+	ChannelDisplayName string              `json:"channel_display_name"`
+	ChannelID          *uuid.UUID          `json:"channel_id,omitempty"` // existing channel the video is imported to
+	VideoID            *uuid.UUID          `json:"video_id,omitempty"`   // existing video with the same path
+}
+
+type Report struct {
+	Directory string `json:"directory"`
+	Items     []Item `json:"items"`
+	New       int    `json:"new"`
+	Exists    int    `json:"exists"`
+	Unmatched int    `json:"unmatched"`
+}
+
+// Scan returns the videos below directory without importing them. Relative directories are
+// resolved from the videos directory, which is scanned if directory is empty.
+func (s *Service) Scan(ctx context.Context, directory string) (*Report, error) {
+	env := config.GetEnvConfig()
+	if storage.Driver(env.StorageDriver) == storage.DriverS3 {
+		return nil, ErrUnsupportedDriver
+	}
+	directory, err := resolveDirectory(env.VideosDir, directory)
+	if err != nil {
+		return nil, err
+	}
+
+	matcher, err := storagetemplate.NewPathMatcher(config.Get().StorageTemplates)
+	if err != nil {
+		return nil, err
+	}
+	items, err := scanDirectory(directory, env.VideosDir, matcher)
+	if err != nil {
+		return nil, err
+	}
+
+	report := &Report{Directory: directory, Items: items}
+	for i := range report.Items {
+		item := &report.Items[i]
+		if err := s.resolveItem(ctx, item); err != nil {
+			return nil, err
+		}
+		switch item.Status {
+		case StatusNew:
+			report.New++
+		case StatusExists:
+			report.Exists++
+		case StatusUnmatched:
+			report.Unmatched++
+		}
+	}
+	return report, nil
+}
+
+// resolveDirectory returns the absolute directory, which must be the videos directory or inside it.
+func resolveDirectory(videosDir string, directory string) (string, error) {
+	videosDir = filepath.Clean(videosDir)
+	if directory == "" {
+		return videosDir, nil
+	}
+	if !filepath.IsAbs(directory) {
+		directory = filepath.Join(videosDir, directory)
+	}
+	directory = filepath.Clean(directory)
+	rel, err := filepath.Rel(videosDir, directory)
+	if err != nil || rel == ".." || strings.HasPrefix(rel, "../") {
+		return "", ErrInvalidDirectory
+	}
+	return directory, nil
+}
+
+// resolveItem sets the existing video and channel of the item and its status.
+func (s *Service) resolveItem(ctx context.Context, item *Item) error {
+	video, err := s.Store.Client.Vod.Query().Where(entVod.VideoPath(item.VideoPath)).First(ctx)
+	if err != nil && !ent.IsNotFound(err) {
+		return fmt.Errorf("error fetching video: %w", err)
+	}
+	if video != nil {
+		item.VideoID = &video.ID
+		item.Status = StatusExists
+		return nil
+	}
+
+	channel, err := s.findChannel(ctx, *item)
+	if err != nil {
+		return err
+	}
+	if channel != nil {
+		item.ChannelID = &channel.ID
+	}
+
+	item.Status = StatusNew
+	if item.ChannelName == "" && channel == nil {
+		item.Status = StatusUnmatched
+	}
+	return nil
+}
+
+// findChannel returns the channel of the item by its external ID or name, nil if it does not exist.
+func (s *Service) findChannel(ctx context.Context, item Item) (*ent.Channel, error) {
+	if item.ChannelID != nil {
+		channel, err := s.Store.Client.Channel.Get(ctx, *item.ChannelID)
+		if err != nil {
+			return nil, fmt.Errorf("error fetching channel: %w", err)
+		}
+		return channel, nil
+	}
+	query := s.Store.Client.Channel.Query()
+	switch {
+	case item.ChannelExtID != "":
+		query.Where(entChannel.Or(entChannel.ExtID(item.ChannelExtID), entChannel.Name(item.ChannelName)))
+	case item.ChannelName != "":
+		query.Where(entChannel.Name(item.ChannelName))
+	default:
+		return nil, nil
+	}
+	channel, err := query.First(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("error fetching channel: %w", err)
+	}
+	return channel, nil
+}
+
+type ConfirmInput struct {
+	Items         []Item `json:"items" validate:"required,dive"`
+	FetchMetadata bool   `json:"fetch_metadata"` // create channels and update videos with the metadata from their platform
+}
+
+type ConfirmResult struct {
+	Imported []ImportedItem `json:"imported"`
+	Failed   []FailedItem   `json:"failed"`
+}
+
+type ImportedItem struct {
+	VideoPath string    `json:"video_path"`
+	VideoID   uuid.UUID `json:"video_id"`
+}
+
+type FailedItem struct {
+	VideoPath string `json:"video_path"`
+	Error     string `json:"error"`
+}
+
+// Confirm imports the items. Items are imported independently, an item that fails does not stop the others.
+func (s *Service) Confirm(ctx context.Context, input ConfirmInput) (*ConfirmResult, error) {
+	env := config.GetEnvConfig()
+	if storage.Driver(env.StorageDriver) == storage.DriverS3 {
+		return nil, ErrUnsupportedDriver
+	}
+
+	result := &ConfirmResult{Imported: []ImportedItem{}, Failed: []FailedItem{}}
+	for _, item := range input.Items {
+		videoID, err := s.importItem(ctx, env.VideosDir, item, input.FetchMetadata)
+		if err != nil {
+			log.Warn().Err(err).Str("video_path", item.VideoPath).Msg("error importing video")
+			result.Failed = append(result.Failed, FailedItem{VideoPath: item.VideoPath, Error: err.Error()})
+			continue
+		}
+		result.Imported = append(result.Imported, ImportedItem{VideoPath: item.VideoPath, VideoID: videoID})
+	}
+	return result, nil
+}
+
+func (s *Service) importItem(ctx context.Context, videosDir string, item Item, fetchMetadata bool) (uuid.UUID, error) {
+	// only the video path is taken from the item, the other files are found again
+	videoPath, err := resolveDirectory(videosDir, item.VideoPath)
+	if err != nil || videoPath == filepath.Clean(videosDir) {
+		return uuid.Nil, fmt.Errorf("video path must be inside the videos directory")
+	}
+	if !utils.FileExists(videoPath) {
+		return uuid.Nil, fmt.Errorf("video file not found")
+	}
+	files, ok := filesForVideo(videoPath)
+	if !ok {
+		return uuid.Nil, fmt.Errorf("not a video file that can be imported")
+	}
+
+	if item.Platform == "" {
+		item.Platform = utils.PlatformTwitch
+	}
+	if !slices.Contains(utils.VideoPlatform("").Values(), string(item.Platform)) {
+		return uuid.Nil, fmt.Errorf("invalid platform %q", item.Platform)
+	}
+	if item.Type == "" {
+		item.Type = utils.Archive
+	}
+	if !slices.Contains(utils.VodType("").Values(), string(item.Type)) {
+		return uuid.Nil, fmt.Errorf("invalid type %q", item.Type)
+	}
+	if item.Title == "" {
+		item.Title = files.FileName
+	}
+	if item.StreamedAt.IsZero() {
+		item.StreamedAt = time.Now()
+	}
+
+	exists, err := s.Store.Client.Vod.Query().Where(entVod.VideoPath(videoPath)).Exist(ctx)
+	if err != nil {
+		return uuid.Nil, fmt.Errorf("error checking if video exists: %w", err)
+	}
+	if exists {
+		return uuid.Nil, fmt.Errorf("video already exists")
+	}
+
+	channel, err := s.getOrCreateChannel(ctx, videosDir, files, item, fetchMetadata)
+	if err != nil {
+		return uuid.Nil, err
+	}
+
+	vodDTO := vod.Vod{
+		ID:               uuid.New(),
+		ExtID:            item.ExtID,
+		Platform:         item.Platform,
+		Type:             item.Type,
+		Title:            item.Title,
+		Category:         item.Category,
+		Duration:         1,
+		Processing:       true,
+		ThumbnailPath:    files.sibling("-thumbnail.jpg"),
+		WebThumbnailPath: files.sibling("-web_thumbnail.jpg"),
+		VideoPath:        files.VideoPath,
+		VideoHLSPath:     files.VideoHLSPath,
+		ChatPath:         files.ChatPath,
+		ChatVideoPath:    files.ChatVideoPath,
+		InfoPath:         files.InfoPath,
+		StreamedAt:       item.StreamedAt,
+		FolderName:       files.FolderName,
+		FileName:         files.FileName,
+	}
+	err = s.Store.WithTx(ctx, func(txClient *ent.Client, tx *sql.Tx) error {
+		if _, err := s.VodService.CreateVodWithClient(ctx, txClient, vodDTO, channel.ID); err != nil {
+			return err
+		}
+		_, err := s.RiverClient.InsertTx(ctx, tx, tasks.ImportVideoArgs{
+			VideoId:       vodDTO.ID.String(),
+			FetchMetadata: fetchMetadata,
+		}, nil)
+		return err
+	})
+	if err != nil {
+		return uuid.Nil, fmt.Errorf("error creating video: %w", err)
+	}
+
+	log.Info().Str("video_id", vodDTO.ID.String()).Str("video_path", vodDTO.VideoPath).Msg("imported video")
+	return vodDTO.ID, nil
+}
+
+// getOrCreateChannel returns the channel of the item, creating it if it does not exist. New channels are
+// created from their platform if fetchMetadata is set, and from the item otherwise or if fetching fails.
+func (s *Service) getOrCreateChannel(ctx context.Context, videosDir string, files videoFiles, item Item, fetchMetadata bool) (*ent.Channel, error) {
+	channel, err := s.findChannel(ctx, item)
+	if err != nil {
+		return nil, err
+	}
+	if channel != nil {
+		return channel, nil
+	}
+	if item.ChannelName == "" {
+		return nil, fmt.Errorf("channel name is required")
+	}
+
+	if fetchMetadata {
+		// YouTube channels are fetched by ID as the name is the handle
+		name := item.ChannelName
+		if item.Platform == utils.PlatformYoutube && item.ChannelExtID != "" {
+			name = item.ChannelExtID
+		}
+		channel, err := s.ArchiveService.ArchivePlatformChannel(ctx, item.Platform, name)
+		if err == nil {
+			return channel, nil
+		}
+		log.Warn().Err(err).Str("channel", item.ChannelName).Msg("error creating channel from platform, creating it from the imported metadata")
+	}
+
+	// the channel folder is the first directory below the videos directory
+	rel, err := filepath.Rel(videosDir, files.Directory)
+	if err != nil {
+		return nil, err
+	}
+	channelFolder := strings.Split(filepath.ToSlash(rel), "/")[0]
+
+	displayName := item.ChannelDisplayName
+	if displayName == "" {
+		displayName = item.ChannelName
+	}
+	create := s.Store.Client.Channel.Create().
+		SetName(item.ChannelName).
+		SetDisplayName(displayName).
+		SetImagePath(filepath.Join(videosDir, channelFolder, "profile.png")).
+		SetPlatform(item.Platform)
+	// the external ID is unique, so it is only set if it is known
+	if item.ChannelExtID != "" {
+		create.SetExtID(item.ChannelExtID)
+	}
+	channel, err = create.Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("error creating channel: %w", err)
+	}
+	log.Info().Str("channel_id", channel.ID.String()).Str("channel", channel.Name).Msg("created channel for imported videos")
+	return channel, nil
+}
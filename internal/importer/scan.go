@@ -0,0 +1,343 @@
+package importer
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/zibbp/ganymede/internal/nfo"
+	"github.com/zibbp/ganymede/internal/storagetemplate"
+	"github.com/zibbp/ganymede/internal/utils"
+)
+
+// videoFiles are the paths of the files belonging to a video on disk.
+// Videos archived by Ganymede are named {{file}}-video.mp4 or {{file}}-video_hls/{{id}}-video.m3u8
+// with their other files next to them, other mp4 files use their name without the extension as file name.
+type videoFiles struct {
+	VideoPath     string
+	VideoHLSPath  string
+	Directory     string
+	FolderName    string
+	FileName      string
+	ChatPath      string
+	ChatVideoPath string
+	InfoPath      string
+}
+
+// filesForVideo returns the files of the video at path. It returns false if path is not a video that can be imported.
+func filesForVideo(path string) (videoFiles, bool) {
+	files := videoFiles{VideoPath: path}
+	name := filepath.Base(path)
+	switch strings.ToLower(filepath.Ext(name)) {
+	case ".mp4":
+		// rendered chat videos are imported with their video
+		if strings.HasSuffix(name, "-chat.mp4") {
+			return files, false
+		}
+		if strings.HasSuffix(filepath.Base(filepath.Dir(path)), "-video_hls") {
+			return files, false
+		}
+		files.Directory = filepath.Dir(path)
+		files.FileName = strings.TrimSuffix(name, filepath.Ext(name))
+		files.FileName = strings.TrimSuffix(files.FileName, "-video")
+	case ".m3u8":
+		hlsDirectory := filepath.Dir(path)
+		if !strings.HasSuffix(name, "-video.m3u8") || !strings.HasSuffix(filepath.Base(hlsDirectory), "-video_hls") {
+			return files, false
+		}
+		files.VideoHLSPath = hlsDirectory
+		files.Directory = filepath.Dir(hlsDirectory)
+		files.FileName = strings.TrimSuffix(filepath.Base(hlsDirectory), "-video_hls")
+	default:
+		return files, false
+	}
+	files.FolderName = filepath.Base(files.Directory)
+
+	if p := files.sibling("-chat.json"); utils.FileExists(p) {
+		files.ChatPath = p
+	}
+	if p := files.sibling("-chat.mp4"); utils.FileExists(p) {
+		files.ChatVideoPath = p
+	}
+	if p := files.sibling("-info.json"); utils.FileExists(p) {
+		files.InfoPath = p
+	}
+	return files, true
+}
+
+// sibling returns the path of the video file with the suffix, e.g. -thumbnail.jpg.
+func (f videoFiles) sibling(suffix string) string {
+	return filepath.Join(f.Directory, f.FileName+suffix)
+}
+
+// scanDirectory returns an item for every video below directory. Paths are matched against the
+// storage templates relative to videosDir. The returned items only contain what is found on disk.
+func scanDirectory(directory string, videosDir string, matcher *storagetemplate.PathMatcher) ([]Item, error) {
+	items := []Item{}
+	err := filepath.WalkDir(directory, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if strings.HasPrefix(d.Name(), ".") && path != directory {
+			if d.IsDir() {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if !d.Type().IsRegular() {
+			return nil
+		}
+		files, ok := filesForVideo(path)
+		if !ok {
+			return nil
+		}
+		items = append(items, itemFromFiles(files, videosDir, matcher))
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error scanning %s: %w", directory, err)
+	}
+	return items, nil
+}
+
+// itemFromFiles reads the metadata of the video from its sidecar files, falling back to the
+// storage template variables in its path.
+func itemFromFiles(files videoFiles, videosDir string, matcher *storagetemplate.PathMatcher) Item {
+	item := Item{
+		VideoPath:     files.VideoPath,
+		VideoHLSPath:  files.VideoHLSPath,
+		ChatPath:      files.ChatPath,
+		ChatVideoPath: files.ChatVideoPath,
+		Source:        SourceNone,
+		Problems:      []string{},
+	}
+
+	stem := strings.TrimSuffix(files.VideoPath, filepath.Ext(files.VideoPath))
+	nfoPath, _ := nfo.SidecarPath(files.VideoPath)
+	switch {
+	case files.InfoPath != "":
+		if err := readGanymedeInfo(files.InfoPath, &item); err != nil {
+			item.Problems = append(item.Problems, err.Error())
+		} else {
+			item.Source = SourceInfoJSON
+		}
+	case utils.FileExists(stem + ".info.json"):
+		if err := readYtdlpInfo(stem+".info.json", &item); err != nil {
+			item.Problems = append(item.Problems, err.Error())
+		} else {
+			item.Source = SourceYtdlpInfoJSON
+		}
+	case utils.FileExists(nfoPath):
+		if err := readNFO(nfoPath, &item); err != nil {
+			item.Problems = append(item.Problems, err.Error())
+		} else {
+			item.Source = SourceNFO
+		}
+	}
+
+	if rel, err := filepath.Rel(videosDir, files.VideoPath); err == nil {
+		if variables, ok := matcher.Match(filepath.ToSlash(rel)); ok {
+			if item.Source == SourceNone {
+				item.Source = SourceTemplate
+			}
+			applyTemplateVariables(&item, variables)
+		}
+	}
+
+	if item.Title == "" {
+		item.Title = files.FileName
+	}
+	if item.Type == "" {
+		item.Type = utils.Archive
+	}
+	if item.Platform == "" {
+		item.Platform = utils.PlatformTwitch
+	}
+	if item.StreamedAt.IsZero() {
+		if info, err := os.Stat(files.VideoPath); err == nil {
+			item.StreamedAt = info.ModTime().UTC()
+		}
+	}
+	if item.ChannelName == "" {
+		item.Problems = append(item.Problems, "channel not found in sidecar files or storage template")
+	}
+	return item
+}
+
+func applyTemplateVariables(item *Item, variables map[string]string) {
+	setIfEmpty := func(field *string, value string) {
+		if *field == "" {
+			*field = value
+		}
+	}
+	setIfEmpty(&item.ExtID, variables["id"])
+	setIfEmpty(&item.Title, variables["title"])
+	setIfEmpty(&item.ChannelName, variables["channel"])
+	setIfEmpty(&item.ChannelExtID, variables["channel_id"])
+	setIfEmpty(&item.ChannelDisplayName, variables["channel_display_name"])
+	if item.Type == "" && variables["type"] != "" {
+		item.Type = utils.VodType(variables["type"])
+	}
+	if item.StreamedAt.IsZero() {
+		date := variables["date"]
+		if date == "" && variables["YYYY"] != "" && variables["MM"] != "" && variables["DD"] != "" {
+			date = variables["YYYY"] + "-" + variables["MM"] + "-" + variables["DD"]
+		}
+		if streamedAt, err := time.Parse("2006-01-02", date); err == nil {
+			if hour, err := time.ParseDuration(variables["HH"] + "h"); err == nil {
+				streamedAt = streamedAt.Add(hour)
+			}
+			item.StreamedAt = streamedAt
+		}
+	}
+}
+
+// ganymedeInfo is the info file written when archiving, which is the platform.VideoInfo of
+// videos or the platform.LiveStreamInfo of live streams.
+type ganymedeInfo struct {
+	ID        string    `json:"id"`
+	UserID    string    `json:"user_id"`
+	UserLogin string    `json:"user_login"`
+	UserName  string    `json:"user_name"`
+	Title     string    `json:"title"`
+	Type      string    `json:"type"`
+	URL       string    `json:"url"`
+	Category  *string   `json:"category"`
+	GameName  string    `json:"game_name"`
+	CreatedAt time.Time `json:"created_at"`
+	StartedAt time.Time `json:"started_at"`
+}
+
+func readGanymedeInfo(path string, item *Item) error {
+	var info ganymedeInfo
+	if err := readJSON(path, &info); err != nil {
+		return err
+	}
+	item.ExtID = info.ID
+	item.Title = info.Title
+	item.ChannelName = info.UserLogin
+	item.ChannelExtID = info.UserID
+	item.ChannelDisplayName = info.UserName
+	item.Platform = platformFromURL(info.URL)
+	item.StreamedAt = info.CreatedAt
+	if !info.StartedAt.IsZero() {
+		item.StreamedAt = info.StartedAt
+	}
+	if info.Category != nil {
+		item.Category = *info.Category
+	} else {
+		item.Category = info.GameName
+	}
+	if vodType := utils.VodType(info.Type); slices.Contains(utils.VodType("").Values(), string(vodType)) {
+		item.Type = vodType
+	}
+	return nil
+}
+
+func platformFromURL(url string) utils.VideoPlatform {
+	switch {
+	case strings.Contains(url, "youtube.com"), strings.Contains(url, "youtu.be"):
+		return utils.PlatformYoutube
+	case strings.Contains(url, "kick.com"):
+		return utils.PlatformKick
+	default:
+		return utils.PlatformTwitch
+	}
+}
+
+// ytdlpInfo is the info file written by yt-dlp with --write-info-json.
+type ytdlpInfo struct {
+	ID           string `json:"id"`
+	Title        string `json:"title"`
+	ExtractorKey string `json:"extractor_key"`
+	Uploader     string `json:"uploader"`
+	UploaderID   string `json:"uploader_id"`
+	Channel      string `json:"channel"`
+	ChannelID    string `json:"channel_id"`
+	UploadDate   string `json:"upload_date"`
+	Timestamp    int64  `json:"timestamp"`
+	LiveStatus   string `json:"live_status"`
+}
+
+func readYtdlpInfo(path string, item *Item) error {
+	var info ytdlpInfo
+	if err := readJSON(path, &info); err != nil {
+		return err
+	}
+	extractor := strings.ToLower(info.ExtractorKey)
+	switch {
+	case strings.HasPrefix(extractor, "youtube"):
+		item.Platform = utils.PlatformYoutube
+		item.Type = utils.Upload
+		if info.LiveStatus == "was_live" {
+			item.Type = utils.Archive
+		}
+	case strings.HasPrefix(extractor, "twitch"):
+		item.Platform = utils.PlatformTwitch
+		item.Type = utils.Archive
+		if strings.Contains(extractor, "clip") {
+			item.Type = utils.Clip
+		}
+	case strings.HasPrefix(extractor, "kick"):
+		item.Platform = utils.PlatformKick
+		item.Type = utils.Archive
+	default:
+		return fmt.Errorf("unsupported yt-dlp extractor %q in %s", info.ExtractorKey, path)
+	}
+
+	item.ExtID = info.ID
+	if item.Platform == utils.PlatformTwitch {
+		// yt-dlp prefixes twitch video ids with v
+		item.ExtID = strings.TrimPrefix(info.ID, "v")
+	}
+	item.Title = info.Title
+	item.ChannelName = strings.TrimPrefix(info.UploaderID, "@")
+	item.ChannelExtID = info.ChannelID
+	item.ChannelDisplayName = info.Channel
+	if item.ChannelDisplayName == "" {
+		item.ChannelDisplayName = info.Uploader
+	}
+	if item.ChannelName == "" {
+		item.ChannelName = item.ChannelDisplayName
+	}
+	if info.Timestamp > 0 {
+		item.StreamedAt = time.Unix(info.Timestamp, 0).UTC()
+	} else if streamedAt, err := time.Parse("20060102", info.UploadDate); err == nil {
+		item.StreamedAt = streamedAt
+	}
+	return nil
+}
+
+func readNFO(path string, item *Item) error {
+	metadata, err := nfo.ReadMovie(path)
+	if err != nil {
+		return err
+	}
+	item.Title = metadata.Title
+	item.StreamedAt = metadata.Premiered
+	item.ChannelDisplayName = metadata.Studio
+	item.ExtID = metadata.ExternalID
+	if metadata.Platform != "" {
+		item.Platform = utils.VideoPlatform(metadata.Platform)
+	}
+	if len(metadata.Genres) > 0 {
+		item.Category = metadata.Genres[0]
+	}
+	return nil
+}
+
+func readJSON(path string, v any) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	if err := json.Unmarshal(data, v); err != nil {
+		return fmt.Errorf("error parsing %s: %w", path, err)
+	}
+	return nil
+}
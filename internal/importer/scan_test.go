@@ -0,0 +1,182 @@
+package importer
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/zibbp/ganymede/internal/config"
+	"github.com/zibbp/ganymede/internal/storagetemplate"
+	"github.com/zibbp/ganymede/internal/utils"
+	tests_files "github.com/zibbp/ganymede/tests/files"
+)
+
+func newTestMatcher(t *testing.T) *storagetemplate.PathMatcher {
+	t.Helper()
+	matcher, err := storagetemplate.NewPathMatcher(config.StorageTemplate{
+		FolderTemplate:        "{{date}}-{{id}}-{{type}}-{{uuid}}",
+		FileTemplate:          "{{id}}",
+		ChannelFolderTemplate: "{{channel}}",
+	})
+	require.NoError(t, err)
+	return matcher
+}
+
+func findItem(t *testing.T, items []Item, videoPath string) Item {
+	t.Helper()
+	for _, item := range items {
+		if item.VideoPath == videoPath {
+			return item
+		}
+	}
+	require.Failf(t, "item not found", "no item for %s in %v", videoPath, items)
+	return Item{}
+}
+
+func TestScanDirectory(t *testing.T) {
+	t.Parallel()
+
+	videosDir := t.TempDir()
+	archiveDir := filepath.Join(videosDir, "streamer", "2024-01-02-123-archive-0d6b1b0e-3a5c-4d7f-9f3a-1c2b3d4e5f60")
+	tests_files.Write(t, filepath.Join(archiveDir, "123-video.mp4"), "video")
+	tests_files.Write(t, filepath.Join(archiveDir, "123-chat.json"), "{}")
+	tests_files.Write(t, filepath.Join(archiveDir, "123-chat.mp4"), "chat video")
+	tests_files.Write(t, filepath.Join(archiveDir, "123-info.json"), `{
+		"id": "123",
+		"user_id": "42",
+		"user_login": "streamer",
+		"user_name": "Streamer",
+		"title": "Archived stream",
+		"type": "archive",
+		"url": "https://www.twitch.tv/videos/123",
+		"category": "Just Chatting",
+		"created_at": "2024-01-02T15:04:05Z"
+	}`)
+
+	liveDir := filepath.Join(videosDir, "streamer", "2024-02-03-456-live-1d6b1b0e-3a5c-4d7f-9f3a-1c2b3d4e5f60")
+	tests_files.Write(t, filepath.Join(liveDir, "456-video_hls", "456-video.m3u8"), "#EXTM3U")
+	tests_files.Write(t, filepath.Join(liveDir, "456-video_hls", "456-video0.mp4"), "segment")
+
+	ytdlpDir := filepath.Join(videosDir, "downloads")
+	tests_files.Write(t, filepath.Join(ytdlpDir, "A video [abc].mp4"), "video")
+	tests_files.Write(t, filepath.Join(ytdlpDir, "A video [abc].info.json"), `{
+		"id": "abc",
+		"title": "A video",
+		"extractor_key": "Youtube",
+		"uploader": "Creator",
+		"uploader_id": "@creator",
+		"channel": "Creator",
+		"channel_id": "UC123",
+		"timestamp": 1704207845,
+		"live_status": "not_live"
+	}`)
+
+	tests_files.Write(t, filepath.Join(videosDir, "nfo", "video.mp4"), "video")
+	tests_files.Write(t, filepath.Join(videosDir, "nfo", "video.nfo"), `<movie><title>NFO title</title><premiered>2023-05-06</premiered><studio>Someone</studio><uniqueid type="kick" default="true">789</uniqueid></movie>`)
+
+	tests_files.Write(t, filepath.Join(videosDir, "unknown", "clip.mp4"), "video")
+	tests_files.Write(t, filepath.Join(videosDir, ".trash", "deleted.mp4"), "video")
+
+	items, err := scanDirectory(videosDir, videosDir, newTestMatcher(t))
+	require.NoError(t, err)
+	require.Len(t, items, 5)
+
+	archive := findItem(t, items, filepath.Join(archiveDir, "123-video.mp4"))
+	require.Equal(t, SourceInfoJSON, archive.Source)
+	require.Equal(t, utils.PlatformTwitch, archive.Platform)
+	require.Equal(t, utils.Archive, archive.Type)
+	require.Equal(t, "123", archive.ExtID)
+	require.Equal(t, "Archived stream", archive.Title)
+	require.Equal(t, "Just Chatting", archive.Category)
+	require.Equal(t, time.Date(2024, 1, 2, 15, 4, 5, 0, time.UTC), archive.StreamedAt)
+	require.Equal(t, "streamer", archive.ChannelName)
+	require.Equal(t, "42", archive.ChannelExtID)
+	require.Equal(t, "Streamer", archive.ChannelDisplayName)
+	require.Equal(t, filepath.Join(archiveDir, "123-chat.json"), archive.ChatPath)
+	require.Equal(t, filepath.Join(archiveDir, "123-chat.mp4"), archive.ChatVideoPath)
+	require.Empty(t, archive.Problems)
+
+	live := findItem(t, items, filepath.Join(liveDir, "456-video_hls", "456-video.m3u8"))
+	require.Equal(t, SourceTemplate, live.Source)
+	require.Equal(t, filepath.Join(liveDir, "456-video_hls"), live.VideoHLSPath)
+	require.Equal(t, utils.Live, live.Type)
+	require.Equal(t, "456", live.ExtID)
+	require.Equal(t, "456", live.Title)
+	require.Equal(t, "streamer", live.ChannelName)
+	require.Equal(t, time.Date(2024, 2, 3, 0, 0, 0, 0, time.UTC), live.StreamedAt)
+
+	ytdlp := findItem(t, items, filepath.Join(ytdlpDir, "A video [abc].mp4"))
+	require.Equal(t, SourceYtdlpInfoJSON, ytdlp.Source)
+	require.Equal(t, utils.PlatformYoutube, ytdlp.Platform)
+	require.Equal(t, utils.Upload, ytdlp.Type)
+	require.Equal(t, "abc", ytdlp.ExtID)
+	require.Equal(t, "creator", ytdlp.ChannelName)
+	require.Equal(t, "UC123", ytdlp.ChannelExtID)
+	require.Equal(t, "Creator", ytdlp.ChannelDisplayName)
+	require.Equal(t, time.Unix(1704207845, 0).UTC(), ytdlp.StreamedAt)
+
+	nfoItem := findItem(t, items, filepath.Join(videosDir, "nfo", "video.mp4"))
+	require.Equal(t, SourceNFO, nfoItem.Source)
+	require.Equal(t, utils.PlatformKick, nfoItem.Platform)
+	require.Equal(t, "789", nfoItem.ExtID)
+	require.Equal(t, "NFO title", nfoItem.Title)
+	require.Equal(t, "Someone", nfoItem.ChannelDisplayName)
+	require.Equal(t, time.Date(2023, 5, 6, 0, 0, 0, 0, time.UTC), nfoItem.StreamedAt)
+	// the NFO has the display name of the channel but not its name
+	require.Empty(t, nfoItem.ChannelName)
+	require.NotEmpty(t, nfoItem.Problems)
+
+	unknown := findItem(t, items, filepath.Join(videosDir, "unknown", "clip.mp4"))
+	require.Equal(t, SourceNone, unknown.Source)
+	require.Equal(t, "clip", unknown.Title)
+	require.Equal(t, utils.Archive, unknown.Type)
+	require.NotEmpty(t, unknown.Problems)
+}
+
+func TestFilesForVideo(t *testing.T) {
+	t.Parallel()
+
+	files, ok := filesForVideo("/data/videos/streamer/folder/123-video.mp4")
+	require.True(t, ok)
+	require.Equal(t, "folder", files.FolderName)
+	require.Equal(t, "123", files.FileName)
+	require.Equal(t, "/data/videos/streamer/folder/123-thumbnail.jpg", files.sibling("-thumbnail.jpg"))
+
+	files, ok = filesForVideo("/data/videos/streamer/folder/123-video_hls/123-video.m3u8")
+	require.True(t, ok)
+	require.Equal(t, "/data/videos/streamer/folder/123-video_hls", files.VideoHLSPath)
+	require.Equal(t, "folder", files.FolderName)
+	require.Equal(t, "123", files.FileName)
+
+	for _, path := range []string{
+		"/data/videos/streamer/folder/123-chat.mp4",
+		"/data/videos/streamer/folder/123-video_hls/123-video0.mp4",
+		"/data/videos/streamer/folder/playlist.m3u8",
+		"/data/videos/streamer/folder/123-thumbnail.jpg",
+	} {
+		_, ok := filesForVideo(path)
+		require.False(t, ok, path)
+	}
+}
+
+func TestResolveDirectory(t *testing.T) {
+	t.Parallel()
+
+	directory, err := resolveDirectory("/data/videos/", "")
+	require.NoError(t, err)
+	require.Equal(t, "/data/videos", directory)
+
+	directory, err = resolveDirectory("/data/videos", "streamer")
+	require.NoError(t, err)
+	require.Equal(t, "/data/videos/streamer", directory)
+
+	directory, err = resolveDirectory("/data/videos", "/data/videos/streamer/folder")
+	require.NoError(t, err)
+	require.Equal(t, "/data/videos/streamer/folder", directory)
+
+	for _, directory := range []string{"/data/temp", "../temp", "/data/videos-old", "/data/videos/../temp"} {
+		_, err := resolveDirectory("/data/videos", directory)
+		require.ErrorIs(t, err, ErrInvalidDirectory, directory)
+	}
+}
@@ -30,6 +30,11 @@ func (s *Service) CheckWatchedChannelClips(ctx context.Context, logger zerolog.L
 	logger.Info().Msgf("checking %d channels for new clips", len(channels))
 
 	for _, watchedChannel := range channels {
+		// clips are only supported for Twitch
+		if watchedChannel.Edges.Channel.Platform != utils.PlatformTwitch {
+			logger.Debug().Str("channel", watchedChannel.Edges.Channel.Name).Msg("clips are not supported for channel platform, skipping")
+			continue
+		}
 
 		if watchedChannel.ClipsLimit == 0 || watchedChannel.ClipsIntervalDays == 0 {
 			logger.Error().Err(err).Str("channel", watchedChannel.Edges.Channel.Name).Msg("clip limit and clips interval must be greater than 0")
@@ -103,10 +108,11 @@ func (s *Service) CheckWatchedChannelClips(ctx context.Context, logger zerolog.L
 			if !contains(dbVideos, clip.ID) {
 				// Archive clip
 				input := archive.ArchiveClipInput{
-					ID:          clip.ID,
-					Quality:     utils.VodQuality(watchedChannel.VodResolution),
-					ArchiveChat: watchedChannel.ArchiveChat,
-					RenderChat:  watchedChannel.RenderChat,
+					ID:                   clip.ID,
+					Quality:              utils.VodQuality(watchedChannel.VodResolution),
+					ArchiveChat:          watchedChannel.ArchiveChat,
+					RenderChat:           watchedChannel.RenderChat,
+					TranscodingProfileID: watchedChannel.TranscodingProfileID,
 				}
 				_, err = s.ArchiveService.ArchiveClip(ctx, input)
 				if err != nil {
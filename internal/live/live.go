@@ -15,6 +15,7 @@ import (
 	"github.com/zibbp/ganymede/ent/channel"
 	"github.com/zibbp/ganymede/ent/live"
 	"github.com/zibbp/ganymede/ent/livecategory"
+	"github.com/zibbp/ganymede/ent/liveschedule"
 	"github.com/zibbp/ganymede/ent/livetitleregex"
 	entQueue "github.com/zibbp/ganymede/ent/queue"
 	entVod "github.com/zibbp/ganymede/ent/vod"
@@ -32,6 +33,8 @@ type Service struct {
 	Store               *database.Database
 	ArchiveService      *archive.Service
 	PlatformTwitch      platform.Platform
+	PlatformYoutube     platform.Platform
+	PlatformKick        platform.Platform
 	ChapterService      *chapter.Service
 	QueueService        *queue.Service
 	NotificationService *notification.Service
@@ -50,6 +53,7 @@ type Live struct {
 	VodResolution          string               `json:"vod_resolution"`
 	LastLive               time.Time            `json:"last_live"`
 	RenderChat             bool                 `json:"render_chat"`
+	GenerateCaptions       bool                 `json:"generate_captions"` // Generate captions with speech-to-text after archiving.
 	DownloadSubOnly        bool                 `json:"download_sub_only"`
 	Categories             []string             `json:"categories"`               // List of category names
 	ApplyCategoriesToLive  bool                 `json:"apply_categories_to_live"` // Apply category restrictions to live streams
@@ -61,7 +65,13 @@ type Live struct {
 	ClipsLimit             int                  `json:"clips_limit"`
 	ClipsIntervalDays      int                  `json:"clips_interval_days"`
 	ClipsIgnoreLastChecked bool                 `json:"clips_ignore_last_checked"`
-	UpdateMetadataMinutes  int                  `json:"update_metadata_minutes"` // Queue metadata update X minutes after the stream is live. Set to 0 to disable.
+	UpdateMetadataMinutes  int                  `json:"update_metadata_minutes"`  // Queue metadata update X minutes after the stream is live. Set to 0 to disable.
+	TranscodingProfileID   *uuid.UUID           `json:"transcoding_profile_id"`   // Post-process archives with the transcoding profile instead of the global video convert arguments.
+	QualityFallbacks       []string             `json:"quality_fallbacks"`        // Qualities tried in order when archiving the live stream at the resolution keeps failing.
+	RecordAudioFallback    bool                 `json:"record_audio_fallback"`    // Record the audio of live streams alongside the video as a fallback.
+	SplitHours             int                  `json:"split_hours"`              // Split live stream archives into parts every X hours. Set to 0 to disable.
+	SplitOnCategoryChange  bool                 `json:"split_on_category_change"` // Split live stream archives into parts when the category changes.
+	Schedule               *ent.LiveSchedule    `json:"schedule"`                 // Restricts when live streams are archived. Live streams are archived at any time if nil.
 }
 
 type ConvertChat struct {
@@ -80,8 +90,8 @@ type ArchiveLive struct {
 	RenderChat  bool      `json:"render_chat"`
 }
 
-func NewService(store *database.Database, archiveService *archive.Service, platformTwitch platform.Platform, chapterService *chapter.Service, queueService *queue.Service, notificationService *notification.Service) *Service {
-	return &Service{Store: store, ArchiveService: archiveService, PlatformTwitch: platformTwitch, ChapterService: chapterService, QueueService: queueService, NotificationService: notificationService}
+func NewService(store *database.Database, archiveService *archive.Service, platformTwitch platform.Platform, platformYoutube platform.Platform, platformKick platform.Platform, chapterService *chapter.Service, queueService *queue.Service, notificationService *notification.Service) *Service {
+	return &Service{Store: store, ArchiveService: archiveService, PlatformTwitch: platformTwitch, PlatformYoutube: platformYoutube, PlatformKick: platformKick, ChapterService: chapterService, QueueService: queueService, NotificationService: notificationService}
 }
 
 // ResetLiveStatus sets is_live=false for all watched channels.
@@ -97,13 +107,42 @@ func (s *Service) ResetLiveStatus(ctx context.Context) error {
 }
 
 func (s *Service) GetLiveWatchedChannels(c echo.Context) ([]*ent.Live, error) {
-	watchedChannels, err := s.Store.Client.Live.Query().WithChannel().WithCategories().WithTitleRegex().All(c.Request().Context())
+	watchedChannels, err := s.Store.Client.Live.Query().WithChannel().WithCategories().WithTitleRegex().WithSchedule().All(c.Request().Context())
 	if err != nil {
 		return nil, fmt.Errorf("error getting watched channels: %v", err)
 	}
 	return watchedChannels, nil
 }
 
+// validateQualityFallbacks validates the qualities tried when archiving the live stream at the resolution
+// keeps failing.
+//
+// MPEG-TS archives are stitched from parts with the same streams, so unless archives are saved as HLS the
+// chain can't switch between audio and video qualities. Use the audio recording fallback to keep the audio
+// of a failing video archive instead.
+func validateQualityFallbacks(resolution string, fallbacks []string, saveAsHls bool) error {
+	for _, quality := range fallbacks {
+		if !utils.ValidLiveQuality(quality) {
+			return fmt.Errorf("invalid fallback quality: %s", quality)
+		}
+	}
+	if len(fallbacks) == 0 || saveAsHls {
+		return nil
+	}
+	audio, video := false, false
+	for _, quality := range utils.QualityChain(resolution, fallbacks) {
+		if quality == "audio" {
+			audio = true
+		} else {
+			video = true
+		}
+	}
+	if audio && video {
+		return fmt.Errorf("the audio quality can't be combined with video qualities unless archives are saved as HLS")
+	}
+	return nil
+}
+
 func (s *Service) AddLiveWatchedChannel(ctx context.Context, liveDto Live) (*ent.Live, error) {
 	// Check if channel is already in database
 	liveWatchedChannel, err := s.Store.Client.Live.Query().WithChannel().Where(live.HasChannelWith(channel.ID(liveDto.ID))).All(context.Background())
@@ -121,6 +160,14 @@ func (s *Service) AddLiveWatchedChannel(ctx context.Context, liveDto Live) (*ent
 	if liveDto.VodResolution == "" {
 		liveDto.VodResolution = liveDto.Resolution
 	}
+	if err := validateQualityFallbacks(liveDto.Resolution, liveDto.QualityFallbacks, config.Get().Archive.SaveAsHls); err != nil {
+		return nil, err
+	}
+	if liveDto.Schedule != nil {
+		if err := validateSchedule(liveDto.Schedule); err != nil {
+			return nil, err
+		}
+	}
 
 	l, err := s.Store.Client.Live.Create().
 		SetChannelID(liveDto.ID).
@@ -133,6 +180,7 @@ func (s *Service) AddLiveWatchedChannel(ctx context.Context, liveDto Live) (*ent
 		SetVodResolution(liveDto.VodResolution).
 		SetArchiveChat(liveDto.ArchiveChat).
 		SetRenderChat(liveDto.RenderChat).
+		SetGenerateCaptions(liveDto.GenerateCaptions).
 		SetDownloadSubOnly(liveDto.DownloadSubOnly).
 		SetVideoAge(liveDto.VideoAge).
 		SetApplyCategoriesToLive(liveDto.ApplyCategoriesToLive).
@@ -143,6 +191,11 @@ func (s *Service) AddLiveWatchedChannel(ctx context.Context, liveDto Live) (*ent
 		SetClipsIntervalDays(liveDto.ClipsIntervalDays).
 		SetClipsIgnoreLastChecked(liveDto.ClipsIgnoreLastChecked).
 		SetUpdateMetadataMinutes(liveDto.UpdateMetadataMinutes).
+		SetNillableTranscodingProfileID(liveDto.TranscodingProfileID).
+		SetQualityFallbacks(liveDto.QualityFallbacks).
+		SetRecordAudioFallback(liveDto.RecordAudioFallback).
+		SetSplitHours(liveDto.SplitHours).
+		SetSplitOnCategoryChange(liveDto.SplitOnCategoryChange).
 		Save(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("error adding watched channel: %v", err)
@@ -165,6 +218,12 @@ func (s *Service) AddLiveWatchedChannel(ctx context.Context, liveDto Live) (*ent
 			}
 		}
 	}
+	// add schedule
+	if liveDto.Schedule != nil {
+		if err := s.createSchedule(ctx, l.ID, liveDto.Schedule); err != nil {
+			return nil, err
+		}
+	}
 	return l, nil
 }
 
@@ -176,8 +235,22 @@ func (s *Service) UpdateLiveWatchedChannel(ctx context.Context, liveDto Live) (*
 	if liveDto.VodResolution == "" {
 		liveDto.VodResolution = liveDto.Resolution
 	}
+	if err := validateQualityFallbacks(liveDto.Resolution, liveDto.QualityFallbacks, config.Get().Archive.SaveAsHls); err != nil {
+		return nil, err
+	}
+	if liveDto.Schedule != nil {
+		if err := validateSchedule(liveDto.Schedule); err != nil {
+			return nil, err
+		}
+	}
 
-	l, err := s.Store.Client.Live.UpdateOneID(liveDto.ID).
+	update := s.Store.Client.Live.UpdateOneID(liveDto.ID)
+	if liveDto.TranscodingProfileID != nil {
+		update.SetTranscodingProfileID(*liveDto.TranscodingProfileID)
+	} else {
+		update.ClearTranscodingProfileID()
+	}
+	l, err := update.
 		SetWatchLive(liveDto.WatchLive).
 		SetWatchVod(liveDto.WatchVod).
 		SetDownloadArchives(liveDto.DownloadArchives).
@@ -187,6 +260,7 @@ func (s *Service) UpdateLiveWatchedChannel(ctx context.Context, liveDto Live) (*
 		SetVodResolution(liveDto.VodResolution).
 		SetArchiveChat(liveDto.ArchiveChat).
 		SetRenderChat(liveDto.RenderChat).
+		SetGenerateCaptions(liveDto.GenerateCaptions).
 		SetDownloadSubOnly(liveDto.DownloadSubOnly).
 		SetVideoAge(liveDto.VideoAge).
 		SetApplyCategoriesToLive(liveDto.ApplyCategoriesToLive).
@@ -197,6 +271,10 @@ func (s *Service) UpdateLiveWatchedChannel(ctx context.Context, liveDto Live) (*
 		SetClipsIgnoreLastChecked(liveDto.ClipsIgnoreLastChecked).
 		SetWatchClips(liveDto.WatchClips).
 		SetUpdateMetadataMinutes(liveDto.UpdateMetadataMinutes).
+		SetQualityFallbacks(liveDto.QualityFallbacks).
+		SetRecordAudioFallback(liveDto.RecordAudioFallback).
+		SetSplitHours(liveDto.SplitHours).
+		SetSplitOnCategoryChange(liveDto.SplitOnCategoryChange).
 		Save(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("error updating watched channel: %v", err)
@@ -235,9 +313,35 @@ func (s *Service) UpdateLiveWatchedChannel(ctx context.Context, liveDto Live) (*
 		}
 	}
 
+	// replace schedule
+	_, err = s.Store.Client.LiveSchedule.Delete().Where(liveschedule.LiveID(liveDto.ID)).Exec(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("error deleting schedule: %v", err)
+	}
+	if liveDto.Schedule != nil {
+		if err := s.createSchedule(ctx, l.ID, liveDto.Schedule); err != nil {
+			return nil, err
+		}
+	}
+
 	return l, nil
 }
 
+// createSchedule adds the schedule to the watched channel.
+func (s *Service) createSchedule(ctx context.Context, liveID uuid.UUID, schedule *ent.LiveSchedule) error {
+	_, err := s.Store.Client.LiveSchedule.Create().
+		SetLiveID(liveID).
+		SetTimezone(schedule.Timezone).
+		SetWindows(schedule.Windows).
+		SetMaxStreamHours(schedule.MaxStreamHours).
+		SetFirstStreamOfDay(schedule.FirstStreamOfDay).
+		Save(ctx)
+	if err != nil {
+		return fmt.Errorf("error adding schedule: %v", err)
+	}
+	return nil
+}
+
 func (s *Service) DeleteLiveWatchedChannel(c echo.Context, lID uuid.UUID) error {
 	// delete watched channel and categories
 	v, err := s.Store.Client.Live.Query().Where(live.ID(lID)).WithCategories().Only(c.Request().Context())
@@ -268,7 +372,7 @@ func (s *Service) Check(ctx context.Context) error {
 	// get live watched channels from database
 	liveWatchedChannels, err := s.Store.Client.Live.Query().Where(live.WatchLive(true)).WithChannel().WithCategories().WithTitleRegex(func(ltrq *ent.LiveTitleRegexQuery) {
 		ltrq.Where(livetitleregex.ApplyToVideosEQ(false))
-	}).All(context.Background())
+	}).WithSchedule().All(context.Background())
 	if err != nil {
 		log.Error().Err(err).Msg("error getting live watched channels")
 	}
@@ -277,52 +381,75 @@ func (s *Service) Check(ctx context.Context) error {
 		return nil
 	}
 
-	// split into 99 channels per requests to avoid 100 channel limit
-	var liveWatchedChannelsSplit [][]*ent.Live
-	for i := 0; i < len(liveWatchedChannels); i += 99 {
-		end := i + 99
-		if end > len(liveWatchedChannels) {
-			end = len(liveWatchedChannels)
-		}
-		liveWatchedChannelsSplit = append(liveWatchedChannelsSplit, liveWatchedChannels[i:end])
+	// group channels by platform as each platform is queried separately
+	liveWatchedChannelsByPlatform := make(map[utils.VideoPlatform][]*ent.Live)
+	for _, lwc := range liveWatchedChannels {
+		liveWatchedChannelsByPlatform[lwc.Edges.Channel.Platform] = append(liveWatchedChannelsByPlatform[lwc.Edges.Channel.Platform], lwc)
 	}
 
 	var streams []platform.LiveStreamInfo
-	// generate query string for twitch api
-	for _, lwc := range liveWatchedChannelsSplit {
-		channelIDs := make([]string, 0, len(lwc))
-		for _, lwc := range lwc {
-			if lwc.Edges.Channel.ExtID == "" {
-				log.Warn().
-					Str("channel_id", lwc.Edges.Channel.ID.String()).
-					Msg("missing twitch external ID; skipping live check for this channel")
-				continue
+	// channels whose live status couldn't be fetched are not set offline
+	unchecked := make(map[uuid.UUID]struct{})
+	for videoPlatform, platformLiveWatchedChannels := range liveWatchedChannelsByPlatform {
+		livePlatform, err := platform.Resolve(videoPlatform, s.PlatformTwitch, s.PlatformYoutube, s.PlatformKick)
+		if err != nil {
+			log.Warn().Err(err).Str("platform", string(videoPlatform)).Msg("skipping live check for channels of platform")
+			for _, lwc := range platformLiveWatchedChannels {
+				unchecked[lwc.ID] = struct{}{}
 			}
-			channelIDs = append(channelIDs, lwc.Edges.Channel.ExtID)
-		}
-		if len(channelIDs) == 0 {
 			continue
 		}
-		log.Debug().Str("channels", strings.Join(channelIDs, ", ")).Msg("checking live streams")
-		twitchStreams, err := s.PlatformTwitch.GetLiveStreams(ctx, channelIDs)
-		if err != nil {
-			var e platform.ErrorNoStreamsFound
-			if errors.As(err, &e) {
-				log.Debug().Msgf("live stream not found for channels: %s, skipping", strings.Join(channelIDs, ", "))
-				continue
-			} else {
-				return fmt.Errorf("error getting live streams: %v", err)
+
+		// split into 99 channels per requests to avoid 100 channel limit
+		var liveWatchedChannelsSplit [][]*ent.Live
+		for i := 0; i < len(platformLiveWatchedChannels); i += 99 {
+			end := i + 99
+			if end > len(platformLiveWatchedChannels) {
+				end = len(platformLiveWatchedChannels)
 			}
+			liveWatchedChannelsSplit = append(liveWatchedChannelsSplit, platformLiveWatchedChannels[i:end])
 		}
 
-		streams = append(streams, twitchStreams...)
+		// generate query string for platform api
+		for _, lwc := range liveWatchedChannelsSplit {
+			channelIDs := make([]string, 0, len(lwc))
+			for _, lwc := range lwc {
+				if lwc.Edges.Channel.ExtID == "" {
+					log.Warn().
+						Str("channel_id", lwc.Edges.Channel.ID.String()).
+						Msgf("missing %s external ID; skipping live check for this channel", videoPlatform)
+					continue
+				}
+				channelIDs = append(channelIDs, lwc.Edges.Channel.ExtID)
+			}
+			if len(channelIDs) == 0 {
+				continue
+			}
+			log.Debug().Str("platform", string(videoPlatform)).Str("channels", strings.Join(channelIDs, ", ")).Msg("checking live streams")
+			platformStreams, err := livePlatform.GetLiveStreams(ctx, channelIDs)
+			if err != nil {
+				var e platform.ErrorNoStreamsFound
+				if errors.As(err, &e) {
+					log.Debug().Msgf("live stream not found for channels: %s, skipping", strings.Join(channelIDs, ", "))
+					continue
+				}
+				// the other platforms are still checked
+				log.Error().Err(err).Str("platform", string(videoPlatform)).Str("channels", strings.Join(channelIDs, ", ")).Msg("error getting live streams")
+				for _, lwc := range lwc {
+					unchecked[lwc.ID] = struct{}{}
+				}
+				continue
+			}
+
+			streams = append(streams, platformStreams...)
+		}
 	}
 
 	// check if live stream is online
 OUTER:
 	for _, lwc := range liveWatchedChannels {
 		// Check if LWC is in twitchStreams.Data
-		stream := channelInLiveStreamInfo(lwc.Edges.Channel.ExtID, streams)
+		stream := channelInLiveStreamInfo(lwc.Edges.Channel.Platform, lwc.Edges.Channel.ExtID, streams)
 		if len(stream.ID) > 0 {
 			// Build map of channel watched categories
 			watchedChannelCategories := make(map[string]struct{}, len(lwc.Edges.Categories))
@@ -339,15 +466,8 @@ OUTER:
 				if _, found := watchedChannelCategories[strings.ToLower(stream.GameName)]; !found {
 					log.Info().Str("channel", lwc.Edges.Channel.Name).Str("category", stream.GameName).Str("category_restrictions", strings.Join(categoryNamesForLog, ", ")).Msg("stream does not match selected categories, stopping archive")
 					// Stop archive
-					video, err := s.Store.Client.Vod.Query().Where(entVod.ExtStreamID(stream.ID)).WithChannel().WithQueue().Order(ent.Desc(entVod.FieldCreatedAt)).First(ctx)
-					if err != nil {
-						log.Error().Err(err).Msg("error getting video")
-						continue OUTER
-					}
-					err = s.QueueService.StopQueueItem(ctx, video.Edges.Queue.ID)
-					if err != nil {
+					if err := s.stopLiveStreamArchive(ctx, stream); err != nil {
 						log.Error().Err(err).Msg("error stopping live stream archive")
-						continue OUTER
 					}
 					// Continue to next liveWatchedChannel
 					continue OUTER
@@ -356,6 +476,27 @@ OUTER:
 				}
 			}
 
+			// Stop the archive if the schedule of the watched channel no longer allows it
+			if lwc.IsLive && lwc.Edges.Schedule != nil {
+				stop, reason, err := s.scheduleEndsArchive(ctx, lwc, stream, time.Now())
+				if err != nil {
+					log.Error().Err(err).Str("channel", lwc.Edges.Channel.Name).Msg("error checking schedule of live stream archive")
+				} else if stop {
+					log.Info().Str("channel", lwc.Edges.Channel.Name).Str("reason", reason).Msg("stopping live stream archive")
+					if err := s.stopLiveStreamArchive(ctx, stream); err != nil {
+						log.Error().Err(err).Msg("error stopping live stream archive")
+					}
+					continue OUTER
+				}
+			}
+
+			// Split the archive into a new part if the watched channel splits archives
+			if lwc.IsLive && (lwc.SplitHours > 0 || lwc.SplitOnCategoryChange) {
+				if err := s.splitLiveStreamArchive(ctx, lwc, stream); err != nil {
+					log.Error().Err(err).Str("channel", lwc.Edges.Channel.Name).Msg("error splitting live stream archive")
+				}
+			}
+
 			// Run chapter update, this needs to be done before additional checks to cover the case where a stream is being archived but fails restriction checks
 			err = s.updateLiveStreamArchiveChapter(stream)
 			if err != nil {
@@ -409,13 +550,26 @@ OUTER:
 					}
 				}
 
+				// check the schedule
+				if lwc.Edges.Schedule != nil {
+					allowed, reason, err := s.scheduleAllowsArchive(ctx, lwc, stream, time.Now())
+					if err != nil {
+						log.Error().Err(err).Str("channel", lwc.Edges.Channel.Name).Msg("error checking schedule, skipping")
+						continue OUTER
+					}
+					if !allowed {
+						log.Debug().Str("channel", lwc.Edges.Channel.Name).Str("reason", reason).Msg("schedule does not allow archiving stream")
+						continue OUTER
+					}
+				}
+
 				// check if stream is already being archived
 				queueItems, err := database.DB().Client.Queue.Query().Where(entQueue.Processing(true)).WithVod().All(ctx)
 				if err != nil {
 					log.Error().Err(err).Msg("error getting queue items")
 				}
 				for _, queueItem := range queueItems {
-					if queueItem.Edges.Vod.ExtID == stream.ID && queueItem.TaskVideoDownload == utils.Running {
+					if queueItem.Edges.Vod.ExtID == stream.ID && queueItem.Edges.Vod.Platform == stream.Platform && queueItem.TaskVideoDownload == utils.Running {
 						log.Debug().Msgf("%s is already being archived", lwc.Edges.Channel.Name)
 						continue OUTER
 					}
@@ -425,7 +579,12 @@ OUTER:
 				// This is behind an experimental flag
 				if config.Get().Experimental.BetterLiveStreamDetectionAndCleanup {
 					log.Debug().Msgf("checking if %s is really live", lwc.Edges.Channel.Name)
-					isLive, err := s.PlatformTwitch.CheckIfStreamIsLive(ctx, lwc.Edges.Channel.Name)
+					livePlatform, err := platform.Resolve(lwc.Edges.Channel.Platform, s.PlatformTwitch, s.PlatformYoutube, s.PlatformKick)
+					if err != nil {
+						log.Error().Err(err).Msg("error checking if stream is live")
+						continue OUTER
+					}
+					isLive, err := livePlatform.CheckIfStreamIsLive(ctx, lwc.Edges.Channel.Name)
 					if err != nil {
 						log.Error().Err(err).Msg("error checking if stream is live")
 						continue OUTER
@@ -438,13 +597,16 @@ OUTER:
 
 				// Archive stream
 				_, err = s.ArchiveService.ArchiveLivestream(ctx, archive.ArchiveVideoInput{
-					ChannelId:   lwc.Edges.Channel.ID,
-					Quality:     utils.VodQuality(lwc.Resolution),
-					ArchiveChat: lwc.ArchiveChat,
-					RenderChat:  lwc.RenderChat,
+					ChannelId:            lwc.Edges.Channel.ID,
+					Quality:              utils.VodQuality(lwc.Resolution),
+					ArchiveChat:          lwc.ArchiveChat,
+					RenderChat:           lwc.RenderChat,
+					TranscodingProfileID: lwc.TranscodingProfileID,
+					QualityFallbacks:     lwc.QualityFallbacks,
+					RecordAudioFallback:  lwc.RecordAudioFallback,
 				})
 				if err != nil {
-					log.Error().Err(err).Msg("error archiving twitch livestream")
+					log.Error().Err(err).Msgf("error archiving %s livestream", lwc.Edges.Channel.Platform)
 					continue
 				}
 
@@ -458,7 +620,7 @@ OUTER:
 
 				// Notification
 				// Fetch vod for notification and chapter creation
-				vod, err := s.Store.Client.Vod.Query().Where(entVod.ExtStreamID(stream.ID)).WithChannel().WithQueue().Order(ent.Desc(entVod.FieldCreatedAt)).First(ctx)
+				vod, err := s.Store.Client.Vod.Query().Where(entVod.ExtStreamID(stream.ID), entVod.PlatformEQ(stream.Platform), entVod.TypeEQ(utils.Live)).WithChannel().WithQueue().Order(ent.Desc(entVod.FieldCreatedAt)).First(ctx)
 				if err != nil {
 					log.Error().Err(err).Msg("error getting vod")
 					continue
@@ -489,6 +651,9 @@ OUTER:
 
 			}
 		} else {
+			if _, ok := unchecked[lwc.ID]; ok {
+				continue
+			}
 			if lwc.IsLive {
 				log.Debug().Msgf("%s is now offline", lwc.Edges.Channel.Name)
 				// Stream is offline, update database
@@ -502,10 +667,23 @@ OUTER:
 	return nil
 }
 
-// channelInLiveStreamInfo searches for a string in a slice of LiveStreamInfo and returns the first match.
-func channelInLiveStreamInfo(a string, list []platform.LiveStreamInfo) platform.LiveStreamInfo {
+// stopLiveStreamArchive stops the latest archive of the live stream. The download is cancelled and the
+// archive is finalized with what was recorded.
+func (s *Service) stopLiveStreamArchive(ctx context.Context, stream platform.LiveStreamInfo) error {
+	video, err := s.Store.Client.Vod.Query().Where(entVod.ExtStreamID(stream.ID), entVod.PlatformEQ(stream.Platform), entVod.TypeEQ(utils.Live)).WithChannel().WithQueue().Order(ent.Desc(entVod.FieldCreatedAt)).First(ctx)
+	if err != nil {
+		return fmt.Errorf("error getting video: %v", err)
+	}
+	if video.Edges.Queue == nil {
+		return fmt.Errorf("video %s has no queue item", video.ID)
+	}
+	return s.QueueService.StopQueueItem(ctx, video.Edges.Queue.ID)
+}
+
+// channelInLiveStreamInfo returns the first live stream of the channel on the platform. IDs of different platforms can be the same.
+func channelInLiveStreamInfo(videoPlatform utils.VideoPlatform, a string, list []platform.LiveStreamInfo) platform.LiveStreamInfo {
 	for _, b := range list {
-		if b.UserLogin == a || b.UserID == a {
+		if b.Platform == videoPlatform && (b.UserLogin == a || b.UserID == a) {
 			return b
 		}
 	}
@@ -515,7 +693,7 @@ func channelInLiveStreamInfo(a string, list []platform.LiveStreamInfo) platform.
 // updateLiveStreamArchiveChapter updates the last chapter of a live stream archive if the category has changed.
 func (s *Service) updateLiveStreamArchiveChapter(stream platform.LiveStreamInfo) error {
 	// Get video
-	video, err := s.Store.Client.Vod.Query().Where(entVod.ExtStreamID(stream.ID)).Order(ent.Desc(entVod.FieldCreatedAt)).First(context.Background())
+	video, err := s.Store.Client.Vod.Query().Where(entVod.ExtStreamID(stream.ID), entVod.PlatformEQ(stream.Platform), entVod.TypeEQ(utils.Live)).Order(ent.Desc(entVod.FieldCreatedAt)).First(context.Background())
 	if err != nil {
 		if _, ok := err.(*ent.NotFoundError); ok {
 			// Video not found, likely not archived yet because of restrictions
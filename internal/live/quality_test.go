@@ -0,0 +1,20 @@
+package live
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateQualityFallbacks(t *testing.T) {
+	t.Parallel()
+
+	require.NoError(t, validateQualityFallbacks("best", []string{"720p60", "480p30"}, false))
+	require.NoError(t, validateQualityFallbacks("audio", nil, false))
+	require.Error(t, validateQualityFallbacks("best", []string{"4k"}, false))
+
+	// MPEG-TS archives can't switch between audio and video
+	require.Error(t, validateQualityFallbacks("best", []string{"720p60", "audio"}, false))
+	require.Error(t, validateQualityFallbacks("audio", []string{"best"}, false))
+	require.NoError(t, validateQualityFallbacks("best", []string{"720p60", "audio"}, true))
+}
@@ -0,0 +1,137 @@
+package live
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/zibbp/ganymede/ent"
+	"github.com/zibbp/ganymede/ent/channel"
+	entVod "github.com/zibbp/ganymede/ent/vod"
+	"github.com/zibbp/ganymede/internal/platform"
+	"github.com/zibbp/ganymede/internal/utils"
+)
+
+// validateSchedule returns an error if the time zone or a window of the schedule is invalid.
+func validateSchedule(schedule *ent.LiveSchedule) error {
+	if _, err := time.LoadLocation(schedule.Timezone); err != nil {
+		return fmt.Errorf("invalid schedule timezone: %s", schedule.Timezone)
+	}
+	if schedule.MaxStreamHours < 0 {
+		return fmt.Errorf("max stream hours cannot be negative")
+	}
+	for _, window := range schedule.Windows {
+		if err := window.Validate(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// scheduleWindowOpen returns whether the time is inside a window of the schedule. Schedules without windows
+// are always open.
+func scheduleWindowOpen(schedule *ent.LiveSchedule, now time.Time) (bool, error) {
+	if len(schedule.Windows) == 0 {
+		return true, nil
+	}
+	location, err := time.LoadLocation(schedule.Timezone)
+	if err != nil {
+		return false, fmt.Errorf("invalid schedule timezone: %s", schedule.Timezone)
+	}
+	now = now.In(location)
+	for _, window := range schedule.Windows {
+		if window.Contains(now) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// scheduleDayStart returns the start of the day of the time in the time zone of the schedule.
+func scheduleDayStart(schedule *ent.LiveSchedule, now time.Time) (time.Time, error) {
+	location, err := time.LoadLocation(schedule.Timezone)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid schedule timezone: %s", schedule.Timezone)
+	}
+	now = now.In(location)
+	return time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, location), nil
+}
+
+// streamArchiveLimitReached returns whether the stream has been archived for the max stream hours of the
+// schedule, counted from the first archive of the stream.
+func (s *Service) streamArchiveLimitReached(ctx context.Context, schedule *ent.LiveSchedule, stream platform.LiveStreamInfo, now time.Time) (bool, error) {
+	if schedule.MaxStreamHours == 0 {
+		return false, nil
+	}
+	first, err := s.Store.Client.Vod.Query().Where(entVod.ExtStreamID(stream.ID), entVod.PlatformEQ(stream.Platform), entVod.TypeEQ(utils.Live)).Order(ent.Asc(entVod.FieldCreatedAt)).First(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return false, nil
+		}
+		return false, err
+	}
+	return now.Sub(first.CreatedAt) >= time.Duration(schedule.MaxStreamHours)*time.Hour, nil
+}
+
+// scheduleAllowsArchive returns whether the schedule of the watched channel allows archiving the live stream
+// now, and why not if it doesn't.
+func (s *Service) scheduleAllowsArchive(ctx context.Context, lwc *ent.Live, stream platform.LiveStreamInfo, now time.Time) (bool, string, error) {
+	schedule := lwc.Edges.Schedule
+	open, err := scheduleWindowOpen(schedule, now)
+	if err != nil {
+		return false, "", err
+	}
+	if !open {
+		return false, "outside of the schedule windows", nil
+	}
+
+	limitReached, err := s.streamArchiveLimitReached(ctx, schedule, stream, now)
+	if err != nil {
+		return false, "", err
+	}
+	if limitReached {
+		return false, fmt.Sprintf("stream was archived for %d hours", schedule.MaxStreamHours), nil
+	}
+
+	if schedule.FirstStreamOfDay {
+		dayStart, err := scheduleDayStart(schedule, now)
+		if err != nil {
+			return false, "", err
+		}
+		archived, err := s.Store.Client.Vod.Query().Where(
+			entVod.HasChannelWith(channel.ID(lwc.Edges.Channel.ID)),
+			entVod.TypeEQ(utils.Live),
+			entVod.ExtStreamIDNEQ(stream.ID),
+			entVod.StreamedAtGTE(dayStart),
+		).Exist(ctx)
+		if err != nil {
+			return false, "", err
+		}
+		if archived {
+			return false, "a stream was already archived today", nil
+		}
+	}
+	return true, "", nil
+}
+
+// scheduleEndsArchive returns whether the running archive of the live stream should be stopped because the
+// schedule of the watched channel no longer allows it, and why.
+func (s *Service) scheduleEndsArchive(ctx context.Context, lwc *ent.Live, stream platform.LiveStreamInfo, now time.Time) (bool, string, error) {
+	schedule := lwc.Edges.Schedule
+	open, err := scheduleWindowOpen(schedule, now)
+	if err != nil {
+		return false, "", err
+	}
+	if !open {
+		return true, "schedule window ended", nil
+	}
+
+	limitReached, err := s.streamArchiveLimitReached(ctx, schedule, stream, now)
+	if err != nil {
+		return false, "", err
+	}
+	if limitReached {
+		return true, fmt.Sprintf("stream was archived for %d hours", schedule.MaxStreamHours), nil
+	}
+	return false, "", nil
+}
@@ -0,0 +1,130 @@
+package live
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/rs/zerolog/log"
+	"github.com/zibbp/ganymede/ent"
+	entPlaylist "github.com/zibbp/ganymede/ent/playlist"
+	entVod "github.com/zibbp/ganymede/ent/vod"
+	"github.com/zibbp/ganymede/internal/archive"
+	"github.com/zibbp/ganymede/internal/chapter"
+	"github.com/zibbp/ganymede/internal/platform"
+	"github.com/zibbp/ganymede/internal/utils"
+)
+
+// liveArchiveSplitReason returns why the current part of the live stream archive should be split, or an
+// empty string if it shouldn't.
+func liveArchiveSplitReason(lwc *ent.Live, current *ent.Vod, stream platform.LiveStreamInfo, now time.Time) string {
+	if lwc.SplitHours > 0 && now.Sub(current.CreatedAt) >= time.Duration(lwc.SplitHours)*time.Hour {
+		return fmt.Sprintf("part was archived for %d hours", lwc.SplitHours)
+	}
+	if lwc.SplitOnCategoryChange && stream.GameName != "" && !strings.EqualFold(stream.GameName, current.Category) {
+		return fmt.Sprintf("category changed from %s to %s", current.Category, stream.GameName)
+	}
+	return ""
+}
+
+// splitLiveStreamArchive starts archiving the next part of the live stream if the current part should be
+// split, then stops the current part. The next part is started first so nothing is missed between the parts.
+func (s *Service) splitLiveStreamArchive(ctx context.Context, lwc *ent.Live, stream platform.LiveStreamInfo) error {
+	current, err := s.Store.Client.Vod.Query().Where(entVod.ExtStreamID(stream.ID), entVod.PlatformEQ(stream.Platform), entVod.TypeEQ(utils.Live)).WithQueue().Order(ent.Desc(entVod.FieldCreatedAt)).First(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil
+		}
+		return fmt.Errorf("error getting video: %v", err)
+	}
+	// only split parts that are still being archived
+	if current.Edges.Queue == nil || !current.Edges.Queue.Processing || (current.Edges.Queue.TaskVideoDownload != utils.Pending && current.Edges.Queue.TaskVideoDownload != utils.Running) {
+		return nil
+	}
+
+	reason := liveArchiveSplitReason(lwc, current, stream, time.Now())
+	if reason == "" {
+		return nil
+	}
+	log.Info().Str("channel", lwc.Edges.Channel.Name).Str("reason", reason).Msg("splitting live stream archive")
+
+	if current.Part == 0 {
+		current, err = current.Update().SetPart(1).Save(ctx)
+		if err != nil {
+			return fmt.Errorf("error updating part of video: %v", err)
+		}
+	}
+
+	next, err := s.ArchiveService.ArchiveLivestream(ctx, archive.ArchiveVideoInput{
+		ChannelId:            lwc.Edges.Channel.ID,
+		Quality:              utils.VodQuality(lwc.Resolution),
+		ArchiveChat:          lwc.ArchiveChat,
+		RenderChat:           lwc.RenderChat,
+		TranscodingProfileID: lwc.TranscodingProfileID,
+		QualityFallbacks:     lwc.QualityFallbacks,
+		RecordAudioFallback:  lwc.RecordAudioFallback,
+		Part:                 current.Part + 1,
+	})
+	if err != nil {
+		return fmt.Errorf("error archiving next part: %v", err)
+	}
+
+	if err := s.QueueService.StopQueueItem(ctx, current.Edges.Queue.ID); err != nil {
+		log.Error().Err(err).Str("video_id", current.ID.String()).Msg("error stopping previous part of live stream archive")
+	}
+
+	// Create initial chapter of the next part
+	_, err = s.ChapterService.CreateChapter(chapter.Chapter{
+		Type:  string(utils.ChapterTypeGameChange),
+		Start: 0,
+		End:   0,
+		Title: stream.GameName,
+	}, next.Video.ID)
+	if err != nil {
+		log.Error().Err(err).Msg("error creating initial chapter")
+	}
+
+	if err := s.addLiveStreamPartsToPlaylist(ctx, lwc, stream, current, next.Video); err != nil {
+		log.Error().Err(err).Str("stream_id", stream.ID).Msg("error adding parts of live stream archive to playlist")
+	}
+
+	log.Info().Str("channel", lwc.Edges.Channel.Name).Msgf("started part %d of live archive", current.Part+1)
+	return nil
+}
+
+// addLiveStreamPartsToPlaylist adds the parts of the live stream archive to the playlist of the stream,
+// creating the playlist when the stream is split for the first time.
+func (s *Service) addLiveStreamPartsToPlaylist(ctx context.Context, lwc *ent.Live, stream platform.LiveStreamInfo, parts ...*ent.Vod) error {
+	// playlists have no platform, the parts in them do
+	p, err := s.Store.Client.Playlist.Query().Where(entPlaylist.ExtStreamID(stream.ID), entPlaylist.HasVodsWith(entVod.PlatformEQ(stream.Platform))).First(ctx)
+	if err != nil {
+		if !ent.IsNotFound(err) {
+			return err
+		}
+		name := fmt.Sprintf("%s - %s (%s)", lwc.Edges.Channel.DisplayName, parts[0].Title, parts[0].StreamedAt.Format("2006-01-02"))
+		p, err = s.Store.Client.Playlist.Create().SetName(name).SetExtStreamID(stream.ID).Save(ctx)
+		if ent.IsConstraintError(err) {
+			// playlist names are unique
+			p, err = s.Store.Client.Playlist.Create().SetName(fmt.Sprintf("%s [%s]", name, stream.ID)).SetExtStreamID(stream.ID).Save(ctx)
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, part := range parts {
+		// parts already in the playlist are skipped
+		exists, err := p.QueryVods().Where(entVod.ID(part.ID)).Exist(ctx)
+		if err != nil {
+			return err
+		}
+		if exists {
+			continue
+		}
+		if _, err := p.Update().AddVodIDs(part.ID).Save(ctx); err != nil {
+			return err
+		}
+	}
+	return nil
+}
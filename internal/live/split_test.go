@@ -0,0 +1,44 @@
+package live
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/zibbp/ganymede/ent"
+	"github.com/zibbp/ganymede/internal/platform"
+	"github.com/zibbp/ganymede/internal/utils"
+)
+
+func TestLiveArchiveSplitReason(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	current := &ent.Vod{Category: "Just Chatting", CreatedAt: now.Add(-3 * time.Hour)}
+	stream := platform.LiveStreamInfo{GameName: "just chatting"}
+
+	require.Empty(t, liveArchiveSplitReason(&ent.Live{}, current, stream, now))
+	require.Empty(t, liveArchiveSplitReason(&ent.Live{SplitHours: 4, SplitOnCategoryChange: true}, current, stream, now))
+	require.NotEmpty(t, liveArchiveSplitReason(&ent.Live{SplitHours: 3}, current, stream, now))
+
+	stream.GameName = "Minecraft"
+	require.Empty(t, liveArchiveSplitReason(&ent.Live{SplitHours: 4}, current, stream, now))
+	require.Equal(t, "category changed from Just Chatting to Minecraft", liveArchiveSplitReason(&ent.Live{SplitOnCategoryChange: true}, current, stream, now))
+
+	stream.GameName = ""
+	require.Empty(t, liveArchiveSplitReason(&ent.Live{SplitOnCategoryChange: true}, current, stream, now))
+}
+
+func TestChannelInLiveStreamInfoMatchesPlatform(t *testing.T) {
+	t.Parallel()
+
+	streams := []platform.LiveStreamInfo{
+		{Platform: utils.PlatformKick, ID: "1", UserID: "streamer", UserLogin: "streamer"},
+		{Platform: utils.PlatformTwitch, ID: "2", UserID: "123", UserLogin: "streamer"},
+	}
+
+	require.Equal(t, "2", channelInLiveStreamInfo(utils.PlatformTwitch, "streamer", streams).ID)
+	require.Equal(t, "1", channelInLiveStreamInfo(utils.PlatformKick, "streamer", streams).ID)
+	require.Empty(t, channelInLiveStreamInfo(utils.PlatformYoutube, "streamer", streams).ID)
+	require.Empty(t, channelInLiveStreamInfo(utils.PlatformKick, "123", streams).ID)
+}
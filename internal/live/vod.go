@@ -75,6 +75,12 @@ func (s *Service) CheckVodWatchedChannels(ctx context.Context, logger zerolog.Lo
 	logger.Info().Msgf("checking %d channels for new videos", len(channels))
 
 	for _, watch := range channels {
+		channelPlatform, err := platform.Resolve(watch.Edges.Channel.Platform, s.PlatformTwitch, s.PlatformYoutube, s.PlatformKick)
+		if err != nil {
+			logger.Error().Str("channel", watch.Edges.Channel.Name).Err(err).Msg("error getting channel platform")
+			continue
+		}
+
 		// Check if channel has category restrictions
 		var channelVideoCategories []string
 		if len(watch.Edges.Categories) > 0 {
@@ -87,7 +93,7 @@ func (s *Service) CheckVodWatchedChannels(ctx context.Context, logger zerolog.Lo
 		var videos []platform.VideoInfo
 		// If archives is enabled, fetch all videos
 		if watch.DownloadArchives {
-			tmpVideos, err := s.PlatformTwitch.GetVideos(ctx, watch.Edges.Channel.ExtID, platform.VideoTypeArchive, false, false)
+			tmpVideos, err := channelPlatform.GetVideos(ctx, watch.Edges.Channel.ExtID, platform.VideoTypeArchive, false, false)
 			if err != nil {
 				logger.Error().Str("channel", watch.Edges.Channel.Name).Err(err).Msg("error getting videos")
 				continue
@@ -96,7 +102,7 @@ func (s *Service) CheckVodWatchedChannels(ctx context.Context, logger zerolog.Lo
 		}
 		// If highlights is enabled, fetch all videos
 		if watch.DownloadHighlights {
-			tmpVideos, err := s.PlatformTwitch.GetVideos(ctx, watch.Edges.Channel.ExtID, platform.VideoTypeHighlight, false, false)
+			tmpVideos, err := channelPlatform.GetVideos(ctx, watch.Edges.Channel.ExtID, platform.VideoTypeHighlight, false, false)
 			if err != nil {
 				logger.Error().Str("channel", watch.Edges.Channel.Name).Err(err).Msg("error getting videos")
 				continue
@@ -105,7 +111,7 @@ func (s *Service) CheckVodWatchedChannels(ctx context.Context, logger zerolog.Lo
 		}
 		// If uploads is enabled, fetch all videos
 		if watch.DownloadUploads {
-			tmpVideos, err := s.PlatformTwitch.GetVideos(ctx, watch.Edges.Channel.ExtID, platform.VideoTypeUpload, false, false)
+			tmpVideos, err := channelPlatform.GetVideos(ctx, watch.Edges.Channel.ExtID, platform.VideoTypeUpload, false, false)
 			if err != nil {
 				logger.Error().Str("channel", watch.Edges.Channel.Name).Err(err).Msg("error getting videos")
 				continue
@@ -124,7 +130,7 @@ func (s *Service) CheckVodWatchedChannels(ctx context.Context, logger zerolog.Lo
 		for _, video := range videos {
 			// Video is not in DB
 			if !contains(dbVideos, video.ID) {
-				platformVideo, err := s.PlatformTwitch.GetVideo(ctx, video.ID, true, true)
+				platformVideo, err := channelPlatform.GetVideo(ctx, video.ID, true, true)
 				if err != nil {
 					logger.Error().Str("channel", watch.Edges.Channel.Name).Err(err).Msg("error getting video")
 					continue
@@ -237,10 +243,12 @@ func (s *Service) CheckVodWatchedChannels(ctx context.Context, logger zerolog.Lo
 
 				// archive the video
 				input := archive.ArchiveVideoInput{
-					VideoId:     video.ID,
-					Quality:     utils.VodQuality(watch.VodResolution),
-					ArchiveChat: watch.ArchiveChat,
-					RenderChat:  watch.RenderChat,
+					VideoId:              video.ID,
+					Platform:             watch.Edges.Channel.Platform,
+					Quality:              utils.VodQuality(watch.VodResolution),
+					ArchiveChat:          watch.ArchiveChat,
+					RenderChat:           watch.RenderChat,
+					TranscodingProfileID: watch.TranscodingProfileID,
 				}
 				_, err = s.ArchiveService.ArchiveVideo(ctx, input)
 				if err != nil {
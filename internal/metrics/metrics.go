@@ -8,6 +8,7 @@ import (
 	"github.com/rs/zerolog/log"
 	"github.com/zibbp/ganymede/ent/queue"
 	"github.com/zibbp/ganymede/internal/database"
+	"github.com/zibbp/ganymede/internal/diskspace"
 	tasks_client "github.com/zibbp/ganymede/internal/tasks/client"
 )
 
@@ -37,6 +38,9 @@ type Metrics struct {
 	riverTotalCancelledJobs  prometheus.Gauge
 	riverTotalDiscardedJobs  prometheus.Gauge
 	riverTotalCompletedJobs  prometheus.Gauge
+	directoryFreeBytes       *prometheus.GaugeVec
+	directoryMinFreeBytes    *prometheus.GaugeVec
+	archivingPausedDiskSpace prometheus.Gauge
 }
 
 func NewService(store *database.Database, riverClient *tasks_client.RiverClient) *Service {
@@ -114,6 +118,18 @@ func NewService(store *database.Database, riverClient *tasks_client.RiverClient)
 			Name: "river_total_completed_jobs",
 			Help: "Total number of completed jobs",
 		}),
+		directoryFreeBytes: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Name: "directory_free_bytes",
+			Help: "Free space of the videos and temp directories in bytes",
+		}, []string{"directory"}),
+		directoryMinFreeBytes: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Name: "directory_min_free_bytes",
+			Help: "Configured minimum free space of the videos and temp directories in bytes",
+		}, []string{"directory"}),
+		archivingPausedDiskSpace: prometheus.NewGauge(prometheus.GaugeOpts{
+			Name: "archiving_paused_low_disk_space",
+			Help: "Whether video downloads are paused because of low disk space (1) or not (0)",
+		}),
 	}
 
 	registry.MustRegister(
@@ -135,6 +151,9 @@ func NewService(store *database.Database, riverClient *tasks_client.RiverClient)
 		metrics.riverTotalCancelledJobs,
 		metrics.riverTotalDiscardedJobs,
 		metrics.riverTotalCompletedJobs,
+		metrics.directoryFreeBytes,
+		metrics.directoryMinFreeBytes,
+		metrics.archivingPausedDiskSpace,
 	)
 
 	return &Service{Store: store, riverClient: riverClient, metrics: metrics, Registry: registry}
@@ -234,6 +253,21 @@ func (s *Service) GatherMetrics(ctx context.Context) (*prometheus.Registry, erro
 	}
 	s.metrics.totalVodsInQueue.Set(float64(qCount))
 
+	// Free space of the archive directories
+	diskSpace, err := diskspace.Check()
+	if err != nil {
+		log.Error().Err(err).Msg("error checking disk space")
+	}
+	for _, directory := range diskSpace.Directories {
+		s.metrics.directoryFreeBytes.With(prometheus.Labels{"directory": directory.Name}).Set(float64(directory.FreeBytes))
+		s.metrics.directoryMinFreeBytes.With(prometheus.Labels{"directory": directory.Name}).Set(float64(directory.MinFreeBytes))
+	}
+	if diskSpace.Low {
+		s.metrics.archivingPausedDiskSpace.Set(1)
+	} else {
+		s.metrics.archivingPausedDiskSpace.Set(0)
+	}
+
 	// gather River job metrics
 	err = s.gatherRiverJobMetrics(ctx)
 	if err != nil {
@@ -0,0 +1,245 @@
+// Package mutedaudio recovers the audio of the muted segments of Twitch VODs from the live archive of
+// the same stream.
+//
+// Twitch mutes parts of a VOD after the stream ended while the live archive recorded during the stream
+// still has the original audio. The audio of the live archive replaces the muted audio of the VOD, or,
+// if the VOD can't be changed, the muted segments are marked in the player with a link to the live archive.
+package mutedaudio
+
+import (
+	"cmp"
+	"context"
+	"database/sql"
+	"fmt"
+	"math"
+	"path/filepath"
+	"slices"
+
+	"github.com/google/uuid"
+	"github.com/zibbp/ganymede/ent"
+	entMutedSegment "github.com/zibbp/ganymede/ent/mutedsegment"
+	"github.com/zibbp/ganymede/ent/predicate"
+	entVod "github.com/zibbp/ganymede/ent/vod"
+	"github.com/zibbp/ganymede/internal/chat"
+	"github.com/zibbp/ganymede/internal/database"
+	"github.com/zibbp/ganymede/internal/exec"
+	"github.com/zibbp/ganymede/internal/storage"
+	"github.com/zibbp/ganymede/internal/utils"
+)
+
+// Splice is the parts of a muted segment the live archive covers. A segment spanning a gap of the live
+// archive is covered by a range before and after the gap, each taking the live archive from its own offset.
+type Splice struct {
+	SegmentID uuid.UUID
+	Ranges    []exec.SourceRange
+	Complete  bool // the live archive covers the whole segment
+}
+
+// Result is the outcome of recovering the audio of a video.
+type Result struct {
+	LiveArchiveID uuid.UUID `json:"live_archive_id"`
+	Offset        float64   `json:"offset"`
+	Segments      int       `json:"segments"`
+	Recovered     int       `json:"recovered"`
+	Replaced      bool      `json:"replaced"` // the audio of the video was replaced, otherwise the segments are only marked
+}
+
+// Candidates returns the IDs of the VODs with muted segments that were not paired with a live archive yet.
+func Candidates(ctx context.Context, client *ent.Client) ([]uuid.UUID, error) {
+	return client.Vod.Query().
+		Where(
+			entVod.TypeEQ(utils.Archive),
+			entVod.PlatformEQ(utils.PlatformTwitch),
+			entVod.Processing(false),
+			entVod.AudioSourceIDIsNil(),
+			entVod.HasMutedSegmentsWith(entMutedSegment.Recovered(false)),
+		).
+		IDs(ctx)
+}
+
+// FindLiveArchive returns the live archive of the stream of the video, or nil if the stream wasn't archived live.
+// Live archives get the ID of the VOD once it is published, so the IDs are compared as well as the stream IDs.
+// The parts of a split live archive only hold part of the stream, so they aren't used. The gaps of the live
+// archive are loaded.
+func FindLiveArchive(ctx context.Context, client *ent.Client, video *ent.Vod) (*ent.Vod, error) {
+	sameStream := []predicate.Vod{entVod.ExtID(video.ExtID)}
+	if video.ExtStreamID != "" {
+		sameStream = append(sameStream, entVod.ExtStreamID(video.ExtStreamID))
+	}
+	live, err := client.Vod.Query().
+		Where(
+			entVod.IDNEQ(video.ID),
+			entVod.TypeEQ(utils.Live),
+			entVod.PlatformEQ(video.Platform),
+			entVod.Processing(false),
+			entVod.Part(0),
+			entVod.Or(sameStream...),
+		).
+		WithLiveGaps().
+		Order(ent.Desc(entVod.FieldDuration)).
+		First(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("error fetching live archive: %w", err)
+	}
+	return live, nil
+}
+
+// Gaps returns the parts of the stream missing from the live archive in the order they were missed.
+// The gaps of the live archive must be loaded.
+func Gaps(live *ent.Vod) []chat.Gap {
+	gaps := make([]chat.Gap, 0, len(live.Edges.LiveGaps))
+	for _, gap := range live.Edges.LiveGaps {
+		if duration := gap.EndedAt.Sub(gap.StartedAt).Seconds(); duration > 0 {
+			gaps = append(gaps, chat.Gap{Offset: gap.Offset, Duration: duration})
+		}
+	}
+	slices.SortFunc(gaps, func(a, b chat.Gap) int { return cmp.Compare(a.Offset, b.Offset) })
+	return gaps
+}
+
+// Offset returns the position in the video where the live archive starts. Both end when the stream ends
+// but the live archive starts when the stream was found to be live, so it misses the start of the stream,
+// and it misses the gaps of the recording. The gaps of the live archive must be loaded.
+func Offset(video *ent.Vod, live *ent.Vod) float64 {
+	missing := float64(video.Duration - live.Duration)
+	for _, gap := range Gaps(live) {
+		missing -= gap.Duration
+	}
+	return math.Max(0, missing)
+}
+
+// piece is a part of the live archive between its gaps, from start to end in the video. The live archive
+// is shift seconds behind the video in the piece.
+type piece struct {
+	start, end, shift float64
+}
+
+// livePieces maps the live archive onto the video. The live archive starts offset seconds into the video and
+// every gap moves the rest of it later in the video by the duration of the gap.
+func livePieces(offset float64, liveDuration int, gaps []chat.Gap) []piece {
+	pieces := []piece{}
+	archived, shift := 0.0, offset
+	for _, gap := range append(slices.Clone(gaps), chat.Gap{Offset: float64(liveDuration)}) {
+		end := math.Min(math.Max(gap.Offset, archived), float64(liveDuration))
+		if end > archived {
+			pieces = append(pieces, piece{start: archived + shift, end: end + shift, shift: shift})
+		}
+		archived = end
+		shift += gap.Duration
+	}
+	return pieces
+}
+
+// Plan returns the parts of the muted segments the live archive covers. The live archive starts offset
+// seconds into the video and the gaps, sorted by their position in the live archive, aren't covered.
+func Plan(segments []*ent.MutedSegment, offset float64, liveDuration int, gaps []chat.Gap) []Splice {
+	pieces := livePieces(offset, liveDuration, gaps)
+	splices := []Splice{}
+	for _, segment := range segments {
+		splice := Splice{SegmentID: segment.ID}
+		covered := 0.0
+		for _, piece := range pieces {
+			start := math.Max(float64(segment.Start), piece.start)
+			end := math.Min(float64(segment.End), piece.end)
+			if end <= start {
+				continue
+			}
+			splice.Ranges = append(splice.Ranges, exec.SourceRange{TimeRange: exec.TimeRange{Start: start, End: end}, Offset: piece.shift})
+			covered += end - start
+		}
+		if len(splice.Ranges) == 0 {
+			continue
+		}
+		splice.Complete = covered == float64(segment.End-segment.Start)
+		splices = append(splices, splice)
+	}
+	return splices
+}
+
+// Recover replaces the audio of the muted segments of the video with the audio of the live archive and
+// records the live archive as the audio source. HLS videos are not changed, their muted segments stay marked.
+//
+// The live archive starts offset seconds into the video, its gaps must be loaded. Replacing the audio is
+// repeatable, so running it again with a corrected offset fixes misaligned audio. The video stays the
+// authoritative version unless the live archive has audio it couldn't take.
+func Recover(ctx context.Context, store *database.Database, s storage.Storage, video *ent.Vod, live *ent.Vod, offset float64, tempDir string) (*Result, error) {
+	segments, err := store.Client.MutedSegment.Query().Where(entMutedSegment.HasVodWith(entVod.ID(video.ID))).All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("error fetching muted segments: %w", err)
+	}
+
+	splices := Plan(segments, offset, live.Duration, Gaps(live))
+	result := &Result{LiveArchiveID: live.ID, Offset: offset, Segments: len(segments)}
+
+	if len(splices) > 0 && video.VideoHlsPath == "" {
+		ranges := []exec.SourceRange{}
+		for _, splice := range splices {
+			ranges = append(ranges, splice.Ranges...)
+		}
+		videoURL, err := s.URL(ctx, video.VideoPath)
+		if err != nil {
+			return nil, err
+		}
+		liveURL, err := s.URL(ctx, live.VideoPath)
+		if err != nil {
+			return nil, err
+		}
+		output := filepath.Join(tempDir, fmt.Sprintf("%s-recovered-audio%s", video.ID, filepath.Ext(video.VideoPath)))
+		if err := exec.ReplaceAudio(ctx, videoURL, liveURL, ranges, output); err != nil {
+			_ = utils.DeleteFile(output)
+			return nil, err
+		}
+		if err := s.Save(ctx, output, video.VideoPath); err != nil {
+			return nil, fmt.Errorf("error saving video: %w", err)
+		}
+		result.Replaced = true
+	}
+
+	recovered := []uuid.UUID{}
+	if result.Replaced {
+		for _, splice := range splices {
+			if splice.Complete {
+				recovered = append(recovered, splice.SegmentID)
+			}
+		}
+	}
+	result.Recovered = len(recovered)
+	videoAuthoritative := len(splices) == 0 || result.Replaced
+
+	err = store.WithTx(ctx, func(txClient *ent.Client, _ *sql.Tx) error {
+		if _, err := txClient.MutedSegment.Update().
+			Where(entMutedSegment.HasVodWith(entVod.ID(video.ID))).
+			SetRecovered(false).
+			Save(ctx); err != nil {
+			return fmt.Errorf("error saving muted segments: %w", err)
+		}
+		if len(recovered) > 0 {
+			if _, err := txClient.MutedSegment.Update().
+				Where(entMutedSegment.IDIn(recovered...)).
+				SetRecovered(true).
+				Save(ctx); err != nil {
+				return fmt.Errorf("error saving muted segments: %w", err)
+			}
+		}
+		if _, err := txClient.Vod.UpdateOneID(video.ID).
+			SetAudioSourceID(live.ID).
+			SetAudioSourceOffset(offset).
+			SetAuthoritative(videoAuthoritative).
+			Save(ctx); err != nil {
+			return fmt.Errorf("error saving video: %w", err)
+		}
+		if _, err := txClient.Vod.UpdateOneID(live.ID).
+			SetAuthoritative(!videoAuthoritative).
+			Save(ctx); err != nil {
+			return fmt.Errorf("error saving live archive: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
@@ -0,0 +1,66 @@
+package mutedaudio
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"github.com/zibbp/ganymede/ent"
+	"github.com/zibbp/ganymede/internal/chat"
+	"github.com/zibbp/ganymede/internal/exec"
+)
+
+func TestOffset(t *testing.T) {
+	t.Parallel()
+
+	require.Equal(t, 120.0, Offset(&ent.Vod{Duration: 3720}, &ent.Vod{Duration: 3600}))
+	// the live archive can't start before the video
+	require.Equal(t, 0.0, Offset(&ent.Vod{Duration: 3600}, &ent.Vod{Duration: 3610}))
+
+	// the live archive is shorter than the stream by its gaps
+	started := time.Now()
+	live := &ent.Vod{Duration: 3540}
+	live.Edges.LiveGaps = []*ent.LiveGap{{Offset: 1000, StartedAt: started, EndedAt: started.Add(60 * time.Second)}}
+	require.Equal(t, 120.0, Offset(&ent.Vod{Duration: 3720}, live))
+}
+
+func TestPlan(t *testing.T) {
+	t.Parallel()
+
+	before := &ent.MutedSegment{ID: uuid.New(), Start: 0, End: 360}
+	covered := &ent.MutedSegment{ID: uuid.New(), Start: 1080, End: 1440}
+	partial := &ent.MutedSegment{ID: uuid.New(), Start: 3420, End: 3780}
+	after := &ent.MutedSegment{ID: uuid.New(), Start: 3780, End: 3900}
+
+	// the live archive covers 300s-3600s of the video
+	splices := Plan([]*ent.MutedSegment{before, covered, partial, after}, 300, 3300, nil)
+	require.Equal(t, []Splice{
+		{SegmentID: before.ID, Ranges: []exec.SourceRange{{TimeRange: exec.TimeRange{Start: 300, End: 360}, Offset: 300}}, Complete: false},
+		{SegmentID: covered.ID, Ranges: []exec.SourceRange{{TimeRange: exec.TimeRange{Start: 1080, End: 1440}, Offset: 300}}, Complete: true},
+		{SegmentID: partial.ID, Ranges: []exec.SourceRange{{TimeRange: exec.TimeRange{Start: 3420, End: 3600}, Offset: 300}}, Complete: false},
+	}, splices)
+
+	require.Empty(t, Plan([]*ent.MutedSegment{before}, 360, 3300, nil))
+}
+
+func TestPlanWithGap(t *testing.T) {
+	t.Parallel()
+
+	beforeGap := &ent.MutedSegment{ID: uuid.New(), Start: 400, End: 700}
+	acrossGap := &ent.MutedSegment{ID: uuid.New(), Start: 1200, End: 1500}
+	inGap := &ent.MutedSegment{ID: uuid.New(), Start: 1320, End: 1350}
+	afterGap := &ent.MutedSegment{ID: uuid.New(), Start: 2000, End: 2400}
+
+	// the live archive starts 300s into the video and misses 60s of the stream 1000s into the live archive,
+	// so it covers 300s-1300s and 1360s-3600s of the video
+	splices := Plan([]*ent.MutedSegment{beforeGap, acrossGap, inGap, afterGap}, 300, 3240, []chat.Gap{{Offset: 1000, Duration: 60}})
+	require.Equal(t, []Splice{
+		{SegmentID: beforeGap.ID, Ranges: []exec.SourceRange{{TimeRange: exec.TimeRange{Start: 400, End: 700}, Offset: 300}}, Complete: true},
+		{SegmentID: acrossGap.ID, Ranges: []exec.SourceRange{
+			{TimeRange: exec.TimeRange{Start: 1200, End: 1300}, Offset: 300},
+			{TimeRange: exec.TimeRange{Start: 1360, End: 1500}, Offset: 360},
+		}, Complete: false},
+		{SegmentID: afterGap.ID, Ranges: []exec.SourceRange{{TimeRange: exec.TimeRange{Start: 2000, End: 2400}, Offset: 360}}, Complete: true},
+	}, splices)
+}
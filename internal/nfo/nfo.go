@@ -77,6 +77,38 @@ func MarshalMovie(metadata MovieMetadata) ([]byte, error) {
 	return result, nil
 }
 
+// UnmarshalMovie parses a movie NFO. The premiered date is parsed as UTC and left
+// zero if it is missing or invalid.
+func UnmarshalMovie(data []byte) (MovieMetadata, error) {
+	var nfo movie
+	if err := xml.Unmarshal(data, &nfo); err != nil {
+		return MovieMetadata{}, fmt.Errorf("unmarshal movie NFO: %w", err)
+	}
+
+	metadata := MovieMetadata{
+		Title:  strings.TrimSpace(nfo.Title),
+		Studio: strings.TrimSpace(nfo.Studio),
+		Genres: nfo.Genres,
+	}
+	if premiered, err := time.Parse("2006-01-02", strings.TrimSpace(nfo.Premiered)); err == nil {
+		metadata.Premiered = premiered
+	}
+	if nfo.UniqueID != nil {
+		metadata.Platform = strings.TrimSpace(nfo.UniqueID.Type)
+		metadata.ExternalID = strings.TrimSpace(nfo.UniqueID.Value)
+	}
+	return metadata, nil
+}
+
+// ReadMovie reads and parses a movie NFO file.
+func ReadMovie(path string) (MovieMetadata, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return MovieMetadata{}, fmt.Errorf("read NFO %s: %w", path, err)
+	}
+	return UnmarshalMovie(data)
+}
+
 // CreateMovieIfMissing writes an NFO atomically without replacing an existing
 // sidecar. It returns true only when a new file was created.
 func CreateMovieIfMissing(path string, metadata MovieMetadata) (bool, error) {
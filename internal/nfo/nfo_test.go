@@ -82,6 +82,36 @@ func TestMarshalMovie(t *testing.T) {
 	require.Equal(t, "123456789", got.UniqueID.Value)
 }
 
+func TestUnmarshalMovieRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	metadata := MovieMetadata{
+		Title:      "A stream",
+		Premiered:  time.Date(2026, time.July, 31, 0, 0, 0, 0, time.UTC),
+		Studio:     "Streamer",
+		Genres:     []string{"Just Chatting"},
+		Platform:   "twitch",
+		ExternalID: "123456789",
+	}
+	data, err := MarshalMovie(metadata)
+	require.NoError(t, err)
+
+	got, err := UnmarshalMovie(data)
+	require.NoError(t, err)
+	require.Equal(t, metadata, got)
+}
+
+func TestUnmarshalMovieWithoutOptionalFields(t *testing.T) {
+	t.Parallel()
+
+	got, err := UnmarshalMovie([]byte("<movie><title> Title </title><premiered>unknown</premiered></movie>"))
+	require.NoError(t, err)
+	require.Equal(t, MovieMetadata{Title: "Title"}, got)
+
+	_, err = UnmarshalMovie([]byte("not xml"))
+	require.Error(t, err)
+}
+
 func TestCreateMovieIfMissingPreservesExistingFile(t *testing.T) {
 	t.Parallel()
 
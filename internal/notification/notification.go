@@ -73,10 +73,14 @@ func (s *Service) CreateNotification(ctx context.Context, n *ent.Notification) (
 		SetTriggerLiveSuccess(n.TriggerLiveSuccess).
 		SetTriggerError(n.TriggerError).
 		SetTriggerIsLive(n.TriggerIsLive).
+		SetTriggerLowDiskSpace(n.TriggerLowDiskSpace).
+		SetTriggerChecksumMismatch(n.TriggerChecksumMismatch).
 		SetVideoSuccessTemplate(n.VideoSuccessTemplate).
 		SetLiveSuccessTemplate(n.LiveSuccessTemplate).
 		SetErrorTemplate(n.ErrorTemplate).
 		SetIsLiveTemplate(n.IsLiveTemplate).
+		SetLowDiskSpaceTemplate(n.LowDiskSpaceTemplate).
+		SetChecksumMismatchTemplate(n.ChecksumMismatchTemplate).
 		SetAppriseUrls(n.AppriseUrls).
 		SetAppriseTitle(n.AppriseTitle).
 		SetAppriseTag(n.AppriseTag)
@@ -115,10 +119,14 @@ func (s *Service) UpdateNotification(ctx context.Context, id uuid.UUID, n *ent.N
 		SetTriggerLiveSuccess(n.TriggerLiveSuccess).
 		SetTriggerError(n.TriggerError).
 		SetTriggerIsLive(n.TriggerIsLive).
+		SetTriggerLowDiskSpace(n.TriggerLowDiskSpace).
+		SetTriggerChecksumMismatch(n.TriggerChecksumMismatch).
 		SetVideoSuccessTemplate(n.VideoSuccessTemplate).
 		SetLiveSuccessTemplate(n.LiveSuccessTemplate).
 		SetErrorTemplate(n.ErrorTemplate).
 		SetIsLiveTemplate(n.IsLiveTemplate).
+		SetLowDiskSpaceTemplate(n.LowDiskSpaceTemplate).
+		SetChecksumMismatchTemplate(n.ChecksumMismatchTemplate).
 		SetAppriseUrls(n.AppriseUrls).
 		SetAppriseTitle(n.AppriseTitle).
 		SetAppriseTag(n.AppriseTag)
@@ -362,6 +370,51 @@ func (s *Service) SendLive(ctx context.Context, channelItem *ent.Channel, vodIte
 	}
 }
 
+// SendLowDiskSpace sends notifications to all enabled configs with trigger_low_disk_space.
+func (s *Service) SendLowDiskSpace(ctx context.Context, directoryPath string, freeBytes int64, minFreeBytes int64) {
+	notifications, err := s.Store.Client.Notification.Query().
+		Where(
+			entNotification.EnabledEQ(true),
+			entNotification.TriggerLowDiskSpaceEQ(true),
+		).All(ctx)
+	if err != nil {
+		log.Error().Err(err).Msg("error querying low disk space notifications")
+		return
+	}
+
+	variableMap := getLowDiskSpaceVariableMap(directoryPath, freeBytes, minFreeBytes)
+
+	for _, n := range notifications {
+		body := renderTemplate(n.LowDiskSpaceTemplate, variableMap)
+		if err := s.send(ctx, n, body, variableMap); err != nil {
+			log.Error().Err(err).Str("notification_id", n.ID.String()).Str("name", n.Name).Msg("error sending low disk space notification")
+		}
+	}
+}
+
+// SendChecksumMismatch sends notifications to all enabled configs with trigger_checksum_mismatch.
+// files are the paths of the files of the video that changed or went missing.
+func (s *Service) SendChecksumMismatch(ctx context.Context, channelItem *ent.Channel, vodItem *ent.Vod, files []string) {
+	notifications, err := s.Store.Client.Notification.Query().
+		Where(
+			entNotification.EnabledEQ(true),
+			entNotification.TriggerChecksumMismatchEQ(true),
+		).All(ctx)
+	if err != nil {
+		log.Error().Err(err).Msg("error querying checksum mismatch notifications")
+		return
+	}
+
+	variableMap := getChecksumMismatchVariableMap(channelItem, vodItem, files)
+
+	for _, n := range notifications {
+		body := renderTemplate(n.ChecksumMismatchTemplate, variableMap)
+		if err := s.send(ctx, n, body, variableMap); err != nil {
+			log.Error().Err(err).Str("notification_id", n.ID.String()).Str("name", n.Name).Msg("error sending checksum mismatch notification")
+		}
+	}
+}
+
 // SendTestNotification sends a test notification using the config's own templates with dummy data.
 func (s *Service) SendTestNotification(ctx context.Context, n *ent.Notification, eventType string) error {
 	variableMap := getTestVariableMap()
@@ -378,6 +431,12 @@ func (s *Service) SendTestNotification(ctx context.Context, n *ent.Notification,
 	case "is_live":
 		variableMap["category"] = "Demo Game"
 		tmpl = n.IsLiveTemplate
+	case "low_disk_space":
+		variableMap = getLowDiskSpaceVariableMap("/data/videos", 4*1024*1024*1024, 10*1024*1024*1024)
+		tmpl = n.LowDiskSpaceTemplate
+	case "checksum_mismatch":
+		variableMap["checksum_files"] = "/data/videos/channel/folder/123-video.mp4"
+		tmpl = n.ChecksumMismatchTemplate
 	default:
 		return fmt.Errorf("unknown test notification event type: %s", eventType)
 	}
@@ -638,6 +697,27 @@ func getVariableMap(channelItem *ent.Channel, vodItem *ent.Vod, qItem *ent.Queue
 	return variables
 }
 
+// getLowDiskSpaceVariableMap builds the variable map for low disk space notifications.
+func getLowDiskSpaceVariableMap(directoryPath string, freeBytes int64, minFreeBytes int64) map[string]interface{} {
+	return map[string]interface{}{
+		"directory_path": directoryPath,
+		"free_space":     formatBytes(freeBytes),
+		"min_free_space": formatBytes(minFreeBytes),
+	}
+}
+
+// getChecksumMismatchVariableMap builds the variable map for checksum mismatch notifications.
+func getChecksumMismatchVariableMap(channelItem *ent.Channel, vodItem *ent.Vod, files []string) map[string]interface{} {
+	variables := getVariableMap(channelItem, vodItem, nil, "", nil)
+	variables["checksum_files"] = strings.Join(files, ", ")
+	return variables
+}
+
+// formatBytes formats a size in bytes as gigabytes.
+func formatBytes(b int64) string {
+	return fmt.Sprintf("%.1f GB", float64(b)/(1024*1024*1024))
+}
+
 // getTestVariableMap builds a variable map with dummy test data.
 // Note: "failed_task" and "category" are left empty here — SendTestNotification
 // overwrites them with test values for the relevant event types.
@@ -238,6 +238,26 @@ func TestGetTestVariableMap(t *testing.T) {
 	}
 }
 
+func TestGetLowDiskSpaceVariableMap(t *testing.T) {
+	t.Parallel()
+
+	m := getLowDiskSpaceVariableMap("/data/videos", 5*1024*1024*1024+512*1024*1024, 10*1024*1024*1024)
+	body := renderTemplate("{{directory_path}} has {{free_space}} free, minimum {{min_free_space}}", m)
+	if body != "/data/videos has 5.5 GB free, minimum 10.0 GB" {
+		t.Fatalf("unexpected body: %q", body)
+	}
+}
+
+func TestGetChecksumMismatchVariableMap(t *testing.T) {
+	t.Parallel()
+
+	m := getChecksumMismatchVariableMap(&ent.Channel{DisplayName: "Streamer"}, &ent.Vod{Title: "Stream"}, []string{"/a/video.mp4", "/a/chat.json"})
+	body := renderTemplate("{{checksum_files}} of {{vod_title}} by {{channel_display_name}}", m)
+	if body != "/a/video.mp4, /a/chat.json of Stream by Streamer" {
+		t.Fatalf("unexpected body: %q", body)
+	}
+}
+
 func TestSendUnknownType(t *testing.T) {
 	t.Parallel()
 
@@ -5,6 +5,7 @@ import (
 	"time"
 
 	"github.com/zibbp/ganymede/internal/chapter"
+	"github.com/zibbp/ganymede/internal/utils"
 )
 
 type VideoInfo struct {
@@ -38,18 +39,19 @@ const (
 )
 
 type LiveStreamInfo struct {
-	ID           string    `json:"id"`
-	UserID       string    `json:"user_id"`
-	UserLogin    string    `json:"user_login"`
-	UserName     string    `json:"user_name"`
-	GameID       string    `json:"game_id"`
-	GameName     string    `json:"game_name"`
-	Type         string    `json:"type"`
-	Title        string    `json:"title"`
-	ViewerCount  int64     `json:"viewer_count"`
-	StartedAt    time.Time `json:"started_at"`
-	Language     string    `json:"language"`
-	ThumbnailURL string    `json:"thumbnail_url"`
+	Platform     utils.VideoPlatform `json:"platform"`
+	ID           string              `json:"id"`
+	UserID       string              `json:"user_id"`
+	UserLogin    string              `json:"user_login"`
+	UserName     string              `json:"user_name"`
+	GameID       string              `json:"game_id"`
+	GameName     string              `json:"game_name"`
+	Type         string              `json:"type"`
+	Title        string              `json:"title"`
+	ViewerCount  int64               `json:"viewer_count"`
+	StartedAt    time.Time           `json:"started_at"`
+	Language     string              `json:"language"`
+	ThumbnailURL string              `json:"thumbnail_url"`
 }
 
 type ChannelInfo struct {
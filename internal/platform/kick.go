@@ -0,0 +1,551 @@
+package platform
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/rs/zerolog/log"
+	"github.com/zibbp/ganymede/internal/chapter"
+	"github.com/zibbp/ganymede/internal/hls"
+	"github.com/zibbp/ganymede/internal/utils"
+)
+
+// GetVideo implements the Platform interface to get video information from Kick. The video ID is the UUID of the video. Kick does not have chapters or muted segments so only the category fallback chapter is returned.
+func (c *KickConnection) GetVideo(ctx context.Context, id string, withChapters bool, withMutedSegments bool) (*VideoInfo, error) {
+	video, err := c.getVideo(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if video.Livestream.Channel == nil {
+		return nil, fmt.Errorf("video %s has no channel", id)
+	}
+
+	livestream := video.Livestream
+	livestream.Source = video.Source
+	livestream.Video = &KickLivestreamVideo{
+		ID:        video.ID,
+		UUID:      video.UUID,
+		Views:     video.Views,
+		CreatedAt: video.CreatedAt,
+	}
+
+	return kickLivestreamToVideoInfo(livestream, livestream.Channel, withChapters)
+}
+
+func (c *KickConnection) GetLiveStream(ctx context.Context, channelName string) (*LiveStreamInfo, error) {
+	channel, err := c.getChannel(ctx, channelName)
+	if err != nil {
+		return nil, err
+	}
+
+	if channel.Livestream == nil || !channel.Livestream.IsLive {
+		return nil, fmt.Errorf("failed to fetch stream for channel %s: %w", channel.Slug, ErrorNoStreamsFound{})
+	}
+
+	return kickLivestreamToLiveStreamInfo(*channel.Livestream, channel)
+}
+
+// GetLiveStreams retrieves live streams for multiple channel IDs. The Kick API does not support fetching multiple channels in a single request so each channel is checked individually.
+func (c *KickConnection) GetLiveStreams(ctx context.Context, channelIDs []string) ([]LiveStreamInfo, error) {
+	var streams []LiveStreamInfo
+	for _, channelID := range channelIDs {
+		stream, err := c.GetLiveStream(ctx, channelID)
+		if err != nil {
+			var e ErrorNoStreamsFound
+			if errors.As(err, &e) {
+				continue
+			}
+			return nil, err
+		}
+		streams = append(streams, *stream)
+	}
+
+	if len(streams) == 0 {
+		return nil, fmt.Errorf("failed to fetch stream for channels: %w", ErrorNoStreamsFound{})
+	}
+
+	return streams, nil
+}
+
+// GetChannel retrieves channel information by its name or ID. The public Kick API identifies channels by their slug so the slug is used as the channel ID.
+func (c *KickConnection) GetChannel(ctx context.Context, channelName *string, channelID *string) (*ChannelInfo, error) {
+	var slug string
+	if channelID != nil && *channelID != "" {
+		slug = *channelID
+	} else if channelName != nil && *channelName != "" {
+		slug = *channelName
+	} else {
+		return nil, fmt.Errorf("either channelName or channelID must be provided")
+	}
+
+	channel, err := c.getChannel(ctx, slug)
+	if err != nil {
+		return nil, err
+	}
+
+	info := ChannelInfo{
+		ID:              channel.Slug,
+		Login:           channel.Slug,
+		DisplayName:     channel.User.Username,
+		Description:     channel.User.Bio,
+		ProfileImageURL: channel.User.ProfilePic,
+	}
+	if channel.OfflineBannerImage != nil {
+		info.OfflineImageURL = channel.OfflineBannerImage.Src
+	}
+
+	return &info, nil
+}
+
+// GetVideos retrieves videos for a given channel ID. Kick only has VODs of past livestreams which are returned for the archive type.
+func (c *KickConnection) GetVideos(ctx context.Context, channelId string, videoType VideoType, withChapters bool, withMutedSegments bool) ([]VideoInfo, error) {
+	if videoType != VideoTypeArchive {
+		return []VideoInfo{}, nil
+	}
+
+	channel, err := c.getChannel(ctx, channelId)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.kickMakeHTTPRequest(ctx, "GET", fmt.Sprintf("api/v2/channels/%s/videos", url.PathEscape(channel.Slug)), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var livestreams []KickLivestream
+	err = json.Unmarshal(body, &livestreams)
+	if err != nil {
+		return nil, err
+	}
+
+	info := []VideoInfo{}
+	for _, livestream := range livestreams {
+		// the current livestream is listed before its VOD is finished
+		if livestream.IsLive || livestream.Video == nil {
+			continue
+		}
+
+		videoInfo, err := kickLivestreamToVideoInfo(livestream, channel, withChapters)
+		if err != nil {
+			return nil, err
+		}
+		info = append(info, *videoInfo)
+	}
+
+	return info, nil
+}
+
+// GetCategories retrieves all categories from Kick.
+func (c *KickConnection) GetCategories(ctx context.Context) ([]Category, error) {
+	params := url.Values{
+		"limit": []string{"100"},
+		"page":  []string{"1"},
+	}
+
+	var categories []Category
+	for page := 1; ; page++ {
+		params.Set("page", strconv.Itoa(page))
+		body, err := c.kickMakeHTTPRequest(ctx, "GET", "api/v1/subcategories", params)
+		if err != nil {
+			return nil, err
+		}
+
+		var resp KickCategoriesResponse
+		err = json.Unmarshal(body, &resp)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, category := range resp.Data {
+			categories = append(categories, Category{
+				ID:   strconv.FormatInt(category.ID, 10),
+				Name: category.Name,
+			})
+		}
+
+		if len(resp.Data) == 0 || resp.CurrentPage >= resp.LastPage {
+			break
+		}
+	}
+
+	return categories, nil
+}
+
+// GetGlobalBadges is not supported by Kick, an empty list is returned.
+func (c *KickConnection) GetGlobalBadges(ctx context.Context) ([]Badge, error) {
+	return []Badge{}, nil
+}
+
+// GetChannelBadges is not supported by Kick, an empty list is returned.
+func (c *KickConnection) GetChannelBadges(ctx context.Context, channelId string) ([]Badge, error) {
+	return []Badge{}, nil
+}
+
+// GetGlobalEmotes returns an empty list. Kick only exposes global emotes alongside the emotes of a channel, these are returned by GetChannelEmotes.
+func (c *KickConnection) GetGlobalEmotes(ctx context.Context) ([]Emote, error) {
+	return []Emote{}, nil
+}
+
+// GetChannelEmotes retrieves the emotes usable in the channel's chat. This includes the global and emoji emote sets.
+func (c *KickConnection) GetChannelEmotes(ctx context.Context, channelId string) ([]Emote, error) {
+	body, err := c.kickMakeHTTPRequest(ctx, "GET", fmt.Sprintf("emotes/%s", url.PathEscape(channelId)), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var sets []KickEmoteSet
+	err = json.Unmarshal(body, &sets)
+	if err != nil {
+		return nil, err
+	}
+
+	var emotes []Emote
+	for _, set := range sets {
+		for _, emote := range set.Emotes {
+			emoteType := EmoteTypeGlobal
+			if emote.SubscribersOnly {
+				emoteType = EmoteTypeSubscription
+			}
+			id := strconv.FormatInt(emote.ID, 10)
+			emotes = append(emotes, Emote{
+				ID:     id,
+				Name:   emote.Name,
+				URL:    KickEmoteURL(id),
+				Format: EmoteFormatStatic,
+				Type:   emoteType,
+				Source: string(utils.PlatformKick),
+			})
+		}
+	}
+
+	return emotes, nil
+}
+
+// GetChannelClips is not supported for Kick.
+func (c *KickConnection) GetChannelClips(ctx context.Context, channelId string, filter ClipsFilter) ([]ClipInfo, error) {
+	return nil, fmt.Errorf("clips are not supported for kick")
+}
+
+// GetClip is not supported for Kick.
+func (c *KickConnection) GetClip(ctx context.Context, id string) (*ClipInfo, error) {
+	return nil, fmt.Errorf("clips are not supported for kick")
+}
+
+// CheckIfStreamIsLive checks if a Kick channel is currently live.
+func (c *KickConnection) CheckIfStreamIsLive(ctx context.Context, channelName string) (bool, error) {
+	channel, err := c.getChannel(ctx, channelName)
+	if err != nil {
+		return false, err
+	}
+
+	return channel.Livestream != nil && channel.Livestream.IsLive, nil
+}
+
+// GetStreams fetches live streams from Kick sorted by viewership.
+func (c *KickConnection) GetStreams(ctx context.Context, limit int) ([]LiveStreamInfo, error) {
+	params := url.Values{
+		"limit": []string{"100"},
+		"sort":  []string{"desc"},
+	}
+
+	var streams []LiveStreamInfo
+	for page := 1; ; page++ {
+		params.Set("page", strconv.Itoa(page))
+		body, err := c.kickMakeHTTPRequest(ctx, "GET", "stream/livestreams/en", params)
+		if err != nil {
+			return nil, err
+		}
+
+		var resp KickLivestreamsResponse
+		err = json.Unmarshal(body, &resp)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, livestream := range resp.Data {
+			if limit > 0 && len(streams) >= limit {
+				return streams, nil
+			}
+			if livestream.Channel == nil {
+				continue
+			}
+			stream, err := kickLivestreamToLiveStreamInfo(livestream, livestream.Channel)
+			if err != nil {
+				return nil, err
+			}
+			streams = append(streams, *stream)
+		}
+
+		if len(resp.Data) == 0 || resp.CurrentPage >= resp.LastPage {
+			break
+		}
+	}
+
+	return streams, nil
+}
+
+// GetStream fetches the m3u8 playlist for a live Kick stream.
+func (c *KickConnection) GetStream(ctx context.Context, channelName string) (*hls.Multivariant, error) {
+	channel, err := c.getChannel(ctx, channelName)
+	if err != nil {
+		return nil, err
+	}
+
+	if channel.Livestream == nil || !channel.Livestream.IsLive || channel.PlaybackURL == "" {
+		return nil, fmt.Errorf("failed to fetch stream for channel %s: %w", channel.Slug, ErrorNoStreamsFound{})
+	}
+
+	log.Debug().Msgf("Kick m3u8 URL for %s: %s", channel.Slug, channel.PlaybackURL)
+
+	return kickGetMultivariant(ctx, channel.PlaybackURL)
+}
+
+// GetVideoPlaylistURL returns the URL of the m3u8 playlist of a Kick video.
+func (c *KickConnection) GetVideoPlaylistURL(ctx context.Context, id string) (string, error) {
+	video, err := c.getVideo(ctx, id)
+	if err != nil {
+		return "", err
+	}
+
+	if video.Source == "" {
+		return "", fmt.Errorf("video %s has no playlist", id)
+	}
+
+	return video.Source, nil
+}
+
+// GetVideoChat fetches the chat replay of a Kick video. The chat replay API only returns messages of a short window after the requested start time so the replay is fetched window by window.
+func (c *KickConnection) GetVideoChat(ctx context.Context, id string) (*KickVideoChat, error) {
+	video, err := c.getVideo(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	startedAt, err := parseKickTime(video.Livestream.StartTime)
+	if err != nil {
+		return nil, err
+	}
+
+	videoChat := KickVideoChat{
+		ChannelID: video.Livestream.ChannelID,
+		StartedAt: startedAt,
+		Duration:  time.Duration(video.Livestream.Duration) * time.Millisecond,
+	}
+	if video.Livestream.Channel != nil {
+		videoChat.ChannelSlug = video.Livestream.Channel.Slug
+	}
+
+	seen := make(map[string]struct{})
+	endpoint := fmt.Sprintf("api/v2/channels/%d/messages", videoChat.ChannelID)
+	for offset := time.Duration(0); offset <= videoChat.Duration; offset += kickChatReplayInterval {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		params := url.Values{
+			"start_time": []string{startedAt.Add(offset).Format("2006-01-02T15:04:05.000Z")},
+		}
+		body, err := c.kickMakeHTTPRequest(ctx, "GET", endpoint, params)
+		if err != nil {
+			return nil, fmt.Errorf("failed to fetch chat messages at offset %s: %w", offset, err)
+		}
+
+		var resp KickChatMessagesResponse
+		err = json.Unmarshal(body, &resp)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, message := range resp.Data.Messages {
+			if _, ok := seen[message.ID]; ok {
+				continue
+			}
+			seen[message.ID] = struct{}{}
+			videoChat.Messages = append(videoChat.Messages, message)
+		}
+	}
+
+	log.Debug().Str("video_id", id).Int("messages", len(videoChat.Messages)).Msg("fetched kick chat replay")
+
+	return &videoChat, nil
+}
+
+// getChannel fetches a channel by its slug.
+func (c *KickConnection) getChannel(ctx context.Context, slug string) (*KickChannel, error) {
+	body, err := c.kickMakeHTTPRequest(ctx, "GET", fmt.Sprintf("api/v2/channels/%s", url.PathEscape(strings.ToLower(slug))), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var channel KickChannel
+	err = json.Unmarshal(body, &channel)
+	if err != nil {
+		return nil, err
+	}
+
+	if channel.Slug == "" {
+		return nil, fmt.Errorf("channel not found")
+	}
+
+	return &channel, nil
+}
+
+// getVideo fetches a video by its UUID.
+func (c *KickConnection) getVideo(ctx context.Context, id string) (*KickVideo, error) {
+	body, err := c.kickMakeHTTPRequest(ctx, "GET", fmt.Sprintf("api/v1/video/%s", url.PathEscape(id)), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var video KickVideo
+	err = json.Unmarshal(body, &video)
+	if err != nil {
+		return nil, err
+	}
+
+	if video.UUID == "" {
+		return nil, fmt.Errorf("video not found")
+	}
+
+	return &video, nil
+}
+
+// kickGetMultivariant fetches and decodes a multivariant m3u8 playlist.
+func kickGetMultivariant(ctx context.Context, playlistURL string) (*hls.Multivariant, error) {
+	client := &http.Client{
+		Timeout: 10 * time.Second,
+	}
+	req, err := http.NewRequestWithContext(ctx, "GET", playlistURL, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %v", err)
+	}
+	req.Header.Set("User-Agent", utils.ChromeUserAgent)
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch m3u8 playlist: %v", err)
+	}
+	defer resp.Body.Close() //nolint:errcheck
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("received unexpected status code: %d", resp.StatusCode)
+	}
+
+	masterPlaylist, err := hls.DecodeMultivariant(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("error decoding m3u8 response body: %v", err)
+	}
+
+	// variant URIs can be relative to the multivariant playlist
+	base, err := url.Parse(playlistURL)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing playlist url: %v", err)
+	}
+	for _, variant := range masterPlaylist.Variants {
+		uri, err := url.Parse(variant.URI)
+		if err != nil {
+			return nil, fmt.Errorf("error parsing variant uri: %v", err)
+		}
+		variant.URI = base.ResolveReference(uri).String()
+	}
+
+	return masterPlaylist, nil
+}
+
+func kickLivestreamToVideoInfo(livestream KickLivestream, channel *KickChannel, withChapters bool) (*VideoInfo, error) {
+	if livestream.Video == nil {
+		return nil, fmt.Errorf("livestream %d has no video", livestream.ID)
+	}
+
+	startedAt, err := parseKickTime(livestream.StartTime)
+	if err != nil {
+		return nil, err
+	}
+
+	publishedAt := startedAt
+	if livestream.Video.CreatedAt != "" {
+		publishedAt, err = parseKickTime(livestream.Video.CreatedAt)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	viewCount := livestream.Views
+	if livestream.Video.Views > viewCount {
+		viewCount = livestream.Video.Views
+	}
+
+	info := VideoInfo{
+		ID:           livestream.Video.UUID,
+		StreamID:     strconv.FormatInt(livestream.ID, 10),
+		UserID:       channel.Slug,
+		UserLogin:    channel.Slug,
+		UserName:     channel.User.Username,
+		Title:        livestream.SessionTitle,
+		CreatedAt:    startedAt,
+		PublishedAt:  publishedAt,
+		URL:          utils.CreateKickURL(livestream.Video.UUID, utils.Archive, channel.Slug),
+		ThumbnailURL: string(livestream.Thumbnail),
+		Viewable:     "public",
+		ViewCount:    viewCount,
+		Language:     livestream.Language,
+		Type:         string(VideoTypeArchive),
+		Duration:     time.Duration(livestream.Duration) * time.Millisecond,
+	}
+
+	if len(livestream.Categories) > 0 {
+		info.Category = &livestream.Categories[0].Name
+	}
+
+	// If chapter is empty use the category as a fallback chapter
+	if withChapters && info.Category != nil {
+		info.Chapters = []chapter.Chapter{
+			{
+				ID:    "fallback",
+				Type:  string(utils.ChapterTypeFallback),
+				Title: *info.Category,
+				Start: 0,
+				End:   int(info.Duration.Seconds()),
+			},
+		}
+	}
+
+	return &info, nil
+}
+
+func kickLivestreamToLiveStreamInfo(livestream KickLivestream, channel *KickChannel) (*LiveStreamInfo, error) {
+	startedAt, err := parseKickTime(livestream.StartTime)
+	if err != nil {
+		return nil, err
+	}
+
+	info := LiveStreamInfo{
+		Platform:     utils.PlatformKick,
+		ID:           strconv.FormatInt(livestream.ID, 10),
+		UserID:       channel.Slug,
+		UserLogin:    channel.Slug,
+		UserName:     channel.User.Username,
+		Type:         "live",
+		Title:        livestream.SessionTitle,
+		ViewerCount:  livestream.ViewerCount,
+		StartedAt:    startedAt,
+		Language:     livestream.Language,
+		ThumbnailURL: string(livestream.Thumbnail),
+	}
+
+	if len(livestream.Categories) > 0 {
+		info.GameID = strconv.FormatInt(livestream.Categories[0].ID, 10)
+		info.GameName = livestream.Categories[0].Name
+	}
+
+	return &info, nil
+}
@@ -0,0 +1,342 @@
+package platform
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/rs/zerolog/log"
+	"github.com/zibbp/ganymede/internal/utils"
+)
+
+var (
+	KickUrl      = "https://kick.com"
+	KickFilesUrl = "https://files.kick.com"
+	// chat replay is fetched in windows starting at the requested time
+	kickChatReplayInterval = 5 * time.Second
+	// minimum time between requests to the Kick API
+	kickRequestInterval = 250 * time.Millisecond
+	// delay before the first retry of a failed request, doubled for every further retry
+	kickRetryDelay    = 2 * time.Second
+	kickRetryAttempts = 5
+)
+
+type KickUser struct {
+	ID         int64  `json:"id"`
+	Username   string `json:"username"`
+	Bio        string `json:"bio"`
+	ProfilePic string `json:"profile_pic"`
+}
+
+type KickChannel struct {
+	ID             int64           `json:"id"`
+	UserID         int64           `json:"user_id"`
+	Slug           string          `json:"slug"`
+	PlaybackURL    string          `json:"playback_url"`
+	VodEnabled     bool            `json:"vod_enabled"`
+	FollowersCount int64           `json:"followers_count"`
+	User           KickUser        `json:"user"`
+	Livestream     *KickLivestream `json:"livestream"`
+	BannerImage    *struct {
+		URL string `json:"url"`
+	} `json:"banner_image"`
+	OfflineBannerImage *struct {
+		Src string `json:"src"`
+	} `json:"offline_banner_image"`
+}
+
+type KickCategory struct {
+	ID   int64  `json:"id"`
+	Name string `json:"name"`
+	Slug string `json:"slug"`
+}
+
+// KickThumbnail is returned either as a plain URL or as an object depending on the endpoint.
+type KickThumbnail string
+
+func (t *KickThumbnail) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(data, []byte("null")) {
+		return nil
+	}
+
+	var value string
+	if err := json.Unmarshal(data, &value); err == nil {
+		*t = KickThumbnail(value)
+		return nil
+	}
+
+	var object struct {
+		URL string `json:"url"`
+		Src string `json:"src"`
+	}
+	if err := json.Unmarshal(data, &object); err != nil {
+		return err
+	}
+	if object.URL != "" {
+		*t = KickThumbnail(object.URL)
+	} else {
+		*t = KickThumbnail(object.Src)
+	}
+	return nil
+}
+
+type KickLivestream struct {
+	ID           int64                `json:"id"`
+	Slug         string               `json:"slug"`
+	ChannelID    int64                `json:"channel_id"`
+	CreatedAt    string               `json:"created_at"`
+	SessionTitle string               `json:"session_title"`
+	IsLive       bool                 `json:"is_live"`
+	StartTime    string               `json:"start_time"`
+	Source       string               `json:"source"`
+	Duration     int64                `json:"duration"` // milliseconds
+	Language     string               `json:"language"`
+	ViewerCount  int64                `json:"viewer_count"`
+	Views        int64                `json:"views"`
+	Thumbnail    KickThumbnail        `json:"thumbnail"`
+	Categories   []KickCategory       `json:"categories"`
+	Video        *KickLivestreamVideo `json:"video"`
+	Channel      *KickChannel         `json:"channel"`
+}
+
+// KickLivestreamVideo is the VOD of a finished livestream. The UUID is used as the video ID.
+type KickLivestreamVideo struct {
+	ID        int64  `json:"id"`
+	UUID      string `json:"uuid"`
+	Views     int64  `json:"views"`
+	CreatedAt string `json:"created_at"`
+}
+
+type KickVideo struct {
+	ID           int64          `json:"id"`
+	LiveStreamID int64          `json:"live_stream_id"`
+	UUID         string         `json:"uuid"`
+	Source       string         `json:"source"`
+	Views        int64          `json:"views"`
+	CreatedAt    string         `json:"created_at"`
+	Livestream   KickLivestream `json:"livestream"`
+}
+
+type KickLivestreamsResponse struct {
+	CurrentPage int              `json:"current_page"`
+	LastPage    int              `json:"last_page"`
+	Data        []KickLivestream `json:"data"`
+}
+
+type KickCategoriesResponse struct {
+	CurrentPage int            `json:"current_page"`
+	LastPage    int            `json:"last_page"`
+	Data        []KickCategory `json:"data"`
+}
+
+type KickEmoteSet struct {
+	ID     interface{} `json:"id"` // channel ID, "Global" or "Emoji"
+	Name   string      `json:"name"`
+	Emotes []struct {
+		ID              int64  `json:"id"`
+		Name            string `json:"name"`
+		SubscribersOnly bool   `json:"subscribers_only"`
+	} `json:"emotes"`
+}
+
+type KickChatMessagesResponse struct {
+	Data struct {
+		Messages []KickChatMessage `json:"messages"`
+		Cursor   string            `json:"cursor"`
+	} `json:"data"`
+}
+
+type KickChatMessage struct {
+	ID        string `json:"id"`
+	ChatID    int64  `json:"chat_id"`
+	UserID    int64  `json:"user_id"`
+	Content   string `json:"content"`
+	Type      string `json:"type"`     // message or reply
+	Metadata  string `json:"metadata"` // JSON encoded reply metadata
+	CreatedAt string `json:"created_at"`
+	Sender    struct {
+		ID       int64  `json:"id"`
+		Slug     string `json:"slug"`
+		Username string `json:"username"`
+		Identity struct {
+			Color  string `json:"color"`
+			Badges []struct {
+				Type  string `json:"type"`
+				Text  string `json:"text"`
+				Count int    `json:"count"`
+			} `json:"badges"`
+		} `json:"identity"`
+	} `json:"sender"`
+}
+
+// UnmarshalJSON decodes the message metadata which is either a JSON encoded string or an object.
+func (m *KickChatMessage) UnmarshalJSON(data []byte) error {
+	type kickChatMessage KickChatMessage
+	var raw struct {
+		kickChatMessage
+		Metadata json.RawMessage `json:"metadata"`
+	}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+	*m = KickChatMessage(raw.kickChatMessage)
+
+	if len(raw.Metadata) == 0 || bytes.Equal(raw.Metadata, []byte("null")) {
+		return nil
+	}
+	var metadata string
+	if err := json.Unmarshal(raw.Metadata, &metadata); err == nil {
+		m.Metadata = metadata
+	} else {
+		m.Metadata = string(raw.Metadata)
+	}
+	return nil
+}
+
+type KickChatReplyMetadata struct {
+	OriginalSender struct {
+		ID       interface{} `json:"id"`
+		Username string      `json:"username"`
+	} `json:"original_sender"`
+	OriginalMessage struct {
+		ID      string `json:"id"`
+		Content string `json:"content"`
+	} `json:"original_message"`
+}
+
+// KickVideoChat is the chat replay of a Kick video.
+type KickVideoChat struct {
+	ChannelID   int64
+	ChannelSlug string
+	StartedAt   time.Time
+	Duration    time.Duration
+	Messages    []KickChatMessage
+}
+
+// kickMakeHTTPRequest sends a request to the Kick API. The public API does not require authentication but requests without a browser user agent are rejected.
+// Requests are spaced out by the request interval and failed requests are retried with an exponential backoff.
+func (c *KickConnection) kickMakeHTTPRequest(ctx context.Context, method, endpoint string, queryParams url.Values) ([]byte, error) {
+	client := &http.Client{}
+
+	var lastErr error
+	for attempt := 0; attempt < kickRetryAttempts; attempt++ {
+		if err := c.wait(ctx); err != nil {
+			return nil, err
+		}
+
+		req, err := http.NewRequestWithContext(ctx, method, fmt.Sprintf("%s/%s", KickUrl, endpoint), nil)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create request: %v", err)
+		}
+		req.Header.Set("Accept", "application/json")
+		req.Header.Set("User-Agent", utils.ChromeUserAgent)
+		if queryParams != nil {
+			req.URL.RawQuery = queryParams.Encode()
+		}
+
+		resp, err := client.Do(req)
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil, ctx.Err()
+			}
+			lastErr = fmt.Errorf("failed to make request: %v", err)
+			if err := c.backoff(ctx, attempt, ""); err != nil {
+				return nil, err
+			}
+			continue
+		}
+
+		body, err := io.ReadAll(resp.Body)
+		if closeErr := resp.Body.Close(); closeErr != nil {
+			log.Debug().Err(closeErr).Msg("error closing response body")
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to read response body: %v", err)
+		}
+
+		if resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode >= http.StatusInternalServerError {
+			lastErr = fmt.Errorf("unexpected status code %d: %s", resp.StatusCode, body)
+			if err := c.backoff(ctx, attempt, resp.Header.Get("Retry-After")); err != nil {
+				return nil, err
+			}
+			continue
+		}
+
+		if resp.StatusCode == http.StatusNotFound {
+			return nil, fmt.Errorf("not found: %s", endpoint)
+		}
+
+		if resp.StatusCode != http.StatusOK {
+			return nil, fmt.Errorf("unexpected status code %d: %s", resp.StatusCode, body)
+		}
+
+		return body, nil
+	}
+
+	return nil, fmt.Errorf("max retry attempts reached: %w", lastErr)
+}
+
+// backoff waits before the retry of a failed attempt. The delay requested by the API is used if there is one.
+// The wait is skipped after the last attempt.
+func (c *KickConnection) backoff(ctx context.Context, attempt int, retryAfter string) error {
+	if attempt >= kickRetryAttempts-1 {
+		return nil
+	}
+	delay := kickRetryDelay << attempt
+	if seconds, err := strconv.Atoi(retryAfter); err == nil && seconds > 0 {
+		delay = time.Duration(seconds) * time.Second
+	}
+	log.Debug().Int("attempt", attempt+1).Str("delay", delay.String()).Msg("kick request failed; retrying")
+	return sleepContext(ctx, delay)
+}
+
+// wait blocks until the request interval passed since the previous request of the connection.
+func (c *KickConnection) wait(ctx context.Context) error {
+	c.mu.Lock()
+	now := time.Now()
+	at := c.nextRequest
+	if at.Before(now) {
+		at = now
+	}
+	c.nextRequest = at.Add(kickRequestInterval)
+	c.mu.Unlock()
+
+	return sleepContext(ctx, time.Until(at))
+}
+
+// sleepContext waits for the duration or until the context is done.
+func sleepContext(ctx context.Context, d time.Duration) error {
+	if d <= 0 {
+		return ctx.Err()
+	}
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// parseKickTime parses the timestamps returned by the Kick API. Most endpoints return UTC timestamps without a timezone while chat messages use RFC 3339.
+func parseKickTime(value string) (time.Time, error) {
+	for _, layout := range []string{time.RFC3339Nano, "2006-01-02 15:04:05"} {
+		t, err := time.Parse(layout, value)
+		if err == nil {
+			return t.UTC(), nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("invalid time: %q", value)
+}
+
+// KickEmoteURL returns the URL of the full size emote image.
+func KickEmoteURL(id string) string {
+	return fmt.Sprintf("%s/emotes/%s/fullsize", KickFilesUrl, id)
+}
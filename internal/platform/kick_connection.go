@@ -0,0 +1,23 @@
+package platform
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/rs/zerolog/log"
+)
+
+// KickConnection uses the public Kick API which does not require credentials. The connection is shared so
+// the requests of all tasks are spaced out to stay under the rate limit of the API.
+type KickConnection struct {
+	mu          sync.Mutex
+	nextRequest time.Time // the earliest time the next request can be sent
+}
+
+// Authenticate is a no-op as the public Kick API does not require authentication.
+func (c *KickConnection) Authenticate(ctx context.Context) (*ConnectionInfo, error) {
+	log.Info().Msg("kick connection authenticated")
+
+	return &ConnectionInfo{}, nil
+}
@@ -0,0 +1,398 @@
+package platform
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/zibbp/ganymede/internal/utils"
+)
+
+const testKickVideoUUID = "0b1c2d3e-4f50-6172-8394-a5b6c7d8e9f0"
+
+// fakeKickAPI is a minimal stand-in for the public Kick API.
+func fakeKickAPI(t *testing.T, live bool) http.HandlerFunc {
+	t.Helper()
+
+	pastStream := map[string]any{
+		"id":            1001,
+		"slug":          "past-stream",
+		"channel_id":    42,
+		"created_at":    "2024-05-02 09:00:00",
+		"session_title": "A past stream",
+		"is_live":       false,
+		"start_time":    "2024-05-02 09:00:00",
+		"source":        "https://stream.kick.test/vod/master.m3u8",
+		"duration":      12000,
+		"language":      "English",
+		"views":         7,
+		"thumbnail":     map[string]any{"src": "https://kick.test/past-thumbnail.jpg"},
+		"categories":    []any{map[string]any{"id": 15, "name": "Just Chatting", "slug": "just-chatting"}},
+		"video":         map[string]any{"id": 500, "uuid": testKickVideoUUID, "views": 9, "created_at": "2024-05-02T12:00:00.000000Z"},
+	}
+	liveStream := map[string]any{
+		"id":            1002,
+		"slug":          "live-stream",
+		"channel_id":    42,
+		"session_title": "Live now",
+		"is_live":       true,
+		"start_time":    "2024-05-03 09:00:00",
+		"language":      "English",
+		"viewer_count":  123,
+		"thumbnail":     map[string]any{"url": "https://kick.test/live-thumbnail.jpg"},
+		"categories":    []any{map[string]any{"id": 16, "name": "Slots", "slug": "slots"}},
+	}
+
+	return func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("User-Agent") == "" {
+			t.Errorf("expected user agent header")
+		}
+
+		var resp any
+		switch r.URL.Path {
+		case "/api/v2/channels/testkick":
+			channel := map[string]any{
+				"id":                   42,
+				"user_id":              43,
+				"slug":                 "testkick",
+				"playback_url":         KickUrl + "/playback/master.m3u8", // the test server is the kick url
+				"user":                 map[string]any{"id": 43, "username": "TestKick", "bio": "channel bio", "profile_pic": "https://kick.test/avatar.jpg"},
+				"offline_banner_image": map[string]any{"src": "https://kick.test/offline.jpg"},
+				"livestream":           nil,
+			}
+			if live {
+				channel["livestream"] = liveStream
+			}
+			resp = channel
+		case "/api/v2/channels/testkick/videos":
+			videos := []any{pastStream}
+			if live {
+				videos = append([]any{liveStream}, videos...)
+			}
+			resp = videos
+		case "/api/v1/video/" + testKickVideoUUID:
+			livestream := map[string]any{}
+			for k, v := range pastStream {
+				livestream[k] = v
+			}
+			delete(livestream, "video")
+			livestream["thumbnail"] = "https://kick.test/past-thumbnail.jpg"
+			livestream["channel"] = map[string]any{"id": 42, "slug": "testkick", "user": map[string]any{"username": "TestKick"}}
+			resp = map[string]any{
+				"id":             500,
+				"live_stream_id": 1001,
+				"uuid":           testKickVideoUUID,
+				"source":         "https://stream.kick.test/vod/master.m3u8",
+				"views":          9,
+				"created_at":     "2024-05-02T12:00:00.000000Z",
+				"livestream":     livestream,
+			}
+		case "/api/v2/channels/42/messages":
+			startTime, err := time.Parse(time.RFC3339Nano, r.URL.Query().Get("start_time"))
+			if err != nil {
+				t.Errorf("invalid start_time: %v", err)
+			}
+			offset := int(startTime.Sub(time.Date(2024, 5, 2, 9, 0, 0, 0, time.UTC)).Seconds())
+			// every window returns the message of the previous window again
+			messages := []any{}
+			for _, second := range []int{offset - 5, offset} {
+				if second < 0 {
+					continue
+				}
+				messages = append(messages, map[string]any{
+					"id":         fmt.Sprintf("message-%d", second),
+					"chat_id":    42,
+					"content":    fmt.Sprintf("message at %d [emote:37226:KEKW]", second),
+					"type":       "message",
+					"created_at": time.Date(2024, 5, 2, 9, 0, second, 0, time.UTC).Format(time.RFC3339Nano),
+					"sender":     map[string]any{"id": 7, "slug": "chatter", "username": "Chatter"},
+				})
+			}
+			resp = map[string]any{"data": map[string]any{"messages": messages}}
+		case "/playback/master.m3u8":
+			w.Header().Set("Content-Type", "application/vnd.apple.mpegurl")
+			_, _ = w.Write([]byte(`#EXTM3U
+#EXT-X-SESSION-DATA:DATA-ID="com.amazon.ivs.broadcast-id",VALUE="example-broadcast"
+#EXT-X-STREAM-INF:BANDWIDTH=6000000,CODECS="avc1.64002a,mp4a.40.2",RESOLUTION=1920x1080,FRAME-RATE=60.000,STABLE-VARIANT-ID="1080p60",IVS-NAME="source",AUDIO="audio"
+1080p60/playlist.m3u8
+#EXT-X-STREAM-INF:BANDWIDTH=3000000,CODECS="avc1.640020,mp4a.40.2",RESOLUTION=1280x720,FRAME-RATE=30.000,STABLE-VARIANT-ID="720p30",IVS-NAME="720p30",AUDIO="audio"
+https://cdn.kick.test/720p30/playlist.m3u8
+`))
+			return
+		default:
+			http.NotFound(w, r)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		if err := json.NewEncoder(w).Encode(resp); err != nil {
+			t.Fatalf("failed to write response: %v", err)
+		}
+	}
+}
+
+func withKickTestServer(t *testing.T, handler http.HandlerFunc) {
+	t.Helper()
+
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	previousURL, previousInterval, previousRetryDelay := KickUrl, kickRequestInterval, kickRetryDelay
+	KickUrl = server.URL
+	kickRequestInterval = time.Millisecond
+	kickRetryDelay = time.Millisecond
+	t.Cleanup(func() {
+		KickUrl = previousURL
+		kickRequestInterval = previousInterval
+		kickRetryDelay = previousRetryDelay
+	})
+}
+
+func TestKickGetChannel(t *testing.T) {
+	withKickTestServer(t, fakeKickAPI(t, false))
+	conn := &KickConnection{}
+
+	name := "TestKick"
+	channel, err := conn.GetChannel(context.Background(), &name, nil)
+	if err != nil {
+		t.Fatalf("GetChannel returned error: %v", err)
+	}
+
+	if channel.ID != "testkick" || channel.Login != "testkick" {
+		t.Fatalf("expected slug as id and login, got %s %s", channel.ID, channel.Login)
+	}
+	if channel.DisplayName != "TestKick" || channel.ProfileImageURL != "https://kick.test/avatar.jpg" || channel.OfflineImageURL != "https://kick.test/offline.jpg" {
+		t.Fatalf("unexpected channel: %+v", channel)
+	}
+
+	missing := "missing"
+	if _, err := conn.GetChannel(context.Background(), &missing, nil); err == nil {
+		t.Fatal("expected error for missing channel")
+	}
+}
+
+func TestKickGetVideo(t *testing.T) {
+	withKickTestServer(t, fakeKickAPI(t, false))
+	conn := &KickConnection{}
+
+	video, err := conn.GetVideo(context.Background(), testKickVideoUUID, true, true)
+	if err != nil {
+		t.Fatalf("GetVideo returned error: %v", err)
+	}
+
+	if video.ID != testKickVideoUUID || video.StreamID != "1001" || video.UserID != "testkick" {
+		t.Fatalf("unexpected video ids: %+v", video)
+	}
+	if video.Type != string(VideoTypeArchive) || video.Duration != 12*time.Second {
+		t.Fatalf("unexpected video type or duration: %s %s", video.Type, video.Duration)
+	}
+	if !video.CreatedAt.Equal(time.Date(2024, 5, 2, 9, 0, 0, 0, time.UTC)) {
+		t.Fatalf("unexpected created at: %s", video.CreatedAt)
+	}
+	if video.ThumbnailURL != "https://kick.test/past-thumbnail.jpg" || video.ViewCount != 9 {
+		t.Fatalf("unexpected thumbnail or views: %s %d", video.ThumbnailURL, video.ViewCount)
+	}
+	if video.Category == nil || *video.Category != "Just Chatting" {
+		t.Fatalf("expected Just Chatting category, got %v", video.Category)
+	}
+	if len(video.Chapters) != 1 || video.Chapters[0].Type != string(utils.ChapterTypeFallback) || video.Chapters[0].End != 12 {
+		t.Fatalf("expected fallback chapter, got %+v", video.Chapters)
+	}
+
+	playlistURL, err := conn.GetVideoPlaylistURL(context.Background(), testKickVideoUUID)
+	if err != nil {
+		t.Fatalf("GetVideoPlaylistURL returned error: %v", err)
+	}
+	if playlistURL != "https://stream.kick.test/vod/master.m3u8" {
+		t.Fatalf("unexpected playlist url: %s", playlistURL)
+	}
+}
+
+func TestKickGetVideos(t *testing.T) {
+	withKickTestServer(t, fakeKickAPI(t, true))
+	conn := &KickConnection{}
+
+	archives, err := conn.GetVideos(context.Background(), "testkick", VideoTypeArchive, false, false)
+	if err != nil {
+		t.Fatalf("GetVideos returned error: %v", err)
+	}
+	if len(archives) != 1 || archives[0].ID != testKickVideoUUID {
+		t.Fatalf("expected only the finished stream, got %+v", archives)
+	}
+
+	uploads, err := conn.GetVideos(context.Background(), "testkick", VideoTypeUpload, false, false)
+	if err != nil {
+		t.Fatalf("GetVideos returned error: %v", err)
+	}
+	if len(uploads) != 0 {
+		t.Fatalf("expected no uploads, got %d", len(uploads))
+	}
+}
+
+func TestKickGetLiveStream(t *testing.T) {
+	withKickTestServer(t, fakeKickAPI(t, true))
+	conn := &KickConnection{}
+
+	stream, err := conn.GetLiveStream(context.Background(), "testkick")
+	if err != nil {
+		t.Fatalf("GetLiveStream returned error: %v", err)
+	}
+	if stream.ID != "1002" || stream.UserID != "testkick" || stream.GameName != "Slots" || stream.ViewerCount != 123 {
+		t.Fatalf("unexpected stream: %+v", stream)
+	}
+
+	streams, err := conn.GetLiveStreams(context.Background(), []string{"testkick"})
+	if err != nil {
+		t.Fatalf("GetLiveStreams returned error: %v", err)
+	}
+	if len(streams) != 1 {
+		t.Fatalf("expected 1 stream, got %d", len(streams))
+	}
+
+	live, err := conn.CheckIfStreamIsLive(context.Background(), "testkick")
+	if err != nil || !live {
+		t.Fatalf("expected channel to be live, got %v %v", live, err)
+	}
+
+	playlist, err := conn.GetStream(context.Background(), "testkick")
+	if err != nil {
+		t.Fatalf("GetStream returned error: %v", err)
+	}
+	if len(playlist.Variants) != 2 {
+		t.Fatalf("expected 2 variants, got %d", len(playlist.Variants))
+	}
+	if !strings.HasSuffix(playlist.Variants[0].URI, "/playback/1080p60/playlist.m3u8") || !strings.HasPrefix(playlist.Variants[0].URI, "http") {
+		t.Fatalf("expected relative variant uri to be resolved, got %s", playlist.Variants[0].URI)
+	}
+	if playlist.Variants[1].URI != "https://cdn.kick.test/720p30/playlist.m3u8" {
+		t.Fatalf("expected absolute variant uri to be unchanged, got %s", playlist.Variants[1].URI)
+	}
+}
+
+func TestKickGetLiveStreamOffline(t *testing.T) {
+	withKickTestServer(t, fakeKickAPI(t, false))
+	conn := &KickConnection{}
+
+	_, err := conn.GetLiveStream(context.Background(), "testkick")
+	var e ErrorNoStreamsFound
+	if !errors.As(err, &e) {
+		t.Fatalf("expected ErrorNoStreamsFound, got %v", err)
+	}
+
+	live, err := conn.CheckIfStreamIsLive(context.Background(), "testkick")
+	if err != nil || live {
+		t.Fatalf("expected channel to be offline, got %v %v", live, err)
+	}
+}
+
+func TestKickGetVideoChat(t *testing.T) {
+	withKickTestServer(t, fakeKickAPI(t, false))
+	conn := &KickConnection{}
+
+	videoChat, err := conn.GetVideoChat(context.Background(), testKickVideoUUID)
+	if err != nil {
+		t.Fatalf("GetVideoChat returned error: %v", err)
+	}
+
+	if videoChat.ChannelID != 42 || videoChat.ChannelSlug != "testkick" || videoChat.Duration != 12*time.Second {
+		t.Fatalf("unexpected video chat: %+v", videoChat)
+	}
+	// windows at 0s, 5s and 10s each return their own and the previous message
+	if len(videoChat.Messages) != 3 {
+		t.Fatalf("expected 3 unique messages, got %d", len(videoChat.Messages))
+	}
+	if videoChat.Messages[2].ID != "message-10" || videoChat.Messages[2].Sender.Username != "Chatter" {
+		t.Fatalf("unexpected message: %+v", videoChat.Messages[2])
+	}
+}
+
+func TestKickRetriesFailedRequests(t *testing.T) {
+	handler := fakeKickAPI(t, false)
+	requests := 0
+	var requestTimes []time.Time
+	withKickTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		requestTimes = append(requestTimes, time.Now())
+		// the first request of every chat window is rate limited
+		if strings.HasSuffix(r.URL.Path, "/messages") && requests%2 == 0 {
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		handler(w, r)
+	})
+	kickRequestInterval = 20 * time.Millisecond
+	conn := &KickConnection{}
+
+	videoChat, err := conn.GetVideoChat(context.Background(), testKickVideoUUID)
+	if err != nil {
+		t.Fatalf("GetVideoChat returned error: %v", err)
+	}
+	if len(videoChat.Messages) != 3 {
+		t.Fatalf("expected 3 unique messages, got %d", len(videoChat.Messages))
+	}
+	// the video and 3 chat windows, each retried once
+	if requests != 7 {
+		t.Fatalf("expected 7 requests, got %d", requests)
+	}
+	for i := 1; i < len(requestTimes); i++ {
+		if gap := requestTimes[i].Sub(requestTimes[i-1]); gap < 15*time.Millisecond {
+			t.Fatalf("requests %d and %d were only %s apart", i-1, i, gap)
+		}
+	}
+
+	// requests that keep failing return an error
+	withKickTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadGateway)
+	})
+	if _, err := conn.GetVideoChat(context.Background(), testKickVideoUUID); err == nil {
+		t.Fatal("expected error when every attempt fails")
+	}
+}
+
+func TestKickChatMessageMetadata(t *testing.T) {
+	tests := []string{
+		`{"id":"1","type":"reply","metadata":"{\"original_message\":{\"id\":\"0\",\"content\":\"hi\"}}"}`,
+		`{"id":"1","type":"reply","metadata":{"original_message":{"id":"0","content":"hi"}}}`,
+	}
+
+	for _, input := range tests {
+		var message KickChatMessage
+		if err := json.Unmarshal([]byte(input), &message); err != nil {
+			t.Fatalf("failed to unmarshal %s: %v", input, err)
+		}
+		if message.ID != "1" || message.Type != "reply" {
+			t.Fatalf("unexpected message: %+v", message)
+		}
+
+		var metadata KickChatReplyMetadata
+		if err := json.Unmarshal([]byte(message.Metadata), &metadata); err != nil {
+			t.Fatalf("failed to unmarshal metadata %q: %v", message.Metadata, err)
+		}
+		if metadata.OriginalMessage.Content != "hi" {
+			t.Fatalf("unexpected metadata: %+v", metadata)
+		}
+	}
+}
+
+func TestParseKickTime(t *testing.T) {
+	expected := time.Date(2024, 5, 2, 9, 0, 0, 0, time.UTC)
+	for _, value := range []string{"2024-05-02 09:00:00", "2024-05-02T09:00:00.000000Z", "2024-05-02T11:00:00+02:00"} {
+		parsed, err := parseKickTime(value)
+		if err != nil {
+			t.Fatalf("parseKickTime(%q) returned error: %v", value, err)
+		}
+		if !parsed.Equal(expected) {
+			t.Fatalf("parseKickTime(%q) = %s, expected %s", value, parsed, expected)
+		}
+	}
+
+	if _, err := parseKickTime("yesterday"); err == nil {
+		t.Fatal("expected error for invalid time")
+	}
+}
@@ -1 +1,30 @@
 package platform
+
+import (
+	"fmt"
+
+	"github.com/zibbp/ganymede/internal/utils"
+)
+
+// Resolve returns the configured connection for the video platform. An empty platform defaults to Twitch.
+func Resolve(videoPlatform utils.VideoPlatform, twitch Platform, youtube Platform, kick Platform) (Platform, error) {
+	switch videoPlatform {
+	case utils.PlatformTwitch, "":
+		if twitch == nil {
+			return nil, fmt.Errorf("twitch platform is not configured; set TWITCH_CLIENT_ID/SECRET")
+		}
+		return twitch, nil
+	case utils.PlatformYoutube:
+		if youtube == nil {
+			return nil, fmt.Errorf("youtube platform is not configured; set YOUTUBE_API_KEY")
+		}
+		return youtube, nil
+	case utils.PlatformKick:
+		if kick == nil {
+			return nil, fmt.Errorf("kick platform is not configured")
+		}
+		return kick, nil
+	default:
+		return nil, fmt.Errorf("unsupported platform: %s", videoPlatform)
+	}
+}
@@ -0,0 +1,31 @@
+package platform
+
+import (
+	"testing"
+
+	"github.com/zibbp/ganymede/internal/utils"
+)
+
+func TestResolve(t *testing.T) {
+	twitch := &TwitchConnection{}
+	youtube := &YoutubeConnection{}
+
+	for _, videoPlatform := range []utils.VideoPlatform{utils.PlatformTwitch, ""} {
+		got, err := Resolve(videoPlatform, twitch, youtube, nil)
+		if err != nil || got != twitch {
+			t.Fatalf("expected twitch connection for %q, got %v %v", videoPlatform, got, err)
+		}
+	}
+	got, err := Resolve(utils.PlatformYoutube, twitch, youtube, nil)
+	if err != nil || got != youtube {
+		t.Fatalf("expected youtube connection, got %v %v", got, err)
+	}
+
+	// platforms without a connection and unknown platforms are errors
+	if _, err := Resolve(utils.PlatformKick, twitch, youtube, nil); err == nil {
+		t.Fatal("expected error for unconfigured platform")
+	}
+	if _, err := Resolve(utils.VideoPlatform("unknown"), twitch, youtube, nil); err == nil {
+		t.Fatal("expected error for unknown platform")
+	}
+}
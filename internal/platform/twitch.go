@@ -157,6 +157,7 @@ func (c *TwitchConnection) GetLiveStream(ctx context.Context, channelName string
 	}
 
 	info := LiveStreamInfo{
+		Platform:     utils.PlatformTwitch,
 		ID:           resp.Data[0].ID,
 		UserID:       resp.Data[0].UserID,
 		UserLogin:    resp.Data[0].UserLogin,
@@ -203,6 +204,7 @@ func (c *TwitchConnection) GetLiveStreams(ctx context.Context, channelIDs []stri
 		}
 
 		streams = append(streams, LiveStreamInfo{
+			Platform:     utils.PlatformTwitch,
 			ID:           stream.ID,
 			UserID:       stream.UserID,
 			UserLogin:    stream.UserLogin,
@@ -945,6 +947,7 @@ func (c *TwitchConnection) GetStreams(ctx context.Context, limit int) ([]LiveStr
 			}
 
 			streams = append(streams, LiveStreamInfo{
+				Platform:     utils.PlatformTwitch,
 				ID:           stream.ID,
 				UserID:       stream.UserID,
 				UserLogin:    stream.UserLogin,
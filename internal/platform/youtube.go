@@ -0,0 +1,677 @@
+package platform
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/rs/zerolog/log"
+	"github.com/zibbp/ganymede/internal/chapter"
+	"github.com/zibbp/ganymede/internal/utils"
+)
+
+// GetVideo implements the Platform interface to get video information from YouTube. Chapters are parsed from timestamps in the video description. YouTube does not mute segments so muted segments are never returned.
+func (c *YoutubeConnection) GetVideo(ctx context.Context, id string, withChapters bool, withMutedSegments bool) (*VideoInfo, error) {
+	videos, err := c.getVideosByID(ctx, []string{id})
+	if err != nil {
+		return nil, err
+	}
+
+	if len(videos) == 0 {
+		return nil, fmt.Errorf("video not found")
+	}
+
+	channel, err := c.getChannel(ctx, nil, &videos[0].Snippet.ChannelID)
+	if err != nil {
+		return nil, err
+	}
+
+	categories, err := c.getCategoryNames(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return youtubeVideoToVideoInfo(videos[0], youtubeChannelLogin(channel), categories, withChapters)
+}
+
+func (c *YoutubeConnection) GetLiveStream(ctx context.Context, channelName string) (*LiveStreamInfo, error) {
+	channel, err := c.getChannel(ctx, &channelName, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.getLiveStreamByChannel(ctx, channel)
+}
+
+// GetLiveStreams retrieves live streams for multiple channel IDs. The YouTube Data API can't list the live streams of multiple channels, so the recent uploads of each channel are checked. The channels and videos are fetched in batches to keep the quota cost to about one unit per channel.
+func (c *YoutubeConnection) GetLiveStreams(ctx context.Context, channelIDs []string) ([]LiveStreamInfo, error) {
+	channels, err := c.getChannelsByID(ctx, channelIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	logins := make(map[string]string, len(channels))
+	var videoIDs []string
+	for i := range channels {
+		ids, err := c.getRecentUploadIDs(ctx, &channels[i])
+		if err != nil {
+			log.Warn().Err(err).Str("channel_id", channels[i].ID).Msg("error fetching recent uploads of youtube channel; skipping live check")
+			continue
+		}
+		logins[channels[i].ID] = youtubeChannelLogin(&channels[i])
+		videoIDs = append(videoIDs, ids...)
+	}
+
+	videos, err := c.getVideosByID(ctx, videoIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	var streams []LiveStreamInfo
+	var categories map[string]string
+	for _, video := range videos {
+		login, ok := logins[video.Snippet.ChannelID]
+		if !ok || video.Snippet.LiveBroadcastContent != "live" {
+			continue
+		}
+		if categories == nil {
+			categories, err = c.getCategoryNames(ctx)
+			if err != nil {
+				return nil, err
+			}
+		}
+		stream, err := youtubeVideoToLiveStreamInfo(video, login, categories)
+		if err != nil {
+			return nil, err
+		}
+		streams = append(streams, *stream)
+		// a channel has one live stream
+		delete(logins, video.Snippet.ChannelID)
+	}
+
+	if len(streams) == 0 {
+		return nil, fmt.Errorf("failed to fetch stream for channels: %w", ErrorNoStreamsFound{})
+	}
+
+	return streams, nil
+}
+
+// GetChannel retrieves channel information by its name or ID. The channel name is the channel handle with or without the leading @.
+func (c *YoutubeConnection) GetChannel(ctx context.Context, channelName *string, channelID *string) (*ChannelInfo, error) {
+	channel, err := c.getChannel(ctx, channelName, channelID)
+	if err != nil {
+		return nil, err
+	}
+
+	createdAt, err := time.Parse(time.RFC3339, channel.Snippet.PublishedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	viewCount, _ := strconv.ParseInt(channel.Statistics.ViewCount, 10, 64)
+
+	info := ChannelInfo{
+		ID:              channel.ID,
+		Login:           youtubeChannelLogin(channel),
+		DisplayName:     channel.Snippet.Title,
+		Description:     channel.Snippet.Description,
+		ProfileImageURL: channel.Snippet.Thumbnails.bestThumbnailURL(),
+		OfflineImageURL: channel.BrandingSettings.Image.BannerExternalURL,
+		ViewCount:       viewCount,
+		CreatedAt:       createdAt,
+	}
+
+	return &info, nil
+}
+
+// GetVideos retrieves videos for a given channel ID. Finished live streams are returned for the archive type and regular videos for the upload type. YouTube has no concept of highlights so none are returned for that type.
+func (c *YoutubeConnection) GetVideos(ctx context.Context, channelId string, videoType VideoType, withChapters bool, withMutedSegments bool) ([]VideoInfo, error) {
+	if videoType == VideoTypeHighlight {
+		return []VideoInfo{}, nil
+	}
+
+	channel, err := c.getChannel(ctx, nil, &channelId)
+	if err != nil {
+		return nil, err
+	}
+
+	// every channel has an "uploads" playlist containing all public videos
+	params := url.Values{
+		"part":       []string{"contentDetails"},
+		"playlistId": []string{channel.ContentDetails.RelatedPlaylists.Uploads},
+		"maxResults": []string{strconv.Itoa(youtubeMaxResults)},
+	}
+
+	var videoIDs []string
+	for {
+		body, err := c.youtubeMakeHTTPRequest(ctx, "GET", "playlistItems", params)
+		if err != nil {
+			return nil, err
+		}
+
+		var resp YoutubePlaylistItemListResponse
+		err = json.Unmarshal(body, &resp)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, item := range resp.Items {
+			videoIDs = append(videoIDs, item.ContentDetails.VideoID)
+		}
+
+		if resp.NextPageToken == "" {
+			break
+		}
+		params.Set("pageToken", resp.NextPageToken)
+	}
+
+	videos, err := c.getVideosByID(ctx, videoIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	categories, err := c.getCategoryNames(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	info := []VideoInfo{}
+	for _, video := range videos {
+		// skip live and upcoming streams, they are not finished videos yet
+		if video.Snippet.LiveBroadcastContent != "" && video.Snippet.LiveBroadcastContent != "none" {
+			continue
+		}
+
+		isStream := video.LiveStreamingDetails != nil
+		if (videoType == VideoTypeArchive && !isStream) || (videoType == VideoTypeUpload && isStream) {
+			continue
+		}
+
+		videoInfo, err := youtubeVideoToVideoInfo(video, youtubeChannelLogin(channel), categories, withChapters)
+		if err != nil {
+			return nil, err
+		}
+		info = append(info, *videoInfo)
+	}
+
+	return info, nil
+}
+
+// GetCategories retrieves the video categories of the US region.
+func (c *YoutubeConnection) GetCategories(ctx context.Context) ([]Category, error) {
+	params := url.Values{
+		"part":       []string{"snippet"},
+		"regionCode": []string{"US"},
+	}
+	body, err := c.youtubeMakeHTTPRequest(ctx, "GET", "videoCategories", params)
+	if err != nil {
+		return nil, err
+	}
+
+	var resp YoutubeVideoCategoryListResponse
+	err = json.Unmarshal(body, &resp)
+	if err != nil {
+		return nil, err
+	}
+
+	categories := make([]Category, 0, len(resp.Items))
+	for _, category := range resp.Items {
+		categories = append(categories, Category{
+			ID:   category.ID,
+			Name: category.Snippet.Title,
+		})
+	}
+
+	return categories, nil
+}
+
+// GetGlobalBadges is not supported by YouTube, an empty list is returned.
+func (c *YoutubeConnection) GetGlobalBadges(ctx context.Context) ([]Badge, error) {
+	return []Badge{}, nil
+}
+
+// GetChannelBadges is not supported by YouTube, an empty list is returned.
+func (c *YoutubeConnection) GetChannelBadges(ctx context.Context, channelId string) ([]Badge, error) {
+	return []Badge{}, nil
+}
+
+// GetGlobalEmotes is not supported by YouTube, an empty list is returned.
+func (c *YoutubeConnection) GetGlobalEmotes(ctx context.Context) ([]Emote, error) {
+	return []Emote{}, nil
+}
+
+// GetChannelEmotes is not supported by YouTube, an empty list is returned.
+func (c *YoutubeConnection) GetChannelEmotes(ctx context.Context, channelId string) ([]Emote, error) {
+	return []Emote{}, nil
+}
+
+// GetChannelClips is not supported as the YouTube Data API does not expose clips.
+func (c *YoutubeConnection) GetChannelClips(ctx context.Context, channelId string, filter ClipsFilter) ([]ClipInfo, error) {
+	return nil, fmt.Errorf("clips are not supported for youtube")
+}
+
+// GetClip is not supported as the YouTube Data API does not expose clips.
+func (c *YoutubeConnection) GetClip(ctx context.Context, id string) (*ClipInfo, error) {
+	return nil, fmt.Errorf("clips are not supported for youtube")
+}
+
+// CheckIfStreamIsLive checks if a YouTube channel is currently live.
+func (c *YoutubeConnection) CheckIfStreamIsLive(ctx context.Context, channelName string) (bool, error) {
+	_, err := c.GetLiveStream(ctx, channelName)
+	if err != nil {
+		var e ErrorNoStreamsFound
+		if errors.As(err, &e) {
+			return false, nil
+		}
+		return false, err
+	}
+
+	return true, nil
+}
+
+// GetStreams fetches live streams from YouTube sorted by viewership.
+func (c *YoutubeConnection) GetStreams(ctx context.Context, limit int) ([]LiveStreamInfo, error) {
+	params := url.Values{
+		"part":       []string{"id"},
+		"eventType":  []string{"live"},
+		"type":       []string{"video"},
+		"order":      []string{"viewCount"},
+		"maxResults": []string{strconv.Itoa(youtubeMaxResults)},
+	}
+
+	var videoIDs []string
+	for limit <= 0 || len(videoIDs) < limit {
+		body, err := c.youtubeMakeHTTPRequest(ctx, "GET", "search", params)
+		if err != nil {
+			return nil, err
+		}
+
+		var resp YoutubeSearchListResponse
+		err = json.Unmarshal(body, &resp)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, item := range resp.Items {
+			videoIDs = append(videoIDs, item.ID.VideoID)
+		}
+
+		if resp.NextPageToken == "" || len(resp.Items) == 0 {
+			break
+		}
+		params.Set("pageToken", resp.NextPageToken)
+	}
+
+	if limit > 0 && len(videoIDs) > limit {
+		videoIDs = videoIDs[:limit]
+	}
+
+	videos, err := c.getVideosByID(ctx, videoIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	categories, err := c.getCategoryNames(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	streams := make([]LiveStreamInfo, 0, len(videos))
+	for _, video := range videos {
+		stream, err := youtubeVideoToLiveStreamInfo(video, "", categories)
+		if err != nil {
+			return nil, err
+		}
+		streams = append(streams, *stream)
+	}
+
+	return streams, nil
+}
+
+// getChannel fetches a channel by ID or handle.
+func (c *YoutubeConnection) getChannel(ctx context.Context, channelName *string, channelID *string) (*YoutubeChannel, error) {
+	params := url.Values{
+		"part": []string{"snippet,contentDetails,statistics,brandingSettings"},
+	}
+
+	if channelID != nil && *channelID != "" {
+		params.Set("id", *channelID)
+	} else if channelName != nil && *channelName != "" {
+		// channel IDs are also accepted as channel names
+		if youtubeChannelIDRegex.MatchString(*channelName) {
+			params.Set("id", *channelName)
+		} else {
+			params.Set("forHandle", "@"+strings.TrimPrefix(*channelName, "@"))
+		}
+	} else {
+		return nil, fmt.Errorf("either channelName or channelID must be provided")
+	}
+
+	body, err := c.youtubeMakeHTTPRequest(ctx, "GET", "channels", params)
+	if err != nil {
+		return nil, err
+	}
+
+	var resp YoutubeChannelListResponse
+	err = json.Unmarshal(body, &resp)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(resp.Items) == 0 {
+		return nil, fmt.Errorf("channel not found")
+	}
+
+	return &resp.Items[0], nil
+}
+
+// getChannelsByID fetches the channels in batches of the maximum page size. Channels that don't exist are not returned.
+func (c *YoutubeConnection) getChannelsByID(ctx context.Context, ids []string) ([]YoutubeChannel, error) {
+	var channels []YoutubeChannel
+	for start := 0; start < len(ids); start += youtubeMaxResults {
+		end := min(start+youtubeMaxResults, len(ids))
+
+		params := url.Values{
+			"part":       []string{"snippet,contentDetails"},
+			"id":         []string{strings.Join(ids[start:end], ",")},
+			"maxResults": []string{strconv.Itoa(youtubeMaxResults)},
+		}
+		body, err := c.youtubeMakeHTTPRequest(ctx, "GET", "channels", params)
+		if err != nil {
+			return nil, err
+		}
+
+		var resp YoutubeChannelListResponse
+		err = json.Unmarshal(body, &resp)
+		if err != nil {
+			return nil, err
+		}
+
+		channels = append(channels, resp.Items...)
+	}
+
+	return channels, nil
+}
+
+// getRecentUploadIDs returns the IDs of the most recent uploads of the channel. Live streams are added to the uploads playlist when they start.
+// This is used instead of the search endpoint as search requests cost 100x more quota.
+func (c *YoutubeConnection) getRecentUploadIDs(ctx context.Context, channel *YoutubeChannel) ([]string, error) {
+	params := url.Values{
+		"part":       []string{"contentDetails"},
+		"playlistId": []string{channel.ContentDetails.RelatedPlaylists.Uploads},
+		"maxResults": []string{strconv.Itoa(youtubeLiveCheckResults)},
+	}
+	body, err := c.youtubeMakeHTTPRequest(ctx, "GET", "playlistItems", params)
+	if err != nil {
+		return nil, err
+	}
+
+	var resp YoutubePlaylistItemListResponse
+	err = json.Unmarshal(body, &resp)
+	if err != nil {
+		return nil, err
+	}
+
+	videoIDs := make([]string, 0, len(resp.Items))
+	for _, item := range resp.Items {
+		videoIDs = append(videoIDs, item.ContentDetails.VideoID)
+	}
+	return videoIDs, nil
+}
+
+// getLiveStreamByChannel checks the most recent uploads of the channel for an active live stream.
+func (c *YoutubeConnection) getLiveStreamByChannel(ctx context.Context, channel *YoutubeChannel) (*LiveStreamInfo, error) {
+	videoIDs, err := c.getRecentUploadIDs(ctx, channel)
+	if err != nil {
+		return nil, err
+	}
+
+	videos, err := c.getVideosByID(ctx, videoIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, video := range videos {
+		if video.Snippet.LiveBroadcastContent != "live" {
+			continue
+		}
+
+		categories, err := c.getCategoryNames(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		return youtubeVideoToLiveStreamInfo(video, youtubeChannelLogin(channel), categories)
+	}
+
+	return nil, fmt.Errorf("failed to fetch stream for channel %s: %w", channel.ID, ErrorNoStreamsFound{})
+}
+
+// getVideosByID fetches the videos in batches of the maximum page size.
+func (c *YoutubeConnection) getVideosByID(ctx context.Context, ids []string) ([]YoutubeVideo, error) {
+	var videos []YoutubeVideo
+	for start := 0; start < len(ids); start += youtubeMaxResults {
+		end := min(start+youtubeMaxResults, len(ids))
+
+		params := url.Values{
+			"part": []string{"snippet,contentDetails,status,statistics,liveStreamingDetails"},
+			"id":   []string{strings.Join(ids[start:end], ",")},
+		}
+		body, err := c.youtubeMakeHTTPRequest(ctx, "GET", "videos", params)
+		if err != nil {
+			return nil, err
+		}
+
+		var resp YoutubeVideoListResponse
+		err = json.Unmarshal(body, &resp)
+		if err != nil {
+			return nil, err
+		}
+
+		videos = append(videos, resp.Items...)
+	}
+
+	return videos, nil
+}
+
+// getCategoryNames returns a map of category ID to category name.
+func (c *YoutubeConnection) getCategoryNames(ctx context.Context) (map[string]string, error) {
+	categories, err := c.GetCategories(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	names := make(map[string]string, len(categories))
+	for _, category := range categories {
+		names[category.ID] = category.Name
+	}
+
+	return names, nil
+}
+
+func youtubeVideoToVideoInfo(video YoutubeVideo, channelLogin string, categories map[string]string, withChapters bool) (*VideoInfo, error) {
+	if channelLogin == "" {
+		channelLogin = video.Snippet.ChannelID
+	}
+
+	publishedAt, err := time.Parse(time.RFC3339, video.Snippet.PublishedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	// live streams report a duration of zero while live
+	duration := time.Duration(0)
+	if video.ContentDetails.Duration != "" {
+		duration, err = parseYoutubeDuration(video.ContentDetails.Duration)
+		if err != nil {
+			return nil, fmt.Errorf("error parsing duration: %v", err)
+		}
+	}
+
+	viewCount, _ := strconv.ParseInt(video.Statistics.ViewCount, 10, 64)
+
+	info := VideoInfo{
+		ID:           video.ID,
+		UserID:       video.Snippet.ChannelID,
+		UserLogin:    channelLogin,
+		UserName:     video.Snippet.ChannelTitle,
+		Title:        video.Snippet.Title,
+		Description:  video.Snippet.Description,
+		CreatedAt:    publishedAt,
+		PublishedAt:  publishedAt,
+		URL:          utils.CreateYoutubeURL(video.ID),
+		ThumbnailURL: video.Snippet.Thumbnails.bestThumbnailURL(),
+		Viewable:     video.Status.PrivacyStatus,
+		ViewCount:    viewCount,
+		Language:     video.Snippet.DefaultAudioLanguage,
+		Type:         string(VideoTypeUpload),
+		Duration:     duration,
+	}
+
+	if categoryName, ok := categories[video.Snippet.CategoryID]; ok {
+		info.Category = &categoryName
+	}
+
+	// the video of a live stream has the same ID as the stream
+	if video.LiveStreamingDetails != nil {
+		info.Type = string(VideoTypeArchive)
+		info.StreamID = video.ID
+		if video.LiveStreamingDetails.ActualStartTime != "" {
+			createdAt, err := time.Parse(time.RFC3339, video.LiveStreamingDetails.ActualStartTime)
+			if err != nil {
+				return nil, err
+			}
+			info.CreatedAt = createdAt
+		}
+	}
+
+	if withChapters {
+		info.Chapters = parseYoutubeDescriptionChapters(info.Description, int(info.Duration.Seconds()))
+
+		// If chapter is empty use the category as a fallback chapter
+		if len(info.Chapters) == 0 && info.Category != nil {
+			info.Chapters = []chapter.Chapter{
+				{
+					ID:    "fallback",
+					Type:  string(utils.ChapterTypeFallback),
+					Title: *info.Category,
+					Start: 0,
+					End:   int(info.Duration.Seconds()),
+				},
+			}
+		}
+	}
+
+	return &info, nil
+}
+
+func youtubeVideoToLiveStreamInfo(video YoutubeVideo, channelLogin string, categories map[string]string) (*LiveStreamInfo, error) {
+	if channelLogin == "" {
+		channelLogin = video.Snippet.ChannelID
+	}
+
+	info := LiveStreamInfo{
+		Platform:     utils.PlatformYoutube,
+		ID:           video.ID,
+		UserID:       video.Snippet.ChannelID,
+		UserLogin:    channelLogin,
+		UserName:     video.Snippet.ChannelTitle,
+		GameID:       video.Snippet.CategoryID,
+		GameName:     categories[video.Snippet.CategoryID],
+		Type:         "live",
+		Title:        video.Snippet.Title,
+		Language:     video.Snippet.DefaultAudioLanguage,
+		ThumbnailURL: video.Snippet.Thumbnails.bestThumbnailURL(),
+	}
+
+	if video.LiveStreamingDetails != nil {
+		info.ViewerCount, _ = strconv.ParseInt(video.LiveStreamingDetails.ConcurrentViewers, 10, 64)
+		if video.LiveStreamingDetails.ActualStartTime != "" {
+			startedAt, err := time.Parse(time.RFC3339, video.LiveStreamingDetails.ActualStartTime)
+			if err != nil {
+				return nil, err
+			}
+			info.StartedAt = startedAt
+		}
+	}
+
+	return &info, nil
+}
+
+var (
+	youtubeChannelIDRegex = regexp.MustCompile(`^UC[0-9A-Za-z_-]{22}$`)
+	// matches lines such as "0:00 Intro", "(1:02:03) - Topic" or "- 12:34 | Topic"
+	youtubeTimestampRegex = regexp.MustCompile(`^\s*(?:[-*•]\s*)?[(\[]?((?:\d{1,2}:)?\d{1,2}:\d{2})[)\]]?\s*(?:[-–—:|]\s*)?(.+?)\s*$`)
+)
+
+// youtubeChannelLogin returns the channel handle without the leading @, falling back to the channel ID if the channel does not have a handle.
+func youtubeChannelLogin(channel *YoutubeChannel) string {
+	login := strings.TrimPrefix(channel.Snippet.CustomURL, "@")
+	if login == "" {
+		return channel.ID
+	}
+	return login
+}
+
+// parseYoutubeDescriptionChapters parses chapters from timestamps in the video description. YouTube only considers timestamps to be chapters if the first starts at 0:00, there are at least three, and they are in ascending order. The same rules are applied here.
+func parseYoutubeDescriptionChapters(description string, duration int) []chapter.Chapter {
+	var chapters []chapter.Chapter
+	for _, line := range strings.Split(description, "\n") {
+		matches := youtubeTimestampRegex.FindStringSubmatch(line)
+		if matches == nil {
+			continue
+		}
+
+		start, err := parseYoutubeTimestamp(matches[1])
+		if err != nil {
+			continue
+		}
+
+		if len(chapters) == 0 && start != 0 {
+			return nil
+		}
+		if len(chapters) > 0 && start <= chapters[len(chapters)-1].Start {
+			return nil
+		}
+
+		chapters = append(chapters, chapter.Chapter{
+			ID:    fmt.Sprintf("timestamp-%d", start),
+			Type:  string(utils.ChapterTypeTimestamp),
+			Title: matches[2],
+			Start: start,
+		})
+	}
+
+	if len(chapters) < 3 {
+		return nil
+	}
+
+	for i := range chapters {
+		if i+1 < len(chapters) {
+			chapters[i].End = chapters[i+1].Start
+		} else {
+			chapters[i].End = duration
+		}
+	}
+
+	return chapters
+}
+
+// parseYoutubeTimestamp parses a timestamp in the format [h:]mm:ss to seconds.
+func parseYoutubeTimestamp(timestamp string) (int, error) {
+	seconds := 0
+	for _, part := range strings.Split(timestamp, ":") {
+		n, err := strconv.Atoi(part)
+		if err != nil {
+			return 0, err
+		}
+		seconds = seconds*60 + n
+	}
+	return seconds, nil
+}
@@ -0,0 +1,242 @@
+package platform
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strconv"
+	"time"
+
+	"github.com/rs/zerolog/log"
+)
+
+var (
+	YoutubeApiUrl = "https://www.googleapis.com/youtube/v3"
+	// maximum number of IDs accepted by list endpoints in a single request
+	youtubeMaxResults = 50
+	// number of recent uploads checked for an active live stream
+	youtubeLiveCheckResults = 10
+)
+
+type YoutubePageInfo struct {
+	TotalResults   int `json:"totalResults"`
+	ResultsPerPage int `json:"resultsPerPage"`
+}
+
+type YoutubeThumbnail struct {
+	URL    string `json:"url"`
+	Width  int    `json:"width"`
+	Height int    `json:"height"`
+}
+
+type YoutubeThumbnails struct {
+	Default  *YoutubeThumbnail `json:"default"`
+	Medium   *YoutubeThumbnail `json:"medium"`
+	High     *YoutubeThumbnail `json:"high"`
+	Standard *YoutubeThumbnail `json:"standard"`
+	Maxres   *YoutubeThumbnail `json:"maxres"`
+}
+
+type YoutubeChannelListResponse struct {
+	Items         []YoutubeChannel `json:"items"`
+	NextPageToken string           `json:"nextPageToken"`
+	PageInfo      YoutubePageInfo  `json:"pageInfo"`
+}
+
+type YoutubeChannel struct {
+	ID      string `json:"id"`
+	Snippet struct {
+		Title       string            `json:"title"`
+		Description string            `json:"description"`
+		CustomURL   string            `json:"customUrl"`
+		PublishedAt string            `json:"publishedAt"`
+		Thumbnails  YoutubeThumbnails `json:"thumbnails"`
+	} `json:"snippet"`
+	ContentDetails struct {
+		RelatedPlaylists struct {
+			Uploads string `json:"uploads"`
+		} `json:"relatedPlaylists"`
+	} `json:"contentDetails"`
+	Statistics struct {
+		ViewCount string `json:"viewCount"`
+	} `json:"statistics"`
+	BrandingSettings struct {
+		Image struct {
+			BannerExternalURL string `json:"bannerExternalUrl"`
+		} `json:"image"`
+	} `json:"brandingSettings"`
+}
+
+type YoutubePlaylistItemListResponse struct {
+	Items []struct {
+		ContentDetails struct {
+			VideoID          string `json:"videoId"`
+			VideoPublishedAt string `json:"videoPublishedAt"`
+		} `json:"contentDetails"`
+	} `json:"items"`
+	NextPageToken string          `json:"nextPageToken"`
+	PageInfo      YoutubePageInfo `json:"pageInfo"`
+}
+
+type YoutubeVideoListResponse struct {
+	Items         []YoutubeVideo  `json:"items"`
+	NextPageToken string          `json:"nextPageToken"`
+	PageInfo      YoutubePageInfo `json:"pageInfo"`
+}
+
+type YoutubeVideo struct {
+	ID      string `json:"id"`
+	Snippet struct {
+		PublishedAt          string            `json:"publishedAt"`
+		ChannelID            string            `json:"channelId"`
+		Title                string            `json:"title"`
+		Description          string            `json:"description"`
+		Thumbnails           YoutubeThumbnails `json:"thumbnails"`
+		ChannelTitle         string            `json:"channelTitle"`
+		CategoryID           string            `json:"categoryId"`
+		LiveBroadcastContent string            `json:"liveBroadcastContent"` // none, live or upcoming
+		DefaultAudioLanguage string            `json:"defaultAudioLanguage"`
+	} `json:"snippet"`
+	ContentDetails struct {
+		Duration string `json:"duration"` // ISO 8601 duration
+	} `json:"contentDetails"`
+	Status struct {
+		PrivacyStatus string `json:"privacyStatus"`
+	} `json:"status"`
+	Statistics struct {
+		ViewCount string `json:"viewCount"`
+	} `json:"statistics"`
+	LiveStreamingDetails *struct {
+		ActualStartTime   string `json:"actualStartTime"`
+		ActualEndTime     string `json:"actualEndTime"`
+		ConcurrentViewers string `json:"concurrentViewers"`
+	} `json:"liveStreamingDetails"`
+}
+
+type YoutubeSearchListResponse struct {
+	Items []struct {
+		ID struct {
+			Kind    string `json:"kind"`
+			VideoID string `json:"videoId"`
+		} `json:"id"`
+	} `json:"items"`
+	NextPageToken string          `json:"nextPageToken"`
+	PageInfo      YoutubePageInfo `json:"pageInfo"`
+}
+
+type YoutubeVideoCategoryListResponse struct {
+	Items []struct {
+		ID      string `json:"id"`
+		Snippet struct {
+			Title      string `json:"title"`
+			Assignable bool   `json:"assignable"`
+		} `json:"snippet"`
+	} `json:"items"`
+}
+
+type YoutubeErrorResponse struct {
+	Error struct {
+		Code    int    `json:"code"`
+		Message string `json:"message"`
+		Errors  []struct {
+			Reason string `json:"reason"`
+		} `json:"errors"`
+	} `json:"error"`
+}
+
+// youtubeMakeHTTPRequest sends a request to the YouTube Data API. The API key is added to every request.
+func (c *YoutubeConnection) youtubeMakeHTTPRequest(ctx context.Context, method, endpoint string, queryParams url.Values) ([]byte, error) {
+	client := &http.Client{}
+
+	if queryParams == nil {
+		queryParams = url.Values{}
+	}
+	queryParams.Set("key", c.ApiKey)
+
+	for attempt := 0; attempt < maxRetryAttempts; attempt++ {
+		req, err := http.NewRequestWithContext(ctx, method, fmt.Sprintf("%s/%s", YoutubeApiUrl, endpoint), nil)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create request: %v", err)
+		}
+		req.Header.Set("Accept", "application/json")
+		req.URL.RawQuery = queryParams.Encode()
+
+		resp, err := client.Do(req)
+		if err != nil {
+			return nil, fmt.Errorf("failed to make request: %v", err)
+		}
+
+		body, err := io.ReadAll(resp.Body)
+		if closeErr := resp.Body.Close(); closeErr != nil {
+			log.Debug().Err(closeErr).Msg("error closing response body")
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to read response body: %v", err)
+		}
+
+		if resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode >= http.StatusInternalServerError {
+			if attempt < maxRetryAttempts-1 {
+				time.Sleep(retryDelay)
+				continue
+			}
+		}
+
+		if resp.StatusCode != http.StatusOK {
+			var apiErr YoutubeErrorResponse
+			if err := json.Unmarshal(body, &apiErr); err == nil && apiErr.Error.Message != "" {
+				return nil, fmt.Errorf("unexpected status code %d: %s", resp.StatusCode, apiErr.Error.Message)
+			}
+			return nil, fmt.Errorf("unexpected status code %d: %s", resp.StatusCode, body)
+		}
+
+		return body, nil
+	}
+
+	return nil, fmt.Errorf("max retry attempts reached")
+}
+
+var youtubeDurationRegex = regexp.MustCompile(`^P(?:(\d+)D)?(?:T(?:(\d+)H)?(?:(\d+)M)?(?:(\d+(?:\.\d+)?)S)?)?$`)
+
+// parseYoutubeDuration parses the ISO 8601 durations returned by the YouTube Data API (e.g. PT1H2M3S).
+func parseYoutubeDuration(value string) (time.Duration, error) {
+	matches := youtubeDurationRegex.FindStringSubmatch(value)
+	if matches == nil || value == "P" || value == "PT" {
+		return 0, fmt.Errorf("invalid duration: %q", value)
+	}
+
+	var duration time.Duration
+	units := []time.Duration{24 * time.Hour, time.Hour, time.Minute}
+	for i, unit := range units {
+		if matches[i+1] == "" {
+			continue
+		}
+		n, err := strconv.Atoi(matches[i+1])
+		if err != nil {
+			return 0, fmt.Errorf("invalid duration: %q", value)
+		}
+		duration += time.Duration(n) * unit
+	}
+	if matches[4] != "" {
+		seconds, err := strconv.ParseFloat(matches[4], 64)
+		if err != nil {
+			return 0, fmt.Errorf("invalid duration: %q", value)
+		}
+		duration += time.Duration(seconds * float64(time.Second))
+	}
+
+	return duration, nil
+}
+
+// bestThumbnailURL returns the highest resolution thumbnail available.
+func (t YoutubeThumbnails) bestThumbnailURL() string {
+	for _, thumbnail := range []*YoutubeThumbnail{t.Maxres, t.Standard, t.High, t.Medium, t.Default} {
+		if thumbnail != nil && thumbnail.URL != "" {
+			return thumbnail.URL
+		}
+	}
+	return ""
+}
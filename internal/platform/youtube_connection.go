@@ -0,0 +1,33 @@
+package platform
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"github.com/rs/zerolog/log"
+)
+
+type YoutubeConnection struct {
+	ApiKey string
+}
+
+// Authenticate validates the API key by making a cheap request to the YouTube Data API. The YouTube Data API does not use access tokens for public data.
+func (c *YoutubeConnection) Authenticate(ctx context.Context) (*ConnectionInfo, error) {
+	if c.ApiKey == "" {
+		return nil, fmt.Errorf("youtube api key is not set")
+	}
+
+	params := url.Values{
+		"part":       []string{"snippet"},
+		"regionCode": []string{"US"},
+	}
+	_, err := c.youtubeMakeHTTPRequest(ctx, "GET", "videoCategories", params)
+	if err != nil {
+		return nil, fmt.Errorf("failed to validate youtube api key: %w", err)
+	}
+
+	log.Info().Msg("youtube connection authenticated")
+
+	return &ConnectionInfo{}, nil
+}
@@ -0,0 +1,409 @@
+package platform
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/zibbp/ganymede/internal/utils"
+)
+
+const testYoutubeChannelID = "UC0123456789abcdefghijkl"
+
+// fakeYoutubeAPI is a minimal stand-in for the YouTube Data API.
+func fakeYoutubeAPI(t *testing.T, live bool) http.HandlerFunc {
+	t.Helper()
+
+	channel := map[string]any{
+		"id": testYoutubeChannelID,
+		"snippet": map[string]any{
+			"title":       "Test Channel",
+			"description": "channel description",
+			"customUrl":   "@testchannel",
+			"publishedAt": "2020-01-02T03:04:05Z",
+			"thumbnails": map[string]any{
+				"default": map[string]any{"url": "https://yt.test/avatar-small.jpg"},
+				"high":    map[string]any{"url": "https://yt.test/avatar-high.jpg"},
+			},
+		},
+		"contentDetails": map[string]any{
+			"relatedPlaylists": map[string]any{"uploads": "UU0123456789abcdefghijkl"},
+		},
+		"statistics": map[string]any{"viewCount": "1000"},
+	}
+
+	videos := map[string]map[string]any{
+		"upload1": {
+			"id": "upload1",
+			"snippet": map[string]any{
+				"publishedAt":          "2024-05-01T10:00:00Z",
+				"channelId":            testYoutubeChannelID,
+				"title":                "An upload",
+				"description":          "Chapters\n0:00 Intro\n1:30 - Main topic\n(10:00) Outro\nthanks for watching",
+				"channelTitle":         "Test Channel",
+				"categoryId":           "20",
+				"liveBroadcastContent": "none",
+				"thumbnails": map[string]any{
+					"medium": map[string]any{"url": "https://yt.test/upload1-medium.jpg"},
+					"maxres": map[string]any{"url": "https://yt.test/upload1-maxres.jpg"},
+				},
+			},
+			"contentDetails": map[string]any{"duration": "PT12M"},
+			"status":         map[string]any{"privacyStatus": "public"},
+			"statistics":     map[string]any{"viewCount": "42"},
+		},
+		"stream1": {
+			"id": "stream1",
+			"snippet": map[string]any{
+				"publishedAt":          "2024-05-02T10:00:00Z",
+				"channelId":            testYoutubeChannelID,
+				"title":                "A past stream",
+				"description":          "no chapters here",
+				"channelTitle":         "Test Channel",
+				"categoryId":           "20",
+				"liveBroadcastContent": "none",
+			},
+			"contentDetails": map[string]any{"duration": "PT1H2M3S"},
+			"status":         map[string]any{"privacyStatus": "public"},
+			"statistics":     map[string]any{"viewCount": "7"},
+			"liveStreamingDetails": map[string]any{
+				"actualStartTime": "2024-05-02T09:00:00Z",
+				"actualEndTime":   "2024-05-02T10:02:03Z",
+			},
+		},
+		"live1": {
+			"id": "live1",
+			"snippet": map[string]any{
+				"publishedAt":          "2024-05-03T10:00:00Z",
+				"channelId":            testYoutubeChannelID,
+				"title":                "Live now",
+				"channelTitle":         "Test Channel",
+				"categoryId":           "20",
+				"liveBroadcastContent": "live",
+			},
+			"contentDetails": map[string]any{"duration": "P0D"},
+			"liveStreamingDetails": map[string]any{
+				"actualStartTime":   "2024-05-03T10:00:00Z",
+				"concurrentViewers": "123",
+			},
+		},
+	}
+
+	return func(w http.ResponseWriter, r *http.Request) {
+		if got := r.URL.Query().Get("key"); got != "api-key" {
+			w.WriteHeader(http.StatusForbidden)
+			_, _ = w.Write([]byte(`{"error":{"code":403,"message":"bad key"}}`))
+			return
+		}
+
+		var resp any
+		switch strings.TrimPrefix(r.URL.Path, "/") {
+		case "channels":
+			if slices.Contains(strings.Split(r.URL.Query().Get("id"), ","), testYoutubeChannelID) || r.URL.Query().Get("forHandle") == "@testchannel" {
+				resp = map[string]any{"items": []any{channel}}
+			} else {
+				resp = map[string]any{"items": []any{}}
+			}
+		case "playlistItems":
+			if r.URL.Query().Get("pageToken") == "" {
+				items := []any{map[string]any{"contentDetails": map[string]any{"videoId": "upload1"}}}
+				if live {
+					items = append([]any{map[string]any{"contentDetails": map[string]any{"videoId": "live1"}}}, items...)
+				}
+				resp = map[string]any{
+					"items":         items,
+					"nextPageToken": "page2",
+				}
+			} else {
+				resp = map[string]any{
+					"items": []any{map[string]any{"contentDetails": map[string]any{"videoId": "stream1"}}},
+				}
+			}
+		case "videos":
+			items := []any{}
+			for _, id := range strings.Split(r.URL.Query().Get("id"), ",") {
+				if video, ok := videos[id]; ok {
+					items = append(items, video)
+				}
+			}
+			resp = map[string]any{"items": items}
+		case "search":
+			if r.URL.Query().Get("eventType") != "live" {
+				t.Fatalf("expected eventType=live search, got %q", r.URL.RawQuery)
+			}
+			items := []any{}
+			if live {
+				items = append(items, map[string]any{"id": map[string]any{"kind": "youtube#video", "videoId": "live1"}})
+			}
+			resp = map[string]any{"items": items}
+		case "videoCategories":
+			resp = map[string]any{"items": []any{map[string]any{"id": "20", "snippet": map[string]any{"title": "Gaming"}}}}
+		default:
+			t.Fatalf("unexpected request path %q", r.URL.Path)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		if err := json.NewEncoder(w).Encode(resp); err != nil {
+			t.Fatalf("failed to write response: %v", err)
+		}
+	}
+}
+
+func withYoutubeTestServer(t *testing.T, handler http.HandlerFunc) {
+	t.Helper()
+
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	previousAPIURL := YoutubeApiUrl
+	YoutubeApiUrl = server.URL
+	t.Cleanup(func() {
+		YoutubeApiUrl = previousAPIURL
+	})
+}
+
+func TestYoutubeConnectionAuthenticate(t *testing.T) {
+	withYoutubeTestServer(t, fakeYoutubeAPI(t, false))
+
+	if _, err := (&YoutubeConnection{ApiKey: "api-key"}).Authenticate(context.Background()); err != nil {
+		t.Fatalf("Authenticate returned error: %v", err)
+	}
+
+	if _, err := (&YoutubeConnection{ApiKey: "wrong"}).Authenticate(context.Background()); err == nil {
+		t.Fatal("expected error for invalid api key")
+	}
+}
+
+func TestYoutubeGetChannelByHandle(t *testing.T) {
+	withYoutubeTestServer(t, fakeYoutubeAPI(t, false))
+	conn := &YoutubeConnection{ApiKey: "api-key"}
+
+	name := "testchannel"
+	channel, err := conn.GetChannel(context.Background(), &name, nil)
+	if err != nil {
+		t.Fatalf("GetChannel returned error: %v", err)
+	}
+
+	if channel.ID != testYoutubeChannelID {
+		t.Fatalf("expected channel id %s, got %s", testYoutubeChannelID, channel.ID)
+	}
+	if channel.Login != "testchannel" {
+		t.Fatalf("expected login testchannel, got %s", channel.Login)
+	}
+	if channel.DisplayName != "Test Channel" {
+		t.Fatalf("expected display name Test Channel, got %s", channel.DisplayName)
+	}
+	if channel.ProfileImageURL != "https://yt.test/avatar-high.jpg" {
+		t.Fatalf("expected highest resolution avatar, got %s", channel.ProfileImageURL)
+	}
+
+	missing := "missing"
+	if _, err := conn.GetChannel(context.Background(), &missing, nil); err == nil {
+		t.Fatal("expected error for missing channel")
+	}
+}
+
+func TestYoutubeGetVideo(t *testing.T) {
+	withYoutubeTestServer(t, fakeYoutubeAPI(t, false))
+	conn := &YoutubeConnection{ApiKey: "api-key"}
+
+	video, err := conn.GetVideo(context.Background(), "upload1", true, true)
+	if err != nil {
+		t.Fatalf("GetVideo returned error: %v", err)
+	}
+
+	if video.Type != string(VideoTypeUpload) {
+		t.Fatalf("expected upload type, got %s", video.Type)
+	}
+	if video.UserID != testYoutubeChannelID || video.UserLogin != "testchannel" {
+		t.Fatalf("expected channel handle as login, got %s %s", video.UserID, video.UserLogin)
+	}
+	if video.Duration != 12*time.Minute {
+		t.Fatalf("expected 12m duration, got %s", video.Duration)
+	}
+	if video.Category == nil || *video.Category != "Gaming" {
+		t.Fatalf("expected Gaming category, got %v", video.Category)
+	}
+	if video.ThumbnailURL != "https://yt.test/upload1-maxres.jpg" {
+		t.Fatalf("expected maxres thumbnail, got %s", video.ThumbnailURL)
+	}
+	if len(video.Chapters) != 3 {
+		t.Fatalf("expected 3 chapters, got %d", len(video.Chapters))
+	}
+	if video.Chapters[1].Title != "Main topic" || video.Chapters[1].Start != 90 || video.Chapters[1].End != 600 {
+		t.Fatalf("unexpected chapter: %+v", video.Chapters[1])
+	}
+	if video.Chapters[2].End != 720 {
+		t.Fatalf("expected last chapter to end at video duration, got %d", video.Chapters[2].End)
+	}
+
+	stream, err := conn.GetVideo(context.Background(), "stream1", true, false)
+	if err != nil {
+		t.Fatalf("GetVideo returned error: %v", err)
+	}
+	if stream.Type != string(VideoTypeArchive) || stream.StreamID != "stream1" {
+		t.Fatalf("expected archive with stream id, got type %s stream id %s", stream.Type, stream.StreamID)
+	}
+	if len(stream.Chapters) != 1 || stream.Chapters[0].Type != string(utils.ChapterTypeFallback) {
+		t.Fatalf("expected fallback chapter, got %+v", stream.Chapters)
+	}
+}
+
+func TestYoutubeGetVideos(t *testing.T) {
+	withYoutubeTestServer(t, fakeYoutubeAPI(t, true))
+	conn := &YoutubeConnection{ApiKey: "api-key"}
+
+	uploads, err := conn.GetVideos(context.Background(), testYoutubeChannelID, VideoTypeUpload, false, false)
+	if err != nil {
+		t.Fatalf("GetVideos returned error: %v", err)
+	}
+	if len(uploads) != 1 || uploads[0].ID != "upload1" {
+		t.Fatalf("expected only upload1, got %+v", uploads)
+	}
+
+	archives, err := conn.GetVideos(context.Background(), testYoutubeChannelID, VideoTypeArchive, false, false)
+	if err != nil {
+		t.Fatalf("GetVideos returned error: %v", err)
+	}
+	if len(archives) != 1 || archives[0].ID != "stream1" {
+		t.Fatalf("expected only stream1, got %+v", archives)
+	}
+
+	highlights, err := conn.GetVideos(context.Background(), testYoutubeChannelID, VideoTypeHighlight, false, false)
+	if err != nil {
+		t.Fatalf("GetVideos returned error: %v", err)
+	}
+	if len(highlights) != 0 {
+		t.Fatalf("expected no highlights, got %d", len(highlights))
+	}
+}
+
+func TestYoutubeGetLiveStream(t *testing.T) {
+	withYoutubeTestServer(t, fakeYoutubeAPI(t, true))
+	conn := &YoutubeConnection{ApiKey: "api-key"}
+
+	stream, err := conn.GetLiveStream(context.Background(), "testchannel")
+	if err != nil {
+		t.Fatalf("GetLiveStream returned error: %v", err)
+	}
+	if stream.ID != "live1" || stream.UserID != testYoutubeChannelID || stream.UserLogin != "testchannel" {
+		t.Fatalf("unexpected stream: %+v", stream)
+	}
+	if stream.ViewerCount != 123 || stream.GameName != "Gaming" {
+		t.Fatalf("unexpected stream details: %+v", stream)
+	}
+
+	streams, err := conn.GetLiveStreams(context.Background(), []string{testYoutubeChannelID})
+	if err != nil {
+		t.Fatalf("GetLiveStreams returned error: %v", err)
+	}
+	if len(streams) != 1 {
+		t.Fatalf("expected 1 stream, got %d", len(streams))
+	}
+
+	live, err := conn.CheckIfStreamIsLive(context.Background(), "testchannel")
+	if err != nil || !live {
+		t.Fatalf("expected channel to be live, got %v %v", live, err)
+	}
+
+	popular, err := conn.GetStreams(context.Background(), 1)
+	if err != nil {
+		t.Fatalf("GetStreams returned error: %v", err)
+	}
+	if len(popular) != 1 || popular[0].ID != "live1" {
+		t.Fatalf("unexpected streams: %+v", popular)
+	}
+}
+
+func TestYoutubeGetLiveStreamsBatchesRequests(t *testing.T) {
+	requests := map[string]int{}
+	handler := fakeYoutubeAPI(t, true)
+	withYoutubeTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		requests[strings.TrimPrefix(r.URL.Path, "/")]++
+		handler(w, r)
+	})
+	conn := &YoutubeConnection{ApiKey: "api-key"}
+
+	streams, err := conn.GetLiveStreams(context.Background(), []string{testYoutubeChannelID, "UCzzzzzzzzzzzzzzzzzzzzzz"})
+	if err != nil {
+		t.Fatalf("GetLiveStreams returned error: %v", err)
+	}
+	if len(streams) != 1 || streams[0].ID != "live1" || streams[0].UserLogin != "testchannel" {
+		t.Fatalf("unexpected streams: %+v", streams)
+	}
+
+	// the channels and videos are fetched in one request each, the uploads of each found channel separately
+	want := map[string]int{"channels": 1, "playlistItems": 1, "videos": 1, "videoCategories": 1}
+	for path, count := range want {
+		if requests[path] != count {
+			t.Fatalf("expected %d %s requests, got %d (%v)", count, path, requests[path], requests)
+		}
+	}
+	if len(requests) != len(want) {
+		t.Fatalf("unexpected requests: %v", requests)
+	}
+}
+
+func TestYoutubeGetLiveStreamOffline(t *testing.T) {
+	withYoutubeTestServer(t, fakeYoutubeAPI(t, false))
+	conn := &YoutubeConnection{ApiKey: "api-key"}
+
+	_, err := conn.GetLiveStream(context.Background(), "testchannel")
+	var e ErrorNoStreamsFound
+	if !errors.As(err, &e) {
+		t.Fatalf("expected ErrorNoStreamsFound, got %v", err)
+	}
+
+	live, err := conn.CheckIfStreamIsLive(context.Background(), "testchannel")
+	if err != nil || live {
+		t.Fatalf("expected channel to be offline, got %v %v", live, err)
+	}
+}
+
+func TestParseYoutubeDuration(t *testing.T) {
+	tests := map[string]time.Duration{
+		"PT15S":     15 * time.Second,
+		"PT1H2M3S":  time.Hour + 2*time.Minute + 3*time.Second,
+		"P1DT1H":    25 * time.Hour,
+		"P0D":       0,
+		"PT10M1.5S": 10*time.Minute + 1500*time.Millisecond,
+	}
+	for input, want := range tests {
+		got, err := parseYoutubeDuration(input)
+		if err != nil {
+			t.Fatalf("parseYoutubeDuration(%q) returned error: %v", input, err)
+		}
+		if got != want {
+			t.Fatalf("parseYoutubeDuration(%q) = %s, want %s", input, got, want)
+		}
+	}
+
+	if _, err := parseYoutubeDuration("1h"); err == nil {
+		t.Fatal("expected error for invalid duration")
+	}
+}
+
+func TestParseYoutubeDescriptionChapters(t *testing.T) {
+	// the first timestamp must start at 0:00
+	if chapters := parseYoutubeDescriptionChapters("0:10 a\n1:00 b\n2:00 c", 300); chapters != nil {
+		t.Fatalf("expected no chapters, got %+v", chapters)
+	}
+	// at least three timestamps are required
+	if chapters := parseYoutubeDescriptionChapters("0:00 a\n1:00 b", 300); chapters != nil {
+		t.Fatalf("expected no chapters, got %+v", chapters)
+	}
+
+	chapters := parseYoutubeDescriptionChapters("0:00 a\n- 1:00:00 | b\n[1:30:00] c", 7200)
+	if len(chapters) != 3 {
+		t.Fatalf("expected 3 chapters, got %d", len(chapters))
+	}
+	if chapters[1].Start != 3600 || chapters[1].Title != "b" || chapters[2].End != 7200 {
+		t.Fatalf("unexpected chapters: %+v", chapters)
+	}
+}
@@ -11,15 +11,18 @@ import (
 	"github.com/zibbp/ganymede/ent/playback"
 	entVod "github.com/zibbp/ganymede/ent/vod"
 	"github.com/zibbp/ganymede/internal/database"
+	"github.com/zibbp/ganymede/internal/tasks"
+	tasks_client "github.com/zibbp/ganymede/internal/tasks/client"
 	"github.com/zibbp/ganymede/internal/utils"
 )
 
 type Service struct {
-	Store *database.Database
+	Store       *database.Database
+	RiverClient *tasks_client.RiverClient
 }
 
-func NewService(store *database.Database) *Service {
-	return &Service{Store: store}
+func NewService(store *database.Database, riverClient *tasks_client.RiverClient) *Service {
+	return &Service{Store: store, RiverClient: riverClient}
 }
 
 type GetPlaybackResp struct {
@@ -171,5 +174,13 @@ func (s *Service) StartPlayback(c echo.Context, videoId uuid.UUID) error {
 		return fmt.Errorf("error adding view to video: %v", err)
 	}
 
+	// move the video back from cold storage so it is fast to play next time
+	if video.StorageTier == utils.StorageTierCold {
+		_, err = s.RiverClient.Insert(c.Request().Context(), tasks.MoveVideoStorageTierArgs{VideoID: &video.ID, Tier: utils.StorageTierHot}, nil)
+		if err != nil {
+			return fmt.Errorf("error enqueuing move from cold storage: %v", err)
+		}
+	}
+
 	return nil
 }
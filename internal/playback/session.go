@@ -0,0 +1,109 @@
+package playback
+
+import (
+	"context"
+	"fmt"
+	"time"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+	"github.com/zibbp/ganymede/ent"
+	"github.com/zibbp/ganymede/ent/viewingsession"
+	entVod "github.com/zibbp/ganymede/ent/vod"
+	"github.com/zibbp/ganymede/internal/utils"
+)
+
+const (
+	// maxViewingSessionDevice is the maximum length of the device of a viewing session.
+	maxViewingSessionDevice = 255
+	// maxViewingSessionRanges is the maximum number of ranges added to a viewing session at once.
+	maxViewingSessionRanges = 100
+	// maxViewingSessionPlaybackRate is the seconds of the video the player counts as played per second.
+	maxViewingSessionPlaybackRate = 3
+	// viewingSessionSlack is added to the time bounds of the ranges of a viewing session for requests in flight.
+	viewingSessionSlack = time.Minute
+)
+
+var ErrorViewingSessionNotFound = fmt.Errorf("viewing session not found")
+var ErrorInvalidViewingSessionRange = fmt.Errorf("invalid viewing session range")
+
+// StartViewingSession starts logging a viewing session of the video for the user.
+func (s *Service) StartViewingSession(ctx context.Context, userId uuid.UUID, videoId uuid.UUID, device string) (*ent.ViewingSession, error) {
+	exists, err := s.Store.Client.Vod.Query().Where(entVod.ID(videoId)).Exist(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("error getting video: %v", err)
+	}
+	if !exists {
+		return nil, fmt.Errorf("vod not found")
+	}
+
+	session, err := s.Store.Client.ViewingSession.Create().SetUserID(userId).SetVodID(videoId).SetDevice(truncateViewingSessionDevice(device)).Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("error creating viewing session: %v", err)
+	}
+	return session, nil
+}
+
+// truncateViewingSessionDevice truncates the device to the maximum length without cutting a character in half.
+func truncateViewingSessionDevice(device string) string {
+	if len(device) <= maxViewingSessionDevice {
+		return device
+	}
+	end := maxViewingSessionDevice
+	for end > 0 && !utf8.RuneStart(device[end]) {
+		end--
+	}
+	return device[:end]
+}
+
+// AddViewingSessionRanges adds ranges the user played to their viewing session. The session ends when the last range was played.
+func (s *Service) AddViewingSessionRanges(ctx context.Context, userId uuid.UUID, sessionId uuid.UUID, ranges []utils.WatchedRange) (*ent.ViewingSession, error) {
+	session, err := s.Store.Client.ViewingSession.Query().Where(viewingsession.ID(sessionId), viewingsession.UserID(userId)).WithVod().Only(ctx)
+	if err != nil {
+		if _, ok := err.(*ent.NotFoundError); ok {
+			return nil, ErrorViewingSessionNotFound
+		}
+		return nil, fmt.Errorf("error getting viewing session: %v", err)
+	}
+
+	if err := validateViewingSessionRanges(session, session.Edges.Vod, ranges, time.Now()); err != nil {
+		return nil, err
+	}
+
+	session, err = s.Store.Client.ViewingSession.UpdateOne(session).
+		AppendRanges(ranges).
+		AddWatchedSeconds(utils.WatchedSeconds(ranges)).
+		SetEndedAt(time.Now()).
+		Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("error updating viewing session: %v", err)
+	}
+	return session, nil
+}
+
+// validateViewingSessionRanges returns an error if the ranges can't have been played in the viewing session of
+// the video by now: ranges outside of the video or more seconds than could have been played since the session
+// started.
+func validateViewingSessionRanges(session *ent.ViewingSession, video *ent.Vod, ranges []utils.WatchedRange, now time.Time) error {
+	if len(ranges) > maxViewingSessionRanges {
+		return fmt.Errorf("%w: at most %d ranges can be added at once", ErrorInvalidViewingSessionRange, maxViewingSessionRanges)
+	}
+
+	duration := float64(video.Duration)
+	if video.Processing {
+		// the duration of videos that are still being archived isn't known, but they can't be longer than
+		// the time since archiving started
+		duration = now.Sub(video.CreatedAt).Seconds() + viewingSessionSlack.Seconds()
+	}
+	for _, r := range ranges {
+		if err := r.Validate(duration); err != nil {
+			return fmt.Errorf("%w: %v", ErrorInvalidViewingSessionRange, err)
+		}
+	}
+
+	playable := now.Sub(session.StartedAt).Seconds()*maxViewingSessionPlaybackRate + viewingSessionSlack.Seconds()
+	if watched := session.WatchedSeconds + utils.WatchedSeconds(ranges); watched > playable {
+		return fmt.Errorf("%w: %.0f seconds can't have been played since the session started", ErrorInvalidViewingSessionRange, watched)
+	}
+	return nil
+}
@@ -0,0 +1,50 @@
+package playback
+
+import (
+	"strings"
+	"testing"
+	"time"
+	"unicode/utf8"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/zibbp/ganymede/ent"
+	"github.com/zibbp/ganymede/internal/utils"
+)
+
+func TestTruncateViewingSessionDevice(t *testing.T) {
+	assert.Equal(t, "Firefox", truncateViewingSessionDevice("Firefox"))
+
+	// the limit falls in the middle of a three byte character
+	device := strings.Repeat("a", maxViewingSessionDevice-1) + "€€"
+	truncated := truncateViewingSessionDevice(device)
+	assert.True(t, utf8.ValidString(truncated))
+	assert.Equal(t, strings.Repeat("a", maxViewingSessionDevice-1), truncated)
+
+	truncated = truncateViewingSessionDevice(strings.Repeat("€", maxViewingSessionDevice))
+	assert.True(t, utf8.ValidString(truncated))
+	assert.LessOrEqual(t, len(truncated), maxViewingSessionDevice)
+}
+
+func TestValidateViewingSessionRanges(t *testing.T) {
+	now := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	session := &ent.ViewingSession{StartedAt: now.Add(-10 * time.Minute), WatchedSeconds: 300}
+	video := &ent.Vod{Duration: 3600, CreatedAt: now.Add(-48 * time.Hour)}
+
+	assert.NoError(t, validateViewingSessionRanges(session, video, []utils.WatchedRange{{Start: 0, End: 600}}, now))
+	assert.ErrorIs(t, validateViewingSessionRanges(session, video, []utils.WatchedRange{{Start: 0, End: 3601}}, now), ErrorInvalidViewingSessionRange)
+
+	// more than could have been played since the session started
+	assert.ErrorIs(t, validateViewingSessionRanges(session, video, []utils.WatchedRange{{Start: 0, End: 3600}}, now), ErrorInvalidViewingSessionRange)
+
+	tooMany := make([]utils.WatchedRange, maxViewingSessionRanges+1)
+	for i := range tooMany {
+		tooMany[i] = utils.WatchedRange{Start: float64(i), End: float64(i) + 1}
+	}
+	assert.ErrorIs(t, validateViewingSessionRanges(session, video, tooMany, now), ErrorInvalidViewingSessionRange)
+
+	// videos still being archived are bounded by the time since archiving started
+	live := &ent.Vod{Processing: true, CreatedAt: now.Add(-time.Hour)}
+	session = &ent.ViewingSession{StartedAt: now.Add(-24 * time.Hour)}
+	assert.NoError(t, validateViewingSessionRanges(session, live, []utils.WatchedRange{{Start: 3000, End: 3500}}, now))
+	assert.ErrorIs(t, validateViewingSessionRanges(session, live, []utils.WatchedRange{{Start: 0, End: 1e12}}, now), ErrorInvalidViewingSessionRange)
+}
@@ -0,0 +1,141 @@
+// Package reencode re-encodes archived videos with a transcoding profile to shrink existing archives.
+//
+// The video is re-encoded to a temporary file which only replaces the archived video once its duration
+// matches the duration of the original, so a failed or truncated re-encode never loses the original.
+package reencode
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/zibbp/ganymede/ent"
+	entChannel "github.com/zibbp/ganymede/ent/channel"
+	entQueue "github.com/zibbp/ganymede/ent/queue"
+	entVod "github.com/zibbp/ganymede/ent/vod"
+	"github.com/zibbp/ganymede/internal/exec"
+	"github.com/zibbp/ganymede/internal/storage"
+)
+
+// durationTolerance is how many seconds the duration of a re-encoded video may differ from the original
+// before the relative tolerance applies.
+const durationTolerance = 1.0
+
+// relativeDurationTolerance is the fraction of the original duration a long re-encoded video may differ by.
+const relativeDurationTolerance = 0.001
+
+// Filter selects the archived videos to re-encode. Empty fields match every video.
+type Filter struct {
+	ChannelID     *uuid.UUID `json:"channel_id,omitempty"`
+	OlderThanDays int        `json:"older_than_days,omitempty"` // streamed at least this many days ago
+	Resolution    string     `json:"resolution,omitempty"`      // probed resolution like "1080p"
+	Codec         string     `json:"codec,omitempty"`           // probed video codec like "h264"
+}
+
+// Result is the outcome of re-encoding a video.
+type Result struct {
+	Resolution       string  `json:"resolution"`
+	OriginalDuration float64 `json:"original_duration"`
+	Duration         float64 `json:"duration"`
+}
+
+// Candidates returns the archived videos matching the channel and age of the filter. HLS videos, videos that
+// are processing and videos with an archive in the queue are skipped. The resolution and codec are probed
+// separately with Matches.
+func Candidates(ctx context.Context, client *ent.Client, filter Filter, now time.Time) ([]*ent.Vod, error) {
+	query := client.Vod.Query().
+		Where(
+			entVod.Processing(false),
+			entVod.VideoPathNEQ(""),
+			entVod.Or(entVod.VideoHlsPathIsNil(), entVod.VideoHlsPathEQ("")),
+			entVod.Not(entVod.HasQueueWith(entQueue.Processing(true))),
+		).
+		WithQueue().
+		Order(ent.Asc(entVod.FieldStreamedAt))
+	if filter.ChannelID != nil {
+		query = query.Where(entVod.HasChannelWith(entChannel.ID(*filter.ChannelID)))
+	}
+	if filter.OlderThanDays > 0 {
+		query = query.Where(entVod.StreamedAtLT(now.AddDate(0, 0, -filter.OlderThanDays)))
+	}
+	return query.All(ctx)
+}
+
+// Matches reports whether the probed video matches the resolution and codec of the filter.
+func Matches(filter Filter, probe *exec.FFprobeJsonData) bool {
+	if filter.Resolution != "" && !strings.EqualFold(filter.Resolution, probe.Resolution()) {
+		return false
+	}
+	if filter.Codec != "" {
+		stream := probe.VideoStream()
+		if stream == nil || !strings.EqualFold(filter.Codec, stream.CodecName) {
+			return false
+		}
+	}
+	return true
+}
+
+// DurationMatches reports whether the duration of the re-encoded video is close enough to the original.
+func DurationMatches(original float64, reencoded float64) bool {
+	tolerance := math.Max(durationTolerance, original*relativeDurationTolerance)
+	return math.Abs(original-reencoded) <= tolerance
+}
+
+// Reencode re-encodes the video with the FFmpeg arguments in tempDir and replaces the archived video with it
+// once its duration is verified. The original is kept if anything fails.
+func Reencode(ctx context.Context, s storage.Storage, video *ent.Vod, args string, tempDir string) (*Result, error) {
+	videoURL, err := s.URL(ctx, video.VideoPath)
+	if err != nil {
+		return nil, err
+	}
+	original, err := exec.ProbeMediaDuration(ctx, videoURL)
+	if err != nil {
+		return nil, fmt.Errorf("error probing video: %w", err)
+	}
+
+	output := filepath.Join(tempDir, fmt.Sprintf("%s-reencode.mp4", video.ID))
+	defer os.Remove(output)
+	if err := exec.ReencodeVideo(ctx, *video, videoURL, output, args); err != nil {
+		return nil, err
+	}
+
+	reencoded, err := exec.ProbeMediaDuration(ctx, output)
+	if err != nil {
+		return nil, fmt.Errorf("error probing re-encoded video: %w", err)
+	}
+	if !DurationMatches(original.Duration, reencoded.Duration) {
+		return nil, fmt.Errorf("re-encoded video is %.1fs long but the original is %.1fs; keeping the original", reencoded.Duration, original.Duration)
+	}
+	probe, err := exec.GetFfprobeVideoData(ctx, output)
+	if err != nil {
+		return nil, fmt.Errorf("error probing re-encoded video: %w", err)
+	}
+
+	if err := replace(ctx, s, output, video.VideoPath); err != nil {
+		return nil, fmt.Errorf("error replacing video: %w", err)
+	}
+	return &Result{Resolution: probe.Resolution(), OriginalDuration: original.Duration, Duration: reencoded.Duration}, nil
+}
+
+// replace replaces the file at path with the local file src. On the local disk src is moved next to path first
+// so the final rename is atomic even if the temp directory is on another disk. S3 replaces objects atomically.
+func replace(ctx context.Context, s storage.Storage, src string, path string) error {
+	if s.Driver() != storage.DriverLocal {
+		return s.Save(ctx, src, path)
+	}
+	staged := path + ".reencode"
+	if err := s.Save(ctx, src, staged); err != nil {
+		_ = os.Remove(staged)
+		return err
+	}
+	if err := os.Rename(staged, path); err != nil {
+		_ = os.Remove(staged)
+		return err
+	}
+	return nil
+}
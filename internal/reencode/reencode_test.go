@@ -0,0 +1,39 @@
+package reencode
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/zibbp/ganymede/internal/exec"
+)
+
+func TestMatches(t *testing.T) {
+	t.Parallel()
+
+	height := int64(1080)
+	probe := &exec.FFprobeJsonData{Streams: []exec.FFprobestream{
+		{CodecType: "audio", CodecName: "aac"},
+		{CodecType: "video", CodecName: "h264", Height: &height},
+	}}
+
+	require.True(t, Matches(Filter{}, probe))
+	require.True(t, Matches(Filter{Resolution: "1080p", Codec: "H264"}, probe))
+	require.False(t, Matches(Filter{Resolution: "720p"}, probe))
+	require.False(t, Matches(Filter{Codec: "hevc"}, probe))
+	// the audio codec is not compared
+	require.False(t, Matches(Filter{Codec: "aac"}, probe))
+
+	audio := &exec.FFprobeJsonData{Streams: []exec.FFprobestream{{CodecType: "audio", CodecName: "aac"}}}
+	require.True(t, Matches(Filter{Resolution: "audio"}, audio))
+	require.False(t, Matches(Filter{Codec: "h264"}, audio))
+}
+
+func TestDurationMatches(t *testing.T) {
+	t.Parallel()
+
+	require.True(t, DurationMatches(60, 60.8))
+	require.False(t, DurationMatches(60, 58.5))
+	// long videos may drift by a fraction of their duration
+	require.True(t, DurationMatches(36000, 35990))
+	require.False(t, DurationMatches(36000, 35900))
+}
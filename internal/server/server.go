@@ -20,6 +20,7 @@ import (
 	"github.com/zibbp/ganymede/internal/chapter"
 	"github.com/zibbp/ganymede/internal/config"
 	"github.com/zibbp/ganymede/internal/database"
+	"github.com/zibbp/ganymede/internal/importer"
 	_ "github.com/zibbp/ganymede/internal/kv"
 	"github.com/zibbp/ganymede/internal/live"
 	"github.com/zibbp/ganymede/internal/metrics"
@@ -28,11 +29,15 @@ import (
 	"github.com/zibbp/ganymede/internal/playback"
 	"github.com/zibbp/ganymede/internal/playlist"
 	"github.com/zibbp/ganymede/internal/queue"
+	"github.com/zibbp/ganymede/internal/storage"
 	"github.com/zibbp/ganymede/internal/task"
 	tasks_client "github.com/zibbp/ganymede/internal/tasks/client"
+	"github.com/zibbp/ganymede/internal/transcoding"
 	transportHttp "github.com/zibbp/ganymede/internal/transport/http"
 	"github.com/zibbp/ganymede/internal/user"
+	"github.com/zibbp/ganymede/internal/userlist"
 	"github.com/zibbp/ganymede/internal/vod"
+	"github.com/zibbp/ganymede/internal/watchparty"
 	"riverqueue.com/riverui"
 )
 
@@ -41,6 +46,7 @@ type Application struct {
 	Database            *database.Database
 	Store               *database.Database
 	ArchiveService      *archive.Service
+	ImportService       *importer.Service
 	PlatformTwitch      platform.Platform
 	AdminService        *admin.Service
 	AuthService         *auth.Service
@@ -57,6 +63,9 @@ type Application struct {
 	CategoryService     *category.Service
 	BlockedVodService   *blocked.Service
 	NotificationService *notification.Service
+	TranscodingService  *transcoding.Service
+	WatchPartyService   *watchparty.Service
+	UserListService     *userlist.Service
 	ApiKeyService       *api_key.Service
 	RiverUIServer       *riverui.Handler
 	RiverClient         *tasks_client.RiverClient
@@ -83,6 +92,10 @@ func SetupApplication(ctx context.Context) (*Application, error) {
 		zerolog.SetGlobalLevel(zerolog.Disabled)
 	}
 
+	if _, err := storage.Init(); err != nil {
+		return nil, fmt.Errorf("error initializing archive storage: %w", err)
+	}
+
 	dbString := fmt.Sprintf("user=%s password=%s host=%s port=%s dbname=%s sslmode=%s sslrootcert=%s", envAppConfig.DB_USER, envAppConfig.DB_PASS, envAppConfig.DB_HOST, envAppConfig.DB_PORT, envAppConfig.DB_NAME, envAppConfig.DB_SSL, envAppConfig.DB_SSL_ROOT_CERT)
 
 	db := database.NewDatabase(ctx, database.DatabaseConnectionInput{
@@ -137,8 +150,27 @@ func SetupApplication(ctx context.Context) (*Application, error) {
 		}
 	}
 
+	var platformYoutube platform.Platform
+	// setup youtube platform
+	if envConfig.YoutubeApiKey != "" {
+		platformYoutube = &platform.YoutubeConnection{
+			ApiKey: envConfig.YoutubeApiKey,
+		}
+		_, err = platformYoutube.Authenticate(ctx)
+		if err != nil {
+			log.Panic().Err(err).Msg("Error authenticating to YouTube")
+		}
+	}
+
+	// setup kick platform, the public kick api does not require credentials
+	platformKick := &platform.KickConnection{}
+	_, err = platformKick.Authenticate(ctx)
+	if err != nil {
+		log.Panic().Err(err).Msg("Error authenticating to Kick")
+	}
+
 	authService := auth.NewService(db, &envConfig)
-	channelService := channel.NewService(db, platformTwitch)
+	channelService := channel.NewService(db, platformTwitch, platformYoutube, platformKick)
 	vodService := vod.NewService(db, riverClient, platformTwitch)
 	queueService := queue.NewService(db, vodService, channelService, riverClient)
 	blockedVodService := blocked.NewService(db)
@@ -151,19 +183,26 @@ func SetupApplication(ctx context.Context) (*Application, error) {
 		}
 	}
 
-	archiveService := archive.NewService(db, channelService, vodService, queueService, blockedVodService, riverClient, platformTwitch)
+	archiveService := archive.NewService(db, channelService, vodService, queueService, blockedVodService, riverClient, platformTwitch, platformYoutube, platformKick)
+	importService := importer.NewService(db, archiveService, vodService, riverClient)
 	adminService := admin.NewService(db)
 	userService := user.NewService(db)
 	chapterService := chapter.NewService(db)
-	liveService := live.NewService(db, archiveService, platformTwitch, chapterService, queueService, notificationService)
+	liveService := live.NewService(db, archiveService, platformTwitch, platformYoutube, platformKick, chapterService, queueService, notificationService)
 	if err := liveService.ResetLiveStatus(ctx); err != nil {
 		return nil, err
 	}
-	playbackService := playback.NewService(db)
+	playbackService := playback.NewService(db, riverClient)
 	metricsService := metrics.NewService(db, riverClient)
 	playlistService := playlist.NewService(db)
 	taskService := task.NewService(db, liveService, riverClient)
 	categoryService := category.NewService(db)
+	transcodingService := transcoding.NewService(db)
+	if err := transcodingService.EnsureDefaultProfiles(ctx); err != nil {
+		log.Error().Err(err).Msg("error creating default transcoding profiles")
+	}
+	watchPartyService := watchparty.NewService()
+	userListService := userlist.NewService(db)
 	apiKeyService := api_key.NewService(db)
 	if _, err := apiKeyService.EnsureSystemUser(ctx); err != nil {
 		return nil, fmt.Errorf("error ensuring api key system user: %v", err)
@@ -178,6 +217,7 @@ func SetupApplication(ctx context.Context) (*Application, error) {
 		QueueService:        queueService,
 		BlockedVodService:   blockedVodService,
 		ArchiveService:      archiveService,
+		ImportService:       importService,
 		AdminService:        adminService,
 		UserService:         userService,
 		LiveService:         liveService,
@@ -188,6 +228,9 @@ func SetupApplication(ctx context.Context) (*Application, error) {
 		ChapterService:      chapterService,
 		CategoryService:     categoryService,
 		NotificationService: notificationService,
+		TranscodingService:  transcodingService,
+		WatchPartyService:   watchPartyService,
+		UserListService:     userListService,
 		ApiKeyService:       apiKeyService,
 		PlatformTwitch:      platformTwitch,
 		RiverUIServer:       riverUIServer,
@@ -207,7 +250,7 @@ func Run(ctx context.Context) error {
 		}
 	}()
 
-	httpHandler := transportHttp.NewHandler(app.Database, app.AuthService, app.ChannelService, app.VodService, app.QueueService, app.ArchiveService, app.AdminService, app.UserService, app.LiveService, app.PlaybackService, app.MetricsService, app.PlaylistService, app.TaskService, app.ChapterService, app.CategoryService, app.BlockedVodService, app.NotificationService, app.ApiKeyService, app.PlatformTwitch, app.RiverUIServer)
+	httpHandler := transportHttp.NewHandler(app.Database, app.AuthService, app.ChannelService, app.VodService, app.QueueService, app.ArchiveService, app.ImportService, app.AdminService, app.UserService, app.LiveService, app.PlaybackService, app.MetricsService, app.PlaylistService, app.TaskService, app.ChapterService, app.CategoryService, app.BlockedVodService, app.NotificationService, app.TranscodingService, app.WatchPartyService, app.UserListService, app.ApiKeyService, app.PlatformTwitch, app.RiverUIServer)
 
 	if err := httpHandler.Serve(ctx); err != nil {
 		return err
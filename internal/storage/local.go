@@ -0,0 +1,53 @@
+package storage
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+
+	"github.com/zibbp/ganymede/internal/utils"
+)
+
+// Local stores archives in the videos directory on the local disk.
+type Local struct{}
+
+func NewLocal() *Local { return &Local{} }
+
+func (l *Local) Driver() Driver { return DriverLocal }
+
+func (l *Local) Save(ctx context.Context, src string, path string) error {
+	if err := os.MkdirAll(filepath.Dir(path), os.ModePerm); err != nil {
+		return fmt.Errorf("error creating directory: %w", err)
+	}
+	return utils.MoveFile(ctx, src, path)
+}
+
+func (l *Local) SaveDirectory(ctx context.Context, src string, path string) error {
+	return utils.MoveDirectory(ctx, src, path)
+}
+
+func (l *Local) Open(ctx context.Context, path string) (io.ReadCloser, error) {
+	return os.Open(path)
+}
+
+func (l *Local) Exists(ctx context.Context, path string) (bool, error) {
+	_, err := os.Stat(path)
+	if os.IsNotExist(err) {
+		return false, nil
+	}
+	return err == nil, err
+}
+
+func (l *Local) Delete(ctx context.Context, path string) error {
+	return utils.DeleteFile(path)
+}
+
+func (l *Local) DeleteDirectory(ctx context.Context, path string) error {
+	return utils.DeleteDirectory(path)
+}
+
+func (l *Local) URL(ctx context.Context, path string) (string, error) {
+	return path, nil
+}
@@ -0,0 +1,232 @@
+package storage
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/minio/minio-go/v7"
+	"github.com/minio/minio-go/v7/pkg/credentials"
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	s3DefaultPartSize  = 64 * 1024 * 1024
+	s3MaxPresignExpiry = 7 * 24 * time.Hour
+)
+
+type S3Config struct {
+	Endpoint        string        // e.g. https://s3.us-east-1.amazonaws.com or http://minio:9000, AWS if empty
+	Region          string        // signing region, us-east-1 if empty
+	Bucket          string        // bucket archives are stored in
+	AccessKeyID     string        // access key
+	SecretAccessKey string        // secret key
+	UsePathStyle    bool          // address the bucket as endpoint/bucket instead of bucket.endpoint, required by most self-hosted servers
+	PresignExpiry   time.Duration // how long presigned URLs are valid, 6 hours if 0
+	VideosDir       string        // archive paths are stored in the bucket relative to this directory
+	PartSize        int64         // files larger than this are uploaded in parts, 64 MiB if 0
+}
+
+// S3 stores archives in an S3-compatible bucket using the MinIO client.
+type S3 struct {
+	config S3Config
+	client *minio.Client
+}
+
+func NewS3(c S3Config) (*S3, error) {
+	if c.Bucket == "" {
+		return nil, fmt.Errorf("S3 bucket is required")
+	}
+	if c.AccessKeyID == "" || c.SecretAccessKey == "" {
+		return nil, fmt.Errorf("S3 access key id and secret access key are required")
+	}
+	if c.Region == "" {
+		c.Region = "us-east-1"
+	}
+	if c.Endpoint == "" {
+		c.Endpoint = fmt.Sprintf("https://s3.%s.amazonaws.com", c.Region)
+	}
+	if c.PresignExpiry <= 0 {
+		c.PresignExpiry = 6 * time.Hour
+	}
+	if c.PresignExpiry > s3MaxPresignExpiry {
+		c.PresignExpiry = s3MaxPresignExpiry
+	}
+	if c.PartSize <= 0 {
+		c.PartSize = s3DefaultPartSize
+	}
+	endpoint, err := url.Parse(strings.TrimSuffix(c.Endpoint, "/"))
+	if err != nil || endpoint.Scheme == "" || endpoint.Host == "" {
+		return nil, fmt.Errorf("invalid S3 endpoint %q", c.Endpoint)
+	}
+	if endpoint.Path != "" {
+		return nil, fmt.Errorf("invalid S3 endpoint %q: endpoints with a path are not supported", c.Endpoint)
+	}
+
+	lookup := minio.BucketLookupDNS
+	if c.UsePathStyle {
+		lookup = minio.BucketLookupPath
+	}
+	client, err := minio.New(endpoint.Host, &minio.Options{
+		Creds:        credentials.NewStaticV4(c.AccessKeyID, c.SecretAccessKey, ""),
+		Secure:       endpoint.Scheme == "https",
+		Region:       c.Region,
+		BucketLookup: lookup,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error creating S3 client: %w", err)
+	}
+	return &S3{config: c, client: client}, nil
+}
+
+func (s *S3) Driver() Driver { return DriverS3 }
+
+func (s *S3) Save(ctx context.Context, src string, path string) error {
+	key, err := objectKey(s.config.VideosDir, path)
+	if err != nil {
+		return err
+	}
+	if err := s.putFile(ctx, src, key); err != nil {
+		return err
+	}
+	return os.Remove(src)
+}
+
+func (s *S3) SaveDirectory(ctx context.Context, src string, path string) error {
+	prefix, err := objectKey(s.config.VideosDir, path)
+	if err != nil {
+		return err
+	}
+	err = filepath.WalkDir(src, func(file string, d os.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() {
+			return nil
+		}
+		rel, err := filepath.Rel(src, file)
+		if err != nil {
+			return err
+		}
+		return s.putFile(ctx, file, prefix+"/"+filepath.ToSlash(rel))
+	})
+	if err != nil {
+		return err
+	}
+	return os.RemoveAll(src)
+}
+
+func (s *S3) Open(ctx context.Context, path string) (io.ReadCloser, error) {
+	key, err := objectKey(s.config.VideosDir, path)
+	if err != nil {
+		return nil, err
+	}
+	object, err := s.client.GetObject(ctx, s.config.Bucket, key, minio.GetObjectOptions{})
+	if err != nil {
+		return nil, err
+	}
+	// the object is fetched lazily, stat it so a missing object is reported here instead of on the first read
+	if _, err := object.Stat(); err != nil {
+		object.Close()
+		return nil, err
+	}
+	return object, nil
+}
+
+func (s *S3) Exists(ctx context.Context, path string) (bool, error) {
+	key, err := objectKey(s.config.VideosDir, path)
+	if err != nil {
+		return false, err
+	}
+	_, err = s.client.StatObject(ctx, s.config.Bucket, key, minio.StatObjectOptions{})
+	if err != nil {
+		if minio.ToErrorResponse(err).StatusCode == http.StatusNotFound {
+			return false, nil
+		}
+		return false, err
+	}
+	return true, nil
+}
+
+func (s *S3) Delete(ctx context.Context, path string) error {
+	key, err := objectKey(s.config.VideosDir, path)
+	if err != nil {
+		return err
+	}
+	if err := s.client.RemoveObject(ctx, s.config.Bucket, key, minio.RemoveObjectOptions{}); err != nil {
+		return fmt.Errorf("error deleting %s: %w", key, err)
+	}
+	return nil
+}
+
+func (s *S3) DeleteDirectory(ctx context.Context, path string) error {
+	prefix, err := objectKey(s.config.VideosDir, path)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	objects := s.client.ListObjects(ctx, s.config.Bucket, minio.ListObjectsOptions{Prefix: prefix + "/", Recursive: true})
+	listErr := make(chan error, 1)
+	keys := make(chan minio.ObjectInfo)
+	go func() {
+		defer close(keys)
+		for object := range objects {
+			if object.Err != nil {
+				listErr <- object.Err
+				return
+			}
+			select {
+			case keys <- object:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	for result := range s.client.RemoveObjects(ctx, s.config.Bucket, keys, minio.RemoveObjectsOptions{}) {
+		if result.Err != nil {
+			return fmt.Errorf("error deleting %s: %w", result.ObjectName, result.Err)
+		}
+	}
+	select {
+	case err := <-listErr:
+		return fmt.Errorf("error listing %s: %w", prefix, err)
+	default:
+		return nil
+	}
+}
+
+func (s *S3) URL(ctx context.Context, path string) (string, error) {
+	key, err := objectKey(s.config.VideosDir, path)
+	if err != nil {
+		return "", err
+	}
+	u, err := s.client.PresignedGetObject(ctx, s.config.Bucket, key, s.config.PresignExpiry, nil)
+	if err != nil {
+		return "", fmt.Errorf("error presigning %s: %w", key, err)
+	}
+	return u.String(), nil
+}
+
+// putFile uploads a local file, files larger than the part size are uploaded in parts.
+func (s *S3) putFile(ctx context.Context, src string, key string) error {
+	log.Debug().Str("src", src).Str("bucket", s.config.Bucket).Str("key", key).Msg("uploading file to S3")
+
+	// the payload is not signed so files are streamed without hashing them first
+	_, err := s.client.FPutObject(ctx, s.config.Bucket, key, src, minio.PutObjectOptions{
+		PartSize:             uint64(s.config.PartSize),
+		DisableContentSha256: true,
+	})
+	if err != nil {
+		return fmt.Errorf("error uploading %s: %w", key, err)
+	}
+	return nil
+}
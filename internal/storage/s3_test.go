@@ -0,0 +1,249 @@
+package storage
+
+import (
+	"context"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/minio/minio-go/v7"
+	"github.com/stretchr/testify/require"
+	tests_files "github.com/zibbp/ganymede/tests/files"
+)
+
+// fakeS3 is an in-memory S3-compatible server supporting the path-style requests used by the S3 driver.
+type fakeS3 struct {
+	mu      sync.Mutex
+	bucket  string
+	objects map[string][]byte
+	uploads map[string]map[int][]byte
+	parts   int // parts of completed multipart uploads
+}
+
+func newFakeS3(t *testing.T, bucket string) (*fakeS3, *httptest.Server) {
+	fake := &fakeS3{bucket: bucket, objects: map[string][]byte{}, uploads: map[string]map[int][]byte{}}
+	server := httptest.NewServer(fake)
+	t.Cleanup(server.Close)
+	return fake, server
+}
+
+func (f *fakeS3) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if !strings.HasPrefix(r.Header.Get("Authorization"), "AWS4-HMAC-SHA256 Credential=") && r.URL.Query().Get("X-Amz-Signature") == "" {
+		w.WriteHeader(http.StatusForbidden)
+		return
+	}
+	if r.Header.Get("Transfer-Encoding") == "chunked" || len(r.TransferEncoding) > 0 {
+		w.WriteHeader(http.StatusNotImplemented)
+		return
+	}
+
+	path := strings.TrimPrefix(r.URL.Path, "/"+f.bucket)
+	key := strings.TrimPrefix(path, "/")
+	query := r.URL.Query()
+
+	switch {
+	case r.Method == http.MethodGet && key == "" && query.Get("list-type") == "2":
+		keys := []string{}
+		for k := range f.objects {
+			if strings.HasPrefix(k, query.Get("prefix")) {
+				keys = append(keys, k)
+			}
+		}
+		sort.Strings(keys)
+		fmt.Fprint(w, "<ListBucketResult>")
+		for _, k := range keys {
+			fmt.Fprintf(w, "<Contents><Key>%s</Key></Contents>", k)
+		}
+		fmt.Fprint(w, "<IsTruncated>false</IsTruncated></ListBucketResult>")
+	case r.Method == http.MethodPost && key == "" && query.Has("delete"):
+		var remove struct {
+			Objects []struct {
+				Key string `xml:"Key"`
+			} `xml:"Object"`
+		}
+		if err := xml.NewDecoder(r.Body).Decode(&remove); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		fmt.Fprint(w, "<DeleteResult>")
+		for _, object := range remove.Objects {
+			delete(f.objects, object.Key)
+			fmt.Fprintf(w, "<Deleted><Key>%s</Key></Deleted>", object.Key)
+		}
+		fmt.Fprint(w, "</DeleteResult>")
+	case r.Method == http.MethodPost && query.Has("uploads"):
+		uploadID := strconv.Itoa(len(f.uploads) + 1)
+		f.uploads[uploadID] = map[int][]byte{}
+		fmt.Fprintf(w, "<InitiateMultipartUploadResult><UploadId>%s</UploadId></InitiateMultipartUploadResult>", uploadID)
+	case r.Method == http.MethodPut && query.Has("uploadId"):
+		number, _ := strconv.Atoi(query.Get("partNumber"))
+		data, _ := io.ReadAll(r.Body)
+		f.uploads[query.Get("uploadId")][number] = data
+		w.Header().Set("ETag", fmt.Sprintf(`"part-%d"`, number))
+	case r.Method == http.MethodPost && query.Has("uploadId"):
+		var complete struct {
+			Parts []struct {
+				PartNumber int `xml:"PartNumber"`
+			} `xml:"Part"`
+		}
+		if err := xml.NewDecoder(r.Body).Decode(&complete); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		var data []byte
+		for _, part := range complete.Parts {
+			data = append(data, f.uploads[query.Get("uploadId")][part.PartNumber]...)
+		}
+		f.parts += len(complete.Parts)
+		f.objects[key] = data
+		delete(f.uploads, query.Get("uploadId"))
+		fmt.Fprintf(w, "<CompleteMultipartUploadResult><Bucket>%s</Bucket><Key>%s</Key><ETag>\"object\"</ETag></CompleteMultipartUploadResult>", f.bucket, key)
+	case r.Method == http.MethodPut:
+		data, _ := io.ReadAll(r.Body)
+		f.objects[key] = data
+	case r.Method == http.MethodGet || r.Method == http.MethodHead:
+		data, ok := f.objects[key]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			fmt.Fprint(w, "<Error><Code>NoSuchKey</Code><Message>The specified key does not exist.</Message></Error>")
+			return
+		}
+		w.Header().Set("Content-Length", strconv.Itoa(len(data)))
+		w.Header().Set("ETag", `"object"`)
+		w.Header().Set("Last-Modified", "Mon, 01 Jan 2024 00:00:00 GMT")
+		if r.Method == http.MethodGet {
+			_, _ = w.Write(data)
+		}
+	case r.Method == http.MethodDelete:
+		delete(f.objects, key)
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		w.WriteHeader(http.StatusMethodNotAllowed)
+	}
+}
+
+func newTestS3(t *testing.T, endpoint string, partSize int64) *S3 {
+	s, err := NewS3(S3Config{
+		Endpoint:        endpoint,
+		Bucket:          "ganymede",
+		AccessKeyID:     "access",
+		SecretAccessKey: "secret",
+		UsePathStyle:    true,
+		VideosDir:       "/data/videos",
+		PartSize:        partSize,
+	})
+	require.NoError(t, err)
+	return s
+}
+
+func TestS3SaveOpenDelete(t *testing.T) {
+	fake, server := newFakeS3(t, "ganymede")
+	s := newTestS3(t, server.URL, 0)
+	ctx := context.Background()
+
+	src := filepath.Join(t.TempDir(), "video.mp4")
+	tests_files.Write(t, src, "video data")
+
+	path := "/data/videos/channel/2024-01-01 stream/video.mp4"
+	require.NoError(t, s.Save(ctx, src, path))
+	require.NoFileExists(t, src)
+	require.Equal(t, []byte("video data"), fake.objects["channel/2024-01-01 stream/video.mp4"])
+
+	exists, err := s.Exists(ctx, path)
+	require.NoError(t, err)
+	require.True(t, exists)
+
+	reader, err := s.Open(ctx, path)
+	require.NoError(t, err)
+	data, err := io.ReadAll(reader)
+	require.NoError(t, reader.Close())
+	require.NoError(t, err)
+	require.Equal(t, []byte("video data"), data)
+
+	require.NoError(t, s.Delete(ctx, path))
+	exists, err = s.Exists(ctx, path)
+	require.NoError(t, err)
+	require.False(t, exists)
+
+	_, err = s.Open(ctx, path)
+	require.Error(t, err)
+	require.Equal(t, "NoSuchKey", minio.ToErrorResponse(err).Code)
+}
+
+func TestS3SaveMultipart(t *testing.T) {
+	fake, server := newFakeS3(t, "ganymede")
+	// the smallest part size S3 allows
+	s := newTestS3(t, server.URL, 5*1024*1024)
+
+	data := []byte(strings.Repeat("0123456789", 1024*1024))
+	src := filepath.Join(t.TempDir(), "video.mp4")
+	tests_files.Write(t, src, string(data))
+
+	require.NoError(t, s.Save(context.Background(), src, "/data/videos/channel/folder/video.mp4"))
+	require.Equal(t, data, fake.objects["channel/folder/video.mp4"])
+	require.Equal(t, 2, fake.parts)
+	require.Empty(t, fake.uploads)
+}
+
+func TestS3SaveAndDeleteDirectory(t *testing.T) {
+	fake, server := newFakeS3(t, "ganymede")
+	s := newTestS3(t, server.URL, 0)
+	ctx := context.Background()
+
+	src := t.TempDir()
+	tests_files.Write(t, filepath.Join(src, "video.m3u8"), "#EXTM3U")
+	tests_files.Write(t, filepath.Join(src, "segments", "0.ts"), "segment")
+	tests_files.Write(t, filepath.Join(src, "empty.ts"), "")
+	fake.objects["channel/other/video.mp4"] = []byte("other")
+
+	require.NoError(t, s.SaveDirectory(ctx, src, "/data/videos/channel/folder/hls"))
+	require.NoDirExists(t, src)
+	require.Equal(t, []byte("#EXTM3U"), fake.objects["channel/folder/hls/video.m3u8"])
+	require.Equal(t, []byte("segment"), fake.objects["channel/folder/hls/segments/0.ts"])
+	require.Contains(t, fake.objects, "channel/folder/hls/empty.ts")
+
+	require.NoError(t, s.DeleteDirectory(ctx, "/data/videos/channel/folder"))
+	require.Equal(t, map[string][]byte{"channel/other/video.mp4": []byte("other")}, fake.objects)
+}
+
+func TestS3PresignedURLCanBeFetched(t *testing.T) {
+	fake, server := newFakeS3(t, "ganymede")
+	s := newTestS3(t, server.URL, 0)
+	fake.objects["channel/folder/video.mp4"] = []byte("video data")
+
+	u, err := s.URL(context.Background(), "/data/videos/channel/folder/video.mp4")
+	require.NoError(t, err)
+	require.True(t, strings.HasPrefix(u, server.URL+"/ganymede/channel/folder/video.mp4?"))
+
+	resp, err := http.Get(u)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	data, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	require.Equal(t, []byte("video data"), data)
+}
+
+func TestObjectKey(t *testing.T) {
+	t.Parallel()
+
+	key, err := objectKey("/data/videos/", "/data/videos/channel/folder/video.mp4")
+	require.NoError(t, err)
+	require.Equal(t, "channel/folder/video.mp4", key)
+
+	for _, path := range []string{"/data/videos", "/data/temp/video.mp4", "/data/videos/../temp/video.mp4"} {
+		_, err := objectKey("/data/videos", path)
+		require.Error(t, err, path)
+	}
+}
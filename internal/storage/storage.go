@@ -0,0 +1,113 @@
+// Package storage stores finished archives on the local disk or in an S3-compatible bucket.
+//
+// Files are addressed by their path inside VideosDir, the same path that is saved on the video,
+// so the database does not change with the storage driver. Temporary files are always on the local disk.
+package storage
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/rs/zerolog/log"
+	"github.com/zibbp/ganymede/internal/config"
+)
+
+type Driver string
+
+const (
+	DriverLocal Driver = "local"
+	DriverS3    Driver = "s3"
+)
+
+// Storage is where finished archive files are written to and read from.
+type Storage interface {
+	// Driver returns the storage driver.
+	Driver() Driver
+	// Save moves the local file src to path.
+	Save(ctx context.Context, src string, path string) error
+	// SaveDirectory moves the contents of the local directory src to the directory path.
+	SaveDirectory(ctx context.Context, src string, path string) error
+	// Open opens the file at path for reading.
+	Open(ctx context.Context, path string) (io.ReadCloser, error)
+	// Exists reports whether the file at path exists.
+	Exists(ctx context.Context, path string) (bool, error)
+	// Delete deletes the file at path.
+	Delete(ctx context.Context, path string) error
+	// DeleteDirectory deletes the directory at path and everything in it.
+	DeleteDirectory(ctx context.Context, path string) error
+	// URL returns a location the file at path can be read from by ffmpeg or a browser.
+	// This is the path itself on the local disk and a presigned URL for S3.
+	URL(ctx context.Context, path string) (string, error)
+}
+
+var (
+	instance     Storage
+	instanceOnce sync.Once
+	instanceErr  error
+)
+
+// Init creates the storage configured by the environment. It should be called on startup so
+// configuration errors are reported early, Get calls it on first use otherwise.
+func Init() (Storage, error) {
+	instanceOnce.Do(func() {
+		instance, instanceErr = New(config.GetEnvConfig())
+		if instanceErr == nil {
+			log.Info().Str("driver", string(instance.Driver())).Msg("initialized archive storage")
+		}
+	})
+	return instance, instanceErr
+}
+
+// Get returns the configured storage.
+func Get() Storage {
+	s, err := Init()
+	if err != nil {
+		log.Panic().Err(err).Msg("error initializing archive storage")
+	}
+	return s
+}
+
+// New creates the storage for the driver set in the environment.
+func New(env config.EnvConfig) (Storage, error) {
+	switch Driver(env.StorageDriver) {
+	case "", DriverLocal:
+		return NewLocal(), nil
+	case DriverS3:
+		return NewS3(S3Config{
+			Endpoint:        env.S3Endpoint,
+			Region:          env.S3Region,
+			Bucket:          env.S3Bucket,
+			AccessKeyID:     env.S3AccessKeyID,
+			SecretAccessKey: env.S3SecretAccessKey,
+			UsePathStyle:    env.S3UsePathStyle,
+			PresignExpiry:   time.Duration(env.S3PresignExpiryMinutes) * time.Minute,
+			VideosDir:       env.VideosDir,
+		})
+	default:
+		return nil, fmt.Errorf("unknown storage driver %q", env.StorageDriver)
+	}
+}
+
+// ReadFile reads the whole file at path.
+func ReadFile(ctx context.Context, path string) ([]byte, error) {
+	reader, err := Get().Open(ctx, path)
+	if err != nil {
+		return nil, err
+	}
+	defer reader.Close()
+	return io.ReadAll(reader)
+}
+
+// objectKey returns the key of path relative to the videos directory.
+func objectKey(videosDir string, path string) (string, error) {
+	rel, err := filepath.Rel(filepath.Clean(videosDir), filepath.Clean(path))
+	if err != nil || rel == "." || rel == ".." || strings.HasPrefix(rel, "../") {
+		return "", fmt.Errorf("path %s is not inside the videos directory %s", path, videosDir)
+	}
+	return filepath.ToSlash(rel), nil
+}
@@ -0,0 +1,94 @@
+package storagetemplate
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/zibbp/ganymede/internal/config"
+)
+
+// templateVariablePatterns are the patterns the template variables match when parsing a path.
+// Variables that are not listed match any text within a path segment.
+var templateVariablePatterns = map[string]string{
+	"uuid": `[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}`,
+	"date": `\d{4}-\d{2}-\d{2}`,
+	"type": `archive|live|highlight|upload|clip`,
+	"YYYY": `\d{4}`,
+	"MM":   `\d{2}`,
+	"DD":   `\d{2}`,
+	"HH":   `\d{2}`,
+}
+
+// PathMatcher extracts the template variables from the path of a video archived with the storage templates.
+type PathMatcher struct {
+	regex     *regexp.Regexp
+	variables []string // variable name of each capture group
+}
+
+// NewPathMatcher creates a matcher for video paths relative to the videos directory, which are
+// {{channel folder}}/{{folder}}/{{file}}-video.mp4, or {{file}}-video_hls/{{id}}-video.m3u8 for HLS.
+func NewPathMatcher(templates config.StorageTemplate) (*PathMatcher, error) {
+	channelTemplate := templates.ChannelFolderTemplate
+	if channelTemplate == "" {
+		channelTemplate = "{{channel}}"
+	}
+
+	// empty folder template segments are skipped when archiving
+	folderSegments := []string{}
+	for _, segment := range strings.Split(templates.FolderTemplate, "/") {
+		if segment != "" {
+			folderSegments = append(folderSegments, segment)
+		}
+	}
+
+	m := &PathMatcher{}
+	var pattern strings.Builder
+	pattern.WriteString("^")
+	m.writeTemplate(&pattern, channelTemplate)
+	pattern.WriteString("/")
+	m.writeTemplate(&pattern, strings.Join(folderSegments, "/"))
+	pattern.WriteString("/")
+	m.writeTemplate(&pattern, templates.FileTemplate)
+	pattern.WriteString(`(?:-video\.mp4|-video_hls/[^/]+-video\.m3u8)$`)
+	regex, err := regexp.Compile(pattern.String())
+	if err != nil {
+		return nil, fmt.Errorf("error compiling storage template pattern: %w", err)
+	}
+	m.regex = regex
+	return m, nil
+}
+
+func (m *PathMatcher) writeTemplate(pattern *strings.Builder, template string) {
+	last := 0
+	for _, match := range templateVariableRegex.FindAllStringSubmatchIndex(template, -1) {
+		pattern.WriteString(regexp.QuoteMeta(template[last:match[0]]))
+		name := template[match[2]:match[3]]
+		variablePattern, ok := templateVariablePatterns[name]
+		if !ok {
+			variablePattern = `[^/]+?`
+		}
+		pattern.WriteString("(" + variablePattern + ")")
+		m.variables = append(m.variables, name)
+		last = match[1]
+	}
+	pattern.WriteString(regexp.QuoteMeta(template[last:]))
+}
+
+// Match returns the template variables of the relative path. It returns false if the path was
+// not created by the templates or a variable that is used more than once has different values.
+func (m *PathMatcher) Match(path string) (map[string]string, bool) {
+	groups := m.regex.FindStringSubmatch(path)
+	if groups == nil {
+		return nil, false
+	}
+	variables := make(map[string]string)
+	for i, name := range m.variables {
+		value := groups[i+1]
+		if existing, ok := variables[name]; ok && existing != value {
+			return nil, false
+		}
+		variables[name] = value
+	}
+	return variables, true
+}
@@ -0,0 +1,77 @@
+package storagetemplate
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/zibbp/ganymede/internal/config"
+)
+
+func TestPathMatcherDefaultTemplates(t *testing.T) {
+	t.Parallel()
+
+	m, err := NewPathMatcher(config.StorageTemplate{
+		FolderTemplate:        "{{date}}-{{id}}-{{type}}-{{uuid}}",
+		FileTemplate:          "{{id}}",
+		ChannelFolderTemplate: "{{channel}}",
+	})
+	require.NoError(t, err)
+
+	variables, ok := m.Match("streamer/2024-01-02-123456-archive-0d6b1b0e-3a5c-4d7f-9f3a-1c2b3d4e5f60/123456-video.mp4")
+	require.True(t, ok)
+	require.Equal(t, map[string]string{
+		"channel": "streamer",
+		"date":    "2024-01-02",
+		"id":      "123456",
+		"type":    "archive",
+		"uuid":    "0d6b1b0e-3a5c-4d7f-9f3a-1c2b3d4e5f60",
+	}, variables)
+
+	variables, ok = m.Match("streamer/2024-01-02-123456-live-0d6b1b0e-3a5c-4d7f-9f3a-1c2b3d4e5f60/123456-video_hls/123456-video.m3u8")
+	require.True(t, ok)
+	require.Equal(t, "live", variables["type"])
+	require.Equal(t, "123456", variables["id"])
+}
+
+func TestPathMatcherCustomTemplates(t *testing.T) {
+	t.Parallel()
+
+	m, err := NewPathMatcher(config.StorageTemplate{
+		FolderTemplate:        "{{YYYY}}/{{MM}}/{{title}} ({{id}})",
+		FileTemplate:          "{{title}}",
+		ChannelFolderTemplate: "{{channel_display_name}} [{{channel_id}}]",
+	})
+	require.NoError(t, err)
+
+	variables, ok := m.Match("Streamer [42]/2024/05/Some stream (987)/Some stream-video.mp4")
+	require.True(t, ok)
+	require.Equal(t, map[string]string{
+		"channel_display_name": "Streamer",
+		"channel_id":           "42",
+		"YYYY":                 "2024",
+		"MM":                   "05",
+		"title":                "Some stream",
+		"id":                   "987",
+	}, variables)
+}
+
+func TestPathMatcherRejectsOtherPaths(t *testing.T) {
+	t.Parallel()
+
+	m, err := NewPathMatcher(config.StorageTemplate{
+		FolderTemplate: "{{id}}-{{title}}",
+		FileTemplate:   "{{id}}",
+	})
+	require.NoError(t, err)
+
+	for _, path := range []string{
+		"streamer/123-title/123-thumbnail.jpg",
+		"streamer/123-title/123.mp4",
+		"streamer/extra/123-title/123-video.mp4",
+		// the id in the folder and file name differ
+		"streamer/123-title/456-video.mp4",
+	} {
+		_, ok := m.Match(path)
+		require.False(t, ok, path)
+	}
+}
@@ -0,0 +1,326 @@
+// Package streamversions finds the versions of a stream archived more than once and decides which to keep.
+//
+// A stream archived live is usually archived again as a VOD once the platform publishes it. The live
+// archive misses the start of the stream and any time the recording was interrupted, while parts of the
+// VOD may be muted. Versions are compared by how much of the stream they are missing and, depending on
+// the policy, both are kept, only the most complete one is kept, or the live archive is kept with the
+// chat of the VOD.
+package streamversions
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"slices"
+
+	"github.com/google/uuid"
+	"github.com/zibbp/ganymede/ent"
+	entMutedSegment "github.com/zibbp/ganymede/ent/mutedsegment"
+	"github.com/zibbp/ganymede/ent/predicate"
+	entVod "github.com/zibbp/ganymede/ent/vod"
+	"github.com/zibbp/ganymede/internal/chat"
+	"github.com/zibbp/ganymede/internal/database"
+	"github.com/zibbp/ganymede/internal/mutedaudio"
+	"github.com/zibbp/ganymede/internal/storage"
+	"github.com/zibbp/ganymede/internal/utils"
+)
+
+// Version is a video of a stream and how complete it is.
+type Version struct {
+	Video          *ent.Vod `json:"video"`
+	MissingSeconds int      `json:"missing_seconds"` // seconds of the stream the video didn't record
+	MutedSeconds   int      `json:"muted_seconds"`   // seconds of the video that are muted and weren't recovered
+	Best           bool     `json:"best"`
+}
+
+// Decision is what a policy does with the versions of a stream.
+type Decision struct {
+	Keep   *ent.Vod   // the version that stays authoritative
+	Delete []*ent.Vod // versions that are deleted with their files
+	// ChatFrom is the version whose chat replaces the chat of Keep, which starts Offset seconds into it
+	// and misses the Gaps.
+	ChatFrom *ent.Vod
+	Offset   float64
+	Gaps     []chat.Gap
+}
+
+// Result is the outcome of reconciling the versions of a stream.
+type Result struct {
+	Kept        uuid.UUID   `json:"kept"`
+	Deleted     []uuid.UUID `json:"deleted"`
+	ChatChanged bool        `json:"chat_changed"` // the chat of the kept version was replaced
+}
+
+// Groups returns the videos grouped by stream, only groups with more than one version are returned.
+// Live archives get the ID of the VOD once it is published, so videos with the same ID are versions
+// of the same stream as well as videos with the same stream ID.
+func Groups(videos []*ent.Vod) [][]*ent.Vod {
+	parent := make([]int, len(videos))
+	for i := range parent {
+		parent[i] = i
+	}
+	var find func(i int) int
+	find = func(i int) int {
+		if parent[i] != i {
+			parent[i] = find(parent[i])
+		}
+		return parent[i]
+	}
+
+	first := map[string]int{} // first video with each key
+	for i, video := range videos {
+		// the parts of a split live archive share the stream, but are parts of one version
+		if video.Part > 0 {
+			continue
+		}
+		keys := []string{}
+		if video.ExtID != "" {
+			keys = append(keys, fmt.Sprintf("%s:id:%s", video.Platform, video.ExtID))
+		}
+		if video.ExtStreamID != "" {
+			keys = append(keys, fmt.Sprintf("%s:stream:%s", video.Platform, video.ExtStreamID))
+		}
+		for _, key := range keys {
+			if j, ok := first[key]; ok {
+				parent[find(i)] = find(j)
+				continue
+			}
+			first[key] = i
+		}
+	}
+
+	byRoot := map[int][]*ent.Vod{}
+	roots := []int{}
+	for i, video := range videos {
+		root := find(i)
+		if _, ok := byRoot[root]; !ok {
+			roots = append(roots, root)
+		}
+		byRoot[root] = append(byRoot[root], video)
+	}
+	groups := [][]*ent.Vod{}
+	for _, root := range roots {
+		if len(byRoot[root]) > 1 {
+			groups = append(groups, byRoot[root])
+		}
+	}
+	return groups
+}
+
+// versionTypes are the video types that can be versions of the same stream.
+var versionTypes = []utils.VodType{utils.Live, utils.Archive}
+
+// Candidates returns the live archives and VODs that can have other versions, with their muted segments.
+func Candidates(ctx context.Context, client *ent.Client) ([]*ent.Vod, error) {
+	return client.Vod.Query().
+		Where(
+			entVod.TypeIn(versionTypes...),
+			entVod.Processing(false),
+			entVod.Part(0),
+			entVod.Or(entVod.ExtIDNEQ(""), entVod.ExtStreamIDNEQ("")),
+		).
+		WithMutedSegments(func(q *ent.MutedSegmentQuery) {
+			q.Where(entMutedSegment.Recovered(false))
+		}).
+		Order(ent.Asc(entVod.FieldCreatedAt)).
+		All(ctx)
+}
+
+// Find returns the versions of the stream of the video, including the video, with their muted segments
+// and live gaps. The parts of a split live archive are not versions of the stream.
+func Find(ctx context.Context, client *ent.Client, video *ent.Vod) ([]*ent.Vod, error) {
+	if !slices.Contains(versionTypes, video.Type) || video.Part > 0 || (video.ExtID == "" && video.ExtStreamID == "") {
+		return []*ent.Vod{video}, nil
+	}
+	sameStream := []predicate.Vod{entVod.ID(video.ID)}
+	if video.ExtID != "" {
+		sameStream = append(sameStream, entVod.ExtID(video.ExtID))
+	}
+	if video.ExtStreamID != "" {
+		sameStream = append(sameStream, entVod.ExtStreamID(video.ExtStreamID))
+	}
+	videos, err := client.Vod.Query().
+		Where(
+			entVod.TypeIn(versionTypes...),
+			entVod.PlatformEQ(video.Platform),
+			entVod.Part(0),
+			entVod.Or(sameStream...),
+		).
+		WithChannel().
+		WithMutedSegments(func(q *ent.MutedSegmentQuery) {
+			q.Where(entMutedSegment.Recovered(false))
+		}).
+		WithLiveGaps().
+		Order(ent.Asc(entVod.FieldCreatedAt)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("error fetching versions: %w", err)
+	}
+	return videos, nil
+}
+
+// Evaluate returns how complete each version is. The longest version is taken as the length of the
+// stream, so a live archive misses the time it is shorter than the VOD. Muted segments must be loaded
+// and are counted unless they were recovered. The version missing the fewest seconds is the best,
+// a VOD is preferred over a live archive that is as complete.
+func Evaluate(videos []*ent.Vod) []Version {
+	streamDuration := 0
+	for _, video := range videos {
+		streamDuration = max(streamDuration, video.Duration)
+	}
+
+	versions := make([]Version, 0, len(videos))
+	best := -1
+	for i, video := range videos {
+		version := Version{Video: video, MissingSeconds: streamDuration - video.Duration}
+		for _, segment := range video.Edges.MutedSegments {
+			if !segment.Recovered {
+				version.MutedSeconds += max(0, segment.End-segment.Start)
+			}
+		}
+		versions = append(versions, version)
+		if best == -1 || better(version, versions[best]) {
+			best = i
+		}
+	}
+	if best != -1 {
+		versions[best].Best = true
+	}
+	return versions
+}
+
+// better returns whether version a is more complete than version b.
+func better(a Version, b Version) bool {
+	aMissing, bMissing := a.MissingSeconds+a.MutedSeconds, b.MissingSeconds+b.MutedSeconds
+	if aMissing != bMissing {
+		return aMissing < bMissing
+	}
+	return a.Video.Type == utils.Archive && b.Video.Type != utils.Archive
+}
+
+// Decide returns what the policy does with the versions. Locked and processing versions are never deleted.
+// Keeping the live archive with the chat of the VOD requires both a live archive and a VOD, otherwise the
+// best version is kept.
+func Decide(policy utils.StreamVersionPolicy, versions []Version) Decision {
+	decision := Decision{}
+	for _, version := range versions {
+		if version.Best {
+			decision.Keep = version.Video
+		}
+	}
+	if decision.Keep == nil {
+		return decision
+	}
+
+	switch policy {
+	case utils.StreamVersionPolicyKeepBest:
+		for _, version := range versions {
+			if version.Video.ID != decision.Keep.ID && deletable(version.Video) {
+				decision.Delete = append(decision.Delete, version.Video)
+			}
+		}
+	case utils.StreamVersionPolicyKeepLiveWithVodChat:
+		var live, vod *ent.Vod
+		for _, version := range versions {
+			switch {
+			case version.Video.Type == utils.Live && (live == nil || version.Video.Duration > live.Duration):
+				live = version.Video
+			case version.Video.Type == utils.Archive && (vod == nil || version.Video.Duration > vod.Duration):
+				vod = version.Video
+			}
+		}
+		if live == nil || vod == nil {
+			return Decide(utils.StreamVersionPolicyKeepBest, versions)
+		}
+		decision.Keep = live
+		if vod.ChatPath != "" && !vod.Processing {
+			decision.ChatFrom = vod
+			decision.Offset = Offset(vod, live)
+			decision.Gaps = mutedaudio.Gaps(live)
+		}
+		for _, version := range versions {
+			if version.Video.Type == utils.Archive && deletable(version.Video) {
+				decision.Delete = append(decision.Delete, version.Video)
+			}
+		}
+	}
+	return decision
+}
+
+// deletable returns whether a version can be deleted. A part of a split live archive is never deleted
+// as it only holds part of the stream.
+func deletable(video *ent.Vod) bool {
+	return !video.Locked && !video.Processing && video.Part == 0
+}
+
+// Offset returns the position in the VOD where the live archive starts, the offset used to recover the
+// muted audio of the VOD if it was paired with the live archive. The gaps of the live archive must be loaded.
+func Offset(vod *ent.Vod, live *ent.Vod) float64 {
+	if vod.AudioSourceID != nil && *vod.AudioSourceID == live.ID {
+		return vod.AudioSourceOffset
+	}
+	return mutedaudio.Offset(vod, live)
+}
+
+// ChatPath returns where the chat of the video is saved. Videos archived without their chat get a chat
+// next to their other files.
+func ChatPath(video *ent.Vod) string {
+	if video.ChatPath != "" {
+		return video.ChatPath
+	}
+	directory := filepath.Dir(video.VideoPath)
+	if video.VideoHlsPath != "" {
+		directory = filepath.Dir(directory)
+	}
+	return filepath.Join(directory, fmt.Sprintf("%s-chat.json", video.FileName))
+}
+
+// TransferChat saves the chat of the VOD, shifted by the offset and with the gaps of the live archive closed,
+// as the chat of the live archive and returns the updated live archive. The rendered chat of the live archive
+// is kept.
+func TransferChat(ctx context.Context, store *database.Database, s storage.Storage, vod *ent.Vod, live *ent.Vod, offset float64, gaps []chat.Gap, tempDir string) (*ent.Vod, error) {
+	reader, err := s.Open(ctx, vod.ChatPath)
+	if err != nil {
+		return nil, fmt.Errorf("error opening chat %s: %w", vod.ChatPath, err)
+	}
+	defer reader.Close()
+	data, err := io.ReadAll(reader)
+	if err != nil {
+		return nil, fmt.Errorf("error reading chat %s: %w", vod.ChatPath, err)
+	}
+	shifted, err := chat.Shift(data, offset)
+	if err != nil {
+		return nil, err
+	}
+	if len(gaps) > 0 {
+		shifted, err = chat.CloseGaps(shifted, gaps)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	tmp, err := os.CreateTemp(tempDir, fmt.Sprintf("%s-chat-*.json", live.ID))
+	if err != nil {
+		return nil, fmt.Errorf("error creating chat: %w", err)
+	}
+	defer os.Remove(tmp.Name())
+	if _, err := tmp.Write(shifted); err != nil {
+		tmp.Close()
+		return nil, fmt.Errorf("error writing chat: %w", err)
+	}
+	if err := tmp.Close(); err != nil {
+		return nil, fmt.Errorf("error writing chat: %w", err)
+	}
+
+	chatPath := ChatPath(live)
+	if err := s.Save(ctx, tmp.Name(), chatPath); err != nil {
+		return nil, fmt.Errorf("error saving chat: %w", err)
+	}
+	updated, err := store.Client.Vod.UpdateOneID(live.ID).SetChatPath(chatPath).Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("error updating live archive: %w", err)
+	}
+	return updated, nil
+}
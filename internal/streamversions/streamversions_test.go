@@ -0,0 +1,145 @@
+package streamversions
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"github.com/zibbp/ganymede/ent"
+	"github.com/zibbp/ganymede/internal/chat"
+	"github.com/zibbp/ganymede/internal/utils"
+)
+
+func newVersionTestVideo(videoType utils.VodType, extID string, extStreamID string, duration int) *ent.Vod {
+	return &ent.Vod{
+		ID:          uuid.New(),
+		Type:        videoType,
+		Platform:    utils.PlatformTwitch,
+		ExtID:       extID,
+		ExtStreamID: extStreamID,
+		Duration:    duration,
+	}
+}
+
+func TestGroups(t *testing.T) {
+	t.Parallel()
+
+	live := newVersionTestVideo(utils.Live, "100", "100", 3000)
+	vod := newVersionTestVideo(utils.Archive, "200", "100", 3600)
+	// live archive that got the ID of a VOD archived before stream IDs were recorded for VODs
+	oldLive := newVersionTestVideo(utils.Live, "300", "150", 1000)
+	oldVod := newVersionTestVideo(utils.Archive, "300", "", 1200)
+	other := newVersionTestVideo(utils.Archive, "400", "", 1200)
+	otherPlatform := newVersionTestVideo(utils.Archive, "200", "100", 3600)
+	otherPlatform.Platform = utils.PlatformYoutube
+
+	groups := Groups([]*ent.Vod{live, oldLive, vod, other, oldVod, otherPlatform})
+	require.Equal(t, [][]*ent.Vod{{live, vod}, {oldLive, oldVod}}, groups)
+}
+
+func TestSplitLiveArchiveParts(t *testing.T) {
+	t.Parallel()
+
+	first := newVersionTestVideo(utils.Live, "100", "100", 43200)
+	first.Part = 1
+	second := newVersionTestVideo(utils.Live, "100", "100", 3600)
+	second.Part = 2
+	vod := newVersionTestVideo(utils.Archive, "200", "100", 46800)
+
+	// the parts of a split live archive are not versions of the stream
+	require.Empty(t, Groups([]*ent.Vod{first, second, vod}))
+
+	// keep_best never deletes a part of a split live archive
+	decision := Decide(utils.StreamVersionPolicyKeepBest, Evaluate([]*ent.Vod{first, second}))
+	require.Empty(t, decision.Delete)
+	decision = Decide(utils.StreamVersionPolicyKeepBest, Evaluate([]*ent.Vod{first, second, vod}))
+	require.Empty(t, decision.Delete)
+}
+
+func TestEvaluate(t *testing.T) {
+	t.Parallel()
+
+	live := newVersionTestVideo(utils.Live, "100", "100", 3000)
+	vod := newVersionTestVideo(utils.Archive, "200", "100", 3600)
+	vod.Edges.MutedSegments = []*ent.MutedSegment{{Start: 100, End: 400}, {Start: 1000, End: 2000, Recovered: true}}
+
+	versions := Evaluate([]*ent.Vod{live, vod})
+	require.Equal(t, []Version{
+		{Video: live, MissingSeconds: 600},
+		{Video: vod, MutedSeconds: 300, Best: true},
+	}, versions)
+
+	// a live archive missing less than the VOD has muted is the best version
+	vod.Edges.MutedSegments = []*ent.MutedSegment{{Start: 100, End: 1000}}
+	versions = Evaluate([]*ent.Vod{live, vod})
+	require.True(t, versions[0].Best)
+	require.False(t, versions[1].Best)
+
+	// a VOD is preferred over a live archive that is as complete
+	vod.Edges.MutedSegments = []*ent.MutedSegment{{Start: 100, End: 700}}
+	versions = Evaluate([]*ent.Vod{live, vod})
+	require.False(t, versions[0].Best)
+	require.True(t, versions[1].Best)
+}
+
+func TestDecide(t *testing.T) {
+	t.Parallel()
+
+	live := newVersionTestVideo(utils.Live, "100", "100", 3000)
+	vod := newVersionTestVideo(utils.Archive, "200", "100", 3600)
+	vod.ChatPath = "/data/videos/channel/200/200-chat.json"
+	versions := Evaluate([]*ent.Vod{live, vod})
+
+	decision := Decide(utils.StreamVersionPolicyKeepBoth, versions)
+	require.Equal(t, Decision{Keep: vod}, decision)
+
+	decision = Decide(utils.StreamVersionPolicyKeepBest, versions)
+	require.Equal(t, Decision{Keep: vod, Delete: []*ent.Vod{live}}, decision)
+
+	decision = Decide(utils.StreamVersionPolicyKeepLiveWithVodChat, versions)
+	require.Equal(t, Decision{Keep: live, Delete: []*ent.Vod{vod}, ChatFrom: vod, Offset: 600, Gaps: []chat.Gap{}}, decision)
+
+	// the chat is moved past the gaps of the live archive
+	started := time.Now()
+	live.Edges.LiveGaps = []*ent.LiveGap{{Offset: 1000, StartedAt: started, EndedAt: started.Add(90 * time.Second)}}
+	decision = Decide(utils.StreamVersionPolicyKeepLiveWithVodChat, versions)
+	require.Equal(t, 510.0, decision.Offset)
+	require.Equal(t, []chat.Gap{{Offset: 1000, Duration: 90}}, decision.Gaps)
+	live.Edges.LiveGaps = nil
+
+	// locked versions are never deleted
+	live.Locked = true
+	decision = Decide(utils.StreamVersionPolicyKeepBest, versions)
+	require.Empty(t, decision.Delete)
+
+	// without a live archive the best version is kept
+	otherVod := newVersionTestVideo(utils.Archive, "200", "100", 3500)
+	decision = Decide(utils.StreamVersionPolicyKeepLiveWithVodChat, Evaluate([]*ent.Vod{vod, otherVod}))
+	require.Equal(t, Decision{Keep: vod, Delete: []*ent.Vod{otherVod}}, decision)
+}
+
+func TestOffset(t *testing.T) {
+	t.Parallel()
+
+	live := newVersionTestVideo(utils.Live, "100", "100", 3000)
+	vod := newVersionTestVideo(utils.Archive, "200", "100", 3600)
+	require.Equal(t, 600.0, Offset(vod, live))
+
+	// the offset the muted audio was recovered with is used
+	vod.AudioSourceID = &live.ID
+	vod.AudioSourceOffset = 612.5
+	require.Equal(t, 612.5, Offset(vod, live))
+}
+
+func TestChatPath(t *testing.T) {
+	t.Parallel()
+
+	require.Equal(t, "/data/videos/channel/100/100-chat.json", ChatPath(&ent.Vod{ChatPath: "/data/videos/channel/100/100-chat.json"}))
+	require.Equal(t, "/data/videos/channel/100/100-chat.json", ChatPath(&ent.Vod{VideoPath: "/data/videos/channel/100/100-video.mp4", FileName: "100"}))
+	require.Equal(t, "/data/videos/channel/100/100-chat.json", ChatPath(&ent.Vod{
+		VideoPath:    "/data/videos/channel/100/100-video_hls/100-video.m3u8",
+		VideoHlsPath: "/data/videos/channel/100/100-video_hls",
+		FileName:     "100",
+	}))
+}
@@ -11,10 +11,12 @@ import (
 	"github.com/rs/zerolog/log"
 	"github.com/zibbp/ganymede/ent/vod"
 	"github.com/zibbp/ganymede/internal/archive"
+	"github.com/zibbp/ganymede/internal/checksum"
 	"github.com/zibbp/ganymede/internal/config"
 	"github.com/zibbp/ganymede/internal/database"
 	"github.com/zibbp/ganymede/internal/live"
 	"github.com/zibbp/ganymede/internal/nfo"
+	"github.com/zibbp/ganymede/internal/storage"
 	"github.com/zibbp/ganymede/internal/storagetemplate"
 	"github.com/zibbp/ganymede/internal/tasks"
 	tasks_client "github.com/zibbp/ganymede/internal/tasks/client"
@@ -135,6 +137,48 @@ func (s *Service) StartTask(ctx context.Context, task string) error {
 		}
 		log.Info().Str("task_id", fmt.Sprintf("%d", task.Job.ID)).Msgf("task created")
 
+	case "index_chat":
+		task, err := s.RiverClient.Client.Insert(ctx, tasks.IndexChatArgs{}, nil)
+		if err != nil {
+			return fmt.Errorf("error inserting task: %v", err)
+		}
+		log.Info().Str("task_id", fmt.Sprintf("%d", task.Job.ID)).Msgf("task created")
+
+	case "audit_library":
+		task, err := s.RiverClient.Client.Insert(ctx, tasks.AuditLibraryArgs{}, nil)
+		if err != nil {
+			return fmt.Errorf("error inserting task: %v", err)
+		}
+		log.Info().Str("task_id", fmt.Sprintf("%d", task.Job.ID)).Msgf("task created")
+
+	case "record_checksums":
+		task, err := s.RiverClient.Client.Insert(ctx, tasks.RecordChecksumsArgs{}, nil)
+		if err != nil {
+			return fmt.Errorf("error inserting task: %v", err)
+		}
+		log.Info().Str("task_id", fmt.Sprintf("%d", task.Job.ID)).Msgf("task created")
+
+	case "verify_checksums":
+		task, err := s.RiverClient.Client.Insert(ctx, tasks.VerifyChecksumsArgs{}, nil)
+		if err != nil {
+			return fmt.Errorf("error inserting task: %v", err)
+		}
+		log.Info().Str("task_id", fmt.Sprintf("%d", task.Job.ID)).Msgf("task created")
+
+	case "recover_muted_audio":
+		task, err := s.RiverClient.Client.Insert(ctx, tasks.RecoverMutedAudioArgs{}, nil)
+		if err != nil {
+			return fmt.Errorf("error inserting task: %v", err)
+		}
+		log.Info().Str("task_id", fmt.Sprintf("%d", task.Job.ID)).Msgf("task created")
+
+	case "reconcile_stream_versions":
+		task, err := s.RiverClient.Client.Insert(ctx, tasks.ReconcileStreamVersionsArgs{}, nil)
+		if err != nil {
+			return fmt.Errorf("error inserting task: %v", err)
+		}
+		log.Info().Str("task_id", fmt.Sprintf("%d", task.Job.ID)).Msgf("task created")
+
 	}
 
 	return nil
@@ -451,6 +495,16 @@ func (s *Service) StorageMigration() error {
 			}
 		}
 
+		// Audio fallback file
+		if video.AudioPath != "" {
+			newPath := fmt.Sprintf("%s/%s-audio%s", newRootFolderPath, fileName, path.Ext(video.AudioPath))
+			if err := safeRename(video.AudioPath, newPath); err != nil {
+				log.Error().Err(err).Msgf("error renaming audio for video %s", video.ID)
+				rollbackRenames(renames)
+				continue
+			}
+		}
+
 		// Sprite thumbnails directory
 		if len(video.SpriteThumbnailsImages) > 0 {
 			spriteThumbnailRoot := strings.Split(video.SpriteThumbnailsImages[0], "/sprites")[0]
@@ -509,6 +563,9 @@ func (s *Service) StorageMigration() error {
 		if video.CaptionPath != "" {
 			update = update.SetCaptionPath(fmt.Sprintf("%s/%s-caption%s", newRootFolderPath, fileName, path.Ext(video.CaptionPath)))
 		}
+		if video.AudioPath != "" {
+			update = update.SetAudioPath(fmt.Sprintf("%s/%s-audio%s", newRootFolderPath, fileName, path.Ext(video.AudioPath)))
+		}
 		if len(video.SpriteThumbnailsImages) > 0 {
 			var newSpriteThumbs []string
 			for _, thumb := range video.SpriteThumbnailsImages {
@@ -518,7 +575,8 @@ func (s *Service) StorageMigration() error {
 		}
 
 		// Save the updates.
-		if _, err := update.Save(context.Background()); err != nil {
+		updatedVideo, err := update.Save(context.Background())
+		if err != nil {
 			log.Error().Err(err).Msgf("error updating database paths for video %s", video.ID)
 			if err := tx.Rollback(); err != nil {
 				log.Error().Err(err).Msg("error rolling back transaction")
@@ -535,6 +593,15 @@ func (s *Service) StorageMigration() error {
 
 		log.Info().Msgf("migrated video %s to new storage template", video.ID)
 
+		// The checksum manifest lists the old file names so write it again.
+		oldManifestPath := checksum.ManifestPath(video)
+		if err := os.Remove(oldManifestPath); err != nil && !os.IsNotExist(err) {
+			log.Warn().Err(err).Msgf("error removing old checksum manifest '%s'", oldManifestPath)
+		}
+		if err := checksum.WriteManifest(context.Background(), s.Store, storage.Get(), updatedVideo, envConfig.TempDir); err != nil {
+			log.Error().Err(err).Msgf("error writing checksum manifest for video %s", video.ID)
+		}
+
 		// Remove old root path if it's empty.
 		if err := os.Remove(oldRootFolderPath); err != nil {
 			log.Warn().Err(err).Msgf("error removing old root folder '%s' likely files still exist in there", oldRootFolderPath)
@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
+	"sync/atomic"
 	"time"
 
 	"github.com/google/uuid"
@@ -11,11 +12,16 @@ import (
 	"github.com/riverqueue/river"
 	"github.com/riverqueue/river/rivertype"
 	"github.com/rs/zerolog/log"
+	"github.com/zibbp/ganymede/internal/diskspace"
 	"github.com/zibbp/ganymede/internal/utils"
 )
 
 const archiveHeartbeatInterval = time.Minute
 
+// lowDiskSpaceSnoozeInterval is how long new video downloads are snoozed while an
+// archive directory is below its minimum free space.
+const lowDiskSpaceSnoozeInterval = 5 * time.Minute
+
 type archiveJobMetadata struct {
 	QueueID            uuid.UUID `json:"queue_id"`
 	RecoveryGeneration int       `json:"recovery_generation"`
@@ -31,12 +37,18 @@ type ArchiveProgressOutput struct {
 // ArchiveMiddleware attaches searchable queue metadata during inserts and
 // records progress for running archive jobs. The worker client is optional so
 // the same middleware can be installed on the insertion-only producer client.
+// Video downloads are snoozed before they start while the videos or temp
+// directory is below its configured minimum free space.
 type ArchiveMiddleware struct {
 	river.MiddlewareDefaults
-	client *river.Client[pgx.Tx]
+	client       *river.Client[pgx.Tx]
+	diskSpace    func() (diskspace.Status, error)
+	diskSpaceLow atomic.Bool
 }
 
-func NewArchiveMiddleware() *ArchiveMiddleware { return &ArchiveMiddleware{} }
+func NewArchiveMiddleware() *ArchiveMiddleware {
+	return &ArchiveMiddleware{diskSpace: diskspace.Check}
+}
 
 func (m *ArchiveMiddleware) SetWorkerClient(client *river.Client[pgx.Tx]) {
 	m.client = client
@@ -80,6 +92,11 @@ func (m *ArchiveMiddleware) InsertMany(ctx context.Context, manyParams []*rivert
 }
 
 func (m *ArchiveMiddleware) Work(ctx context.Context, job *rivertype.JobRow, doInner func(context.Context) error) error {
+	if isDiskSpaceGuardedJob(job.Kind) && m.isDiskSpaceLow(ctx) {
+		log.Debug().Int64("job_id", job.ID).Str("kind", job.Kind).Msg("snoozing video download due to low disk space")
+		return river.JobSnooze(lowDiskSpaceSnoozeInterval)
+	}
+
 	if m.client == nil || !utils.Contains(job.Tags, archive_tag) {
 		return doInner(ctx)
 	}
@@ -104,6 +121,55 @@ func (m *ArchiveMiddleware) Work(ctx context.Context, job *rivertype.JobRow, doI
 	return err
 }
 
+// isDiskSpaceGuardedJob reports whether the job kind is paused while disk space is low.
+// Only downloads are paused so jobs already holding partial files can still finish.
+func isDiskSpaceGuardedJob(kind string) bool {
+	return kind == string(utils.TaskDownloadVideo) || kind == string(utils.TaskDownloadLiveVideo)
+}
+
+// isDiskSpaceLow checks the archive directories against their minimum free space.
+// A notification is sent once when archiving is paused, errors checking the free
+// space are logged and never pause archiving.
+func (m *ArchiveMiddleware) isDiskSpaceLow(ctx context.Context) bool {
+	if m.diskSpace == nil {
+		return false
+	}
+	status, err := m.diskSpace()
+	if err != nil {
+		log.Warn().Err(err).Msg("error checking free disk space")
+		return false
+	}
+	if !status.Low {
+		if m.diskSpaceLow.Swap(false) {
+			log.Info().Msg("disk space recovered, resuming video downloads")
+		}
+		return false
+	}
+	if m.diskSpaceLow.Swap(true) {
+		return true
+	}
+
+	lowDirectories := status.LowDirectories()
+	for _, directory := range lowDirectories {
+		log.Warn().Str("path", directory.Path).Int64("free_bytes", directory.FreeBytes).Int64("min_free_bytes", directory.MinFreeBytes).Msg("free disk space below minimum, pausing video downloads")
+	}
+	if notifSvc, err := NotificationServiceFromContext(ctx); err == nil {
+		go func() {
+			notifCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), 30*time.Second)
+			defer cancel()
+			defer func() {
+				if r := recover(); r != nil {
+					log.Error().Interface("panic", r).Msg("panic in notification")
+				}
+			}()
+			for _, directory := range lowDirectories {
+				notifSvc.SendLowDiskSpace(notifCtx, directory.Path, directory.FreeBytes, directory.MinFreeBytes)
+			}
+		}()
+	}
+	return true
+}
+
 func (m *ArchiveMiddleware) runHeartbeat(ctx context.Context, jobID int64) {
 	m.updateProgress(jobID, false)
 	ticker := time.NewTicker(archiveHeartbeatInterval)
@@ -8,6 +8,7 @@ import (
 	"github.com/google/uuid"
 	"github.com/riverqueue/river/rivertype"
 	"github.com/stretchr/testify/require"
+	"github.com/zibbp/ganymede/internal/diskspace"
 	"github.com/zibbp/ganymede/internal/utils"
 )
 
@@ -44,6 +45,34 @@ func TestArchiveMiddlewareAddsQueueMetadataAndPreservesExistingMetadata(t *testi
 	require.Equal(t, 3, metadata.Ganymede.RecoveryGeneration)
 }
 
+func TestArchiveMiddlewareSnoozesDownloadsWhileDiskSpaceIsLow(t *testing.T) {
+	t.Parallel()
+	status := diskspace.Status{Low: true, Directories: []diskspace.Directory{{Name: "videos", Path: "/data/videos", Low: true}}}
+	middleware := &ArchiveMiddleware{diskSpace: func() (diskspace.Status, error) { return status, nil }}
+
+	ran := false
+	doInner := func(context.Context) error {
+		ran = true
+		return nil
+	}
+
+	err := middleware.Work(context.Background(), &rivertype.JobRow{Kind: (DownloadVideoArgs{}).Kind()}, doInner)
+	var snoozeErr *rivertype.JobSnoozeError
+	require.ErrorAs(t, err, &snoozeErr)
+	require.Equal(t, lowDiskSpaceSnoozeInterval, snoozeErr.Duration)
+	require.False(t, ran)
+
+	// other archive jobs keep running so partially archived videos can finish
+	require.NoError(t, middleware.Work(context.Background(), &rivertype.JobRow{Kind: (DownloadChatArgs{}).Kind()}, doInner))
+	require.True(t, ran)
+
+	ran = false
+	status = diskspace.Status{}
+	require.NoError(t, middleware.Work(context.Background(), &rivertype.JobRow{Kind: (DownloadLiveVideoArgs{}).Kind()}, doInner))
+	require.True(t, ran)
+	require.False(t, middleware.diskSpaceLow.Load())
+}
+
 func TestNewRecoveredArchiveArgsIncrementsGenerationAndPreservesJobSettings(t *testing.T) {
 	t.Parallel()
 	queueID := uuid.New()
@@ -0,0 +1,89 @@
+package tasks
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/riverqueue/river"
+	"github.com/rs/zerolog/log"
+	"github.com/zibbp/ganymede/ent"
+	entVod "github.com/zibbp/ganymede/ent/vod"
+	"github.com/zibbp/ganymede/internal/audit"
+)
+
+// AuditLibraryArgs checks the files of one video and saves its health when VideoID is set,
+// or enqueues a check of every video when it is nil.
+type AuditLibraryArgs struct {
+	VideoID *uuid.UUID `json:"video_id,omitempty" river:"unique"`
+}
+
+func (AuditLibraryArgs) Kind() string { return TaskAuditLibrary }
+
+func (AuditLibraryArgs) InsertOpts() river.InsertOpts {
+	return river.InsertOpts{
+		MaxAttempts: 3,
+		UniqueOpts:  archiveUniqueOpts(),
+	}
+}
+
+func (w *AuditLibraryWorker) Timeout(job *river.Job[AuditLibraryArgs]) time.Duration {
+	return 10 * time.Minute
+}
+
+type AuditLibraryWorker struct {
+	river.WorkerDefaults[AuditLibraryArgs]
+}
+
+func (w AuditLibraryWorker) Work(ctx context.Context, job *river.Job[AuditLibraryArgs]) error {
+	logger := log.With().Str("task", job.Kind).Int64("job_id", job.ID).Logger()
+
+	store, err := StoreFromContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	if job.Args.VideoID == nil {
+		enqueuer, err := EnqueuerFromContext(ctx)
+		if err != nil {
+			return err
+		}
+		videos, err := store.Client.Vod.Query().Where(entVod.Processing(false)).IDs(ctx)
+		if err != nil {
+			return fmt.Errorf("fetch videos for library audit: %w", err)
+		}
+		for _, id := range videos {
+			if _, err := enqueuer.Insert(ctx, AuditLibraryArgs{VideoID: &id}, nil); err != nil {
+				return fmt.Errorf("enqueue library audit for video %s: %w", id, err)
+			}
+		}
+		logger.Info().Int("videos", len(videos)).Msg("enqueued library audit")
+		return nil
+	}
+
+	video, err := store.Client.Vod.Get(ctx, *job.Args.VideoID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			logger.Warn().Str("video_id", job.Args.VideoID.String()).Msg("video not found; skipping library audit")
+			return nil
+		}
+		return fmt.Errorf("fetch video %s for library audit: %w", job.Args.VideoID, err)
+	}
+	// files of a processing video are still being written
+	if video.Processing {
+		logger.Debug().Str("video_id", video.ID.String()).Msg("video is processing; skipping library audit")
+		return nil
+	}
+
+	result, err := audit.NewChecker().Check(ctx, video)
+	if err != nil {
+		return fmt.Errorf("audit video %s: %w", video.ID, err)
+	}
+	if err := audit.Save(ctx, store.Client, video.ID, result, time.Now()); err != nil {
+		return err
+	}
+
+	logger.Info().Str("video_id", video.ID.String()).Str("status", string(result.Status)).Interface("issues", result.Issues).Msg("audited video")
+	return nil
+}
@@ -0,0 +1,119 @@
+package tasks
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/riverqueue/river"
+	"github.com/rs/zerolog/log"
+	"github.com/zibbp/ganymede/internal/config"
+	"github.com/zibbp/ganymede/internal/exec"
+	"github.com/zibbp/ganymede/internal/storage"
+)
+
+// GenerateCaptionsArgs generates WebVTT captions for a video by running the configured speech-to-text command on its audio.
+type GenerateCaptionsArgs struct {
+	VideoId string `json:"video_id"`
+}
+
+func (GenerateCaptionsArgs) Kind() string { return TaskGenerateCaptions }
+
+func (args GenerateCaptionsArgs) InsertOpts() river.InsertOpts {
+	return river.InsertOpts{
+		MaxAttempts: 1,
+		Queue:       QueueVideoPostProcess,
+	}
+}
+
+func (w *GenerateCaptionsWorker) Timeout(job *river.Job[GenerateCaptionsArgs]) time.Duration {
+	return 12 * time.Hour
+}
+
+type GenerateCaptionsWorker struct {
+	river.WorkerDefaults[GenerateCaptionsArgs]
+}
+
+func (w GenerateCaptionsWorker) Work(ctx context.Context, job *river.Job[GenerateCaptionsArgs]) error {
+	logger := log.With().Str("task", job.Kind).Str("job_id", fmt.Sprintf("%d", job.ID)).Logger()
+
+	store, err := StoreFromContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	command := config.Get().Parameters.SpeechToText
+	if command == "" {
+		return fmt.Errorf("speech-to-text command is not configured")
+	}
+
+	videoUUID, err := uuid.Parse(job.Args.VideoId)
+	if err != nil {
+		return err
+	}
+
+	video, err := store.Client.Vod.Get(ctx, videoUUID)
+	if err != nil {
+		return err
+	}
+
+	videoPath := video.VideoPath
+	if video.VideoHlsPath != "" {
+		videoPath = fmt.Sprintf("%s/%s-video.m3u8", video.VideoHlsPath, video.ExtID)
+	}
+	exists, err := storage.Get().Exists(ctx, videoPath)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		return fmt.Errorf("video file %s does not exist", videoPath)
+	}
+	videoURL, err := storage.Get().URL(ctx, videoPath)
+	if err != nil {
+		return err
+	}
+
+	// captions are saved next to the video using the same naming as the other video files
+	rootVideoPath := filepath.Dir(video.VideoPath)
+	if video.ThumbnailPath != "" {
+		rootVideoPath = filepath.Dir(video.ThumbnailPath)
+	}
+	captionPath := fmt.Sprintf("%s/%s-caption.vtt", rootVideoPath, video.FileName)
+
+	logger.Info().Str("video_id", video.ID.String()).Msg("generating captions for video")
+
+	tmpDirectory, err := os.MkdirTemp(config.GetEnvConfig().TempDir, fmt.Sprintf("%s-captions", video.ID))
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := os.RemoveAll(tmpDirectory); err != nil {
+			logger.Warn().Err(err).Str("path", tmpDirectory).Msg("failed to delete temporary captions directory")
+		}
+	}()
+
+	tmpAudioPath := filepath.Join(tmpDirectory, "audio.wav")
+	if err := exec.ExtractAudio(ctx, videoURL, tmpAudioPath); err != nil {
+		return fmt.Errorf("error extracting audio: %w", err)
+	}
+
+	tmpCaptionPath := filepath.Join(tmpDirectory, "captions.vtt")
+	if err := exec.GenerateCaptions(ctx, command, tmpAudioPath, tmpCaptionPath); err != nil {
+		return fmt.Errorf("error generating captions: %w", err)
+	}
+
+	if err := storage.Get().Save(ctx, tmpCaptionPath, captionPath); err != nil {
+		return fmt.Errorf("error moving captions: %w", err)
+	}
+
+	if _, err := video.Update().SetCaptionPath(captionPath).Save(ctx); err != nil {
+		return fmt.Errorf("error updating video: %w", err)
+	}
+
+	logger.Info().Str("video_id", video.ID.String()).Str("caption_path", captionPath).Msg("generated captions for video")
+
+	return nil
+}
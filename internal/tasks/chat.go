@@ -5,8 +5,11 @@ import (
 	"time"
 
 	"github.com/riverqueue/river"
+	"github.com/zibbp/ganymede/internal/checksum"
+	"github.com/zibbp/ganymede/internal/config"
 	"github.com/zibbp/ganymede/internal/errors"
 	"github.com/zibbp/ganymede/internal/exec"
+	"github.com/zibbp/ganymede/internal/storage"
 	"github.com/zibbp/ganymede/internal/utils"
 )
 
@@ -59,8 +62,17 @@ func (w DownloadChatWorker) Work(ctx context.Context, job *river.Job[DownloadCha
 		return err
 	}
 
-	// download video
-	err = exec.DownloadTwitchChat(ctx, dbItems.Video)
+	// download chat
+	switch dbItems.Video.Platform {
+	case utils.PlatformKick:
+		if kick, kickErr := KickFromContext(ctx); kickErr != nil {
+			err = kickErr
+		} else {
+			err = exec.DownloadKickChat(ctx, kick, dbItems.Video)
+		}
+	default:
+		err = exec.DownloadTwitchChat(ctx, dbItems.Video)
+	}
 	if err != nil {
 		return err
 	}
@@ -233,35 +245,40 @@ func (w MoveChatWorker) Work(ctx context.Context, job *river.Job[MoveChatArgs])
 		return err
 	}
 
-	err = utils.MoveFile(ctx, dbItems.Video.TmpChatDownloadPath, dbItems.Video.ChatPath)
+	err = storage.Get().Save(ctx, dbItems.Video.TmpChatDownloadPath, dbItems.Video.ChatPath)
 	if err != nil {
 		return err
 	}
 
 	if dbItems.Queue.LiveArchive {
-		err = utils.MoveFile(ctx, dbItems.Video.TmpLiveChatDownloadPath, dbItems.Video.LiveChatPath)
+		err = storage.Get().Save(ctx, dbItems.Video.TmpLiveChatDownloadPath, dbItems.Video.LiveChatPath)
 		if err != nil {
 			return err
 		}
-		err = utils.MoveFile(ctx, dbItems.Video.TmpLiveChatConvertPath, dbItems.Video.LiveChatConvertPath)
+		err = storage.Get().Save(ctx, dbItems.Video.TmpLiveChatConvertPath, dbItems.Video.LiveChatConvertPath)
 		if err != nil {
 			return err
 		}
 	}
 
 	if dbItems.Queue.RenderChat {
-		err = utils.MoveFile(ctx, dbItems.Video.TmpChatRenderPath, dbItems.Video.ChatVideoPath)
+		err = storage.Get().Save(ctx, dbItems.Video.TmpChatRenderPath, dbItems.Video.ChatVideoPath)
 		if err != nil {
 			return err
 		}
 	}
 
-	// set queue status to completed
-	err = setQueueStatus(ctx, store.Client, QueueStatusInput{
+	next := []transactionalJob{{Args: IndexChatArgs{VideoID: &dbItems.Video.ID}}}
+	if config.Get().Checksums.Enabled {
+		next = append(next, transactionalJob{Args: RecordChecksumsArgs{VideoID: &dbItems.Video.ID, Files: checksum.ChatFiles}})
+	}
+
+	// set queue status to completed, index the chat for search and record the chat checksums
+	err = setQueueStatusAndEnqueue(ctx, store, QueueStatusInput{
 		Status:  utils.Success,
 		QueueId: job.Args.Input.QueueId,
 		Task:    utils.TaskMoveChat,
-	})
+	}, next...)
 	if err != nil {
 		return err
 	}
@@ -0,0 +1,227 @@
+package tasks
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/riverqueue/river"
+	"github.com/rs/zerolog/log"
+	"github.com/zibbp/ganymede/ent"
+	entChatMessage "github.com/zibbp/ganymede/ent/chatmessage"
+	entVod "github.com/zibbp/ganymede/ent/vod"
+	"github.com/zibbp/ganymede/internal/chat"
+	"github.com/zibbp/ganymede/internal/storage"
+)
+
+// number of chat messages inserted per statement, kept well below the postgres parameter limit
+const chatIndexBatchSize = 1000
+
+// IndexChatArgs indexes the chat of one video for search when VideoID is set,
+// or enqueues indexing for every video with a chat that has not been indexed when it is nil.
+type IndexChatArgs struct {
+	VideoID *uuid.UUID `json:"video_id,omitempty" river:"unique"`
+}
+
+func (IndexChatArgs) Kind() string { return TaskIndexChat }
+
+func (IndexChatArgs) InsertOpts() river.InsertOpts {
+	return river.InsertOpts{
+		MaxAttempts: 3,
+		UniqueOpts:  archiveUniqueOpts(),
+	}
+}
+
+func (w *IndexChatWorker) Timeout(job *river.Job[IndexChatArgs]) time.Duration {
+	return 30 * time.Minute
+}
+
+type IndexChatWorker struct {
+	river.WorkerDefaults[IndexChatArgs]
+}
+
+func (w IndexChatWorker) Work(ctx context.Context, job *river.Job[IndexChatArgs]) error {
+	logger := log.With().Str("task", job.Kind).Int64("job_id", job.ID).Logger()
+
+	store, err := StoreFromContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	if job.Args.VideoID == nil {
+		enqueuer, err := EnqueuerFromContext(ctx)
+		if err != nil {
+			return err
+		}
+		videos, err := store.Client.Vod.Query().
+			Where(entVod.Processing(false), entVod.ChatPathNEQ(""), entVod.Not(entVod.HasChatMessages())).
+			IDs(ctx)
+		if err != nil {
+			return fmt.Errorf("fetch videos for chat indexing: %w", err)
+		}
+		for _, id := range videos {
+			if _, err := enqueuer.Insert(ctx, IndexChatArgs{VideoID: &id}, nil); err != nil {
+				return fmt.Errorf("enqueue chat indexing for video %s: %w", id, err)
+			}
+		}
+		logger.Info().Int("videos", len(videos)).Msg("enqueued chat indexing")
+		return nil
+	}
+
+	video, err := store.Client.Vod.Get(ctx, *job.Args.VideoID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			logger.Warn().Str("video_id", job.Args.VideoID.String()).Msg("video not found; skipping chat indexing")
+			return nil
+		}
+		return fmt.Errorf("fetch video %s for chat indexing: %w", job.Args.VideoID, err)
+	}
+	if video.ChatPath == "" {
+		logger.Debug().Str("video_id", video.ID.String()).Msg("video has no chat; skipping chat indexing")
+		return nil
+	}
+
+	exists, err := storage.Get().Exists(ctx, video.ChatPath)
+	if err != nil {
+		return fmt.Errorf("check chat file %s: %w", video.ChatPath, err)
+	}
+	if !exists {
+		logger.Warn().Str("video_id", video.ID.String()).Str("chat_path", video.ChatPath).Msg("chat file does not exist; skipping chat indexing")
+		return nil
+	}
+	data, err := storage.ReadFile(ctx, video.ChatPath)
+	if err != nil {
+		return fmt.Errorf("read chat file %s: %w", video.ChatPath, err)
+	}
+	var chatData chatIndexFile
+	if err := json.Unmarshal(data, &chatData); err != nil {
+		return fmt.Errorf("parse chat file %s: %w", video.ChatPath, err)
+	}
+
+	messages := chatMessagesFromChat(chatData, video.StreamedAt)
+
+	// replace any previously indexed messages so re-archived chats don't produce duplicates
+	err = store.WithTx(ctx, func(txClient *ent.Client, _ *sql.Tx) error {
+		if _, err := txClient.ChatMessage.Delete().Where(entChatMessage.VodID(video.ID)).Exec(ctx); err != nil {
+			return fmt.Errorf("delete indexed chat messages: %w", err)
+		}
+		for start := 0; start < len(messages); start += chatIndexBatchSize {
+			end := min(start+chatIndexBatchSize, len(messages))
+			builders := make([]*ent.ChatMessageCreate, 0, end-start)
+			for _, message := range messages[start:end] {
+				builders = append(builders, txClient.ChatMessage.Create().
+					SetVodID(video.ID).
+					SetExtID(message.ExtID).
+					SetContentOffsetSeconds(message.ContentOffsetSeconds).
+					SetCommenterID(message.CommenterID).
+					SetCommenterName(message.CommenterName).
+					SetCommenterDisplayName(message.CommenterDisplayName).
+					SetBody(message.Body).
+					SetEmotes(message.Emotes).
+					SetCreatedAt(message.CreatedAt))
+			}
+			if err := txClient.ChatMessage.CreateBulk(builders...).Exec(ctx); err != nil {
+				return fmt.Errorf("insert chat messages: %w", err)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("index chat for video %s: %w", video.ID, err)
+	}
+
+	logger.Info().Str("video_id", video.ID.String()).Int("messages", len(messages)).Msg("indexed chat")
+	return nil
+}
+
+// chatIndexFile holds the parts of a chat file needed for indexing. The embedded emote images are not decoded.
+type chatIndexFile struct {
+	Comments []chat.Comment `json:"comments"`
+	Emotes   struct {
+		ThirdParty []chatIndexEmote `json:"thirdParty"`
+	} `json:"emotes"`
+}
+
+type chatIndexEmote struct {
+	Name string `json:"name"`
+}
+
+type indexedChatMessage struct {
+	ExtID                string
+	ContentOffsetSeconds float64
+	CommenterID          string
+	CommenterName        string
+	CommenterDisplayName string
+	Body                 string
+	Emotes               []string
+	CreatedAt            time.Time
+}
+
+// chatMessagesFromChat converts the comments of a chat file to messages for the search index.
+// Emotes are taken from the emote fragments of a message and from words matching the name of an embedded third party emote.
+// If a comment has no valid creation time it is derived from the video start time and the comment offset.
+func chatMessagesFromChat(chatData chatIndexFile, streamedAt time.Time) []indexedChatMessage {
+	thirdPartyEmotes := make(map[string]struct{}, len(chatData.Emotes.ThirdParty))
+	for _, emote := range chatData.Emotes.ThirdParty {
+		if emote.Name != "" {
+			thirdPartyEmotes[emote.Name] = struct{}{}
+		}
+	}
+
+	messages := make([]indexedChatMessage, 0, len(chatData.Comments))
+	for _, comment := range chatData.Comments {
+		body := comment.Message.Body
+		if body == "" {
+			for _, fragment := range comment.Message.Fragments {
+				body += fragment.Text
+			}
+		}
+		if body == "" {
+			continue
+		}
+
+		emotes := []string{}
+		seen := make(map[string]struct{})
+		addEmote := func(name string) {
+			if _, ok := seen[name]; ok || name == "" {
+				return
+			}
+			seen[name] = struct{}{}
+			emotes = append(emotes, name)
+		}
+		for _, fragment := range comment.Message.Fragments {
+			if fragment.Emoticon != nil {
+				addEmote(strings.TrimSpace(fragment.Text))
+			}
+		}
+		if len(thirdPartyEmotes) > 0 {
+			for _, word := range strings.Fields(body) {
+				if _, ok := thirdPartyEmotes[word]; ok {
+					addEmote(word)
+				}
+			}
+		}
+
+		createdAt, err := time.Parse(time.RFC3339Nano, comment.CreatedAt)
+		if err != nil {
+			createdAt = streamedAt.Add(time.Duration(comment.ContentOffsetSeconds * float64(time.Second)))
+		}
+
+		messages = append(messages, indexedChatMessage{
+			ExtID:                comment.ID,
+			ContentOffsetSeconds: comment.ContentOffsetSeconds,
+			CommenterID:          comment.Commenter.ID,
+			CommenterName:        comment.Commenter.Name,
+			CommenterDisplayName: comment.Commenter.DisplayName,
+			Body:                 body,
+			Emotes:               emotes,
+			CreatedAt:            createdAt,
+		})
+	}
+
+	return messages
+}
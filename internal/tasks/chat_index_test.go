@@ -0,0 +1,42 @@
+package tasks
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestChatMessagesFromChat(t *testing.T) {
+	t.Parallel()
+
+	var chatData chatIndexFile
+	require.NoError(t, json.Unmarshal([]byte(`{
+		"comments": [
+			{"_id":"c1","created_at":"2024-05-02T09:00:05.5Z","content_offset_seconds":5.5,
+			 "commenter":{"_id":"1","name":"chatter","display_name":"Chatter"},
+			 "message":{"body":"hello Kappa catJAM catJAM","fragments":[{"text":"hello "},{"text":"Kappa","emoticon":{"emoticon_id":"25"}},{"text":" catJAM catJAM"}]}},
+			{"_id":"c2","created_at":"","content_offset_seconds":10,
+			 "commenter":{"_id":"2","name":"other","display_name":"Other"},
+			 "message":{"body":"","fragments":[{"text":"from "},{"text":"fragments"}]}},
+			{"_id":"c3","content_offset_seconds":11,"commenter":{"_id":"3"},"message":{"body":""}}
+		],
+		"emotes": {"thirdParty":[{"name":"catJAM","data":"aGVsbG8="}]}
+	}`), &chatData))
+
+	streamedAt := time.Date(2024, 5, 2, 9, 0, 0, 0, time.UTC)
+	messages := chatMessagesFromChat(chatData, streamedAt)
+	require.Len(t, messages, 2)
+
+	require.Equal(t, "c1", messages[0].ExtID)
+	require.Equal(t, 5.5, messages[0].ContentOffsetSeconds)
+	require.Equal(t, "chatter", messages[0].CommenterName)
+	require.Equal(t, "Chatter", messages[0].CommenterDisplayName)
+	require.Equal(t, []string{"Kappa", "catJAM"}, messages[0].Emotes)
+	require.True(t, messages[0].CreatedAt.Equal(streamedAt.Add(5500*time.Millisecond)))
+
+	require.Equal(t, "from fragments", messages[1].Body)
+	require.Empty(t, messages[1].Emotes)
+	require.True(t, messages[1].CreatedAt.Equal(streamedAt.Add(10*time.Second)))
+}
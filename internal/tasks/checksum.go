@@ -0,0 +1,199 @@
+package tasks
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/riverqueue/river"
+	"github.com/riverqueue/river/rivertype"
+	"github.com/rs/zerolog/log"
+	"github.com/zibbp/ganymede/ent"
+	entChecksum "github.com/zibbp/ganymede/ent/checksum"
+	entVod "github.com/zibbp/ganymede/ent/vod"
+	"github.com/zibbp/ganymede/internal/checksum"
+	"github.com/zibbp/ganymede/internal/config"
+	"github.com/zibbp/ganymede/internal/storage"
+	"github.com/zibbp/ganymede/internal/utils"
+)
+
+// RecordChecksumsArgs records the checksums of the files of one video and writes its manifest when VideoID is set,
+// or enqueues recording for every video without checksums when it is nil. All files are recorded if Files is empty.
+type RecordChecksumsArgs struct {
+	VideoID *uuid.UUID           `json:"video_id,omitempty" river:"unique"`
+	Files   []utils.ChecksumFile `json:"files,omitempty" river:"unique"`
+}
+
+func (RecordChecksumsArgs) Kind() string { return TaskRecordChecksums }
+
+func (RecordChecksumsArgs) InsertOpts() river.InsertOpts {
+	return river.InsertOpts{
+		MaxAttempts: 3,
+		// a running job may have hashed the files before they were replaced, so only skip jobs that have not started
+		UniqueOpts: river.UniqueOpts{
+			ByArgs: true,
+			ByState: []rivertype.JobState{
+				rivertype.JobStateAvailable,
+				rivertype.JobStatePending,
+				rivertype.JobStateRetryable,
+				rivertype.JobStateScheduled,
+			},
+		},
+	}
+}
+
+func (w *RecordChecksumsWorker) Timeout(job *river.Job[RecordChecksumsArgs]) time.Duration {
+	return 2 * time.Hour
+}
+
+type RecordChecksumsWorker struct {
+	river.WorkerDefaults[RecordChecksumsArgs]
+}
+
+func (w RecordChecksumsWorker) Work(ctx context.Context, job *river.Job[RecordChecksumsArgs]) error {
+	logger := log.With().Str("task", job.Kind).Int64("job_id", job.ID).Logger()
+
+	if !config.Get().Checksums.Enabled {
+		logger.Debug().Msg("checksums are disabled; skipping")
+		return nil
+	}
+
+	store, err := StoreFromContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	if job.Args.VideoID == nil {
+		enqueuer, err := EnqueuerFromContext(ctx)
+		if err != nil {
+			return err
+		}
+		videos, err := store.Client.Vod.Query().
+			Where(entVod.Processing(false), entVod.Not(entVod.HasChecksums())).
+			IDs(ctx)
+		if err != nil {
+			return fmt.Errorf("fetch videos for checksums: %w", err)
+		}
+		for _, id := range videos {
+			if _, err := enqueuer.Insert(ctx, RecordChecksumsArgs{VideoID: &id}, nil); err != nil {
+				return fmt.Errorf("enqueue checksums for video %s: %w", id, err)
+			}
+		}
+		logger.Info().Int("videos", len(videos)).Msg("enqueued checksums")
+		return nil
+	}
+
+	video, err := store.Client.Vod.Get(ctx, *job.Args.VideoID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			logger.Warn().Str("video_id", job.Args.VideoID.String()).Msg("video not found; skipping checksums")
+			return nil
+		}
+		return fmt.Errorf("fetch video %s for checksums: %w", job.Args.VideoID, err)
+	}
+
+	files := job.Args.Files
+	if len(files) == 0 {
+		for _, file := range utils.ChecksumFile("").Values() {
+			files = append(files, utils.ChecksumFile(file))
+		}
+	}
+
+	s := storage.Get()
+	if err := checksum.Record(ctx, store, s, video, files); err != nil {
+		return fmt.Errorf("record checksums of video %s: %w", video.ID, err)
+	}
+	if err := checksum.WriteManifest(ctx, store, s, video, config.GetEnvConfig().TempDir); err != nil {
+		return fmt.Errorf("write checksum manifest of video %s: %w", video.ID, err)
+	}
+
+	logger.Info().Str("video_id", video.ID.String()).Interface("files", files).Msg("recorded checksums")
+	return nil
+}
+
+// VerifyChecksumsArgs hashes the files of the checksums that were verified the longest time ago again.
+// Enough checksums are verified on each run to verify the whole library in the configured number of days.
+type VerifyChecksumsArgs struct{}
+
+func (VerifyChecksumsArgs) Kind() string { return TaskVerifyChecksums }
+
+func (VerifyChecksumsArgs) InsertOpts() river.InsertOpts {
+	return river.InsertOpts{
+		MaxAttempts: 1,
+	}
+}
+
+func (w *VerifyChecksumsWorker) Timeout(job *river.Job[VerifyChecksumsArgs]) time.Duration {
+	return 12 * time.Hour
+}
+
+type VerifyChecksumsWorker struct {
+	river.WorkerDefaults[VerifyChecksumsArgs]
+}
+
+func (w VerifyChecksumsWorker) Work(ctx context.Context, job *river.Job[VerifyChecksumsArgs]) error {
+	logger := log.With().Str("task", job.Kind).Int64("job_id", job.ID).Logger()
+
+	checksumConfig := config.Get().Checksums
+	if !checksumConfig.Enabled {
+		logger.Debug().Msg("checksums are disabled; skipping")
+		return nil
+	}
+
+	store, err := StoreFromContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	total, err := store.Client.Checksum.Query().Count(ctx)
+	if err != nil {
+		return fmt.Errorf("count checksums: %w", err)
+	}
+	checksums, err := store.Client.Checksum.Query().
+		Where(entChecksum.HasVodWith(entVod.Processing(false))).
+		WithVod(func(q *ent.VodQuery) { q.WithChannel() }).
+		Order(ent.Asc(entChecksum.FieldVerifiedAt)).
+		Limit(checksum.VerifySliceSize(total, checksumConfig.VerifyDays)).
+		All(ctx)
+	if err != nil {
+		return fmt.Errorf("fetch checksums: %w", err)
+	}
+
+	s := storage.Get()
+	// files that changed since the last verification, by video
+	failed := map[uuid.UUID][]string{}
+	videos := map[uuid.UUID]*ent.Vod{}
+	for _, c := range checksums {
+		video := c.Edges.Vod
+		status, err := checksum.Verify(ctx, s, video, c)
+		if err != nil {
+			logger.Error().Err(err).Str("video_id", video.ID.String()).Str("checksum_id", c.ID.String()).Msg("error verifying checksum")
+			continue
+		}
+		if _, err := store.Client.Checksum.UpdateOne(c).SetStatus(status).SetVerifiedAt(time.Now()).Save(ctx); err != nil {
+			return fmt.Errorf("save checksum %s: %w", c.ID, err)
+		}
+		if status == utils.ChecksumStatusOk {
+			continue
+		}
+		path := checksum.Path(video, c.File, c.Name)
+		logger.Warn().Str("video_id", video.ID.String()).Str("path", path).Str("status", string(status)).Msg("archived file does not match its checksum")
+		// only notify once, the status stays until the checksum is recorded again
+		if c.Status == utils.ChecksumStatusOk {
+			failed[video.ID] = append(failed[video.ID], path)
+			videos[video.ID] = video
+		}
+	}
+
+	if len(failed) > 0 {
+		if notifSvc, err := NotificationServiceFromContext(ctx); err == nil {
+			for id, paths := range failed {
+				notifSvc.SendChecksumMismatch(ctx, videos[id].Edges.Channel, videos[id], paths)
+			}
+		}
+	}
+
+	logger.Info().Int("verified", len(checksums)).Int("videos_with_mismatches", len(failed)).Msg("verified checksums")
+	return nil
+}
@@ -0,0 +1,190 @@
+package tasks
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/riverqueue/river"
+	"github.com/rs/zerolog/log"
+	"github.com/zibbp/ganymede/ent"
+	"github.com/zibbp/ganymede/internal/chat"
+	"github.com/zibbp/ganymede/internal/checksum"
+	"github.com/zibbp/ganymede/internal/config"
+	"github.com/zibbp/ganymede/internal/database"
+	"github.com/zibbp/ganymede/internal/exec"
+	"github.com/zibbp/ganymede/internal/storage"
+	tasks_shared "github.com/zibbp/ganymede/internal/tasks/shared"
+	vods_utility "github.com/zibbp/ganymede/internal/vod/utility"
+)
+
+// CreateClipArgs cuts a clip created in Ganymede from the video it is linked to. The streams are copied
+// when the clip starts on a keyframe and re-encoded otherwise. The rendered chat and the chat of the
+// video are cut as well.
+type CreateClipArgs struct {
+	VideoID uuid.UUID `json:"video_id" river:"unique"`
+}
+
+func (CreateClipArgs) Kind() string { return TaskCreateClip }
+
+func (CreateClipArgs) InsertOpts() river.InsertOpts {
+	return river.InsertOpts{
+		MaxAttempts: 3,
+		UniqueOpts:  archiveUniqueOpts(),
+	}
+}
+
+func (w *CreateClipWorker) Timeout(job *river.Job[CreateClipArgs]) time.Duration {
+	return 2 * time.Hour
+}
+
+type CreateClipWorker struct {
+	river.WorkerDefaults[CreateClipArgs]
+}
+
+func (w CreateClipWorker) Work(ctx context.Context, job *river.Job[CreateClipArgs]) error {
+	logger := log.With().Str("task", job.Kind).Int64("job_id", job.ID).Str("video_id", job.Args.VideoID.String()).Logger()
+
+	store, err := StoreFromContext(ctx)
+	if err != nil {
+		return err
+	}
+	enqueuer, err := EnqueuerFromContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	clip, err := store.Client.Vod.Get(ctx, job.Args.VideoID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			logger.Warn().Msg("clip not found; skipping")
+			return nil
+		}
+		return fmt.Errorf("fetch clip: %w", err)
+	}
+	if clip.ClipVodID == nil {
+		logger.Warn().Msg("clip is not linked to a video; skipping")
+		return nil
+	}
+	video, err := store.Client.Vod.Get(ctx, *clip.ClipVodID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			// the clip can't be cut anymore
+			logger.Warn().Str("parent_id", clip.ClipVodID.String()).Msg("video of clip not found; deleting clip")
+			return vods_utility.DeleteVod(ctx, store, clip.ID, true)
+		}
+		return fmt.Errorf("fetch video of clip: %w", err)
+	}
+
+	if err := createClip(ctx, store, enqueuer, clip, video); err != nil {
+		// the clip would stay processing forever once the job stops being retried
+		if job.Attempt >= job.MaxAttempts && ctx.Err() == nil {
+			logger.Error().Err(err).Msg("clip could not be created; deleting clip")
+			if err := vods_utility.DeleteVod(ctx, store, clip.ID, true); err != nil {
+				logger.Error().Err(err).Msg("error deleting clip")
+			}
+		}
+		return err
+	}
+	return nil
+}
+
+// createClip cuts the video, rendered chat and chat of the clip from its video and queues the tasks run
+// after the clip is archived.
+func createClip(ctx context.Context, store *database.Database, enqueuer tasks_shared.Enqueuer, clip *ent.Vod, video *ent.Vod) error {
+	start := float64(clip.ClipVodOffset)
+	end := start + float64(clip.Duration)
+	tempDir := config.GetEnvConfig().TempDir
+	s := storage.Get()
+
+	if err := cutClipVideo(ctx, s, video.VideoPath, clip.VideoPath, start, end, filepath.Join(tempDir, fmt.Sprintf("%s-clip.mp4", clip.ID))); err != nil {
+		return fmt.Errorf("cut video: %w", err)
+	}
+	if clip.ChatVideoPath != "" && video.ChatVideoPath != "" {
+		if err := cutClipVideo(ctx, s, video.ChatVideoPath, clip.ChatVideoPath, start, end, filepath.Join(tempDir, fmt.Sprintf("%s-clip-chat.mp4", clip.ID))); err != nil {
+			return fmt.Errorf("cut rendered chat: %w", err)
+		}
+	}
+	if clip.ChatPath != "" && video.ChatPath != "" {
+		if err := sliceClipChat(ctx, s, video.ChatPath, clip.ChatPath, start, end, filepath.Join(tempDir, fmt.Sprintf("%s-clip-chat.json", clip.ID))); err != nil {
+			return fmt.Errorf("cut chat: %w", err)
+		}
+	}
+
+	next := []river.JobArgs{
+		GenerateStaticThumbnailArgs{VideoId: clip.ID.String()},
+		&UpdateVideoStorageUsage{VideoID: &clip.ID},
+	}
+	if config.Get().Archive.GenerateSpriteThumbnails {
+		next = append(next, GenerateSpriteThumbnailArgs{VideoId: clip.ID.String()})
+	}
+	if clip.ChatPath != "" {
+		next = append(next, IndexChatArgs{VideoID: &clip.ID})
+	}
+	if config.Get().Checksums.Enabled {
+		next = append(next, RecordChecksumsArgs{VideoID: &clip.ID, Files: slices.Concat(checksum.VideoFiles, checksum.ChatFiles)})
+	}
+
+	err := store.WithTx(ctx, func(txClient *ent.Client, tx *sql.Tx) error {
+		if err := txClient.Vod.UpdateOneID(clip.ID).SetProcessing(false).Exec(ctx); err != nil {
+			return err
+		}
+		for _, args := range next {
+			if _, err := enqueuer.InsertTx(ctx, tx, args, nil); err != nil {
+				return fmt.Errorf("enqueue %s: %w", args.Kind(), err)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("update clip: %w", err)
+	}
+
+	log.Info().Str("video_id", clip.ID.String()).Str("parent_id", video.ID.String()).Float64("start", start).Float64("end", end).Msg("clip created")
+	return nil
+}
+
+// cutClipVideo cuts the part of the video between start and end to a temporary file and saves it to outputPath.
+func cutClipVideo(ctx context.Context, s storage.Storage, videoPath string, outputPath string, start float64, end float64, tmpPath string) error {
+	videoURL, err := s.URL(ctx, videoPath)
+	if err != nil {
+		return err
+	}
+	keyframes, err := exec.Keyframes(ctx, videoURL, start-1, start+1)
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmpPath)
+	if err := exec.CutVideo(ctx, videoURL, start, end, exec.KeyframeAligned(keyframes, start), tmpPath); err != nil {
+		return err
+	}
+	return s.Save(ctx, tmpPath, outputPath)
+}
+
+// sliceClipChat saves the comments of the chat between start and end to outputPath.
+func sliceClipChat(ctx context.Context, s storage.Storage, chatPath string, outputPath string, start float64, end float64, tmpPath string) error {
+	reader, err := s.Open(ctx, chatPath)
+	if err != nil {
+		return err
+	}
+	defer reader.Close()
+	data, err := io.ReadAll(reader)
+	if err != nil {
+		return fmt.Errorf("error reading chat %s: %w", chatPath, err)
+	}
+	sliced, err := chat.Slice(data, start, end)
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmpPath)
+	if err := os.WriteFile(tmpPath, sliced, 0644); err != nil {
+		return fmt.Errorf("error writing chat: %w", err)
+	}
+	return s.Save(ctx, tmpPath, outputPath)
+}
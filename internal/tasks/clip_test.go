@@ -0,0 +1,43 @@
+package tasks
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/zibbp/ganymede/internal/storage"
+)
+
+func TestSliceClipChatLocalStorage(t *testing.T) {
+	dir := t.TempDir()
+	chatPath := filepath.Join(dir, "videos", "channel", "100", "100-chat.json")
+	if err := os.MkdirAll(filepath.Dir(chatPath), 0o755); err != nil {
+		t.Fatalf("failed to create video directory: %v", err)
+	}
+	chat := `{"streamer":{"name":"streamer","id":1},"video":{"start":0,"end":3600},"comments":[` +
+		`{"_id":"a","content_offset_seconds":12.5,"message":{"body":"first"}},` +
+		`{"_id":"b","content_offset_seconds":700.25,"message":{"body":"second"}}]}`
+	if err := os.WriteFile(chatPath, []byte(chat), 0o644); err != nil {
+		t.Fatalf("failed to write chat: %v", err)
+	}
+
+	// the folder of the clip doesn't exist until its files are saved
+	clipChatPath := filepath.Join(dir, "videos", "channel", "clip", "clip-chat.json")
+	tmpPath := filepath.Join(dir, "clip-chat.json")
+	if err := sliceClipChat(context.Background(), storage.NewLocal(), chatPath, clipChatPath, 600, 1200, tmpPath); err != nil {
+		t.Fatalf("sliceClipChat returned error: %v", err)
+	}
+
+	data, err := os.ReadFile(clipChatPath)
+	if err != nil {
+		t.Fatalf("failed to read clip chat: %v", err)
+	}
+	if strings.Contains(string(data), `"first"`) || !strings.Contains(string(data), `"second"`) {
+		t.Fatalf("unexpected clip chat: %s", data)
+	}
+	if _, err := os.Stat(tmpPath); !os.IsNotExist(err) {
+		t.Fatalf("expected temporary chat to be removed, got %v", err)
+	}
+}
@@ -0,0 +1,114 @@
+package tasks
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/riverqueue/river"
+	"github.com/rs/zerolog/log"
+	"github.com/zibbp/ganymede/ent"
+	"github.com/zibbp/ganymede/internal/coldstorage"
+	"github.com/zibbp/ganymede/internal/utils"
+)
+
+// MoveVideoStorageTierArgs moves one video to Tier when VideoID is set,
+// or enqueues moving every video selected by the cold storage policy to the cold tier when it is nil.
+// With RemoveLeftover the files a move of the video to Tier left in the previous tier are removed instead.
+type MoveVideoStorageTierArgs struct {
+	VideoID        *uuid.UUID        `json:"video_id,omitempty" river:"unique"`
+	Tier           utils.StorageTier `json:"tier,omitempty" river:"unique"`
+	RemoveLeftover bool              `json:"remove_leftover,omitempty" river:"unique"`
+}
+
+func (MoveVideoStorageTierArgs) Kind() string { return TaskMoveVideoStorageTier }
+
+func (args MoveVideoStorageTierArgs) InsertOpts() river.InsertOpts {
+	// videos that are played are moved back before the ones moved to the cold tier
+	priority := 1
+	if args.Tier == utils.StorageTierCold {
+		priority = 4
+	}
+	return river.InsertOpts{
+		MaxAttempts: 5,
+		Queue:       QueueStorageTier,
+		Priority:    priority,
+		UniqueOpts:  archiveUniqueOpts(),
+	}
+}
+
+func (w *MoveVideoStorageTierWorker) Timeout(job *river.Job[MoveVideoStorageTierArgs]) time.Duration {
+	return 24 * time.Hour
+}
+
+type MoveVideoStorageTierWorker struct {
+	river.WorkerDefaults[MoveVideoStorageTierArgs]
+}
+
+func (w MoveVideoStorageTierWorker) Work(ctx context.Context, job *river.Job[MoveVideoStorageTierArgs]) error {
+	logger := log.With().Str("task", job.Kind).Str("job_id", fmt.Sprintf("%d", job.ID)).Logger()
+
+	store, err := StoreFromContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	if job.Args.VideoID == nil {
+		if !coldstorage.Enabled() {
+			logger.Debug().Msg("cold storage is not enabled; skipping")
+			return nil
+		}
+		enqueuer, err := EnqueuerFromContext(ctx)
+		if err != nil {
+			return err
+		}
+		videos, err := coldstorage.Candidates(ctx, store, time.Now())
+		if err != nil {
+			return err
+		}
+		for _, video := range videos {
+			if _, err := enqueuer.Insert(ctx, MoveVideoStorageTierArgs{VideoID: &video.ID, Tier: utils.StorageTierCold}, nil); err != nil {
+				return fmt.Errorf("enqueue moving video %s to cold storage: %w", video.ID, err)
+			}
+		}
+		logger.Info().Int("videos", len(videos)).Msg("enqueued moving videos to cold storage")
+		return nil
+	}
+
+	if job.Args.RemoveLeftover {
+		retryAt, err := coldstorage.RemoveLeftover(ctx, store, *job.Args.VideoID, job.Args.Tier, time.Now())
+		if err != nil {
+			if ent.IsNotFound(err) {
+				return nil
+			}
+			return err
+		}
+		if !retryAt.IsZero() {
+			logger.Debug().Str("video_id", job.Args.VideoID.String()).Time("retry_at", retryAt).Msg("video was watched recently; keeping files in previous storage tier")
+			return river.JobSnooze(time.Until(retryAt))
+		}
+		return nil
+	}
+
+	logger.Info().Str("video_id", job.Args.VideoID.String()).Str("tier", string(job.Args.Tier)).Msg("starting task")
+	if err := coldstorage.Move(ctx, store, *job.Args.VideoID, job.Args.Tier); err != nil {
+		if ent.IsNotFound(err) {
+			logger.Warn().Str("video_id", job.Args.VideoID.String()).Msg("video not found; skipping")
+			return nil
+		}
+		return err
+	}
+
+	// viewers may still be playing the files in the previous tier
+	enqueuer, err := EnqueuerFromContext(ctx)
+	if err != nil {
+		return err
+	}
+	_, err = enqueuer.Insert(ctx, MoveVideoStorageTierArgs{VideoID: job.Args.VideoID, Tier: job.Args.Tier, RemoveLeftover: true}, &river.InsertOpts{ScheduledAt: time.Now().Add(coldstorage.LeftoverRetention)})
+	if err != nil {
+		return fmt.Errorf("enqueue removing files left in previous storage tier: %w", err)
+	}
+	logger.Info().Msg("task completed")
+	return nil
+}
@@ -156,7 +156,7 @@ func (w SaveVideoInfoWorker) Work(ctx context.Context, job *river.Job[SaveVideoI
 		return err
 	}
 
-	platformService, err := PlatformFromContext(ctx)
+	platformService, err := VideoPlatformFromContext(ctx, dbItems.Video.Platform)
 	if err != nil {
 		return err
 	}
@@ -215,7 +215,10 @@ func (w SaveVideoInfoWorker) Work(ctx context.Context, job *river.Job[SaveVideoI
 		log.Warn().Err(err).Msg("error resolving channel folder template for info file, falling back to channel login name")
 		infoChannelFolderName = dbItems.Channel.Name
 	}
-	err = utils.WriteJsonFile(info, fmt.Sprintf("%s/%s/%s/%s-info.json", config.GetEnvConfig().VideosDir, infoChannelFolderName, dbItems.Video.FolderName, dbItems.Video.FileName))
+	infoPath := fmt.Sprintf("%s/%s/%s/%s-info.json", config.GetEnvConfig().VideosDir, infoChannelFolderName, dbItems.Video.FolderName, dbItems.Video.FileName)
+	err = saveFileToStorage(ctx, infoPath, func(tmpPath string) error {
+		return utils.WriteJsonFile(info, tmpPath)
+	})
 	if err != nil {
 		return err
 	}
@@ -290,7 +293,7 @@ func (w DownloadTumbnailsWorker) Work(ctx context.Context, job *river.Job[Downlo
 		return err
 	}
 
-	platformService, err := PlatformFromContext(ctx)
+	platformService, err := VideoPlatformFromContext(ctx, dbItems.Video.Platform)
 	if err != nil {
 		return err
 	}
@@ -327,11 +330,15 @@ func (w DownloadTumbnailsWorker) Work(ctx context.Context, job *river.Job[Downlo
 		webResThumbnailUrl = replaceThumbnailPlaceholders(thumbnailUrl, "640", "360", dbItems.Queue.LiveArchive)
 	}
 
-	err = utils.DownloadAndSaveFile(ctx, fullResThumbnailUrl, dbItems.Video.ThumbnailPath)
+	err = saveFileToStorage(ctx, dbItems.Video.ThumbnailPath, func(tmpPath string) error {
+		return utils.DownloadAndSaveFile(ctx, fullResThumbnailUrl, tmpPath)
+	})
 	if err != nil {
 		return err
 	}
-	err = utils.DownloadAndSaveFile(ctx, webResThumbnailUrl, dbItems.Video.WebThumbnailPath)
+	err = saveFileToStorage(ctx, dbItems.Video.WebThumbnailPath, func(tmpPath string) error {
+		return utils.DownloadAndSaveFile(ctx, webResThumbnailUrl, tmpPath)
+	})
 	if err != nil {
 		return err
 	}
@@ -430,7 +437,7 @@ func (w UpdateLiveStreamMetadataWorker) Work(ctx context.Context, job *river.Job
 		return err
 	}
 
-	platformService, err := PlatformFromContext(ctx)
+	platformService, err := VideoPlatformFromContext(ctx, dbItems.Video.Platform)
 	if err != nil {
 		return err
 	}
@@ -523,6 +530,11 @@ func (w UpdateStreamVideoIdWorker) Work(ctx context.Context, job *river.Job[Upda
 	// loop over each channel and get all channel videos
 	// this is necessary because the 'streamid' is not an id we can query from APIs
 	for _, channel := range channels {
+		// other platforms use the same ID for the stream and its video
+		if channel.Platform != utils.PlatformTwitch {
+			continue
+		}
+
 		logger.Info().Str("channel", channel.Name).Msg("fetching channel videos")
 
 		// only get videos if no queue id is set
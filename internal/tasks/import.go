@@ -0,0 +1,169 @@
+package tasks
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/riverqueue/river"
+	"github.com/rs/zerolog/log"
+	"github.com/zibbp/ganymede/ent"
+	"github.com/zibbp/ganymede/internal/config"
+	"github.com/zibbp/ganymede/internal/exec"
+	"github.com/zibbp/ganymede/internal/storage"
+	"github.com/zibbp/ganymede/internal/utils"
+)
+
+// ImportVideoArgs finishes importing a video that was created from files already on disk.
+// The video is probed for its duration and resolution, optionally updated with the
+// metadata from its platform, and its thumbnails are generated if they are missing.
+type ImportVideoArgs struct {
+	VideoId       string `json:"video_id" river:"unique"`
+	FetchMetadata bool   `json:"fetch_metadata"`
+}
+
+func (ImportVideoArgs) Kind() string { return TaskImportVideo }
+
+func (args ImportVideoArgs) InsertOpts() river.InsertOpts {
+	return river.InsertOpts{
+		MaxAttempts: 3,
+		UniqueOpts:  archiveUniqueOpts(),
+	}
+}
+
+func (w *ImportVideoWorker) Timeout(job *river.Job[ImportVideoArgs]) time.Duration {
+	return 10 * time.Minute
+}
+
+type ImportVideoWorker struct {
+	river.WorkerDefaults[ImportVideoArgs]
+}
+
+func (w ImportVideoWorker) Work(ctx context.Context, job *river.Job[ImportVideoArgs]) error {
+	logger := log.With().Str("task", job.Kind).Str("job_id", fmt.Sprintf("%d", job.ID)).Str("video_id", job.Args.VideoId).Logger()
+
+	store, err := StoreFromContext(ctx)
+	if err != nil {
+		return err
+	}
+	enqueuer, err := EnqueuerFromContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	videoUUID, err := uuid.Parse(job.Args.VideoId)
+	if err != nil {
+		return err
+	}
+	video, err := store.Client.Vod.Get(ctx, videoUUID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			logger.Warn().Msg("video not found; skipping")
+			return nil
+		}
+		return err
+	}
+
+	logger.Info().Str("video_path", video.VideoPath).Msg("importing video")
+
+	videoURL, err := storage.Get().URL(ctx, video.VideoPath)
+	if err != nil {
+		return err
+	}
+	duration, err := exec.GetVideoDuration(ctx, videoURL)
+	if err != nil {
+		return fmt.Errorf("error getting video duration: %w", err)
+	}
+	probe, err := exec.GetFfprobeVideoData(ctx, videoURL)
+	if err != nil {
+		return fmt.Errorf("error probing video: %w", err)
+	}
+	resolution := probe.Resolution()
+
+	var metadata *videoImportMetadata
+	if job.Args.FetchMetadata && video.ExtID != "" && video.Type != utils.Live {
+		metadata, err = fetchVideoImportMetadata(ctx, video)
+		if err != nil {
+			// the files are imported with the metadata found on disk
+			logger.Warn().Err(err).Str("ext_id", video.ExtID).Msg("error fetching video metadata from platform")
+		}
+	}
+
+	next := []river.JobArgs{}
+	thumbnailExists, err := storage.Get().Exists(ctx, video.WebThumbnailPath)
+	if err != nil {
+		return err
+	}
+	if !thumbnailExists {
+		next = append(next, GenerateStaticThumbnailArgs{VideoId: video.ID.String()})
+	}
+	if !video.SpriteThumbnailsEnabled && config.Get().Archive.GenerateSpriteThumbnails {
+		next = append(next, GenerateSpriteThumbnailArgs{VideoId: video.ID.String()})
+	}
+	if video.ChatPath != "" {
+		next = append(next, IndexChatArgs{VideoID: &video.ID})
+	}
+
+	err = store.WithTx(ctx, func(txClient *ent.Client, tx *sql.Tx) error {
+		update := txClient.Vod.UpdateOneID(video.ID).
+			SetDuration(duration).
+			SetResolution(resolution).
+			SetProcessing(false)
+		if metadata != nil {
+			update.SetTitle(metadata.Title).
+				SetViews(metadata.Views).
+				SetStreamedAt(metadata.StreamedAt)
+			if metadata.Category != "" {
+				update.SetCategory(metadata.Category)
+			}
+		}
+		if err := update.Exec(ctx); err != nil {
+			return err
+		}
+		for _, args := range next {
+			if _, err := enqueuer.InsertTx(ctx, tx, args, nil); err != nil {
+				return fmt.Errorf("enqueue %s: %w", args.Kind(), err)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("error updating imported video: %w", err)
+	}
+
+	logger.Info().Msg("video imported")
+	return nil
+}
+
+type videoImportMetadata struct {
+	Title      string
+	Category   string
+	Views      int
+	StreamedAt time.Time
+}
+
+func fetchVideoImportMetadata(ctx context.Context, video *ent.Vod) (*videoImportMetadata, error) {
+	platform, err := VideoPlatformFromContext(ctx, video.Platform)
+	if err != nil {
+		return nil, err
+	}
+	info, err := platform.GetVideo(ctx, video.ExtID, false, false)
+	if err != nil {
+		return nil, err
+	}
+
+	metadata := &videoImportMetadata{
+		Title:      info.Title,
+		Views:      int(info.ViewCount),
+		StreamedAt: info.CreatedAt,
+	}
+	if info.Category != nil {
+		metadata.Category = *info.Category
+	}
+	if metadata.StreamedAt.IsZero() {
+		metadata.StreamedAt = video.StreamedAt
+	}
+	return metadata, nil
+}
@@ -4,12 +4,16 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"os"
 	"strconv"
 	"time"
 
 	"github.com/riverqueue/river"
 	"github.com/riverqueue/river/rivertype"
 	"github.com/rs/zerolog/log"
+	"github.com/zibbp/ganymede/ent"
+	"github.com/zibbp/ganymede/ent/livegap"
+	"github.com/zibbp/ganymede/internal/chat"
 	"github.com/zibbp/ganymede/internal/exec"
 	"github.com/zibbp/ganymede/internal/utils"
 )
@@ -246,6 +250,9 @@ func (w ConvertLiveChatWorker) Work(ctx context.Context, job *river.Job[ConvertL
 	if err := utils.EnrichTwitchChatMetadataFromLiveChat(dbItems.Video.TmpLiveChatDownloadPath, dbItems.Video.TmpChatDownloadPath); err != nil {
 		return err
 	}
+	if err := closeLiveChatGaps(ctx, store.Client, dbItems.Video); err != nil {
+		return err
+	}
 
 	next := []transactionalJob{}
 	if job.Args.Continue {
@@ -271,3 +278,33 @@ func (w ConvertLiveChatWorker) Work(ctx context.Context, job *river.Job[ConvertL
 
 	return nil
 }
+
+// closeLiveChatGaps moves the comments of the converted chat to their position in the archive, which doesn't
+// include the parts of the stream missed while the recording reconnected.
+func closeLiveChatGaps(ctx context.Context, client *ent.Client, video ent.Vod) error {
+	liveGaps, err := client.LiveGap.Query().Where(livegap.VodID(video.ID)).All(ctx)
+	if err != nil {
+		return err
+	}
+	if len(liveGaps) == 0 {
+		return nil
+	}
+
+	gaps := make([]chat.Gap, 0, len(liveGaps))
+	for _, gap := range liveGaps {
+		gaps = append(gaps, chat.Gap{Offset: gap.Offset, Duration: gap.EndedAt.Sub(gap.StartedAt).Seconds()})
+	}
+	data, err := os.ReadFile(video.TmpChatDownloadPath)
+	if err != nil {
+		return fmt.Errorf("error reading chat: %w", err)
+	}
+	closed, err := chat.CloseGaps(data, gaps)
+	if err != nil {
+		return err
+	}
+	if err := os.WriteFile(video.TmpChatDownloadPath, closed, 0644); err != nil {
+		return fmt.Errorf("error writing chat: %w", err)
+	}
+	log.Info().Str("video_id", video.ID.String()).Int("gaps", len(gaps)).Msg("closed gaps of live chat")
+	return nil
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/riverqueue/river"
 	"github.com/riverqueue/river/rivertype"
 	"github.com/rs/zerolog/log"
+	"github.com/zibbp/ganymede/ent"
 	"github.com/zibbp/ganymede/internal/exec"
 	"github.com/zibbp/ganymede/internal/utils"
 )
@@ -90,7 +91,35 @@ func (w DownloadLiveVideoWorker) Work(ctx context.Context, job *river.Job[Downlo
 	// Note: even when download fails unexpectedly, continue with finalization steps
 	// (cancel live chat, mark channel not live, enqueue post-process) so partial archive
 	// can still be completed/moved instead of being left in a stuck state.
-	downloadErr := exec.DownloadTwitchLiveVideo(ctx, dbItems.Video, dbItems.Channel, startChatDownload)
+	// record quality switches and gaps of the archive
+	events := exec.LiveArchiveEvents{
+		QualitySwitch: func(qualitySwitch utils.QualitySwitch) {
+			err := store.Client.Vod.UpdateOneID(dbItems.Video.ID).AppendQualitySwitches([]utils.QualitySwitch{qualitySwitch}).Exec(ctx)
+			if err != nil {
+				log.Error().Err(err).Str("video_id", dbItems.Video.ID.String()).Msg("failed to record quality switch")
+			}
+		},
+		Gap: func(gap ent.LiveGap) {
+			err := store.Client.LiveGap.Create().SetVodID(gap.VodID).SetReason(gap.Reason).SetStartedAt(gap.StartedAt).SetEndedAt(gap.EndedAt).SetOffset(gap.Offset).Exec(ctx)
+			if err != nil {
+				log.Error().Err(err).Str("video_id", dbItems.Video.ID.String()).Msg("failed to record live gap")
+			}
+		},
+	}
+
+	var downloadErr error
+	switch dbItems.Video.Platform {
+	case utils.PlatformYoutube:
+		downloadErr = exec.DownloadYoutubeLiveVideo(ctx, dbItems.Video, dbItems.Channel, startChatDownload, events)
+	case utils.PlatformKick:
+		if kick, kickErr := KickFromContext(ctx); kickErr != nil {
+			downloadErr = kickErr
+		} else {
+			downloadErr = exec.DownloadKickLiveVideo(ctx, kick, dbItems.Video, dbItems.Channel, startChatDownload, events)
+		}
+	default:
+		downloadErr = exec.DownloadTwitchLiveVideo(ctx, dbItems.Video, dbItems.Channel, startChatDownload, events)
+	}
 	remotelyCancelled := false
 	if downloadErr != nil {
 		if errors.Is(downloadErr, context.Canceled) {
@@ -136,10 +165,16 @@ func (w DownloadLiveVideoWorker) Work(ctx context.Context, job *river.Job[Downlo
 		}
 	}
 
-	// mark channel as not live
-	if err := setWatchChannelAsNotLive(ctx, store, dbItems.Channel.ID); err != nil {
+	// mark channel as not live unless the next part of the live stream is being archived
+	otherLiveArchive, err := channelHasOtherLiveArchive(ctx, store, dbItems.Channel.ID, dbItems.Video.ID)
+	if err != nil {
 		return err
 	}
+	if !otherLiveArchive {
+		if err := setWatchChannelAsNotLive(ctx, store, dbItems.Channel.ID); err != nil {
+			return err
+		}
+	}
 
 	next := []transactionalJob{}
 	if job.Args.Continue {
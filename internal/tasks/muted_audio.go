@@ -0,0 +1,126 @@
+package tasks
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/riverqueue/river"
+	"github.com/rs/zerolog/log"
+	"github.com/zibbp/ganymede/ent"
+	"github.com/zibbp/ganymede/internal/checksum"
+	"github.com/zibbp/ganymede/internal/config"
+	"github.com/zibbp/ganymede/internal/mutedaudio"
+	"github.com/zibbp/ganymede/internal/storage"
+	"github.com/zibbp/ganymede/internal/utils"
+)
+
+// RecoverMutedAudioArgs recovers the audio of the muted segments of one VOD from the live archive of the
+// same stream when VideoID is set, or enqueues every VOD with muted segments that wasn't paired yet when it is nil.
+// Offset overrides the estimated position in the VOD where the live archive starts.
+type RecoverMutedAudioArgs struct {
+	VideoID *uuid.UUID `json:"video_id,omitempty" river:"unique"`
+	Offset  *float64   `json:"offset,omitempty" river:"unique"`
+}
+
+func (RecoverMutedAudioArgs) Kind() string { return TaskRecoverMutedAudio }
+
+func (RecoverMutedAudioArgs) InsertOpts() river.InsertOpts {
+	return river.InsertOpts{
+		MaxAttempts: 2,
+		UniqueOpts:  archiveUniqueOpts(),
+	}
+}
+
+func (w *RecoverMutedAudioWorker) Timeout(job *river.Job[RecoverMutedAudioArgs]) time.Duration {
+	return 6 * time.Hour
+}
+
+type RecoverMutedAudioWorker struct {
+	river.WorkerDefaults[RecoverMutedAudioArgs]
+}
+
+func (w RecoverMutedAudioWorker) Work(ctx context.Context, job *river.Job[RecoverMutedAudioArgs]) error {
+	logger := log.With().Str("task", job.Kind).Int64("job_id", job.ID).Logger()
+
+	store, err := StoreFromContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	if job.Args.VideoID == nil {
+		enqueuer, err := EnqueuerFromContext(ctx)
+		if err != nil {
+			return err
+		}
+		videos, err := mutedaudio.Candidates(ctx, store.Client)
+		if err != nil {
+			return fmt.Errorf("fetch videos with muted segments: %w", err)
+		}
+		for _, id := range videos {
+			if _, err := enqueuer.Insert(ctx, RecoverMutedAudioArgs{VideoID: &id}, nil); err != nil {
+				return fmt.Errorf("enqueue muted audio recovery for video %s: %w", id, err)
+			}
+		}
+		logger.Info().Int("videos", len(videos)).Msg("enqueued muted audio recovery")
+		return nil
+	}
+
+	video, err := store.Client.Vod.Get(ctx, *job.Args.VideoID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			logger.Warn().Str("video_id", job.Args.VideoID.String()).Msg("video not found; skipping muted audio recovery")
+			return nil
+		}
+		return fmt.Errorf("fetch video %s for muted audio recovery: %w", job.Args.VideoID, err)
+	}
+	if video.Processing || video.Type != utils.Archive {
+		logger.Debug().Str("video_id", video.ID.String()).Msg("video is processing or not a VOD; skipping muted audio recovery")
+		return nil
+	}
+
+	live, err := mutedaudio.FindLiveArchive(ctx, store.Client, video)
+	if err != nil {
+		return err
+	}
+	if live == nil {
+		logger.Debug().Str("video_id", video.ID.String()).Msg("stream was not archived live; skipping muted audio recovery")
+		return nil
+	}
+	enqueuer, err := EnqueuerFromContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	offset := mutedaudio.Offset(video, live)
+	if job.Args.Offset != nil {
+		offset = *job.Args.Offset
+	}
+
+	result, err := mutedaudio.Recover(ctx, store, storage.Get(), video, live, offset, config.GetEnvConfig().TempDir)
+	if err != nil {
+		return fmt.Errorf("recover muted audio of video %s: %w", video.ID, err)
+	}
+
+	if result.Replaced {
+		if _, err := enqueuer.Insert(ctx, &UpdateVideoStorageUsage{VideoID: &video.ID}, nil); err != nil {
+			return fmt.Errorf("enqueue storage usage update for video %s: %w", video.ID, err)
+		}
+		if config.Get().Checksums.Enabled {
+			if _, err := enqueuer.Insert(ctx, RecordChecksumsArgs{VideoID: &video.ID, Files: checksum.VideoFiles}, nil); err != nil {
+				return fmt.Errorf("enqueue checksums for video %s: %w", video.ID, err)
+			}
+		}
+	}
+
+	// the versions are compared with the recovered audio
+	if _, err := enqueuer.Insert(ctx, ReconcileStreamVersionsArgs{VideoID: &video.ID}, nil); err != nil {
+		return fmt.Errorf("enqueue stream version reconciliation for video %s: %w", video.ID, err)
+	}
+
+	logger.Info().Str("video_id", video.ID.String()).Str("live_archive_id", live.ID.String()).Float64("offset", offset).
+		Int("segments", result.Segments).Int("recovered", result.Recovered).Bool("replaced", result.Replaced).
+		Msg("recovered muted audio")
+	return nil
+}
@@ -464,14 +464,13 @@ func (w UpdateTwitchChannelsWorker) Work(ctx context.Context, job *river.Job[Upd
 		return err
 	}
 
-	channelService := channel.NewService(store, platform)
+	channelService := channel.NewService(store, platform, nil, nil)
 	env := config.GetEnvConfig()
 
 	logger.Info().Msgf("updating %d channels", len(channels))
 
-	// TODO: if multi-platform is ever supported a platform field will need to be checked here
 	for _, c := range channels {
-		if c.ExtID == "" {
+		if c.ExtID == "" || c.Platform != utils.PlatformTwitch {
 			continue
 		}
 
@@ -0,0 +1,237 @@
+package tasks
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/riverqueue/river"
+	"github.com/rs/zerolog/log"
+	"github.com/zibbp/ganymede/ent"
+	"github.com/zibbp/ganymede/internal/checksum"
+	"github.com/zibbp/ganymede/internal/config"
+	"github.com/zibbp/ganymede/internal/exec"
+	"github.com/zibbp/ganymede/internal/reencode"
+	"github.com/zibbp/ganymede/internal/storage"
+	"github.com/zibbp/ganymede/internal/utils"
+)
+
+// ReencodeVideosArgs re-encodes the archived videos matching the filter with the transcoding profile. Every
+// matching video gets a ReencodeVideoArgs job which shows in the queue like an archive.
+type ReencodeVideosArgs struct {
+	Filter               reencode.Filter `json:"filter"`
+	TranscodingProfileID uuid.UUID       `json:"transcoding_profile_id"`
+}
+
+func (ReencodeVideosArgs) Kind() string { return TaskReencodeVideos }
+
+func (ReencodeVideosArgs) InsertOpts() river.InsertOpts {
+	return river.InsertOpts{
+		MaxAttempts: 1,
+	}
+}
+
+func (w *ReencodeVideosWorker) Timeout(job *river.Job[ReencodeVideosArgs]) time.Duration {
+	return time.Hour
+}
+
+type ReencodeVideosWorker struct {
+	river.WorkerDefaults[ReencodeVideosArgs]
+}
+
+func (w ReencodeVideosWorker) Work(ctx context.Context, job *river.Job[ReencodeVideosArgs]) error {
+	logger := log.With().Str("task", job.Kind).Int64("job_id", job.ID).Logger()
+
+	store, err := StoreFromContext(ctx)
+	if err != nil {
+		return err
+	}
+	enqueuer, err := EnqueuerFromContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	if _, err := store.Client.TranscodingProfile.Get(ctx, job.Args.TranscodingProfileID); err != nil {
+		if ent.IsNotFound(err) {
+			logger.Warn().Str("transcoding_profile_id", job.Args.TranscodingProfileID.String()).Msg("transcoding profile not found; skipping")
+			return nil
+		}
+		return fmt.Errorf("fetch transcoding profile: %w", err)
+	}
+
+	videos, err := reencode.Candidates(ctx, store.Client, job.Args.Filter, time.Now())
+	if err != nil {
+		return fmt.Errorf("fetch videos to re-encode: %w", err)
+	}
+
+	queued := 0
+	for _, video := range videos {
+		if job.Args.Filter.Resolution != "" || job.Args.Filter.Codec != "" {
+			videoURL, err := storage.Get().URL(ctx, video.VideoPath)
+			if err != nil {
+				return err
+			}
+			probe, err := exec.GetFfprobeVideoData(ctx, videoURL)
+			if err != nil {
+				logger.Warn().Err(err).Str("video_id", video.ID.String()).Msg("error probing video; skipping")
+				continue
+			}
+			if !reencode.Matches(job.Args.Filter, probe) {
+				continue
+			}
+		}
+
+		err := store.WithTx(ctx, func(txClient *ent.Client, tx *sql.Tx) error {
+			queueID, err := prepareReencodeQueueItem(ctx, txClient, video)
+			if err != nil {
+				return err
+			}
+			_, err = enqueuer.InsertTx(ctx, tx, ReencodeVideoArgs{
+				Input:                ArchiveVideoInput{QueueId: queueID},
+				TranscodingProfileID: job.Args.TranscodingProfileID,
+			}, nil)
+			return err
+		})
+		if err != nil {
+			return fmt.Errorf("enqueue re-encode of video %s: %w", video.ID, err)
+		}
+		queued++
+	}
+
+	logger.Info().Int("videos", queued).Str("transcoding_profile_id", job.Args.TranscodingProfileID.String()).Msg("enqueued video re-encodes")
+	return nil
+}
+
+// prepareReencodeQueueItem resets the queue item of the video to a pending video convert so the re-encode
+// shows in the queue. Videos without a queue item, such as imported videos, get one.
+func prepareReencodeQueueItem(ctx context.Context, client *ent.Client, video *ent.Vod) (uuid.UUID, error) {
+	if q := video.Edges.Queue; q != nil {
+		err := client.Queue.UpdateOneID(q.ID).
+			SetProcessing(true).
+			SetVideoProcessing(true).
+			SetChatProcessing(false).
+			SetOnHold(false).
+			SetTaskVideoConvert(utils.Pending).
+			Exec(ctx)
+		return q.ID, err
+	}
+	q, err := client.Queue.Create().
+		SetVodID(video.ID).
+		SetVideoProcessing(true).
+		SetChatProcessing(false).
+		SetArchiveChat(false).
+		SetRenderChat(false).
+		SetTaskVodCreateFolder(utils.Success).
+		SetTaskVodDownloadThumbnail(utils.Success).
+		SetTaskVodSaveInfo(utils.Success).
+		SetTaskVideoDownload(utils.Success).
+		SetTaskVideoMove(utils.Success).
+		SetTaskChatDownload(utils.Success).
+		SetTaskChatConvert(utils.Success).
+		SetTaskChatRender(utils.Success).
+		SetTaskChatMove(utils.Success).
+		Save(ctx)
+	if err != nil {
+		return uuid.Nil, err
+	}
+	return q.ID, nil
+}
+
+// ReencodeVideoArgs re-encodes the video of the queue item with the transcoding profile. Progress is reported
+// as the video convert task of the queue item and the FFmpeg output is written to the video convert log.
+type ReencodeVideoArgs struct {
+	Input                ArchiveVideoInput `json:"input"`
+	TranscodingProfileID uuid.UUID         `json:"transcoding_profile_id"`
+}
+
+func (ReencodeVideoArgs) Kind() string { return string(utils.TaskReencodeVideo) }
+
+func (ReencodeVideoArgs) InsertOpts() river.InsertOpts {
+	return river.InsertOpts{
+		MaxAttempts: 2,
+		Queue:       QueueVideoPostProcess,
+		Tags:        []string{"archive"},
+		UniqueOpts:  archiveUniqueOpts(),
+	}
+}
+
+func (w *ReencodeVideoWorker) Timeout(job *river.Job[ReencodeVideoArgs]) time.Duration {
+	return 24 * time.Hour
+}
+
+type ReencodeVideoWorker struct {
+	river.WorkerDefaults[ReencodeVideoArgs]
+}
+
+func (w ReencodeVideoWorker) Work(ctx context.Context, job *river.Job[ReencodeVideoArgs]) error {
+	logger := log.With().Str("task", job.Kind).Int64("job_id", job.ID).Logger()
+
+	store, err := StoreFromContext(ctx)
+	if err != nil {
+		return err
+	}
+	enqueuer, err := EnqueuerFromContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	err = setQueueStatus(ctx, store.Client, QueueStatusInput{
+		Status:  utils.Running,
+		QueueId: job.Args.Input.QueueId,
+		Task:    utils.TaskPostProcessVideo,
+	})
+	if err != nil {
+		return err
+	}
+
+	dbItems, err := getDatabaseItems(ctx, store.Client, job.Args.Input.QueueId)
+	if err != nil {
+		return err
+	}
+	profile, err := store.Client.TranscodingProfile.Get(ctx, job.Args.TranscodingProfileID)
+	if err != nil {
+		return fmt.Errorf("fetch transcoding profile: %w", err)
+	}
+
+	result, err := reencode.Reencode(ctx, storage.Get(), &dbItems.Video, profile.FfmpegArgs, config.GetEnvConfig().TempDir)
+	if err != nil {
+		return fmt.Errorf("re-encode video %s: %w", dbItems.Video.ID, err)
+	}
+
+	// the storage usage of the channel is updated with the video
+	next := []river.JobArgs{&UpdateVideoStorageUsage{VideoID: &dbItems.Video.ID}}
+	if config.Get().Checksums.Enabled {
+		next = append(next, RecordChecksumsArgs{VideoID: &dbItems.Video.ID, Files: checksum.VideoFiles})
+	}
+
+	err = store.WithTx(ctx, func(txClient *ent.Client, tx *sql.Tx) error {
+		if err := txClient.Vod.UpdateOneID(dbItems.Video.ID).
+			SetResolution(result.Resolution).
+			SetTranscodingProfileID(profile.ID).
+			Exec(ctx); err != nil {
+			return err
+		}
+		if err := txClient.Queue.UpdateOneID(dbItems.Queue.ID).
+			SetTaskVideoConvert(utils.Success).
+			SetVideoProcessing(false).
+			SetProcessing(false).
+			Exec(ctx); err != nil {
+			return err
+		}
+		for _, args := range next {
+			if _, err := enqueuer.InsertTx(ctx, tx, args, nil); err != nil {
+				return fmt.Errorf("enqueue %s: %w", args.Kind(), err)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("update video %s: %w", dbItems.Video.ID, err)
+	}
+
+	logger.Info().Str("video_id", dbItems.Video.ID.String()).Str("transcoding_profile", profile.Name).
+		Str("resolution", result.Resolution).Float64("duration", result.Duration).Msg("re-encoded video")
+	return nil
+}
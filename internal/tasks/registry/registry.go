@@ -49,6 +49,18 @@ func New() (*river.Workers, error) {
 		func() error { return river.AddWorkerSafely(workers, &tasks_periodic.UpdateTwitchChannelsWorker{}) },
 		func() error { return river.AddWorkerSafely(workers, &tasks_periodic.PruneLogFilesWorker{}) },
 		func() error { return river.AddWorkerSafely(workers, &tasks.GenerateNFOFilesWorker{}) },
+		func() error { return river.AddWorkerSafely(workers, &tasks.IndexChatWorker{}) },
+		func() error { return river.AddWorkerSafely(workers, &tasks.GenerateCaptionsWorker{}) },
+		func() error { return river.AddWorkerSafely(workers, &tasks.MoveVideoStorageTierWorker{}) },
+		func() error { return river.AddWorkerSafely(workers, &tasks.ImportVideoWorker{}) },
+		func() error { return river.AddWorkerSafely(workers, &tasks.AuditLibraryWorker{}) },
+		func() error { return river.AddWorkerSafely(workers, &tasks.RecordChecksumsWorker{}) },
+		func() error { return river.AddWorkerSafely(workers, &tasks.VerifyChecksumsWorker{}) },
+		func() error { return river.AddWorkerSafely(workers, &tasks.RecoverMutedAudioWorker{}) },
+		func() error { return river.AddWorkerSafely(workers, &tasks.ReconcileStreamVersionsWorker{}) },
+		func() error { return river.AddWorkerSafely(workers, &tasks.CreateClipWorker{}) },
+		func() error { return river.AddWorkerSafely(workers, &tasks.ReencodeVideosWorker{}) },
+		func() error { return river.AddWorkerSafely(workers, &tasks.ReencodeVideoWorker{}) },
 	}
 
 	for _, register := range registrations {
@@ -54,9 +54,21 @@ func TestEveryWorkerHasItsConfiguredTimeout(t *testing.T) {
 		{"playlist rules", (&tasks_periodic.ProcessPlaylistVideoRulesWorker{}).Timeout(nil), 5 * time.Minute},
 		{"update channels", (&tasks_periodic.UpdateTwitchChannelsWorker{}).Timeout(nil), time.Minute},
 		{"prune logs", (&tasks_periodic.PruneLogFilesWorker{}).Timeout(nil), 10 * time.Minute},
+		{"index chat", (&tasks.IndexChatWorker{}).Timeout(nil), 30 * time.Minute},
+		{"generate captions", (&tasks.GenerateCaptionsWorker{}).Timeout(nil), 12 * time.Hour},
+		{"move video storage tier", (&tasks.MoveVideoStorageTierWorker{}).Timeout(nil), 24 * time.Hour},
+		{"import video", (&tasks.ImportVideoWorker{}).Timeout(nil), 10 * time.Minute},
+		{"audit library", (&tasks.AuditLibraryWorker{}).Timeout(nil), 10 * time.Minute},
+		{"record checksums", (&tasks.RecordChecksumsWorker{}).Timeout(nil), 2 * time.Hour},
+		{"verify checksums", (&tasks.VerifyChecksumsWorker{}).Timeout(nil), 12 * time.Hour},
+		{"recover muted audio", (&tasks.RecoverMutedAudioWorker{}).Timeout(nil), 6 * time.Hour},
+		{"reconcile stream versions", (&tasks.ReconcileStreamVersionsWorker{}).Timeout(nil), time.Hour},
+		{"create clip", (&tasks.CreateClipWorker{}).Timeout(nil), 2 * time.Hour},
+		{"reencode videos", (&tasks.ReencodeVideosWorker{}).Timeout(nil), time.Hour},
+		{"reencode video", (&tasks.ReencodeVideoWorker{}).Timeout(nil), 24 * time.Hour},
 	}
 
-	require.Len(t, tests, 31)
+	require.Len(t, tests, 43)
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
 			require.Equal(t, test.want, test.got)
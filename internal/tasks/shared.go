@@ -6,6 +6,7 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"os"
 	"path/filepath"
 	"strings"
 	"time"
@@ -25,6 +26,7 @@ import (
 	"github.com/zibbp/ganymede/internal/database"
 	"github.com/zibbp/ganymede/internal/notification"
 	"github.com/zibbp/ganymede/internal/platform"
+	"github.com/zibbp/ganymede/internal/storage"
 
 	tasks_shared "github.com/zibbp/ganymede/internal/tasks/shared"
 	"github.com/zibbp/ganymede/internal/utils"
@@ -58,6 +60,17 @@ var (
 	TaskUpdateTwitchChannels        = "update_twitch_channels"
 	TaskPruneLogFiles               = "prune_log_files"
 	TaskGenerateNFOFiles            = "generate_nfo_files"
+	TaskIndexChat                   = "index_chat"
+	TaskGenerateCaptions            = "generate_captions"
+	TaskMoveVideoStorageTier        = "move_video_storage_tier"
+	TaskImportVideo                 = "import_video"
+	TaskAuditLibrary                = "audit_library"
+	TaskRecordChecksums             = "record_checksums"
+	TaskVerifyChecksums             = "verify_checksums"
+	TaskRecoverMutedAudio           = "recover_muted_audio"
+	TaskReconcileStreamVersions     = "reconcile_stream_versions"
+	TaskCreateClip                  = "create_clip"
+	TaskReencodeVideos              = "reencode_videos"
 )
 
 var (
@@ -66,6 +79,7 @@ var (
 	QueueChatDownload             = "chat-download"
 	QueueChatRender               = "chat-render"
 	QueueGenerateThumbnailSprites = "generate-thumbnail-sprites"
+	QueueStorageTier              = "storage-tier"
 )
 
 type ArchiveVideoInput struct {
@@ -108,6 +122,25 @@ type transactionalJob struct {
 	Opts *river.InsertOpts
 }
 
+// saveFileToStorage calls write with a temporary file path and saves the written file to path in the archive storage.
+func saveFileToStorage(ctx context.Context, path string, write func(tmpPath string) error) error {
+	tmpDirectory, err := os.MkdirTemp(config.GetEnvConfig().TempDir, "storage")
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := os.RemoveAll(tmpDirectory); err != nil {
+			log.Warn().Err(err).Str("path", tmpDirectory).Msg("failed to delete temporary directory")
+		}
+	}()
+
+	tmpPath := filepath.Join(tmpDirectory, filepath.Base(path))
+	if err := write(tmpPath); err != nil {
+		return err
+	}
+	return storage.Get().Save(ctx, tmpPath, path)
+}
+
 func StoreFromContext(ctx context.Context) (*database.Database, error) {
 	store, exists := ctx.Value(tasks_shared.StoreKey).(*database.Database)
 	if !exists || store == nil {
@@ -127,6 +160,23 @@ func PlatformFromContext(ctx context.Context) (platform.Platform, error) {
 	return platform, nil
 }
 
+// VideoPlatformFromContext returns the platform connection for the video platform. An empty platform defaults to Twitch.
+func VideoPlatformFromContext(ctx context.Context, videoPlatform utils.VideoPlatform) (platform.Platform, error) {
+	twitch, _ := ctx.Value(tasks_shared.PlatformTwitchKey).(platform.Platform)
+	youtube, _ := ctx.Value(tasks_shared.PlatformYoutubeKey).(platform.Platform)
+	kick, _ := ctx.Value(tasks_shared.PlatformKickKey).(platform.Platform)
+	return platform.Resolve(videoPlatform, twitch, youtube, kick)
+}
+
+// KickFromContext returns the Kick connection. The connection is shared by all tasks to space out requests to the Kick API.
+func KickFromContext(ctx context.Context) (*platform.KickConnection, error) {
+	kick, exists := ctx.Value(tasks_shared.PlatformKickKey).(*platform.KickConnection)
+	if !exists || kick == nil {
+		return nil, errors.New("kick platform not found in context")
+	}
+	return kick, nil
+}
+
 func NotificationServiceFromContext(ctx context.Context) (*notification.Service, error) {
 	svc, exists := ctx.Value(tasks_shared.NotificationServiceKey).(*notification.Service)
 	if !exists || svc == nil {
@@ -145,7 +195,9 @@ func EnqueuerFromContext(ctx context.Context) (tasks_shared.Enqueuer, error) {
 
 // getDatabaseItems retrieves the database items associated with the provided queueId. This is used instead of passing all the structs to each job so that they can be easily updated in the database.
 func getDatabaseItems(ctx context.Context, entClient *ent.Client, queueId uuid.UUID) (*GetDatabaseItemsResponse, error) {
-	queue, err := entClient.Queue.Query().Where(queue.ID(queueId)).WithVod().Only(ctx)
+	queue, err := entClient.Queue.Query().Where(queue.ID(queueId)).WithVod(func(vq *ent.VodQuery) {
+		vq.WithTranscodingProfile()
+	}).Only(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -280,6 +332,16 @@ func checkIfTasksAreDone(ctx context.Context, entClient *ent.Client, input Archi
 				return err
 			}
 		}
+		// muted audio recovery reconciles the versions of the stream once the audio was recovered
+		if config.Get().Archive.RecoverMutedAudio && dbItems.Video.Type == utils.Archive && dbItems.Video.Platform == utils.PlatformTwitch {
+			if _, err := enqueuer.InsertTx(ctx, tx, RecoverMutedAudioArgs{VideoID: &dbItems.Video.ID}, nil); err != nil {
+				return err
+			}
+		} else if dbItems.Video.Type == utils.Archive || dbItems.Video.Type == utils.Live {
+			if _, err := enqueuer.InsertTx(ctx, tx, ReconcileStreamVersionsArgs{VideoID: &dbItems.Video.ID}, nil); err != nil {
+				return err
+			}
+		}
 		return nil
 	}); err != nil {
 		return err
@@ -542,6 +604,17 @@ func shouldFinalizeArchiveError(ctx context.Context, job *rivertype.JobRow, err
 	return job.Attempt >= job.MaxAttempts
 }
 
+// channelHasOtherLiveArchive returns whether another video of the channel is being archived live, e.g. the
+// next part of a live stream archive that was split into parts.
+func channelHasOtherLiveArchive(ctx context.Context, store *database.Database, channelId uuid.UUID, videoId uuid.UUID) (bool, error) {
+	return store.Client.Queue.Query().Where(
+		queue.LiveArchive(true),
+		queue.Processing(true),
+		queue.TaskVideoDownloadIn(utils.Pending, utils.Running),
+		queue.HasVodWith(entVod.IDNEQ(videoId), entVod.HasChannelWith(entChannel.ID(channelId))),
+	).Exist(ctx)
+}
+
 // setWatchChannelAsNotLive marks the watched channel as not live
 func setWatchChannelAsNotLive(ctx context.Context, store *database.Database, channelId uuid.UUID) error {
 	watchedChannel, err := store.Client.Live.Query().Where(entLive.HasChannelWith(entChannel.ID(channelId))).Only(ctx)
@@ -12,6 +12,8 @@ type contextKey string
 
 const StoreKey contextKey = "store"
 const PlatformTwitchKey contextKey = "platform_twitch"
+const PlatformYoutubeKey contextKey = "platform_youtube"
+const PlatformKickKey contextKey = "platform_kick"
 const LiveServiceKey contextKey = "live_service"
 const NotificationServiceKey contextKey = "notification_service"
 const EnqueuerKey contextKey = "enqueuer"
@@ -0,0 +1,156 @@
+package tasks
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/riverqueue/river"
+	"github.com/rs/zerolog/log"
+	"github.com/zibbp/ganymede/ent"
+	entVod "github.com/zibbp/ganymede/ent/vod"
+	"github.com/zibbp/ganymede/internal/checksum"
+	"github.com/zibbp/ganymede/internal/config"
+	"github.com/zibbp/ganymede/internal/storage"
+	"github.com/zibbp/ganymede/internal/streamversions"
+	"github.com/zibbp/ganymede/internal/utils"
+	vods_utility "github.com/zibbp/ganymede/internal/vod/utility"
+)
+
+// ReconcileStreamVersionsArgs applies the stream version policy to the versions of the stream of one video
+// when VideoID is set, or enqueues every stream archived more than once when it is nil. Policy overrides
+// the configured policy.
+type ReconcileStreamVersionsArgs struct {
+	VideoID *uuid.UUID                `json:"video_id,omitempty" river:"unique"`
+	Policy  utils.StreamVersionPolicy `json:"policy,omitempty" river:"unique"`
+}
+
+func (ReconcileStreamVersionsArgs) Kind() string { return TaskReconcileStreamVersions }
+
+func (ReconcileStreamVersionsArgs) InsertOpts() river.InsertOpts {
+	return river.InsertOpts{
+		MaxAttempts: 2,
+		UniqueOpts:  archiveUniqueOpts(),
+	}
+}
+
+func (w *ReconcileStreamVersionsWorker) Timeout(job *river.Job[ReconcileStreamVersionsArgs]) time.Duration {
+	return time.Hour
+}
+
+type ReconcileStreamVersionsWorker struct {
+	river.WorkerDefaults[ReconcileStreamVersionsArgs]
+}
+
+func (w ReconcileStreamVersionsWorker) Work(ctx context.Context, job *river.Job[ReconcileStreamVersionsArgs]) error {
+	logger := log.With().Str("task", job.Kind).Int64("job_id", job.ID).Logger()
+
+	store, err := StoreFromContext(ctx)
+	if err != nil {
+		return err
+	}
+	enqueuer, err := EnqueuerFromContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	if job.Args.VideoID == nil {
+		videos, err := streamversions.Candidates(ctx, store.Client)
+		if err != nil {
+			return fmt.Errorf("fetch videos with stream versions: %w", err)
+		}
+		groups := streamversions.Groups(videos)
+		for _, group := range groups {
+			if _, err := enqueuer.Insert(ctx, ReconcileStreamVersionsArgs{VideoID: &group[0].ID, Policy: job.Args.Policy}, nil); err != nil {
+				return fmt.Errorf("enqueue stream version reconciliation for video %s: %w", group[0].ID, err)
+			}
+		}
+		logger.Info().Int("streams", len(groups)).Msg("enqueued stream version reconciliation")
+		return nil
+	}
+
+	video, err := store.Client.Vod.Get(ctx, *job.Args.VideoID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			logger.Warn().Str("video_id", job.Args.VideoID.String()).Msg("video not found; skipping stream version reconciliation")
+			return nil
+		}
+		return fmt.Errorf("fetch video %s for stream version reconciliation: %w", job.Args.VideoID, err)
+	}
+	videos, err := streamversions.Find(ctx, store.Client, video)
+	if err != nil {
+		return err
+	}
+	if len(videos) < 2 {
+		logger.Debug().Str("video_id", video.ID.String()).Msg("stream has one version; skipping stream version reconciliation")
+		return nil
+	}
+	for _, v := range videos {
+		// the other version may still be downloading
+		if v.Processing {
+			logger.Debug().Str("video_id", v.ID.String()).Msg("version is processing; skipping stream version reconciliation")
+			return nil
+		}
+	}
+
+	policy := job.Args.Policy
+	if policy == "" {
+		policy = config.Get().Archive.StreamVersionPolicy
+	}
+	decision := streamversions.Decide(policy, streamversions.Evaluate(videos))
+
+	if decision.ChatFrom != nil {
+		if _, err := streamversions.TransferChat(ctx, store, storage.Get(), decision.ChatFrom, decision.Keep, decision.Offset, decision.Gaps, config.GetEnvConfig().TempDir); err != nil {
+			return fmt.Errorf("transfer chat of video %s to video %s: %w", decision.ChatFrom.ID, decision.Keep.ID, err)
+		}
+		if _, err := enqueuer.Insert(ctx, IndexChatArgs{VideoID: &decision.Keep.ID}, nil); err != nil {
+			return fmt.Errorf("enqueue chat index for video %s: %w", decision.Keep.ID, err)
+		}
+		if config.Get().Checksums.Enabled {
+			if _, err := enqueuer.Insert(ctx, RecordChecksumsArgs{VideoID: &decision.Keep.ID, Files: checksum.ChatFiles}, nil); err != nil {
+				return fmt.Errorf("enqueue checksums for video %s: %w", decision.Keep.ID, err)
+			}
+		}
+	}
+
+	deleted := make([]uuid.UUID, 0, len(decision.Delete))
+	for _, v := range decision.Delete {
+		deleted = append(deleted, v.ID)
+	}
+	err = store.WithTx(ctx, func(txClient *ent.Client, _ *sql.Tx) error {
+		if _, err := txClient.Vod.UpdateOneID(decision.Keep.ID).SetAuthoritative(true).Save(ctx); err != nil {
+			return err
+		}
+		for _, v := range videos {
+			if v.ID == decision.Keep.ID {
+				continue
+			}
+			if _, err := txClient.Vod.UpdateOneID(v.ID).SetAuthoritative(false).Save(ctx); err != nil {
+				return err
+			}
+		}
+		if len(deleted) > 0 {
+			// the muted audio of the kept version can't link to a deleted version
+			if _, err := txClient.Vod.Update().Where(entVod.AudioSourceIDIn(deleted...)).ClearAudioSourceID().ClearAudioSourceOffset().Save(ctx); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("update versions of video %s: %w", video.ID, err)
+	}
+
+	for _, id := range deleted {
+		if err := vods_utility.DeleteVod(ctx, store, id, true); err != nil {
+			return fmt.Errorf("delete version %s: %w", id, err)
+		}
+	}
+
+	logger.Info().Str("video_id", video.ID.String()).Str("policy", string(policy)).Str("kept", decision.Keep.ID.String()).
+		Int("deleted", len(deleted)).Bool("chat_transferred", decision.ChatFrom != nil).
+		Msg("reconciled stream versions")
+	return nil
+}
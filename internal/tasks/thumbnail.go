@@ -11,8 +11,10 @@ import (
 	"github.com/google/uuid"
 	"github.com/riverqueue/river"
 	"github.com/rs/zerolog/log"
+	"github.com/zibbp/ganymede/internal/checksum"
 	"github.com/zibbp/ganymede/internal/config"
 	"github.com/zibbp/ganymede/internal/exec"
+	"github.com/zibbp/ganymede/internal/storage"
 	"github.com/zibbp/ganymede/internal/storagetemplate"
 )
 
@@ -53,21 +55,41 @@ func (w GenerateStaticThubmnailWorker) Work(ctx context.Context, job *river.Job[
 		return err
 	}
 
+	videoURL, err := storage.Get().URL(ctx, video.VideoPath)
+	if err != nil {
+		return err
+	}
+
 	// get random time
 	time := rand.Intn(video.Duration)
 
 	// generate full-res thumbnail
-	err = exec.GenerateStaticThumbnail(ctx, video.VideoPath, time, video.ThumbnailPath, "")
+	err = saveFileToStorage(ctx, video.ThumbnailPath, func(tmpPath string) error {
+		return exec.GenerateStaticThumbnail(ctx, videoURL, time, tmpPath, "")
+	})
 	if err != nil {
 		return err
 	}
 
 	// generate webp thumbnail
-	err = exec.GenerateStaticThumbnail(ctx, video.VideoPath, time, video.WebThumbnailPath, "640x360")
+	err = saveFileToStorage(ctx, video.WebThumbnailPath, func(tmpPath string) error {
+		return exec.GenerateStaticThumbnail(ctx, videoURL, time, tmpPath, "640x360")
+	})
 	if err != nil {
 		return err
 	}
 
+	// the replaced thumbnails no longer match their checksums
+	if config.Get().Checksums.Enabled {
+		enqueuer, err := EnqueuerFromContext(ctx)
+		if err != nil {
+			return err
+		}
+		if _, err := enqueuer.Insert(ctx, RecordChecksumsArgs{VideoID: &video.ID, Files: checksum.ThumbnailFiles}, nil); err != nil {
+			return fmt.Errorf("error queueing thumbnail checksums: %w", err)
+		}
+	}
+
 	return nil
 }
 
@@ -147,7 +169,18 @@ func (w GenerateSpriteThumbnailWorker) Work(ctx context.Context, job *river.Job[
 	}
 	spritesDirectory := fmt.Sprintf("%s/sprites", rootVideoPath)
 
-	err = os.MkdirAll(spritesDirectory, os.ModePerm)
+	// sprites are created in a temporary directory and saved to the archive storage when done
+	tmpSpritesDirectory, err := os.MkdirTemp(env.TempDir, fmt.Sprintf("%s-sprites", video.ID))
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := os.RemoveAll(tmpSpritesDirectory); err != nil {
+			logger.Warn().Err(err).Str("path", tmpSpritesDirectory).Msg("failed to delete temporary sprites directory")
+		}
+	}()
+
+	videoURL, err := storage.Get().URL(ctx, video.VideoPath)
 	if err != nil {
 		return err
 	}
@@ -173,7 +206,7 @@ func (w GenerateSpriteThumbnailWorker) Work(ctx context.Context, job *river.Job[
 
 	// Create thumbnails
 	generateThumbnailsConfig := exec.GenerateThumbnailsInput{
-		Video:        video.VideoPath,
+		Video:        videoURL,
 		Duration:     video.Duration,
 		ThumbnailDir: tmpThumbnailsDirectory,
 		Interval:     thumbnailInterval,
@@ -187,18 +220,26 @@ func (w GenerateSpriteThumbnailWorker) Work(ctx context.Context, job *river.Job[
 
 	// Create sprites with thumbnails
 	createSpritesConfig := exec.CreateSpritesInput{
-		SpriteDir:    spritesDirectory,
+		SpriteDir:    tmpSpritesDirectory,
 		ThumbnailDir: tmpThumbnailsDirectory,
 		Width:        thumbnailWidth,
 		Height:       thumbnailHeight,
 		TilesX:       spriteTilesX,
 		TilesY:       spriteTilesY,
 	}
-	spritePaths, err := exec.CreateSprites(createSpritesConfig)
+	tmpSpritePaths, err := exec.CreateSprites(createSpritesConfig)
 	if err != nil {
 		return fmt.Errorf("error generating sprites: %v", err)
 	}
 
+	if err := storage.Get().SaveDirectory(ctx, tmpSpritesDirectory, spritesDirectory); err != nil {
+		return fmt.Errorf("error saving sprites: %v", err)
+	}
+	spritePaths := make([]string, len(tmpSpritePaths))
+	for i, tmpSpritePath := range tmpSpritePaths {
+		spritePaths[i] = filepath.Join(spritesDirectory, filepath.Base(tmpSpritePath))
+	}
+
 	// Enable sprite thumbnails for video
 	_, err = video.Update().SetSpriteThumbnailsEnabled(true).SetSpriteThumbnailsImages(spritePaths).SetSpriteThumbnailsInterval(thumbnailInterval).SetSpriteThumbnailsRows(spriteTilesY).SetSpriteThumbnailsColumns(spriteTilesX).SetSpriteThumbnailsWidth(thumbnailWidth).SetSpriteThumbnailsHeight(thumbnailHeight).Save(ctx)
 	if err != nil {
@@ -8,9 +8,14 @@ import (
 
 	"github.com/riverqueue/river"
 	"github.com/rs/zerolog/log"
+	"github.com/zibbp/ganymede/ent"
+	"github.com/zibbp/ganymede/ent/channel"
+	"github.com/zibbp/ganymede/ent/live"
+	"github.com/zibbp/ganymede/internal/checksum"
 	"github.com/zibbp/ganymede/internal/config"
 	"github.com/zibbp/ganymede/internal/exec"
 	"github.com/zibbp/ganymede/internal/hls"
+	"github.com/zibbp/ganymede/internal/storage"
 	"github.com/zibbp/ganymede/internal/utils"
 )
 
@@ -63,7 +68,18 @@ func (w DownloadVideoWorker) Work(ctx context.Context, job *river.Job[DownloadVi
 	}
 
 	// download video
-	err = exec.DownloadTwitchVideo(ctx, dbItems.Video)
+	switch dbItems.Video.Platform {
+	case utils.PlatformYoutube:
+		err = exec.DownloadYoutubeVideo(ctx, dbItems.Video)
+	case utils.PlatformKick:
+		if kick, kickErr := KickFromContext(ctx); kickErr != nil {
+			err = kickErr
+		} else {
+			err = exec.DownloadKickVideo(ctx, kick, dbItems.Video)
+		}
+	default:
+		err = exec.DownloadTwitchVideo(ctx, dbItems.Video)
+	}
 	if err != nil {
 		return err
 	}
@@ -168,6 +184,10 @@ func (w PostProcessVideoWorker) Work(ctx context.Context, job *river.Job[PostPro
 					return err
 				}
 			} else {
+				// stitch the parts of an archive resumed after gaps
+				if err := exec.StitchLiveArchive(ctx, dbItems.Video.TmpVideoDownloadPath); err != nil {
+					return err
+				}
 				if err := validateNonEmptyFile(dbItems.Video.TmpVideoDownloadPath, "live downloaded video input"); err != nil {
 					return err
 				}
@@ -319,6 +339,23 @@ func (args MoveVideoArgs) InsertOpts() river.InsertOpts {
 	}
 }
 
+// moveAudioFallback moves the audio recorded alongside a live stream to the video directory. The audio path
+// is cleared if no audio was recorded.
+func moveAudioFallback(ctx context.Context, client *ent.Client, video ent.Vod) error {
+	if utils.FileExists(video.TmpAudioPath) {
+		return storage.Get().Save(ctx, video.TmpAudioPath, video.AudioPath)
+	}
+	exists, err := storage.Get().Exists(ctx, video.AudioPath)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		log.Warn().Str("video_id", video.ID.String()).Msg("no audio fallback was recorded")
+		return client.Vod.UpdateOneID(video.ID).ClearAudioPath().Exec(ctx)
+	}
+	return nil
+}
+
 func (w *MoveVideoWorker) Timeout(job *river.Job[MoveVideoArgs]) time.Duration {
 	return 24 * time.Hour
 }
@@ -364,7 +401,7 @@ func (w MoveVideoWorker) Work(ctx context.Context, job *river.Job[MoveVideoArgs]
 			return err
 		}
 
-		err := utils.MoveFile(ctx, tmpVideoPath, dbItems.Video.VideoPath)
+		err := storage.Get().Save(ctx, tmpVideoPath, dbItems.Video.VideoPath)
 		if err != nil {
 			return err
 		}
@@ -387,7 +424,7 @@ func (w MoveVideoWorker) Work(ctx context.Context, job *river.Job[MoveVideoArgs]
 		}
 
 		// move hls video
-		err := utils.MoveDirectory(ctx, dbItems.Video.TmpVideoHlsPath, dbItems.Video.VideoHlsPath)
+		err := storage.Get().SaveDirectory(ctx, dbItems.Video.TmpVideoHlsPath, dbItems.Video.VideoHlsPath)
 		if err != nil {
 			return err
 		}
@@ -405,6 +442,13 @@ func (w MoveVideoWorker) Work(ctx context.Context, job *river.Job[MoveVideoArgs]
 		}
 	}
 
+	// move audio recorded as a fallback while live
+	if dbItems.Video.TmpAudioPath != "" && dbItems.Video.AudioPath != "" {
+		if err := moveAudioFallback(ctx, store.Client, dbItems.Video); err != nil {
+			return err
+		}
+	}
+
 	next := []transactionalJob{}
 	if dbItems.Video.Type == utils.Live {
 		logger.Debug().Msg("queueing task to regenerate static thumbnail")
@@ -414,6 +458,20 @@ func (w MoveVideoWorker) Work(ctx context.Context, job *river.Job[MoveVideoArgs]
 		logger.Debug().Msg("queueing task to generate sprite thumbnails")
 		next = append(next, transactionalJob{Args: GenerateSpriteThumbnailArgs{VideoId: dbItems.Video.ID.String()}})
 	}
+	if config.Get().Parameters.SpeechToText != "" {
+		generateCaptions, err := store.Client.Live.Query().Where(live.GenerateCaptions(true), live.HasChannelWith(channel.ID(dbItems.Channel.ID))).Exist(ctx)
+		if err != nil {
+			return fmt.Errorf("error checking if captions are enabled for channel: %w", err)
+		}
+		if generateCaptions {
+			logger.Debug().Msg("queueing task to generate captions")
+			next = append(next, transactionalJob{Args: GenerateCaptionsArgs{VideoId: dbItems.Video.ID.String()}})
+		}
+	}
+	if config.Get().Checksums.Enabled {
+		logger.Debug().Msg("queueing task to record video checksums")
+		next = append(next, transactionalJob{Args: RecordChecksumsArgs{VideoID: &dbItems.Video.ID, Files: checksum.VideoFiles}})
+	}
 	err = setQueueStatusAndEnqueue(ctx, store, QueueStatusInput{
 		Status:  utils.Success,
 		QueueId: job.Args.Input.QueueId,
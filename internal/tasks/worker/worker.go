@@ -13,6 +13,7 @@ import (
 	"github.com/riverqueue/river/rivertype"
 	"github.com/robfig/cron/v3"
 	"github.com/rs/zerolog/log"
+	"github.com/zibbp/ganymede/internal/coldstorage"
 	"github.com/zibbp/ganymede/internal/config"
 	"github.com/zibbp/ganymede/internal/database"
 	"github.com/zibbp/ganymede/internal/live"
@@ -30,6 +31,8 @@ type RiverWorkerInput struct {
 	DB                      *database.Database
 	LiveService             *live.Service
 	PlatformTwitch          platform.Platform
+	PlatformYoutube         platform.Platform
+	PlatformKick            platform.Platform
 	NotificationService     *notification.Service
 	Enqueuer                tasks_shared.Enqueuer
 	VideoDownloadWorkers    int
@@ -62,6 +65,8 @@ func NewRiverWorker(input RiverWorkerInput) (*RiverWorkerClient, error) {
 	rc.Ctx = input.Context
 	rc.Ctx = context.WithValue(rc.Ctx, tasks_shared.StoreKey, input.DB)
 	rc.Ctx = context.WithValue(rc.Ctx, tasks_shared.PlatformTwitchKey, input.PlatformTwitch)
+	rc.Ctx = context.WithValue(rc.Ctx, tasks_shared.PlatformYoutubeKey, input.PlatformYoutube)
+	rc.Ctx = context.WithValue(rc.Ctx, tasks_shared.PlatformKickKey, input.PlatformKick)
 	rc.Ctx = context.WithValue(rc.Ctx, tasks_shared.NotificationServiceKey, input.NotificationService)
 	rc.Ctx = context.WithValue(rc.Ctx, tasks_shared.LiveServiceKey, input.LiveService)
 	rc.Ctx = context.WithValue(rc.Ctx, tasks_shared.EnqueuerKey, input.Enqueuer)
@@ -91,6 +96,7 @@ func NewRiverWorker(input RiverWorkerInput) (*RiverWorkerClient, error) {
 			tasks.QueueChatDownload:             {MaxWorkers: input.ChatDownloadWorkers},
 			tasks.QueueChatRender:               {MaxWorkers: input.ChatRenderWorkers},
 			tasks.QueueGenerateThumbnailSprites: {MaxWorkers: input.SpriteThumbnailWorkers},
+			tasks.QueueStorageTier:              {MaxWorkers: 1}, // videos are moved between storage tiers one at a time
 		},
 		Workers:         workers,
 		Middleware:      []rivertype.Middleware{archiveMiddleware},
@@ -145,6 +151,10 @@ func getPeriodicTasks() ([]*river.PeriodicJob, error) {
 	if err != nil {
 		return nil, err
 	}
+	weeklyCron, err := cron.ParseStandard("0 0 * * 0")
+	if err != nil {
+		return nil, err
+	}
 
 	// get interval configs
 	configCheckLiveInterval := config.Get().LiveCheckInterval
@@ -219,6 +229,19 @@ func getPeriodicTasks() ([]*river.PeriodicJob, error) {
 			&river.PeriodicJobOpts{RunOnStart: true},
 		),
 
+		// move videos selected by the cold storage policy to the cold videos directory
+		// runs once a day at midnight
+		river.NewPeriodicJob(
+			midnightCron,
+			func() (river.JobArgs, *river.InsertOpts) {
+				if !coldstorage.Enabled() {
+					return nil, nil
+				}
+				return tasks.MoveVideoStorageTierArgs{}, periodicInsertOpts(24 * time.Hour)
+			},
+			&river.PeriodicJobOpts{RunOnStart: false},
+		),
+
 		// import categories
 		// runs once a day at midnight
 		river.NewPeriodicJob(
@@ -288,6 +311,52 @@ func getPeriodicTasks() ([]*river.PeriodicJob, error) {
 			},
 			&river.PeriodicJobOpts{RunOnStart: false},
 		),
+
+		// check that the files of every video exist and can be played
+		// runs once a week at midnight on sunday
+		river.NewPeriodicJob(
+			weeklyCron,
+			func() (river.JobArgs, *river.InsertOpts) {
+				return tasks.AuditLibraryArgs{}, periodicInsertOpts(7 * 24 * time.Hour)
+			},
+			&river.PeriodicJobOpts{RunOnStart: false},
+		),
+
+		// hash a slice of the archived files again and compare them to their checksums
+		// runs once a day at midnight
+		river.NewPeriodicJob(
+			midnightCron,
+			func() (river.JobArgs, *river.InsertOpts) {
+				if !config.Get().Checksums.Enabled {
+					return nil, nil
+				}
+				return tasks.VerifyChecksumsArgs{}, periodicInsertOpts(24 * time.Hour)
+			},
+			&river.PeriodicJobOpts{RunOnStart: false},
+		),
+
+		// replace the audio of muted segments of VODs with the audio of their live archive
+		// runs once a day at midnight
+		river.NewPeriodicJob(
+			midnightCron,
+			func() (river.JobArgs, *river.InsertOpts) {
+				if !config.Get().Archive.RecoverMutedAudio {
+					return nil, nil
+				}
+				return tasks.RecoverMutedAudioArgs{}, periodicInsertOpts(24 * time.Hour)
+			},
+			&river.PeriodicJobOpts{RunOnStart: false},
+		),
+
+		// apply the stream version policy to streams archived both live and as a VOD
+		// runs once a day at midnight
+		river.NewPeriodicJob(
+			midnightCron,
+			func() (river.JobArgs, *river.InsertOpts) {
+				return tasks.ReconcileStreamVersionsArgs{}, periodicInsertOpts(24 * time.Hour)
+			},
+			&river.PeriodicJobOpts{RunOnStart: false},
+		),
 	}
 
 	// check jwks
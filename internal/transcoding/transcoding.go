@@ -0,0 +1,146 @@
+// Package transcoding manages the named transcoding profiles videos can be post-processed with instead of the
+// global video convert arguments.
+package transcoding
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/rs/zerolog/log"
+	"github.com/zibbp/ganymede/ent"
+	entTranscodingProfile "github.com/zibbp/ganymede/ent/transcodingprofile"
+	"github.com/zibbp/ganymede/internal/database"
+	"github.com/zibbp/ganymede/internal/exec"
+)
+
+var (
+	// ErrInvalidArgs is returned when FFmpeg can't post-process a video with the arguments of a profile.
+	ErrInvalidArgs = errors.New("invalid ffmpeg arguments")
+	// ErrNameExists is returned when another profile has the same name.
+	ErrNameExists = errors.New("transcoding profile name already exists")
+)
+
+// defaultProfiles are created when no profiles exist. They only use software encoders so they work on any host.
+var defaultProfiles = []Profile{
+	{
+		Name:        "Copy",
+		Description: "Copy the video and audio without re-encoding.",
+		FfmpegArgs:  "-c:v copy -c:a copy",
+	},
+	{
+		Name:        "H.265 archival",
+		Description: "Re-encode the video to H.265 to save storage on long-term archives.",
+		FfmpegArgs:  "-c:v libx265 -preset medium -crf 26 -tag:v hvc1 -c:a copy",
+	},
+	{
+		Name:        "720p mobile",
+		Description: "Scale the video down to 720p for small files that play well on mobile devices.",
+		FfmpegArgs:  "-vf scale=-2:720 -c:v libx264 -preset veryfast -crf 26 -c:a aac -b:a 128k",
+	},
+}
+
+// Profile is a named set of FFmpeg arguments.
+type Profile struct {
+	Name        string
+	Description string
+	FfmpegArgs  string
+}
+
+type Service struct {
+	Store *database.Database
+}
+
+func NewService(store *database.Database) *Service {
+	return &Service{Store: store}
+}
+
+// GetProfiles returns all transcoding profiles ordered by name.
+func (s *Service) GetProfiles(ctx context.Context) ([]*ent.TranscodingProfile, error) {
+	return s.Store.Client.TranscodingProfile.Query().Order(ent.Asc(entTranscodingProfile.FieldName)).All(ctx)
+}
+
+// GetProfile returns the transcoding profile with the ID.
+func (s *Service) GetProfile(ctx context.Context, id uuid.UUID) (*ent.TranscodingProfile, error) {
+	return s.Store.Client.TranscodingProfile.Get(ctx, id)
+}
+
+// CreateProfile validates the FFmpeg arguments of the profile and creates it.
+func (s *Service) CreateProfile(ctx context.Context, p Profile) (*ent.TranscodingProfile, error) {
+	p.FfmpegArgs = strings.TrimSpace(p.FfmpegArgs)
+	if err := validateArgs(ctx, p.FfmpegArgs); err != nil {
+		return nil, err
+	}
+
+	profile, err := s.Store.Client.TranscodingProfile.Create().
+		SetName(p.Name).
+		SetDescription(p.Description).
+		SetFfmpegArgs(p.FfmpegArgs).
+		Save(ctx)
+	if err != nil {
+		if ent.IsConstraintError(err) {
+			return nil, ErrNameExists
+		}
+		return nil, fmt.Errorf("error creating transcoding profile: %w", err)
+	}
+	return profile, nil
+}
+
+// UpdateProfile validates the FFmpeg arguments of the profile and updates it. Videos that are already post-processed are not changed.
+func (s *Service) UpdateProfile(ctx context.Context, id uuid.UUID, p Profile) (*ent.TranscodingProfile, error) {
+	p.FfmpegArgs = strings.TrimSpace(p.FfmpegArgs)
+	if err := validateArgs(ctx, p.FfmpegArgs); err != nil {
+		return nil, err
+	}
+
+	profile, err := s.Store.Client.TranscodingProfile.UpdateOneID(id).
+		SetName(p.Name).
+		SetDescription(p.Description).
+		SetFfmpegArgs(p.FfmpegArgs).
+		Save(ctx)
+	if err != nil {
+		if ent.IsConstraintError(err) {
+			return nil, ErrNameExists
+		}
+		return nil, err
+	}
+	return profile, nil
+}
+
+// DeleteProfile deletes the transcoding profile. Watched channels and videos using it fall back to the global video convert arguments.
+func (s *Service) DeleteProfile(ctx context.Context, id uuid.UUID) error {
+	return s.Store.Client.TranscodingProfile.DeleteOneID(id).Exec(ctx)
+}
+
+// EnsureDefaultProfiles creates the default profiles if no profiles exist.
+func (s *Service) EnsureDefaultProfiles(ctx context.Context) error {
+	exists, err := s.Store.Client.TranscodingProfile.Query().Exist(ctx)
+	if err != nil {
+		return fmt.Errorf("error checking transcoding profiles: %w", err)
+	}
+	if exists {
+		return nil
+	}
+
+	builders := make([]*ent.TranscodingProfileCreate, 0, len(defaultProfiles))
+	for _, p := range defaultProfiles {
+		builders = append(builders, s.Store.Client.TranscodingProfile.Create().
+			SetName(p.Name).
+			SetDescription(p.Description).
+			SetFfmpegArgs(p.FfmpegArgs))
+	}
+	if err := s.Store.Client.TranscodingProfile.CreateBulk(builders...).Exec(ctx); err != nil {
+		return fmt.Errorf("error creating default transcoding profiles: %w", err)
+	}
+	log.Info().Int("profiles", len(builders)).Msg("created default transcoding profiles")
+	return nil
+}
+
+func validateArgs(ctx context.Context, args string) error {
+	if err := exec.ValidateVideoConvertArgs(ctx, args); err != nil {
+		return fmt.Errorf("%w: %v", ErrInvalidArgs, err)
+	}
+	return nil
+}
@@ -4,7 +4,9 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"strconv"
 
+	"github.com/google/uuid"
 	"github.com/labstack/echo/v4"
 	"github.com/zibbp/ganymede/internal/admin"
 )
@@ -14,6 +16,8 @@ type AdminService interface {
 	GetSystemOverview(ctx context.Context) (admin.GetSystemOverviewResponse, error)
 	GetStorageDistribution(ctx context.Context) (admin.GetStorageDistributionResponse, error)
 	GetInfo(ctx context.Context) (admin.InfoResp, error)
+	GetViewingStatistics(ctx context.Context, filter admin.ViewingStatisticsFilter) (admin.GetViewingStatisticsResponse, error)
+	GetVideoWatchHeatmap(ctx context.Context, videoId uuid.UUID, resolutionSeconds int, filter admin.ViewingStatisticsFilter) (map[int]int, error)
 }
 
 // GetVideoStatistics godoc
@@ -95,3 +99,104 @@ func (h *Handler) GetInfo(c echo.Context) error {
 	}
 	return SuccessResponse(c, resp, "Ganymede information")
 }
+
+// GetViewingStatistics godoc
+//
+//	@Summary		Get viewing statistics
+//	@Description	Get the most watched channels and videos, completion rates and watch time per user of the viewing sessions started in the date range
+//	@Tags			admin
+//	@Produce		json
+//	@Param			from	query		string	false	"Start of the date range (YYYY-MM-DD or RFC3339)"
+//	@Param			to		query		string	false	"End of the date range (YYYY-MM-DD or RFC3339)"
+//	@Param			user_id	query		string	false	"Only include sessions of the user"
+//	@Success		200		{object}	admin.GetViewingStatisticsResponse
+//	@Failure		400		{object}	utils.ErrorResponse
+//	@Failure		500		{object}	utils.ErrorResponse
+//	@Router			/admin/viewing-statistics [get]
+//	@Security		ApiKeyCookieAuth
+//	@Security		ApiKeyAuth
+func (h *Handler) GetViewingStatistics(c echo.Context) error {
+	filter, err := viewingStatisticsFilterFromQuery(c)
+	if err != nil {
+		return ErrorResponse(c, http.StatusBadRequest, err.Error())
+	}
+	if userID := c.QueryParam("user_id"); userID != "" {
+		id, err := uuid.Parse(userID)
+		if err != nil {
+			return ErrorResponse(c, http.StatusBadRequest, "invalid user id")
+		}
+		filter.UserID = &id
+	}
+
+	resp, err := h.Service.AdminService.GetViewingStatistics(c.Request().Context(), filter)
+	if err != nil {
+		return ErrorResponse(c, http.StatusInternalServerError, fmt.Sprintf("Error retrieving viewing statistics: %v", err))
+	}
+	return SuccessResponse(c, resp, "Viewing Statistics")
+}
+
+// GetVideoWatchHeatmap godoc
+//
+//	@Summary		Get video watch heatmap
+//	@Description	Get how often each part of the video was watched in the viewing sessions started in the date range. Keys are the start of the buckets in seconds.
+//	@Tags			admin
+//	@Produce		json
+//	@Param			id			path		string	true	"Video ID"
+//	@Param			resolution	query		integer	false	"Bucket size in seconds, increased for long videos so there are at most 10000 buckets"	default(60)	minimum(1)
+//	@Param			from		query		string	false	"Start of the date range (YYYY-MM-DD or RFC3339)"
+//	@Param			to			query		string	false	"End of the date range (YYYY-MM-DD or RFC3339)"
+//	@Success		200			{object}	map[int]int
+//	@Failure		400			{object}	utils.ErrorResponse
+//	@Failure		404			{object}	utils.ErrorResponse
+//	@Failure		500			{object}	utils.ErrorResponse
+//	@Router			/admin/viewing-statistics/videos/{id}/heatmap [get]
+//	@Security		ApiKeyCookieAuth
+//	@Security		ApiKeyAuth
+func (h *Handler) GetVideoWatchHeatmap(c echo.Context) error {
+	videoID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return ErrorResponse(c, http.StatusBadRequest, "invalid video id")
+	}
+	resolution := 60
+	if r := c.QueryParam("resolution"); r != "" {
+		resolution, err = strconv.Atoi(r)
+		if err != nil || resolution < 1 {
+			return ErrorResponse(c, http.StatusBadRequest, "invalid resolution, must be a whole number of seconds of at least 1")
+		}
+	}
+	filter, err := viewingStatisticsFilterFromQuery(c)
+	if err != nil {
+		return ErrorResponse(c, http.StatusBadRequest, err.Error())
+	}
+
+	heatmap, err := h.Service.AdminService.GetVideoWatchHeatmap(c.Request().Context(), videoID, resolution, filter)
+	if err != nil {
+		if err.Error() == "vod not found" {
+			return ErrorResponse(c, http.StatusNotFound, err.Error())
+		}
+		return ErrorResponse(c, http.StatusInternalServerError, err.Error())
+	}
+	return SuccessResponse(c, heatmap, fmt.Sprintf("watch heatmap for %s", videoID))
+}
+
+// viewingStatisticsFilterFromQuery parses the from and to query parameters of the viewing statistics endpoints.
+func viewingStatisticsFilterFromQuery(c echo.Context) (admin.ViewingStatisticsFilter, error) {
+	var filter admin.ViewingStatisticsFilter
+	var err error
+	if from := c.QueryParam("from"); from != "" {
+		filter.From, err = parseSearchDate(from, false)
+		if err != nil {
+			return filter, fmt.Errorf("invalid from: %w", err)
+		}
+	}
+	if to := c.QueryParam("to"); to != "" {
+		filter.To, err = parseSearchDate(to, true)
+		if err != nil {
+			return filter, fmt.Errorf("invalid to: %w", err)
+		}
+	}
+	if !filter.From.IsZero() && !filter.To.IsZero() && filter.To.Before(filter.From) {
+		return filter, fmt.Errorf("to must not be before from")
+	}
+	return filter, nil
+}
@@ -13,25 +13,32 @@ import (
 	"github.com/zibbp/ganymede/ent"
 	"github.com/zibbp/ganymede/internal/archive"
 	"github.com/zibbp/ganymede/internal/config"
+	"github.com/zibbp/ganymede/internal/reencode"
 	"github.com/zibbp/ganymede/internal/utils"
 )
 
 type ArchiveService interface {
 	ArchiveChannel(ctx context.Context, channelName string) (*ent.Channel, error)
+	ArchivePlatformChannel(ctx context.Context, videoPlatform utils.VideoPlatform, channelName string) (*ent.Channel, error)
 	ArchiveVideo(ctx context.Context, input archive.ArchiveVideoInput) (*archive.ArchiveResponse, error)
 	ArchiveLivestream(ctx context.Context, input archive.ArchiveVideoInput) (*archive.ArchiveResponse, error)
 	ArchiveClip(ctx context.Context, input archive.ArchiveClipInput) (*archive.ArchiveResponse, error)
+	CreateClip(ctx context.Context, input archive.CreateClipInput) (*ent.Vod, error)
+	ReencodeVideos(ctx context.Context, input archive.ReencodeVideosInput) error
 }
 
 type ArchiveChannelRequest struct {
-	ChannelName string `json:"channel_name" validate:"required"`
+	ChannelName string              `json:"channel_name" validate:"required"`
+	Platform    utils.VideoPlatform `json:"platform" validate:"omitempty,oneof=twitch youtube kick"` // defaults to twitch
 }
 type ArchiveVideoRequest struct {
-	VideoId     string           `json:"video_id"`
-	ChannelId   string           `json:"channel_id"`
-	Quality     utils.VodQuality `json:"quality" validate:"required,oneof=best 1440p 1080p 720p 480p 360p 160p audio"`
-	ArchiveChat bool             `json:"archive_chat"`
-	RenderChat  bool             `json:"render_chat"`
+	VideoId              string              `json:"video_id"`
+	ChannelId            string              `json:"channel_id"`
+	Platform             utils.VideoPlatform `json:"platform" validate:"omitempty,oneof=twitch youtube kick"` // platform of video_id, defaults to twitch
+	Quality              utils.VodQuality    `json:"quality" validate:"required,oneof=best 1440p 1080p 720p 480p 360p 160p audio"`
+	ArchiveChat          bool                `json:"archive_chat"`
+	RenderChat           bool                `json:"render_chat"`
+	TranscodingProfileID *uuid.UUID          `json:"transcoding_profile_id"` // defaults to the global video convert arguments
 }
 
 // CheckIDType checks if the provided ID is a video id (numeric) or clip (alphanumeric)
@@ -52,8 +59,8 @@ func CheckIDType(id string) string {
 
 // ArchiveChannel godoc
 //
-//	@Summary		Archive a twitch channel
-//	@Description	Archive a twitch channel (creates channel in database and download profile image)
+//	@Summary		Archive a channel
+//	@Description	Archive a twitch, youtube or kick channel (creates channel in database and download profile image)
 //	@Tags			archive
 //	@Accept			json
 //	@Produce		json
@@ -72,17 +79,20 @@ func (h *Handler) ArchiveChannel(c echo.Context) error {
 	if err := c.Validate(body); err != nil {
 		return ErrorResponse(c, http.StatusBadRequest, err.Error())
 	}
-	channel, err := h.Service.ArchiveService.ArchiveChannel(c.Request().Context(), body.ChannelName)
+	if body.Platform == "" {
+		body.Platform = utils.PlatformTwitch
+	}
+	channel, err := h.Service.ArchiveService.ArchivePlatformChannel(c.Request().Context(), body.Platform, body.ChannelName)
 	if err != nil {
 		return ErrorResponse(c, http.StatusInternalServerError, err.Error())
 	}
-	return SuccessResponse(c, channel, "twitch channel created")
+	return SuccessResponse(c, channel, fmt.Sprintf("%s channel created", body.Platform))
 }
 
 // ArchiveVideo godoc
 //
-//	@Summary		Archive a twitch vod
-//	@Description	Archive a twitch vod
+//	@Summary		Archive a vod
+//	@Description	Archive a twitch, youtube or kick vod, a twitch clip, or a live stream of a channel
 //	@Tags			archive
 //	@Accept			json
 //	@Produce		json
@@ -121,10 +131,24 @@ func (h *Handler) ArchiveVideo(c echo.Context) error {
 		}
 
 		archiveResponse, err = h.Service.ArchiveService.ArchiveLivestream(c.Request().Context(), archive.ArchiveVideoInput{
-			ChannelId:   parsedChannelId,
-			Quality:     body.Quality,
-			ArchiveChat: body.ArchiveChat,
-			RenderChat:  body.RenderChat,
+			ChannelId:            parsedChannelId,
+			Quality:              body.Quality,
+			ArchiveChat:          body.ArchiveChat,
+			RenderChat:           body.RenderChat,
+			TranscodingProfileID: body.TranscodingProfileID,
+		})
+		if err != nil {
+			return ErrorResponse(c, http.StatusInternalServerError, err.Error())
+		}
+	} else if body.Platform == utils.PlatformYoutube || body.Platform == utils.PlatformKick {
+		// youtube and kick video IDs are alphanumeric and clips are not supported
+		archiveResponse, err = h.Service.ArchiveService.ArchiveVideo(c.Request().Context(), archive.ArchiveVideoInput{
+			VideoId:              body.VideoId,
+			Platform:             body.Platform,
+			Quality:              body.Quality,
+			ArchiveChat:          body.ArchiveChat,
+			RenderChat:           body.RenderChat,
+			TranscodingProfileID: body.TranscodingProfileID,
 		})
 		if err != nil {
 			return ErrorResponse(c, http.StatusInternalServerError, err.Error())
@@ -135,10 +159,11 @@ func (h *Handler) ArchiveVideo(c echo.Context) error {
 		switch idType {
 		case "numeric":
 			archiveResponse, err = h.Service.ArchiveService.ArchiveVideo(c.Request().Context(), archive.ArchiveVideoInput{
-				VideoId:     body.VideoId,
-				Quality:     body.Quality,
-				ArchiveChat: body.ArchiveChat,
-				RenderChat:  body.RenderChat,
+				VideoId:              body.VideoId,
+				Quality:              body.Quality,
+				ArchiveChat:          body.ArchiveChat,
+				RenderChat:           body.RenderChat,
+				TranscodingProfileID: body.TranscodingProfileID,
 			})
 			if err != nil {
 				return ErrorResponse(c, http.StatusInternalServerError, err.Error())
@@ -146,10 +171,11 @@ func (h *Handler) ArchiveVideo(c echo.Context) error {
 
 		case "alphanumeric":
 			archiveResponse, err = h.Service.ArchiveService.ArchiveClip(c.Request().Context(), archive.ArchiveClipInput{
-				ID:          body.VideoId,
-				Quality:     body.Quality,
-				ArchiveChat: body.ArchiveChat,
-				RenderChat:  body.RenderChat,
+				ID:                   body.VideoId,
+				Quality:              body.Quality,
+				ArchiveChat:          body.ArchiveChat,
+				RenderChat:           body.RenderChat,
+				TranscodingProfileID: body.TranscodingProfileID,
 			})
 			if err != nil {
 				return ErrorResponse(c, http.StatusInternalServerError, err.Error())
@@ -164,6 +190,104 @@ func (h *Handler) ArchiveVideo(c echo.Context) error {
 	return SuccessResponse(c, archiveResponse, "archive started")
 }
 
+type CreateClipRequest struct {
+	VideoID uuid.UUID `json:"video_id" validate:"required"`
+	Start   int       `json:"start" validate:"min=0"` // seconds into the video
+	End     int       `json:"end" validate:"gtfield=Start"`
+	Title   string    `json:"title"` // defaults to the title of the video
+}
+
+// CreateClip godoc
+//
+//	@Summary		Create a clip
+//	@Description	Create a clip of the part of an archived video between start and end. The video, rendered chat and chat are cut by a task, the streams are copied when the clip starts on a keyframe and re-encoded otherwise.
+//	@Tags			archive
+//	@Accept			json
+//	@Produce		json
+//	@Param			clip	body		CreateClipRequest	true	"Clip"
+//	@Success		200		{object}	ent.Vod
+//	@Failure		400		{object}	utils.ErrorResponse
+//	@Failure		404		{object}	utils.ErrorResponse
+//	@Failure		500		{object}	utils.ErrorResponse
+//	@Router			/archive/clip [post]
+//	@Security		ApiKeyCookieAuth
+//	@Security		ApiKeyAuth
+func (h *Handler) CreateClip(c echo.Context) error {
+	body := new(CreateClipRequest)
+	if err := c.Bind(body); err != nil {
+		return ErrorResponse(c, http.StatusBadRequest, err.Error())
+	}
+	if err := c.Validate(body); err != nil {
+		return ErrorResponse(c, http.StatusBadRequest, err.Error())
+	}
+
+	clip, err := h.Service.ArchiveService.CreateClip(c.Request().Context(), archive.CreateClipInput{
+		VideoID: body.VideoID,
+		Start:   body.Start,
+		End:     body.End,
+		Title:   body.Title,
+	})
+	if err != nil {
+		switch err.Error() {
+		case "video not found":
+			return ErrorResponse(c, http.StatusNotFound, err.Error())
+		case "video is processing", "video is a clip", "invalid clip range":
+			return ErrorResponse(c, http.StatusBadRequest, err.Error())
+		}
+		return ErrorResponse(c, http.StatusInternalServerError, err.Error())
+	}
+	return SuccessResponse(c, clip, "clip creation started")
+}
+
+type ReencodeVideosRequest struct {
+	ChannelID            *uuid.UUID `json:"channel_id"`                             // all channels if not set
+	OlderThanDays        int        `json:"older_than_days" validate:"min=0"`       // only videos streamed at least this many days ago
+	Resolution           string     `json:"resolution" validate:"omitempty,max=32"` // only videos with this probed resolution, e.g. 1080p
+	Codec                string     `json:"codec" validate:"omitempty,max=32"`      // only videos with this probed video codec, e.g. h264
+	TranscodingProfileID uuid.UUID  `json:"transcoding_profile_id" validate:"required"`
+}
+
+// ReencodeVideos godoc
+//
+//	@Summary		Re-encode archived videos
+//	@Description	Re-encode the archived videos matching the filter with a transcoding profile. Each video is re-encoded in the temp directory and only replaces the archived video once its duration matches. HLS videos are skipped. Progress is shown in the queue.
+//	@Tags			archive
+//	@Accept			json
+//	@Produce		json
+//	@Param			body	body	ReencodeVideosRequest	true	"Re-encode request"
+//	@Success		200
+//	@Failure		400	{object}	utils.ErrorResponse
+//	@Failure		500	{object}	utils.ErrorResponse
+//	@Router			/archive/reencode [post]
+//	@Security		ApiKeyCookieAuth
+//	@Security		ApiKeyAuth
+func (h *Handler) ReencodeVideos(c echo.Context) error {
+	body := new(ReencodeVideosRequest)
+	if err := c.Bind(body); err != nil {
+		return ErrorResponse(c, http.StatusBadRequest, err.Error())
+	}
+	if err := c.Validate(body); err != nil {
+		return ErrorResponse(c, http.StatusBadRequest, err.Error())
+	}
+
+	err := h.Service.ArchiveService.ReencodeVideos(c.Request().Context(), archive.ReencodeVideosInput{
+		Filter: reencode.Filter{
+			ChannelID:     body.ChannelID,
+			OlderThanDays: body.OlderThanDays,
+			Resolution:    body.Resolution,
+			Codec:         body.Codec,
+		},
+		TranscodingProfileID: body.TranscodingProfileID,
+	})
+	if err != nil {
+		if err.Error() == "transcoding profile not found" {
+			return ErrorResponse(c, http.StatusBadRequest, err.Error())
+		}
+		return ErrorResponse(c, http.StatusInternalServerError, err.Error())
+	}
+	return SuccessResponse(c, nil, "re-encode queued")
+}
+
 // debug route to test converting chat files
 func (h *Handler) ConvertTwitchChat(c echo.Context) error {
 	type Body struct {
@@ -10,6 +10,7 @@ import (
 	"github.com/labstack/echo/v4"
 	"github.com/zibbp/ganymede/ent"
 	"github.com/zibbp/ganymede/internal/channel"
+	"github.com/zibbp/ganymede/internal/utils"
 )
 
 type ChannelService interface {
@@ -23,12 +24,17 @@ type ChannelService interface {
 }
 
 type CreateChannelRequest struct {
-	ExternalID    string `json:"ext_id"`
-	Name          string `json:"name" validate:"required,min=2,max=50"`
-	DisplayName   string `json:"display_name" validate:"required,min=2,max=50"`
-	ImagePath     string `json:"image_path" validate:"required,min=3"`
-	Retention     bool   `json:"retention"`
-	RetentionDays int64  `json:"retention_days"`
+	ExternalID               string          `json:"ext_id"`
+	Name                     string          `json:"name" validate:"required,min=2,max=50"`
+	DisplayName              string          `json:"display_name" validate:"required,min=2,max=50"`
+	ImagePath                string          `json:"image_path" validate:"required,min=3"`
+	Retention                bool            `json:"retention"`
+	RetentionDays            int64           `json:"retention_days" validate:"gte=0"`
+	RetentionKeepNewest      int             `json:"retention_keep_newest" validate:"gte=0"`
+	RetentionMaxSizeBytes    int64           `json:"retention_max_size_bytes" validate:"gte=0"`
+	RetentionVideoTypes      []utils.VodType `json:"retention_video_types" validate:"dive,oneof=archive live highlight upload clip"`
+	RetentionExemptPlaylists bool            `json:"retention_exempt_playlists"`
+	RetentionExemptMinViews  int             `json:"retention_exempt_min_views" validate:"gte=0"`
 }
 
 // CreateChannel godoc
@@ -185,11 +191,16 @@ func (h *Handler) UpdateChannel(c echo.Context) error {
 	}
 
 	ccDto := channel.Channel{
-		Name:          ccr.Name,
-		DisplayName:   ccr.DisplayName,
-		ImagePath:     ccr.ImagePath,
-		Retention:     ccr.Retention,
-		RetentionDays: ccr.RetentionDays,
+		Name:                     ccr.Name,
+		DisplayName:              ccr.DisplayName,
+		ImagePath:                ccr.ImagePath,
+		Retention:                ccr.Retention,
+		RetentionDays:            ccr.RetentionDays,
+		RetentionKeepNewest:      ccr.RetentionKeepNewest,
+		RetentionMaxSizeBytes:    ccr.RetentionMaxSizeBytes,
+		RetentionVideoTypes:      ccr.RetentionVideoTypes,
+		RetentionExemptPlaylists: ccr.RetentionExemptPlaylists,
+		RetentionExemptMinViews:  ccr.RetentionExemptMinViews,
 	}
 
 	cha, err := h.Service.ChannelService.UpdateChannel(cUUID, ccDto)
@@ -202,6 +213,36 @@ func (h *Handler) UpdateChannel(c echo.Context) error {
 	return SuccessResponse(c, cha, "channel updated")
 }
 
+// GetChannelRetentionDryRun godoc
+//
+//	@Summary		Preview a channel retention policy
+//	@Description	Returns the videos the retention policy of the channel would delete without deleting them. The policy is evaluated even if retention is not enabled for the channel.
+//	@Tags			channel
+//	@Produce		json
+//	@Param			id	path		string	true	"Channel ID"
+//	@Success		200	{object}	vod.RetentionDryRun
+//	@Failure		400	{object}	utils.ErrorResponse
+//	@Failure		404	{object}	utils.ErrorResponse
+//	@Failure		500	{object}	utils.ErrorResponse
+//	@Router			/channel/{id}/retention/dry-run [get]
+//	@Security		ApiKeyCookieAuth
+//	@Security		ApiKeyAuth
+func (h *Handler) GetChannelRetentionDryRun(c echo.Context) error {
+	cUUID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return ErrorResponse(c, http.StatusBadRequest, err.Error())
+	}
+
+	dryRun, err := h.Service.VodService.GetChannelRetentionDryRun(c.Request().Context(), cUUID)
+	if err != nil {
+		if err.Error() == "channel not found" {
+			return ErrorResponse(c, http.StatusNotFound, err.Error())
+		}
+		return ErrorResponse(c, http.StatusInternalServerError, err.Error())
+	}
+	return SuccessResponse(c, dryRun, "channel retention dry run")
+}
+
 // GetChannelByName godoc
 //
 //	@Summary		Get a channel by name
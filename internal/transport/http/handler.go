@@ -24,6 +24,7 @@ import (
 	"github.com/zibbp/ganymede/internal/config"
 	"github.com/zibbp/ganymede/internal/database"
 	"github.com/zibbp/ganymede/internal/platform"
+	"github.com/zibbp/ganymede/internal/storage"
 	"github.com/zibbp/ganymede/internal/utils"
 	"riverqueue.com/riverui"
 )
@@ -34,6 +35,7 @@ type Services struct {
 	VodService          VodService
 	QueueService        QueueService
 	ArchiveService      ArchiveService
+	ImportService       ImportService
 	AdminService        AdminService
 	UserService         UserService
 	LiveService         LiveService
@@ -45,6 +47,9 @@ type Services struct {
 	CategoryService     CategoryService
 	BlockedVideoService BlockedVideoService
 	NotificationService NotificationService
+	TranscodingService  TranscodingService
+	WatchPartyService   WatchPartyService
+	UserListService     UserListService
 	ApiKeyService       ApiKeyService
 	PlatformTwitch      platform.Platform
 }
@@ -64,7 +69,7 @@ var sessionManager *scs.SessionManager
 // cleanly with Echo's middleware signature.
 var apiKeyService *api_key.Service
 
-func NewHandler(database *database.Database, authService AuthService, channelService ChannelService, vodService VodService, queueService QueueService, archiveService ArchiveService, adminService AdminService, userService UserService, liveService LiveService, playbackService PlaybackService, metricsService MetricsService, playlistService PlaylistService, taskService TaskService, chapterService ChapterService, categoryService CategoryService, blockedVideoService BlockedVideoService, notificationService NotificationService, apiKeySvc *api_key.Service, platformTwitch platform.Platform, riverUIServer *riverui.Handler) *Handler {
+func NewHandler(database *database.Database, authService AuthService, channelService ChannelService, vodService VodService, queueService QueueService, archiveService ArchiveService, importService ImportService, adminService AdminService, userService UserService, liveService LiveService, playbackService PlaybackService, metricsService MetricsService, playlistService PlaylistService, taskService TaskService, chapterService ChapterService, categoryService CategoryService, blockedVideoService BlockedVideoService, notificationService NotificationService, transcodingService TranscodingService, watchPartyService WatchPartyService, userListService UserListService, apiKeySvc *api_key.Service, platformTwitch platform.Platform, riverUIServer *riverui.Handler) *Handler {
 	log.Debug().Msg("creating route handler")
 	envConfig := config.GetEnvConfig()
 
@@ -88,6 +93,7 @@ func NewHandler(database *database.Database, authService AuthService, channelSer
 			VodService:          vodService,
 			QueueService:        queueService,
 			ArchiveService:      archiveService,
+			ImportService:       importService,
 			AdminService:        adminService,
 			UserService:         userService,
 			LiveService:         liveService,
@@ -99,6 +105,9 @@ func NewHandler(database *database.Database, authService AuthService, channelSer
 			CategoryService:     categoryService,
 			BlockedVideoService: blockedVideoService,
 			NotificationService: notificationService,
+			TranscodingService:  transcodingService,
+			WatchPartyService:   watchPartyService,
+			UserListService:     userListService,
 			ApiKeyService:       apiKeySvc,
 			PlatformTwitch:      platformTwitch,
 		},
@@ -188,7 +197,7 @@ func (h *Handler) mapRoutes() {
 	// Static files if not using nginx
 	env := config.GetEnvConfig()
 	// Use one handler for both GET + HEAD
-	videosH := echo.WrapHandler(http.StripPrefix(env.VideosDir, http.FileServer(http.Dir(env.VideosDir))))
+	videosH := echo.WrapHandler(archiveFileHandler(env.VideosDir, storage.Get()))
 	tempH := echo.WrapHandler(http.StripPrefix(env.TempDir, http.FileServer(http.Dir(env.TempDir))))
 
 	h.Server.GET(env.VideosDir+"/*", videosH)
@@ -197,6 +206,12 @@ func (h *Handler) mapRoutes() {
 	h.Server.GET(env.TempDir+"/*", tempH)
 	h.Server.HEAD(env.TempDir+"/*", tempH)
 
+	if env.ColdVideosDir != "" {
+		coldVideosH := echo.WrapHandler(http.StripPrefix(env.ColdVideosDir, http.FileServer(http.Dir(env.ColdVideosDir))))
+		h.Server.GET(env.ColdVideosDir+"/*", coldVideosH)
+		h.Server.HEAD(env.ColdVideosDir+"/*", coldVideosH)
+	}
+
 	// RiverUI
 	h.Server.Any("/riverui/", echo.WrapHandler(h.RiverUIServer), AuthGuardMiddleware, AuthGetUserMiddleware, AuthUserRoleMiddleware(utils.EditorRole))
 	h.Server.Any("/riverui/*", echo.WrapHandler(h.RiverUIServer), AuthGuardMiddleware, AuthGetUserMiddleware, AuthUserRoleMiddleware(utils.EditorRole))
@@ -237,6 +252,7 @@ func groupV1Routes(e *echo.Group, h *Handler) {
 	channelGroup.PUT("/:id", h.UpdateChannel, AuthAPIKeyOrSessionMiddleware, AuthGetUserMiddleware, RequireRoleOrScope(utils.EditorRole, utils.ApiKeyScopeChannelWrite))
 	channelGroup.DELETE("/:id", h.DeleteChannel, AuthAPIKeyOrSessionMiddleware, AuthGetUserMiddleware, RequireRoleOrScope(utils.AdminRole, utils.ApiKeyScopeChannelAdmin))
 	channelGroup.POST("/:id/update-image", h.UpdateChannelImage, AuthAPIKeyOrSessionMiddleware, AuthGetUserMiddleware, RequireRoleOrScope(utils.EditorRole, utils.ApiKeyScopeChannelWrite))
+	channelGroup.GET("/:id/retention/dry-run", h.GetChannelRetentionDryRun, AuthAPIKeyOrSessionMiddleware, AuthGetUserMiddleware, RequireRoleOrScope(utils.EditorRole, utils.ApiKeyScopeChannelRead))
 
 	// VOD
 	//
@@ -250,10 +266,14 @@ func groupV1Routes(e *echo.Group, h *Handler) {
 	vodGroup.GET("/:id", h.GetVod)
 	vodGroup.GET("/external_id/:external_id", h.GetVod)
 	vodGroup.GET("/search", h.SearchVods)
+	vodGroup.GET("/chat/search", h.SearchVodChat)
 	vodGroup.PUT("/:id", h.UpdateVod, AuthAPIKeyOrSessionMiddleware, AuthGetUserMiddleware, RequireRoleOrScope(utils.EditorRole, utils.ApiKeyScopeVodWrite))
 	vodGroup.DELETE("/:id", h.DeleteVod, AuthAPIKeyOrSessionMiddleware, AuthGetUserMiddleware, RequireRoleOrScope(utils.AdminRole, utils.ApiKeyScopeVodAdmin))
 	vodGroup.GET("/:id/playlist", h.GetVodPlaylists)
 	vodGroup.GET("/:id/clips", h.GetVodClips)
+	vodGroup.GET("/:id/versions", h.GetVodVersions)
+	vodGroup.GET("/:id/renditions", h.GetVodRenditions)
+	vodGroup.GET("/:id/gaps", h.GetVodLiveGaps)
 	vodGroup.GET("/paginate", h.GetVodsPagination)
 	vodGroup.GET("/:id/chat", h.GetVodChatComments)
 	vodGroup.GET("/:id/chat/chatter/:chatter_id", h.GetVodChatCommentsFromChatter)
@@ -265,6 +285,10 @@ func groupV1Routes(e *echo.Group, h *Handler) {
 	vodGroup.POST("/:id/lock", h.LockVod, AuthAPIKeyOrSessionMiddleware, AuthGetUserMiddleware, RequireRoleOrScope(utils.EditorRole, utils.ApiKeyScopeVodWrite))
 	vodGroup.POST("/:id/generate-static-thumbnail", h.GenerateStaticThumbnail, AuthAPIKeyOrSessionMiddleware, AuthGetUserMiddleware, RequireRoleOrScope(utils.EditorRole, utils.ApiKeyScopeVodWrite))
 	vodGroup.POST("/:id/generate-sprite-thumbnails", h.GenerateSpriteThumbnails, AuthAPIKeyOrSessionMiddleware, AuthGetUserMiddleware, RequireRoleOrScope(utils.EditorRole, utils.ApiKeyScopeVodWrite))
+	vodGroup.POST("/:id/generate-captions", h.GenerateCaptions, AuthAPIKeyOrSessionMiddleware, AuthGetUserMiddleware, RequireRoleOrScope(utils.EditorRole, utils.ApiKeyScopeVodWrite))
+	vodGroup.POST("/:id/repair", h.RepairVod, AuthAPIKeyOrSessionMiddleware, AuthGetUserMiddleware, RequireRoleOrScope(utils.EditorRole, utils.ApiKeyScopeVodWrite))
+	vodGroup.POST("/:id/recover-muted-audio", h.RecoverVodMutedAudio, AuthAPIKeyOrSessionMiddleware, AuthGetUserMiddleware, RequireRoleOrScope(utils.EditorRole, utils.ApiKeyScopeVodWrite))
+	vodGroup.POST("/:id/versions/reconcile", h.ReconcileVodVersions, AuthAPIKeyOrSessionMiddleware, AuthGetUserMiddleware, RequireRoleOrScope(utils.AdminRole, utils.ApiKeyScopeVodAdmin))
 	vodGroup.GET("/:id/thumbnails/vtt", h.GetVodSpriteThumbnails)
 	vodGroup.POST("/:id/ffprobe", h.GetFFprobe, AuthAPIKeyOrSessionMiddleware, AuthGetUserMiddleware, RequireRoleOrScope(utils.ArchiverRole, utils.ApiKeyScopeVodWrite))
 
@@ -297,11 +321,15 @@ func groupV1Routes(e *echo.Group, h *Handler) {
 	//
 	// All POSTs accept either a session cookie or an API key. Archive
 	// channel/video are write-tier (Archiver role); the chat converter
-	// is admin-tier (Admin role).
+	// and importing existing files are admin-tier (Admin role).
 	archiveGroup := e.Group("/archive")
 	archiveGroup.POST("/channel", h.ArchiveChannel, AuthAPIKeyOrSessionMiddleware, AuthGetUserMiddleware, RequireRoleOrScope(utils.ArchiverRole, utils.ApiKeyScopeArchiveWrite))
 	archiveGroup.POST("/video", h.ArchiveVideo, AuthAPIKeyOrSessionMiddleware, AuthGetUserMiddleware, RequireRoleOrScope(utils.ArchiverRole, utils.ApiKeyScopeArchiveWrite))
+	archiveGroup.POST("/clip", h.CreateClip, AuthAPIKeyOrSessionMiddleware, AuthGetUserMiddleware, RequireRoleOrScope(utils.EditorRole, utils.ApiKeyScopeArchiveWrite))
 	archiveGroup.POST("/convert-twitch-live-chat", h.ConvertTwitchChat, AuthAPIKeyOrSessionMiddleware, AuthGetUserMiddleware, RequireRoleOrScope(utils.AdminRole, utils.ApiKeyScopeArchiveAdmin))
+	archiveGroup.POST("/import/scan", h.ScanImport, AuthAPIKeyOrSessionMiddleware, AuthGetUserMiddleware, RequireRoleOrScope(utils.AdminRole, utils.ApiKeyScopeArchiveAdmin))
+	archiveGroup.POST("/import/confirm", h.ConfirmImport, AuthAPIKeyOrSessionMiddleware, AuthGetUserMiddleware, RequireRoleOrScope(utils.AdminRole, utils.ApiKeyScopeArchiveAdmin))
+	archiveGroup.POST("/reencode", h.ReencodeVideos, AuthAPIKeyOrSessionMiddleware, AuthGetUserMiddleware, RequireRoleOrScope(utils.AdminRole, utils.ApiKeyScopeArchiveAdmin))
 
 	// Admin: system stats and info.
 	//
@@ -314,6 +342,9 @@ func groupV1Routes(e *echo.Group, h *Handler) {
 	adminGroup.GET("/system-overview", h.GetSystemOverview, AuthAPIKeyOrSessionMiddleware, AuthGetUserMiddleware, RequireRoleOrScope(utils.AdminRole, utils.ApiKeyScopeSystemRead))
 	adminGroup.GET("/storage-distribution", h.GetStorageDistribution, AuthAPIKeyOrSessionMiddleware, AuthGetUserMiddleware, RequireRoleOrScope(utils.AdminRole, utils.ApiKeyScopeSystemRead))
 	adminGroup.GET("/info", h.GetInfo, AuthAPIKeyOrSessionMiddleware, AuthGetUserMiddleware, RequireRoleOrScope(utils.AdminRole, utils.ApiKeyScopeSystemRead))
+	adminGroup.GET("/library-audit", h.GetLibraryAudit, AuthAPIKeyOrSessionMiddleware, AuthGetUserMiddleware, RequireRoleOrScope(utils.AdminRole, utils.ApiKeyScopeSystemRead))
+	adminGroup.GET("/viewing-statistics", h.GetViewingStatistics, AuthAPIKeyOrSessionMiddleware, AuthGetUserMiddleware, RequireRoleOrScope(utils.AdminRole, utils.ApiKeyScopeSystemRead))
+	adminGroup.GET("/viewing-statistics/videos/:id/heatmap", h.GetVideoWatchHeatmap, AuthAPIKeyOrSessionMiddleware, AuthGetUserMiddleware, RequireRoleOrScope(utils.AdminRole, utils.ApiKeyScopeSystemRead))
 
 	// Admin: API keys. Session-only — admins must use the web UI to mint
 	// or revoke keys. This avoids the chicken-and-egg of needing a key
@@ -339,6 +370,12 @@ func groupV1Routes(e *echo.Group, h *Handler) {
 	configGroup := e.Group("/config")
 	configGroup.GET("", h.GetConfig, AuthAPIKeyOrSessionMiddleware, AuthGetUserMiddleware, RequireRoleOrScope(utils.AdminRole, utils.ApiKeyScopeConfigRead))
 	configGroup.PUT("", h.UpdateConfig, AuthAPIKeyOrSessionMiddleware, AuthGetUserMiddleware, RequireRoleOrScope(utils.AdminRole, utils.ApiKeyScopeConfigWrite))
+	// Transcoding profiles are listed to archivers so they can pick one
+	// when archiving a video or editing a watched channel.
+	configGroup.GET("/transcoding-profiles", h.GetTranscodingProfiles, AuthAPIKeyOrSessionMiddleware, AuthGetUserMiddleware, RequireRoleOrScope(utils.ArchiverRole, utils.ApiKeyScopeConfigRead))
+	configGroup.POST("/transcoding-profiles", h.CreateTranscodingProfile, AuthAPIKeyOrSessionMiddleware, AuthGetUserMiddleware, RequireRoleOrScope(utils.AdminRole, utils.ApiKeyScopeConfigWrite))
+	configGroup.PUT("/transcoding-profiles/:id", h.UpdateTranscodingProfile, AuthAPIKeyOrSessionMiddleware, AuthGetUserMiddleware, RequireRoleOrScope(utils.AdminRole, utils.ApiKeyScopeConfigWrite))
+	configGroup.DELETE("/transcoding-profiles/:id", h.DeleteTranscodingProfile, AuthAPIKeyOrSessionMiddleware, AuthGetUserMiddleware, RequireRoleOrScope(utils.AdminRole, utils.ApiKeyScopeConfigAdmin))
 
 	// Live
 	//
@@ -362,6 +399,32 @@ func groupV1Routes(e *echo.Group, h *Handler) {
 	playbackGroup.DELETE("/:id", h.DeleteProgress, AuthGuardMiddleware, AuthGetUserMiddleware)
 	playbackGroup.GET("/last", h.GetLastPlaybacks, AuthGuardMiddleware, AuthGetUserMiddleware)
 	playbackGroup.POST("/start", h.StartPlayback)
+	playbackGroup.POST("/sessions", h.StartViewingSession, AuthGuardMiddleware, AuthGetUserMiddleware)
+	playbackGroup.PUT("/sessions/:id", h.AddViewingSessionRanges, AuthGuardMiddleware, AuthGetUserMiddleware)
+	playbackGroup.GET("/statistics", h.GetMyViewingStatistics, AuthGuardMiddleware, AuthGetUserMiddleware)
+
+	// Watch party
+	watchPartyGroup := e.Group("/watch-party")
+	watchPartyGroup.POST("", h.CreateWatchParty, AuthGuardMiddleware, AuthGetUserMiddleware)
+	watchPartyGroup.GET("/:id", h.GetWatchParty, AuthGuardMiddleware, AuthGetUserMiddleware)
+	watchPartyGroup.GET("/:id/ws", h.JoinWatchParty, AuthGuardMiddleware, AuthGetUserMiddleware)
+
+	// Me
+	//
+	// Personal video lists of the user. Shared lists are read-only and
+	// public like the videos themselves.
+	meGroup := e.Group("/me")
+	meGroup.GET("/lists", h.GetUserLists, AuthGuardMiddleware, AuthGetUserMiddleware)
+	meGroup.POST("/lists", h.CreateUserList, AuthGuardMiddleware, AuthGetUserMiddleware)
+	meGroup.GET("/lists/:id", h.GetUserList, AuthGuardMiddleware, AuthGetUserMiddleware)
+	meGroup.PUT("/lists/:id", h.UpdateUserList, AuthGuardMiddleware, AuthGetUserMiddleware)
+	meGroup.DELETE("/lists/:id", h.DeleteUserList, AuthGuardMiddleware, AuthGetUserMiddleware)
+	meGroup.POST("/lists/:id/videos", h.AddVideoToUserList, AuthGuardMiddleware, AuthGetUserMiddleware)
+	meGroup.DELETE("/lists/:id/videos/:video_id", h.RemoveVideoFromUserList, AuthGuardMiddleware, AuthGetUserMiddleware)
+	meGroup.PUT("/lists/:id/order", h.ReorderUserList, AuthGuardMiddleware, AuthGetUserMiddleware)
+	meGroup.POST("/lists/:id/share", h.ShareUserList, AuthGuardMiddleware, AuthGetUserMiddleware)
+	meGroup.DELETE("/lists/:id/share", h.UnshareUserList, AuthGuardMiddleware, AuthGetUserMiddleware)
+	e.GET("/shared-lists/:token", h.GetSharedUserList)
 
 	// Playlist
 	//
@@ -0,0 +1,82 @@
+package http
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/zibbp/ganymede/internal/importer"
+)
+
+type ImportService interface {
+	Scan(ctx context.Context, directory string) (*importer.Report, error)
+	Confirm(ctx context.Context, input importer.ConfirmInput) (*importer.ConfirmResult, error)
+}
+
+type ScanImportRequest struct {
+	Directory string `json:"directory"` // absolute or relative to the videos directory, defaults to the videos directory
+}
+
+// ScanImport godoc
+//
+//	@Summary		Scan a directory for videos to import
+//	@Description	Returns the videos found below the directory with the metadata read from their info files, NFO files or storage template without importing them. The directory must be inside the videos directory.
+//	@Tags			archive
+//	@Accept			json
+//	@Produce		json
+//	@Param			body	body		ScanImportRequest	true	"Directory"
+//	@Success		200		{object}	importer.Report
+//	@Failure		400		{object}	utils.ErrorResponse
+//	@Failure		500		{object}	utils.ErrorResponse
+//	@Router			/archive/import/scan [post]
+//	@Security		ApiKeyCookieAuth
+//	@Security		ApiKeyAuth
+func (h *Handler) ScanImport(c echo.Context) error {
+	body := new(ScanImportRequest)
+	if err := c.Bind(body); err != nil {
+		return ErrorResponse(c, http.StatusBadRequest, err.Error())
+	}
+
+	report, err := h.Service.ImportService.Scan(c.Request().Context(), body.Directory)
+	if err != nil {
+		if errors.Is(err, importer.ErrInvalidDirectory) || errors.Is(err, importer.ErrUnsupportedDriver) {
+			return ErrorResponse(c, http.StatusBadRequest, err.Error())
+		}
+		return ErrorResponse(c, http.StatusInternalServerError, err.Error())
+	}
+	return SuccessResponse(c, report, "import scan")
+}
+
+// ConfirmImport godoc
+//
+//	@Summary		Import videos
+//	@Description	Imports the reviewed items of an import scan. Missing channels are created and each video is probed and gets its thumbnails generated by a task. Set fetch_metadata to create channels and update videos with the metadata from their platform.
+//	@Tags			archive
+//	@Accept			json
+//	@Produce		json
+//	@Param			body	body		importer.ConfirmInput	true	"Items to import"
+//	@Success		200		{object}	importer.ConfirmResult
+//	@Failure		400		{object}	utils.ErrorResponse
+//	@Failure		500		{object}	utils.ErrorResponse
+//	@Router			/archive/import/confirm [post]
+//	@Security		ApiKeyCookieAuth
+//	@Security		ApiKeyAuth
+func (h *Handler) ConfirmImport(c echo.Context) error {
+	body := new(importer.ConfirmInput)
+	if err := c.Bind(body); err != nil {
+		return ErrorResponse(c, http.StatusBadRequest, err.Error())
+	}
+	if err := c.Validate(body); err != nil {
+		return ErrorResponse(c, http.StatusBadRequest, err.Error())
+	}
+
+	result, err := h.Service.ImportService.Confirm(c.Request().Context(), *body)
+	if err != nil {
+		if errors.Is(err, importer.ErrUnsupportedDriver) {
+			return ErrorResponse(c, http.StatusBadRequest, err.Error())
+		}
+		return ErrorResponse(c, http.StatusInternalServerError, err.Error())
+	}
+	return SuccessResponse(c, result, "import confirmed")
+}
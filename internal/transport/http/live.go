@@ -3,11 +3,13 @@ package http
 import (
 	"context"
 	"net/http"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/labstack/echo/v4"
 	"github.com/zibbp/ganymede/ent"
 	"github.com/zibbp/ganymede/internal/live"
+	"github.com/zibbp/ganymede/internal/utils"
 )
 
 type LiveService interface {
@@ -30,6 +32,7 @@ type AddWatchedChannelRequest struct {
 	VodResolution          string              `json:"vod_resolution" validate:"omitempty,oneof=best 1440p 1080p 720p 480p 360p 160p audio"`
 	ArchiveChat            bool                `json:"archive_chat" validate:"boolean"`
 	RenderChat             bool                `json:"render_chat" validate:"boolean"`
+	GenerateCaptions       bool                `json:"generate_captions" validate:"boolean"`
 	DownloadSubOnly        bool                `json:"download_sub_only" validate:"boolean"`
 	Categories             []string            `json:"categories"`
 	ApplyCategoriesToLive  bool                `json:"apply_categories_to_live" validate:"boolean"`
@@ -42,6 +45,12 @@ type AddWatchedChannelRequest struct {
 	ClipsIntervalDays      int                 `json:"clips_interval_days" validate:"number,gte=1"`
 	ClipsIgnoreLastChecked bool                `json:"clips_ignore_last_checked" validate:"boolean"`
 	UpdateMetadataMinutes  int                 `json:"update_metadata_minutes" validate:"number,gte=0"` // Queue metadata update X minutes after the stream is live. Set to 0 to disable.
+	TranscodingProfileID   *uuid.UUID          `json:"transcoding_profile_id"`                          // Post-process archives with the transcoding profile instead of the global video convert arguments.
+	RecordAudioFallback    bool                `json:"record_audio_fallback" validate:"boolean"`
+	SplitHours             int                 `json:"split_hours" validate:"number,gte=0"` // Split live stream archives into parts every X hours. Set to 0 to disable.
+	SplitOnCategoryChange  bool                `json:"split_on_category_change" validate:"boolean"`
+	QualityFallbacks       []string            `json:"quality_fallbacks" validate:"max=5,dive,required,max=16"` // Qualities tried in order when archiving the live stream keeps failing, e.g. 720p60 or best.
+	Schedule               *AddLiveSchedule    `json:"schedule"`
 }
 
 type AddLiveTitleRegex struct {
@@ -50,6 +59,20 @@ type AddLiveTitleRegex struct {
 	ApplyToVideos bool   `json:"apply_to_videos" validate:"boolean"`
 }
 
+// AddLiveSchedule restricts when the live streams of a watched channel are archived.
+type AddLiveSchedule struct {
+	Timezone         string                  `json:"timezone" validate:"omitempty,timezone"` // UTC if empty
+	Windows          []AddLiveScheduleWindow `json:"windows" validate:"max=14,dive"`
+	MaxStreamHours   int                     `json:"max_stream_hours" validate:"gte=0"` // Stop archiving a live stream after this many hours. Set to 0 to disable.
+	FirstStreamOfDay bool                    `json:"first_stream_of_day" validate:"boolean"`
+}
+
+type AddLiveScheduleWindow struct {
+	Days  []time.Weekday `json:"days" validate:"max=7,dive,gte=0,lte=6" swaggertype:"array,integer"` // 0 is Sunday; every day if empty
+	Start string         `json:"start" validate:"required,datetime=15:04"`
+	End   string         `json:"end" validate:"required,datetime=15:04"`
+}
+
 type UpdateWatchedChannelRequest struct {
 	WatchLive              bool                `json:"watch_live" validate:"boolean"`
 	WatchVod               bool                `json:"watch_vod" validate:"boolean"`
@@ -60,6 +83,7 @@ type UpdateWatchedChannelRequest struct {
 	VodResolution          string              `json:"vod_resolution" validate:"omitempty,oneof=best 1440p 1080p 720p 480p 360p 160p audio"`
 	ArchiveChat            bool                `json:"archive_chat" validate:"boolean"`
 	RenderChat             bool                `json:"render_chat" validate:"boolean"`
+	GenerateCaptions       bool                `json:"generate_captions" validate:"boolean"`
 	DownloadSubOnly        bool                `json:"download_sub_only" validate:"boolean"`
 	Categories             []string            `json:"categories"`
 	ApplyCategoriesToLive  bool                `json:"apply_categories_to_live" validate:"boolean"`
@@ -72,6 +96,12 @@ type UpdateWatchedChannelRequest struct {
 	ClipsIntervalDays      int                 `json:"clips_interval_days" validate:"number,gte=1"`
 	ClipsIgnoreLastChecked bool                `json:"clips_ignore_last_checked" validate:"boolean"`
 	UpdateMetadataMinutes  int                 `json:"update_metadata_minutes" validate:"number,gte=0"` // Queue metadata update X minutes after the stream is live. Set to 0 to disable.
+	TranscodingProfileID   *uuid.UUID          `json:"transcoding_profile_id"`                          // Post-process archives with the transcoding profile instead of the global video convert arguments.
+	RecordAudioFallback    bool                `json:"record_audio_fallback" validate:"boolean"`
+	SplitHours             int                 `json:"split_hours" validate:"number,gte=0"` // Split live stream archives into parts every X hours. Set to 0 to disable.
+	SplitOnCategoryChange  bool                `json:"split_on_category_change" validate:"boolean"`
+	QualityFallbacks       []string            `json:"quality_fallbacks" validate:"max=5,dive,required,max=16"` // Qualities tried in order when archiving the live stream keeps failing, e.g. 720p60 or best.
+	Schedule               *AddLiveSchedule    `json:"schedule"`
 }
 
 type ConvertChatRequest struct {
@@ -163,6 +193,7 @@ func (h *Handler) AddLiveWatchedChannel(c echo.Context) error {
 		Resolution:             ccr.Resolution,
 		VodResolution:          ccr.VodResolution,
 		RenderChat:             ccr.RenderChat,
+		GenerateCaptions:       ccr.GenerateCaptions,
 		DownloadSubOnly:        ccr.DownloadSubOnly,
 		Categories:             ccr.Categories,
 		ApplyCategoriesToLive:  ccr.ApplyCategoriesToLive,
@@ -174,6 +205,12 @@ func (h *Handler) AddLiveWatchedChannel(c echo.Context) error {
 		ClipsIntervalDays:      ccr.ClipsIntervalDays,
 		ClipsIgnoreLastChecked: ccr.ClipsIgnoreLastChecked,
 		UpdateMetadataMinutes:  ccr.UpdateMetadataMinutes,
+		TranscodingProfileID:   ccr.TranscodingProfileID,
+		QualityFallbacks:       ccr.QualityFallbacks,
+		RecordAudioFallback:    ccr.RecordAudioFallback,
+		SplitHours:             ccr.SplitHours,
+		SplitOnCategoryChange:  ccr.SplitOnCategoryChange,
+		Schedule:               ccr.Schedule.toEnt(),
 	}
 
 	for _, regex := range ccr.Regex {
@@ -195,6 +232,25 @@ func (h *Handler) AddLiveWatchedChannel(c echo.Context) error {
 	return SuccessResponse(c, l, "created watched channel")
 }
 
+// toEnt returns the schedule as an ent.LiveSchedule, or nil if no schedule is set.
+func (schedule *AddLiveSchedule) toEnt() *ent.LiveSchedule {
+	if schedule == nil {
+		return nil
+	}
+	liveSchedule := &ent.LiveSchedule{
+		Timezone:         schedule.Timezone,
+		MaxStreamHours:   schedule.MaxStreamHours,
+		FirstStreamOfDay: schedule.FirstStreamOfDay,
+	}
+	if liveSchedule.Timezone == "" {
+		liveSchedule.Timezone = "UTC"
+	}
+	for _, window := range schedule.Windows {
+		liveSchedule.Windows = append(liveSchedule.Windows, utils.LiveScheduleWindow{Days: window.Days, Start: window.Start, End: window.End})
+	}
+	return liveSchedule
+}
+
 // UpdateLiveWatchedChannel godoc
 //
 //	@Summary		Update watched channel
@@ -244,6 +300,7 @@ func (h *Handler) UpdateLiveWatchedChannel(c echo.Context) error {
 		Resolution:             ccr.Resolution,
 		VodResolution:          ccr.VodResolution,
 		RenderChat:             ccr.RenderChat,
+		GenerateCaptions:       ccr.GenerateCaptions,
 		DownloadSubOnly:        ccr.DownloadSubOnly,
 		Categories:             ccr.Categories,
 		ApplyCategoriesToLive:  ccr.ApplyCategoriesToLive,
@@ -255,6 +312,12 @@ func (h *Handler) UpdateLiveWatchedChannel(c echo.Context) error {
 		ClipsIntervalDays:      ccr.ClipsIntervalDays,
 		ClipsIgnoreLastChecked: ccr.ClipsIgnoreLastChecked,
 		UpdateMetadataMinutes:  ccr.UpdateMetadataMinutes,
+		TranscodingProfileID:   ccr.TranscodingProfileID,
+		QualityFallbacks:       ccr.QualityFallbacks,
+		RecordAudioFallback:    ccr.RecordAudioFallback,
+		SplitHours:             ccr.SplitHours,
+		SplitOnCategoryChange:  ccr.SplitOnCategoryChange,
+		Schedule:               ccr.Schedule.toEnt(),
 	}
 
 	for _, regex := range ccr.Regex {
@@ -11,6 +11,7 @@ import (
 	"github.com/labstack/echo/v4"
 	"github.com/zibbp/ganymede/ent"
 	"github.com/zibbp/ganymede/internal/playback"
+	"github.com/zibbp/ganymede/internal/utils"
 )
 
 type PlaybackService interface {
@@ -21,6 +22,8 @@ type PlaybackService interface {
 	DeleteProgress(ctx context.Context, userId uuid.UUID, videoId uuid.UUID) error
 	GetLastPlaybacks(ctx context.Context, userId uuid.UUID, limit int) (*playback.GetPlaybackResp, error)
 	StartPlayback(c echo.Context, videoId uuid.UUID) error
+	StartViewingSession(ctx context.Context, userId uuid.UUID, videoId uuid.UUID, device string) (*ent.ViewingSession, error)
+	AddViewingSessionRanges(ctx context.Context, userId uuid.UUID, sessionId uuid.UUID, ranges []utils.WatchedRange) (*ent.ViewingSession, error)
 }
 
 type UpdateProgressRequest struct {
@@ -33,6 +36,15 @@ type UpdateStatusRequest struct {
 	Status string `json:"status" validate:"required,oneof=in_progress finished"`
 }
 
+type StartViewingSessionRequest struct {
+	VodID  string `json:"vod_id" validate:"required,uuid"`
+	Device string `json:"device"` // defaults to the user agent of the request
+}
+
+type AddViewingSessionRangesRequest struct {
+	Ranges []utils.WatchedRange `json:"ranges" validate:"required,min=1,max=100"`
+}
+
 // UpdateProgress godoc
 //
 //	@Summary		Update progress
@@ -217,3 +229,115 @@ func (h *Handler) StartPlayback(c echo.Context) error {
 
 	return SuccessResponse(c, "", "ok")
 }
+
+// StartViewingSession godoc
+//
+//	@Summary		Start viewing session
+//	@Description	Start logging a viewing session of the video. The ranges played are added to the session while watching.
+//	@Tags			Playback
+//	@Accept			json
+//	@Produce		json
+//	@Param			session	body		StartViewingSessionRequest	true	"viewing session"
+//	@Success		200		{object}	ent.ViewingSession
+//	@Failure		400		{object}	utils.ErrorResponse
+//	@Failure		404		{object}	utils.ErrorResponse
+//	@Failure		500		{object}	utils.ErrorResponse
+//	@Router			/playback/sessions [post]
+//	@Security		ApiKeyCookieAuth
+func (h *Handler) StartViewingSession(c echo.Context) error {
+	user := userFromContext(c)
+	req := new(StartViewingSessionRequest)
+	if err := c.Bind(req); err != nil {
+		return ErrorResponse(c, http.StatusBadRequest, err.Error())
+	}
+	if err := c.Validate(req); err != nil {
+		return ErrorResponse(c, http.StatusBadRequest, err.Error())
+	}
+	vID, err := uuid.Parse(req.VodID)
+	if err != nil {
+		return ErrorResponse(c, http.StatusBadRequest, "invalid vod id")
+	}
+	device := req.Device
+	if device == "" {
+		device = c.Request().UserAgent()
+	}
+
+	session, err := h.Service.PlaybackService.StartViewingSession(c.Request().Context(), user.ID, vID, device)
+	if err != nil {
+		if err.Error() == "vod not found" {
+			return ErrorResponse(c, http.StatusNotFound, err.Error())
+		}
+		return ErrorResponse(c, http.StatusInternalServerError, err.Error())
+	}
+	return SuccessResponse(c, session, "viewing session started")
+}
+
+// AddViewingSessionRanges godoc
+//
+//	@Summary		Add viewing session ranges
+//	@Description	Add the ranges of the video played since the last update to the viewing session
+//	@Tags			Playback
+//	@Accept			json
+//	@Produce		json
+//	@Param			id		path		string							true	"viewing session id"
+//	@Param			ranges	body		AddViewingSessionRangesRequest	true	"ranges"
+//	@Success		200		{object}	ent.ViewingSession
+//	@Failure		400		{object}	utils.ErrorResponse
+//	@Failure		404		{object}	utils.ErrorResponse
+//	@Failure		500		{object}	utils.ErrorResponse
+//	@Router			/playback/sessions/{id} [put]
+//	@Security		ApiKeyCookieAuth
+func (h *Handler) AddViewingSessionRanges(c echo.Context) error {
+	user := userFromContext(c)
+	sessionID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return ErrorResponse(c, http.StatusBadRequest, "invalid viewing session id")
+	}
+	req := new(AddViewingSessionRangesRequest)
+	if err := c.Bind(req); err != nil {
+		return ErrorResponse(c, http.StatusBadRequest, err.Error())
+	}
+	if err := c.Validate(req); err != nil {
+		return ErrorResponse(c, http.StatusBadRequest, err.Error())
+	}
+
+	session, err := h.Service.PlaybackService.AddViewingSessionRanges(c.Request().Context(), user.ID, sessionID, req.Ranges)
+	if err != nil {
+		if errors.Is(err, playback.ErrorViewingSessionNotFound) {
+			return ErrorResponse(c, http.StatusNotFound, err.Error())
+		}
+		if errors.Is(err, playback.ErrorInvalidViewingSessionRange) {
+			return ErrorResponse(c, http.StatusBadRequest, err.Error())
+		}
+		return ErrorResponse(c, http.StatusInternalServerError, err.Error())
+	}
+	return SuccessResponse(c, session, "viewing session updated")
+}
+
+// GetMyViewingStatistics godoc
+//
+//	@Summary		Get my viewing statistics
+//	@Description	Get the most watched channels and videos, completion rates and watch time of the viewing sessions of the user started in the date range
+//	@Tags			Playback
+//	@Produce		json
+//	@Param			from	query		string	false	"Start of the date range (YYYY-MM-DD or RFC3339)"
+//	@Param			to		query		string	false	"End of the date range (YYYY-MM-DD or RFC3339)"
+//	@Success		200		{object}	admin.GetViewingStatisticsResponse
+//	@Failure		400		{object}	utils.ErrorResponse
+//	@Failure		500		{object}	utils.ErrorResponse
+//	@Router			/playback/statistics [get]
+//	@Security		ApiKeyCookieAuth
+func (h *Handler) GetMyViewingStatistics(c echo.Context) error {
+	user := userFromContext(c)
+	filter, err := viewingStatisticsFilterFromQuery(c)
+	if err != nil {
+		return ErrorResponse(c, http.StatusBadRequest, err.Error())
+	}
+	filter.UserID = &user.ID
+
+	resp, err := h.Service.AdminService.GetViewingStatistics(c.Request().Context(), filter)
+	if err != nil {
+		return ErrorResponse(c, http.StatusInternalServerError, err.Error())
+	}
+	return SuccessResponse(c, resp, "viewing statistics")
+}
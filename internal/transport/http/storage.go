@@ -0,0 +1,56 @@
+package http
+
+import (
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/zibbp/ganymede/internal/storage"
+)
+
+// archiveFileHandler serves the files in the videos directory. When archives are stored in an
+// object storage, files that are not on the local disk are read from the storage instead. HLS
+// playlists are proxied so their relative segment paths resolve to this handler again, everything
+// else is redirected to a presigned URL.
+func archiveFileHandler(videosDir string, s storage.Storage) http.Handler {
+	fileServer := http.StripPrefix(videosDir, http.FileServer(http.Dir(videosDir)))
+	if s.Driver() == storage.DriverLocal {
+		return fileServer
+	}
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		path := filepath.Clean(r.URL.Path)
+		if !strings.HasPrefix(path, filepath.Clean(videosDir)+"/") {
+			http.NotFound(w, r)
+			return
+		}
+		if _, err := os.Stat(path); err == nil {
+			fileServer.ServeHTTP(w, r)
+			return
+		}
+
+		if strings.HasSuffix(path, ".m3u8") {
+			reader, err := s.Open(r.Context(), path)
+			if err != nil {
+				http.NotFound(w, r)
+				return
+			}
+			defer reader.Close()
+			w.Header().Set("Content-Type", "application/vnd.apple.mpegurl")
+			if r.Method == http.MethodHead {
+				return
+			}
+			_, _ = io.Copy(w, reader)
+			return
+		}
+
+		u, err := s.URL(r.Context(), path)
+		if err != nil {
+			http.NotFound(w, r)
+			return
+		}
+		http.Redirect(w, r, u, http.StatusFound)
+	})
+}
@@ -0,0 +1,84 @@
+package http
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/zibbp/ganymede/internal/storage"
+)
+
+// fakeStorage is an object storage holding files in memory.
+type fakeStorage struct {
+	files map[string]string
+}
+
+func (f *fakeStorage) Driver() storage.Driver { return storage.DriverS3 }
+
+func (f *fakeStorage) Save(ctx context.Context, src string, path string) error { return nil }
+
+func (f *fakeStorage) SaveDirectory(ctx context.Context, src string, path string) error { return nil }
+
+func (f *fakeStorage) Open(ctx context.Context, path string) (io.ReadCloser, error) {
+	data, ok := f.files[path]
+	if !ok {
+		return nil, os.ErrNotExist
+	}
+	return io.NopCloser(strings.NewReader(data)), nil
+}
+
+func (f *fakeStorage) Exists(ctx context.Context, path string) (bool, error) {
+	_, ok := f.files[path]
+	return ok, nil
+}
+
+func (f *fakeStorage) Delete(ctx context.Context, path string) error { return nil }
+
+func (f *fakeStorage) DeleteDirectory(ctx context.Context, path string) error { return nil }
+
+func (f *fakeStorage) URL(ctx context.Context, path string) (string, error) {
+	if _, ok := f.files[path]; !ok {
+		return "", errors.New("not found")
+	}
+	return "https://s3.example.com/bucket" + path + "?X-Amz-Signature=test", nil
+}
+
+func TestArchiveFileHandlerReadsFromObjectStorage(t *testing.T) {
+	videosDir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(videosDir, "local.mp4"), []byte("local"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	handler := archiveFileHandler(videosDir, &fakeStorage{files: map[string]string{
+		videosDir + "/channel/video.mp4":  "video",
+		videosDir + "/channel/video.m3u8": "#EXTM3U",
+	}})
+
+	serve := func(path string) *httptest.ResponseRecorder {
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, path, nil))
+		return rec
+	}
+
+	if rec := serve(videosDir + "/local.mp4"); rec.Code != http.StatusOK || rec.Body.String() != "local" {
+		t.Fatalf("expected local file to be served, got %d %q", rec.Code, rec.Body.String())
+	}
+	if rec := serve(videosDir + "/channel/video.m3u8"); rec.Code != http.StatusOK || rec.Body.String() != "#EXTM3U" {
+		t.Fatalf("expected playlist to be proxied, got %d %q", rec.Code, rec.Body.String())
+	}
+	rec := serve(videosDir + "/channel/video.mp4")
+	if rec.Code != http.StatusFound || !strings.HasPrefix(rec.Header().Get("Location"), "https://s3.example.com/bucket") {
+		t.Fatalf("expected redirect to presigned URL, got %d %q", rec.Code, rec.Header().Get("Location"))
+	}
+	if rec := serve(videosDir + "/channel/missing.mp4"); rec.Code != http.StatusNotFound {
+		t.Fatalf("expected missing file to return not found, got %d", rec.Code)
+	}
+	if rec := serve(videosDir + "/../outside.mp4"); rec.Code != http.StatusNotFound {
+		t.Fatalf("expected path outside the videos directory to return not found, got %d", rec.Code)
+	}
+}
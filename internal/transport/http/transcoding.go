@@ -0,0 +1,162 @@
+package http
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	"github.com/rs/zerolog/log"
+	"github.com/zibbp/ganymede/ent"
+	"github.com/zibbp/ganymede/internal/transcoding"
+)
+
+// TranscodingService defines the interface for transcoding profile operations.
+type TranscodingService interface {
+	GetProfiles(ctx context.Context) ([]*ent.TranscodingProfile, error)
+	CreateProfile(ctx context.Context, p transcoding.Profile) (*ent.TranscodingProfile, error)
+	UpdateProfile(ctx context.Context, id uuid.UUID, p transcoding.Profile) (*ent.TranscodingProfile, error)
+	DeleteProfile(ctx context.Context, id uuid.UUID) error
+}
+
+// TranscodingProfileRequest is the request body for creating and updating a transcoding profile.
+type TranscodingProfileRequest struct {
+	Name        string `json:"name" validate:"required,max=255"`
+	Description string `json:"description" validate:"max=1024"`
+	FfmpegArgs  string `json:"ffmpeg_args" validate:"max=4096"` // FFmpeg output arguments, e.g. "-c:v libx265 -crf 26 -c:a copy"
+}
+
+// statusForTranscodingServiceError maps transcoding service errors to HTTP statuses.
+func statusForTranscodingServiceError(err error) int {
+	switch {
+	case errors.Is(err, transcoding.ErrInvalidArgs), errors.Is(err, transcoding.ErrNameExists):
+		return http.StatusBadRequest
+	case ent.IsNotFound(err):
+		return http.StatusNotFound
+	default:
+		return http.StatusInternalServerError
+	}
+}
+
+// GetTranscodingProfiles godoc
+//
+//	@Summary		Get transcoding profiles
+//	@Description	Get the transcoding profiles that watched channels and archives can be post-processed with
+//	@Tags			config
+//	@Accept			json
+//	@Produce		json
+//	@Success		200	{array}		ent.TranscodingProfile
+//	@Failure		500	{object}	utils.ErrorResponse
+//	@Router			/config/transcoding-profiles [get]
+//	@Security		ApiKeyCookieAuth
+//	@Security		ApiKeyAuth
+func (h *Handler) GetTranscodingProfiles(c echo.Context) error {
+	profiles, err := h.Service.TranscodingService.GetProfiles(c.Request().Context())
+	if err != nil {
+		log.Error().Err(err).Msg("error getting transcoding profiles")
+		return ErrorResponse(c, http.StatusInternalServerError, "error getting transcoding profiles")
+	}
+	return SuccessResponse(c, profiles, "transcoding profiles")
+}
+
+// CreateTranscodingProfile godoc
+//
+//	@Summary		Create a transcoding profile
+//	@Description	Create a transcoding profile. The FFmpeg arguments are validated by post-processing a short test video.
+//	@Tags			config
+//	@Accept			json
+//	@Produce		json
+//	@Param			body	body		TranscodingProfileRequest	true	"Transcoding profile"
+//	@Success		200		{object}	ent.TranscodingProfile
+//	@Failure		400		{object}	utils.ErrorResponse
+//	@Failure		500		{object}	utils.ErrorResponse
+//	@Router			/config/transcoding-profiles [post]
+//	@Security		ApiKeyCookieAuth
+//	@Security		ApiKeyAuth
+func (h *Handler) CreateTranscodingProfile(c echo.Context) error {
+	body := new(TranscodingProfileRequest)
+	if err := c.Bind(body); err != nil {
+		return ErrorResponse(c, http.StatusBadRequest, err.Error())
+	}
+	if err := c.Validate(body); err != nil {
+		return ErrorResponse(c, http.StatusBadRequest, err.Error())
+	}
+
+	profile, err := h.Service.TranscodingService.CreateProfile(c.Request().Context(), transcoding.Profile{
+		Name:        body.Name,
+		Description: body.Description,
+		FfmpegArgs:  body.FfmpegArgs,
+	})
+	if err != nil {
+		return ErrorResponse(c, statusForTranscodingServiceError(err), err.Error())
+	}
+	return SuccessResponse(c, profile, "transcoding profile created")
+}
+
+// UpdateTranscodingProfile godoc
+//
+//	@Summary		Update a transcoding profile
+//	@Description	Update a transcoding profile. The FFmpeg arguments are validated by post-processing a short test video.
+//	@Tags			config
+//	@Accept			json
+//	@Produce		json
+//	@Param			id		path		string						true	"Transcoding profile ID"
+//	@Param			body	body		TranscodingProfileRequest	true	"Transcoding profile"
+//	@Success		200		{object}	ent.TranscodingProfile
+//	@Failure		400		{object}	utils.ErrorResponse
+//	@Failure		404		{object}	utils.ErrorResponse
+//	@Failure		500		{object}	utils.ErrorResponse
+//	@Router			/config/transcoding-profiles/{id} [put]
+//	@Security		ApiKeyCookieAuth
+//	@Security		ApiKeyAuth
+func (h *Handler) UpdateTranscodingProfile(c echo.Context) error {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return ErrorResponse(c, http.StatusBadRequest, "invalid id")
+	}
+	body := new(TranscodingProfileRequest)
+	if err := c.Bind(body); err != nil {
+		return ErrorResponse(c, http.StatusBadRequest, err.Error())
+	}
+	if err := c.Validate(body); err != nil {
+		return ErrorResponse(c, http.StatusBadRequest, err.Error())
+	}
+
+	profile, err := h.Service.TranscodingService.UpdateProfile(c.Request().Context(), id, transcoding.Profile{
+		Name:        body.Name,
+		Description: body.Description,
+		FfmpegArgs:  body.FfmpegArgs,
+	})
+	if err != nil {
+		return ErrorResponse(c, statusForTranscodingServiceError(err), err.Error())
+	}
+	return SuccessResponse(c, profile, "transcoding profile updated")
+}
+
+// DeleteTranscodingProfile godoc
+//
+//	@Summary		Delete a transcoding profile
+//	@Description	Delete a transcoding profile. Watched channels and videos using it fall back to the global video convert arguments.
+//	@Tags			config
+//	@Accept			json
+//	@Produce		json
+//	@Param			id	path	string	true	"Transcoding profile ID"
+//	@Success		200
+//	@Failure		400	{object}	utils.ErrorResponse
+//	@Failure		404	{object}	utils.ErrorResponse
+//	@Failure		500	{object}	utils.ErrorResponse
+//	@Router			/config/transcoding-profiles/{id} [delete]
+//	@Security		ApiKeyCookieAuth
+//	@Security		ApiKeyAuth
+func (h *Handler) DeleteTranscodingProfile(c echo.Context) error {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return ErrorResponse(c, http.StatusBadRequest, "invalid id")
+	}
+
+	if err := h.Service.TranscodingService.DeleteProfile(c.Request().Context(), id); err != nil {
+		return ErrorResponse(c, statusForTranscodingServiceError(err), err.Error())
+	}
+	return SuccessResponse(c, nil, "transcoding profile deleted")
+}
@@ -0,0 +1,371 @@
+package http
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	"github.com/zibbp/ganymede/ent"
+	"github.com/zibbp/ganymede/internal/userlist"
+)
+
+// UserListService defines the interface for the personal video lists of users.
+type UserListService interface {
+	GetLists(ctx context.Context, userId uuid.UUID) ([]*ent.UserList, error)
+	GetList(ctx context.Context, userId uuid.UUID, listId uuid.UUID) (*ent.UserList, error)
+	CreateList(ctx context.Context, userId uuid.UUID, name string) (*ent.UserList, error)
+	UpdateList(ctx context.Context, userId uuid.UUID, listId uuid.UUID, name string) (*ent.UserList, error)
+	DeleteList(ctx context.Context, userId uuid.UUID, listId uuid.UUID) error
+	AddVideo(ctx context.Context, userId uuid.UUID, listId uuid.UUID, videoId uuid.UUID) error
+	RemoveVideo(ctx context.Context, userId uuid.UUID, listId uuid.UUID, videoId uuid.UUID) error
+	ReorderList(ctx context.Context, userId uuid.UUID, listId uuid.UUID, videoIds []uuid.UUID) error
+	ShareList(ctx context.Context, userId uuid.UUID, listId uuid.UUID) (*ent.UserList, error)
+	UnshareList(ctx context.Context, userId uuid.UUID, listId uuid.UUID) (*ent.UserList, error)
+	GetSharedList(ctx context.Context, token string) (*userlist.SharedList, error)
+}
+
+type UserListRequest struct {
+	Name string `json:"name" validate:"required,min=1,max=100"`
+}
+
+type AddVideoToUserListRequest struct {
+	VideoID string `json:"video_id" validate:"required,uuid"`
+}
+
+type ReorderUserListRequest struct {
+	VideoIDs []uuid.UUID `json:"video_ids" validate:"required"`
+}
+
+// userListErrorResponse responds with the status of the error of the user list service.
+func userListErrorResponse(c echo.Context, err error) error {
+	switch {
+	case errors.Is(err, userlist.ErrorListNotFound), errors.Is(err, userlist.ErrorVideoNotInList), err.Error() == "vod not found":
+		return ErrorResponse(c, http.StatusNotFound, err.Error())
+	case errors.Is(err, userlist.ErrorListExists), errors.Is(err, userlist.ErrorReservedListName), errors.Is(err, userlist.ErrorVideoInList):
+		return ErrorResponse(c, http.StatusConflict, err.Error())
+	case errors.Is(err, userlist.ErrorDefaultList), errors.Is(err, userlist.ErrorInvalidListOrder):
+		return ErrorResponse(c, http.StatusBadRequest, err.Error())
+	}
+	return ErrorResponse(c, http.StatusInternalServerError, err.Error())
+}
+
+// GetUserLists godoc
+//
+//	@Summary		Get my lists
+//	@Description	Get the personal video lists of the user with their items. Every user has a watch later and a favorites list.
+//	@Tags			Me
+//	@Produce		json
+//	@Success		200	{object}	[]ent.UserList
+//	@Failure		500	{object}	utils.ErrorResponse
+//	@Router			/me/lists [get]
+//	@Security		ApiKeyCookieAuth
+func (h *Handler) GetUserLists(c echo.Context) error {
+	user := userFromContext(c)
+	lists, err := h.Service.UserListService.GetLists(c.Request().Context(), user.ID)
+	if err != nil {
+		return userListErrorResponse(c, err)
+	}
+	return SuccessResponse(c, lists, "lists")
+}
+
+// CreateUserList godoc
+//
+//	@Summary		Create list
+//	@Description	Create a custom personal video list
+//	@Tags			Me
+//	@Accept			json
+//	@Produce		json
+//	@Param			body	body		UserListRequest	true	"list"
+//	@Success		200		{object}	ent.UserList
+//	@Failure		400		{object}	utils.ErrorResponse
+//	@Failure		409		{object}	utils.ErrorResponse
+//	@Failure		500		{object}	utils.ErrorResponse
+//	@Router			/me/lists [post]
+//	@Security		ApiKeyCookieAuth
+func (h *Handler) CreateUserList(c echo.Context) error {
+	user := userFromContext(c)
+	req := new(UserListRequest)
+	if err := c.Bind(req); err != nil {
+		return ErrorResponse(c, http.StatusBadRequest, err.Error())
+	}
+	if err := c.Validate(req); err != nil {
+		return ErrorResponse(c, http.StatusBadRequest, err.Error())
+	}
+
+	list, err := h.Service.UserListService.CreateList(c.Request().Context(), user.ID, req.Name)
+	if err != nil {
+		return userListErrorResponse(c, err)
+	}
+	return SuccessResponse(c, list, "list created")
+}
+
+// GetUserList godoc
+//
+//	@Summary		Get my list
+//	@Description	Get a personal video list with its videos in order
+//	@Tags			Me
+//	@Produce		json
+//	@Param			id	path		string	true	"list id"
+//	@Success		200	{object}	ent.UserList
+//	@Failure		400	{object}	utils.ErrorResponse
+//	@Failure		404	{object}	utils.ErrorResponse
+//	@Failure		500	{object}	utils.ErrorResponse
+//	@Router			/me/lists/{id} [get]
+//	@Security		ApiKeyCookieAuth
+func (h *Handler) GetUserList(c echo.Context) error {
+	user := userFromContext(c)
+	listID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return ErrorResponse(c, http.StatusBadRequest, "invalid list id")
+	}
+
+	list, err := h.Service.UserListService.GetList(c.Request().Context(), user.ID, listID)
+	if err != nil {
+		return userListErrorResponse(c, err)
+	}
+	return SuccessResponse(c, list, "list")
+}
+
+// UpdateUserList godoc
+//
+//	@Summary		Update list
+//	@Description	Rename a custom personal video list
+//	@Tags			Me
+//	@Accept			json
+//	@Produce		json
+//	@Param			id		path		string			true	"list id"
+//	@Param			body	body		UserListRequest	true	"list"
+//	@Success		200		{object}	ent.UserList
+//	@Failure		400		{object}	utils.ErrorResponse
+//	@Failure		404		{object}	utils.ErrorResponse
+//	@Failure		409		{object}	utils.ErrorResponse
+//	@Failure		500		{object}	utils.ErrorResponse
+//	@Router			/me/lists/{id} [put]
+//	@Security		ApiKeyCookieAuth
+func (h *Handler) UpdateUserList(c echo.Context) error {
+	user := userFromContext(c)
+	listID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return ErrorResponse(c, http.StatusBadRequest, "invalid list id")
+	}
+	req := new(UserListRequest)
+	if err := c.Bind(req); err != nil {
+		return ErrorResponse(c, http.StatusBadRequest, err.Error())
+	}
+	if err := c.Validate(req); err != nil {
+		return ErrorResponse(c, http.StatusBadRequest, err.Error())
+	}
+
+	list, err := h.Service.UserListService.UpdateList(c.Request().Context(), user.ID, listID, req.Name)
+	if err != nil {
+		return userListErrorResponse(c, err)
+	}
+	return SuccessResponse(c, list, "list updated")
+}
+
+// DeleteUserList godoc
+//
+//	@Summary		Delete list
+//	@Description	Delete a custom personal video list
+//	@Tags			Me
+//	@Produce		json
+//	@Param			id	path		string	true	"list id"
+//	@Success		200	{object}	string
+//	@Failure		400	{object}	utils.ErrorResponse
+//	@Failure		404	{object}	utils.ErrorResponse
+//	@Failure		500	{object}	utils.ErrorResponse
+//	@Router			/me/lists/{id} [delete]
+//	@Security		ApiKeyCookieAuth
+func (h *Handler) DeleteUserList(c echo.Context) error {
+	user := userFromContext(c)
+	listID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return ErrorResponse(c, http.StatusBadRequest, "invalid list id")
+	}
+
+	if err := h.Service.UserListService.DeleteList(c.Request().Context(), user.ID, listID); err != nil {
+		return userListErrorResponse(c, err)
+	}
+	return SuccessResponse(c, "", "list deleted")
+}
+
+// AddVideoToUserList godoc
+//
+//	@Summary		Add video to list
+//	@Description	Add a video to the end of a personal video list
+//	@Tags			Me
+//	@Accept			json
+//	@Produce		json
+//	@Param			id		path		string						true	"list id"
+//	@Param			body	body		AddVideoToUserListRequest	true	"video"
+//	@Success		200		{object}	string
+//	@Failure		400		{object}	utils.ErrorResponse
+//	@Failure		404		{object}	utils.ErrorResponse
+//	@Failure		409		{object}	utils.ErrorResponse
+//	@Failure		500		{object}	utils.ErrorResponse
+//	@Router			/me/lists/{id}/videos [post]
+//	@Security		ApiKeyCookieAuth
+func (h *Handler) AddVideoToUserList(c echo.Context) error {
+	user := userFromContext(c)
+	listID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return ErrorResponse(c, http.StatusBadRequest, "invalid list id")
+	}
+	req := new(AddVideoToUserListRequest)
+	if err := c.Bind(req); err != nil {
+		return ErrorResponse(c, http.StatusBadRequest, err.Error())
+	}
+	if err := c.Validate(req); err != nil {
+		return ErrorResponse(c, http.StatusBadRequest, err.Error())
+	}
+	videoID, err := uuid.Parse(req.VideoID)
+	if err != nil {
+		return ErrorResponse(c, http.StatusBadRequest, "invalid video id")
+	}
+
+	if err := h.Service.UserListService.AddVideo(c.Request().Context(), user.ID, listID, videoID); err != nil {
+		return userListErrorResponse(c, err)
+	}
+	return SuccessResponse(c, "", "video added to list")
+}
+
+// RemoveVideoFromUserList godoc
+//
+//	@Summary		Remove video from list
+//	@Description	Remove a video from a personal video list
+//	@Tags			Me
+//	@Produce		json
+//	@Param			id			path		string	true	"list id"
+//	@Param			video_id	path		string	true	"video id"
+//	@Success		200			{object}	string
+//	@Failure		400			{object}	utils.ErrorResponse
+//	@Failure		404			{object}	utils.ErrorResponse
+//	@Failure		500			{object}	utils.ErrorResponse
+//	@Router			/me/lists/{id}/videos/{video_id} [delete]
+//	@Security		ApiKeyCookieAuth
+func (h *Handler) RemoveVideoFromUserList(c echo.Context) error {
+	user := userFromContext(c)
+	listID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return ErrorResponse(c, http.StatusBadRequest, "invalid list id")
+	}
+	videoID, err := uuid.Parse(c.Param("video_id"))
+	if err != nil {
+		return ErrorResponse(c, http.StatusBadRequest, "invalid video id")
+	}
+
+	if err := h.Service.UserListService.RemoveVideo(c.Request().Context(), user.ID, listID, videoID); err != nil {
+		return userListErrorResponse(c, err)
+	}
+	return SuccessResponse(c, "", "video removed from list")
+}
+
+// ReorderUserList godoc
+//
+//	@Summary		Reorder list
+//	@Description	Set the order of the videos of a personal video list. The order must contain every video of the list.
+//	@Tags			Me
+//	@Accept			json
+//	@Produce		json
+//	@Param			id		path		string					true	"list id"
+//	@Param			body	body		ReorderUserListRequest	true	"order"
+//	@Success		200		{object}	string
+//	@Failure		400		{object}	utils.ErrorResponse
+//	@Failure		404		{object}	utils.ErrorResponse
+//	@Failure		500		{object}	utils.ErrorResponse
+//	@Router			/me/lists/{id}/order [put]
+//	@Security		ApiKeyCookieAuth
+func (h *Handler) ReorderUserList(c echo.Context) error {
+	user := userFromContext(c)
+	listID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return ErrorResponse(c, http.StatusBadRequest, "invalid list id")
+	}
+	req := new(ReorderUserListRequest)
+	if err := c.Bind(req); err != nil {
+		return ErrorResponse(c, http.StatusBadRequest, err.Error())
+	}
+	if err := c.Validate(req); err != nil {
+		return ErrorResponse(c, http.StatusBadRequest, err.Error())
+	}
+
+	if err := h.Service.UserListService.ReorderList(c.Request().Context(), user.ID, listID, req.VideoIDs); err != nil {
+		return userListErrorResponse(c, err)
+	}
+	return SuccessResponse(c, "", "list reordered")
+}
+
+// ShareUserList godoc
+//
+//	@Summary		Share list
+//	@Description	Create a read-only link for a personal video list. The share_token of the list is used in the link.
+//	@Tags			Me
+//	@Produce		json
+//	@Param			id	path		string	true	"list id"
+//	@Success		200	{object}	ent.UserList
+//	@Failure		400	{object}	utils.ErrorResponse
+//	@Failure		404	{object}	utils.ErrorResponse
+//	@Failure		500	{object}	utils.ErrorResponse
+//	@Router			/me/lists/{id}/share [post]
+//	@Security		ApiKeyCookieAuth
+func (h *Handler) ShareUserList(c echo.Context) error {
+	user := userFromContext(c)
+	listID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return ErrorResponse(c, http.StatusBadRequest, "invalid list id")
+	}
+
+	list, err := h.Service.UserListService.ShareList(c.Request().Context(), user.ID, listID)
+	if err != nil {
+		return userListErrorResponse(c, err)
+	}
+	return SuccessResponse(c, list, "list shared")
+}
+
+// UnshareUserList godoc
+//
+//	@Summary		Unshare list
+//	@Description	Remove the read-only link of a personal video list
+//	@Tags			Me
+//	@Produce		json
+//	@Param			id	path		string	true	"list id"
+//	@Success		200	{object}	ent.UserList
+//	@Failure		400	{object}	utils.ErrorResponse
+//	@Failure		404	{object}	utils.ErrorResponse
+//	@Failure		500	{object}	utils.ErrorResponse
+//	@Router			/me/lists/{id}/share [delete]
+//	@Security		ApiKeyCookieAuth
+func (h *Handler) UnshareUserList(c echo.Context) error {
+	user := userFromContext(c)
+	listID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return ErrorResponse(c, http.StatusBadRequest, "invalid list id")
+	}
+
+	list, err := h.Service.UserListService.UnshareList(c.Request().Context(), user.ID, listID)
+	if err != nil {
+		return userListErrorResponse(c, err)
+	}
+	return SuccessResponse(c, list, "list unshared")
+}
+
+// GetSharedUserList godoc
+//
+//	@Summary		Get shared list
+//	@Description	Get a personal video list shared by link
+//	@Tags			Me
+//	@Produce		json
+//	@Param			token	path		string	true	"share token"
+//	@Success		200		{object}	userlist.SharedList
+//	@Failure		404		{object}	utils.ErrorResponse
+//	@Failure		500		{object}	utils.ErrorResponse
+//	@Router			/shared-lists/{token} [get]
+func (h *Handler) GetSharedUserList(c echo.Context) error {
+	list, err := h.Service.UserListService.GetSharedList(c.Request().Context(), c.Param("token"))
+	if err != nil {
+		return userListErrorResponse(c, err)
+	}
+	return SuccessResponse(c, list, "shared list")
+}
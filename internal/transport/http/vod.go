@@ -15,13 +15,13 @@ import (
 	"github.com/rs/zerolog/log"
 	"github.com/zibbp/ganymede/ent"
 	entChannel "github.com/zibbp/ganymede/ent/channel"
-	entChapter "github.com/zibbp/ganymede/ent/chapter"
-	"github.com/zibbp/ganymede/ent/predicate"
 	entVod "github.com/zibbp/ganymede/ent/vod"
 	"github.com/zibbp/ganymede/internal/chat"
 	"github.com/zibbp/ganymede/internal/config"
 	"github.com/zibbp/ganymede/internal/exec"
+	"github.com/zibbp/ganymede/internal/hls"
 	"github.com/zibbp/ganymede/internal/platform"
+	"github.com/zibbp/ganymede/internal/streamversions"
 	"github.com/zibbp/ganymede/internal/utils"
 	"github.com/zibbp/ganymede/internal/vod"
 )
@@ -34,7 +34,7 @@ type VodService interface {
 	GetVodByExternalId(ctx context.Context, externalId string) (*ent.Vod, error)
 	DeleteVod(ctx context.Context, vID uuid.UUID, deleteFiles bool) error
 	UpdateVod(c echo.Context, vID uuid.UUID, vod vod.Vod, cID uuid.UUID) (*ent.Vod, error)
-	SearchVods(ctx context.Context, limit int, offset int, types []utils.VodType, predicates []predicate.Vod, sortBy utils.VideoSort, order utils.SortOrder) (vod.Pagination, error)
+	SearchVods(ctx context.Context, params vod.SearchParams) (vod.SearchPagination, error)
 	GetVodPlaylists(c echo.Context, vID uuid.UUID) ([]*ent.Playlist, error)
 	GetVodsPagination(c echo.Context, limit int, offset int, channelId uuid.UUID, types []utils.VodType, playlistId uuid.UUID, processing bool, sortBy utils.VideoSort, sortOrder utils.SortOrder) (vod.Pagination, error)
 	GetVodChatComments(c echo.Context, vodID uuid.UUID, start float64, end float64) (*[]chat.Comment, error)
@@ -46,17 +46,28 @@ type VodService interface {
 	LockVod(c echo.Context, vID uuid.UUID, status bool) error
 	GenerateStaticThumbnail(ctx context.Context, videoID uuid.UUID) (*rivertype.JobInsertResult, error)
 	GenerateSpriteThumbnails(ctx context.Context, videoID uuid.UUID) (*rivertype.JobInsertResult, error)
+	GenerateCaptions(ctx context.Context, videoID uuid.UUID) (*rivertype.JobInsertResult, error)
+	GetChannelRetentionDryRun(ctx context.Context, channelID uuid.UUID) (*vod.RetentionDryRun, error)
+	GetLibraryAudit(ctx context.Context) (*vod.LibraryAudit, error)
+	RepairVideo(ctx context.Context, videoID uuid.UUID) (*vod.RepairResult, error)
+	RecoverMutedAudio(ctx context.Context, videoID uuid.UUID, offset *float64) error
+	GetVideoVersions(ctx context.Context, videoID uuid.UUID) ([]streamversions.Version, error)
+	GetVideoRenditions(ctx context.Context, videoID uuid.UUID) ([]hls.Variant, error)
+	GetVideoLiveGaps(ctx context.Context, videoID uuid.UUID) ([]*ent.LiveGap, error)
+	ReconcileVideoVersions(ctx context.Context, videoID uuid.UUID, policy utils.StreamVersionPolicy) error
 	GetVodClips(ctx context.Context, id uuid.UUID) ([]*ent.Vod, error)
 	GetVodChatHistogram(ctx context.Context, vodID uuid.UUID, resolutionSeconds float64) (map[int]int, error)
+	SearchChat(ctx context.Context, params vod.ChatSearchParams) (vod.ChatSearchPagination, error)
 }
 
 type CreateVodRequest struct {
 	ID               string              `json:"id"`
 	ChannelID        string              `json:"channel_id" validate:"required"`
 	ExtID            string              `json:"ext_id" validate:"min=1"`
-	Platform         utils.VideoPlatform `json:"platform" validate:"required,oneof=twitch youtube"`
+	Platform         utils.VideoPlatform `json:"platform" validate:"required,oneof=twitch youtube kick"`
 	Type             utils.VodType       `json:"type" validate:"required,oneof=archive live highlight upload clip"`
 	Title            string              `json:"title" validate:"required,min=1"`
+	Category         string              `json:"category"`
 	Duration         int                 `json:"duration" validate:"required"`
 	Views            int                 `json:"views" validate:"required"`
 	Resolution       string              `json:"resolution"`
@@ -73,12 +84,32 @@ type CreateVodRequest struct {
 }
 
 type SearchQueryParams struct {
-	Q      string          `query:"q" validate:"required"`
-	Limit  int             `query:"limit" default:"10" validate:"number"`
-	Offset int             `query:"offset" default:"0" validate:"number"`
-	Fields []string        `validate:"dive,oneof=title id ext_id chapter channel_name channel_id channel_ext_id"`
-	SortBy utils.VideoSort `query:"sort_by" default:"date" validate:"oneof=date views local_views created"`
-	Order  utils.SortOrder `query:"order" default:"desc" validate:"oneof=asc desc"`
+	Q           string          `query:"q"`
+	Limit       int             `query:"limit" validate:"min=1,max=100"`
+	Offset      int             `query:"offset" validate:"min=0"`
+	Fields      []string        `validate:"dive,oneof=title id ext_id chapter channel_name channel_id channel_ext_id"`
+	Types       []string        `validate:"dive,oneof=archive live highlight upload clip"`
+	ChannelIDs  []string        `validate:"dive,uuid"`
+	Category    string          `query:"category"`
+	From        string          `query:"from"`
+	To          string          `query:"to"`
+	MinDuration int             `query:"min_duration" validate:"min=0"`
+	MaxDuration int             `query:"max_duration" validate:"min=0"`
+	Resolutions []string        `validate:"dive,min=1"`
+	SortBy      utils.VideoSort `query:"sort_by" validate:"oneof=relevance date views local_views created"`
+	Order       utils.SortOrder `query:"order" validate:"oneof=asc desc"`
+}
+
+type ChatSearchQueryParams struct {
+	Q         string `query:"q"`
+	Chatter   string `query:"chatter"`
+	Emote     string `query:"emote"`
+	ChannelID string `query:"channel_id"`
+	VideoID   string `query:"video_id"`
+	From      string `query:"from"`
+	To        string `query:"to"`
+	Limit     int    `query:"limit" validate:"min=1,max=100"`
+	Offset    int    `query:"offset" validate:"min=0"`
 }
 
 // CreateVod godoc
@@ -133,6 +164,7 @@ func (h *Handler) CreateVod(c echo.Context) error {
 		Platform:         req.Platform,
 		Type:             req.Type,
 		Title:            req.Title,
+		Category:         req.Category,
 		Duration:         req.Duration,
 		Views:            req.Views,
 		Resolution:       req.Resolution,
@@ -342,6 +374,7 @@ func (h *Handler) UpdateVod(c echo.Context) error {
 		Platform:         req.Platform,
 		Type:             req.Type,
 		Title:            req.Title,
+		Category:         req.Category,
 		Duration:         req.Duration,
 		Views:            req.Views,
 		Resolution:       req.Resolution,
@@ -370,47 +403,67 @@ func (h *Handler) UpdateVod(c echo.Context) error {
 // SearchVods godoc
 //
 //	@Summary		Search vods
-//	@Description	Search vods
+//	@Description	Full-text search over the title, category, channel name and chapter titles of videos. Results are ranked by relevance and include facet counts per channel, type and year.
+//	@Description	The id, ext_id, channel_id and channel_ext_id fields look up videos by ID instead of using full-text search.
 //	@Tags			vods
 //	@Accept			json
 //	@Produce		json
-//	@Param			q		query		string	true	"Search query"
-//	@Param			limit	query		integer	false	"Limit"		default(10)
-//	@Param			offset	query		integer	false	"Offset"	default(0)
-//	@Success		200		{array}		ent.Vod
-//	@Failure		400		{object}	utils.ErrorResponse
-//	@Failure		500		{object}	utils.ErrorResponse
+//	@Param			q				query		string	false	"Search query"
+//	@Param			limit			query		integer	false	"Limit"		default(10)
+//	@Param			offset			query		integer	false	"Offset"	default(0)
+//	@Param			fields			query		string	false	"Comma separated fields to look up the query in (id, ext_id, channel_id, channel_ext_id). title, chapter and channel_name use full-text search."
+//	@Param			types			query		string	false	"Comma separated video types"
+//	@Param			channel_id		query		string	false	"Comma separated channel IDs"
+//	@Param			category		query		string	false	"Category or chapter title"
+//	@Param			from			query		string	false	"Streamed at or after this date (YYYY-MM-DD or RFC3339)"
+//	@Param			to				query		string	false	"Streamed at or before this date (YYYY-MM-DD or RFC3339)"
+//	@Param			min_duration	query		integer	false	"Minimum duration in seconds"
+//	@Param			max_duration	query		integer	false	"Maximum duration in seconds"
+//	@Param			resolution		query		string	false	"Comma separated resolutions"
+//	@Param			sort_by			query		string	false	"Sort by (relevance, date, views, local_views, created). Defaults to relevance when a query is provided, otherwise date."
+//	@Param			order			query		string	false	"Order (asc, desc)"	default(desc)
+//	@Success		200				{object}	vod.SearchPagination
+//	@Failure		400				{object}	utils.ErrorResponse
+//	@Failure		500				{object}	utils.ErrorResponse
 //	@Router			/vod/search [get]
 func (h *Handler) SearchVods(c echo.Context) error {
 	// Parse query params
-	var qp SearchQueryParams
-	qp.Q = c.QueryParam("q")
-	limit, err := strconv.Atoi(c.QueryParam("limit"))
-	if err != nil {
-		return ErrorResponse(c, http.StatusBadRequest, fmt.Errorf("invalid limit: %w", err).Error())
+	qp := SearchQueryParams{
+		Q:        strings.TrimSpace(c.QueryParam("q")),
+		Limit:    10,
+		Category: strings.TrimSpace(c.QueryParam("category")),
+		From:     c.QueryParam("from"),
+		To:       c.QueryParam("to"),
+	}
+	var err error
+	if limit := c.QueryParam("limit"); limit != "" {
+		qp.Limit, err = strconv.Atoi(limit)
+		if err != nil {
+			return ErrorResponse(c, http.StatusBadRequest, fmt.Errorf("invalid limit: %w", err).Error())
+		}
 	}
-
-	qp.Limit = limit
-	offset, err := strconv.Atoi(c.QueryParam("offset"))
-	if err != nil {
-		return ErrorResponse(c, http.StatusBadRequest, fmt.Errorf("invalid offset: %w", err).Error())
+	if offset := c.QueryParam("offset"); offset != "" {
+		qp.Offset, err = strconv.Atoi(offset)
+		if err != nil {
+			return ErrorResponse(c, http.StatusBadRequest, fmt.Errorf("invalid offset: %w", err).Error())
+		}
 	}
-
-	qp.Offset = offset
-	vTypes := c.QueryParam("types")
-	var types []utils.VodType
-	if vTypes != "" {
-		for _, vType := range strings.Split(vTypes, ",") {
-			types = append(types, utils.VodType(vType))
+	if minDuration := c.QueryParam("min_duration"); minDuration != "" {
+		qp.MinDuration, err = strconv.Atoi(minDuration)
+		if err != nil {
+			return ErrorResponse(c, http.StatusBadRequest, fmt.Errorf("invalid min_duration: %w", err).Error())
 		}
 	}
-
-	fieldsRaw := c.QueryParam("fields")
-	var fields []string
-	if fieldsRaw != "" {
-		fields = strings.Split(fieldsRaw, ",")
+	if maxDuration := c.QueryParam("max_duration"); maxDuration != "" {
+		qp.MaxDuration, err = strconv.Atoi(maxDuration)
+		if err != nil {
+			return ErrorResponse(c, http.StatusBadRequest, fmt.Errorf("invalid max_duration: %w", err).Error())
+		}
 	}
-	qp.Fields = fields
+	qp.Fields = splitQueryList(c.QueryParam("fields"))
+	qp.Types = splitQueryList(c.QueryParam("types"))
+	qp.ChannelIDs = splitQueryList(c.QueryParam("channel_id"))
+	qp.Resolutions = splitQueryList(c.QueryParam("resolution"))
 
 	qp.Order = utils.SortOrder(c.QueryParam("order"))
 	if qp.Order == "" {
@@ -418,7 +471,11 @@ func (h *Handler) SearchVods(c echo.Context) error {
 	}
 	qp.SortBy = utils.VideoSort(c.QueryParam("sort_by"))
 	if qp.SortBy == "" {
-		qp.SortBy = utils.SortDate
+		if qp.Q != "" {
+			qp.SortBy = utils.SortRelevance
+		} else {
+			qp.SortBy = utils.SortDate
+		}
 	}
 
 	// Validate query params
@@ -426,53 +483,93 @@ func (h *Handler) SearchVods(c echo.Context) error {
 		return ErrorResponse(c, http.StatusBadRequest, err.Error())
 	}
 
-	// Default field to title if not provided
-	if len(qp.Fields) == 0 {
-		qp.Fields = []string{"title"}
+	params := vod.SearchParams{
+		Query:       qp.Q,
+		Category:    qp.Category,
+		MinDuration: qp.MinDuration,
+		MaxDuration: qp.MaxDuration,
+		Resolutions: qp.Resolutions,
+		SortBy:      qp.SortBy,
+		Order:       qp.Order,
+		Limit:       qp.Limit,
+		Offset:      qp.Offset,
 	}
-
-	// Create predicates based on the qp.Fields
-	var predicates []predicate.Vod
-	for _, field := range qp.Fields {
-		switch field {
-		case "title":
-			predicates = append(predicates, entVod.TitleContainsFold(qp.Q))
-		case "id":
-			if id, err := uuid.Parse(qp.Q); err == nil {
-				predicates = append(predicates, entVod.IDEQ(id))
-			} else {
-				log.Info().Msg("invalid id format in query")
-			}
-		case "ext_id":
-			predicates = append(predicates, entVod.ExtIDContainsFold(qp.Q))
-		case "chapter":
-			predicates = append(predicates, entVod.HasChaptersWith(entChapter.TitleContainsFold(qp.Q)))
-		case "channel_name":
-			predicates = append(predicates, entVod.HasChannelWith(entChannel.NameContainsFold(qp.Q)))
-		case "channel_id":
-			if id, err := uuid.Parse(qp.Q); err == nil {
-				predicates = append(predicates, entVod.HasChannelWith(entChannel.IDEQ(id)))
-			} else {
-				log.Info().Msg("invalid channel id format in query")
-			}
-		case "channel_ext_id":
-			predicates = append(predicates, entVod.HasChannelWith(entChannel.ExtIDContainsFold(qp.Q)))
-		default:
+	for _, vType := range qp.Types {
+		params.Types = append(params.Types, utils.VodType(vType))
+	}
+	for _, id := range qp.ChannelIDs {
+		params.ChannelIDs = append(params.ChannelIDs, uuid.MustParse(id))
+	}
+	if qp.From != "" {
+		from, err := parseSearchDate(qp.From, false)
+		if err != nil {
+			return ErrorResponse(c, http.StatusBadRequest, fmt.Errorf("invalid from: %w", err).Error())
 		}
+		params.From = &from
 	}
-
-	// Default to title if no predicates are provided
-	if len(predicates) == 0 {
-		predicates = append(predicates, entVod.TitleContainsFold(qp.Q))
+	if qp.To != "" {
+		to, err := parseSearchDate(qp.To, true)
+		if err != nil {
+			return ErrorResponse(c, http.StatusBadRequest, fmt.Errorf("invalid to: %w", err).Error())
+		}
+		params.To = &to
+	}
+
+	// ID fields are looked up directly, the remaining fields are covered by the full-text search.
+	// The full-text search is used if none of the lookups are valid.
+	if qp.Q != "" {
+		for _, field := range qp.Fields {
+			switch field {
+			case "id":
+				if id, err := uuid.Parse(qp.Q); err == nil {
+					params.Predicates = append(params.Predicates, entVod.IDEQ(id))
+				} else {
+					log.Info().Msg("invalid id format in query")
+				}
+			case "ext_id":
+				params.Predicates = append(params.Predicates, entVod.ExtIDContainsFold(qp.Q))
+			case "channel_id":
+				if id, err := uuid.Parse(qp.Q); err == nil {
+					params.Predicates = append(params.Predicates, entVod.HasChannelWith(entChannel.IDEQ(id)))
+				} else {
+					log.Info().Msg("invalid channel id format in query")
+				}
+			case "channel_ext_id":
+				params.Predicates = append(params.Predicates, entVod.HasChannelWith(entChannel.ExtIDContainsFold(qp.Q)))
+			default:
+			}
+		}
 	}
 
-	v, err := h.Service.VodService.SearchVods(c.Request().Context(), limit, offset, types, predicates, qp.SortBy, qp.Order)
+	v, err := h.Service.VodService.SearchVods(c.Request().Context(), params)
 	if err != nil {
 		return ErrorResponse(c, http.StatusInternalServerError, err.Error())
 	}
 	return SuccessResponse(c, v, "videos")
 }
 
+// splitQueryList splits a comma separated query parameter, dropping empty values.
+func splitQueryList(value string) []string {
+	var values []string
+	for _, v := range strings.Split(value, ",") {
+		if v = strings.TrimSpace(v); v != "" {
+			values = append(values, v)
+		}
+	}
+	return values
+}
+
+// parseSearchDate parses a date (YYYY-MM-DD) or RFC3339 timestamp. A date used as the end of a range includes the whole day.
+func parseSearchDate(value string, endOfDay bool) (time.Time, error) {
+	if t, err := time.Parse(time.DateOnly, value); err == nil {
+		if endOfDay {
+			t = t.Add(24*time.Hour - time.Nanosecond)
+		}
+		return t, nil
+	}
+	return time.Parse(time.RFC3339, value)
+}
+
 // GetVodPlaylists godoc
 //
 //	@Summary		Get vod playlists
@@ -556,7 +653,7 @@ func (h *Handler) GetVodsPagination(c echo.Context) error {
 	if vSortBy != "" {
 		sortBy = utils.VideoSort(vSortBy)
 		if sortBy != utils.SortDate && sortBy != utils.SortViews && sortBy != utils.SortLocalViews && sortBy != utils.SortCreated {
-			return ErrorResponse(c, http.StatusBadRequest, "invalid sort_by option, must be one of: "+strings.Join(utils.VideoSort("").Values(), ", "))
+			return ErrorResponse(c, http.StatusBadRequest, "invalid sort_by option, must be one of: "+strings.Join(utils.VideoSort("").ListValues(), ", "))
 		}
 	} else {
 		sortBy = utils.SortDate
@@ -677,6 +774,81 @@ func (h *Handler) GetVodChatCommentsFromChatter(c echo.Context) error {
 	return SuccessResponse(c, v, fmt.Sprintf("comments for %s from chatter %s", vID, chatterID))
 }
 
+// SearchVodChat godoc
+//
+//	@Summary		Search chat
+//	@Description	Search the chat of all archived videos. At least one of q, chatter or emote is required. Hits include the video ID and content offset of the message.
+//	@Tags			vods
+//	@Accept			json
+//	@Produce		json
+//	@Param			q			query		string	false	"Full-text query over the message text"
+//	@Param			chatter		query		string	false	"Chatter login or display name"
+//	@Param			emote		query		string	false	"Emote name"
+//	@Param			channel_id	query		string	false	"Channel ID"
+//	@Param			video_id	query		string	false	"Video ID"
+//	@Param			from		query		string	false	"Messages sent at or after this time (RFC3339)"
+//	@Param			to			query		string	false	"Messages sent at or before this time (RFC3339)"
+//	@Param			limit		query		integer	false	"Limit"		default(20)
+//	@Param			offset		query		integer	false	"Offset"	default(0)
+//	@Success		200			{object}	vod.ChatSearchPagination
+//	@Failure		400			{object}	utils.ErrorResponse
+//	@Failure		500			{object}	utils.ErrorResponse
+//	@Router			/vod/chat/search [get]
+func (h *Handler) SearchVodChat(c echo.Context) error {
+	qp := ChatSearchQueryParams{Limit: 20}
+	if err := c.Bind(&qp); err != nil {
+		return ErrorResponse(c, http.StatusBadRequest, err.Error())
+	}
+	if err := c.Validate(&qp); err != nil {
+		return ErrorResponse(c, http.StatusBadRequest, err.Error())
+	}
+
+	params := vod.ChatSearchParams{
+		Query:   strings.TrimSpace(qp.Q),
+		Chatter: strings.TrimSpace(qp.Chatter),
+		Emote:   strings.TrimSpace(qp.Emote),
+		Limit:   qp.Limit,
+		Offset:  qp.Offset,
+	}
+	if params.Query == "" && params.Chatter == "" && params.Emote == "" {
+		return ErrorResponse(c, http.StatusBadRequest, "one of q, chatter or emote is required")
+	}
+	if qp.ChannelID != "" {
+		cUUID, err := uuid.Parse(qp.ChannelID)
+		if err != nil {
+			return ErrorResponse(c, http.StatusBadRequest, fmt.Errorf("invalid channel_id: %w", err).Error())
+		}
+		params.ChannelID = cUUID
+	}
+	if qp.VideoID != "" {
+		vUUID, err := uuid.Parse(qp.VideoID)
+		if err != nil {
+			return ErrorResponse(c, http.StatusBadRequest, fmt.Errorf("invalid video_id: %w", err).Error())
+		}
+		params.VideoID = vUUID
+	}
+	if qp.From != "" {
+		from, err := time.Parse(time.RFC3339, qp.From)
+		if err != nil {
+			return ErrorResponse(c, http.StatusBadRequest, fmt.Errorf("invalid from: %w", err).Error())
+		}
+		params.From = &from
+	}
+	if qp.To != "" {
+		to, err := time.Parse(time.RFC3339, qp.To)
+		if err != nil {
+			return ErrorResponse(c, http.StatusBadRequest, fmt.Errorf("invalid to: %w", err).Error())
+		}
+		params.To = &to
+	}
+
+	results, err := h.Service.VodService.SearchChat(c.Request().Context(), params)
+	if err != nil {
+		return ErrorResponse(c, http.StatusInternalServerError, err.Error())
+	}
+	return SuccessResponse(c, results, "chat search results")
+}
+
 // GetChatEmotes godoc
 //
 //	@Summary		Get vod chat emotes
@@ -974,6 +1146,243 @@ func (h *Handler) GenerateSpriteThumbnails(c echo.Context) error {
 	return SuccessResponse(c, nil, fmt.Sprintf("job created: %d", job.Job.ID))
 }
 
+func (h *Handler) GenerateCaptions(c echo.Context) error {
+	vID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return ErrorResponse(c, http.StatusBadRequest, err.Error())
+	}
+	job, err := h.Service.VodService.GenerateCaptions(c.Request().Context(), vID)
+	if err != nil {
+		return ErrorResponse(c, http.StatusInternalServerError, err.Error())
+	}
+	return SuccessResponse(c, nil, fmt.Sprintf("job created: %d", job.Job.ID))
+}
+
+// GetLibraryAudit godoc
+//
+//	@Summary		Get the library audit
+//	@Description	Returns the number of videos of each health status and the degraded and broken videos found by the last library audit.
+//	@Tags			admin
+//	@Produce		json
+//	@Success		200	{object}	vod.LibraryAudit
+//	@Failure		500	{object}	utils.ErrorResponse
+//	@Router			/admin/library-audit [get]
+//	@Security		ApiKeyCookieAuth
+//	@Security		ApiKeyAuth
+func (h *Handler) GetLibraryAudit(c echo.Context) error {
+	audit, err := h.Service.VodService.GetLibraryAudit(c.Request().Context())
+	if err != nil {
+		return ErrorResponse(c, http.StatusInternalServerError, err.Error())
+	}
+	return SuccessResponse(c, audit, "library audit")
+}
+
+// RepairVod godoc
+//
+//	@Summary		Repair a video
+//	@Description	Queues the tasks that regenerate the thumbnails, sprite thumbnails and chat of a video that the last library audit found missing. The video is audited again an hour later.
+//	@Tags			vods
+//	@Produce		json
+//	@Param			id	path		string	true	"Video ID"
+//	@Success		200	{object}	vod.RepairResult
+//	@Failure		400	{object}	utils.ErrorResponse
+//	@Failure		404	{object}	utils.ErrorResponse
+//	@Failure		500	{object}	utils.ErrorResponse
+//	@Router			/vod/{id}/repair [post]
+//	@Security		ApiKeyCookieAuth
+//	@Security		ApiKeyAuth
+func (h *Handler) RepairVod(c echo.Context) error {
+	vID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return ErrorResponse(c, http.StatusBadRequest, err.Error())
+	}
+
+	result, err := h.Service.VodService.RepairVideo(c.Request().Context(), vID)
+	if err != nil {
+		switch err.Error() {
+		case "video not found":
+			return ErrorResponse(c, http.StatusNotFound, err.Error())
+		case "video is processing":
+			return ErrorResponse(c, http.StatusBadRequest, err.Error())
+		}
+		return ErrorResponse(c, http.StatusInternalServerError, err.Error())
+	}
+	return SuccessResponse(c, result, "video repair queued")
+}
+
+type RecoverMutedAudioRequest struct {
+	Offset *float64 `json:"offset" validate:"omitempty,min=0"` // position in seconds in the VOD where the live archive starts, estimated if not set
+}
+
+// RecoverVodMutedAudio godoc
+//
+//	@Summary		Recover the muted audio of a video
+//	@Description	Queues the replacement of the audio of the muted segments of a VOD with the audio of the live archive of the same stream. HLS videos are not changed, their muted segments are marked in the player instead.
+//	@Tags			vods
+//	@Accept			json
+//	@Produce		json
+//	@Param			id		path	string						true	"Video ID"
+//	@Param			body	body	RecoverMutedAudioRequest	false	"Recover muted audio request"
+//	@Success		200
+//	@Failure		400	{object}	utils.ErrorResponse
+//	@Failure		404	{object}	utils.ErrorResponse
+//	@Failure		500	{object}	utils.ErrorResponse
+//	@Router			/vod/{id}/recover-muted-audio [post]
+//	@Security		ApiKeyCookieAuth
+//	@Security		ApiKeyAuth
+func (h *Handler) RecoverVodMutedAudio(c echo.Context) error {
+	vID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return ErrorResponse(c, http.StatusBadRequest, err.Error())
+	}
+	var req RecoverMutedAudioRequest
+	if err := c.Bind(&req); err != nil {
+		return ErrorResponse(c, http.StatusBadRequest, err.Error())
+	}
+	if err := c.Validate(&req); err != nil {
+		return ErrorResponse(c, http.StatusBadRequest, err.Error())
+	}
+
+	err = h.Service.VodService.RecoverMutedAudio(c.Request().Context(), vID, req.Offset)
+	if err != nil {
+		switch err.Error() {
+		case "video not found":
+			return ErrorResponse(c, http.StatusNotFound, err.Error())
+		case "video is processing", "video is not a vod", "stream was not archived live":
+			return ErrorResponse(c, http.StatusBadRequest, err.Error())
+		}
+		return ErrorResponse(c, http.StatusInternalServerError, err.Error())
+	}
+	return SuccessResponse(c, nil, "muted audio recovery queued")
+}
+
+// GetVodVersions godoc
+//
+//	@Summary		Get the versions of a video
+//	@Description	Returns the versions of the stream of a video, such as its live archive and its VOD, including the video. Each version has how many seconds of the stream it is missing and how many seconds are muted. The most complete version is marked as best.
+//	@Tags			vods
+//	@Produce		json
+//	@Param			id	path		string	true	"Video ID"
+//	@Success		200	{object}	[]streamversions.Version
+//	@Failure		400	{object}	utils.ErrorResponse
+//	@Failure		404	{object}	utils.ErrorResponse
+//	@Failure		500	{object}	utils.ErrorResponse
+//	@Router			/vod/{id}/versions [get]
+func (h *Handler) GetVodVersions(c echo.Context) error {
+	vID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return ErrorResponse(c, http.StatusBadRequest, err.Error())
+	}
+	versions, err := h.Service.VodService.GetVideoVersions(c.Request().Context(), vID)
+	if err != nil {
+		if err.Error() == "video not found" {
+			return ErrorResponse(c, http.StatusNotFound, err.Error())
+		}
+		return ErrorResponse(c, http.StatusInternalServerError, err.Error())
+	}
+	return SuccessResponse(c, versions, "video versions")
+}
+
+// GetVodRenditions godoc
+//
+//	@Summary		Get the renditions of a video
+//	@Description	Returns the adaptive bitrate renditions of an HLS video, such as source, 720p, 480p and audio-only, with their bandwidth, resolution and codecs. Videos with a single rendition return an empty list.
+//	@Tags			vods
+//	@Produce		json
+//	@Param			id	path		string	true	"Video ID"
+//	@Success		200	{object}	[]hls.Variant
+//	@Failure		400	{object}	utils.ErrorResponse
+//	@Failure		404	{object}	utils.ErrorResponse
+//	@Failure		500	{object}	utils.ErrorResponse
+//	@Router			/vod/{id}/renditions [get]
+func (h *Handler) GetVodRenditions(c echo.Context) error {
+	vID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return ErrorResponse(c, http.StatusBadRequest, err.Error())
+	}
+	renditions, err := h.Service.VodService.GetVideoRenditions(c.Request().Context(), vID)
+	if err != nil {
+		if err.Error() == "video not found" {
+			return ErrorResponse(c, http.StatusNotFound, err.Error())
+		}
+		return ErrorResponse(c, http.StatusInternalServerError, err.Error())
+	}
+	return SuccessResponse(c, renditions, "video renditions")
+}
+
+// GetVodLiveGaps godoc
+//
+//	@Summary		Get the live gaps of a video
+//	@Description	Returns the parts of the stream missed while recording the live archive of a video, such as while the recording reconnected after the stream went offline. The offset of a gap is its position in the archive in seconds. The archive and its chat don't include the gaps.
+//	@Tags			vods
+//	@Produce		json
+//	@Param			id	path		string	true	"Video ID"
+//	@Success		200	{object}	[]ent.LiveGap
+//	@Failure		400	{object}	utils.ErrorResponse
+//	@Failure		404	{object}	utils.ErrorResponse
+//	@Failure		500	{object}	utils.ErrorResponse
+//	@Router			/vod/{id}/gaps [get]
+func (h *Handler) GetVodLiveGaps(c echo.Context) error {
+	vID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return ErrorResponse(c, http.StatusBadRequest, err.Error())
+	}
+	gaps, err := h.Service.VodService.GetVideoLiveGaps(c.Request().Context(), vID)
+	if err != nil {
+		if err.Error() == "video not found" {
+			return ErrorResponse(c, http.StatusNotFound, err.Error())
+		}
+		return ErrorResponse(c, http.StatusInternalServerError, err.Error())
+	}
+	return SuccessResponse(c, gaps, "video live gaps")
+}
+
+type ReconcileVersionsRequest struct {
+	Policy utils.StreamVersionPolicy `json:"policy" validate:"omitempty,oneof=keep_both keep_best keep_live_with_vod_chat"` // the configured policy is used if not set
+}
+
+// ReconcileVodVersions godoc
+//
+//	@Summary		Reconcile the versions of a video
+//	@Description	Queues the reconciliation of the versions of the stream of a video. Both versions are kept, only the most complete version is kept, or the live archive is kept with the chat of the VOD. Locked versions are never deleted.
+//	@Tags			vods
+//	@Accept			json
+//	@Produce		json
+//	@Param			id		path	string						true	"Video ID"
+//	@Param			body	body	ReconcileVersionsRequest	false	"Reconcile versions request"
+//	@Success		200
+//	@Failure		400	{object}	utils.ErrorResponse
+//	@Failure		404	{object}	utils.ErrorResponse
+//	@Failure		500	{object}	utils.ErrorResponse
+//	@Router			/vod/{id}/versions/reconcile [post]
+//	@Security		ApiKeyCookieAuth
+//	@Security		ApiKeyAuth
+func (h *Handler) ReconcileVodVersions(c echo.Context) error {
+	vID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return ErrorResponse(c, http.StatusBadRequest, err.Error())
+	}
+	var req ReconcileVersionsRequest
+	if err := c.Bind(&req); err != nil {
+		return ErrorResponse(c, http.StatusBadRequest, err.Error())
+	}
+	if err := c.Validate(&req); err != nil {
+		return ErrorResponse(c, http.StatusBadRequest, err.Error())
+	}
+
+	err = h.Service.VodService.ReconcileVideoVersions(c.Request().Context(), vID, req.Policy)
+	if err != nil {
+		switch err.Error() {
+		case "video not found":
+			return ErrorResponse(c, http.StatusNotFound, err.Error())
+		case "video is processing", "video has no other versions":
+			return ErrorResponse(c, http.StatusBadRequest, err.Error())
+		}
+		return ErrorResponse(c, http.StatusInternalServerError, err.Error())
+	}
+	return SuccessResponse(c, nil, "version reconciliation queued")
+}
+
 func (h *Handler) GetVodChatHistogram(c echo.Context) error {
 	vID, err := uuid.Parse(c.Param("id"))
 	if err != nil {
@@ -0,0 +1,172 @@
+package http
+
+import (
+	"errors"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/gorilla/websocket"
+	"github.com/labstack/echo/v4"
+	"github.com/rs/zerolog/log"
+	"github.com/zibbp/ganymede/ent"
+	"github.com/zibbp/ganymede/internal/config"
+	"github.com/zibbp/ganymede/internal/watchparty"
+)
+
+// WatchPartyService defines the interface for watch party operations.
+type WatchPartyService interface {
+	CreateRoom(host *ent.User, video *ent.Vod) *watchparty.State
+	GetRoom(id uuid.UUID) (*watchparty.State, error)
+	Join(id uuid.UUID, user *ent.User, conn watchparty.Conn) error
+}
+
+// CreateWatchPartyRequest is the request body for creating a watch party.
+type CreateWatchPartyRequest struct {
+	VideoID string `json:"video_id" validate:"required,uuid"`
+}
+
+var watchPartyUpgrader = websocket.Upgrader{
+	CheckOrigin: func(r *http.Request) bool {
+		return watchPartyOriginAllowed(r, config.GetEnvConfig().FrontendHost)
+	},
+}
+
+// watchPartyOriginAllowed reports whether a watch party socket can be opened from the origin of the request.
+// The socket is authenticated by the session cookie so only the frontend may open it: pages served by the host
+// of the API and the origins of FRONTEND_HOST. Requests without an origin aren't sent by browsers.
+func watchPartyOriginAllowed(r *http.Request, frontendHosts string) bool {
+	origin := r.Header.Get("Origin")
+	if origin == "" {
+		return true
+	}
+	u, err := url.Parse(origin)
+	if err != nil || u.Host == "" {
+		return false
+	}
+	if strings.EqualFold(u.Host, r.Host) {
+		return true
+	}
+	for _, host := range strings.Split(frontendHosts, ",") {
+		host = strings.TrimSuffix(strings.TrimSpace(host), "/")
+		if host == "" {
+			continue
+		}
+		// hosts without a scheme match both http and https
+		if !strings.Contains(host, "://") {
+			if strings.EqualFold(host, u.Host) {
+				return true
+			}
+			continue
+		}
+		if strings.EqualFold(host, u.Scheme+"://"+u.Host) {
+			return true
+		}
+	}
+	return false
+}
+
+// CreateWatchParty godoc
+//
+//	@Summary		Create watch party
+//	@Description	Create a watch party room for a video hosted by the user. Members join the room over a WebSocket.
+//	@Tags			Watch Party
+//	@Accept			json
+//	@Produce		json
+//	@Param			body	body		CreateWatchPartyRequest	true	"watch party"
+//	@Success		200		{object}	watchparty.State
+//	@Failure		400		{object}	utils.ErrorResponse
+//	@Failure		404		{object}	utils.ErrorResponse
+//	@Failure		500		{object}	utils.ErrorResponse
+//	@Router			/watch-party [post]
+//	@Security		ApiKeyCookieAuth
+func (h *Handler) CreateWatchParty(c echo.Context) error {
+	user := userFromContext(c)
+	req := new(CreateWatchPartyRequest)
+	if err := c.Bind(req); err != nil {
+		return ErrorResponse(c, http.StatusBadRequest, err.Error())
+	}
+	if err := c.Validate(req); err != nil {
+		return ErrorResponse(c, http.StatusBadRequest, err.Error())
+	}
+	videoID, err := uuid.Parse(req.VideoID)
+	if err != nil {
+		return ErrorResponse(c, http.StatusBadRequest, "invalid video id")
+	}
+
+	video, err := h.Service.VodService.GetVod(c.Request().Context(), videoID, false, false, false, false)
+	if err != nil {
+		if err.Error() == "vod not found" {
+			return ErrorResponse(c, http.StatusNotFound, err.Error())
+		}
+		return ErrorResponse(c, http.StatusInternalServerError, err.Error())
+	}
+
+	return SuccessResponse(c, h.Service.WatchPartyService.CreateRoom(user, video), "watch party created")
+}
+
+// GetWatchParty godoc
+//
+//	@Summary		Get watch party
+//	@Description	Get the playback state and members of a watch party room
+//	@Tags			Watch Party
+//	@Produce		json
+//	@Param			id	path		string	true	"watch party id"
+//	@Success		200	{object}	watchparty.State
+//	@Failure		400	{object}	utils.ErrorResponse
+//	@Failure		404	{object}	utils.ErrorResponse
+//	@Router			/watch-party/{id} [get]
+//	@Security		ApiKeyCookieAuth
+func (h *Handler) GetWatchParty(c echo.Context) error {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return ErrorResponse(c, http.StatusBadRequest, "invalid watch party id")
+	}
+
+	state, err := h.Service.WatchPartyService.GetRoom(id)
+	if err != nil {
+		if errors.Is(err, watchparty.ErrorRoomNotFound) {
+			return ErrorResponse(c, http.StatusNotFound, err.Error())
+		}
+		return ErrorResponse(c, http.StatusInternalServerError, err.Error())
+	}
+	return SuccessResponse(c, state, "watch party")
+}
+
+// JoinWatchParty godoc
+//
+//	@Summary		Join watch party
+//	@Description	Join a watch party room over a WebSocket. The server sends a "state" message with the playback state when joining and whenever it changes. The host sends "play", "pause" and "seek" messages with the position in seconds; any member can send "sync" to get the current state.
+//	@Tags			Watch Party
+//	@Param			id	path	string	true	"watch party id"
+//	@Success		101
+//	@Failure		400	{object}	utils.ErrorResponse
+//	@Failure		404	{object}	utils.ErrorResponse
+//	@Router			/watch-party/{id}/ws [get]
+//	@Security		ApiKeyCookieAuth
+func (h *Handler) JoinWatchParty(c echo.Context) error {
+	user := userFromContext(c)
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return ErrorResponse(c, http.StatusBadRequest, "invalid watch party id")
+	}
+	if _, err := h.Service.WatchPartyService.GetRoom(id); err != nil {
+		return ErrorResponse(c, http.StatusNotFound, err.Error())
+	}
+
+	conn, err := watchPartyUpgrader.Upgrade(c.Response(), c.Request(), nil)
+	if err != nil {
+		// the upgrader already responded with an error
+		log.Debug().Err(err).Msg("error upgrading watch party connection")
+		return nil
+	}
+	defer func() {
+		_ = conn.Close()
+	}()
+
+	if err := h.Service.WatchPartyService.Join(id, user, conn); err != nil {
+		_ = conn.WriteJSON(watchparty.Message{Type: watchparty.MessageError, Error: err.Error()})
+	}
+	return nil
+}
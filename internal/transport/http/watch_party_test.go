@@ -0,0 +1,31 @@
+package http
+
+import (
+	"net/http/httptest"
+	"testing"
+)
+
+func TestWatchPartyOriginAllowed(t *testing.T) {
+	tests := []struct {
+		origin        string
+		frontendHosts string
+		allowed       bool
+	}{
+		{origin: "", allowed: true},
+		{origin: "http://ganymede.local:4800", allowed: true},
+		{origin: "https://evil.example.com", allowed: false},
+		{origin: "null", allowed: false},
+		{origin: "https://ganymede.example.com", frontendHosts: "https://other.example.com, https://ganymede.example.com/", allowed: true},
+		{origin: "http://ganymede.example.com", frontendHosts: "https://ganymede.example.com", allowed: false},
+		{origin: "http://ganymede.example.com", frontendHosts: "ganymede.example.com", allowed: true},
+	}
+	for _, test := range tests {
+		r := httptest.NewRequest("GET", "http://ganymede.local:4800/api/v1/watch-party/id/ws", nil)
+		if test.origin != "" {
+			r.Header.Set("Origin", test.origin)
+		}
+		if got := watchPartyOriginAllowed(r, test.frontendHosts); got != test.allowed {
+			t.Errorf("origin %q with frontend hosts %q: expected %v, got %v", test.origin, test.frontendHosts, test.allowed, got)
+		}
+	}
+}
@@ -0,0 +1,45 @@
+package userlist_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/zibbp/ganymede/internal/userlist"
+	"github.com/zibbp/ganymede/internal/utils"
+	"github.com/zibbp/ganymede/tests"
+)
+
+// TestDefaultListsAfterCustomList tests that a user that creates a custom list before getting their lists still gets the default lists.
+func TestDefaultListsAfterCustomList(t *testing.T) {
+	app, err := tests.SetupWithoutWorker(t)
+	require.NoError(t, err)
+	ctx := context.Background()
+
+	user, err := app.Database.Client.User.Create().SetUsername("list_user").Save(ctx)
+	require.NoError(t, err)
+
+	_, err = app.UserListService.CreateList(ctx, user.ID, "Watch Later")
+	require.ErrorIs(t, err, userlist.ErrorReservedListName)
+	custom, err := app.UserListService.CreateList(ctx, user.ID, "Later")
+	require.NoError(t, err)
+	_, err = app.UserListService.UpdateList(ctx, user.ID, custom.ID, "favorites")
+	require.ErrorIs(t, err, userlist.ErrorReservedListName)
+
+	// a custom list with the name of a default list created before the names were reserved
+	_, err = app.Database.Client.UserList.Create().SetUserID(user.ID).SetName("Favorites").SetType(utils.UserListTypeCustom).Save(ctx)
+	require.NoError(t, err)
+
+	lists, err := app.UserListService.GetLists(ctx, user.ID)
+	require.NoError(t, err)
+	types := map[utils.UserListType]int{}
+	for _, list := range lists {
+		types[list.Type]++
+	}
+	require.Equal(t, map[utils.UserListType]int{utils.UserListTypeWatchLater: 1, utils.UserListTypeFavorites: 1, utils.UserListTypeCustom: 2}, types)
+
+	// getting the lists again doesn't create them twice
+	lists, err = app.UserListService.GetLists(ctx, user.ID)
+	require.NoError(t, err)
+	require.Len(t, lists, 4)
+}
@@ -0,0 +1,423 @@
+package userlist
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rs/zerolog/log"
+	"github.com/zibbp/ganymede/ent"
+	"github.com/zibbp/ganymede/ent/userlist"
+	"github.com/zibbp/ganymede/ent/userlistitem"
+	entVod "github.com/zibbp/ganymede/ent/vod"
+	"github.com/zibbp/ganymede/internal/database"
+	"github.com/zibbp/ganymede/internal/utils"
+)
+
+// shareTokenBytes is the entropy of the token of a shared list.
+const shareTokenBytes = 24
+
+var (
+	ErrorListNotFound     = fmt.Errorf("list not found")
+	ErrorListExists       = fmt.Errorf("list already exists")
+	ErrorDefaultList      = fmt.Errorf("watch later and favorites lists can't be renamed or deleted")
+	ErrorReservedListName = fmt.Errorf("watch later and favorites are reserved list names")
+	ErrorVideoInList      = fmt.Errorf("video is already in the list")
+	ErrorVideoNotInList   = fmt.Errorf("video is not in the list")
+	ErrorInvalidListOrder = fmt.Errorf("order must contain every video of the list exactly once")
+)
+
+// defaultLists are the lists every user has.
+var defaultLists = []struct {
+	Type utils.UserListType
+	Name string
+}{
+	{Type: utils.UserListTypeWatchLater, Name: "Watch Later"},
+	{Type: utils.UserListTypeFavorites, Name: "Favorites"},
+}
+
+// SharedList is a list viewed through its share link.
+type SharedList struct {
+	ID        uuid.UUID  `json:"id"`
+	Name      string     `json:"name"`
+	Username  string     `json:"username"` // the user that shared the list
+	Videos    []*ent.Vod `json:"videos"`   // in the order of the list
+	UpdatedAt time.Time  `json:"updated_at"`
+}
+
+// Service manages the personal video lists of users. Every method is scoped to the lists of the user.
+type Service struct {
+	Store *database.Database
+}
+
+func NewService(store *database.Database) *Service {
+	return &Service{Store: store}
+}
+
+// GetLists returns the lists of the user with their items, creating the watch later and favorites lists if they don't exist yet.
+func (s *Service) GetLists(ctx context.Context, userId uuid.UUID) ([]*ent.UserList, error) {
+	if err := s.createDefaultLists(ctx, userId); err != nil {
+		return nil, err
+	}
+
+	lists, err := s.Store.Client.UserList.Query().Where(userlist.UserID(userId)).WithItems(func(q *ent.UserListItemQuery) {
+		q.Order(ent.Asc(userlistitem.FieldPosition))
+	}).All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("error getting lists: %v", err)
+	}
+	sortLists(lists)
+
+	return lists, nil
+}
+
+// GetList returns the list of the user with its videos in order.
+func (s *Service) GetList(ctx context.Context, userId uuid.UUID, listId uuid.UUID) (*ent.UserList, error) {
+	list, err := s.Store.Client.UserList.Query().Where(userlist.ID(listId), userlist.UserID(userId)).WithItems(func(q *ent.UserListItemQuery) {
+		q.Order(ent.Asc(userlistitem.FieldPosition)).WithVod(func(vq *ent.VodQuery) {
+			vq.WithChannel()
+		})
+	}).Only(ctx)
+	if err != nil {
+		if _, ok := err.(*ent.NotFoundError); ok {
+			return nil, ErrorListNotFound
+		}
+		return nil, fmt.Errorf("error getting list: %v", err)
+	}
+
+	return list, nil
+}
+
+// CreateList creates a custom list for the user.
+func (s *Service) CreateList(ctx context.Context, userId uuid.UUID, name string) (*ent.UserList, error) {
+	if isReservedListName(name) {
+		return nil, ErrorReservedListName
+	}
+	list, err := s.Store.Client.UserList.Create().SetUserID(userId).SetName(name).SetType(utils.UserListTypeCustom).Save(ctx)
+	if err != nil {
+		if _, ok := err.(*ent.ConstraintError); ok {
+			return nil, ErrorListExists
+		}
+		return nil, fmt.Errorf("error creating list: %v", err)
+	}
+
+	return list, nil
+}
+
+// UpdateList renames a custom list of the user.
+func (s *Service) UpdateList(ctx context.Context, userId uuid.UUID, listId uuid.UUID, name string) (*ent.UserList, error) {
+	if isReservedListName(name) {
+		return nil, ErrorReservedListName
+	}
+	list, err := s.getCustomList(ctx, userId, listId)
+	if err != nil {
+		return nil, err
+	}
+
+	list, err = s.Store.Client.UserList.UpdateOne(list).SetName(name).Save(ctx)
+	if err != nil {
+		if _, ok := err.(*ent.ConstraintError); ok {
+			return nil, ErrorListExists
+		}
+		return nil, fmt.Errorf("error updating list: %v", err)
+	}
+
+	return list, nil
+}
+
+// DeleteList deletes a custom list of the user.
+func (s *Service) DeleteList(ctx context.Context, userId uuid.UUID, listId uuid.UUID) error {
+	list, err := s.getCustomList(ctx, userId, listId)
+	if err != nil {
+		return err
+	}
+
+	err = s.Store.Client.UserList.DeleteOne(list).Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("error deleting list: %v", err)
+	}
+
+	return nil
+}
+
+// AddVideo adds the video to the end of the list of the user.
+func (s *Service) AddVideo(ctx context.Context, userId uuid.UUID, listId uuid.UUID, videoId uuid.UUID) error {
+	list, err := s.getList(ctx, userId, listId)
+	if err != nil {
+		return err
+	}
+	exists, err := s.Store.Client.Vod.Query().Where(entVod.ID(videoId)).Exist(ctx)
+	if err != nil {
+		return fmt.Errorf("error getting video: %v", err)
+	}
+	if !exists {
+		return fmt.Errorf("vod not found")
+	}
+
+	position := 0
+	last, err := s.Store.Client.UserListItem.Query().Where(userlistitem.ListID(list.ID)).Order(ent.Desc(userlistitem.FieldPosition)).First(ctx)
+	if err != nil {
+		if _, ok := err.(*ent.NotFoundError); !ok {
+			return fmt.Errorf("error getting list items: %v", err)
+		}
+	} else {
+		position = last.Position + 1
+	}
+
+	_, err = s.Store.Client.UserListItem.Create().SetListID(list.ID).SetVodID(videoId).SetPosition(position).Save(ctx)
+	if err != nil {
+		if _, ok := err.(*ent.ConstraintError); ok {
+			return ErrorVideoInList
+		}
+		return fmt.Errorf("error adding video to list: %v", err)
+	}
+
+	return s.touchList(ctx, list)
+}
+
+// RemoveVideo removes the video from the list of the user.
+func (s *Service) RemoveVideo(ctx context.Context, userId uuid.UUID, listId uuid.UUID, videoId uuid.UUID) error {
+	list, err := s.getList(ctx, userId, listId)
+	if err != nil {
+		return err
+	}
+
+	deleted, err := s.Store.Client.UserListItem.Delete().Where(userlistitem.ListID(list.ID), userlistitem.VodID(videoId)).Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("error removing video from list: %v", err)
+	}
+	if deleted == 0 {
+		return ErrorVideoNotInList
+	}
+
+	return s.touchList(ctx, list)
+}
+
+// ReorderList sets the order of the videos of the list of the user. The order must contain every video of the list.
+func (s *Service) ReorderList(ctx context.Context, userId uuid.UUID, listId uuid.UUID, videoIds []uuid.UUID) error {
+	list, err := s.getList(ctx, userId, listId)
+	if err != nil {
+		return err
+	}
+
+	tx, err := s.Store.Client.Tx(ctx)
+	if err != nil {
+		return fmt.Errorf("error starting transaction: %v", err)
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			_ = tx.Rollback()
+			panic(r)
+		}
+	}()
+
+	items, err := tx.UserListItem.Query().Where(userlistitem.ListID(list.ID)).All(ctx)
+	if err != nil {
+		_ = tx.Rollback()
+		return fmt.Errorf("error getting list items: %v", err)
+	}
+	positions, err := listOrderPositions(items, videoIds)
+	if err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+	for _, item := range items {
+		if err := tx.UserListItem.UpdateOne(item).SetPosition(positions[item.VodID]).Exec(ctx); err != nil {
+			_ = tx.Rollback()
+			return fmt.Errorf("error updating list item: %v", err)
+		}
+	}
+	if err := tx.UserList.UpdateOne(list).SetUpdatedAt(time.Now()).Exec(ctx); err != nil {
+		_ = tx.Rollback()
+		return fmt.Errorf("error updating list: %v", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("error committing transaction: %v", err)
+	}
+	return nil
+}
+
+// ShareList creates a read-only link for the list of the user. The link of a list that is already shared is kept.
+func (s *Service) ShareList(ctx context.Context, userId uuid.UUID, listId uuid.UUID) (*ent.UserList, error) {
+	list, err := s.getList(ctx, userId, listId)
+	if err != nil {
+		return nil, err
+	}
+	if list.ShareToken != nil {
+		return list, nil
+	}
+
+	buf := make([]byte, shareTokenBytes)
+	if _, err := rand.Read(buf); err != nil {
+		return nil, fmt.Errorf("error generating share token: %v", err)
+	}
+	list, err = s.Store.Client.UserList.UpdateOne(list).SetShareToken(base64.RawURLEncoding.EncodeToString(buf)).Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("error sharing list: %v", err)
+	}
+
+	return list, nil
+}
+
+// UnshareList removes the read-only link of the list of the user.
+func (s *Service) UnshareList(ctx context.Context, userId uuid.UUID, listId uuid.UUID) (*ent.UserList, error) {
+	list, err := s.getList(ctx, userId, listId)
+	if err != nil {
+		return nil, err
+	}
+
+	list, err = s.Store.Client.UserList.UpdateOne(list).ClearShareToken().Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("error unsharing list: %v", err)
+	}
+
+	return list, nil
+}
+
+// GetSharedList returns the list shared with the token.
+func (s *Service) GetSharedList(ctx context.Context, token string) (*SharedList, error) {
+	list, err := s.Store.Client.UserList.Query().Where(userlist.ShareToken(token)).WithUser().WithItems(func(q *ent.UserListItemQuery) {
+		q.Order(ent.Asc(userlistitem.FieldPosition)).WithVod(func(vq *ent.VodQuery) {
+			vq.WithChannel()
+		})
+	}).Only(ctx)
+	if err != nil {
+		if _, ok := err.(*ent.NotFoundError); ok {
+			return nil, ErrorListNotFound
+		}
+		return nil, fmt.Errorf("error getting list: %v", err)
+	}
+
+	shared := &SharedList{ID: list.ID, Name: list.Name, UpdatedAt: list.UpdatedAt, Videos: []*ent.Vod{}}
+	if list.Edges.User != nil {
+		shared.Username = list.Edges.User.Username
+	}
+	for _, item := range list.Edges.Items {
+		if item.Edges.Vod != nil {
+			shared.Videos = append(shared.Videos, item.Edges.Vod)
+		}
+	}
+
+	return shared, nil
+}
+
+func (s *Service) getList(ctx context.Context, userId uuid.UUID, listId uuid.UUID) (*ent.UserList, error) {
+	list, err := s.Store.Client.UserList.Query().Where(userlist.ID(listId), userlist.UserID(userId)).Only(ctx)
+	if err != nil {
+		if _, ok := err.(*ent.NotFoundError); ok {
+			return nil, ErrorListNotFound
+		}
+		return nil, fmt.Errorf("error getting list: %v", err)
+	}
+	return list, nil
+}
+
+func (s *Service) getCustomList(ctx context.Context, userId uuid.UUID, listId uuid.UUID) (*ent.UserList, error) {
+	list, err := s.getList(ctx, userId, listId)
+	if err != nil {
+		return nil, err
+	}
+	if list.Type != utils.UserListTypeCustom {
+		return nil, ErrorDefaultList
+	}
+	return list, nil
+}
+
+// touchList marks the list as updated when its items change.
+func (s *Service) touchList(ctx context.Context, list *ent.UserList) error {
+	if err := s.Store.Client.UserList.UpdateOne(list).SetUpdatedAt(time.Now()).Exec(ctx); err != nil {
+		return fmt.Errorf("error updating list: %v", err)
+	}
+	return nil
+}
+
+// createDefaultLists creates the watch later and favorites lists the user doesn't have yet.
+func (s *Service) createDefaultLists(ctx context.Context, userId uuid.UUID) error {
+	for _, defaultList := range defaultLists {
+		exists, err := s.Store.Client.UserList.Query().Where(userlist.UserID(userId), userlist.TypeEQ(defaultList.Type)).Exist(ctx)
+		if err != nil {
+			return fmt.Errorf("error getting lists: %v", err)
+		}
+		if exists {
+			continue
+		}
+		err = s.createDefaultList(ctx, userId, defaultList.Type, defaultList.Name)
+		if _, ok := err.(*ent.ConstraintError); ok {
+			// the list was created by a concurrent request, otherwise the name is taken by a custom list
+			// created before the names were reserved
+			exists, err = s.Store.Client.UserList.Query().Where(userlist.UserID(userId), userlist.TypeEQ(defaultList.Type)).Exist(ctx)
+			if err != nil {
+				return fmt.Errorf("error getting lists: %v", err)
+			}
+			if exists {
+				continue
+			}
+			name := defaultList.Name + " (default)"
+			log.Warn().Str("user_id", userId.String()).Str("name", defaultList.Name).Msgf("custom list has the name of the %s list, creating it as %s", defaultList.Type, name)
+			err = s.createDefaultList(ctx, userId, defaultList.Type, name)
+		}
+		if err != nil {
+			return fmt.Errorf("error creating %s list: %v", defaultList.Type, err)
+		}
+	}
+	return nil
+}
+
+func (s *Service) createDefaultList(ctx context.Context, userId uuid.UUID, listType utils.UserListType, name string) error {
+	_, err := s.Store.Client.UserList.Create().SetUserID(userId).SetType(listType).SetName(name).Save(ctx)
+	return err
+}
+
+// isReservedListName reports whether the name is the name of a watch later or favorites list.
+func isReservedListName(name string) bool {
+	for _, defaultList := range defaultLists {
+		if strings.EqualFold(strings.TrimSpace(name), defaultList.Name) {
+			return true
+		}
+	}
+	return false
+}
+
+// sortLists sorts the watch later and favorites lists first, followed by custom lists by name.
+func sortLists(lists []*ent.UserList) {
+	rank := func(list *ent.UserList) int {
+		for i, defaultList := range defaultLists {
+			if list.Type == defaultList.Type {
+				return i
+			}
+		}
+		return len(defaultLists)
+	}
+	sort.SliceStable(lists, func(i, j int) bool {
+		if ri, rj := rank(lists[i]), rank(lists[j]); ri != rj {
+			return ri < rj
+		}
+		return lists[i].Name < lists[j].Name
+	})
+}
+
+// listOrderPositions returns the position of each video of the items in the order.
+func listOrderPositions(items []*ent.UserListItem, videoIds []uuid.UUID) (map[uuid.UUID]int, error) {
+	if len(items) != len(videoIds) {
+		return nil, ErrorInvalidListOrder
+	}
+	positions := make(map[uuid.UUID]int, len(videoIds))
+	for i, id := range videoIds {
+		if _, ok := positions[id]; ok {
+			return nil, ErrorInvalidListOrder
+		}
+		positions[id] = i
+	}
+	for _, item := range items {
+		if _, ok := positions[item.VodID]; !ok {
+			return nil, ErrorInvalidListOrder
+		}
+	}
+	return positions, nil
+}
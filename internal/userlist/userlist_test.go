@@ -0,0 +1,50 @@
+package userlist
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"github.com/zibbp/ganymede/ent"
+	"github.com/zibbp/ganymede/internal/utils"
+)
+
+func TestSortLists(t *testing.T) {
+	lists := []*ent.UserList{
+		{Name: "b", Type: utils.UserListTypeCustom},
+		{Name: "Favorites", Type: utils.UserListTypeFavorites},
+		{Name: "a", Type: utils.UserListTypeCustom},
+		{Name: "Watch Later", Type: utils.UserListTypeWatchLater},
+	}
+	sortLists(lists)
+
+	var names []string
+	for _, list := range lists {
+		names = append(names, list.Name)
+	}
+	require.Equal(t, []string{"Watch Later", "Favorites", "a", "b"}, names)
+}
+
+func TestListOrderPositions(t *testing.T) {
+	a, b, c := uuid.New(), uuid.New(), uuid.New()
+	items := []*ent.UserListItem{{VodID: a}, {VodID: b}, {VodID: c}}
+
+	positions, err := listOrderPositions(items, []uuid.UUID{c, a, b})
+	require.NoError(t, err)
+	require.Equal(t, map[uuid.UUID]int{c: 0, a: 1, b: 2}, positions)
+
+	_, err = listOrderPositions(items, []uuid.UUID{c, a})
+	require.ErrorIs(t, err, ErrorInvalidListOrder)
+
+	_, err = listOrderPositions(items, []uuid.UUID{c, a, a})
+	require.ErrorIs(t, err, ErrorInvalidListOrder)
+
+	_, err = listOrderPositions(items, []uuid.UUID{c, a, uuid.New()})
+	require.ErrorIs(t, err, ErrorInvalidListOrder)
+}
+
+func TestIsReservedListName(t *testing.T) {
+	require.True(t, isReservedListName("Watch Later"))
+	require.True(t, isReservedListName(" favorites "))
+	require.False(t, isReservedListName("Watch Later 2"))
+}
@@ -338,10 +338,11 @@ type VideoPlatform string
 const (
 	PlatformTwitch  VideoPlatform = "twitch"
 	PlatformYoutube VideoPlatform = "youtube"
+	PlatformKick    VideoPlatform = "kick"
 )
 
 func (VideoPlatform) Values() (kinds []string) {
-	for _, s := range []VideoPlatform{PlatformTwitch, PlatformYoutube} {
+	for _, s := range []VideoPlatform{PlatformTwitch, PlatformYoutube, PlatformKick} {
 		kinds = append(kinds, string(s))
 	}
 	return
@@ -371,9 +372,18 @@ const (
 	SortViews      VideoSort = "views"       // views from platform
 	SortLocalViews VideoSort = "local_views" // views from Ganymede
 	SortCreated    VideoSort = "created"     // when the vod was created in Ganymede
+	SortRelevance  VideoSort = "relevance"   // full-text search rank, only valid when searching
 )
 
 func (VideoSort) Values() (kinds []string) {
+	for _, s := range []VideoSort{SortDate, SortViews, SortLocalViews, SortCreated, SortRelevance} {
+		kinds = append(kinds, string(s))
+	}
+	return
+}
+
+// ListValues returns the sort options valid when listing videos without searching.
+func (VideoSort) ListValues() (kinds []string) {
 	for _, s := range []VideoSort{SortDate, SortViews, SortLocalViews, SortCreated} {
 		kinds = append(kinds, string(s))
 	}
@@ -449,6 +459,104 @@ func (PlaybackStatus) Values() (kinds []string) {
 	return
 }
 
+type StorageTier string
+
+const (
+	StorageTierHot  StorageTier = "hot"  // stored in the videos directory
+	StorageTierCold StorageTier = "cold" // stored in the cold videos directory
+)
+
+func (StorageTier) Values() (kinds []string) {
+	for _, s := range []StorageTier{StorageTierHot, StorageTierCold} {
+		kinds = append(kinds, string(s))
+	}
+	return
+}
+
+// VideoHealth is the result of the last library audit of a video.
+type VideoHealth string
+
+const (
+	VideoHealthUnknown  VideoHealth = "unknown"  // not audited yet
+	VideoHealthHealthy  VideoHealth = "healthy"  // all files exist and the video can be played
+	VideoHealthDegraded VideoHealth = "degraded" // the video can be played but other files are missing
+	VideoHealthBroken   VideoHealth = "broken"   // the video is missing or cannot be played
+)
+
+func (VideoHealth) Values() (kinds []string) {
+	for _, s := range []VideoHealth{VideoHealthUnknown, VideoHealthHealthy, VideoHealthDegraded, VideoHealthBroken} {
+		kinds = append(kinds, string(s))
+	}
+	return
+}
+
+// VideoHealthIssue is a problem found by the library audit.
+type VideoHealthIssue string
+
+const (
+	VideoHealthIssueVideoMissing            VideoHealthIssue = "video_missing"
+	VideoHealthIssueVideoUnplayable         VideoHealthIssue = "video_unplayable"     // ffprobe failed or found timestamp anomalies
+	VideoHealthIssueHLSSegmentsMissing      VideoHealthIssue = "hls_segments_missing" // files referenced by the HLS playlist are missing
+	VideoHealthIssueSizeMismatch            VideoHealthIssue = "size_mismatch"        // the files are smaller than the recorded storage size
+	VideoHealthIssueChatMissing             VideoHealthIssue = "chat_missing"
+	VideoHealthIssueChatVideoMissing        VideoHealthIssue = "chat_video_missing"
+	VideoHealthIssueThumbnailMissing        VideoHealthIssue = "thumbnail_missing"
+	VideoHealthIssueSpriteThumbnailsMissing VideoHealthIssue = "sprite_thumbnails_missing"
+)
+
+// ChecksumFile is the kind of archived file a checksum is recorded for.
+type ChecksumFile string
+
+const (
+	ChecksumFileVideo           ChecksumFile = "video" // the video or a file of its HLS playlist
+	ChecksumFileChat            ChecksumFile = "chat"
+	ChecksumFileChatVideo       ChecksumFile = "chat_video"
+	ChecksumFileLiveChat        ChecksumFile = "live_chat"
+	ChecksumFileLiveChatConvert ChecksumFile = "live_chat_convert"
+	ChecksumFileInfo            ChecksumFile = "info"
+	ChecksumFileThumbnail       ChecksumFile = "thumbnail"
+	ChecksumFileWebThumbnail    ChecksumFile = "web_thumbnail"
+)
+
+func (ChecksumFile) Values() (kinds []string) {
+	for _, s := range []ChecksumFile{ChecksumFileVideo, ChecksumFileChat, ChecksumFileChatVideo, ChecksumFileLiveChat, ChecksumFileLiveChatConvert, ChecksumFileInfo, ChecksumFileThumbnail, ChecksumFileWebThumbnail} {
+		kinds = append(kinds, string(s))
+	}
+	return
+}
+
+// ChecksumStatus is the result of the last verification of a checksum.
+type ChecksumStatus string
+
+const (
+	ChecksumStatusOk       ChecksumStatus = "ok"
+	ChecksumStatusMismatch ChecksumStatus = "mismatch" // the file changed since the checksum was recorded
+	ChecksumStatusMissing  ChecksumStatus = "missing"
+)
+
+func (ChecksumStatus) Values() (kinds []string) {
+	for _, s := range []ChecksumStatus{ChecksumStatusOk, ChecksumStatusMismatch, ChecksumStatusMissing} {
+		kinds = append(kinds, string(s))
+	}
+	return
+}
+
+// StreamVersionPolicy is what is kept when a stream was archived both live and as a VOD.
+type StreamVersionPolicy string
+
+const (
+	StreamVersionPolicyKeepBoth            StreamVersionPolicy = "keep_both"
+	StreamVersionPolicyKeepBest            StreamVersionPolicy = "keep_best"               // keep the most complete version
+	StreamVersionPolicyKeepLiveWithVodChat StreamVersionPolicy = "keep_live_with_vod_chat" // keep the live archive with the chat of the VOD
+)
+
+func (StreamVersionPolicy) Values() (kinds []string) {
+	for _, s := range []StreamVersionPolicy{StreamVersionPolicyKeepBoth, StreamVersionPolicyKeepBest, StreamVersionPolicyKeepLiveWithVodChat} {
+		kinds = append(kinds, string(s))
+	}
+	return
+}
+
 type TaskName string
 
 const (
@@ -465,10 +573,11 @@ const (
 	TaskRenderChat               TaskName = "task_chat_render"
 	TaskMoveChat                 TaskName = "task_chat_move"
 	TaskUpdateLiveStreamMetadata TaskName = "task_update_live_stream_metadata" // not used queue
+	TaskReencodeVideo            TaskName = "task_video_reencode"              // reported as task_video_convert in the queue
 )
 
 func (TaskName) Values() (kinds []string) {
-	for _, s := range []TaskName{TaskCreateFolder, TaskDownloadThumbnail, TaskSaveInfo, TaskDownloadVideo, TaskPostProcessVideo, TaskMoveVideo, TaskDownloadChat, TaskConvertChat, TaskRenderChat, TaskMoveChat, TaskUpdateLiveStreamMetadata} {
+	for _, s := range []TaskName{TaskCreateFolder, TaskDownloadThumbnail, TaskSaveInfo, TaskDownloadVideo, TaskPostProcessVideo, TaskMoveVideo, TaskDownloadChat, TaskConvertChat, TaskRenderChat, TaskMoveChat, TaskUpdateLiveStreamMetadata, TaskReencodeVideo} {
 		kinds = append(kinds, string(s))
 	}
 	return
@@ -502,6 +611,8 @@ func GetTaskName(s string) TaskName {
 		return TaskMoveChat
 	case string(TaskUpdateLiveStreamMetadata):
 		return TaskUpdateLiveStreamMetadata
+	case string(TaskReencodeVideo):
+		return TaskPostProcessVideo
 	default:
 		return ""
 	}
@@ -564,10 +675,42 @@ type ChapterType string
 const (
 	ChapterTypeGameChange ChapterType = "GAME_CHANGE" // A chapter that indicates a change in the game being played
 	ChapterTypeFallback   ChapterType = "FALLBACK"    // A fallback chapter to be used when no other chapter is available, typically the video category/game is used instead
+	ChapterTypeTimestamp  ChapterType = "TIMESTAMP"   // A chapter parsed from timestamps in the video description
 )
 
 func (ChapterType) Values() (kinds []string) {
-	for _, s := range []ChapterType{ChapterTypeGameChange, ChapterTypeFallback} {
+	for _, s := range []ChapterType{ChapterTypeGameChange, ChapterTypeFallback, ChapterTypeTimestamp} {
+		kinds = append(kinds, string(s))
+	}
+	return
+}
+
+// LiveGapReason is why the recording of a live stream dropped.
+type LiveGapReason string
+
+const (
+	LiveGapReasonDisconnected LiveGapReason = "disconnected" // the recording failed while the stream was live
+	LiveGapReasonOffline      LiveGapReason = "offline"      // the stream went offline and came back within the reconnect window
+)
+
+func (LiveGapReason) Values() (kinds []string) {
+	for _, s := range []LiveGapReason{LiveGapReasonDisconnected, LiveGapReasonOffline} {
+		kinds = append(kinds, string(s))
+	}
+	return
+}
+
+// UserListType is the kind of a personal list of videos of a user.
+type UserListType string
+
+const (
+	UserListTypeWatchLater UserListType = "watch_later"
+	UserListTypeFavorites  UserListType = "favorites"
+	UserListTypeCustom     UserListType = "custom" // a list created by the user
+)
+
+func (UserListType) Values() (kinds []string) {
+	for _, s := range []UserListType{UserListTypeWatchLater, UserListTypeFavorites, UserListTypeCustom} {
 		kinds = append(kinds, string(s))
 	}
 	return
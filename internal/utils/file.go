@@ -372,6 +372,37 @@ func MoveDirectory(ctx context.Context, source, dest string) error {
 	})
 }
 
+// CopyDirectory copies the directory from source to destination, replacing files that already exist
+// at the destination. The source is left untouched.
+func CopyDirectory(ctx context.Context, source, dest string) error {
+	if err := os.MkdirAll(dest, os.ModePerm); err != nil {
+		return fmt.Errorf("failed to create destination directory: %w", err)
+	}
+
+	return filepath.Walk(source, func(path string, info os.FileInfo, err error) error {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		if err != nil {
+			return fmt.Errorf("error accessing path %q: %w", path, err)
+		}
+
+		relPath, err := filepath.Rel(source, path)
+		if err != nil {
+			return fmt.Errorf("failed to get relative path for %q: %w", path, err)
+		}
+		destPath := filepath.Join(dest, relPath)
+
+		if info.IsDir() {
+			return os.MkdirAll(destPath, info.Mode())
+		}
+		if err := CopyFile(path, destPath); err != nil {
+			return fmt.Errorf("failed to copy file %q: %w", path, err)
+		}
+		return nil
+	})
+}
+
 func MoveFolder(src string, dst string) error {
 	// Check if the source path exists
 	if _, err := os.Stat(src); os.IsNotExist(err) {
@@ -66,6 +66,45 @@ func TestMoveFilePreservesCopyError(t *testing.T) {
 	}
 }
 
+func TestCopyDirectory(t *testing.T) {
+	dir := t.TempDir()
+	source := filepath.Join(dir, "source")
+	dest := filepath.Join(dir, "dest")
+	if err := os.MkdirAll(filepath.Join(source, "video_hls"), 0755); err != nil {
+		t.Fatalf("failed to create source directory: %v", err)
+	}
+	if err := os.WriteFile(filepath.Join(source, "video.mp4"), []byte("video data"), 0644); err != nil {
+		t.Fatalf("failed to write source file: %v", err)
+	}
+	if err := os.WriteFile(filepath.Join(source, "video_hls", "0.ts"), []byte("segment"), 0644); err != nil {
+		t.Fatalf("failed to write source file: %v", err)
+	}
+	// a partial copy left by an interrupted copy is replaced
+	if err := os.MkdirAll(dest, 0755); err != nil {
+		t.Fatalf("failed to create destination directory: %v", err)
+	}
+	if err := os.WriteFile(filepath.Join(dest, "video.mp4"), []byte("video"), 0644); err != nil {
+		t.Fatalf("failed to write partial file: %v", err)
+	}
+
+	if err := CopyDirectory(context.Background(), source, dest); err != nil {
+		t.Fatalf("CopyDirectory returned error: %v", err)
+	}
+
+	for rel, want := range map[string]string{"video.mp4": "video data", filepath.Join("video_hls", "0.ts"): "segment"} {
+		got, err := os.ReadFile(filepath.Join(dest, rel))
+		if err != nil {
+			t.Fatalf("failed to read copied file %s: %v", rel, err)
+		}
+		if string(got) != want {
+			t.Fatalf("copied %s = %q; want %q", rel, got, want)
+		}
+		if _, err := os.Stat(filepath.Join(source, rel)); err != nil {
+			t.Fatalf("source file %s should remain: %v", rel, err)
+		}
+	}
+}
+
 func TestGetSizeOfDirectory(t *testing.T) {
 	dir := t.TempDir()
 
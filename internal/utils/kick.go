@@ -0,0 +1,11 @@
+package utils
+
+import "fmt"
+
+// CreateKickURL generates a Kick URL for the video. Live streams use the channel URL.
+func CreateKickURL(videoId string, videoType VodType, channelName string) string {
+	if videoType == Live {
+		return fmt.Sprintf("https://kick.com/%s", channelName)
+	}
+	return fmt.Sprintf("https://kick.com/%s/videos/%s", channelName, videoId)
+}
@@ -0,0 +1,66 @@
+package utils
+
+import (
+	"fmt"
+	"time"
+)
+
+// LiveScheduleWindow is a recurring window of a watched channel's schedule in which live streams are archived.
+type LiveScheduleWindow struct {
+	Days  []time.Weekday `json:"days" swaggertype:"array,integer"` // 0 is Sunday; every day if empty
+	Start string         `json:"start"`                            // 15:04
+	End   string         `json:"end"`                              // 15:04, the window ends the next day if it isn't after the start
+}
+
+// Validate returns an error if the days or times of the window are invalid.
+func (w LiveScheduleWindow) Validate() error {
+	for _, day := range w.Days {
+		if day < time.Sunday || day > time.Saturday {
+			return fmt.Errorf("invalid schedule day: %d", day)
+		}
+	}
+	if _, err := time.Parse("15:04", w.Start); err != nil {
+		return fmt.Errorf("invalid schedule start: %s", w.Start)
+	}
+	if _, err := time.Parse("15:04", w.End); err != nil {
+		return fmt.Errorf("invalid schedule end: %s", w.End)
+	}
+	return nil
+}
+
+// Contains returns whether the time is inside the window, using the location of the time.
+func (w LiveScheduleWindow) Contains(t time.Time) bool {
+	start, err := time.Parse("15:04", w.Start)
+	if err != nil {
+		return false
+	}
+	end, err := time.Parse("15:04", w.End)
+	if err != nil {
+		return false
+	}
+	startMinutes := start.Hour()*60 + start.Minute()
+	endMinutes := end.Hour()*60 + end.Minute()
+	minutes := t.Hour()*60 + t.Minute()
+
+	if startMinutes < endMinutes {
+		return w.onDay(t.Weekday()) && minutes >= startMinutes && minutes < endMinutes
+	}
+	// the window crosses midnight, the part after midnight belongs to the window of the previous day
+	if minutes >= startMinutes {
+		return w.onDay(t.Weekday())
+	}
+	return minutes < endMinutes && w.onDay((t.Weekday()+6)%7)
+}
+
+// onDay returns whether the window starts on the day.
+func (w LiveScheduleWindow) onDay(day time.Weekday) bool {
+	if len(w.Days) == 0 {
+		return true
+	}
+	for _, d := range w.Days {
+		if d == day {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,52 @@
+package utils
+
+import (
+	"testing"
+	"time"
+)
+
+func TestLiveScheduleWindowContains(t *testing.T) {
+	// 2026-03-02 is a Monday
+	at := func(day int, hour int, minute int) time.Time {
+		return time.Date(2026, 3, day, hour, minute, 0, 0, time.UTC)
+	}
+	tests := []struct {
+		name   string
+		window LiveScheduleWindow
+		time   time.Time
+		expect bool
+	}{
+		{name: "inside", window: LiveScheduleWindow{Start: "18:00", End: "23:00"}, time: at(2, 20, 0), expect: true},
+		{name: "start is inclusive", window: LiveScheduleWindow{Start: "18:00", End: "23:00"}, time: at(2, 18, 0), expect: true},
+		{name: "end is exclusive", window: LiveScheduleWindow{Start: "18:00", End: "23:00"}, time: at(2, 23, 0), expect: false},
+		{name: "other day", window: LiveScheduleWindow{Days: []time.Weekday{time.Saturday, time.Sunday}, Start: "18:00", End: "23:00"}, time: at(2, 20, 0), expect: false},
+		{name: "listed day", window: LiveScheduleWindow{Days: []time.Weekday{time.Monday}, Start: "18:00", End: "23:00"}, time: at(2, 20, 0), expect: true},
+		{name: "before midnight", window: LiveScheduleWindow{Days: []time.Weekday{time.Monday}, Start: "22:00", End: "02:00"}, time: at(2, 23, 30), expect: true},
+		{name: "after midnight", window: LiveScheduleWindow{Days: []time.Weekday{time.Monday}, Start: "22:00", End: "02:00"}, time: at(3, 1, 30), expect: true},
+		{name: "after midnight of unlisted day", window: LiveScheduleWindow{Days: []time.Weekday{time.Monday}, Start: "22:00", End: "02:00"}, time: at(2, 1, 30), expect: false},
+		{name: "whole day", window: LiveScheduleWindow{Days: []time.Weekday{time.Monday}, Start: "00:00", End: "00:00"}, time: at(2, 12, 0), expect: true},
+		{name: "invalid", window: LiveScheduleWindow{Start: "6pm", End: "23:00"}, time: at(2, 20, 0), expect: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.window.Contains(tt.time); got != tt.expect {
+				t.Errorf("expected %v, got %v", tt.expect, got)
+			}
+		})
+	}
+}
+
+func TestLiveScheduleWindowValidate(t *testing.T) {
+	if err := (LiveScheduleWindow{Days: []time.Weekday{time.Sunday, time.Saturday}, Start: "08:00", End: "17:30"}).Validate(); err != nil {
+		t.Errorf("expected valid window, got %v", err)
+	}
+	for _, window := range []LiveScheduleWindow{
+		{Days: []time.Weekday{7}, Start: "08:00", End: "17:30"},
+		{Start: "24:00", End: "17:30"},
+		{Start: "08:00", End: ""},
+	} {
+		if err := window.Validate(); err == nil {
+			t.Errorf("expected %+v to be invalid", window)
+		}
+	}
+}
@@ -5,6 +5,8 @@ import (
 	"regexp"
 	"sort"
 	"strconv"
+	"strings"
+	"time"
 	"unicode"
 )
 
@@ -128,3 +130,35 @@ func pickHighestQuality(options []string) string {
 
 	return parsed[0].Original
 }
+
+// liveQualityRegex matches the qualities a live stream can be requested in besides best and audio.
+var liveQualityRegex = regexp.MustCompile(`^\d+p(\d+)?$`)
+
+// ValidLiveQuality returns whether the quality can be requested for a live stream, e.g. best, 720p60 or audio.
+func ValidLiveQuality(quality string) bool {
+	return quality == "best" || quality == "audio" || liveQualityRegex.MatchString(quality)
+}
+
+// QualityChain returns the qualities a live stream is archived in, in order: the resolution followed by
+// the fallbacks. Empty and repeated qualities are skipped.
+func QualityChain(resolution string, fallbacks []string) []string {
+	chain := []string{}
+	seen := map[string]bool{}
+	for _, quality := range append([]string{resolution}, fallbacks...) {
+		quality = strings.ToLower(strings.TrimSpace(quality))
+		if quality == "" || seen[quality] {
+			continue
+		}
+		seen[quality] = true
+		chain = append(chain, quality)
+	}
+	return chain
+}
+
+// QualitySwitch is a switch of a live stream archive to another quality after repeated failures.
+type QualitySwitch struct {
+	Time     time.Time `json:"time"`
+	From     string    `json:"from"`     // the variant that kept failing
+	To       string    `json:"to"`       // the variant archived from now on
+	Failures int       `json:"failures"` // the number of failures of the previous variant
+}
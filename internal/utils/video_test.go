@@ -2,6 +2,7 @@ package utils
 
 import (
 	"fmt"
+	"strings"
 	"testing"
 )
 
@@ -67,3 +68,43 @@ func TestParseQuality(t *testing.T) {
 		})
 	}
 }
+
+// TestQualityChain validates the order and normalization of the quality chain.
+func TestQualityChain(t *testing.T) {
+	tests := []struct {
+		resolution string
+		fallbacks  []string
+		expected   string
+	}{
+		{"1080p60", nil, "1080p60"},
+		{"1080p60", []string{"720p60", "best"}, "1080p60,720p60,best"},
+		{"best", []string{" 720P60 ", "best", "", "720p60"}, "best,720p60"},
+		{"", []string{"480p30"}, "480p30"},
+	}
+
+	for _, test := range tests {
+		t.Run(fmt.Sprintf("Resolution: %s", test.resolution), func(t *testing.T) {
+			result := strings.Join(QualityChain(test.resolution, test.fallbacks), ",")
+			if result != test.expected {
+				t.Errorf("For resolution %s and fallbacks %v, expected %s but got %s", test.resolution, test.fallbacks, test.expected, result)
+			}
+		})
+	}
+}
+
+// TestValidLiveQuality validates the accepted live stream qualities.
+func TestValidLiveQuality(t *testing.T) {
+	for quality, expected := range map[string]bool{
+		"best":       true,
+		"audio":      true,
+		"1080p60":    true,
+		"720p":       true,
+		"chunked":    false,
+		"720":        false,
+		"1080p60 -y": false,
+	} {
+		if result := ValidLiveQuality(quality); result != expected {
+			t.Errorf("For quality %q, expected %t but got %t", quality, expected, result)
+		}
+	}
+}
@@ -0,0 +1,56 @@
+package utils
+
+import (
+	"fmt"
+	"math"
+	"sort"
+)
+
+// WatchedRange is a range of a video that was played during a viewing session.
+type WatchedRange struct {
+	Start float64 `json:"start"` // seconds on the video timeline
+	End   float64 `json:"end"`   // seconds on the video timeline, after the start
+}
+
+// Validate returns an error if the range is empty or outside of a video of the duration in seconds.
+func (r WatchedRange) Validate(duration float64) error {
+	if math.IsNaN(r.Start) || math.IsNaN(r.End) || r.Start < 0 || r.End <= r.Start {
+		return fmt.Errorf("invalid watched range: %v - %v", r.Start, r.End)
+	}
+	if duration > 0 && r.End > duration {
+		return fmt.Errorf("watched range ends after the video: %v > %v", r.End, duration)
+	}
+	return nil
+}
+
+// WatchedSeconds returns the seconds played in the ranges. Parts of the video played multiple times are counted every time.
+func WatchedSeconds(ranges []WatchedRange) float64 {
+	var seconds float64
+	for _, r := range ranges {
+		seconds += r.End - r.Start
+	}
+	return seconds
+}
+
+// MergeWatchedRanges returns the parts of the video covered by the ranges, sorted and without overlaps.
+func MergeWatchedRanges(ranges []WatchedRange) []WatchedRange {
+	if len(ranges) == 0 {
+		return nil
+	}
+	sorted := make([]WatchedRange, len(ranges))
+	copy(sorted, ranges)
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].Start < sorted[j].Start
+	})
+
+	merged := []WatchedRange{sorted[0]}
+	for _, r := range sorted[1:] {
+		last := &merged[len(merged)-1]
+		if r.Start <= last.End {
+			last.End = math.Max(last.End, r.End)
+			continue
+		}
+		merged = append(merged, r)
+	}
+	return merged
+}
@@ -0,0 +1,48 @@
+package utils
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestMergeWatchedRanges(t *testing.T) {
+	tests := []struct {
+		name   string
+		ranges []WatchedRange
+		expect []WatchedRange
+	}{
+		{name: "empty", ranges: nil, expect: nil},
+		{name: "single", ranges: []WatchedRange{{Start: 10, End: 20}}, expect: []WatchedRange{{Start: 10, End: 20}}},
+		{name: "overlapping", ranges: []WatchedRange{{Start: 10, End: 20}, {Start: 15, End: 30}}, expect: []WatchedRange{{Start: 10, End: 30}}},
+		{name: "adjacent", ranges: []WatchedRange{{Start: 10, End: 20}, {Start: 20, End: 30}}, expect: []WatchedRange{{Start: 10, End: 30}}},
+		{name: "contained", ranges: []WatchedRange{{Start: 0, End: 100}, {Start: 20, End: 30}}, expect: []WatchedRange{{Start: 0, End: 100}}},
+		{name: "unsorted with gap", ranges: []WatchedRange{{Start: 50, End: 60}, {Start: 0, End: 10}, {Start: 5, End: 15}}, expect: []WatchedRange{{Start: 0, End: 15}, {Start: 50, End: 60}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := MergeWatchedRanges(tt.ranges); !reflect.DeepEqual(got, tt.expect) {
+				t.Errorf("MergeWatchedRanges() = %v, want %v", got, tt.expect)
+			}
+		})
+	}
+}
+
+func TestWatchedRangeValidate(t *testing.T) {
+	tests := []struct {
+		name    string
+		r       WatchedRange
+		wantErr bool
+	}{
+		{name: "valid", r: WatchedRange{Start: 0, End: 10}},
+		{name: "negative start", r: WatchedRange{Start: -1, End: 10}, wantErr: true},
+		{name: "empty", r: WatchedRange{Start: 10, End: 10}, wantErr: true},
+		{name: "after the video", r: WatchedRange{Start: 50, End: 101}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.r.Validate(100); (err != nil) != tt.wantErr {
+				t.Errorf("Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
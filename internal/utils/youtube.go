@@ -0,0 +1,8 @@
+package utils
+
+import "fmt"
+
+// CreateYoutubeURL generates a YouTube watch URL for the video ID. Live streams share the ID of their video so this is valid for both.
+func CreateYoutubeURL(videoId string) string {
+	return fmt.Sprintf("https://www.youtube.com/watch?v=%s", videoId)
+}
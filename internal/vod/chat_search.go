@@ -0,0 +1,143 @@
+package vod
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math"
+	"strings"
+	"time"
+
+	entsql "entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/rs/zerolog/log"
+	"github.com/zibbp/ganymede/ent"
+	entChannel "github.com/zibbp/ganymede/ent/channel"
+	entChatMessage "github.com/zibbp/ganymede/ent/chatmessage"
+	"github.com/zibbp/ganymede/ent/predicate"
+	"github.com/zibbp/ganymede/ent/vod"
+)
+
+type ChatSearchParams struct {
+	Query     string     // full-text query over the message text
+	Chatter   string     // login or display name of the chatter
+	Emote     string     // name of an emote used in the message
+	ChannelID uuid.UUID  // restrict results to a channel
+	VideoID   uuid.UUID  // restrict results to a video
+	From      *time.Time // messages sent at or after this time
+	To        *time.Time // messages sent at or before this time
+	Limit     int
+	Offset    int
+}
+
+type ChatSearchPagination struct {
+	Offset     int                `json:"offset"`
+	Limit      int                `json:"limit"`
+	TotalCount int                `json:"total_count"`
+	Pages      int                `json:"pages"`
+	Data       []*ent.ChatMessage `json:"data"`
+}
+
+// SearchChat searches the indexed chat of all videos. Each hit includes the video and the content offset of the message so playback can start at the message.
+func (s *Service) SearchChat(ctx context.Context, params ChatSearchParams) (ChatSearchPagination, error) {
+	var pagination ChatSearchPagination
+
+	predicates := chatSearchPredicates(params)
+
+	messages, err := s.Store.Client.ChatMessage.Query().
+		Where(predicates...).
+		WithVod(func(q *ent.VodQuery) {
+			q.WithChannel()
+		}).
+		Order(entChatMessage.ByCreatedAt(entsql.OrderDesc()), entChatMessage.ByContentOffsetSeconds()).
+		Limit(params.Limit).
+		Offset(params.Offset).
+		All(ctx)
+	if err != nil {
+		log.Debug().Err(err).Msg("error searching chat")
+		return pagination, fmt.Errorf("error searching chat: %v", err)
+	}
+
+	totalCount, err := s.Store.Client.ChatMessage.Query().Where(predicates...).Count(ctx)
+	if err != nil {
+		log.Debug().Err(err).Msg("error getting total chat message count")
+		return pagination, fmt.Errorf("error getting total chat message count: %v", err)
+	}
+
+	pagination.TotalCount = totalCount
+	pagination.Limit = params.Limit
+	pagination.Offset = params.Offset
+	pagination.Pages = int(math.Ceil(float64(totalCount) / float64(params.Limit)))
+	pagination.Data = messages
+
+	return pagination, nil
+}
+
+func chatSearchPredicates(params ChatSearchParams) []predicate.ChatMessage {
+	var predicates []predicate.ChatMessage
+	if params.Query != "" {
+		predicates = append(predicates, chatMessageBodyMatches(params.Query))
+	}
+	if params.Chatter != "" {
+		predicates = append(predicates, entChatMessage.Or(
+			chatMessageLowerEquals(entChatMessage.FieldCommenterName, params.Chatter),
+			chatMessageLowerEquals(entChatMessage.FieldCommenterDisplayName, params.Chatter),
+		))
+	}
+	if params.Emote != "" {
+		predicates = append(predicates, chatMessageHasEmote(params.Emote))
+	}
+	if params.ChannelID != uuid.Nil {
+		predicates = append(predicates, entChatMessage.HasVodWith(vod.HasChannelWith(entChannel.ID(params.ChannelID))))
+	}
+	if params.VideoID != uuid.Nil {
+		predicates = append(predicates, entChatMessage.VodID(params.VideoID))
+	}
+	if params.From != nil {
+		predicates = append(predicates, entChatMessage.CreatedAtGTE(*params.From))
+	}
+	if params.To != nil {
+		predicates = append(predicates, entChatMessage.CreatedAtLTE(*params.To))
+	}
+	return predicates
+}
+
+// chatMessageLowerEquals matches messages whose field equals the value ignoring case.
+// The expression must stay in sync with the indexes created by database.ensureChatMessageSearchIndex.
+func chatMessageLowerEquals(field string, value string) predicate.ChatMessage {
+	return func(s *entsql.Selector) {
+		s.Where(entsql.P(func(b *entsql.Builder) {
+			b.WriteString("lower(").
+				WriteString(s.C(field)).
+				WriteString(") = ").
+				Arg(strings.ToLower(value))
+		}))
+	}
+}
+
+// chatMessageHasEmote matches messages using the emote. The emotes are compared as a JSON array so the
+// containment can use the GIN index created by database.ensureChatMessageSearchIndex.
+func chatMessageHasEmote(emote string) predicate.ChatMessage {
+	emotes, _ := json.Marshal([]string{emote})
+	return func(s *entsql.Selector) {
+		s.Where(entsql.P(func(b *entsql.Builder) {
+			b.WriteString(s.C(entChatMessage.FieldEmotes)).
+				WriteString(" @> ").
+				Arg(string(emotes))
+		}))
+	}
+}
+
+// chatMessageBodyMatches matches messages using the postgres full-text index on the body.
+// The expression must stay in sync with the index created by database.ensureChatMessageSearchIndex.
+func chatMessageBodyMatches(query string) predicate.ChatMessage {
+	return func(s *entsql.Selector) {
+		s.Where(entsql.P(func(b *entsql.Builder) {
+			b.WriteString("to_tsvector('simple', ").
+				WriteString(s.C(entChatMessage.FieldBody)).
+				WriteString(") @@ websearch_to_tsquery('simple', ").
+				Arg(query).
+				WriteString(")")
+		}))
+	}
+}
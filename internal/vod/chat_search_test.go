@@ -0,0 +1,23 @@
+package vod
+
+import (
+	"testing"
+
+	"entgo.io/ent/dialect"
+	entsql "entgo.io/ent/dialect/sql"
+	"github.com/stretchr/testify/require"
+	entChatMessage "github.com/zibbp/ganymede/ent/chatmessage"
+)
+
+func TestChatSearchPredicatesUseIndexedExpressions(t *testing.T) {
+	t.Parallel()
+
+	selector := entsql.Dialect(dialect.Postgres).Select("*").From(entsql.Table(entChatMessage.Table))
+	for _, p := range chatSearchPredicates(ChatSearchParams{Chatter: "Chatter", Emote: "Kappa"}) {
+		p(selector)
+	}
+
+	query, args := selector.Query()
+	require.Equal(t, `SELECT * FROM "chat_messages" WHERE (lower("chat_messages"."commenter_name") = $1 OR lower("chat_messages"."commenter_display_name") = $2) AND "chat_messages"."emotes" @> $3`, query)
+	require.Equal(t, []any{"chatter", "chatter", `["Kappa"]`}, args)
+}
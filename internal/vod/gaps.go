@@ -0,0 +1,29 @@
+package vod
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/zibbp/ganymede/ent"
+	"github.com/zibbp/ganymede/ent/livegap"
+	"github.com/zibbp/ganymede/ent/vod"
+)
+
+// GetVideoLiveGaps returns the parts of the stream missed while recording the live archive of the video,
+// ordered by their position in the archive.
+func (s *Service) GetVideoLiveGaps(ctx context.Context, videoID uuid.UUID) ([]*ent.LiveGap, error) {
+	exists, err := s.Store.Client.Vod.Query().Where(vod.ID(videoID)).Exist(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("error fetching video: %v", err)
+	}
+	if !exists {
+		return nil, fmt.Errorf("video not found")
+	}
+
+	gaps, err := s.Store.Client.LiveGap.Query().Where(livegap.VodID(videoID)).Order(ent.Asc(livegap.FieldOffset)).All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("error fetching live gaps: %v", err)
+	}
+	return gaps, nil
+}
@@ -0,0 +1,119 @@
+package vod
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/riverqueue/river"
+	"github.com/zibbp/ganymede/ent"
+	entVod "github.com/zibbp/ganymede/ent/vod"
+	"github.com/zibbp/ganymede/internal/tasks"
+	"github.com/zibbp/ganymede/internal/utils"
+)
+
+// repairAuditDelay is how long after a repair the video is audited again, long enough for
+// thumbnails to be generated and most chats to be downloaded and rendered.
+const repairAuditDelay = time.Hour
+
+// LibraryAudit is the result of the last library audit.
+type LibraryAudit struct {
+	Counts map[utils.VideoHealth]int `json:"counts"`
+	Videos []*ent.Vod                `json:"videos"` // degraded and broken videos, broken first
+}
+
+// RepairResult lists the issues of a video that were queued for repair.
+type RepairResult struct {
+	Queued  []utils.VideoHealthIssue `json:"queued"`
+	Skipped []utils.VideoHealthIssue `json:"skipped"` // issues that can't be repaired automatically
+}
+
+// GetLibraryAudit returns the number of videos of each health status and the videos with issues.
+func (s *Service) GetLibraryAudit(ctx context.Context) (*LibraryAudit, error) {
+	var counts []struct {
+		HealthStatus utils.VideoHealth `json:"health_status"`
+		Count        int               `json:"count"`
+	}
+	err := s.Store.Client.Vod.Query().
+		GroupBy(entVod.FieldHealthStatus).
+		Aggregate(ent.Count()).
+		Scan(ctx, &counts)
+	if err != nil {
+		return nil, fmt.Errorf("error counting videos by health: %v", err)
+	}
+
+	audit := &LibraryAudit{Counts: map[utils.VideoHealth]int{}}
+	for _, status := range utils.VideoHealth("").Values() {
+		audit.Counts[utils.VideoHealth(status)] = 0
+	}
+	for _, count := range counts {
+		audit.Counts[count.HealthStatus] = count.Count
+	}
+
+	audit.Videos, err = s.Store.Client.Vod.Query().
+		Where(entVod.HealthStatusIn(utils.VideoHealthBroken, utils.VideoHealthDegraded)).
+		WithChannel().
+		Order(ent.Asc(entVod.FieldHealthStatus), ent.Desc(entVod.FieldStreamedAt)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("error fetching videos with issues: %v", err)
+	}
+	return audit, nil
+}
+
+// RepairVideo queues the tasks that regenerate the missing files of a video found by the last audit.
+// Thumbnails and sprite thumbnails are regenerated from the video and the chat is downloaded again
+// if the video was archived from a platform. The video is audited again after the repair.
+func (s *Service) RepairVideo(ctx context.Context, videoID uuid.UUID) (*RepairResult, error) {
+	video, err := s.Store.Client.Vod.Query().Where(entVod.ID(videoID)).WithQueue().Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, fmt.Errorf("video not found")
+		}
+		return nil, fmt.Errorf("error fetching video: %v", err)
+	}
+	if video.Processing {
+		return nil, fmt.Errorf("video is processing")
+	}
+
+	result := &RepairResult{Queued: []utils.VideoHealthIssue{}, Skipped: []utils.VideoHealthIssue{}}
+	chatQueued := false
+	for _, issue := range video.HealthIssues {
+		var args river.JobArgs
+		switch issue {
+		case utils.VideoHealthIssueThumbnailMissing:
+			args = tasks.GenerateStaticThumbnailArgs{VideoId: video.ID.String()}
+		case utils.VideoHealthIssueSpriteThumbnailsMissing:
+			args = tasks.GenerateSpriteThumbnailArgs{VideoId: video.ID.String()}
+		case utils.VideoHealthIssueChatMissing, utils.VideoHealthIssueChatVideoMissing:
+			// live chats can't be downloaded again
+			if video.Type == utils.Live || video.Edges.Queue == nil {
+				break
+			}
+			// downloading the chat again also renders and moves it
+			if chatQueued {
+				result.Queued = append(result.Queued, issue)
+				continue
+			}
+			args = tasks.DownloadChatArgs{Continue: true, Input: tasks.ArchiveVideoInput{QueueId: video.Edges.Queue.ID}}
+			chatQueued = true
+		}
+		if args == nil {
+			result.Skipped = append(result.Skipped, issue)
+			continue
+		}
+		if _, err := s.RiverClient.Client.Insert(ctx, args, nil); err != nil {
+			return nil, fmt.Errorf("error queueing repair of %s: %v", issue, err)
+		}
+		result.Queued = append(result.Queued, issue)
+	}
+
+	if len(result.Queued) > 0 {
+		_, err = s.RiverClient.Client.Insert(ctx, tasks.AuditLibraryArgs{VideoID: &video.ID}, &river.InsertOpts{ScheduledAt: time.Now().Add(repairAuditDelay)})
+		if err != nil {
+			return nil, fmt.Errorf("error queueing audit: %v", err)
+		}
+	}
+	return result, nil
+}
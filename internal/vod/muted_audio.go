@@ -0,0 +1,43 @@
+package vod
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/zibbp/ganymede/ent"
+	"github.com/zibbp/ganymede/internal/mutedaudio"
+	"github.com/zibbp/ganymede/internal/tasks"
+	"github.com/zibbp/ganymede/internal/utils"
+)
+
+// RecoverMutedAudio queues the recovery of the audio of the muted segments of a VOD from the live archive of
+// the same stream. The offset overrides the estimated position in the VOD where the live archive starts.
+func (s *Service) RecoverMutedAudio(ctx context.Context, videoID uuid.UUID, offset *float64) error {
+	video, err := s.Store.Client.Vod.Get(ctx, videoID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return fmt.Errorf("video not found")
+		}
+		return fmt.Errorf("error fetching video: %v", err)
+	}
+	if video.Processing {
+		return fmt.Errorf("video is processing")
+	}
+	if video.Type != utils.Archive {
+		return fmt.Errorf("video is not a vod")
+	}
+
+	live, err := mutedaudio.FindLiveArchive(ctx, s.Store.Client, video)
+	if err != nil {
+		return err
+	}
+	if live == nil {
+		return fmt.Errorf("stream was not archived live")
+	}
+
+	if _, err := s.RiverClient.Client.Insert(ctx, tasks.RecoverMutedAudioArgs{VideoID: &video.ID, Offset: offset}, nil); err != nil {
+		return fmt.Errorf("error queueing muted audio recovery: %v", err)
+	}
+	return nil
+}
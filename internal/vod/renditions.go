@@ -0,0 +1,41 @@
+package vod
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/zibbp/ganymede/ent"
+	"github.com/zibbp/ganymede/internal/hls"
+	"github.com/zibbp/ganymede/internal/storage"
+)
+
+// GetVideoRenditions returns the adaptive bitrate renditions of an HLS video. Videos with a single
+// rendition, such as MP4 videos, have no renditions.
+func (s *Service) GetVideoRenditions(ctx context.Context, videoID uuid.UUID) ([]hls.Variant, error) {
+	video, err := s.Store.Client.Vod.Get(ctx, videoID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, fmt.Errorf("video not found")
+		}
+		return nil, fmt.Errorf("error fetching video: %v", err)
+	}
+	if video.VideoHlsPath == "" || video.Processing {
+		return []hls.Variant{}, nil
+	}
+
+	reader, err := storage.Get().Open(ctx, video.VideoPath)
+	if err != nil {
+		return nil, fmt.Errorf("error opening playlist: %v", err)
+	}
+	defer reader.Close()
+
+	variants, err := hls.ReadVariants(reader)
+	if err != nil {
+		return nil, fmt.Errorf("error reading playlist: %v", err)
+	}
+	if variants == nil {
+		return []hls.Variant{}, nil
+	}
+	return variants, nil
+}
@@ -0,0 +1,185 @@
+package vod
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/zibbp/ganymede/ent"
+	entChannel "github.com/zibbp/ganymede/ent/channel"
+	entVod "github.com/zibbp/ganymede/ent/vod"
+	"github.com/zibbp/ganymede/internal/database"
+	"github.com/zibbp/ganymede/internal/utils"
+)
+
+// RetentionReason is the rule of a retention policy that selected a video for deletion.
+type RetentionReason string
+
+const (
+	RetentionReasonAge        RetentionReason = "age"         // older than the retention days
+	RetentionReasonKeepNewest RetentionReason = "keep_newest" // not one of the newest N videos
+	RetentionReasonMaxSize    RetentionReason = "max_size"    // deleted to bring the channel below the size cap
+)
+
+// RetentionPolicy is the video retention policy of a channel.
+//
+// Rules are combined, a video is deleted if any rule selects it. Locked and processing videos,
+// videos of other types and exempt videos are never deleted but still count towards the channel size.
+type RetentionPolicy struct {
+	Days            int64           // delete videos older than this many days, 0 disables the rule
+	KeepNewest      int             // keep only the newest N videos the policy applies to, 0 disables the rule
+	MaxSizeBytes    int64           // delete the oldest videos while the channel is larger than this, 0 disables the rule
+	VideoTypes      []utils.VodType // video types the policy applies to, all types if empty
+	ExemptPlaylists bool            // never delete videos that are in a playlist
+	ExemptMinViews  int             // never delete videos with at least this many local views, 0 disables the exemption
+}
+
+func RetentionPolicyFromChannel(channel *ent.Channel) RetentionPolicy {
+	return RetentionPolicy{
+		Days:            channel.RetentionDays,
+		KeepNewest:      channel.RetentionKeepNewest,
+		MaxSizeBytes:    channel.RetentionMaxSizeBytes,
+		VideoTypes:      channel.RetentionVideoTypes,
+		ExemptPlaylists: channel.RetentionExemptPlaylists,
+		ExemptMinViews:  channel.RetentionExemptMinViews,
+	}
+}
+
+type RetentionCandidate struct {
+	ID               uuid.UUID       `json:"id"`
+	Title            string          `json:"title"`
+	Type             utils.VodType   `json:"type"`
+	CreatedAt        time.Time       `json:"created_at"`
+	StorageSizeBytes int64           `json:"storage_size_bytes"`
+	Reason           RetentionReason `json:"reason"`
+}
+
+type RetentionDryRun struct {
+	ChannelID  uuid.UUID            `json:"channel_id"`
+	Enabled    bool                 `json:"enabled"` // nothing is deleted until retention is enabled for the channel
+	TotalCount int                  `json:"total_count"`
+	TotalBytes int64                `json:"total_bytes"`
+	Videos     []RetentionCandidate `json:"videos"`
+}
+
+// Evaluate returns the videos the policy would delete, oldest first.
+// inPlaylist holds the IDs of the videos that are in at least one playlist.
+func (p RetentionPolicy) Evaluate(videos []*ent.Vod, inPlaylist map[uuid.UUID]bool, now time.Time) []RetentionCandidate {
+	var totalBytes int64
+	eligible := make([]*ent.Vod, 0, len(videos))
+	for _, video := range videos {
+		totalBytes += video.StorageSizeBytes
+		if video.Locked || video.Processing {
+			continue
+		}
+		if len(p.VideoTypes) > 0 && !slices.Contains(p.VideoTypes, video.Type) {
+			continue
+		}
+		if p.ExemptPlaylists && inPlaylist[video.ID] {
+			continue
+		}
+		if p.ExemptMinViews > 0 && video.LocalViews >= p.ExemptMinViews {
+			continue
+		}
+		eligible = append(eligible, video)
+	}
+
+	// newest first
+	sort.SliceStable(eligible, func(i, j int) bool {
+		return eligible[i].CreatedAt.After(eligible[j].CreatedAt)
+	})
+
+	reasons := make(map[uuid.UUID]RetentionReason)
+	for i, video := range eligible {
+		switch {
+		case p.Days > 0 && video.CreatedAt.Add(time.Duration(p.Days)*24*time.Hour).Before(now):
+			reasons[video.ID] = RetentionReasonAge
+		case p.KeepNewest > 0 && i >= p.KeepNewest:
+			reasons[video.ID] = RetentionReasonKeepNewest
+		}
+		if _, ok := reasons[video.ID]; ok {
+			totalBytes -= video.StorageSizeBytes
+		}
+	}
+
+	if p.MaxSizeBytes > 0 {
+		for i := len(eligible) - 1; i >= 0 && totalBytes > p.MaxSizeBytes; i-- {
+			video := eligible[i]
+			if _, ok := reasons[video.ID]; ok {
+				continue
+			}
+			reasons[video.ID] = RetentionReasonMaxSize
+			totalBytes -= video.StorageSizeBytes
+		}
+	}
+
+	candidates := []RetentionCandidate{}
+	for i := len(eligible) - 1; i >= 0; i-- {
+		video := eligible[i]
+		reason, ok := reasons[video.ID]
+		if !ok {
+			continue
+		}
+		candidates = append(candidates, RetentionCandidate{
+			ID:               video.ID,
+			Title:            video.Title,
+			Type:             video.Type,
+			CreatedAt:        video.CreatedAt,
+			StorageSizeBytes: video.StorageSizeBytes,
+			Reason:           reason,
+		})
+	}
+	return candidates
+}
+
+// retentionCandidates returns the videos of the channel that its retention policy would delete.
+func retentionCandidates(ctx context.Context, store *database.Database, channel *ent.Channel, now time.Time) ([]RetentionCandidate, error) {
+	videos, err := store.Client.Vod.Query().Where(entVod.HasChannelWith(entChannel.ID(channel.ID))).All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("error fetching videos for channel %s: %w", channel.ID, err)
+	}
+
+	inPlaylist := make(map[uuid.UUID]bool)
+	if channel.RetentionExemptPlaylists {
+		ids, err := store.Client.Vod.Query().Where(entVod.HasChannelWith(entChannel.ID(channel.ID)), entVod.HasPlaylists()).IDs(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("error fetching playlist videos for channel %s: %w", channel.ID, err)
+		}
+		for _, id := range ids {
+			inPlaylist[id] = true
+		}
+	}
+
+	return RetentionPolicyFromChannel(channel).Evaluate(videos, inPlaylist, now), nil
+}
+
+// GetChannelRetentionDryRun returns the videos the retention policy of the channel would delete without deleting them.
+// The policy is evaluated even if retention is not enabled for the channel so it can be checked before enabling it.
+func (s *Service) GetChannelRetentionDryRun(ctx context.Context, channelID uuid.UUID) (*RetentionDryRun, error) {
+	channel, err := s.Store.Client.Channel.Get(ctx, channelID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, fmt.Errorf("channel not found")
+		}
+		return nil, fmt.Errorf("error fetching channel: %v", err)
+	}
+
+	candidates, err := retentionCandidates(ctx, s.Store, channel, time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	dryRun := &RetentionDryRun{
+		ChannelID:  channel.ID,
+		Enabled:    channel.Retention,
+		TotalCount: len(candidates),
+		Videos:     candidates,
+	}
+	for _, candidate := range candidates {
+		dryRun.TotalBytes += candidate.StorageSizeBytes
+	}
+	return dryRun, nil
+}
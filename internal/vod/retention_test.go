@@ -0,0 +1,100 @@
+package vod
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"github.com/zibbp/ganymede/ent"
+	"github.com/zibbp/ganymede/internal/utils"
+)
+
+func retentionTestVideo(daysOld int, videoType utils.VodType, sizeBytes int64, now time.Time) *ent.Vod {
+	return &ent.Vod{
+		ID:               uuid.New(),
+		Type:             videoType,
+		StorageSizeBytes: sizeBytes,
+		CreatedAt:        now.Add(-time.Duration(daysOld) * 24 * time.Hour),
+	}
+}
+
+func retentionCandidateIDs(candidates []RetentionCandidate) []uuid.UUID {
+	ids := make([]uuid.UUID, 0, len(candidates))
+	for _, candidate := range candidates {
+		ids = append(ids, candidate.ID)
+	}
+	return ids
+}
+
+func TestRetentionPolicyAgeByType(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)
+	oldClip := retentionTestVideo(10, utils.Clip, 1, now)
+	newClip := retentionTestVideo(2, utils.Clip, 1, now)
+	oldArchive := retentionTestVideo(30, utils.Archive, 1, now)
+
+	policy := RetentionPolicy{Days: 7, VideoTypes: []utils.VodType{utils.Clip}}
+	candidates := policy.Evaluate([]*ent.Vod{oldClip, newClip, oldArchive}, nil, now)
+
+	require.Equal(t, []uuid.UUID{oldClip.ID}, retentionCandidateIDs(candidates))
+	require.Equal(t, RetentionReasonAge, candidates[0].Reason)
+}
+
+func TestRetentionPolicyKeepNewestWithExemptions(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)
+	newest := retentionTestVideo(1, utils.Archive, 1, now)
+	second := retentionTestVideo(2, utils.Archive, 1, now)
+	inPlaylist := retentionTestVideo(3, utils.Archive, 1, now)
+	popular := retentionTestVideo(4, utils.Archive, 1, now)
+	popular.LocalViews = 50
+	locked := retentionTestVideo(5, utils.Archive, 1, now)
+	locked.Locked = true
+	oldest := retentionTestVideo(6, utils.Archive, 1, now)
+	third := retentionTestVideo(3, utils.Archive, 1, now)
+
+	policy := RetentionPolicy{KeepNewest: 2, ExemptPlaylists: true, ExemptMinViews: 10}
+	candidates := policy.Evaluate(
+		[]*ent.Vod{newest, second, inPlaylist, popular, locked, oldest, third},
+		map[uuid.UUID]bool{inPlaylist.ID: true},
+		now,
+	)
+
+	require.Equal(t, []uuid.UUID{oldest.ID, third.ID}, retentionCandidateIDs(candidates))
+	for _, candidate := range candidates {
+		require.Equal(t, RetentionReasonKeepNewest, candidate.Reason)
+	}
+}
+
+func TestRetentionPolicyMaxSize(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)
+	newest := retentionTestVideo(1, utils.Archive, 40, now)
+	middle := retentionTestVideo(2, utils.Archive, 40, now)
+	oldest := retentionTestVideo(3, utils.Archive, 40, now)
+	expired := retentionTestVideo(40, utils.Archive, 40, now)
+	locked := retentionTestVideo(50, utils.Archive, 40, now)
+	locked.Locked = true
+
+	// 200 bytes in total, the expired video is deleted by age and the locked video always counts towards the size
+	policy := RetentionPolicy{Days: 30, MaxSizeBytes: 100}
+	candidates := policy.Evaluate([]*ent.Vod{newest, middle, oldest, expired, locked}, nil, now)
+
+	require.Equal(t, []uuid.UUID{expired.ID, oldest.ID, middle.ID}, retentionCandidateIDs(candidates))
+	require.Equal(t, RetentionReasonAge, candidates[0].Reason)
+	require.Equal(t, RetentionReasonMaxSize, candidates[1].Reason)
+	require.Equal(t, RetentionReasonMaxSize, candidates[2].Reason)
+}
+
+func TestRetentionPolicyDisabledRules(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)
+	videos := []*ent.Vod{retentionTestVideo(365, utils.Archive, 1<<40, now)}
+
+	require.Empty(t, RetentionPolicy{}.Evaluate(videos, nil, now))
+}
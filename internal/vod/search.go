@@ -0,0 +1,314 @@
+package vod
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+	"time"
+	"unicode"
+
+	"entgo.io/ent/dialect"
+	entsql "entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/rs/zerolog/log"
+	"github.com/zibbp/ganymede/ent"
+	entChannel "github.com/zibbp/ganymede/ent/channel"
+	entChapter "github.com/zibbp/ganymede/ent/chapter"
+	"github.com/zibbp/ganymede/ent/predicate"
+	"github.com/zibbp/ganymede/ent/vod"
+	"github.com/zibbp/ganymede/internal/database"
+	"github.com/zibbp/ganymede/internal/utils"
+)
+
+type SearchParams struct {
+	Query       string          // full-text query over the title, category, channel and chapter titles
+	Predicates  []predicate.Vod // field lookups which replace the full-text query, OR-combined
+	ChannelIDs  []uuid.UUID
+	Types       []utils.VodType
+	Category    string // matches the video category or a chapter title
+	From        *time.Time
+	To          *time.Time
+	MinDuration int // seconds, 0 for no minimum
+	MaxDuration int // seconds, 0 for no maximum
+	Resolutions []string
+	SortBy      utils.VideoSort
+	Order       utils.SortOrder
+	Limit       int
+	Offset      int
+}
+
+type SearchPagination struct {
+	Offset     int          `json:"offset"`
+	Limit      int          `json:"limit"`
+	TotalCount int          `json:"total_count"`
+	Pages      int          `json:"pages"`
+	Data       []*ent.Vod   `json:"data"`
+	Facets     SearchFacets `json:"facets"`
+}
+
+// SearchFacets holds the number of matching videos per bucket. Facets are computed over all matches, not just the current page.
+type SearchFacets struct {
+	Channels []SearchFacetBucket `json:"channels"`
+	Types    []SearchFacetBucket `json:"types"`
+	Years    []SearchFacetBucket `json:"years"`
+}
+
+type SearchFacetBucket struct {
+	Value string `json:"value"`
+	Label string `json:"label,omitempty"`
+	Count int    `json:"count"`
+}
+
+// SearchVods searches videos using postgres full-text search over the title, category, channel name and chapter titles.
+// Results can be filtered by channel, type, category, date range, duration and resolution and are returned with facet counts.
+func (s *Service) SearchVods(ctx context.Context, params SearchParams) (SearchPagination, error) {
+	var pagination SearchPagination
+
+	predicates := searchPredicates(params)
+
+	queryBuilder := s.Store.Client.Vod.Query().
+		Where(predicates...).
+		WithChannel().
+		Limit(params.Limit).
+		Offset(params.Offset)
+
+	if params.SortBy == utils.SortRelevance {
+		if params.Query != "" && len(params.Predicates) == 0 {
+			queryBuilder = queryBuilder.Order(searchRankOrder(params.Query), ent.Desc(vod.FieldStreamedAt))
+		} else {
+			queryBuilder = queryBuilder.Order(ent.Desc(vod.FieldStreamedAt))
+		}
+	} else {
+		var err error
+		queryBuilder, err = applyVodSorting(queryBuilder, params.SortBy, params.Order)
+		if err != nil {
+			log.Debug().Err(err).Msg("error applying vod sorting")
+			return pagination, fmt.Errorf("error applying vod sorting: %v", err)
+		}
+	}
+
+	vods, err := queryBuilder.All(ctx)
+	if err != nil {
+		log.Debug().Err(err).Msg("error searching vods")
+		return pagination, fmt.Errorf("error searching vods: %v", err)
+	}
+
+	totalCount, facets, err := s.searchFacets(ctx, predicates)
+	if err != nil {
+		log.Debug().Err(err).Msg("error getting search facets")
+		return pagination, fmt.Errorf("error getting search facets: %v", err)
+	}
+
+	pagination.TotalCount = totalCount
+	pagination.Limit = params.Limit
+	pagination.Offset = params.Offset
+	pagination.Pages = int(math.Ceil(float64(totalCount) / float64(params.Limit)))
+	pagination.Data = vods
+	pagination.Facets = facets
+
+	return pagination, nil
+}
+
+func searchPredicates(params SearchParams) []predicate.Vod {
+	var predicates []predicate.Vod
+	if len(params.Predicates) > 0 {
+		predicates = append(predicates, vod.Or(params.Predicates...))
+	} else if params.Query != "" {
+		predicates = append(predicates, searchMatches(params.Query))
+	}
+	if len(params.ChannelIDs) > 0 {
+		predicates = append(predicates, vod.HasChannelWith(entChannel.IDIn(params.ChannelIDs...)))
+	}
+	if len(params.Types) > 0 {
+		predicates = append(predicates, vod.TypeIn(params.Types...))
+	}
+	if params.Category != "" {
+		predicates = append(predicates, vod.Or(
+			vod.CategoryEqualFold(params.Category),
+			vod.HasChaptersWith(entChapter.TitleEqualFold(params.Category)),
+		))
+	}
+	if params.From != nil {
+		predicates = append(predicates, vod.StreamedAtGTE(*params.From))
+	}
+	if params.To != nil {
+		predicates = append(predicates, vod.StreamedAtLTE(*params.To))
+	}
+	if params.MinDuration > 0 {
+		predicates = append(predicates, vod.DurationGTE(params.MinDuration))
+	}
+	if params.MaxDuration > 0 {
+		predicates = append(predicates, vod.DurationLTE(params.MaxDuration))
+	}
+	if len(params.Resolutions) > 0 {
+		predicates = append(predicates, vod.ResolutionIn(params.Resolutions...))
+	}
+	return predicates
+}
+
+// searchDocument returns the column holding the weighted text search document of the video.
+// The title ranks highest, followed by the category and channel names, then chapter titles.
+func searchDocument(s *entsql.Selector) string {
+	return s.C(database.VodSearchDocumentColumn)
+}
+
+// searchPrefixQuery returns a tsquery matching the words of the query as prefixes so partially typed
+// words still find videos. Excluded words and operators of the query are skipped.
+func searchPrefixQuery(query string) string {
+	var terms []string
+	for _, word := range strings.Fields(query) {
+		if strings.HasPrefix(word, "-") || strings.EqualFold(word, "or") {
+			continue
+		}
+		for _, term := range strings.FieldsFunc(word, func(r rune) bool {
+			return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+		}) {
+			terms = append(terms, strings.ToLower(term)+":*")
+		}
+	}
+	return strings.Join(terms, " & ")
+}
+
+// searchMatches matches videos whose search document matches the query, or the words of the query as prefixes.
+// Both use the index of the search document.
+func searchMatches(query string) predicate.Vod {
+	return func(s *entsql.Selector) {
+		matches := []*entsql.Predicate{
+			entsql.P(func(b *entsql.Builder) {
+				b.WriteString(searchDocument(s)).
+					WriteString(" @@ websearch_to_tsquery('simple', ").
+					Arg(query).
+					WriteString(")")
+			}),
+		}
+		if prefix := searchPrefixQuery(query); prefix != "" {
+			matches = append(matches, entsql.P(func(b *entsql.Builder) {
+				b.WriteString(searchDocument(s)).
+					WriteString(" @@ to_tsquery('simple', ").
+					Arg(prefix).
+					WriteString(")")
+			}))
+		}
+		s.Where(entsql.Or(matches...))
+	}
+}
+
+func searchRankOrder(query string) vod.OrderOption {
+	return func(s *entsql.Selector) {
+		s.OrderExpr(entsql.ExprFunc(func(b *entsql.Builder) {
+			b.WriteString("ts_rank(").
+				WriteString(searchDocument(s)).
+				WriteString(", websearch_to_tsquery('simple', ").
+				Arg(query).
+				WriteString(")) DESC")
+		}))
+	}
+}
+
+// searchFacets counts the videos matching the predicates in total and per channel, type and year. The counts
+// are computed in one query so the matching videos are only filtered once.
+func (s *Service) searchFacets(ctx context.Context, predicates []predicate.Vod) (int, SearchFacets, error) {
+	facets := SearchFacets{Channels: []SearchFacetBucket{}, Types: []SearchFacetBucket{}, Years: []SearchFacetBucket{}}
+	total := 0
+
+	query, args := searchFacetQuery(predicates)
+	rows, err := s.Store.SQLDB.QueryContext(ctx, query, args...)
+	if err != nil {
+		return 0, facets, err
+	}
+	defer func() {
+		if err := rows.Close(); err != nil {
+			log.Debug().Err(err).Msg("error closing facet rows")
+		}
+	}()
+
+	for rows.Next() {
+		var facet int
+		var bucket SearchFacetBucket
+		if err := rows.Scan(&facet, &bucket.Value, &bucket.Count); err != nil {
+			return 0, facets, err
+		}
+		if facet == searchFacetTotal {
+			total = bucket.Count
+			continue
+		}
+		// videos without a value for the facet aren't a bucket
+		if bucket.Value == "" {
+			continue
+		}
+		switch facet {
+		case searchFacetChannel:
+			facets.Channels = append(facets.Channels, bucket)
+		case searchFacetType:
+			facets.Types = append(facets.Types, bucket)
+		case searchFacetYear:
+			facets.Years = append(facets.Years, bucket)
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return 0, facets, err
+	}
+
+	byCount := func(buckets []SearchFacetBucket) {
+		sort.SliceStable(buckets, func(i, j int) bool {
+			if buckets[i].Count != buckets[j].Count {
+				return buckets[i].Count > buckets[j].Count
+			}
+			return buckets[i].Value < buckets[j].Value
+		})
+	}
+	byCount(facets.Channels)
+	byCount(facets.Types)
+	sort.SliceStable(facets.Years, func(i, j int) bool { return facets.Years[i].Value > facets.Years[j].Value })
+
+	if len(facets.Channels) > 0 {
+		ids := make([]uuid.UUID, 0, len(facets.Channels))
+		for _, bucket := range facets.Channels {
+			if id, err := uuid.Parse(bucket.Value); err == nil {
+				ids = append(ids, id)
+			}
+		}
+		channels, err := s.Store.Client.Channel.Query().Where(entChannel.IDIn(ids...)).All(ctx)
+		if err != nil {
+			return 0, facets, fmt.Errorf("error getting channels for facet: %w", err)
+		}
+		names := make(map[string]string, len(channels))
+		for _, channel := range channels {
+			names[channel.ID.String()] = channel.DisplayName
+		}
+		for i := range facets.Channels {
+			facets.Channels[i].Label = names[facets.Channels[i].Value]
+		}
+	}
+
+	return total, facets, nil
+}
+
+// The grouping set of a row of the facet query, as returned by GROUPING over the channel, type and year.
+// A bit is set for every expression the row is not grouped by.
+const (
+	searchFacetChannel = 0b011
+	searchFacetType    = 0b101
+	searchFacetYear    = 0b110
+	searchFacetTotal   = 0b111
+)
+
+// searchFacetQuery counts the videos matching the predicates grouped by channel, type and year, and in total.
+func searchFacetQuery(predicates []predicate.Vod) (string, []any) {
+	selector := entsql.Dialect(dialect.Postgres).Select().From(entsql.Table(vod.Table))
+	for _, p := range predicates {
+		p(selector)
+	}
+	channel := selector.C(vod.ChannelColumn)
+	videoType := selector.C(vod.FieldType)
+	year := fmt.Sprintf("EXTRACT(YEAR FROM %s)::int", selector.C(vod.FieldStreamedAt))
+	selector.SelectExpr(
+		entsql.Raw(fmt.Sprintf("GROUPING(%s, %s, %s) AS facet", channel, videoType, year)),
+		entsql.Raw(fmt.Sprintf("COALESCE(CAST(%s AS text), CAST(%s AS text), CAST(%s AS text), '') AS value", channel, videoType, year)),
+		entsql.Raw("COUNT(*) AS count"),
+	)
+	selector.GroupBy(fmt.Sprintf("GROUPING SETS ((%s), (%s), (%s), ())", channel, videoType, year))
+	return selector.Query()
+}
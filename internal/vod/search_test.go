@@ -0,0 +1,63 @@
+package vod
+
+import (
+	"testing"
+
+	"entgo.io/ent/dialect"
+	entsql "entgo.io/ent/dialect/sql"
+	"github.com/stretchr/testify/require"
+	"github.com/zibbp/ganymede/ent/predicate"
+	entVod "github.com/zibbp/ganymede/ent/vod"
+	"github.com/zibbp/ganymede/internal/utils"
+)
+
+func TestSearchQueryArguments(t *testing.T) {
+	t.Parallel()
+
+	selector := entsql.Dialect(dialect.Postgres).Select("*").From(entsql.Table(entVod.Table))
+	for _, p := range searchPredicates(SearchParams{Query: "speedrun", Types: []utils.VodType{utils.Archive}}) {
+		p(selector)
+	}
+	searchRankOrder("speedrun")(selector)
+
+	query, args := selector.Query()
+	require.Contains(t, query, `"vods"."search_document" @@ websearch_to_tsquery('simple', $1) OR "vods"."search_document" @@ to_tsquery('simple', $2)`)
+	require.Contains(t, query, `"vods"."type" IN ($3)`)
+	require.Contains(t, query, `ORDER BY ts_rank("vods"."search_document", websearch_to_tsquery('simple', $4)) DESC`)
+	require.NotContains(t, query, "ILIKE")
+	require.Equal(t, []any{"speedrun", "speedrun:*", utils.Archive, "speedrun"}, args)
+}
+
+func TestSearchPrefixQuery(t *testing.T) {
+	t.Parallel()
+
+	require.Equal(t, "any:* & speed:*", searchPrefixQuery(`"Any% Speed"`))
+	require.Equal(t, "mario:* & 64:*", searchPrefixQuery("mario 64 -kart or"))
+	require.Equal(t, "", searchPrefixQuery("-kart !!"))
+}
+
+func TestSearchPredicatesReplaceFullText(t *testing.T) {
+	t.Parallel()
+
+	selector := entsql.Dialect(dialect.Postgres).Select("*").From(entsql.Table(entVod.Table))
+	for _, p := range searchPredicates(SearchParams{Query: "123", Predicates: []predicate.Vod{entVod.ExtID("123")}}) {
+		p(selector)
+	}
+
+	query, args := selector.Query()
+	require.NotContains(t, query, "websearch_to_tsquery")
+	require.Equal(t, []any{"123"}, args)
+}
+
+func TestSearchFacetQuery(t *testing.T) {
+	t.Parallel()
+
+	predicates := searchPredicates(SearchParams{MinDuration: 60})
+	query, args := searchFacetQuery(predicates)
+
+	require.Equal(t, `SELECT GROUPING("vods"."channel_vods", "vods"."type", EXTRACT(YEAR FROM "vods"."streamed_at")::int) AS facet, `+
+		`COALESCE(CAST("vods"."channel_vods" AS text), CAST("vods"."type" AS text), CAST(EXTRACT(YEAR FROM "vods"."streamed_at")::int AS text), '') AS value, `+
+		`COUNT(*) AS count FROM "vods" WHERE "vods"."duration" >= $1 `+
+		`GROUP BY GROUPING SETS (("vods"."channel_vods"), ("vods"."type"), (EXTRACT(YEAR FROM "vods"."streamed_at")::int), ())`, query)
+	require.Equal(t, []any{60}, args)
+}
@@ -0,0 +1,51 @@
+package vod
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/zibbp/ganymede/ent"
+	"github.com/zibbp/ganymede/internal/streamversions"
+	"github.com/zibbp/ganymede/internal/tasks"
+	"github.com/zibbp/ganymede/internal/utils"
+)
+
+// GetVideoVersions returns the versions of the stream of a video, including the video, and how complete
+// each of them is. A video that was archived once is its only version.
+func (s *Service) GetVideoVersions(ctx context.Context, videoID uuid.UUID) ([]streamversions.Version, error) {
+	video, err := s.Store.Client.Vod.Get(ctx, videoID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, fmt.Errorf("video not found")
+		}
+		return nil, fmt.Errorf("error fetching video: %v", err)
+	}
+	videos, err := streamversions.Find(ctx, s.Store.Client, video)
+	if err != nil {
+		return nil, err
+	}
+	return streamversions.Evaluate(videos), nil
+}
+
+// ReconcileVideoVersions queues the reconciliation of the versions of the stream of a video with the policy,
+// or with the configured policy if it is empty.
+func (s *Service) ReconcileVideoVersions(ctx context.Context, videoID uuid.UUID, policy utils.StreamVersionPolicy) error {
+	versions, err := s.GetVideoVersions(ctx, videoID)
+	if err != nil {
+		return err
+	}
+	if len(versions) < 2 {
+		return fmt.Errorf("video has no other versions")
+	}
+	for _, version := range versions {
+		if version.Video.Processing {
+			return fmt.Errorf("video is processing")
+		}
+	}
+
+	if _, err := s.RiverClient.Client.Insert(ctx, tasks.ReconcileStreamVersionsArgs{VideoID: &videoID, Policy: policy}, nil); err != nil {
+		return fmt.Errorf("error queueing stream version reconciliation: %v", err)
+	}
+	return nil
+}
@@ -15,6 +15,7 @@ import (
 	entMutedSegment "github.com/zibbp/ganymede/ent/mutedsegment"
 	"github.com/zibbp/ganymede/ent/vod"
 	"github.com/zibbp/ganymede/internal/database"
+	"github.com/zibbp/ganymede/internal/storage"
 	"github.com/zibbp/ganymede/internal/utils"
 )
 
@@ -49,6 +50,16 @@ func DeleteVod(ctx context.Context, store *database.Database, vodID uuid.UUID, d
 			return fmt.Errorf("error deleting muted segments: %v", err)
 		}
 	}
+	// clips cut from the video keep their own files
+	_, err = store.Client.Vod.Update().Where(vod.ClipVodID(vodID)).ClearClipVodID().Save(ctx)
+	if err != nil {
+		return fmt.Errorf("error unlinking clips: %v", err)
+	}
+	// videos recovered with the audio of the video no longer have a source
+	_, err = store.Client.Vod.Update().Where(vod.AudioSourceID(vodID)).ClearAudioSourceID().ClearAudioSourceOffset().Save(ctx)
+	if err != nil {
+		return fmt.Errorf("error unlinking audio source: %v", err)
+	}
 
 	// delete files
 	if deleteFiles {
@@ -74,7 +85,7 @@ func DeleteVod(ctx context.Context, store *database.Database, vodID uuid.UUID, d
 
 		log.Info().Msgf("deleting directory %s", path)
 
-		if err := utils.DeleteDirectory(path); err != nil {
+		if err := storage.Get().DeleteDirectory(ctx, path); err != nil {
 			log.Error().Err(err).Msg("error deleting directory")
 			return fmt.Errorf("error deleting directory: %v", err)
 		}
@@ -87,6 +98,7 @@ func DeleteVod(ctx context.Context, store *database.Database, vodID uuid.UUID, d
 			v.TmpChatRenderPath,
 			v.TmpLiveChatConvertPath,
 			v.TmpLiveChatDownloadPath,
+			v.TmpAudioPath,
 		}
 		for _, path := range tempFiles {
 			if path != "" {
@@ -21,8 +21,10 @@ import (
 	"github.com/zibbp/ganymede/ent/vod"
 	"github.com/zibbp/ganymede/internal/cache"
 	"github.com/zibbp/ganymede/internal/chat"
+	"github.com/zibbp/ganymede/internal/config"
 	"github.com/zibbp/ganymede/internal/database"
 	"github.com/zibbp/ganymede/internal/platform"
+	"github.com/zibbp/ganymede/internal/storage"
 	"github.com/zibbp/ganymede/internal/tasks"
 	tasks_client "github.com/zibbp/ganymede/internal/tasks/client"
 	"github.com/zibbp/ganymede/internal/utils"
@@ -47,10 +49,15 @@ type Vod struct {
 	Platform                utils.VideoPlatform `json:"platform"`
 	Type                    utils.VodType       `json:"type"`
 	Title                   string              `json:"title"`
+	Category                string              `json:"category"`
 	Duration                int                 `json:"duration"`
 	ClipVodOffset           int                 `json:"clip_vod_offset"`
+	ClipVodID               *uuid.UUID          `json:"clip_vod_id"`
+	TranscodingProfileID    *uuid.UUID          `json:"transcoding_profile_id"`
 	Views                   int                 `json:"views"`
 	Resolution              string              `json:"resolution"`
+	QualityFallbacks        []string            `json:"quality_fallbacks"`
+	Part                    int                 `json:"part"`
 	Processing              bool                `json:"processing"`
 	ThumbnailPath           string              `json:"thumbnail_path"`
 	WebThumbnailPath        string              `json:"web_thumbnail_path"`
@@ -62,6 +69,7 @@ type Vod struct {
 	ChatVideoPath           string              `json:"chat_video_path"`
 	InfoPath                string              `json:"info_path"`
 	CaptionPath             string              `json:"caption_path"`
+	AudioPath               string              `json:"audio_path"`
 	StreamedAt              time.Time           `json:"streamed_at"`
 	UpdatedAt               time.Time           `json:"updated_at"`
 	CreatedAt               time.Time           `json:"created_at"`
@@ -75,6 +83,7 @@ type Vod struct {
 	TmpLiveChatConvertPath  string              `json:"tmp_live_chat_convert_path"`
 	TmpChatRenderPath       string              `json:"tmp_chat_render_path"`
 	TmpVideoHLSPath         string              `json:"tmp_video_hls_path"`
+	TmpAudioPath            string              `json:"tmp_audio_path"`
 }
 
 type Pagination struct {
@@ -96,7 +105,7 @@ func (s *Service) CreateVod(vodDto Vod, cUUID uuid.UUID) (*ent.Vod, error) {
 }
 
 func (s *Service) CreateVodWithClient(ctx context.Context, client *ent.Client, vodDto Vod, cUUID uuid.UUID) (*ent.Vod, error) {
-	v, err := client.Vod.Create().SetID(vodDto.ID).SetChannelID(cUUID).SetExtID(vodDto.ExtID).SetExtStreamID(vodDto.ExtStreamID).SetPlatform(vodDto.Platform).SetType(vodDto.Type).SetTitle(vodDto.Title).SetDuration(vodDto.Duration).SetViews(vodDto.Views).SetResolution(vodDto.Resolution).SetProcessing(vodDto.Processing).SetThumbnailPath(vodDto.ThumbnailPath).SetWebThumbnailPath(vodDto.WebThumbnailPath).SetVideoPath(vodDto.VideoPath).SetChatPath(vodDto.ChatPath).SetChatVideoPath(vodDto.ChatVideoPath).SetInfoPath(vodDto.InfoPath).SetCaptionPath(vodDto.CaptionPath).SetStreamedAt(vodDto.StreamedAt).SetFolderName(vodDto.FolderName).SetFileName(vodDto.FileName).SetLocked(vodDto.Locked).SetTmpVideoDownloadPath(vodDto.TmpVideoDownloadPath).SetTmpVideoConvertPath(vodDto.TmpVideoConvertPath).SetTmpChatDownloadPath(vodDto.TmpChatDownloadPath).SetTmpLiveChatDownloadPath(vodDto.TmpLiveChatDownloadPath).SetTmpLiveChatConvertPath(vodDto.TmpLiveChatConvertPath).SetTmpChatRenderPath(vodDto.TmpChatRenderPath).SetLiveChatPath(vodDto.LiveChatPath).SetLiveChatConvertPath(vodDto.LiveChatConvertPath).SetVideoHlsPath(vodDto.VideoHLSPath).SetTmpVideoHlsPath(vodDto.TmpVideoHLSPath).SetClipVodOffset(vodDto.ClipVodOffset).SetClipExtVodID(vodDto.ClipExtVodID).Save(ctx)
+	v, err := client.Vod.Create().SetID(vodDto.ID).SetChannelID(cUUID).SetExtID(vodDto.ExtID).SetExtStreamID(vodDto.ExtStreamID).SetPlatform(vodDto.Platform).SetType(vodDto.Type).SetTitle(vodDto.Title).SetCategory(vodDto.Category).SetDuration(vodDto.Duration).SetViews(vodDto.Views).SetResolution(vodDto.Resolution).SetProcessing(vodDto.Processing).SetThumbnailPath(vodDto.ThumbnailPath).SetWebThumbnailPath(vodDto.WebThumbnailPath).SetVideoPath(vodDto.VideoPath).SetChatPath(vodDto.ChatPath).SetChatVideoPath(vodDto.ChatVideoPath).SetInfoPath(vodDto.InfoPath).SetCaptionPath(vodDto.CaptionPath).SetStreamedAt(vodDto.StreamedAt).SetFolderName(vodDto.FolderName).SetFileName(vodDto.FileName).SetLocked(vodDto.Locked).SetTmpVideoDownloadPath(vodDto.TmpVideoDownloadPath).SetTmpVideoConvertPath(vodDto.TmpVideoConvertPath).SetTmpChatDownloadPath(vodDto.TmpChatDownloadPath).SetTmpLiveChatDownloadPath(vodDto.TmpLiveChatDownloadPath).SetTmpLiveChatConvertPath(vodDto.TmpLiveChatConvertPath).SetTmpChatRenderPath(vodDto.TmpChatRenderPath).SetLiveChatPath(vodDto.LiveChatPath).SetLiveChatConvertPath(vodDto.LiveChatConvertPath).SetVideoHlsPath(vodDto.VideoHLSPath).SetTmpVideoHlsPath(vodDto.TmpVideoHLSPath).SetQualityFallbacks(vodDto.QualityFallbacks).SetPart(vodDto.Part).SetAudioPath(vodDto.AudioPath).SetTmpAudioPath(vodDto.TmpAudioPath).SetClipVodOffset(vodDto.ClipVodOffset).SetClipExtVodID(vodDto.ClipExtVodID).SetNillableClipVodID(vodDto.ClipVodID).SetNillableTranscodingProfileID(vodDto.TranscodingProfileID).Save(ctx)
 	if err != nil {
 		log.Debug().Err(err).Msg("error creating vod")
 		if _, ok := err.(*ent.ConstraintError); ok {
@@ -178,7 +187,7 @@ func (s *Service) DeleteVod(ctx context.Context, vodID uuid.UUID, deleteFiles bo
 }
 
 func (s *Service) UpdateVod(c echo.Context, vodID uuid.UUID, vodDto Vod, cUUID uuid.UUID) (*ent.Vod, error) {
-	v, err := s.Store.Client.Vod.UpdateOneID(vodID).SetChannelID(cUUID).SetExtID(vodDto.ExtID).SetExtID(vodDto.ExtID).SetPlatform(vodDto.Platform).SetType(vodDto.Type).SetTitle(vodDto.Title).SetDuration(vodDto.Duration).SetViews(vodDto.Views).SetResolution(vodDto.Resolution).SetProcessing(vodDto.Processing).SetThumbnailPath(vodDto.ThumbnailPath).SetWebThumbnailPath(vodDto.WebThumbnailPath).SetVideoPath(vodDto.VideoPath).SetChatPath(vodDto.ChatPath).SetChatVideoPath(vodDto.ChatVideoPath).SetInfoPath(vodDto.InfoPath).SetCaptionPath(vodDto.CaptionPath).SetStreamedAt(vodDto.StreamedAt).SetLocked(vodDto.Locked).SetClipVodOffset(vodDto.ClipVodOffset).SetClipExtVodID(vodDto.ClipExtVodID).Save(c.Request().Context())
+	v, err := s.Store.Client.Vod.UpdateOneID(vodID).SetChannelID(cUUID).SetExtID(vodDto.ExtID).SetExtID(vodDto.ExtID).SetPlatform(vodDto.Platform).SetType(vodDto.Type).SetTitle(vodDto.Title).SetCategory(vodDto.Category).SetDuration(vodDto.Duration).SetViews(vodDto.Views).SetResolution(vodDto.Resolution).SetProcessing(vodDto.Processing).SetThumbnailPath(vodDto.ThumbnailPath).SetWebThumbnailPath(vodDto.WebThumbnailPath).SetVideoPath(vodDto.VideoPath).SetChatPath(vodDto.ChatPath).SetChatVideoPath(vodDto.ChatVideoPath).SetInfoPath(vodDto.InfoPath).SetCaptionPath(vodDto.CaptionPath).SetStreamedAt(vodDto.StreamedAt).SetLocked(vodDto.Locked).SetClipVodOffset(vodDto.ClipVodOffset).SetClipExtVodID(vodDto.ClipExtVodID).Save(c.Request().Context())
 	if err != nil {
 		log.Debug().Err(err).Msg("error updating vod")
 
@@ -207,53 +216,6 @@ func (s *Service) CheckVodExists(extID string) (bool, error) {
 	return true, nil
 }
 
-func (s *Service) SearchVods(ctx context.Context, limit int, offset int, types []utils.VodType, predicates []predicate.Vod, sortBy utils.VideoSort, sortOrder utils.SortOrder) (Pagination, error) {
-
-	var pagination Pagination
-
-	queryBuilder := s.Store.Client.Vod.Query().
-		Where(vod.Or(predicates...)).
-		WithChannel().
-		Limit(limit).
-		Offset(offset)
-
-	if len(types) > 0 {
-		queryBuilder = queryBuilder.Where(vod.TypeIn(types...))
-	}
-
-	// Apply sorting
-	queryBuilder, err := applyVodSorting(queryBuilder, sortBy, sortOrder)
-	if err != nil {
-		log.Debug().Err(err).Msg("error applying vod sorting")
-		return pagination, fmt.Errorf("error applying vod sorting: %v", err)
-	}
-
-	vods, err := queryBuilder.All(ctx)
-	if err != nil {
-		log.Debug().Err(err).Msg("error searching vods")
-		return pagination, fmt.Errorf("error searching vods: %v", err)
-	}
-
-	countQuery := s.Store.Client.Vod.Query().Where(vod.Or(predicates...))
-	if len(types) > 0 {
-		countQuery = countQuery.Where(vod.TypeIn(types...))
-	}
-
-	totalCount, err := countQuery.Count(ctx)
-	if err != nil {
-		log.Debug().Err(err).Msg("error getting total vod count")
-		return pagination, fmt.Errorf("error getting total vod count: %v", err)
-	}
-
-	pagination.TotalCount = totalCount
-	pagination.Limit = limit
-	pagination.Offset = offset
-	pagination.Pages = int(math.Ceil(float64(totalCount) / float64(limit)))
-	pagination.Data = vods
-
-	return pagination, nil
-}
-
 func (s *Service) GetVodPlaylists(c echo.Context, vodID uuid.UUID) ([]*ent.Playlist, error) {
 	v, err := s.Store.Client.Vod.Query().Where(vod.ID(vodID)).WithPlaylists().Only(c.Request().Context())
 	if err != nil {
@@ -353,13 +315,29 @@ func (s *Service) GenerateSpriteThumbnails(ctx context.Context, videoID uuid.UUI
 	}, nil)
 }
 
+// GenerateCaptions queues speech-to-text caption generation for a video.
+func (s *Service) GenerateCaptions(ctx context.Context, videoID uuid.UUID) (*rivertype.JobInsertResult, error) {
+	if config.Get().Parameters.SpeechToText == "" {
+		return nil, fmt.Errorf("speech-to-text command is not configured")
+	}
+	return s.RiverClient.Client.Insert(ctx, tasks.GenerateCaptionsArgs{
+		VideoId: videoID.String(),
+	}, nil)
+}
+
 func (s *Service) GetVodClips(ctx context.Context, id uuid.UUID) ([]*ent.Vod, error) {
 	video, err := s.Store.Client.Vod.Query().Where(vod.ID(id)).Only(ctx)
 	if err != nil {
 		return nil, err
 	}
 
-	clips, err := s.Store.Client.Vod.Query().Where(vod.ClipExtVodID(video.ExtID)).All(ctx)
+	// clips archived from the platform are linked by the ID of the video on the platform
+	// and clips created in Ganymede by the ID of the video
+	linked := []predicate.Vod{vod.ClipVodID(video.ID)}
+	if video.ExtID != "" {
+		linked = append(linked, vod.ClipExtVodID(video.ExtID))
+	}
+	clips, err := s.Store.Client.Vod.Query().Where(vod.TypeEQ(utils.Clip), vod.Or(linked...)).All(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -372,7 +350,7 @@ func (s *Service) GetUserIdFromChat(c echo.Context, vodID uuid.UUID) (*int64, er
 		log.Debug().Err(err).Msg("error getting vod")
 		return nil, fmt.Errorf("error getting vod: %v", err)
 	}
-	data, err := utils.ReadChatFile(v.ChatPath)
+	data, err := storage.ReadFile(c.Request().Context(), v.ChatPath)
 	if err != nil {
 		log.Debug().Err(err).Msg("error reading chat file")
 		return nil, fmt.Errorf("error reading chat file: %v", err)
@@ -504,7 +482,7 @@ func loadChatIntoCache(vod *ent.Vod) error {
 	var chatData *chat.ChatNoEmotes
 	var comments []chat.Comment
 
-	data, err := utils.ReadChatFile(vod.ChatPath)
+	data, err := storage.ReadFile(context.Background(), vod.ChatPath)
 	if err != nil {
 		log.Debug().Err(err).Msg("error getting vod chat")
 		return fmt.Errorf("error getting vod chat: %v", err)
@@ -596,7 +574,7 @@ func (s *Service) GetChatEmotes(ctx context.Context, vodID uuid.UUID) (*platform
 	if err != nil {
 		return nil, err
 	}
-	data, err := utils.ReadChatFile(v.ChatPath)
+	data, err := storage.ReadFile(ctx, v.ChatPath)
 	if err != nil {
 		return nil, fmt.Errorf("error reading chat file: %v", err)
 	}
@@ -736,7 +714,7 @@ func (s *Service) GetChatBadges(ctx context.Context, vodID uuid.UUID) (*platform
 	if err != nil {
 		return nil, fmt.Errorf("error getting vod chat emotes: %v", err)
 	}
-	data, err := utils.ReadChatFile(v.ChatPath)
+	data, err := storage.ReadFile(ctx, v.ChatPath)
 	if err != nil {
 		return nil, fmt.Errorf("error getting vod chat emotes: %v", err)
 	}
@@ -1022,7 +1000,7 @@ func applyVodSorting(query *ent.VodQuery, sortBy utils.VideoSort, sortOrder util
 				query = query.Order(ent.Desc(vod.FieldCreatedAt))
 			}
 		default:
-			return query, fmt.Errorf("invalid sortBy option, must be one of: %v", utils.VideoSort("").Values())
+			return query, fmt.Errorf("invalid sortBy option, must be one of: %v", utils.VideoSort("").ListValues())
 		}
 	} else {
 		// Default sortBy by streamed at
@@ -0,0 +1,342 @@
+package watchparty
+
+import (
+	"fmt"
+	"math"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/gorilla/websocket"
+	"github.com/rs/zerolog/log"
+	"github.com/zibbp/ganymede/ent"
+)
+
+// MessageType is the type of a message sent over the connection of a watch party member.
+type MessageType string
+
+const (
+	// Sent by the host to control playback of the room.
+	MessagePlay  MessageType = "play"
+	MessagePause MessageType = "pause"
+	MessageSeek  MessageType = "seek"
+	// Sent by members to request the state of the room, e.g. to correct drift.
+	MessageSync MessageType = "sync"
+	// Sent by the server when the state of the room changes or is requested.
+	MessageState MessageType = "state"
+	// Sent by the server when a message of the member is rejected.
+	MessageError MessageType = "error"
+)
+
+// Message is a message sent between the server and a member of a watch party.
+type Message struct {
+	Type     MessageType `json:"type"`
+	Position float64     `json:"position,omitempty"` // seconds on the video timeline, for play, pause and seek
+	State    *State      `json:"state,omitempty"`
+	Error    string      `json:"error,omitempty"`
+}
+
+// Member is a user that joined a watch party.
+type Member struct {
+	UserID   uuid.UUID `json:"user_id"`
+	Username string    `json:"username"`
+}
+
+// State is the playback state of a watch party room.
+type State struct {
+	ID        uuid.UUID `json:"id"`
+	VideoID   uuid.UUID `json:"video_id"`
+	HostID    uuid.UUID `json:"host_id"`
+	Playing   bool      `json:"playing"`
+	Position  float64   `json:"position"` // seconds on the video timeline when the state was sent
+	UpdatedAt time.Time `json:"updated_at"`
+	Members   []Member  `json:"members"`
+}
+
+// Conn is the WebSocket connection of a watch party member.
+type Conn interface {
+	ReadJSON(v any) error
+	WriteJSON(v any) error
+	WriteControl(messageType int, data []byte, deadline time.Time) error
+	SetReadLimit(limit int64)
+	SetReadDeadline(t time.Time) error
+	SetPongHandler(h func(appData string) error)
+	Close() error
+}
+
+// emptyRoomTimeout is how long a room without members is kept for the host to (re)join.
+const emptyRoomTimeout = 10 * time.Minute
+
+// memberSendBuffer is how many messages are queued for a member before it is disconnected as too slow.
+const memberSendBuffer = 16
+
+// maxMessageSize is the largest message a member can send, the messages of members are small.
+const maxMessageSize = 4096
+
+const (
+	// pongWait is how long a member has to answer a ping before it is disconnected, e.g. after its device went to sleep.
+	pongWait = 60 * time.Second
+	// pingPeriod is how often members are pinged, it must be less than pongWait.
+	pingPeriod = pongWait * 9 / 10
+	// writeWait is how long writing a ping can take.
+	writeWait = 10 * time.Second
+)
+
+var ErrorRoomNotFound = fmt.Errorf("watch party not found")
+
+type member struct {
+	user *ent.User
+	conn Conn
+	send chan Message
+}
+
+type room struct {
+	id            uuid.UUID
+	videoID       uuid.UUID
+	videoDuration float64
+	hostID        uuid.UUID
+	playing       bool
+	position      float64
+	updatedAt     time.Time
+	members       []*member
+	emptySince    time.Time
+}
+
+// Service keeps the watch party rooms in memory. The state of a room is owned by the server; only the host
+// controls playback and every change is broadcast to the members.
+type Service struct {
+	mu         sync.Mutex
+	rooms      map[uuid.UUID]*room
+	now        func() time.Time
+	pongWait   time.Duration
+	pingPeriod time.Duration
+}
+
+func NewService() *Service {
+	return &Service{rooms: make(map[uuid.UUID]*room), now: time.Now, pongWait: pongWait, pingPeriod: pingPeriod}
+}
+
+// CreateRoom creates a paused watch party room for the video hosted by the user.
+func (s *Service) CreateRoom(host *ent.User, video *ent.Vod) *State {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.pruneRooms()
+
+	now := s.now()
+	r := &room{
+		id:            uuid.New(),
+		videoID:       video.ID,
+		videoDuration: float64(video.Duration),
+		hostID:        host.ID,
+		updatedAt:     now,
+		emptySince:    now,
+	}
+	s.rooms[r.id] = r
+	return s.state(r)
+}
+
+// GetRoom returns the state of the watch party room.
+func (s *Service) GetRoom(id uuid.UUID) (*State, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.pruneRooms()
+
+	r, ok := s.rooms[id]
+	if !ok {
+		return nil, ErrorRoomNotFound
+	}
+	return s.state(r), nil
+}
+
+// Join adds the user to the watch party room and handles the messages of the connection until it is closed.
+// The state of the room is sent to the user when joining and to every member when it changes. Members that
+// stop answering pings are disconnected.
+func (s *Service) Join(id uuid.UUID, user *ent.User, conn Conn) error {
+	m := &member{user: user, conn: conn, send: make(chan Message, memberSendBuffer)}
+
+	conn.SetReadLimit(maxMessageSize)
+	if err := conn.SetReadDeadline(time.Now().Add(s.pongWait)); err != nil {
+		return err
+	}
+	conn.SetPongHandler(func(string) error {
+		return conn.SetReadDeadline(time.Now().Add(s.pongWait))
+	})
+
+	s.mu.Lock()
+	r, ok := s.rooms[id]
+	if !ok {
+		s.mu.Unlock()
+		return ErrorRoomNotFound
+	}
+	r.members = append(r.members, m)
+	r.emptySince = time.Time{}
+	s.broadcast(r)
+	s.mu.Unlock()
+
+	go m.write(s.pingPeriod)
+	defer s.leave(r, m)
+
+	for {
+		var msg Message
+		if err := conn.ReadJSON(&msg); err != nil {
+			return nil
+		}
+		s.handle(r, m, msg)
+	}
+}
+
+// handle applies a message of the member to the room.
+func (s *Service) handle(r *room, m *member, msg Message) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	switch msg.Type {
+	case MessageSync:
+		s.sendTo(r, m, Message{Type: MessageState, State: s.state(r)})
+		return
+	case MessagePlay, MessagePause, MessageSeek:
+	default:
+		s.sendTo(r, m, Message{Type: MessageError, Error: fmt.Sprintf("unknown message type: %s", msg.Type)})
+		return
+	}
+
+	if m.user.ID != r.hostID {
+		s.sendTo(r, m, Message{Type: MessageError, Error: "only the host can control playback"})
+		return
+	}
+	if math.IsNaN(msg.Position) || msg.Position < 0 || (r.videoDuration > 0 && msg.Position > r.videoDuration) {
+		s.sendTo(r, m, Message{Type: MessageError, Error: "position is out of range"})
+		return
+	}
+
+	switch msg.Type {
+	case MessagePlay:
+		r.playing = true
+	case MessagePause:
+		r.playing = false
+	}
+	r.position = msg.Position
+	r.updatedAt = s.now()
+	s.broadcast(r)
+}
+
+// leave removes the member from the room. The member who joined first becomes the host if the host left
+// and nobody else connected as the host.
+func (s *Service) leave(r *room, m *member) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for i, member := range r.members {
+		if member == m {
+			r.members = append(r.members[:i], r.members[i+1:]...)
+			close(m.send)
+			break
+		}
+	}
+	if len(r.members) == 0 {
+		// keep playing on the server so members rejoining are in sync
+		r.emptySince = s.now()
+		return
+	}
+
+	hostConnected := false
+	for _, member := range r.members {
+		if member.user.ID == r.hostID {
+			hostConnected = true
+			break
+		}
+	}
+	if !hostConnected {
+		r.hostID = r.members[0].user.ID
+	}
+	s.broadcast(r)
+}
+
+// broadcast sends the state of the room to every member. s.mu must be held.
+func (s *Service) broadcast(r *room) {
+	state := s.state(r)
+	for _, m := range r.members {
+		s.sendTo(r, m, Message{Type: MessageState, State: state})
+	}
+}
+
+// sendTo queues the message for the member, disconnecting members that can't keep up. s.mu must be held.
+func (s *Service) sendTo(r *room, m *member, msg Message) {
+	select {
+	case m.send <- msg:
+	default:
+		log.Debug().Str("watch_party_id", r.id.String()).Str("user_id", m.user.ID.String()).Msg("watch party member is too slow, disconnecting")
+		// the read loop of the member fails and removes the member
+		_ = m.conn.Close()
+	}
+}
+
+// write writes the queued messages of the member to its connection and pings it until the member leaves.
+func (m *member) write(pingPeriod time.Duration) {
+	ticker := time.NewTicker(pingPeriod)
+	defer ticker.Stop()
+
+	for {
+		var err error
+		select {
+		case msg, ok := <-m.send:
+			if !ok {
+				_ = m.conn.Close()
+				return
+			}
+			err = m.conn.WriteJSON(msg)
+		case <-ticker.C:
+			err = m.conn.WriteControl(websocket.PingMessage, nil, time.Now().Add(writeWait))
+		}
+		if err != nil {
+			_ = m.conn.Close()
+			// drain the queue until the read loop removes the member
+			for range m.send {
+			}
+			return
+		}
+	}
+}
+
+// state returns the state of the room with the position at the current time. s.mu must be held.
+func (s *Service) state(r *room) *State {
+	now := s.now()
+	position := r.position
+	if r.playing {
+		position += now.Sub(r.updatedAt).Seconds()
+		if r.videoDuration > 0 {
+			position = math.Min(position, r.videoDuration)
+		}
+	}
+
+	members := []Member{}
+	seen := make(map[uuid.UUID]bool)
+	for _, m := range r.members {
+		// a user can join from multiple devices
+		if seen[m.user.ID] {
+			continue
+		}
+		seen[m.user.ID] = true
+		members = append(members, Member{UserID: m.user.ID, Username: m.user.Username})
+	}
+
+	return &State{
+		ID:        r.id,
+		VideoID:   r.videoID,
+		HostID:    r.hostID,
+		Playing:   r.playing,
+		Position:  position,
+		UpdatedAt: r.updatedAt,
+		Members:   members,
+	}
+}
+
+// pruneRooms removes rooms that have been empty for longer than the empty room timeout. s.mu must be held.
+func (s *Service) pruneRooms() {
+	now := s.now()
+	for id, r := range s.rooms {
+		if len(r.members) == 0 && now.Sub(r.emptySince) > emptyRoomTimeout {
+			delete(s.rooms, id)
+		}
+	}
+}
@@ -0,0 +1,236 @@
+package watchparty
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/gorilla/websocket"
+	"github.com/stretchr/testify/require"
+	"github.com/zibbp/ganymede/ent"
+)
+
+// testClock is a clock the tests advance manually so positions of playing rooms are deterministic.
+type testClock struct {
+	mu  sync.Mutex
+	now time.Time
+}
+
+func (c *testClock) Now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.now
+}
+
+func (c *testClock) Advance(d time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.now = c.now.Add(d)
+}
+
+// newWatchPartyTestServer serves the rooms of the service over WebSockets, authenticating users by the
+// user query parameter.
+func newWatchPartyTestServer(t *testing.T, s *Service, users map[string]*ent.User) *httptest.Server {
+	t.Helper()
+	upgrader := websocket.Upgrader{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		id, err := uuid.Parse(strings.TrimPrefix(r.URL.Path, "/"))
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		conn, err := upgrader.Upgrade(w, r, nil)
+		if err != nil {
+			return
+		}
+		defer conn.Close() //nolint:errcheck
+		if err := s.Join(id, users[r.URL.Query().Get("user")], conn); err != nil {
+			_ = conn.WriteJSON(Message{Type: MessageError, Error: err.Error()})
+		}
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func joinWatchParty(t *testing.T, server *httptest.Server, id uuid.UUID, user string) *websocket.Conn {
+	t.Helper()
+	url := "ws" + strings.TrimPrefix(server.URL, "http") + "/" + id.String() + "?user=" + user
+	conn, _, err := websocket.DefaultDialer.Dial(url, nil)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = conn.Close() })
+	return conn
+}
+
+func readWatchPartyMessage(t *testing.T, conn *websocket.Conn) Message {
+	t.Helper()
+	require.NoError(t, conn.SetReadDeadline(time.Now().Add(5*time.Second)))
+	var msg Message
+	require.NoError(t, conn.ReadJSON(&msg))
+	return msg
+}
+
+// readWatchPartyState reads messages until a state matching the condition is received.
+func readWatchPartyState(t *testing.T, conn *websocket.Conn, condition func(*State) bool) *State {
+	t.Helper()
+	for {
+		msg := readWatchPartyMessage(t, conn)
+		if msg.Type == MessageState && condition(msg.State) {
+			return msg.State
+		}
+	}
+}
+
+func newWatchPartyTestService() (*Service, *testClock) {
+	clock := &testClock{now: time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)}
+	s := NewService()
+	s.now = clock.Now
+	return s, clock
+}
+
+func TestWatchPartySync(t *testing.T) {
+	t.Parallel()
+
+	s, clock := newWatchPartyTestService()
+	host := &ent.User{ID: uuid.New(), Username: "host"}
+	guest := &ent.User{ID: uuid.New(), Username: "guest"}
+	server := newWatchPartyTestServer(t, s, map[string]*ent.User{"host": host, "guest": guest})
+
+	video := &ent.Vod{ID: uuid.New(), Duration: 3600}
+	room := s.CreateRoom(host, video)
+	require.Equal(t, video.ID, room.VideoID)
+	require.Equal(t, host.ID, room.HostID)
+	require.False(t, room.Playing)
+
+	hostConn := joinWatchParty(t, server, room.ID, "host")
+	readWatchPartyState(t, hostConn, func(state *State) bool { return len(state.Members) == 1 })
+	guestConn := joinWatchParty(t, server, room.ID, "guest")
+	state := readWatchPartyState(t, guestConn, func(state *State) bool { return len(state.Members) == 2 })
+	require.Equal(t, []Member{{UserID: host.ID, Username: "host"}, {UserID: guest.ID, Username: "guest"}}, state.Members)
+	readWatchPartyState(t, hostConn, func(state *State) bool { return len(state.Members) == 2 })
+
+	// playback of the host is broadcast to the guest
+	require.NoError(t, hostConn.WriteJSON(Message{Type: MessagePlay, Position: 120}))
+	state = readWatchPartyState(t, guestConn, func(state *State) bool { return state.Playing })
+	require.Equal(t, 120.0, state.Position)
+
+	// the position of a playing room follows the clock
+	clock.Advance(30 * time.Second)
+	require.NoError(t, guestConn.WriteJSON(Message{Type: MessageSync}))
+	state = readWatchPartyState(t, guestConn, func(state *State) bool { return true })
+	require.Equal(t, 150.0, state.Position)
+
+	require.NoError(t, hostConn.WriteJSON(Message{Type: MessageSeek, Position: 600}))
+	state = readWatchPartyState(t, guestConn, func(state *State) bool { return state.Position == 600 })
+	require.True(t, state.Playing)
+
+	require.NoError(t, hostConn.WriteJSON(Message{Type: MessagePause, Position: 610}))
+	state = readWatchPartyState(t, guestConn, func(state *State) bool { return !state.Playing })
+	require.Equal(t, 610.0, state.Position)
+
+	clock.Advance(time.Minute)
+	state, err := s.GetRoom(room.ID)
+	require.NoError(t, err)
+	require.Equal(t, 610.0, state.Position)
+}
+
+func TestWatchPartyOnlyHostControlsPlayback(t *testing.T) {
+	t.Parallel()
+
+	s, _ := newWatchPartyTestService()
+	host := &ent.User{ID: uuid.New(), Username: "host"}
+	guest := &ent.User{ID: uuid.New(), Username: "guest"}
+	server := newWatchPartyTestServer(t, s, map[string]*ent.User{"host": host, "guest": guest})
+	room := s.CreateRoom(host, &ent.Vod{ID: uuid.New(), Duration: 60})
+
+	hostConn := joinWatchParty(t, server, room.ID, "host")
+	readWatchPartyState(t, hostConn, func(state *State) bool { return len(state.Members) == 1 })
+	guestConn := joinWatchParty(t, server, room.ID, "guest")
+	readWatchPartyState(t, guestConn, func(state *State) bool { return len(state.Members) == 2 })
+
+	require.NoError(t, guestConn.WriteJSON(Message{Type: MessagePlay, Position: 10}))
+	msg := readWatchPartyMessage(t, guestConn)
+	require.Equal(t, MessageError, msg.Type)
+	require.Equal(t, "only the host can control playback", msg.Error)
+
+	require.NoError(t, hostConn.WriteJSON(Message{Type: MessageSeek, Position: 61}))
+	readWatchPartyState(t, hostConn, func(state *State) bool { return true })
+	msg = readWatchPartyMessage(t, hostConn)
+	require.Equal(t, MessageError, msg.Type)
+	require.Equal(t, "position is out of range", msg.Error)
+
+	state, err := s.GetRoom(room.ID)
+	require.NoError(t, err)
+	require.False(t, state.Playing)
+	require.Zero(t, state.Position)
+
+	// the guest becomes the host when the host leaves
+	require.NoError(t, hostConn.Close())
+	state = readWatchPartyState(t, guestConn, func(state *State) bool { return len(state.Members) == 1 })
+	require.Equal(t, guest.ID, state.HostID)
+}
+
+func TestWatchPartyRoomNotFound(t *testing.T) {
+	t.Parallel()
+
+	s, clock := newWatchPartyTestService()
+	user := &ent.User{ID: uuid.New(), Username: "user"}
+	server := newWatchPartyTestServer(t, s, map[string]*ent.User{"user": user})
+
+	_, err := s.GetRoom(uuid.New())
+	require.ErrorIs(t, err, ErrorRoomNotFound)
+
+	conn := joinWatchParty(t, server, uuid.New(), "user")
+	msg := readWatchPartyMessage(t, conn)
+	require.Equal(t, MessageError, msg.Type)
+	require.Equal(t, ErrorRoomNotFound.Error(), msg.Error)
+
+	// rooms nobody joined are removed after the empty room timeout
+	room := s.CreateRoom(user, &ent.Vod{ID: uuid.New()})
+	clock.Advance(emptyRoomTimeout + time.Second)
+	_, err = s.GetRoom(room.ID)
+	require.ErrorIs(t, err, ErrorRoomNotFound)
+}
+
+func TestWatchPartyDisconnectsUnresponsiveMembers(t *testing.T) {
+	t.Parallel()
+
+	s, _ := newWatchPartyTestService()
+	s.pongWait = 300 * time.Millisecond
+	s.pingPeriod = 100 * time.Millisecond
+	host := &ent.User{ID: uuid.New(), Username: "host"}
+	guest := &ent.User{ID: uuid.New(), Username: "guest"}
+	other := &ent.User{ID: uuid.New(), Username: "other"}
+	server := newWatchPartyTestServer(t, s, map[string]*ent.User{"host": host, "guest": guest, "other": other})
+	room := s.CreateRoom(host, &ent.Vod{ID: uuid.New(), Duration: 60})
+
+	hostConn := joinWatchParty(t, server, room.ID, "host")
+	readWatchPartyState(t, hostConn, func(state *State) bool { return len(state.Members) == 1 })
+	guestConn := joinWatchParty(t, server, room.ID, "guest")
+	readWatchPartyState(t, guestConn, func(state *State) bool { return len(state.Members) == 2 })
+
+	// the socket of the host stays open but stops answering pings, e.g. after the laptop went to sleep
+	hostConn.SetPingHandler(func(string) error { return nil })
+	require.NoError(t, hostConn.SetReadDeadline(time.Time{}))
+	go func() {
+		for {
+			if _, _, err := hostConn.ReadMessage(); err != nil {
+				return
+			}
+		}
+	}()
+
+	// the guest keeps answering pings and becomes the host
+	state := readWatchPartyState(t, guestConn, func(state *State) bool { return len(state.Members) == 1 })
+	require.Equal(t, guest.ID, state.HostID)
+
+	// members sending messages over the limit are disconnected
+	otherConn := joinWatchParty(t, server, room.ID, "other")
+	readWatchPartyState(t, otherConn, func(state *State) bool { return len(state.Members) == 2 })
+	require.NoError(t, otherConn.WriteJSON(Message{Type: MessageSync, Error: strings.Repeat("a", maxMessageSize)}))
+	state = readWatchPartyState(t, guestConn, func(state *State) bool { return len(state.Members) == 1 })
+	require.Equal(t, guest.ID, state.HostID)
+}
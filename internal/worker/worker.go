@@ -18,6 +18,7 @@ import (
 	"github.com/zibbp/ganymede/internal/notification"
 	"github.com/zibbp/ganymede/internal/platform"
 	"github.com/zibbp/ganymede/internal/queue"
+	"github.com/zibbp/ganymede/internal/storage"
 	tasks_client "github.com/zibbp/ganymede/internal/tasks/client"
 	tasks_worker "github.com/zibbp/ganymede/internal/tasks/worker"
 	"github.com/zibbp/ganymede/internal/vod"
@@ -45,6 +46,10 @@ func SetupWorker(ctx context.Context) (*tasks_worker.RiverWorkerClient, error) {
 		zerolog.SetGlobalLevel(zerolog.Disabled)
 	}
 
+	if _, err := storage.Init(); err != nil {
+		return nil, fmt.Errorf("error initializing archive storage: %w", err)
+	}
+
 	dbString := fmt.Sprintf("user=%s password=%s host=%s port=%s dbname=%s sslmode=%s sslrootcert=%s", envAppConfig.DB_USER, envAppConfig.DB_PASS, envAppConfig.DB_HOST, envAppConfig.DB_PORT, envAppConfig.DB_NAME, envAppConfig.DB_SSL, envAppConfig.DB_SSL_ROOT_CERT)
 
 	db := database.NewDatabase(ctx, database.DatabaseConnectionInput{
@@ -72,14 +77,33 @@ func SetupWorker(ctx context.Context) (*tasks_worker.RiverWorkerClient, error) {
 		}
 	}
 
+	var platformYoutube platform.Platform
+	// setup youtube platform
+	if envConfig.YoutubeApiKey != "" {
+		platformYoutube = &platform.YoutubeConnection{
+			ApiKey: envConfig.YoutubeApiKey,
+		}
+		_, err = platformYoutube.Authenticate(ctx)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// setup kick platform, the public kick api does not require credentials
+	platformKick := &platform.KickConnection{}
+	_, err = platformKick.Authenticate(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	chapterService := chapter.NewService(db)
-	channelService := channel.NewService(db, platformTwitch)
+	channelService := channel.NewService(db, platformTwitch, platformYoutube, platformKick)
 	vodService := vod.NewService(db, riverClient, platformTwitch)
 	queueService := queue.NewService(db, vodService, channelService, riverClient)
 	blockedVodsService := blocked.NewService(db)
 	notificationService := notification.NewService(db)
-	archiveService := archive.NewService(db, channelService, vodService, queueService, blockedVodsService, riverClient, platformTwitch)
-	liveService := live.NewService(db, archiveService, platformTwitch, chapterService, queueService, notificationService)
+	archiveService := archive.NewService(db, channelService, vodService, queueService, blockedVodsService, riverClient, platformTwitch, platformYoutube, platformKick)
+	liveService := live.NewService(db, archiveService, platformTwitch, platformYoutube, platformKick, chapterService, queueService, notificationService)
 
 	// initialize river
 	riverWorkerClient, err := tasks_worker.NewRiverWorker(tasks_worker.RiverWorkerInput{
@@ -88,6 +112,8 @@ func SetupWorker(ctx context.Context) (*tasks_worker.RiverWorkerClient, error) {
 		DB:                      db,
 		LiveService:             liveService,
 		PlatformTwitch:          platformTwitch,
+		PlatformYoutube:         platformYoutube,
+		PlatformKick:            platformKick,
 		NotificationService:     notificationService,
 		Enqueuer:                riverClient,
 		VideoDownloadWorkers:    envConfig.MaxVideoDownloadExecutions,
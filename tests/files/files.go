@@ -0,0 +1,19 @@
+// Package tests_files writes the files of unit tests that read from disk.
+package tests_files
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+// Write writes data to the file at path, creating its parent directories.
+func Write(t testing.TB, path string, data string) {
+	t.Helper()
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		t.Fatalf("error creating directory of %s: %v", path, err)
+	}
+	if err := os.WriteFile(path, []byte(data), 0644); err != nil {
+		t.Fatalf("error writing %s: %v", path, err)
+	}
+}